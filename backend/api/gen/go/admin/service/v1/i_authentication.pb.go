@@ -29,30 +29,60 @@ var File_admin_service_v1_i_authentication_proto protoreflect.FileDescriptor
 
 const file_admin_service_v1_i_authentication_proto_rawDesc = "" +
 	"\n" +
-	"'admin/service/v1/i_authentication.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1auser/service/v1/user.proto\x1a.authentication/service/v1/authentication.proto2\xf3\x02\n" +
+	"'admin/service/v1/i_authentication.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1auser/service/v1/user.proto\x1a.authentication/service/v1/authentication.proto2\xe4\n" +
+	"\n" +
 	"\x15AuthenticationService\x12{\n" +
 	"\x05Login\x12'.authentication.service.v1.LoginRequest\x1a(.authentication.service.v1.LoginResponse\"\x1f\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/admin/v1/login\x12U\n" +
 	"\x06Logout\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/admin/v1/logout\x12\x85\x01\n" +
-	"\fRefreshToken\x12'.authentication.service.v1.LoginRequest\x1a(.authentication.service.v1.LoginResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/v1/refresh-tokenB\xc1\x01\n" +
+	"\fRefreshToken\x12'.authentication.service.v1.LoginRequest\x1a(.authentication.service.v1.LoginResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/v1/refresh-token\x12e\n" +
+	"\x06WhoAmI\x12\x16.google.protobuf.Empty\x1a).authentication.service.v1.WhoAmIResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/admin/v1/whoami\x12\x98\x01\n" +
+	"\vImpersonate\x12-.authentication.service.v1.ImpersonateRequest\x1a(.authentication.service.v1.LoginResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/admin/v1/users/{user_id}:impersonate\x12\x90\x01\n" +
+	"\x14RequestPasswordReset\x126.authentication.service.v1.RequestPasswordResetRequest\x1a\x16.google.protobuf.Empty\"(\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/admin/v1/password-reset\x12\x98\x01\n" +
+	"\x14ConfirmPasswordReset\x126.authentication.service.v1.ConfirmPasswordResetRequest\x1a\x16.google.protobuf.Empty\"0\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02%:\x01*\" /admin/v1/password-reset:confirm\x12\x93\x01\n" +
+	"\fRegisterUser\x12..authentication.service.v1.RegisterUserRequest\x1a/.authentication.service.v1.RegisterUserResponse\"\"\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/admin/v1/register\x12\x90\x01\n" +
+	"\x13ConfirmRegistration\x125.authentication.service.v1.ConfirmRegistrationRequest\x1a\x16.google.protobuf.Empty\"*\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/admin/v1/register:confirm\x12\x95\x01\n" +
+	"\x16ResendRegistrationCode\x128.authentication.service.v1.ResendRegistrationCodeRequest\x1a\x16.google.protobuf.Empty\")\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/admin/v1/register:resendB\xc1\x01\n" +
 	"\x14com.admin.service.v1B\x14IAuthenticationProtoP\x01Z1go-wind-admin/api/gen/go/admin/service/v1;adminpb\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var file_admin_service_v1_i_authentication_proto_goTypes = []any{
-	(*v1.LoginRequest)(nil),  // 0: authentication.service.v1.LoginRequest
-	(*emptypb.Empty)(nil),    // 1: google.protobuf.Empty
-	(*v1.LoginResponse)(nil), // 2: authentication.service.v1.LoginResponse
+	(*v1.LoginRequest)(nil),                  // 0: authentication.service.v1.LoginRequest
+	(*emptypb.Empty)(nil),                    // 1: google.protobuf.Empty
+	(*v1.ImpersonateRequest)(nil),            // 2: authentication.service.v1.ImpersonateRequest
+	(*v1.RequestPasswordResetRequest)(nil),   // 3: authentication.service.v1.RequestPasswordResetRequest
+	(*v1.ConfirmPasswordResetRequest)(nil),   // 4: authentication.service.v1.ConfirmPasswordResetRequest
+	(*v1.RegisterUserRequest)(nil),           // 5: authentication.service.v1.RegisterUserRequest
+	(*v1.ConfirmRegistrationRequest)(nil),    // 6: authentication.service.v1.ConfirmRegistrationRequest
+	(*v1.ResendRegistrationCodeRequest)(nil), // 7: authentication.service.v1.ResendRegistrationCodeRequest
+	(*v1.LoginResponse)(nil),                 // 8: authentication.service.v1.LoginResponse
+	(*v1.WhoAmIResponse)(nil),                // 9: authentication.service.v1.WhoAmIResponse
+	(*v1.RegisterUserResponse)(nil),          // 10: authentication.service.v1.RegisterUserResponse
 }
 var file_admin_service_v1_i_authentication_proto_depIdxs = []int32{
-	0, // 0: admin.service.v1.AuthenticationService.Login:input_type -> authentication.service.v1.LoginRequest
-	1, // 1: admin.service.v1.AuthenticationService.Logout:input_type -> google.protobuf.Empty
-	0, // 2: admin.service.v1.AuthenticationService.RefreshToken:input_type -> authentication.service.v1.LoginRequest
-	2, // 3: admin.service.v1.AuthenticationService.Login:output_type -> authentication.service.v1.LoginResponse
-	1, // 4: admin.service.v1.AuthenticationService.Logout:output_type -> google.protobuf.Empty
-	2, // 5: admin.service.v1.AuthenticationService.RefreshToken:output_type -> authentication.service.v1.LoginResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: admin.service.v1.AuthenticationService.Login:input_type -> authentication.service.v1.LoginRequest
+	1,  // 1: admin.service.v1.AuthenticationService.Logout:input_type -> google.protobuf.Empty
+	0,  // 2: admin.service.v1.AuthenticationService.RefreshToken:input_type -> authentication.service.v1.LoginRequest
+	1,  // 3: admin.service.v1.AuthenticationService.WhoAmI:input_type -> google.protobuf.Empty
+	2,  // 4: admin.service.v1.AuthenticationService.Impersonate:input_type -> authentication.service.v1.ImpersonateRequest
+	3,  // 5: admin.service.v1.AuthenticationService.RequestPasswordReset:input_type -> authentication.service.v1.RequestPasswordResetRequest
+	4,  // 6: admin.service.v1.AuthenticationService.ConfirmPasswordReset:input_type -> authentication.service.v1.ConfirmPasswordResetRequest
+	5,  // 7: admin.service.v1.AuthenticationService.RegisterUser:input_type -> authentication.service.v1.RegisterUserRequest
+	6,  // 8: admin.service.v1.AuthenticationService.ConfirmRegistration:input_type -> authentication.service.v1.ConfirmRegistrationRequest
+	7,  // 9: admin.service.v1.AuthenticationService.ResendRegistrationCode:input_type -> authentication.service.v1.ResendRegistrationCodeRequest
+	8,  // 10: admin.service.v1.AuthenticationService.Login:output_type -> authentication.service.v1.LoginResponse
+	1,  // 11: admin.service.v1.AuthenticationService.Logout:output_type -> google.protobuf.Empty
+	8,  // 12: admin.service.v1.AuthenticationService.RefreshToken:output_type -> authentication.service.v1.LoginResponse
+	9,  // 13: admin.service.v1.AuthenticationService.WhoAmI:output_type -> authentication.service.v1.WhoAmIResponse
+	8,  // 14: admin.service.v1.AuthenticationService.Impersonate:output_type -> authentication.service.v1.LoginResponse
+	1,  // 15: admin.service.v1.AuthenticationService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	1,  // 16: admin.service.v1.AuthenticationService.ConfirmPasswordReset:output_type -> google.protobuf.Empty
+	10, // 17: admin.service.v1.AuthenticationService.RegisterUser:output_type -> authentication.service.v1.RegisterUserResponse
+	1,  // 18: admin.service.v1.AuthenticationService.ConfirmRegistration:output_type -> google.protobuf.Empty
+	1,  // 19: admin.service.v1.AuthenticationService.ResendRegistrationCode:output_type -> google.protobuf.Empty
+	10, // [10:20] is the sub-list for method output_type
+	0,  // [0:10] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_admin_service_v1_i_authentication_proto_init() }
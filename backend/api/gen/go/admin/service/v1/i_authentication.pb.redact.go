@@ -76,3 +76,80 @@ func (s *redactedAuthenticationServiceServer) RefreshToken(ctx context.Context,
 	}
 	return res, err
 }
+
+// WhoAmI is the redacted wrapper for the actual AuthenticationServiceServer.WhoAmI method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) WhoAmI(ctx context.Context, in *emptypb.Empty) (*authenticationpb.WhoAmIResponse, error) {
+	res, err := s.srv.WhoAmI(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Impersonate is the redacted wrapper for the actual AuthenticationServiceServer.Impersonate method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) Impersonate(ctx context.Context, in *authenticationpb.ImpersonateRequest) (*authenticationpb.LoginResponse, error) {
+	res, err := s.srv.Impersonate(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RequestPasswordReset is the redacted wrapper for the actual AuthenticationServiceServer.RequestPasswordReset method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) RequestPasswordReset(ctx context.Context, in *authenticationpb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.RequestPasswordReset(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ConfirmPasswordReset is the redacted wrapper for the actual AuthenticationServiceServer.ConfirmPasswordReset method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) ConfirmPasswordReset(ctx context.Context, in *authenticationpb.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.ConfirmPasswordReset(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RegisterUser is the redacted wrapper for the actual AuthenticationServiceServer.RegisterUser method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) RegisterUser(ctx context.Context, in *authenticationpb.RegisterUserRequest) (*authenticationpb.RegisterUserResponse, error) {
+	res, err := s.srv.RegisterUser(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ConfirmRegistration is the redacted wrapper for the actual AuthenticationServiceServer.ConfirmRegistration method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) ConfirmRegistration(ctx context.Context, in *authenticationpb.ConfirmRegistrationRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.ConfirmRegistration(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ResendRegistrationCode is the redacted wrapper for the actual AuthenticationServiceServer.ResendRegistrationCode method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) ResendRegistrationCode(ctx context.Context, in *authenticationpb.ResendRegistrationCodeRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.ResendRegistrationCode(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
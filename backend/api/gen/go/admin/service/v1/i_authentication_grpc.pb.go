@@ -21,9 +21,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthenticationService_Login_FullMethodName        = "/admin.service.v1.AuthenticationService/Login"
-	AuthenticationService_Logout_FullMethodName       = "/admin.service.v1.AuthenticationService/Logout"
-	AuthenticationService_RefreshToken_FullMethodName = "/admin.service.v1.AuthenticationService/RefreshToken"
+	AuthenticationService_Login_FullMethodName                  = "/admin.service.v1.AuthenticationService/Login"
+	AuthenticationService_Logout_FullMethodName                 = "/admin.service.v1.AuthenticationService/Logout"
+	AuthenticationService_RefreshToken_FullMethodName           = "/admin.service.v1.AuthenticationService/RefreshToken"
+	AuthenticationService_WhoAmI_FullMethodName                 = "/admin.service.v1.AuthenticationService/WhoAmI"
+	AuthenticationService_Impersonate_FullMethodName            = "/admin.service.v1.AuthenticationService/Impersonate"
+	AuthenticationService_RequestPasswordReset_FullMethodName   = "/admin.service.v1.AuthenticationService/RequestPasswordReset"
+	AuthenticationService_ConfirmPasswordReset_FullMethodName   = "/admin.service.v1.AuthenticationService/ConfirmPasswordReset"
+	AuthenticationService_RegisterUser_FullMethodName           = "/admin.service.v1.AuthenticationService/RegisterUser"
+	AuthenticationService_ConfirmRegistration_FullMethodName    = "/admin.service.v1.AuthenticationService/ConfirmRegistration"
+	AuthenticationService_ResendRegistrationCode_FullMethodName = "/admin.service.v1.AuthenticationService/ResendRegistrationCode"
 )
 
 // AuthenticationServiceClient is the client API for AuthenticationService service.
@@ -38,6 +45,20 @@ type AuthenticationServiceClient interface {
 	Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 刷新认证令牌
 	RefreshToken(ctx context.Context, in *v1.LoginRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error)
+	// 获取当前用户身份信息，模拟登录时返回实际操作者
+	WhoAmI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.WhoAmIResponse, error)
+	// 模拟登录为同租户的其他用户，需要模拟登录权限
+	Impersonate(ctx context.Context, in *v1.ImpersonateRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error)
+	// 忘记密码，向用户绑定的邮箱或手机发送重置密码验证码
+	RequestPasswordReset(ctx context.Context, in *v1.RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 使用验证码或重置链接中的令牌设置新密码
+	ConfirmPasswordReset(ctx context.Context, in *v1.ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 用户自助注册，是否开放及注册方式由租户的注册策略决定
+	RegisterUser(ctx context.Context, in *v1.RegisterUserRequest, opts ...grpc.CallOption) (*v1.RegisterUserResponse, error)
+	// 使用邮箱验证码激活注册的用户
+	ConfirmRegistration(ctx context.Context, in *v1.ConfirmRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 重新发送注册激活验证码
+	ResendRegistrationCode(ctx context.Context, in *v1.ResendRegistrationCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type authenticationServiceClient struct {
@@ -78,6 +99,76 @@ func (c *authenticationServiceClient) RefreshToken(ctx context.Context, in *v1.L
 	return out, nil
 }
 
+func (c *authenticationServiceClient) WhoAmI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.WhoAmIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.WhoAmIResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_WhoAmI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) Impersonate(ctx context.Context, in *v1.ImpersonateRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.LoginResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_Impersonate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) RequestPasswordReset(ctx context.Context, in *v1.RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthenticationService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) ConfirmPasswordReset(ctx context.Context, in *v1.ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthenticationService_ConfirmPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) RegisterUser(ctx context.Context, in *v1.RegisterUserRequest, opts ...grpc.CallOption) (*v1.RegisterUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RegisterUserResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_RegisterUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) ConfirmRegistration(ctx context.Context, in *v1.ConfirmRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthenticationService_ConfirmRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) ResendRegistrationCode(ctx context.Context, in *v1.ResendRegistrationCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthenticationService_ResendRegistrationCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthenticationServiceServer is the server API for AuthenticationService service.
 // All implementations must embed UnimplementedAuthenticationServiceServer
 // for forward compatibility.
@@ -90,6 +181,20 @@ type AuthenticationServiceServer interface {
 	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// 刷新认证令牌
 	RefreshToken(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error)
+	// 获取当前用户身份信息，模拟登录时返回实际操作者
+	WhoAmI(context.Context, *emptypb.Empty) (*v1.WhoAmIResponse, error)
+	// 模拟登录为同租户的其他用户，需要模拟登录权限
+	Impersonate(context.Context, *v1.ImpersonateRequest) (*v1.LoginResponse, error)
+	// 忘记密码，向用户绑定的邮箱或手机发送重置密码验证码
+	RequestPasswordReset(context.Context, *v1.RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// 使用验证码或重置链接中的令牌设置新密码
+	ConfirmPasswordReset(context.Context, *v1.ConfirmPasswordResetRequest) (*emptypb.Empty, error)
+	// 用户自助注册，是否开放及注册方式由租户的注册策略决定
+	RegisterUser(context.Context, *v1.RegisterUserRequest) (*v1.RegisterUserResponse, error)
+	// 使用邮箱验证码激活注册的用户
+	ConfirmRegistration(context.Context, *v1.ConfirmRegistrationRequest) (*emptypb.Empty, error)
+	// 重新发送注册激活验证码
+	ResendRegistrationCode(context.Context, *v1.ResendRegistrationCodeRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAuthenticationServiceServer()
 }
 
@@ -109,6 +214,27 @@ func (UnimplementedAuthenticationServiceServer) Logout(context.Context, *emptypb
 func (UnimplementedAuthenticationServiceServer) RefreshToken(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RefreshToken not implemented")
 }
+func (UnimplementedAuthenticationServiceServer) WhoAmI(context.Context, *emptypb.Empty) (*v1.WhoAmIResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WhoAmI not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) Impersonate(context.Context, *v1.ImpersonateRequest) (*v1.LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Impersonate not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) RequestPasswordReset(context.Context, *v1.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) ConfirmPasswordReset(context.Context, *v1.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) RegisterUser(context.Context, *v1.RegisterUserRequest) (*v1.RegisterUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterUser not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) ConfirmRegistration(context.Context, *v1.ConfirmRegistrationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmRegistration not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) ResendRegistrationCode(context.Context, *v1.ResendRegistrationCodeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResendRegistrationCode not implemented")
+}
 func (UnimplementedAuthenticationServiceServer) mustEmbedUnimplementedAuthenticationServiceServer() {}
 func (UnimplementedAuthenticationServiceServer) testEmbeddedByValue()                               {}
 
@@ -184,6 +310,132 @@ func _AuthenticationService_RefreshToken_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthenticationService_WhoAmI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).WhoAmI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_WhoAmI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).WhoAmI(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_Impersonate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ImpersonateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).Impersonate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_Impersonate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).Impersonate(ctx, req.(*v1.ImpersonateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).RequestPasswordReset(ctx, req.(*v1.RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ConfirmPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_ConfirmPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).ConfirmPasswordReset(ctx, req.(*v1.ConfirmPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RegisterUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).RegisterUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_RegisterUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).RegisterUser(ctx, req.(*v1.RegisterUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_ConfirmRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ConfirmRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).ConfirmRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_ConfirmRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).ConfirmRegistration(ctx, req.(*v1.ConfirmRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_ResendRegistrationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ResendRegistrationCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).ResendRegistrationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_ResendRegistrationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).ResendRegistrationCode(ctx, req.(*v1.ResendRegistrationCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthenticationService_ServiceDesc is the grpc.ServiceDesc for AuthenticationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +455,34 @@ var AuthenticationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshToken",
 			Handler:    _AuthenticationService_RefreshToken_Handler,
 		},
+		{
+			MethodName: "WhoAmI",
+			Handler:    _AuthenticationService_WhoAmI_Handler,
+		},
+		{
+			MethodName: "Impersonate",
+			Handler:    _AuthenticationService_Impersonate_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AuthenticationService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _AuthenticationService_ConfirmPasswordReset_Handler,
+		},
+		{
+			MethodName: "RegisterUser",
+			Handler:    _AuthenticationService_RegisterUser_Handler,
+		},
+		{
+			MethodName: "ConfirmRegistration",
+			Handler:    _AuthenticationService_ConfirmRegistration_Handler,
+		},
+		{
+			MethodName: "ResendRegistrationCode",
+			Handler:    _AuthenticationService_ResendRegistrationCode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_authentication.proto",
@@ -21,17 +21,38 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthenticationServiceConfirmPasswordReset = "/admin.service.v1.AuthenticationService/ConfirmPasswordReset"
+const OperationAuthenticationServiceConfirmRegistration = "/admin.service.v1.AuthenticationService/ConfirmRegistration"
+const OperationAuthenticationServiceImpersonate = "/admin.service.v1.AuthenticationService/Impersonate"
 const OperationAuthenticationServiceLogin = "/admin.service.v1.AuthenticationService/Login"
 const OperationAuthenticationServiceLogout = "/admin.service.v1.AuthenticationService/Logout"
 const OperationAuthenticationServiceRefreshToken = "/admin.service.v1.AuthenticationService/RefreshToken"
+const OperationAuthenticationServiceRegisterUser = "/admin.service.v1.AuthenticationService/RegisterUser"
+const OperationAuthenticationServiceRequestPasswordReset = "/admin.service.v1.AuthenticationService/RequestPasswordReset"
+const OperationAuthenticationServiceResendRegistrationCode = "/admin.service.v1.AuthenticationService/ResendRegistrationCode"
+const OperationAuthenticationServiceWhoAmI = "/admin.service.v1.AuthenticationService/WhoAmI"
 
 type AuthenticationServiceHTTPServer interface {
+	// ConfirmPasswordReset 使用验证码或重置链接中的令牌设置新密码
+	ConfirmPasswordReset(context.Context, *v1.ConfirmPasswordResetRequest) (*emptypb.Empty, error)
+	// ConfirmRegistration 使用邮箱验证码激活注册的用户
+	ConfirmRegistration(context.Context, *v1.ConfirmRegistrationRequest) (*emptypb.Empty, error)
+	// Impersonate 模拟登录为同租户的其他用户，需要模拟登录权限
+	Impersonate(context.Context, *v1.ImpersonateRequest) (*v1.LoginResponse, error)
 	// Login 登录
 	Login(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error)
 	// Logout 登出
 	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// RefreshToken 刷新认证令牌
 	RefreshToken(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error)
+	// RegisterUser 用户自助注册，是否开放及注册方式由租户的注册策略决定
+	RegisterUser(context.Context, *v1.RegisterUserRequest) (*v1.RegisterUserResponse, error)
+	// RequestPasswordReset 忘记密码，向用户绑定的邮箱或手机发送重置密码验证码
+	RequestPasswordReset(context.Context, *v1.RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// ResendRegistrationCode 重新发送注册激活验证码
+	ResendRegistrationCode(context.Context, *v1.ResendRegistrationCodeRequest) (*emptypb.Empty, error)
+	// WhoAmI 获取当前用户身份信息，模拟登录时返回实际操作者
+	WhoAmI(context.Context, *emptypb.Empty) (*v1.WhoAmIResponse, error)
 }
 
 func RegisterAuthenticationServiceHTTPServer(s *http.Server, srv AuthenticationServiceHTTPServer) {
@@ -39,6 +60,13 @@ func RegisterAuthenticationServiceHTTPServer(s *http.Server, srv AuthenticationS
 	r.POST("/admin/v1/login", _AuthenticationService_Login0_HTTP_Handler(srv))
 	r.POST("/admin/v1/logout", _AuthenticationService_Logout0_HTTP_Handler(srv))
 	r.POST("/admin/v1/refresh-token", _AuthenticationService_RefreshToken0_HTTP_Handler(srv))
+	r.GET("/admin/v1/whoami", _AuthenticationService_WhoAmI0_HTTP_Handler(srv))
+	r.POST("/admin/v1/users/{user_id}:impersonate", _AuthenticationService_Impersonate0_HTTP_Handler(srv))
+	r.POST("/admin/v1/password-reset", _AuthenticationService_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/admin/v1/password-reset:confirm", _AuthenticationService_ConfirmPasswordReset0_HTTP_Handler(srv))
+	r.POST("/admin/v1/register", _AuthenticationService_RegisterUser0_HTTP_Handler(srv))
+	r.POST("/admin/v1/register:confirm", _AuthenticationService_ConfirmRegistration0_HTTP_Handler(srv))
+	r.POST("/admin/v1/register:resend", _AuthenticationService_ResendRegistrationCode0_HTTP_Handler(srv))
 }
 
 func _AuthenticationService_Login0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
@@ -107,13 +135,181 @@ func _AuthenticationService_RefreshToken0_HTTP_Handler(srv AuthenticationService
 	}
 }
 
+func _AuthenticationService_WhoAmI0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceWhoAmI)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WhoAmI(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.WhoAmIResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_Impersonate0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ImpersonateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceImpersonate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Impersonate(ctx, req.(*v1.ImpersonateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_RequestPasswordReset0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*v1.RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_ConfirmPasswordReset0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ConfirmPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceConfirmPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmPasswordReset(ctx, req.(*v1.ConfirmPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_RegisterUser0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.RegisterUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceRegisterUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RegisterUser(ctx, req.(*v1.RegisterUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.RegisterUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_ConfirmRegistration0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ConfirmRegistrationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceConfirmRegistration)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmRegistration(ctx, req.(*v1.ConfirmRegistrationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_ResendRegistrationCode0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ResendRegistrationCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceResendRegistrationCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResendRegistrationCode(ctx, req.(*v1.ResendRegistrationCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AuthenticationServiceHTTPClient interface {
+	// ConfirmPasswordReset 使用验证码或重置链接中的令牌设置新密码
+	ConfirmPasswordReset(ctx context.Context, req *v1.ConfirmPasswordResetRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ConfirmRegistration 使用邮箱验证码激活注册的用户
+	ConfirmRegistration(ctx context.Context, req *v1.ConfirmRegistrationRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// Impersonate 模拟登录为同租户的其他用户，需要模拟登录权限
+	Impersonate(ctx context.Context, req *v1.ImpersonateRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
 	// Login 登录
 	Login(ctx context.Context, req *v1.LoginRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
 	// Logout 登出
 	Logout(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// RefreshToken 刷新认证令牌
 	RefreshToken(ctx context.Context, req *v1.LoginRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
+	// RegisterUser 用户自助注册，是否开放及注册方式由租户的注册策略决定
+	RegisterUser(ctx context.Context, req *v1.RegisterUserRequest, opts ...http.CallOption) (rsp *v1.RegisterUserResponse, err error)
+	// RequestPasswordReset 忘记密码，向用户绑定的邮箱或手机发送重置密码验证码
+	RequestPasswordReset(ctx context.Context, req *v1.RequestPasswordResetRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ResendRegistrationCode 重新发送注册激活验证码
+	ResendRegistrationCode(ctx context.Context, req *v1.ResendRegistrationCodeRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// WhoAmI 获取当前用户身份信息，模拟登录时返回实际操作者
+	WhoAmI(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v1.WhoAmIResponse, err error)
 }
 
 type AuthenticationServiceHTTPClientImpl struct {
@@ -124,6 +320,48 @@ func NewAuthenticationServiceHTTPClient(client *http.Client) AuthenticationServi
 	return &AuthenticationServiceHTTPClientImpl{client}
 }
 
+// ConfirmPasswordReset 使用验证码或重置链接中的令牌设置新密码
+func (c *AuthenticationServiceHTTPClientImpl) ConfirmPasswordReset(ctx context.Context, in *v1.ConfirmPasswordResetRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/password-reset:confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceConfirmPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ConfirmRegistration 使用邮箱验证码激活注册的用户
+func (c *AuthenticationServiceHTTPClientImpl) ConfirmRegistration(ctx context.Context, in *v1.ConfirmRegistrationRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/register:confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceConfirmRegistration))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Impersonate 模拟登录为同租户的其他用户，需要模拟登录权限
+func (c *AuthenticationServiceHTTPClientImpl) Impersonate(ctx context.Context, in *v1.ImpersonateRequest, opts ...http.CallOption) (*v1.LoginResponse, error) {
+	var out v1.LoginResponse
+	pattern := "/admin/v1/users/{user_id}:impersonate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceImpersonate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Login 登录
 func (c *AuthenticationServiceHTTPClientImpl) Login(ctx context.Context, in *v1.LoginRequest, opts ...http.CallOption) (*v1.LoginResponse, error) {
 	var out v1.LoginResponse
@@ -165,3 +403,59 @@ func (c *AuthenticationServiceHTTPClientImpl) RefreshToken(ctx context.Context,
 	}
 	return &out, nil
 }
+
+// RegisterUser 用户自助注册，是否开放及注册方式由租户的注册策略决定
+func (c *AuthenticationServiceHTTPClientImpl) RegisterUser(ctx context.Context, in *v1.RegisterUserRequest, opts ...http.CallOption) (*v1.RegisterUserResponse, error) {
+	var out v1.RegisterUserResponse
+	pattern := "/admin/v1/register"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceRegisterUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestPasswordReset 忘记密码，向用户绑定的邮箱或手机发送重置密码验证码
+func (c *AuthenticationServiceHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *v1.RequestPasswordResetRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/password-reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResendRegistrationCode 重新发送注册激活验证码
+func (c *AuthenticationServiceHTTPClientImpl) ResendRegistrationCode(ctx context.Context, in *v1.ResendRegistrationCodeRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/register:resend"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceResendRegistrationCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// WhoAmI 获取当前用户身份信息，模拟登录时返回实际操作者
+func (c *AuthenticationServiceHTTPClientImpl) WhoAmI(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v1.WhoAmIResponse, error) {
+	var out v1.WhoAmIResponse
+	pattern := "/admin/v1/whoami"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceWhoAmI))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -27,9 +27,10 @@ var File_admin_service_v1_i_file_transfer_proto protoreflect.FileDescriptor
 
 const file_admin_service_v1_i_file_transfer_proto_rawDesc = "" +
 	"\n" +
-	"&admin/service/v1/i_file_transfer.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a#file/service/v1/file_transfer.proto\x1a\x1dfile/service/v1/ueditor.proto2\x9d\x05\n" +
+	"&admin/service/v1/i_file_transfer.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a#file/service/v1/file_transfer.proto\x1a\x1dfile/service/v1/ueditor.proto2\xa5\x06\n" +
 	"\x13FileTransferService\x12|\n" +
-	"\fDownloadFile\x12$.file.service.v1.DownloadFileRequest\x1a%.file.service.v1.DownloadFileResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/admin/v1/file/download\x12z\n" +
+	"\fDownloadFile\x12$.file.service.v1.DownloadFileRequest\x1a%.file.service.v1.DownloadFileResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/admin/v1/file/download\x12\x85\x01\n" +
+	"\x0eGetFileVariant\x12&.file.service.v1.GetFileVariantRequest\x1a%.file.service.v1.DownloadFileResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/admin/v1/files/{id}/variant\x12z\n" +
 	"\rPutUploadFile\x12\".file.service.v1.UploadFileRequest\x1a#.file.service.v1.UploadFileResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/admin/v1/file/upload\x12{\n" +
 	"\x0ePostUploadFile\x12\".file.service.v1.UploadFileRequest\x1a#.file.service.v1.UploadFileResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/admin/v1/file/upload\x12\x86\x01\n" +
 	"\x15UEditorPostUploadFile\x12%.file.service.v1.UEditorUploadRequest\x1a&.file.service.v1.UEditorUploadResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/admin/v1/ueditor(\x01\x12\x85\x01\n" +
@@ -38,25 +39,28 @@ const file_admin_service_v1_i_file_transfer_proto_rawDesc = "" +
 
 var file_admin_service_v1_i_file_transfer_proto_goTypes = []any{
 	(*v1.DownloadFileRequest)(nil),   // 0: file.service.v1.DownloadFileRequest
-	(*v1.UploadFileRequest)(nil),     // 1: file.service.v1.UploadFileRequest
-	(*v1.UEditorUploadRequest)(nil),  // 2: file.service.v1.UEditorUploadRequest
-	(*v1.DownloadFileResponse)(nil),  // 3: file.service.v1.DownloadFileResponse
-	(*v1.UploadFileResponse)(nil),    // 4: file.service.v1.UploadFileResponse
-	(*v1.UEditorUploadResponse)(nil), // 5: file.service.v1.UEditorUploadResponse
+	(*v1.GetFileVariantRequest)(nil), // 1: file.service.v1.GetFileVariantRequest
+	(*v1.UploadFileRequest)(nil),     // 2: file.service.v1.UploadFileRequest
+	(*v1.UEditorUploadRequest)(nil),  // 3: file.service.v1.UEditorUploadRequest
+	(*v1.DownloadFileResponse)(nil),  // 4: file.service.v1.DownloadFileResponse
+	(*v1.UploadFileResponse)(nil),    // 5: file.service.v1.UploadFileResponse
+	(*v1.UEditorUploadResponse)(nil), // 6: file.service.v1.UEditorUploadResponse
 }
 var file_admin_service_v1_i_file_transfer_proto_depIdxs = []int32{
 	0, // 0: admin.service.v1.FileTransferService.DownloadFile:input_type -> file.service.v1.DownloadFileRequest
-	1, // 1: admin.service.v1.FileTransferService.PutUploadFile:input_type -> file.service.v1.UploadFileRequest
-	1, // 2: admin.service.v1.FileTransferService.PostUploadFile:input_type -> file.service.v1.UploadFileRequest
-	2, // 3: admin.service.v1.FileTransferService.UEditorPostUploadFile:input_type -> file.service.v1.UEditorUploadRequest
-	2, // 4: admin.service.v1.FileTransferService.UEditorPutUploadFile:input_type -> file.service.v1.UEditorUploadRequest
-	3, // 5: admin.service.v1.FileTransferService.DownloadFile:output_type -> file.service.v1.DownloadFileResponse
-	4, // 6: admin.service.v1.FileTransferService.PutUploadFile:output_type -> file.service.v1.UploadFileResponse
-	4, // 7: admin.service.v1.FileTransferService.PostUploadFile:output_type -> file.service.v1.UploadFileResponse
-	5, // 8: admin.service.v1.FileTransferService.UEditorPostUploadFile:output_type -> file.service.v1.UEditorUploadResponse
-	5, // 9: admin.service.v1.FileTransferService.UEditorPutUploadFile:output_type -> file.service.v1.UEditorUploadResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
+	1, // 1: admin.service.v1.FileTransferService.GetFileVariant:input_type -> file.service.v1.GetFileVariantRequest
+	2, // 2: admin.service.v1.FileTransferService.PutUploadFile:input_type -> file.service.v1.UploadFileRequest
+	2, // 3: admin.service.v1.FileTransferService.PostUploadFile:input_type -> file.service.v1.UploadFileRequest
+	3, // 4: admin.service.v1.FileTransferService.UEditorPostUploadFile:input_type -> file.service.v1.UEditorUploadRequest
+	3, // 5: admin.service.v1.FileTransferService.UEditorPutUploadFile:input_type -> file.service.v1.UEditorUploadRequest
+	4, // 6: admin.service.v1.FileTransferService.DownloadFile:output_type -> file.service.v1.DownloadFileResponse
+	4, // 7: admin.service.v1.FileTransferService.GetFileVariant:output_type -> file.service.v1.DownloadFileResponse
+	5, // 8: admin.service.v1.FileTransferService.PutUploadFile:output_type -> file.service.v1.UploadFileResponse
+	5, // 9: admin.service.v1.FileTransferService.PostUploadFile:output_type -> file.service.v1.UploadFileResponse
+	6, // 10: admin.service.v1.FileTransferService.UEditorPostUploadFile:output_type -> file.service.v1.UEditorUploadResponse
+	6, // 11: admin.service.v1.FileTransferService.UEditorPutUploadFile:output_type -> file.service.v1.UEditorUploadResponse
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
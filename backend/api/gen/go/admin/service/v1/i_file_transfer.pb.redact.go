@@ -21,7 +21,7 @@ var (
 	_ codes.Code
 	_ status.Status
 	_ httpbody.HttpBody
-	_ filepb.DownloadFileRequest
+	_ filepb.GetFileVariantRequest
 	_ filepb.UEditorRequest
 )
 
@@ -54,6 +54,17 @@ func (s *redactedFileTransferServiceServer) DownloadFile(ctx context.Context, in
 	return res, err
 }
 
+// GetFileVariant is the redacted wrapper for the actual FileTransferServiceServer.GetFileVariant method
+// Unary RPC
+func (s *redactedFileTransferServiceServer) GetFileVariant(ctx context.Context, in *filepb.GetFileVariantRequest) (*filepb.DownloadFileResponse, error) {
+	res, err := s.srv.GetFileVariant(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // PutUploadFile is the redacted wrapper for the actual FileTransferServiceServer.PutUploadFile method
 // Unary RPC
 func (s *redactedFileTransferServiceServer) PutUploadFile(ctx context.Context, in *filepb.UploadFileRequest) (*filepb.UploadFileResponse, error) {
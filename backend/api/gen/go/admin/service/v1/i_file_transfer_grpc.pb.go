@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	FileTransferService_DownloadFile_FullMethodName          = "/admin.service.v1.FileTransferService/DownloadFile"
+	FileTransferService_GetFileVariant_FullMethodName        = "/admin.service.v1.FileTransferService/GetFileVariant"
 	FileTransferService_PutUploadFile_FullMethodName         = "/admin.service.v1.FileTransferService/PutUploadFile"
 	FileTransferService_PostUploadFile_FullMethodName        = "/admin.service.v1.FileTransferService/PostUploadFile"
 	FileTransferService_UEditorPostUploadFile_FullMethodName = "/admin.service.v1.FileTransferService/UEditorPostUploadFile"
@@ -35,6 +36,8 @@ const (
 type FileTransferServiceClient interface {
 	// 下载文件
 	DownloadFile(ctx context.Context, in *v1.DownloadFileRequest, opts ...grpc.CallOption) (*v1.DownloadFileResponse, error)
+	// 获取图片衍生图（缩略图、缩放、格式转换）
+	GetFileVariant(ctx context.Context, in *v1.GetFileVariantRequest, opts ...grpc.CallOption) (*v1.DownloadFileResponse, error)
 	// 上传文件 PUT 方式
 	PutUploadFile(ctx context.Context, in *v1.UploadFileRequest, opts ...grpc.CallOption) (*v1.UploadFileResponse, error)
 	// 上传文件 POST 方式
@@ -63,6 +66,16 @@ func (c *fileTransferServiceClient) DownloadFile(ctx context.Context, in *v1.Dow
 	return out, nil
 }
 
+func (c *fileTransferServiceClient) GetFileVariant(ctx context.Context, in *v1.GetFileVariantRequest, opts ...grpc.CallOption) (*v1.DownloadFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.DownloadFileResponse)
+	err := c.cc.Invoke(ctx, FileTransferService_GetFileVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fileTransferServiceClient) PutUploadFile(ctx context.Context, in *v1.UploadFileRequest, opts ...grpc.CallOption) (*v1.UploadFileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(v1.UploadFileResponse)
@@ -117,6 +130,8 @@ type FileTransferService_UEditorPutUploadFileClient = grpc.ClientStreamingClient
 type FileTransferServiceServer interface {
 	// 下载文件
 	DownloadFile(context.Context, *v1.DownloadFileRequest) (*v1.DownloadFileResponse, error)
+	// 获取图片衍生图（缩略图、缩放、格式转换）
+	GetFileVariant(context.Context, *v1.GetFileVariantRequest) (*v1.DownloadFileResponse, error)
 	// 上传文件 PUT 方式
 	PutUploadFile(context.Context, *v1.UploadFileRequest) (*v1.UploadFileResponse, error)
 	// 上传文件 POST 方式
@@ -138,6 +153,9 @@ type UnimplementedFileTransferServiceServer struct{}
 func (UnimplementedFileTransferServiceServer) DownloadFile(context.Context, *v1.DownloadFileRequest) (*v1.DownloadFileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DownloadFile not implemented")
 }
+func (UnimplementedFileTransferServiceServer) GetFileVariant(context.Context, *v1.GetFileVariantRequest) (*v1.DownloadFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileVariant not implemented")
+}
 func (UnimplementedFileTransferServiceServer) PutUploadFile(context.Context, *v1.UploadFileRequest) (*v1.UploadFileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PutUploadFile not implemented")
 }
@@ -189,6 +207,24 @@ func _FileTransferService_DownloadFile_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileTransferService_GetFileVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetFileVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileTransferServiceServer).GetFileVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileTransferService_GetFileVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileTransferServiceServer).GetFileVariant(ctx, req.(*v1.GetFileVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FileTransferService_PutUploadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1.UploadFileRequest)
 	if err := dec(in); err != nil {
@@ -250,6 +286,10 @@ var FileTransferService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DownloadFile",
 			Handler:    _FileTransferService_DownloadFile_Handler,
 		},
+		{
+			MethodName: "GetFileVariant",
+			Handler:    _FileTransferService_GetFileVariant_Handler,
+		},
 		{
 			MethodName: "PutUploadFile",
 			Handler:    _FileTransferService_PutUploadFile_Handler,
@@ -21,12 +21,15 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationFileTransferServiceDownloadFile = "/admin.service.v1.FileTransferService/DownloadFile"
+const OperationFileTransferServiceGetFileVariant = "/admin.service.v1.FileTransferService/GetFileVariant"
 const OperationFileTransferServicePostUploadFile = "/admin.service.v1.FileTransferService/PostUploadFile"
 const OperationFileTransferServicePutUploadFile = "/admin.service.v1.FileTransferService/PutUploadFile"
 
 type FileTransferServiceHTTPServer interface {
 	// DownloadFile 下载文件
 	DownloadFile(context.Context, *v1.DownloadFileRequest) (*v1.DownloadFileResponse, error)
+	// GetFileVariant 获取图片衍生图（缩略图、缩放、格式转换）
+	GetFileVariant(context.Context, *v1.GetFileVariantRequest) (*v1.DownloadFileResponse, error)
 	// PostUploadFile 上传文件 POST 方式
 	PostUploadFile(context.Context, *v1.UploadFileRequest) (*v1.UploadFileResponse, error)
 	// PutUploadFile 上传文件 PUT 方式
@@ -36,6 +39,7 @@ type FileTransferServiceHTTPServer interface {
 func RegisterFileTransferServiceHTTPServer(s *http.Server, srv FileTransferServiceHTTPServer) {
 	r := s.Route("/")
 	r.GET("/admin/v1/file/download", _FileTransferService_DownloadFile0_HTTP_Handler(srv))
+	r.GET("/admin/v1/files/{id}/variant", _FileTransferService_GetFileVariant0_HTTP_Handler(srv))
 	r.PUT("/admin/v1/file/upload", _FileTransferService_PutUploadFile0_HTTP_Handler(srv))
 	r.POST("/admin/v1/file/upload", _FileTransferService_PostUploadFile0_HTTP_Handler(srv))
 }
@@ -59,6 +63,28 @@ func _FileTransferService_DownloadFile0_HTTP_Handler(srv FileTransferServiceHTTP
 	}
 }
 
+func _FileTransferService_GetFileVariant0_HTTP_Handler(srv FileTransferServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.GetFileVariantRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileTransferServiceGetFileVariant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetFileVariant(ctx, req.(*v1.GetFileVariantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.DownloadFileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _FileTransferService_PutUploadFile0_HTTP_Handler(srv FileTransferServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.UploadFileRequest
@@ -106,6 +132,8 @@ func _FileTransferService_PostUploadFile0_HTTP_Handler(srv FileTransferServiceHT
 type FileTransferServiceHTTPClient interface {
 	// DownloadFile 下载文件
 	DownloadFile(ctx context.Context, req *v1.DownloadFileRequest, opts ...http.CallOption) (rsp *v1.DownloadFileResponse, err error)
+	// GetFileVariant 获取图片衍生图（缩略图、缩放、格式转换）
+	GetFileVariant(ctx context.Context, req *v1.GetFileVariantRequest, opts ...http.CallOption) (rsp *v1.DownloadFileResponse, err error)
 	// PostUploadFile 上传文件 POST 方式
 	PostUploadFile(ctx context.Context, req *v1.UploadFileRequest, opts ...http.CallOption) (rsp *v1.UploadFileResponse, err error)
 	// PutUploadFile 上传文件 PUT 方式
@@ -134,6 +162,20 @@ func (c *FileTransferServiceHTTPClientImpl) DownloadFile(ctx context.Context, in
 	return &out, nil
 }
 
+// GetFileVariant 获取图片衍生图（缩略图、缩放、格式转换）
+func (c *FileTransferServiceHTTPClientImpl) GetFileVariant(ctx context.Context, in *v1.GetFileVariantRequest, opts ...http.CallOption) (*v1.DownloadFileResponse, error) {
+	var out v1.DownloadFileResponse
+	pattern := "/admin/v1/files/{id}/variant"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFileTransferServiceGetFileVariant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // PostUploadFile 上传文件 POST 方式
 func (c *FileTransferServiceHTTPClientImpl) PostUploadFile(ctx context.Context, in *v1.UploadFileRequest, opts ...http.CallOption) (*v1.UploadFileResponse, error) {
 	var out v1.UploadFileResponse
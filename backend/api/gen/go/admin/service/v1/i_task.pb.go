@@ -32,7 +32,7 @@ var File_admin_service_v1_i_task_proto protoreflect.FileDescriptor
 
 const file_admin_service_v1_i_task_proto_rawDesc = "" +
 	"\n" +
-	"\x1dadmin/service/v1/i_task.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1atask/service/v1/task.proto2\xc4\b\n" +
+	"\x1dadmin/service/v1/i_task.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1atask/service/v1/task.proto2\xc2\x0e\n" +
 	"\vTaskService\x12]\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a!.task.service.v1.ListTaskResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/admin/v1/tasks\x12\x84\x01\n" +
 	"\x03Get\x12\x1f.task.service.v1.GetTaskRequest\x1a\x15.task.service.v1.Task\"E\x82\xd3\xe4\x93\x02?Z'\x12%/admin/v1/tasks/type-name/{type_name}\x12\x14/admin/v1/tasks/{id}\x12`\n" +
@@ -40,10 +40,17 @@ const file_admin_service_v1_i_task_proto_rawDesc = "" +
 	"\x06Update\x12\".task.service.v1.UpdateTaskRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/admin/v1/tasks/{id}\x12b\n" +
 	"\x06Delete\x12\".task.service.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/admin/v1/tasks/{id}\x12y\n" +
 	"\x10ListTaskTypeName\x12\x16.google.protobuf.Empty\x1a).task.service.v1.ListTaskTypeNameResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/admin/v1/tasks:type-names\x12u\n" +
-	"\x0eRestartAllTask\x12\x16.google.protobuf.Empty\x1a'.task.service.v1.RestartAllTaskResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/v1/tasks:restart\x12`\n" +
-	"\fStartAllTask\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/admin/v1/tasks:start\x12^\n" +
+	"\x0eRestartAllTask\x12\x16.google.protobuf.Empty\x1a'.task.service.v1.RestartAllTaskResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/v1/tasks:restart\x12o\n" +
+	"\fStartAllTask\x12\x16.google.protobuf.Empty\x1a%.task.service.v1.StartAllTaskResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/admin/v1/tasks:start\x12^\n" +
 	"\vStopAllTask\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/admin/v1/tasks:stop\x12n\n" +
-	"\vControlTask\x12#.task.service.v1.ControlTaskRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/v1/tasks:controlB\xb7\x01\n" +
+	"\vControlTask\x12#.task.service.v1.ControlTaskRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/v1/tasks:control\x12k\n" +
+	"\vListTaskRun\x12\x19.pagination.PagingRequest\x1a$.task.service.v1.ListTaskRunResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/admin/v1/task-runs\x12l\n" +
+	"\n" +
+	"GetTaskRun\x12\".task.service.v1.GetTaskRunRequest\x1a\x18.task.service.v1.TaskRun\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/admin/v1/task-runs/{id}\x12n\n" +
+	"\rListTaskQueue\x12\x16.google.protobuf.Empty\x1a&.task.service.v1.ListTaskQueueResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/admin/v1/task-queues\x12\x8b\x01\n" +
+	"\rListQueueTask\x12%.task.service.v1.ListQueueTaskRequest\x1a&.task.service.v1.ListQueueTaskResponse\"+\x82\xd3\xe4\x93\x02%\x12#/admin/v1/task-queues/{queue}/tasks\x12\x8c\x01\n" +
+	"\x11RetryArchivedTask\x12!.task.service.v1.QueueTaskRequest\x1a\x16.google.protobuf.Empty\"<\x82\xd3\xe4\x93\x026:\x01*\"1/admin/v1/task-queues/{queue}/archived/{id}/retry\x12\x84\x01\n" +
+	"\x12DeleteArchivedTask\x12!.task.service.v1.QueueTaskRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-*+/admin/v1/task-queues/{queue}/archived/{id}B\xb7\x01\n" +
 	"\x14com.admin.service.v1B\n" +
 	"ITaskProtoP\x01Z1go-wind-admin/api/gen/go/admin/service/v1;adminpb\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
@@ -55,10 +62,18 @@ var file_admin_service_v1_i_task_proto_goTypes = []any{
 	(*v11.DeleteTaskRequest)(nil),        // 4: task.service.v1.DeleteTaskRequest
 	(*emptypb.Empty)(nil),                // 5: google.protobuf.Empty
 	(*v11.ControlTaskRequest)(nil),       // 6: task.service.v1.ControlTaskRequest
-	(*v11.ListTaskResponse)(nil),         // 7: task.service.v1.ListTaskResponse
-	(*v11.Task)(nil),                     // 8: task.service.v1.Task
-	(*v11.ListTaskTypeNameResponse)(nil), // 9: task.service.v1.ListTaskTypeNameResponse
-	(*v11.RestartAllTaskResponse)(nil),   // 10: task.service.v1.RestartAllTaskResponse
+	(*v11.GetTaskRunRequest)(nil),        // 7: task.service.v1.GetTaskRunRequest
+	(*v11.ListQueueTaskRequest)(nil),     // 8: task.service.v1.ListQueueTaskRequest
+	(*v11.QueueTaskRequest)(nil),         // 9: task.service.v1.QueueTaskRequest
+	(*v11.ListTaskResponse)(nil),         // 10: task.service.v1.ListTaskResponse
+	(*v11.Task)(nil),                     // 11: task.service.v1.Task
+	(*v11.ListTaskTypeNameResponse)(nil), // 12: task.service.v1.ListTaskTypeNameResponse
+	(*v11.RestartAllTaskResponse)(nil),   // 13: task.service.v1.RestartAllTaskResponse
+	(*v11.StartAllTaskResponse)(nil),     // 14: task.service.v1.StartAllTaskResponse
+	(*v11.ListTaskRunResponse)(nil),      // 15: task.service.v1.ListTaskRunResponse
+	(*v11.TaskRun)(nil),                  // 16: task.service.v1.TaskRun
+	(*v11.ListTaskQueueResponse)(nil),    // 17: task.service.v1.ListTaskQueueResponse
+	(*v11.ListQueueTaskResponse)(nil),    // 18: task.service.v1.ListQueueTaskResponse
 }
 var file_admin_service_v1_i_task_proto_depIdxs = []int32{
 	0,  // 0: admin.service.v1.TaskService.List:input_type -> pagination.PagingRequest
@@ -71,18 +86,30 @@ var file_admin_service_v1_i_task_proto_depIdxs = []int32{
 	5,  // 7: admin.service.v1.TaskService.StartAllTask:input_type -> google.protobuf.Empty
 	5,  // 8: admin.service.v1.TaskService.StopAllTask:input_type -> google.protobuf.Empty
 	6,  // 9: admin.service.v1.TaskService.ControlTask:input_type -> task.service.v1.ControlTaskRequest
-	7,  // 10: admin.service.v1.TaskService.List:output_type -> task.service.v1.ListTaskResponse
-	8,  // 11: admin.service.v1.TaskService.Get:output_type -> task.service.v1.Task
-	5,  // 12: admin.service.v1.TaskService.Create:output_type -> google.protobuf.Empty
-	5,  // 13: admin.service.v1.TaskService.Update:output_type -> google.protobuf.Empty
-	5,  // 14: admin.service.v1.TaskService.Delete:output_type -> google.protobuf.Empty
-	9,  // 15: admin.service.v1.TaskService.ListTaskTypeName:output_type -> task.service.v1.ListTaskTypeNameResponse
-	10, // 16: admin.service.v1.TaskService.RestartAllTask:output_type -> task.service.v1.RestartAllTaskResponse
-	5,  // 17: admin.service.v1.TaskService.StartAllTask:output_type -> google.protobuf.Empty
-	5,  // 18: admin.service.v1.TaskService.StopAllTask:output_type -> google.protobuf.Empty
-	5,  // 19: admin.service.v1.TaskService.ControlTask:output_type -> google.protobuf.Empty
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	0,  // 10: admin.service.v1.TaskService.ListTaskRun:input_type -> pagination.PagingRequest
+	7,  // 11: admin.service.v1.TaskService.GetTaskRun:input_type -> task.service.v1.GetTaskRunRequest
+	5,  // 12: admin.service.v1.TaskService.ListTaskQueue:input_type -> google.protobuf.Empty
+	8,  // 13: admin.service.v1.TaskService.ListQueueTask:input_type -> task.service.v1.ListQueueTaskRequest
+	9,  // 14: admin.service.v1.TaskService.RetryArchivedTask:input_type -> task.service.v1.QueueTaskRequest
+	9,  // 15: admin.service.v1.TaskService.DeleteArchivedTask:input_type -> task.service.v1.QueueTaskRequest
+	10, // 16: admin.service.v1.TaskService.List:output_type -> task.service.v1.ListTaskResponse
+	11, // 17: admin.service.v1.TaskService.Get:output_type -> task.service.v1.Task
+	5,  // 18: admin.service.v1.TaskService.Create:output_type -> google.protobuf.Empty
+	5,  // 19: admin.service.v1.TaskService.Update:output_type -> google.protobuf.Empty
+	5,  // 20: admin.service.v1.TaskService.Delete:output_type -> google.protobuf.Empty
+	12, // 21: admin.service.v1.TaskService.ListTaskTypeName:output_type -> task.service.v1.ListTaskTypeNameResponse
+	13, // 22: admin.service.v1.TaskService.RestartAllTask:output_type -> task.service.v1.RestartAllTaskResponse
+	14, // 23: admin.service.v1.TaskService.StartAllTask:output_type -> task.service.v1.StartAllTaskResponse
+	5,  // 24: admin.service.v1.TaskService.StopAllTask:output_type -> google.protobuf.Empty
+	5,  // 25: admin.service.v1.TaskService.ControlTask:output_type -> google.protobuf.Empty
+	15, // 26: admin.service.v1.TaskService.ListTaskRun:output_type -> task.service.v1.ListTaskRunResponse
+	16, // 27: admin.service.v1.TaskService.GetTaskRun:output_type -> task.service.v1.TaskRun
+	17, // 28: admin.service.v1.TaskService.ListTaskQueue:output_type -> task.service.v1.ListTaskQueueResponse
+	18, // 29: admin.service.v1.TaskService.ListQueueTask:output_type -> task.service.v1.ListQueueTaskResponse
+	5,  // 30: admin.service.v1.TaskService.RetryArchivedTask:output_type -> google.protobuf.Empty
+	5,  // 31: admin.service.v1.TaskService.DeleteArchivedTask:output_type -> google.protobuf.Empty
+	16, // [16:32] is the sub-list for method output_type
+	0,  // [0:16] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
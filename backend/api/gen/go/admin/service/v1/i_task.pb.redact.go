@@ -131,7 +131,7 @@ func (s *redactedTaskServiceServer) RestartAllTask(ctx context.Context, in *empt
 
 // StartAllTask is the redacted wrapper for the actual TaskServiceServer.StartAllTask method
 // Unary RPC
-func (s *redactedTaskServiceServer) StartAllTask(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+func (s *redactedTaskServiceServer) StartAllTask(ctx context.Context, in *emptypb.Empty) (*taskpb.StartAllTaskResponse, error) {
 	res, err := s.srv.StartAllTask(ctx, in)
 	if !s.bypass.CheckInternal(ctx) {
 		// Apply redaction to the response
@@ -161,3 +161,69 @@ func (s *redactedTaskServiceServer) ControlTask(ctx context.Context, in *taskpb.
 	}
 	return res, err
 }
+
+// ListTaskRun is the redacted wrapper for the actual TaskServiceServer.ListTaskRun method
+// Unary RPC
+func (s *redactedTaskServiceServer) ListTaskRun(ctx context.Context, in *pagination.PagingRequest) (*taskpb.ListTaskRunResponse, error) {
+	res, err := s.srv.ListTaskRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetTaskRun is the redacted wrapper for the actual TaskServiceServer.GetTaskRun method
+// Unary RPC
+func (s *redactedTaskServiceServer) GetTaskRun(ctx context.Context, in *taskpb.GetTaskRunRequest) (*taskpb.TaskRun, error) {
+	res, err := s.srv.GetTaskRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListTaskQueue is the redacted wrapper for the actual TaskServiceServer.ListTaskQueue method
+// Unary RPC
+func (s *redactedTaskServiceServer) ListTaskQueue(ctx context.Context, in *emptypb.Empty) (*taskpb.ListTaskQueueResponse, error) {
+	res, err := s.srv.ListTaskQueue(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListQueueTask is the redacted wrapper for the actual TaskServiceServer.ListQueueTask method
+// Unary RPC
+func (s *redactedTaskServiceServer) ListQueueTask(ctx context.Context, in *taskpb.ListQueueTaskRequest) (*taskpb.ListQueueTaskResponse, error) {
+	res, err := s.srv.ListQueueTask(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RetryArchivedTask is the redacted wrapper for the actual TaskServiceServer.RetryArchivedTask method
+// Unary RPC
+func (s *redactedTaskServiceServer) RetryArchivedTask(ctx context.Context, in *taskpb.QueueTaskRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.RetryArchivedTask(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// DeleteArchivedTask is the redacted wrapper for the actual TaskServiceServer.DeleteArchivedTask method
+// Unary RPC
+func (s *redactedTaskServiceServer) DeleteArchivedTask(ctx context.Context, in *taskpb.QueueTaskRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.DeleteArchivedTask(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
@@ -25,10 +25,16 @@ const _ = http.SupportPackageIsVersion1
 const OperationTaskServiceControlTask = "/admin.service.v1.TaskService/ControlTask"
 const OperationTaskServiceCreate = "/admin.service.v1.TaskService/Create"
 const OperationTaskServiceDelete = "/admin.service.v1.TaskService/Delete"
+const OperationTaskServiceDeleteArchivedTask = "/admin.service.v1.TaskService/DeleteArchivedTask"
 const OperationTaskServiceGet = "/admin.service.v1.TaskService/Get"
+const OperationTaskServiceGetTaskRun = "/admin.service.v1.TaskService/GetTaskRun"
 const OperationTaskServiceList = "/admin.service.v1.TaskService/List"
+const OperationTaskServiceListQueueTask = "/admin.service.v1.TaskService/ListQueueTask"
+const OperationTaskServiceListTaskQueue = "/admin.service.v1.TaskService/ListTaskQueue"
+const OperationTaskServiceListTaskRun = "/admin.service.v1.TaskService/ListTaskRun"
 const OperationTaskServiceListTaskTypeName = "/admin.service.v1.TaskService/ListTaskTypeName"
 const OperationTaskServiceRestartAllTask = "/admin.service.v1.TaskService/RestartAllTask"
+const OperationTaskServiceRetryArchivedTask = "/admin.service.v1.TaskService/RetryArchivedTask"
 const OperationTaskServiceStartAllTask = "/admin.service.v1.TaskService/StartAllTask"
 const OperationTaskServiceStopAllTask = "/admin.service.v1.TaskService/StopAllTask"
 const OperationTaskServiceUpdate = "/admin.service.v1.TaskService/Update"
@@ -40,16 +46,28 @@ type TaskServiceHTTPServer interface {
 	Create(context.Context, *v11.CreateTaskRequest) (*emptypb.Empty, error)
 	// Delete 删除调度任务
 	Delete(context.Context, *v11.DeleteTaskRequest) (*emptypb.Empty, error)
+	// DeleteArchivedTask 删除已归档（死信）的任务
+	DeleteArchivedTask(context.Context, *v11.QueueTaskRequest) (*emptypb.Empty, error)
 	// Get 查询调度任务详情
 	Get(context.Context, *v11.GetTaskRequest) (*v11.Task, error)
+	// GetTaskRun 查询任务执行记录详情
+	GetTaskRun(context.Context, *v11.GetTaskRunRequest) (*v11.TaskRun, error)
 	// List 查询调度任务列表
 	List(context.Context, *v1.PagingRequest) (*v11.ListTaskResponse, error)
+	// ListQueueTask 查询队列中的任务
+	ListQueueTask(context.Context, *v11.ListQueueTaskRequest) (*v11.ListQueueTaskResponse, error)
+	// ListTaskQueue 查询任务队列统计
+	ListTaskQueue(context.Context, *emptypb.Empty) (*v11.ListTaskQueueResponse, error)
+	// ListTaskRun 查询任务执行记录列表
+	ListTaskRun(context.Context, *v1.PagingRequest) (*v11.ListTaskRunResponse, error)
 	// ListTaskTypeName 任务类型名称列表
 	ListTaskTypeName(context.Context, *emptypb.Empty) (*v11.ListTaskTypeNameResponse, error)
 	// RestartAllTask 重启所有的调度任务
 	RestartAllTask(context.Context, *emptypb.Empty) (*v11.RestartAllTaskResponse, error)
+	// RetryArchivedTask 重新执行已归档（死信）的任务
+	RetryArchivedTask(context.Context, *v11.QueueTaskRequest) (*emptypb.Empty, error)
 	// StartAllTask 启动所有的调度任务
-	StartAllTask(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	StartAllTask(context.Context, *emptypb.Empty) (*v11.StartAllTaskResponse, error)
 	// StopAllTask 停止所有的调度任务
 	StopAllTask(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// Update 更新调度任务
@@ -58,20 +76,26 @@ type TaskServiceHTTPServer interface {
 
 func RegisterTaskServiceHTTPServer(s *http.Server, srv TaskServiceHTTPServer) {
 	r := s.Route("/")
-	r.GET("/admin/v1/tasks", _TaskService_List17_HTTP_Handler(srv))
-	r.GET("/admin/v1/tasks/type-name/{type_name}", _TaskService_Get17_HTTP_Handler(srv))
-	r.GET("/admin/v1/tasks/{id}", _TaskService_Get18_HTTP_Handler(srv))
-	r.POST("/admin/v1/tasks", _TaskService_Create11_HTTP_Handler(srv))
-	r.PUT("/admin/v1/tasks/{id}", _TaskService_Update11_HTTP_Handler(srv))
-	r.DELETE("/admin/v1/tasks/{id}", _TaskService_Delete11_HTTP_Handler(srv))
+	r.GET("/admin/v1/tasks", _TaskService_List26_HTTP_Handler(srv))
+	r.GET("/admin/v1/tasks/type-name/{type_name}", _TaskService_Get26_HTTP_Handler(srv))
+	r.GET("/admin/v1/tasks/{id}", _TaskService_Get27_HTTP_Handler(srv))
+	r.POST("/admin/v1/tasks", _TaskService_Create19_HTTP_Handler(srv))
+	r.PUT("/admin/v1/tasks/{id}", _TaskService_Update18_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/tasks/{id}", _TaskService_Delete19_HTTP_Handler(srv))
 	r.GET("/admin/v1/tasks:type-names", _TaskService_ListTaskTypeName0_HTTP_Handler(srv))
 	r.POST("/admin/v1/tasks:restart", _TaskService_RestartAllTask0_HTTP_Handler(srv))
 	r.POST("/admin/v1/tasks:start", _TaskService_StartAllTask0_HTTP_Handler(srv))
 	r.POST("/admin/v1/tasks:stop", _TaskService_StopAllTask0_HTTP_Handler(srv))
 	r.POST("/admin/v1/tasks:control", _TaskService_ControlTask0_HTTP_Handler(srv))
+	r.GET("/admin/v1/task-runs", _TaskService_ListTaskRun0_HTTP_Handler(srv))
+	r.GET("/admin/v1/task-runs/{id}", _TaskService_GetTaskRun0_HTTP_Handler(srv))
+	r.GET("/admin/v1/task-queues", _TaskService_ListTaskQueue0_HTTP_Handler(srv))
+	r.GET("/admin/v1/task-queues/{queue}/tasks", _TaskService_ListQueueTask0_HTTP_Handler(srv))
+	r.POST("/admin/v1/task-queues/{queue}/archived/{id}/retry", _TaskService_RetryArchivedTask0_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/task-queues/{queue}/archived/{id}", _TaskService_DeleteArchivedTask0_HTTP_Handler(srv))
 }
 
-func _TaskService_List17_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_List26_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.PagingRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -90,7 +114,7 @@ func _TaskService_List17_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.C
 	}
 }
 
-func _TaskService_Get17_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_Get26_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetTaskRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -112,7 +136,7 @@ func _TaskService_Get17_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Co
 	}
 }
 
-func _TaskService_Get18_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_Get27_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetTaskRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -134,7 +158,7 @@ func _TaskService_Get18_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Co
 	}
 }
 
-func _TaskService_Create11_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_Create19_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.CreateTaskRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -156,7 +180,7 @@ func _TaskService_Create11_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http
 	}
 }
 
-func _TaskService_Update11_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_Update18_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.UpdateTaskRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -181,7 +205,7 @@ func _TaskService_Update11_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http
 	}
 }
 
-func _TaskService_Delete11_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_Delete19_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.DeleteTaskRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -261,7 +285,7 @@ func _TaskService_StartAllTask0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx
 		if err != nil {
 			return err
 		}
-		reply := out.(*emptypb.Empty)
+		reply := out.(*v11.StartAllTaskResponse)
 		return ctx.Result(200, reply)
 	}
 }
@@ -310,6 +334,135 @@ func _TaskService_ControlTask0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx
 	}
 }
 
+func _TaskService_ListTaskRun0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.PagingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceListTaskRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTaskRun(ctx, req.(*v1.PagingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ListTaskRunResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_GetTaskRun0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.GetTaskRunRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceGetTaskRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTaskRun(ctx, req.(*v11.GetTaskRunRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.TaskRun)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_ListTaskQueue0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceListTaskQueue)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTaskQueue(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ListTaskQueueResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_ListQueueTask0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.ListQueueTaskRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceListQueueTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListQueueTask(ctx, req.(*v11.ListQueueTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ListQueueTaskResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_RetryArchivedTask0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.QueueTaskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceRetryArchivedTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RetryArchivedTask(ctx, req.(*v11.QueueTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_DeleteArchivedTask0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.QueueTaskRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceDeleteArchivedTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteArchivedTask(ctx, req.(*v11.QueueTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 type TaskServiceHTTPClient interface {
 	// ControlTask 控制调度任务
 	ControlTask(ctx context.Context, req *v11.ControlTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -317,16 +470,28 @@ type TaskServiceHTTPClient interface {
 	Create(ctx context.Context, req *v11.CreateTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// Delete 删除调度任务
 	Delete(ctx context.Context, req *v11.DeleteTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteArchivedTask 删除已归档（死信）的任务
+	DeleteArchivedTask(ctx context.Context, req *v11.QueueTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// Get 查询调度任务详情
 	Get(ctx context.Context, req *v11.GetTaskRequest, opts ...http.CallOption) (rsp *v11.Task, err error)
+	// GetTaskRun 查询任务执行记录详情
+	GetTaskRun(ctx context.Context, req *v11.GetTaskRunRequest, opts ...http.CallOption) (rsp *v11.TaskRun, err error)
 	// List 查询调度任务列表
 	List(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *v11.ListTaskResponse, err error)
+	// ListQueueTask 查询队列中的任务
+	ListQueueTask(ctx context.Context, req *v11.ListQueueTaskRequest, opts ...http.CallOption) (rsp *v11.ListQueueTaskResponse, err error)
+	// ListTaskQueue 查询任务队列统计
+	ListTaskQueue(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v11.ListTaskQueueResponse, err error)
+	// ListTaskRun 查询任务执行记录列表
+	ListTaskRun(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *v11.ListTaskRunResponse, err error)
 	// ListTaskTypeName 任务类型名称列表
 	ListTaskTypeName(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v11.ListTaskTypeNameResponse, err error)
 	// RestartAllTask 重启所有的调度任务
 	RestartAllTask(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v11.RestartAllTaskResponse, err error)
+	// RetryArchivedTask 重新执行已归档（死信）的任务
+	RetryArchivedTask(ctx context.Context, req *v11.QueueTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// StartAllTask 启动所有的调度任务
-	StartAllTask(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	StartAllTask(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v11.StartAllTaskResponse, err error)
 	// StopAllTask 停止所有的调度任务
 	StopAllTask(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// Update 更新调度任务
@@ -383,6 +548,20 @@ func (c *TaskServiceHTTPClientImpl) Delete(ctx context.Context, in *v11.DeleteTa
 	return &out, nil
 }
 
+// DeleteArchivedTask 删除已归档（死信）的任务
+func (c *TaskServiceHTTPClientImpl) DeleteArchivedTask(ctx context.Context, in *v11.QueueTaskRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/task-queues/{queue}/archived/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTaskServiceDeleteArchivedTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Get 查询调度任务详情
 func (c *TaskServiceHTTPClientImpl) Get(ctx context.Context, in *v11.GetTaskRequest, opts ...http.CallOption) (*v11.Task, error) {
 	var out v11.Task
@@ -397,6 +576,20 @@ func (c *TaskServiceHTTPClientImpl) Get(ctx context.Context, in *v11.GetTaskRequ
 	return &out, nil
 }
 
+// GetTaskRun 查询任务执行记录详情
+func (c *TaskServiceHTTPClientImpl) GetTaskRun(ctx context.Context, in *v11.GetTaskRunRequest, opts ...http.CallOption) (*v11.TaskRun, error) {
+	var out v11.TaskRun
+	pattern := "/admin/v1/task-runs/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTaskServiceGetTaskRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // List 查询调度任务列表
 func (c *TaskServiceHTTPClientImpl) List(ctx context.Context, in *v1.PagingRequest, opts ...http.CallOption) (*v11.ListTaskResponse, error) {
 	var out v11.ListTaskResponse
@@ -411,6 +604,48 @@ func (c *TaskServiceHTTPClientImpl) List(ctx context.Context, in *v1.PagingReque
 	return &out, nil
 }
 
+// ListQueueTask 查询队列中的任务
+func (c *TaskServiceHTTPClientImpl) ListQueueTask(ctx context.Context, in *v11.ListQueueTaskRequest, opts ...http.CallOption) (*v11.ListQueueTaskResponse, error) {
+	var out v11.ListQueueTaskResponse
+	pattern := "/admin/v1/task-queues/{queue}/tasks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTaskServiceListQueueTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTaskQueue 查询任务队列统计
+func (c *TaskServiceHTTPClientImpl) ListTaskQueue(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v11.ListTaskQueueResponse, error) {
+	var out v11.ListTaskQueueResponse
+	pattern := "/admin/v1/task-queues"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTaskServiceListTaskQueue))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTaskRun 查询任务执行记录列表
+func (c *TaskServiceHTTPClientImpl) ListTaskRun(ctx context.Context, in *v1.PagingRequest, opts ...http.CallOption) (*v11.ListTaskRunResponse, error) {
+	var out v11.ListTaskRunResponse
+	pattern := "/admin/v1/task-runs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTaskServiceListTaskRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListTaskTypeName 任务类型名称列表
 func (c *TaskServiceHTTPClientImpl) ListTaskTypeName(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v11.ListTaskTypeNameResponse, error) {
 	var out v11.ListTaskTypeNameResponse
@@ -439,9 +674,23 @@ func (c *TaskServiceHTTPClientImpl) RestartAllTask(ctx context.Context, in *empt
 	return &out, nil
 }
 
-// StartAllTask 启动所有的调度任务
-func (c *TaskServiceHTTPClientImpl) StartAllTask(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*emptypb.Empty, error) {
+// RetryArchivedTask 重新执行已归档（死信）的任务
+func (c *TaskServiceHTTPClientImpl) RetryArchivedTask(ctx context.Context, in *v11.QueueTaskRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
+	pattern := "/admin/v1/task-queues/{queue}/archived/{id}/retry"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTaskServiceRetryArchivedTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartAllTask 启动所有的调度任务
+func (c *TaskServiceHTTPClientImpl) StartAllTask(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v11.StartAllTaskResponse, error) {
+	var out v11.StartAllTaskResponse
 	pattern := "/admin/v1/tasks:start"
 	path := binding.EncodeURL(pattern, in, false)
 	opts = append(opts, http.Operation(OperationTaskServiceStartAllTask))
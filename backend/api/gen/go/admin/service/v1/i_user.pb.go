@@ -10,6 +10,7 @@ import (
 	_ "github.com/google/gnostic/openapiv3"
 	_ "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
 	v1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	v12 "go-wind-admin/api/gen/go/authentication/service/v1"
 	v11 "go-wind-admin/api/gen/go/user/service/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -30,7 +31,8 @@ var File_admin_service_v1_i_user_proto protoreflect.FileDescriptor
 
 const file_admin_service_v1_i_user_proto_rawDesc = "" +
 	"\n" +
-	"\x1dadmin/service/v1/i_user.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16redact/v3/redact.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1auser/service/v1/user.proto2\xcb\x06\n" +
+	"\x1dadmin/service/v1/i_user.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16redact/v3/redact.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1auser/service/v1/user.proto\x1a,authentication/service/v1/user_session.proto2\x9a\n" +
+	"\n" +
 	"\vUserService\x12a\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a!.user.service.v1.ListUserResponse\"\x1b\xe0\xb6\x1a\x01\x82\xd3\xe4\x93\x02\x11\x12\x0f/admin/v1/users\x12\x86\x01\n" +
 	"\x03Get\x12\x1f.user.service.v1.GetUserRequest\x1a\x15.user.service.v1.User\"G\xe0\xb6\x1a\x01\x82\xd3\xe4\x93\x02=Z%\x12#/admin/v1/users/username/{username}\x12\x14/admin/v1/users/{id}\x12`\n" +
@@ -39,22 +41,29 @@ const file_admin_service_v1_i_user_proto_rawDesc = "" +
 	"\x06Delete\x12\".user.service.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"C\x82\xd3\xe4\x93\x02=Z%*#/admin/v1/users/username/{username}*\x14/admin/v1/users/{id}\x12u\n" +
 	"\n" +
 	"UserExists\x12\".user.service.v1.UserExistsRequest\x1a#.user.service.v1.UserExistsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/admin/v1/users:exists\x12\x83\x01\n" +
-	"\x10EditUserPassword\x12(.user.service.v1.EditUserPasswordRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/admin/v1/users/{user_id}/passwordB\xb7\x01\n" +
+	"\x10EditUserPassword\x12(.user.service.v1.EditUserPasswordRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/admin/v1/users/{user_id}/password\x12\xa1\x01\n" +
+	"\fListSessions\x121.authentication.service.v1.ListUserSessionRequest\x1a2.authentication.service.v1.ListUserSessionResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/admin/v1/users/{user_id}/sessions\x12\x95\x01\n" +
+	"\rRevokeSession\x123.authentication.service.v1.RevokeUserSessionRequest\x1a\x16.google.protobuf.Empty\"7\x82\xd3\xe4\x93\x021*//admin/v1/users/{user_id}/sessions/{session_id}\x12\x90\x01\n" +
+	"\x11RevokeAllSessions\x127.authentication.service.v1.RevokeAllUserSessionsRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$*\"/admin/v1/users/{user_id}/sessionsB\xb7\x01\n" +
 	"\x14com.admin.service.v1B\n" +
 	"IUserProtoP\x01Z1go-wind-admin/api/gen/go/admin/service/v1;adminpb\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var file_admin_service_v1_i_user_proto_goTypes = []any{
-	(*v1.PagingRequest)(nil),            // 0: pagination.PagingRequest
-	(*v11.GetUserRequest)(nil),          // 1: user.service.v1.GetUserRequest
-	(*v11.CreateUserRequest)(nil),       // 2: user.service.v1.CreateUserRequest
-	(*v11.UpdateUserRequest)(nil),       // 3: user.service.v1.UpdateUserRequest
-	(*v11.DeleteUserRequest)(nil),       // 4: user.service.v1.DeleteUserRequest
-	(*v11.UserExistsRequest)(nil),       // 5: user.service.v1.UserExistsRequest
-	(*v11.EditUserPasswordRequest)(nil), // 6: user.service.v1.EditUserPasswordRequest
-	(*v11.ListUserResponse)(nil),        // 7: user.service.v1.ListUserResponse
-	(*v11.User)(nil),                    // 8: user.service.v1.User
-	(*emptypb.Empty)(nil),               // 9: google.protobuf.Empty
-	(*v11.UserExistsResponse)(nil),      // 10: user.service.v1.UserExistsResponse
+	(*v1.PagingRequest)(nil),                 // 0: pagination.PagingRequest
+	(*v11.GetUserRequest)(nil),               // 1: user.service.v1.GetUserRequest
+	(*v11.CreateUserRequest)(nil),            // 2: user.service.v1.CreateUserRequest
+	(*v11.UpdateUserRequest)(nil),            // 3: user.service.v1.UpdateUserRequest
+	(*v11.DeleteUserRequest)(nil),            // 4: user.service.v1.DeleteUserRequest
+	(*v11.UserExistsRequest)(nil),            // 5: user.service.v1.UserExistsRequest
+	(*v11.EditUserPasswordRequest)(nil),      // 6: user.service.v1.EditUserPasswordRequest
+	(*v12.ListUserSessionRequest)(nil),       // 7: authentication.service.v1.ListUserSessionRequest
+	(*v12.RevokeUserSessionRequest)(nil),     // 8: authentication.service.v1.RevokeUserSessionRequest
+	(*v12.RevokeAllUserSessionsRequest)(nil), // 9: authentication.service.v1.RevokeAllUserSessionsRequest
+	(*v11.ListUserResponse)(nil),             // 10: user.service.v1.ListUserResponse
+	(*v11.User)(nil),                         // 11: user.service.v1.User
+	(*emptypb.Empty)(nil),                    // 12: google.protobuf.Empty
+	(*v11.UserExistsResponse)(nil),           // 13: user.service.v1.UserExistsResponse
+	(*v12.ListUserSessionResponse)(nil),      // 14: authentication.service.v1.ListUserSessionResponse
 }
 var file_admin_service_v1_i_user_proto_depIdxs = []int32{
 	0,  // 0: admin.service.v1.UserService.List:input_type -> pagination.PagingRequest
@@ -64,15 +73,21 @@ var file_admin_service_v1_i_user_proto_depIdxs = []int32{
 	4,  // 4: admin.service.v1.UserService.Delete:input_type -> user.service.v1.DeleteUserRequest
 	5,  // 5: admin.service.v1.UserService.UserExists:input_type -> user.service.v1.UserExistsRequest
 	6,  // 6: admin.service.v1.UserService.EditUserPassword:input_type -> user.service.v1.EditUserPasswordRequest
-	7,  // 7: admin.service.v1.UserService.List:output_type -> user.service.v1.ListUserResponse
-	8,  // 8: admin.service.v1.UserService.Get:output_type -> user.service.v1.User
-	9,  // 9: admin.service.v1.UserService.Create:output_type -> google.protobuf.Empty
-	9,  // 10: admin.service.v1.UserService.Update:output_type -> google.protobuf.Empty
-	9,  // 11: admin.service.v1.UserService.Delete:output_type -> google.protobuf.Empty
-	10, // 12: admin.service.v1.UserService.UserExists:output_type -> user.service.v1.UserExistsResponse
-	9,  // 13: admin.service.v1.UserService.EditUserPassword:output_type -> google.protobuf.Empty
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	7,  // 7: admin.service.v1.UserService.ListSessions:input_type -> authentication.service.v1.ListUserSessionRequest
+	8,  // 8: admin.service.v1.UserService.RevokeSession:input_type -> authentication.service.v1.RevokeUserSessionRequest
+	9,  // 9: admin.service.v1.UserService.RevokeAllSessions:input_type -> authentication.service.v1.RevokeAllUserSessionsRequest
+	10, // 10: admin.service.v1.UserService.List:output_type -> user.service.v1.ListUserResponse
+	11, // 11: admin.service.v1.UserService.Get:output_type -> user.service.v1.User
+	12, // 12: admin.service.v1.UserService.Create:output_type -> google.protobuf.Empty
+	12, // 13: admin.service.v1.UserService.Update:output_type -> google.protobuf.Empty
+	12, // 14: admin.service.v1.UserService.Delete:output_type -> google.protobuf.Empty
+	13, // 15: admin.service.v1.UserService.UserExists:output_type -> user.service.v1.UserExistsResponse
+	12, // 16: admin.service.v1.UserService.EditUserPassword:output_type -> google.protobuf.Empty
+	14, // 17: admin.service.v1.UserService.ListSessions:output_type -> authentication.service.v1.ListUserSessionResponse
+	12, // 18: admin.service.v1.UserService.RevokeSession:output_type -> google.protobuf.Empty
+	12, // 19: admin.service.v1.UserService.RevokeAllSessions:output_type -> google.protobuf.Empty
+	10, // [10:20] is the sub-list for method output_type
+	0,  // [0:10] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -7,6 +7,7 @@ import (
 	context "context"
 	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
 	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	authenticationpb "go-wind-admin/api/gen/go/authentication/service/v1"
 	userpb "go-wind-admin/api/gen/go/user/service/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -25,6 +26,7 @@ var (
 	_ redact.FieldRules
 	_ pagination.Sorting
 	_ userpb.User
+	_ authenticationpb.UserSession
 )
 
 // RegisterRedactedUserServiceServer wraps the UserServiceServer with the redacted server and registers the service in GRPC
@@ -117,3 +119,36 @@ func (s *redactedUserServiceServer) EditUserPassword(ctx context.Context, in *us
 	}
 	return res, err
 }
+
+// ListSessions is the redacted wrapper for the actual UserServiceServer.ListSessions method
+// Unary RPC
+func (s *redactedUserServiceServer) ListSessions(ctx context.Context, in *authenticationpb.ListUserSessionRequest) (*authenticationpb.ListUserSessionResponse, error) {
+	res, err := s.srv.ListSessions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RevokeSession is the redacted wrapper for the actual UserServiceServer.RevokeSession method
+// Unary RPC
+func (s *redactedUserServiceServer) RevokeSession(ctx context.Context, in *authenticationpb.RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.RevokeSession(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RevokeAllSessions is the redacted wrapper for the actual UserServiceServer.RevokeAllSessions method
+// Unary RPC
+func (s *redactedUserServiceServer) RevokeAllSessions(ctx context.Context, in *authenticationpb.RevokeAllUserSessionsRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.RevokeAllSessions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
@@ -11,6 +11,7 @@ import (
 	http "github.com/go-kratos/kratos/v2/transport/http"
 	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
 	v1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	v12 "go-wind-admin/api/gen/go/authentication/service/v1"
 	v11 "go-wind-admin/api/gen/go/user/service/v1"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
@@ -27,6 +28,9 @@ const OperationUserServiceDelete = "/admin.service.v1.UserService/Delete"
 const OperationUserServiceEditUserPassword = "/admin.service.v1.UserService/EditUserPassword"
 const OperationUserServiceGet = "/admin.service.v1.UserService/Get"
 const OperationUserServiceList = "/admin.service.v1.UserService/List"
+const OperationUserServiceListSessions = "/admin.service.v1.UserService/ListSessions"
+const OperationUserServiceRevokeAllSessions = "/admin.service.v1.UserService/RevokeAllSessions"
+const OperationUserServiceRevokeSession = "/admin.service.v1.UserService/RevokeSession"
 const OperationUserServiceUpdate = "/admin.service.v1.UserService/Update"
 const OperationUserServiceUserExists = "/admin.service.v1.UserService/UserExists"
 
@@ -41,6 +45,12 @@ type UserServiceHTTPServer interface {
 	Get(context.Context, *v11.GetUserRequest) (*v11.User, error)
 	// List 获取用户列表
 	List(context.Context, *v1.PagingRequest) (*v11.ListUserResponse, error)
+	// ListSessions 查询用户的登录会话
+	ListSessions(context.Context, *v12.ListUserSessionRequest) (*v12.ListUserSessionResponse, error)
+	// RevokeAllSessions 强制注销用户的全部会话
+	RevokeAllSessions(context.Context, *v12.RevokeAllUserSessionsRequest) (*emptypb.Empty, error)
+	// RevokeSession 强制注销用户的指定会话
+	RevokeSession(context.Context, *v12.RevokeUserSessionRequest) (*emptypb.Empty, error)
 	// Update 更新用户
 	Update(context.Context, *v11.UpdateUserRequest) (*emptypb.Empty, error)
 	// UserExists 用户是否存在
@@ -49,18 +59,21 @@ type UserServiceHTTPServer interface {
 
 func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
 	r := s.Route("/")
-	r.GET("/admin/v1/users", _UserService_List19_HTTP_Handler(srv))
-	r.GET("/admin/v1/users/username/{username}", _UserService_Get20_HTTP_Handler(srv))
-	r.GET("/admin/v1/users/{id}", _UserService_Get21_HTTP_Handler(srv))
-	r.POST("/admin/v1/users", _UserService_Create13_HTTP_Handler(srv))
-	r.PUT("/admin/v1/users/{id}", _UserService_Update13_HTTP_Handler(srv))
-	r.DELETE("/admin/v1/users/username/{username}", _UserService_Delete13_HTTP_Handler(srv))
-	r.DELETE("/admin/v1/users/{id}", _UserService_Delete14_HTTP_Handler(srv))
+	r.GET("/admin/v1/users", _UserService_List28_HTTP_Handler(srv))
+	r.GET("/admin/v1/users/username/{username}", _UserService_Get29_HTTP_Handler(srv))
+	r.GET("/admin/v1/users/{id}", _UserService_Get30_HTTP_Handler(srv))
+	r.POST("/admin/v1/users", _UserService_Create21_HTTP_Handler(srv))
+	r.PUT("/admin/v1/users/{id}", _UserService_Update20_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/users/username/{username}", _UserService_Delete21_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/users/{id}", _UserService_Delete22_HTTP_Handler(srv))
 	r.GET("/admin/v1/users:exists", _UserService_UserExists0_HTTP_Handler(srv))
 	r.POST("/admin/v1/users/{user_id}/password", _UserService_EditUserPassword0_HTTP_Handler(srv))
+	r.GET("/admin/v1/users/{user_id}/sessions", _UserService_ListSessions0_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/users/{user_id}/sessions/{session_id}", _UserService_RevokeSession0_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/users/{user_id}/sessions", _UserService_RevokeAllSessions0_HTTP_Handler(srv))
 }
 
-func _UserService_List19_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_List28_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.PagingRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -79,7 +92,7 @@ func _UserService_List19_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.C
 	}
 }
 
-func _UserService_Get20_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Get29_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetUserRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -101,7 +114,7 @@ func _UserService_Get20_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Co
 	}
 }
 
-func _UserService_Get21_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Get30_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetUserRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -123,7 +136,7 @@ func _UserService_Get21_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Co
 	}
 }
 
-func _UserService_Create13_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Create21_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.CreateUserRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -145,7 +158,7 @@ func _UserService_Create13_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http
 	}
 }
 
-func _UserService_Update13_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Update20_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.UpdateUserRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -170,7 +183,7 @@ func _UserService_Update13_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http
 	}
 }
 
-func _UserService_Delete13_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Delete21_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.DeleteUserRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -192,7 +205,7 @@ func _UserService_Delete13_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http
 	}
 }
 
-func _UserService_Delete14_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Delete22_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.DeleteUserRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -258,6 +271,72 @@ func _UserService_EditUserPassword0_HTTP_Handler(srv UserServiceHTTPServer) func
 	}
 }
 
+func _UserService_ListSessions0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v12.ListUserSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceListSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSessions(ctx, req.(*v12.ListUserSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v12.ListUserSessionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_RevokeSession0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v12.RevokeUserSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRevokeSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSession(ctx, req.(*v12.RevokeUserSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_RevokeAllSessions0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v12.RevokeAllUserSessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRevokeAllSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeAllSessions(ctx, req.(*v12.RevokeAllUserSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserServiceHTTPClient interface {
 	// Create 创建用户
 	Create(ctx context.Context, req *v11.CreateUserRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -269,6 +348,12 @@ type UserServiceHTTPClient interface {
 	Get(ctx context.Context, req *v11.GetUserRequest, opts ...http.CallOption) (rsp *v11.User, err error)
 	// List 获取用户列表
 	List(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *v11.ListUserResponse, err error)
+	// ListSessions 查询用户的登录会话
+	ListSessions(ctx context.Context, req *v12.ListUserSessionRequest, opts ...http.CallOption) (rsp *v12.ListUserSessionResponse, err error)
+	// RevokeAllSessions 强制注销用户的全部会话
+	RevokeAllSessions(ctx context.Context, req *v12.RevokeAllUserSessionsRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// RevokeSession 强制注销用户的指定会话
+	RevokeSession(ctx context.Context, req *v12.RevokeUserSessionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// Update 更新用户
 	Update(ctx context.Context, req *v11.UpdateUserRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// UserExists 用户是否存在
@@ -353,6 +438,48 @@ func (c *UserServiceHTTPClientImpl) List(ctx context.Context, in *v1.PagingReque
 	return &out, nil
 }
 
+// ListSessions 查询用户的登录会话
+func (c *UserServiceHTTPClientImpl) ListSessions(ctx context.Context, in *v12.ListUserSessionRequest, opts ...http.CallOption) (*v12.ListUserSessionResponse, error) {
+	var out v12.ListUserSessionResponse
+	pattern := "/admin/v1/users/{user_id}/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceListSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeAllSessions 强制注销用户的全部会话
+func (c *UserServiceHTTPClientImpl) RevokeAllSessions(ctx context.Context, in *v12.RevokeAllUserSessionsRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/users/{user_id}/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceRevokeAllSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeSession 强制注销用户的指定会话
+func (c *UserServiceHTTPClientImpl) RevokeSession(ctx context.Context, in *v12.RevokeUserSessionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/users/{user_id}/sessions/{session_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceRevokeSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Update 更新用户
 func (c *UserServiceHTTPClientImpl) Update(ctx context.Context, in *v11.UpdateUserRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
@@ -6,6 +6,7 @@ package adminpb
 import (
 	context "context"
 	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	authenticationpb "go-wind-admin/api/gen/go/authentication/service/v1"
 	userpb "go-wind-admin/api/gen/go/user/service/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -22,6 +23,7 @@ var (
 	_ status.Status
 	_ emptypb.Empty
 	_ userpb.User
+	_ authenticationpb.UserSession
 )
 
 // RegisterRedactedUserProfileServiceServer wraps the UserProfileServiceServer with the redacted server and registers the service in GRPC
@@ -118,3 +120,36 @@ func (s *redactedUserProfileServiceServer) VerifyContact(ctx context.Context, in
 	}
 	return res, err
 }
+
+// ListSessions is the redacted wrapper for the actual UserProfileServiceServer.ListSessions method
+// Unary RPC
+func (s *redactedUserProfileServiceServer) ListSessions(ctx context.Context, in *emptypb.Empty) (*authenticationpb.ListUserSessionResponse, error) {
+	res, err := s.srv.ListSessions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RevokeSession is the redacted wrapper for the actual UserProfileServiceServer.RevokeSession method
+// Unary RPC
+func (s *redactedUserProfileServiceServer) RevokeSession(ctx context.Context, in *authenticationpb.RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.RevokeSession(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RevokeOtherSessions is the redacted wrapper for the actual UserProfileServiceServer.RevokeOtherSessions method
+// Unary RPC
+func (s *redactedUserProfileServiceServer) RevokeOtherSessions(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+	res, err := s.srv.RevokeOtherSessions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
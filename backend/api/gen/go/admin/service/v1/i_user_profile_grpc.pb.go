@@ -8,6 +8,7 @@ package adminpb
 
 import (
 	context "context"
+	v11 "go-wind-admin/api/gen/go/authentication/service/v1"
 	v1 "go-wind-admin/api/gen/go/user/service/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -21,13 +22,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserProfileService_GetUser_FullMethodName        = "/admin.service.v1.UserProfileService/GetUser"
-	UserProfileService_UpdateUser_FullMethodName     = "/admin.service.v1.UserProfileService/UpdateUser"
-	UserProfileService_ChangePassword_FullMethodName = "/admin.service.v1.UserProfileService/ChangePassword"
-	UserProfileService_UploadAvatar_FullMethodName   = "/admin.service.v1.UserProfileService/UploadAvatar"
-	UserProfileService_DeleteAvatar_FullMethodName   = "/admin.service.v1.UserProfileService/DeleteAvatar"
-	UserProfileService_BindContact_FullMethodName    = "/admin.service.v1.UserProfileService/BindContact"
-	UserProfileService_VerifyContact_FullMethodName  = "/admin.service.v1.UserProfileService/VerifyContact"
+	UserProfileService_GetUser_FullMethodName             = "/admin.service.v1.UserProfileService/GetUser"
+	UserProfileService_UpdateUser_FullMethodName          = "/admin.service.v1.UserProfileService/UpdateUser"
+	UserProfileService_ChangePassword_FullMethodName      = "/admin.service.v1.UserProfileService/ChangePassword"
+	UserProfileService_UploadAvatar_FullMethodName        = "/admin.service.v1.UserProfileService/UploadAvatar"
+	UserProfileService_DeleteAvatar_FullMethodName        = "/admin.service.v1.UserProfileService/DeleteAvatar"
+	UserProfileService_BindContact_FullMethodName         = "/admin.service.v1.UserProfileService/BindContact"
+	UserProfileService_VerifyContact_FullMethodName       = "/admin.service.v1.UserProfileService/VerifyContact"
+	UserProfileService_ListSessions_FullMethodName        = "/admin.service.v1.UserProfileService/ListSessions"
+	UserProfileService_RevokeSession_FullMethodName       = "/admin.service.v1.UserProfileService/RevokeSession"
+	UserProfileService_RevokeOtherSessions_FullMethodName = "/admin.service.v1.UserProfileService/RevokeOtherSessions"
 )
 
 // UserProfileServiceClient is the client API for UserProfileService service.
@@ -50,6 +54,12 @@ type UserProfileServiceClient interface {
 	BindContact(ctx context.Context, in *v1.BindContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 验证手机号码/邮箱
 	VerifyContact(ctx context.Context, in *v1.VerifyContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查询本人的登录会话
+	ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v11.ListUserSessionResponse, error)
+	// 注销本人的指定会话
+	RevokeSession(ctx context.Context, in *v11.RevokeUserSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 注销本人除当前会话以外的全部会话
+	RevokeOtherSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type userProfileServiceClient struct {
@@ -130,6 +140,36 @@ func (c *userProfileServiceClient) VerifyContact(ctx context.Context, in *v1.Ver
 	return out, nil
 }
 
+func (c *userProfileServiceClient) ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v11.ListUserSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ListUserSessionResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) RevokeSession(ctx context.Context, in *v11.RevokeUserSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserProfileService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) RevokeOtherSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserProfileService_RevokeOtherSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserProfileServiceServer is the server API for UserProfileService service.
 // All implementations must embed UnimplementedUserProfileServiceServer
 // for forward compatibility.
@@ -150,6 +190,12 @@ type UserProfileServiceServer interface {
 	BindContact(context.Context, *v1.BindContactRequest) (*emptypb.Empty, error)
 	// 验证手机号码/邮箱
 	VerifyContact(context.Context, *v1.VerifyContactRequest) (*emptypb.Empty, error)
+	// 查询本人的登录会话
+	ListSessions(context.Context, *emptypb.Empty) (*v11.ListUserSessionResponse, error)
+	// 注销本人的指定会话
+	RevokeSession(context.Context, *v11.RevokeUserSessionRequest) (*emptypb.Empty, error)
+	// 注销本人除当前会话以外的全部会话
+	RevokeOtherSessions(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUserProfileServiceServer()
 }
 
@@ -181,6 +227,15 @@ func (UnimplementedUserProfileServiceServer) BindContact(context.Context, *v1.Bi
 func (UnimplementedUserProfileServiceServer) VerifyContact(context.Context, *v1.VerifyContactRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method VerifyContact not implemented")
 }
+func (UnimplementedUserProfileServiceServer) ListSessions(context.Context, *emptypb.Empty) (*v11.ListUserSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUserProfileServiceServer) RevokeSession(context.Context, *v11.RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserProfileServiceServer) RevokeOtherSessions(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeOtherSessions not implemented")
+}
 func (UnimplementedUserProfileServiceServer) mustEmbedUnimplementedUserProfileServiceServer() {}
 func (UnimplementedUserProfileServiceServer) testEmbeddedByValue()                            {}
 
@@ -328,6 +383,60 @@ func _UserProfileService_VerifyContact_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserProfileService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).ListSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.RevokeUserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).RevokeSession(ctx, req.(*v11.RevokeUserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_RevokeOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).RevokeOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_RevokeOtherSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).RevokeOtherSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserProfileService_ServiceDesc is the grpc.ServiceDesc for UserProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -363,6 +472,18 @@ var UserProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyContact",
 			Handler:    _UserProfileService_VerifyContact_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _UserProfileService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserProfileService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeOtherSessions",
+			Handler:    _UserProfileService_RevokeOtherSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_user_profile.proto",
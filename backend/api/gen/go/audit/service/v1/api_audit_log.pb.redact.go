@@ -99,6 +99,10 @@ func (x *ApiAuditLog) Redact() string {
 
 	// Safe field: Username
 
+	// Safe field: ActorUserId
+
+	// Safe field: ActorUsername
+
 	// Safe field: IpAddress
 
 	// Safe field: GeoLocation
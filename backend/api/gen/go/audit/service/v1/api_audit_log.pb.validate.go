@@ -77,6 +77,14 @@ func (m *ApiAuditLog) validate(all bool) error {
 		// no validation rules for Username
 	}
 
+	if m.ActorUserId != nil {
+		// no validation rules for ActorUserId
+	}
+
+	if m.ActorUsername != nil {
+		// no validation rules for ActorUsername
+	}
+
 	if m.IpAddress != nil {
 		// no validation rules for IpAddress
 	}
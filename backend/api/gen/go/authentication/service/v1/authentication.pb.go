@@ -363,16 +363,17 @@ func (x *LoginRequest) GetDeviceId() string {
 
 // 用户后台登录 - 回应
 type LoginResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	TokenType        TokenType              `protobuf:"varint,1,opt,name=token_type,proto3,enum=authentication.service.v1.TokenType" json:"token_type,omitempty"` // 令牌类型，该值大小写不敏感，必选项，可以是bearer类型或mac类型。
-	AccessToken      string                 `protobuf:"bytes,2,opt,name=access_token,proto3" json:"access_token,omitempty"`                                       // 访问令牌，必选项。
-	ExpiresIn        int64                  `protobuf:"varint,3,opt,name=expires_in,proto3" json:"expires_in,omitempty"`                                          // 访问令牌过期时间（秒）
-	RefreshToken     *string                `protobuf:"bytes,4,opt,name=refresh_token,proto3,oneof" json:"refresh_token,omitempty"`                               // 更新令牌，用来获取下一次的访问令牌，可选项。
-	Scope            *string                `protobuf:"bytes,5,opt,name=scope,proto3,oneof" json:"scope,omitempty"`                                               // 以空格分隔的用户授予范围列表。如果未提供，scope则授权任何范围，默认为空列表。
-	RefreshExpiresIn *int64                 `protobuf:"varint,6,opt,name=refresh_expires_in,proto3,oneof" json:"refresh_expires_in,omitempty"`                    // 刷新令牌过期时间（秒）
-	IdToken          *string                `protobuf:"bytes,7,opt,name=id_token,proto3,oneof" json:"id_token,omitempty"`                                         // ID 令牌，OpenID Connect 扩展中定义的 JWT 格式令牌
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TokenType              TokenType              `protobuf:"varint,1,opt,name=token_type,proto3,enum=authentication.service.v1.TokenType" json:"token_type,omitempty"` // 令牌类型，该值大小写不敏感，必选项，可以是bearer类型或mac类型。
+	AccessToken            string                 `protobuf:"bytes,2,opt,name=access_token,proto3" json:"access_token,omitempty"`                                       // 访问令牌，必选项。
+	ExpiresIn              int64                  `protobuf:"varint,3,opt,name=expires_in,proto3" json:"expires_in,omitempty"`                                          // 访问令牌过期时间（秒）
+	RefreshToken           *string                `protobuf:"bytes,4,opt,name=refresh_token,proto3,oneof" json:"refresh_token,omitempty"`                               // 更新令牌，用来获取下一次的访问令牌，可选项。
+	Scope                  *string                `protobuf:"bytes,5,opt,name=scope,proto3,oneof" json:"scope,omitempty"`                                               // 以空格分隔的用户授予范围列表。如果未提供，scope则授权任何范围，默认为空列表。
+	RefreshExpiresIn       *int64                 `protobuf:"varint,6,opt,name=refresh_expires_in,proto3,oneof" json:"refresh_expires_in,omitempty"`                    // 刷新令牌过期时间（秒）
+	IdToken                *string                `protobuf:"bytes,7,opt,name=id_token,proto3,oneof" json:"id_token,omitempty"`                                         // ID 令牌，OpenID Connect 扩展中定义的 JWT 格式令牌
+	PasswordChangeRequired *bool                  `protobuf:"varint,8,opt,name=password_change_required,proto3,oneof" json:"password_change_required,omitempty"`        // 须先修改密码
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
@@ -454,6 +455,13 @@ func (x *LoginResponse) GetIdToken() string {
 	return ""
 }
 
+func (x *LoginResponse) GetPasswordChangeRequired() bool {
+	if x != nil && x.PasswordChangeRequired != nil {
+		return *x.PasswordChangeRequired
+	}
+	return false
+}
+
 // 用户登出 - 请求
 type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -631,10 +639,12 @@ func (x *ValidateTokenResponse) GetClaim() *UserTokenPayload {
 
 type RegisterUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`                       // 用户名
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                       // 登入密码
-	TenantCode    string                 `protobuf:"bytes,3,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"` // 租户代码
-	Email         *string                `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`                       // 电子邮件地址
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`                                   // 用户名
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                                   // 登入密码
+	TenantCode    string                 `protobuf:"bytes,3,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`             // 租户代码
+	Email         *string                `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`                                   // 电子邮件地址
+	CaptchaToken  *string                `protobuf:"bytes,5,opt,name=captcha_token,json=captchaToken,proto3,oneof" json:"captcha_token,omitempty"` // 人机验证令牌
+	InviteCode    *string                `protobuf:"bytes,6,opt,name=invite_code,json=inviteCode,proto3,oneof" json:"invite_code,omitempty"`       // 邀请码
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -697,11 +707,26 @@ func (x *RegisterUserRequest) GetEmail() string {
 	return ""
 }
 
+func (x *RegisterUserRequest) GetCaptchaToken() string {
+	if x != nil && x.CaptchaToken != nil {
+		return *x.CaptchaToken
+	}
+	return ""
+}
+
+func (x *RegisterUserRequest) GetInviteCode() string {
+	if x != nil && x.InviteCode != nil {
+		return *x.InviteCode
+	}
+	return ""
+}
+
 type RegisterUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActivationRequired bool                   `protobuf:"varint,2,opt,name=activation_required,json=activationRequired,proto3" json:"activation_required,omitempty"` // 是否需要激活
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *RegisterUserResponse) Reset() {
@@ -741,18 +766,309 @@ func (x *RegisterUserResponse) GetUserId() uint32 {
 	return 0
 }
 
+func (x *RegisterUserResponse) GetActivationRequired() bool {
+	if x != nil {
+		return x.ActivationRequired
+	}
+	return false
+}
+
+// 激活注册的用户 - 请求
+type ConfirmRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"` // 邮箱地址
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`   // 验证码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmRegistrationRequest) Reset() {
+	*x = ConfirmRegistrationRequest{}
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRegistrationRequest) ProtoMessage() {}
+
+func (x *ConfirmRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConfirmRegistrationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ConfirmRegistrationRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// 重新发送注册激活验证码 - 请求
+type ResendRegistrationCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"` // 邮箱地址
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendRegistrationCodeRequest) Reset() {
+	*x = ResendRegistrationCodeRequest{}
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendRegistrationCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendRegistrationCodeRequest) ProtoMessage() {}
+
+func (x *ResendRegistrationCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendRegistrationCodeRequest.ProtoReflect.Descriptor instead.
+func (*ResendRegistrationCodeRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResendRegistrationCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// 忘记密码 - 请求
+type RequestPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*RequestPasswordResetRequest_Email
+	//	*RequestPasswordResetRequest_Phone
+	Contact       isRequestPasswordResetRequest_Contact `protobuf_oneof:"contact"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RequestPasswordResetRequest) GetContact() isRequestPasswordResetRequest_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Contact.(*RequestPasswordResetRequest_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetRequest) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Contact.(*RequestPasswordResetRequest_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+type isRequestPasswordResetRequest_Contact interface {
+	isRequestPasswordResetRequest_Contact()
+}
+
+type RequestPasswordResetRequest_Email struct {
+	Email string `protobuf:"bytes,1,opt,name=email,proto3,oneof"` // 邮箱地址
+}
+
+type RequestPasswordResetRequest_Phone struct {
+	Phone string `protobuf:"bytes,2,opt,name=phone,proto3,oneof"` // 手机号码
+}
+
+func (*RequestPasswordResetRequest_Email) isRequestPasswordResetRequest_Contact() {}
+
+func (*RequestPasswordResetRequest_Phone) isRequestPasswordResetRequest_Contact() {}
+
+// 重置密码 - 请求
+type ConfirmPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*ConfirmPasswordResetRequest_Email
+	//	*ConfirmPasswordResetRequest_Phone
+	Contact       isConfirmPasswordResetRequest_Contact `protobuf_oneof:"contact"`
+	Code          string                                `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`                                  // 验证码
+	NewPassword   string                                `protobuf:"bytes,4,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"` // 新密码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetRequest) Reset() {
+	*x = ConfirmPasswordResetRequest{}
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetRequest) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConfirmPasswordResetRequest) GetContact() isConfirmPasswordResetRequest_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *ConfirmPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Contact.(*ConfirmPasswordResetRequest_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Contact.(*ConfirmPasswordResetRequest_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type isConfirmPasswordResetRequest_Contact interface {
+	isConfirmPasswordResetRequest_Contact()
+}
+
+type ConfirmPasswordResetRequest_Email struct {
+	Email string `protobuf:"bytes,1,opt,name=email,proto3,oneof"` // 邮箱地址
+}
+
+type ConfirmPasswordResetRequest_Phone struct {
+	Phone string `protobuf:"bytes,2,opt,name=phone,proto3,oneof"` // 手机号码
+}
+
+func (*ConfirmPasswordResetRequest_Email) isConfirmPasswordResetRequest_Contact() {}
+
+func (*ConfirmPasswordResetRequest_Phone) isConfirmPasswordResetRequest_Contact() {}
+
 // 获取当前用户身份信息 - 响应
 type WhoAmIResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=uid,proto3" json:"user_id,omitempty"` // 用户ID
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`         // 当前用户的用户名
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=uid,proto3" json:"user_id,omitempty"`                // 用户ID
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`                        // 当前用户的用户名
+	TenantId      *uint32                `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"` // 租户ID
+	Impersonated  bool                   `protobuf:"varint,4,opt,name=impersonated,proto3" json:"impersonated,omitempty"`               // 是否为模拟登录
+	Actor         *TokenActor            `protobuf:"bytes,5,opt,name=actor,proto3,oneof" json:"actor,omitempty"`                        // 模拟登录的实际操作者
+	ReadOnly      bool                   `protobuf:"varint,6,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`       // 是否只读
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WhoAmIResponse) Reset() {
 	*x = WhoAmIResponse{}
-	mi := &file_authentication_service_v1_authentication_proto_msgTypes[7]
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -764,7 +1080,7 @@ func (x *WhoAmIResponse) String() string {
 func (*WhoAmIResponse) ProtoMessage() {}
 
 func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_authentication_proto_msgTypes[7]
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,7 +1093,7 @@ func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhoAmIResponse.ProtoReflect.Descriptor instead.
 func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{7}
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WhoAmIResponse) GetUserId() uint32 {
@@ -794,6 +1110,87 @@ func (x *WhoAmIResponse) GetUsername() string {
 	return ""
 }
 
+func (x *WhoAmIResponse) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *WhoAmIResponse) GetImpersonated() bool {
+	if x != nil {
+		return x.Impersonated
+	}
+	return false
+}
+
+func (x *WhoAmIResponse) GetActor() *TokenActor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+// 模拟登录 - 请求
+type ImpersonateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 被模拟的用户ID
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                // 模拟登录的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateRequest) Reset() {
+	*x = ImpersonateRequest{}
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateRequest) ProtoMessage() {}
+
+func (x *ImpersonateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonateRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImpersonateRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImpersonateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type GetAccessTokensRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                       // 用户ID
@@ -804,7 +1201,7 @@ type GetAccessTokensRequest struct {
 
 func (x *GetAccessTokensRequest) Reset() {
 	*x = GetAccessTokensRequest{}
-	mi := &file_authentication_service_v1_authentication_proto_msgTypes[8]
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -816,7 +1213,7 @@ func (x *GetAccessTokensRequest) String() string {
 func (*GetAccessTokensRequest) ProtoMessage() {}
 
 func (x *GetAccessTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_authentication_proto_msgTypes[8]
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +1226,7 @@ func (x *GetAccessTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccessTokensRequest.ProtoReflect.Descriptor instead.
 func (*GetAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{8}
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetAccessTokensRequest) GetUserId() uint32 {
@@ -855,7 +1252,7 @@ type GetAccessTokensResponse struct {
 
 func (x *GetAccessTokensResponse) Reset() {
 	*x = GetAccessTokensResponse{}
-	mi := &file_authentication_service_v1_authentication_proto_msgTypes[9]
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -867,7 +1264,7 @@ func (x *GetAccessTokensResponse) String() string {
 func (*GetAccessTokensResponse) ProtoMessage() {}
 
 func (x *GetAccessTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_authentication_proto_msgTypes[9]
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,7 +1277,7 @@ func (x *GetAccessTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccessTokensResponse.ProtoReflect.Descriptor instead.
 func (*GetAccessTokensResponse) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{9}
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetAccessTokensResponse) GetAccessTokens() []string {
@@ -926,7 +1323,8 @@ const file_authentication_service_v1_authentication_proto_rawDesc = "" +
 	"\x05_codeB\x0e\n" +
 	"\f_client_typeB\f\n" +
 	"\n" +
-	"_device_id\"\x8c\t\n" +
+	"_device_id\"\xc1\n" +
+	"\n" +
 	"\rLoginResponse\x12\xdb\x01\n" +
 	"\n" +
 	"token_type\x18\x01 \x01(\x0e2$.authentication.service.v1.TokenTypeB\x94\x01\xbaG\x90\x01\x8a\x02\b\x1a\x06Bearer\x92\x02\x81\x01令牌的类型，该值大小写不敏感，必选项，可以是bearer类型或mac类型，通常只是字符串“Bearer”。R\n" +
@@ -938,11 +1336,13 @@ const file_authentication_service_v1_authentication_proto_rawDesc = "" +
 	"\rrefresh_token\x18\x04 \x01(\tB\x96\x02\xbaG\x92\x02\x92\x02\x8e\x02更新令牌，用来获取下一次的访问令牌，可选项。如果访问令牌将过期，则返回刷新令牌很有用，应用程序可以使用该刷新令牌来获取另一个访问令牌。但是，通过隐式授予颁发的令牌不能颁发刷新令牌。H\x00R\rrefresh_token\x88\x01\x01\x12\x92\x01\n" +
 	"\x05scope\x18\x05 \x01(\tBw\xbaGt\x92\x02q以空格分隔的用户授予范围列表。如果未提供，scope则授权任何范围，默认为空列表。H\x01R\x05scope\x88\x01\x01\x12\\\n" +
 	"\x12refresh_expires_in\x18\x06 \x01(\x03B'\xbaG$\x92\x02!刷新令牌过期时间（秒）H\x02R\x12refresh_expires_in\x88\x01\x01\x12e\n" +
-	"\bid_token\x18\a \x01(\tBD\xbaGA\x92\x02>ID 令牌，OpenID Connect 扩展中定义的 JWT 格式令牌H\x03R\bid_token\x88\x01\x01B\x10\n" +
+	"\bid_token\x18\a \x01(\tBD\xbaGA\x92\x02>ID 令牌，OpenID Connect 扩展中定义的 JWT 格式令牌H\x03R\bid_token\x88\x01\x01\x12\x95\x01\n" +
+	"\x18password_change_required\x18\b \x01(\bBT\xbaGQ\x92\x02N密码已超过最长使用期限，须先修改密码，修改后重新登录H\x04R\x18password_change_required\x88\x01\x01B\x10\n" +
 	"\x0e_refresh_tokenB\b\n" +
 	"\x06_scopeB\x15\n" +
 	"\x13_refresh_expires_inB\v\n" +
-	"\t_id_token\"\x97\x01\n" +
+	"\t_id_tokenB\x1b\n" +
+	"\x19_password_change_required\"\x97\x01\n" +
 	"\rLogoutRequest\x12'\n" +
 	"\auser_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x06userId\x12]\n" +
 	"\vclient_type\x18\x02 \x01(\x0e2%.authentication.service.v1.ClientTypeB\x15\xbaG\x12\x92\x02\x0f客户端类型R\n" +
@@ -956,19 +1356,50 @@ const file_authentication_service_v1_authentication_proto_rawDesc = "" +
 	"\x15ValidateTokenResponse\x123\n" +
 	"\bis_valid\x18\x01 \x01(\bB\x18\xbaG\x15\x92\x02\x12令牌是否有效R\aisValid\x12`\n" +
 	"\x05claim\x18\x02 \x01(\v2+.authentication.service.v1.UserTokenPayloadB\x18\xbaG\x15\x92\x02\x12用户令牌载体H\x00R\x05claim\x88\x01\x01B\b\n" +
-	"\x06_claim\"\xe6\x01\n" +
+	"\x06_claim\"\x93\x04\n" +
 	"\x13RegisterUserRequest\x12+\n" +
 	"\busername\x18\x01 \x01(\tB\x0f\xbaG\f\x92\x02\t用户名R\busername\x12.\n" +
 	"\bpassword\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f登入密码R\bpassword\x123\n" +
 	"\vtenant_code\x18\x03 \x01(\tB\x12\xbaG\x0f\x92\x02\f租户代码R\n" +
-	"tenantCode\x123\n" +
-	"\x05email\x18\x04 \x01(\tB\x18\xbaG\x15\x92\x02\x12电子邮件地址H\x00R\x05email\x88\x01\x01B\b\n" +
-	"\x06_email\"/\n" +
+	"tenantCode\x12l\n" +
+	"\x05email\x18\x04 \x01(\tBQ\xbaGN\x92\x02K电子邮件地址，租户要求邮箱验证或限制邮箱域名时必填H\x00R\x05email\x88\x01\x01\x12f\n" +
+	"\rcaptcha_token\x18\x05 \x01(\tB<\xbaG9\x92\x026人机验证令牌，租户要求人机验证时必填H\x01R\fcaptchaToken\x88\x01\x01\x12h\n" +
+	"\vinvite_code\x18\x06 \x01(\tBB\xbaG3\x92\x020邀请码，租户仅允许邀请注册时必填ڶ\x1a\bz\x06******H\x02R\n" +
+	"inviteCode\x88\x01\x01B\b\n" +
+	"\x06_emailB\x10\n" +
+	"\x0e_captcha_tokenB\x0e\n" +
+	"\f_invite_code\"\xa1\x01\n" +
 	"\x14RegisterUserResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\rR\x06userId\"r\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12p\n" +
+	"\x13activation_required\x18\x02 \x01(\bB?\xbaG<\x92\x029是否需要先通过邮箱验证码激活后才能登录R\x12activationRequired\"\x9e\x01\n" +
+	"\x1aConfirmRegistrationRequest\x12=\n" +
+	"\x05email\x18\x01 \x01(\tB'\xe0A\x02\xbaG!\x92\x02\x1e注册时填写的邮箱地址R\x05email\x12A\n" +
+	"\x04code\x18\x02 \x01(\tB-\xe0A\x02\xbaG\x1b\x92\x02\x18邮箱收到的验证码ڶ\x1a\bz\x06******R\x04code\"^\n" +
+	"\x1dResendRegistrationCodeRequest\x12=\n" +
+	"\x05email\x18\x01 \x01(\tB'\xe0A\x02\xbaG!\x92\x02\x1e注册时填写的邮箱地址R\x05email\"\x9e\x01\n" +
+	"\x1bRequestPasswordResetRequest\x129\n" +
+	"\x05email\x18\x01 \x01(\tB!\xbaG\x1e\x92\x02\x1b用户绑定的邮箱地址H\x00R\x05email\x129\n" +
+	"\x05phone\x18\x02 \x01(\tB!\xbaG\x1e\x92\x02\x1b用户绑定的手机号码H\x00R\x05phoneB\t\n" +
+	"\acontact\"\xb9\x02\n" +
+	"\x1bConfirmPasswordResetRequest\x12<\n" +
+	"\x05email\x18\x01 \x01(\tB$\xbaG!\x92\x02\x1e接收验证码的邮箱地址H\x00R\x05email\x12<\n" +
+	"\x05phone\x18\x02 \x01(\tB$\xbaG!\x92\x02\x1e接收验证码的手机号码H\x00R\x05phone\x12P\n" +
+	"\x04code\x18\x03 \x01(\tB<\xe0A\x02\xbaG*\x92\x02'验证码，或重置链接中的 tokenڶ\x1a\bz\x06******R\x04code\x12A\n" +
+	"\fnew_password\x18\x04 \x01(\tB\x1e\xe0A\x02\xbaG\f\x92\x02\t新密码ڶ\x1a\bz\x06******R\vnewPasswordB\t\n" +
+	"\acontact\"\xb4\x03\n" +
 	"\x0eWhoAmIResponse\x12$\n" +
 	"\auser_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x03uid\x12:\n" +
-	"\busername\x18\x02 \x01(\tB\x1e\xbaG\x1b\x92\x02\x18当前用户的用户名R\busername\"\xa0\x01\n" +
+	"\busername\x18\x02 \x01(\tB\x1e\xbaG\x1b\x92\x02\x18当前用户的用户名R\busername\x120\n" +
+	"\ttenant_id\x18\x03 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x00R\btenantId\x88\x01\x01\x12?\n" +
+	"\fimpersonated\x18\x04 \x01(\bB\x1b\xbaG\x18\x92\x02\x15是否为模拟登录R\fimpersonated\x12f\n" +
+	"\x05actor\x18\x05 \x01(\v2%.authentication.service.v1.TokenActorB$\xbaG!\x92\x02\x1e模拟登录的实际操作者H\x01R\x05actor\x88\x01\x01\x12M\n" +
+	"\tread_only\x18\x06 \x01(\bB0\xbaG-\x92\x02*当前令牌是否只允许查询类请求R\breadOnlyB\f\n" +
+	"\n" +
+	"_tenant_idB\b\n" +
+	"\x06_actor\"\xd2\x01\n" +
+	"\x12ImpersonateRequest\x12Z\n" +
+	"\auser_id\x18\x01 \x01(\rBA\xe0A\x02\xbaG;\x92\x028被模拟的用户ID，须与操作者属于同一租户R\x06userId\x12`\n" +
+	"\x06reason\x18\x02 \x01(\tBH\xe0A\x02\xbaGB\x92\x02?模拟登录的原因，如工单号，记录在审计日志中R\x06reason\"\xa0\x01\n" +
 	"\x16GetAccessTokensRequest\x12'\n" +
 	"\auser_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x06userId\x12]\n" +
 	"\vclient_type\x18\x02 \x01(\x0e2%.authentication.service.v1.ClientTypeB\x15\xbaG\x12\x92\x02\x0f客户端类型R\n" +
@@ -993,15 +1424,20 @@ const file_authentication_service_v1_authentication_proto_rawDesc = "" +
 	"\x1aTOKEN_CATEGORY_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06ACCESS\x10\x01\x12\v\n" +
-	"\aREFRESH\x10\x022\xdc\x05\n" +
+	"\aREFRESH\x10\x022\xf0\t\n" +
 	"\x15AuthenticationService\x12\\\n" +
 	"\x05Login\x12'.authentication.service.v1.LoginRequest\x1a(.authentication.service.v1.LoginResponse\"\x00\x12L\n" +
 	"\x06Logout\x12(.authentication.service.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\"\x00\x12q\n" +
-	"\fRegisterUser\x12..authentication.service.v1.RegisterUserRequest\x1a/.authentication.service.v1.RegisterUserResponse\"\x00\x12c\n" +
+	"\fRegisterUser\x12..authentication.service.v1.RegisterUserRequest\x1a/.authentication.service.v1.RegisterUserResponse\"\x00\x12f\n" +
+	"\x13ConfirmRegistration\x125.authentication.service.v1.ConfirmRegistrationRequest\x1a\x16.google.protobuf.Empty\"\x00\x12l\n" +
+	"\x16ResendRegistrationCode\x128.authentication.service.v1.ResendRegistrationCodeRequest\x1a\x16.google.protobuf.Empty\"\x00\x12c\n" +
 	"\fRefreshToken\x12'.authentication.service.v1.LoginRequest\x1a(.authentication.service.v1.LoginResponse\"\x00\x12t\n" +
 	"\rValidateToken\x12/.authentication.service.v1.ValidateTokenRequest\x1a0.authentication.service.v1.ValidateTokenResponse\"\x00\x12z\n" +
 	"\x0fGetAccessTokens\x121.authentication.service.v1.GetAccessTokensRequest\x1a2.authentication.service.v1.GetAccessTokensResponse\"\x00\x12M\n" +
-	"\x06WhoAmI\x12\x16.google.protobuf.Empty\x1a).authentication.service.v1.WhoAmIResponse\"\x00B\xff\x01\n" +
+	"\x06WhoAmI\x12\x16.google.protobuf.Empty\x1a).authentication.service.v1.WhoAmIResponse\"\x00\x12h\n" +
+	"\vImpersonate\x12-.authentication.service.v1.ImpersonateRequest\x1a(.authentication.service.v1.LoginResponse\"\x00\x12h\n" +
+	"\x14RequestPasswordReset\x126.authentication.service.v1.RequestPasswordResetRequest\x1a\x16.google.protobuf.Empty\"\x00\x12h\n" +
+	"\x14ConfirmPasswordReset\x126.authentication.service.v1.ConfirmPasswordResetRequest\x1a\x16.google.protobuf.Empty\"\x00B\xff\x01\n" +
 	"\x1dcom.authentication.service.v1B\x13AuthenticationProtoP\x01ZCgo-wind-admin/api/gen/go/authentication/service/v1;authenticationpb\xa2\x02\x03ASX\xaa\x02\x19Authentication.Service.V1\xca\x02\x19Authentication\\Service\\V1\xe2\x02%Authentication\\Service\\V1\\GPBMetadata\xea\x02\x1bAuthentication::Service::V1b\x06proto3"
 
 var (
@@ -1017,24 +1453,30 @@ func file_authentication_service_v1_authentication_proto_rawDescGZIP() []byte {
 }
 
 var file_authentication_service_v1_authentication_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_authentication_service_v1_authentication_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_authentication_service_v1_authentication_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_authentication_service_v1_authentication_proto_goTypes = []any{
-	(GrantType)(0),                  // 0: authentication.service.v1.GrantType
-	(TokenType)(0),                  // 1: authentication.service.v1.TokenType
-	(ClientType)(0),                 // 2: authentication.service.v1.ClientType
-	(TokenCategory)(0),              // 3: authentication.service.v1.TokenCategory
-	(*LoginRequest)(nil),            // 4: authentication.service.v1.LoginRequest
-	(*LoginResponse)(nil),           // 5: authentication.service.v1.LoginResponse
-	(*LogoutRequest)(nil),           // 6: authentication.service.v1.LogoutRequest
-	(*ValidateTokenRequest)(nil),    // 7: authentication.service.v1.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),   // 8: authentication.service.v1.ValidateTokenResponse
-	(*RegisterUserRequest)(nil),     // 9: authentication.service.v1.RegisterUserRequest
-	(*RegisterUserResponse)(nil),    // 10: authentication.service.v1.RegisterUserResponse
-	(*WhoAmIResponse)(nil),          // 11: authentication.service.v1.WhoAmIResponse
-	(*GetAccessTokensRequest)(nil),  // 12: authentication.service.v1.GetAccessTokensRequest
-	(*GetAccessTokensResponse)(nil), // 13: authentication.service.v1.GetAccessTokensResponse
-	(*UserTokenPayload)(nil),        // 14: authentication.service.v1.UserTokenPayload
-	(*emptypb.Empty)(nil),           // 15: google.protobuf.Empty
+	(GrantType)(0),                        // 0: authentication.service.v1.GrantType
+	(TokenType)(0),                        // 1: authentication.service.v1.TokenType
+	(ClientType)(0),                       // 2: authentication.service.v1.ClientType
+	(TokenCategory)(0),                    // 3: authentication.service.v1.TokenCategory
+	(*LoginRequest)(nil),                  // 4: authentication.service.v1.LoginRequest
+	(*LoginResponse)(nil),                 // 5: authentication.service.v1.LoginResponse
+	(*LogoutRequest)(nil),                 // 6: authentication.service.v1.LogoutRequest
+	(*ValidateTokenRequest)(nil),          // 7: authentication.service.v1.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),         // 8: authentication.service.v1.ValidateTokenResponse
+	(*RegisterUserRequest)(nil),           // 9: authentication.service.v1.RegisterUserRequest
+	(*RegisterUserResponse)(nil),          // 10: authentication.service.v1.RegisterUserResponse
+	(*ConfirmRegistrationRequest)(nil),    // 11: authentication.service.v1.ConfirmRegistrationRequest
+	(*ResendRegistrationCodeRequest)(nil), // 12: authentication.service.v1.ResendRegistrationCodeRequest
+	(*RequestPasswordResetRequest)(nil),   // 13: authentication.service.v1.RequestPasswordResetRequest
+	(*ConfirmPasswordResetRequest)(nil),   // 14: authentication.service.v1.ConfirmPasswordResetRequest
+	(*WhoAmIResponse)(nil),                // 15: authentication.service.v1.WhoAmIResponse
+	(*ImpersonateRequest)(nil),            // 16: authentication.service.v1.ImpersonateRequest
+	(*GetAccessTokensRequest)(nil),        // 17: authentication.service.v1.GetAccessTokensRequest
+	(*GetAccessTokensResponse)(nil),       // 18: authentication.service.v1.GetAccessTokensResponse
+	(*UserTokenPayload)(nil),              // 19: authentication.service.v1.UserTokenPayload
+	(*TokenActor)(nil),                    // 20: authentication.service.v1.TokenActor
+	(*emptypb.Empty)(nil),                 // 21: google.protobuf.Empty
 }
 var file_authentication_service_v1_authentication_proto_depIdxs = []int32{
 	0,  // 0: authentication.service.v1.LoginRequest.grant_type:type_name -> authentication.service.v1.GrantType
@@ -1043,27 +1485,38 @@ var file_authentication_service_v1_authentication_proto_depIdxs = []int32{
 	2,  // 3: authentication.service.v1.LogoutRequest.client_type:type_name -> authentication.service.v1.ClientType
 	2,  // 4: authentication.service.v1.ValidateTokenRequest.client_type:type_name -> authentication.service.v1.ClientType
 	3,  // 5: authentication.service.v1.ValidateTokenRequest.token_category:type_name -> authentication.service.v1.TokenCategory
-	14, // 6: authentication.service.v1.ValidateTokenResponse.claim:type_name -> authentication.service.v1.UserTokenPayload
-	2,  // 7: authentication.service.v1.GetAccessTokensRequest.client_type:type_name -> authentication.service.v1.ClientType
-	4,  // 8: authentication.service.v1.AuthenticationService.Login:input_type -> authentication.service.v1.LoginRequest
-	6,  // 9: authentication.service.v1.AuthenticationService.Logout:input_type -> authentication.service.v1.LogoutRequest
-	9,  // 10: authentication.service.v1.AuthenticationService.RegisterUser:input_type -> authentication.service.v1.RegisterUserRequest
-	4,  // 11: authentication.service.v1.AuthenticationService.RefreshToken:input_type -> authentication.service.v1.LoginRequest
-	7,  // 12: authentication.service.v1.AuthenticationService.ValidateToken:input_type -> authentication.service.v1.ValidateTokenRequest
-	12, // 13: authentication.service.v1.AuthenticationService.GetAccessTokens:input_type -> authentication.service.v1.GetAccessTokensRequest
-	15, // 14: authentication.service.v1.AuthenticationService.WhoAmI:input_type -> google.protobuf.Empty
-	5,  // 15: authentication.service.v1.AuthenticationService.Login:output_type -> authentication.service.v1.LoginResponse
-	15, // 16: authentication.service.v1.AuthenticationService.Logout:output_type -> google.protobuf.Empty
-	10, // 17: authentication.service.v1.AuthenticationService.RegisterUser:output_type -> authentication.service.v1.RegisterUserResponse
-	5,  // 18: authentication.service.v1.AuthenticationService.RefreshToken:output_type -> authentication.service.v1.LoginResponse
-	8,  // 19: authentication.service.v1.AuthenticationService.ValidateToken:output_type -> authentication.service.v1.ValidateTokenResponse
-	13, // 20: authentication.service.v1.AuthenticationService.GetAccessTokens:output_type -> authentication.service.v1.GetAccessTokensResponse
-	11, // 21: authentication.service.v1.AuthenticationService.WhoAmI:output_type -> authentication.service.v1.WhoAmIResponse
-	15, // [15:22] is the sub-list for method output_type
-	8,  // [8:15] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	19, // 6: authentication.service.v1.ValidateTokenResponse.claim:type_name -> authentication.service.v1.UserTokenPayload
+	20, // 7: authentication.service.v1.WhoAmIResponse.actor:type_name -> authentication.service.v1.TokenActor
+	2,  // 8: authentication.service.v1.GetAccessTokensRequest.client_type:type_name -> authentication.service.v1.ClientType
+	4,  // 9: authentication.service.v1.AuthenticationService.Login:input_type -> authentication.service.v1.LoginRequest
+	6,  // 10: authentication.service.v1.AuthenticationService.Logout:input_type -> authentication.service.v1.LogoutRequest
+	9,  // 11: authentication.service.v1.AuthenticationService.RegisterUser:input_type -> authentication.service.v1.RegisterUserRequest
+	11, // 12: authentication.service.v1.AuthenticationService.ConfirmRegistration:input_type -> authentication.service.v1.ConfirmRegistrationRequest
+	12, // 13: authentication.service.v1.AuthenticationService.ResendRegistrationCode:input_type -> authentication.service.v1.ResendRegistrationCodeRequest
+	4,  // 14: authentication.service.v1.AuthenticationService.RefreshToken:input_type -> authentication.service.v1.LoginRequest
+	7,  // 15: authentication.service.v1.AuthenticationService.ValidateToken:input_type -> authentication.service.v1.ValidateTokenRequest
+	17, // 16: authentication.service.v1.AuthenticationService.GetAccessTokens:input_type -> authentication.service.v1.GetAccessTokensRequest
+	21, // 17: authentication.service.v1.AuthenticationService.WhoAmI:input_type -> google.protobuf.Empty
+	16, // 18: authentication.service.v1.AuthenticationService.Impersonate:input_type -> authentication.service.v1.ImpersonateRequest
+	13, // 19: authentication.service.v1.AuthenticationService.RequestPasswordReset:input_type -> authentication.service.v1.RequestPasswordResetRequest
+	14, // 20: authentication.service.v1.AuthenticationService.ConfirmPasswordReset:input_type -> authentication.service.v1.ConfirmPasswordResetRequest
+	5,  // 21: authentication.service.v1.AuthenticationService.Login:output_type -> authentication.service.v1.LoginResponse
+	21, // 22: authentication.service.v1.AuthenticationService.Logout:output_type -> google.protobuf.Empty
+	10, // 23: authentication.service.v1.AuthenticationService.RegisterUser:output_type -> authentication.service.v1.RegisterUserResponse
+	21, // 24: authentication.service.v1.AuthenticationService.ConfirmRegistration:output_type -> google.protobuf.Empty
+	21, // 25: authentication.service.v1.AuthenticationService.ResendRegistrationCode:output_type -> google.protobuf.Empty
+	5,  // 26: authentication.service.v1.AuthenticationService.RefreshToken:output_type -> authentication.service.v1.LoginResponse
+	8,  // 27: authentication.service.v1.AuthenticationService.ValidateToken:output_type -> authentication.service.v1.ValidateTokenResponse
+	18, // 28: authentication.service.v1.AuthenticationService.GetAccessTokens:output_type -> authentication.service.v1.GetAccessTokensResponse
+	15, // 29: authentication.service.v1.AuthenticationService.WhoAmI:output_type -> authentication.service.v1.WhoAmIResponse
+	5,  // 30: authentication.service.v1.AuthenticationService.Impersonate:output_type -> authentication.service.v1.LoginResponse
+	21, // 31: authentication.service.v1.AuthenticationService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	21, // 32: authentication.service.v1.AuthenticationService.ConfirmPasswordReset:output_type -> google.protobuf.Empty
+	21, // [21:33] is the sub-list for method output_type
+	9,  // [9:21] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_authentication_service_v1_authentication_proto_init() }
@@ -1076,13 +1529,22 @@ func file_authentication_service_v1_authentication_proto_init() {
 	file_authentication_service_v1_authentication_proto_msgTypes[1].OneofWrappers = []any{}
 	file_authentication_service_v1_authentication_proto_msgTypes[4].OneofWrappers = []any{}
 	file_authentication_service_v1_authentication_proto_msgTypes[5].OneofWrappers = []any{}
+	file_authentication_service_v1_authentication_proto_msgTypes[9].OneofWrappers = []any{
+		(*RequestPasswordResetRequest_Email)(nil),
+		(*RequestPasswordResetRequest_Phone)(nil),
+	}
+	file_authentication_service_v1_authentication_proto_msgTypes[10].OneofWrappers = []any{
+		(*ConfirmPasswordResetRequest_Email)(nil),
+		(*ConfirmPasswordResetRequest_Phone)(nil),
+	}
+	file_authentication_service_v1_authentication_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_authentication_service_v1_authentication_proto_rawDesc), len(file_authentication_service_v1_authentication_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   10,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
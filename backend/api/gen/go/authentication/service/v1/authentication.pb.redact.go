@@ -83,6 +83,28 @@ func (s *redactedAuthenticationServiceServer) RegisterUser(ctx context.Context,
 	return res, err
 }
 
+// ConfirmRegistration is the redacted wrapper for the actual AuthenticationServiceServer.ConfirmRegistration method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) ConfirmRegistration(ctx context.Context, in *ConfirmRegistrationRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.ConfirmRegistration(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ResendRegistrationCode is the redacted wrapper for the actual AuthenticationServiceServer.ResendRegistrationCode method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) ResendRegistrationCode(ctx context.Context, in *ResendRegistrationCodeRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.ResendRegistrationCode(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // RefreshToken is the redacted wrapper for the actual AuthenticationServiceServer.RefreshToken method
 // Unary RPC
 func (s *redactedAuthenticationServiceServer) RefreshToken(ctx context.Context, in *LoginRequest) (*LoginResponse, error) {
@@ -127,6 +149,39 @@ func (s *redactedAuthenticationServiceServer) WhoAmI(ctx context.Context, in *em
 	return res, err
 }
 
+// Impersonate is the redacted wrapper for the actual AuthenticationServiceServer.Impersonate method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) Impersonate(ctx context.Context, in *ImpersonateRequest) (*LoginResponse, error) {
+	res, err := s.srv.Impersonate(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RequestPasswordReset is the redacted wrapper for the actual AuthenticationServiceServer.RequestPasswordReset method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.RequestPasswordReset(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ConfirmPasswordReset is the redacted wrapper for the actual AuthenticationServiceServer.ConfirmPasswordReset method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.ConfirmPasswordReset(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for LoginRequest
 func (x *LoginRequest) Redact() string {
 	if x == nil {
@@ -180,6 +235,8 @@ func (x *LoginResponse) Redact() string {
 	// Safe field: RefreshExpiresIn
 
 	// Safe field: IdToken
+
+	// Safe field: PasswordChangeRequired
 	return x.String()
 }
 
@@ -236,6 +293,12 @@ func (x *RegisterUserRequest) Redact() string {
 	// Safe field: TenantCode
 
 	// Safe field: Email
+
+	// Safe field: CaptchaToken
+
+	// Redacting field: InviteCode
+	InviteCodeTmp := `******`
+	x.InviteCode = &InviteCodeTmp
 	return x.String()
 }
 
@@ -246,6 +309,61 @@ func (x *RegisterUserResponse) Redact() string {
 	}
 
 	// Safe field: UserId
+
+	// Safe field: ActivationRequired
+	return x.String()
+}
+
+// Redact method implementation for ConfirmRegistrationRequest
+func (x *ConfirmRegistrationRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Email
+
+	// Redacting field: Code
+	x.Code = `******`
+	return x.String()
+}
+
+// Redact method implementation for ResendRegistrationCodeRequest
+func (x *ResendRegistrationCodeRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Email
+	return x.String()
+}
+
+// Redact method implementation for RequestPasswordResetRequest
+func (x *RequestPasswordResetRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Email
+
+	// Safe field: Phone
+	return x.String()
+}
+
+// Redact method implementation for ConfirmPasswordResetRequest
+func (x *ConfirmPasswordResetRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Email
+
+	// Safe field: Phone
+
+	// Redacting field: Code
+	x.Code = `******`
+
+	// Redacting field: NewPassword
+	x.NewPassword = `******`
 	return x.String()
 }
 
@@ -258,6 +376,26 @@ func (x *WhoAmIResponse) Redact() string {
 	// Safe field: UserId
 
 	// Safe field: Username
+
+	// Safe field: TenantId
+
+	// Safe field: Impersonated
+
+	// Safe field: Actor
+
+	// Safe field: ReadOnly
+	return x.String()
+}
+
+// Redact method implementation for ImpersonateRequest
+func (x *ImpersonateRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: UserId
+
+	// Safe field: Reason
 	return x.String()
 }
 
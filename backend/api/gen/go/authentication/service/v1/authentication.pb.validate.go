@@ -224,6 +224,10 @@ func (m *LoginResponse) validate(all bool) error {
 		// no validation rules for IdToken
 	}
 
+	if m.PasswordChangeRequired != nil {
+		// no validation rules for PasswordChangeRequired
+	}
+
 	if len(errors) > 0 {
 		return LoginResponseMultiError(errors)
 	}
@@ -685,6 +689,14 @@ func (m *RegisterUserRequest) validate(all bool) error {
 		// no validation rules for Email
 	}
 
+	if m.CaptchaToken != nil {
+		// no validation rules for CaptchaToken
+	}
+
+	if m.InviteCode != nil {
+		// no validation rules for InviteCode
+	}
+
 	if len(errors) > 0 {
 		return RegisterUserRequestMultiError(errors)
 	}
@@ -789,6 +801,8 @@ func (m *RegisterUserResponse) validate(all bool) error {
 
 	// no validation rules for UserId
 
+	// no validation rules for ActivationRequired
+
 	if len(errors) > 0 {
 		return RegisterUserResponseMultiError(errors)
 	}
@@ -869,6 +883,485 @@ var _ interface {
 	ErrorName() string
 } = RegisterUserResponseValidationError{}
 
+// Validate checks the field values on ConfirmRegistrationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmRegistrationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmRegistrationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmRegistrationRequestMultiError, or nil if none found.
+func (m *ConfirmRegistrationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmRegistrationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return ConfirmRegistrationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmRegistrationRequestMultiError is an error wrapping multiple
+// validation errors returned by ConfirmRegistrationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ConfirmRegistrationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmRegistrationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmRegistrationRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmRegistrationRequestValidationError is the validation error returned
+// by ConfirmRegistrationRequest.Validate if the designated constraints aren't met.
+type ConfirmRegistrationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmRegistrationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmRegistrationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmRegistrationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmRegistrationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmRegistrationRequestValidationError) ErrorName() string {
+	return "ConfirmRegistrationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmRegistrationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmRegistrationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmRegistrationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmRegistrationRequestValidationError{}
+
+// Validate checks the field values on ResendRegistrationCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResendRegistrationCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResendRegistrationCodeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResendRegistrationCodeRequestMultiError, or nil if none found.
+func (m *ResendRegistrationCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResendRegistrationCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return ResendRegistrationCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResendRegistrationCodeRequestMultiError is an error wrapping multiple
+// validation errors returned by ResendRegistrationCodeRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ResendRegistrationCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResendRegistrationCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResendRegistrationCodeRequestMultiError) AllErrors() []error { return m }
+
+// ResendRegistrationCodeRequestValidationError is the validation error
+// returned by ResendRegistrationCodeRequest.Validate if the designated
+// constraints aren't met.
+type ResendRegistrationCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResendRegistrationCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResendRegistrationCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResendRegistrationCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResendRegistrationCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResendRegistrationCodeRequestValidationError) ErrorName() string {
+	return "ResendRegistrationCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResendRegistrationCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResendRegistrationCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResendRegistrationCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResendRegistrationCodeRequestValidationError{}
+
+// Validate checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetRequestMultiError, or nil if none found.
+func (m *RequestPasswordResetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Contact.(type) {
+	case *RequestPasswordResetRequest_Email:
+		if v == nil {
+			err := RequestPasswordResetRequestValidationError{
+				field:  "Contact",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Email
+	case *RequestPasswordResetRequest_Phone:
+		if v == nil {
+			err := RequestPasswordResetRequestValidationError{
+				field:  "Contact",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Phone
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return RequestPasswordResetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordResetRequestMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordResetRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RequestPasswordResetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetRequestMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetRequestValidationError is the validation error returned
+// by RequestPasswordResetRequest.Validate if the designated constraints
+// aren't met.
+type RequestPasswordResetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetRequestValidationError) ErrorName() string {
+	return "RequestPasswordResetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetRequestValidationError{}
+
+// Validate checks the field values on ConfirmPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmPasswordResetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmPasswordResetRequestMultiError, or nil if none found.
+func (m *ConfirmPasswordResetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmPasswordResetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for NewPassword
+
+	switch v := m.Contact.(type) {
+	case *ConfirmPasswordResetRequest_Email:
+		if v == nil {
+			err := ConfirmPasswordResetRequestValidationError{
+				field:  "Contact",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Email
+	case *ConfirmPasswordResetRequest_Phone:
+		if v == nil {
+			err := ConfirmPasswordResetRequestValidationError{
+				field:  "Contact",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Phone
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ConfirmPasswordResetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmPasswordResetRequestMultiError is an error wrapping multiple
+// validation errors returned by ConfirmPasswordResetRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ConfirmPasswordResetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmPasswordResetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmPasswordResetRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmPasswordResetRequestValidationError is the validation error returned
+// by ConfirmPasswordResetRequest.Validate if the designated constraints
+// aren't met.
+type ConfirmPasswordResetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmPasswordResetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmPasswordResetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmPasswordResetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmPasswordResetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmPasswordResetRequestValidationError) ErrorName() string {
+	return "ConfirmPasswordResetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmPasswordResetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmPasswordResetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmPasswordResetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmPasswordResetRequestValidationError{}
+
 // Validate checks the field values on WhoAmIResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -895,6 +1388,47 @@ func (m *WhoAmIResponse) validate(all bool) error {
 
 	// no validation rules for Username
 
+	// no validation rules for Impersonated
+
+	// no validation rules for ReadOnly
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.Actor != nil {
+
+		if all {
+			switch v := interface{}(m.GetActor()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WhoAmIResponseValidationError{
+						field:  "Actor",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WhoAmIResponseValidationError{
+						field:  "Actor",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetActor()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WhoAmIResponseValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return WhoAmIResponseMultiError(errors)
 	}
@@ -973,6 +1507,112 @@ var _ interface {
 	ErrorName() string
 } = WhoAmIResponseValidationError{}
 
+// Validate checks the field values on ImpersonateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImpersonateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImpersonateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImpersonateRequestMultiError, or nil if none found.
+func (m *ImpersonateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImpersonateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ImpersonateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImpersonateRequestMultiError is an error wrapping multiple validation errors
+// returned by ImpersonateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImpersonateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImpersonateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImpersonateRequestMultiError) AllErrors() []error { return m }
+
+// ImpersonateRequestValidationError is the validation error returned by
+// ImpersonateRequest.Validate if the designated constraints aren't met.
+type ImpersonateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImpersonateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImpersonateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImpersonateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImpersonateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImpersonateRequestValidationError) ErrorName() string {
+	return "ImpersonateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImpersonateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImpersonateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImpersonateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImpersonateRequestValidationError{}
+
 // Validate checks the field values on GetAccessTokensRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
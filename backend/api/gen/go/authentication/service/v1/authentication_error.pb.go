@@ -27,11 +27,15 @@ type AuthenticationErrorReason int32
 
 const (
 	// 400
-	AuthenticationErrorReason_BAD_REQUEST        AuthenticationErrorReason = 0 // 错误请求
-	AuthenticationErrorReason_INVALID_GRANT_TYPE AuthenticationErrorReason = 1 // 400
-	AuthenticationErrorReason_INVALID_USERID     AuthenticationErrorReason = 2 // 用户ID无效
-	AuthenticationErrorReason_INVALID_TOKEN      AuthenticationErrorReason = 3 // token无效
-	AuthenticationErrorReason_INVALID_PASSWORD   AuthenticationErrorReason = 4 // 密码无效
+	AuthenticationErrorReason_BAD_REQUEST               AuthenticationErrorReason = 0 // 错误请求
+	AuthenticationErrorReason_INVALID_GRANT_TYPE        AuthenticationErrorReason = 1 // 400
+	AuthenticationErrorReason_INVALID_USERID            AuthenticationErrorReason = 2 // 用户ID无效
+	AuthenticationErrorReason_INVALID_TOKEN             AuthenticationErrorReason = 3 // token无效
+	AuthenticationErrorReason_INVALID_PASSWORD          AuthenticationErrorReason = 4 // 密码无效
+	AuthenticationErrorReason_PASSWORD_POLICY_VIOLATION AuthenticationErrorReason = 5 // 密码不符合密码策略，未通过的规则见错误元数据
+	AuthenticationErrorReason_INVALID_VERIFICATION_CODE AuthenticationErrorReason = 6 // 验证码错误或已失效
+	AuthenticationErrorReason_INVALID_CAPTCHA           AuthenticationErrorReason = 7 // 人机验证未通过
+	AuthenticationErrorReason_INVALID_INVITE_CODE       AuthenticationErrorReason = 8 // 邀请码无效、已使用或已过期
 	// 401
 	AuthenticationErrorReason_UNAUTHORIZED            AuthenticationErrorReason = 100 // 未授权
 	AuthenticationErrorReason_USER_FREEZE             AuthenticationErrorReason = 101 // 用户被冻结
@@ -44,7 +48,10 @@ const (
 	// 402
 	AuthenticationErrorReason_PAYMENT_REQUIRED AuthenticationErrorReason = 200 // 需要支付
 	// 403
-	AuthenticationErrorReason_FORBIDDEN AuthenticationErrorReason = 300 // 禁止访问
+	AuthenticationErrorReason_FORBIDDEN                AuthenticationErrorReason = 300 // 禁止访问
+	AuthenticationErrorReason_PASSWORD_EXPIRED         AuthenticationErrorReason = 301 // 密码已过期，须先修改密码
+	AuthenticationErrorReason_REGISTRATION_CLOSED      AuthenticationErrorReason = 302 // 租户未开放注册
+	AuthenticationErrorReason_EMAIL_DOMAIN_NOT_ALLOWED AuthenticationErrorReason = 303 // 邮箱域名不在允许注册的范围内
 	// 404
 	AuthenticationErrorReason_NOT_FOUND      AuthenticationErrorReason = 400 // 找不到资源
 	AuthenticationErrorReason_USER_NOT_FOUND AuthenticationErrorReason = 401 // 用户不存在
@@ -132,6 +139,10 @@ var (
 		2:    "INVALID_USERID",
 		3:    "INVALID_TOKEN",
 		4:    "INVALID_PASSWORD",
+		5:    "PASSWORD_POLICY_VIOLATION",
+		6:    "INVALID_VERIFICATION_CODE",
+		7:    "INVALID_CAPTCHA",
+		8:    "INVALID_INVITE_CODE",
 		100:  "UNAUTHORIZED",
 		101:  "USER_FREEZE",
 		102:  "INCORRECT_PASSWORD",
@@ -142,6 +153,9 @@ var (
 		107:  "TOKEN_NOT_EXIST",
 		200:  "PAYMENT_REQUIRED",
 		300:  "FORBIDDEN",
+		301:  "PASSWORD_EXPIRED",
+		302:  "REGISTRATION_CLOSED",
+		303:  "EMAIL_DOMAIN_NOT_ALLOWED",
 		400:  "NOT_FOUND",
 		401:  "USER_NOT_FOUND",
 		500:  "METHOD_NOT_ALLOWED",
@@ -188,6 +202,10 @@ var (
 		"INVALID_USERID":                  2,
 		"INVALID_TOKEN":                   3,
 		"INVALID_PASSWORD":                4,
+		"PASSWORD_POLICY_VIOLATION":       5,
+		"INVALID_VERIFICATION_CODE":       6,
+		"INVALID_CAPTCHA":                 7,
+		"INVALID_INVITE_CODE":             8,
 		"UNAUTHORIZED":                    100,
 		"USER_FREEZE":                     101,
 		"INCORRECT_PASSWORD":              102,
@@ -198,6 +216,9 @@ var (
 		"TOKEN_NOT_EXIST":                 107,
 		"PAYMENT_REQUIRED":                200,
 		"FORBIDDEN":                       300,
+		"PASSWORD_EXPIRED":                301,
+		"REGISTRATION_CLOSED":             302,
+		"EMAIL_DOMAIN_NOT_ALLOWED":        303,
 		"NOT_FOUND":                       400,
 		"USER_NOT_FOUND":                  401,
 		"METHOD_NOT_ALLOWED":              500,
@@ -271,13 +292,17 @@ var File_authentication_service_v1_authentication_error_proto protoreflect.FileD
 
 const file_authentication_service_v1_authentication_error_proto_rawDesc = "" +
 	"\n" +
-	"4authentication/service/v1/authentication_error.proto\x12\x19authentication.service.v1\x1a\x13errors/errors.proto*\xed\f\n" +
+	"4authentication/service/v1/authentication_error.proto\x12\x19authentication.service.v1\x1a\x13errors/errors.proto*\xd3\x0e\n" +
 	"\x19AuthenticationErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
 	"\x12INVALID_GRANT_TYPE\x10\x01\x1a\x04\xa8E\x90\x03\x12\x18\n" +
 	"\x0eINVALID_USERID\x10\x02\x1a\x04\xa8E\x90\x03\x12\x17\n" +
 	"\rINVALID_TOKEN\x10\x03\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
-	"\x10INVALID_PASSWORD\x10\x04\x1a\x04\xa8E\x90\x03\x12\x16\n" +
+	"\x10INVALID_PASSWORD\x10\x04\x1a\x04\xa8E\x90\x03\x12#\n" +
+	"\x19PASSWORD_POLICY_VIOLATION\x10\x05\x1a\x04\xa8E\x90\x03\x12#\n" +
+	"\x19INVALID_VERIFICATION_CODE\x10\x06\x1a\x04\xa8E\x90\x03\x12\x19\n" +
+	"\x0fINVALID_CAPTCHA\x10\a\x1a\x04\xa8E\x90\x03\x12\x1d\n" +
+	"\x13INVALID_INVITE_CODE\x10\b\x1a\x04\xa8E\x90\x03\x12\x16\n" +
 	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12\x15\n" +
 	"\vUSER_FREEZE\x10e\x1a\x04\xa8E\x91\x03\x12\x1c\n" +
 	"\x12INCORRECT_PASSWORD\x10f\x1a\x04\xa8E\x91\x03\x12\x1e\n" +
@@ -287,7 +312,10 @@ const file_authentication_service_v1_authentication_error_proto_rawDesc = "" +
 	"\rTOKEN_EXPIRED\x10j\x1a\x04\xa8E\x91\x03\x12\x19\n" +
 	"\x0fTOKEN_NOT_EXIST\x10k\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
 	"\x10PAYMENT_REQUIRED\x10\xc8\x01\x1a\x04\xa8E\x92\x03\x12\x14\n" +
-	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
+	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x1b\n" +
+	"\x10PASSWORD_EXPIRED\x10\xad\x02\x1a\x04\xa8E\x93\x03\x12\x1e\n" +
+	"\x13REGISTRATION_CLOSED\x10\xae\x02\x1a\x04\xa8E\x93\x03\x12#\n" +
+	"\x18EMAIL_DOMAIN_NOT_ALLOWED\x10\xaf\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
 	"\tNOT_FOUND\x10\x90\x03\x1a\x04\xa8E\x94\x03\x12\x19\n" +
 	"\x0eUSER_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
 	"\x12METHOD_NOT_ALLOWED\x10\xf4\x03\x1a\x04\xa8E\x95\x03\x12\x19\n" +
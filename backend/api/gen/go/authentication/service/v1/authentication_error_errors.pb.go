@@ -81,6 +81,62 @@ func ErrorInvalidPassword(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, AuthenticationErrorReason_INVALID_PASSWORD.String(), fmt.Sprintf(format, args...))
 }
 
+// 密码不符合密码策略，未通过的规则见错误元数据
+func IsPasswordPolicyViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_PASSWORD_POLICY_VIOLATION.String() && e.Code == 400
+}
+
+// 密码不符合密码策略，未通过的规则见错误元数据
+func ErrorPasswordPolicyViolation(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, AuthenticationErrorReason_PASSWORD_POLICY_VIOLATION.String(), fmt.Sprintf(format, args...))
+}
+
+// 验证码错误或已失效
+func IsInvalidVerificationCode(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_INVALID_VERIFICATION_CODE.String() && e.Code == 400
+}
+
+// 验证码错误或已失效
+func ErrorInvalidVerificationCode(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, AuthenticationErrorReason_INVALID_VERIFICATION_CODE.String(), fmt.Sprintf(format, args...))
+}
+
+// 人机验证未通过
+func IsInvalidCaptcha(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_INVALID_CAPTCHA.String() && e.Code == 400
+}
+
+// 人机验证未通过
+func ErrorInvalidCaptcha(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, AuthenticationErrorReason_INVALID_CAPTCHA.String(), fmt.Sprintf(format, args...))
+}
+
+// 邀请码无效、已使用或已过期
+func IsInvalidInviteCode(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_INVALID_INVITE_CODE.String() && e.Code == 400
+}
+
+// 邀请码无效、已使用或已过期
+func ErrorInvalidInviteCode(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, AuthenticationErrorReason_INVALID_INVITE_CODE.String(), fmt.Sprintf(format, args...))
+}
+
 // 401
 func IsUnauthorized(err error) bool {
 	if err == nil {
@@ -221,6 +277,48 @@ func ErrorForbidden(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, AuthenticationErrorReason_FORBIDDEN.String(), fmt.Sprintf(format, args...))
 }
 
+// 密码已过期，须先修改密码
+func IsPasswordExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_PASSWORD_EXPIRED.String() && e.Code == 403
+}
+
+// 密码已过期，须先修改密码
+func ErrorPasswordExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, AuthenticationErrorReason_PASSWORD_EXPIRED.String(), fmt.Sprintf(format, args...))
+}
+
+// 租户未开放注册
+func IsRegistrationClosed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_REGISTRATION_CLOSED.String() && e.Code == 403
+}
+
+// 租户未开放注册
+func ErrorRegistrationClosed(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, AuthenticationErrorReason_REGISTRATION_CLOSED.String(), fmt.Sprintf(format, args...))
+}
+
+// 邮箱域名不在允许注册的范围内
+func IsEmailDomainNotAllowed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_EMAIL_DOMAIN_NOT_ALLOWED.String() && e.Code == 403
+}
+
+// 邮箱域名不在允许注册的范围内
+func ErrorEmailDomainNotAllowed(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, AuthenticationErrorReason_EMAIL_DOMAIN_NOT_ALLOWED.String(), fmt.Sprintf(format, args...))
+}
+
 // 404
 func IsNotFound(err error) bool {
 	if err == nil {
@@ -20,13 +20,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthenticationService_Login_FullMethodName           = "/authentication.service.v1.AuthenticationService/Login"
-	AuthenticationService_Logout_FullMethodName          = "/authentication.service.v1.AuthenticationService/Logout"
-	AuthenticationService_RegisterUser_FullMethodName    = "/authentication.service.v1.AuthenticationService/RegisterUser"
-	AuthenticationService_RefreshToken_FullMethodName    = "/authentication.service.v1.AuthenticationService/RefreshToken"
-	AuthenticationService_ValidateToken_FullMethodName   = "/authentication.service.v1.AuthenticationService/ValidateToken"
-	AuthenticationService_GetAccessTokens_FullMethodName = "/authentication.service.v1.AuthenticationService/GetAccessTokens"
-	AuthenticationService_WhoAmI_FullMethodName          = "/authentication.service.v1.AuthenticationService/WhoAmI"
+	AuthenticationService_Login_FullMethodName                  = "/authentication.service.v1.AuthenticationService/Login"
+	AuthenticationService_Logout_FullMethodName                 = "/authentication.service.v1.AuthenticationService/Logout"
+	AuthenticationService_RegisterUser_FullMethodName           = "/authentication.service.v1.AuthenticationService/RegisterUser"
+	AuthenticationService_ConfirmRegistration_FullMethodName    = "/authentication.service.v1.AuthenticationService/ConfirmRegistration"
+	AuthenticationService_ResendRegistrationCode_FullMethodName = "/authentication.service.v1.AuthenticationService/ResendRegistrationCode"
+	AuthenticationService_RefreshToken_FullMethodName           = "/authentication.service.v1.AuthenticationService/RefreshToken"
+	AuthenticationService_ValidateToken_FullMethodName          = "/authentication.service.v1.AuthenticationService/ValidateToken"
+	AuthenticationService_GetAccessTokens_FullMethodName        = "/authentication.service.v1.AuthenticationService/GetAccessTokens"
+	AuthenticationService_WhoAmI_FullMethodName                 = "/authentication.service.v1.AuthenticationService/WhoAmI"
+	AuthenticationService_Impersonate_FullMethodName            = "/authentication.service.v1.AuthenticationService/Impersonate"
+	AuthenticationService_RequestPasswordReset_FullMethodName   = "/authentication.service.v1.AuthenticationService/RequestPasswordReset"
+	AuthenticationService_ConfirmPasswordReset_FullMethodName   = "/authentication.service.v1.AuthenticationService/ConfirmPasswordReset"
 )
 
 // AuthenticationServiceClient is the client API for AuthenticationService service.
@@ -41,6 +46,10 @@ type AuthenticationServiceClient interface {
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 注册用户
 	RegisterUser(ctx context.Context, in *RegisterUserRequest, opts ...grpc.CallOption) (*RegisterUserResponse, error)
+	// 使用邮箱验证码激活注册的用户
+	ConfirmRegistration(ctx context.Context, in *ConfirmRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 重新发送注册激活验证码
+	ResendRegistrationCode(ctx context.Context, in *ResendRegistrationCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 刷新认证令牌
 	RefreshToken(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	// 验证令牌
@@ -49,6 +58,12 @@ type AuthenticationServiceClient interface {
 	GetAccessTokens(ctx context.Context, in *GetAccessTokensRequest, opts ...grpc.CallOption) (*GetAccessTokensResponse, error)
 	// 获取当前用户身份信息
 	WhoAmI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WhoAmIResponse, error)
+	// 模拟登录为同租户的其他用户，签发短期访问令牌
+	Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// 忘记密码，向用户绑定的邮箱或手机发送重置密码验证码
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 使用验证码或重置链接中的令牌设置新密码
+	ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type authenticationServiceClient struct {
@@ -89,6 +104,26 @@ func (c *authenticationServiceClient) RegisterUser(ctx context.Context, in *Regi
 	return out, nil
 }
 
+func (c *authenticationServiceClient) ConfirmRegistration(ctx context.Context, in *ConfirmRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthenticationService_ConfirmRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) ResendRegistrationCode(ctx context.Context, in *ResendRegistrationCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthenticationService_ResendRegistrationCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authenticationServiceClient) RefreshToken(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginResponse)
@@ -129,6 +164,36 @@ func (c *authenticationServiceClient) WhoAmI(ctx context.Context, in *emptypb.Em
 	return out, nil
 }
 
+func (c *authenticationServiceClient) Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_Impersonate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthenticationService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthenticationService_ConfirmPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthenticationServiceServer is the server API for AuthenticationService service.
 // All implementations must embed UnimplementedAuthenticationServiceServer
 // for forward compatibility.
@@ -141,6 +206,10 @@ type AuthenticationServiceServer interface {
 	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
 	// 注册用户
 	RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error)
+	// 使用邮箱验证码激活注册的用户
+	ConfirmRegistration(context.Context, *ConfirmRegistrationRequest) (*emptypb.Empty, error)
+	// 重新发送注册激活验证码
+	ResendRegistrationCode(context.Context, *ResendRegistrationCodeRequest) (*emptypb.Empty, error)
 	// 刷新认证令牌
 	RefreshToken(context.Context, *LoginRequest) (*LoginResponse, error)
 	// 验证令牌
@@ -149,6 +218,12 @@ type AuthenticationServiceServer interface {
 	GetAccessTokens(context.Context, *GetAccessTokensRequest) (*GetAccessTokensResponse, error)
 	// 获取当前用户身份信息
 	WhoAmI(context.Context, *emptypb.Empty) (*WhoAmIResponse, error)
+	// 模拟登录为同租户的其他用户，签发短期访问令牌
+	Impersonate(context.Context, *ImpersonateRequest) (*LoginResponse, error)
+	// 忘记密码，向用户绑定的邮箱或手机发送重置密码验证码
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// 使用验证码或重置链接中的令牌设置新密码
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAuthenticationServiceServer()
 }
 
@@ -168,6 +243,12 @@ func (UnimplementedAuthenticationServiceServer) Logout(context.Context, *LogoutR
 func (UnimplementedAuthenticationServiceServer) RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RegisterUser not implemented")
 }
+func (UnimplementedAuthenticationServiceServer) ConfirmRegistration(context.Context, *ConfirmRegistrationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmRegistration not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) ResendRegistrationCode(context.Context, *ResendRegistrationCodeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResendRegistrationCode not implemented")
+}
 func (UnimplementedAuthenticationServiceServer) RefreshToken(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RefreshToken not implemented")
 }
@@ -180,6 +261,15 @@ func (UnimplementedAuthenticationServiceServer) GetAccessTokens(context.Context,
 func (UnimplementedAuthenticationServiceServer) WhoAmI(context.Context, *emptypb.Empty) (*WhoAmIResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method WhoAmI not implemented")
 }
+func (UnimplementedAuthenticationServiceServer) Impersonate(context.Context, *ImpersonateRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Impersonate not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
 func (UnimplementedAuthenticationServiceServer) mustEmbedUnimplementedAuthenticationServiceServer() {}
 func (UnimplementedAuthenticationServiceServer) testEmbeddedByValue()                               {}
 
@@ -255,6 +345,42 @@ func _AuthenticationService_RegisterUser_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthenticationService_ConfirmRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).ConfirmRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_ConfirmRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).ConfirmRegistration(ctx, req.(*ConfirmRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_ResendRegistrationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendRegistrationCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).ResendRegistrationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_ResendRegistrationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).ResendRegistrationCode(ctx, req.(*ResendRegistrationCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthenticationService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoginRequest)
 	if err := dec(in); err != nil {
@@ -327,6 +453,60 @@ func _AuthenticationService_WhoAmI_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthenticationService_Impersonate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).Impersonate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_Impersonate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).Impersonate(ctx, req.(*ImpersonateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_ConfirmPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).ConfirmPasswordReset(ctx, req.(*ConfirmPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthenticationService_ServiceDesc is the grpc.ServiceDesc for AuthenticationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -346,6 +526,14 @@ var AuthenticationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterUser",
 			Handler:    _AuthenticationService_RegisterUser_Handler,
 		},
+		{
+			MethodName: "ConfirmRegistration",
+			Handler:    _AuthenticationService_ConfirmRegistration_Handler,
+		},
+		{
+			MethodName: "ResendRegistrationCode",
+			Handler:    _AuthenticationService_ResendRegistrationCode_Handler,
+		},
 		{
 			MethodName: "RefreshToken",
 			Handler:    _AuthenticationService_RefreshToken_Handler,
@@ -362,6 +550,18 @@ var AuthenticationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WhoAmI",
 			Handler:    _AuthenticationService_WhoAmI_Handler,
 		},
+		{
+			MethodName: "Impersonate",
+			Handler:    _AuthenticationService_Impersonate_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AuthenticationService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _AuthenticationService_ConfirmPasswordReset_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "authentication/service/v1/authentication.proto",
@@ -136,6 +136,8 @@ const (
 	// 临时 / 会话类
 	UserCredential_SESSION_COOKIE       UserCredential_CredentialType = 80
 	UserCredential_TEMPORARY_CREDENTIAL UserCredential_CredentialType = 81
+	// 外部身份源（如 LDAP / AD），本地不保存密码，由外部目录校验
+	UserCredential_EXTERNAL UserCredential_CredentialType = 90
 	// 其他 / 自定义
 	UserCredential_CUSTOM              UserCredential_CredentialType = 200
 	UserCredential_RESERVED_FOR_FUTURE UserCredential_CredentialType = 1000
@@ -168,6 +170,7 @@ var (
 		72:   "OPENID_CONNECT_ID_TOKEN",
 		80:   "SESSION_COOKIE",
 		81:   "TEMPORARY_CREDENTIAL",
+		90:   "EXTERNAL",
 		200:  "CUSTOM",
 		1000: "RESERVED_FOR_FUTURE",
 	}
@@ -196,6 +199,7 @@ var (
 		"OPENID_CONNECT_ID_TOKEN":  72,
 		"SESSION_COOKIE":           80,
 		"TEMPORARY_CREDENTIAL":     81,
+		"EXTERNAL":                 90,
 		"CUSTOM":                   200,
 		"RESERVED_FOR_FUTURE":      1000,
 	}
@@ -1067,7 +1071,7 @@ var File_authentication_service_v1_user_credential_proto protoreflect.FileDescri
 
 const file_authentication_service_v1_user_credential_proto_rawDesc = "" +
 	"\n" +
-	"/authentication/service/v1/user_credential.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1epagination/v1/pagination.proto\"\xa7\x18\n" +
+	"/authentication/service/v1/user_credential.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1epagination/v1/pagination.proto\"\xb5\x18\n" +
 	"\x0eUserCredential\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12;\n" +
 	"\auser_id\x18\x02 \x01(\rB\x1d\xbaG\x1a\x92\x02\x17关联主表的用户IDH\x00R\x06userId\x88\x01\x01\x120\n" +
@@ -1110,7 +1114,7 @@ const file_authentication_service_v1_user_credential_proto_rawDesc = "" +
 	"\x10IDENTITY_API_KEY\x10\xac\x02\x12\x0e\n" +
 	"\tDEVICE_ID\x10\x90\x03\x12\x14\n" +
 	"\x0fIDENTITY_CUSTOM\x10\xe8\a\x12!\n" +
-	"\x1cIDENTITY_RESERVED_FOR_FUTURE\x10\x90N\"\xf3\x03\n" +
+	"\x1cIDENTITY_RESERVED_FOR_FUTURE\x10\x90N\"\x81\x04\n" +
 	"\x0eCredentialType\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rPASSWORD_HASH\x10\x01\x12\v\n" +
@@ -1137,7 +1141,8 @@ const file_authentication_service_v1_user_credential_proto_rawDesc = "" +
 	"\x0eSAML_ASSERTION\x10G\x12\x1b\n" +
 	"\x17OPENID_CONNECT_ID_TOKEN\x10H\x12\x12\n" +
 	"\x0eSESSION_COOKIE\x10P\x12\x18\n" +
-	"\x14TEMPORARY_CREDENTIAL\x10Q\x12\v\n" +
+	"\x14TEMPORARY_CREDENTIAL\x10Q\x12\f\n" +
+	"\bEXTERNAL\x10Z\x12\v\n" +
 	"\x06CUSTOM\x10\xc8\x01\x12\x18\n" +
 	"\x13RESERVED_FOR_FUTURE\x10\xe8\a\"i\n" +
 	"\x06Status\x12\f\n" +
@@ -23,19 +23,73 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 令牌主体类型
+type UserTokenPayload_SubjectType int32
+
+const (
+	UserTokenPayload_USER   UserTokenPayload_SubjectType = 0 // 用户，按角色鉴权
+	UserTokenPayload_CLIENT UserTokenPayload_SubjectType = 1 // OAuth客户端，按授权范围鉴权
+)
+
+// Enum value maps for UserTokenPayload_SubjectType.
+var (
+	UserTokenPayload_SubjectType_name = map[int32]string{
+		0: "USER",
+		1: "CLIENT",
+	}
+	UserTokenPayload_SubjectType_value = map[string]int32{
+		"USER":   0,
+		"CLIENT": 1,
+	}
+)
+
+func (x UserTokenPayload_SubjectType) Enum() *UserTokenPayload_SubjectType {
+	p := new(UserTokenPayload_SubjectType)
+	*p = x
+	return p
+}
+
+func (x UserTokenPayload_SubjectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserTokenPayload_SubjectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_authentication_service_v1_user_token_proto_enumTypes[0].Descriptor()
+}
+
+func (UserTokenPayload_SubjectType) Type() protoreflect.EnumType {
+	return &file_authentication_service_v1_user_token_proto_enumTypes[0]
+}
+
+func (x UserTokenPayload_SubjectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserTokenPayload_SubjectType.Descriptor instead.
+func (UserTokenPayload_SubjectType) EnumDescriptor() ([]byte, []int) {
+	return file_authentication_service_v1_user_token_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // 用户令牌载体
 type UserTokenPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=uid,proto3" json:"user_id,omitempty"`                                                  // 用户ID
-	TenantId      *uint32                `protobuf:"varint,2,opt,name=tenant_id,json=tid,proto3,oneof" json:"tenant_id,omitempty"`                                        // 租户ID
-	ClientId      *string                `protobuf:"bytes,3,opt,name=client_id,json=cid,proto3,oneof" json:"client_id,omitempty"`                                         // 客户端ID
-	DeviceId      *string                `protobuf:"bytes,4,opt,name=device_id,json=did,proto3,oneof" json:"device_id,omitempty"`                                         // 设备ID
-	Username      *string                `protobuf:"bytes,5,opt,name=username,json=sub,proto3,oneof" json:"username,omitempty"`                                           // 用户名
-	Roles         []string               `protobuf:"bytes,10,rep,name=roles,json=roc,proto3" json:"roles,omitempty"`                                                      // 用户角色码列表
-	DataScope     *v1.DataScope          `protobuf:"varint,11,opt,name=data_scope,json=ds,proto3,enum=permission.service.v1.DataScope,oneof" json:"data_scope,omitempty"` // 数据权限范围
-	OrgUnitId     *uint32                `protobuf:"varint,12,opt,name=org_unit_id,json=ouid,proto3,oneof" json:"org_unit_id,omitempty"`                                  // 当前组织单元ID
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState        `protogen:"open.v1"`
+	UserId              uint32                        `protobuf:"varint,1,opt,name=user_id,json=uid,proto3" json:"user_id,omitempty"`                                                                            // 用户ID
+	TenantId            *uint32                       `protobuf:"varint,2,opt,name=tenant_id,json=tid,proto3,oneof" json:"tenant_id,omitempty"`                                                                  // 租户ID
+	ClientId            *string                       `protobuf:"bytes,3,opt,name=client_id,json=cid,proto3,oneof" json:"client_id,omitempty"`                                                                   // 客户端ID
+	DeviceId            *string                       `protobuf:"bytes,4,opt,name=device_id,json=did,proto3,oneof" json:"device_id,omitempty"`                                                                   // 设备ID
+	Username            *string                       `protobuf:"bytes,5,opt,name=username,json=sub,proto3,oneof" json:"username,omitempty"`                                                                     // 用户名
+	SubjectType         *UserTokenPayload_SubjectType `protobuf:"varint,6,opt,name=subject_type,json=st,proto3,enum=authentication.service.v1.UserTokenPayload_SubjectType,oneof" json:"subject_type,omitempty"` // 令牌主体类型
+	SessionId           *string                       `protobuf:"bytes,7,opt,name=session_id,json=sid,proto3,oneof" json:"session_id,omitempty"`                                                                 // 会话ID
+	Actor               *TokenActor                   `protobuf:"bytes,8,opt,name=actor,json=act,proto3,oneof" json:"actor,omitempty"`                                                                           // 实际操作者
+	ReadOnly            *bool                         `protobuf:"varint,9,opt,name=read_only,json=ro,proto3,oneof" json:"read_only,omitempty"`                                                                   // 只读令牌
+	Roles               []string                      `protobuf:"bytes,10,rep,name=roles,json=roc,proto3" json:"roles,omitempty"`                                                                                // 用户角色码列表
+	DataScope           *v1.DataScope                 `protobuf:"varint,11,opt,name=data_scope,json=ds,proto3,enum=permission.service.v1.DataScope,oneof" json:"data_scope,omitempty"`                           // 数据权限范围
+	OrgUnitId           *uint32                       `protobuf:"varint,12,opt,name=org_unit_id,json=ouid,proto3,oneof" json:"org_unit_id,omitempty"`                                                            // 当前组织单元ID
+	Scopes              []string                      `protobuf:"bytes,13,rep,name=scopes,json=scp,proto3" json:"scopes,omitempty"`                                                                              // 客户端令牌的授权范围
+	PasswordExpired     *bool                         `protobuf:"varint,14,opt,name=password_expired,json=pwe,proto3,oneof" json:"password_expired,omitempty"`                                                   // 密码已过期
+	ClientSecretVersion *uint32                       `protobuf:"varint,15,opt,name=client_secret_version,json=csv,proto3,oneof" json:"client_secret_version,omitempty"`                                         // 客户端密钥版本
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *UserTokenPayload) Reset() {
@@ -103,6 +157,34 @@ func (x *UserTokenPayload) GetUsername() string {
 	return ""
 }
 
+func (x *UserTokenPayload) GetSubjectType() UserTokenPayload_SubjectType {
+	if x != nil && x.SubjectType != nil {
+		return *x.SubjectType
+	}
+	return UserTokenPayload_USER
+}
+
+func (x *UserTokenPayload) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *UserTokenPayload) GetActor() *TokenActor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *UserTokenPayload) GetReadOnly() bool {
+	if x != nil && x.ReadOnly != nil {
+		return *x.ReadOnly
+	}
+	return false
+}
+
 func (x *UserTokenPayload) GetRoles() []string {
 	if x != nil {
 		return x.Roles
@@ -124,31 +206,141 @@ func (x *UserTokenPayload) GetOrgUnitId() uint32 {
 	return 0
 }
 
+func (x *UserTokenPayload) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *UserTokenPayload) GetPasswordExpired() bool {
+	if x != nil && x.PasswordExpired != nil {
+		return *x.PasswordExpired
+	}
+	return false
+}
+
+func (x *UserTokenPayload) GetClientSecretVersion() uint32 {
+	if x != nil && x.ClientSecretVersion != nil {
+		return *x.ClientSecretVersion
+	}
+	return 0
+}
+
+// 令牌的实际操作者（RFC 8693 act 声明）
+type TokenActor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=uid,proto3" json:"user_id,omitempty"`           // 操作者用户ID
+	Username      *string                `protobuf:"bytes,2,opt,name=username,json=sub,proto3,oneof" json:"username,omitempty"`    // 操作者用户名
+	TenantId      *uint32                `protobuf:"varint,3,opt,name=tenant_id,json=tid,proto3,oneof" json:"tenant_id,omitempty"` // 操作者租户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenActor) Reset() {
+	*x = TokenActor{}
+	mi := &file_authentication_service_v1_user_token_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenActor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenActor) ProtoMessage() {}
+
+func (x *TokenActor) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_user_token_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenActor.ProtoReflect.Descriptor instead.
+func (*TokenActor) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_user_token_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TokenActor) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TokenActor) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *TokenActor) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
 var File_authentication_service_v1_user_token_proto protoreflect.FileDescriptor
 
 const file_authentication_service_v1_user_token_proto_rawDesc = "" +
 	"\n" +
-	"*authentication/service/v1/user_token.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a&permission/service/v1/permission.proto\"\x8b\x04\n" +
+	"*authentication/service/v1/user_token.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a&permission/service/v1/permission.proto\"\xea\v\n" +
 	"\x10UserTokenPayload\x12$\n" +
 	"\auser_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x03uid\x12+\n" +
 	"\ttenant_id\x18\x02 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x00R\x03tid\x88\x01\x01\x12.\n" +
 	"\tclient_id\x18\x03 \x01(\tB\x11\xbaG\x0e\x92\x02\v客户端IDH\x01R\x03cid\x88\x01\x01\x12+\n" +
 	"\tdevice_id\x18\x04 \x01(\tB\x0e\xbaG\v\x92\x02\b设备IDH\x02R\x03did\x88\x01\x01\x12+\n" +
-	"\busername\x18\x05 \x01(\tB\x0f\xbaG\f\x92\x02\t用户名H\x03R\x03sub\x88\x01\x01\x12/\n" +
+	"\busername\x18\x05 \x01(\tB\x0f\xbaG\f\x92\x02\t用户名H\x03R\x03sub\x88\x01\x01\x12\x98\x01\n" +
+	"\fsubject_type\x18\x06 \x01(\x0e27.authentication.service.v1.UserTokenPayload.SubjectTypeB@\xbaG=\x92\x02:令牌主体类型，客户端令牌的 sub 为客户端IDH\x04R\x02st\x88\x01\x01\x12w\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tBY\xbaGV\x92\x02S会话ID，同一次登录签发的访问令牌和刷新令牌共享同一个会话H\x05R\x03sid\x88\x01\x01\x12\x8e\x01\n" +
+	"\x05actor\x18\b \x01(\v2%.authentication.service.v1.TokenActorBN\xbaGK\x92\x02H实际操作者，模拟登录签发的令牌中为发起模拟的用户H\x06R\x03act\x88\x01\x01\x12I\n" +
+	"\tread_only\x18\t \x01(\bB-\xbaG*\x92\x02'只读令牌，只允许查询类请求H\aR\x02ro\x88\x01\x01\x12/\n" +
 	"\x05roles\x18\n" +
 	" \x03(\tB\x1b\xbaG\x18\x92\x02\x15用户角色码列表R\x03roc\x12W\n" +
 	"\n" +
-	"data_scope\x18\v \x01(\x0e2 .permission.service.v1.DataScopeB\x18\xbaG\x15\x92\x02\x12数据权限范围H\x04R\x02ds\x88\x01\x01\x12:\n" +
-	"\vorg_unit_id\x18\f \x01(\rB\x1a\xbaG\x17\x92\x02\x14当前组织单元IDH\x05R\x04ouid\x88\x01\x01B\f\n" +
+	"data_scope\x18\v \x01(\x0e2 .permission.service.v1.DataScopeB\x18\xbaG\x15\x92\x02\x12数据权限范围H\bR\x02ds\x88\x01\x01\x12:\n" +
+	"\vorg_unit_id\x18\f \x01(\rB\x1a\xbaG\x17\x92\x02\x14当前组织单元IDH\tR\x04ouid\x88\x01\x01\x12N\n" +
+	"\x06scopes\x18\r \x03(\tB9\xbaG6\x92\x023客户端令牌的授权范围，即权限码列表R\x03scp\x12\x81\x01\n" +
+	"\x10password_expired\x18\x0e \x01(\bB]\xbaGZ\x92\x02W密码已过期，修改密码前只能调用修改密码、查看当前身份和登出H\n" +
+	"R\x03pwe\x88\x01\x01\x12\x8c\x01\n" +
+	"\x15client_secret_version\x18\x0f \x01(\rBc\xbaG`\x92\x02]换取客户端令牌时使用的密钥版本，密钥轮换且旧密钥过期后令牌失效H\vR\x03csv\x88\x01\x01\"#\n" +
+	"\vSubjectType\x12\b\n" +
+	"\x04USER\x10\x00\x12\n" +
+	"\n" +
+	"\x06CLIENT\x10\x01B\f\n" +
 	"\n" +
 	"_tenant_idB\f\n" +
 	"\n" +
 	"_client_idB\f\n" +
 	"\n" +
 	"_device_idB\v\n" +
-	"\t_usernameB\r\n" +
+	"\t_usernameB\x0f\n" +
+	"\r_subject_typeB\r\n" +
+	"\v_session_idB\b\n" +
+	"\x06_actorB\f\n" +
+	"\n" +
+	"_read_onlyB\r\n" +
 	"\v_data_scopeB\x0e\n" +
-	"\f_org_unit_idB\xfa\x01\n" +
+	"\f_org_unit_idB\x13\n" +
+	"\x11_password_expiredB\x18\n" +
+	"\x16_client_secret_version\"\xc2\x01\n" +
+	"\n" +
+	"TokenActor\x12-\n" +
+	"\auser_id\x18\x01 \x01(\rB\x17\xbaG\x14\x92\x02\x11操作者用户IDR\x03uid\x124\n" +
+	"\busername\x18\x02 \x01(\tB\x18\xbaG\x15\x92\x02\x12操作者用户名H\x00R\x03sub\x88\x01\x01\x124\n" +
+	"\ttenant_id\x18\x03 \x01(\rB\x17\xbaG\x14\x92\x02\x11操作者租户IDH\x01R\x03tid\x88\x01\x01B\v\n" +
+	"\t_usernameB\f\n" +
+	"\n" +
+	"_tenant_idB\xfa\x01\n" +
 	"\x1dcom.authentication.service.v1B\x0eUserTokenProtoP\x01ZCgo-wind-admin/api/gen/go/authentication/service/v1;authenticationpb\xa2\x02\x03ASX\xaa\x02\x19Authentication.Service.V1\xca\x02\x19Authentication\\Service\\V1\xe2\x02%Authentication\\Service\\V1\\GPBMetadata\xea\x02\x1bAuthentication::Service::V1b\x06proto3"
 
 var (
@@ -163,18 +355,23 @@ func file_authentication_service_v1_user_token_proto_rawDescGZIP() []byte {
 	return file_authentication_service_v1_user_token_proto_rawDescData
 }
 
-var file_authentication_service_v1_user_token_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_authentication_service_v1_user_token_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_authentication_service_v1_user_token_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_authentication_service_v1_user_token_proto_goTypes = []any{
-	(*UserTokenPayload)(nil), // 0: authentication.service.v1.UserTokenPayload
-	(v1.DataScope)(0),        // 1: permission.service.v1.DataScope
+	(UserTokenPayload_SubjectType)(0), // 0: authentication.service.v1.UserTokenPayload.SubjectType
+	(*UserTokenPayload)(nil),          // 1: authentication.service.v1.UserTokenPayload
+	(*TokenActor)(nil),                // 2: authentication.service.v1.TokenActor
+	(v1.DataScope)(0),                 // 3: permission.service.v1.DataScope
 }
 var file_authentication_service_v1_user_token_proto_depIdxs = []int32{
-	1, // 0: authentication.service.v1.UserTokenPayload.data_scope:type_name -> permission.service.v1.DataScope
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0, // 0: authentication.service.v1.UserTokenPayload.subject_type:type_name -> authentication.service.v1.UserTokenPayload.SubjectType
+	2, // 1: authentication.service.v1.UserTokenPayload.actor:type_name -> authentication.service.v1.TokenActor
+	3, // 2: authentication.service.v1.UserTokenPayload.data_scope:type_name -> permission.service.v1.DataScope
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_authentication_service_v1_user_token_proto_init() }
@@ -183,18 +380,20 @@ func file_authentication_service_v1_user_token_proto_init() {
 		return
 	}
 	file_authentication_service_v1_user_token_proto_msgTypes[0].OneofWrappers = []any{}
+	file_authentication_service_v1_user_token_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_authentication_service_v1_user_token_proto_rawDesc), len(file_authentication_service_v1_user_token_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_authentication_service_v1_user_token_proto_goTypes,
 		DependencyIndexes: file_authentication_service_v1_user_token_proto_depIdxs,
+		EnumInfos:         file_authentication_service_v1_user_token_proto_enumTypes,
 		MessageInfos:      file_authentication_service_v1_user_token_proto_msgTypes,
 	}.Build()
 	File_authentication_service_v1_user_token_proto = out.File
@@ -38,10 +38,38 @@ func (x *UserTokenPayload) Redact() string {
 
 	// Safe field: Username
 
+	// Safe field: SubjectType
+
+	// Safe field: SessionId
+
+	// Safe field: Actor
+
+	// Safe field: ReadOnly
+
 	// Safe field: Roles
 
 	// Safe field: DataScope
 
 	// Safe field: OrgUnitId
+
+	// Safe field: Scopes
+
+	// Safe field: PasswordExpired
+
+	// Safe field: ClientSecretVersion
+	return x.String()
+}
+
+// Redact method implementation for TokenActor
+func (x *TokenActor) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: UserId
+
+	// Safe field: Username
+
+	// Safe field: TenantId
 	return x.String()
 }
@@ -79,6 +79,51 @@ func (m *UserTokenPayload) validate(all bool) error {
 		// no validation rules for Username
 	}
 
+	if m.SubjectType != nil {
+		// no validation rules for SubjectType
+	}
+
+	if m.SessionId != nil {
+		// no validation rules for SessionId
+	}
+
+	if m.Actor != nil {
+
+		if all {
+			switch v := interface{}(m.GetActor()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserTokenPayloadValidationError{
+						field:  "Actor",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserTokenPayloadValidationError{
+						field:  "Actor",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetActor()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserTokenPayloadValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ReadOnly != nil {
+		// no validation rules for ReadOnly
+	}
+
 	if m.DataScope != nil {
 		// no validation rules for DataScope
 	}
@@ -87,6 +132,14 @@ func (m *UserTokenPayload) validate(all bool) error {
 		// no validation rules for OrgUnitId
 	}
 
+	if m.PasswordExpired != nil {
+		// no validation rules for PasswordExpired
+	}
+
+	if m.ClientSecretVersion != nil {
+		// no validation rules for ClientSecretVersion
+	}
+
 	if len(errors) > 0 {
 		return UserTokenPayloadMultiError(errors)
 	}
@@ -164,3 +217,112 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = UserTokenPayloadValidationError{}
+
+// Validate checks the field values on TokenActor with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TokenActor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenActor with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenActorMultiError, or
+// nil if none found.
+func (m *TokenActor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenActor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if len(errors) > 0 {
+		return TokenActorMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenActorMultiError is an error wrapping multiple validation errors
+// returned by TokenActor.ValidateAll() if the designated constraints aren't met.
+type TokenActorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenActorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenActorMultiError) AllErrors() []error { return m }
+
+// TokenActorValidationError is the validation error returned by
+// TokenActor.Validate if the designated constraints aren't met.
+type TokenActorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenActorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenActorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenActorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenActorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenActorValidationError) ErrorName() string { return "TokenActorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenActorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenActor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenActorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenActorValidationError{}
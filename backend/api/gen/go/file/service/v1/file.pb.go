@@ -98,29 +98,82 @@ func (OSSProvider) EnumDescriptor() ([]byte, []int) {
 	return file_file_service_v1_file_proto_rawDescGZIP(), []int{0}
 }
 
+// 文件内容扫描状态
+type FileScanStatus int32
+
+const (
+	FileScanStatus_UNSCANNED   FileScanStatus = 0 // 未扫描（未配置扫描器）
+	FileScanStatus_CLEAN       FileScanStatus = 1 // 扫描通过
+	FileScanStatus_QUARANTINED FileScanStatus = 2 // 已隔离（发现威胁或扫描失败）
+)
+
+// Enum value maps for FileScanStatus.
+var (
+	FileScanStatus_name = map[int32]string{
+		0: "UNSCANNED",
+		1: "CLEAN",
+		2: "QUARANTINED",
+	}
+	FileScanStatus_value = map[string]int32{
+		"UNSCANNED":   0,
+		"CLEAN":       1,
+		"QUARANTINED": 2,
+	}
+)
+
+func (x FileScanStatus) Enum() *FileScanStatus {
+	p := new(FileScanStatus)
+	*p = x
+	return p
+}
+
+func (x FileScanStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileScanStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_file_service_v1_file_proto_enumTypes[1].Descriptor()
+}
+
+func (FileScanStatus) Type() protoreflect.EnumType {
+	return &file_file_service_v1_file_proto_enumTypes[1]
+}
+
+func (x FileScanStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileScanStatus.Descriptor instead.
+func (FileScanStatus) EnumDescriptor() ([]byte, []int) {
+	return file_file_service_v1_file_proto_rawDescGZIP(), []int{1}
+}
+
 // 文件
 type File struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                              // 文件ID
-	Provider      *OSSProvider           `protobuf:"varint,2,opt,name=provider,proto3,enum=file.service.v1.OSSProvider,oneof" json:"provider,omitempty"` // OSS供应商
-	BucketName    *string                `protobuf:"bytes,3,opt,name=bucket_name,json=bucketName,proto3,oneof" json:"bucket_name,omitempty"`             // 存储桶名称
-	FileDirectory *string                `protobuf:"bytes,4,opt,name=file_directory,json=fileDirectory,proto3,oneof" json:"file_directory,omitempty"`    // 文件目录
-	FileGuid      *string                `protobuf:"bytes,5,opt,name=file_guid,json=fileGuid,proto3,oneof" json:"file_guid,omitempty"`                   // 文件Guid
-	SaveFileName  *string                `protobuf:"bytes,6,opt,name=save_file_name,json=saveFileName,proto3,oneof" json:"save_file_name,omitempty"`     // 实际存储文件名（防止在服务器文件系统发生文件冲突）
-	FileName      *string                `protobuf:"bytes,7,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"`                   // 原始文件名
-	Extension     *string                `protobuf:"bytes,8,opt,name=extension,proto3,oneof" json:"extension,omitempty"`                                 // 文件扩展名
-	Size          *uint64                `protobuf:"varint,9,opt,name=size,proto3,oneof" json:"size,omitempty"`                                          // 文件字节长度
-	SizeFormat    *string                `protobuf:"bytes,10,opt,name=size_format,json=sizeFormat,proto3,oneof" json:"size_format,omitempty"`            // 格式化后的文件长度字符串
-	LinkUrl       *string                `protobuf:"bytes,11,opt,name=link_url,json=linkUrl,proto3,oneof" json:"link_url,omitempty"`                     // 链接地址
-	ContentHash   *string                `protobuf:"bytes,12,opt,name=content_hash,json=contentHash,proto3,oneof" json:"content_hash,omitempty"`         // 文件内容hash值
-	TenantId      *uint32                `protobuf:"varint,40,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                 // 租户ID，0代表系统全局角色
-	TenantName    *string                `protobuf:"bytes,41,opt,name=tenant_name,json=tenantName,proto3,oneof" json:"tenant_name,omitempty"`            // 租户名称
-	CreatedBy     *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`             // 创建者ID
-	UpdatedBy     *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`             // 更新者ID
-	DeletedBy     *uint32                `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`             // 删除者用户ID
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`              // 创建时间
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`              // 更新时间
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`              // 删除时间
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                        // 文件ID
+	Provider      *OSSProvider           `protobuf:"varint,2,opt,name=provider,proto3,enum=file.service.v1.OSSProvider,oneof" json:"provider,omitempty"`                           // OSS供应商
+	BucketName    *string                `protobuf:"bytes,3,opt,name=bucket_name,json=bucketName,proto3,oneof" json:"bucket_name,omitempty"`                                       // 存储桶名称
+	FileDirectory *string                `protobuf:"bytes,4,opt,name=file_directory,json=fileDirectory,proto3,oneof" json:"file_directory,omitempty"`                              // 文件目录
+	FileGuid      *string                `protobuf:"bytes,5,opt,name=file_guid,json=fileGuid,proto3,oneof" json:"file_guid,omitempty"`                                             // 文件Guid
+	SaveFileName  *string                `protobuf:"bytes,6,opt,name=save_file_name,json=saveFileName,proto3,oneof" json:"save_file_name,omitempty"`                               // 实际存储文件名（防止在服务器文件系统发生文件冲突）
+	FileName      *string                `protobuf:"bytes,7,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"`                                             // 原始文件名
+	Extension     *string                `protobuf:"bytes,8,opt,name=extension,proto3,oneof" json:"extension,omitempty"`                                                           // 文件扩展名
+	Size          *uint64                `protobuf:"varint,9,opt,name=size,proto3,oneof" json:"size,omitempty"`                                                                    // 文件字节长度
+	SizeFormat    *string                `protobuf:"bytes,10,opt,name=size_format,json=sizeFormat,proto3,oneof" json:"size_format,omitempty"`                                      // 格式化后的文件长度字符串
+	LinkUrl       *string                `protobuf:"bytes,11,opt,name=link_url,json=linkUrl,proto3,oneof" json:"link_url,omitempty"`                                               // 链接地址
+	ContentHash   *string                `protobuf:"bytes,12,opt,name=content_hash,json=contentHash,proto3,oneof" json:"content_hash,omitempty"`                                   // 文件内容hash值
+	ContentType   *string                `protobuf:"bytes,14,opt,name=content_type,json=contentType,proto3,oneof" json:"content_type,omitempty"`                                   // 文件内容类型（MIME）
+	ScanStatus    *FileScanStatus        `protobuf:"varint,15,opt,name=scan_status,json=scanStatus,proto3,enum=file.service.v1.FileScanStatus,oneof" json:"scan_status,omitempty"` // 内容扫描状态
+	ScanResult    *string                `protobuf:"bytes,16,opt,name=scan_result,json=scanResult,proto3,oneof" json:"scan_result,omitempty"`                                      // 扫描结果
+	TenantId      *uint32                `protobuf:"varint,40,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                                           // 租户ID，0代表系统全局角色
+	TenantName    *string                `protobuf:"bytes,41,opt,name=tenant_name,json=tenantName,proto3,oneof" json:"tenant_name,omitempty"`                                      // 租户名称
+	CreatedBy     *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                                       // 创建者ID
+	UpdatedBy     *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                                       // 更新者ID
+	DeletedBy     *uint32                `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`                                       // 删除者用户ID
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                                        // 创建时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`                                        // 更新时间
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                                        // 删除时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -239,6 +292,27 @@ func (x *File) GetContentHash() string {
 	return ""
 }
 
+func (x *File) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+func (x *File) GetScanStatus() FileScanStatus {
+	if x != nil && x.ScanStatus != nil {
+		return *x.ScanStatus
+	}
+	return FileScanStatus_UNSCANNED
+}
+
+func (x *File) GetScanResult() string {
+	if x != nil && x.ScanResult != nil {
+		return *x.ScanResult
+	}
+	return ""
+}
+
 func (x *File) GetTenantId() uint32 {
 	if x != nil && x.TenantId != nil {
 		return *x.TenantId
@@ -586,7 +660,7 @@ var File_file_service_v1_file_proto protoreflect.FileDescriptor
 
 const file_file_service_v1_file_proto_rawDesc = "" +
 	"\n" +
-	"\x1afile/service/v1/file.proto\x12\x0ffile.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\x81\x0e\n" +
+	"\x1afile/service/v1/file.proto\x12\x0ffile.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xdc\x10\n" +
 	"\x04File\x12&\n" +
 	"\x02id\x18\x01 \x01(\rB\x11\xe0A\x01\xbaG\v\x92\x02\b文件IDH\x00R\x02id\x88\x01\x01\x12Q\n" +
 	"\bprovider\x18\x02 \x01(\x0e2\x1c.file.service.v1.OSSProviderB\x12\xbaG\x0f\x92\x02\fOSS供应商H\x01R\bprovider\x88\x01\x01\x12;\n" +
@@ -604,22 +678,27 @@ const file_file_service_v1_file_proto_rawDesc = "" +
 	"sizeFormat\x88\x01\x01\x122\n" +
 	"\blink_url\x18\v \x01(\tB\x12\xbaG\x0f\x92\x02\f链接地址H\n" +
 	"R\alinkUrl\x88\x01\x01\x12A\n" +
-	"\fcontent_hash\x18\f \x01(\tB\x19\xbaG\x16\x92\x02\x13文件内容hash值H\vR\vcontentHash\x88\x01\x01\x12L\n" +
-	"\ttenant_id\x18( \x01(\rB*\xbaG'\x92\x02$租户ID，0代表系统全局角色H\fR\btenantId\x88\x01\x01\x128\n" +
-	"\vtenant_name\x18) \x01(\tB\x12\xbaG\x0f\x92\x02\f租户名称H\rR\n" +
+	"\fcontent_hash\x18\f \x01(\tB\x19\xbaG\x16\x92\x02\x13文件内容hash值H\vR\vcontentHash\x88\x01\x01\x12]\n" +
+	"\fcontent_type\x18\x0e \x01(\tB5\xbaG2:\x11\x12\x0fapplication/pdf\x92\x02\x1c文件内容类型（MIME）H\fR\vcontentType\x88\x01\x01\x12_\n" +
+	"\vscan_status\x18\x0f \x01(\x0e2\x1f.file.service.v1.FileScanStatusB\x18\xbaG\x15\x92\x02\x12内容扫描状态H\rR\n" +
+	"scanStatus\x88\x01\x01\x12h\n" +
+	"\vscan_result\x18\x10 \x01(\tBB\xbaG?\x92\x02<扫描结果，如命中的威胁特征或扫描失败原因H\x0eR\n" +
+	"scanResult\x88\x01\x01\x12L\n" +
+	"\ttenant_id\x18( \x01(\rB*\xbaG'\x92\x02$租户ID，0代表系统全局角色H\x0fR\btenantId\x88\x01\x01\x128\n" +
+	"\vtenant_name\x18) \x01(\tB\x12\xbaG\x0f\x92\x02\f租户名称H\x10R\n" +
 	"tenantName\x88\x01\x01\x125\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x0eR\tcreatedBy\x88\x01\x01\x125\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x11R\tcreatedBy\x88\x01\x01\x125\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x0fR\tupdatedBy\x88\x01\x01\x12;\n" +
+	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x12R\tupdatedBy\x88\x01\x01\x12;\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x10R\tdeletedBy\x88\x01\x01\x12S\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x13R\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x11R\tcreatedAt\x88\x01\x01\x12S\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x14R\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x12R\tupdatedAt\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x15R\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x13R\tdeletedAt\x88\x01\x01B\x05\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x16R\tdeletedAt\x88\x01\x01B\x05\n" +
 	"\x03_idB\v\n" +
 	"\t_providerB\x0e\n" +
 	"\f_bucket_nameB\x11\n" +
@@ -634,7 +713,10 @@ const file_file_service_v1_file_proto_rawDesc = "" +
 	"\x05_sizeB\x0e\n" +
 	"\f_size_formatB\v\n" +
 	"\t_link_urlB\x0f\n" +
-	"\r_content_hashB\f\n" +
+	"\r_content_hashB\x0f\n" +
+	"\r_content_typeB\x0e\n" +
+	"\f_scan_statusB\x0e\n" +
+	"\f_scan_resultB\f\n" +
 	"\n" +
 	"_tenant_idB\x0e\n" +
 	"\f_tenant_nameB\r\n" +
@@ -680,7 +762,11 @@ const file_file_service_v1_file_proto_rawDesc = "" +
 	"\n" +
 	"\x06HUAWEI\x10\b\x12\t\n" +
 	"\x05LOCAL\x10\n" +
-	"2\xee\x02\n" +
+	"*;\n" +
+	"\x0eFileScanStatus\x12\r\n" +
+	"\tUNSCANNED\x10\x00\x12\t\n" +
+	"\x05CLEAN\x10\x01\x12\x0f\n" +
+	"\vQUARANTINED\x10\x022\xee\x02\n" +
 	"\vFileService\x12F\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a!.file.service.v1.ListFileResponse\"\x00\x12?\n" +
 	"\x03Get\x12\x1f.file.service.v1.GetFileRequest\x1a\x15.file.service.v1.File\"\x00\x12F\n" +
@@ -701,46 +787,48 @@ func file_file_service_v1_file_proto_rawDescGZIP() []byte {
 	return file_file_service_v1_file_proto_rawDescData
 }
 
-var file_file_service_v1_file_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_file_service_v1_file_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_file_service_v1_file_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_file_service_v1_file_proto_goTypes = []any{
 	(OSSProvider)(0),              // 0: file.service.v1.OSSProvider
-	(*File)(nil),                  // 1: file.service.v1.File
-	(*ListFileResponse)(nil),      // 2: file.service.v1.ListFileResponse
-	(*GetFileRequest)(nil),        // 3: file.service.v1.GetFileRequest
-	(*CreateFileRequest)(nil),     // 4: file.service.v1.CreateFileRequest
-	(*UpdateFileRequest)(nil),     // 5: file.service.v1.UpdateFileRequest
-	(*DeleteFileRequest)(nil),     // 6: file.service.v1.DeleteFileRequest
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
-	(*v1.PagingRequest)(nil),      // 9: pagination.PagingRequest
-	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
+	(FileScanStatus)(0),           // 1: file.service.v1.FileScanStatus
+	(*File)(nil),                  // 2: file.service.v1.File
+	(*ListFileResponse)(nil),      // 3: file.service.v1.ListFileResponse
+	(*GetFileRequest)(nil),        // 4: file.service.v1.GetFileRequest
+	(*CreateFileRequest)(nil),     // 5: file.service.v1.CreateFileRequest
+	(*UpdateFileRequest)(nil),     // 6: file.service.v1.UpdateFileRequest
+	(*DeleteFileRequest)(nil),     // 7: file.service.v1.DeleteFileRequest
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 9: google.protobuf.FieldMask
+	(*v1.PagingRequest)(nil),      // 10: pagination.PagingRequest
+	(*emptypb.Empty)(nil),         // 11: google.protobuf.Empty
 }
 var file_file_service_v1_file_proto_depIdxs = []int32{
 	0,  // 0: file.service.v1.File.provider:type_name -> file.service.v1.OSSProvider
-	7,  // 1: file.service.v1.File.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 2: file.service.v1.File.updated_at:type_name -> google.protobuf.Timestamp
-	7,  // 3: file.service.v1.File.deleted_at:type_name -> google.protobuf.Timestamp
-	1,  // 4: file.service.v1.ListFileResponse.items:type_name -> file.service.v1.File
-	8,  // 5: file.service.v1.GetFileRequest.view_mask:type_name -> google.protobuf.FieldMask
-	1,  // 6: file.service.v1.CreateFileRequest.data:type_name -> file.service.v1.File
-	1,  // 7: file.service.v1.UpdateFileRequest.data:type_name -> file.service.v1.File
-	8,  // 8: file.service.v1.UpdateFileRequest.update_mask:type_name -> google.protobuf.FieldMask
-	9,  // 9: file.service.v1.FileService.List:input_type -> pagination.PagingRequest
-	3,  // 10: file.service.v1.FileService.Get:input_type -> file.service.v1.GetFileRequest
-	4,  // 11: file.service.v1.FileService.Create:input_type -> file.service.v1.CreateFileRequest
-	5,  // 12: file.service.v1.FileService.Update:input_type -> file.service.v1.UpdateFileRequest
-	6,  // 13: file.service.v1.FileService.Delete:input_type -> file.service.v1.DeleteFileRequest
-	2,  // 14: file.service.v1.FileService.List:output_type -> file.service.v1.ListFileResponse
-	1,  // 15: file.service.v1.FileService.Get:output_type -> file.service.v1.File
-	10, // 16: file.service.v1.FileService.Create:output_type -> google.protobuf.Empty
-	10, // 17: file.service.v1.FileService.Update:output_type -> google.protobuf.Empty
-	10, // 18: file.service.v1.FileService.Delete:output_type -> google.protobuf.Empty
-	14, // [14:19] is the sub-list for method output_type
-	9,  // [9:14] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	1,  // 1: file.service.v1.File.scan_status:type_name -> file.service.v1.FileScanStatus
+	8,  // 2: file.service.v1.File.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: file.service.v1.File.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 4: file.service.v1.File.deleted_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: file.service.v1.ListFileResponse.items:type_name -> file.service.v1.File
+	9,  // 6: file.service.v1.GetFileRequest.view_mask:type_name -> google.protobuf.FieldMask
+	2,  // 7: file.service.v1.CreateFileRequest.data:type_name -> file.service.v1.File
+	2,  // 8: file.service.v1.UpdateFileRequest.data:type_name -> file.service.v1.File
+	9,  // 9: file.service.v1.UpdateFileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	10, // 10: file.service.v1.FileService.List:input_type -> pagination.PagingRequest
+	4,  // 11: file.service.v1.FileService.Get:input_type -> file.service.v1.GetFileRequest
+	5,  // 12: file.service.v1.FileService.Create:input_type -> file.service.v1.CreateFileRequest
+	6,  // 13: file.service.v1.FileService.Update:input_type -> file.service.v1.UpdateFileRequest
+	7,  // 14: file.service.v1.FileService.Delete:input_type -> file.service.v1.DeleteFileRequest
+	3,  // 15: file.service.v1.FileService.List:output_type -> file.service.v1.ListFileResponse
+	2,  // 16: file.service.v1.FileService.Get:output_type -> file.service.v1.File
+	11, // 17: file.service.v1.FileService.Create:output_type -> google.protobuf.Empty
+	11, // 18: file.service.v1.FileService.Update:output_type -> google.protobuf.Empty
+	11, // 19: file.service.v1.FileService.Delete:output_type -> google.protobuf.Empty
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_file_service_v1_file_proto_init() }
@@ -758,7 +846,7 @@ func file_file_service_v1_file_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_file_service_v1_file_proto_rawDesc), len(file_file_service_v1_file_proto_rawDesc)),
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
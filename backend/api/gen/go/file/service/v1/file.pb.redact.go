@@ -133,6 +133,12 @@ func (x *File) Redact() string {
 
 	// Safe field: ContentHash
 
+	// Safe field: ContentType
+
+	// Safe field: ScanStatus
+
+	// Safe field: ScanResult
+
 	// Safe field: TenantId
 
 	// Safe field: TenantName
@@ -104,6 +104,18 @@ func (m *File) validate(all bool) error {
 		// no validation rules for ContentHash
 	}
 
+	if m.ContentType != nil {
+		// no validation rules for ContentType
+	}
+
+	if m.ScanStatus != nil {
+		// no validation rules for ScanStatus
+	}
+
+	if m.ScanResult != nil {
+		// no validation rules for ScanResult
+	}
+
 	if m.TenantId != nil {
 		// no validation rules for TenantId
 	}
@@ -29,11 +29,15 @@ const (
 	// 400
 	FileErrorReason_BAD_REQUEST FileErrorReason = 0 // 错误请求
 	// 401
-	FileErrorReason_UNAUTHORIZED FileErrorReason = 100 // 未授权
+	FileErrorReason_UNAUTHORIZED                  FileErrorReason = 100 // 未授权
+	FileErrorReason_FILE_SHARE_PASSWORD_REQUIRED  FileErrorReason = 101 // 分享链接需要访问密码
+	FileErrorReason_FILE_SHARE_PASSWORD_INCORRECT FileErrorReason = 102 // 分享链接访问密码错误
 	// 402
 	FileErrorReason_PAYMENT_REQUIRED FileErrorReason = 200 // 需要支付
 	// 403
-	FileErrorReason_FORBIDDEN FileErrorReason = 300 // 禁止访问
+	FileErrorReason_FORBIDDEN                FileErrorReason = 300 // 禁止访问
+	FileErrorReason_FILE_QUARANTINED         FileErrorReason = 301 // 文件已被隔离
+	FileErrorReason_FILE_SHARE_ACCESS_DENIED FileErrorReason = 302 // 无权访问分享链接
 	// 404
 	FileErrorReason_NOT_FOUND      FileErrorReason = 400 // 找不到资源
 	FileErrorReason_FILE_NOT_FOUND FileErrorReason = 401 // 文件不存在
@@ -48,7 +52,9 @@ const (
 	// 409
 	FileErrorReason_CONFLICT FileErrorReason = 900 // 冲突
 	// 410
-	FileErrorReason_GONE FileErrorReason = 1000 // 已删除
+	FileErrorReason_GONE                              FileErrorReason = 1000 // 已删除
+	FileErrorReason_FILE_SHARE_EXPIRED                FileErrorReason = 1001 // 分享链接已过期或已停用
+	FileErrorReason_FILE_SHARE_DOWNLOAD_LIMIT_REACHED FileErrorReason = 1002 // 分享链接已达到最大下载次数
 	// 411
 	FileErrorReason_LENGTH_REQUIRED FileErrorReason = 1010 // 需要Content-Length
 	// 412
@@ -60,6 +66,8 @@ const (
 	FileErrorReason_URI_TOO_LONG FileErrorReason = 1040 // URI过长
 	// 415
 	FileErrorReason_UNSUPPORTED_MEDIA_TYPE FileErrorReason = 1050 // 不支持的媒体类型
+	FileErrorReason_FILE_TYPE_NOT_ALLOWED  FileErrorReason = 1051 // 文件类型不允许上传
+	FileErrorReason_FILE_TYPE_MISMATCH     FileErrorReason = 1052 // 文件内容与扩展名或声明的类型不一致
 	// 416
 	FileErrorReason_RANGE_NOT_SATISFIABLE FileErrorReason = 1060 // 请求范围无法满足
 	// 417
@@ -104,7 +112,8 @@ const (
 	// 506
 	FileErrorReason_VARIANT_ALSO_NEGOTIATES FileErrorReason = 2600 // 变体也协商
 	// 507
-	FileErrorReason_INSUFFICIENT_STORAGE FileErrorReason = 2700 // 存储空间不足
+	FileErrorReason_INSUFFICIENT_STORAGE   FileErrorReason = 2700 // 存储空间不足
+	FileErrorReason_STORAGE_QUOTA_EXCEEDED FileErrorReason = 2701 // 超出存储配额
 	// 508
 	FileErrorReason_LOOP_DETECTED FileErrorReason = 2800 // 检测到循环
 	// 510
@@ -122,8 +131,12 @@ var (
 	FileErrorReason_name = map[int32]string{
 		0:    "BAD_REQUEST",
 		100:  "UNAUTHORIZED",
+		101:  "FILE_SHARE_PASSWORD_REQUIRED",
+		102:  "FILE_SHARE_PASSWORD_INCORRECT",
 		200:  "PAYMENT_REQUIRED",
 		300:  "FORBIDDEN",
+		301:  "FILE_QUARANTINED",
+		302:  "FILE_SHARE_ACCESS_DENIED",
 		400:  "NOT_FOUND",
 		401:  "FILE_NOT_FOUND",
 		500:  "METHOD_NOT_ALLOWED",
@@ -132,12 +145,16 @@ var (
 		800:  "REQUEST_TIMEOUT",
 		900:  "CONFLICT",
 		1000: "GONE",
+		1001: "FILE_SHARE_EXPIRED",
+		1002: "FILE_SHARE_DOWNLOAD_LIMIT_REACHED",
 		1010: "LENGTH_REQUIRED",
 		1020: "PRECONDITION_FAILED",
 		1030: "PAYLOAD_TOO_LARGE",
 		1031: "FILE_TOO_LARGE",
 		1040: "URI_TOO_LONG",
 		1050: "UNSUPPORTED_MEDIA_TYPE",
+		1051: "FILE_TYPE_NOT_ALLOWED",
+		1052: "FILE_TYPE_MISMATCH",
 		1060: "RANGE_NOT_SATISFIABLE",
 		1070: "EXPECTATION_FAILED",
 		1080: "IM_A_TEAPOT",
@@ -162,6 +179,7 @@ var (
 		2500: "HTTP_VERSION_NOT_SUPPORTED",
 		2600: "VARIANT_ALSO_NEGOTIATES",
 		2700: "INSUFFICIENT_STORAGE",
+		2701: "STORAGE_QUOTA_EXCEEDED",
 		2800: "LOOP_DETECTED",
 		2900: "NOT_EXTENDED",
 		3000: "NETWORK_AUTHENTICATION_REQUIRED",
@@ -169,53 +187,62 @@ var (
 		3200: "NETWORK_CONNECT_TIMEOUT_ERROR",
 	}
 	FileErrorReason_value = map[string]int32{
-		"BAD_REQUEST":                     0,
-		"UNAUTHORIZED":                    100,
-		"PAYMENT_REQUIRED":                200,
-		"FORBIDDEN":                       300,
-		"NOT_FOUND":                       400,
-		"FILE_NOT_FOUND":                  401,
-		"METHOD_NOT_ALLOWED":              500,
-		"NOT_ACCEPTABLE":                  600,
-		"PROXY_AUTHENTICATION_REQUIRED":   700,
-		"REQUEST_TIMEOUT":                 800,
-		"CONFLICT":                        900,
-		"GONE":                            1000,
-		"LENGTH_REQUIRED":                 1010,
-		"PRECONDITION_FAILED":             1020,
-		"PAYLOAD_TOO_LARGE":               1030,
-		"FILE_TOO_LARGE":                  1031,
-		"URI_TOO_LONG":                    1040,
-		"UNSUPPORTED_MEDIA_TYPE":          1050,
-		"RANGE_NOT_SATISFIABLE":           1060,
-		"EXPECTATION_FAILED":              1070,
-		"IM_A_TEAPOT":                     1080,
-		"MISDIRECTED_REQUEST":             1090,
-		"UNPROCESSABLE_ENTITY":            1100,
-		"LOCKED":                          1110,
-		"FAILED_DEPENDENCY":               1120,
-		"TOO_EARLY":                       1130,
-		"UPGRADE_REQUIRED":                1140,
-		"PRECONDITION_REQUIRED":           1150,
-		"TOO_MANY_REQUESTS":               1160,
-		"REQUEST_HEADER_FIELDS_TOO_LARGE": 1170,
-		"UNAVAILABLE_FOR_LEGAL_REASONS":   1180,
-		"INTERNAL_SERVER_ERROR":           2000,
-		"UPLOAD_FAILED":                   2001,
-		"DOWNLOAD_FAILED":                 2002,
-		"DELETE_FAILED":                   2003,
-		"NOT_IMPLEMENTED":                 2100,
-		"BAD_GATEWAY":                     2200,
-		"SERVICE_UNAVAILABLE":             2300,
-		"GATEWAY_TIMEOUT":                 2400,
-		"HTTP_VERSION_NOT_SUPPORTED":      2500,
-		"VARIANT_ALSO_NEGOTIATES":         2600,
-		"INSUFFICIENT_STORAGE":            2700,
-		"LOOP_DETECTED":                   2800,
-		"NOT_EXTENDED":                    2900,
-		"NETWORK_AUTHENTICATION_REQUIRED": 3000,
-		"NETWORK_READ_TIMEOUT_ERROR":      3100,
-		"NETWORK_CONNECT_TIMEOUT_ERROR":   3200,
+		"BAD_REQUEST":                       0,
+		"UNAUTHORIZED":                      100,
+		"FILE_SHARE_PASSWORD_REQUIRED":      101,
+		"FILE_SHARE_PASSWORD_INCORRECT":     102,
+		"PAYMENT_REQUIRED":                  200,
+		"FORBIDDEN":                         300,
+		"FILE_QUARANTINED":                  301,
+		"FILE_SHARE_ACCESS_DENIED":          302,
+		"NOT_FOUND":                         400,
+		"FILE_NOT_FOUND":                    401,
+		"METHOD_NOT_ALLOWED":                500,
+		"NOT_ACCEPTABLE":                    600,
+		"PROXY_AUTHENTICATION_REQUIRED":     700,
+		"REQUEST_TIMEOUT":                   800,
+		"CONFLICT":                          900,
+		"GONE":                              1000,
+		"FILE_SHARE_EXPIRED":                1001,
+		"FILE_SHARE_DOWNLOAD_LIMIT_REACHED": 1002,
+		"LENGTH_REQUIRED":                   1010,
+		"PRECONDITION_FAILED":               1020,
+		"PAYLOAD_TOO_LARGE":                 1030,
+		"FILE_TOO_LARGE":                    1031,
+		"URI_TOO_LONG":                      1040,
+		"UNSUPPORTED_MEDIA_TYPE":            1050,
+		"FILE_TYPE_NOT_ALLOWED":             1051,
+		"FILE_TYPE_MISMATCH":                1052,
+		"RANGE_NOT_SATISFIABLE":             1060,
+		"EXPECTATION_FAILED":                1070,
+		"IM_A_TEAPOT":                       1080,
+		"MISDIRECTED_REQUEST":               1090,
+		"UNPROCESSABLE_ENTITY":              1100,
+		"LOCKED":                            1110,
+		"FAILED_DEPENDENCY":                 1120,
+		"TOO_EARLY":                         1130,
+		"UPGRADE_REQUIRED":                  1140,
+		"PRECONDITION_REQUIRED":             1150,
+		"TOO_MANY_REQUESTS":                 1160,
+		"REQUEST_HEADER_FIELDS_TOO_LARGE":   1170,
+		"UNAVAILABLE_FOR_LEGAL_REASONS":     1180,
+		"INTERNAL_SERVER_ERROR":             2000,
+		"UPLOAD_FAILED":                     2001,
+		"DOWNLOAD_FAILED":                   2002,
+		"DELETE_FAILED":                     2003,
+		"NOT_IMPLEMENTED":                   2100,
+		"BAD_GATEWAY":                       2200,
+		"SERVICE_UNAVAILABLE":               2300,
+		"GATEWAY_TIMEOUT":                   2400,
+		"HTTP_VERSION_NOT_SUPPORTED":        2500,
+		"VARIANT_ALSO_NEGOTIATES":           2600,
+		"INSUFFICIENT_STORAGE":              2700,
+		"STORAGE_QUOTA_EXCEEDED":            2701,
+		"LOOP_DETECTED":                     2800,
+		"NOT_EXTENDED":                      2900,
+		"NETWORK_AUTHENTICATION_REQUIRED":   3000,
+		"NETWORK_READ_TIMEOUT_ERROR":        3100,
+		"NETWORK_CONNECT_TIMEOUT_ERROR":     3200,
 	}
 )
 
@@ -250,12 +277,16 @@ var File_file_service_v1_file_error_proto protoreflect.FileDescriptor
 
 const file_file_service_v1_file_error_proto_rawDesc = "" +
 	"\n" +
-	" file/service/v1/file_error.proto\x12\x0ffile.service.v1\x1a\x13errors/errors.proto*\x93\v\n" +
+	" file/service/v1/file_error.proto\x12\x0ffile.service.v1\x1a\x13errors/errors.proto*\xd7\r\n" +
 	"\x0fFileErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x16\n" +
-	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
+	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12&\n" +
+	"\x1cFILE_SHARE_PASSWORD_REQUIRED\x10e\x1a\x04\xa8E\x91\x03\x12'\n" +
+	"\x1dFILE_SHARE_PASSWORD_INCORRECT\x10f\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
 	"\x10PAYMENT_REQUIRED\x10\xc8\x01\x1a\x04\xa8E\x92\x03\x12\x14\n" +
-	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
+	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x1b\n" +
+	"\x10FILE_QUARANTINED\x10\xad\x02\x1a\x04\xa8E\x93\x03\x12#\n" +
+	"\x18FILE_SHARE_ACCESS_DENIED\x10\xae\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
 	"\tNOT_FOUND\x10\x90\x03\x1a\x04\xa8E\x94\x03\x12\x19\n" +
 	"\x0eFILE_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
 	"\x12METHOD_NOT_ALLOWED\x10\xf4\x03\x1a\x04\xa8E\x95\x03\x12\x19\n" +
@@ -263,13 +294,17 @@ const file_file_service_v1_file_error_proto_rawDesc = "" +
 	"\x1dPROXY_AUTHENTICATION_REQUIRED\x10\xbc\x05\x1a\x04\xa8E\x97\x03\x12\x1a\n" +
 	"\x0fREQUEST_TIMEOUT\x10\xa0\x06\x1a\x04\xa8E\x98\x03\x12\x13\n" +
 	"\bCONFLICT\x10\x84\a\x1a\x04\xa8E\x99\x03\x12\x0f\n" +
-	"\x04GONE\x10\xe8\a\x1a\x04\xa8E\x9a\x03\x12\x1a\n" +
+	"\x04GONE\x10\xe8\a\x1a\x04\xa8E\x9a\x03\x12\x1d\n" +
+	"\x12FILE_SHARE_EXPIRED\x10\xe9\a\x1a\x04\xa8E\x9a\x03\x12,\n" +
+	"!FILE_SHARE_DOWNLOAD_LIMIT_REACHED\x10\xea\a\x1a\x04\xa8E\x9a\x03\x12\x1a\n" +
 	"\x0fLENGTH_REQUIRED\x10\xf2\a\x1a\x04\xa8E\x9b\x03\x12\x1e\n" +
 	"\x13PRECONDITION_FAILED\x10\xfc\a\x1a\x04\xa8E\x9c\x03\x12\x1c\n" +
 	"\x11PAYLOAD_TOO_LARGE\x10\x86\b\x1a\x04\xa8E\x9d\x03\x12\x19\n" +
 	"\x0eFILE_TOO_LARGE\x10\x87\b\x1a\x04\xa8E\x9d\x03\x12\x17\n" +
 	"\fURI_TOO_LONG\x10\x90\b\x1a\x04\xa8E\x9e\x03\x12!\n" +
 	"\x16UNSUPPORTED_MEDIA_TYPE\x10\x9a\b\x1a\x04\xa8E\x9f\x03\x12 \n" +
+	"\x15FILE_TYPE_NOT_ALLOWED\x10\x9b\b\x1a\x04\xa8E\x9f\x03\x12\x1d\n" +
+	"\x12FILE_TYPE_MISMATCH\x10\x9c\b\x1a\x04\xa8E\x9f\x03\x12 \n" +
 	"\x15RANGE_NOT_SATISFIABLE\x10\xa4\b\x1a\x04\xa8E\xa0\x03\x12\x1d\n" +
 	"\x12EXPECTATION_FAILED\x10\xae\b\x1a\x04\xa8E\xa1\x03\x12\x16\n" +
 	"\vIM_A_TEAPOT\x10\xb8\b\x1a\x04\xa8E\xa2\x03\x12\x1e\n" +
@@ -293,7 +328,8 @@ const file_file_service_v1_file_error_proto_rawDesc = "" +
 	"\x0fGATEWAY_TIMEOUT\x10\xe0\x12\x1a\x04\xa8E\xf8\x03\x12%\n" +
 	"\x1aHTTP_VERSION_NOT_SUPPORTED\x10\xc4\x13\x1a\x04\xa8E\xf9\x03\x12\"\n" +
 	"\x17VARIANT_ALSO_NEGOTIATES\x10\xa8\x14\x1a\x04\xa8E\xfa\x03\x12\x1f\n" +
-	"\x14INSUFFICIENT_STORAGE\x10\x8c\x15\x1a\x04\xa8E\xfb\x03\x12\x18\n" +
+	"\x14INSUFFICIENT_STORAGE\x10\x8c\x15\x1a\x04\xa8E\xfb\x03\x12!\n" +
+	"\x16STORAGE_QUOTA_EXCEEDED\x10\x8d\x15\x1a\x04\xa8E\xfb\x03\x12\x18\n" +
 	"\rLOOP_DETECTED\x10\xf0\x15\x1a\x04\xa8E\xfc\x03\x12\x17\n" +
 	"\fNOT_EXTENDED\x10\xd4\x16\x1a\x04\xa8E\xfe\x03\x12*\n" +
 	"\x1fNETWORK_AUTHENTICATION_REQUIRED\x10\xb8\x17\x1a\x04\xa8E\xff\x03\x12%\n" +
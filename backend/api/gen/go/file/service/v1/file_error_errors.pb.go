@@ -39,6 +39,34 @@ func ErrorUnauthorized(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, FileErrorReason_UNAUTHORIZED.String(), fmt.Sprintf(format, args...))
 }
 
+// 分享链接需要访问密码
+func IsFileSharePasswordRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == FileErrorReason_FILE_SHARE_PASSWORD_REQUIRED.String() && e.Code == 401
+}
+
+// 分享链接需要访问密码
+func ErrorFileSharePasswordRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, FileErrorReason_FILE_SHARE_PASSWORD_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
+// 分享链接访问密码错误
+func IsFileSharePasswordIncorrect(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == FileErrorReason_FILE_SHARE_PASSWORD_INCORRECT.String() && e.Code == 401
+}
+
+// 分享链接访问密码错误
+func ErrorFileSharePasswordIncorrect(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, FileErrorReason_FILE_SHARE_PASSWORD_INCORRECT.String(), fmt.Sprintf(format, args...))
+}
+
 // 402
 func IsPaymentRequired(err error) bool {
 	if err == nil {
@@ -67,6 +95,34 @@ func ErrorForbidden(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, FileErrorReason_FORBIDDEN.String(), fmt.Sprintf(format, args...))
 }
 
+// 文件已被隔离
+func IsFileQuarantined(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == FileErrorReason_FILE_QUARANTINED.String() && e.Code == 403
+}
+
+// 文件已被隔离
+func ErrorFileQuarantined(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, FileErrorReason_FILE_QUARANTINED.String(), fmt.Sprintf(format, args...))
+}
+
+// 无权访问分享链接
+func IsFileShareAccessDenied(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == FileErrorReason_FILE_SHARE_ACCESS_DENIED.String() && e.Code == 403
+}
+
+// 无权访问分享链接
+func ErrorFileShareAccessDenied(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, FileErrorReason_FILE_SHARE_ACCESS_DENIED.String(), fmt.Sprintf(format, args...))
+}
+
 // 404
 func IsNotFound(err error) bool {
 	if err == nil {
@@ -179,6 +235,34 @@ func ErrorGone(format string, args ...interface{}) *errors.Error {
 	return errors.New(410, FileErrorReason_GONE.String(), fmt.Sprintf(format, args...))
 }
 
+// 分享链接已过期或已停用
+func IsFileShareExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == FileErrorReason_FILE_SHARE_EXPIRED.String() && e.Code == 410
+}
+
+// 分享链接已过期或已停用
+func ErrorFileShareExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(410, FileErrorReason_FILE_SHARE_EXPIRED.String(), fmt.Sprintf(format, args...))
+}
+
+// 分享链接已达到最大下载次数
+func IsFileShareDownloadLimitReached(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == FileErrorReason_FILE_SHARE_DOWNLOAD_LIMIT_REACHED.String() && e.Code == 410
+}
+
+// 分享链接已达到最大下载次数
+func ErrorFileShareDownloadLimitReached(format string, args ...interface{}) *errors.Error {
+	return errors.New(410, FileErrorReason_FILE_SHARE_DOWNLOAD_LIMIT_REACHED.String(), fmt.Sprintf(format, args...))
+}
+
 // 411
 func IsLengthRequired(err error) bool {
 	if err == nil {
@@ -263,6 +347,34 @@ func ErrorUnsupportedMediaType(format string, args ...interface{}) *errors.Error
 	return errors.New(415, FileErrorReason_UNSUPPORTED_MEDIA_TYPE.String(), fmt.Sprintf(format, args...))
 }
 
+// 文件类型不允许上传
+func IsFileTypeNotAllowed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == FileErrorReason_FILE_TYPE_NOT_ALLOWED.String() && e.Code == 415
+}
+
+// 文件类型不允许上传
+func ErrorFileTypeNotAllowed(format string, args ...interface{}) *errors.Error {
+	return errors.New(415, FileErrorReason_FILE_TYPE_NOT_ALLOWED.String(), fmt.Sprintf(format, args...))
+}
+
+// 文件内容与扩展名或声明的类型不一致
+func IsFileTypeMismatch(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == FileErrorReason_FILE_TYPE_MISMATCH.String() && e.Code == 415
+}
+
+// 文件内容与扩展名或声明的类型不一致
+func ErrorFileTypeMismatch(format string, args ...interface{}) *errors.Error {
+	return errors.New(415, FileErrorReason_FILE_TYPE_MISMATCH.String(), fmt.Sprintf(format, args...))
+}
+
 // 416
 func IsRangeNotSatisfiable(err error) bool {
 	if err == nil {
@@ -593,6 +705,20 @@ func ErrorInsufficientStorage(format string, args ...interface{}) *errors.Error
 	return errors.New(507, FileErrorReason_INSUFFICIENT_STORAGE.String(), fmt.Sprintf(format, args...))
 }
 
+// 超出存储配额
+func IsStorageQuotaExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == FileErrorReason_STORAGE_QUOTA_EXCEEDED.String() && e.Code == 507
+}
+
+// 超出存储配额
+func ErrorStorageQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(507, FileErrorReason_STORAGE_QUOTA_EXCEEDED.String(), fmt.Sprintf(format, args...))
+}
+
 // 508
 func IsLoopDetected(err error) bool {
 	if err == nil {
@@ -24,6 +24,83 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 图片衍生图请求
+type GetFileVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	W             *uint32                `protobuf:"varint,2,opt,name=w,proto3,oneof" json:"w,omitempty"`
+	H             *uint32                `protobuf:"varint,3,opt,name=h,proto3,oneof" json:"h,omitempty"`
+	Fit           *string                `protobuf:"bytes,4,opt,name=fit,proto3,oneof" json:"fit,omitempty"`
+	Fmt           *string                `protobuf:"bytes,5,opt,name=fmt,proto3,oneof" json:"fmt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileVariantRequest) Reset() {
+	*x = GetFileVariantRequest{}
+	mi := &file_file_service_v1_file_transfer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileVariantRequest) ProtoMessage() {}
+
+func (x *GetFileVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_v1_file_transfer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileVariantRequest.ProtoReflect.Descriptor instead.
+func (*GetFileVariantRequest) Descriptor() ([]byte, []int) {
+	return file_file_service_v1_file_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetFileVariantRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetFileVariantRequest) GetW() uint32 {
+	if x != nil && x.W != nil {
+		return *x.W
+	}
+	return 0
+}
+
+func (x *GetFileVariantRequest) GetH() uint32 {
+	if x != nil && x.H != nil {
+		return *x.H
+	}
+	return 0
+}
+
+func (x *GetFileVariantRequest) GetFit() string {
+	if x != nil && x.Fit != nil {
+		return *x.Fit
+	}
+	return ""
+}
+
+func (x *GetFileVariantRequest) GetFmt() string {
+	if x != nil && x.Fmt != nil {
+		return *x.Fmt
+	}
+	return ""
+}
+
 // 文件下载请求
 type DownloadFileRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -46,7 +123,7 @@ type DownloadFileRequest struct {
 
 func (x *DownloadFileRequest) Reset() {
 	*x = DownloadFileRequest{}
-	mi := &file_file_service_v1_file_transfer_proto_msgTypes[0]
+	mi := &file_file_service_v1_file_transfer_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -58,7 +135,7 @@ func (x *DownloadFileRequest) String() string {
 func (*DownloadFileRequest) ProtoMessage() {}
 
 func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_service_v1_file_transfer_proto_msgTypes[0]
+	mi := &file_file_service_v1_file_transfer_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -71,7 +148,7 @@ func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadFileRequest.ProtoReflect.Descriptor instead.
 func (*DownloadFileRequest) Descriptor() ([]byte, []int) {
-	return file_file_service_v1_file_transfer_proto_rawDescGZIP(), []int{0}
+	return file_file_service_v1_file_transfer_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *DownloadFileRequest) GetSelector() isDownloadFileRequest_Selector {
@@ -194,7 +271,7 @@ type DownloadFileResponse struct {
 
 func (x *DownloadFileResponse) Reset() {
 	*x = DownloadFileResponse{}
-	mi := &file_file_service_v1_file_transfer_proto_msgTypes[1]
+	mi := &file_file_service_v1_file_transfer_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -206,7 +283,7 @@ func (x *DownloadFileResponse) String() string {
 func (*DownloadFileResponse) ProtoMessage() {}
 
 func (x *DownloadFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_file_service_v1_file_transfer_proto_msgTypes[1]
+	mi := &file_file_service_v1_file_transfer_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +296,7 @@ func (x *DownloadFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadFileResponse.ProtoReflect.Descriptor instead.
 func (*DownloadFileResponse) Descriptor() ([]byte, []int) {
-	return file_file_service_v1_file_transfer_proto_rawDescGZIP(), []int{1}
+	return file_file_service_v1_file_transfer_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *DownloadFileResponse) GetContent() isDownloadFileResponse_Content {
@@ -322,7 +399,7 @@ type UploadFileRequest struct {
 
 func (x *UploadFileRequest) Reset() {
 	*x = UploadFileRequest{}
-	mi := &file_file_service_v1_file_transfer_proto_msgTypes[2]
+	mi := &file_file_service_v1_file_transfer_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -334,7 +411,7 @@ func (x *UploadFileRequest) String() string {
 func (*UploadFileRequest) ProtoMessage() {}
 
 func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_service_v1_file_transfer_proto_msgTypes[2]
+	mi := &file_file_service_v1_file_transfer_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +424,7 @@ func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadFileRequest.ProtoReflect.Descriptor instead.
 func (*UploadFileRequest) Descriptor() ([]byte, []int) {
-	return file_file_service_v1_file_transfer_proto_rawDescGZIP(), []int{2}
+	return file_file_service_v1_file_transfer_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UploadFileRequest) GetStorageObject() *StorageObject {
@@ -429,7 +506,7 @@ type UploadFileResponse struct {
 
 func (x *UploadFileResponse) Reset() {
 	*x = UploadFileResponse{}
-	mi := &file_file_service_v1_file_transfer_proto_msgTypes[3]
+	mi := &file_file_service_v1_file_transfer_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +518,7 @@ func (x *UploadFileResponse) String() string {
 func (*UploadFileResponse) ProtoMessage() {}
 
 func (x *UploadFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_file_service_v1_file_transfer_proto_msgTypes[3]
+	mi := &file_file_service_v1_file_transfer_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +531,7 @@ func (x *UploadFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadFileResponse.ProtoReflect.Descriptor instead.
 func (*UploadFileResponse) Descriptor() ([]byte, []int) {
-	return file_file_service_v1_file_transfer_proto_rawDescGZIP(), []int{3}
+	return file_file_service_v1_file_transfer_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UploadFileResponse) GetObjectName() string {
@@ -475,7 +552,17 @@ var File_file_service_v1_file_transfer_proto protoreflect.FileDescriptor
 
 const file_file_service_v1_file_transfer_proto_rawDesc = "" +
 	"\n" +
-	"#file/service/v1/file_transfer.proto\x12\x0ffile.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19file/service/v1/oss.proto\"\xd4\b\n" +
+	"#file/service/v1/file_transfer.proto\x12\x0ffile.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19file/service/v1/oss.proto\"\x9b\x03\n" +
+	"\x15GetFileVariantRequest\x12%\n" +
+	"\x02id\x18\x01 \x01(\rB\x15\xbaG\x12\x92\x02\x0f原图文件 IDR\x02id\x12H\n" +
+	"\x01w\x18\x02 \x01(\rB5\xbaG2\x92\x02/目标宽度，0 或不填表示按比例计算H\x00R\x01w\x88\x01\x01\x12H\n" +
+	"\x01h\x18\x03 \x01(\rB5\xbaG2\x92\x02/目标高度，0 或不填表示按比例计算H\x01R\x01h\x88\x01\x01\x12N\n" +
+	"\x03fit\x18\x04 \x01(\tB7\xbaG4\x92\x021缩放模式：cover（默认）、contain、fillH\x02R\x03fit\x88\x01\x01\x12[\n" +
+	"\x03fmt\x18\x05 \x01(\tBD\xbaGA\x92\x02>输出格式：jpeg、png、gif、webp，默认与原图相同H\x03R\x03fmt\x88\x01\x01B\x04\n" +
+	"\x02_wB\x04\n" +
+	"\x02_hB\x06\n" +
+	"\x04_fitB\x06\n" +
+	"\x04_fmt\"\xd4\b\n" +
 	"\x13DownloadFileRequest\x129\n" +
 	"\afile_id\x18\x01 \x01(\rB\x1e\xbaG\x1b\x92\x02\x18服务端内部文件 IDH\x00R\x06fileId\x12a\n" +
 	"\x0estorage_object\x18\x02 \x01(\v2\x1e.file.service.v1.StorageObjectB\x18\xbaG\x15\x92\x02\x12对象存储对象H\x00R\rstorageObject\x12\\\n" +
@@ -544,28 +631,29 @@ func file_file_service_v1_file_transfer_proto_rawDescGZIP() []byte {
 	return file_file_service_v1_file_transfer_proto_rawDescData
 }
 
-var file_file_service_v1_file_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_file_service_v1_file_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_file_service_v1_file_transfer_proto_goTypes = []any{
-	(*DownloadFileRequest)(nil),   // 0: file.service.v1.DownloadFileRequest
-	(*DownloadFileResponse)(nil),  // 1: file.service.v1.DownloadFileResponse
-	(*UploadFileRequest)(nil),     // 2: file.service.v1.UploadFileRequest
-	(*UploadFileResponse)(nil),    // 3: file.service.v1.UploadFileResponse
-	(*StorageObject)(nil),         // 4: file.service.v1.StorageObject
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
-	(*PresignOption)(nil),         // 6: file.service.v1.PresignOption
-	(*httpbody.HttpBody)(nil),     // 7: google.api.HttpBody
+	(*GetFileVariantRequest)(nil), // 0: file.service.v1.GetFileVariantRequest
+	(*DownloadFileRequest)(nil),   // 1: file.service.v1.DownloadFileRequest
+	(*DownloadFileResponse)(nil),  // 2: file.service.v1.DownloadFileResponse
+	(*UploadFileRequest)(nil),     // 3: file.service.v1.UploadFileRequest
+	(*UploadFileResponse)(nil),    // 4: file.service.v1.UploadFileResponse
+	(*StorageObject)(nil),         // 5: file.service.v1.StorageObject
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*PresignOption)(nil),         // 7: file.service.v1.PresignOption
+	(*httpbody.HttpBody)(nil),     // 8: google.api.HttpBody
 }
 var file_file_service_v1_file_transfer_proto_depIdxs = []int32{
-	4, // 0: file.service.v1.DownloadFileRequest.storage_object:type_name -> file.service.v1.StorageObject
-	5, // 1: file.service.v1.DownloadFileResponse.updated_at:type_name -> google.protobuf.Timestamp
-	4, // 2: file.service.v1.UploadFileRequest.storage_object:type_name -> file.service.v1.StorageObject
-	6, // 3: file.service.v1.UploadFileRequest.presign:type_name -> file.service.v1.PresignOption
-	0, // 4: file.service.v1.FileTransferService.DownloadFile:input_type -> file.service.v1.DownloadFileRequest
-	7, // 5: file.service.v1.FileTransferService.PutUploadFile:input_type -> google.api.HttpBody
-	7, // 6: file.service.v1.FileTransferService.PostUploadFile:input_type -> google.api.HttpBody
-	7, // 7: file.service.v1.FileTransferService.DownloadFile:output_type -> google.api.HttpBody
-	3, // 8: file.service.v1.FileTransferService.PutUploadFile:output_type -> file.service.v1.UploadFileResponse
-	3, // 9: file.service.v1.FileTransferService.PostUploadFile:output_type -> file.service.v1.UploadFileResponse
+	5, // 0: file.service.v1.DownloadFileRequest.storage_object:type_name -> file.service.v1.StorageObject
+	6, // 1: file.service.v1.DownloadFileResponse.updated_at:type_name -> google.protobuf.Timestamp
+	5, // 2: file.service.v1.UploadFileRequest.storage_object:type_name -> file.service.v1.StorageObject
+	7, // 3: file.service.v1.UploadFileRequest.presign:type_name -> file.service.v1.PresignOption
+	1, // 4: file.service.v1.FileTransferService.DownloadFile:input_type -> file.service.v1.DownloadFileRequest
+	8, // 5: file.service.v1.FileTransferService.PutUploadFile:input_type -> google.api.HttpBody
+	8, // 6: file.service.v1.FileTransferService.PostUploadFile:input_type -> google.api.HttpBody
+	8, // 7: file.service.v1.FileTransferService.DownloadFile:output_type -> google.api.HttpBody
+	4, // 8: file.service.v1.FileTransferService.PutUploadFile:output_type -> file.service.v1.UploadFileResponse
+	4, // 9: file.service.v1.FileTransferService.PostUploadFile:output_type -> file.service.v1.UploadFileResponse
 	7, // [7:10] is the sub-list for method output_type
 	4, // [4:7] is the sub-list for method input_type
 	4, // [4:4] is the sub-list for extension type_name
@@ -579,27 +667,28 @@ func file_file_service_v1_file_transfer_proto_init() {
 		return
 	}
 	file_file_service_v1_oss_proto_init()
-	file_file_service_v1_file_transfer_proto_msgTypes[0].OneofWrappers = []any{
+	file_file_service_v1_file_transfer_proto_msgTypes[0].OneofWrappers = []any{}
+	file_file_service_v1_file_transfer_proto_msgTypes[1].OneofWrappers = []any{
 		(*DownloadFileRequest_FileId)(nil),
 		(*DownloadFileRequest_StorageObject)(nil),
 		(*DownloadFileRequest_DownloadUrl)(nil),
 	}
-	file_file_service_v1_file_transfer_proto_msgTypes[1].OneofWrappers = []any{
+	file_file_service_v1_file_transfer_proto_msgTypes[2].OneofWrappers = []any{
 		(*DownloadFileResponse_File)(nil),
 		(*DownloadFileResponse_DownloadUrl)(nil),
 	}
-	file_file_service_v1_file_transfer_proto_msgTypes[2].OneofWrappers = []any{
+	file_file_service_v1_file_transfer_proto_msgTypes[3].OneofWrappers = []any{
 		(*UploadFileRequest_File)(nil),
 		(*UploadFileRequest_Presign)(nil),
 	}
-	file_file_service_v1_file_transfer_proto_msgTypes[3].OneofWrappers = []any{}
+	file_file_service_v1_file_transfer_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_file_service_v1_file_transfer_proto_rawDesc), len(file_file_service_v1_file_transfer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -66,6 +66,24 @@ func (s *redactedFileTransferServiceServer) PostUploadFile(stream grpc.ClientStr
 	return s.srv.PostUploadFile(stream)
 }
 
+// Redact method implementation for GetFileVariantRequest
+func (x *GetFileVariantRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: W
+
+	// Safe field: H
+
+	// Safe field: Fit
+
+	// Safe field: Fmt
+	return x.String()
+}
+
 // Redact method implementation for DownloadFileRequest
 func (x *DownloadFileRequest) Redact() string {
 	if x == nil {
@@ -35,6 +35,126 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on GetFileVariantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFileVariantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFileVariantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFileVariantRequestMultiError, or nil if none found.
+func (m *GetFileVariantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFileVariantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.W != nil {
+		// no validation rules for W
+	}
+
+	if m.H != nil {
+		// no validation rules for H
+	}
+
+	if m.Fit != nil {
+		// no validation rules for Fit
+	}
+
+	if m.Fmt != nil {
+		// no validation rules for Fmt
+	}
+
+	if len(errors) > 0 {
+		return GetFileVariantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileVariantRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFileVariantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFileVariantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileVariantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileVariantRequestMultiError) AllErrors() []error { return m }
+
+// GetFileVariantRequestValidationError is the validation error returned by
+// GetFileVariantRequest.Validate if the designated constraints aren't met.
+type GetFileVariantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileVariantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileVariantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileVariantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileVariantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileVariantRequestValidationError) ErrorName() string {
+	return "GetFileVariantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFileVariantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileVariantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileVariantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileVariantRequestValidationError{}
+
 // Validate checks the field values on DownloadFileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
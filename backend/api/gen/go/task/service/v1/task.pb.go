@@ -78,6 +78,56 @@ func (Task_Type) EnumDescriptor() ([]byte, []int) {
 	return file_task_service_v1_task_proto_rawDescGZIP(), []int{1, 0}
 }
 
+// 执行状态
+type TaskRun_Status int32
+
+const (
+	TaskRun_RUNNING   TaskRun_Status = 0 // 执行中
+	TaskRun_SUCCEEDED TaskRun_Status = 1 // 成功
+	TaskRun_FAILED    TaskRun_Status = 2 // 失败
+)
+
+// Enum value maps for TaskRun_Status.
+var (
+	TaskRun_Status_name = map[int32]string{
+		0: "RUNNING",
+		1: "SUCCEEDED",
+		2: "FAILED",
+	}
+	TaskRun_Status_value = map[string]int32{
+		"RUNNING":   0,
+		"SUCCEEDED": 1,
+		"FAILED":    2,
+	}
+)
+
+func (x TaskRun_Status) Enum() *TaskRun_Status {
+	p := new(TaskRun_Status)
+	*p = x
+	return p
+}
+
+func (x TaskRun_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRun_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_service_v1_task_proto_enumTypes[1].Descriptor()
+}
+
+func (TaskRun_Status) Type() protoreflect.EnumType {
+	return &file_task_service_v1_task_proto_enumTypes[1]
+}
+
+func (x TaskRun_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRun_Status.Descriptor instead.
+func (TaskRun_Status) EnumDescriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{2, 0}
+}
+
 // 调度任务控制类型
 type ControlTaskRequest_ControlType int32
 
@@ -85,6 +135,9 @@ const (
 	ControlTaskRequest_Start   ControlTaskRequest_ControlType = 0 // 启动
 	ControlTaskRequest_Stop    ControlTaskRequest_ControlType = 1 // 停止
 	ControlTaskRequest_Restart ControlTaskRequest_ControlType = 2 // 重启
+	ControlTaskRequest_RunNow  ControlTaskRequest_ControlType = 3 // 立即执行一次
+	ControlTaskRequest_Pause   ControlTaskRequest_ControlType = 4 // 暂停
+	ControlTaskRequest_Resume  ControlTaskRequest_ControlType = 5 // 恢复
 )
 
 // Enum value maps for ControlTaskRequest_ControlType.
@@ -93,11 +146,17 @@ var (
 		0: "Start",
 		1: "Stop",
 		2: "Restart",
+		3: "RunNow",
+		4: "Pause",
+		5: "Resume",
 	}
 	ControlTaskRequest_ControlType_value = map[string]int32{
 		"Start":   0,
 		"Stop":    1,
 		"Restart": 2,
+		"RunNow":  3,
+		"Pause":   4,
+		"Resume":  5,
 	}
 )
 
@@ -112,11 +171,11 @@ func (x ControlTaskRequest_ControlType) String() string {
 }
 
 func (ControlTaskRequest_ControlType) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_service_v1_task_proto_enumTypes[1].Descriptor()
+	return file_task_service_v1_task_proto_enumTypes[2].Descriptor()
 }
 
 func (ControlTaskRequest_ControlType) Type() protoreflect.EnumType {
-	return &file_task_service_v1_task_proto_enumTypes[1]
+	return &file_task_service_v1_task_proto_enumTypes[2]
 }
 
 func (x ControlTaskRequest_ControlType) Number() protoreflect.EnumNumber {
@@ -125,7 +184,69 @@ func (x ControlTaskRequest_ControlType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ControlTaskRequest_ControlType.Descriptor instead.
 func (ControlTaskRequest_ControlType) EnumDescriptor() ([]byte, []int) {
-	return file_task_service_v1_task_proto_rawDescGZIP(), []int{8, 0}
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{10, 0}
+}
+
+// 任务状态
+type QueueTask_State int32
+
+const (
+	QueueTask_PENDING     QueueTask_State = 0 // 待处理
+	QueueTask_ACTIVE      QueueTask_State = 1 // 处理中
+	QueueTask_SCHEDULED   QueueTask_State = 2 // 计划执行
+	QueueTask_RETRY       QueueTask_State = 3 // 等待重试
+	QueueTask_ARCHIVED    QueueTask_State = 4 // 已归档（死信）
+	QueueTask_COMPLETED   QueueTask_State = 5 // 已完成
+	QueueTask_AGGREGATING QueueTask_State = 6 // 等待聚合
+)
+
+// Enum value maps for QueueTask_State.
+var (
+	QueueTask_State_name = map[int32]string{
+		0: "PENDING",
+		1: "ACTIVE",
+		2: "SCHEDULED",
+		3: "RETRY",
+		4: "ARCHIVED",
+		5: "COMPLETED",
+		6: "AGGREGATING",
+	}
+	QueueTask_State_value = map[string]int32{
+		"PENDING":     0,
+		"ACTIVE":      1,
+		"SCHEDULED":   2,
+		"RETRY":       3,
+		"ARCHIVED":    4,
+		"COMPLETED":   5,
+		"AGGREGATING": 6,
+	}
+)
+
+func (x QueueTask_State) Enum() *QueueTask_State {
+	p := new(QueueTask_State)
+	*p = x
+	return p
+}
+
+func (x QueueTask_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueTask_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_service_v1_task_proto_enumTypes[3].Descriptor()
+}
+
+func (QueueTask_State) Type() protoreflect.EnumType {
+	return &file_task_service_v1_task_proto_enumTypes[3]
+}
+
+func (x QueueTask_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueTask_State.Descriptor instead.
+func (QueueTask_State) EnumDescriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{16, 0}
 }
 
 // 任务选项
@@ -248,6 +369,8 @@ type Task struct {
 	TaskOptions   *TaskOption            `protobuf:"bytes,6,opt,name=task_options,json=taskOptions,proto3,oneof" json:"task_options,omitempty"` // 任务选项
 	Enable        *bool                  `protobuf:"varint,10,opt,name=enable,proto3,oneof" json:"enable,omitempty"`                            // 启用/禁用任务
 	Remark        *string                `protobuf:"bytes,11,opt,name=remark,proto3,oneof" json:"remark,omitempty"`                             // 备注
+	LastRun       *TaskRun               `protobuf:"bytes,12,opt,name=last_run,json=lastRun,proto3,oneof" json:"last_run,omitempty"`            // 最近一次执行记录
+	Paused        *bool                  `protobuf:"varint,13,opt,name=paused,proto3,oneof" json:"paused,omitempty"`                            // 是否已暂停
 	TenantId      *uint32                `protobuf:"varint,20,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`        // 租户ID，0代表系统全局角色
 	CreatedBy     *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`    // 创建者ID
 	UpdatedBy     *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`    // 更新者ID
@@ -345,6 +468,20 @@ func (x *Task) GetRemark() string {
 	return ""
 }
 
+func (x *Task) GetLastRun() *TaskRun {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *Task) GetPaused() bool {
+	if x != nil && x.Paused != nil {
+		return *x.Paused
+	}
+	return false
+}
+
 func (x *Task) GetTenantId() uint32 {
 	if x != nil && x.TenantId != nil {
 		return *x.TenantId
@@ -394,6 +531,179 @@ func (x *Task) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// 任务执行记录
+type TaskRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                             // 执行记录ID
+	TaskId        *uint32                `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`                       // 调度任务ID
+	TypeName      *string                `protobuf:"bytes,3,opt,name=type_name,json=typeName,proto3,oneof" json:"type_name,omitempty"`                  // 任务执行类型名
+	QueueTaskId   *string                `protobuf:"bytes,4,opt,name=queue_task_id,json=queueTaskId,proto3,oneof" json:"queue_task_id,omitempty"`       // 任务队列中的任务ID
+	Queue         *string                `protobuf:"bytes,5,opt,name=queue,proto3,oneof" json:"queue,omitempty"`                                        // 任务队列名称
+	Status        *TaskRun_Status        `protobuf:"varint,6,opt,name=status,proto3,enum=task.service.v1.TaskRun_Status,oneof" json:"status,omitempty"` // 执行状态
+	RetryCount    *int32                 `protobuf:"varint,7,opt,name=retry_count,json=retryCount,proto3,oneof" json:"retry_count,omitempty"`           // 本次执行前已重试的次数
+	MaxRetry      *int32                 `protobuf:"varint,8,opt,name=max_retry,json=maxRetry,proto3,oneof" json:"max_retry,omitempty"`                 // 最多可重试的次数
+	Progress      *uint32                `protobuf:"varint,9,opt,name=progress,proto3,oneof" json:"progress,omitempty"`                                 // 执行进度百分比
+	Message       *string                `protobuf:"bytes,10,opt,name=message,proto3,oneof" json:"message,omitempty"`                                   // 最近一次上报的进度说明
+	Error         *string                `protobuf:"bytes,11,opt,name=error,proto3,oneof" json:"error,omitempty"`                                       // 失败原因
+	Result        *string                `protobuf:"bytes,12,opt,name=result,proto3,oneof" json:"result,omitempty"`                                     // 执行结果
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`              // 开始时间
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`           // 结束时间
+	DurationMs    *uint64                `protobuf:"varint,15,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms,omitempty"`          // 执行耗时（毫秒）
+	TenantId      *uint32                `protobuf:"varint,20,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                // 租户ID，0代表系统全局角色
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`             // 创建时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskRun) Reset() {
+	*x = TaskRun{}
+	mi := &file_task_service_v1_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRun) ProtoMessage() {}
+
+func (x *TaskRun) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRun.ProtoReflect.Descriptor instead.
+func (*TaskRun) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaskRun) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *TaskRun) GetTaskId() uint32 {
+	if x != nil && x.TaskId != nil {
+		return *x.TaskId
+	}
+	return 0
+}
+
+func (x *TaskRun) GetTypeName() string {
+	if x != nil && x.TypeName != nil {
+		return *x.TypeName
+	}
+	return ""
+}
+
+func (x *TaskRun) GetQueueTaskId() string {
+	if x != nil && x.QueueTaskId != nil {
+		return *x.QueueTaskId
+	}
+	return ""
+}
+
+func (x *TaskRun) GetQueue() string {
+	if x != nil && x.Queue != nil {
+		return *x.Queue
+	}
+	return ""
+}
+
+func (x *TaskRun) GetStatus() TaskRun_Status {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TaskRun_RUNNING
+}
+
+func (x *TaskRun) GetRetryCount() int32 {
+	if x != nil && x.RetryCount != nil {
+		return *x.RetryCount
+	}
+	return 0
+}
+
+func (x *TaskRun) GetMaxRetry() int32 {
+	if x != nil && x.MaxRetry != nil {
+		return *x.MaxRetry
+	}
+	return 0
+}
+
+func (x *TaskRun) GetProgress() uint32 {
+	if x != nil && x.Progress != nil {
+		return *x.Progress
+	}
+	return 0
+}
+
+func (x *TaskRun) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *TaskRun) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *TaskRun) GetResult() string {
+	if x != nil && x.Result != nil {
+		return *x.Result
+	}
+	return ""
+}
+
+func (x *TaskRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TaskRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *TaskRun) GetDurationMs() uint64 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
+func (x *TaskRun) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *TaskRun) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 // 查询调度任务列表 - 回应
 type ListTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -405,7 +715,7 @@ type ListTaskResponse struct {
 
 func (x *ListTaskResponse) Reset() {
 	*x = ListTaskResponse{}
-	mi := &file_task_service_v1_task_proto_msgTypes[2]
+	mi := &file_task_service_v1_task_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -417,7 +727,7 @@ func (x *ListTaskResponse) String() string {
 func (*ListTaskResponse) ProtoMessage() {}
 
 func (x *ListTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_service_v1_task_proto_msgTypes[2]
+	mi := &file_task_service_v1_task_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,7 +740,7 @@ func (x *ListTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTaskResponse.ProtoReflect.Descriptor instead.
 func (*ListTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_service_v1_task_proto_rawDescGZIP(), []int{2}
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListTaskResponse) GetItems() []*Task {
@@ -462,7 +772,7 @@ type GetTaskRequest struct {
 
 func (x *GetTaskRequest) Reset() {
 	*x = GetTaskRequest{}
-	mi := &file_task_service_v1_task_proto_msgTypes[3]
+	mi := &file_task_service_v1_task_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -474,7 +784,7 @@ func (x *GetTaskRequest) String() string {
 func (*GetTaskRequest) ProtoMessage() {}
 
 func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_service_v1_task_proto_msgTypes[3]
+	mi := &file_task_service_v1_task_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +797,7 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
 func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_service_v1_task_proto_rawDescGZIP(), []int{3}
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetTaskRequest) GetQueryBy() isGetTaskRequest_QueryBy {
@@ -548,7 +858,7 @@ type CreateTaskRequest struct {
 
 func (x *CreateTaskRequest) Reset() {
 	*x = CreateTaskRequest{}
-	mi := &file_task_service_v1_task_proto_msgTypes[4]
+	mi := &file_task_service_v1_task_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -560,7 +870,7 @@ func (x *CreateTaskRequest) String() string {
 func (*CreateTaskRequest) ProtoMessage() {}
 
 func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_service_v1_task_proto_msgTypes[4]
+	mi := &file_task_service_v1_task_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,7 +883,7 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
 func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_service_v1_task_proto_rawDescGZIP(), []int{4}
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateTaskRequest) GetData() *Task {
@@ -596,7 +906,7 @@ type UpdateTaskRequest struct {
 
 func (x *UpdateTaskRequest) Reset() {
 	*x = UpdateTaskRequest{}
-	mi := &file_task_service_v1_task_proto_msgTypes[5]
+	mi := &file_task_service_v1_task_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -608,7 +918,7 @@ func (x *UpdateTaskRequest) String() string {
 func (*UpdateTaskRequest) ProtoMessage() {}
 
 func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_service_v1_task_proto_msgTypes[5]
+	mi := &file_task_service_v1_task_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,7 +931,7 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_service_v1_task_proto_rawDescGZIP(), []int{5}
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateTaskRequest) GetId() uint32 {
@@ -662,7 +972,7 @@ type DeleteTaskRequest struct {
 
 func (x *DeleteTaskRequest) Reset() {
 	*x = DeleteTaskRequest{}
-	mi := &file_task_service_v1_task_proto_msgTypes[6]
+	mi := &file_task_service_v1_task_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -674,7 +984,7 @@ func (x *DeleteTaskRequest) String() string {
 func (*DeleteTaskRequest) ProtoMessage() {}
 
 func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_service_v1_task_proto_msgTypes[6]
+	mi := &file_task_service_v1_task_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,7 +997,7 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_service_v1_task_proto_rawDescGZIP(), []int{6}
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteTaskRequest) GetId() uint32 {
@@ -707,7 +1017,7 @@ type RestartAllTaskResponse struct {
 
 func (x *RestartAllTaskResponse) Reset() {
 	*x = RestartAllTaskResponse{}
-	mi := &file_task_service_v1_task_proto_msgTypes[7]
+	mi := &file_task_service_v1_task_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -719,7 +1029,7 @@ func (x *RestartAllTaskResponse) String() string {
 func (*RestartAllTaskResponse) ProtoMessage() {}
 
 func (x *RestartAllTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_service_v1_task_proto_msgTypes[7]
+	mi := &file_task_service_v1_task_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +1042,7 @@ func (x *RestartAllTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartAllTaskResponse.ProtoReflect.Descriptor instead.
 func (*RestartAllTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_service_v1_task_proto_rawDescGZIP(), []int{7}
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RestartAllTaskResponse) GetCount() int32 {
@@ -742,6 +1052,59 @@ func (x *RestartAllTaskResponse) GetCount() int32 {
 	return 0
 }
 
+// 启动所有的调度任务 - 回应
+type StartAllTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // 成功启动的任务数
+	Items         []*Task                `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`  // 全部任务，附带最近一次执行记录
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartAllTaskResponse) Reset() {
+	*x = StartAllTaskResponse{}
+	mi := &file_task_service_v1_task_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartAllTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAllTaskResponse) ProtoMessage() {}
+
+func (x *StartAllTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAllTaskResponse.ProtoReflect.Descriptor instead.
+func (*StartAllTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StartAllTaskResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StartAllTaskResponse) GetItems() []*Task {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 // 控制调度任务 - 请求
 type ControlTaskRequest struct {
 	state         protoimpl.MessageState         `protogen:"open.v1"`
@@ -753,7 +1116,7 @@ type ControlTaskRequest struct {
 
 func (x *ControlTaskRequest) Reset() {
 	*x = ControlTaskRequest{}
-	mi := &file_task_service_v1_task_proto_msgTypes[8]
+	mi := &file_task_service_v1_task_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -765,7 +1128,7 @@ func (x *ControlTaskRequest) String() string {
 func (*ControlTaskRequest) ProtoMessage() {}
 
 func (x *ControlTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_service_v1_task_proto_msgTypes[8]
+	mi := &file_task_service_v1_task_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,7 +1141,7 @@ func (x *ControlTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControlTaskRequest.ProtoReflect.Descriptor instead.
 func (*ControlTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_service_v1_task_proto_rawDescGZIP(), []int{8}
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ControlTaskRequest) GetControlType() ControlTaskRequest_ControlType {
@@ -805,7 +1168,7 @@ type ListTaskTypeNameResponse struct {
 
 func (x *ListTaskTypeNameResponse) Reset() {
 	*x = ListTaskTypeNameResponse{}
-	mi := &file_task_service_v1_task_proto_msgTypes[9]
+	mi := &file_task_service_v1_task_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -817,7 +1180,7 @@ func (x *ListTaskTypeNameResponse) String() string {
 func (*ListTaskTypeNameResponse) ProtoMessage() {}
 
 func (x *ListTaskTypeNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_service_v1_task_proto_msgTypes[9]
+	mi := &file_task_service_v1_task_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,7 +1193,7 @@ func (x *ListTaskTypeNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTaskTypeNameResponse.ProtoReflect.Descriptor instead.
 func (*ListTaskTypeNameResponse) Descriptor() ([]byte, []int) {
-	return file_task_service_v1_task_proto_rawDescGZIP(), []int{9}
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListTaskTypeNameResponse) GetTypeNames() []string {
@@ -840,8 +1203,656 @@ func (x *ListTaskTypeNameResponse) GetTypeNames() []string {
 	return nil
 }
 
-var File_task_service_v1_task_proto protoreflect.FileDescriptor
-
+// 查询任务执行记录列表 - 回应
+type ListTaskRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TaskRun             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         uint64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskRunResponse) Reset() {
+	*x = ListTaskRunResponse{}
+	mi := &file_task_service_v1_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskRunResponse) ProtoMessage() {}
+
+func (x *ListTaskRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskRunResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskRunResponse) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTaskRunResponse) GetItems() []*TaskRun {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTaskRunResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 查询任务执行记录详情 - 请求
+type GetTaskRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ViewMask      *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=view_mask,json=viewMask,proto3,oneof" json:"view_mask,omitempty"` // 视图字段过滤器，用于控制返回的字段
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRunRequest) Reset() {
+	*x = GetTaskRunRequest{}
+	mi := &file_task_service_v1_task_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRunRequest) ProtoMessage() {}
+
+func (x *GetTaskRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRunRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRunRequest) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTaskRunRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetTaskRunRequest) GetViewMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ViewMask
+	}
+	return nil
+}
+
+// 任务队列统计
+type TaskQueueInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Queue          *string                `protobuf:"bytes,1,opt,name=queue,proto3,oneof" json:"queue,omitempty"`                                           // 队列名称
+	MemoryUsage    *int64                 `protobuf:"varint,2,opt,name=memory_usage,json=memoryUsage,proto3,oneof" json:"memory_usage,omitempty"`           // 队列占用的内存（字节）
+	Latency        *durationpb.Duration   `protobuf:"bytes,3,opt,name=latency,proto3,oneof" json:"latency,omitempty"`                                       // 最早的待处理任务已等待的时间
+	Size           *int32                 `protobuf:"varint,4,opt,name=size,proto3,oneof" json:"size,omitempty"`                                            // 队列中的任务总数
+	Pending        *int32                 `protobuf:"varint,5,opt,name=pending,proto3,oneof" json:"pending,omitempty"`                                      // 待处理的任务数
+	Active         *int32                 `protobuf:"varint,6,opt,name=active,proto3,oneof" json:"active,omitempty"`                                        // 处理中的任务数
+	Scheduled      *int32                 `protobuf:"varint,7,opt,name=scheduled,proto3,oneof" json:"scheduled,omitempty"`                                  // 计划执行的任务数
+	Retry          *int32                 `protobuf:"varint,8,opt,name=retry,proto3,oneof" json:"retry,omitempty"`                                          // 等待重试的任务数
+	Archived       *int32                 `protobuf:"varint,9,opt,name=archived,proto3,oneof" json:"archived,omitempty"`                                    // 已归档（死信）的任务数
+	Completed      *int32                 `protobuf:"varint,10,opt,name=completed,proto3,oneof" json:"completed,omitempty"`                                 // 已完成的任务数
+	Aggregating    *int32                 `protobuf:"varint,11,opt,name=aggregating,proto3,oneof" json:"aggregating,omitempty"`                             // 等待聚合的任务数
+	Processed      *int32                 `protobuf:"varint,12,opt,name=processed,proto3,oneof" json:"processed,omitempty"`                                 // 今日处理的任务数
+	Failed         *int32                 `protobuf:"varint,13,opt,name=failed,proto3,oneof" json:"failed,omitempty"`                                       // 今日失败的任务数
+	ProcessedTotal *int32                 `protobuf:"varint,14,opt,name=processed_total,json=processedTotal,proto3,oneof" json:"processed_total,omitempty"` // 累计处理的任务数
+	FailedTotal    *int32                 `protobuf:"varint,15,opt,name=failed_total,json=failedTotal,proto3,oneof" json:"failed_total,omitempty"`          // 累计失败的任务数
+	Paused         *bool                  `protobuf:"varint,16,opt,name=paused,proto3,oneof" json:"paused,omitempty"`                                       // 队列是否已暂停
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=timestamp,proto3,oneof" json:"timestamp,omitempty"`                                  // 统计时间
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TaskQueueInfo) Reset() {
+	*x = TaskQueueInfo{}
+	mi := &file_task_service_v1_task_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueueInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueInfo) ProtoMessage() {}
+
+func (x *TaskQueueInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueInfo.ProtoReflect.Descriptor instead.
+func (*TaskQueueInfo) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TaskQueueInfo) GetQueue() string {
+	if x != nil && x.Queue != nil {
+		return *x.Queue
+	}
+	return ""
+}
+
+func (x *TaskQueueInfo) GetMemoryUsage() int64 {
+	if x != nil && x.MemoryUsage != nil {
+		return *x.MemoryUsage
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *TaskQueueInfo) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetPending() int32 {
+	if x != nil && x.Pending != nil {
+		return *x.Pending
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetActive() int32 {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetScheduled() int32 {
+	if x != nil && x.Scheduled != nil {
+		return *x.Scheduled
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetRetry() int32 {
+	if x != nil && x.Retry != nil {
+		return *x.Retry
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetArchived() int32 {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetCompleted() int32 {
+	if x != nil && x.Completed != nil {
+		return *x.Completed
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetAggregating() int32 {
+	if x != nil && x.Aggregating != nil {
+		return *x.Aggregating
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetProcessed() int32 {
+	if x != nil && x.Processed != nil {
+		return *x.Processed
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetFailed() int32 {
+	if x != nil && x.Failed != nil {
+		return *x.Failed
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetProcessedTotal() int32 {
+	if x != nil && x.ProcessedTotal != nil {
+		return *x.ProcessedTotal
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetFailedTotal() int32 {
+	if x != nil && x.FailedTotal != nil {
+		return *x.FailedTotal
+	}
+	return 0
+}
+
+func (x *TaskQueueInfo) GetPaused() bool {
+	if x != nil && x.Paused != nil {
+		return *x.Paused
+	}
+	return false
+}
+
+func (x *TaskQueueInfo) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// 查询任务队列统计 - 回应
+type ListTaskQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TaskQueueInfo       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         uint64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskQueueResponse) Reset() {
+	*x = ListTaskQueueResponse{}
+	mi := &file_task_service_v1_task_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskQueueResponse) ProtoMessage() {}
+
+func (x *ListTaskQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskQueueResponse) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListTaskQueueResponse) GetItems() []*TaskQueueInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTaskQueueResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 队列中的任务
+type QueueTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                               // 任务ID
+	Queue         *string                `protobuf:"bytes,2,opt,name=queue,proto3,oneof" json:"queue,omitempty"`                                         // 队列名称
+	TypeName      *string                `protobuf:"bytes,3,opt,name=type_name,json=typeName,proto3,oneof" json:"type_name,omitempty"`                   // 任务执行类型名
+	Payload       *string                `protobuf:"bytes,4,opt,name=payload,proto3,oneof" json:"payload,omitempty"`                                     // 任务数据
+	State         *QueueTask_State       `protobuf:"varint,5,opt,name=state,proto3,enum=task.service.v1.QueueTask_State,oneof" json:"state,omitempty"`   // 任务状态
+	MaxRetry      *int32                 `protobuf:"varint,6,opt,name=max_retry,json=maxRetry,proto3,oneof" json:"max_retry,omitempty"`                  // 最多可重试的次数
+	Retried       *int32                 `protobuf:"varint,7,opt,name=retried,proto3,oneof" json:"retried,omitempty"`                                    // 已重试的次数
+	LastError     *string                `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`                // 最近一次失败的原因
+	LastFailedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_failed_at,json=lastFailedAt,proto3,oneof" json:"last_failed_at,omitempty"`     // 最近一次失败的时间
+	NextProcessAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_process_at,json=nextProcessAt,proto3,oneof" json:"next_process_at,omitempty"` // 下次处理的时间
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`         // 完成时间
+	Group         *string                `protobuf:"bytes,12,opt,name=group,proto3,oneof" json:"group,omitempty"`                                        // 任务分组
+	IsOrphaned    *bool                  `protobuf:"varint,13,opt,name=is_orphaned,json=isOrphaned,proto3,oneof" json:"is_orphaned,omitempty"`           // 处理中的任务是否已失去处理者
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueTask) Reset() {
+	*x = QueueTask{}
+	mi := &file_task_service_v1_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueTask) ProtoMessage() {}
+
+func (x *QueueTask) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueTask.ProtoReflect.Descriptor instead.
+func (*QueueTask) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueueTask) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *QueueTask) GetQueue() string {
+	if x != nil && x.Queue != nil {
+		return *x.Queue
+	}
+	return ""
+}
+
+func (x *QueueTask) GetTypeName() string {
+	if x != nil && x.TypeName != nil {
+		return *x.TypeName
+	}
+	return ""
+}
+
+func (x *QueueTask) GetPayload() string {
+	if x != nil && x.Payload != nil {
+		return *x.Payload
+	}
+	return ""
+}
+
+func (x *QueueTask) GetState() QueueTask_State {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return QueueTask_PENDING
+}
+
+func (x *QueueTask) GetMaxRetry() int32 {
+	if x != nil && x.MaxRetry != nil {
+		return *x.MaxRetry
+	}
+	return 0
+}
+
+func (x *QueueTask) GetRetried() int32 {
+	if x != nil && x.Retried != nil {
+		return *x.Retried
+	}
+	return 0
+}
+
+func (x *QueueTask) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *QueueTask) GetLastFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFailedAt
+	}
+	return nil
+}
+
+func (x *QueueTask) GetNextProcessAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextProcessAt
+	}
+	return nil
+}
+
+func (x *QueueTask) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *QueueTask) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *QueueTask) GetIsOrphaned() bool {
+	if x != nil && x.IsOrphaned != nil {
+		return *x.IsOrphaned
+	}
+	return false
+}
+
+// 查询队列中的任务 - 请求
+type ListQueueTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`                                       // 队列名称
+	State         QueueTask_State        `protobuf:"varint,2,opt,name=state,proto3,enum=task.service.v1.QueueTask_State" json:"state,omitempty"` // 任务状态
+	Page          *uint32                `protobuf:"varint,3,opt,name=page,proto3,oneof" json:"page,omitempty"`                                  // 当前页码
+	PageSize      *uint32                `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`          // 每页的行数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueTaskRequest) Reset() {
+	*x = ListQueueTaskRequest{}
+	mi := &file_task_service_v1_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueTaskRequest) ProtoMessage() {}
+
+func (x *ListQueueTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueTaskRequest.ProtoReflect.Descriptor instead.
+func (*ListQueueTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListQueueTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ListQueueTaskRequest) GetState() QueueTask_State {
+	if x != nil {
+		return x.State
+	}
+	return QueueTask_PENDING
+}
+
+func (x *ListQueueTaskRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListQueueTaskRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+// 查询队列中的任务 - 回应
+type ListQueueTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*QueueTask           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         uint64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueTaskResponse) Reset() {
+	*x = ListQueueTaskResponse{}
+	mi := &file_task_service_v1_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueTaskResponse) ProtoMessage() {}
+
+func (x *ListQueueTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueTaskResponse.ProtoReflect.Descriptor instead.
+func (*ListQueueTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListQueueTaskResponse) GetItems() []*QueueTask {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListQueueTaskResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 队列中的任务 - 请求
+type QueueTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"` // 队列名称
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`       // 任务ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueTaskRequest) Reset() {
+	*x = QueueTaskRequest{}
+	mi := &file_task_service_v1_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueTaskRequest) ProtoMessage() {}
+
+func (x *QueueTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_service_v1_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueTaskRequest.ProtoReflect.Descriptor instead.
+func (*QueueTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_service_v1_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueueTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *QueueTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_task_service_v1_task_proto protoreflect.FileDescriptor
+
 const file_task_service_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"\x1atask/service/v1/task.proto\x12\x0ftask.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xf7\x06\n" +
@@ -871,7 +1882,7 @@ const file_task_service_v1_task_proto_rawDesc = "" +
 	"_retentionB\b\n" +
 	"\x06_groupB\n" +
 	"\n" +
-	"\b_task_id\"\xed\v\n" +
+	"\b_task_id\"\xcd\r\n" +
 	"\x04Task\x12&\n" +
 	"\x02id\x18\x01 \x01(\rB\x11\xe0A\x01\xbaG\v\x92\x02\b任务IDH\x00R\x02id\x88\x01\x01\x12J\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1a.task.service.v1.Task.TypeB\x15\xe0A\x01\xbaG\x0f\x92\x02\f任务类型H\x01R\x04type\x88\x01\x01\x12\x92\x01\n" +
@@ -881,21 +1892,23 @@ const file_task_service_v1_task_proto_rawDesc = "" +
 	"\ftask_options\x18\x06 \x01(\v2\x1b.task.service.v1.TaskOptionBZ\xe0A\x01\xbaGT\x92\x02Q任务选项，以 JSON 格式存储，方便存储不同类型和数量的选项H\x05R\vtaskOptions\x88\x01\x01\x126\n" +
 	"\x06enable\x18\n" +
 	" \x01(\bB\x19\xbaG\x16\x92\x02\x13启用/禁用任务H\x06R\x06enable\x88\x01\x01\x12)\n" +
-	"\x06remark\x18\v \x01(\tB\f\xbaG\t\x92\x02\x06备注H\aR\x06remark\x88\x01\x01\x12L\n" +
-	"\ttenant_id\x18\x14 \x01(\rB*\xbaG'\x92\x02$租户ID，0代表系统全局角色H\bR\btenantId\x88\x01\x01\x125\n" +
+	"\x06remark\x18\v \x01(\tB\f\xbaG\t\x92\x02\x06备注H\aR\x06remark\x88\x01\x01\x12Z\n" +
+	"\blast_run\x18\f \x01(\v2\x18.task.service.v1.TaskRunB \xbaG\x1d\x18\x01\x92\x02\x18最近一次执行记录H\bR\alastRun\x88\x01\x01\x12j\n" +
+	"\x06paused\x18\r \x01(\bBM\xbaGJ\x18\x01\x92\x02E是否已暂停，暂停的任务保留调度配置但不会被调度H\tR\x06paused\x88\x01\x01\x12L\n" +
+	"\ttenant_id\x18\x14 \x01(\rB*\xbaG'\x92\x02$租户ID，0代表系统全局角色H\n" +
+	"R\btenantId\x88\x01\x01\x125\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\tR\tcreatedBy\x88\x01\x01\x125\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\vR\tcreatedBy\x88\x01\x01\x125\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\n" +
-	"R\tupdatedBy\x88\x01\x01\x12;\n" +
+	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\fR\tupdatedBy\x88\x01\x01\x12;\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\vR\tdeletedBy\x88\x01\x01\x12S\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\rR\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\fR\tcreatedAt\x88\x01\x01\x12S\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x0eR\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\rR\tupdatedAt\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x0fR\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x0eR\tdeletedAt\x88\x01\x01\"0\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x10R\tdeletedAt\x88\x01\x01\"0\n" +
 	"\x04Type\x12\f\n" +
 	"\bPERIODIC\x10\x00\x12\t\n" +
 	"\x05DELAY\x10\x01\x12\x0f\n" +
@@ -909,7 +1922,9 @@ const file_task_service_v1_task_proto_rawDesc = "" +
 	"_cron_specB\x0f\n" +
 	"\r_task_optionsB\t\n" +
 	"\a_enableB\t\n" +
-	"\a_remarkB\f\n" +
+	"\a_remarkB\v\n" +
+	"\t_last_runB\t\n" +
+	"\a_pausedB\f\n" +
 	"\n" +
 	"_tenant_idB\r\n" +
 	"\v_created_byB\r\n" +
@@ -917,7 +1932,59 @@ const file_task_service_v1_task_proto_rawDesc = "" +
 	"\v_deleted_byB\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\r\n" +
-	"\v_deleted_at\"U\n" +
+	"\v_deleted_at\"\xcc\v\n" +
+	"\aTaskRun\x12)\n" +
+	"\x02id\x18\x01 \x01(\rB\x14\xbaG\x11\x92\x02\x0e执行记录IDH\x00R\x02id\x88\x01\x01\x12P\n" +
+	"\atask_id\x18\x02 \x01(\rB2\xbaG/\x92\x02,调度任务ID，临时投递的任务为空H\x01R\x06taskId\x88\x01\x01\x12=\n" +
+	"\ttype_name\x18\x03 \x01(\tB\x1b\xbaG\x18\x92\x02\x15任务执行类型名H\x02R\btypeName\x88\x01\x01\x12I\n" +
+	"\rqueue_task_id\x18\x04 \x01(\tB \xbaG\x1d\x92\x02\x1a任务队列中的任务IDH\x03R\vqueueTaskId\x88\x01\x01\x123\n" +
+	"\x05queue\x18\x05 \x01(\tB\x18\xbaG\x15\x92\x02\x12任务队列名称H\x04R\x05queue\x88\x01\x01\x12P\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1f.task.service.v1.TaskRun.StatusB\x12\xbaG\x0f\x92\x02\f执行状态H\x05R\x06status\x88\x01\x01\x12M\n" +
+	"\vretry_count\x18\a \x01(\x05B'\xbaG$\x92\x02!本次执行前已重试的次数H\x06R\n" +
+	"retryCount\x88\x01\x01\x12@\n" +
+	"\tmax_retry\x18\b \x01(\x05B\x1e\xbaG\x1b\x92\x02\x18最多可重试的次数H\aR\bmaxRetry\x88\x01\x01\x12G\n" +
+	"\bprogress\x18\t \x01(\rB&\xbaG#\x92\x02 执行进度百分比（0-100）H\bR\bprogress\x88\x01\x01\x12F\n" +
+	"\amessage\x18\n" +
+	" \x01(\tB'\xbaG$\x92\x02!最近一次上报的进度说明H\tR\amessage\x88\x01\x01\x12-\n" +
+	"\x05error\x18\v \x01(\tB\x12\xbaG\x0f\x92\x02\f失败原因H\n" +
+	"R\x05error\x88\x01\x01\x12=\n" +
+	"\x06result\x18\f \x01(\tB \xbaG\x1d\x92\x02\x1a执行结果，JSON 格式H\vR\x06result\x88\x01\x01\x12R\n" +
+	"\n" +
+	"started_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f开始时间H\fR\tstartedAt\x88\x01\x01\x12T\n" +
+	"\vfinished_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f结束时间H\rR\n" +
+	"finishedAt\x88\x01\x01\x12D\n" +
+	"\vduration_ms\x18\x0f \x01(\x04B\x1e\xbaG\x1b\x92\x02\x18执行耗时（毫秒）H\x0eR\n" +
+	"durationMs\x88\x01\x01\x12L\n" +
+	"\ttenant_id\x18\x14 \x01(\rB*\xbaG'\x92\x02$租户ID，0代表系统全局角色H\x0fR\btenantId\x88\x01\x01\x12S\n" +
+	"\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x10R\tcreatedAt\x88\x01\x01\"0\n" +
+	"\x06Status\x12\v\n" +
+	"\aRUNNING\x10\x00\x12\r\n" +
+	"\tSUCCEEDED\x10\x01\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x02B\x05\n" +
+	"\x03_idB\n" +
+	"\n" +
+	"\b_task_idB\f\n" +
+	"\n" +
+	"_type_nameB\x10\n" +
+	"\x0e_queue_task_idB\b\n" +
+	"\x06_queueB\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_retry_countB\f\n" +
+	"\n" +
+	"_max_retryB\v\n" +
+	"\t_progressB\n" +
+	"\n" +
+	"\b_messageB\b\n" +
+	"\x06_errorB\t\n" +
+	"\a_resultB\r\n" +
+	"\v_started_atB\x0e\n" +
+	"\f_finished_atB\x0e\n" +
+	"\f_duration_msB\f\n" +
+	"\n" +
+	"_tenant_idB\r\n" +
+	"\v_created_at\"U\n" +
 	"\x10ListTaskResponse\x12+\n" +
 	"\x05items\x18\x01 \x03(\v2\x15.task.service.v1.TaskR\x05items\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x04R\x05total\"\xd3\x02\n" +
@@ -942,17 +2009,137 @@ const file_task_service_v1_task_proto_rawDesc = "" +
 	"\x11DeleteTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\".\n" +
 	"\x16RestartAllTaskResponse\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x05R\x05count\"\xbd\x02\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"Y\n" +
+	"\x14StartAllTaskResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12+\n" +
+	"\x05items\x18\x02 \x03(\v2\x15.task.service.v1.TaskR\x05items\"\xe0\x02\n" +
 	"\x12ControlTaskRequest\x12f\n" +
 	"\fcontrol_type\x18\x01 \x01(\x0e2/.task.service.v1.ControlTaskRequest.ControlTypeB\x12\xbaG\x0f\x92\x02\f控制类型R\vcontrolType\x12\x8d\x01\n" +
-	"\ttype_name\x18\x02 \x01(\tBp\xe0A\x01\xbaGj\x92\x02g任务执行类型名，例如 \"send_email\"、\"generate_report\" 等，用于区分不同类型的任务R\btypeName\"/\n" +
+	"\ttype_name\x18\x02 \x01(\tBp\xe0A\x01\xbaGj\x92\x02g任务执行类型名，例如 \"send_email\"、\"generate_report\" 等，用于区分不同类型的任务R\btypeName\"R\n" +
 	"\vControlType\x12\t\n" +
 	"\x05Start\x10\x00\x12\b\n" +
 	"\x04Stop\x10\x01\x12\v\n" +
-	"\aRestart\x10\x02\"S\n" +
+	"\aRestart\x10\x02\x12\n" +
+	"\n" +
+	"\x06RunNow\x10\x03\x12\t\n" +
+	"\x05Pause\x10\x04\x12\n" +
+	"\n" +
+	"\x06Resume\x10\x05\"S\n" +
 	"\x18ListTaskTypeNameResponse\x127\n" +
 	"\n" +
-	"type_names\x18\x01 \x03(\tB\x18\xbaG\x15\x92\x02\x12类型名称列表R\ttypeNames2\xed\x05\n" +
+	"type_names\x18\x01 \x03(\tB\x18\xbaG\x15\x92\x02\x12类型名称列表R\ttypeNames\"[\n" +
+	"\x13ListTaskRunResponse\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.task.service.v1.TaskRunR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\xaa\x01\n" +
+	"\x11GetTaskRunRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12w\n" +
+	"\tview_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskB9\xbaG6\x92\x023视图字段过滤器，用于控制返回的字段H\x00R\bviewMask\x88\x01\x01B\f\n" +
+	"\n" +
+	"_view_mask\"\x92\v\n" +
+	"\rTaskQueueInfo\x12-\n" +
+	"\x05queue\x18\x01 \x01(\tB\x12\xbaG\x0f\x92\x02\f队列名称H\x00R\x05queue\x88\x01\x01\x12O\n" +
+	"\fmemory_usage\x18\x02 \x01(\x03B'\xbaG$\x92\x02!队列占用的内存（字节）H\x01R\vmemoryUsage\x88\x01\x01\x12j\n" +
+	"\alatency\x18\x03 \x01(\v2\x19.google.protobuf.DurationB0\xbaG-\x92\x02*最早的待处理任务已等待的时间H\x02R\alatency\x88\x01\x01\x127\n" +
+	"\x04size\x18\x04 \x01(\x05B\x1e\xbaG\x1b\x92\x02\x18队列中的任务总数H\x03R\x04size\x88\x01\x01\x12:\n" +
+	"\apending\x18\x05 \x01(\x05B\x1b\xbaG\x18\x92\x02\x15待处理的任务数H\x04R\apending\x88\x01\x01\x128\n" +
+	"\x06active\x18\x06 \x01(\x05B\x1b\xbaG\x18\x92\x02\x15处理中的任务数H\x05R\x06active\x88\x01\x01\x12A\n" +
+	"\tscheduled\x18\a \x01(\x05B\x1e\xbaG\x1b\x92\x02\x18计划执行的任务数H\x06R\tscheduled\x88\x01\x01\x129\n" +
+	"\x05retry\x18\b \x01(\x05B\x1e\xbaG\x1b\x92\x02\x18等待重试的任务数H\aR\x05retry\x88\x01\x01\x12H\n" +
+	"\barchived\x18\t \x01(\x05B'\xbaG$\x92\x02!已归档（死信）的任务数H\bR\barchived\x88\x01\x01\x12S\n" +
+	"\tcompleted\x18\n" +
+	" \x01(\x05B0\xbaG-\x92\x02*已完成且仍在保留期内的任务数H\tR\tcompleted\x88\x01\x01\x12E\n" +
+	"\vaggregating\x18\v \x01(\x05B\x1e\xbaG\x1b\x92\x02\x18等待聚合的任务数H\n" +
+	"R\vaggregating\x88\x01\x01\x12A\n" +
+	"\tprocessed\x18\f \x01(\x05B\x1e\xbaG\x1b\x92\x02\x18今日处理的任务数H\vR\tprocessed\x88\x01\x01\x12;\n" +
+	"\x06failed\x18\r \x01(\x05B\x1e\xbaG\x1b\x92\x02\x18今日失败的任务数H\fR\x06failed\x88\x01\x01\x12L\n" +
+	"\x0fprocessed_total\x18\x0e \x01(\x05B\x1e\xbaG\x1b\x92\x02\x18累计处理的任务数H\rR\x0eprocessedTotal\x88\x01\x01\x12F\n" +
+	"\ffailed_total\x18\x0f \x01(\x05B\x1e\xbaG\x1b\x92\x02\x18累计失败的任务数H\x0eR\vfailedTotal\x88\x01\x01\x128\n" +
+	"\x06paused\x18\x10 \x01(\bB\x1b\xbaG\x18\x92\x02\x15队列是否已暂停H\x0fR\x06paused\x88\x01\x01\x12Q\n" +
+	"\ttimestamp\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f统计时间H\x10R\ttimestamp\x88\x01\x01B\b\n" +
+	"\x06_queueB\x0f\n" +
+	"\r_memory_usageB\n" +
+	"\n" +
+	"\b_latencyB\a\n" +
+	"\x05_sizeB\n" +
+	"\n" +
+	"\b_pendingB\t\n" +
+	"\a_activeB\f\n" +
+	"\n" +
+	"_scheduledB\b\n" +
+	"\x06_retryB\v\n" +
+	"\t_archivedB\f\n" +
+	"\n" +
+	"_completedB\x0e\n" +
+	"\f_aggregatingB\f\n" +
+	"\n" +
+	"_processedB\t\n" +
+	"\a_failedB\x12\n" +
+	"\x10_processed_totalB\x0f\n" +
+	"\r_failed_totalB\t\n" +
+	"\a_pausedB\f\n" +
+	"\n" +
+	"_timestamp\"c\n" +
+	"\x15ListTaskQueueResponse\x124\n" +
+	"\x05items\x18\x01 \x03(\v2\x1e.task.service.v1.TaskQueueInfoR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\xad\t\n" +
+	"\tQueueTask\x12#\n" +
+	"\x02id\x18\x01 \x01(\tB\x0e\xbaG\v\x92\x02\b任务IDH\x00R\x02id\x88\x01\x01\x12-\n" +
+	"\x05queue\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f队列名称H\x01R\x05queue\x88\x01\x01\x12=\n" +
+	"\ttype_name\x18\x03 \x01(\tB\x1b\xbaG\x18\x92\x02\x15任务执行类型名H\x02R\btypeName\x88\x01\x01\x121\n" +
+	"\apayload\x18\x04 \x01(\tB\x12\xbaG\x0f\x92\x02\f任务数据H\x03R\apayload\x88\x01\x01\x12O\n" +
+	"\x05state\x18\x05 \x01(\x0e2 .task.service.v1.QueueTask.StateB\x12\xbaG\x0f\x92\x02\f任务状态H\x04R\x05state\x88\x01\x01\x12@\n" +
+	"\tmax_retry\x18\x06 \x01(\x05B\x1e\xbaG\x1b\x92\x02\x18最多可重试的次数H\x05R\bmaxRetry\x88\x01\x01\x127\n" +
+	"\aretried\x18\a \x01(\x05B\x18\xbaG\x15\x92\x02\x12已重试的次数H\x06R\aretried\x88\x01\x01\x12E\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tB!\xbaG\x1e\x92\x02\x1b最近一次失败的原因H\aR\tlastError\x88\x01\x01\x12h\n" +
+	"\x0elast_failed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB!\xbaG\x1e\x92\x02\x1b最近一次失败的时间H\bR\flastFailedAt\x88\x01\x01\x12d\n" +
+	"\x0fnext_process_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x1b\xbaG\x18\x92\x02\x15下次处理的时间H\tR\rnextProcessAt\x88\x01\x01\x12V\n" +
+	"\fcompleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f完成时间H\n" +
+	"R\vcompletedAt\x88\x01\x01\x12-\n" +
+	"\x05group\x18\f \x01(\tB\x12\xbaG\x0f\x92\x02\f任务分组H\vR\x05group\x88\x01\x01\x12V\n" +
+	"\vis_orphaned\x18\r \x01(\bB0\xbaG-\x92\x02*处理中的任务是否已失去处理者H\fR\n" +
+	"isOrphaned\x88\x01\x01\"h\n" +
+	"\x05State\x12\v\n" +
+	"\aPENDING\x10\x00\x12\n" +
+	"\n" +
+	"\x06ACTIVE\x10\x01\x12\r\n" +
+	"\tSCHEDULED\x10\x02\x12\t\n" +
+	"\x05RETRY\x10\x03\x12\f\n" +
+	"\bARCHIVED\x10\x04\x12\r\n" +
+	"\tCOMPLETED\x10\x05\x12\x0f\n" +
+	"\vAGGREGATING\x10\x06B\x05\n" +
+	"\x03_idB\b\n" +
+	"\x06_queueB\f\n" +
+	"\n" +
+	"_type_nameB\n" +
+	"\n" +
+	"\b_payloadB\b\n" +
+	"\x06_stateB\f\n" +
+	"\n" +
+	"_max_retryB\n" +
+	"\n" +
+	"\b_retriedB\r\n" +
+	"\v_last_errorB\x11\n" +
+	"\x0f_last_failed_atB\x12\n" +
+	"\x10_next_process_atB\x0f\n" +
+	"\r_completed_atB\b\n" +
+	"\x06_groupB\x0e\n" +
+	"\f_is_orphaned\"\x96\x02\n" +
+	"\x14ListQueueTaskRequest\x12(\n" +
+	"\x05queue\x18\x01 \x01(\tB\x12\xbaG\x0f\x92\x02\f队列名称R\x05queue\x12J\n" +
+	"\x05state\x18\x02 \x01(\x0e2 .task.service.v1.QueueTask.StateB\x12\xbaG\x0f\x92\x02\f任务状态R\x05state\x128\n" +
+	"\x04page\x18\x03 \x01(\rB\x1f\xbaG\x1c\x92\x02\x19当前页码，从1开始H\x00R\x04page\x88\x01\x01\x127\n" +
+	"\tpage_size\x18\x04 \x01(\rB\x15\xbaG\x12\x92\x02\x0f每页的行数H\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"_\n" +
+	"\x15ListQueueTaskResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.task.service.v1.QueueTaskR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\\\n" +
+	"\x10QueueTaskRequest\x12(\n" +
+	"\x05queue\x18\x01 \x01(\tB\x12\xbaG\x0f\x92\x02\f队列名称R\x05queue\x12\x1e\n" +
+	"\x02id\x18\x02 \x01(\tB\x0e\xbaG\v\x92\x02\b任务IDR\x02id2\xf6\t\n" +
 	"\vTaskService\x12F\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a!.task.service.v1.ListTaskResponse\"\x00\x12?\n" +
 	"\x03Get\x12\x1f.task.service.v1.GetTaskRequest\x1a\x15.task.service.v1.Task\"\x00\x12F\n" +
@@ -960,10 +2147,17 @@ const file_task_service_v1_task_proto_rawDesc = "" +
 	"\x06Update\x12\".task.service.v1.UpdateTaskRequest\x1a\x16.google.protobuf.Empty\"\x00\x12F\n" +
 	"\x06Delete\x12\".task.service.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\"\x00\x12W\n" +
 	"\x10ListTaskTypeName\x12\x16.google.protobuf.Empty\x1a).task.service.v1.ListTaskTypeNameResponse\"\x00\x12S\n" +
-	"\x0eRestartAllTask\x12\x16.google.protobuf.Empty\x1a'.task.service.v1.RestartAllTaskResponse\"\x00\x12@\n" +
-	"\fStartAllTask\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12?\n" +
+	"\x0eRestartAllTask\x12\x16.google.protobuf.Empty\x1a'.task.service.v1.RestartAllTaskResponse\"\x00\x12O\n" +
+	"\fStartAllTask\x12\x16.google.protobuf.Empty\x1a%.task.service.v1.StartAllTaskResponse\"\x00\x12?\n" +
 	"\vStopAllTask\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12L\n" +
-	"\vControlTask\x12#.task.service.v1.ControlTaskRequest\x1a\x16.google.protobuf.Empty\"\x00B\xaf\x01\n" +
+	"\vControlTask\x12#.task.service.v1.ControlTaskRequest\x1a\x16.google.protobuf.Empty\"\x00\x12P\n" +
+	"\vListTaskRun\x12\x19.pagination.PagingRequest\x1a$.task.service.v1.ListTaskRunResponse\"\x00\x12L\n" +
+	"\n" +
+	"GetTaskRun\x12\".task.service.v1.GetTaskRunRequest\x1a\x18.task.service.v1.TaskRun\"\x00\x12Q\n" +
+	"\rListTaskQueue\x12\x16.google.protobuf.Empty\x1a&.task.service.v1.ListTaskQueueResponse\"\x00\x12`\n" +
+	"\rListQueueTask\x12%.task.service.v1.ListQueueTaskRequest\x1a&.task.service.v1.ListQueueTaskResponse\"\x00\x12P\n" +
+	"\x11RetryArchivedTask\x12!.task.service.v1.QueueTaskRequest\x1a\x16.google.protobuf.Empty\"\x00\x12Q\n" +
+	"\x12DeleteArchivedTask\x12!.task.service.v1.QueueTaskRequest\x1a\x16.google.protobuf.Empty\"\x00B\xaf\x01\n" +
 	"\x13com.task.service.v1B\tTaskProtoP\x01Z/go-wind-admin/api/gen/go/task/service/v1;taskpb\xa2\x02\x03TSX\xaa\x02\x0fTask.Service.V1\xca\x02\x0fTask\\Service\\V1\xe2\x02\x1bTask\\Service\\V1\\GPBMetadata\xea\x02\x11Task::Service::V1b\x06proto3"
 
 var (
@@ -978,70 +2172,111 @@ func file_task_service_v1_task_proto_rawDescGZIP() []byte {
 	return file_task_service_v1_task_proto_rawDescData
 }
 
-var file_task_service_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_task_service_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_task_service_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_task_service_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_task_service_v1_task_proto_goTypes = []any{
 	(Task_Type)(0),                      // 0: task.service.v1.Task.Type
-	(ControlTaskRequest_ControlType)(0), // 1: task.service.v1.ControlTaskRequest.ControlType
-	(*TaskOption)(nil),                  // 2: task.service.v1.TaskOption
-	(*Task)(nil),                        // 3: task.service.v1.Task
-	(*ListTaskResponse)(nil),            // 4: task.service.v1.ListTaskResponse
-	(*GetTaskRequest)(nil),              // 5: task.service.v1.GetTaskRequest
-	(*CreateTaskRequest)(nil),           // 6: task.service.v1.CreateTaskRequest
-	(*UpdateTaskRequest)(nil),           // 7: task.service.v1.UpdateTaskRequest
-	(*DeleteTaskRequest)(nil),           // 8: task.service.v1.DeleteTaskRequest
-	(*RestartAllTaskResponse)(nil),      // 9: task.service.v1.RestartAllTaskResponse
-	(*ControlTaskRequest)(nil),          // 10: task.service.v1.ControlTaskRequest
-	(*ListTaskTypeNameResponse)(nil),    // 11: task.service.v1.ListTaskTypeNameResponse
-	(*durationpb.Duration)(nil),         // 12: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),       // 13: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),       // 14: google.protobuf.FieldMask
-	(*v1.PagingRequest)(nil),            // 15: pagination.PagingRequest
-	(*emptypb.Empty)(nil),               // 16: google.protobuf.Empty
+	(TaskRun_Status)(0),                 // 1: task.service.v1.TaskRun.Status
+	(ControlTaskRequest_ControlType)(0), // 2: task.service.v1.ControlTaskRequest.ControlType
+	(QueueTask_State)(0),                // 3: task.service.v1.QueueTask.State
+	(*TaskOption)(nil),                  // 4: task.service.v1.TaskOption
+	(*Task)(nil),                        // 5: task.service.v1.Task
+	(*TaskRun)(nil),                     // 6: task.service.v1.TaskRun
+	(*ListTaskResponse)(nil),            // 7: task.service.v1.ListTaskResponse
+	(*GetTaskRequest)(nil),              // 8: task.service.v1.GetTaskRequest
+	(*CreateTaskRequest)(nil),           // 9: task.service.v1.CreateTaskRequest
+	(*UpdateTaskRequest)(nil),           // 10: task.service.v1.UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),           // 11: task.service.v1.DeleteTaskRequest
+	(*RestartAllTaskResponse)(nil),      // 12: task.service.v1.RestartAllTaskResponse
+	(*StartAllTaskResponse)(nil),        // 13: task.service.v1.StartAllTaskResponse
+	(*ControlTaskRequest)(nil),          // 14: task.service.v1.ControlTaskRequest
+	(*ListTaskTypeNameResponse)(nil),    // 15: task.service.v1.ListTaskTypeNameResponse
+	(*ListTaskRunResponse)(nil),         // 16: task.service.v1.ListTaskRunResponse
+	(*GetTaskRunRequest)(nil),           // 17: task.service.v1.GetTaskRunRequest
+	(*TaskQueueInfo)(nil),               // 18: task.service.v1.TaskQueueInfo
+	(*ListTaskQueueResponse)(nil),       // 19: task.service.v1.ListTaskQueueResponse
+	(*QueueTask)(nil),                   // 20: task.service.v1.QueueTask
+	(*ListQueueTaskRequest)(nil),        // 21: task.service.v1.ListQueueTaskRequest
+	(*ListQueueTaskResponse)(nil),       // 22: task.service.v1.ListQueueTaskResponse
+	(*QueueTaskRequest)(nil),            // 23: task.service.v1.QueueTaskRequest
+	(*durationpb.Duration)(nil),         // 24: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),       // 25: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 26: google.protobuf.FieldMask
+	(*v1.PagingRequest)(nil),            // 27: pagination.PagingRequest
+	(*emptypb.Empty)(nil),               // 28: google.protobuf.Empty
 }
 var file_task_service_v1_task_proto_depIdxs = []int32{
-	12, // 0: task.service.v1.TaskOption.timeout:type_name -> google.protobuf.Duration
-	13, // 1: task.service.v1.TaskOption.deadline:type_name -> google.protobuf.Timestamp
-	12, // 2: task.service.v1.TaskOption.process_in:type_name -> google.protobuf.Duration
-	13, // 3: task.service.v1.TaskOption.process_at:type_name -> google.protobuf.Timestamp
-	12, // 4: task.service.v1.TaskOption.unique_ttl:type_name -> google.protobuf.Duration
-	12, // 5: task.service.v1.TaskOption.retention:type_name -> google.protobuf.Duration
+	24, // 0: task.service.v1.TaskOption.timeout:type_name -> google.protobuf.Duration
+	25, // 1: task.service.v1.TaskOption.deadline:type_name -> google.protobuf.Timestamp
+	24, // 2: task.service.v1.TaskOption.process_in:type_name -> google.protobuf.Duration
+	25, // 3: task.service.v1.TaskOption.process_at:type_name -> google.protobuf.Timestamp
+	24, // 4: task.service.v1.TaskOption.unique_ttl:type_name -> google.protobuf.Duration
+	24, // 5: task.service.v1.TaskOption.retention:type_name -> google.protobuf.Duration
 	0,  // 6: task.service.v1.Task.type:type_name -> task.service.v1.Task.Type
-	2,  // 7: task.service.v1.Task.task_options:type_name -> task.service.v1.TaskOption
-	13, // 8: task.service.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	13, // 9: task.service.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 10: task.service.v1.Task.deleted_at:type_name -> google.protobuf.Timestamp
-	3,  // 11: task.service.v1.ListTaskResponse.items:type_name -> task.service.v1.Task
-	14, // 12: task.service.v1.GetTaskRequest.view_mask:type_name -> google.protobuf.FieldMask
-	3,  // 13: task.service.v1.CreateTaskRequest.data:type_name -> task.service.v1.Task
-	3,  // 14: task.service.v1.UpdateTaskRequest.data:type_name -> task.service.v1.Task
-	14, // 15: task.service.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 16: task.service.v1.ControlTaskRequest.control_type:type_name -> task.service.v1.ControlTaskRequest.ControlType
-	15, // 17: task.service.v1.TaskService.List:input_type -> pagination.PagingRequest
-	5,  // 18: task.service.v1.TaskService.Get:input_type -> task.service.v1.GetTaskRequest
-	6,  // 19: task.service.v1.TaskService.Create:input_type -> task.service.v1.CreateTaskRequest
-	7,  // 20: task.service.v1.TaskService.Update:input_type -> task.service.v1.UpdateTaskRequest
-	8,  // 21: task.service.v1.TaskService.Delete:input_type -> task.service.v1.DeleteTaskRequest
-	16, // 22: task.service.v1.TaskService.ListTaskTypeName:input_type -> google.protobuf.Empty
-	16, // 23: task.service.v1.TaskService.RestartAllTask:input_type -> google.protobuf.Empty
-	16, // 24: task.service.v1.TaskService.StartAllTask:input_type -> google.protobuf.Empty
-	16, // 25: task.service.v1.TaskService.StopAllTask:input_type -> google.protobuf.Empty
-	10, // 26: task.service.v1.TaskService.ControlTask:input_type -> task.service.v1.ControlTaskRequest
-	4,  // 27: task.service.v1.TaskService.List:output_type -> task.service.v1.ListTaskResponse
-	3,  // 28: task.service.v1.TaskService.Get:output_type -> task.service.v1.Task
-	16, // 29: task.service.v1.TaskService.Create:output_type -> google.protobuf.Empty
-	16, // 30: task.service.v1.TaskService.Update:output_type -> google.protobuf.Empty
-	16, // 31: task.service.v1.TaskService.Delete:output_type -> google.protobuf.Empty
-	11, // 32: task.service.v1.TaskService.ListTaskTypeName:output_type -> task.service.v1.ListTaskTypeNameResponse
-	9,  // 33: task.service.v1.TaskService.RestartAllTask:output_type -> task.service.v1.RestartAllTaskResponse
-	16, // 34: task.service.v1.TaskService.StartAllTask:output_type -> google.protobuf.Empty
-	16, // 35: task.service.v1.TaskService.StopAllTask:output_type -> google.protobuf.Empty
-	16, // 36: task.service.v1.TaskService.ControlTask:output_type -> google.protobuf.Empty
-	27, // [27:37] is the sub-list for method output_type
-	17, // [17:27] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	4,  // 7: task.service.v1.Task.task_options:type_name -> task.service.v1.TaskOption
+	6,  // 8: task.service.v1.Task.last_run:type_name -> task.service.v1.TaskRun
+	25, // 9: task.service.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	25, // 10: task.service.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 11: task.service.v1.Task.deleted_at:type_name -> google.protobuf.Timestamp
+	1,  // 12: task.service.v1.TaskRun.status:type_name -> task.service.v1.TaskRun.Status
+	25, // 13: task.service.v1.TaskRun.started_at:type_name -> google.protobuf.Timestamp
+	25, // 14: task.service.v1.TaskRun.finished_at:type_name -> google.protobuf.Timestamp
+	25, // 15: task.service.v1.TaskRun.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 16: task.service.v1.ListTaskResponse.items:type_name -> task.service.v1.Task
+	26, // 17: task.service.v1.GetTaskRequest.view_mask:type_name -> google.protobuf.FieldMask
+	5,  // 18: task.service.v1.CreateTaskRequest.data:type_name -> task.service.v1.Task
+	5,  // 19: task.service.v1.UpdateTaskRequest.data:type_name -> task.service.v1.Task
+	26, // 20: task.service.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 21: task.service.v1.StartAllTaskResponse.items:type_name -> task.service.v1.Task
+	2,  // 22: task.service.v1.ControlTaskRequest.control_type:type_name -> task.service.v1.ControlTaskRequest.ControlType
+	6,  // 23: task.service.v1.ListTaskRunResponse.items:type_name -> task.service.v1.TaskRun
+	26, // 24: task.service.v1.GetTaskRunRequest.view_mask:type_name -> google.protobuf.FieldMask
+	24, // 25: task.service.v1.TaskQueueInfo.latency:type_name -> google.protobuf.Duration
+	25, // 26: task.service.v1.TaskQueueInfo.timestamp:type_name -> google.protobuf.Timestamp
+	18, // 27: task.service.v1.ListTaskQueueResponse.items:type_name -> task.service.v1.TaskQueueInfo
+	3,  // 28: task.service.v1.QueueTask.state:type_name -> task.service.v1.QueueTask.State
+	25, // 29: task.service.v1.QueueTask.last_failed_at:type_name -> google.protobuf.Timestamp
+	25, // 30: task.service.v1.QueueTask.next_process_at:type_name -> google.protobuf.Timestamp
+	25, // 31: task.service.v1.QueueTask.completed_at:type_name -> google.protobuf.Timestamp
+	3,  // 32: task.service.v1.ListQueueTaskRequest.state:type_name -> task.service.v1.QueueTask.State
+	20, // 33: task.service.v1.ListQueueTaskResponse.items:type_name -> task.service.v1.QueueTask
+	27, // 34: task.service.v1.TaskService.List:input_type -> pagination.PagingRequest
+	8,  // 35: task.service.v1.TaskService.Get:input_type -> task.service.v1.GetTaskRequest
+	9,  // 36: task.service.v1.TaskService.Create:input_type -> task.service.v1.CreateTaskRequest
+	10, // 37: task.service.v1.TaskService.Update:input_type -> task.service.v1.UpdateTaskRequest
+	11, // 38: task.service.v1.TaskService.Delete:input_type -> task.service.v1.DeleteTaskRequest
+	28, // 39: task.service.v1.TaskService.ListTaskTypeName:input_type -> google.protobuf.Empty
+	28, // 40: task.service.v1.TaskService.RestartAllTask:input_type -> google.protobuf.Empty
+	28, // 41: task.service.v1.TaskService.StartAllTask:input_type -> google.protobuf.Empty
+	28, // 42: task.service.v1.TaskService.StopAllTask:input_type -> google.protobuf.Empty
+	14, // 43: task.service.v1.TaskService.ControlTask:input_type -> task.service.v1.ControlTaskRequest
+	27, // 44: task.service.v1.TaskService.ListTaskRun:input_type -> pagination.PagingRequest
+	17, // 45: task.service.v1.TaskService.GetTaskRun:input_type -> task.service.v1.GetTaskRunRequest
+	28, // 46: task.service.v1.TaskService.ListTaskQueue:input_type -> google.protobuf.Empty
+	21, // 47: task.service.v1.TaskService.ListQueueTask:input_type -> task.service.v1.ListQueueTaskRequest
+	23, // 48: task.service.v1.TaskService.RetryArchivedTask:input_type -> task.service.v1.QueueTaskRequest
+	23, // 49: task.service.v1.TaskService.DeleteArchivedTask:input_type -> task.service.v1.QueueTaskRequest
+	7,  // 50: task.service.v1.TaskService.List:output_type -> task.service.v1.ListTaskResponse
+	5,  // 51: task.service.v1.TaskService.Get:output_type -> task.service.v1.Task
+	28, // 52: task.service.v1.TaskService.Create:output_type -> google.protobuf.Empty
+	28, // 53: task.service.v1.TaskService.Update:output_type -> google.protobuf.Empty
+	28, // 54: task.service.v1.TaskService.Delete:output_type -> google.protobuf.Empty
+	15, // 55: task.service.v1.TaskService.ListTaskTypeName:output_type -> task.service.v1.ListTaskTypeNameResponse
+	12, // 56: task.service.v1.TaskService.RestartAllTask:output_type -> task.service.v1.RestartAllTaskResponse
+	13, // 57: task.service.v1.TaskService.StartAllTask:output_type -> task.service.v1.StartAllTaskResponse
+	28, // 58: task.service.v1.TaskService.StopAllTask:output_type -> google.protobuf.Empty
+	28, // 59: task.service.v1.TaskService.ControlTask:output_type -> google.protobuf.Empty
+	16, // 60: task.service.v1.TaskService.ListTaskRun:output_type -> task.service.v1.ListTaskRunResponse
+	6,  // 61: task.service.v1.TaskService.GetTaskRun:output_type -> task.service.v1.TaskRun
+	19, // 62: task.service.v1.TaskService.ListTaskQueue:output_type -> task.service.v1.ListTaskQueueResponse
+	22, // 63: task.service.v1.TaskService.ListQueueTask:output_type -> task.service.v1.ListQueueTaskResponse
+	28, // 64: task.service.v1.TaskService.RetryArchivedTask:output_type -> google.protobuf.Empty
+	28, // 65: task.service.v1.TaskService.DeleteArchivedTask:output_type -> google.protobuf.Empty
+	50, // [50:66] is the sub-list for method output_type
+	34, // [34:50] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_task_service_v1_task_proto_init() }
@@ -1051,18 +2286,23 @@ func file_task_service_v1_task_proto_init() {
 	}
 	file_task_service_v1_task_proto_msgTypes[0].OneofWrappers = []any{}
 	file_task_service_v1_task_proto_msgTypes[1].OneofWrappers = []any{}
-	file_task_service_v1_task_proto_msgTypes[3].OneofWrappers = []any{
+	file_task_service_v1_task_proto_msgTypes[2].OneofWrappers = []any{}
+	file_task_service_v1_task_proto_msgTypes[4].OneofWrappers = []any{
 		(*GetTaskRequest_Id)(nil),
 		(*GetTaskRequest_TypeName)(nil),
 	}
-	file_task_service_v1_task_proto_msgTypes[5].OneofWrappers = []any{}
+	file_task_service_v1_task_proto_msgTypes[6].OneofWrappers = []any{}
+	file_task_service_v1_task_proto_msgTypes[13].OneofWrappers = []any{}
+	file_task_service_v1_task_proto_msgTypes[14].OneofWrappers = []any{}
+	file_task_service_v1_task_proto_msgTypes[16].OneofWrappers = []any{}
+	file_task_service_v1_task_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_service_v1_task_proto_rawDesc), len(file_task_service_v1_task_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   10,
+			NumEnums:      4,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -129,7 +129,7 @@ func (s *redactedTaskServiceServer) RestartAllTask(ctx context.Context, in *empt
 
 // StartAllTask is the redacted wrapper for the actual TaskServiceServer.StartAllTask method
 // Unary RPC
-func (s *redactedTaskServiceServer) StartAllTask(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+func (s *redactedTaskServiceServer) StartAllTask(ctx context.Context, in *emptypb.Empty) (*StartAllTaskResponse, error) {
 	res, err := s.srv.StartAllTask(ctx, in)
 	if !s.bypass.CheckInternal(ctx) {
 		// Apply redaction to the response
@@ -160,6 +160,72 @@ func (s *redactedTaskServiceServer) ControlTask(ctx context.Context, in *Control
 	return res, err
 }
 
+// ListTaskRun is the redacted wrapper for the actual TaskServiceServer.ListTaskRun method
+// Unary RPC
+func (s *redactedTaskServiceServer) ListTaskRun(ctx context.Context, in *pagination.PagingRequest) (*ListTaskRunResponse, error) {
+	res, err := s.srv.ListTaskRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetTaskRun is the redacted wrapper for the actual TaskServiceServer.GetTaskRun method
+// Unary RPC
+func (s *redactedTaskServiceServer) GetTaskRun(ctx context.Context, in *GetTaskRunRequest) (*TaskRun, error) {
+	res, err := s.srv.GetTaskRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListTaskQueue is the redacted wrapper for the actual TaskServiceServer.ListTaskQueue method
+// Unary RPC
+func (s *redactedTaskServiceServer) ListTaskQueue(ctx context.Context, in *emptypb.Empty) (*ListTaskQueueResponse, error) {
+	res, err := s.srv.ListTaskQueue(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListQueueTask is the redacted wrapper for the actual TaskServiceServer.ListQueueTask method
+// Unary RPC
+func (s *redactedTaskServiceServer) ListQueueTask(ctx context.Context, in *ListQueueTaskRequest) (*ListQueueTaskResponse, error) {
+	res, err := s.srv.ListQueueTask(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RetryArchivedTask is the redacted wrapper for the actual TaskServiceServer.RetryArchivedTask method
+// Unary RPC
+func (s *redactedTaskServiceServer) RetryArchivedTask(ctx context.Context, in *QueueTaskRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.RetryArchivedTask(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// DeleteArchivedTask is the redacted wrapper for the actual TaskServiceServer.DeleteArchivedTask method
+// Unary RPC
+func (s *redactedTaskServiceServer) DeleteArchivedTask(ctx context.Context, in *QueueTaskRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.DeleteArchivedTask(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for TaskOption
 func (x *TaskOption) Redact() string {
 	if x == nil {
@@ -208,6 +274,10 @@ func (x *Task) Redact() string {
 
 	// Safe field: Remark
 
+	// Safe field: LastRun
+
+	// Safe field: Paused
+
 	// Safe field: TenantId
 
 	// Safe field: CreatedBy
@@ -224,6 +294,48 @@ func (x *Task) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for TaskRun
+func (x *TaskRun) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TaskId
+
+	// Safe field: TypeName
+
+	// Safe field: QueueTaskId
+
+	// Safe field: Queue
+
+	// Safe field: Status
+
+	// Safe field: RetryCount
+
+	// Safe field: MaxRetry
+
+	// Safe field: Progress
+
+	// Safe field: Message
+
+	// Safe field: Error
+
+	// Safe field: Result
+
+	// Safe field: StartedAt
+
+	// Safe field: FinishedAt
+
+	// Safe field: DurationMs
+
+	// Safe field: TenantId
+
+	// Safe field: CreatedAt
+	return x.String()
+}
+
 // Redact method implementation for ListTaskResponse
 func (x *ListTaskResponse) Redact() string {
 	if x == nil {
@@ -296,6 +408,18 @@ func (x *RestartAllTaskResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for StartAllTaskResponse
+func (x *StartAllTaskResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Count
+
+	// Safe field: Items
+	return x.String()
+}
+
 // Redact method implementation for ControlTaskRequest
 func (x *ControlTaskRequest) Redact() string {
 	if x == nil {
@@ -317,3 +441,155 @@ func (x *ListTaskTypeNameResponse) Redact() string {
 	// Safe field: TypeNames
 	return x.String()
 }
+
+// Redact method implementation for ListTaskRunResponse
+func (x *ListTaskRunResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for GetTaskRunRequest
+func (x *GetTaskRunRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: ViewMask
+	return x.String()
+}
+
+// Redact method implementation for TaskQueueInfo
+func (x *TaskQueueInfo) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Queue
+
+	// Safe field: MemoryUsage
+
+	// Safe field: Latency
+
+	// Safe field: Size
+
+	// Safe field: Pending
+
+	// Safe field: Active
+
+	// Safe field: Scheduled
+
+	// Safe field: Retry
+
+	// Safe field: Archived
+
+	// Safe field: Completed
+
+	// Safe field: Aggregating
+
+	// Safe field: Processed
+
+	// Safe field: Failed
+
+	// Safe field: ProcessedTotal
+
+	// Safe field: FailedTotal
+
+	// Safe field: Paused
+
+	// Safe field: Timestamp
+	return x.String()
+}
+
+// Redact method implementation for ListTaskQueueResponse
+func (x *ListTaskQueueResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for QueueTask
+func (x *QueueTask) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Queue
+
+	// Safe field: TypeName
+
+	// Safe field: Payload
+
+	// Safe field: State
+
+	// Safe field: MaxRetry
+
+	// Safe field: Retried
+
+	// Safe field: LastError
+
+	// Safe field: LastFailedAt
+
+	// Safe field: NextProcessAt
+
+	// Safe field: CompletedAt
+
+	// Safe field: Group
+
+	// Safe field: IsOrphaned
+	return x.String()
+}
+
+// Redact method implementation for ListQueueTaskRequest
+func (x *ListQueueTaskRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Queue
+
+	// Safe field: State
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for ListQueueTaskResponse
+func (x *ListQueueTaskResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for QueueTaskRequest
+func (x *QueueTaskRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Queue
+
+	// Safe field: Id
+	return x.String()
+}
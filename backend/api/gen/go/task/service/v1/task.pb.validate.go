@@ -426,6 +426,43 @@ func (m *Task) validate(all bool) error {
 		// no validation rules for Remark
 	}
 
+	if m.LastRun != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastRun()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  "LastRun",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  "LastRun",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastRun()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskValidationError{
+					field:  "LastRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Paused != nil {
+		// no validation rules for Paused
+	}
+
 	if m.TenantId != nil {
 		// no validation rules for TenantId
 	}
@@ -618,6 +655,259 @@ var _ interface {
 	ErrorName() string
 } = TaskValidationError{}
 
+// Validate checks the field values on TaskRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TaskRunMultiError, or nil if none found.
+func (m *TaskRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Id != nil {
+		// no validation rules for Id
+	}
+
+	if m.TaskId != nil {
+		// no validation rules for TaskId
+	}
+
+	if m.TypeName != nil {
+		// no validation rules for TypeName
+	}
+
+	if m.QueueTaskId != nil {
+		// no validation rules for QueueTaskId
+	}
+
+	if m.Queue != nil {
+		// no validation rules for Queue
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.RetryCount != nil {
+		// no validation rules for RetryCount
+	}
+
+	if m.MaxRetry != nil {
+		// no validation rules for MaxRetry
+	}
+
+	if m.Progress != nil {
+		// no validation rules for Progress
+	}
+
+	if m.Message != nil {
+		// no validation rules for Message
+	}
+
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
+	if m.Result != nil {
+		// no validation rules for Result
+	}
+
+	if m.StartedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "StartedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "StartedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskRunValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.FinishedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetFinishedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskRunValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DurationMs != nil {
+		// no validation rules for DurationMs
+	}
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.CreatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskRunValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TaskRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskRunMultiError is an error wrapping multiple validation errors returned
+// by TaskRun.ValidateAll() if the designated constraints aren't met.
+type TaskRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskRunMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskRunMultiError) AllErrors() []error { return m }
+
+// TaskRunValidationError is the validation error returned by TaskRun.Validate
+// if the designated constraints aren't met.
+type TaskRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskRunValidationError) ErrorName() string { return "TaskRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskRunValidationError{}
+
 // Validate checks the field values on ListTaskResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1421,58 +1711,196 @@ var _ interface {
 	ErrorName() string
 } = RestartAllTaskResponseValidationError{}
 
-// Validate checks the field values on ControlTaskRequest with the rules
+// Validate checks the field values on StartAllTaskResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ControlTaskRequest) Validate() error {
+func (m *StartAllTaskResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ControlTaskRequest with the rules
+// ValidateAll checks the field values on StartAllTaskResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ControlTaskRequestMultiError, or nil if none found.
-func (m *ControlTaskRequest) ValidateAll() error {
+// StartAllTaskResponseMultiError, or nil if none found.
+func (m *StartAllTaskResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ControlTaskRequest) validate(all bool) error {
+func (m *StartAllTaskResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ControlType
-
-	// no validation rules for TypeName
-
-	if len(errors) > 0 {
-		return ControlTaskRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// ControlTaskRequestMultiError is an error wrapping multiple validation errors
-// returned by ControlTaskRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ControlTaskRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m ControlTaskRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	// no validation rules for Count
 
-// AllErrors returns a list of validation violation errors.
-func (m ControlTaskRequestMultiError) AllErrors() []error { return m }
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
 
-// ControlTaskRequestValidationError is the validation error returned by
-// ControlTaskRequest.Validate if the designated constraints aren't met.
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartAllTaskResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartAllTaskResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartAllTaskResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StartAllTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartAllTaskResponseMultiError is an error wrapping multiple validation
+// errors returned by StartAllTaskResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartAllTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartAllTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartAllTaskResponseMultiError) AllErrors() []error { return m }
+
+// StartAllTaskResponseValidationError is the validation error returned by
+// StartAllTaskResponse.Validate if the designated constraints aren't met.
+type StartAllTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartAllTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartAllTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartAllTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartAllTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartAllTaskResponseValidationError) ErrorName() string {
+	return "StartAllTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartAllTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartAllTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartAllTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartAllTaskResponseValidationError{}
+
+// Validate checks the field values on ControlTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ControlTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ControlTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ControlTaskRequestMultiError, or nil if none found.
+func (m *ControlTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ControlTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ControlType
+
+	// no validation rules for TypeName
+
+	if len(errors) > 0 {
+		return ControlTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ControlTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by ControlTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ControlTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ControlTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ControlTaskRequestMultiError) AllErrors() []error { return m }
+
+// ControlTaskRequestValidationError is the validation error returned by
+// ControlTaskRequest.Validate if the designated constraints aren't met.
 type ControlTaskRequestValidationError struct {
 	field  string
 	reason string
@@ -1628,3 +2056,1236 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListTaskTypeNameResponseValidationError{}
+
+// Validate checks the field values on ListTaskRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskRunResponseMultiError, or nil if none found.
+func (m *ListTaskRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTaskRunResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTaskRunResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTaskRunResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListTaskRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskRunResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTaskRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaskRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskRunResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskRunResponseMultiError) AllErrors() []error { return m }
+
+// ListTaskRunResponseValidationError is the validation error returned by
+// ListTaskRunResponse.Validate if the designated constraints aren't met.
+type ListTaskRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskRunResponseValidationError) ErrorName() string {
+	return "ListTaskRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskRunResponseValidationError{}
+
+// Validate checks the field values on GetTaskRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTaskRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTaskRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTaskRunRequestMultiError, or nil if none found.
+func (m *GetTaskRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTaskRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.ViewMask != nil {
+
+		if all {
+			switch v := interface{}(m.GetViewMask()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTaskRunRequestValidationError{
+						field:  "ViewMask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTaskRunRequestValidationError{
+						field:  "ViewMask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetViewMask()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTaskRunRequestValidationError{
+					field:  "ViewMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetTaskRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTaskRunRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTaskRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTaskRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTaskRunRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTaskRunRequestMultiError) AllErrors() []error { return m }
+
+// GetTaskRunRequestValidationError is the validation error returned by
+// GetTaskRunRequest.Validate if the designated constraints aren't met.
+type GetTaskRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTaskRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTaskRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTaskRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTaskRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTaskRunRequestValidationError) ErrorName() string {
+	return "GetTaskRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTaskRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTaskRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTaskRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTaskRunRequestValidationError{}
+
+// Validate checks the field values on TaskQueueInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskQueueInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskQueueInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaskQueueInfoMultiError, or
+// nil if none found.
+func (m *TaskQueueInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskQueueInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Queue != nil {
+		// no validation rules for Queue
+	}
+
+	if m.MemoryUsage != nil {
+		// no validation rules for MemoryUsage
+	}
+
+	if m.Latency != nil {
+
+		if all {
+			switch v := interface{}(m.GetLatency()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskQueueInfoValidationError{
+						field:  "Latency",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskQueueInfoValidationError{
+						field:  "Latency",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLatency()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskQueueInfoValidationError{
+					field:  "Latency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Size != nil {
+		// no validation rules for Size
+	}
+
+	if m.Pending != nil {
+		// no validation rules for Pending
+	}
+
+	if m.Active != nil {
+		// no validation rules for Active
+	}
+
+	if m.Scheduled != nil {
+		// no validation rules for Scheduled
+	}
+
+	if m.Retry != nil {
+		// no validation rules for Retry
+	}
+
+	if m.Archived != nil {
+		// no validation rules for Archived
+	}
+
+	if m.Completed != nil {
+		// no validation rules for Completed
+	}
+
+	if m.Aggregating != nil {
+		// no validation rules for Aggregating
+	}
+
+	if m.Processed != nil {
+		// no validation rules for Processed
+	}
+
+	if m.Failed != nil {
+		// no validation rules for Failed
+	}
+
+	if m.ProcessedTotal != nil {
+		// no validation rules for ProcessedTotal
+	}
+
+	if m.FailedTotal != nil {
+		// no validation rules for FailedTotal
+	}
+
+	if m.Paused != nil {
+		// no validation rules for Paused
+	}
+
+	if m.Timestamp != nil {
+
+		if all {
+			switch v := interface{}(m.GetTimestamp()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskQueueInfoValidationError{
+						field:  "Timestamp",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskQueueInfoValidationError{
+						field:  "Timestamp",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskQueueInfoValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TaskQueueInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskQueueInfoMultiError is an error wrapping multiple validation errors
+// returned by TaskQueueInfo.ValidateAll() if the designated constraints
+// aren't met.
+type TaskQueueInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskQueueInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskQueueInfoMultiError) AllErrors() []error { return m }
+
+// TaskQueueInfoValidationError is the validation error returned by
+// TaskQueueInfo.Validate if the designated constraints aren't met.
+type TaskQueueInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskQueueInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskQueueInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskQueueInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskQueueInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskQueueInfoValidationError) ErrorName() string { return "TaskQueueInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskQueueInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskQueueInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskQueueInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskQueueInfoValidationError{}
+
+// Validate checks the field values on ListTaskQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskQueueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskQueueResponseMultiError, or nil if none found.
+func (m *ListTaskQueueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskQueueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTaskQueueResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTaskQueueResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTaskQueueResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListTaskQueueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskQueueResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTaskQueueResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaskQueueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskQueueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskQueueResponseMultiError) AllErrors() []error { return m }
+
+// ListTaskQueueResponseValidationError is the validation error returned by
+// ListTaskQueueResponse.Validate if the designated constraints aren't met.
+type ListTaskQueueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskQueueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskQueueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskQueueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskQueueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskQueueResponseValidationError) ErrorName() string {
+	return "ListTaskQueueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskQueueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskQueueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskQueueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskQueueResponseValidationError{}
+
+// Validate checks the field values on QueueTask with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueueTask) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueTask with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueueTaskMultiError, or nil
+// if none found.
+func (m *QueueTask) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueTask) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Id != nil {
+		// no validation rules for Id
+	}
+
+	if m.Queue != nil {
+		// no validation rules for Queue
+	}
+
+	if m.TypeName != nil {
+		// no validation rules for TypeName
+	}
+
+	if m.Payload != nil {
+		// no validation rules for Payload
+	}
+
+	if m.State != nil {
+		// no validation rules for State
+	}
+
+	if m.MaxRetry != nil {
+		// no validation rules for MaxRetry
+	}
+
+	if m.Retried != nil {
+		// no validation rules for Retried
+	}
+
+	if m.LastError != nil {
+		// no validation rules for LastError
+	}
+
+	if m.LastFailedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastFailedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueueTaskValidationError{
+						field:  "LastFailedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueueTaskValidationError{
+						field:  "LastFailedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastFailedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueueTaskValidationError{
+					field:  "LastFailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.NextProcessAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetNextProcessAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueueTaskValidationError{
+						field:  "NextProcessAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueueTaskValidationError{
+						field:  "NextProcessAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNextProcessAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueueTaskValidationError{
+					field:  "NextProcessAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CompletedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCompletedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueueTaskValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueueTaskValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueueTaskValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Group != nil {
+		// no validation rules for Group
+	}
+
+	if m.IsOrphaned != nil {
+		// no validation rules for IsOrphaned
+	}
+
+	if len(errors) > 0 {
+		return QueueTaskMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueTaskMultiError is an error wrapping multiple validation errors returned
+// by QueueTask.ValidateAll() if the designated constraints aren't met.
+type QueueTaskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueTaskMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueTaskMultiError) AllErrors() []error { return m }
+
+// QueueTaskValidationError is the validation error returned by
+// QueueTask.Validate if the designated constraints aren't met.
+type QueueTaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueTaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueTaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueTaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueTaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueTaskValidationError) ErrorName() string { return "QueueTaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueueTaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueTaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueTaskValidationError{}
+
+// Validate checks the field values on ListQueueTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueueTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueueTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueueTaskRequestMultiError, or nil if none found.
+func (m *ListQueueTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueueTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Queue
+
+	// no validation rules for State
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return ListQueueTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueueTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by ListQueueTaskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListQueueTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueueTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueueTaskRequestMultiError) AllErrors() []error { return m }
+
+// ListQueueTaskRequestValidationError is the validation error returned by
+// ListQueueTaskRequest.Validate if the designated constraints aren't met.
+type ListQueueTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueueTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueueTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueueTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueueTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueueTaskRequestValidationError) ErrorName() string {
+	return "ListQueueTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueueTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueueTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueueTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueueTaskRequestValidationError{}
+
+// Validate checks the field values on ListQueueTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueueTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueueTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueueTaskResponseMultiError, or nil if none found.
+func (m *ListQueueTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueueTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQueueTaskResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQueueTaskResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQueueTaskResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListQueueTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueueTaskResponseMultiError is an error wrapping multiple validation
+// errors returned by ListQueueTaskResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListQueueTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueueTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueueTaskResponseMultiError) AllErrors() []error { return m }
+
+// ListQueueTaskResponseValidationError is the validation error returned by
+// ListQueueTaskResponse.Validate if the designated constraints aren't met.
+type ListQueueTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueueTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueueTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueueTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueueTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueueTaskResponseValidationError) ErrorName() string {
+	return "ListQueueTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueueTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueueTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQueueTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueueTaskResponseValidationError{}
+
+// Validate checks the field values on QueueTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueueTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueueTaskRequestMultiError, or nil if none found.
+func (m *QueueTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Queue
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return QueueTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by QueueTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type QueueTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueTaskRequestMultiError) AllErrors() []error { return m }
+
+// QueueTaskRequestValidationError is the validation error returned by
+// QueueTaskRequest.Validate if the designated constraints aren't met.
+type QueueTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueTaskRequestValidationError) ErrorName() string { return "QueueTaskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueueTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueTaskRequestValidationError{}
@@ -21,16 +21,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TaskService_List_FullMethodName             = "/task.service.v1.TaskService/List"
-	TaskService_Get_FullMethodName              = "/task.service.v1.TaskService/Get"
-	TaskService_Create_FullMethodName           = "/task.service.v1.TaskService/Create"
-	TaskService_Update_FullMethodName           = "/task.service.v1.TaskService/Update"
-	TaskService_Delete_FullMethodName           = "/task.service.v1.TaskService/Delete"
-	TaskService_ListTaskTypeName_FullMethodName = "/task.service.v1.TaskService/ListTaskTypeName"
-	TaskService_RestartAllTask_FullMethodName   = "/task.service.v1.TaskService/RestartAllTask"
-	TaskService_StartAllTask_FullMethodName     = "/task.service.v1.TaskService/StartAllTask"
-	TaskService_StopAllTask_FullMethodName      = "/task.service.v1.TaskService/StopAllTask"
-	TaskService_ControlTask_FullMethodName      = "/task.service.v1.TaskService/ControlTask"
+	TaskService_List_FullMethodName               = "/task.service.v1.TaskService/List"
+	TaskService_Get_FullMethodName                = "/task.service.v1.TaskService/Get"
+	TaskService_Create_FullMethodName             = "/task.service.v1.TaskService/Create"
+	TaskService_Update_FullMethodName             = "/task.service.v1.TaskService/Update"
+	TaskService_Delete_FullMethodName             = "/task.service.v1.TaskService/Delete"
+	TaskService_ListTaskTypeName_FullMethodName   = "/task.service.v1.TaskService/ListTaskTypeName"
+	TaskService_RestartAllTask_FullMethodName     = "/task.service.v1.TaskService/RestartAllTask"
+	TaskService_StartAllTask_FullMethodName       = "/task.service.v1.TaskService/StartAllTask"
+	TaskService_StopAllTask_FullMethodName        = "/task.service.v1.TaskService/StopAllTask"
+	TaskService_ControlTask_FullMethodName        = "/task.service.v1.TaskService/ControlTask"
+	TaskService_ListTaskRun_FullMethodName        = "/task.service.v1.TaskService/ListTaskRun"
+	TaskService_GetTaskRun_FullMethodName         = "/task.service.v1.TaskService/GetTaskRun"
+	TaskService_ListTaskQueue_FullMethodName      = "/task.service.v1.TaskService/ListTaskQueue"
+	TaskService_ListQueueTask_FullMethodName      = "/task.service.v1.TaskService/ListQueueTask"
+	TaskService_RetryArchivedTask_FullMethodName  = "/task.service.v1.TaskService/RetryArchivedTask"
+	TaskService_DeleteArchivedTask_FullMethodName = "/task.service.v1.TaskService/DeleteArchivedTask"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -54,11 +60,23 @@ type TaskServiceClient interface {
 	// 重启所有的调度任务
 	RestartAllTask(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RestartAllTaskResponse, error)
 	// 启动所有的调度任务
-	StartAllTask(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StartAllTask(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StartAllTaskResponse, error)
 	// 停止所有的调度任务
 	StopAllTask(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 控制调度任务
 	ControlTask(ctx context.Context, in *ControlTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查询任务执行记录列表
+	ListTaskRun(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*ListTaskRunResponse, error)
+	// 查询任务执行记录详情
+	GetTaskRun(ctx context.Context, in *GetTaskRunRequest, opts ...grpc.CallOption) (*TaskRun, error)
+	// 查询任务队列统计
+	ListTaskQueue(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTaskQueueResponse, error)
+	// 查询队列中的任务
+	ListQueueTask(ctx context.Context, in *ListQueueTaskRequest, opts ...grpc.CallOption) (*ListQueueTaskResponse, error)
+	// 重新执行已归档（死信）的任务
+	RetryArchivedTask(ctx context.Context, in *QueueTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 删除已归档（死信）的任务
+	DeleteArchivedTask(ctx context.Context, in *QueueTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type taskServiceClient struct {
@@ -139,9 +157,9 @@ func (c *taskServiceClient) RestartAllTask(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
-func (c *taskServiceClient) StartAllTask(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *taskServiceClient) StartAllTask(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StartAllTaskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(StartAllTaskResponse)
 	err := c.cc.Invoke(ctx, TaskService_StartAllTask_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -169,6 +187,66 @@ func (c *taskServiceClient) ControlTask(ctx context.Context, in *ControlTaskRequ
 	return out, nil
 }
 
+func (c *taskServiceClient) ListTaskRun(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*ListTaskRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskRunResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTaskRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskRun(ctx context.Context, in *GetTaskRunRequest, opts ...grpc.CallOption) (*TaskRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskRun)
+	err := c.cc.Invoke(ctx, TaskService_GetTaskRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskQueue(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTaskQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskQueueResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTaskQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListQueueTask(ctx context.Context, in *ListQueueTaskRequest, opts ...grpc.CallOption) (*ListQueueTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueueTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListQueueTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RetryArchivedTask(ctx context.Context, in *QueueTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskService_RetryArchivedTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteArchivedTask(ctx context.Context, in *QueueTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskService_DeleteArchivedTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
@@ -190,11 +268,23 @@ type TaskServiceServer interface {
 	// 重启所有的调度任务
 	RestartAllTask(context.Context, *emptypb.Empty) (*RestartAllTaskResponse, error)
 	// 启动所有的调度任务
-	StartAllTask(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	StartAllTask(context.Context, *emptypb.Empty) (*StartAllTaskResponse, error)
 	// 停止所有的调度任务
 	StopAllTask(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// 控制调度任务
 	ControlTask(context.Context, *ControlTaskRequest) (*emptypb.Empty, error)
+	// 查询任务执行记录列表
+	ListTaskRun(context.Context, *v1.PagingRequest) (*ListTaskRunResponse, error)
+	// 查询任务执行记录详情
+	GetTaskRun(context.Context, *GetTaskRunRequest) (*TaskRun, error)
+	// 查询任务队列统计
+	ListTaskQueue(context.Context, *emptypb.Empty) (*ListTaskQueueResponse, error)
+	// 查询队列中的任务
+	ListQueueTask(context.Context, *ListQueueTaskRequest) (*ListQueueTaskResponse, error)
+	// 重新执行已归档（死信）的任务
+	RetryArchivedTask(context.Context, *QueueTaskRequest) (*emptypb.Empty, error)
+	// 删除已归档（死信）的任务
+	DeleteArchivedTask(context.Context, *QueueTaskRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -226,7 +316,7 @@ func (UnimplementedTaskServiceServer) ListTaskTypeName(context.Context, *emptypb
 func (UnimplementedTaskServiceServer) RestartAllTask(context.Context, *emptypb.Empty) (*RestartAllTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RestartAllTask not implemented")
 }
-func (UnimplementedTaskServiceServer) StartAllTask(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+func (UnimplementedTaskServiceServer) StartAllTask(context.Context, *emptypb.Empty) (*StartAllTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method StartAllTask not implemented")
 }
 func (UnimplementedTaskServiceServer) StopAllTask(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
@@ -235,6 +325,24 @@ func (UnimplementedTaskServiceServer) StopAllTask(context.Context, *emptypb.Empt
 func (UnimplementedTaskServiceServer) ControlTask(context.Context, *ControlTaskRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ControlTask not implemented")
 }
+func (UnimplementedTaskServiceServer) ListTaskRun(context.Context, *v1.PagingRequest) (*ListTaskRunResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTaskRun not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskRun(context.Context, *GetTaskRunRequest) (*TaskRun, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTaskRun not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskQueue(context.Context, *emptypb.Empty) (*ListTaskQueueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTaskQueue not implemented")
+}
+func (UnimplementedTaskServiceServer) ListQueueTask(context.Context, *ListQueueTaskRequest) (*ListQueueTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQueueTask not implemented")
+}
+func (UnimplementedTaskServiceServer) RetryArchivedTask(context.Context, *QueueTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryArchivedTask not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteArchivedTask(context.Context, *QueueTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteArchivedTask not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -436,6 +544,114 @@ func _TaskService_ControlTask_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_ListTaskRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskRun(ctx, req.(*v1.PagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTaskRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskRun(ctx, req.(*GetTaskRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskQueue(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListQueueTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueueTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListQueueTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListQueueTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListQueueTask(ctx, req.(*ListQueueTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RetryArchivedTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RetryArchivedTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RetryArchivedTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RetryArchivedTask(ctx, req.(*QueueTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteArchivedTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteArchivedTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteArchivedTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteArchivedTask(ctx, req.(*QueueTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -483,6 +699,30 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ControlTask",
 			Handler:    _TaskService_ControlTask_Handler,
 		},
+		{
+			MethodName: "ListTaskRun",
+			Handler:    _TaskService_ListTaskRun_Handler,
+		},
+		{
+			MethodName: "GetTaskRun",
+			Handler:    _TaskService_GetTaskRun_Handler,
+		},
+		{
+			MethodName: "ListTaskQueue",
+			Handler:    _TaskService_ListTaskQueue_Handler,
+		},
+		{
+			MethodName: "ListQueueTask",
+			Handler:    _TaskService_ListQueueTask_Handler,
+		},
+		{
+			MethodName: "RetryArchivedTask",
+			Handler:    _TaskService_RetryArchivedTask_Handler,
+		},
+		{
+			MethodName: "DeleteArchivedTask",
+			Handler:    _TaskService_DeleteArchivedTask_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "task/service/v1/task.proto",
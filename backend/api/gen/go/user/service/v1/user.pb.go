@@ -167,6 +167,7 @@ type User struct {
 	Region        *string                `protobuf:"bytes,29,opt,name=region,proto3,oneof" json:"region,omitempty"`                                   // 国家地区
 	Description   *string                `protobuf:"bytes,30,opt,name=description,proto3,oneof" json:"description,omitempty"`                         // 个人描述
 	Remark        *string                `protobuf:"bytes,31,opt,name=remark,proto3,oneof" json:"remark,omitempty"`                                   // 备注
+	ExternalId    *string                `protobuf:"bytes,32,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`         // 外部标识
 	LastLoginAt   *timestamppb.Timestamp `protobuf:"bytes,50,opt,name=last_login_at,json=lastLoginAt,proto3,oneof" json:"last_login_at,omitempty"`    // 最后登录时间
 	LastLoginIp   *string                `protobuf:"bytes,51,opt,name=last_login_ip,json=lastLoginIp,proto3,oneof" json:"last_login_ip,omitempty"`    // 最后登录IP
 	Status        *User_Status           `protobuf:"varint,52,opt,name=status,proto3,enum=user.service.v1.User_Status,oneof" json:"status,omitempty"` // 状态
@@ -400,6 +401,13 @@ func (x *User) GetRemark() string {
 	return ""
 }
 
+func (x *User) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
 func (x *User) GetLastLoginAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.LastLoginAt
@@ -530,6 +538,8 @@ type GetUserRequest struct {
 	//
 	//	*GetUserRequest_Id
 	//	*GetUserRequest_Username
+	//	*GetUserRequest_Email
+	//	*GetUserRequest_Mobile
 	QueryBy       isGetUserRequest_QueryBy `protobuf_oneof:"query_by"`
 	ViewMask      *fieldmaskpb.FieldMask   `protobuf:"bytes,100,opt,name=view_mask,json=viewMask,proto3,oneof" json:"view_mask,omitempty"` // 视图字段过滤器，用于控制返回的字段
 	unknownFields protoimpl.UnknownFields
@@ -591,6 +601,24 @@ func (x *GetUserRequest) GetUsername() string {
 	return ""
 }
 
+func (x *GetUserRequest) GetEmail() string {
+	if x != nil {
+		if x, ok := x.QueryBy.(*GetUserRequest_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *GetUserRequest) GetMobile() string {
+	if x != nil {
+		if x, ok := x.QueryBy.(*GetUserRequest_Mobile); ok {
+			return x.Mobile
+		}
+	}
+	return ""
+}
+
 func (x *GetUserRequest) GetViewMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.ViewMask
@@ -610,10 +638,22 @@ type GetUserRequest_Username struct {
 	Username string `protobuf:"bytes,2,opt,name=username,proto3,oneof"` // 用户登录名
 }
 
+type GetUserRequest_Email struct {
+	Email string `protobuf:"bytes,3,opt,name=email,proto3,oneof"` // 电子邮箱
+}
+
+type GetUserRequest_Mobile struct {
+	Mobile string `protobuf:"bytes,4,opt,name=mobile,proto3,oneof"` // 手机号码
+}
+
 func (*GetUserRequest_Id) isGetUserRequest_QueryBy() {}
 
 func (*GetUserRequest_Username) isGetUserRequest_QueryBy() {}
 
+func (*GetUserRequest_Email) isGetUserRequest_QueryBy() {}
+
+func (*GetUserRequest_Mobile) isGetUserRequest_QueryBy() {}
+
 // 创建用户 - 请求
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -834,6 +874,8 @@ type UserExistsRequest struct {
 	//
 	//	*UserExistsRequest_Id
 	//	*UserExistsRequest_Username
+	//	*UserExistsRequest_Email
+	//	*UserExistsRequest_Mobile
 	QueryBy       isUserExistsRequest_QueryBy `protobuf_oneof:"query_by"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -894,6 +936,24 @@ func (x *UserExistsRequest) GetUsername() string {
 	return ""
 }
 
+func (x *UserExistsRequest) GetEmail() string {
+	if x != nil {
+		if x, ok := x.QueryBy.(*UserExistsRequest_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *UserExistsRequest) GetMobile() string {
+	if x != nil {
+		if x, ok := x.QueryBy.(*UserExistsRequest_Mobile); ok {
+			return x.Mobile
+		}
+	}
+	return ""
+}
+
 type isUserExistsRequest_QueryBy interface {
 	isUserExistsRequest_QueryBy()
 }
@@ -906,10 +966,22 @@ type UserExistsRequest_Username struct {
 	Username string `protobuf:"bytes,2,opt,name=username,proto3,oneof"` // 用户登录名
 }
 
+type UserExistsRequest_Email struct {
+	Email string `protobuf:"bytes,3,opt,name=email,proto3,oneof"` // 电子邮箱
+}
+
+type UserExistsRequest_Mobile struct {
+	Mobile string `protobuf:"bytes,4,opt,name=mobile,proto3,oneof"` // 手机号码
+}
+
 func (*UserExistsRequest_Id) isUserExistsRequest_QueryBy() {}
 
 func (*UserExistsRequest_Username) isUserExistsRequest_QueryBy() {}
 
+func (*UserExistsRequest_Email) isUserExistsRequest_QueryBy() {}
+
+func (*UserExistsRequest_Mobile) isUserExistsRequest_QueryBy() {}
+
 // 用户是否存在 - 答复
 type UserExistsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1706,7 +1778,7 @@ var File_user_service_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_service_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x1auser/service/v1/user.proto\x12\x0fuser.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x16redact/v3/redact.proto\x1a\x1epagination/v1/pagination.proto\"\xb1\x15\n" +
+	"\x1auser/service/v1/user.proto\x12\x0fuser.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x16redact/v3/redact.proto\x1a\x1epagination/v1/pagination.proto\"\xb1\x16\n" +
 	"\x04User\x12#\n" +
 	"\x02id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDH\x00R\x02id\x88\x01\x01\x120\n" +
 	"\ttenant_id\x18\x02 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x01R\btenantId\x88\x01\x01\x128\n" +
@@ -1741,23 +1813,25 @@ const file_user_service_v1_user_proto_rawDesc = "" +
 	"\aaddress\x18\x1c \x01(\tB\f\xbaG\t\x92\x02\x06住址H\x10R\aaddress\x88\x01\x01\x12/\n" +
 	"\x06region\x18\x1d \x01(\tB\x12\xbaG\x0f\x92\x02\f国家地区H\x11R\x06region\x88\x01\x01\x129\n" +
 	"\vdescription\x18\x1e \x01(\tB\x12\xbaG\x0f\x92\x02\f个人描述H\x12R\vdescription\x88\x01\x01\x12)\n" +
-	"\x06remark\x18\x1f \x01(\tB\f\xbaG\t\x92\x02\x06备注H\x13R\x06remark\x88\x01\x01\x12]\n" +
-	"\rlast_login_at\x182 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12最后登录时间H\x14R\vlastLoginAt\x88\x01\x01\x12=\n" +
-	"\rlast_login_ip\x183 \x01(\tB\x14\xbaG\x11\x92\x02\x0e最后登录IPH\x15R\vlastLoginIp\x88\x01\x01\x12G\n" +
-	"\x06status\x184 \x01(\x0e2\x1c.user.service.v1.User.StatusB\f\xbaG\t\x92\x02\x06状态H\x16R\x06status\x88\x01\x01\x12\\\n" +
-	"\flocked_until\x185 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12锁定截止时间H\x17R\vlockedUntil\x88\x01\x01\x125\n" +
+	"\x06remark\x18\x1f \x01(\tB\f\xbaG\t\x92\x02\x06备注H\x13R\x06remark\x88\x01\x01\x12n\n" +
+	"\vexternal_id\x18  \x01(\tBH\xbaGE\x92\x02B外部系统中的用户标识，由 SCIM 等身份供应方写入H\x14R\n" +
+	"externalId\x88\x01\x01\x12]\n" +
+	"\rlast_login_at\x182 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12最后登录时间H\x15R\vlastLoginAt\x88\x01\x01\x12=\n" +
+	"\rlast_login_ip\x183 \x01(\tB\x14\xbaG\x11\x92\x02\x0e最后登录IPH\x16R\vlastLoginIp\x88\x01\x01\x12G\n" +
+	"\x06status\x184 \x01(\x0e2\x1c.user.service.v1.User.StatusB\f\xbaG\t\x92\x02\x06状态H\x17R\x06status\x88\x01\x01\x12\\\n" +
+	"\flocked_until\x185 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12锁定截止时间H\x18R\vlockedUntil\x88\x01\x01\x125\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x18R\tcreatedBy\x88\x01\x01\x125\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x19R\tcreatedBy\x88\x01\x01\x125\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x19R\tupdatedBy\x88\x01\x01\x12;\n" +
+	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x1aR\tupdatedBy\x88\x01\x01\x12;\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x1aR\tdeletedBy\x88\x01\x01\x12S\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x1bR\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x1bR\tcreatedAt\x88\x01\x01\x12S\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x1cR\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x1cR\tupdatedAt\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x1dR\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x1dR\tdeletedAt\x88\x01\x01\"*\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x1eR\tdeletedAt\x88\x01\x01\"*\n" +
 	"\x06Gender\x12\n" +
 	"\n" +
 	"\x06SECRET\x10\x00\x12\b\n" +
@@ -1797,7 +1871,8 @@ const file_user_service_v1_user_proto_rawDesc = "" +
 	"\b_addressB\t\n" +
 	"\a_regionB\x0e\n" +
 	"\f_descriptionB\t\n" +
-	"\a_remarkB\x10\n" +
+	"\a_remarkB\x0e\n" +
+	"\f_external_idB\x10\n" +
 	"\x0e_last_login_atB\x10\n" +
 	"\x0e_last_login_ipB\t\n" +
 	"\a_statusB\x0f\n" +
@@ -1810,10 +1885,12 @@ const file_user_service_v1_user_proto_rawDesc = "" +
 	"\v_deleted_at\"U\n" +
 	"\x10ListUserResponse\x12+\n" +
 	"\x05items\x18\x01 \x03(\v2\x15.user.service.v1.UserR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x04R\x05total\"\xfe\x01\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\xdc\x02\n" +
 	"\x0eGetUserRequest\x12\"\n" +
 	"\x02id\x18\x01 \x01(\rB\x10\xbaG\r\x18\x01\x92\x02\b用户IDH\x00R\x02id\x125\n" +
-	"\busername\x18\x02 \x01(\tB\x17\xbaG\x14\x18\x01\x92\x02\x0f用户登录名H\x00R\busername\x12w\n" +
+	"\busername\x18\x02 \x01(\tB\x17\xbaG\x14\x18\x01\x92\x02\x0f用户登录名H\x00R\busername\x12,\n" +
+	"\x05email\x18\x03 \x01(\tB\x14\xbaG\x11\x18\x01\x92\x02\f电子邮箱H\x00R\x05email\x12.\n" +
+	"\x06mobile\x18\x04 \x01(\tB\x14\xbaG\x11\x18\x01\x92\x02\f手机号码H\x00R\x06mobile\x12w\n" +
 	"\tview_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskB9\xbaG6\x92\x023视图字段过滤器，用于控制返回的字段H\x01R\bviewMask\x88\x01\x01B\n" +
 	"\n" +
 	"\bquery_byB\f\n" +
@@ -1835,10 +1912,12 @@ const file_user_service_v1_user_proto_rawDesc = "" +
 	"\x11DeleteUserRequest\x12\"\n" +
 	"\x02id\x18\x01 \x01(\rB\x10\xbaG\r\x18\x01\x92\x02\b用户IDH\x00R\x02id\x125\n" +
 	"\busername\x18\x02 \x01(\tB\x17\xbaG\x14\x18\x01\x92\x02\x0f用户登录名H\x00R\busernameB\v\n" +
-	"\tdelete_by\"z\n" +
+	"\tdelete_by\"\xd8\x01\n" +
 	"\x11UserExistsRequest\x12\"\n" +
 	"\x02id\x18\x01 \x01(\rB\x10\xbaG\r\x18\x01\x92\x02\b用户IDH\x00R\x02id\x125\n" +
-	"\busername\x18\x02 \x01(\tB\x17\xbaG\x14\x18\x01\x92\x02\x0f用户登录名H\x00R\busernameB\n" +
+	"\busername\x18\x02 \x01(\tB\x17\xbaG\x14\x18\x01\x92\x02\x0f用户登录名H\x00R\busername\x12,\n" +
+	"\x05email\x18\x03 \x01(\tB\x14\xbaG\x11\x18\x01\x92\x02\f电子邮箱H\x00R\x05email\x12.\n" +
+	"\x06mobile\x18\x04 \x01(\tB\x14\xbaG\x11\x18\x01\x92\x02\f手机号码H\x00R\x06mobileB\n" +
 	"\n" +
 	"\bquery_by\"*\n" +
 	"\x12UserExistsResponse\x12\x14\n" +
@@ -1990,6 +2069,8 @@ func file_user_service_v1_user_proto_init() {
 	file_user_service_v1_user_proto_msgTypes[2].OneofWrappers = []any{
 		(*GetUserRequest_Id)(nil),
 		(*GetUserRequest_Username)(nil),
+		(*GetUserRequest_Email)(nil),
+		(*GetUserRequest_Mobile)(nil),
 	}
 	file_user_service_v1_user_proto_msgTypes[3].OneofWrappers = []any{}
 	file_user_service_v1_user_proto_msgTypes[4].OneofWrappers = []any{}
@@ -2000,6 +2081,8 @@ func file_user_service_v1_user_proto_init() {
 	file_user_service_v1_user_proto_msgTypes[6].OneofWrappers = []any{
 		(*UserExistsRequest_Id)(nil),
 		(*UserExistsRequest_Username)(nil),
+		(*UserExistsRequest_Email)(nil),
+		(*UserExistsRequest_Mobile)(nil),
 	}
 	file_user_service_v1_user_proto_msgTypes[13].OneofWrappers = []any{
 		(*UploadAvatarRequest_ImageBase64)(nil),
@@ -199,6 +199,8 @@ func (x *User) Redact() string {
 
 	// Safe field: Remark
 
+	// Safe field: ExternalId
+
 	// Safe field: LastLoginAt
 
 	// Safe field: LastLoginIp
@@ -243,6 +245,10 @@ func (x *GetUserRequest) Redact() string {
 
 	// Safe field: Username
 
+	// Safe field: Email
+
+	// Safe field: Mobile
+
 	// Safe field: ViewMask
 	return x.String()
 }
@@ -298,6 +304,10 @@ func (x *UserExistsRequest) Redact() string {
 	// Safe field: Id
 
 	// Safe field: Username
+
+	// Safe field: Email
+
+	// Safe field: Mobile
 	return x.String()
 }
 
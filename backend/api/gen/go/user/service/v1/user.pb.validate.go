@@ -136,6 +136,10 @@ func (m *User) validate(all bool) error {
 		// no validation rules for Remark
 	}
 
+	if m.ExternalId != nil {
+		// no validation rules for ExternalId
+	}
+
 	if m.LastLoginAt != nil {
 
 		if all {
@@ -581,6 +585,30 @@ func (m *GetUserRequest) validate(all bool) error {
 			errors = append(errors, err)
 		}
 		// no validation rules for Username
+	case *GetUserRequest_Email:
+		if v == nil {
+			err := GetUserRequestValidationError{
+				field:  "QueryBy",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Email
+	case *GetUserRequest_Mobile:
+		if v == nil {
+			err := GetUserRequestValidationError{
+				field:  "QueryBy",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Mobile
 	default:
 		_ = v // ensures v is used
 	}
@@ -1179,6 +1207,30 @@ func (m *UserExistsRequest) validate(all bool) error {
 			errors = append(errors, err)
 		}
 		// no validation rules for Username
+	case *UserExistsRequest_Email:
+		if v == nil {
+			err := UserExistsRequestValidationError{
+				field:  "QueryBy",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Email
+	case *UserExistsRequest_Mobile:
+		if v == nil {
+			err := UserExistsRequestValidationError{
+				field:  "QueryBy",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Mobile
 	default:
 		_ = v // ensures v is used
 	}
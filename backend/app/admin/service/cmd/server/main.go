@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/spf13/cobra"
 	"github.com/tx7do/kratos-transport/transport/asynq"
 	"github.com/tx7do/kratos-transport/transport/sse"
 
@@ -36,6 +37,9 @@ import (
 
 	//_ "github.com/tx7do/kratos-bootstrap/tracer"
 
+	"go-wind-admin/app/admin/service/internal/data"
+
+	"go-wind-admin/pkg/lua"
 	"go-wind-admin/pkg/service"
 )
 
@@ -48,7 +52,10 @@ func newApp(
 	hs *http.Server,
 	as *asynq.Server,
 	ss *sse.Server,
+	luaEngine *lua.Engine,
 ) *kratos.App {
+	runServerStartHook(ctx, luaEngine)
+
 	return bootstrap.NewApp(ctx,
 		hs,
 		as,
@@ -56,6 +63,29 @@ func newApp(
 	)
 }
 
+// runServerStartHook 触发服务启动钩子，脚本执行失败不影响服务启动
+func runServerStartHook(ctx *bootstrap.Context, luaEngine *lua.Engine) {
+	if luaEngine == nil {
+		return
+	}
+
+	execCtx := lua.NewContext(lua.HookOnServerStart)
+	execCtx.Set("service", map[string]any{
+		"project": service.Project,
+		"app_id":  service.AdminService,
+		"version": version,
+	})
+	if cfg := ctx.GetConfig(); cfg != nil && cfg.Server != nil && cfg.Server.Rest != nil {
+		execCtx.Set("config", map[string]any{
+			"server": cfg.Server.Rest.GetAddr(),
+		})
+	}
+
+	if err := luaEngine.ExecuteHook(ctx.Context(), lua.HookOnServerStart, execCtx); err != nil {
+		ctx.NewLoggerHelper("lua/main/admin-service").Errorf("execute on_server_start hook failed: %s", err.Error())
+	}
+}
+
 func runApp() error {
 	ctx := bootstrap.NewContext(
 		context.Background(),
@@ -65,7 +95,10 @@ func runApp() error {
 			Version: version,
 		},
 	)
-	return bootstrap.RunApp(ctx, initApp)
+	data.RegisterAdminConfig(ctx)
+	return bootstrap.RunApp(ctx, initApp, func(root *cobra.Command) {
+		root.AddCommand(newRestoreCmd(ctx))
+	})
 }
 
 func main() {
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	bConfig "github.com/tx7do/kratos-bootstrap/config"
+	bLogger "github.com/tx7do/kratos-bootstrap/logger"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/service"
+
+	"go-wind-admin/pkg/backup"
+)
+
+// newRestoreCmd 从对象存储中的备份恢复数据库
+//
+//	server restore -c ../../configs --object database/20240102T030405.000Z.jsonl.gz --dry-run
+func newRestoreCmd(ctx *bootstrap.Context) *cobra.Command {
+	var (
+		bucket string
+		object string
+		tables []string
+		dryRun bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore the database from a backup archive in object storage",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			confPath, err := cmd.Flags().GetString("conf")
+			if err != nil {
+				return err
+			}
+
+			if err = bConfig.LoadBootstrapConfig(confPath); err != nil {
+				return err
+			}
+			cfg := bConfig.GetBootstrapConfig()
+			if cfg == nil {
+				return errors.New("bootstrap config is nil")
+			}
+
+			rctx := bootstrap.NewContextWithParam(ctx.Context(), ctx.GetAppInfo(), cfg, bLogger.NewLoggerProvider(cfg.Logger, ctx.GetAppInfo()))
+			// 扩展配置已在加载配置文件时填充
+			if adminCfg, ok := ctx.GetCustomConfig(data.AdminConfigKey); ok {
+				rctx.SetCustomConfig(data.AdminConfigKey, adminCfg.(proto.Message))
+			}
+
+			entClient, cleanup, err := data.NewEntClient(rctx)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			svc, err := service.NewBackupService(
+				rctx,
+				data.NewBackupRepo(rctx, entClient),
+				data.NewMinIoClient(rctx),
+				data.NewAdminConfig(rctx),
+			)
+			if err != nil {
+				return err
+			}
+
+			header, stats, err := svc.Restore(rctx.Context(), bucket, object, backup.RestoreOptions{
+				DryRun: dryRun,
+				Tables: tables,
+			})
+			if err != nil {
+				return err
+			}
+
+			action := "restored"
+			if dryRun {
+				action = "validated"
+			}
+			for _, t := range stats.Tables {
+				fmt.Printf("%-48s %d rows\n", t.Name, t.Rows)
+			}
+			fmt.Printf("%s %d tables, %d rows from backup created at %s\n", action, len(stats.Tables), stats.Rows, header.CreatedAt.Format("2006-01-02 15:04:05"))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&bucket, "bucket", "", "backup bucket, defaults to the configured bucket")
+	cmd.Flags().StringVar(&object, "object", "", "backup object name, eg: database/20240102T030405.000Z.jsonl.gz")
+	cmd.Flags().StringSliceVar(&tables, "tables", nil, "only restore the given tables")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "validate the backup against the database without writing")
+	_ = cmd.MarkFlagRequired("object")
+
+	return cmd
+}
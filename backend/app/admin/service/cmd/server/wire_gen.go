@@ -27,11 +27,14 @@ import (
 //   - func(): 应用关闭时的清理函数 / func(): cleanup function to run on shutdown
 //   - error: 构建过程中可能发生的错误 / error: possible construction error
 func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
-	authenticator := data.NewAuthenticator(context)
+	adminconfpbBootstrap := data.NewAdminConfig(context)
 	entClient, cleanup, err := data.NewEntClient(context)
 	if err != nil {
 		return nil, nil, err
 	}
+	jwtSigningKeyRepo := data.NewJwtSigningKeyRepo(context, entClient, adminconfpbBootstrap)
+	keyRing := data.NewJwtKeyRing(context, adminconfpbBootstrap, jwtSigningKeyRepo)
+	authenticator := data.NewAuthenticator(context, adminconfpbBootstrap, keyRing)
 	rolePermissionRepo := data.NewRolePermissionRepo(context, entClient)
 	permissionApiRepo := data.NewPermissionApiRepo(context, entClient)
 	permissionMenuRepo := data.NewPermissionMenuRepo(context, entClient)
@@ -39,11 +42,19 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	roleMetadataRepo := data.NewRoleMetadataRepo(context, entClient)
 	roleRepo := data.NewRoleRepo(context, entClient, rolePermissionRepo, permissionRepo, roleMetadataRepo)
 	apiRepo := data.NewApiRepo(context, entClient)
-	authorizerProvider := data.NewAuthorizerProvider(context, roleRepo, apiRepo)
+	crypto := data.NewPasswordCrypto()
+	oAuthClientRepo := data.NewOAuthClientRepo(context, entClient, crypto)
+	authorizerProvider := data.NewAuthorizerProvider(context, roleRepo, apiRepo, permissionRepo, oAuthClientRepo)
 	authorizer := data.NewAuthorizer(context, authorizerProvider)
 	apiAuditLogRepo := data.NewApiAuditLogRepo(context, entClient)
 	loginAuditLogRepo := data.NewLoginAuditLogRepo(context, entClient)
-	v := server.NewRestMiddleware(context, authenticator, authorizer, apiAuditLogRepo, loginAuditLogRepo)
+	client, cleanup2, err := data.NewRedisClient(context)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userTokenCacheRepo := data.NewUserTokenRepo(context, client, authenticator)
+	v := server.NewRestMiddleware(context, authenticator, authorizer, apiAuditLogRepo, loginAuditLogRepo, userTokenCacheRepo, oAuthClientRepo)
 	userRoleRepo := data.NewUserRoleRepo(context, entClient)
 	userOrgUnitRepo := data.NewUserOrgUnitRepo(context, entClient)
 	userPositionRepo := data.NewUserPositionRepo(context, entClient)
@@ -52,28 +63,76 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	membershipOrgUnitRepo := data.NewMembershipOrgUnitRepo(context, entClient)
 	membershipRepo := data.NewMembershipRepo(context, entClient, membershipRoleRepo, membershipPositionRepo, membershipOrgUnitRepo)
 	userRepo := data.NewUserRepo(context, entClient, userRoleRepo, userOrgUnitRepo, userPositionRepo, membershipRepo)
-	crypto := data.NewPasswordCrypto()
-	userCredentialRepo := data.NewUserCredentialRepo(context, entClient, crypto)
+	passwordPolicyRepo := data.NewPasswordPolicyRepo(context, entClient, adminconfpbBootstrap)
+	userCredentialRepo := data.NewUserCredentialRepo(context, entClient, crypto, passwordPolicyRepo)
 	tenantRepo := data.NewTenantRepo(context, entClient)
 	orgUnitRepo := data.NewOrgUnitRepo(context, entClient)
-	client, cleanup2, err := data.NewRedisClient(context)
+	operationAuditLogRepo := data.NewOperationAuditLogRepo(context, entClient)
+	notifier := data.NewNotifier(context, adminconfpbBootstrap)
+	verificationCodeRepo := data.NewVerificationCodeRepo(context, client, notifier, adminconfpbBootstrap)
+	registrationPolicyRepo := data.NewRegistrationPolicyRepo(context, entClient)
+	registrationInviteRepo := data.NewRegistrationInviteRepo(context, entClient)
+	userRegistrationRepo := data.NewUserRegistrationRepo(context, entClient, userRepo, userCredentialRepo, registrationInviteRepo)
+	ldapSourceRepo := data.NewLdapSourceRepo(context, entClient, adminconfpbBootstrap)
+	verifier := data.NewCaptchaVerifier(context, adminconfpbBootstrap)
+	transport, cleanup3, err := data.NewEventBusTransport(context, adminconfpbBootstrap, client)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	userTokenCacheRepo := data.NewUserTokenRepo(context, client, authenticator)
-	authenticationService := service.NewAuthenticationService(context, userRepo, userCredentialRepo, roleRepo, tenantRepo, membershipRepo, orgUnitRepo, permissionRepo, userTokenCacheRepo, authenticator)
+	eventBus, cleanup4 := data.NewEventBus(context, transport)
+	minIOClient := data.NewMinIoClient(context)
+	luaQueryRepo := data.NewLuaQueryRepo(context, entClient)
+	engine, cleanup5, err := data.NewLuaEngine(context, adminconfpbBootstrap, client, minIOClient, luaQueryRepo)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authenticationService := service.NewAuthenticationService(context, userRepo, userCredentialRepo, roleRepo, tenantRepo, membershipRepo, orgUnitRepo, permissionRepo, oAuthClientRepo, loginAuditLogRepo, operationAuditLogRepo, userTokenCacheRepo, verificationCodeRepo, registrationPolicyRepo, registrationInviteRepo, userRegistrationRepo, ldapSourceRepo, verifier, eventBus, authenticator, engine, adminconfpbBootstrap)
 	loginPolicyRepo := data.NewLoginPolicyRepo(context, entClient)
 	loginPolicyService := service.NewLoginPolicyService(context, loginPolicyRepo)
+	passwordPolicyService := service.NewPasswordPolicyService(context, passwordPolicyRepo)
+	registrationPolicyService := service.NewRegistrationPolicyService(context, registrationPolicyRepo, registrationInviteRepo, roleRepo, notifier)
+	ldapSyncRepo := data.NewLdapSyncRepo(context, entClient, userRepo, userCredentialRepo, userRoleRepo, userOrgUnitRepo)
+	ldapSourceService := service.NewLdapSourceService(context, ldapSourceRepo, ldapSyncRepo, roleRepo)
+	scimTokenRepo := data.NewScimTokenRepo(context, entClient)
+	scimTokenService := service.NewScimTokenService(context, scimTokenRepo, tenantRepo)
+	scimRepo := data.NewScimRepo(context, entClient, userRepo, userCredentialRepo, userRoleRepo, userOrgUnitRepo, roleRepo)
+	scimService := service.NewScimService(context, scimTokenRepo, scimRepo, userTokenCacheRepo, authorizer)
+	oAuthClientService := service.NewOAuthClientService(context, oAuthClientRepo, roleRepo, permissionRepo, operationAuditLogRepo, authorizer)
+	jwtSigningKeyService := service.NewJwtSigningKeyService(context, jwtSigningKeyRepo, operationAuditLogRepo, keyRing, adminconfpbBootstrap)
 	menuRepo := data.NewMenuRepo(context, entClient)
 	adminPortalService := service.NewAdminPortalService(context, menuRepo, roleRepo, userRepo)
 	taskRepo := data.NewTaskRepo(context, entClient)
-	taskService := service.NewTaskService(context, taskRepo, userRepo)
-	minIOClient := data.NewMinIoClient(context)
+	taskRunRepo := data.NewTaskRunRepo(context, entClient)
+	sseServer := server.NewSseServer(context)
+	taskService := service.NewTaskService(context, taskRepo, taskRunRepo, userRepo, userTokenCacheRepo, operationAuditLogRepo, client, sseServer)
+	luaScriptRepo := data.NewLuaScriptRepo(context, entClient)
+	luaScriptService, cleanup6, err := service.NewLuaScriptService(context, luaScriptRepo, operationAuditLogRepo, engine, client)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	uEditorService := service.NewUEditorService(context, minIOClient)
-	fileRepo := data.NewFileRepo(context, entClient)
-	fileService := service.NewFileService(context, fileRepo, minIOClient)
-	fileTransferService := service.NewFileTransferService(context, minIOClient, fileRepo)
+	storageQuotaRepo := data.NewStorageQuotaRepo(context, entClient)
+	fileRepo := data.NewFileRepo(context, entClient, storageQuotaRepo)
+	fileObjectLockRepo := data.NewFileObjectLockRepo(context, client)
+	fileService := service.NewFileService(context, fileRepo, fileObjectLockRepo, minIOClient)
+	scanner := data.NewContentScanner(context, adminconfpbBootstrap)
+	fileTransferService := service.NewFileTransferService(context, adminconfpbBootstrap, minIOClient, fileRepo, storageQuotaRepo, fileObjectLockRepo, scanner, engine)
+	storageQuotaService := service.NewStorageQuotaService(context, storageQuotaRepo, fileRepo)
+	fileShareRepo := data.NewFileShareRepo(context, entClient)
+	fileShareAttemptRepo := data.NewFileShareAttemptRepo(context, client)
+	dataAccessAuditLogRepo := data.NewDataAccessAuditLogRepo(context, entClient)
+	fileShareService := service.NewFileShareService(context, fileShareRepo, fileShareAttemptRepo, fileRepo, dataAccessAuditLogRepo, userTokenCacheRepo, crypto, authenticator, minIOClient)
 	dictTypeI18nRepo := data.NewDictTypeI18nRepo(context, entClient)
 	dictTypeRepo := data.NewDictTypeRepo(context, entClient, dictTypeI18nRepo)
 	dictTypeService := service.NewDictTypeService(context, dictTypeRepo)
@@ -84,8 +143,8 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	languageService := service.NewLanguageService(context, languageRepo)
 	tenantService := service.NewTenantService(context, tenantRepo, userRepo, userCredentialRepo, roleRepo, authorizer)
 	positionRepo := data.NewPositionRepo(context, entClient)
-	userService := service.NewUserService(context, userRepo, roleRepo, userCredentialRepo, positionRepo, orgUnitRepo, tenantRepo, membershipRepo)
-	userProfileService := service.NewUserProfileService(context, userRepo, userTokenCacheRepo, roleRepo, userCredentialRepo)
+	userService := service.NewUserService(context, userRepo, roleRepo, userCredentialRepo, positionRepo, orgUnitRepo, tenantRepo, membershipRepo, userTokenCacheRepo, engine)
+	userProfileService := service.NewUserProfileService(context, userRepo, userTokenCacheRepo, roleRepo, userCredentialRepo, fileRepo, storageQuotaRepo, verificationCodeRepo, adminconfpbBootstrap, minIOClient, scanner)
 	roleService := service.NewRoleService(context, authorizer, roleRepo, tenantRepo)
 	positionService := service.NewPositionService(context, positionRepo, orgUnitRepo)
 	orgUnitService := service.NewOrgUnitService(context, orgUnitRepo, userRepo)
@@ -100,31 +159,52 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	policyEvaluationLogService := service.NewPolicyEvaluationLogService(context, policyEvaluationLogRepo)
 	loginAuditLogService := service.NewLoginAuditLogService(context, loginAuditLogRepo)
 	apiAuditLogService := service.NewApiAuditLogService(context, apiAuditLogRepo, apiRepo)
-	operationAuditLogRepo := data.NewOperationAuditLogRepo(context, entClient)
 	operationAuditLogService := service.NewOperationAuditLogService(context, operationAuditLogRepo)
-	dataAccessAuditLogRepo := data.NewDataAccessAuditLogRepo(context, entClient)
 	dataAccessAuditLogService := service.NewDataAccessAuditLogService(context, dataAccessAuditLogRepo)
 	internalMessageRepo := data.NewInternalMessageRepo(context, entClient)
 	internalMessageCategoryRepo := data.NewInternalMessageCategoryRepo(context, entClient)
 	internalMessageRecipientRepo := data.NewInternalMessageRecipientRepo(context, entClient)
-	sseServer := server.NewSseServer(context)
 	internalMessageService := service.NewInternalMessageService(context, internalMessageRepo, internalMessageCategoryRepo, internalMessageRecipientRepo, userRepo, sseServer, userTokenCacheRepo)
 	internalMessageCategoryService := service.NewInternalMessageCategoryService(context, internalMessageCategoryRepo)
 	internalMessageRecipientService := service.NewInternalMessageRecipientService(context, internalMessageRepo, internalMessageRecipientRepo)
-	httpServer, err := server.NewRestServer(context, v, authorizer, authenticationService, loginPolicyService, adminPortalService, taskService, uEditorService, fileService, fileTransferService, dictTypeService, dictEntryService, languageService, tenantService, userService, userProfileService, roleService, positionService, orgUnitService, menuService, apiService, permissionService, permissionGroupService, permissionAuditLogService, policyEvaluationLogService, loginAuditLogService, apiAuditLogService, operationAuditLogService, dataAccessAuditLogService, internalMessageService, internalMessageCategoryService, internalMessageRecipientService)
+	httpServer, err := server.NewRestServer(context, v, authorizer, authenticationService, loginPolicyService, passwordPolicyService, registrationPolicyService, ldapSourceService, scimTokenService, scimService, oAuthClientService, jwtSigningKeyService, adminPortalService, taskService, luaScriptService, uEditorService, fileService, fileTransferService, storageQuotaService, fileShareService, dictTypeService, dictEntryService, languageService, tenantService, userService, userProfileService, roleService, positionService, orgUnitService, menuService, apiService, permissionService, permissionGroupService, permissionAuditLogService, policyEvaluationLogService, loginAuditLogService, apiAuditLogService, operationAuditLogService, dataAccessAuditLogService, internalMessageService, internalMessageCategoryService, internalMessageRecipientService)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	backupRepo := data.NewBackupRepo(context, entClient)
+	backupService, err := service.NewBackupService(context, backupRepo, minIOClient, adminconfpbBootstrap)
 	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	asynqServer, err := server.NewAsynqServer(context, taskService)
+	asynqServer, cleanup7, err := server.NewAsynqServer(context, taskService, storageQuotaService, backupService, ldapSourceService, engine)
 	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	app := newApp(context, httpServer, asynqServer, sseServer)
+	app := newApp(context, httpServer, asynqServer, sseServer, engine)
 	return app, func() {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 	}, nil
@@ -0,0 +1,25 @@
+package data
+
+import (
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+)
+
+// AdminConfigKey 后台服务扩展配置在 bootstrap.Context 中的注册键
+const AdminConfigKey = "admin"
+
+// RegisterAdminConfig 注册后台服务扩展配置，须在加载配置文件之前调用
+func RegisterAdminConfig(ctx *bootstrap.Context) {
+	ctx.RegisterCustomConfig(AdminConfigKey, &adminConfV1.Bootstrap{})
+}
+
+// NewAdminConfig 获取后台服务扩展配置，未注册时返回空配置
+func NewAdminConfig(ctx *bootstrap.Context) *adminConfV1.Bootstrap {
+	if v, ok := ctx.GetCustomConfig(AdminConfigKey); ok {
+		if cfg, ok := v.(*adminConfV1.Bootstrap); ok && cfg != nil {
+			return cfg
+		}
+	}
+	return &adminConfV1.Bootstrap{}
+}
@@ -158,6 +158,8 @@ func (r *ApiAuditLogRepo) Create(ctx context.Context, req *auditV1.CreateApiAudi
 		SetNillableTenantID(req.Data.TenantId).
 		SetNillableUserID(req.Data.UserId).
 		SetNillableUsername(req.Data.Username).
+		SetNillableActorUserID(req.Data.ActorUserId).
+		SetNillableActorUsername(req.Data.ActorUsername).
 		SetNillableIPAddress(req.Data.IpAddress).
 		SetGeoLocation(req.Data.GeoLocation).
 		SetDeviceInfo(req.Data.DeviceInfo).
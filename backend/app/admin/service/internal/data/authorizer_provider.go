@@ -30,19 +30,25 @@ type AuthorizerDataMap map[string]AuthorizerDataArray
 type AuthorizerProvider struct {
 	log *log.Helper
 
-	roleRepo *RoleRepo
-	apiRepo  *ApiRepo
+	roleRepo        *RoleRepo
+	apiRepo         *ApiRepo
+	permissionRepo  *PermissionRepo
+	oauthClientRepo *OAuthClientRepo
 }
 
 func NewAuthorizerProvider(
 	ctx *bootstrap.Context,
 	roleRepo *RoleRepo,
 	apiRepo *ApiRepo,
+	permissionRepo *PermissionRepo,
+	oauthClientRepo *OAuthClientRepo,
 ) *AuthorizerProvider {
 	return &AuthorizerProvider{
-		log:      ctx.NewLoggerHelper("authorizer-data-provider/data/admin-service"),
-		roleRepo: roleRepo,
-		apiRepo:  apiRepo,
+		log:             ctx.NewLoggerHelper("authorizer-data-provider/data/admin-service"),
+		roleRepo:        roleRepo,
+		apiRepo:         apiRepo,
+		permissionRepo:  permissionRepo,
+		oauthClientRepo: oauthClientRepo,
 	}
 }
 
@@ -81,26 +87,68 @@ func (p *AuthorizerProvider) Provide(ctx context.Context) (AuthorizerDataMap, er
 			continue
 		}
 
-		var authorizerDataArray AuthorizerDataArray
-		for _, api := range apis {
-			if api == nil {
-				continue
-			}
-			if api.GetPath() == "" || api.GetMethod() == "" {
-				continue
-			}
-
-			var data = AuthorizerData{
-				Domain: defaultDomain,
-				Path:   api.GetPath(),
-				Method: api.GetMethod(),
-			}
-			authorizerDataArray = append(authorizerDataArray, data)
-		}
-		if len(authorizerDataArray) > 0 {
+		if authorizerDataArray := newAuthorizerDataArray(apis); len(authorizerDataArray) > 0 {
 			result[role.GetCode()] = authorizerDataArray
 		}
 	}
 
+	p.provideClientScopes(ctx, result)
+
 	return result, nil
 }
+
+// provideClientScopes 为 OAuth 客户端使用的每个授权范围生成策略，主体为带前缀的权限码
+func (p *AuthorizerProvider) provideClientScopes(ctx context.Context, result AuthorizerDataMap) {
+	scopes, err := p.oauthClientRepo.ListEnabledScopes(ctx)
+	if err != nil {
+		p.log.Errorf("failed to list oauth client scopes: %v", err)
+		return
+	}
+
+	for _, scope := range scopes {
+		permissionIDs, err := p.permissionRepo.GetPermissionIDsByCodes(ctx, []string{scope})
+		if err != nil {
+			p.log.Errorf("failed to get permission ids by code [%s]: %v", scope, err)
+			continue
+		}
+		if len(permissionIDs) == 0 {
+			continue
+		}
+
+		apiIDs, err := p.permissionRepo.ListApiIDsByPermissionIDs(ctx, permissionIDs)
+		if err != nil {
+			p.log.Errorf("failed to get permission [%s] api ids: %v", scope, err)
+			continue
+		}
+
+		apis, err := p.apiRepo.GetApiByIDs(ctx, apiIDs)
+		if err != nil {
+			p.log.Errorf("failed to list apis by ids: %v", err)
+			continue
+		}
+
+		if authorizerDataArray := newAuthorizerDataArray(apis); len(authorizerDataArray) > 0 {
+			result[constants.ClientScopeSubject(scope)] = authorizerDataArray
+		}
+	}
+}
+
+// newAuthorizerDataArray 将接口列表转换为权限数据
+func newAuthorizerDataArray(apis []*permissionV1.Api) AuthorizerDataArray {
+	var authorizerDataArray AuthorizerDataArray
+	for _, api := range apis {
+		if api == nil {
+			continue
+		}
+		if api.GetPath() == "" || api.GetMethod() == "" {
+			continue
+		}
+
+		authorizerDataArray = append(authorizerDataArray, AuthorizerData{
+			Domain: defaultDomain,
+			Path:   api.GetPath(),
+			Method: api.GetMethod(),
+		})
+	}
+	return authorizerDataArray
+}
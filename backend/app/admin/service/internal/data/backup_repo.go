@@ -0,0 +1,108 @@
+package data
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/migrate"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+
+	"go-wind-admin/pkg/backup"
+)
+
+// BackupRepo 数据库逻辑备份与恢复，范围为 ent 管理的全部表
+type BackupRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	tables []string // 按外键依赖排序，被引用的表在前
+}
+
+func NewBackupRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *BackupRepo {
+	return &BackupRepo{
+		log:       ctx.NewLoggerHelper("backup/repo/admin-service"),
+		entClient: entClient,
+		tables:    sortTablesByDependency(migrate.Tables),
+	}
+}
+
+// sortTablesByDependency 按外键依赖对表做拓扑排序，忽略自引用
+func sortTablesByDependency(tables []*schema.Table) []string {
+	sorted := make([]string, 0, len(tables))
+	visited := make(map[string]bool, len(tables))
+
+	var visit func(t *schema.Table)
+	visit = func(t *schema.Table) {
+		if _, ok := visited[t.Name]; ok {
+			// 已完成或正在访问（存在环）时跳过
+			return
+		}
+		visited[t.Name] = false
+
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable != nil && fk.RefTable.Name != t.Name {
+				visit(fk.RefTable)
+			}
+		}
+
+		visited[t.Name] = true
+		sorted = append(sorted, t.Name)
+	}
+
+	for _, t := range tables {
+		visit(t)
+	}
+
+	return sorted
+}
+
+// Tables 返回要备份的表，names 为空时返回全部表
+func (r *BackupRepo) Tables(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return r.tables, nil
+	}
+
+	for _, name := range names {
+		if !slices.Contains(r.tables, name) {
+			return nil, adminV1.ErrorBadRequest("unknown table [%s]", name)
+		}
+	}
+
+	return slices.DeleteFunc(slices.Clone(r.tables), func(t string) bool {
+		return !slices.Contains(names, t)
+	}), nil
+}
+
+// Dialect 数据库方言
+func (r *BackupRepo) Dialect() string {
+	return r.entClient.Driver().Dialect()
+}
+
+// Dump 导出指定的表到 w
+func (r *BackupRepo) Dump(ctx context.Context, tables []string, w io.Writer) (*backup.Stats, error) {
+	stats, err := backup.Dump(ctx, r.entClient.DB(), r.Dialect(), tables, w)
+	if err != nil {
+		r.log.Errorf("dump database failed: %s", err.Error())
+		return nil, err
+	}
+	return stats, nil
+}
+
+// Restore 从 rd 恢复数据，opts.DryRun 为 true 时只做校验
+func (r *BackupRepo) Restore(ctx context.Context, rd io.Reader, opts backup.RestoreOptions) (*backup.Header, *backup.Stats, error) {
+	header, stats, err := backup.Restore(ctx, r.entClient.DB(), r.Dialect(), rd, opts)
+	if err != nil {
+		r.log.Errorf("restore database failed: %s", err.Error())
+		return header, nil, err
+	}
+	return header, stats, nil
+}
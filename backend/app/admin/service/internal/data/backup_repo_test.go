@@ -0,0 +1,38 @@
+package data
+
+import (
+	"slices"
+	"testing"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/stretchr/testify/assert"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/migrate"
+)
+
+func TestSortTablesByDependency(t *testing.T) {
+	tenants := &schema.Table{Name: "tenants"}
+	users := &schema.Table{Name: "users"}
+	roles := &schema.Table{Name: "roles"}
+	userRoles := &schema.Table{Name: "user_roles"}
+
+	users.ForeignKeys = []*schema.ForeignKey{{RefTable: tenants}}
+	roles.ForeignKeys = []*schema.ForeignKey{{RefTable: roles}} // 自引用
+	userRoles.ForeignKeys = []*schema.ForeignKey{{RefTable: users}, {RefTable: roles}}
+
+	assert.Equal(t,
+		[]string{"tenants", "users", "roles", "user_roles"},
+		sortTablesByDependency([]*schema.Table{userRoles, users, tenants, roles}),
+	)
+
+	// ent 管理的表：每张表都排在它引用的表之后
+	sorted := sortTablesByDependency(migrate.Tables)
+	assert.Len(t, sorted, len(migrate.Tables))
+	for _, table := range migrate.Tables {
+		for _, fk := range table.ForeignKeys {
+			if fk.RefTable.Name != table.Name {
+				assert.Less(t, slices.Index(sorted, fk.RefTable.Name), slices.Index(sorted, table.Name))
+			}
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package data
 
 import (
+	"strings"
+	"time"
+
 	"github.com/redis/go-redis/v9"
 	"github.com/tx7do/go-utils/password"
 
@@ -10,6 +13,12 @@ import (
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	redisClient "github.com/tx7do/kratos-bootstrap/cache/redis"
 
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+
+	"go-wind-admin/pkg/captcha"
+	"go-wind-admin/pkg/eventbus"
+	appJwt "go-wind-admin/pkg/jwt"
+	"go-wind-admin/pkg/notifier"
 	"go-wind-admin/pkg/oss"
 )
 
@@ -32,7 +41,7 @@ func NewRedisClient(ctx *bootstrap.Context) (*redis.Client, func(), error) {
 }
 
 // NewAuthenticator 创建认证器
-func NewAuthenticator(ctx *bootstrap.Context) authnEngine.Authenticator {
+func NewAuthenticator(ctx *bootstrap.Context, adminCfg *adminConfV1.Bootstrap, keyRing *appJwt.KeyRing) authnEngine.Authenticator {
 	cfg := ctx.GetConfig()
 	if cfg == nil || cfg.Authn == nil {
 		return nil
@@ -43,6 +52,15 @@ func NewAuthenticator(ctx *bootstrap.Context) authnEngine.Authenticator {
 		return nil
 
 	case "jwt":
+		if keyRing != nil {
+			var opts []appJwt.KeyRingAuthenticatorOption
+			// 配置了截止时间时，迁移期间继续接受使用共享密钥签发的旧令牌
+			if until := adminCfg.GetJwtKeyRing().GetLegacyHmacUntil(); until != nil {
+				opts = append(opts, appJwt.WithLegacyHMACKey("HS256", []byte(cfg.Authn.GetJwt().GetKey()), until.AsTime()))
+			}
+			return appJwt.NewKeyRingAuthenticator(keyRing, opts...)
+		}
+
 		authenticator, err := jwt.NewAuthenticator(
 			jwt.WithKey([]byte(cfg.Authn.GetJwt().GetKey())),
 			jwt.WithSigningMethod(cfg.Authn.GetJwt().GetMethod()),
@@ -60,6 +78,48 @@ func NewAuthenticator(ctx *bootstrap.Context) authnEngine.Authenticator {
 	}
 }
 
+// NewJwtKeyRing 创建JWT签名密钥环，只在 authn.jwt.method 为 RS256 或 ES256 时启用，否则返回 nil
+func NewJwtKeyRing(ctx *bootstrap.Context, adminCfg *adminConfV1.Bootstrap, repo *JwtSigningKeyRepo) *appJwt.KeyRing {
+	cfg := ctx.GetConfig()
+	if cfg == nil || cfg.GetAuthn().GetType() != "jwt" {
+		return nil
+	}
+
+	alg := cfg.GetAuthn().GetJwt().GetMethod()
+	if !appJwt.IsAsymmetricAlgorithm(alg) {
+		return nil
+	}
+
+	l := ctx.NewLoggerHelper("jwt-key-ring/data/admin-service")
+
+	// 首次启用时自动生成签名密钥
+	exist, err := repo.HasActiveKey(ctx.Context(), alg)
+	if err != nil {
+		l.Fatalf("query jwt signing key failed: %v", err)
+	}
+	if !exist {
+		if _, err = repo.Rotate(ctx.Context(), alg, JwtKeyRingRetireGrace(adminCfg), 0); err != nil {
+			l.Fatalf("generate jwt signing key failed: %v", err)
+		}
+		l.Infof("generated jwt signing key with algorithm %s", alg)
+	}
+
+	ring := appJwt.NewKeyRing(repo, adminCfg.GetJwtKeyRing().GetReloadInterval().AsDuration())
+	if err = ring.Reload(ctx.Context()); err != nil {
+		l.Fatalf("load jwt signing keys failed: %v", err)
+	}
+
+	return ring
+}
+
+// JwtKeyRingRetireGrace 签名密钥退役后继续验签的宽限期
+func JwtKeyRingRetireGrace(adminCfg *adminConfV1.Bootstrap) time.Duration {
+	if d := adminCfg.GetJwtKeyRing().GetRetireGrace(); d != nil {
+		return d.AsDuration()
+	}
+	return DefaultJwtSigningKeyRetireGrace
+}
+
 func NewUserTokenRepo(ctx *bootstrap.Context, rdb *redis.Client, authenticator authnEngine.Authenticator) *UserTokenCacheRepo {
 	cfg := ctx.GetConfig()
 	if cfg == nil || cfg.Server == nil || cfg.Server.Rest == nil {
@@ -81,6 +141,134 @@ func NewMinIoClient(ctx *bootstrap.Context) *oss.MinIOClient {
 	return oss.NewMinIoClient(ctx.GetConfig(), ctx.GetLogger())
 }
 
+// NewContentScanner 创建上传内容扫描器，未配置时返回 nil
+func NewContentScanner(ctx *bootstrap.Context, cfg *adminConfV1.Bootstrap) oss.Scanner {
+	c := cfg.GetFileStorage().GetScanner()
+	if c == nil || c.GetAddress() == "" {
+		return nil
+	}
+
+	switch strings.ToLower(c.GetType()) {
+	case "", "clamd":
+		return oss.NewClamdScanner(c.GetNetwork(), c.GetAddress(), c.GetTimeout().AsDuration())
+	default:
+		ctx.NewLoggerHelper("scanner/data/admin-service").Warnf("unsupported content scanner type [%s]", c.GetType())
+		return nil
+	}
+}
+
+// NewNotifier 创建消息发送器，按通道分发：邮件使用 SMTP，短信暂未接入服务商；
+// 开启 log_sink 时所有消息只输出到日志
+func NewNotifier(ctx *bootstrap.Context, cfg *adminConfV1.Bootstrap) notifier.Notifier {
+	c := cfg.GetNotifier()
+	l := ctx.NewLoggerHelper("notifier/data/admin-service")
+
+	router := notifier.NewRouter()
+
+	if c.GetLogSink() {
+		l.Warn("notifier log sink enabled, messages are written to the log and not delivered")
+		sink := notifier.NewLogNotifier(l)
+		return router.
+			Register(notifier.ChannelEmail, sink).
+			Register(notifier.ChannelSMS, sink)
+	}
+
+	if smtp := c.GetSmtp(); smtp.GetHost() != "" {
+		router.Register(notifier.ChannelEmail, notifier.NewSmtpNotifier(notifier.SmtpConfig{
+			Host:        smtp.GetHost(),
+			Port:        int(smtp.GetPort()),
+			Username:    smtp.GetUsername(),
+			Password:    smtp.GetPassword(),
+			From:        smtp.GetFrom(),
+			ImplicitTLS: smtp.GetImplicitTls(),
+			Timeout:     smtp.GetTimeout().AsDuration(),
+		}))
+	}
+	router.Register(notifier.ChannelSMS, notifier.NewStubSMSNotifier())
+
+	return router
+}
+
+// NewCaptchaVerifier 创建人机验证校验器，未配置时返回 nil
+func NewCaptchaVerifier(ctx *bootstrap.Context, cfg *adminConfV1.Bootstrap) captcha.Verifier {
+	c := cfg.GetCaptcha()
+	if c.GetSecret() == "" {
+		return nil
+	}
+
+	v, err := captcha.NewSiteVerifier(captcha.Config{
+		Provider:  captcha.Provider(c.GetProvider()),
+		Secret:    c.GetSecret(),
+		VerifyURL: c.GetVerifyUrl(),
+		Timeout:   c.GetTimeout().AsDuration(),
+	})
+	if err != nil {
+		ctx.NewLoggerHelper("captcha/data/admin-service").Errorf("create captcha verifier failed: %s", err.Error())
+		return nil
+	}
+	return v
+}
+
+// NewEventBusTransport 创建跨进程的事件传输，未配置时返回 nil
+func NewEventBusTransport(ctx *bootstrap.Context, cfg *adminConfV1.Bootstrap, rdb *redis.Client) (eventbus.Transport, func(), error) {
+	c := cfg.GetEventBus().GetRedisStream()
+	if c == nil || rdb == nil {
+		return nil, func() {}, nil
+	}
+
+	group := c.GetGroup()
+	if group == "" {
+		group = "admin-service"
+	}
+
+	var backoff eventbus.Backoff
+	if c.GetBackoff() != nil || c.GetMaxBackoff() != nil {
+		base, maxDelay := time.Second, time.Minute
+		if c.GetBackoff() != nil {
+			base = c.GetBackoff().AsDuration()
+		}
+		if c.GetMaxBackoff() != nil {
+			maxDelay = c.GetMaxBackoff().AsDuration()
+		}
+		backoff = eventbus.ExponentialBackoff(base, maxDelay)
+	}
+
+	transport, err := eventbus.NewRedisStreamTransport(rdb, eventbus.RedisStreamConfig{
+		Prefix:        c.GetPrefix(),
+		Group:         group,
+		Consumer:      c.GetConsumer(),
+		MaxLen:        c.GetMaxLen(),
+		BatchSize:     c.GetBatchSize(),
+		Block:         c.GetBlock().AsDuration(),
+		MaxDeliveries: c.GetMaxDeliveries(),
+		Backoff:       backoff,
+		ClaimTimeout:  c.GetClaimTimeout().AsDuration(),
+	}, ctx.GetLogger())
+	if err != nil {
+		return nil, func() {}, err
+	}
+
+	l := ctx.NewLoggerHelper("event-bus/data/admin-service")
+	return transport, func() {
+		if err := transport.Close(); err != nil {
+			l.Error(err)
+		}
+	}, nil
+}
+
+// NewEventBus 创建事件总线，配置了传输时事件经由传输持久化并分发到所有副本，否则只在进程内分发
+func NewEventBus(ctx *bootstrap.Context, transport eventbus.Transport) (eventbus.EventBus, func()) {
+	var bus eventbus.EventBus
+	if transport != nil {
+		bus = eventbus.NewTransportEventBus("", transport, ctx.GetLogger())
+	} else {
+		bus = eventbus.NewEventBus(ctx.GetLogger())
+	}
+	return bus, func() {
+		_ = bus.Close()
+	}
+}
+
 func NewPasswordCrypto() password.Crypto {
 	crypto, err := password.CreateCrypto("bcrypt")
 	if err != nil {
@@ -28,6 +28,10 @@ type ApiAuditLog struct {
 	UserID *uint32 `json:"user_id,omitempty"`
 	// 操作者账号名
 	Username *string `json:"username,omitempty"`
+	// 模拟登录时实际操作者的用户ID
+	ActorUserID *uint32 `json:"actor_user_id,omitempty"`
+	// 模拟登录时实际操作者的账号名
+	ActorUsername *string `json:"actor_username,omitempty"`
 	// IP地址
 	IPAddress *string `json:"ip_address,omitempty"`
 	// 地理位置(来自IP库)
@@ -86,9 +90,9 @@ func (*ApiAuditLog) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case apiauditlog.FieldSuccess:
 			values[i] = new(sql.NullBool)
-		case apiauditlog.FieldID, apiauditlog.FieldTenantID, apiauditlog.FieldUserID, apiauditlog.FieldLatencyMs, apiauditlog.FieldStatusCode:
+		case apiauditlog.FieldID, apiauditlog.FieldTenantID, apiauditlog.FieldUserID, apiauditlog.FieldActorUserID, apiauditlog.FieldLatencyMs, apiauditlog.FieldStatusCode:
 			values[i] = new(sql.NullInt64)
-		case apiauditlog.FieldUsername, apiauditlog.FieldIPAddress, apiauditlog.FieldReferer, apiauditlog.FieldAppVersion, apiauditlog.FieldHTTPMethod, apiauditlog.FieldPath, apiauditlog.FieldRequestURI, apiauditlog.FieldAPIModule, apiauditlog.FieldAPIOperation, apiauditlog.FieldAPIDescription, apiauditlog.FieldRequestID, apiauditlog.FieldTraceID, apiauditlog.FieldSpanID, apiauditlog.FieldReason, apiauditlog.FieldRequestHeader, apiauditlog.FieldRequestBody, apiauditlog.FieldResponse, apiauditlog.FieldLogHash:
+		case apiauditlog.FieldUsername, apiauditlog.FieldActorUsername, apiauditlog.FieldIPAddress, apiauditlog.FieldReferer, apiauditlog.FieldAppVersion, apiauditlog.FieldHTTPMethod, apiauditlog.FieldPath, apiauditlog.FieldRequestURI, apiauditlog.FieldAPIModule, apiauditlog.FieldAPIOperation, apiauditlog.FieldAPIDescription, apiauditlog.FieldRequestID, apiauditlog.FieldTraceID, apiauditlog.FieldSpanID, apiauditlog.FieldReason, apiauditlog.FieldRequestHeader, apiauditlog.FieldRequestBody, apiauditlog.FieldResponse, apiauditlog.FieldLogHash:
 			values[i] = new(sql.NullString)
 		case apiauditlog.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -141,6 +145,20 @@ func (_m *ApiAuditLog) assignValues(columns []string, values []any) error {
 				_m.Username = new(string)
 				*_m.Username = value.String
 			}
+		case apiauditlog.FieldActorUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_user_id", values[i])
+			} else if value.Valid {
+				_m.ActorUserID = new(uint32)
+				*_m.ActorUserID = uint32(value.Int64)
+			}
+		case apiauditlog.FieldActorUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_username", values[i])
+			} else if value.Valid {
+				_m.ActorUsername = new(string)
+				*_m.ActorUsername = value.String
+			}
 		case apiauditlog.FieldIPAddress:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
@@ -359,6 +377,16 @@ func (_m *ApiAuditLog) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.ActorUserID; v != nil {
+		builder.WriteString("actor_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ActorUsername; v != nil {
+		builder.WriteString("actor_username=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.IPAddress; v != nil {
 		builder.WriteString("ip_address=")
 		builder.WriteString(*v)
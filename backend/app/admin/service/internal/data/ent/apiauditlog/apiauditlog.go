@@ -20,6 +20,10 @@ const (
 	FieldUserID = "user_id"
 	// FieldUsername holds the string denoting the username field in the database.
 	FieldUsername = "username"
+	// FieldActorUserID holds the string denoting the actor_user_id field in the database.
+	FieldActorUserID = "actor_user_id"
+	// FieldActorUsername holds the string denoting the actor_username field in the database.
+	FieldActorUsername = "actor_username"
 	// FieldIPAddress holds the string denoting the ip_address field in the database.
 	FieldIPAddress = "ip_address"
 	// FieldGeoLocation holds the string denoting the geo_location field in the database.
@@ -77,6 +81,8 @@ var Columns = []string{
 	FieldTenantID,
 	FieldUserID,
 	FieldUsername,
+	FieldActorUserID,
+	FieldActorUsername,
 	FieldIPAddress,
 	FieldGeoLocation,
 	FieldDeviceInfo,
@@ -154,6 +160,16 @@ func ByUsername(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsername, opts...).ToFunc()
 }
 
+// ByActorUserID orders the results by the actor_user_id field.
+func ByActorUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorUserID, opts...).ToFunc()
+}
+
+// ByActorUsername orders the results by the actor_username field.
+func ByActorUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorUsername, opts...).ToFunc()
+}
+
 // ByIPAddress orders the results by the ip_address field.
 func ByIPAddress(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIPAddress, opts...).ToFunc()
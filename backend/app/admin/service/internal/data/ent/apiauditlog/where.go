@@ -74,6 +74,16 @@ func Username(v string) predicate.ApiAuditLog {
 	return predicate.ApiAuditLog(sql.FieldEQ(FieldUsername, v))
 }
 
+// ActorUserID applies equality check predicate on the "actor_user_id" field. It's identical to ActorUserIDEQ.
+func ActorUserID(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldEQ(FieldActorUserID, v))
+}
+
+// ActorUsername applies equality check predicate on the "actor_username" field. It's identical to ActorUsernameEQ.
+func ActorUsername(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldEQ(FieldActorUsername, v))
+}
+
 // IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
 func IPAddress(v string) predicate.ApiAuditLog {
 	return predicate.ApiAuditLog(sql.FieldEQ(FieldIPAddress, v))
@@ -404,6 +414,131 @@ func UsernameContainsFold(v string) predicate.ApiAuditLog {
 	return predicate.ApiAuditLog(sql.FieldContainsFold(FieldUsername, v))
 }
 
+// ActorUserIDEQ applies the EQ predicate on the "actor_user_id" field.
+func ActorUserIDEQ(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldEQ(FieldActorUserID, v))
+}
+
+// ActorUserIDNEQ applies the NEQ predicate on the "actor_user_id" field.
+func ActorUserIDNEQ(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNEQ(FieldActorUserID, v))
+}
+
+// ActorUserIDIn applies the In predicate on the "actor_user_id" field.
+func ActorUserIDIn(vs ...uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldIn(FieldActorUserID, vs...))
+}
+
+// ActorUserIDNotIn applies the NotIn predicate on the "actor_user_id" field.
+func ActorUserIDNotIn(vs ...uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNotIn(FieldActorUserID, vs...))
+}
+
+// ActorUserIDGT applies the GT predicate on the "actor_user_id" field.
+func ActorUserIDGT(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldGT(FieldActorUserID, v))
+}
+
+// ActorUserIDGTE applies the GTE predicate on the "actor_user_id" field.
+func ActorUserIDGTE(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldGTE(FieldActorUserID, v))
+}
+
+// ActorUserIDLT applies the LT predicate on the "actor_user_id" field.
+func ActorUserIDLT(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldLT(FieldActorUserID, v))
+}
+
+// ActorUserIDLTE applies the LTE predicate on the "actor_user_id" field.
+func ActorUserIDLTE(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldLTE(FieldActorUserID, v))
+}
+
+// ActorUserIDIsNil applies the IsNil predicate on the "actor_user_id" field.
+func ActorUserIDIsNil() predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldIsNull(FieldActorUserID))
+}
+
+// ActorUserIDNotNil applies the NotNil predicate on the "actor_user_id" field.
+func ActorUserIDNotNil() predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNotNull(FieldActorUserID))
+}
+
+// ActorUsernameEQ applies the EQ predicate on the "actor_username" field.
+func ActorUsernameEQ(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldEQ(FieldActorUsername, v))
+}
+
+// ActorUsernameNEQ applies the NEQ predicate on the "actor_username" field.
+func ActorUsernameNEQ(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNEQ(FieldActorUsername, v))
+}
+
+// ActorUsernameIn applies the In predicate on the "actor_username" field.
+func ActorUsernameIn(vs ...string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldIn(FieldActorUsername, vs...))
+}
+
+// ActorUsernameNotIn applies the NotIn predicate on the "actor_username" field.
+func ActorUsernameNotIn(vs ...string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNotIn(FieldActorUsername, vs...))
+}
+
+// ActorUsernameGT applies the GT predicate on the "actor_username" field.
+func ActorUsernameGT(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldGT(FieldActorUsername, v))
+}
+
+// ActorUsernameGTE applies the GTE predicate on the "actor_username" field.
+func ActorUsernameGTE(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldGTE(FieldActorUsername, v))
+}
+
+// ActorUsernameLT applies the LT predicate on the "actor_username" field.
+func ActorUsernameLT(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldLT(FieldActorUsername, v))
+}
+
+// ActorUsernameLTE applies the LTE predicate on the "actor_username" field.
+func ActorUsernameLTE(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldLTE(FieldActorUsername, v))
+}
+
+// ActorUsernameContains applies the Contains predicate on the "actor_username" field.
+func ActorUsernameContains(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldContains(FieldActorUsername, v))
+}
+
+// ActorUsernameHasPrefix applies the HasPrefix predicate on the "actor_username" field.
+func ActorUsernameHasPrefix(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldHasPrefix(FieldActorUsername, v))
+}
+
+// ActorUsernameHasSuffix applies the HasSuffix predicate on the "actor_username" field.
+func ActorUsernameHasSuffix(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldHasSuffix(FieldActorUsername, v))
+}
+
+// ActorUsernameIsNil applies the IsNil predicate on the "actor_username" field.
+func ActorUsernameIsNil() predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldIsNull(FieldActorUsername))
+}
+
+// ActorUsernameNotNil applies the NotNil predicate on the "actor_username" field.
+func ActorUsernameNotNil() predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNotNull(FieldActorUsername))
+}
+
+// ActorUsernameEqualFold applies the EqualFold predicate on the "actor_username" field.
+func ActorUsernameEqualFold(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldEqualFold(FieldActorUsername, v))
+}
+
+// ActorUsernameContainsFold applies the ContainsFold predicate on the "actor_username" field.
+func ActorUsernameContainsFold(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldContainsFold(FieldActorUsername, v))
+}
+
 // IPAddressEQ applies the EQ predicate on the "ip_address" field.
 func IPAddressEQ(v string) predicate.ApiAuditLog {
 	return predicate.ApiAuditLog(sql.FieldEQ(FieldIPAddress, v))
@@ -79,6 +79,34 @@ func (_c *ApiAuditLogCreate) SetNillableUsername(v *string) *ApiAuditLogCreate {
 	return _c
 }
 
+// SetActorUserID sets the "actor_user_id" field.
+func (_c *ApiAuditLogCreate) SetActorUserID(v uint32) *ApiAuditLogCreate {
+	_c.mutation.SetActorUserID(v)
+	return _c
+}
+
+// SetNillableActorUserID sets the "actor_user_id" field if the given value is not nil.
+func (_c *ApiAuditLogCreate) SetNillableActorUserID(v *uint32) *ApiAuditLogCreate {
+	if v != nil {
+		_c.SetActorUserID(*v)
+	}
+	return _c
+}
+
+// SetActorUsername sets the "actor_username" field.
+func (_c *ApiAuditLogCreate) SetActorUsername(v string) *ApiAuditLogCreate {
+	_c.mutation.SetActorUsername(v)
+	return _c
+}
+
+// SetNillableActorUsername sets the "actor_username" field if the given value is not nil.
+func (_c *ApiAuditLogCreate) SetNillableActorUsername(v *string) *ApiAuditLogCreate {
+	if v != nil {
+		_c.SetActorUsername(*v)
+	}
+	return _c
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (_c *ApiAuditLogCreate) SetIPAddress(v string) *ApiAuditLogCreate {
 	_c.mutation.SetIPAddress(v)
@@ -493,6 +521,14 @@ func (_c *ApiAuditLogCreate) createSpec() (*ApiAuditLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(apiauditlog.FieldUsername, field.TypeString, value)
 		_node.Username = &value
 	}
+	if value, ok := _c.mutation.ActorUserID(); ok {
+		_spec.SetField(apiauditlog.FieldActorUserID, field.TypeUint32, value)
+		_node.ActorUserID = &value
+	}
+	if value, ok := _c.mutation.ActorUsername(); ok {
+		_spec.SetField(apiauditlog.FieldActorUsername, field.TypeString, value)
+		_node.ActorUsername = &value
+	}
 	if value, ok := _c.mutation.IPAddress(); ok {
 		_spec.SetField(apiauditlog.FieldIPAddress, field.TypeString, value)
 		_node.IPAddress = &value
@@ -679,6 +715,48 @@ func (u *ApiAuditLogUpsert) ClearUsername() *ApiAuditLogUpsert {
 	return u
 }
 
+// SetActorUserID sets the "actor_user_id" field.
+func (u *ApiAuditLogUpsert) SetActorUserID(v uint32) *ApiAuditLogUpsert {
+	u.Set(apiauditlog.FieldActorUserID, v)
+	return u
+}
+
+// UpdateActorUserID sets the "actor_user_id" field to the value that was provided on create.
+func (u *ApiAuditLogUpsert) UpdateActorUserID() *ApiAuditLogUpsert {
+	u.SetExcluded(apiauditlog.FieldActorUserID)
+	return u
+}
+
+// AddActorUserID adds v to the "actor_user_id" field.
+func (u *ApiAuditLogUpsert) AddActorUserID(v uint32) *ApiAuditLogUpsert {
+	u.Add(apiauditlog.FieldActorUserID, v)
+	return u
+}
+
+// ClearActorUserID clears the value of the "actor_user_id" field.
+func (u *ApiAuditLogUpsert) ClearActorUserID() *ApiAuditLogUpsert {
+	u.SetNull(apiauditlog.FieldActorUserID)
+	return u
+}
+
+// SetActorUsername sets the "actor_username" field.
+func (u *ApiAuditLogUpsert) SetActorUsername(v string) *ApiAuditLogUpsert {
+	u.Set(apiauditlog.FieldActorUsername, v)
+	return u
+}
+
+// UpdateActorUsername sets the "actor_username" field to the value that was provided on create.
+func (u *ApiAuditLogUpsert) UpdateActorUsername() *ApiAuditLogUpsert {
+	u.SetExcluded(apiauditlog.FieldActorUsername)
+	return u
+}
+
+// ClearActorUsername clears the value of the "actor_username" field.
+func (u *ApiAuditLogUpsert) ClearActorUsername() *ApiAuditLogUpsert {
+	u.SetNull(apiauditlog.FieldActorUsername)
+	return u
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (u *ApiAuditLogUpsert) SetIPAddress(v string) *ApiAuditLogUpsert {
 	u.Set(apiauditlog.FieldIPAddress, v)
@@ -1208,6 +1286,55 @@ func (u *ApiAuditLogUpsertOne) ClearUsername() *ApiAuditLogUpsertOne {
 	})
 }
 
+// SetActorUserID sets the "actor_user_id" field.
+func (u *ApiAuditLogUpsertOne) SetActorUserID(v uint32) *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.SetActorUserID(v)
+	})
+}
+
+// AddActorUserID adds v to the "actor_user_id" field.
+func (u *ApiAuditLogUpsertOne) AddActorUserID(v uint32) *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.AddActorUserID(v)
+	})
+}
+
+// UpdateActorUserID sets the "actor_user_id" field to the value that was provided on create.
+func (u *ApiAuditLogUpsertOne) UpdateActorUserID() *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.UpdateActorUserID()
+	})
+}
+
+// ClearActorUserID clears the value of the "actor_user_id" field.
+func (u *ApiAuditLogUpsertOne) ClearActorUserID() *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.ClearActorUserID()
+	})
+}
+
+// SetActorUsername sets the "actor_username" field.
+func (u *ApiAuditLogUpsertOne) SetActorUsername(v string) *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.SetActorUsername(v)
+	})
+}
+
+// UpdateActorUsername sets the "actor_username" field to the value that was provided on create.
+func (u *ApiAuditLogUpsertOne) UpdateActorUsername() *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.UpdateActorUsername()
+	})
+}
+
+// ClearActorUsername clears the value of the "actor_username" field.
+func (u *ApiAuditLogUpsertOne) ClearActorUsername() *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.ClearActorUsername()
+	})
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (u *ApiAuditLogUpsertOne) SetIPAddress(v string) *ApiAuditLogUpsertOne {
 	return u.Update(func(s *ApiAuditLogUpsert) {
@@ -1974,6 +2101,55 @@ func (u *ApiAuditLogUpsertBulk) ClearUsername() *ApiAuditLogUpsertBulk {
 	})
 }
 
+// SetActorUserID sets the "actor_user_id" field.
+func (u *ApiAuditLogUpsertBulk) SetActorUserID(v uint32) *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.SetActorUserID(v)
+	})
+}
+
+// AddActorUserID adds v to the "actor_user_id" field.
+func (u *ApiAuditLogUpsertBulk) AddActorUserID(v uint32) *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.AddActorUserID(v)
+	})
+}
+
+// UpdateActorUserID sets the "actor_user_id" field to the value that was provided on create.
+func (u *ApiAuditLogUpsertBulk) UpdateActorUserID() *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.UpdateActorUserID()
+	})
+}
+
+// ClearActorUserID clears the value of the "actor_user_id" field.
+func (u *ApiAuditLogUpsertBulk) ClearActorUserID() *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.ClearActorUserID()
+	})
+}
+
+// SetActorUsername sets the "actor_username" field.
+func (u *ApiAuditLogUpsertBulk) SetActorUsername(v string) *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.SetActorUsername(v)
+	})
+}
+
+// UpdateActorUsername sets the "actor_username" field to the value that was provided on create.
+func (u *ApiAuditLogUpsertBulk) UpdateActorUsername() *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.UpdateActorUsername()
+	})
+}
+
+// ClearActorUsername clears the value of the "actor_username" field.
+func (u *ApiAuditLogUpsertBulk) ClearActorUsername() *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.ClearActorUsername()
+	})
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (u *ApiAuditLogUpsertBulk) SetIPAddress(v string) *ApiAuditLogUpsertBulk {
 	return u.Update(func(s *ApiAuditLogUpsert) {
@@ -76,6 +76,53 @@ func (_u *ApiAuditLogUpdate) ClearUsername() *ApiAuditLogUpdate {
 	return _u
 }
 
+// SetActorUserID sets the "actor_user_id" field.
+func (_u *ApiAuditLogUpdate) SetActorUserID(v uint32) *ApiAuditLogUpdate {
+	_u.mutation.ResetActorUserID()
+	_u.mutation.SetActorUserID(v)
+	return _u
+}
+
+// SetNillableActorUserID sets the "actor_user_id" field if the given value is not nil.
+func (_u *ApiAuditLogUpdate) SetNillableActorUserID(v *uint32) *ApiAuditLogUpdate {
+	if v != nil {
+		_u.SetActorUserID(*v)
+	}
+	return _u
+}
+
+// AddActorUserID adds value to the "actor_user_id" field.
+func (_u *ApiAuditLogUpdate) AddActorUserID(v int32) *ApiAuditLogUpdate {
+	_u.mutation.AddActorUserID(v)
+	return _u
+}
+
+// ClearActorUserID clears the value of the "actor_user_id" field.
+func (_u *ApiAuditLogUpdate) ClearActorUserID() *ApiAuditLogUpdate {
+	_u.mutation.ClearActorUserID()
+	return _u
+}
+
+// SetActorUsername sets the "actor_username" field.
+func (_u *ApiAuditLogUpdate) SetActorUsername(v string) *ApiAuditLogUpdate {
+	_u.mutation.SetActorUsername(v)
+	return _u
+}
+
+// SetNillableActorUsername sets the "actor_username" field if the given value is not nil.
+func (_u *ApiAuditLogUpdate) SetNillableActorUsername(v *string) *ApiAuditLogUpdate {
+	if v != nil {
+		_u.SetActorUsername(*v)
+	}
+	return _u
+}
+
+// ClearActorUsername clears the value of the "actor_username" field.
+func (_u *ApiAuditLogUpdate) ClearActorUsername() *ApiAuditLogUpdate {
+	_u.mutation.ClearActorUsername()
+	return _u
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (_u *ApiAuditLogUpdate) SetIPAddress(v string) *ApiAuditLogUpdate {
 	_u.mutation.SetIPAddress(v)
@@ -594,6 +641,21 @@ func (_u *ApiAuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.UsernameCleared() {
 		_spec.ClearField(apiauditlog.FieldUsername, field.TypeString)
 	}
+	if value, ok := _u.mutation.ActorUserID(); ok {
+		_spec.SetField(apiauditlog.FieldActorUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedActorUserID(); ok {
+		_spec.AddField(apiauditlog.FieldActorUserID, field.TypeUint32, value)
+	}
+	if _u.mutation.ActorUserIDCleared() {
+		_spec.ClearField(apiauditlog.FieldActorUserID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ActorUsername(); ok {
+		_spec.SetField(apiauditlog.FieldActorUsername, field.TypeString, value)
+	}
+	if _u.mutation.ActorUsernameCleared() {
+		_spec.ClearField(apiauditlog.FieldActorUsername, field.TypeString)
+	}
 	if value, ok := _u.mutation.IPAddress(); ok {
 		_spec.SetField(apiauditlog.FieldIPAddress, field.TypeString, value)
 	}
@@ -807,6 +869,53 @@ func (_u *ApiAuditLogUpdateOne) ClearUsername() *ApiAuditLogUpdateOne {
 	return _u
 }
 
+// SetActorUserID sets the "actor_user_id" field.
+func (_u *ApiAuditLogUpdateOne) SetActorUserID(v uint32) *ApiAuditLogUpdateOne {
+	_u.mutation.ResetActorUserID()
+	_u.mutation.SetActorUserID(v)
+	return _u
+}
+
+// SetNillableActorUserID sets the "actor_user_id" field if the given value is not nil.
+func (_u *ApiAuditLogUpdateOne) SetNillableActorUserID(v *uint32) *ApiAuditLogUpdateOne {
+	if v != nil {
+		_u.SetActorUserID(*v)
+	}
+	return _u
+}
+
+// AddActorUserID adds value to the "actor_user_id" field.
+func (_u *ApiAuditLogUpdateOne) AddActorUserID(v int32) *ApiAuditLogUpdateOne {
+	_u.mutation.AddActorUserID(v)
+	return _u
+}
+
+// ClearActorUserID clears the value of the "actor_user_id" field.
+func (_u *ApiAuditLogUpdateOne) ClearActorUserID() *ApiAuditLogUpdateOne {
+	_u.mutation.ClearActorUserID()
+	return _u
+}
+
+// SetActorUsername sets the "actor_username" field.
+func (_u *ApiAuditLogUpdateOne) SetActorUsername(v string) *ApiAuditLogUpdateOne {
+	_u.mutation.SetActorUsername(v)
+	return _u
+}
+
+// SetNillableActorUsername sets the "actor_username" field if the given value is not nil.
+func (_u *ApiAuditLogUpdateOne) SetNillableActorUsername(v *string) *ApiAuditLogUpdateOne {
+	if v != nil {
+		_u.SetActorUsername(*v)
+	}
+	return _u
+}
+
+// ClearActorUsername clears the value of the "actor_username" field.
+func (_u *ApiAuditLogUpdateOne) ClearActorUsername() *ApiAuditLogUpdateOne {
+	_u.mutation.ClearActorUsername()
+	return _u
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (_u *ApiAuditLogUpdateOne) SetIPAddress(v string) *ApiAuditLogUpdateOne {
 	_u.mutation.SetIPAddress(v)
@@ -1355,6 +1464,21 @@ func (_u *ApiAuditLogUpdateOne) sqlSave(ctx context.Context) (_node *ApiAuditLog
 	if _u.mutation.UsernameCleared() {
 		_spec.ClearField(apiauditlog.FieldUsername, field.TypeString)
 	}
+	if value, ok := _u.mutation.ActorUserID(); ok {
+		_spec.SetField(apiauditlog.FieldActorUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedActorUserID(); ok {
+		_spec.AddField(apiauditlog.FieldActorUserID, field.TypeUint32, value)
+	}
+	if _u.mutation.ActorUserIDCleared() {
+		_spec.ClearField(apiauditlog.FieldActorUserID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ActorUsername(); ok {
+		_spec.SetField(apiauditlog.FieldActorUsername, field.TypeString, value)
+	}
+	if _u.mutation.ActorUsernameCleared() {
+		_spec.ClearField(apiauditlog.FieldActorUsername, field.TypeString)
+	}
 	if value, ok := _u.mutation.IPAddress(); ok {
 		_spec.SetField(apiauditlog.FieldIPAddress, field.TypeString, value)
 	}
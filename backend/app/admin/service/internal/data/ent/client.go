@@ -19,19 +19,26 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttypei18n"
 	"go-wind-admin/app/admin/service/internal/data/ent/file"
+	"go-wind-admin/app/admin/service/internal/data/ent/fileshare"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapsource"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascript"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
 	"go-wind-admin/app/admin/service/internal/data/ent/membership"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiporgunit"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershipposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiprole"
 	"go-wind-admin/app/admin/service/internal/data/ent/menu"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthclient"
 	"go-wind-admin/app/admin/service/internal/data/ent/operationauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/permission"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionapi"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionauditlog"
@@ -40,14 +47,20 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/policyevaluationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationinvite"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemetadata"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
+	"go-wind-admin/app/admin/service/internal/data/ent/scimtoken"
+	"go-wind-admin/app/admin/service/internal/data/ent/storagequota"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
 	"go-wind-admin/app/admin/service/internal/data/ent/userorgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/userpasswordhistory"
 	"go-wind-admin/app/admin/service/internal/data/ent/userposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
 
@@ -78,18 +91,28 @@ type Client struct {
 	DictTypeI18n *DictTypeI18nClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// FileShare is the client for interacting with the FileShare builders.
+	FileShare *FileShareClient
 	// InternalMessage is the client for interacting with the InternalMessage builders.
 	InternalMessage *InternalMessageClient
 	// InternalMessageCategory is the client for interacting with the InternalMessageCategory builders.
 	InternalMessageCategory *InternalMessageCategoryClient
 	// InternalMessageRecipient is the client for interacting with the InternalMessageRecipient builders.
 	InternalMessageRecipient *InternalMessageRecipientClient
+	// JwtSigningKey is the client for interacting with the JwtSigningKey builders.
+	JwtSigningKey *JwtSigningKeyClient
 	// Language is the client for interacting with the Language builders.
 	Language *LanguageClient
+	// LdapSource is the client for interacting with the LdapSource builders.
+	LdapSource *LdapSourceClient
 	// LoginAuditLog is the client for interacting with the LoginAuditLog builders.
 	LoginAuditLog *LoginAuditLogClient
 	// LoginPolicy is the client for interacting with the LoginPolicy builders.
 	LoginPolicy *LoginPolicyClient
+	// LuaScript is the client for interacting with the LuaScript builders.
+	LuaScript *LuaScriptClient
+	// LuaScriptVersion is the client for interacting with the LuaScriptVersion builders.
+	LuaScriptVersion *LuaScriptVersionClient
 	// Membership is the client for interacting with the Membership builders.
 	Membership *MembershipClient
 	// MembershipOrgUnit is the client for interacting with the MembershipOrgUnit builders.
@@ -100,10 +123,14 @@ type Client struct {
 	MembershipRole *MembershipRoleClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
+	// OAuthClient is the client for interacting with the OAuthClient builders.
+	OAuthClient *OAuthClientClient
 	// OperationAuditLog is the client for interacting with the OperationAuditLog builders.
 	OperationAuditLog *OperationAuditLogClient
 	// OrgUnit is the client for interacting with the OrgUnit builders.
 	OrgUnit *OrgUnitClient
+	// PasswordPolicy is the client for interacting with the PasswordPolicy builders.
+	PasswordPolicy *PasswordPolicyClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
 	// PermissionApi is the client for interacting with the PermissionApi builders.
@@ -120,14 +147,24 @@ type Client struct {
 	PolicyEvaluationLog *PolicyEvaluationLogClient
 	// Position is the client for interacting with the Position builders.
 	Position *PositionClient
+	// RegistrationInvite is the client for interacting with the RegistrationInvite builders.
+	RegistrationInvite *RegistrationInviteClient
+	// RegistrationPolicy is the client for interacting with the RegistrationPolicy builders.
+	RegistrationPolicy *RegistrationPolicyClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RoleMetadata is the client for interacting with the RoleMetadata builders.
 	RoleMetadata *RoleMetadataClient
 	// RolePermission is the client for interacting with the RolePermission builders.
 	RolePermission *RolePermissionClient
+	// ScimToken is the client for interacting with the ScimToken builders.
+	ScimToken *ScimTokenClient
+	// StorageQuota is the client for interacting with the StorageQuota builders.
+	StorageQuota *StorageQuotaClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskRun is the client for interacting with the TaskRun builders.
+	TaskRun *TaskRunClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
 	// User is the client for interacting with the User builders.
@@ -136,6 +173,8 @@ type Client struct {
 	UserCredential *UserCredentialClient
 	// UserOrgUnit is the client for interacting with the UserOrgUnit builders.
 	UserOrgUnit *UserOrgUnitClient
+	// UserPasswordHistory is the client for interacting with the UserPasswordHistory builders.
+	UserPasswordHistory *UserPasswordHistoryClient
 	// UserPosition is the client for interacting with the UserPosition builders.
 	UserPosition *UserPositionClient
 	// UserRole is the client for interacting with the UserRole builders.
@@ -159,19 +198,26 @@ func (c *Client) init() {
 	c.DictType = NewDictTypeClient(c.config)
 	c.DictTypeI18n = NewDictTypeI18nClient(c.config)
 	c.File = NewFileClient(c.config)
+	c.FileShare = NewFileShareClient(c.config)
 	c.InternalMessage = NewInternalMessageClient(c.config)
 	c.InternalMessageCategory = NewInternalMessageCategoryClient(c.config)
 	c.InternalMessageRecipient = NewInternalMessageRecipientClient(c.config)
+	c.JwtSigningKey = NewJwtSigningKeyClient(c.config)
 	c.Language = NewLanguageClient(c.config)
+	c.LdapSource = NewLdapSourceClient(c.config)
 	c.LoginAuditLog = NewLoginAuditLogClient(c.config)
 	c.LoginPolicy = NewLoginPolicyClient(c.config)
+	c.LuaScript = NewLuaScriptClient(c.config)
+	c.LuaScriptVersion = NewLuaScriptVersionClient(c.config)
 	c.Membership = NewMembershipClient(c.config)
 	c.MembershipOrgUnit = NewMembershipOrgUnitClient(c.config)
 	c.MembershipPosition = NewMembershipPositionClient(c.config)
 	c.MembershipRole = NewMembershipRoleClient(c.config)
 	c.Menu = NewMenuClient(c.config)
+	c.OAuthClient = NewOAuthClientClient(c.config)
 	c.OperationAuditLog = NewOperationAuditLogClient(c.config)
 	c.OrgUnit = NewOrgUnitClient(c.config)
+	c.PasswordPolicy = NewPasswordPolicyClient(c.config)
 	c.Permission = NewPermissionClient(c.config)
 	c.PermissionApi = NewPermissionApiClient(c.config)
 	c.PermissionAuditLog = NewPermissionAuditLogClient(c.config)
@@ -180,14 +226,20 @@ func (c *Client) init() {
 	c.PermissionPolicy = NewPermissionPolicyClient(c.config)
 	c.PolicyEvaluationLog = NewPolicyEvaluationLogClient(c.config)
 	c.Position = NewPositionClient(c.config)
+	c.RegistrationInvite = NewRegistrationInviteClient(c.config)
+	c.RegistrationPolicy = NewRegistrationPolicyClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.RoleMetadata = NewRoleMetadataClient(c.config)
 	c.RolePermission = NewRolePermissionClient(c.config)
+	c.ScimToken = NewScimTokenClient(c.config)
+	c.StorageQuota = NewStorageQuotaClient(c.config)
 	c.Task = NewTaskClient(c.config)
+	c.TaskRun = NewTaskRunClient(c.config)
 	c.Tenant = NewTenantClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserCredential = NewUserCredentialClient(c.config)
 	c.UserOrgUnit = NewUserOrgUnitClient(c.config)
+	c.UserPasswordHistory = NewUserPasswordHistoryClient(c.config)
 	c.UserPosition = NewUserPositionClient(c.config)
 	c.UserRole = NewUserRoleClient(c.config)
 }
@@ -290,19 +342,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		DictType:                 NewDictTypeClient(cfg),
 		DictTypeI18n:             NewDictTypeI18nClient(cfg),
 		File:                     NewFileClient(cfg),
+		FileShare:                NewFileShareClient(cfg),
 		InternalMessage:          NewInternalMessageClient(cfg),
 		InternalMessageCategory:  NewInternalMessageCategoryClient(cfg),
 		InternalMessageRecipient: NewInternalMessageRecipientClient(cfg),
+		JwtSigningKey:            NewJwtSigningKeyClient(cfg),
 		Language:                 NewLanguageClient(cfg),
+		LdapSource:               NewLdapSourceClient(cfg),
 		LoginAuditLog:            NewLoginAuditLogClient(cfg),
 		LoginPolicy:              NewLoginPolicyClient(cfg),
+		LuaScript:                NewLuaScriptClient(cfg),
+		LuaScriptVersion:         NewLuaScriptVersionClient(cfg),
 		Membership:               NewMembershipClient(cfg),
 		MembershipOrgUnit:        NewMembershipOrgUnitClient(cfg),
 		MembershipPosition:       NewMembershipPositionClient(cfg),
 		MembershipRole:           NewMembershipRoleClient(cfg),
 		Menu:                     NewMenuClient(cfg),
+		OAuthClient:              NewOAuthClientClient(cfg),
 		OperationAuditLog:        NewOperationAuditLogClient(cfg),
 		OrgUnit:                  NewOrgUnitClient(cfg),
+		PasswordPolicy:           NewPasswordPolicyClient(cfg),
 		Permission:               NewPermissionClient(cfg),
 		PermissionApi:            NewPermissionApiClient(cfg),
 		PermissionAuditLog:       NewPermissionAuditLogClient(cfg),
@@ -311,14 +370,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		PermissionPolicy:         NewPermissionPolicyClient(cfg),
 		PolicyEvaluationLog:      NewPolicyEvaluationLogClient(cfg),
 		Position:                 NewPositionClient(cfg),
+		RegistrationInvite:       NewRegistrationInviteClient(cfg),
+		RegistrationPolicy:       NewRegistrationPolicyClient(cfg),
 		Role:                     NewRoleClient(cfg),
 		RoleMetadata:             NewRoleMetadataClient(cfg),
 		RolePermission:           NewRolePermissionClient(cfg),
+		ScimToken:                NewScimTokenClient(cfg),
+		StorageQuota:             NewStorageQuotaClient(cfg),
 		Task:                     NewTaskClient(cfg),
+		TaskRun:                  NewTaskRunClient(cfg),
 		Tenant:                   NewTenantClient(cfg),
 		User:                     NewUserClient(cfg),
 		UserCredential:           NewUserCredentialClient(cfg),
 		UserOrgUnit:              NewUserOrgUnitClient(cfg),
+		UserPasswordHistory:      NewUserPasswordHistoryClient(cfg),
 		UserPosition:             NewUserPositionClient(cfg),
 		UserRole:                 NewUserRoleClient(cfg),
 	}, nil
@@ -348,19 +413,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		DictType:                 NewDictTypeClient(cfg),
 		DictTypeI18n:             NewDictTypeI18nClient(cfg),
 		File:                     NewFileClient(cfg),
+		FileShare:                NewFileShareClient(cfg),
 		InternalMessage:          NewInternalMessageClient(cfg),
 		InternalMessageCategory:  NewInternalMessageCategoryClient(cfg),
 		InternalMessageRecipient: NewInternalMessageRecipientClient(cfg),
+		JwtSigningKey:            NewJwtSigningKeyClient(cfg),
 		Language:                 NewLanguageClient(cfg),
+		LdapSource:               NewLdapSourceClient(cfg),
 		LoginAuditLog:            NewLoginAuditLogClient(cfg),
 		LoginPolicy:              NewLoginPolicyClient(cfg),
+		LuaScript:                NewLuaScriptClient(cfg),
+		LuaScriptVersion:         NewLuaScriptVersionClient(cfg),
 		Membership:               NewMembershipClient(cfg),
 		MembershipOrgUnit:        NewMembershipOrgUnitClient(cfg),
 		MembershipPosition:       NewMembershipPositionClient(cfg),
 		MembershipRole:           NewMembershipRoleClient(cfg),
 		Menu:                     NewMenuClient(cfg),
+		OAuthClient:              NewOAuthClientClient(cfg),
 		OperationAuditLog:        NewOperationAuditLogClient(cfg),
 		OrgUnit:                  NewOrgUnitClient(cfg),
+		PasswordPolicy:           NewPasswordPolicyClient(cfg),
 		Permission:               NewPermissionClient(cfg),
 		PermissionApi:            NewPermissionApiClient(cfg),
 		PermissionAuditLog:       NewPermissionAuditLogClient(cfg),
@@ -369,14 +441,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		PermissionPolicy:         NewPermissionPolicyClient(cfg),
 		PolicyEvaluationLog:      NewPolicyEvaluationLogClient(cfg),
 		Position:                 NewPositionClient(cfg),
+		RegistrationInvite:       NewRegistrationInviteClient(cfg),
+		RegistrationPolicy:       NewRegistrationPolicyClient(cfg),
 		Role:                     NewRoleClient(cfg),
 		RoleMetadata:             NewRoleMetadataClient(cfg),
 		RolePermission:           NewRolePermissionClient(cfg),
+		ScimToken:                NewScimTokenClient(cfg),
+		StorageQuota:             NewStorageQuotaClient(cfg),
 		Task:                     NewTaskClient(cfg),
+		TaskRun:                  NewTaskRunClient(cfg),
 		Tenant:                   NewTenantClient(cfg),
 		User:                     NewUserClient(cfg),
 		UserCredential:           NewUserCredentialClient(cfg),
 		UserOrgUnit:              NewUserOrgUnitClient(cfg),
+		UserPasswordHistory:      NewUserPasswordHistoryClient(cfg),
 		UserPosition:             NewUserPositionClient(cfg),
 		UserRole:                 NewUserRoleClient(cfg),
 	}, nil
@@ -409,14 +487,17 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.Api, c.ApiAuditLog, c.DataAccessAuditLog, c.DictEntry, c.DictEntryI18n,
-		c.DictType, c.DictTypeI18n, c.File, c.InternalMessage,
-		c.InternalMessageCategory, c.InternalMessageRecipient, c.Language,
-		c.LoginAuditLog, c.LoginPolicy, c.Membership, c.MembershipOrgUnit,
-		c.MembershipPosition, c.MembershipRole, c.Menu, c.OperationAuditLog, c.OrgUnit,
-		c.Permission, c.PermissionApi, c.PermissionAuditLog, c.PermissionGroup,
-		c.PermissionMenu, c.PermissionPolicy, c.PolicyEvaluationLog, c.Position,
-		c.Role, c.RoleMetadata, c.RolePermission, c.Task, c.Tenant, c.User,
-		c.UserCredential, c.UserOrgUnit, c.UserPosition, c.UserRole,
+		c.DictType, c.DictTypeI18n, c.File, c.FileShare, c.InternalMessage,
+		c.InternalMessageCategory, c.InternalMessageRecipient, c.JwtSigningKey,
+		c.Language, c.LdapSource, c.LoginAuditLog, c.LoginPolicy, c.LuaScript,
+		c.LuaScriptVersion, c.Membership, c.MembershipOrgUnit, c.MembershipPosition,
+		c.MembershipRole, c.Menu, c.OAuthClient, c.OperationAuditLog, c.OrgUnit,
+		c.PasswordPolicy, c.Permission, c.PermissionApi, c.PermissionAuditLog,
+		c.PermissionGroup, c.PermissionMenu, c.PermissionPolicy, c.PolicyEvaluationLog,
+		c.Position, c.RegistrationInvite, c.RegistrationPolicy, c.Role, c.RoleMetadata,
+		c.RolePermission, c.ScimToken, c.StorageQuota, c.Task, c.TaskRun, c.Tenant,
+		c.User, c.UserCredential, c.UserOrgUnit, c.UserPasswordHistory, c.UserPosition,
+		c.UserRole,
 	} {
 		n.Use(hooks...)
 	}
@@ -427,14 +508,17 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.Api, c.ApiAuditLog, c.DataAccessAuditLog, c.DictEntry, c.DictEntryI18n,
-		c.DictType, c.DictTypeI18n, c.File, c.InternalMessage,
-		c.InternalMessageCategory, c.InternalMessageRecipient, c.Language,
-		c.LoginAuditLog, c.LoginPolicy, c.Membership, c.MembershipOrgUnit,
-		c.MembershipPosition, c.MembershipRole, c.Menu, c.OperationAuditLog, c.OrgUnit,
-		c.Permission, c.PermissionApi, c.PermissionAuditLog, c.PermissionGroup,
-		c.PermissionMenu, c.PermissionPolicy, c.PolicyEvaluationLog, c.Position,
-		c.Role, c.RoleMetadata, c.RolePermission, c.Task, c.Tenant, c.User,
-		c.UserCredential, c.UserOrgUnit, c.UserPosition, c.UserRole,
+		c.DictType, c.DictTypeI18n, c.File, c.FileShare, c.InternalMessage,
+		c.InternalMessageCategory, c.InternalMessageRecipient, c.JwtSigningKey,
+		c.Language, c.LdapSource, c.LoginAuditLog, c.LoginPolicy, c.LuaScript,
+		c.LuaScriptVersion, c.Membership, c.MembershipOrgUnit, c.MembershipPosition,
+		c.MembershipRole, c.Menu, c.OAuthClient, c.OperationAuditLog, c.OrgUnit,
+		c.PasswordPolicy, c.Permission, c.PermissionApi, c.PermissionAuditLog,
+		c.PermissionGroup, c.PermissionMenu, c.PermissionPolicy, c.PolicyEvaluationLog,
+		c.Position, c.RegistrationInvite, c.RegistrationPolicy, c.Role, c.RoleMetadata,
+		c.RolePermission, c.ScimToken, c.StorageQuota, c.Task, c.TaskRun, c.Tenant,
+		c.User, c.UserCredential, c.UserOrgUnit, c.UserPasswordHistory, c.UserPosition,
+		c.UserRole,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -459,18 +543,28 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.DictTypeI18n.mutate(ctx, m)
 	case *FileMutation:
 		return c.File.mutate(ctx, m)
+	case *FileShareMutation:
+		return c.FileShare.mutate(ctx, m)
 	case *InternalMessageMutation:
 		return c.InternalMessage.mutate(ctx, m)
 	case *InternalMessageCategoryMutation:
 		return c.InternalMessageCategory.mutate(ctx, m)
 	case *InternalMessageRecipientMutation:
 		return c.InternalMessageRecipient.mutate(ctx, m)
+	case *JwtSigningKeyMutation:
+		return c.JwtSigningKey.mutate(ctx, m)
 	case *LanguageMutation:
 		return c.Language.mutate(ctx, m)
+	case *LdapSourceMutation:
+		return c.LdapSource.mutate(ctx, m)
 	case *LoginAuditLogMutation:
 		return c.LoginAuditLog.mutate(ctx, m)
 	case *LoginPolicyMutation:
 		return c.LoginPolicy.mutate(ctx, m)
+	case *LuaScriptMutation:
+		return c.LuaScript.mutate(ctx, m)
+	case *LuaScriptVersionMutation:
+		return c.LuaScriptVersion.mutate(ctx, m)
 	case *MembershipMutation:
 		return c.Membership.mutate(ctx, m)
 	case *MembershipOrgUnitMutation:
@@ -481,10 +575,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.MembershipRole.mutate(ctx, m)
 	case *MenuMutation:
 		return c.Menu.mutate(ctx, m)
+	case *OAuthClientMutation:
+		return c.OAuthClient.mutate(ctx, m)
 	case *OperationAuditLogMutation:
 		return c.OperationAuditLog.mutate(ctx, m)
 	case *OrgUnitMutation:
 		return c.OrgUnit.mutate(ctx, m)
+	case *PasswordPolicyMutation:
+		return c.PasswordPolicy.mutate(ctx, m)
 	case *PermissionMutation:
 		return c.Permission.mutate(ctx, m)
 	case *PermissionApiMutation:
@@ -501,14 +599,24 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PolicyEvaluationLog.mutate(ctx, m)
 	case *PositionMutation:
 		return c.Position.mutate(ctx, m)
+	case *RegistrationInviteMutation:
+		return c.RegistrationInvite.mutate(ctx, m)
+	case *RegistrationPolicyMutation:
+		return c.RegistrationPolicy.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
 	case *RoleMetadataMutation:
 		return c.RoleMetadata.mutate(ctx, m)
 	case *RolePermissionMutation:
 		return c.RolePermission.mutate(ctx, m)
+	case *ScimTokenMutation:
+		return c.ScimToken.mutate(ctx, m)
+	case *StorageQuotaMutation:
+		return c.StorageQuota.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
+	case *TaskRunMutation:
+		return c.TaskRun.mutate(ctx, m)
 	case *TenantMutation:
 		return c.Tenant.mutate(ctx, m)
 	case *UserMutation:
@@ -517,6 +625,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.UserCredential.mutate(ctx, m)
 	case *UserOrgUnitMutation:
 		return c.UserOrgUnit.mutate(ctx, m)
+	case *UserPasswordHistoryMutation:
+		return c.UserPasswordHistory.mutate(ctx, m)
 	case *UserPositionMutation:
 		return c.UserPosition.mutate(ctx, m)
 	case *UserRoleMutation:
@@ -1693,6 +1803,140 @@ func (c *FileClient) mutate(ctx context.Context, m *FileMutation) (Value, error)
 	}
 }
 
+// FileShareClient is a client for the FileShare schema.
+type FileShareClient struct {
+	config
+}
+
+// NewFileShareClient returns a client for the FileShare from the given config.
+func NewFileShareClient(c config) *FileShareClient {
+	return &FileShareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fileshare.Hooks(f(g(h())))`.
+func (c *FileShareClient) Use(hooks ...Hook) {
+	c.hooks.FileShare = append(c.hooks.FileShare, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fileshare.Intercept(f(g(h())))`.
+func (c *FileShareClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileShare = append(c.inters.FileShare, interceptors...)
+}
+
+// Create returns a builder for creating a FileShare entity.
+func (c *FileShareClient) Create() *FileShareCreate {
+	mutation := newFileShareMutation(c.config, OpCreate)
+	return &FileShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FileShare entities.
+func (c *FileShareClient) CreateBulk(builders ...*FileShareCreate) *FileShareCreateBulk {
+	return &FileShareCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileShareClient) MapCreateBulk(slice any, setFunc func(*FileShareCreate, int)) *FileShareCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileShareCreateBulk{err: fmt.Errorf("calling to FileShareClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileShareCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileShareCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FileShare.
+func (c *FileShareClient) Update() *FileShareUpdate {
+	mutation := newFileShareMutation(c.config, OpUpdate)
+	return &FileShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileShareClient) UpdateOne(_m *FileShare) *FileShareUpdateOne {
+	mutation := newFileShareMutation(c.config, OpUpdateOne, withFileShare(_m))
+	return &FileShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileShareClient) UpdateOneID(id uint32) *FileShareUpdateOne {
+	mutation := newFileShareMutation(c.config, OpUpdateOne, withFileShareID(id))
+	return &FileShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileShare.
+func (c *FileShareClient) Delete() *FileShareDelete {
+	mutation := newFileShareMutation(c.config, OpDelete)
+	return &FileShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FileShareClient) DeleteOne(_m *FileShare) *FileShareDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FileShareClient) DeleteOneID(id uint32) *FileShareDeleteOne {
+	builder := c.Delete().Where(fileshare.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileShareDeleteOne{builder}
+}
+
+// Query returns a query builder for FileShare.
+func (c *FileShareClient) Query() *FileShareQuery {
+	return &FileShareQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFileShare},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FileShare entity by its id.
+func (c *FileShareClient) Get(ctx context.Context, id uint32) (*FileShare, error) {
+	return c.Query().Where(fileshare.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileShareClient) GetX(ctx context.Context, id uint32) *FileShare {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FileShareClient) Hooks() []Hook {
+	hooks := c.hooks.FileShare
+	return append(hooks[:len(hooks):len(hooks)], fileshare.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FileShareClient) Interceptors() []Interceptor {
+	return c.inters.FileShare
+}
+
+func (c *FileShareClient) mutate(ctx context.Context, m *FileShareMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FileShareCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FileShareUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FileShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FileShareDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FileShare mutation op: %q", m.Op())
+	}
+}
+
 // InternalMessageClient is a client for the InternalMessage schema.
 type InternalMessageClient struct {
 	config
@@ -2095,6 +2339,139 @@ func (c *InternalMessageRecipientClient) mutate(ctx context.Context, m *Internal
 	}
 }
 
+// JwtSigningKeyClient is a client for the JwtSigningKey schema.
+type JwtSigningKeyClient struct {
+	config
+}
+
+// NewJwtSigningKeyClient returns a client for the JwtSigningKey from the given config.
+func NewJwtSigningKeyClient(c config) *JwtSigningKeyClient {
+	return &JwtSigningKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jwtsigningkey.Hooks(f(g(h())))`.
+func (c *JwtSigningKeyClient) Use(hooks ...Hook) {
+	c.hooks.JwtSigningKey = append(c.hooks.JwtSigningKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jwtsigningkey.Intercept(f(g(h())))`.
+func (c *JwtSigningKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JwtSigningKey = append(c.inters.JwtSigningKey, interceptors...)
+}
+
+// Create returns a builder for creating a JwtSigningKey entity.
+func (c *JwtSigningKeyClient) Create() *JwtSigningKeyCreate {
+	mutation := newJwtSigningKeyMutation(c.config, OpCreate)
+	return &JwtSigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JwtSigningKey entities.
+func (c *JwtSigningKeyClient) CreateBulk(builders ...*JwtSigningKeyCreate) *JwtSigningKeyCreateBulk {
+	return &JwtSigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JwtSigningKeyClient) MapCreateBulk(slice any, setFunc func(*JwtSigningKeyCreate, int)) *JwtSigningKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JwtSigningKeyCreateBulk{err: fmt.Errorf("calling to JwtSigningKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JwtSigningKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JwtSigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JwtSigningKey.
+func (c *JwtSigningKeyClient) Update() *JwtSigningKeyUpdate {
+	mutation := newJwtSigningKeyMutation(c.config, OpUpdate)
+	return &JwtSigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JwtSigningKeyClient) UpdateOne(_m *JwtSigningKey) *JwtSigningKeyUpdateOne {
+	mutation := newJwtSigningKeyMutation(c.config, OpUpdateOne, withJwtSigningKey(_m))
+	return &JwtSigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JwtSigningKeyClient) UpdateOneID(id uint32) *JwtSigningKeyUpdateOne {
+	mutation := newJwtSigningKeyMutation(c.config, OpUpdateOne, withJwtSigningKeyID(id))
+	return &JwtSigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JwtSigningKey.
+func (c *JwtSigningKeyClient) Delete() *JwtSigningKeyDelete {
+	mutation := newJwtSigningKeyMutation(c.config, OpDelete)
+	return &JwtSigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JwtSigningKeyClient) DeleteOne(_m *JwtSigningKey) *JwtSigningKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JwtSigningKeyClient) DeleteOneID(id uint32) *JwtSigningKeyDeleteOne {
+	builder := c.Delete().Where(jwtsigningkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JwtSigningKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for JwtSigningKey.
+func (c *JwtSigningKeyClient) Query() *JwtSigningKeyQuery {
+	return &JwtSigningKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJwtSigningKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JwtSigningKey entity by its id.
+func (c *JwtSigningKeyClient) Get(ctx context.Context, id uint32) (*JwtSigningKey, error) {
+	return c.Query().Where(jwtsigningkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JwtSigningKeyClient) GetX(ctx context.Context, id uint32) *JwtSigningKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JwtSigningKeyClient) Hooks() []Hook {
+	return c.hooks.JwtSigningKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *JwtSigningKeyClient) Interceptors() []Interceptor {
+	return c.inters.JwtSigningKey
+}
+
+func (c *JwtSigningKeyClient) mutate(ctx context.Context, m *JwtSigningKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JwtSigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JwtSigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JwtSigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JwtSigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JwtSigningKey mutation op: %q", m.Op())
+	}
+}
+
 // LanguageClient is a client for the Language schema.
 type LanguageClient struct {
 	config
@@ -2228,6 +2605,140 @@ func (c *LanguageClient) mutate(ctx context.Context, m *LanguageMutation) (Value
 	}
 }
 
+// LdapSourceClient is a client for the LdapSource schema.
+type LdapSourceClient struct {
+	config
+}
+
+// NewLdapSourceClient returns a client for the LdapSource from the given config.
+func NewLdapSourceClient(c config) *LdapSourceClient {
+	return &LdapSourceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ldapsource.Hooks(f(g(h())))`.
+func (c *LdapSourceClient) Use(hooks ...Hook) {
+	c.hooks.LdapSource = append(c.hooks.LdapSource, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ldapsource.Intercept(f(g(h())))`.
+func (c *LdapSourceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LdapSource = append(c.inters.LdapSource, interceptors...)
+}
+
+// Create returns a builder for creating a LdapSource entity.
+func (c *LdapSourceClient) Create() *LdapSourceCreate {
+	mutation := newLdapSourceMutation(c.config, OpCreate)
+	return &LdapSourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LdapSource entities.
+func (c *LdapSourceClient) CreateBulk(builders ...*LdapSourceCreate) *LdapSourceCreateBulk {
+	return &LdapSourceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LdapSourceClient) MapCreateBulk(slice any, setFunc func(*LdapSourceCreate, int)) *LdapSourceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LdapSourceCreateBulk{err: fmt.Errorf("calling to LdapSourceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LdapSourceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LdapSourceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LdapSource.
+func (c *LdapSourceClient) Update() *LdapSourceUpdate {
+	mutation := newLdapSourceMutation(c.config, OpUpdate)
+	return &LdapSourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LdapSourceClient) UpdateOne(_m *LdapSource) *LdapSourceUpdateOne {
+	mutation := newLdapSourceMutation(c.config, OpUpdateOne, withLdapSource(_m))
+	return &LdapSourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LdapSourceClient) UpdateOneID(id uint32) *LdapSourceUpdateOne {
+	mutation := newLdapSourceMutation(c.config, OpUpdateOne, withLdapSourceID(id))
+	return &LdapSourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LdapSource.
+func (c *LdapSourceClient) Delete() *LdapSourceDelete {
+	mutation := newLdapSourceMutation(c.config, OpDelete)
+	return &LdapSourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LdapSourceClient) DeleteOne(_m *LdapSource) *LdapSourceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LdapSourceClient) DeleteOneID(id uint32) *LdapSourceDeleteOne {
+	builder := c.Delete().Where(ldapsource.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LdapSourceDeleteOne{builder}
+}
+
+// Query returns a query builder for LdapSource.
+func (c *LdapSourceClient) Query() *LdapSourceQuery {
+	return &LdapSourceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLdapSource},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LdapSource entity by its id.
+func (c *LdapSourceClient) Get(ctx context.Context, id uint32) (*LdapSource, error) {
+	return c.Query().Where(ldapsource.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LdapSourceClient) GetX(ctx context.Context, id uint32) *LdapSource {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LdapSourceClient) Hooks() []Hook {
+	hooks := c.hooks.LdapSource
+	return append(hooks[:len(hooks):len(hooks)], ldapsource.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LdapSourceClient) Interceptors() []Interceptor {
+	return c.inters.LdapSource
+}
+
+func (c *LdapSourceClient) mutate(ctx context.Context, m *LdapSourceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LdapSourceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LdapSourceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LdapSourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LdapSourceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LdapSource mutation op: %q", m.Op())
+	}
+}
+
 // LoginAuditLogClient is a client for the LoginAuditLog schema.
 type LoginAuditLogClient struct {
 	config
@@ -2496,69 +3007,335 @@ func (c *LoginPolicyClient) mutate(ctx context.Context, m *LoginPolicyMutation)
 	}
 }
 
-// MembershipClient is a client for the Membership schema.
-type MembershipClient struct {
+// LuaScriptClient is a client for the LuaScript schema.
+type LuaScriptClient struct {
 	config
 }
 
-// NewMembershipClient returns a client for the Membership from the given config.
-func NewMembershipClient(c config) *MembershipClient {
-	return &MembershipClient{config: c}
+// NewLuaScriptClient returns a client for the LuaScript from the given config.
+func NewLuaScriptClient(c config) *LuaScriptClient {
+	return &LuaScriptClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `membership.Hooks(f(g(h())))`.
-func (c *MembershipClient) Use(hooks ...Hook) {
-	c.hooks.Membership = append(c.hooks.Membership, hooks...)
+// A call to `Use(f, g, h)` equals to `luascript.Hooks(f(g(h())))`.
+func (c *LuaScriptClient) Use(hooks ...Hook) {
+	c.hooks.LuaScript = append(c.hooks.LuaScript, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `membership.Intercept(f(g(h())))`.
-func (c *MembershipClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Membership = append(c.inters.Membership, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `luascript.Intercept(f(g(h())))`.
+func (c *LuaScriptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LuaScript = append(c.inters.LuaScript, interceptors...)
 }
 
-// Create returns a builder for creating a Membership entity.
-func (c *MembershipClient) Create() *MembershipCreate {
-	mutation := newMembershipMutation(c.config, OpCreate)
-	return &MembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LuaScript entity.
+func (c *LuaScriptClient) Create() *LuaScriptCreate {
+	mutation := newLuaScriptMutation(c.config, OpCreate)
+	return &LuaScriptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Membership entities.
-func (c *MembershipClient) CreateBulk(builders ...*MembershipCreate) *MembershipCreateBulk {
-	return &MembershipCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LuaScript entities.
+func (c *LuaScriptClient) CreateBulk(builders ...*LuaScriptCreate) *LuaScriptCreateBulk {
+	return &LuaScriptCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MembershipClient) MapCreateBulk(slice any, setFunc func(*MembershipCreate, int)) *MembershipCreateBulk {
+func (c *LuaScriptClient) MapCreateBulk(slice any, setFunc func(*LuaScriptCreate, int)) *LuaScriptCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MembershipCreateBulk{err: fmt.Errorf("calling to MembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LuaScriptCreateBulk{err: fmt.Errorf("calling to LuaScriptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MembershipCreate, rv.Len())
+	builders := make([]*LuaScriptCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MembershipCreateBulk{config: c.config, builders: builders}
+	return &LuaScriptCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Membership.
-func (c *MembershipClient) Update() *MembershipUpdate {
-	mutation := newMembershipMutation(c.config, OpUpdate)
-	return &MembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LuaScript.
+func (c *LuaScriptClient) Update() *LuaScriptUpdate {
+	mutation := newLuaScriptMutation(c.config, OpUpdate)
+	return &LuaScriptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MembershipClient) UpdateOne(_m *Membership) *MembershipUpdateOne {
-	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembership(_m))
-	return &MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LuaScriptClient) UpdateOne(_m *LuaScript) *LuaScriptUpdateOne {
+	mutation := newLuaScriptMutation(c.config, OpUpdateOne, withLuaScript(_m))
+	return &LuaScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MembershipClient) UpdateOneID(id uint32) *MembershipUpdateOne {
-	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembershipID(id))
+func (c *LuaScriptClient) UpdateOneID(id uint32) *LuaScriptUpdateOne {
+	mutation := newLuaScriptMutation(c.config, OpUpdateOne, withLuaScriptID(id))
+	return &LuaScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LuaScript.
+func (c *LuaScriptClient) Delete() *LuaScriptDelete {
+	mutation := newLuaScriptMutation(c.config, OpDelete)
+	return &LuaScriptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LuaScriptClient) DeleteOne(_m *LuaScript) *LuaScriptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LuaScriptClient) DeleteOneID(id uint32) *LuaScriptDeleteOne {
+	builder := c.Delete().Where(luascript.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LuaScriptDeleteOne{builder}
+}
+
+// Query returns a query builder for LuaScript.
+func (c *LuaScriptClient) Query() *LuaScriptQuery {
+	return &LuaScriptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLuaScript},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LuaScript entity by its id.
+func (c *LuaScriptClient) Get(ctx context.Context, id uint32) (*LuaScript, error) {
+	return c.Query().Where(luascript.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LuaScriptClient) GetX(ctx context.Context, id uint32) *LuaScript {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LuaScriptClient) Hooks() []Hook {
+	return c.hooks.LuaScript
+}
+
+// Interceptors returns the client interceptors.
+func (c *LuaScriptClient) Interceptors() []Interceptor {
+	return c.inters.LuaScript
+}
+
+func (c *LuaScriptClient) mutate(ctx context.Context, m *LuaScriptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LuaScriptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LuaScriptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LuaScriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LuaScriptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LuaScript mutation op: %q", m.Op())
+	}
+}
+
+// LuaScriptVersionClient is a client for the LuaScriptVersion schema.
+type LuaScriptVersionClient struct {
+	config
+}
+
+// NewLuaScriptVersionClient returns a client for the LuaScriptVersion from the given config.
+func NewLuaScriptVersionClient(c config) *LuaScriptVersionClient {
+	return &LuaScriptVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `luascriptversion.Hooks(f(g(h())))`.
+func (c *LuaScriptVersionClient) Use(hooks ...Hook) {
+	c.hooks.LuaScriptVersion = append(c.hooks.LuaScriptVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `luascriptversion.Intercept(f(g(h())))`.
+func (c *LuaScriptVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LuaScriptVersion = append(c.inters.LuaScriptVersion, interceptors...)
+}
+
+// Create returns a builder for creating a LuaScriptVersion entity.
+func (c *LuaScriptVersionClient) Create() *LuaScriptVersionCreate {
+	mutation := newLuaScriptVersionMutation(c.config, OpCreate)
+	return &LuaScriptVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LuaScriptVersion entities.
+func (c *LuaScriptVersionClient) CreateBulk(builders ...*LuaScriptVersionCreate) *LuaScriptVersionCreateBulk {
+	return &LuaScriptVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LuaScriptVersionClient) MapCreateBulk(slice any, setFunc func(*LuaScriptVersionCreate, int)) *LuaScriptVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LuaScriptVersionCreateBulk{err: fmt.Errorf("calling to LuaScriptVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LuaScriptVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LuaScriptVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LuaScriptVersion.
+func (c *LuaScriptVersionClient) Update() *LuaScriptVersionUpdate {
+	mutation := newLuaScriptVersionMutation(c.config, OpUpdate)
+	return &LuaScriptVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LuaScriptVersionClient) UpdateOne(_m *LuaScriptVersion) *LuaScriptVersionUpdateOne {
+	mutation := newLuaScriptVersionMutation(c.config, OpUpdateOne, withLuaScriptVersion(_m))
+	return &LuaScriptVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LuaScriptVersionClient) UpdateOneID(id uint32) *LuaScriptVersionUpdateOne {
+	mutation := newLuaScriptVersionMutation(c.config, OpUpdateOne, withLuaScriptVersionID(id))
+	return &LuaScriptVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LuaScriptVersion.
+func (c *LuaScriptVersionClient) Delete() *LuaScriptVersionDelete {
+	mutation := newLuaScriptVersionMutation(c.config, OpDelete)
+	return &LuaScriptVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LuaScriptVersionClient) DeleteOne(_m *LuaScriptVersion) *LuaScriptVersionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LuaScriptVersionClient) DeleteOneID(id uint32) *LuaScriptVersionDeleteOne {
+	builder := c.Delete().Where(luascriptversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LuaScriptVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for LuaScriptVersion.
+func (c *LuaScriptVersionClient) Query() *LuaScriptVersionQuery {
+	return &LuaScriptVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLuaScriptVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LuaScriptVersion entity by its id.
+func (c *LuaScriptVersionClient) Get(ctx context.Context, id uint32) (*LuaScriptVersion, error) {
+	return c.Query().Where(luascriptversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LuaScriptVersionClient) GetX(ctx context.Context, id uint32) *LuaScriptVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LuaScriptVersionClient) Hooks() []Hook {
+	return c.hooks.LuaScriptVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *LuaScriptVersionClient) Interceptors() []Interceptor {
+	return c.inters.LuaScriptVersion
+}
+
+func (c *LuaScriptVersionClient) mutate(ctx context.Context, m *LuaScriptVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LuaScriptVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LuaScriptVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LuaScriptVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LuaScriptVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LuaScriptVersion mutation op: %q", m.Op())
+	}
+}
+
+// MembershipClient is a client for the Membership schema.
+type MembershipClient struct {
+	config
+}
+
+// NewMembershipClient returns a client for the Membership from the given config.
+func NewMembershipClient(c config) *MembershipClient {
+	return &MembershipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `membership.Hooks(f(g(h())))`.
+func (c *MembershipClient) Use(hooks ...Hook) {
+	c.hooks.Membership = append(c.hooks.Membership, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `membership.Intercept(f(g(h())))`.
+func (c *MembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Membership = append(c.inters.Membership, interceptors...)
+}
+
+// Create returns a builder for creating a Membership entity.
+func (c *MembershipClient) Create() *MembershipCreate {
+	mutation := newMembershipMutation(c.config, OpCreate)
+	return &MembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Membership entities.
+func (c *MembershipClient) CreateBulk(builders ...*MembershipCreate) *MembershipCreateBulk {
+	return &MembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MembershipClient) MapCreateBulk(slice any, setFunc func(*MembershipCreate, int)) *MembershipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MembershipCreateBulk{err: fmt.Errorf("calling to MembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MembershipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Membership.
+func (c *MembershipClient) Update() *MembershipUpdate {
+	mutation := newMembershipMutation(c.config, OpUpdate)
+	return &MembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MembershipClient) UpdateOne(_m *Membership) *MembershipUpdateOne {
+	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembership(_m))
+	return &MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MembershipClient) UpdateOneID(id uint32) *MembershipUpdateOne {
+	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembershipID(id))
 	return &MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -3197,6 +3974,140 @@ func (c *MenuClient) mutate(ctx context.Context, m *MenuMutation) (Value, error)
 	}
 }
 
+// OAuthClientClient is a client for the OAuthClient schema.
+type OAuthClientClient struct {
+	config
+}
+
+// NewOAuthClientClient returns a client for the OAuthClient from the given config.
+func NewOAuthClientClient(c config) *OAuthClientClient {
+	return &OAuthClientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthclient.Hooks(f(g(h())))`.
+func (c *OAuthClientClient) Use(hooks ...Hook) {
+	c.hooks.OAuthClient = append(c.hooks.OAuthClient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthclient.Intercept(f(g(h())))`.
+func (c *OAuthClientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OAuthClient = append(c.inters.OAuthClient, interceptors...)
+}
+
+// Create returns a builder for creating a OAuthClient entity.
+func (c *OAuthClientClient) Create() *OAuthClientCreate {
+	mutation := newOAuthClientMutation(c.config, OpCreate)
+	return &OAuthClientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OAuthClient entities.
+func (c *OAuthClientClient) CreateBulk(builders ...*OAuthClientCreate) *OAuthClientCreateBulk {
+	return &OAuthClientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OAuthClientClient) MapCreateBulk(slice any, setFunc func(*OAuthClientCreate, int)) *OAuthClientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OAuthClientCreateBulk{err: fmt.Errorf("calling to OAuthClientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OAuthClientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OAuthClientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OAuthClient.
+func (c *OAuthClientClient) Update() *OAuthClientUpdate {
+	mutation := newOAuthClientMutation(c.config, OpUpdate)
+	return &OAuthClientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OAuthClientClient) UpdateOne(_m *OAuthClient) *OAuthClientUpdateOne {
+	mutation := newOAuthClientMutation(c.config, OpUpdateOne, withOAuthClient(_m))
+	return &OAuthClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OAuthClientClient) UpdateOneID(id uint32) *OAuthClientUpdateOne {
+	mutation := newOAuthClientMutation(c.config, OpUpdateOne, withOAuthClientID(id))
+	return &OAuthClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OAuthClient.
+func (c *OAuthClientClient) Delete() *OAuthClientDelete {
+	mutation := newOAuthClientMutation(c.config, OpDelete)
+	return &OAuthClientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OAuthClientClient) DeleteOne(_m *OAuthClient) *OAuthClientDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OAuthClientClient) DeleteOneID(id uint32) *OAuthClientDeleteOne {
+	builder := c.Delete().Where(oauthclient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OAuthClientDeleteOne{builder}
+}
+
+// Query returns a query builder for OAuthClient.
+func (c *OAuthClientClient) Query() *OAuthClientQuery {
+	return &OAuthClientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOAuthClient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OAuthClient entity by its id.
+func (c *OAuthClientClient) Get(ctx context.Context, id uint32) (*OAuthClient, error) {
+	return c.Query().Where(oauthclient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OAuthClientClient) GetX(ctx context.Context, id uint32) *OAuthClient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OAuthClientClient) Hooks() []Hook {
+	hooks := c.hooks.OAuthClient
+	return append(hooks[:len(hooks):len(hooks)], oauthclient.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OAuthClientClient) Interceptors() []Interceptor {
+	return c.inters.OAuthClient
+}
+
+func (c *OAuthClientClient) mutate(ctx context.Context, m *OAuthClientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OAuthClientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OAuthClientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OAuthClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OAuthClientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OAuthClient mutation op: %q", m.Op())
+	}
+}
+
 // OperationAuditLogClient is a client for the OperationAuditLog schema.
 type OperationAuditLogClient struct {
 	config
@@ -3497,6 +4408,140 @@ func (c *OrgUnitClient) mutate(ctx context.Context, m *OrgUnitMutation) (Value,
 	}
 }
 
+// PasswordPolicyClient is a client for the PasswordPolicy schema.
+type PasswordPolicyClient struct {
+	config
+}
+
+// NewPasswordPolicyClient returns a client for the PasswordPolicy from the given config.
+func NewPasswordPolicyClient(c config) *PasswordPolicyClient {
+	return &PasswordPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passwordpolicy.Hooks(f(g(h())))`.
+func (c *PasswordPolicyClient) Use(hooks ...Hook) {
+	c.hooks.PasswordPolicy = append(c.hooks.PasswordPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passwordpolicy.Intercept(f(g(h())))`.
+func (c *PasswordPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordPolicy = append(c.inters.PasswordPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a PasswordPolicy entity.
+func (c *PasswordPolicyClient) Create() *PasswordPolicyCreate {
+	mutation := newPasswordPolicyMutation(c.config, OpCreate)
+	return &PasswordPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordPolicy entities.
+func (c *PasswordPolicyClient) CreateBulk(builders ...*PasswordPolicyCreate) *PasswordPolicyCreateBulk {
+	return &PasswordPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordPolicyClient) MapCreateBulk(slice any, setFunc func(*PasswordPolicyCreate, int)) *PasswordPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordPolicyCreateBulk{err: fmt.Errorf("calling to PasswordPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordPolicy.
+func (c *PasswordPolicyClient) Update() *PasswordPolicyUpdate {
+	mutation := newPasswordPolicyMutation(c.config, OpUpdate)
+	return &PasswordPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordPolicyClient) UpdateOne(_m *PasswordPolicy) *PasswordPolicyUpdateOne {
+	mutation := newPasswordPolicyMutation(c.config, OpUpdateOne, withPasswordPolicy(_m))
+	return &PasswordPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordPolicyClient) UpdateOneID(id uint32) *PasswordPolicyUpdateOne {
+	mutation := newPasswordPolicyMutation(c.config, OpUpdateOne, withPasswordPolicyID(id))
+	return &PasswordPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordPolicy.
+func (c *PasswordPolicyClient) Delete() *PasswordPolicyDelete {
+	mutation := newPasswordPolicyMutation(c.config, OpDelete)
+	return &PasswordPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordPolicyClient) DeleteOne(_m *PasswordPolicy) *PasswordPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordPolicyClient) DeleteOneID(id uint32) *PasswordPolicyDeleteOne {
+	builder := c.Delete().Where(passwordpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordPolicy.
+func (c *PasswordPolicyClient) Query() *PasswordPolicyQuery {
+	return &PasswordPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasswordPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordPolicy entity by its id.
+func (c *PasswordPolicyClient) Get(ctx context.Context, id uint32) (*PasswordPolicy, error) {
+	return c.Query().Where(passwordpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordPolicyClient) GetX(ctx context.Context, id uint32) *PasswordPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordPolicyClient) Hooks() []Hook {
+	hooks := c.hooks.PasswordPolicy
+	return append(hooks[:len(hooks):len(hooks)], passwordpolicy.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordPolicyClient) Interceptors() []Interceptor {
+	return c.inters.PasswordPolicy
+}
+
+func (c *PasswordPolicyClient) mutate(ctx context.Context, m *PasswordPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordPolicy mutation op: %q", m.Op())
+	}
+}
+
 // PermissionClient is a client for the Permission schema.
 type PermissionClient struct {
 	config
@@ -4596,6 +5641,274 @@ func (c *PositionClient) mutate(ctx context.Context, m *PositionMutation) (Value
 	}
 }
 
+// RegistrationInviteClient is a client for the RegistrationInvite schema.
+type RegistrationInviteClient struct {
+	config
+}
+
+// NewRegistrationInviteClient returns a client for the RegistrationInvite from the given config.
+func NewRegistrationInviteClient(c config) *RegistrationInviteClient {
+	return &RegistrationInviteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `registrationinvite.Hooks(f(g(h())))`.
+func (c *RegistrationInviteClient) Use(hooks ...Hook) {
+	c.hooks.RegistrationInvite = append(c.hooks.RegistrationInvite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `registrationinvite.Intercept(f(g(h())))`.
+func (c *RegistrationInviteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RegistrationInvite = append(c.inters.RegistrationInvite, interceptors...)
+}
+
+// Create returns a builder for creating a RegistrationInvite entity.
+func (c *RegistrationInviteClient) Create() *RegistrationInviteCreate {
+	mutation := newRegistrationInviteMutation(c.config, OpCreate)
+	return &RegistrationInviteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RegistrationInvite entities.
+func (c *RegistrationInviteClient) CreateBulk(builders ...*RegistrationInviteCreate) *RegistrationInviteCreateBulk {
+	return &RegistrationInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RegistrationInviteClient) MapCreateBulk(slice any, setFunc func(*RegistrationInviteCreate, int)) *RegistrationInviteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RegistrationInviteCreateBulk{err: fmt.Errorf("calling to RegistrationInviteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RegistrationInviteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RegistrationInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RegistrationInvite.
+func (c *RegistrationInviteClient) Update() *RegistrationInviteUpdate {
+	mutation := newRegistrationInviteMutation(c.config, OpUpdate)
+	return &RegistrationInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RegistrationInviteClient) UpdateOne(_m *RegistrationInvite) *RegistrationInviteUpdateOne {
+	mutation := newRegistrationInviteMutation(c.config, OpUpdateOne, withRegistrationInvite(_m))
+	return &RegistrationInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RegistrationInviteClient) UpdateOneID(id uint32) *RegistrationInviteUpdateOne {
+	mutation := newRegistrationInviteMutation(c.config, OpUpdateOne, withRegistrationInviteID(id))
+	return &RegistrationInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RegistrationInvite.
+func (c *RegistrationInviteClient) Delete() *RegistrationInviteDelete {
+	mutation := newRegistrationInviteMutation(c.config, OpDelete)
+	return &RegistrationInviteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RegistrationInviteClient) DeleteOne(_m *RegistrationInvite) *RegistrationInviteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RegistrationInviteClient) DeleteOneID(id uint32) *RegistrationInviteDeleteOne {
+	builder := c.Delete().Where(registrationinvite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RegistrationInviteDeleteOne{builder}
+}
+
+// Query returns a query builder for RegistrationInvite.
+func (c *RegistrationInviteClient) Query() *RegistrationInviteQuery {
+	return &RegistrationInviteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRegistrationInvite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RegistrationInvite entity by its id.
+func (c *RegistrationInviteClient) Get(ctx context.Context, id uint32) (*RegistrationInvite, error) {
+	return c.Query().Where(registrationinvite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RegistrationInviteClient) GetX(ctx context.Context, id uint32) *RegistrationInvite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RegistrationInviteClient) Hooks() []Hook {
+	hooks := c.hooks.RegistrationInvite
+	return append(hooks[:len(hooks):len(hooks)], registrationinvite.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RegistrationInviteClient) Interceptors() []Interceptor {
+	return c.inters.RegistrationInvite
+}
+
+func (c *RegistrationInviteClient) mutate(ctx context.Context, m *RegistrationInviteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RegistrationInviteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RegistrationInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RegistrationInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RegistrationInviteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RegistrationInvite mutation op: %q", m.Op())
+	}
+}
+
+// RegistrationPolicyClient is a client for the RegistrationPolicy schema.
+type RegistrationPolicyClient struct {
+	config
+}
+
+// NewRegistrationPolicyClient returns a client for the RegistrationPolicy from the given config.
+func NewRegistrationPolicyClient(c config) *RegistrationPolicyClient {
+	return &RegistrationPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `registrationpolicy.Hooks(f(g(h())))`.
+func (c *RegistrationPolicyClient) Use(hooks ...Hook) {
+	c.hooks.RegistrationPolicy = append(c.hooks.RegistrationPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `registrationpolicy.Intercept(f(g(h())))`.
+func (c *RegistrationPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RegistrationPolicy = append(c.inters.RegistrationPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a RegistrationPolicy entity.
+func (c *RegistrationPolicyClient) Create() *RegistrationPolicyCreate {
+	mutation := newRegistrationPolicyMutation(c.config, OpCreate)
+	return &RegistrationPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RegistrationPolicy entities.
+func (c *RegistrationPolicyClient) CreateBulk(builders ...*RegistrationPolicyCreate) *RegistrationPolicyCreateBulk {
+	return &RegistrationPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RegistrationPolicyClient) MapCreateBulk(slice any, setFunc func(*RegistrationPolicyCreate, int)) *RegistrationPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RegistrationPolicyCreateBulk{err: fmt.Errorf("calling to RegistrationPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RegistrationPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RegistrationPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RegistrationPolicy.
+func (c *RegistrationPolicyClient) Update() *RegistrationPolicyUpdate {
+	mutation := newRegistrationPolicyMutation(c.config, OpUpdate)
+	return &RegistrationPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RegistrationPolicyClient) UpdateOne(_m *RegistrationPolicy) *RegistrationPolicyUpdateOne {
+	mutation := newRegistrationPolicyMutation(c.config, OpUpdateOne, withRegistrationPolicy(_m))
+	return &RegistrationPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RegistrationPolicyClient) UpdateOneID(id uint32) *RegistrationPolicyUpdateOne {
+	mutation := newRegistrationPolicyMutation(c.config, OpUpdateOne, withRegistrationPolicyID(id))
+	return &RegistrationPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RegistrationPolicy.
+func (c *RegistrationPolicyClient) Delete() *RegistrationPolicyDelete {
+	mutation := newRegistrationPolicyMutation(c.config, OpDelete)
+	return &RegistrationPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RegistrationPolicyClient) DeleteOne(_m *RegistrationPolicy) *RegistrationPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RegistrationPolicyClient) DeleteOneID(id uint32) *RegistrationPolicyDeleteOne {
+	builder := c.Delete().Where(registrationpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RegistrationPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for RegistrationPolicy.
+func (c *RegistrationPolicyClient) Query() *RegistrationPolicyQuery {
+	return &RegistrationPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRegistrationPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RegistrationPolicy entity by its id.
+func (c *RegistrationPolicyClient) Get(ctx context.Context, id uint32) (*RegistrationPolicy, error) {
+	return c.Query().Where(registrationpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RegistrationPolicyClient) GetX(ctx context.Context, id uint32) *RegistrationPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RegistrationPolicyClient) Hooks() []Hook {
+	hooks := c.hooks.RegistrationPolicy
+	return append(hooks[:len(hooks):len(hooks)], registrationpolicy.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RegistrationPolicyClient) Interceptors() []Interceptor {
+	return c.inters.RegistrationPolicy
+}
+
+func (c *RegistrationPolicyClient) mutate(ctx context.Context, m *RegistrationPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RegistrationPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RegistrationPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RegistrationPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RegistrationPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RegistrationPolicy mutation op: %q", m.Op())
+	}
+}
+
 // RoleClient is a client for the Role schema.
 type RoleClient struct {
 	config
@@ -4998,69 +6311,337 @@ func (c *RolePermissionClient) mutate(ctx context.Context, m *RolePermissionMuta
 	}
 }
 
-// TaskClient is a client for the Task schema.
-type TaskClient struct {
+// ScimTokenClient is a client for the ScimToken schema.
+type ScimTokenClient struct {
 	config
 }
 
-// NewTaskClient returns a client for the Task from the given config.
-func NewTaskClient(c config) *TaskClient {
-	return &TaskClient{config: c}
+// NewScimTokenClient returns a client for the ScimToken from the given config.
+func NewScimTokenClient(c config) *ScimTokenClient {
+	return &ScimTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `task.Hooks(f(g(h())))`.
-func (c *TaskClient) Use(hooks ...Hook) {
-	c.hooks.Task = append(c.hooks.Task, hooks...)
+// A call to `Use(f, g, h)` equals to `scimtoken.Hooks(f(g(h())))`.
+func (c *ScimTokenClient) Use(hooks ...Hook) {
+	c.hooks.ScimToken = append(c.hooks.ScimToken, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
-func (c *TaskClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Task = append(c.inters.Task, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `scimtoken.Intercept(f(g(h())))`.
+func (c *ScimTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScimToken = append(c.inters.ScimToken, interceptors...)
 }
 
-// Create returns a builder for creating a Task entity.
-func (c *TaskClient) Create() *TaskCreate {
-	mutation := newTaskMutation(c.config, OpCreate)
-	return &TaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ScimToken entity.
+func (c *ScimTokenClient) Create() *ScimTokenCreate {
+	mutation := newScimTokenMutation(c.config, OpCreate)
+	return &ScimTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Task entities.
-func (c *TaskClient) CreateBulk(builders ...*TaskCreate) *TaskCreateBulk {
-	return &TaskCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ScimToken entities.
+func (c *ScimTokenClient) CreateBulk(builders ...*ScimTokenCreate) *ScimTokenCreateBulk {
+	return &ScimTokenCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TaskClient) MapCreateBulk(slice any, setFunc func(*TaskCreate, int)) *TaskCreateBulk {
+func (c *ScimTokenClient) MapCreateBulk(slice any, setFunc func(*ScimTokenCreate, int)) *ScimTokenCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TaskCreateBulk{err: fmt.Errorf("calling to TaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ScimTokenCreateBulk{err: fmt.Errorf("calling to ScimTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TaskCreate, rv.Len())
+	builders := make([]*ScimTokenCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TaskCreateBulk{config: c.config, builders: builders}
+	return &ScimTokenCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Task.
-func (c *TaskClient) Update() *TaskUpdate {
-	mutation := newTaskMutation(c.config, OpUpdate)
-	return &TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ScimToken.
+func (c *ScimTokenClient) Update() *ScimTokenUpdate {
+	mutation := newScimTokenMutation(c.config, OpUpdate)
+	return &ScimTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TaskClient) UpdateOne(_m *Task) *TaskUpdateOne {
-	mutation := newTaskMutation(c.config, OpUpdateOne, withTask(_m))
-	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScimTokenClient) UpdateOne(_m *ScimToken) *ScimTokenUpdateOne {
+	mutation := newScimTokenMutation(c.config, OpUpdateOne, withScimToken(_m))
+	return &ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TaskClient) UpdateOneID(id uint32) *TaskUpdateOne {
-	mutation := newTaskMutation(c.config, OpUpdateOne, withTaskID(id))
+func (c *ScimTokenClient) UpdateOneID(id uint32) *ScimTokenUpdateOne {
+	mutation := newScimTokenMutation(c.config, OpUpdateOne, withScimTokenID(id))
+	return &ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScimToken.
+func (c *ScimTokenClient) Delete() *ScimTokenDelete {
+	mutation := newScimTokenMutation(c.config, OpDelete)
+	return &ScimTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScimTokenClient) DeleteOne(_m *ScimToken) *ScimTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScimTokenClient) DeleteOneID(id uint32) *ScimTokenDeleteOne {
+	builder := c.Delete().Where(scimtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScimTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for ScimToken.
+func (c *ScimTokenClient) Query() *ScimTokenQuery {
+	return &ScimTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScimToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScimToken entity by its id.
+func (c *ScimTokenClient) Get(ctx context.Context, id uint32) (*ScimToken, error) {
+	return c.Query().Where(scimtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScimTokenClient) GetX(ctx context.Context, id uint32) *ScimToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScimTokenClient) Hooks() []Hook {
+	hooks := c.hooks.ScimToken
+	return append(hooks[:len(hooks):len(hooks)], scimtoken.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScimTokenClient) Interceptors() []Interceptor {
+	return c.inters.ScimToken
+}
+
+func (c *ScimTokenClient) mutate(ctx context.Context, m *ScimTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScimTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScimTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScimTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScimToken mutation op: %q", m.Op())
+	}
+}
+
+// StorageQuotaClient is a client for the StorageQuota schema.
+type StorageQuotaClient struct {
+	config
+}
+
+// NewStorageQuotaClient returns a client for the StorageQuota from the given config.
+func NewStorageQuotaClient(c config) *StorageQuotaClient {
+	return &StorageQuotaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `storagequota.Hooks(f(g(h())))`.
+func (c *StorageQuotaClient) Use(hooks ...Hook) {
+	c.hooks.StorageQuota = append(c.hooks.StorageQuota, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `storagequota.Intercept(f(g(h())))`.
+func (c *StorageQuotaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StorageQuota = append(c.inters.StorageQuota, interceptors...)
+}
+
+// Create returns a builder for creating a StorageQuota entity.
+func (c *StorageQuotaClient) Create() *StorageQuotaCreate {
+	mutation := newStorageQuotaMutation(c.config, OpCreate)
+	return &StorageQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StorageQuota entities.
+func (c *StorageQuotaClient) CreateBulk(builders ...*StorageQuotaCreate) *StorageQuotaCreateBulk {
+	return &StorageQuotaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StorageQuotaClient) MapCreateBulk(slice any, setFunc func(*StorageQuotaCreate, int)) *StorageQuotaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StorageQuotaCreateBulk{err: fmt.Errorf("calling to StorageQuotaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StorageQuotaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StorageQuotaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StorageQuota.
+func (c *StorageQuotaClient) Update() *StorageQuotaUpdate {
+	mutation := newStorageQuotaMutation(c.config, OpUpdate)
+	return &StorageQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StorageQuotaClient) UpdateOne(_m *StorageQuota) *StorageQuotaUpdateOne {
+	mutation := newStorageQuotaMutation(c.config, OpUpdateOne, withStorageQuota(_m))
+	return &StorageQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StorageQuotaClient) UpdateOneID(id uint32) *StorageQuotaUpdateOne {
+	mutation := newStorageQuotaMutation(c.config, OpUpdateOne, withStorageQuotaID(id))
+	return &StorageQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StorageQuota.
+func (c *StorageQuotaClient) Delete() *StorageQuotaDelete {
+	mutation := newStorageQuotaMutation(c.config, OpDelete)
+	return &StorageQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StorageQuotaClient) DeleteOne(_m *StorageQuota) *StorageQuotaDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StorageQuotaClient) DeleteOneID(id uint32) *StorageQuotaDeleteOne {
+	builder := c.Delete().Where(storagequota.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StorageQuotaDeleteOne{builder}
+}
+
+// Query returns a query builder for StorageQuota.
+func (c *StorageQuotaClient) Query() *StorageQuotaQuery {
+	return &StorageQuotaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStorageQuota},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StorageQuota entity by its id.
+func (c *StorageQuotaClient) Get(ctx context.Context, id uint32) (*StorageQuota, error) {
+	return c.Query().Where(storagequota.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StorageQuotaClient) GetX(ctx context.Context, id uint32) *StorageQuota {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StorageQuotaClient) Hooks() []Hook {
+	hooks := c.hooks.StorageQuota
+	return append(hooks[:len(hooks):len(hooks)], storagequota.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *StorageQuotaClient) Interceptors() []Interceptor {
+	return c.inters.StorageQuota
+}
+
+func (c *StorageQuotaClient) mutate(ctx context.Context, m *StorageQuotaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StorageQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StorageQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StorageQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StorageQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StorageQuota mutation op: %q", m.Op())
+	}
+}
+
+// TaskClient is a client for the Task schema.
+type TaskClient struct {
+	config
+}
+
+// NewTaskClient returns a client for the Task from the given config.
+func NewTaskClient(c config) *TaskClient {
+	return &TaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `task.Hooks(f(g(h())))`.
+func (c *TaskClient) Use(hooks ...Hook) {
+	c.hooks.Task = append(c.hooks.Task, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
+func (c *TaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Task = append(c.inters.Task, interceptors...)
+}
+
+// Create returns a builder for creating a Task entity.
+func (c *TaskClient) Create() *TaskCreate {
+	mutation := newTaskMutation(c.config, OpCreate)
+	return &TaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Task entities.
+func (c *TaskClient) CreateBulk(builders ...*TaskCreate) *TaskCreateBulk {
+	return &TaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskClient) MapCreateBulk(slice any, setFunc func(*TaskCreate, int)) *TaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskCreateBulk{err: fmt.Errorf("calling to TaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Task.
+func (c *TaskClient) Update() *TaskUpdate {
+	mutation := newTaskMutation(c.config, OpUpdate)
+	return &TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskClient) UpdateOne(_m *Task) *TaskUpdateOne {
+	mutation := newTaskMutation(c.config, OpUpdateOne, withTask(_m))
+	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskClient) UpdateOneID(id uint32) *TaskUpdateOne {
+	mutation := newTaskMutation(c.config, OpUpdateOne, withTaskID(id))
 	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -5132,6 +6713,140 @@ func (c *TaskClient) mutate(ctx context.Context, m *TaskMutation) (Value, error)
 	}
 }
 
+// TaskRunClient is a client for the TaskRun schema.
+type TaskRunClient struct {
+	config
+}
+
+// NewTaskRunClient returns a client for the TaskRun from the given config.
+func NewTaskRunClient(c config) *TaskRunClient {
+	return &TaskRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskrun.Hooks(f(g(h())))`.
+func (c *TaskRunClient) Use(hooks ...Hook) {
+	c.hooks.TaskRun = append(c.hooks.TaskRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskrun.Intercept(f(g(h())))`.
+func (c *TaskRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskRun = append(c.inters.TaskRun, interceptors...)
+}
+
+// Create returns a builder for creating a TaskRun entity.
+func (c *TaskRunClient) Create() *TaskRunCreate {
+	mutation := newTaskRunMutation(c.config, OpCreate)
+	return &TaskRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskRun entities.
+func (c *TaskRunClient) CreateBulk(builders ...*TaskRunCreate) *TaskRunCreateBulk {
+	return &TaskRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskRunClient) MapCreateBulk(slice any, setFunc func(*TaskRunCreate, int)) *TaskRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskRunCreateBulk{err: fmt.Errorf("calling to TaskRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskRun.
+func (c *TaskRunClient) Update() *TaskRunUpdate {
+	mutation := newTaskRunMutation(c.config, OpUpdate)
+	return &TaskRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskRunClient) UpdateOne(_m *TaskRun) *TaskRunUpdateOne {
+	mutation := newTaskRunMutation(c.config, OpUpdateOne, withTaskRun(_m))
+	return &TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskRunClient) UpdateOneID(id uint32) *TaskRunUpdateOne {
+	mutation := newTaskRunMutation(c.config, OpUpdateOne, withTaskRunID(id))
+	return &TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskRun.
+func (c *TaskRunClient) Delete() *TaskRunDelete {
+	mutation := newTaskRunMutation(c.config, OpDelete)
+	return &TaskRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskRunClient) DeleteOne(_m *TaskRun) *TaskRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskRunClient) DeleteOneID(id uint32) *TaskRunDeleteOne {
+	builder := c.Delete().Where(taskrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskRunDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskRun.
+func (c *TaskRunClient) Query() *TaskRunQuery {
+	return &TaskRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskRun entity by its id.
+func (c *TaskRunClient) Get(ctx context.Context, id uint32) (*TaskRun, error) {
+	return c.Query().Where(taskrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskRunClient) GetX(ctx context.Context, id uint32) *TaskRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskRunClient) Hooks() []Hook {
+	hooks := c.hooks.TaskRun
+	return append(hooks[:len(hooks):len(hooks)], taskrun.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskRunClient) Interceptors() []Interceptor {
+	return c.inters.TaskRun
+}
+
+func (c *TaskRunClient) mutate(ctx context.Context, m *TaskRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskRun mutation op: %q", m.Op())
+	}
+}
+
 // TenantClient is a client for the Tenant schema.
 type TenantClient struct {
 	config
@@ -5667,6 +7382,140 @@ func (c *UserOrgUnitClient) mutate(ctx context.Context, m *UserOrgUnitMutation)
 	}
 }
 
+// UserPasswordHistoryClient is a client for the UserPasswordHistory schema.
+type UserPasswordHistoryClient struct {
+	config
+}
+
+// NewUserPasswordHistoryClient returns a client for the UserPasswordHistory from the given config.
+func NewUserPasswordHistoryClient(c config) *UserPasswordHistoryClient {
+	return &UserPasswordHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userpasswordhistory.Hooks(f(g(h())))`.
+func (c *UserPasswordHistoryClient) Use(hooks ...Hook) {
+	c.hooks.UserPasswordHistory = append(c.hooks.UserPasswordHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userpasswordhistory.Intercept(f(g(h())))`.
+func (c *UserPasswordHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserPasswordHistory = append(c.inters.UserPasswordHistory, interceptors...)
+}
+
+// Create returns a builder for creating a UserPasswordHistory entity.
+func (c *UserPasswordHistoryClient) Create() *UserPasswordHistoryCreate {
+	mutation := newUserPasswordHistoryMutation(c.config, OpCreate)
+	return &UserPasswordHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserPasswordHistory entities.
+func (c *UserPasswordHistoryClient) CreateBulk(builders ...*UserPasswordHistoryCreate) *UserPasswordHistoryCreateBulk {
+	return &UserPasswordHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserPasswordHistoryClient) MapCreateBulk(slice any, setFunc func(*UserPasswordHistoryCreate, int)) *UserPasswordHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserPasswordHistoryCreateBulk{err: fmt.Errorf("calling to UserPasswordHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserPasswordHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserPasswordHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserPasswordHistory.
+func (c *UserPasswordHistoryClient) Update() *UserPasswordHistoryUpdate {
+	mutation := newUserPasswordHistoryMutation(c.config, OpUpdate)
+	return &UserPasswordHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserPasswordHistoryClient) UpdateOne(_m *UserPasswordHistory) *UserPasswordHistoryUpdateOne {
+	mutation := newUserPasswordHistoryMutation(c.config, OpUpdateOne, withUserPasswordHistory(_m))
+	return &UserPasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserPasswordHistoryClient) UpdateOneID(id uint32) *UserPasswordHistoryUpdateOne {
+	mutation := newUserPasswordHistoryMutation(c.config, OpUpdateOne, withUserPasswordHistoryID(id))
+	return &UserPasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserPasswordHistory.
+func (c *UserPasswordHistoryClient) Delete() *UserPasswordHistoryDelete {
+	mutation := newUserPasswordHistoryMutation(c.config, OpDelete)
+	return &UserPasswordHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserPasswordHistoryClient) DeleteOne(_m *UserPasswordHistory) *UserPasswordHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserPasswordHistoryClient) DeleteOneID(id uint32) *UserPasswordHistoryDeleteOne {
+	builder := c.Delete().Where(userpasswordhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserPasswordHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for UserPasswordHistory.
+func (c *UserPasswordHistoryClient) Query() *UserPasswordHistoryQuery {
+	return &UserPasswordHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserPasswordHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserPasswordHistory entity by its id.
+func (c *UserPasswordHistoryClient) Get(ctx context.Context, id uint32) (*UserPasswordHistory, error) {
+	return c.Query().Where(userpasswordhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserPasswordHistoryClient) GetX(ctx context.Context, id uint32) *UserPasswordHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserPasswordHistoryClient) Hooks() []Hook {
+	hooks := c.hooks.UserPasswordHistory
+	return append(hooks[:len(hooks):len(hooks)], userpasswordhistory.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserPasswordHistoryClient) Interceptors() []Interceptor {
+	return c.inters.UserPasswordHistory
+}
+
+func (c *UserPasswordHistoryClient) mutate(ctx context.Context, m *UserPasswordHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserPasswordHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserPasswordHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserPasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserPasswordHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserPasswordHistory mutation op: %q", m.Op())
+	}
+}
+
 // UserPositionClient is a client for the UserPosition schema.
 type UserPositionClient struct {
 	config
@@ -5939,22 +7788,28 @@ func (c *UserRoleClient) mutate(ctx context.Context, m *UserRoleMutation) (Value
 type (
 	hooks struct {
 		Api, ApiAuditLog, DataAccessAuditLog, DictEntry, DictEntryI18n, DictType,
-		DictTypeI18n, File, InternalMessage, InternalMessageCategory,
-		InternalMessageRecipient, Language, LoginAuditLog, LoginPolicy, Membership,
-		MembershipOrgUnit, MembershipPosition, MembershipRole, Menu, OperationAuditLog,
-		OrgUnit, Permission, PermissionApi, PermissionAuditLog, PermissionGroup,
-		PermissionMenu, PermissionPolicy, PolicyEvaluationLog, Position, Role,
-		RoleMetadata, RolePermission, Task, Tenant, User, UserCredential, UserOrgUnit,
-		UserPosition, UserRole []ent.Hook
+		DictTypeI18n, File, FileShare, InternalMessage, InternalMessageCategory,
+		InternalMessageRecipient, JwtSigningKey, Language, LdapSource, LoginAuditLog,
+		LoginPolicy, LuaScript, LuaScriptVersion, Membership, MembershipOrgUnit,
+		MembershipPosition, MembershipRole, Menu, OAuthClient, OperationAuditLog,
+		OrgUnit, PasswordPolicy, Permission, PermissionApi, PermissionAuditLog,
+		PermissionGroup, PermissionMenu, PermissionPolicy, PolicyEvaluationLog,
+		Position, RegistrationInvite, RegistrationPolicy, Role, RoleMetadata,
+		RolePermission, ScimToken, StorageQuota, Task, TaskRun, Tenant, User,
+		UserCredential, UserOrgUnit, UserPasswordHistory, UserPosition,
+		UserRole []ent.Hook
 	}
 	inters struct {
 		Api, ApiAuditLog, DataAccessAuditLog, DictEntry, DictEntryI18n, DictType,
-		DictTypeI18n, File, InternalMessage, InternalMessageCategory,
-		InternalMessageRecipient, Language, LoginAuditLog, LoginPolicy, Membership,
-		MembershipOrgUnit, MembershipPosition, MembershipRole, Menu, OperationAuditLog,
-		OrgUnit, Permission, PermissionApi, PermissionAuditLog, PermissionGroup,
-		PermissionMenu, PermissionPolicy, PolicyEvaluationLog, Position, Role,
-		RoleMetadata, RolePermission, Task, Tenant, User, UserCredential, UserOrgUnit,
-		UserPosition, UserRole []ent.Interceptor
+		DictTypeI18n, File, FileShare, InternalMessage, InternalMessageCategory,
+		InternalMessageRecipient, JwtSigningKey, Language, LdapSource, LoginAuditLog,
+		LoginPolicy, LuaScript, LuaScriptVersion, Membership, MembershipOrgUnit,
+		MembershipPosition, MembershipRole, Menu, OAuthClient, OperationAuditLog,
+		OrgUnit, PasswordPolicy, Permission, PermissionApi, PermissionAuditLog,
+		PermissionGroup, PermissionMenu, PermissionPolicy, PolicyEvaluationLog,
+		Position, RegistrationInvite, RegistrationPolicy, Role, RoleMetadata,
+		RolePermission, ScimToken, StorageQuota, Task, TaskRun, Tenant, User,
+		UserCredential, UserOrgUnit, UserPasswordHistory, UserPosition,
+		UserRole []ent.Interceptor
 	}
 )
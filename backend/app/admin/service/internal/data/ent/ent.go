@@ -14,19 +14,26 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttypei18n"
 	"go-wind-admin/app/admin/service/internal/data/ent/file"
+	"go-wind-admin/app/admin/service/internal/data/ent/fileshare"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapsource"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascript"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
 	"go-wind-admin/app/admin/service/internal/data/ent/membership"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiporgunit"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershipposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiprole"
 	"go-wind-admin/app/admin/service/internal/data/ent/menu"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthclient"
 	"go-wind-admin/app/admin/service/internal/data/ent/operationauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/permission"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionapi"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionauditlog"
@@ -35,14 +42,20 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/policyevaluationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationinvite"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemetadata"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
+	"go-wind-admin/app/admin/service/internal/data/ent/scimtoken"
+	"go-wind-admin/app/admin/service/internal/data/ent/storagequota"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
 	"go-wind-admin/app/admin/service/internal/data/ent/userorgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/userpasswordhistory"
 	"go-wind-admin/app/admin/service/internal/data/ent/userposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
 	"reflect"
@@ -119,19 +132,26 @@ func checkColumn(t, c string) error {
 			dicttype.Table:                 dicttype.ValidColumn,
 			dicttypei18n.Table:             dicttypei18n.ValidColumn,
 			file.Table:                     file.ValidColumn,
+			fileshare.Table:                fileshare.ValidColumn,
 			internalmessage.Table:          internalmessage.ValidColumn,
 			internalmessagecategory.Table:  internalmessagecategory.ValidColumn,
 			internalmessagerecipient.Table: internalmessagerecipient.ValidColumn,
+			jwtsigningkey.Table:            jwtsigningkey.ValidColumn,
 			language.Table:                 language.ValidColumn,
+			ldapsource.Table:               ldapsource.ValidColumn,
 			loginauditlog.Table:            loginauditlog.ValidColumn,
 			loginpolicy.Table:              loginpolicy.ValidColumn,
+			luascript.Table:                luascript.ValidColumn,
+			luascriptversion.Table:         luascriptversion.ValidColumn,
 			membership.Table:               membership.ValidColumn,
 			membershiporgunit.Table:        membershiporgunit.ValidColumn,
 			membershipposition.Table:       membershipposition.ValidColumn,
 			membershiprole.Table:           membershiprole.ValidColumn,
 			menu.Table:                     menu.ValidColumn,
+			oauthclient.Table:              oauthclient.ValidColumn,
 			operationauditlog.Table:        operationauditlog.ValidColumn,
 			orgunit.Table:                  orgunit.ValidColumn,
+			passwordpolicy.Table:           passwordpolicy.ValidColumn,
 			permission.Table:               permission.ValidColumn,
 			permissionapi.Table:            permissionapi.ValidColumn,
 			permissionauditlog.Table:       permissionauditlog.ValidColumn,
@@ -140,14 +160,20 @@ func checkColumn(t, c string) error {
 			permissionpolicy.Table:         permissionpolicy.ValidColumn,
 			policyevaluationlog.Table:      policyevaluationlog.ValidColumn,
 			position.Table:                 position.ValidColumn,
+			registrationinvite.Table:       registrationinvite.ValidColumn,
+			registrationpolicy.Table:       registrationpolicy.ValidColumn,
 			role.Table:                     role.ValidColumn,
 			rolemetadata.Table:             rolemetadata.ValidColumn,
 			rolepermission.Table:           rolepermission.ValidColumn,
+			scimtoken.Table:                scimtoken.ValidColumn,
+			storagequota.Table:             storagequota.ValidColumn,
 			task.Table:                     task.ValidColumn,
+			taskrun.Table:                  taskrun.ValidColumn,
 			tenant.Table:                   tenant.ValidColumn,
 			user.Table:                     user.ValidColumn,
 			usercredential.Table:           usercredential.ValidColumn,
 			userorgunit.Table:              userorgunit.ValidColumn,
+			userpasswordhistory.Table:      userpasswordhistory.ValidColumn,
 			userposition.Table:             userposition.ValidColumn,
 			userrole.Table:                 userrole.ValidColumn,
 		})
@@ -11,19 +11,26 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttypei18n"
 	"go-wind-admin/app/admin/service/internal/data/ent/file"
+	"go-wind-admin/app/admin/service/internal/data/ent/fileshare"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapsource"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascript"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
 	"go-wind-admin/app/admin/service/internal/data/ent/membership"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiporgunit"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershipposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiprole"
 	"go-wind-admin/app/admin/service/internal/data/ent/menu"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthclient"
 	"go-wind-admin/app/admin/service/internal/data/ent/operationauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/permission"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionapi"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionauditlog"
@@ -33,14 +40,20 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/policyevaluationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationinvite"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemetadata"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
+	"go-wind-admin/app/admin/service/internal/data/ent/scimtoken"
+	"go-wind-admin/app/admin/service/internal/data/ent/storagequota"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
 	"go-wind-admin/app/admin/service/internal/data/ent/userorgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/userpasswordhistory"
 	"go-wind-admin/app/admin/service/internal/data/ent/userposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
 
@@ -52,7 +65,7 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 39)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 52)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   api.Table,
@@ -95,6 +108,8 @@ var schemaGraph = func() *sqlgraph.Schema {
 			apiauditlog.FieldTenantID:       {Type: field.TypeUint32, Column: apiauditlog.FieldTenantID},
 			apiauditlog.FieldUserID:         {Type: field.TypeUint32, Column: apiauditlog.FieldUserID},
 			apiauditlog.FieldUsername:       {Type: field.TypeString, Column: apiauditlog.FieldUsername},
+			apiauditlog.FieldActorUserID:    {Type: field.TypeUint32, Column: apiauditlog.FieldActorUserID},
+			apiauditlog.FieldActorUsername:  {Type: field.TypeString, Column: apiauditlog.FieldActorUsername},
 			apiauditlog.FieldIPAddress:      {Type: field.TypeString, Column: apiauditlog.FieldIPAddress},
 			apiauditlog.FieldGeoLocation:    {Type: field.TypeJSON, Column: apiauditlog.FieldGeoLocation},
 			apiauditlog.FieldDeviceInfo:     {Type: field.TypeJSON, Column: apiauditlog.FieldDeviceInfo},
@@ -283,9 +298,41 @@ var schemaGraph = func() *sqlgraph.Schema {
 			file.FieldSizeFormat:    {Type: field.TypeString, Column: file.FieldSizeFormat},
 			file.FieldLinkURL:       {Type: field.TypeString, Column: file.FieldLinkURL},
 			file.FieldContentHash:   {Type: field.TypeString, Column: file.FieldContentHash},
+			file.FieldContentType:   {Type: field.TypeString, Column: file.FieldContentType},
+			file.FieldScanStatus:    {Type: field.TypeEnum, Column: file.FieldScanStatus},
+			file.FieldScanResult:    {Type: field.TypeString, Column: file.FieldScanResult},
 		},
 	}
 	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   fileshare.Table,
+			Columns: fileshare.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: fileshare.FieldID,
+			},
+		},
+		Type: "FileShare",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			fileshare.FieldCreatedAt:        {Type: field.TypeTime, Column: fileshare.FieldCreatedAt},
+			fileshare.FieldUpdatedAt:        {Type: field.TypeTime, Column: fileshare.FieldUpdatedAt},
+			fileshare.FieldDeletedAt:        {Type: field.TypeTime, Column: fileshare.FieldDeletedAt},
+			fileshare.FieldCreatedBy:        {Type: field.TypeUint32, Column: fileshare.FieldCreatedBy},
+			fileshare.FieldUpdatedBy:        {Type: field.TypeUint32, Column: fileshare.FieldUpdatedBy},
+			fileshare.FieldDeletedBy:        {Type: field.TypeUint32, Column: fileshare.FieldDeletedBy},
+			fileshare.FieldTenantID:         {Type: field.TypeUint32, Column: fileshare.FieldTenantID},
+			fileshare.FieldFileID:           {Type: field.TypeUint32, Column: fileshare.FieldFileID},
+			fileshare.FieldSlug:             {Type: field.TypeString, Column: fileshare.FieldSlug},
+			fileshare.FieldPasswordHash:     {Type: field.TypeString, Column: fileshare.FieldPasswordHash},
+			fileshare.FieldExpiresAt:        {Type: field.TypeTime, Column: fileshare.FieldExpiresAt},
+			fileshare.FieldMaxDownloads:     {Type: field.TypeUint32, Column: fileshare.FieldMaxDownloads},
+			fileshare.FieldDownloadCount:    {Type: field.TypeUint32, Column: fileshare.FieldDownloadCount},
+			fileshare.FieldAllowedTenantIds: {Type: field.TypeJSON, Column: fileshare.FieldAllowedTenantIds},
+			fileshare.FieldAllowedUserIds:   {Type: field.TypeJSON, Column: fileshare.FieldAllowedUserIds},
+			fileshare.FieldDisabled:         {Type: field.TypeBool, Column: fileshare.FieldDisabled},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessage.Table,
 			Columns: internalmessage.Columns,
@@ -311,7 +358,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			internalmessage.FieldType:       {Type: field.TypeEnum, Column: internalmessage.FieldType},
 		},
 	}
-	graph.Nodes[9] = &sqlgraph.Node{
+	graph.Nodes[10] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessagecategory.Table,
 			Columns: internalmessagecategory.Columns,
@@ -337,7 +384,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			internalmessagecategory.FieldIconURL:   {Type: field.TypeString, Column: internalmessagecategory.FieldIconURL},
 		},
 	}
-	graph.Nodes[10] = &sqlgraph.Node{
+	graph.Nodes[11] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessagerecipient.Table,
 			Columns: internalmessagerecipient.Columns,
@@ -359,7 +406,33 @@ var schemaGraph = func() *sqlgraph.Schema {
 			internalmessagerecipient.FieldReadAt:          {Type: field.TypeTime, Column: internalmessagerecipient.FieldReadAt},
 		},
 	}
-	graph.Nodes[11] = &sqlgraph.Node{
+	graph.Nodes[12] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   jwtsigningkey.Table,
+			Columns: jwtsigningkey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: jwtsigningkey.FieldID,
+			},
+		},
+		Type: "JwtSigningKey",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			jwtsigningkey.FieldCreatedAt:  {Type: field.TypeTime, Column: jwtsigningkey.FieldCreatedAt},
+			jwtsigningkey.FieldUpdatedAt:  {Type: field.TypeTime, Column: jwtsigningkey.FieldUpdatedAt},
+			jwtsigningkey.FieldDeletedAt:  {Type: field.TypeTime, Column: jwtsigningkey.FieldDeletedAt},
+			jwtsigningkey.FieldCreatedBy:  {Type: field.TypeUint32, Column: jwtsigningkey.FieldCreatedBy},
+			jwtsigningkey.FieldUpdatedBy:  {Type: field.TypeUint32, Column: jwtsigningkey.FieldUpdatedBy},
+			jwtsigningkey.FieldDeletedBy:  {Type: field.TypeUint32, Column: jwtsigningkey.FieldDeletedBy},
+			jwtsigningkey.FieldKid:        {Type: field.TypeString, Column: jwtsigningkey.FieldKid},
+			jwtsigningkey.FieldAlgorithm:  {Type: field.TypeEnum, Column: jwtsigningkey.FieldAlgorithm},
+			jwtsigningkey.FieldPrivateKey: {Type: field.TypeString, Column: jwtsigningkey.FieldPrivateKey},
+			jwtsigningkey.FieldPublicKey:  {Type: field.TypeString, Column: jwtsigningkey.FieldPublicKey},
+			jwtsigningkey.FieldStatus:     {Type: field.TypeEnum, Column: jwtsigningkey.FieldStatus},
+			jwtsigningkey.FieldRetiredAt:  {Type: field.TypeTime, Column: jwtsigningkey.FieldRetiredAt},
+			jwtsigningkey.FieldExpiresAt:  {Type: field.TypeTime, Column: jwtsigningkey.FieldExpiresAt},
+		},
+	}
+	graph.Nodes[13] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   language.Table,
 			Columns: language.Columns,
@@ -384,7 +457,50 @@ var schemaGraph = func() *sqlgraph.Schema {
 			language.FieldIsDefault:    {Type: field.TypeBool, Column: language.FieldIsDefault},
 		},
 	}
-	graph.Nodes[12] = &sqlgraph.Node{
+	graph.Nodes[14] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   ldapsource.Table,
+			Columns: ldapsource.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: ldapsource.FieldID,
+			},
+		},
+		Type: "LdapSource",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			ldapsource.FieldCreatedAt:            {Type: field.TypeTime, Column: ldapsource.FieldCreatedAt},
+			ldapsource.FieldUpdatedAt:            {Type: field.TypeTime, Column: ldapsource.FieldUpdatedAt},
+			ldapsource.FieldDeletedAt:            {Type: field.TypeTime, Column: ldapsource.FieldDeletedAt},
+			ldapsource.FieldCreatedBy:            {Type: field.TypeUint32, Column: ldapsource.FieldCreatedBy},
+			ldapsource.FieldUpdatedBy:            {Type: field.TypeUint32, Column: ldapsource.FieldUpdatedBy},
+			ldapsource.FieldDeletedBy:            {Type: field.TypeUint32, Column: ldapsource.FieldDeletedBy},
+			ldapsource.FieldTenantID:             {Type: field.TypeUint32, Column: ldapsource.FieldTenantID},
+			ldapsource.FieldName:                 {Type: field.TypeString, Column: ldapsource.FieldName},
+			ldapsource.FieldEnabled:              {Type: field.TypeBool, Column: ldapsource.FieldEnabled},
+			ldapsource.FieldURL:                  {Type: field.TypeString, Column: ldapsource.FieldURL},
+			ldapsource.FieldStartTLS:             {Type: field.TypeBool, Column: ldapsource.FieldStartTLS},
+			ldapsource.FieldInsecureSkipVerify:   {Type: field.TypeBool, Column: ldapsource.FieldInsecureSkipVerify},
+			ldapsource.FieldBindDn:               {Type: field.TypeString, Column: ldapsource.FieldBindDn},
+			ldapsource.FieldBindPassword:         {Type: field.TypeString, Column: ldapsource.FieldBindPassword},
+			ldapsource.FieldBaseDn:               {Type: field.TypeString, Column: ldapsource.FieldBaseDn},
+			ldapsource.FieldUserFilter:           {Type: field.TypeString, Column: ldapsource.FieldUserFilter},
+			ldapsource.FieldLoginAttribute:       {Type: field.TypeString, Column: ldapsource.FieldLoginAttribute},
+			ldapsource.FieldEmailAttribute:       {Type: field.TypeString, Column: ldapsource.FieldEmailAttribute},
+			ldapsource.FieldDisplayNameAttribute: {Type: field.TypeString, Column: ldapsource.FieldDisplayNameAttribute},
+			ldapsource.FieldMobileAttribute:      {Type: field.TypeString, Column: ldapsource.FieldMobileAttribute},
+			ldapsource.FieldOrgUnitFilter:        {Type: field.TypeString, Column: ldapsource.FieldOrgUnitFilter},
+			ldapsource.FieldGroupBaseDn:          {Type: field.TypeString, Column: ldapsource.FieldGroupBaseDn},
+			ldapsource.FieldGroupFilter:          {Type: field.TypeString, Column: ldapsource.FieldGroupFilter},
+			ldapsource.FieldGroupMemberAttribute: {Type: field.TypeString, Column: ldapsource.FieldGroupMemberAttribute},
+			ldapsource.FieldGroupRoleMappings:    {Type: field.TypeJSON, Column: ldapsource.FieldGroupRoleMappings},
+			ldapsource.FieldDefaultRoleID:        {Type: field.TypeUint32, Column: ldapsource.FieldDefaultRoleID},
+			ldapsource.FieldSyncEnabled:          {Type: field.TypeBool, Column: ldapsource.FieldSyncEnabled},
+			ldapsource.FieldDisableMissingUsers:  {Type: field.TypeBool, Column: ldapsource.FieldDisableMissingUsers},
+			ldapsource.FieldLastSyncAt:           {Type: field.TypeTime, Column: ldapsource.FieldLastSyncAt},
+			ldapsource.FieldLastSyncError:        {Type: field.TypeString, Column: ldapsource.FieldLastSyncError},
+		},
+	}
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   loginauditlog.Table,
 			Columns: loginauditlog.Columns,
@@ -417,7 +533,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			loginauditlog.FieldSignature:     {Type: field.TypeBytes, Column: loginauditlog.FieldSignature},
 		},
 	}
-	graph.Nodes[13] = &sqlgraph.Node{
+	graph.Nodes[16] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   loginpolicy.Table,
 			Columns: loginpolicy.Columns,
@@ -442,7 +558,60 @@ var schemaGraph = func() *sqlgraph.Schema {
 			loginpolicy.FieldMethod:    {Type: field.TypeEnum, Column: loginpolicy.FieldMethod},
 		},
 	}
-	graph.Nodes[14] = &sqlgraph.Node{
+	graph.Nodes[17] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   luascript.Table,
+			Columns: luascript.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: luascript.FieldID,
+			},
+		},
+		Type: "LuaScript",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			luascript.FieldCreatedAt:   {Type: field.TypeTime, Column: luascript.FieldCreatedAt},
+			luascript.FieldUpdatedAt:   {Type: field.TypeTime, Column: luascript.FieldUpdatedAt},
+			luascript.FieldDeletedAt:   {Type: field.TypeTime, Column: luascript.FieldDeletedAt},
+			luascript.FieldCreatedBy:   {Type: field.TypeUint32, Column: luascript.FieldCreatedBy},
+			luascript.FieldUpdatedBy:   {Type: field.TypeUint32, Column: luascript.FieldUpdatedBy},
+			luascript.FieldDeletedBy:   {Type: field.TypeUint32, Column: luascript.FieldDeletedBy},
+			luascript.FieldName:        {Type: field.TypeString, Column: luascript.FieldName},
+			luascript.FieldHook:        {Type: field.TypeString, Column: luascript.FieldHook},
+			luascript.FieldSource:      {Type: field.TypeString, Column: luascript.FieldSource},
+			luascript.FieldPriority:    {Type: field.TypeInt32, Column: luascript.FieldPriority},
+			luascript.FieldEnabled:     {Type: field.TypeBool, Column: luascript.FieldEnabled},
+			luascript.FieldCritical:    {Type: field.TypeBool, Column: luascript.FieldCritical},
+			luascript.FieldVersion:     {Type: field.TypeUint32, Column: luascript.FieldVersion},
+			luascript.FieldAuthor:      {Type: field.TypeString, Column: luascript.FieldAuthor},
+			luascript.FieldDescription: {Type: field.TypeString, Column: luascript.FieldDescription},
+		},
+	}
+	graph.Nodes[18] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   luascriptversion.Table,
+			Columns: luascriptversion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: luascriptversion.FieldID,
+			},
+		},
+		Type: "LuaScriptVersion",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			luascriptversion.FieldCreatedAt:   {Type: field.TypeTime, Column: luascriptversion.FieldCreatedAt},
+			luascriptversion.FieldCreatedBy:   {Type: field.TypeUint32, Column: luascriptversion.FieldCreatedBy},
+			luascriptversion.FieldScriptID:    {Type: field.TypeUint32, Column: luascriptversion.FieldScriptID},
+			luascriptversion.FieldVersion:     {Type: field.TypeUint32, Column: luascriptversion.FieldVersion},
+			luascriptversion.FieldName:        {Type: field.TypeString, Column: luascriptversion.FieldName},
+			luascriptversion.FieldHook:        {Type: field.TypeString, Column: luascriptversion.FieldHook},
+			luascriptversion.FieldSource:      {Type: field.TypeString, Column: luascriptversion.FieldSource},
+			luascriptversion.FieldPriority:    {Type: field.TypeInt32, Column: luascriptversion.FieldPriority},
+			luascriptversion.FieldEnabled:     {Type: field.TypeBool, Column: luascriptversion.FieldEnabled},
+			luascriptversion.FieldCritical:    {Type: field.TypeBool, Column: luascriptversion.FieldCritical},
+			luascriptversion.FieldAuthor:      {Type: field.TypeString, Column: luascriptversion.FieldAuthor},
+			luascriptversion.FieldDescription: {Type: field.TypeString, Column: luascriptversion.FieldDescription},
+		},
+	}
+	graph.Nodes[19] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membership.Table,
 			Columns: membership.Columns,
@@ -474,7 +643,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			membership.FieldStatus:     {Type: field.TypeEnum, Column: membership.FieldStatus},
 		},
 	}
-	graph.Nodes[15] = &sqlgraph.Node{
+	graph.Nodes[20] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membershiporgunit.Table,
 			Columns: membershiporgunit.Columns,
@@ -505,7 +674,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			membershiporgunit.FieldStatus:       {Type: field.TypeEnum, Column: membershiporgunit.FieldStatus},
 		},
 	}
-	graph.Nodes[16] = &sqlgraph.Node{
+	graph.Nodes[21] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membershipposition.Table,
 			Columns: membershipposition.Columns,
@@ -534,7 +703,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			membershipposition.FieldStatus:       {Type: field.TypeEnum, Column: membershipposition.FieldStatus},
 		},
 	}
-	graph.Nodes[17] = &sqlgraph.Node{
+	graph.Nodes[22] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membershiprole.Table,
 			Columns: membershiprole.Columns,
@@ -562,7 +731,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			membershiprole.FieldStatus:       {Type: field.TypeEnum, Column: membershiprole.FieldStatus},
 		},
 	}
-	graph.Nodes[18] = &sqlgraph.Node{
+	graph.Nodes[23] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   menu.Table,
 			Columns: menu.Columns,
@@ -591,7 +760,40 @@ var schemaGraph = func() *sqlgraph.Schema {
 			menu.FieldMeta:      {Type: field.TypeJSON, Column: menu.FieldMeta},
 		},
 	}
-	graph.Nodes[19] = &sqlgraph.Node{
+	graph.Nodes[24] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   oauthclient.Table,
+			Columns: oauthclient.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: oauthclient.FieldID,
+			},
+		},
+		Type: "OAuthClient",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			oauthclient.FieldCreatedAt:               {Type: field.TypeTime, Column: oauthclient.FieldCreatedAt},
+			oauthclient.FieldUpdatedAt:               {Type: field.TypeTime, Column: oauthclient.FieldUpdatedAt},
+			oauthclient.FieldDeletedAt:               {Type: field.TypeTime, Column: oauthclient.FieldDeletedAt},
+			oauthclient.FieldCreatedBy:               {Type: field.TypeUint32, Column: oauthclient.FieldCreatedBy},
+			oauthclient.FieldUpdatedBy:               {Type: field.TypeUint32, Column: oauthclient.FieldUpdatedBy},
+			oauthclient.FieldDeletedBy:               {Type: field.TypeUint32, Column: oauthclient.FieldDeletedBy},
+			oauthclient.FieldStatus:                  {Type: field.TypeEnum, Column: oauthclient.FieldStatus},
+			oauthclient.FieldTenantID:                {Type: field.TypeUint32, Column: oauthclient.FieldTenantID},
+			oauthclient.FieldClientID:                {Type: field.TypeString, Column: oauthclient.FieldClientID},
+			oauthclient.FieldName:                    {Type: field.TypeString, Column: oauthclient.FieldName},
+			oauthclient.FieldDescription:             {Type: field.TypeString, Column: oauthclient.FieldDescription},
+			oauthclient.FieldSecretHash:              {Type: field.TypeString, Column: oauthclient.FieldSecretHash},
+			oauthclient.FieldPreviousSecretHash:      {Type: field.TypeString, Column: oauthclient.FieldPreviousSecretHash},
+			oauthclient.FieldPreviousSecretExpiresAt: {Type: field.TypeTime, Column: oauthclient.FieldPreviousSecretExpiresAt},
+			oauthclient.FieldScopes:                  {Type: field.TypeJSON, Column: oauthclient.FieldScopes},
+			oauthclient.FieldTokenTTL:                {Type: field.TypeUint32, Column: oauthclient.FieldTokenTTL},
+			oauthclient.FieldLastUsedAt:              {Type: field.TypeTime, Column: oauthclient.FieldLastUsedAt},
+			oauthclient.FieldSecretVersion:           {Type: field.TypeUint32, Column: oauthclient.FieldSecretVersion},
+			oauthclient.FieldDataScope:               {Type: field.TypeEnum, Column: oauthclient.FieldDataScope},
+			oauthclient.FieldOrgUnitID:               {Type: field.TypeUint32, Column: oauthclient.FieldOrgUnitID},
+		},
+	}
+	graph.Nodes[25] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   operationauditlog.Table,
 			Columns: operationauditlog.Columns,
@@ -623,7 +825,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			operationauditlog.FieldSignature:      {Type: field.TypeBytes, Column: operationauditlog.FieldSignature},
 		},
 	}
-	graph.Nodes[20] = &sqlgraph.Node{
+	graph.Nodes[26] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   orgunit.Table,
 			Columns: orgunit.Columns,
@@ -670,7 +872,35 @@ var schemaGraph = func() *sqlgraph.Schema {
 			orgunit.FieldPermissionTags:     {Type: field.TypeJSON, Column: orgunit.FieldPermissionTags},
 		},
 	}
-	graph.Nodes[21] = &sqlgraph.Node{
+	graph.Nodes[27] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   passwordpolicy.Table,
+			Columns: passwordpolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: passwordpolicy.FieldID,
+			},
+		},
+		Type: "PasswordPolicy",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			passwordpolicy.FieldCreatedAt:        {Type: field.TypeTime, Column: passwordpolicy.FieldCreatedAt},
+			passwordpolicy.FieldUpdatedAt:        {Type: field.TypeTime, Column: passwordpolicy.FieldUpdatedAt},
+			passwordpolicy.FieldDeletedAt:        {Type: field.TypeTime, Column: passwordpolicy.FieldDeletedAt},
+			passwordpolicy.FieldCreatedBy:        {Type: field.TypeUint32, Column: passwordpolicy.FieldCreatedBy},
+			passwordpolicy.FieldUpdatedBy:        {Type: field.TypeUint32, Column: passwordpolicy.FieldUpdatedBy},
+			passwordpolicy.FieldDeletedBy:        {Type: field.TypeUint32, Column: passwordpolicy.FieldDeletedBy},
+			passwordpolicy.FieldTenantID:         {Type: field.TypeUint32, Column: passwordpolicy.FieldTenantID},
+			passwordpolicy.FieldMinLength:        {Type: field.TypeUint32, Column: passwordpolicy.FieldMinLength},
+			passwordpolicy.FieldRequireUppercase: {Type: field.TypeBool, Column: passwordpolicy.FieldRequireUppercase},
+			passwordpolicy.FieldRequireLowercase: {Type: field.TypeBool, Column: passwordpolicy.FieldRequireLowercase},
+			passwordpolicy.FieldRequireDigit:     {Type: field.TypeBool, Column: passwordpolicy.FieldRequireDigit},
+			passwordpolicy.FieldRequireSymbol:    {Type: field.TypeBool, Column: passwordpolicy.FieldRequireSymbol},
+			passwordpolicy.FieldHistoryCount:     {Type: field.TypeUint32, Column: passwordpolicy.FieldHistoryCount},
+			passwordpolicy.FieldMaxAgeDays:       {Type: field.TypeUint32, Column: passwordpolicy.FieldMaxAgeDays},
+			passwordpolicy.FieldRejectBreached:   {Type: field.TypeBool, Column: passwordpolicy.FieldRejectBreached},
+		},
+	}
+	graph.Nodes[28] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permission.Table,
 			Columns: permission.Columns,
@@ -694,7 +924,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permission.FieldGroupID:     {Type: field.TypeUint32, Column: permission.FieldGroupID},
 		},
 	}
-	graph.Nodes[22] = &sqlgraph.Node{
+	graph.Nodes[29] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionapi.Table,
 			Columns: permissionapi.Columns,
@@ -715,7 +945,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permissionapi.FieldAPIID:        {Type: field.TypeUint32, Column: permissionapi.FieldAPIID},
 		},
 	}
-	graph.Nodes[23] = &sqlgraph.Node{
+	graph.Nodes[30] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionauditlog.Table,
 			Columns: permissionauditlog.Columns,
@@ -741,7 +971,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permissionauditlog.FieldSignature:  {Type: field.TypeBytes, Column: permissionauditlog.FieldSignature},
 		},
 	}
-	graph.Nodes[24] = &sqlgraph.Node{
+	graph.Nodes[31] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissiongroup.Table,
 			Columns: permissiongroup.Columns,
@@ -767,7 +997,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permissiongroup.FieldModule:      {Type: field.TypeString, Column: permissiongroup.FieldModule},
 		},
 	}
-	graph.Nodes[25] = &sqlgraph.Node{
+	graph.Nodes[32] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionmenu.Table,
 			Columns: permissionmenu.Columns,
@@ -788,7 +1018,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permissionmenu.FieldMenuID:       {Type: field.TypeUint32, Column: permissionmenu.FieldMenuID},
 		},
 	}
-	graph.Nodes[26] = &sqlgraph.Node{
+	graph.Nodes[33] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionpolicy.Table,
 			Columns: permissionpolicy.Columns,
@@ -814,7 +1044,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permissionpolicy.FieldCacheTTL:     {Type: field.TypeUint32, Column: permissionpolicy.FieldCacheTTL},
 		},
 	}
-	graph.Nodes[27] = &sqlgraph.Node{
+	graph.Nodes[34] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   policyevaluationlog.Table,
 			Columns: policyevaluationlog.Columns,
@@ -843,7 +1073,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			policyevaluationlog.FieldSignature:         {Type: field.TypeBytes, Column: policyevaluationlog.FieldSignature},
 		},
 	}
-	graph.Nodes[28] = &sqlgraph.Node{
+	graph.Nodes[35] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   position.Table,
 			Columns: position.Columns,
@@ -879,7 +1109,57 @@ var schemaGraph = func() *sqlgraph.Schema {
 			position.FieldEndAt:               {Type: field.TypeTime, Column: position.FieldEndAt},
 		},
 	}
-	graph.Nodes[29] = &sqlgraph.Node{
+	graph.Nodes[36] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   registrationinvite.Table,
+			Columns: registrationinvite.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: registrationinvite.FieldID,
+			},
+		},
+		Type: "RegistrationInvite",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			registrationinvite.FieldCreatedAt: {Type: field.TypeTime, Column: registrationinvite.FieldCreatedAt},
+			registrationinvite.FieldUpdatedAt: {Type: field.TypeTime, Column: registrationinvite.FieldUpdatedAt},
+			registrationinvite.FieldDeletedAt: {Type: field.TypeTime, Column: registrationinvite.FieldDeletedAt},
+			registrationinvite.FieldCreatedBy: {Type: field.TypeUint32, Column: registrationinvite.FieldCreatedBy},
+			registrationinvite.FieldUpdatedBy: {Type: field.TypeUint32, Column: registrationinvite.FieldUpdatedBy},
+			registrationinvite.FieldDeletedBy: {Type: field.TypeUint32, Column: registrationinvite.FieldDeletedBy},
+			registrationinvite.FieldTenantID:  {Type: field.TypeUint32, Column: registrationinvite.FieldTenantID},
+			registrationinvite.FieldCodeHash:  {Type: field.TypeString, Column: registrationinvite.FieldCodeHash},
+			registrationinvite.FieldEmail:     {Type: field.TypeString, Column: registrationinvite.FieldEmail},
+			registrationinvite.FieldExpiresAt: {Type: field.TypeTime, Column: registrationinvite.FieldExpiresAt},
+			registrationinvite.FieldUsedAt:    {Type: field.TypeTime, Column: registrationinvite.FieldUsedAt},
+			registrationinvite.FieldUsedBy:    {Type: field.TypeUint32, Column: registrationinvite.FieldUsedBy},
+		},
+	}
+	graph.Nodes[37] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   registrationpolicy.Table,
+			Columns: registrationpolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: registrationpolicy.FieldID,
+			},
+		},
+		Type: "RegistrationPolicy",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			registrationpolicy.FieldCreatedAt:                {Type: field.TypeTime, Column: registrationpolicy.FieldCreatedAt},
+			registrationpolicy.FieldUpdatedAt:                {Type: field.TypeTime, Column: registrationpolicy.FieldUpdatedAt},
+			registrationpolicy.FieldDeletedAt:                {Type: field.TypeTime, Column: registrationpolicy.FieldDeletedAt},
+			registrationpolicy.FieldCreatedBy:                {Type: field.TypeUint32, Column: registrationpolicy.FieldCreatedBy},
+			registrationpolicy.FieldUpdatedBy:                {Type: field.TypeUint32, Column: registrationpolicy.FieldUpdatedBy},
+			registrationpolicy.FieldDeletedBy:                {Type: field.TypeUint32, Column: registrationpolicy.FieldDeletedBy},
+			registrationpolicy.FieldTenantID:                 {Type: field.TypeUint32, Column: registrationpolicy.FieldTenantID},
+			registrationpolicy.FieldMode:                     {Type: field.TypeEnum, Column: registrationpolicy.FieldMode},
+			registrationpolicy.FieldAllowedDomains:           {Type: field.TypeJSON, Column: registrationpolicy.FieldAllowedDomains},
+			registrationpolicy.FieldRequireEmailVerification: {Type: field.TypeBool, Column: registrationpolicy.FieldRequireEmailVerification},
+			registrationpolicy.FieldRequireCaptcha:           {Type: field.TypeBool, Column: registrationpolicy.FieldRequireCaptcha},
+			registrationpolicy.FieldDefaultRoleID:            {Type: field.TypeUint32, Column: registrationpolicy.FieldDefaultRoleID},
+		},
+	}
+	graph.Nodes[38] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   role.Table,
 			Columns: role.Columns,
@@ -907,7 +1187,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			role.FieldIsSystem:    {Type: field.TypeBool, Column: role.FieldIsSystem},
 		},
 	}
-	graph.Nodes[30] = &sqlgraph.Node{
+	graph.Nodes[39] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   rolemetadata.Table,
 			Columns: rolemetadata.Columns,
@@ -936,7 +1216,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			rolemetadata.FieldCustomOverrides:   {Type: field.TypeJSON, Column: rolemetadata.FieldCustomOverrides},
 		},
 	}
-	graph.Nodes[31] = &sqlgraph.Node{
+	graph.Nodes[40] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   rolepermission.Table,
 			Columns: rolepermission.Columns,
@@ -961,7 +1241,57 @@ var schemaGraph = func() *sqlgraph.Schema {
 			rolepermission.FieldPriority:     {Type: field.TypeInt32, Column: rolepermission.FieldPriority},
 		},
 	}
-	graph.Nodes[32] = &sqlgraph.Node{
+	graph.Nodes[41] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   scimtoken.Table,
+			Columns: scimtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: scimtoken.FieldID,
+			},
+		},
+		Type: "ScimToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			scimtoken.FieldCreatedAt:   {Type: field.TypeTime, Column: scimtoken.FieldCreatedAt},
+			scimtoken.FieldUpdatedAt:   {Type: field.TypeTime, Column: scimtoken.FieldUpdatedAt},
+			scimtoken.FieldDeletedAt:   {Type: field.TypeTime, Column: scimtoken.FieldDeletedAt},
+			scimtoken.FieldCreatedBy:   {Type: field.TypeUint32, Column: scimtoken.FieldCreatedBy},
+			scimtoken.FieldUpdatedBy:   {Type: field.TypeUint32, Column: scimtoken.FieldUpdatedBy},
+			scimtoken.FieldDeletedBy:   {Type: field.TypeUint32, Column: scimtoken.FieldDeletedBy},
+			scimtoken.FieldTenantID:    {Type: field.TypeUint32, Column: scimtoken.FieldTenantID},
+			scimtoken.FieldName:        {Type: field.TypeString, Column: scimtoken.FieldName},
+			scimtoken.FieldTokenPrefix: {Type: field.TypeString, Column: scimtoken.FieldTokenPrefix},
+			scimtoken.FieldTokenHash:   {Type: field.TypeString, Column: scimtoken.FieldTokenHash},
+			scimtoken.FieldExpiresAt:   {Type: field.TypeTime, Column: scimtoken.FieldExpiresAt},
+			scimtoken.FieldLastUsedAt:  {Type: field.TypeTime, Column: scimtoken.FieldLastUsedAt},
+		},
+	}
+	graph.Nodes[42] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   storagequota.Table,
+			Columns: storagequota.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: storagequota.FieldID,
+			},
+		},
+		Type: "StorageQuota",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			storagequota.FieldCreatedAt: {Type: field.TypeTime, Column: storagequota.FieldCreatedAt},
+			storagequota.FieldUpdatedAt: {Type: field.TypeTime, Column: storagequota.FieldUpdatedAt},
+			storagequota.FieldDeletedAt: {Type: field.TypeTime, Column: storagequota.FieldDeletedAt},
+			storagequota.FieldCreatedBy: {Type: field.TypeUint32, Column: storagequota.FieldCreatedBy},
+			storagequota.FieldUpdatedBy: {Type: field.TypeUint32, Column: storagequota.FieldUpdatedBy},
+			storagequota.FieldDeletedBy: {Type: field.TypeUint32, Column: storagequota.FieldDeletedBy},
+			storagequota.FieldTenantID:  {Type: field.TypeUint32, Column: storagequota.FieldTenantID},
+			storagequota.FieldUserID:    {Type: field.TypeUint32, Column: storagequota.FieldUserID},
+			storagequota.FieldMaxBytes:  {Type: field.TypeUint64, Column: storagequota.FieldMaxBytes},
+			storagequota.FieldMaxFiles:  {Type: field.TypeUint64, Column: storagequota.FieldMaxFiles},
+			storagequota.FieldUsedBytes: {Type: field.TypeUint64, Column: storagequota.FieldUsedBytes},
+			storagequota.FieldUsedFiles: {Type: field.TypeUint64, Column: storagequota.FieldUsedFiles},
+		},
+	}
+	graph.Nodes[43] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   task.Table,
 			Columns: task.Columns,
@@ -986,9 +1316,39 @@ var schemaGraph = func() *sqlgraph.Schema {
 			task.FieldCronSpec:    {Type: field.TypeString, Column: task.FieldCronSpec},
 			task.FieldTaskOptions: {Type: field.TypeJSON, Column: task.FieldTaskOptions},
 			task.FieldEnable:      {Type: field.TypeBool, Column: task.FieldEnable},
+			task.FieldPaused:      {Type: field.TypeBool, Column: task.FieldPaused},
 		},
 	}
-	graph.Nodes[33] = &sqlgraph.Node{
+	graph.Nodes[44] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   taskrun.Table,
+			Columns: taskrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: taskrun.FieldID,
+			},
+		},
+		Type: "TaskRun",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			taskrun.FieldCreatedAt:   {Type: field.TypeTime, Column: taskrun.FieldCreatedAt},
+			taskrun.FieldTenantID:    {Type: field.TypeUint32, Column: taskrun.FieldTenantID},
+			taskrun.FieldTaskID:      {Type: field.TypeUint32, Column: taskrun.FieldTaskID},
+			taskrun.FieldTypeName:    {Type: field.TypeString, Column: taskrun.FieldTypeName},
+			taskrun.FieldQueueTaskID: {Type: field.TypeString, Column: taskrun.FieldQueueTaskID},
+			taskrun.FieldQueue:       {Type: field.TypeString, Column: taskrun.FieldQueue},
+			taskrun.FieldStatus:      {Type: field.TypeEnum, Column: taskrun.FieldStatus},
+			taskrun.FieldRetryCount:  {Type: field.TypeInt32, Column: taskrun.FieldRetryCount},
+			taskrun.FieldMaxRetry:    {Type: field.TypeInt32, Column: taskrun.FieldMaxRetry},
+			taskrun.FieldProgress:    {Type: field.TypeUint32, Column: taskrun.FieldProgress},
+			taskrun.FieldMessage:     {Type: field.TypeString, Column: taskrun.FieldMessage},
+			taskrun.FieldError:       {Type: field.TypeString, Column: taskrun.FieldError},
+			taskrun.FieldResult:      {Type: field.TypeString, Column: taskrun.FieldResult},
+			taskrun.FieldStartedAt:   {Type: field.TypeTime, Column: taskrun.FieldStartedAt},
+			taskrun.FieldFinishedAt:  {Type: field.TypeTime, Column: taskrun.FieldFinishedAt},
+			taskrun.FieldDurationMs:  {Type: field.TypeUint64, Column: taskrun.FieldDurationMs},
+		},
+	}
+	graph.Nodes[45] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   tenant.Table,
 			Columns: tenant.Columns,
@@ -1021,7 +1381,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			tenant.FieldExpiredAt:        {Type: field.TypeTime, Column: tenant.FieldExpiredAt},
 		},
 	}
-	graph.Nodes[34] = &sqlgraph.Node{
+	graph.Nodes[46] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -1051,13 +1411,14 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldRegion:      {Type: field.TypeString, Column: user.FieldRegion},
 			user.FieldDescription: {Type: field.TypeString, Column: user.FieldDescription},
 			user.FieldGender:      {Type: field.TypeEnum, Column: user.FieldGender},
+			user.FieldExternalID:  {Type: field.TypeString, Column: user.FieldExternalID},
 			user.FieldLastLoginAt: {Type: field.TypeTime, Column: user.FieldLastLoginAt},
 			user.FieldLastLoginIP: {Type: field.TypeString, Column: user.FieldLastLoginIP},
 			user.FieldLockedUntil: {Type: field.TypeTime, Column: user.FieldLockedUntil},
 			user.FieldStatus:      {Type: field.TypeEnum, Column: user.FieldStatus},
 		},
 	}
-	graph.Nodes[35] = &sqlgraph.Node{
+	graph.Nodes[47] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   usercredential.Table,
 			Columns: usercredential.Columns,
@@ -1088,9 +1449,10 @@ var schemaGraph = func() *sqlgraph.Schema {
 			usercredential.FieldResetTokenHash:         {Type: field.TypeString, Column: usercredential.FieldResetTokenHash},
 			usercredential.FieldResetTokenExpiresAt:    {Type: field.TypeTime, Column: usercredential.FieldResetTokenExpiresAt},
 			usercredential.FieldResetTokenUsedAt:       {Type: field.TypeTime, Column: usercredential.FieldResetTokenUsedAt},
+			usercredential.FieldPasswordChangedAt:      {Type: field.TypeTime, Column: usercredential.FieldPasswordChangedAt},
 		},
 	}
-	graph.Nodes[36] = &sqlgraph.Node{
+	graph.Nodes[48] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userorgunit.Table,
 			Columns: userorgunit.Columns,
@@ -1120,7 +1482,26 @@ var schemaGraph = func() *sqlgraph.Schema {
 			userorgunit.FieldStatus:     {Type: field.TypeEnum, Column: userorgunit.FieldStatus},
 		},
 	}
-	graph.Nodes[37] = &sqlgraph.Node{
+	graph.Nodes[49] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   userpasswordhistory.Table,
+			Columns: userpasswordhistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: userpasswordhistory.FieldID,
+			},
+		},
+		Type: "UserPasswordHistory",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			userpasswordhistory.FieldCreatedAt:  {Type: field.TypeTime, Column: userpasswordhistory.FieldCreatedAt},
+			userpasswordhistory.FieldUpdatedAt:  {Type: field.TypeTime, Column: userpasswordhistory.FieldUpdatedAt},
+			userpasswordhistory.FieldDeletedAt:  {Type: field.TypeTime, Column: userpasswordhistory.FieldDeletedAt},
+			userpasswordhistory.FieldTenantID:   {Type: field.TypeUint32, Column: userpasswordhistory.FieldTenantID},
+			userpasswordhistory.FieldUserID:     {Type: field.TypeUint32, Column: userpasswordhistory.FieldUserID},
+			userpasswordhistory.FieldCredential: {Type: field.TypeString, Column: userpasswordhistory.FieldCredential},
+		},
+	}
+	graph.Nodes[50] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userposition.Table,
 			Columns: userposition.Columns,
@@ -1149,7 +1530,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			userposition.FieldStatus:     {Type: field.TypeEnum, Column: userposition.FieldStatus},
 		},
 	}
-	graph.Nodes[38] = &sqlgraph.Node{
+	graph.Nodes[51] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userrole.Table,
 			Columns: userrole.Columns,
@@ -1500,6 +1881,16 @@ func (f *ApiAuditLogFilter) WhereUsername(p entql.StringP) {
 	f.Where(p.Field(apiauditlog.FieldUsername))
 }
 
+// WhereActorUserID applies the entql uint32 predicate on the actor_user_id field.
+func (f *ApiAuditLogFilter) WhereActorUserID(p entql.Uint32P) {
+	f.Where(p.Field(apiauditlog.FieldActorUserID))
+}
+
+// WhereActorUsername applies the entql string predicate on the actor_username field.
+func (f *ApiAuditLogFilter) WhereActorUsername(p entql.StringP) {
+	f.Where(p.Field(apiauditlog.FieldActorUsername))
+}
+
 // WhereIPAddress applies the entql string predicate on the ip_address field.
 func (f *ApiAuditLogFilter) WhereIPAddress(p entql.StringP) {
 	f.Where(p.Field(apiauditlog.FieldIPAddress))
@@ -2374,6 +2765,141 @@ func (f *FileFilter) WhereContentHash(p entql.StringP) {
 	f.Where(p.Field(file.FieldContentHash))
 }
 
+// WhereContentType applies the entql string predicate on the content_type field.
+func (f *FileFilter) WhereContentType(p entql.StringP) {
+	f.Where(p.Field(file.FieldContentType))
+}
+
+// WhereScanStatus applies the entql string predicate on the scan_status field.
+func (f *FileFilter) WhereScanStatus(p entql.StringP) {
+	f.Where(p.Field(file.FieldScanStatus))
+}
+
+// WhereScanResult applies the entql string predicate on the scan_result field.
+func (f *FileFilter) WhereScanResult(p entql.StringP) {
+	f.Where(p.Field(file.FieldScanResult))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *FileShareQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the FileShareQuery builder.
+func (_q *FileShareQuery) Filter() *FileShareFilter {
+	return &FileShareFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *FileShareMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the FileShareMutation builder.
+func (m *FileShareMutation) Filter() *FileShareFilter {
+	return &FileShareFilter{config: m.config, predicateAdder: m}
+}
+
+// FileShareFilter provides a generic filtering capability at runtime for FileShareQuery.
+type FileShareFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *FileShareFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *FileShareFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(fileshare.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *FileShareFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(fileshare.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *FileShareFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(fileshare.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *FileShareFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(fileshare.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *FileShareFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(fileshare.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *FileShareFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(fileshare.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *FileShareFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(fileshare.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *FileShareFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(fileshare.FieldTenantID))
+}
+
+// WhereFileID applies the entql uint32 predicate on the file_id field.
+func (f *FileShareFilter) WhereFileID(p entql.Uint32P) {
+	f.Where(p.Field(fileshare.FieldFileID))
+}
+
+// WhereSlug applies the entql string predicate on the slug field.
+func (f *FileShareFilter) WhereSlug(p entql.StringP) {
+	f.Where(p.Field(fileshare.FieldSlug))
+}
+
+// WherePasswordHash applies the entql string predicate on the password_hash field.
+func (f *FileShareFilter) WherePasswordHash(p entql.StringP) {
+	f.Where(p.Field(fileshare.FieldPasswordHash))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *FileShareFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(fileshare.FieldExpiresAt))
+}
+
+// WhereMaxDownloads applies the entql uint32 predicate on the max_downloads field.
+func (f *FileShareFilter) WhereMaxDownloads(p entql.Uint32P) {
+	f.Where(p.Field(fileshare.FieldMaxDownloads))
+}
+
+// WhereDownloadCount applies the entql uint32 predicate on the download_count field.
+func (f *FileShareFilter) WhereDownloadCount(p entql.Uint32P) {
+	f.Where(p.Field(fileshare.FieldDownloadCount))
+}
+
+// WhereAllowedTenantIds applies the entql json.RawMessage predicate on the allowed_tenant_ids field.
+func (f *FileShareFilter) WhereAllowedTenantIds(p entql.BytesP) {
+	f.Where(p.Field(fileshare.FieldAllowedTenantIds))
+}
+
+// WhereAllowedUserIds applies the entql json.RawMessage predicate on the allowed_user_ids field.
+func (f *FileShareFilter) WhereAllowedUserIds(p entql.BytesP) {
+	f.Where(p.Field(fileshare.FieldAllowedUserIds))
+}
+
+// WhereDisabled applies the entql bool predicate on the disabled field.
+func (f *FileShareFilter) WhereDisabled(p entql.BoolP) {
+	f.Where(p.Field(fileshare.FieldDisabled))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *InternalMessageQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -2403,7 +2929,7 @@ type InternalMessageFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *InternalMessageFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2508,7 +3034,7 @@ type InternalMessageCategoryFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *InternalMessageCategoryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2613,7 +3139,7 @@ type InternalMessageRecipientFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *InternalMessageRecipientFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2670,27 +3196,132 @@ func (f *InternalMessageRecipientFilter) WhereReadAt(p entql.TimeP) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *LanguageQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *JwtSigningKeyQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the LanguageQuery builder.
-func (_q *LanguageQuery) Filter() *LanguageFilter {
-	return &LanguageFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the JwtSigningKeyQuery builder.
+func (_q *JwtSigningKeyQuery) Filter() *JwtSigningKeyFilter {
+	return &JwtSigningKeyFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *LanguageMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *JwtSigningKeyMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the LanguageMutation builder.
-func (m *LanguageMutation) Filter() *LanguageFilter {
-	return &LanguageFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the JwtSigningKeyMutation builder.
+func (m *JwtSigningKeyMutation) Filter() *JwtSigningKeyFilter {
+	return &JwtSigningKeyFilter{config: m.config, predicateAdder: m}
 }
 
-// LanguageFilter provides a generic filtering capability at runtime for LanguageQuery.
-type LanguageFilter struct {
+// JwtSigningKeyFilter provides a generic filtering capability at runtime for JwtSigningKeyQuery.
+type JwtSigningKeyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *JwtSigningKeyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *JwtSigningKeyFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(jwtsigningkey.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *JwtSigningKeyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(jwtsigningkey.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *JwtSigningKeyFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(jwtsigningkey.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *JwtSigningKeyFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(jwtsigningkey.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *JwtSigningKeyFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(jwtsigningkey.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *JwtSigningKeyFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(jwtsigningkey.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *JwtSigningKeyFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(jwtsigningkey.FieldDeletedBy))
+}
+
+// WhereKid applies the entql string predicate on the kid field.
+func (f *JwtSigningKeyFilter) WhereKid(p entql.StringP) {
+	f.Where(p.Field(jwtsigningkey.FieldKid))
+}
+
+// WhereAlgorithm applies the entql string predicate on the algorithm field.
+func (f *JwtSigningKeyFilter) WhereAlgorithm(p entql.StringP) {
+	f.Where(p.Field(jwtsigningkey.FieldAlgorithm))
+}
+
+// WherePrivateKey applies the entql string predicate on the private_key field.
+func (f *JwtSigningKeyFilter) WherePrivateKey(p entql.StringP) {
+	f.Where(p.Field(jwtsigningkey.FieldPrivateKey))
+}
+
+// WherePublicKey applies the entql string predicate on the public_key field.
+func (f *JwtSigningKeyFilter) WherePublicKey(p entql.StringP) {
+	f.Where(p.Field(jwtsigningkey.FieldPublicKey))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *JwtSigningKeyFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(jwtsigningkey.FieldStatus))
+}
+
+// WhereRetiredAt applies the entql time.Time predicate on the retired_at field.
+func (f *JwtSigningKeyFilter) WhereRetiredAt(p entql.TimeP) {
+	f.Where(p.Field(jwtsigningkey.FieldRetiredAt))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *JwtSigningKeyFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(jwtsigningkey.FieldExpiresAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *LanguageQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LanguageQuery builder.
+func (_q *LanguageQuery) Filter() *LanguageFilter {
+	return &LanguageFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LanguageMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LanguageMutation builder.
+func (m *LanguageMutation) Filter() *LanguageFilter {
+	return &LanguageFilter{config: m.config, predicateAdder: m}
+}
+
+// LanguageFilter provides a generic filtering capability at runtime for LanguageQuery.
+type LanguageFilter struct {
 	predicateAdder
 	config
 }
@@ -2698,7 +3329,7 @@ type LanguageFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *LanguageFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2769,6 +3400,196 @@ func (f *LanguageFilter) WhereIsDefault(p entql.BoolP) {
 	f.Where(p.Field(language.FieldIsDefault))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *LdapSourceQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LdapSourceQuery builder.
+func (_q *LdapSourceQuery) Filter() *LdapSourceFilter {
+	return &LdapSourceFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LdapSourceMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LdapSourceMutation builder.
+func (m *LdapSourceMutation) Filter() *LdapSourceFilter {
+	return &LdapSourceFilter{config: m.config, predicateAdder: m}
+}
+
+// LdapSourceFilter provides a generic filtering capability at runtime for LdapSourceQuery.
+type LdapSourceFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *LdapSourceFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *LdapSourceFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(ldapsource.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *LdapSourceFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(ldapsource.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *LdapSourceFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(ldapsource.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *LdapSourceFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(ldapsource.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *LdapSourceFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(ldapsource.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *LdapSourceFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(ldapsource.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *LdapSourceFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(ldapsource.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *LdapSourceFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(ldapsource.FieldTenantID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *LdapSourceFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldName))
+}
+
+// WhereEnabled applies the entql bool predicate on the enabled field.
+func (f *LdapSourceFilter) WhereEnabled(p entql.BoolP) {
+	f.Where(p.Field(ldapsource.FieldEnabled))
+}
+
+// WhereURL applies the entql string predicate on the url field.
+func (f *LdapSourceFilter) WhereURL(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldURL))
+}
+
+// WhereStartTLS applies the entql bool predicate on the start_tls field.
+func (f *LdapSourceFilter) WhereStartTLS(p entql.BoolP) {
+	f.Where(p.Field(ldapsource.FieldStartTLS))
+}
+
+// WhereInsecureSkipVerify applies the entql bool predicate on the insecure_skip_verify field.
+func (f *LdapSourceFilter) WhereInsecureSkipVerify(p entql.BoolP) {
+	f.Where(p.Field(ldapsource.FieldInsecureSkipVerify))
+}
+
+// WhereBindDn applies the entql string predicate on the bind_dn field.
+func (f *LdapSourceFilter) WhereBindDn(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldBindDn))
+}
+
+// WhereBindPassword applies the entql string predicate on the bind_password field.
+func (f *LdapSourceFilter) WhereBindPassword(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldBindPassword))
+}
+
+// WhereBaseDn applies the entql string predicate on the base_dn field.
+func (f *LdapSourceFilter) WhereBaseDn(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldBaseDn))
+}
+
+// WhereUserFilter applies the entql string predicate on the user_filter field.
+func (f *LdapSourceFilter) WhereUserFilter(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldUserFilter))
+}
+
+// WhereLoginAttribute applies the entql string predicate on the login_attribute field.
+func (f *LdapSourceFilter) WhereLoginAttribute(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldLoginAttribute))
+}
+
+// WhereEmailAttribute applies the entql string predicate on the email_attribute field.
+func (f *LdapSourceFilter) WhereEmailAttribute(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldEmailAttribute))
+}
+
+// WhereDisplayNameAttribute applies the entql string predicate on the display_name_attribute field.
+func (f *LdapSourceFilter) WhereDisplayNameAttribute(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldDisplayNameAttribute))
+}
+
+// WhereMobileAttribute applies the entql string predicate on the mobile_attribute field.
+func (f *LdapSourceFilter) WhereMobileAttribute(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldMobileAttribute))
+}
+
+// WhereOrgUnitFilter applies the entql string predicate on the org_unit_filter field.
+func (f *LdapSourceFilter) WhereOrgUnitFilter(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldOrgUnitFilter))
+}
+
+// WhereGroupBaseDn applies the entql string predicate on the group_base_dn field.
+func (f *LdapSourceFilter) WhereGroupBaseDn(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldGroupBaseDn))
+}
+
+// WhereGroupFilter applies the entql string predicate on the group_filter field.
+func (f *LdapSourceFilter) WhereGroupFilter(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldGroupFilter))
+}
+
+// WhereGroupMemberAttribute applies the entql string predicate on the group_member_attribute field.
+func (f *LdapSourceFilter) WhereGroupMemberAttribute(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldGroupMemberAttribute))
+}
+
+// WhereGroupRoleMappings applies the entql json.RawMessage predicate on the group_role_mappings field.
+func (f *LdapSourceFilter) WhereGroupRoleMappings(p entql.BytesP) {
+	f.Where(p.Field(ldapsource.FieldGroupRoleMappings))
+}
+
+// WhereDefaultRoleID applies the entql uint32 predicate on the default_role_id field.
+func (f *LdapSourceFilter) WhereDefaultRoleID(p entql.Uint32P) {
+	f.Where(p.Field(ldapsource.FieldDefaultRoleID))
+}
+
+// WhereSyncEnabled applies the entql bool predicate on the sync_enabled field.
+func (f *LdapSourceFilter) WhereSyncEnabled(p entql.BoolP) {
+	f.Where(p.Field(ldapsource.FieldSyncEnabled))
+}
+
+// WhereDisableMissingUsers applies the entql bool predicate on the disable_missing_users field.
+func (f *LdapSourceFilter) WhereDisableMissingUsers(p entql.BoolP) {
+	f.Where(p.Field(ldapsource.FieldDisableMissingUsers))
+}
+
+// WhereLastSyncAt applies the entql time.Time predicate on the last_sync_at field.
+func (f *LdapSourceFilter) WhereLastSyncAt(p entql.TimeP) {
+	f.Where(p.Field(ldapsource.FieldLastSyncAt))
+}
+
+// WhereLastSyncError applies the entql string predicate on the last_sync_error field.
+func (f *LdapSourceFilter) WhereLastSyncError(p entql.StringP) {
+	f.Where(p.Field(ldapsource.FieldLastSyncError))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *LoginAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -2798,7 +3619,7 @@ type LoginAuditLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *LoginAuditLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2938,7 +3759,7 @@ type LoginPolicyFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *LoginPolicyFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3009,6 +3830,221 @@ func (f *LoginPolicyFilter) WhereMethod(p entql.StringP) {
 	f.Where(p.Field(loginpolicy.FieldMethod))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *LuaScriptQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LuaScriptQuery builder.
+func (_q *LuaScriptQuery) Filter() *LuaScriptFilter {
+	return &LuaScriptFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LuaScriptMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LuaScriptMutation builder.
+func (m *LuaScriptMutation) Filter() *LuaScriptFilter {
+	return &LuaScriptFilter{config: m.config, predicateAdder: m}
+}
+
+// LuaScriptFilter provides a generic filtering capability at runtime for LuaScriptQuery.
+type LuaScriptFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *LuaScriptFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *LuaScriptFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(luascript.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *LuaScriptFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(luascript.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *LuaScriptFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(luascript.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *LuaScriptFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(luascript.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *LuaScriptFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(luascript.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *LuaScriptFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(luascript.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *LuaScriptFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(luascript.FieldDeletedBy))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *LuaScriptFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(luascript.FieldName))
+}
+
+// WhereHook applies the entql string predicate on the hook field.
+func (f *LuaScriptFilter) WhereHook(p entql.StringP) {
+	f.Where(p.Field(luascript.FieldHook))
+}
+
+// WhereSource applies the entql string predicate on the source field.
+func (f *LuaScriptFilter) WhereSource(p entql.StringP) {
+	f.Where(p.Field(luascript.FieldSource))
+}
+
+// WherePriority applies the entql int32 predicate on the priority field.
+func (f *LuaScriptFilter) WherePriority(p entql.Int32P) {
+	f.Where(p.Field(luascript.FieldPriority))
+}
+
+// WhereEnabled applies the entql bool predicate on the enabled field.
+func (f *LuaScriptFilter) WhereEnabled(p entql.BoolP) {
+	f.Where(p.Field(luascript.FieldEnabled))
+}
+
+// WhereCritical applies the entql bool predicate on the critical field.
+func (f *LuaScriptFilter) WhereCritical(p entql.BoolP) {
+	f.Where(p.Field(luascript.FieldCritical))
+}
+
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *LuaScriptFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(luascript.FieldVersion))
+}
+
+// WhereAuthor applies the entql string predicate on the author field.
+func (f *LuaScriptFilter) WhereAuthor(p entql.StringP) {
+	f.Where(p.Field(luascript.FieldAuthor))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *LuaScriptFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(luascript.FieldDescription))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *LuaScriptVersionQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LuaScriptVersionQuery builder.
+func (_q *LuaScriptVersionQuery) Filter() *LuaScriptVersionFilter {
+	return &LuaScriptVersionFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LuaScriptVersionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LuaScriptVersionMutation builder.
+func (m *LuaScriptVersionMutation) Filter() *LuaScriptVersionFilter {
+	return &LuaScriptVersionFilter{config: m.config, predicateAdder: m}
+}
+
+// LuaScriptVersionFilter provides a generic filtering capability at runtime for LuaScriptVersionQuery.
+type LuaScriptVersionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *LuaScriptVersionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *LuaScriptVersionFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(luascriptversion.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *LuaScriptVersionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(luascriptversion.FieldCreatedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *LuaScriptVersionFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(luascriptversion.FieldCreatedBy))
+}
+
+// WhereScriptID applies the entql uint32 predicate on the script_id field.
+func (f *LuaScriptVersionFilter) WhereScriptID(p entql.Uint32P) {
+	f.Where(p.Field(luascriptversion.FieldScriptID))
+}
+
+// WhereVersion applies the entql uint32 predicate on the version field.
+func (f *LuaScriptVersionFilter) WhereVersion(p entql.Uint32P) {
+	f.Where(p.Field(luascriptversion.FieldVersion))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *LuaScriptVersionFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(luascriptversion.FieldName))
+}
+
+// WhereHook applies the entql string predicate on the hook field.
+func (f *LuaScriptVersionFilter) WhereHook(p entql.StringP) {
+	f.Where(p.Field(luascriptversion.FieldHook))
+}
+
+// WhereSource applies the entql string predicate on the source field.
+func (f *LuaScriptVersionFilter) WhereSource(p entql.StringP) {
+	f.Where(p.Field(luascriptversion.FieldSource))
+}
+
+// WherePriority applies the entql int32 predicate on the priority field.
+func (f *LuaScriptVersionFilter) WherePriority(p entql.Int32P) {
+	f.Where(p.Field(luascriptversion.FieldPriority))
+}
+
+// WhereEnabled applies the entql bool predicate on the enabled field.
+func (f *LuaScriptVersionFilter) WhereEnabled(p entql.BoolP) {
+	f.Where(p.Field(luascriptversion.FieldEnabled))
+}
+
+// WhereCritical applies the entql bool predicate on the critical field.
+func (f *LuaScriptVersionFilter) WhereCritical(p entql.BoolP) {
+	f.Where(p.Field(luascriptversion.FieldCritical))
+}
+
+// WhereAuthor applies the entql string predicate on the author field.
+func (f *LuaScriptVersionFilter) WhereAuthor(p entql.StringP) {
+	f.Where(p.Field(luascriptversion.FieldAuthor))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *LuaScriptVersionFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(luascriptversion.FieldDescription))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *MembershipQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -3038,7 +4074,7 @@ type MembershipFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MembershipFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3173,7 +4209,7 @@ type MembershipOrgUnitFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MembershipOrgUnitFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3303,7 +4339,7 @@ type MembershipPositionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MembershipPositionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3423,7 +4459,7 @@ type MembershipRoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MembershipRoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3538,7 +4574,7 @@ type MenuFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MenuFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3599,62 +4635,202 @@ func (f *MenuFilter) WhereType(p entql.StringP) {
 	f.Where(p.Field(menu.FieldType))
 }
 
-// WherePath applies the entql string predicate on the path field.
-func (f *MenuFilter) WherePath(p entql.StringP) {
-	f.Where(p.Field(menu.FieldPath))
+// WherePath applies the entql string predicate on the path field.
+func (f *MenuFilter) WherePath(p entql.StringP) {
+	f.Where(p.Field(menu.FieldPath))
+}
+
+// WhereRedirect applies the entql string predicate on the redirect field.
+func (f *MenuFilter) WhereRedirect(p entql.StringP) {
+	f.Where(p.Field(menu.FieldRedirect))
+}
+
+// WhereAlias applies the entql string predicate on the alias field.
+func (f *MenuFilter) WhereAlias(p entql.StringP) {
+	f.Where(p.Field(menu.FieldAlias))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *MenuFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(menu.FieldName))
+}
+
+// WhereComponent applies the entql string predicate on the component field.
+func (f *MenuFilter) WhereComponent(p entql.StringP) {
+	f.Where(p.Field(menu.FieldComponent))
+}
+
+// WhereMeta applies the entql json.RawMessage predicate on the meta field.
+func (f *MenuFilter) WhereMeta(p entql.BytesP) {
+	f.Where(p.Field(menu.FieldMeta))
+}
+
+// WhereHasParent applies a predicate to check if query has an edge parent.
+func (f *MenuFilter) WhereHasParent() {
+	f.Where(entql.HasEdge("parent"))
+}
+
+// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
+func (f *MenuFilter) WhereHasParentWith(preds ...predicate.Menu) {
+	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasChildren applies a predicate to check if query has an edge children.
+func (f *MenuFilter) WhereHasChildren() {
+	f.Where(entql.HasEdge("children"))
+}
+
+// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
+func (f *MenuFilter) WhereHasChildrenWith(preds ...predicate.Menu) {
+	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *OAuthClientQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OAuthClientQuery builder.
+func (_q *OAuthClientQuery) Filter() *OAuthClientFilter {
+	return &OAuthClientFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OAuthClientMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OAuthClientMutation builder.
+func (m *OAuthClientMutation) Filter() *OAuthClientFilter {
+	return &OAuthClientFilter{config: m.config, predicateAdder: m}
+}
+
+// OAuthClientFilter provides a generic filtering capability at runtime for OAuthClientQuery.
+type OAuthClientFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OAuthClientFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *OAuthClientFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(oauthclient.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OAuthClientFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthclient.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *OAuthClientFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthclient.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *OAuthClientFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthclient.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *OAuthClientFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(oauthclient.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *OAuthClientFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(oauthclient.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *OAuthClientFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(oauthclient.FieldDeletedBy))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *OAuthClientFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldStatus))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *OAuthClientFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(oauthclient.FieldTenantID))
+}
+
+// WhereClientID applies the entql string predicate on the client_id field.
+func (f *OAuthClientFilter) WhereClientID(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldClientID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *OAuthClientFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldName))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *OAuthClientFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldDescription))
 }
 
-// WhereRedirect applies the entql string predicate on the redirect field.
-func (f *MenuFilter) WhereRedirect(p entql.StringP) {
-	f.Where(p.Field(menu.FieldRedirect))
+// WhereSecretHash applies the entql string predicate on the secret_hash field.
+func (f *OAuthClientFilter) WhereSecretHash(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldSecretHash))
 }
 
-// WhereAlias applies the entql string predicate on the alias field.
-func (f *MenuFilter) WhereAlias(p entql.StringP) {
-	f.Where(p.Field(menu.FieldAlias))
+// WherePreviousSecretHash applies the entql string predicate on the previous_secret_hash field.
+func (f *OAuthClientFilter) WherePreviousSecretHash(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldPreviousSecretHash))
 }
 
-// WhereName applies the entql string predicate on the name field.
-func (f *MenuFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(menu.FieldName))
+// WherePreviousSecretExpiresAt applies the entql time.Time predicate on the previous_secret_expires_at field.
+func (f *OAuthClientFilter) WherePreviousSecretExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(oauthclient.FieldPreviousSecretExpiresAt))
 }
 
-// WhereComponent applies the entql string predicate on the component field.
-func (f *MenuFilter) WhereComponent(p entql.StringP) {
-	f.Where(p.Field(menu.FieldComponent))
+// WhereScopes applies the entql json.RawMessage predicate on the scopes field.
+func (f *OAuthClientFilter) WhereScopes(p entql.BytesP) {
+	f.Where(p.Field(oauthclient.FieldScopes))
 }
 
-// WhereMeta applies the entql json.RawMessage predicate on the meta field.
-func (f *MenuFilter) WhereMeta(p entql.BytesP) {
-	f.Where(p.Field(menu.FieldMeta))
+// WhereTokenTTL applies the entql uint32 predicate on the token_ttl field.
+func (f *OAuthClientFilter) WhereTokenTTL(p entql.Uint32P) {
+	f.Where(p.Field(oauthclient.FieldTokenTTL))
 }
 
-// WhereHasParent applies a predicate to check if query has an edge parent.
-func (f *MenuFilter) WhereHasParent() {
-	f.Where(entql.HasEdge("parent"))
+// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
+func (f *OAuthClientFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthclient.FieldLastUsedAt))
 }
 
-// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
-func (f *MenuFilter) WhereHasParentWith(preds ...predicate.Menu) {
-	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereSecretVersion applies the entql uint32 predicate on the secret_version field.
+func (f *OAuthClientFilter) WhereSecretVersion(p entql.Uint32P) {
+	f.Where(p.Field(oauthclient.FieldSecretVersion))
 }
 
-// WhereHasChildren applies a predicate to check if query has an edge children.
-func (f *MenuFilter) WhereHasChildren() {
-	f.Where(entql.HasEdge("children"))
+// WhereDataScope applies the entql string predicate on the data_scope field.
+func (f *OAuthClientFilter) WhereDataScope(p entql.StringP) {
+	f.Where(p.Field(oauthclient.FieldDataScope))
 }
 
-// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
-func (f *MenuFilter) WhereHasChildrenWith(preds ...predicate.Menu) {
-	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereOrgUnitID applies the entql uint32 predicate on the org_unit_id field.
+func (f *OAuthClientFilter) WhereOrgUnitID(p entql.Uint32P) {
+	f.Where(p.Field(oauthclient.FieldOrgUnitID))
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -3686,7 +4862,7 @@ type OperationAuditLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *OperationAuditLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[25].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3821,7 +4997,7 @@ type OrgUnitFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *OrgUnitFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[26].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4030,6 +5206,121 @@ func (f *OrgUnitFilter) WhereHasChildrenWith(preds ...predicate.OrgUnit) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *PasswordPolicyQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PasswordPolicyQuery builder.
+func (_q *PasswordPolicyQuery) Filter() *PasswordPolicyFilter {
+	return &PasswordPolicyFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PasswordPolicyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PasswordPolicyMutation builder.
+func (m *PasswordPolicyMutation) Filter() *PasswordPolicyFilter {
+	return &PasswordPolicyFilter{config: m.config, predicateAdder: m}
+}
+
+// PasswordPolicyFilter provides a generic filtering capability at runtime for PasswordPolicyQuery.
+type PasswordPolicyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PasswordPolicyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[27].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *PasswordPolicyFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PasswordPolicyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordpolicy.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *PasswordPolicyFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordpolicy.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *PasswordPolicyFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordpolicy.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *PasswordPolicyFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *PasswordPolicyFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *PasswordPolicyFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *PasswordPolicyFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldTenantID))
+}
+
+// WhereMinLength applies the entql uint32 predicate on the min_length field.
+func (f *PasswordPolicyFilter) WhereMinLength(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldMinLength))
+}
+
+// WhereRequireUppercase applies the entql bool predicate on the require_uppercase field.
+func (f *PasswordPolicyFilter) WhereRequireUppercase(p entql.BoolP) {
+	f.Where(p.Field(passwordpolicy.FieldRequireUppercase))
+}
+
+// WhereRequireLowercase applies the entql bool predicate on the require_lowercase field.
+func (f *PasswordPolicyFilter) WhereRequireLowercase(p entql.BoolP) {
+	f.Where(p.Field(passwordpolicy.FieldRequireLowercase))
+}
+
+// WhereRequireDigit applies the entql bool predicate on the require_digit field.
+func (f *PasswordPolicyFilter) WhereRequireDigit(p entql.BoolP) {
+	f.Where(p.Field(passwordpolicy.FieldRequireDigit))
+}
+
+// WhereRequireSymbol applies the entql bool predicate on the require_symbol field.
+func (f *PasswordPolicyFilter) WhereRequireSymbol(p entql.BoolP) {
+	f.Where(p.Field(passwordpolicy.FieldRequireSymbol))
+}
+
+// WhereHistoryCount applies the entql uint32 predicate on the history_count field.
+func (f *PasswordPolicyFilter) WhereHistoryCount(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldHistoryCount))
+}
+
+// WhereMaxAgeDays applies the entql uint32 predicate on the max_age_days field.
+func (f *PasswordPolicyFilter) WhereMaxAgeDays(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldMaxAgeDays))
+}
+
+// WhereRejectBreached applies the entql bool predicate on the reject_breached field.
+func (f *PasswordPolicyFilter) WhereRejectBreached(p entql.BoolP) {
+	f.Where(p.Field(passwordpolicy.FieldRejectBreached))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *PermissionQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -4059,7 +5350,7 @@ type PermissionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[28].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4154,7 +5445,7 @@ type PermissionApiFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionApiFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[29].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4234,7 +5525,7 @@ type PermissionAuditLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionAuditLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[30].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4339,7 +5630,7 @@ type PermissionGroupFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionGroupFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[31].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4472,7 +5763,7 @@ type PermissionMenuFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionMenuFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[25].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[32].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4552,7 +5843,7 @@ type PermissionPolicyFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionPolicyFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[26].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[33].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4657,7 +5948,7 @@ type PolicyEvaluationLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PolicyEvaluationLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[27].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[34].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4777,7 +6068,7 @@ type PositionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PositionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[28].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[35].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4878,29 +6169,229 @@ func (f *PositionFilter) WhereLevel(p entql.Int32P) {
 	f.Where(p.Field(position.FieldLevel))
 }
 
-// WhereHeadcount applies the entql uint32 predicate on the headcount field.
-func (f *PositionFilter) WhereHeadcount(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldHeadcount))
+// WhereHeadcount applies the entql uint32 predicate on the headcount field.
+func (f *PositionFilter) WhereHeadcount(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldHeadcount))
+}
+
+// WhereIsKeyPosition applies the entql bool predicate on the is_key_position field.
+func (f *PositionFilter) WhereIsKeyPosition(p entql.BoolP) {
+	f.Where(p.Field(position.FieldIsKeyPosition))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *PositionFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(position.FieldType))
+}
+
+// WhereStartAt applies the entql time.Time predicate on the start_at field.
+func (f *PositionFilter) WhereStartAt(p entql.TimeP) {
+	f.Where(p.Field(position.FieldStartAt))
+}
+
+// WhereEndAt applies the entql time.Time predicate on the end_at field.
+func (f *PositionFilter) WhereEndAt(p entql.TimeP) {
+	f.Where(p.Field(position.FieldEndAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *RegistrationInviteQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RegistrationInviteQuery builder.
+func (_q *RegistrationInviteQuery) Filter() *RegistrationInviteFilter {
+	return &RegistrationInviteFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RegistrationInviteMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RegistrationInviteMutation builder.
+func (m *RegistrationInviteMutation) Filter() *RegistrationInviteFilter {
+	return &RegistrationInviteFilter{config: m.config, predicateAdder: m}
+}
+
+// RegistrationInviteFilter provides a generic filtering capability at runtime for RegistrationInviteQuery.
+type RegistrationInviteFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RegistrationInviteFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[36].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *RegistrationInviteFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(registrationinvite.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RegistrationInviteFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(registrationinvite.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *RegistrationInviteFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(registrationinvite.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *RegistrationInviteFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(registrationinvite.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *RegistrationInviteFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(registrationinvite.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *RegistrationInviteFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(registrationinvite.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *RegistrationInviteFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(registrationinvite.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *RegistrationInviteFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(registrationinvite.FieldTenantID))
+}
+
+// WhereCodeHash applies the entql string predicate on the code_hash field.
+func (f *RegistrationInviteFilter) WhereCodeHash(p entql.StringP) {
+	f.Where(p.Field(registrationinvite.FieldCodeHash))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *RegistrationInviteFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(registrationinvite.FieldEmail))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *RegistrationInviteFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(registrationinvite.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *RegistrationInviteFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(registrationinvite.FieldUsedAt))
+}
+
+// WhereUsedBy applies the entql uint32 predicate on the used_by field.
+func (f *RegistrationInviteFilter) WhereUsedBy(p entql.Uint32P) {
+	f.Where(p.Field(registrationinvite.FieldUsedBy))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *RegistrationPolicyQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RegistrationPolicyQuery builder.
+func (_q *RegistrationPolicyQuery) Filter() *RegistrationPolicyFilter {
+	return &RegistrationPolicyFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RegistrationPolicyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RegistrationPolicyMutation builder.
+func (m *RegistrationPolicyMutation) Filter() *RegistrationPolicyFilter {
+	return &RegistrationPolicyFilter{config: m.config, predicateAdder: m}
+}
+
+// RegistrationPolicyFilter provides a generic filtering capability at runtime for RegistrationPolicyQuery.
+type RegistrationPolicyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RegistrationPolicyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[37].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *RegistrationPolicyFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(registrationpolicy.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RegistrationPolicyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(registrationpolicy.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *RegistrationPolicyFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(registrationpolicy.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *RegistrationPolicyFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(registrationpolicy.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *RegistrationPolicyFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(registrationpolicy.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *RegistrationPolicyFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(registrationpolicy.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *RegistrationPolicyFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(registrationpolicy.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *RegistrationPolicyFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(registrationpolicy.FieldTenantID))
+}
+
+// WhereMode applies the entql string predicate on the mode field.
+func (f *RegistrationPolicyFilter) WhereMode(p entql.StringP) {
+	f.Where(p.Field(registrationpolicy.FieldMode))
 }
 
-// WhereIsKeyPosition applies the entql bool predicate on the is_key_position field.
-func (f *PositionFilter) WhereIsKeyPosition(p entql.BoolP) {
-	f.Where(p.Field(position.FieldIsKeyPosition))
+// WhereAllowedDomains applies the entql json.RawMessage predicate on the allowed_domains field.
+func (f *RegistrationPolicyFilter) WhereAllowedDomains(p entql.BytesP) {
+	f.Where(p.Field(registrationpolicy.FieldAllowedDomains))
 }
 
-// WhereType applies the entql string predicate on the type field.
-func (f *PositionFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(position.FieldType))
+// WhereRequireEmailVerification applies the entql bool predicate on the require_email_verification field.
+func (f *RegistrationPolicyFilter) WhereRequireEmailVerification(p entql.BoolP) {
+	f.Where(p.Field(registrationpolicy.FieldRequireEmailVerification))
 }
 
-// WhereStartAt applies the entql time.Time predicate on the start_at field.
-func (f *PositionFilter) WhereStartAt(p entql.TimeP) {
-	f.Where(p.Field(position.FieldStartAt))
+// WhereRequireCaptcha applies the entql bool predicate on the require_captcha field.
+func (f *RegistrationPolicyFilter) WhereRequireCaptcha(p entql.BoolP) {
+	f.Where(p.Field(registrationpolicy.FieldRequireCaptcha))
 }
 
-// WhereEndAt applies the entql time.Time predicate on the end_at field.
-func (f *PositionFilter) WhereEndAt(p entql.TimeP) {
-	f.Where(p.Field(position.FieldEndAt))
+// WhereDefaultRoleID applies the entql uint32 predicate on the default_role_id field.
+func (f *RegistrationPolicyFilter) WhereDefaultRoleID(p entql.Uint32P) {
+	f.Where(p.Field(registrationpolicy.FieldDefaultRoleID))
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -4932,7 +6423,7 @@ type RoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[29].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[38].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5047,7 +6538,7 @@ type RoleMetadataFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleMetadataFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[30].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[39].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5167,7 +6658,7 @@ type RolePermissionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RolePermissionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[31].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[40].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5238,6 +6729,206 @@ func (f *RolePermissionFilter) WherePriority(p entql.Int32P) {
 	f.Where(p.Field(rolepermission.FieldPriority))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *ScimTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ScimTokenQuery builder.
+func (_q *ScimTokenQuery) Filter() *ScimTokenFilter {
+	return &ScimTokenFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ScimTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ScimTokenMutation builder.
+func (m *ScimTokenMutation) Filter() *ScimTokenFilter {
+	return &ScimTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// ScimTokenFilter provides a generic filtering capability at runtime for ScimTokenQuery.
+type ScimTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ScimTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[41].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *ScimTokenFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(scimtoken.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ScimTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(scimtoken.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *ScimTokenFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(scimtoken.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *ScimTokenFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(scimtoken.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *ScimTokenFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(scimtoken.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *ScimTokenFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(scimtoken.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *ScimTokenFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(scimtoken.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *ScimTokenFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(scimtoken.FieldTenantID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *ScimTokenFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(scimtoken.FieldName))
+}
+
+// WhereTokenPrefix applies the entql string predicate on the token_prefix field.
+func (f *ScimTokenFilter) WhereTokenPrefix(p entql.StringP) {
+	f.Where(p.Field(scimtoken.FieldTokenPrefix))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *ScimTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(scimtoken.FieldTokenHash))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *ScimTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(scimtoken.FieldExpiresAt))
+}
+
+// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
+func (f *ScimTokenFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(scimtoken.FieldLastUsedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *StorageQuotaQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the StorageQuotaQuery builder.
+func (_q *StorageQuotaQuery) Filter() *StorageQuotaFilter {
+	return &StorageQuotaFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *StorageQuotaMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the StorageQuotaMutation builder.
+func (m *StorageQuotaMutation) Filter() *StorageQuotaFilter {
+	return &StorageQuotaFilter{config: m.config, predicateAdder: m}
+}
+
+// StorageQuotaFilter provides a generic filtering capability at runtime for StorageQuotaQuery.
+type StorageQuotaFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *StorageQuotaFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[42].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *StorageQuotaFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *StorageQuotaFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(storagequota.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *StorageQuotaFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(storagequota.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *StorageQuotaFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(storagequota.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *StorageQuotaFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *StorageQuotaFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *StorageQuotaFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *StorageQuotaFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldTenantID))
+}
+
+// WhereUserID applies the entql uint32 predicate on the user_id field.
+func (f *StorageQuotaFilter) WhereUserID(p entql.Uint32P) {
+	f.Where(p.Field(storagequota.FieldUserID))
+}
+
+// WhereMaxBytes applies the entql uint64 predicate on the max_bytes field.
+func (f *StorageQuotaFilter) WhereMaxBytes(p entql.Uint64P) {
+	f.Where(p.Field(storagequota.FieldMaxBytes))
+}
+
+// WhereMaxFiles applies the entql uint64 predicate on the max_files field.
+func (f *StorageQuotaFilter) WhereMaxFiles(p entql.Uint64P) {
+	f.Where(p.Field(storagequota.FieldMaxFiles))
+}
+
+// WhereUsedBytes applies the entql uint64 predicate on the used_bytes field.
+func (f *StorageQuotaFilter) WhereUsedBytes(p entql.Uint64P) {
+	f.Where(p.Field(storagequota.FieldUsedBytes))
+}
+
+// WhereUsedFiles applies the entql uint64 predicate on the used_files field.
+func (f *StorageQuotaFilter) WhereUsedFiles(p entql.Uint64P) {
+	f.Where(p.Field(storagequota.FieldUsedFiles))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *TaskQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -5267,7 +6958,7 @@ type TaskFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TaskFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[32].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[43].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5348,6 +7039,131 @@ func (f *TaskFilter) WhereEnable(p entql.BoolP) {
 	f.Where(p.Field(task.FieldEnable))
 }
 
+// WherePaused applies the entql bool predicate on the paused field.
+func (f *TaskFilter) WherePaused(p entql.BoolP) {
+	f.Where(p.Field(task.FieldPaused))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *TaskRunQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TaskRunQuery builder.
+func (_q *TaskRunQuery) Filter() *TaskRunFilter {
+	return &TaskRunFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TaskRunMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TaskRunMutation builder.
+func (m *TaskRunMutation) Filter() *TaskRunFilter {
+	return &TaskRunFilter{config: m.config, predicateAdder: m}
+}
+
+// TaskRunFilter provides a generic filtering capability at runtime for TaskRunQuery.
+type TaskRunFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TaskRunFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[44].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *TaskRunFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TaskRunFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldCreatedAt))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *TaskRunFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldTenantID))
+}
+
+// WhereTaskID applies the entql uint32 predicate on the task_id field.
+func (f *TaskRunFilter) WhereTaskID(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldTaskID))
+}
+
+// WhereTypeName applies the entql string predicate on the type_name field.
+func (f *TaskRunFilter) WhereTypeName(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldTypeName))
+}
+
+// WhereQueueTaskID applies the entql string predicate on the queue_task_id field.
+func (f *TaskRunFilter) WhereQueueTaskID(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldQueueTaskID))
+}
+
+// WhereQueue applies the entql string predicate on the queue field.
+func (f *TaskRunFilter) WhereQueue(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldQueue))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *TaskRunFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldStatus))
+}
+
+// WhereRetryCount applies the entql int32 predicate on the retry_count field.
+func (f *TaskRunFilter) WhereRetryCount(p entql.Int32P) {
+	f.Where(p.Field(taskrun.FieldRetryCount))
+}
+
+// WhereMaxRetry applies the entql int32 predicate on the max_retry field.
+func (f *TaskRunFilter) WhereMaxRetry(p entql.Int32P) {
+	f.Where(p.Field(taskrun.FieldMaxRetry))
+}
+
+// WhereProgress applies the entql uint32 predicate on the progress field.
+func (f *TaskRunFilter) WhereProgress(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldProgress))
+}
+
+// WhereMessage applies the entql string predicate on the message field.
+func (f *TaskRunFilter) WhereMessage(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldMessage))
+}
+
+// WhereError applies the entql string predicate on the error field.
+func (f *TaskRunFilter) WhereError(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldError))
+}
+
+// WhereResult applies the entql string predicate on the result field.
+func (f *TaskRunFilter) WhereResult(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldResult))
+}
+
+// WhereStartedAt applies the entql time.Time predicate on the started_at field.
+func (f *TaskRunFilter) WhereStartedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldStartedAt))
+}
+
+// WhereFinishedAt applies the entql time.Time predicate on the finished_at field.
+func (f *TaskRunFilter) WhereFinishedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldFinishedAt))
+}
+
+// WhereDurationMs applies the entql uint64 predicate on the duration_ms field.
+func (f *TaskRunFilter) WhereDurationMs(p entql.Uint64P) {
+	f.Where(p.Field(taskrun.FieldDurationMs))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *TenantQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -5377,7 +7193,7 @@ type TenantFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TenantFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[33].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[45].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5517,7 +7333,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[34].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[46].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5623,6 +7439,11 @@ func (f *UserFilter) WhereGender(p entql.StringP) {
 	f.Where(p.Field(user.FieldGender))
 }
 
+// WhereExternalID applies the entql string predicate on the external_id field.
+func (f *UserFilter) WhereExternalID(p entql.StringP) {
+	f.Where(p.Field(user.FieldExternalID))
+}
+
 // WhereLastLoginAt applies the entql time.Time predicate on the last_login_at field.
 func (f *UserFilter) WhereLastLoginAt(p entql.TimeP) {
 	f.Where(p.Field(user.FieldLastLoginAt))
@@ -5672,7 +7493,7 @@ type UserCredentialFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserCredentialFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[35].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[47].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5783,6 +7604,11 @@ func (f *UserCredentialFilter) WhereResetTokenUsedAt(p entql.TimeP) {
 	f.Where(p.Field(usercredential.FieldResetTokenUsedAt))
 }
 
+// WherePasswordChangedAt applies the entql time.Time predicate on the password_changed_at field.
+func (f *UserCredentialFilter) WherePasswordChangedAt(p entql.TimeP) {
+	f.Where(p.Field(usercredential.FieldPasswordChangedAt))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *UserOrgUnitQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -5812,7 +7638,7 @@ type UserOrgUnitFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserOrgUnitFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[36].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[48].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5908,6 +7734,76 @@ func (f *UserOrgUnitFilter) WhereStatus(p entql.StringP) {
 	f.Where(p.Field(userorgunit.FieldStatus))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *UserPasswordHistoryQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the UserPasswordHistoryQuery builder.
+func (_q *UserPasswordHistoryQuery) Filter() *UserPasswordHistoryFilter {
+	return &UserPasswordHistoryFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *UserPasswordHistoryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the UserPasswordHistoryMutation builder.
+func (m *UserPasswordHistoryMutation) Filter() *UserPasswordHistoryFilter {
+	return &UserPasswordHistoryFilter{config: m.config, predicateAdder: m}
+}
+
+// UserPasswordHistoryFilter provides a generic filtering capability at runtime for UserPasswordHistoryQuery.
+type UserPasswordHistoryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *UserPasswordHistoryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[49].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *UserPasswordHistoryFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(userpasswordhistory.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *UserPasswordHistoryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(userpasswordhistory.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *UserPasswordHistoryFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(userpasswordhistory.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *UserPasswordHistoryFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(userpasswordhistory.FieldDeletedAt))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *UserPasswordHistoryFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(userpasswordhistory.FieldTenantID))
+}
+
+// WhereUserID applies the entql uint32 predicate on the user_id field.
+func (f *UserPasswordHistoryFilter) WhereUserID(p entql.Uint32P) {
+	f.Where(p.Field(userpasswordhistory.FieldUserID))
+}
+
+// WhereCredential applies the entql string predicate on the credential field.
+func (f *UserPasswordHistoryFilter) WhereCredential(p entql.StringP) {
+	f.Where(p.Field(userpasswordhistory.FieldCredential))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *UserPositionQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -5937,7 +7833,7 @@ type UserPositionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserPositionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[37].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[50].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -6057,7 +7953,7 @@ type UserRoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserRoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[38].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[51].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
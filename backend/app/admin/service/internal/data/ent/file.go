@@ -55,7 +55,13 @@ type File struct {
 	// 链接地址
 	LinkURL *string `json:"link_url,omitempty"`
 	// 文件内容hash值，防止上传重复文件
-	ContentHash  *string `json:"content_hash,omitempty"`
+	ContentHash *string `json:"content_hash,omitempty"`
+	// 文件内容类型（MIME）
+	ContentType *string `json:"content_type,omitempty"`
+	// 内容扫描状态
+	ScanStatus *file.ScanStatus `json:"scan_status,omitempty"`
+	// 扫描结果，如命中的威胁特征或扫描失败原因
+	ScanResult   *string `json:"scan_result,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -66,7 +72,7 @@ func (*File) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case file.FieldID, file.FieldCreatedBy, file.FieldUpdatedBy, file.FieldDeletedBy, file.FieldTenantID, file.FieldSize:
 			values[i] = new(sql.NullInt64)
-		case file.FieldRemark, file.FieldProvider, file.FieldBucketName, file.FieldFileDirectory, file.FieldFileGUID, file.FieldSaveFileName, file.FieldFileName, file.FieldExtension, file.FieldSizeFormat, file.FieldLinkURL, file.FieldContentHash:
+		case file.FieldRemark, file.FieldProvider, file.FieldBucketName, file.FieldFileDirectory, file.FieldFileGUID, file.FieldSaveFileName, file.FieldFileName, file.FieldExtension, file.FieldSizeFormat, file.FieldLinkURL, file.FieldContentHash, file.FieldContentType, file.FieldScanStatus, file.FieldScanResult:
 			values[i] = new(sql.NullString)
 		case file.FieldCreatedAt, file.FieldUpdatedAt, file.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -224,6 +230,27 @@ func (_m *File) assignValues(columns []string, values []any) error {
 				_m.ContentHash = new(string)
 				*_m.ContentHash = value.String
 			}
+		case file.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				_m.ContentType = new(string)
+				*_m.ContentType = value.String
+			}
+		case file.FieldScanStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_status", values[i])
+			} else if value.Valid {
+				_m.ScanStatus = new(file.ScanStatus)
+				*_m.ScanStatus = file.ScanStatus(value.String)
+			}
+		case file.FieldScanResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_result", values[i])
+			} else if value.Valid {
+				_m.ScanResult = new(string)
+				*_m.ScanResult = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -354,6 +381,21 @@ func (_m *File) String() string {
 		builder.WriteString("content_hash=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.ContentType; v != nil {
+		builder.WriteString("content_type=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ScanStatus; v != nil {
+		builder.WriteString("scan_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ScanResult; v != nil {
+		builder.WriteString("scan_result=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
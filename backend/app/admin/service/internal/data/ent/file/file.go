@@ -52,6 +52,12 @@ const (
 	FieldLinkURL = "link_url"
 	// FieldContentHash holds the string denoting the content_hash field in the database.
 	FieldContentHash = "content_hash"
+	// FieldContentType holds the string denoting the content_type field in the database.
+	FieldContentType = "content_type"
+	// FieldScanStatus holds the string denoting the scan_status field in the database.
+	FieldScanStatus = "scan_status"
+	// FieldScanResult holds the string denoting the scan_result field in the database.
+	FieldScanResult = "scan_result"
 	// Table holds the table name of the file in the database.
 	Table = "files"
 )
@@ -78,6 +84,9 @@ var Columns = []string{
 	FieldSizeFormat,
 	FieldLinkURL,
 	FieldContentHash,
+	FieldContentType,
+	FieldScanStatus,
+	FieldScanResult,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -139,6 +148,33 @@ func ProviderValidator(pr Provider) error {
 	}
 }
 
+// ScanStatus defines the type for the "scan_status" enum field.
+type ScanStatus string
+
+// ScanStatusUnscanned is the default value of the ScanStatus enum.
+const DefaultScanStatus = ScanStatusUnscanned
+
+// ScanStatus values.
+const (
+	ScanStatusUnscanned   ScanStatus = "UNSCANNED"
+	ScanStatusClean       ScanStatus = "CLEAN"
+	ScanStatusQuarantined ScanStatus = "QUARANTINED"
+)
+
+func (ss ScanStatus) String() string {
+	return string(ss)
+}
+
+// ScanStatusValidator is a validator for the "scan_status" field enum values. It is called by the builders before save.
+func ScanStatusValidator(ss ScanStatus) error {
+	switch ss {
+	case ScanStatusUnscanned, ScanStatusClean, ScanStatusQuarantined:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for scan_status field: %q", ss)
+	}
+}
+
 // OrderOption defines the ordering options for the File queries.
 type OrderOption func(*sql.Selector)
 
@@ -241,3 +277,18 @@ func ByLinkURL(opts ...sql.OrderTermOption) OrderOption {
 func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
 }
+
+// ByContentType orders the results by the content_type field.
+func ByContentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentType, opts...).ToFunc()
+}
+
+// ByScanStatus orders the results by the scan_status field.
+func ByScanStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanStatus, opts...).ToFunc()
+}
+
+// ByScanResult orders the results by the scan_result field.
+func ByScanResult(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanResult, opts...).ToFunc()
+}
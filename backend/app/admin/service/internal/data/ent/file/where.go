@@ -144,6 +144,16 @@ func ContentHash(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldContentHash, v))
 }
 
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldContentType, v))
+}
+
+// ScanResult applies equality check predicate on the "scan_result" field. It's identical to ScanResultEQ.
+func ScanResult(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanResult, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldCreatedAt, v))
@@ -1324,6 +1334,186 @@ func ContentHashContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldContentHash, v))
 }
 
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeIsNil applies the IsNil predicate on the "content_type" field.
+func ContentTypeIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldContentType))
+}
+
+// ContentTypeNotNil applies the NotNil predicate on the "content_type" field.
+func ContentTypeNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldContentType))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// ScanStatusEQ applies the EQ predicate on the "scan_status" field.
+func ScanStatusEQ(v ScanStatus) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanStatus, v))
+}
+
+// ScanStatusNEQ applies the NEQ predicate on the "scan_status" field.
+func ScanStatusNEQ(v ScanStatus) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScanStatus, v))
+}
+
+// ScanStatusIn applies the In predicate on the "scan_status" field.
+func ScanStatusIn(vs ...ScanStatus) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusNotIn applies the NotIn predicate on the "scan_status" field.
+func ScanStatusNotIn(vs ...ScanStatus) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusIsNil applies the IsNil predicate on the "scan_status" field.
+func ScanStatusIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldScanStatus))
+}
+
+// ScanStatusNotNil applies the NotNil predicate on the "scan_status" field.
+func ScanStatusNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldScanStatus))
+}
+
+// ScanResultEQ applies the EQ predicate on the "scan_result" field.
+func ScanResultEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanResult, v))
+}
+
+// ScanResultNEQ applies the NEQ predicate on the "scan_result" field.
+func ScanResultNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScanResult, v))
+}
+
+// ScanResultIn applies the In predicate on the "scan_result" field.
+func ScanResultIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScanResult, vs...))
+}
+
+// ScanResultNotIn applies the NotIn predicate on the "scan_result" field.
+func ScanResultNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScanResult, vs...))
+}
+
+// ScanResultGT applies the GT predicate on the "scan_result" field.
+func ScanResultGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldScanResult, v))
+}
+
+// ScanResultGTE applies the GTE predicate on the "scan_result" field.
+func ScanResultGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldScanResult, v))
+}
+
+// ScanResultLT applies the LT predicate on the "scan_result" field.
+func ScanResultLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldScanResult, v))
+}
+
+// ScanResultLTE applies the LTE predicate on the "scan_result" field.
+func ScanResultLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldScanResult, v))
+}
+
+// ScanResultContains applies the Contains predicate on the "scan_result" field.
+func ScanResultContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldScanResult, v))
+}
+
+// ScanResultHasPrefix applies the HasPrefix predicate on the "scan_result" field.
+func ScanResultHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldScanResult, v))
+}
+
+// ScanResultHasSuffix applies the HasSuffix predicate on the "scan_result" field.
+func ScanResultHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldScanResult, v))
+}
+
+// ScanResultIsNil applies the IsNil predicate on the "scan_result" field.
+func ScanResultIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldScanResult))
+}
+
+// ScanResultNotNil applies the NotNil predicate on the "scan_result" field.
+func ScanResultNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldScanResult))
+}
+
+// ScanResultEqualFold applies the EqualFold predicate on the "scan_result" field.
+func ScanResultEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldScanResult, v))
+}
+
+// ScanResultContainsFold applies the ContainsFold predicate on the "scan_result" field.
+func ScanResultContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldScanResult, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.File) predicate.File {
 	return predicate.File(sql.AndPredicates(predicates...))
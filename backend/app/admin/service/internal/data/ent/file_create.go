@@ -288,6 +288,48 @@ func (_c *FileCreate) SetNillableContentHash(v *string) *FileCreate {
 	return _c
 }
 
+// SetContentType sets the "content_type" field.
+func (_c *FileCreate) SetContentType(v string) *FileCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_c *FileCreate) SetNillableContentType(v *string) *FileCreate {
+	if v != nil {
+		_c.SetContentType(*v)
+	}
+	return _c
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (_c *FileCreate) SetScanStatus(v file.ScanStatus) *FileCreate {
+	_c.mutation.SetScanStatus(v)
+	return _c
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_c *FileCreate) SetNillableScanStatus(v *file.ScanStatus) *FileCreate {
+	if v != nil {
+		_c.SetScanStatus(*v)
+	}
+	return _c
+}
+
+// SetScanResult sets the "scan_result" field.
+func (_c *FileCreate) SetScanResult(v string) *FileCreate {
+	_c.mutation.SetScanResult(v)
+	return _c
+}
+
+// SetNillableScanResult sets the "scan_result" field if the given value is not nil.
+func (_c *FileCreate) SetNillableScanResult(v *string) *FileCreate {
+	if v != nil {
+		_c.SetScanResult(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FileCreate) SetID(v uint32) *FileCreate {
 	_c.mutation.SetID(v)
@@ -339,6 +381,10 @@ func (_c *FileCreate) defaults() error {
 		v := file.DefaultProvider
 		_c.mutation.SetProvider(v)
 	}
+	if _, ok := _c.mutation.ScanStatus(); !ok {
+		v := file.DefaultScanStatus
+		_c.mutation.SetScanStatus(v)
+	}
 	return nil
 }
 
@@ -349,6 +395,11 @@ func (_c *FileCreate) check() error {
 			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "File.provider": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := file.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "File.id": %w`, err)}
@@ -463,6 +514,18 @@ func (_c *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldContentHash, field.TypeString, value)
 		_node.ContentHash = &value
 	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(file.FieldContentType, field.TypeString, value)
+		_node.ContentType = &value
+	}
+	if value, ok := _c.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+		_node.ScanStatus = &value
+	}
+	if value, ok := _c.mutation.ScanResult(); ok {
+		_spec.SetField(file.FieldScanResult, field.TypeString, value)
+		_node.ScanResult = &value
+	}
 	return _node, _spec
 }
 
@@ -845,6 +908,60 @@ func (u *FileUpsert) ClearContentHash() *FileUpsert {
 	return u
 }
 
+// SetContentType sets the "content_type" field.
+func (u *FileUpsert) SetContentType(v string) *FileUpsert {
+	u.Set(file.FieldContentType, v)
+	return u
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *FileUpsert) UpdateContentType() *FileUpsert {
+	u.SetExcluded(file.FieldContentType)
+	return u
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (u *FileUpsert) ClearContentType() *FileUpsert {
+	u.SetNull(file.FieldContentType)
+	return u
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *FileUpsert) SetScanStatus(v file.ScanStatus) *FileUpsert {
+	u.Set(file.FieldScanStatus, v)
+	return u
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *FileUpsert) UpdateScanStatus() *FileUpsert {
+	u.SetExcluded(file.FieldScanStatus)
+	return u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (u *FileUpsert) ClearScanStatus() *FileUpsert {
+	u.SetNull(file.FieldScanStatus)
+	return u
+}
+
+// SetScanResult sets the "scan_result" field.
+func (u *FileUpsert) SetScanResult(v string) *FileUpsert {
+	u.Set(file.FieldScanResult, v)
+	return u
+}
+
+// UpdateScanResult sets the "scan_result" field to the value that was provided on create.
+func (u *FileUpsert) UpdateScanResult() *FileUpsert {
+	u.SetExcluded(file.FieldScanResult)
+	return u
+}
+
+// ClearScanResult clears the value of the "scan_result" field.
+func (u *FileUpsert) ClearScanResult() *FileUpsert {
+	u.SetNull(file.FieldScanResult)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1284,6 +1401,69 @@ func (u *FileUpsertOne) ClearContentHash() *FileUpsertOne {
 	})
 }
 
+// SetContentType sets the "content_type" field.
+func (u *FileUpsertOne) SetContentType(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateContentType() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (u *FileUpsertOne) ClearContentType() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearContentType()
+	})
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *FileUpsertOne) SetScanStatus(v file.ScanStatus) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetScanStatus(v)
+	})
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateScanStatus() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateScanStatus()
+	})
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (u *FileUpsertOne) ClearScanStatus() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearScanStatus()
+	})
+}
+
+// SetScanResult sets the "scan_result" field.
+func (u *FileUpsertOne) SetScanResult(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetScanResult(v)
+	})
+}
+
+// UpdateScanResult sets the "scan_result" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateScanResult() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateScanResult()
+	})
+}
+
+// ClearScanResult clears the value of the "scan_result" field.
+func (u *FileUpsertOne) ClearScanResult() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearScanResult()
+	})
+}
+
 // Exec executes the query.
 func (u *FileUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1889,6 +2069,69 @@ func (u *FileUpsertBulk) ClearContentHash() *FileUpsertBulk {
 	})
 }
 
+// SetContentType sets the "content_type" field.
+func (u *FileUpsertBulk) SetContentType(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateContentType() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (u *FileUpsertBulk) ClearContentType() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearContentType()
+	})
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *FileUpsertBulk) SetScanStatus(v file.ScanStatus) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetScanStatus(v)
+	})
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateScanStatus() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateScanStatus()
+	})
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (u *FileUpsertBulk) ClearScanStatus() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearScanStatus()
+	})
+}
+
+// SetScanResult sets the "scan_result" field.
+func (u *FileUpsertBulk) SetScanResult(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetScanResult(v)
+	})
+}
+
+// UpdateScanResult sets the "scan_result" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateScanResult() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateScanResult()
+	})
+}
+
+// ClearScanResult clears the value of the "scan_result" field.
+func (u *FileUpsertBulk) ClearScanResult() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearScanResult()
+	})
+}
+
 // Exec executes the query.
 func (u *FileUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -397,6 +397,66 @@ func (_u *FileUpdate) ClearContentHash() *FileUpdate {
 	return _u
 }
 
+// SetContentType sets the "content_type" field.
+func (_u *FileUpdate) SetContentType(v string) *FileUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableContentType(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (_u *FileUpdate) ClearContentType() *FileUpdate {
+	_u.mutation.ClearContentType()
+	return _u
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileUpdate) SetScanStatus(v file.ScanStatus) *FileUpdate {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableScanStatus(v *file.ScanStatus) *FileUpdate {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (_u *FileUpdate) ClearScanStatus() *FileUpdate {
+	_u.mutation.ClearScanStatus()
+	return _u
+}
+
+// SetScanResult sets the "scan_result" field.
+func (_u *FileUpdate) SetScanResult(v string) *FileUpdate {
+	_u.mutation.SetScanResult(v)
+	return _u
+}
+
+// SetNillableScanResult sets the "scan_result" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableScanResult(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetScanResult(*v)
+	}
+	return _u
+}
+
+// ClearScanResult clears the value of the "scan_result" field.
+func (_u *FileUpdate) ClearScanResult() *FileUpdate {
+	_u.mutation.ClearScanResult()
+	return _u
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (_u *FileUpdate) Mutation() *FileMutation {
 	return _u.mutation
@@ -436,6 +496,11 @@ func (_u *FileUpdate) check() error {
 			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "File.provider": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -577,6 +642,24 @@ func (_u *FileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ContentHashCleared() {
 		_spec.ClearField(file.FieldContentHash, field.TypeString)
 	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(file.FieldContentType, field.TypeString, value)
+	}
+	if _u.mutation.ContentTypeCleared() {
+		_spec.ClearField(file.FieldContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.ScanStatusCleared() {
+		_spec.ClearField(file.FieldScanStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ScanResult(); ok {
+		_spec.SetField(file.FieldScanResult, field.TypeString, value)
+	}
+	if _u.mutation.ScanResultCleared() {
+		_spec.ClearField(file.FieldScanResult, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -967,6 +1050,66 @@ func (_u *FileUpdateOne) ClearContentHash() *FileUpdateOne {
 	return _u
 }
 
+// SetContentType sets the "content_type" field.
+func (_u *FileUpdateOne) SetContentType(v string) *FileUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableContentType(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (_u *FileUpdateOne) ClearContentType() *FileUpdateOne {
+	_u.mutation.ClearContentType()
+	return _u
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileUpdateOne) SetScanStatus(v file.ScanStatus) *FileUpdateOne {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableScanStatus(v *file.ScanStatus) *FileUpdateOne {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (_u *FileUpdateOne) ClearScanStatus() *FileUpdateOne {
+	_u.mutation.ClearScanStatus()
+	return _u
+}
+
+// SetScanResult sets the "scan_result" field.
+func (_u *FileUpdateOne) SetScanResult(v string) *FileUpdateOne {
+	_u.mutation.SetScanResult(v)
+	return _u
+}
+
+// SetNillableScanResult sets the "scan_result" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableScanResult(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetScanResult(*v)
+	}
+	return _u
+}
+
+// ClearScanResult clears the value of the "scan_result" field.
+func (_u *FileUpdateOne) ClearScanResult() *FileUpdateOne {
+	_u.mutation.ClearScanResult()
+	return _u
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (_u *FileUpdateOne) Mutation() *FileMutation {
 	return _u.mutation
@@ -1019,6 +1162,11 @@ func (_u *FileUpdateOne) check() error {
 			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "File.provider": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1177,6 +1325,24 @@ func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	if _u.mutation.ContentHashCleared() {
 		_spec.ClearField(file.FieldContentHash, field.TypeString)
 	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(file.FieldContentType, field.TypeString, value)
+	}
+	if _u.mutation.ContentTypeCleared() {
+		_spec.ClearField(file.FieldContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.ScanStatusCleared() {
+		_spec.ClearField(file.FieldScanStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ScanResult(); ok {
+		_spec.SetField(file.FieldScanResult, field.TypeString, value)
+	}
+	if _u.mutation.ScanResultCleared() {
+		_spec.ClearField(file.FieldScanResult, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &File{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,1627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/fileshare"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FileShareCreate is the builder for creating a FileShare entity.
+type FileShareCreate struct {
+	config
+	mutation *FileShareMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FileShareCreate) SetCreatedAt(v time.Time) *FileShareCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillableCreatedAt(v *time.Time) *FileShareCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *FileShareCreate) SetUpdatedAt(v time.Time) *FileShareCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillableUpdatedAt(v *time.Time) *FileShareCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *FileShareCreate) SetDeletedAt(v time.Time) *FileShareCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillableDeletedAt(v *time.Time) *FileShareCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *FileShareCreate) SetCreatedBy(v uint32) *FileShareCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillableCreatedBy(v *uint32) *FileShareCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *FileShareCreate) SetUpdatedBy(v uint32) *FileShareCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillableUpdatedBy(v *uint32) *FileShareCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *FileShareCreate) SetDeletedBy(v uint32) *FileShareCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillableDeletedBy(v *uint32) *FileShareCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *FileShareCreate) SetTenantID(v uint32) *FileShareCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillableTenantID(v *uint32) *FileShareCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetFileID sets the "file_id" field.
+func (_c *FileShareCreate) SetFileID(v uint32) *FileShareCreate {
+	_c.mutation.SetFileID(v)
+	return _c
+}
+
+// SetSlug sets the "slug" field.
+func (_c *FileShareCreate) SetSlug(v string) *FileShareCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_c *FileShareCreate) SetPasswordHash(v string) *FileShareCreate {
+	_c.mutation.SetPasswordHash(v)
+	return _c
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillablePasswordHash(v *string) *FileShareCreate {
+	if v != nil {
+		_c.SetPasswordHash(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *FileShareCreate) SetExpiresAt(v time.Time) *FileShareCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillableExpiresAt(v *time.Time) *FileShareCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_c *FileShareCreate) SetMaxDownloads(v uint32) *FileShareCreate {
+	_c.mutation.SetMaxDownloads(v)
+	return _c
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillableMaxDownloads(v *uint32) *FileShareCreate {
+	if v != nil {
+		_c.SetMaxDownloads(*v)
+	}
+	return _c
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_c *FileShareCreate) SetDownloadCount(v uint32) *FileShareCreate {
+	_c.mutation.SetDownloadCount(v)
+	return _c
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillableDownloadCount(v *uint32) *FileShareCreate {
+	if v != nil {
+		_c.SetDownloadCount(*v)
+	}
+	return _c
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (_c *FileShareCreate) SetAllowedTenantIds(v []uint32) *FileShareCreate {
+	_c.mutation.SetAllowedTenantIds(v)
+	return _c
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (_c *FileShareCreate) SetAllowedUserIds(v []uint32) *FileShareCreate {
+	_c.mutation.SetAllowedUserIds(v)
+	return _c
+}
+
+// SetDisabled sets the "disabled" field.
+func (_c *FileShareCreate) SetDisabled(v bool) *FileShareCreate {
+	_c.mutation.SetDisabled(v)
+	return _c
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (_c *FileShareCreate) SetNillableDisabled(v *bool) *FileShareCreate {
+	if v != nil {
+		_c.SetDisabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FileShareCreate) SetID(v uint32) *FileShareCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the FileShareMutation object of the builder.
+func (_c *FileShareCreate) Mutation() *FileShareMutation {
+	return _c.mutation
+}
+
+// Save creates the FileShare in the database.
+func (_c *FileShareCreate) Save(ctx context.Context) (*FileShare, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FileShareCreate) SaveX(ctx context.Context) *FileShare {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileShareCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileShareCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FileShareCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := fileshare.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.MaxDownloads(); !ok {
+		v := fileshare.DefaultMaxDownloads
+		_c.mutation.SetMaxDownloads(v)
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		v := fileshare.DefaultDownloadCount
+		_c.mutation.SetDownloadCount(v)
+	}
+	if _, ok := _c.mutation.Disabled(); !ok {
+		v := fileshare.DefaultDisabled
+		_c.mutation.SetDisabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FileShareCreate) check() error {
+	if _, ok := _c.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "FileShare.file_id"`)}
+	}
+	if _, ok := _c.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "FileShare.slug"`)}
+	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := fileshare.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "FileShare.slug": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := fileshare.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "FileShare.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *FileShareCreate) sqlSave(ctx context.Context) (*FileShare, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FileShareCreate) createSpec() (*FileShare, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FileShare{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(fileshare.Table, sqlgraph.NewFieldSpec(fileshare.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(fileshare.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(fileshare.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(fileshare.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(fileshare.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(fileshare.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(fileshare.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(fileshare.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.FileID(); ok {
+		_spec.SetField(fileshare.FieldFileID, field.TypeUint32, value)
+		_node.FileID = value
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(fileshare.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := _c.mutation.PasswordHash(); ok {
+		_spec.SetField(fileshare.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(fileshare.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.MaxDownloads(); ok {
+		_spec.SetField(fileshare.FieldMaxDownloads, field.TypeUint32, value)
+		_node.MaxDownloads = &value
+	}
+	if value, ok := _c.mutation.DownloadCount(); ok {
+		_spec.SetField(fileshare.FieldDownloadCount, field.TypeUint32, value)
+		_node.DownloadCount = &value
+	}
+	if value, ok := _c.mutation.AllowedTenantIds(); ok {
+		_spec.SetField(fileshare.FieldAllowedTenantIds, field.TypeJSON, value)
+		_node.AllowedTenantIds = value
+	}
+	if value, ok := _c.mutation.AllowedUserIds(); ok {
+		_spec.SetField(fileshare.FieldAllowedUserIds, field.TypeJSON, value)
+		_node.AllowedUserIds = value
+	}
+	if value, ok := _c.mutation.Disabled(); ok {
+		_spec.SetField(fileshare.FieldDisabled, field.TypeBool, value)
+		_node.Disabled = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FileShare.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileShareUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FileShareCreate) OnConflict(opts ...sql.ConflictOption) *FileShareUpsertOne {
+	_c.conflict = opts
+	return &FileShareUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FileShare.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FileShareCreate) OnConflictColumns(columns ...string) *FileShareUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FileShareUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// FileShareUpsertOne is the builder for "upsert"-ing
+	//  one FileShare node.
+	FileShareUpsertOne struct {
+		create *FileShareCreate
+	}
+
+	// FileShareUpsert is the "OnConflict" setter.
+	FileShareUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FileShareUpsert) SetUpdatedAt(v time.Time) *FileShareUpsert {
+	u.Set(fileshare.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateUpdatedAt() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *FileShareUpsert) ClearUpdatedAt() *FileShareUpsert {
+	u.SetNull(fileshare.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FileShareUpsert) SetDeletedAt(v time.Time) *FileShareUpsert {
+	u.Set(fileshare.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateDeletedAt() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FileShareUpsert) ClearDeletedAt() *FileShareUpsert {
+	u.SetNull(fileshare.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *FileShareUpsert) SetCreatedBy(v uint32) *FileShareUpsert {
+	u.Set(fileshare.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateCreatedBy() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *FileShareUpsert) AddCreatedBy(v uint32) *FileShareUpsert {
+	u.Add(fileshare.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *FileShareUpsert) ClearCreatedBy() *FileShareUpsert {
+	u.SetNull(fileshare.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *FileShareUpsert) SetUpdatedBy(v uint32) *FileShareUpsert {
+	u.Set(fileshare.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateUpdatedBy() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *FileShareUpsert) AddUpdatedBy(v uint32) *FileShareUpsert {
+	u.Add(fileshare.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *FileShareUpsert) ClearUpdatedBy() *FileShareUpsert {
+	u.SetNull(fileshare.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *FileShareUpsert) SetDeletedBy(v uint32) *FileShareUpsert {
+	u.Set(fileshare.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateDeletedBy() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *FileShareUpsert) AddDeletedBy(v uint32) *FileShareUpsert {
+	u.Add(fileshare.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *FileShareUpsert) ClearDeletedBy() *FileShareUpsert {
+	u.SetNull(fileshare.FieldDeletedBy)
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileShareUpsert) SetFileID(v uint32) *FileShareUpsert {
+	u.Set(fileshare.FieldFileID, v)
+	return u
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateFileID() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldFileID)
+	return u
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *FileShareUpsert) AddFileID(v uint32) *FileShareUpsert {
+	u.Add(fileshare.FieldFileID, v)
+	return u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *FileShareUpsert) SetPasswordHash(v string) *FileShareUpsert {
+	u.Set(fileshare.FieldPasswordHash, v)
+	return u
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdatePasswordHash() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldPasswordHash)
+	return u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *FileShareUpsert) ClearPasswordHash() *FileShareUpsert {
+	u.SetNull(fileshare.FieldPasswordHash)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *FileShareUpsert) SetExpiresAt(v time.Time) *FileShareUpsert {
+	u.Set(fileshare.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateExpiresAt() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *FileShareUpsert) ClearExpiresAt() *FileShareUpsert {
+	u.SetNull(fileshare.FieldExpiresAt)
+	return u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (u *FileShareUpsert) SetMaxDownloads(v uint32) *FileShareUpsert {
+	u.Set(fileshare.FieldMaxDownloads, v)
+	return u
+}
+
+// UpdateMaxDownloads sets the "max_downloads" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateMaxDownloads() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldMaxDownloads)
+	return u
+}
+
+// AddMaxDownloads adds v to the "max_downloads" field.
+func (u *FileShareUpsert) AddMaxDownloads(v uint32) *FileShareUpsert {
+	u.Add(fileshare.FieldMaxDownloads, v)
+	return u
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (u *FileShareUpsert) ClearMaxDownloads() *FileShareUpsert {
+	u.SetNull(fileshare.FieldMaxDownloads)
+	return u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (u *FileShareUpsert) SetDownloadCount(v uint32) *FileShareUpsert {
+	u.Set(fileshare.FieldDownloadCount, v)
+	return u
+}
+
+// UpdateDownloadCount sets the "download_count" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateDownloadCount() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldDownloadCount)
+	return u
+}
+
+// AddDownloadCount adds v to the "download_count" field.
+func (u *FileShareUpsert) AddDownloadCount(v uint32) *FileShareUpsert {
+	u.Add(fileshare.FieldDownloadCount, v)
+	return u
+}
+
+// ClearDownloadCount clears the value of the "download_count" field.
+func (u *FileShareUpsert) ClearDownloadCount() *FileShareUpsert {
+	u.SetNull(fileshare.FieldDownloadCount)
+	return u
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (u *FileShareUpsert) SetAllowedTenantIds(v []uint32) *FileShareUpsert {
+	u.Set(fileshare.FieldAllowedTenantIds, v)
+	return u
+}
+
+// UpdateAllowedTenantIds sets the "allowed_tenant_ids" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateAllowedTenantIds() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldAllowedTenantIds)
+	return u
+}
+
+// ClearAllowedTenantIds clears the value of the "allowed_tenant_ids" field.
+func (u *FileShareUpsert) ClearAllowedTenantIds() *FileShareUpsert {
+	u.SetNull(fileshare.FieldAllowedTenantIds)
+	return u
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (u *FileShareUpsert) SetAllowedUserIds(v []uint32) *FileShareUpsert {
+	u.Set(fileshare.FieldAllowedUserIds, v)
+	return u
+}
+
+// UpdateAllowedUserIds sets the "allowed_user_ids" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateAllowedUserIds() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldAllowedUserIds)
+	return u
+}
+
+// ClearAllowedUserIds clears the value of the "allowed_user_ids" field.
+func (u *FileShareUpsert) ClearAllowedUserIds() *FileShareUpsert {
+	u.SetNull(fileshare.FieldAllowedUserIds)
+	return u
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *FileShareUpsert) SetDisabled(v bool) *FileShareUpsert {
+	u.Set(fileshare.FieldDisabled, v)
+	return u
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *FileShareUpsert) UpdateDisabled() *FileShareUpsert {
+	u.SetExcluded(fileshare.FieldDisabled)
+	return u
+}
+
+// ClearDisabled clears the value of the "disabled" field.
+func (u *FileShareUpsert) ClearDisabled() *FileShareUpsert {
+	u.SetNull(fileshare.FieldDisabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.FileShare.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(fileshare.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FileShareUpsertOne) UpdateNewValues() *FileShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(fileshare.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(fileshare.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(fileshare.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.Slug(); exists {
+			s.SetIgnore(fileshare.FieldSlug)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FileShare.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FileShareUpsertOne) Ignore() *FileShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileShareUpsertOne) DoNothing() *FileShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileShareCreate.OnConflict
+// documentation for more info.
+func (u *FileShareUpsertOne) Update(set func(*FileShareUpsert)) *FileShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FileShareUpsertOne) SetUpdatedAt(v time.Time) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateUpdatedAt() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *FileShareUpsertOne) ClearUpdatedAt() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FileShareUpsertOne) SetDeletedAt(v time.Time) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateDeletedAt() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FileShareUpsertOne) ClearDeletedAt() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *FileShareUpsertOne) SetCreatedBy(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *FileShareUpsertOne) AddCreatedBy(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateCreatedBy() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *FileShareUpsertOne) ClearCreatedBy() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *FileShareUpsertOne) SetUpdatedBy(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *FileShareUpsertOne) AddUpdatedBy(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateUpdatedBy() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *FileShareUpsertOne) ClearUpdatedBy() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *FileShareUpsertOne) SetDeletedBy(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *FileShareUpsertOne) AddDeletedBy(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateDeletedBy() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *FileShareUpsertOne) ClearDeletedBy() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileShareUpsertOne) SetFileID(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *FileShareUpsertOne) AddFileID(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateFileID() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *FileShareUpsertOne) SetPasswordHash(v string) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdatePasswordHash() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *FileShareUpsertOne) ClearPasswordHash() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearPasswordHash()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *FileShareUpsertOne) SetExpiresAt(v time.Time) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateExpiresAt() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *FileShareUpsertOne) ClearExpiresAt() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (u *FileShareUpsertOne) SetMaxDownloads(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetMaxDownloads(v)
+	})
+}
+
+// AddMaxDownloads adds v to the "max_downloads" field.
+func (u *FileShareUpsertOne) AddMaxDownloads(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddMaxDownloads(v)
+	})
+}
+
+// UpdateMaxDownloads sets the "max_downloads" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateMaxDownloads() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateMaxDownloads()
+	})
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (u *FileShareUpsertOne) ClearMaxDownloads() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearMaxDownloads()
+	})
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (u *FileShareUpsertOne) SetDownloadCount(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetDownloadCount(v)
+	})
+}
+
+// AddDownloadCount adds v to the "download_count" field.
+func (u *FileShareUpsertOne) AddDownloadCount(v uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddDownloadCount(v)
+	})
+}
+
+// UpdateDownloadCount sets the "download_count" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateDownloadCount() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateDownloadCount()
+	})
+}
+
+// ClearDownloadCount clears the value of the "download_count" field.
+func (u *FileShareUpsertOne) ClearDownloadCount() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearDownloadCount()
+	})
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (u *FileShareUpsertOne) SetAllowedTenantIds(v []uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetAllowedTenantIds(v)
+	})
+}
+
+// UpdateAllowedTenantIds sets the "allowed_tenant_ids" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateAllowedTenantIds() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateAllowedTenantIds()
+	})
+}
+
+// ClearAllowedTenantIds clears the value of the "allowed_tenant_ids" field.
+func (u *FileShareUpsertOne) ClearAllowedTenantIds() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearAllowedTenantIds()
+	})
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (u *FileShareUpsertOne) SetAllowedUserIds(v []uint32) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetAllowedUserIds(v)
+	})
+}
+
+// UpdateAllowedUserIds sets the "allowed_user_ids" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateAllowedUserIds() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateAllowedUserIds()
+	})
+}
+
+// ClearAllowedUserIds clears the value of the "allowed_user_ids" field.
+func (u *FileShareUpsertOne) ClearAllowedUserIds() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearAllowedUserIds()
+	})
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *FileShareUpsertOne) SetDisabled(v bool) *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetDisabled(v)
+	})
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *FileShareUpsertOne) UpdateDisabled() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateDisabled()
+	})
+}
+
+// ClearDisabled clears the value of the "disabled" field.
+func (u *FileShareUpsertOne) ClearDisabled() *FileShareUpsertOne {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearDisabled()
+	})
+}
+
+// Exec executes the query.
+func (u *FileShareUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileShareCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileShareUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FileShareUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FileShareUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FileShareCreateBulk is the builder for creating many FileShare entities in bulk.
+type FileShareCreateBulk struct {
+	config
+	err      error
+	builders []*FileShareCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FileShare entities in the database.
+func (_c *FileShareCreateBulk) Save(ctx context.Context) ([]*FileShare, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FileShare, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileShareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FileShareCreateBulk) SaveX(ctx context.Context) []*FileShare {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileShareCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileShareCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FileShare.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FileShareUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FileShareCreateBulk) OnConflict(opts ...sql.ConflictOption) *FileShareUpsertBulk {
+	_c.conflict = opts
+	return &FileShareUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FileShare.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FileShareCreateBulk) OnConflictColumns(columns ...string) *FileShareUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FileShareUpsertBulk{
+		create: _c,
+	}
+}
+
+// FileShareUpsertBulk is the builder for "upsert"-ing
+// a bulk of FileShare nodes.
+type FileShareUpsertBulk struct {
+	create *FileShareCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FileShare.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(fileshare.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FileShareUpsertBulk) UpdateNewValues() *FileShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(fileshare.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(fileshare.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(fileshare.FieldTenantID)
+			}
+			if _, exists := b.mutation.Slug(); exists {
+				s.SetIgnore(fileshare.FieldSlug)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FileShare.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FileShareUpsertBulk) Ignore() *FileShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileShareUpsertBulk) DoNothing() *FileShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileShareCreateBulk.OnConflict
+// documentation for more info.
+func (u *FileShareUpsertBulk) Update(set func(*FileShareUpsert)) *FileShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FileShareUpsertBulk) SetUpdatedAt(v time.Time) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateUpdatedAt() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *FileShareUpsertBulk) ClearUpdatedAt() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FileShareUpsertBulk) SetDeletedAt(v time.Time) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateDeletedAt() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FileShareUpsertBulk) ClearDeletedAt() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *FileShareUpsertBulk) SetCreatedBy(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *FileShareUpsertBulk) AddCreatedBy(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateCreatedBy() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *FileShareUpsertBulk) ClearCreatedBy() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *FileShareUpsertBulk) SetUpdatedBy(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *FileShareUpsertBulk) AddUpdatedBy(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateUpdatedBy() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *FileShareUpsertBulk) ClearUpdatedBy() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *FileShareUpsertBulk) SetDeletedBy(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *FileShareUpsertBulk) AddDeletedBy(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateDeletedBy() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *FileShareUpsertBulk) ClearDeletedBy() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *FileShareUpsertBulk) SetFileID(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *FileShareUpsertBulk) AddFileID(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateFileID() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *FileShareUpsertBulk) SetPasswordHash(v string) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdatePasswordHash() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *FileShareUpsertBulk) ClearPasswordHash() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearPasswordHash()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *FileShareUpsertBulk) SetExpiresAt(v time.Time) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateExpiresAt() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *FileShareUpsertBulk) ClearExpiresAt() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (u *FileShareUpsertBulk) SetMaxDownloads(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetMaxDownloads(v)
+	})
+}
+
+// AddMaxDownloads adds v to the "max_downloads" field.
+func (u *FileShareUpsertBulk) AddMaxDownloads(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddMaxDownloads(v)
+	})
+}
+
+// UpdateMaxDownloads sets the "max_downloads" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateMaxDownloads() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateMaxDownloads()
+	})
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (u *FileShareUpsertBulk) ClearMaxDownloads() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearMaxDownloads()
+	})
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (u *FileShareUpsertBulk) SetDownloadCount(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetDownloadCount(v)
+	})
+}
+
+// AddDownloadCount adds v to the "download_count" field.
+func (u *FileShareUpsertBulk) AddDownloadCount(v uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.AddDownloadCount(v)
+	})
+}
+
+// UpdateDownloadCount sets the "download_count" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateDownloadCount() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateDownloadCount()
+	})
+}
+
+// ClearDownloadCount clears the value of the "download_count" field.
+func (u *FileShareUpsertBulk) ClearDownloadCount() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearDownloadCount()
+	})
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (u *FileShareUpsertBulk) SetAllowedTenantIds(v []uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetAllowedTenantIds(v)
+	})
+}
+
+// UpdateAllowedTenantIds sets the "allowed_tenant_ids" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateAllowedTenantIds() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateAllowedTenantIds()
+	})
+}
+
+// ClearAllowedTenantIds clears the value of the "allowed_tenant_ids" field.
+func (u *FileShareUpsertBulk) ClearAllowedTenantIds() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearAllowedTenantIds()
+	})
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (u *FileShareUpsertBulk) SetAllowedUserIds(v []uint32) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetAllowedUserIds(v)
+	})
+}
+
+// UpdateAllowedUserIds sets the "allowed_user_ids" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateAllowedUserIds() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateAllowedUserIds()
+	})
+}
+
+// ClearAllowedUserIds clears the value of the "allowed_user_ids" field.
+func (u *FileShareUpsertBulk) ClearAllowedUserIds() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearAllowedUserIds()
+	})
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *FileShareUpsertBulk) SetDisabled(v bool) *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.SetDisabled(v)
+	})
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *FileShareUpsertBulk) UpdateDisabled() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.UpdateDisabled()
+	})
+}
+
+// ClearDisabled clears the value of the "disabled" field.
+func (u *FileShareUpsertBulk) ClearDisabled() *FileShareUpsertBulk {
+	return u.Update(func(s *FileShareUpsert) {
+		s.ClearDisabled()
+	})
+}
+
+// Exec executes the query.
+func (u *FileShareUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FileShareCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FileShareCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FileShareUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,995 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/fileshare"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// FileShareUpdate is the builder for updating FileShare entities.
+type FileShareUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FileShareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FileShareUpdate builder.
+func (_u *FileShareUpdate) Where(ps ...predicate.FileShare) *FileShareUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FileShareUpdate) SetUpdatedAt(v time.Time) *FileShareUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *FileShareUpdate) SetNillableUpdatedAt(v *time.Time) *FileShareUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *FileShareUpdate) ClearUpdatedAt() *FileShareUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FileShareUpdate) SetDeletedAt(v time.Time) *FileShareUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FileShareUpdate) SetNillableDeletedAt(v *time.Time) *FileShareUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FileShareUpdate) ClearDeletedAt() *FileShareUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *FileShareUpdate) SetCreatedBy(v uint32) *FileShareUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *FileShareUpdate) SetNillableCreatedBy(v *uint32) *FileShareUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *FileShareUpdate) AddCreatedBy(v int32) *FileShareUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *FileShareUpdate) ClearCreatedBy() *FileShareUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *FileShareUpdate) SetUpdatedBy(v uint32) *FileShareUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *FileShareUpdate) SetNillableUpdatedBy(v *uint32) *FileShareUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *FileShareUpdate) AddUpdatedBy(v int32) *FileShareUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *FileShareUpdate) ClearUpdatedBy() *FileShareUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *FileShareUpdate) SetDeletedBy(v uint32) *FileShareUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *FileShareUpdate) SetNillableDeletedBy(v *uint32) *FileShareUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *FileShareUpdate) AddDeletedBy(v int32) *FileShareUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *FileShareUpdate) ClearDeletedBy() *FileShareUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *FileShareUpdate) SetFileID(v uint32) *FileShareUpdate {
+	_u.mutation.ResetFileID()
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *FileShareUpdate) SetNillableFileID(v *uint32) *FileShareUpdate {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// AddFileID adds value to the "file_id" field.
+func (_u *FileShareUpdate) AddFileID(v int32) *FileShareUpdate {
+	_u.mutation.AddFileID(v)
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_u *FileShareUpdate) SetPasswordHash(v string) *FileShareUpdate {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *FileShareUpdate) SetNillablePasswordHash(v *string) *FileShareUpdate {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (_u *FileShareUpdate) ClearPasswordHash() *FileShareUpdate {
+	_u.mutation.ClearPasswordHash()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *FileShareUpdate) SetExpiresAt(v time.Time) *FileShareUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *FileShareUpdate) SetNillableExpiresAt(v *time.Time) *FileShareUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *FileShareUpdate) ClearExpiresAt() *FileShareUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_u *FileShareUpdate) SetMaxDownloads(v uint32) *FileShareUpdate {
+	_u.mutation.ResetMaxDownloads()
+	_u.mutation.SetMaxDownloads(v)
+	return _u
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_u *FileShareUpdate) SetNillableMaxDownloads(v *uint32) *FileShareUpdate {
+	if v != nil {
+		_u.SetMaxDownloads(*v)
+	}
+	return _u
+}
+
+// AddMaxDownloads adds value to the "max_downloads" field.
+func (_u *FileShareUpdate) AddMaxDownloads(v int32) *FileShareUpdate {
+	_u.mutation.AddMaxDownloads(v)
+	return _u
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (_u *FileShareUpdate) ClearMaxDownloads() *FileShareUpdate {
+	_u.mutation.ClearMaxDownloads()
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *FileShareUpdate) SetDownloadCount(v uint32) *FileShareUpdate {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *FileShareUpdate) SetNillableDownloadCount(v *uint32) *FileShareUpdate {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *FileShareUpdate) AddDownloadCount(v int32) *FileShareUpdate {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// ClearDownloadCount clears the value of the "download_count" field.
+func (_u *FileShareUpdate) ClearDownloadCount() *FileShareUpdate {
+	_u.mutation.ClearDownloadCount()
+	return _u
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (_u *FileShareUpdate) SetAllowedTenantIds(v []uint32) *FileShareUpdate {
+	_u.mutation.SetAllowedTenantIds(v)
+	return _u
+}
+
+// AppendAllowedTenantIds appends value to the "allowed_tenant_ids" field.
+func (_u *FileShareUpdate) AppendAllowedTenantIds(v []uint32) *FileShareUpdate {
+	_u.mutation.AppendAllowedTenantIds(v)
+	return _u
+}
+
+// ClearAllowedTenantIds clears the value of the "allowed_tenant_ids" field.
+func (_u *FileShareUpdate) ClearAllowedTenantIds() *FileShareUpdate {
+	_u.mutation.ClearAllowedTenantIds()
+	return _u
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (_u *FileShareUpdate) SetAllowedUserIds(v []uint32) *FileShareUpdate {
+	_u.mutation.SetAllowedUserIds(v)
+	return _u
+}
+
+// AppendAllowedUserIds appends value to the "allowed_user_ids" field.
+func (_u *FileShareUpdate) AppendAllowedUserIds(v []uint32) *FileShareUpdate {
+	_u.mutation.AppendAllowedUserIds(v)
+	return _u
+}
+
+// ClearAllowedUserIds clears the value of the "allowed_user_ids" field.
+func (_u *FileShareUpdate) ClearAllowedUserIds() *FileShareUpdate {
+	_u.mutation.ClearAllowedUserIds()
+	return _u
+}
+
+// SetDisabled sets the "disabled" field.
+func (_u *FileShareUpdate) SetDisabled(v bool) *FileShareUpdate {
+	_u.mutation.SetDisabled(v)
+	return _u
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (_u *FileShareUpdate) SetNillableDisabled(v *bool) *FileShareUpdate {
+	if v != nil {
+		_u.SetDisabled(*v)
+	}
+	return _u
+}
+
+// ClearDisabled clears the value of the "disabled" field.
+func (_u *FileShareUpdate) ClearDisabled() *FileShareUpdate {
+	_u.mutation.ClearDisabled()
+	return _u
+}
+
+// Mutation returns the FileShareMutation object of the builder.
+func (_u *FileShareUpdate) Mutation() *FileShareMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FileShareUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FileShareUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileShareUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileShareUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileShareUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FileShareUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(fileshare.Table, fileshare.Columns, sqlgraph.NewFieldSpec(fileshare.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(fileshare.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(fileshare.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(fileshare.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(fileshare.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(fileshare.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(fileshare.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(fileshare.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(fileshare.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(fileshare.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(fileshare.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(fileshare.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(fileshare.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(fileshare.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(fileshare.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(fileshare.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(fileshare.FieldFileID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedFileID(); ok {
+		_spec.AddField(fileshare.FieldFileID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(fileshare.FieldPasswordHash, field.TypeString, value)
+	}
+	if _u.mutation.PasswordHashCleared() {
+		_spec.ClearField(fileshare.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(fileshare.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(fileshare.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxDownloads(); ok {
+		_spec.SetField(fileshare.FieldMaxDownloads, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(fileshare.FieldMaxDownloads, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxDownloadsCleared() {
+		_spec.ClearField(fileshare.FieldMaxDownloads, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(fileshare.FieldDownloadCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(fileshare.FieldDownloadCount, field.TypeUint32, value)
+	}
+	if _u.mutation.DownloadCountCleared() {
+		_spec.ClearField(fileshare.FieldDownloadCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AllowedTenantIds(); ok {
+		_spec.SetField(fileshare.FieldAllowedTenantIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedTenantIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fileshare.FieldAllowedTenantIds, value)
+		})
+	}
+	if _u.mutation.AllowedTenantIdsCleared() {
+		_spec.ClearField(fileshare.FieldAllowedTenantIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedUserIds(); ok {
+		_spec.SetField(fileshare.FieldAllowedUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fileshare.FieldAllowedUserIds, value)
+		})
+	}
+	if _u.mutation.AllowedUserIdsCleared() {
+		_spec.ClearField(fileshare.FieldAllowedUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Disabled(); ok {
+		_spec.SetField(fileshare.FieldDisabled, field.TypeBool, value)
+	}
+	if _u.mutation.DisabledCleared() {
+		_spec.ClearField(fileshare.FieldDisabled, field.TypeBool)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FileShareUpdateOne is the builder for updating a single FileShare entity.
+type FileShareUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FileShareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FileShareUpdateOne) SetUpdatedAt(v time.Time) *FileShareUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *FileShareUpdateOne) SetNillableUpdatedAt(v *time.Time) *FileShareUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *FileShareUpdateOne) ClearUpdatedAt() *FileShareUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FileShareUpdateOne) SetDeletedAt(v time.Time) *FileShareUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FileShareUpdateOne) SetNillableDeletedAt(v *time.Time) *FileShareUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FileShareUpdateOne) ClearDeletedAt() *FileShareUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *FileShareUpdateOne) SetCreatedBy(v uint32) *FileShareUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *FileShareUpdateOne) SetNillableCreatedBy(v *uint32) *FileShareUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *FileShareUpdateOne) AddCreatedBy(v int32) *FileShareUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *FileShareUpdateOne) ClearCreatedBy() *FileShareUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *FileShareUpdateOne) SetUpdatedBy(v uint32) *FileShareUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *FileShareUpdateOne) SetNillableUpdatedBy(v *uint32) *FileShareUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *FileShareUpdateOne) AddUpdatedBy(v int32) *FileShareUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *FileShareUpdateOne) ClearUpdatedBy() *FileShareUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *FileShareUpdateOne) SetDeletedBy(v uint32) *FileShareUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *FileShareUpdateOne) SetNillableDeletedBy(v *uint32) *FileShareUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *FileShareUpdateOne) AddDeletedBy(v int32) *FileShareUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *FileShareUpdateOne) ClearDeletedBy() *FileShareUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *FileShareUpdateOne) SetFileID(v uint32) *FileShareUpdateOne {
+	_u.mutation.ResetFileID()
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *FileShareUpdateOne) SetNillableFileID(v *uint32) *FileShareUpdateOne {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// AddFileID adds value to the "file_id" field.
+func (_u *FileShareUpdateOne) AddFileID(v int32) *FileShareUpdateOne {
+	_u.mutation.AddFileID(v)
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_u *FileShareUpdateOne) SetPasswordHash(v string) *FileShareUpdateOne {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *FileShareUpdateOne) SetNillablePasswordHash(v *string) *FileShareUpdateOne {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (_u *FileShareUpdateOne) ClearPasswordHash() *FileShareUpdateOne {
+	_u.mutation.ClearPasswordHash()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *FileShareUpdateOne) SetExpiresAt(v time.Time) *FileShareUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *FileShareUpdateOne) SetNillableExpiresAt(v *time.Time) *FileShareUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *FileShareUpdateOne) ClearExpiresAt() *FileShareUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (_u *FileShareUpdateOne) SetMaxDownloads(v uint32) *FileShareUpdateOne {
+	_u.mutation.ResetMaxDownloads()
+	_u.mutation.SetMaxDownloads(v)
+	return _u
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (_u *FileShareUpdateOne) SetNillableMaxDownloads(v *uint32) *FileShareUpdateOne {
+	if v != nil {
+		_u.SetMaxDownloads(*v)
+	}
+	return _u
+}
+
+// AddMaxDownloads adds value to the "max_downloads" field.
+func (_u *FileShareUpdateOne) AddMaxDownloads(v int32) *FileShareUpdateOne {
+	_u.mutation.AddMaxDownloads(v)
+	return _u
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (_u *FileShareUpdateOne) ClearMaxDownloads() *FileShareUpdateOne {
+	_u.mutation.ClearMaxDownloads()
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *FileShareUpdateOne) SetDownloadCount(v uint32) *FileShareUpdateOne {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *FileShareUpdateOne) SetNillableDownloadCount(v *uint32) *FileShareUpdateOne {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *FileShareUpdateOne) AddDownloadCount(v int32) *FileShareUpdateOne {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// ClearDownloadCount clears the value of the "download_count" field.
+func (_u *FileShareUpdateOne) ClearDownloadCount() *FileShareUpdateOne {
+	_u.mutation.ClearDownloadCount()
+	return _u
+}
+
+// SetAllowedTenantIds sets the "allowed_tenant_ids" field.
+func (_u *FileShareUpdateOne) SetAllowedTenantIds(v []uint32) *FileShareUpdateOne {
+	_u.mutation.SetAllowedTenantIds(v)
+	return _u
+}
+
+// AppendAllowedTenantIds appends value to the "allowed_tenant_ids" field.
+func (_u *FileShareUpdateOne) AppendAllowedTenantIds(v []uint32) *FileShareUpdateOne {
+	_u.mutation.AppendAllowedTenantIds(v)
+	return _u
+}
+
+// ClearAllowedTenantIds clears the value of the "allowed_tenant_ids" field.
+func (_u *FileShareUpdateOne) ClearAllowedTenantIds() *FileShareUpdateOne {
+	_u.mutation.ClearAllowedTenantIds()
+	return _u
+}
+
+// SetAllowedUserIds sets the "allowed_user_ids" field.
+func (_u *FileShareUpdateOne) SetAllowedUserIds(v []uint32) *FileShareUpdateOne {
+	_u.mutation.SetAllowedUserIds(v)
+	return _u
+}
+
+// AppendAllowedUserIds appends value to the "allowed_user_ids" field.
+func (_u *FileShareUpdateOne) AppendAllowedUserIds(v []uint32) *FileShareUpdateOne {
+	_u.mutation.AppendAllowedUserIds(v)
+	return _u
+}
+
+// ClearAllowedUserIds clears the value of the "allowed_user_ids" field.
+func (_u *FileShareUpdateOne) ClearAllowedUserIds() *FileShareUpdateOne {
+	_u.mutation.ClearAllowedUserIds()
+	return _u
+}
+
+// SetDisabled sets the "disabled" field.
+func (_u *FileShareUpdateOne) SetDisabled(v bool) *FileShareUpdateOne {
+	_u.mutation.SetDisabled(v)
+	return _u
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (_u *FileShareUpdateOne) SetNillableDisabled(v *bool) *FileShareUpdateOne {
+	if v != nil {
+		_u.SetDisabled(*v)
+	}
+	return _u
+}
+
+// ClearDisabled clears the value of the "disabled" field.
+func (_u *FileShareUpdateOne) ClearDisabled() *FileShareUpdateOne {
+	_u.mutation.ClearDisabled()
+	return _u
+}
+
+// Mutation returns the FileShareMutation object of the builder.
+func (_u *FileShareUpdateOne) Mutation() *FileShareMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FileShareUpdate builder.
+func (_u *FileShareUpdateOne) Where(ps ...predicate.FileShare) *FileShareUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FileShareUpdateOne) Select(field string, fields ...string) *FileShareUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FileShare entity.
+func (_u *FileShareUpdateOne) Save(ctx context.Context) (*FileShare, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileShareUpdateOne) SaveX(ctx context.Context) *FileShare {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FileShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileShareUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileShareUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileShareUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FileShareUpdateOne) sqlSave(ctx context.Context) (_node *FileShare, err error) {
+	_spec := sqlgraph.NewUpdateSpec(fileshare.Table, fileshare.Columns, sqlgraph.NewFieldSpec(fileshare.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FileShare.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fileshare.FieldID)
+		for _, f := range fields {
+			if !fileshare.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fileshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(fileshare.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(fileshare.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(fileshare.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(fileshare.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(fileshare.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(fileshare.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(fileshare.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(fileshare.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(fileshare.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(fileshare.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(fileshare.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(fileshare.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(fileshare.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(fileshare.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(fileshare.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(fileshare.FieldFileID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedFileID(); ok {
+		_spec.AddField(fileshare.FieldFileID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(fileshare.FieldPasswordHash, field.TypeString, value)
+	}
+	if _u.mutation.PasswordHashCleared() {
+		_spec.ClearField(fileshare.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(fileshare.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(fileshare.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxDownloads(); ok {
+		_spec.SetField(fileshare.FieldMaxDownloads, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(fileshare.FieldMaxDownloads, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxDownloadsCleared() {
+		_spec.ClearField(fileshare.FieldMaxDownloads, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(fileshare.FieldDownloadCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(fileshare.FieldDownloadCount, field.TypeUint32, value)
+	}
+	if _u.mutation.DownloadCountCleared() {
+		_spec.ClearField(fileshare.FieldDownloadCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AllowedTenantIds(); ok {
+		_spec.SetField(fileshare.FieldAllowedTenantIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedTenantIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fileshare.FieldAllowedTenantIds, value)
+		})
+	}
+	if _u.mutation.AllowedTenantIdsCleared() {
+		_spec.ClearField(fileshare.FieldAllowedTenantIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AllowedUserIds(); ok {
+		_spec.SetField(fileshare.FieldAllowedUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fileshare.FieldAllowedUserIds, value)
+		})
+	}
+	if _u.mutation.AllowedUserIdsCleared() {
+		_spec.ClearField(fileshare.FieldAllowedUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Disabled(); ok {
+		_spec.SetField(fileshare.FieldDisabled, field.TypeBool, value)
+	}
+	if _u.mutation.DisabledCleared() {
+		_spec.ClearField(fileshare.FieldDisabled, field.TypeBool)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FileShare{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -104,6 +104,18 @@ func (f FileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileMutation", m)
 }
 
+// The FileShareFunc type is an adapter to allow the use of ordinary
+// function as FileShare mutator.
+type FileShareFunc func(context.Context, *ent.FileShareMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileShareFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FileShareMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileShareMutation", m)
+}
+
 // The InternalMessageFunc type is an adapter to allow the use of ordinary
 // function as InternalMessage mutator.
 type InternalMessageFunc func(context.Context, *ent.InternalMessageMutation) (ent.Value, error)
@@ -140,6 +152,18 @@ func (f InternalMessageRecipientFunc) Mutate(ctx context.Context, m ent.Mutation
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InternalMessageRecipientMutation", m)
 }
 
+// The JwtSigningKeyFunc type is an adapter to allow the use of ordinary
+// function as JwtSigningKey mutator.
+type JwtSigningKeyFunc func(context.Context, *ent.JwtSigningKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JwtSigningKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JwtSigningKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JwtSigningKeyMutation", m)
+}
+
 // The LanguageFunc type is an adapter to allow the use of ordinary
 // function as Language mutator.
 type LanguageFunc func(context.Context, *ent.LanguageMutation) (ent.Value, error)
@@ -152,6 +176,18 @@ func (f LanguageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LanguageMutation", m)
 }
 
+// The LdapSourceFunc type is an adapter to allow the use of ordinary
+// function as LdapSource mutator.
+type LdapSourceFunc func(context.Context, *ent.LdapSourceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LdapSourceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LdapSourceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LdapSourceMutation", m)
+}
+
 // The LoginAuditLogFunc type is an adapter to allow the use of ordinary
 // function as LoginAuditLog mutator.
 type LoginAuditLogFunc func(context.Context, *ent.LoginAuditLogMutation) (ent.Value, error)
@@ -176,6 +212,30 @@ func (f LoginPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoginPolicyMutation", m)
 }
 
+// The LuaScriptFunc type is an adapter to allow the use of ordinary
+// function as LuaScript mutator.
+type LuaScriptFunc func(context.Context, *ent.LuaScriptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LuaScriptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LuaScriptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LuaScriptMutation", m)
+}
+
+// The LuaScriptVersionFunc type is an adapter to allow the use of ordinary
+// function as LuaScriptVersion mutator.
+type LuaScriptVersionFunc func(context.Context, *ent.LuaScriptVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LuaScriptVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LuaScriptVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LuaScriptVersionMutation", m)
+}
+
 // The MembershipFunc type is an adapter to allow the use of ordinary
 // function as Membership mutator.
 type MembershipFunc func(context.Context, *ent.MembershipMutation) (ent.Value, error)
@@ -236,6 +296,18 @@ func (f MenuFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MenuMutation", m)
 }
 
+// The OAuthClientFunc type is an adapter to allow the use of ordinary
+// function as OAuthClient mutator.
+type OAuthClientFunc func(context.Context, *ent.OAuthClientMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OAuthClientFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OAuthClientMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OAuthClientMutation", m)
+}
+
 // The OperationAuditLogFunc type is an adapter to allow the use of ordinary
 // function as OperationAuditLog mutator.
 type OperationAuditLogFunc func(context.Context, *ent.OperationAuditLogMutation) (ent.Value, error)
@@ -260,6 +332,18 @@ func (f OrgUnitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrgUnitMutation", m)
 }
 
+// The PasswordPolicyFunc type is an adapter to allow the use of ordinary
+// function as PasswordPolicy mutator.
+type PasswordPolicyFunc func(context.Context, *ent.PasswordPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PasswordPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PasswordPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PasswordPolicyMutation", m)
+}
+
 // The PermissionFunc type is an adapter to allow the use of ordinary
 // function as Permission mutator.
 type PermissionFunc func(context.Context, *ent.PermissionMutation) (ent.Value, error)
@@ -356,6 +440,30 @@ func (f PositionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PositionMutation", m)
 }
 
+// The RegistrationInviteFunc type is an adapter to allow the use of ordinary
+// function as RegistrationInvite mutator.
+type RegistrationInviteFunc func(context.Context, *ent.RegistrationInviteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RegistrationInviteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RegistrationInviteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RegistrationInviteMutation", m)
+}
+
+// The RegistrationPolicyFunc type is an adapter to allow the use of ordinary
+// function as RegistrationPolicy mutator.
+type RegistrationPolicyFunc func(context.Context, *ent.RegistrationPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RegistrationPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RegistrationPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RegistrationPolicyMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
@@ -392,6 +500,30 @@ func (f RolePermissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RolePermissionMutation", m)
 }
 
+// The ScimTokenFunc type is an adapter to allow the use of ordinary
+// function as ScimToken mutator.
+type ScimTokenFunc func(context.Context, *ent.ScimTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScimTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScimTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScimTokenMutation", m)
+}
+
+// The StorageQuotaFunc type is an adapter to allow the use of ordinary
+// function as StorageQuota mutator.
+type StorageQuotaFunc func(context.Context, *ent.StorageQuotaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StorageQuotaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StorageQuotaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StorageQuotaMutation", m)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *ent.TaskMutation) (ent.Value, error)
@@ -404,6 +536,18 @@ func (f TaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskMutation", m)
 }
 
+// The TaskRunFunc type is an adapter to allow the use of ordinary
+// function as TaskRun mutator.
+type TaskRunFunc func(context.Context, *ent.TaskRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskRunMutation", m)
+}
+
 // The TenantFunc type is an adapter to allow the use of ordinary
 // function as Tenant mutator.
 type TenantFunc func(context.Context, *ent.TenantMutation) (ent.Value, error)
@@ -452,6 +596,18 @@ func (f UserOrgUnitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserOrgUnitMutation", m)
 }
 
+// The UserPasswordHistoryFunc type is an adapter to allow the use of ordinary
+// function as UserPasswordHistory mutator.
+type UserPasswordHistoryFunc func(context.Context, *ent.UserPasswordHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserPasswordHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserPasswordHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserPasswordHistoryMutation", m)
+}
+
 // The UserPositionFunc type is an adapter to allow the use of ordinary
 // function as UserPosition mutator.
 type UserPositionFunc func(context.Context, *ent.UserPositionMutation) (ent.Value, error)
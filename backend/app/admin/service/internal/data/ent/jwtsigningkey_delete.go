@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JwtSigningKeyDelete is the builder for deleting a JwtSigningKey entity.
+type JwtSigningKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *JwtSigningKeyMutation
+}
+
+// Where appends a list predicates to the JwtSigningKeyDelete builder.
+func (_d *JwtSigningKeyDelete) Where(ps ...predicate.JwtSigningKey) *JwtSigningKeyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *JwtSigningKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *JwtSigningKeyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *JwtSigningKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(jwtsigningkey.Table, sqlgraph.NewFieldSpec(jwtsigningkey.FieldID, field.TypeUint32))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// JwtSigningKeyDeleteOne is the builder for deleting a single JwtSigningKey entity.
+type JwtSigningKeyDeleteOne struct {
+	_d *JwtSigningKeyDelete
+}
+
+// Where appends a list predicates to the JwtSigningKeyDelete builder.
+func (_d *JwtSigningKeyDeleteOne) Where(ps ...predicate.JwtSigningKey) *JwtSigningKeyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *JwtSigningKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{jwtsigningkey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *JwtSigningKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
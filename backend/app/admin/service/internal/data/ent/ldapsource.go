@@ -0,0 +1,511 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapsource"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LDAP身份源表
+type LdapSource struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 创建者ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 更新者ID
+	UpdatedBy *uint32 `json:"updated_by,omitempty"`
+	// 删除者ID
+	DeletedBy *uint32 `json:"deleted_by,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 名称
+	Name *string `json:"name,omitempty"`
+	// 是否启用
+	Enabled *bool `json:"enabled,omitempty"`
+	// 服务地址
+	URL string `json:"url,omitempty"`
+	// 连接后升级为TLS
+	StartTLS *bool `json:"start_tls,omitempty"`
+	// 跳过证书校验
+	InsecureSkipVerify *bool `json:"insecure_skip_verify,omitempty"`
+	// 服务账号DN
+	BindDn *string `json:"bind_dn,omitempty"`
+	// 服务账号密码，配置了加密密钥时加密保存
+	BindPassword *string `json:"-"`
+	// 搜索根
+	BaseDn string `json:"base_dn,omitempty"`
+	// 用户过滤条件
+	UserFilter *string `json:"user_filter,omitempty"`
+	// 登录名属性
+	LoginAttribute *string `json:"login_attribute,omitempty"`
+	// 邮箱属性
+	EmailAttribute *string `json:"email_attribute,omitempty"`
+	// 显示名属性
+	DisplayNameAttribute *string `json:"display_name_attribute,omitempty"`
+	// 手机号属性
+	MobileAttribute *string `json:"mobile_attribute,omitempty"`
+	// 组织单元过滤条件，为空字符串时不同步组织
+	OrgUnitFilter *string `json:"org_unit_filter,omitempty"`
+	// 组搜索根
+	GroupBaseDn *string `json:"group_base_dn,omitempty"`
+	// 组过滤条件
+	GroupFilter *string `json:"group_filter,omitempty"`
+	// 组成员属性
+	GroupMemberAttribute *string `json:"group_member_attribute,omitempty"`
+	// 组DN到角色ID的映射
+	GroupRoleMappings map[string]uint32 `json:"group_role_mappings,omitempty"`
+	// 默认角色ID
+	DefaultRoleID *uint32 `json:"default_role_id,omitempty"`
+	// 是否参与定时同步
+	SyncEnabled *bool `json:"sync_enabled,omitempty"`
+	// 同步时禁用目录中已不存在的用户
+	DisableMissingUsers *bool `json:"disable_missing_users,omitempty"`
+	// 最近一次同步时间
+	LastSyncAt *time.Time `json:"last_sync_at,omitempty"`
+	// 最近一次同步的错误
+	LastSyncError *string `json:"last_sync_error,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LdapSource) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ldapsource.FieldGroupRoleMappings:
+			values[i] = new([]byte)
+		case ldapsource.FieldEnabled, ldapsource.FieldStartTLS, ldapsource.FieldInsecureSkipVerify, ldapsource.FieldSyncEnabled, ldapsource.FieldDisableMissingUsers:
+			values[i] = new(sql.NullBool)
+		case ldapsource.FieldID, ldapsource.FieldCreatedBy, ldapsource.FieldUpdatedBy, ldapsource.FieldDeletedBy, ldapsource.FieldTenantID, ldapsource.FieldDefaultRoleID:
+			values[i] = new(sql.NullInt64)
+		case ldapsource.FieldName, ldapsource.FieldURL, ldapsource.FieldBindDn, ldapsource.FieldBindPassword, ldapsource.FieldBaseDn, ldapsource.FieldUserFilter, ldapsource.FieldLoginAttribute, ldapsource.FieldEmailAttribute, ldapsource.FieldDisplayNameAttribute, ldapsource.FieldMobileAttribute, ldapsource.FieldOrgUnitFilter, ldapsource.FieldGroupBaseDn, ldapsource.FieldGroupFilter, ldapsource.FieldGroupMemberAttribute, ldapsource.FieldLastSyncError:
+			values[i] = new(sql.NullString)
+		case ldapsource.FieldCreatedAt, ldapsource.FieldUpdatedAt, ldapsource.FieldDeletedAt, ldapsource.FieldLastSyncAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LdapSource fields.
+func (_m *LdapSource) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ldapsource.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case ldapsource.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case ldapsource.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case ldapsource.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case ldapsource.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case ldapsource.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(uint32)
+				*_m.UpdatedBy = uint32(value.Int64)
+			}
+		case ldapsource.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = new(uint32)
+				*_m.DeletedBy = uint32(value.Int64)
+			}
+		case ldapsource.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case ldapsource.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = new(string)
+				*_m.Name = value.String
+			}
+		case ldapsource.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = new(bool)
+				*_m.Enabled = value.Bool
+			}
+		case ldapsource.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case ldapsource.FieldStartTLS:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field start_tls", values[i])
+			} else if value.Valid {
+				_m.StartTLS = new(bool)
+				*_m.StartTLS = value.Bool
+			}
+		case ldapsource.FieldInsecureSkipVerify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field insecure_skip_verify", values[i])
+			} else if value.Valid {
+				_m.InsecureSkipVerify = new(bool)
+				*_m.InsecureSkipVerify = value.Bool
+			}
+		case ldapsource.FieldBindDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bind_dn", values[i])
+			} else if value.Valid {
+				_m.BindDn = new(string)
+				*_m.BindDn = value.String
+			}
+		case ldapsource.FieldBindPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bind_password", values[i])
+			} else if value.Valid {
+				_m.BindPassword = new(string)
+				*_m.BindPassword = value.String
+			}
+		case ldapsource.FieldBaseDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_dn", values[i])
+			} else if value.Valid {
+				_m.BaseDn = value.String
+			}
+		case ldapsource.FieldUserFilter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_filter", values[i])
+			} else if value.Valid {
+				_m.UserFilter = new(string)
+				*_m.UserFilter = value.String
+			}
+		case ldapsource.FieldLoginAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field login_attribute", values[i])
+			} else if value.Valid {
+				_m.LoginAttribute = new(string)
+				*_m.LoginAttribute = value.String
+			}
+		case ldapsource.FieldEmailAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email_attribute", values[i])
+			} else if value.Valid {
+				_m.EmailAttribute = new(string)
+				*_m.EmailAttribute = value.String
+			}
+		case ldapsource.FieldDisplayNameAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name_attribute", values[i])
+			} else if value.Valid {
+				_m.DisplayNameAttribute = new(string)
+				*_m.DisplayNameAttribute = value.String
+			}
+		case ldapsource.FieldMobileAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mobile_attribute", values[i])
+			} else if value.Valid {
+				_m.MobileAttribute = new(string)
+				*_m.MobileAttribute = value.String
+			}
+		case ldapsource.FieldOrgUnitFilter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field org_unit_filter", values[i])
+			} else if value.Valid {
+				_m.OrgUnitFilter = new(string)
+				*_m.OrgUnitFilter = value.String
+			}
+		case ldapsource.FieldGroupBaseDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_base_dn", values[i])
+			} else if value.Valid {
+				_m.GroupBaseDn = new(string)
+				*_m.GroupBaseDn = value.String
+			}
+		case ldapsource.FieldGroupFilter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_filter", values[i])
+			} else if value.Valid {
+				_m.GroupFilter = new(string)
+				*_m.GroupFilter = value.String
+			}
+		case ldapsource.FieldGroupMemberAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_member_attribute", values[i])
+			} else if value.Valid {
+				_m.GroupMemberAttribute = new(string)
+				*_m.GroupMemberAttribute = value.String
+			}
+		case ldapsource.FieldGroupRoleMappings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field group_role_mappings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GroupRoleMappings); err != nil {
+					return fmt.Errorf("unmarshal field group_role_mappings: %w", err)
+				}
+			}
+		case ldapsource.FieldDefaultRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_role_id", values[i])
+			} else if value.Valid {
+				_m.DefaultRoleID = new(uint32)
+				*_m.DefaultRoleID = uint32(value.Int64)
+			}
+		case ldapsource.FieldSyncEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_enabled", values[i])
+			} else if value.Valid {
+				_m.SyncEnabled = new(bool)
+				*_m.SyncEnabled = value.Bool
+			}
+		case ldapsource.FieldDisableMissingUsers:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field disable_missing_users", values[i])
+			} else if value.Valid {
+				_m.DisableMissingUsers = new(bool)
+				*_m.DisableMissingUsers = value.Bool
+			}
+		case ldapsource.FieldLastSyncAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_at", values[i])
+			} else if value.Valid {
+				_m.LastSyncAt = new(time.Time)
+				*_m.LastSyncAt = value.Time
+			}
+		case ldapsource.FieldLastSyncError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_error", values[i])
+			} else if value.Valid {
+				_m.LastSyncError = new(string)
+				*_m.LastSyncError = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LdapSource.
+// This includes values selected through modifiers, order, etc.
+func (_m *LdapSource) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LdapSource.
+// Note that you need to call LdapSource.Unwrap() before calling this method if this LdapSource
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LdapSource) Update() *LdapSourceUpdateOne {
+	return NewLdapSourceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LdapSource entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LdapSource) Unwrap() *LdapSource {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LdapSource is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LdapSource) String() string {
+	var builder strings.Builder
+	builder.WriteString("LdapSource(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedBy; v != nil {
+		builder.WriteString("deleted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Name; v != nil {
+		builder.WriteString("name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Enabled; v != nil {
+		builder.WriteString("enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	if v := _m.StartTLS; v != nil {
+		builder.WriteString("start_tls=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.InsecureSkipVerify; v != nil {
+		builder.WriteString("insecure_skip_verify=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BindDn; v != nil {
+		builder.WriteString("bind_dn=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("bind_password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("base_dn=")
+	builder.WriteString(_m.BaseDn)
+	builder.WriteString(", ")
+	if v := _m.UserFilter; v != nil {
+		builder.WriteString("user_filter=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LoginAttribute; v != nil {
+		builder.WriteString("login_attribute=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.EmailAttribute; v != nil {
+		builder.WriteString("email_attribute=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.DisplayNameAttribute; v != nil {
+		builder.WriteString("display_name_attribute=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.MobileAttribute; v != nil {
+		builder.WriteString("mobile_attribute=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.OrgUnitFilter; v != nil {
+		builder.WriteString("org_unit_filter=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.GroupBaseDn; v != nil {
+		builder.WriteString("group_base_dn=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.GroupFilter; v != nil {
+		builder.WriteString("group_filter=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.GroupMemberAttribute; v != nil {
+		builder.WriteString("group_member_attribute=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("group_role_mappings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupRoleMappings))
+	builder.WriteString(", ")
+	if v := _m.DefaultRoleID; v != nil {
+		builder.WriteString("default_role_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SyncEnabled; v != nil {
+		builder.WriteString("sync_enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DisableMissingUsers; v != nil {
+		builder.WriteString("disable_missing_users=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastSyncAt; v != nil {
+		builder.WriteString("last_sync_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastSyncError; v != nil {
+		builder.WriteString("last_sync_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LdapSources is a parsable slice of LdapSource.
+type LdapSources []*LdapSource
@@ -0,0 +1,331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ldapsource
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the ldapsource type in the database.
+	Label = "ldap_source"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldStartTLS holds the string denoting the start_tls field in the database.
+	FieldStartTLS = "start_tls"
+	// FieldInsecureSkipVerify holds the string denoting the insecure_skip_verify field in the database.
+	FieldInsecureSkipVerify = "insecure_skip_verify"
+	// FieldBindDn holds the string denoting the bind_dn field in the database.
+	FieldBindDn = "bind_dn"
+	// FieldBindPassword holds the string denoting the bind_password field in the database.
+	FieldBindPassword = "bind_password"
+	// FieldBaseDn holds the string denoting the base_dn field in the database.
+	FieldBaseDn = "base_dn"
+	// FieldUserFilter holds the string denoting the user_filter field in the database.
+	FieldUserFilter = "user_filter"
+	// FieldLoginAttribute holds the string denoting the login_attribute field in the database.
+	FieldLoginAttribute = "login_attribute"
+	// FieldEmailAttribute holds the string denoting the email_attribute field in the database.
+	FieldEmailAttribute = "email_attribute"
+	// FieldDisplayNameAttribute holds the string denoting the display_name_attribute field in the database.
+	FieldDisplayNameAttribute = "display_name_attribute"
+	// FieldMobileAttribute holds the string denoting the mobile_attribute field in the database.
+	FieldMobileAttribute = "mobile_attribute"
+	// FieldOrgUnitFilter holds the string denoting the org_unit_filter field in the database.
+	FieldOrgUnitFilter = "org_unit_filter"
+	// FieldGroupBaseDn holds the string denoting the group_base_dn field in the database.
+	FieldGroupBaseDn = "group_base_dn"
+	// FieldGroupFilter holds the string denoting the group_filter field in the database.
+	FieldGroupFilter = "group_filter"
+	// FieldGroupMemberAttribute holds the string denoting the group_member_attribute field in the database.
+	FieldGroupMemberAttribute = "group_member_attribute"
+	// FieldGroupRoleMappings holds the string denoting the group_role_mappings field in the database.
+	FieldGroupRoleMappings = "group_role_mappings"
+	// FieldDefaultRoleID holds the string denoting the default_role_id field in the database.
+	FieldDefaultRoleID = "default_role_id"
+	// FieldSyncEnabled holds the string denoting the sync_enabled field in the database.
+	FieldSyncEnabled = "sync_enabled"
+	// FieldDisableMissingUsers holds the string denoting the disable_missing_users field in the database.
+	FieldDisableMissingUsers = "disable_missing_users"
+	// FieldLastSyncAt holds the string denoting the last_sync_at field in the database.
+	FieldLastSyncAt = "last_sync_at"
+	// FieldLastSyncError holds the string denoting the last_sync_error field in the database.
+	FieldLastSyncError = "last_sync_error"
+	// Table holds the table name of the ldapsource in the database.
+	Table = "sys_ldap_sources"
+)
+
+// Columns holds all SQL columns for ldapsource fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldDeletedBy,
+	FieldTenantID,
+	FieldName,
+	FieldEnabled,
+	FieldURL,
+	FieldStartTLS,
+	FieldInsecureSkipVerify,
+	FieldBindDn,
+	FieldBindPassword,
+	FieldBaseDn,
+	FieldUserFilter,
+	FieldLoginAttribute,
+	FieldEmailAttribute,
+	FieldDisplayNameAttribute,
+	FieldMobileAttribute,
+	FieldOrgUnitFilter,
+	FieldGroupBaseDn,
+	FieldGroupFilter,
+	FieldGroupMemberAttribute,
+	FieldGroupRoleMappings,
+	FieldDefaultRoleID,
+	FieldSyncEnabled,
+	FieldDisableMissingUsers,
+	FieldLastSyncAt,
+	FieldLastSyncError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// URLValidator is a validator for the "url" field. It is called by the builders before save.
+	URLValidator func(string) error
+	// DefaultStartTLS holds the default value on creation for the "start_tls" field.
+	DefaultStartTLS bool
+	// DefaultInsecureSkipVerify holds the default value on creation for the "insecure_skip_verify" field.
+	DefaultInsecureSkipVerify bool
+	// BindDnValidator is a validator for the "bind_dn" field. It is called by the builders before save.
+	BindDnValidator func(string) error
+	// BindPasswordValidator is a validator for the "bind_password" field. It is called by the builders before save.
+	BindPasswordValidator func(string) error
+	// BaseDnValidator is a validator for the "base_dn" field. It is called by the builders before save.
+	BaseDnValidator func(string) error
+	// UserFilterValidator is a validator for the "user_filter" field. It is called by the builders before save.
+	UserFilterValidator func(string) error
+	// LoginAttributeValidator is a validator for the "login_attribute" field. It is called by the builders before save.
+	LoginAttributeValidator func(string) error
+	// EmailAttributeValidator is a validator for the "email_attribute" field. It is called by the builders before save.
+	EmailAttributeValidator func(string) error
+	// DisplayNameAttributeValidator is a validator for the "display_name_attribute" field. It is called by the builders before save.
+	DisplayNameAttributeValidator func(string) error
+	// MobileAttributeValidator is a validator for the "mobile_attribute" field. It is called by the builders before save.
+	MobileAttributeValidator func(string) error
+	// OrgUnitFilterValidator is a validator for the "org_unit_filter" field. It is called by the builders before save.
+	OrgUnitFilterValidator func(string) error
+	// GroupBaseDnValidator is a validator for the "group_base_dn" field. It is called by the builders before save.
+	GroupBaseDnValidator func(string) error
+	// GroupFilterValidator is a validator for the "group_filter" field. It is called by the builders before save.
+	GroupFilterValidator func(string) error
+	// GroupMemberAttributeValidator is a validator for the "group_member_attribute" field. It is called by the builders before save.
+	GroupMemberAttributeValidator func(string) error
+	// DefaultDefaultRoleID holds the default value on creation for the "default_role_id" field.
+	DefaultDefaultRoleID uint32
+	// DefaultSyncEnabled holds the default value on creation for the "sync_enabled" field.
+	DefaultSyncEnabled bool
+	// DefaultDisableMissingUsers holds the default value on creation for the "disable_missing_users" field.
+	DefaultDisableMissingUsers bool
+	// LastSyncErrorValidator is a validator for the "last_sync_error" field. It is called by the builders before save.
+	LastSyncErrorValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// OrderOption defines the ordering options for the LdapSource queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByStartTLS orders the results by the start_tls field.
+func ByStartTLS(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTLS, opts...).ToFunc()
+}
+
+// ByInsecureSkipVerify orders the results by the insecure_skip_verify field.
+func ByInsecureSkipVerify(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInsecureSkipVerify, opts...).ToFunc()
+}
+
+// ByBindDn orders the results by the bind_dn field.
+func ByBindDn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBindDn, opts...).ToFunc()
+}
+
+// ByBindPassword orders the results by the bind_password field.
+func ByBindPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBindPassword, opts...).ToFunc()
+}
+
+// ByBaseDn orders the results by the base_dn field.
+func ByBaseDn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseDn, opts...).ToFunc()
+}
+
+// ByUserFilter orders the results by the user_filter field.
+func ByUserFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserFilter, opts...).ToFunc()
+}
+
+// ByLoginAttribute orders the results by the login_attribute field.
+func ByLoginAttribute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoginAttribute, opts...).ToFunc()
+}
+
+// ByEmailAttribute orders the results by the email_attribute field.
+func ByEmailAttribute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailAttribute, opts...).ToFunc()
+}
+
+// ByDisplayNameAttribute orders the results by the display_name_attribute field.
+func ByDisplayNameAttribute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayNameAttribute, opts...).ToFunc()
+}
+
+// ByMobileAttribute orders the results by the mobile_attribute field.
+func ByMobileAttribute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMobileAttribute, opts...).ToFunc()
+}
+
+// ByOrgUnitFilter orders the results by the org_unit_filter field.
+func ByOrgUnitFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgUnitFilter, opts...).ToFunc()
+}
+
+// ByGroupBaseDn orders the results by the group_base_dn field.
+func ByGroupBaseDn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupBaseDn, opts...).ToFunc()
+}
+
+// ByGroupFilter orders the results by the group_filter field.
+func ByGroupFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupFilter, opts...).ToFunc()
+}
+
+// ByGroupMemberAttribute orders the results by the group_member_attribute field.
+func ByGroupMemberAttribute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupMemberAttribute, opts...).ToFunc()
+}
+
+// ByDefaultRoleID orders the results by the default_role_id field.
+func ByDefaultRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultRoleID, opts...).ToFunc()
+}
+
+// BySyncEnabled orders the results by the sync_enabled field.
+func BySyncEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncEnabled, opts...).ToFunc()
+}
+
+// ByDisableMissingUsers orders the results by the disable_missing_users field.
+func ByDisableMissingUsers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisableMissingUsers, opts...).ToFunc()
+}
+
+// ByLastSyncAt orders the results by the last_sync_at field.
+func ByLastSyncAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncAt, opts...).ToFunc()
+}
+
+// ByLastSyncError orders the results by the last_sync_error field.
+func ByLastSyncError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncError, opts...).ToFunc()
+}
@@ -0,0 +1,1880 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ldapsource
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldEnabled, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldURL, v))
+}
+
+// StartTLS applies equality check predicate on the "start_tls" field. It's identical to StartTLSEQ.
+func StartTLS(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldStartTLS, v))
+}
+
+// InsecureSkipVerify applies equality check predicate on the "insecure_skip_verify" field. It's identical to InsecureSkipVerifyEQ.
+func InsecureSkipVerify(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldInsecureSkipVerify, v))
+}
+
+// BindDn applies equality check predicate on the "bind_dn" field. It's identical to BindDnEQ.
+func BindDn(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldBindDn, v))
+}
+
+// BindPassword applies equality check predicate on the "bind_password" field. It's identical to BindPasswordEQ.
+func BindPassword(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldBindPassword, v))
+}
+
+// BaseDn applies equality check predicate on the "base_dn" field. It's identical to BaseDnEQ.
+func BaseDn(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldBaseDn, v))
+}
+
+// UserFilter applies equality check predicate on the "user_filter" field. It's identical to UserFilterEQ.
+func UserFilter(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldUserFilter, v))
+}
+
+// LoginAttribute applies equality check predicate on the "login_attribute" field. It's identical to LoginAttributeEQ.
+func LoginAttribute(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldLoginAttribute, v))
+}
+
+// EmailAttribute applies equality check predicate on the "email_attribute" field. It's identical to EmailAttributeEQ.
+func EmailAttribute(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldEmailAttribute, v))
+}
+
+// DisplayNameAttribute applies equality check predicate on the "display_name_attribute" field. It's identical to DisplayNameAttributeEQ.
+func DisplayNameAttribute(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldDisplayNameAttribute, v))
+}
+
+// MobileAttribute applies equality check predicate on the "mobile_attribute" field. It's identical to MobileAttributeEQ.
+func MobileAttribute(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldMobileAttribute, v))
+}
+
+// OrgUnitFilter applies equality check predicate on the "org_unit_filter" field. It's identical to OrgUnitFilterEQ.
+func OrgUnitFilter(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldOrgUnitFilter, v))
+}
+
+// GroupBaseDn applies equality check predicate on the "group_base_dn" field. It's identical to GroupBaseDnEQ.
+func GroupBaseDn(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldGroupBaseDn, v))
+}
+
+// GroupFilter applies equality check predicate on the "group_filter" field. It's identical to GroupFilterEQ.
+func GroupFilter(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldGroupFilter, v))
+}
+
+// GroupMemberAttribute applies equality check predicate on the "group_member_attribute" field. It's identical to GroupMemberAttributeEQ.
+func GroupMemberAttribute(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldGroupMemberAttribute, v))
+}
+
+// DefaultRoleID applies equality check predicate on the "default_role_id" field. It's identical to DefaultRoleIDEQ.
+func DefaultRoleID(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldDefaultRoleID, v))
+}
+
+// SyncEnabled applies equality check predicate on the "sync_enabled" field. It's identical to SyncEnabledEQ.
+func SyncEnabled(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldSyncEnabled, v))
+}
+
+// DisableMissingUsers applies equality check predicate on the "disable_missing_users" field. It's identical to DisableMissingUsersEQ.
+func DisableMissingUsers(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldDisableMissingUsers, v))
+}
+
+// LastSyncAt applies equality check predicate on the "last_sync_at" field. It's identical to LastSyncAtEQ.
+func LastSyncAt(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncError applies equality check predicate on the "last_sync_error" field. It's identical to LastSyncErrorEQ.
+func LastSyncError(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldLastSyncError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldTenantID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// EnabledIsNil applies the IsNil predicate on the "enabled" field.
+func EnabledIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldEnabled))
+}
+
+// EnabledNotNil applies the NotNil predicate on the "enabled" field.
+func EnabledNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldEnabled))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldURL, v))
+}
+
+// StartTLSEQ applies the EQ predicate on the "start_tls" field.
+func StartTLSEQ(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldStartTLS, v))
+}
+
+// StartTLSNEQ applies the NEQ predicate on the "start_tls" field.
+func StartTLSNEQ(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldStartTLS, v))
+}
+
+// StartTLSIsNil applies the IsNil predicate on the "start_tls" field.
+func StartTLSIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldStartTLS))
+}
+
+// StartTLSNotNil applies the NotNil predicate on the "start_tls" field.
+func StartTLSNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldStartTLS))
+}
+
+// InsecureSkipVerifyEQ applies the EQ predicate on the "insecure_skip_verify" field.
+func InsecureSkipVerifyEQ(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldInsecureSkipVerify, v))
+}
+
+// InsecureSkipVerifyNEQ applies the NEQ predicate on the "insecure_skip_verify" field.
+func InsecureSkipVerifyNEQ(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldInsecureSkipVerify, v))
+}
+
+// InsecureSkipVerifyIsNil applies the IsNil predicate on the "insecure_skip_verify" field.
+func InsecureSkipVerifyIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldInsecureSkipVerify))
+}
+
+// InsecureSkipVerifyNotNil applies the NotNil predicate on the "insecure_skip_verify" field.
+func InsecureSkipVerifyNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldInsecureSkipVerify))
+}
+
+// BindDnEQ applies the EQ predicate on the "bind_dn" field.
+func BindDnEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldBindDn, v))
+}
+
+// BindDnNEQ applies the NEQ predicate on the "bind_dn" field.
+func BindDnNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldBindDn, v))
+}
+
+// BindDnIn applies the In predicate on the "bind_dn" field.
+func BindDnIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldBindDn, vs...))
+}
+
+// BindDnNotIn applies the NotIn predicate on the "bind_dn" field.
+func BindDnNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldBindDn, vs...))
+}
+
+// BindDnGT applies the GT predicate on the "bind_dn" field.
+func BindDnGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldBindDn, v))
+}
+
+// BindDnGTE applies the GTE predicate on the "bind_dn" field.
+func BindDnGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldBindDn, v))
+}
+
+// BindDnLT applies the LT predicate on the "bind_dn" field.
+func BindDnLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldBindDn, v))
+}
+
+// BindDnLTE applies the LTE predicate on the "bind_dn" field.
+func BindDnLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldBindDn, v))
+}
+
+// BindDnContains applies the Contains predicate on the "bind_dn" field.
+func BindDnContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldBindDn, v))
+}
+
+// BindDnHasPrefix applies the HasPrefix predicate on the "bind_dn" field.
+func BindDnHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldBindDn, v))
+}
+
+// BindDnHasSuffix applies the HasSuffix predicate on the "bind_dn" field.
+func BindDnHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldBindDn, v))
+}
+
+// BindDnIsNil applies the IsNil predicate on the "bind_dn" field.
+func BindDnIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldBindDn))
+}
+
+// BindDnNotNil applies the NotNil predicate on the "bind_dn" field.
+func BindDnNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldBindDn))
+}
+
+// BindDnEqualFold applies the EqualFold predicate on the "bind_dn" field.
+func BindDnEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldBindDn, v))
+}
+
+// BindDnContainsFold applies the ContainsFold predicate on the "bind_dn" field.
+func BindDnContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldBindDn, v))
+}
+
+// BindPasswordEQ applies the EQ predicate on the "bind_password" field.
+func BindPasswordEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldBindPassword, v))
+}
+
+// BindPasswordNEQ applies the NEQ predicate on the "bind_password" field.
+func BindPasswordNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldBindPassword, v))
+}
+
+// BindPasswordIn applies the In predicate on the "bind_password" field.
+func BindPasswordIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldBindPassword, vs...))
+}
+
+// BindPasswordNotIn applies the NotIn predicate on the "bind_password" field.
+func BindPasswordNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldBindPassword, vs...))
+}
+
+// BindPasswordGT applies the GT predicate on the "bind_password" field.
+func BindPasswordGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldBindPassword, v))
+}
+
+// BindPasswordGTE applies the GTE predicate on the "bind_password" field.
+func BindPasswordGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldBindPassword, v))
+}
+
+// BindPasswordLT applies the LT predicate on the "bind_password" field.
+func BindPasswordLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldBindPassword, v))
+}
+
+// BindPasswordLTE applies the LTE predicate on the "bind_password" field.
+func BindPasswordLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldBindPassword, v))
+}
+
+// BindPasswordContains applies the Contains predicate on the "bind_password" field.
+func BindPasswordContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldBindPassword, v))
+}
+
+// BindPasswordHasPrefix applies the HasPrefix predicate on the "bind_password" field.
+func BindPasswordHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldBindPassword, v))
+}
+
+// BindPasswordHasSuffix applies the HasSuffix predicate on the "bind_password" field.
+func BindPasswordHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldBindPassword, v))
+}
+
+// BindPasswordIsNil applies the IsNil predicate on the "bind_password" field.
+func BindPasswordIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldBindPassword))
+}
+
+// BindPasswordNotNil applies the NotNil predicate on the "bind_password" field.
+func BindPasswordNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldBindPassword))
+}
+
+// BindPasswordEqualFold applies the EqualFold predicate on the "bind_password" field.
+func BindPasswordEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldBindPassword, v))
+}
+
+// BindPasswordContainsFold applies the ContainsFold predicate on the "bind_password" field.
+func BindPasswordContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldBindPassword, v))
+}
+
+// BaseDnEQ applies the EQ predicate on the "base_dn" field.
+func BaseDnEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldBaseDn, v))
+}
+
+// BaseDnNEQ applies the NEQ predicate on the "base_dn" field.
+func BaseDnNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldBaseDn, v))
+}
+
+// BaseDnIn applies the In predicate on the "base_dn" field.
+func BaseDnIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldBaseDn, vs...))
+}
+
+// BaseDnNotIn applies the NotIn predicate on the "base_dn" field.
+func BaseDnNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldBaseDn, vs...))
+}
+
+// BaseDnGT applies the GT predicate on the "base_dn" field.
+func BaseDnGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldBaseDn, v))
+}
+
+// BaseDnGTE applies the GTE predicate on the "base_dn" field.
+func BaseDnGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldBaseDn, v))
+}
+
+// BaseDnLT applies the LT predicate on the "base_dn" field.
+func BaseDnLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldBaseDn, v))
+}
+
+// BaseDnLTE applies the LTE predicate on the "base_dn" field.
+func BaseDnLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldBaseDn, v))
+}
+
+// BaseDnContains applies the Contains predicate on the "base_dn" field.
+func BaseDnContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldBaseDn, v))
+}
+
+// BaseDnHasPrefix applies the HasPrefix predicate on the "base_dn" field.
+func BaseDnHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldBaseDn, v))
+}
+
+// BaseDnHasSuffix applies the HasSuffix predicate on the "base_dn" field.
+func BaseDnHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldBaseDn, v))
+}
+
+// BaseDnEqualFold applies the EqualFold predicate on the "base_dn" field.
+func BaseDnEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldBaseDn, v))
+}
+
+// BaseDnContainsFold applies the ContainsFold predicate on the "base_dn" field.
+func BaseDnContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldBaseDn, v))
+}
+
+// UserFilterEQ applies the EQ predicate on the "user_filter" field.
+func UserFilterEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldUserFilter, v))
+}
+
+// UserFilterNEQ applies the NEQ predicate on the "user_filter" field.
+func UserFilterNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldUserFilter, v))
+}
+
+// UserFilterIn applies the In predicate on the "user_filter" field.
+func UserFilterIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldUserFilter, vs...))
+}
+
+// UserFilterNotIn applies the NotIn predicate on the "user_filter" field.
+func UserFilterNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldUserFilter, vs...))
+}
+
+// UserFilterGT applies the GT predicate on the "user_filter" field.
+func UserFilterGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldUserFilter, v))
+}
+
+// UserFilterGTE applies the GTE predicate on the "user_filter" field.
+func UserFilterGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldUserFilter, v))
+}
+
+// UserFilterLT applies the LT predicate on the "user_filter" field.
+func UserFilterLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldUserFilter, v))
+}
+
+// UserFilterLTE applies the LTE predicate on the "user_filter" field.
+func UserFilterLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldUserFilter, v))
+}
+
+// UserFilterContains applies the Contains predicate on the "user_filter" field.
+func UserFilterContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldUserFilter, v))
+}
+
+// UserFilterHasPrefix applies the HasPrefix predicate on the "user_filter" field.
+func UserFilterHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldUserFilter, v))
+}
+
+// UserFilterHasSuffix applies the HasSuffix predicate on the "user_filter" field.
+func UserFilterHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldUserFilter, v))
+}
+
+// UserFilterIsNil applies the IsNil predicate on the "user_filter" field.
+func UserFilterIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldUserFilter))
+}
+
+// UserFilterNotNil applies the NotNil predicate on the "user_filter" field.
+func UserFilterNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldUserFilter))
+}
+
+// UserFilterEqualFold applies the EqualFold predicate on the "user_filter" field.
+func UserFilterEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldUserFilter, v))
+}
+
+// UserFilterContainsFold applies the ContainsFold predicate on the "user_filter" field.
+func UserFilterContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldUserFilter, v))
+}
+
+// LoginAttributeEQ applies the EQ predicate on the "login_attribute" field.
+func LoginAttributeEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldLoginAttribute, v))
+}
+
+// LoginAttributeNEQ applies the NEQ predicate on the "login_attribute" field.
+func LoginAttributeNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldLoginAttribute, v))
+}
+
+// LoginAttributeIn applies the In predicate on the "login_attribute" field.
+func LoginAttributeIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldLoginAttribute, vs...))
+}
+
+// LoginAttributeNotIn applies the NotIn predicate on the "login_attribute" field.
+func LoginAttributeNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldLoginAttribute, vs...))
+}
+
+// LoginAttributeGT applies the GT predicate on the "login_attribute" field.
+func LoginAttributeGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldLoginAttribute, v))
+}
+
+// LoginAttributeGTE applies the GTE predicate on the "login_attribute" field.
+func LoginAttributeGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldLoginAttribute, v))
+}
+
+// LoginAttributeLT applies the LT predicate on the "login_attribute" field.
+func LoginAttributeLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldLoginAttribute, v))
+}
+
+// LoginAttributeLTE applies the LTE predicate on the "login_attribute" field.
+func LoginAttributeLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldLoginAttribute, v))
+}
+
+// LoginAttributeContains applies the Contains predicate on the "login_attribute" field.
+func LoginAttributeContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldLoginAttribute, v))
+}
+
+// LoginAttributeHasPrefix applies the HasPrefix predicate on the "login_attribute" field.
+func LoginAttributeHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldLoginAttribute, v))
+}
+
+// LoginAttributeHasSuffix applies the HasSuffix predicate on the "login_attribute" field.
+func LoginAttributeHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldLoginAttribute, v))
+}
+
+// LoginAttributeIsNil applies the IsNil predicate on the "login_attribute" field.
+func LoginAttributeIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldLoginAttribute))
+}
+
+// LoginAttributeNotNil applies the NotNil predicate on the "login_attribute" field.
+func LoginAttributeNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldLoginAttribute))
+}
+
+// LoginAttributeEqualFold applies the EqualFold predicate on the "login_attribute" field.
+func LoginAttributeEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldLoginAttribute, v))
+}
+
+// LoginAttributeContainsFold applies the ContainsFold predicate on the "login_attribute" field.
+func LoginAttributeContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldLoginAttribute, v))
+}
+
+// EmailAttributeEQ applies the EQ predicate on the "email_attribute" field.
+func EmailAttributeEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldEmailAttribute, v))
+}
+
+// EmailAttributeNEQ applies the NEQ predicate on the "email_attribute" field.
+func EmailAttributeNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldEmailAttribute, v))
+}
+
+// EmailAttributeIn applies the In predicate on the "email_attribute" field.
+func EmailAttributeIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldEmailAttribute, vs...))
+}
+
+// EmailAttributeNotIn applies the NotIn predicate on the "email_attribute" field.
+func EmailAttributeNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldEmailAttribute, vs...))
+}
+
+// EmailAttributeGT applies the GT predicate on the "email_attribute" field.
+func EmailAttributeGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldEmailAttribute, v))
+}
+
+// EmailAttributeGTE applies the GTE predicate on the "email_attribute" field.
+func EmailAttributeGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldEmailAttribute, v))
+}
+
+// EmailAttributeLT applies the LT predicate on the "email_attribute" field.
+func EmailAttributeLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldEmailAttribute, v))
+}
+
+// EmailAttributeLTE applies the LTE predicate on the "email_attribute" field.
+func EmailAttributeLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldEmailAttribute, v))
+}
+
+// EmailAttributeContains applies the Contains predicate on the "email_attribute" field.
+func EmailAttributeContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldEmailAttribute, v))
+}
+
+// EmailAttributeHasPrefix applies the HasPrefix predicate on the "email_attribute" field.
+func EmailAttributeHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldEmailAttribute, v))
+}
+
+// EmailAttributeHasSuffix applies the HasSuffix predicate on the "email_attribute" field.
+func EmailAttributeHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldEmailAttribute, v))
+}
+
+// EmailAttributeIsNil applies the IsNil predicate on the "email_attribute" field.
+func EmailAttributeIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldEmailAttribute))
+}
+
+// EmailAttributeNotNil applies the NotNil predicate on the "email_attribute" field.
+func EmailAttributeNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldEmailAttribute))
+}
+
+// EmailAttributeEqualFold applies the EqualFold predicate on the "email_attribute" field.
+func EmailAttributeEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldEmailAttribute, v))
+}
+
+// EmailAttributeContainsFold applies the ContainsFold predicate on the "email_attribute" field.
+func EmailAttributeContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldEmailAttribute, v))
+}
+
+// DisplayNameAttributeEQ applies the EQ predicate on the "display_name_attribute" field.
+func DisplayNameAttributeEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldDisplayNameAttribute, v))
+}
+
+// DisplayNameAttributeNEQ applies the NEQ predicate on the "display_name_attribute" field.
+func DisplayNameAttributeNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldDisplayNameAttribute, v))
+}
+
+// DisplayNameAttributeIn applies the In predicate on the "display_name_attribute" field.
+func DisplayNameAttributeIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldDisplayNameAttribute, vs...))
+}
+
+// DisplayNameAttributeNotIn applies the NotIn predicate on the "display_name_attribute" field.
+func DisplayNameAttributeNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldDisplayNameAttribute, vs...))
+}
+
+// DisplayNameAttributeGT applies the GT predicate on the "display_name_attribute" field.
+func DisplayNameAttributeGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldDisplayNameAttribute, v))
+}
+
+// DisplayNameAttributeGTE applies the GTE predicate on the "display_name_attribute" field.
+func DisplayNameAttributeGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldDisplayNameAttribute, v))
+}
+
+// DisplayNameAttributeLT applies the LT predicate on the "display_name_attribute" field.
+func DisplayNameAttributeLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldDisplayNameAttribute, v))
+}
+
+// DisplayNameAttributeLTE applies the LTE predicate on the "display_name_attribute" field.
+func DisplayNameAttributeLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldDisplayNameAttribute, v))
+}
+
+// DisplayNameAttributeContains applies the Contains predicate on the "display_name_attribute" field.
+func DisplayNameAttributeContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldDisplayNameAttribute, v))
+}
+
+// DisplayNameAttributeHasPrefix applies the HasPrefix predicate on the "display_name_attribute" field.
+func DisplayNameAttributeHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldDisplayNameAttribute, v))
+}
+
+// DisplayNameAttributeHasSuffix applies the HasSuffix predicate on the "display_name_attribute" field.
+func DisplayNameAttributeHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldDisplayNameAttribute, v))
+}
+
+// DisplayNameAttributeIsNil applies the IsNil predicate on the "display_name_attribute" field.
+func DisplayNameAttributeIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldDisplayNameAttribute))
+}
+
+// DisplayNameAttributeNotNil applies the NotNil predicate on the "display_name_attribute" field.
+func DisplayNameAttributeNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldDisplayNameAttribute))
+}
+
+// DisplayNameAttributeEqualFold applies the EqualFold predicate on the "display_name_attribute" field.
+func DisplayNameAttributeEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldDisplayNameAttribute, v))
+}
+
+// DisplayNameAttributeContainsFold applies the ContainsFold predicate on the "display_name_attribute" field.
+func DisplayNameAttributeContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldDisplayNameAttribute, v))
+}
+
+// MobileAttributeEQ applies the EQ predicate on the "mobile_attribute" field.
+func MobileAttributeEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldMobileAttribute, v))
+}
+
+// MobileAttributeNEQ applies the NEQ predicate on the "mobile_attribute" field.
+func MobileAttributeNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldMobileAttribute, v))
+}
+
+// MobileAttributeIn applies the In predicate on the "mobile_attribute" field.
+func MobileAttributeIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldMobileAttribute, vs...))
+}
+
+// MobileAttributeNotIn applies the NotIn predicate on the "mobile_attribute" field.
+func MobileAttributeNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldMobileAttribute, vs...))
+}
+
+// MobileAttributeGT applies the GT predicate on the "mobile_attribute" field.
+func MobileAttributeGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldMobileAttribute, v))
+}
+
+// MobileAttributeGTE applies the GTE predicate on the "mobile_attribute" field.
+func MobileAttributeGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldMobileAttribute, v))
+}
+
+// MobileAttributeLT applies the LT predicate on the "mobile_attribute" field.
+func MobileAttributeLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldMobileAttribute, v))
+}
+
+// MobileAttributeLTE applies the LTE predicate on the "mobile_attribute" field.
+func MobileAttributeLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldMobileAttribute, v))
+}
+
+// MobileAttributeContains applies the Contains predicate on the "mobile_attribute" field.
+func MobileAttributeContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldMobileAttribute, v))
+}
+
+// MobileAttributeHasPrefix applies the HasPrefix predicate on the "mobile_attribute" field.
+func MobileAttributeHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldMobileAttribute, v))
+}
+
+// MobileAttributeHasSuffix applies the HasSuffix predicate on the "mobile_attribute" field.
+func MobileAttributeHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldMobileAttribute, v))
+}
+
+// MobileAttributeIsNil applies the IsNil predicate on the "mobile_attribute" field.
+func MobileAttributeIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldMobileAttribute))
+}
+
+// MobileAttributeNotNil applies the NotNil predicate on the "mobile_attribute" field.
+func MobileAttributeNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldMobileAttribute))
+}
+
+// MobileAttributeEqualFold applies the EqualFold predicate on the "mobile_attribute" field.
+func MobileAttributeEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldMobileAttribute, v))
+}
+
+// MobileAttributeContainsFold applies the ContainsFold predicate on the "mobile_attribute" field.
+func MobileAttributeContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldMobileAttribute, v))
+}
+
+// OrgUnitFilterEQ applies the EQ predicate on the "org_unit_filter" field.
+func OrgUnitFilterEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterNEQ applies the NEQ predicate on the "org_unit_filter" field.
+func OrgUnitFilterNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterIn applies the In predicate on the "org_unit_filter" field.
+func OrgUnitFilterIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldOrgUnitFilter, vs...))
+}
+
+// OrgUnitFilterNotIn applies the NotIn predicate on the "org_unit_filter" field.
+func OrgUnitFilterNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldOrgUnitFilter, vs...))
+}
+
+// OrgUnitFilterGT applies the GT predicate on the "org_unit_filter" field.
+func OrgUnitFilterGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterGTE applies the GTE predicate on the "org_unit_filter" field.
+func OrgUnitFilterGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterLT applies the LT predicate on the "org_unit_filter" field.
+func OrgUnitFilterLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterLTE applies the LTE predicate on the "org_unit_filter" field.
+func OrgUnitFilterLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterContains applies the Contains predicate on the "org_unit_filter" field.
+func OrgUnitFilterContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterHasPrefix applies the HasPrefix predicate on the "org_unit_filter" field.
+func OrgUnitFilterHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterHasSuffix applies the HasSuffix predicate on the "org_unit_filter" field.
+func OrgUnitFilterHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterIsNil applies the IsNil predicate on the "org_unit_filter" field.
+func OrgUnitFilterIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldOrgUnitFilter))
+}
+
+// OrgUnitFilterNotNil applies the NotNil predicate on the "org_unit_filter" field.
+func OrgUnitFilterNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldOrgUnitFilter))
+}
+
+// OrgUnitFilterEqualFold applies the EqualFold predicate on the "org_unit_filter" field.
+func OrgUnitFilterEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterContainsFold applies the ContainsFold predicate on the "org_unit_filter" field.
+func OrgUnitFilterContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldOrgUnitFilter, v))
+}
+
+// GroupBaseDnEQ applies the EQ predicate on the "group_base_dn" field.
+func GroupBaseDnEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnNEQ applies the NEQ predicate on the "group_base_dn" field.
+func GroupBaseDnNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnIn applies the In predicate on the "group_base_dn" field.
+func GroupBaseDnIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldGroupBaseDn, vs...))
+}
+
+// GroupBaseDnNotIn applies the NotIn predicate on the "group_base_dn" field.
+func GroupBaseDnNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldGroupBaseDn, vs...))
+}
+
+// GroupBaseDnGT applies the GT predicate on the "group_base_dn" field.
+func GroupBaseDnGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnGTE applies the GTE predicate on the "group_base_dn" field.
+func GroupBaseDnGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnLT applies the LT predicate on the "group_base_dn" field.
+func GroupBaseDnLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnLTE applies the LTE predicate on the "group_base_dn" field.
+func GroupBaseDnLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnContains applies the Contains predicate on the "group_base_dn" field.
+func GroupBaseDnContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnHasPrefix applies the HasPrefix predicate on the "group_base_dn" field.
+func GroupBaseDnHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnHasSuffix applies the HasSuffix predicate on the "group_base_dn" field.
+func GroupBaseDnHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnIsNil applies the IsNil predicate on the "group_base_dn" field.
+func GroupBaseDnIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldGroupBaseDn))
+}
+
+// GroupBaseDnNotNil applies the NotNil predicate on the "group_base_dn" field.
+func GroupBaseDnNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldGroupBaseDn))
+}
+
+// GroupBaseDnEqualFold applies the EqualFold predicate on the "group_base_dn" field.
+func GroupBaseDnEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldGroupBaseDn, v))
+}
+
+// GroupBaseDnContainsFold applies the ContainsFold predicate on the "group_base_dn" field.
+func GroupBaseDnContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldGroupBaseDn, v))
+}
+
+// GroupFilterEQ applies the EQ predicate on the "group_filter" field.
+func GroupFilterEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldGroupFilter, v))
+}
+
+// GroupFilterNEQ applies the NEQ predicate on the "group_filter" field.
+func GroupFilterNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldGroupFilter, v))
+}
+
+// GroupFilterIn applies the In predicate on the "group_filter" field.
+func GroupFilterIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldGroupFilter, vs...))
+}
+
+// GroupFilterNotIn applies the NotIn predicate on the "group_filter" field.
+func GroupFilterNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldGroupFilter, vs...))
+}
+
+// GroupFilterGT applies the GT predicate on the "group_filter" field.
+func GroupFilterGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldGroupFilter, v))
+}
+
+// GroupFilterGTE applies the GTE predicate on the "group_filter" field.
+func GroupFilterGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldGroupFilter, v))
+}
+
+// GroupFilterLT applies the LT predicate on the "group_filter" field.
+func GroupFilterLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldGroupFilter, v))
+}
+
+// GroupFilterLTE applies the LTE predicate on the "group_filter" field.
+func GroupFilterLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldGroupFilter, v))
+}
+
+// GroupFilterContains applies the Contains predicate on the "group_filter" field.
+func GroupFilterContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldGroupFilter, v))
+}
+
+// GroupFilterHasPrefix applies the HasPrefix predicate on the "group_filter" field.
+func GroupFilterHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldGroupFilter, v))
+}
+
+// GroupFilterHasSuffix applies the HasSuffix predicate on the "group_filter" field.
+func GroupFilterHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldGroupFilter, v))
+}
+
+// GroupFilterIsNil applies the IsNil predicate on the "group_filter" field.
+func GroupFilterIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldGroupFilter))
+}
+
+// GroupFilterNotNil applies the NotNil predicate on the "group_filter" field.
+func GroupFilterNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldGroupFilter))
+}
+
+// GroupFilterEqualFold applies the EqualFold predicate on the "group_filter" field.
+func GroupFilterEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldGroupFilter, v))
+}
+
+// GroupFilterContainsFold applies the ContainsFold predicate on the "group_filter" field.
+func GroupFilterContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldGroupFilter, v))
+}
+
+// GroupMemberAttributeEQ applies the EQ predicate on the "group_member_attribute" field.
+func GroupMemberAttributeEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeNEQ applies the NEQ predicate on the "group_member_attribute" field.
+func GroupMemberAttributeNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeIn applies the In predicate on the "group_member_attribute" field.
+func GroupMemberAttributeIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldGroupMemberAttribute, vs...))
+}
+
+// GroupMemberAttributeNotIn applies the NotIn predicate on the "group_member_attribute" field.
+func GroupMemberAttributeNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldGroupMemberAttribute, vs...))
+}
+
+// GroupMemberAttributeGT applies the GT predicate on the "group_member_attribute" field.
+func GroupMemberAttributeGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeGTE applies the GTE predicate on the "group_member_attribute" field.
+func GroupMemberAttributeGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeLT applies the LT predicate on the "group_member_attribute" field.
+func GroupMemberAttributeLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeLTE applies the LTE predicate on the "group_member_attribute" field.
+func GroupMemberAttributeLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeContains applies the Contains predicate on the "group_member_attribute" field.
+func GroupMemberAttributeContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeHasPrefix applies the HasPrefix predicate on the "group_member_attribute" field.
+func GroupMemberAttributeHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeHasSuffix applies the HasSuffix predicate on the "group_member_attribute" field.
+func GroupMemberAttributeHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeIsNil applies the IsNil predicate on the "group_member_attribute" field.
+func GroupMemberAttributeIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldGroupMemberAttribute))
+}
+
+// GroupMemberAttributeNotNil applies the NotNil predicate on the "group_member_attribute" field.
+func GroupMemberAttributeNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldGroupMemberAttribute))
+}
+
+// GroupMemberAttributeEqualFold applies the EqualFold predicate on the "group_member_attribute" field.
+func GroupMemberAttributeEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeContainsFold applies the ContainsFold predicate on the "group_member_attribute" field.
+func GroupMemberAttributeContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldGroupMemberAttribute, v))
+}
+
+// GroupRoleMappingsIsNil applies the IsNil predicate on the "group_role_mappings" field.
+func GroupRoleMappingsIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldGroupRoleMappings))
+}
+
+// GroupRoleMappingsNotNil applies the NotNil predicate on the "group_role_mappings" field.
+func GroupRoleMappingsNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldGroupRoleMappings))
+}
+
+// DefaultRoleIDEQ applies the EQ predicate on the "default_role_id" field.
+func DefaultRoleIDEQ(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDNEQ applies the NEQ predicate on the "default_role_id" field.
+func DefaultRoleIDNEQ(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDIn applies the In predicate on the "default_role_id" field.
+func DefaultRoleIDIn(vs ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldDefaultRoleID, vs...))
+}
+
+// DefaultRoleIDNotIn applies the NotIn predicate on the "default_role_id" field.
+func DefaultRoleIDNotIn(vs ...uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldDefaultRoleID, vs...))
+}
+
+// DefaultRoleIDGT applies the GT predicate on the "default_role_id" field.
+func DefaultRoleIDGT(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDGTE applies the GTE predicate on the "default_role_id" field.
+func DefaultRoleIDGTE(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDLT applies the LT predicate on the "default_role_id" field.
+func DefaultRoleIDLT(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDLTE applies the LTE predicate on the "default_role_id" field.
+func DefaultRoleIDLTE(v uint32) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDIsNil applies the IsNil predicate on the "default_role_id" field.
+func DefaultRoleIDIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldDefaultRoleID))
+}
+
+// DefaultRoleIDNotNil applies the NotNil predicate on the "default_role_id" field.
+func DefaultRoleIDNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldDefaultRoleID))
+}
+
+// SyncEnabledEQ applies the EQ predicate on the "sync_enabled" field.
+func SyncEnabledEQ(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldSyncEnabled, v))
+}
+
+// SyncEnabledNEQ applies the NEQ predicate on the "sync_enabled" field.
+func SyncEnabledNEQ(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldSyncEnabled, v))
+}
+
+// SyncEnabledIsNil applies the IsNil predicate on the "sync_enabled" field.
+func SyncEnabledIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldSyncEnabled))
+}
+
+// SyncEnabledNotNil applies the NotNil predicate on the "sync_enabled" field.
+func SyncEnabledNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldSyncEnabled))
+}
+
+// DisableMissingUsersEQ applies the EQ predicate on the "disable_missing_users" field.
+func DisableMissingUsersEQ(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldDisableMissingUsers, v))
+}
+
+// DisableMissingUsersNEQ applies the NEQ predicate on the "disable_missing_users" field.
+func DisableMissingUsersNEQ(v bool) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldDisableMissingUsers, v))
+}
+
+// DisableMissingUsersIsNil applies the IsNil predicate on the "disable_missing_users" field.
+func DisableMissingUsersIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldDisableMissingUsers))
+}
+
+// DisableMissingUsersNotNil applies the NotNil predicate on the "disable_missing_users" field.
+func DisableMissingUsersNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldDisableMissingUsers))
+}
+
+// LastSyncAtEQ applies the EQ predicate on the "last_sync_at" field.
+func LastSyncAtEQ(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncAtNEQ applies the NEQ predicate on the "last_sync_at" field.
+func LastSyncAtNEQ(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncAtIn applies the In predicate on the "last_sync_at" field.
+func LastSyncAtIn(vs ...time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldLastSyncAt, vs...))
+}
+
+// LastSyncAtNotIn applies the NotIn predicate on the "last_sync_at" field.
+func LastSyncAtNotIn(vs ...time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldLastSyncAt, vs...))
+}
+
+// LastSyncAtGT applies the GT predicate on the "last_sync_at" field.
+func LastSyncAtGT(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldLastSyncAt, v))
+}
+
+// LastSyncAtGTE applies the GTE predicate on the "last_sync_at" field.
+func LastSyncAtGTE(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldLastSyncAt, v))
+}
+
+// LastSyncAtLT applies the LT predicate on the "last_sync_at" field.
+func LastSyncAtLT(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldLastSyncAt, v))
+}
+
+// LastSyncAtLTE applies the LTE predicate on the "last_sync_at" field.
+func LastSyncAtLTE(v time.Time) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldLastSyncAt, v))
+}
+
+// LastSyncAtIsNil applies the IsNil predicate on the "last_sync_at" field.
+func LastSyncAtIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldLastSyncAt))
+}
+
+// LastSyncAtNotNil applies the NotNil predicate on the "last_sync_at" field.
+func LastSyncAtNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldLastSyncAt))
+}
+
+// LastSyncErrorEQ applies the EQ predicate on the "last_sync_error" field.
+func LastSyncErrorEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEQ(FieldLastSyncError, v))
+}
+
+// LastSyncErrorNEQ applies the NEQ predicate on the "last_sync_error" field.
+func LastSyncErrorNEQ(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNEQ(FieldLastSyncError, v))
+}
+
+// LastSyncErrorIn applies the In predicate on the "last_sync_error" field.
+func LastSyncErrorIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIn(FieldLastSyncError, vs...))
+}
+
+// LastSyncErrorNotIn applies the NotIn predicate on the "last_sync_error" field.
+func LastSyncErrorNotIn(vs ...string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotIn(FieldLastSyncError, vs...))
+}
+
+// LastSyncErrorGT applies the GT predicate on the "last_sync_error" field.
+func LastSyncErrorGT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGT(FieldLastSyncError, v))
+}
+
+// LastSyncErrorGTE applies the GTE predicate on the "last_sync_error" field.
+func LastSyncErrorGTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldGTE(FieldLastSyncError, v))
+}
+
+// LastSyncErrorLT applies the LT predicate on the "last_sync_error" field.
+func LastSyncErrorLT(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLT(FieldLastSyncError, v))
+}
+
+// LastSyncErrorLTE applies the LTE predicate on the "last_sync_error" field.
+func LastSyncErrorLTE(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldLTE(FieldLastSyncError, v))
+}
+
+// LastSyncErrorContains applies the Contains predicate on the "last_sync_error" field.
+func LastSyncErrorContains(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContains(FieldLastSyncError, v))
+}
+
+// LastSyncErrorHasPrefix applies the HasPrefix predicate on the "last_sync_error" field.
+func LastSyncErrorHasPrefix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasPrefix(FieldLastSyncError, v))
+}
+
+// LastSyncErrorHasSuffix applies the HasSuffix predicate on the "last_sync_error" field.
+func LastSyncErrorHasSuffix(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldHasSuffix(FieldLastSyncError, v))
+}
+
+// LastSyncErrorIsNil applies the IsNil predicate on the "last_sync_error" field.
+func LastSyncErrorIsNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldIsNull(FieldLastSyncError))
+}
+
+// LastSyncErrorNotNil applies the NotNil predicate on the "last_sync_error" field.
+func LastSyncErrorNotNil() predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldNotNull(FieldLastSyncError))
+}
+
+// LastSyncErrorEqualFold applies the EqualFold predicate on the "last_sync_error" field.
+func LastSyncErrorEqualFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldEqualFold(FieldLastSyncError, v))
+}
+
+// LastSyncErrorContainsFold applies the ContainsFold predicate on the "last_sync_error" field.
+func LastSyncErrorContainsFold(v string) predicate.LdapSource {
+	return predicate.LdapSource(sql.FieldContainsFold(FieldLastSyncError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LdapSource) predicate.LdapSource {
+	return predicate.LdapSource(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LdapSource) predicate.LdapSource {
+	return predicate.LdapSource(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LdapSource) predicate.LdapSource {
+	return predicate.LdapSource(sql.NotPredicates(p))
+}
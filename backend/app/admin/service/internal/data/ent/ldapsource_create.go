@@ -0,0 +1,2803 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapsource"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LdapSourceCreate is the builder for creating a LdapSource entity.
+type LdapSourceCreate struct {
+	config
+	mutation *LdapSourceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LdapSourceCreate) SetCreatedAt(v time.Time) *LdapSourceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableCreatedAt(v *time.Time) *LdapSourceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LdapSourceCreate) SetUpdatedAt(v time.Time) *LdapSourceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableUpdatedAt(v *time.Time) *LdapSourceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *LdapSourceCreate) SetDeletedAt(v time.Time) *LdapSourceCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableDeletedAt(v *time.Time) *LdapSourceCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *LdapSourceCreate) SetCreatedBy(v uint32) *LdapSourceCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableCreatedBy(v *uint32) *LdapSourceCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *LdapSourceCreate) SetUpdatedBy(v uint32) *LdapSourceCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableUpdatedBy(v *uint32) *LdapSourceCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *LdapSourceCreate) SetDeletedBy(v uint32) *LdapSourceCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableDeletedBy(v *uint32) *LdapSourceCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LdapSourceCreate) SetTenantID(v uint32) *LdapSourceCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableTenantID(v *uint32) *LdapSourceCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *LdapSourceCreate) SetName(v string) *LdapSourceCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableName(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *LdapSourceCreate) SetEnabled(v bool) *LdapSourceCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableEnabled(v *bool) *LdapSourceCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *LdapSourceCreate) SetURL(v string) *LdapSourceCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (_c *LdapSourceCreate) SetStartTLS(v bool) *LdapSourceCreate {
+	_c.mutation.SetStartTLS(v)
+	return _c
+}
+
+// SetNillableStartTLS sets the "start_tls" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableStartTLS(v *bool) *LdapSourceCreate {
+	if v != nil {
+		_c.SetStartTLS(*v)
+	}
+	return _c
+}
+
+// SetInsecureSkipVerify sets the "insecure_skip_verify" field.
+func (_c *LdapSourceCreate) SetInsecureSkipVerify(v bool) *LdapSourceCreate {
+	_c.mutation.SetInsecureSkipVerify(v)
+	return _c
+}
+
+// SetNillableInsecureSkipVerify sets the "insecure_skip_verify" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableInsecureSkipVerify(v *bool) *LdapSourceCreate {
+	if v != nil {
+		_c.SetInsecureSkipVerify(*v)
+	}
+	return _c
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (_c *LdapSourceCreate) SetBindDn(v string) *LdapSourceCreate {
+	_c.mutation.SetBindDn(v)
+	return _c
+}
+
+// SetNillableBindDn sets the "bind_dn" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableBindDn(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetBindDn(*v)
+	}
+	return _c
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (_c *LdapSourceCreate) SetBindPassword(v string) *LdapSourceCreate {
+	_c.mutation.SetBindPassword(v)
+	return _c
+}
+
+// SetNillableBindPassword sets the "bind_password" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableBindPassword(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetBindPassword(*v)
+	}
+	return _c
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (_c *LdapSourceCreate) SetBaseDn(v string) *LdapSourceCreate {
+	_c.mutation.SetBaseDn(v)
+	return _c
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (_c *LdapSourceCreate) SetUserFilter(v string) *LdapSourceCreate {
+	_c.mutation.SetUserFilter(v)
+	return _c
+}
+
+// SetNillableUserFilter sets the "user_filter" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableUserFilter(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetUserFilter(*v)
+	}
+	return _c
+}
+
+// SetLoginAttribute sets the "login_attribute" field.
+func (_c *LdapSourceCreate) SetLoginAttribute(v string) *LdapSourceCreate {
+	_c.mutation.SetLoginAttribute(v)
+	return _c
+}
+
+// SetNillableLoginAttribute sets the "login_attribute" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableLoginAttribute(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetLoginAttribute(*v)
+	}
+	return _c
+}
+
+// SetEmailAttribute sets the "email_attribute" field.
+func (_c *LdapSourceCreate) SetEmailAttribute(v string) *LdapSourceCreate {
+	_c.mutation.SetEmailAttribute(v)
+	return _c
+}
+
+// SetNillableEmailAttribute sets the "email_attribute" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableEmailAttribute(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetEmailAttribute(*v)
+	}
+	return _c
+}
+
+// SetDisplayNameAttribute sets the "display_name_attribute" field.
+func (_c *LdapSourceCreate) SetDisplayNameAttribute(v string) *LdapSourceCreate {
+	_c.mutation.SetDisplayNameAttribute(v)
+	return _c
+}
+
+// SetNillableDisplayNameAttribute sets the "display_name_attribute" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableDisplayNameAttribute(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetDisplayNameAttribute(*v)
+	}
+	return _c
+}
+
+// SetMobileAttribute sets the "mobile_attribute" field.
+func (_c *LdapSourceCreate) SetMobileAttribute(v string) *LdapSourceCreate {
+	_c.mutation.SetMobileAttribute(v)
+	return _c
+}
+
+// SetNillableMobileAttribute sets the "mobile_attribute" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableMobileAttribute(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetMobileAttribute(*v)
+	}
+	return _c
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (_c *LdapSourceCreate) SetOrgUnitFilter(v string) *LdapSourceCreate {
+	_c.mutation.SetOrgUnitFilter(v)
+	return _c
+}
+
+// SetNillableOrgUnitFilter sets the "org_unit_filter" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableOrgUnitFilter(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetOrgUnitFilter(*v)
+	}
+	return _c
+}
+
+// SetGroupBaseDn sets the "group_base_dn" field.
+func (_c *LdapSourceCreate) SetGroupBaseDn(v string) *LdapSourceCreate {
+	_c.mutation.SetGroupBaseDn(v)
+	return _c
+}
+
+// SetNillableGroupBaseDn sets the "group_base_dn" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableGroupBaseDn(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetGroupBaseDn(*v)
+	}
+	return _c
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (_c *LdapSourceCreate) SetGroupFilter(v string) *LdapSourceCreate {
+	_c.mutation.SetGroupFilter(v)
+	return _c
+}
+
+// SetNillableGroupFilter sets the "group_filter" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableGroupFilter(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetGroupFilter(*v)
+	}
+	return _c
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (_c *LdapSourceCreate) SetGroupMemberAttribute(v string) *LdapSourceCreate {
+	_c.mutation.SetGroupMemberAttribute(v)
+	return _c
+}
+
+// SetNillableGroupMemberAttribute sets the "group_member_attribute" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableGroupMemberAttribute(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetGroupMemberAttribute(*v)
+	}
+	return _c
+}
+
+// SetGroupRoleMappings sets the "group_role_mappings" field.
+func (_c *LdapSourceCreate) SetGroupRoleMappings(v map[string]uint32) *LdapSourceCreate {
+	_c.mutation.SetGroupRoleMappings(v)
+	return _c
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_c *LdapSourceCreate) SetDefaultRoleID(v uint32) *LdapSourceCreate {
+	_c.mutation.SetDefaultRoleID(v)
+	return _c
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableDefaultRoleID(v *uint32) *LdapSourceCreate {
+	if v != nil {
+		_c.SetDefaultRoleID(*v)
+	}
+	return _c
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (_c *LdapSourceCreate) SetSyncEnabled(v bool) *LdapSourceCreate {
+	_c.mutation.SetSyncEnabled(v)
+	return _c
+}
+
+// SetNillableSyncEnabled sets the "sync_enabled" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableSyncEnabled(v *bool) *LdapSourceCreate {
+	if v != nil {
+		_c.SetSyncEnabled(*v)
+	}
+	return _c
+}
+
+// SetDisableMissingUsers sets the "disable_missing_users" field.
+func (_c *LdapSourceCreate) SetDisableMissingUsers(v bool) *LdapSourceCreate {
+	_c.mutation.SetDisableMissingUsers(v)
+	return _c
+}
+
+// SetNillableDisableMissingUsers sets the "disable_missing_users" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableDisableMissingUsers(v *bool) *LdapSourceCreate {
+	if v != nil {
+		_c.SetDisableMissingUsers(*v)
+	}
+	return _c
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_c *LdapSourceCreate) SetLastSyncAt(v time.Time) *LdapSourceCreate {
+	_c.mutation.SetLastSyncAt(v)
+	return _c
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableLastSyncAt(v *time.Time) *LdapSourceCreate {
+	if v != nil {
+		_c.SetLastSyncAt(*v)
+	}
+	return _c
+}
+
+// SetLastSyncError sets the "last_sync_error" field.
+func (_c *LdapSourceCreate) SetLastSyncError(v string) *LdapSourceCreate {
+	_c.mutation.SetLastSyncError(v)
+	return _c
+}
+
+// SetNillableLastSyncError sets the "last_sync_error" field if the given value is not nil.
+func (_c *LdapSourceCreate) SetNillableLastSyncError(v *string) *LdapSourceCreate {
+	if v != nil {
+		_c.SetLastSyncError(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LdapSourceCreate) SetID(v uint32) *LdapSourceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LdapSourceMutation object of the builder.
+func (_c *LdapSourceCreate) Mutation() *LdapSourceMutation {
+	return _c.mutation
+}
+
+// Save creates the LdapSource in the database.
+func (_c *LdapSourceCreate) Save(ctx context.Context) (*LdapSource, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LdapSourceCreate) SaveX(ctx context.Context) *LdapSource {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapSourceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapSourceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LdapSourceCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := ldapsource.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := ldapsource.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.StartTLS(); !ok {
+		v := ldapsource.DefaultStartTLS
+		_c.mutation.SetStartTLS(v)
+	}
+	if _, ok := _c.mutation.InsecureSkipVerify(); !ok {
+		v := ldapsource.DefaultInsecureSkipVerify
+		_c.mutation.SetInsecureSkipVerify(v)
+	}
+	if _, ok := _c.mutation.DefaultRoleID(); !ok {
+		v := ldapsource.DefaultDefaultRoleID
+		_c.mutation.SetDefaultRoleID(v)
+	}
+	if _, ok := _c.mutation.SyncEnabled(); !ok {
+		v := ldapsource.DefaultSyncEnabled
+		_c.mutation.SetSyncEnabled(v)
+	}
+	if _, ok := _c.mutation.DisableMissingUsers(); !ok {
+		v := ldapsource.DefaultDisableMissingUsers
+		_c.mutation.SetDisableMissingUsers(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LdapSourceCreate) check() error {
+	if v, ok := _c.mutation.Name(); ok {
+		if err := ldapsource.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LdapSource.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "LdapSource.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := ldapsource.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LdapSource.url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BindDn(); ok {
+		if err := ldapsource.BindDnValidator(v); err != nil {
+			return &ValidationError{Name: "bind_dn", err: fmt.Errorf(`ent: validator failed for field "LdapSource.bind_dn": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BindPassword(); ok {
+		if err := ldapsource.BindPasswordValidator(v); err != nil {
+			return &ValidationError{Name: "bind_password", err: fmt.Errorf(`ent: validator failed for field "LdapSource.bind_password": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BaseDn(); !ok {
+		return &ValidationError{Name: "base_dn", err: errors.New(`ent: missing required field "LdapSource.base_dn"`)}
+	}
+	if v, ok := _c.mutation.BaseDn(); ok {
+		if err := ldapsource.BaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapSource.base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserFilter(); ok {
+		if err := ldapsource.UserFilterValidator(v); err != nil {
+			return &ValidationError{Name: "user_filter", err: fmt.Errorf(`ent: validator failed for field "LdapSource.user_filter": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LoginAttribute(); ok {
+		if err := ldapsource.LoginAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "login_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.login_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EmailAttribute(); ok {
+		if err := ldapsource.EmailAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "email_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.email_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DisplayNameAttribute(); ok {
+		if err := ldapsource.DisplayNameAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "display_name_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.display_name_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MobileAttribute(); ok {
+		if err := ldapsource.MobileAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "mobile_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.mobile_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.OrgUnitFilter(); ok {
+		if err := ldapsource.OrgUnitFilterValidator(v); err != nil {
+			return &ValidationError{Name: "org_unit_filter", err: fmt.Errorf(`ent: validator failed for field "LdapSource.org_unit_filter": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GroupBaseDn(); ok {
+		if err := ldapsource.GroupBaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "group_base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapSource.group_base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GroupFilter(); ok {
+		if err := ldapsource.GroupFilterValidator(v); err != nil {
+			return &ValidationError{Name: "group_filter", err: fmt.Errorf(`ent: validator failed for field "LdapSource.group_filter": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GroupMemberAttribute(); ok {
+		if err := ldapsource.GroupMemberAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "group_member_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.group_member_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastSyncError(); ok {
+		if err := ldapsource.LastSyncErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_sync_error", err: fmt.Errorf(`ent: validator failed for field "LdapSource.last_sync_error": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := ldapsource.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LdapSource.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LdapSourceCreate) sqlSave(ctx context.Context) (*LdapSource, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LdapSourceCreate) createSpec() (*LdapSource, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LdapSource{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ldapsource.Table, sqlgraph.NewFieldSpec(ldapsource.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ldapsource.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapsource.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(ldapsource.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(ldapsource.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(ldapsource.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(ldapsource.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(ldapsource.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(ldapsource.FieldName, field.TypeString, value)
+		_node.Name = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(ldapsource.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = &value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(ldapsource.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.StartTLS(); ok {
+		_spec.SetField(ldapsource.FieldStartTLS, field.TypeBool, value)
+		_node.StartTLS = &value
+	}
+	if value, ok := _c.mutation.InsecureSkipVerify(); ok {
+		_spec.SetField(ldapsource.FieldInsecureSkipVerify, field.TypeBool, value)
+		_node.InsecureSkipVerify = &value
+	}
+	if value, ok := _c.mutation.BindDn(); ok {
+		_spec.SetField(ldapsource.FieldBindDn, field.TypeString, value)
+		_node.BindDn = &value
+	}
+	if value, ok := _c.mutation.BindPassword(); ok {
+		_spec.SetField(ldapsource.FieldBindPassword, field.TypeString, value)
+		_node.BindPassword = &value
+	}
+	if value, ok := _c.mutation.BaseDn(); ok {
+		_spec.SetField(ldapsource.FieldBaseDn, field.TypeString, value)
+		_node.BaseDn = value
+	}
+	if value, ok := _c.mutation.UserFilter(); ok {
+		_spec.SetField(ldapsource.FieldUserFilter, field.TypeString, value)
+		_node.UserFilter = &value
+	}
+	if value, ok := _c.mutation.LoginAttribute(); ok {
+		_spec.SetField(ldapsource.FieldLoginAttribute, field.TypeString, value)
+		_node.LoginAttribute = &value
+	}
+	if value, ok := _c.mutation.EmailAttribute(); ok {
+		_spec.SetField(ldapsource.FieldEmailAttribute, field.TypeString, value)
+		_node.EmailAttribute = &value
+	}
+	if value, ok := _c.mutation.DisplayNameAttribute(); ok {
+		_spec.SetField(ldapsource.FieldDisplayNameAttribute, field.TypeString, value)
+		_node.DisplayNameAttribute = &value
+	}
+	if value, ok := _c.mutation.MobileAttribute(); ok {
+		_spec.SetField(ldapsource.FieldMobileAttribute, field.TypeString, value)
+		_node.MobileAttribute = &value
+	}
+	if value, ok := _c.mutation.OrgUnitFilter(); ok {
+		_spec.SetField(ldapsource.FieldOrgUnitFilter, field.TypeString, value)
+		_node.OrgUnitFilter = &value
+	}
+	if value, ok := _c.mutation.GroupBaseDn(); ok {
+		_spec.SetField(ldapsource.FieldGroupBaseDn, field.TypeString, value)
+		_node.GroupBaseDn = &value
+	}
+	if value, ok := _c.mutation.GroupFilter(); ok {
+		_spec.SetField(ldapsource.FieldGroupFilter, field.TypeString, value)
+		_node.GroupFilter = &value
+	}
+	if value, ok := _c.mutation.GroupMemberAttribute(); ok {
+		_spec.SetField(ldapsource.FieldGroupMemberAttribute, field.TypeString, value)
+		_node.GroupMemberAttribute = &value
+	}
+	if value, ok := _c.mutation.GroupRoleMappings(); ok {
+		_spec.SetField(ldapsource.FieldGroupRoleMappings, field.TypeJSON, value)
+		_node.GroupRoleMappings = value
+	}
+	if value, ok := _c.mutation.DefaultRoleID(); ok {
+		_spec.SetField(ldapsource.FieldDefaultRoleID, field.TypeUint32, value)
+		_node.DefaultRoleID = &value
+	}
+	if value, ok := _c.mutation.SyncEnabled(); ok {
+		_spec.SetField(ldapsource.FieldSyncEnabled, field.TypeBool, value)
+		_node.SyncEnabled = &value
+	}
+	if value, ok := _c.mutation.DisableMissingUsers(); ok {
+		_spec.SetField(ldapsource.FieldDisableMissingUsers, field.TypeBool, value)
+		_node.DisableMissingUsers = &value
+	}
+	if value, ok := _c.mutation.LastSyncAt(); ok {
+		_spec.SetField(ldapsource.FieldLastSyncAt, field.TypeTime, value)
+		_node.LastSyncAt = &value
+	}
+	if value, ok := _c.mutation.LastSyncError(); ok {
+		_spec.SetField(ldapsource.FieldLastSyncError, field.TypeString, value)
+		_node.LastSyncError = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LdapSource.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LdapSourceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LdapSourceCreate) OnConflict(opts ...sql.ConflictOption) *LdapSourceUpsertOne {
+	_c.conflict = opts
+	return &LdapSourceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LdapSource.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LdapSourceCreate) OnConflictColumns(columns ...string) *LdapSourceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LdapSourceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LdapSourceUpsertOne is the builder for "upsert"-ing
+	//  one LdapSource node.
+	LdapSourceUpsertOne struct {
+		create *LdapSourceCreate
+	}
+
+	// LdapSourceUpsert is the "OnConflict" setter.
+	LdapSourceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LdapSourceUpsert) SetUpdatedAt(v time.Time) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateUpdatedAt() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *LdapSourceUpsert) ClearUpdatedAt() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LdapSourceUpsert) SetDeletedAt(v time.Time) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateDeletedAt() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LdapSourceUpsert) ClearDeletedAt() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LdapSourceUpsert) SetCreatedBy(v uint32) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateCreatedBy() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LdapSourceUpsert) AddCreatedBy(v uint32) *LdapSourceUpsert {
+	u.Add(ldapsource.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LdapSourceUpsert) ClearCreatedBy() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *LdapSourceUpsert) SetUpdatedBy(v uint32) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateUpdatedBy() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *LdapSourceUpsert) AddUpdatedBy(v uint32) *LdapSourceUpsert {
+	u.Add(ldapsource.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *LdapSourceUpsert) ClearUpdatedBy() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *LdapSourceUpsert) SetDeletedBy(v uint32) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateDeletedBy() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *LdapSourceUpsert) AddDeletedBy(v uint32) *LdapSourceUpsert {
+	u.Add(ldapsource.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *LdapSourceUpsert) ClearDeletedBy() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldDeletedBy)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *LdapSourceUpsert) SetName(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateName() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *LdapSourceUpsert) ClearName() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldName)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *LdapSourceUpsert) SetEnabled(v bool) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateEnabled() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldEnabled)
+	return u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *LdapSourceUpsert) ClearEnabled() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldEnabled)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *LdapSourceUpsert) SetURL(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateURL() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldURL)
+	return u
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (u *LdapSourceUpsert) SetStartTLS(v bool) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldStartTLS, v)
+	return u
+}
+
+// UpdateStartTLS sets the "start_tls" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateStartTLS() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldStartTLS)
+	return u
+}
+
+// ClearStartTLS clears the value of the "start_tls" field.
+func (u *LdapSourceUpsert) ClearStartTLS() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldStartTLS)
+	return u
+}
+
+// SetInsecureSkipVerify sets the "insecure_skip_verify" field.
+func (u *LdapSourceUpsert) SetInsecureSkipVerify(v bool) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldInsecureSkipVerify, v)
+	return u
+}
+
+// UpdateInsecureSkipVerify sets the "insecure_skip_verify" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateInsecureSkipVerify() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldInsecureSkipVerify)
+	return u
+}
+
+// ClearInsecureSkipVerify clears the value of the "insecure_skip_verify" field.
+func (u *LdapSourceUpsert) ClearInsecureSkipVerify() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldInsecureSkipVerify)
+	return u
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (u *LdapSourceUpsert) SetBindDn(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldBindDn, v)
+	return u
+}
+
+// UpdateBindDn sets the "bind_dn" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateBindDn() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldBindDn)
+	return u
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (u *LdapSourceUpsert) ClearBindDn() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldBindDn)
+	return u
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (u *LdapSourceUpsert) SetBindPassword(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldBindPassword, v)
+	return u
+}
+
+// UpdateBindPassword sets the "bind_password" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateBindPassword() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldBindPassword)
+	return u
+}
+
+// ClearBindPassword clears the value of the "bind_password" field.
+func (u *LdapSourceUpsert) ClearBindPassword() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldBindPassword)
+	return u
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (u *LdapSourceUpsert) SetBaseDn(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldBaseDn, v)
+	return u
+}
+
+// UpdateBaseDn sets the "base_dn" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateBaseDn() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldBaseDn)
+	return u
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (u *LdapSourceUpsert) SetUserFilter(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldUserFilter, v)
+	return u
+}
+
+// UpdateUserFilter sets the "user_filter" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateUserFilter() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldUserFilter)
+	return u
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (u *LdapSourceUpsert) ClearUserFilter() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldUserFilter)
+	return u
+}
+
+// SetLoginAttribute sets the "login_attribute" field.
+func (u *LdapSourceUpsert) SetLoginAttribute(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldLoginAttribute, v)
+	return u
+}
+
+// UpdateLoginAttribute sets the "login_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateLoginAttribute() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldLoginAttribute)
+	return u
+}
+
+// ClearLoginAttribute clears the value of the "login_attribute" field.
+func (u *LdapSourceUpsert) ClearLoginAttribute() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldLoginAttribute)
+	return u
+}
+
+// SetEmailAttribute sets the "email_attribute" field.
+func (u *LdapSourceUpsert) SetEmailAttribute(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldEmailAttribute, v)
+	return u
+}
+
+// UpdateEmailAttribute sets the "email_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateEmailAttribute() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldEmailAttribute)
+	return u
+}
+
+// ClearEmailAttribute clears the value of the "email_attribute" field.
+func (u *LdapSourceUpsert) ClearEmailAttribute() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldEmailAttribute)
+	return u
+}
+
+// SetDisplayNameAttribute sets the "display_name_attribute" field.
+func (u *LdapSourceUpsert) SetDisplayNameAttribute(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldDisplayNameAttribute, v)
+	return u
+}
+
+// UpdateDisplayNameAttribute sets the "display_name_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateDisplayNameAttribute() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldDisplayNameAttribute)
+	return u
+}
+
+// ClearDisplayNameAttribute clears the value of the "display_name_attribute" field.
+func (u *LdapSourceUpsert) ClearDisplayNameAttribute() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldDisplayNameAttribute)
+	return u
+}
+
+// SetMobileAttribute sets the "mobile_attribute" field.
+func (u *LdapSourceUpsert) SetMobileAttribute(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldMobileAttribute, v)
+	return u
+}
+
+// UpdateMobileAttribute sets the "mobile_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateMobileAttribute() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldMobileAttribute)
+	return u
+}
+
+// ClearMobileAttribute clears the value of the "mobile_attribute" field.
+func (u *LdapSourceUpsert) ClearMobileAttribute() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldMobileAttribute)
+	return u
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (u *LdapSourceUpsert) SetOrgUnitFilter(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldOrgUnitFilter, v)
+	return u
+}
+
+// UpdateOrgUnitFilter sets the "org_unit_filter" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateOrgUnitFilter() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldOrgUnitFilter)
+	return u
+}
+
+// ClearOrgUnitFilter clears the value of the "org_unit_filter" field.
+func (u *LdapSourceUpsert) ClearOrgUnitFilter() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldOrgUnitFilter)
+	return u
+}
+
+// SetGroupBaseDn sets the "group_base_dn" field.
+func (u *LdapSourceUpsert) SetGroupBaseDn(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldGroupBaseDn, v)
+	return u
+}
+
+// UpdateGroupBaseDn sets the "group_base_dn" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateGroupBaseDn() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldGroupBaseDn)
+	return u
+}
+
+// ClearGroupBaseDn clears the value of the "group_base_dn" field.
+func (u *LdapSourceUpsert) ClearGroupBaseDn() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldGroupBaseDn)
+	return u
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (u *LdapSourceUpsert) SetGroupFilter(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldGroupFilter, v)
+	return u
+}
+
+// UpdateGroupFilter sets the "group_filter" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateGroupFilter() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldGroupFilter)
+	return u
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (u *LdapSourceUpsert) ClearGroupFilter() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldGroupFilter)
+	return u
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (u *LdapSourceUpsert) SetGroupMemberAttribute(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldGroupMemberAttribute, v)
+	return u
+}
+
+// UpdateGroupMemberAttribute sets the "group_member_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateGroupMemberAttribute() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldGroupMemberAttribute)
+	return u
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (u *LdapSourceUpsert) ClearGroupMemberAttribute() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldGroupMemberAttribute)
+	return u
+}
+
+// SetGroupRoleMappings sets the "group_role_mappings" field.
+func (u *LdapSourceUpsert) SetGroupRoleMappings(v map[string]uint32) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldGroupRoleMappings, v)
+	return u
+}
+
+// UpdateGroupRoleMappings sets the "group_role_mappings" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateGroupRoleMappings() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldGroupRoleMappings)
+	return u
+}
+
+// ClearGroupRoleMappings clears the value of the "group_role_mappings" field.
+func (u *LdapSourceUpsert) ClearGroupRoleMappings() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldGroupRoleMappings)
+	return u
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (u *LdapSourceUpsert) SetDefaultRoleID(v uint32) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldDefaultRoleID, v)
+	return u
+}
+
+// UpdateDefaultRoleID sets the "default_role_id" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateDefaultRoleID() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldDefaultRoleID)
+	return u
+}
+
+// AddDefaultRoleID adds v to the "default_role_id" field.
+func (u *LdapSourceUpsert) AddDefaultRoleID(v uint32) *LdapSourceUpsert {
+	u.Add(ldapsource.FieldDefaultRoleID, v)
+	return u
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (u *LdapSourceUpsert) ClearDefaultRoleID() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldDefaultRoleID)
+	return u
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (u *LdapSourceUpsert) SetSyncEnabled(v bool) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldSyncEnabled, v)
+	return u
+}
+
+// UpdateSyncEnabled sets the "sync_enabled" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateSyncEnabled() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldSyncEnabled)
+	return u
+}
+
+// ClearSyncEnabled clears the value of the "sync_enabled" field.
+func (u *LdapSourceUpsert) ClearSyncEnabled() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldSyncEnabled)
+	return u
+}
+
+// SetDisableMissingUsers sets the "disable_missing_users" field.
+func (u *LdapSourceUpsert) SetDisableMissingUsers(v bool) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldDisableMissingUsers, v)
+	return u
+}
+
+// UpdateDisableMissingUsers sets the "disable_missing_users" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateDisableMissingUsers() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldDisableMissingUsers)
+	return u
+}
+
+// ClearDisableMissingUsers clears the value of the "disable_missing_users" field.
+func (u *LdapSourceUpsert) ClearDisableMissingUsers() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldDisableMissingUsers)
+	return u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *LdapSourceUpsert) SetLastSyncAt(v time.Time) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldLastSyncAt, v)
+	return u
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateLastSyncAt() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldLastSyncAt)
+	return u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *LdapSourceUpsert) ClearLastSyncAt() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldLastSyncAt)
+	return u
+}
+
+// SetLastSyncError sets the "last_sync_error" field.
+func (u *LdapSourceUpsert) SetLastSyncError(v string) *LdapSourceUpsert {
+	u.Set(ldapsource.FieldLastSyncError, v)
+	return u
+}
+
+// UpdateLastSyncError sets the "last_sync_error" field to the value that was provided on create.
+func (u *LdapSourceUpsert) UpdateLastSyncError() *LdapSourceUpsert {
+	u.SetExcluded(ldapsource.FieldLastSyncError)
+	return u
+}
+
+// ClearLastSyncError clears the value of the "last_sync_error" field.
+func (u *LdapSourceUpsert) ClearLastSyncError() *LdapSourceUpsert {
+	u.SetNull(ldapsource.FieldLastSyncError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LdapSource.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ldapsource.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LdapSourceUpsertOne) UpdateNewValues() *LdapSourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ldapsource.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ldapsource.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(ldapsource.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LdapSource.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LdapSourceUpsertOne) Ignore() *LdapSourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LdapSourceUpsertOne) DoNothing() *LdapSourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LdapSourceCreate.OnConflict
+// documentation for more info.
+func (u *LdapSourceUpsertOne) Update(set func(*LdapSourceUpsert)) *LdapSourceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LdapSourceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LdapSourceUpsertOne) SetUpdatedAt(v time.Time) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateUpdatedAt() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *LdapSourceUpsertOne) ClearUpdatedAt() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LdapSourceUpsertOne) SetDeletedAt(v time.Time) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateDeletedAt() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LdapSourceUpsertOne) ClearDeletedAt() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LdapSourceUpsertOne) SetCreatedBy(v uint32) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LdapSourceUpsertOne) AddCreatedBy(v uint32) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateCreatedBy() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LdapSourceUpsertOne) ClearCreatedBy() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *LdapSourceUpsertOne) SetUpdatedBy(v uint32) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *LdapSourceUpsertOne) AddUpdatedBy(v uint32) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateUpdatedBy() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *LdapSourceUpsertOne) ClearUpdatedBy() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *LdapSourceUpsertOne) SetDeletedBy(v uint32) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *LdapSourceUpsertOne) AddDeletedBy(v uint32) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateDeletedBy() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *LdapSourceUpsertOne) ClearDeletedBy() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LdapSourceUpsertOne) SetName(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateName() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *LdapSourceUpsertOne) ClearName() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *LdapSourceUpsertOne) SetEnabled(v bool) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateEnabled() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *LdapSourceUpsertOne) ClearEnabled() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearEnabled()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *LdapSourceUpsertOne) SetURL(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateURL() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (u *LdapSourceUpsertOne) SetStartTLS(v bool) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetStartTLS(v)
+	})
+}
+
+// UpdateStartTLS sets the "start_tls" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateStartTLS() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateStartTLS()
+	})
+}
+
+// ClearStartTLS clears the value of the "start_tls" field.
+func (u *LdapSourceUpsertOne) ClearStartTLS() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearStartTLS()
+	})
+}
+
+// SetInsecureSkipVerify sets the "insecure_skip_verify" field.
+func (u *LdapSourceUpsertOne) SetInsecureSkipVerify(v bool) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetInsecureSkipVerify(v)
+	})
+}
+
+// UpdateInsecureSkipVerify sets the "insecure_skip_verify" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateInsecureSkipVerify() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateInsecureSkipVerify()
+	})
+}
+
+// ClearInsecureSkipVerify clears the value of the "insecure_skip_verify" field.
+func (u *LdapSourceUpsertOne) ClearInsecureSkipVerify() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearInsecureSkipVerify()
+	})
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (u *LdapSourceUpsertOne) SetBindDn(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetBindDn(v)
+	})
+}
+
+// UpdateBindDn sets the "bind_dn" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateBindDn() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateBindDn()
+	})
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (u *LdapSourceUpsertOne) ClearBindDn() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearBindDn()
+	})
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (u *LdapSourceUpsertOne) SetBindPassword(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetBindPassword(v)
+	})
+}
+
+// UpdateBindPassword sets the "bind_password" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateBindPassword() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateBindPassword()
+	})
+}
+
+// ClearBindPassword clears the value of the "bind_password" field.
+func (u *LdapSourceUpsertOne) ClearBindPassword() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearBindPassword()
+	})
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (u *LdapSourceUpsertOne) SetBaseDn(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetBaseDn(v)
+	})
+}
+
+// UpdateBaseDn sets the "base_dn" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateBaseDn() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateBaseDn()
+	})
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (u *LdapSourceUpsertOne) SetUserFilter(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetUserFilter(v)
+	})
+}
+
+// UpdateUserFilter sets the "user_filter" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateUserFilter() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateUserFilter()
+	})
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (u *LdapSourceUpsertOne) ClearUserFilter() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearUserFilter()
+	})
+}
+
+// SetLoginAttribute sets the "login_attribute" field.
+func (u *LdapSourceUpsertOne) SetLoginAttribute(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetLoginAttribute(v)
+	})
+}
+
+// UpdateLoginAttribute sets the "login_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateLoginAttribute() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateLoginAttribute()
+	})
+}
+
+// ClearLoginAttribute clears the value of the "login_attribute" field.
+func (u *LdapSourceUpsertOne) ClearLoginAttribute() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearLoginAttribute()
+	})
+}
+
+// SetEmailAttribute sets the "email_attribute" field.
+func (u *LdapSourceUpsertOne) SetEmailAttribute(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetEmailAttribute(v)
+	})
+}
+
+// UpdateEmailAttribute sets the "email_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateEmailAttribute() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateEmailAttribute()
+	})
+}
+
+// ClearEmailAttribute clears the value of the "email_attribute" field.
+func (u *LdapSourceUpsertOne) ClearEmailAttribute() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearEmailAttribute()
+	})
+}
+
+// SetDisplayNameAttribute sets the "display_name_attribute" field.
+func (u *LdapSourceUpsertOne) SetDisplayNameAttribute(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetDisplayNameAttribute(v)
+	})
+}
+
+// UpdateDisplayNameAttribute sets the "display_name_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateDisplayNameAttribute() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateDisplayNameAttribute()
+	})
+}
+
+// ClearDisplayNameAttribute clears the value of the "display_name_attribute" field.
+func (u *LdapSourceUpsertOne) ClearDisplayNameAttribute() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearDisplayNameAttribute()
+	})
+}
+
+// SetMobileAttribute sets the "mobile_attribute" field.
+func (u *LdapSourceUpsertOne) SetMobileAttribute(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetMobileAttribute(v)
+	})
+}
+
+// UpdateMobileAttribute sets the "mobile_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateMobileAttribute() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateMobileAttribute()
+	})
+}
+
+// ClearMobileAttribute clears the value of the "mobile_attribute" field.
+func (u *LdapSourceUpsertOne) ClearMobileAttribute() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearMobileAttribute()
+	})
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (u *LdapSourceUpsertOne) SetOrgUnitFilter(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetOrgUnitFilter(v)
+	})
+}
+
+// UpdateOrgUnitFilter sets the "org_unit_filter" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateOrgUnitFilter() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateOrgUnitFilter()
+	})
+}
+
+// ClearOrgUnitFilter clears the value of the "org_unit_filter" field.
+func (u *LdapSourceUpsertOne) ClearOrgUnitFilter() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearOrgUnitFilter()
+	})
+}
+
+// SetGroupBaseDn sets the "group_base_dn" field.
+func (u *LdapSourceUpsertOne) SetGroupBaseDn(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetGroupBaseDn(v)
+	})
+}
+
+// UpdateGroupBaseDn sets the "group_base_dn" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateGroupBaseDn() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateGroupBaseDn()
+	})
+}
+
+// ClearGroupBaseDn clears the value of the "group_base_dn" field.
+func (u *LdapSourceUpsertOne) ClearGroupBaseDn() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearGroupBaseDn()
+	})
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (u *LdapSourceUpsertOne) SetGroupFilter(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetGroupFilter(v)
+	})
+}
+
+// UpdateGroupFilter sets the "group_filter" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateGroupFilter() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateGroupFilter()
+	})
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (u *LdapSourceUpsertOne) ClearGroupFilter() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearGroupFilter()
+	})
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (u *LdapSourceUpsertOne) SetGroupMemberAttribute(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetGroupMemberAttribute(v)
+	})
+}
+
+// UpdateGroupMemberAttribute sets the "group_member_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateGroupMemberAttribute() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateGroupMemberAttribute()
+	})
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (u *LdapSourceUpsertOne) ClearGroupMemberAttribute() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearGroupMemberAttribute()
+	})
+}
+
+// SetGroupRoleMappings sets the "group_role_mappings" field.
+func (u *LdapSourceUpsertOne) SetGroupRoleMappings(v map[string]uint32) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetGroupRoleMappings(v)
+	})
+}
+
+// UpdateGroupRoleMappings sets the "group_role_mappings" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateGroupRoleMappings() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateGroupRoleMappings()
+	})
+}
+
+// ClearGroupRoleMappings clears the value of the "group_role_mappings" field.
+func (u *LdapSourceUpsertOne) ClearGroupRoleMappings() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearGroupRoleMappings()
+	})
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (u *LdapSourceUpsertOne) SetDefaultRoleID(v uint32) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetDefaultRoleID(v)
+	})
+}
+
+// AddDefaultRoleID adds v to the "default_role_id" field.
+func (u *LdapSourceUpsertOne) AddDefaultRoleID(v uint32) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.AddDefaultRoleID(v)
+	})
+}
+
+// UpdateDefaultRoleID sets the "default_role_id" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateDefaultRoleID() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateDefaultRoleID()
+	})
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (u *LdapSourceUpsertOne) ClearDefaultRoleID() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearDefaultRoleID()
+	})
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (u *LdapSourceUpsertOne) SetSyncEnabled(v bool) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetSyncEnabled(v)
+	})
+}
+
+// UpdateSyncEnabled sets the "sync_enabled" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateSyncEnabled() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateSyncEnabled()
+	})
+}
+
+// ClearSyncEnabled clears the value of the "sync_enabled" field.
+func (u *LdapSourceUpsertOne) ClearSyncEnabled() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearSyncEnabled()
+	})
+}
+
+// SetDisableMissingUsers sets the "disable_missing_users" field.
+func (u *LdapSourceUpsertOne) SetDisableMissingUsers(v bool) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetDisableMissingUsers(v)
+	})
+}
+
+// UpdateDisableMissingUsers sets the "disable_missing_users" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateDisableMissingUsers() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateDisableMissingUsers()
+	})
+}
+
+// ClearDisableMissingUsers clears the value of the "disable_missing_users" field.
+func (u *LdapSourceUpsertOne) ClearDisableMissingUsers() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearDisableMissingUsers()
+	})
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *LdapSourceUpsertOne) SetLastSyncAt(v time.Time) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetLastSyncAt(v)
+	})
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateLastSyncAt() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateLastSyncAt()
+	})
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *LdapSourceUpsertOne) ClearLastSyncAt() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearLastSyncAt()
+	})
+}
+
+// SetLastSyncError sets the "last_sync_error" field.
+func (u *LdapSourceUpsertOne) SetLastSyncError(v string) *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetLastSyncError(v)
+	})
+}
+
+// UpdateLastSyncError sets the "last_sync_error" field to the value that was provided on create.
+func (u *LdapSourceUpsertOne) UpdateLastSyncError() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateLastSyncError()
+	})
+}
+
+// ClearLastSyncError clears the value of the "last_sync_error" field.
+func (u *LdapSourceUpsertOne) ClearLastSyncError() *LdapSourceUpsertOne {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearLastSyncError()
+	})
+}
+
+// Exec executes the query.
+func (u *LdapSourceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LdapSourceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LdapSourceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LdapSourceUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LdapSourceUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LdapSourceCreateBulk is the builder for creating many LdapSource entities in bulk.
+type LdapSourceCreateBulk struct {
+	config
+	err      error
+	builders []*LdapSourceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LdapSource entities in the database.
+func (_c *LdapSourceCreateBulk) Save(ctx context.Context) ([]*LdapSource, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LdapSource, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LdapSourceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LdapSourceCreateBulk) SaveX(ctx context.Context) []*LdapSource {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapSourceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapSourceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LdapSource.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LdapSourceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LdapSourceCreateBulk) OnConflict(opts ...sql.ConflictOption) *LdapSourceUpsertBulk {
+	_c.conflict = opts
+	return &LdapSourceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LdapSource.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LdapSourceCreateBulk) OnConflictColumns(columns ...string) *LdapSourceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LdapSourceUpsertBulk{
+		create: _c,
+	}
+}
+
+// LdapSourceUpsertBulk is the builder for "upsert"-ing
+// a bulk of LdapSource nodes.
+type LdapSourceUpsertBulk struct {
+	create *LdapSourceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LdapSource.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ldapsource.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LdapSourceUpsertBulk) UpdateNewValues() *LdapSourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ldapsource.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ldapsource.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(ldapsource.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LdapSource.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LdapSourceUpsertBulk) Ignore() *LdapSourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LdapSourceUpsertBulk) DoNothing() *LdapSourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LdapSourceCreateBulk.OnConflict
+// documentation for more info.
+func (u *LdapSourceUpsertBulk) Update(set func(*LdapSourceUpsert)) *LdapSourceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LdapSourceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LdapSourceUpsertBulk) SetUpdatedAt(v time.Time) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateUpdatedAt() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *LdapSourceUpsertBulk) ClearUpdatedAt() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LdapSourceUpsertBulk) SetDeletedAt(v time.Time) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateDeletedAt() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LdapSourceUpsertBulk) ClearDeletedAt() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LdapSourceUpsertBulk) SetCreatedBy(v uint32) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LdapSourceUpsertBulk) AddCreatedBy(v uint32) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateCreatedBy() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LdapSourceUpsertBulk) ClearCreatedBy() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *LdapSourceUpsertBulk) SetUpdatedBy(v uint32) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *LdapSourceUpsertBulk) AddUpdatedBy(v uint32) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateUpdatedBy() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *LdapSourceUpsertBulk) ClearUpdatedBy() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *LdapSourceUpsertBulk) SetDeletedBy(v uint32) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *LdapSourceUpsertBulk) AddDeletedBy(v uint32) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateDeletedBy() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *LdapSourceUpsertBulk) ClearDeletedBy() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LdapSourceUpsertBulk) SetName(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateName() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *LdapSourceUpsertBulk) ClearName() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *LdapSourceUpsertBulk) SetEnabled(v bool) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateEnabled() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *LdapSourceUpsertBulk) ClearEnabled() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearEnabled()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *LdapSourceUpsertBulk) SetURL(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateURL() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (u *LdapSourceUpsertBulk) SetStartTLS(v bool) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetStartTLS(v)
+	})
+}
+
+// UpdateStartTLS sets the "start_tls" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateStartTLS() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateStartTLS()
+	})
+}
+
+// ClearStartTLS clears the value of the "start_tls" field.
+func (u *LdapSourceUpsertBulk) ClearStartTLS() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearStartTLS()
+	})
+}
+
+// SetInsecureSkipVerify sets the "insecure_skip_verify" field.
+func (u *LdapSourceUpsertBulk) SetInsecureSkipVerify(v bool) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetInsecureSkipVerify(v)
+	})
+}
+
+// UpdateInsecureSkipVerify sets the "insecure_skip_verify" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateInsecureSkipVerify() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateInsecureSkipVerify()
+	})
+}
+
+// ClearInsecureSkipVerify clears the value of the "insecure_skip_verify" field.
+func (u *LdapSourceUpsertBulk) ClearInsecureSkipVerify() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearInsecureSkipVerify()
+	})
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (u *LdapSourceUpsertBulk) SetBindDn(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetBindDn(v)
+	})
+}
+
+// UpdateBindDn sets the "bind_dn" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateBindDn() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateBindDn()
+	})
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (u *LdapSourceUpsertBulk) ClearBindDn() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearBindDn()
+	})
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (u *LdapSourceUpsertBulk) SetBindPassword(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetBindPassword(v)
+	})
+}
+
+// UpdateBindPassword sets the "bind_password" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateBindPassword() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateBindPassword()
+	})
+}
+
+// ClearBindPassword clears the value of the "bind_password" field.
+func (u *LdapSourceUpsertBulk) ClearBindPassword() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearBindPassword()
+	})
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (u *LdapSourceUpsertBulk) SetBaseDn(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetBaseDn(v)
+	})
+}
+
+// UpdateBaseDn sets the "base_dn" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateBaseDn() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateBaseDn()
+	})
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (u *LdapSourceUpsertBulk) SetUserFilter(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetUserFilter(v)
+	})
+}
+
+// UpdateUserFilter sets the "user_filter" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateUserFilter() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateUserFilter()
+	})
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (u *LdapSourceUpsertBulk) ClearUserFilter() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearUserFilter()
+	})
+}
+
+// SetLoginAttribute sets the "login_attribute" field.
+func (u *LdapSourceUpsertBulk) SetLoginAttribute(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetLoginAttribute(v)
+	})
+}
+
+// UpdateLoginAttribute sets the "login_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateLoginAttribute() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateLoginAttribute()
+	})
+}
+
+// ClearLoginAttribute clears the value of the "login_attribute" field.
+func (u *LdapSourceUpsertBulk) ClearLoginAttribute() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearLoginAttribute()
+	})
+}
+
+// SetEmailAttribute sets the "email_attribute" field.
+func (u *LdapSourceUpsertBulk) SetEmailAttribute(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetEmailAttribute(v)
+	})
+}
+
+// UpdateEmailAttribute sets the "email_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateEmailAttribute() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateEmailAttribute()
+	})
+}
+
+// ClearEmailAttribute clears the value of the "email_attribute" field.
+func (u *LdapSourceUpsertBulk) ClearEmailAttribute() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearEmailAttribute()
+	})
+}
+
+// SetDisplayNameAttribute sets the "display_name_attribute" field.
+func (u *LdapSourceUpsertBulk) SetDisplayNameAttribute(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetDisplayNameAttribute(v)
+	})
+}
+
+// UpdateDisplayNameAttribute sets the "display_name_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateDisplayNameAttribute() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateDisplayNameAttribute()
+	})
+}
+
+// ClearDisplayNameAttribute clears the value of the "display_name_attribute" field.
+func (u *LdapSourceUpsertBulk) ClearDisplayNameAttribute() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearDisplayNameAttribute()
+	})
+}
+
+// SetMobileAttribute sets the "mobile_attribute" field.
+func (u *LdapSourceUpsertBulk) SetMobileAttribute(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetMobileAttribute(v)
+	})
+}
+
+// UpdateMobileAttribute sets the "mobile_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateMobileAttribute() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateMobileAttribute()
+	})
+}
+
+// ClearMobileAttribute clears the value of the "mobile_attribute" field.
+func (u *LdapSourceUpsertBulk) ClearMobileAttribute() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearMobileAttribute()
+	})
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (u *LdapSourceUpsertBulk) SetOrgUnitFilter(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetOrgUnitFilter(v)
+	})
+}
+
+// UpdateOrgUnitFilter sets the "org_unit_filter" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateOrgUnitFilter() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateOrgUnitFilter()
+	})
+}
+
+// ClearOrgUnitFilter clears the value of the "org_unit_filter" field.
+func (u *LdapSourceUpsertBulk) ClearOrgUnitFilter() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearOrgUnitFilter()
+	})
+}
+
+// SetGroupBaseDn sets the "group_base_dn" field.
+func (u *LdapSourceUpsertBulk) SetGroupBaseDn(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetGroupBaseDn(v)
+	})
+}
+
+// UpdateGroupBaseDn sets the "group_base_dn" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateGroupBaseDn() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateGroupBaseDn()
+	})
+}
+
+// ClearGroupBaseDn clears the value of the "group_base_dn" field.
+func (u *LdapSourceUpsertBulk) ClearGroupBaseDn() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearGroupBaseDn()
+	})
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (u *LdapSourceUpsertBulk) SetGroupFilter(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetGroupFilter(v)
+	})
+}
+
+// UpdateGroupFilter sets the "group_filter" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateGroupFilter() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateGroupFilter()
+	})
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (u *LdapSourceUpsertBulk) ClearGroupFilter() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearGroupFilter()
+	})
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (u *LdapSourceUpsertBulk) SetGroupMemberAttribute(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetGroupMemberAttribute(v)
+	})
+}
+
+// UpdateGroupMemberAttribute sets the "group_member_attribute" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateGroupMemberAttribute() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateGroupMemberAttribute()
+	})
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (u *LdapSourceUpsertBulk) ClearGroupMemberAttribute() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearGroupMemberAttribute()
+	})
+}
+
+// SetGroupRoleMappings sets the "group_role_mappings" field.
+func (u *LdapSourceUpsertBulk) SetGroupRoleMappings(v map[string]uint32) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetGroupRoleMappings(v)
+	})
+}
+
+// UpdateGroupRoleMappings sets the "group_role_mappings" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateGroupRoleMappings() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateGroupRoleMappings()
+	})
+}
+
+// ClearGroupRoleMappings clears the value of the "group_role_mappings" field.
+func (u *LdapSourceUpsertBulk) ClearGroupRoleMappings() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearGroupRoleMappings()
+	})
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (u *LdapSourceUpsertBulk) SetDefaultRoleID(v uint32) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetDefaultRoleID(v)
+	})
+}
+
+// AddDefaultRoleID adds v to the "default_role_id" field.
+func (u *LdapSourceUpsertBulk) AddDefaultRoleID(v uint32) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.AddDefaultRoleID(v)
+	})
+}
+
+// UpdateDefaultRoleID sets the "default_role_id" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateDefaultRoleID() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateDefaultRoleID()
+	})
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (u *LdapSourceUpsertBulk) ClearDefaultRoleID() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearDefaultRoleID()
+	})
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (u *LdapSourceUpsertBulk) SetSyncEnabled(v bool) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetSyncEnabled(v)
+	})
+}
+
+// UpdateSyncEnabled sets the "sync_enabled" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateSyncEnabled() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateSyncEnabled()
+	})
+}
+
+// ClearSyncEnabled clears the value of the "sync_enabled" field.
+func (u *LdapSourceUpsertBulk) ClearSyncEnabled() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearSyncEnabled()
+	})
+}
+
+// SetDisableMissingUsers sets the "disable_missing_users" field.
+func (u *LdapSourceUpsertBulk) SetDisableMissingUsers(v bool) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetDisableMissingUsers(v)
+	})
+}
+
+// UpdateDisableMissingUsers sets the "disable_missing_users" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateDisableMissingUsers() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateDisableMissingUsers()
+	})
+}
+
+// ClearDisableMissingUsers clears the value of the "disable_missing_users" field.
+func (u *LdapSourceUpsertBulk) ClearDisableMissingUsers() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearDisableMissingUsers()
+	})
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (u *LdapSourceUpsertBulk) SetLastSyncAt(v time.Time) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetLastSyncAt(v)
+	})
+}
+
+// UpdateLastSyncAt sets the "last_sync_at" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateLastSyncAt() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateLastSyncAt()
+	})
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (u *LdapSourceUpsertBulk) ClearLastSyncAt() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearLastSyncAt()
+	})
+}
+
+// SetLastSyncError sets the "last_sync_error" field.
+func (u *LdapSourceUpsertBulk) SetLastSyncError(v string) *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.SetLastSyncError(v)
+	})
+}
+
+// UpdateLastSyncError sets the "last_sync_error" field to the value that was provided on create.
+func (u *LdapSourceUpsertBulk) UpdateLastSyncError() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.UpdateLastSyncError()
+	})
+}
+
+// ClearLastSyncError clears the value of the "last_sync_error" field.
+func (u *LdapSourceUpsertBulk) ClearLastSyncError() *LdapSourceUpsertBulk {
+	return u.Update(func(s *LdapSourceUpsert) {
+		s.ClearLastSyncError()
+	})
+}
+
+// Exec executes the query.
+func (u *LdapSourceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LdapSourceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LdapSourceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LdapSourceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1854 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapsource"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LdapSourceUpdate is the builder for updating LdapSource entities.
+type LdapSourceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LdapSourceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LdapSourceUpdate builder.
+func (_u *LdapSourceUpdate) Where(ps ...predicate.LdapSource) *LdapSourceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapSourceUpdate) SetUpdatedAt(v time.Time) *LdapSourceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableUpdatedAt(v *time.Time) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *LdapSourceUpdate) ClearUpdatedAt() *LdapSourceUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *LdapSourceUpdate) SetDeletedAt(v time.Time) *LdapSourceUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableDeletedAt(v *time.Time) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *LdapSourceUpdate) ClearDeletedAt() *LdapSourceUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LdapSourceUpdate) SetCreatedBy(v uint32) *LdapSourceUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableCreatedBy(v *uint32) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LdapSourceUpdate) AddCreatedBy(v int32) *LdapSourceUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LdapSourceUpdate) ClearCreatedBy() *LdapSourceUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *LdapSourceUpdate) SetUpdatedBy(v uint32) *LdapSourceUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableUpdatedBy(v *uint32) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *LdapSourceUpdate) AddUpdatedBy(v int32) *LdapSourceUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *LdapSourceUpdate) ClearUpdatedBy() *LdapSourceUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *LdapSourceUpdate) SetDeletedBy(v uint32) *LdapSourceUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableDeletedBy(v *uint32) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *LdapSourceUpdate) AddDeletedBy(v int32) *LdapSourceUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *LdapSourceUpdate) ClearDeletedBy() *LdapSourceUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LdapSourceUpdate) SetName(v string) *LdapSourceUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableName(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *LdapSourceUpdate) ClearName() *LdapSourceUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *LdapSourceUpdate) SetEnabled(v bool) *LdapSourceUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableEnabled(v *bool) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *LdapSourceUpdate) ClearEnabled() *LdapSourceUpdate {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *LdapSourceUpdate) SetURL(v string) *LdapSourceUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableURL(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (_u *LdapSourceUpdate) SetStartTLS(v bool) *LdapSourceUpdate {
+	_u.mutation.SetStartTLS(v)
+	return _u
+}
+
+// SetNillableStartTLS sets the "start_tls" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableStartTLS(v *bool) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetStartTLS(*v)
+	}
+	return _u
+}
+
+// ClearStartTLS clears the value of the "start_tls" field.
+func (_u *LdapSourceUpdate) ClearStartTLS() *LdapSourceUpdate {
+	_u.mutation.ClearStartTLS()
+	return _u
+}
+
+// SetInsecureSkipVerify sets the "insecure_skip_verify" field.
+func (_u *LdapSourceUpdate) SetInsecureSkipVerify(v bool) *LdapSourceUpdate {
+	_u.mutation.SetInsecureSkipVerify(v)
+	return _u
+}
+
+// SetNillableInsecureSkipVerify sets the "insecure_skip_verify" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableInsecureSkipVerify(v *bool) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetInsecureSkipVerify(*v)
+	}
+	return _u
+}
+
+// ClearInsecureSkipVerify clears the value of the "insecure_skip_verify" field.
+func (_u *LdapSourceUpdate) ClearInsecureSkipVerify() *LdapSourceUpdate {
+	_u.mutation.ClearInsecureSkipVerify()
+	return _u
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (_u *LdapSourceUpdate) SetBindDn(v string) *LdapSourceUpdate {
+	_u.mutation.SetBindDn(v)
+	return _u
+}
+
+// SetNillableBindDn sets the "bind_dn" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableBindDn(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetBindDn(*v)
+	}
+	return _u
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (_u *LdapSourceUpdate) ClearBindDn() *LdapSourceUpdate {
+	_u.mutation.ClearBindDn()
+	return _u
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (_u *LdapSourceUpdate) SetBindPassword(v string) *LdapSourceUpdate {
+	_u.mutation.SetBindPassword(v)
+	return _u
+}
+
+// SetNillableBindPassword sets the "bind_password" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableBindPassword(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetBindPassword(*v)
+	}
+	return _u
+}
+
+// ClearBindPassword clears the value of the "bind_password" field.
+func (_u *LdapSourceUpdate) ClearBindPassword() *LdapSourceUpdate {
+	_u.mutation.ClearBindPassword()
+	return _u
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (_u *LdapSourceUpdate) SetBaseDn(v string) *LdapSourceUpdate {
+	_u.mutation.SetBaseDn(v)
+	return _u
+}
+
+// SetNillableBaseDn sets the "base_dn" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableBaseDn(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetBaseDn(*v)
+	}
+	return _u
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (_u *LdapSourceUpdate) SetUserFilter(v string) *LdapSourceUpdate {
+	_u.mutation.SetUserFilter(v)
+	return _u
+}
+
+// SetNillableUserFilter sets the "user_filter" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableUserFilter(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetUserFilter(*v)
+	}
+	return _u
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (_u *LdapSourceUpdate) ClearUserFilter() *LdapSourceUpdate {
+	_u.mutation.ClearUserFilter()
+	return _u
+}
+
+// SetLoginAttribute sets the "login_attribute" field.
+func (_u *LdapSourceUpdate) SetLoginAttribute(v string) *LdapSourceUpdate {
+	_u.mutation.SetLoginAttribute(v)
+	return _u
+}
+
+// SetNillableLoginAttribute sets the "login_attribute" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableLoginAttribute(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetLoginAttribute(*v)
+	}
+	return _u
+}
+
+// ClearLoginAttribute clears the value of the "login_attribute" field.
+func (_u *LdapSourceUpdate) ClearLoginAttribute() *LdapSourceUpdate {
+	_u.mutation.ClearLoginAttribute()
+	return _u
+}
+
+// SetEmailAttribute sets the "email_attribute" field.
+func (_u *LdapSourceUpdate) SetEmailAttribute(v string) *LdapSourceUpdate {
+	_u.mutation.SetEmailAttribute(v)
+	return _u
+}
+
+// SetNillableEmailAttribute sets the "email_attribute" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableEmailAttribute(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetEmailAttribute(*v)
+	}
+	return _u
+}
+
+// ClearEmailAttribute clears the value of the "email_attribute" field.
+func (_u *LdapSourceUpdate) ClearEmailAttribute() *LdapSourceUpdate {
+	_u.mutation.ClearEmailAttribute()
+	return _u
+}
+
+// SetDisplayNameAttribute sets the "display_name_attribute" field.
+func (_u *LdapSourceUpdate) SetDisplayNameAttribute(v string) *LdapSourceUpdate {
+	_u.mutation.SetDisplayNameAttribute(v)
+	return _u
+}
+
+// SetNillableDisplayNameAttribute sets the "display_name_attribute" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableDisplayNameAttribute(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetDisplayNameAttribute(*v)
+	}
+	return _u
+}
+
+// ClearDisplayNameAttribute clears the value of the "display_name_attribute" field.
+func (_u *LdapSourceUpdate) ClearDisplayNameAttribute() *LdapSourceUpdate {
+	_u.mutation.ClearDisplayNameAttribute()
+	return _u
+}
+
+// SetMobileAttribute sets the "mobile_attribute" field.
+func (_u *LdapSourceUpdate) SetMobileAttribute(v string) *LdapSourceUpdate {
+	_u.mutation.SetMobileAttribute(v)
+	return _u
+}
+
+// SetNillableMobileAttribute sets the "mobile_attribute" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableMobileAttribute(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetMobileAttribute(*v)
+	}
+	return _u
+}
+
+// ClearMobileAttribute clears the value of the "mobile_attribute" field.
+func (_u *LdapSourceUpdate) ClearMobileAttribute() *LdapSourceUpdate {
+	_u.mutation.ClearMobileAttribute()
+	return _u
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (_u *LdapSourceUpdate) SetOrgUnitFilter(v string) *LdapSourceUpdate {
+	_u.mutation.SetOrgUnitFilter(v)
+	return _u
+}
+
+// SetNillableOrgUnitFilter sets the "org_unit_filter" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableOrgUnitFilter(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetOrgUnitFilter(*v)
+	}
+	return _u
+}
+
+// ClearOrgUnitFilter clears the value of the "org_unit_filter" field.
+func (_u *LdapSourceUpdate) ClearOrgUnitFilter() *LdapSourceUpdate {
+	_u.mutation.ClearOrgUnitFilter()
+	return _u
+}
+
+// SetGroupBaseDn sets the "group_base_dn" field.
+func (_u *LdapSourceUpdate) SetGroupBaseDn(v string) *LdapSourceUpdate {
+	_u.mutation.SetGroupBaseDn(v)
+	return _u
+}
+
+// SetNillableGroupBaseDn sets the "group_base_dn" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableGroupBaseDn(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetGroupBaseDn(*v)
+	}
+	return _u
+}
+
+// ClearGroupBaseDn clears the value of the "group_base_dn" field.
+func (_u *LdapSourceUpdate) ClearGroupBaseDn() *LdapSourceUpdate {
+	_u.mutation.ClearGroupBaseDn()
+	return _u
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (_u *LdapSourceUpdate) SetGroupFilter(v string) *LdapSourceUpdate {
+	_u.mutation.SetGroupFilter(v)
+	return _u
+}
+
+// SetNillableGroupFilter sets the "group_filter" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableGroupFilter(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetGroupFilter(*v)
+	}
+	return _u
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (_u *LdapSourceUpdate) ClearGroupFilter() *LdapSourceUpdate {
+	_u.mutation.ClearGroupFilter()
+	return _u
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (_u *LdapSourceUpdate) SetGroupMemberAttribute(v string) *LdapSourceUpdate {
+	_u.mutation.SetGroupMemberAttribute(v)
+	return _u
+}
+
+// SetNillableGroupMemberAttribute sets the "group_member_attribute" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableGroupMemberAttribute(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetGroupMemberAttribute(*v)
+	}
+	return _u
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (_u *LdapSourceUpdate) ClearGroupMemberAttribute() *LdapSourceUpdate {
+	_u.mutation.ClearGroupMemberAttribute()
+	return _u
+}
+
+// SetGroupRoleMappings sets the "group_role_mappings" field.
+func (_u *LdapSourceUpdate) SetGroupRoleMappings(v map[string]uint32) *LdapSourceUpdate {
+	_u.mutation.SetGroupRoleMappings(v)
+	return _u
+}
+
+// ClearGroupRoleMappings clears the value of the "group_role_mappings" field.
+func (_u *LdapSourceUpdate) ClearGroupRoleMappings() *LdapSourceUpdate {
+	_u.mutation.ClearGroupRoleMappings()
+	return _u
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_u *LdapSourceUpdate) SetDefaultRoleID(v uint32) *LdapSourceUpdate {
+	_u.mutation.ResetDefaultRoleID()
+	_u.mutation.SetDefaultRoleID(v)
+	return _u
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableDefaultRoleID(v *uint32) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetDefaultRoleID(*v)
+	}
+	return _u
+}
+
+// AddDefaultRoleID adds value to the "default_role_id" field.
+func (_u *LdapSourceUpdate) AddDefaultRoleID(v int32) *LdapSourceUpdate {
+	_u.mutation.AddDefaultRoleID(v)
+	return _u
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (_u *LdapSourceUpdate) ClearDefaultRoleID() *LdapSourceUpdate {
+	_u.mutation.ClearDefaultRoleID()
+	return _u
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (_u *LdapSourceUpdate) SetSyncEnabled(v bool) *LdapSourceUpdate {
+	_u.mutation.SetSyncEnabled(v)
+	return _u
+}
+
+// SetNillableSyncEnabled sets the "sync_enabled" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableSyncEnabled(v *bool) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetSyncEnabled(*v)
+	}
+	return _u
+}
+
+// ClearSyncEnabled clears the value of the "sync_enabled" field.
+func (_u *LdapSourceUpdate) ClearSyncEnabled() *LdapSourceUpdate {
+	_u.mutation.ClearSyncEnabled()
+	return _u
+}
+
+// SetDisableMissingUsers sets the "disable_missing_users" field.
+func (_u *LdapSourceUpdate) SetDisableMissingUsers(v bool) *LdapSourceUpdate {
+	_u.mutation.SetDisableMissingUsers(v)
+	return _u
+}
+
+// SetNillableDisableMissingUsers sets the "disable_missing_users" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableDisableMissingUsers(v *bool) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetDisableMissingUsers(*v)
+	}
+	return _u
+}
+
+// ClearDisableMissingUsers clears the value of the "disable_missing_users" field.
+func (_u *LdapSourceUpdate) ClearDisableMissingUsers() *LdapSourceUpdate {
+	_u.mutation.ClearDisableMissingUsers()
+	return _u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_u *LdapSourceUpdate) SetLastSyncAt(v time.Time) *LdapSourceUpdate {
+	_u.mutation.SetLastSyncAt(v)
+	return _u
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableLastSyncAt(v *time.Time) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetLastSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (_u *LdapSourceUpdate) ClearLastSyncAt() *LdapSourceUpdate {
+	_u.mutation.ClearLastSyncAt()
+	return _u
+}
+
+// SetLastSyncError sets the "last_sync_error" field.
+func (_u *LdapSourceUpdate) SetLastSyncError(v string) *LdapSourceUpdate {
+	_u.mutation.SetLastSyncError(v)
+	return _u
+}
+
+// SetNillableLastSyncError sets the "last_sync_error" field if the given value is not nil.
+func (_u *LdapSourceUpdate) SetNillableLastSyncError(v *string) *LdapSourceUpdate {
+	if v != nil {
+		_u.SetLastSyncError(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncError clears the value of the "last_sync_error" field.
+func (_u *LdapSourceUpdate) ClearLastSyncError() *LdapSourceUpdate {
+	_u.mutation.ClearLastSyncError()
+	return _u
+}
+
+// Mutation returns the LdapSourceMutation object of the builder.
+func (_u *LdapSourceUpdate) Mutation() *LdapSourceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LdapSourceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapSourceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LdapSourceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapSourceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapSourceUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ldapsource.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LdapSource.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := ldapsource.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LdapSource.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BindDn(); ok {
+		if err := ldapsource.BindDnValidator(v); err != nil {
+			return &ValidationError{Name: "bind_dn", err: fmt.Errorf(`ent: validator failed for field "LdapSource.bind_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BindPassword(); ok {
+		if err := ldapsource.BindPasswordValidator(v); err != nil {
+			return &ValidationError{Name: "bind_password", err: fmt.Errorf(`ent: validator failed for field "LdapSource.bind_password": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BaseDn(); ok {
+		if err := ldapsource.BaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapSource.base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserFilter(); ok {
+		if err := ldapsource.UserFilterValidator(v); err != nil {
+			return &ValidationError{Name: "user_filter", err: fmt.Errorf(`ent: validator failed for field "LdapSource.user_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LoginAttribute(); ok {
+		if err := ldapsource.LoginAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "login_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.login_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EmailAttribute(); ok {
+		if err := ldapsource.EmailAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "email_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.email_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayNameAttribute(); ok {
+		if err := ldapsource.DisplayNameAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "display_name_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.display_name_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MobileAttribute(); ok {
+		if err := ldapsource.MobileAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "mobile_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.mobile_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrgUnitFilter(); ok {
+		if err := ldapsource.OrgUnitFilterValidator(v); err != nil {
+			return &ValidationError{Name: "org_unit_filter", err: fmt.Errorf(`ent: validator failed for field "LdapSource.org_unit_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupBaseDn(); ok {
+		if err := ldapsource.GroupBaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "group_base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapSource.group_base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupFilter(); ok {
+		if err := ldapsource.GroupFilterValidator(v); err != nil {
+			return &ValidationError{Name: "group_filter", err: fmt.Errorf(`ent: validator failed for field "LdapSource.group_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupMemberAttribute(); ok {
+		if err := ldapsource.GroupMemberAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "group_member_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.group_member_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastSyncError(); ok {
+		if err := ldapsource.LastSyncErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_sync_error", err: fmt.Errorf(`ent: validator failed for field "LdapSource.last_sync_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapSourceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapSourceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapSourceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapsource.Table, ldapsource.Columns, sqlgraph.NewFieldSpec(ldapsource.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(ldapsource.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapsource.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(ldapsource.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(ldapsource.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(ldapsource.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(ldapsource.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(ldapsource.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(ldapsource.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(ldapsource.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(ldapsource.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(ldapsource.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(ldapsource.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(ldapsource.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(ldapsource.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(ldapsource.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ldapsource.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(ldapsource.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ldapsource.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(ldapsource.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(ldapsource.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartTLS(); ok {
+		_spec.SetField(ldapsource.FieldStartTLS, field.TypeBool, value)
+	}
+	if _u.mutation.StartTLSCleared() {
+		_spec.ClearField(ldapsource.FieldStartTLS, field.TypeBool)
+	}
+	if value, ok := _u.mutation.InsecureSkipVerify(); ok {
+		_spec.SetField(ldapsource.FieldInsecureSkipVerify, field.TypeBool, value)
+	}
+	if _u.mutation.InsecureSkipVerifyCleared() {
+		_spec.ClearField(ldapsource.FieldInsecureSkipVerify, field.TypeBool)
+	}
+	if value, ok := _u.mutation.BindDn(); ok {
+		_spec.SetField(ldapsource.FieldBindDn, field.TypeString, value)
+	}
+	if _u.mutation.BindDnCleared() {
+		_spec.ClearField(ldapsource.FieldBindDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.BindPassword(); ok {
+		_spec.SetField(ldapsource.FieldBindPassword, field.TypeString, value)
+	}
+	if _u.mutation.BindPasswordCleared() {
+		_spec.ClearField(ldapsource.FieldBindPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.BaseDn(); ok {
+		_spec.SetField(ldapsource.FieldBaseDn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserFilter(); ok {
+		_spec.SetField(ldapsource.FieldUserFilter, field.TypeString, value)
+	}
+	if _u.mutation.UserFilterCleared() {
+		_spec.ClearField(ldapsource.FieldUserFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoginAttribute(); ok {
+		_spec.SetField(ldapsource.FieldLoginAttribute, field.TypeString, value)
+	}
+	if _u.mutation.LoginAttributeCleared() {
+		_spec.ClearField(ldapsource.FieldLoginAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailAttribute(); ok {
+		_spec.SetField(ldapsource.FieldEmailAttribute, field.TypeString, value)
+	}
+	if _u.mutation.EmailAttributeCleared() {
+		_spec.ClearField(ldapsource.FieldEmailAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisplayNameAttribute(); ok {
+		_spec.SetField(ldapsource.FieldDisplayNameAttribute, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameAttributeCleared() {
+		_spec.ClearField(ldapsource.FieldDisplayNameAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.MobileAttribute(); ok {
+		_spec.SetField(ldapsource.FieldMobileAttribute, field.TypeString, value)
+	}
+	if _u.mutation.MobileAttributeCleared() {
+		_spec.ClearField(ldapsource.FieldMobileAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrgUnitFilter(); ok {
+		_spec.SetField(ldapsource.FieldOrgUnitFilter, field.TypeString, value)
+	}
+	if _u.mutation.OrgUnitFilterCleared() {
+		_spec.ClearField(ldapsource.FieldOrgUnitFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupBaseDn(); ok {
+		_spec.SetField(ldapsource.FieldGroupBaseDn, field.TypeString, value)
+	}
+	if _u.mutation.GroupBaseDnCleared() {
+		_spec.ClearField(ldapsource.FieldGroupBaseDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupFilter(); ok {
+		_spec.SetField(ldapsource.FieldGroupFilter, field.TypeString, value)
+	}
+	if _u.mutation.GroupFilterCleared() {
+		_spec.ClearField(ldapsource.FieldGroupFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupMemberAttribute(); ok {
+		_spec.SetField(ldapsource.FieldGroupMemberAttribute, field.TypeString, value)
+	}
+	if _u.mutation.GroupMemberAttributeCleared() {
+		_spec.ClearField(ldapsource.FieldGroupMemberAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupRoleMappings(); ok {
+		_spec.SetField(ldapsource.FieldGroupRoleMappings, field.TypeJSON, value)
+	}
+	if _u.mutation.GroupRoleMappingsCleared() {
+		_spec.ClearField(ldapsource.FieldGroupRoleMappings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultRoleID(); ok {
+		_spec.SetField(ldapsource.FieldDefaultRoleID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultRoleID(); ok {
+		_spec.AddField(ldapsource.FieldDefaultRoleID, field.TypeUint32, value)
+	}
+	if _u.mutation.DefaultRoleIDCleared() {
+		_spec.ClearField(ldapsource.FieldDefaultRoleID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.SyncEnabled(); ok {
+		_spec.SetField(ldapsource.FieldSyncEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.SyncEnabledCleared() {
+		_spec.ClearField(ldapsource.FieldSyncEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DisableMissingUsers(); ok {
+		_spec.SetField(ldapsource.FieldDisableMissingUsers, field.TypeBool, value)
+	}
+	if _u.mutation.DisableMissingUsersCleared() {
+		_spec.ClearField(ldapsource.FieldDisableMissingUsers, field.TypeBool)
+	}
+	if value, ok := _u.mutation.LastSyncAt(); ok {
+		_spec.SetField(ldapsource.FieldLastSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncAtCleared() {
+		_spec.ClearField(ldapsource.FieldLastSyncAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSyncError(); ok {
+		_spec.SetField(ldapsource.FieldLastSyncError, field.TypeString, value)
+	}
+	if _u.mutation.LastSyncErrorCleared() {
+		_spec.ClearField(ldapsource.FieldLastSyncError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapsource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LdapSourceUpdateOne is the builder for updating a single LdapSource entity.
+type LdapSourceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LdapSourceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapSourceUpdateOne) SetUpdatedAt(v time.Time) *LdapSourceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableUpdatedAt(v *time.Time) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *LdapSourceUpdateOne) ClearUpdatedAt() *LdapSourceUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *LdapSourceUpdateOne) SetDeletedAt(v time.Time) *LdapSourceUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableDeletedAt(v *time.Time) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *LdapSourceUpdateOne) ClearDeletedAt() *LdapSourceUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LdapSourceUpdateOne) SetCreatedBy(v uint32) *LdapSourceUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableCreatedBy(v *uint32) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LdapSourceUpdateOne) AddCreatedBy(v int32) *LdapSourceUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LdapSourceUpdateOne) ClearCreatedBy() *LdapSourceUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *LdapSourceUpdateOne) SetUpdatedBy(v uint32) *LdapSourceUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableUpdatedBy(v *uint32) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *LdapSourceUpdateOne) AddUpdatedBy(v int32) *LdapSourceUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *LdapSourceUpdateOne) ClearUpdatedBy() *LdapSourceUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *LdapSourceUpdateOne) SetDeletedBy(v uint32) *LdapSourceUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableDeletedBy(v *uint32) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *LdapSourceUpdateOne) AddDeletedBy(v int32) *LdapSourceUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *LdapSourceUpdateOne) ClearDeletedBy() *LdapSourceUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LdapSourceUpdateOne) SetName(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableName(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *LdapSourceUpdateOne) ClearName() *LdapSourceUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *LdapSourceUpdateOne) SetEnabled(v bool) *LdapSourceUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableEnabled(v *bool) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *LdapSourceUpdateOne) ClearEnabled() *LdapSourceUpdateOne {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *LdapSourceUpdateOne) SetURL(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableURL(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (_u *LdapSourceUpdateOne) SetStartTLS(v bool) *LdapSourceUpdateOne {
+	_u.mutation.SetStartTLS(v)
+	return _u
+}
+
+// SetNillableStartTLS sets the "start_tls" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableStartTLS(v *bool) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetStartTLS(*v)
+	}
+	return _u
+}
+
+// ClearStartTLS clears the value of the "start_tls" field.
+func (_u *LdapSourceUpdateOne) ClearStartTLS() *LdapSourceUpdateOne {
+	_u.mutation.ClearStartTLS()
+	return _u
+}
+
+// SetInsecureSkipVerify sets the "insecure_skip_verify" field.
+func (_u *LdapSourceUpdateOne) SetInsecureSkipVerify(v bool) *LdapSourceUpdateOne {
+	_u.mutation.SetInsecureSkipVerify(v)
+	return _u
+}
+
+// SetNillableInsecureSkipVerify sets the "insecure_skip_verify" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableInsecureSkipVerify(v *bool) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetInsecureSkipVerify(*v)
+	}
+	return _u
+}
+
+// ClearInsecureSkipVerify clears the value of the "insecure_skip_verify" field.
+func (_u *LdapSourceUpdateOne) ClearInsecureSkipVerify() *LdapSourceUpdateOne {
+	_u.mutation.ClearInsecureSkipVerify()
+	return _u
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (_u *LdapSourceUpdateOne) SetBindDn(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetBindDn(v)
+	return _u
+}
+
+// SetNillableBindDn sets the "bind_dn" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableBindDn(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetBindDn(*v)
+	}
+	return _u
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (_u *LdapSourceUpdateOne) ClearBindDn() *LdapSourceUpdateOne {
+	_u.mutation.ClearBindDn()
+	return _u
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (_u *LdapSourceUpdateOne) SetBindPassword(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetBindPassword(v)
+	return _u
+}
+
+// SetNillableBindPassword sets the "bind_password" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableBindPassword(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetBindPassword(*v)
+	}
+	return _u
+}
+
+// ClearBindPassword clears the value of the "bind_password" field.
+func (_u *LdapSourceUpdateOne) ClearBindPassword() *LdapSourceUpdateOne {
+	_u.mutation.ClearBindPassword()
+	return _u
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (_u *LdapSourceUpdateOne) SetBaseDn(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetBaseDn(v)
+	return _u
+}
+
+// SetNillableBaseDn sets the "base_dn" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableBaseDn(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetBaseDn(*v)
+	}
+	return _u
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (_u *LdapSourceUpdateOne) SetUserFilter(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetUserFilter(v)
+	return _u
+}
+
+// SetNillableUserFilter sets the "user_filter" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableUserFilter(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetUserFilter(*v)
+	}
+	return _u
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (_u *LdapSourceUpdateOne) ClearUserFilter() *LdapSourceUpdateOne {
+	_u.mutation.ClearUserFilter()
+	return _u
+}
+
+// SetLoginAttribute sets the "login_attribute" field.
+func (_u *LdapSourceUpdateOne) SetLoginAttribute(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetLoginAttribute(v)
+	return _u
+}
+
+// SetNillableLoginAttribute sets the "login_attribute" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableLoginAttribute(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetLoginAttribute(*v)
+	}
+	return _u
+}
+
+// ClearLoginAttribute clears the value of the "login_attribute" field.
+func (_u *LdapSourceUpdateOne) ClearLoginAttribute() *LdapSourceUpdateOne {
+	_u.mutation.ClearLoginAttribute()
+	return _u
+}
+
+// SetEmailAttribute sets the "email_attribute" field.
+func (_u *LdapSourceUpdateOne) SetEmailAttribute(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetEmailAttribute(v)
+	return _u
+}
+
+// SetNillableEmailAttribute sets the "email_attribute" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableEmailAttribute(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetEmailAttribute(*v)
+	}
+	return _u
+}
+
+// ClearEmailAttribute clears the value of the "email_attribute" field.
+func (_u *LdapSourceUpdateOne) ClearEmailAttribute() *LdapSourceUpdateOne {
+	_u.mutation.ClearEmailAttribute()
+	return _u
+}
+
+// SetDisplayNameAttribute sets the "display_name_attribute" field.
+func (_u *LdapSourceUpdateOne) SetDisplayNameAttribute(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetDisplayNameAttribute(v)
+	return _u
+}
+
+// SetNillableDisplayNameAttribute sets the "display_name_attribute" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableDisplayNameAttribute(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetDisplayNameAttribute(*v)
+	}
+	return _u
+}
+
+// ClearDisplayNameAttribute clears the value of the "display_name_attribute" field.
+func (_u *LdapSourceUpdateOne) ClearDisplayNameAttribute() *LdapSourceUpdateOne {
+	_u.mutation.ClearDisplayNameAttribute()
+	return _u
+}
+
+// SetMobileAttribute sets the "mobile_attribute" field.
+func (_u *LdapSourceUpdateOne) SetMobileAttribute(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetMobileAttribute(v)
+	return _u
+}
+
+// SetNillableMobileAttribute sets the "mobile_attribute" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableMobileAttribute(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetMobileAttribute(*v)
+	}
+	return _u
+}
+
+// ClearMobileAttribute clears the value of the "mobile_attribute" field.
+func (_u *LdapSourceUpdateOne) ClearMobileAttribute() *LdapSourceUpdateOne {
+	_u.mutation.ClearMobileAttribute()
+	return _u
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (_u *LdapSourceUpdateOne) SetOrgUnitFilter(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetOrgUnitFilter(v)
+	return _u
+}
+
+// SetNillableOrgUnitFilter sets the "org_unit_filter" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableOrgUnitFilter(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetOrgUnitFilter(*v)
+	}
+	return _u
+}
+
+// ClearOrgUnitFilter clears the value of the "org_unit_filter" field.
+func (_u *LdapSourceUpdateOne) ClearOrgUnitFilter() *LdapSourceUpdateOne {
+	_u.mutation.ClearOrgUnitFilter()
+	return _u
+}
+
+// SetGroupBaseDn sets the "group_base_dn" field.
+func (_u *LdapSourceUpdateOne) SetGroupBaseDn(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetGroupBaseDn(v)
+	return _u
+}
+
+// SetNillableGroupBaseDn sets the "group_base_dn" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableGroupBaseDn(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetGroupBaseDn(*v)
+	}
+	return _u
+}
+
+// ClearGroupBaseDn clears the value of the "group_base_dn" field.
+func (_u *LdapSourceUpdateOne) ClearGroupBaseDn() *LdapSourceUpdateOne {
+	_u.mutation.ClearGroupBaseDn()
+	return _u
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (_u *LdapSourceUpdateOne) SetGroupFilter(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetGroupFilter(v)
+	return _u
+}
+
+// SetNillableGroupFilter sets the "group_filter" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableGroupFilter(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetGroupFilter(*v)
+	}
+	return _u
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (_u *LdapSourceUpdateOne) ClearGroupFilter() *LdapSourceUpdateOne {
+	_u.mutation.ClearGroupFilter()
+	return _u
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (_u *LdapSourceUpdateOne) SetGroupMemberAttribute(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetGroupMemberAttribute(v)
+	return _u
+}
+
+// SetNillableGroupMemberAttribute sets the "group_member_attribute" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableGroupMemberAttribute(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetGroupMemberAttribute(*v)
+	}
+	return _u
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (_u *LdapSourceUpdateOne) ClearGroupMemberAttribute() *LdapSourceUpdateOne {
+	_u.mutation.ClearGroupMemberAttribute()
+	return _u
+}
+
+// SetGroupRoleMappings sets the "group_role_mappings" field.
+func (_u *LdapSourceUpdateOne) SetGroupRoleMappings(v map[string]uint32) *LdapSourceUpdateOne {
+	_u.mutation.SetGroupRoleMappings(v)
+	return _u
+}
+
+// ClearGroupRoleMappings clears the value of the "group_role_mappings" field.
+func (_u *LdapSourceUpdateOne) ClearGroupRoleMappings() *LdapSourceUpdateOne {
+	_u.mutation.ClearGroupRoleMappings()
+	return _u
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_u *LdapSourceUpdateOne) SetDefaultRoleID(v uint32) *LdapSourceUpdateOne {
+	_u.mutation.ResetDefaultRoleID()
+	_u.mutation.SetDefaultRoleID(v)
+	return _u
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableDefaultRoleID(v *uint32) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetDefaultRoleID(*v)
+	}
+	return _u
+}
+
+// AddDefaultRoleID adds value to the "default_role_id" field.
+func (_u *LdapSourceUpdateOne) AddDefaultRoleID(v int32) *LdapSourceUpdateOne {
+	_u.mutation.AddDefaultRoleID(v)
+	return _u
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (_u *LdapSourceUpdateOne) ClearDefaultRoleID() *LdapSourceUpdateOne {
+	_u.mutation.ClearDefaultRoleID()
+	return _u
+}
+
+// SetSyncEnabled sets the "sync_enabled" field.
+func (_u *LdapSourceUpdateOne) SetSyncEnabled(v bool) *LdapSourceUpdateOne {
+	_u.mutation.SetSyncEnabled(v)
+	return _u
+}
+
+// SetNillableSyncEnabled sets the "sync_enabled" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableSyncEnabled(v *bool) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetSyncEnabled(*v)
+	}
+	return _u
+}
+
+// ClearSyncEnabled clears the value of the "sync_enabled" field.
+func (_u *LdapSourceUpdateOne) ClearSyncEnabled() *LdapSourceUpdateOne {
+	_u.mutation.ClearSyncEnabled()
+	return _u
+}
+
+// SetDisableMissingUsers sets the "disable_missing_users" field.
+func (_u *LdapSourceUpdateOne) SetDisableMissingUsers(v bool) *LdapSourceUpdateOne {
+	_u.mutation.SetDisableMissingUsers(v)
+	return _u
+}
+
+// SetNillableDisableMissingUsers sets the "disable_missing_users" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableDisableMissingUsers(v *bool) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetDisableMissingUsers(*v)
+	}
+	return _u
+}
+
+// ClearDisableMissingUsers clears the value of the "disable_missing_users" field.
+func (_u *LdapSourceUpdateOne) ClearDisableMissingUsers() *LdapSourceUpdateOne {
+	_u.mutation.ClearDisableMissingUsers()
+	return _u
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (_u *LdapSourceUpdateOne) SetLastSyncAt(v time.Time) *LdapSourceUpdateOne {
+	_u.mutation.SetLastSyncAt(v)
+	return _u
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableLastSyncAt(v *time.Time) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetLastSyncAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (_u *LdapSourceUpdateOne) ClearLastSyncAt() *LdapSourceUpdateOne {
+	_u.mutation.ClearLastSyncAt()
+	return _u
+}
+
+// SetLastSyncError sets the "last_sync_error" field.
+func (_u *LdapSourceUpdateOne) SetLastSyncError(v string) *LdapSourceUpdateOne {
+	_u.mutation.SetLastSyncError(v)
+	return _u
+}
+
+// SetNillableLastSyncError sets the "last_sync_error" field if the given value is not nil.
+func (_u *LdapSourceUpdateOne) SetNillableLastSyncError(v *string) *LdapSourceUpdateOne {
+	if v != nil {
+		_u.SetLastSyncError(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncError clears the value of the "last_sync_error" field.
+func (_u *LdapSourceUpdateOne) ClearLastSyncError() *LdapSourceUpdateOne {
+	_u.mutation.ClearLastSyncError()
+	return _u
+}
+
+// Mutation returns the LdapSourceMutation object of the builder.
+func (_u *LdapSourceUpdateOne) Mutation() *LdapSourceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LdapSourceUpdate builder.
+func (_u *LdapSourceUpdateOne) Where(ps ...predicate.LdapSource) *LdapSourceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LdapSourceUpdateOne) Select(field string, fields ...string) *LdapSourceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LdapSource entity.
+func (_u *LdapSourceUpdateOne) Save(ctx context.Context) (*LdapSource, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapSourceUpdateOne) SaveX(ctx context.Context) *LdapSource {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LdapSourceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapSourceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapSourceUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ldapsource.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LdapSource.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := ldapsource.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LdapSource.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BindDn(); ok {
+		if err := ldapsource.BindDnValidator(v); err != nil {
+			return &ValidationError{Name: "bind_dn", err: fmt.Errorf(`ent: validator failed for field "LdapSource.bind_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BindPassword(); ok {
+		if err := ldapsource.BindPasswordValidator(v); err != nil {
+			return &ValidationError{Name: "bind_password", err: fmt.Errorf(`ent: validator failed for field "LdapSource.bind_password": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BaseDn(); ok {
+		if err := ldapsource.BaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapSource.base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserFilter(); ok {
+		if err := ldapsource.UserFilterValidator(v); err != nil {
+			return &ValidationError{Name: "user_filter", err: fmt.Errorf(`ent: validator failed for field "LdapSource.user_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LoginAttribute(); ok {
+		if err := ldapsource.LoginAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "login_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.login_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EmailAttribute(); ok {
+		if err := ldapsource.EmailAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "email_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.email_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayNameAttribute(); ok {
+		if err := ldapsource.DisplayNameAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "display_name_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.display_name_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MobileAttribute(); ok {
+		if err := ldapsource.MobileAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "mobile_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.mobile_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrgUnitFilter(); ok {
+		if err := ldapsource.OrgUnitFilterValidator(v); err != nil {
+			return &ValidationError{Name: "org_unit_filter", err: fmt.Errorf(`ent: validator failed for field "LdapSource.org_unit_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupBaseDn(); ok {
+		if err := ldapsource.GroupBaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "group_base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapSource.group_base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupFilter(); ok {
+		if err := ldapsource.GroupFilterValidator(v); err != nil {
+			return &ValidationError{Name: "group_filter", err: fmt.Errorf(`ent: validator failed for field "LdapSource.group_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupMemberAttribute(); ok {
+		if err := ldapsource.GroupMemberAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "group_member_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapSource.group_member_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastSyncError(); ok {
+		if err := ldapsource.LastSyncErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_sync_error", err: fmt.Errorf(`ent: validator failed for field "LdapSource.last_sync_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapSourceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapSourceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapSourceUpdateOne) sqlSave(ctx context.Context) (_node *LdapSource, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapsource.Table, ldapsource.Columns, sqlgraph.NewFieldSpec(ldapsource.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LdapSource.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ldapsource.FieldID)
+		for _, f := range fields {
+			if !ldapsource.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ldapsource.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(ldapsource.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapsource.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(ldapsource.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(ldapsource.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(ldapsource.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(ldapsource.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(ldapsource.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(ldapsource.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(ldapsource.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(ldapsource.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(ldapsource.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(ldapsource.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(ldapsource.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(ldapsource.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(ldapsource.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ldapsource.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(ldapsource.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ldapsource.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(ldapsource.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(ldapsource.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartTLS(); ok {
+		_spec.SetField(ldapsource.FieldStartTLS, field.TypeBool, value)
+	}
+	if _u.mutation.StartTLSCleared() {
+		_spec.ClearField(ldapsource.FieldStartTLS, field.TypeBool)
+	}
+	if value, ok := _u.mutation.InsecureSkipVerify(); ok {
+		_spec.SetField(ldapsource.FieldInsecureSkipVerify, field.TypeBool, value)
+	}
+	if _u.mutation.InsecureSkipVerifyCleared() {
+		_spec.ClearField(ldapsource.FieldInsecureSkipVerify, field.TypeBool)
+	}
+	if value, ok := _u.mutation.BindDn(); ok {
+		_spec.SetField(ldapsource.FieldBindDn, field.TypeString, value)
+	}
+	if _u.mutation.BindDnCleared() {
+		_spec.ClearField(ldapsource.FieldBindDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.BindPassword(); ok {
+		_spec.SetField(ldapsource.FieldBindPassword, field.TypeString, value)
+	}
+	if _u.mutation.BindPasswordCleared() {
+		_spec.ClearField(ldapsource.FieldBindPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.BaseDn(); ok {
+		_spec.SetField(ldapsource.FieldBaseDn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserFilter(); ok {
+		_spec.SetField(ldapsource.FieldUserFilter, field.TypeString, value)
+	}
+	if _u.mutation.UserFilterCleared() {
+		_spec.ClearField(ldapsource.FieldUserFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoginAttribute(); ok {
+		_spec.SetField(ldapsource.FieldLoginAttribute, field.TypeString, value)
+	}
+	if _u.mutation.LoginAttributeCleared() {
+		_spec.ClearField(ldapsource.FieldLoginAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailAttribute(); ok {
+		_spec.SetField(ldapsource.FieldEmailAttribute, field.TypeString, value)
+	}
+	if _u.mutation.EmailAttributeCleared() {
+		_spec.ClearField(ldapsource.FieldEmailAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisplayNameAttribute(); ok {
+		_spec.SetField(ldapsource.FieldDisplayNameAttribute, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameAttributeCleared() {
+		_spec.ClearField(ldapsource.FieldDisplayNameAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.MobileAttribute(); ok {
+		_spec.SetField(ldapsource.FieldMobileAttribute, field.TypeString, value)
+	}
+	if _u.mutation.MobileAttributeCleared() {
+		_spec.ClearField(ldapsource.FieldMobileAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrgUnitFilter(); ok {
+		_spec.SetField(ldapsource.FieldOrgUnitFilter, field.TypeString, value)
+	}
+	if _u.mutation.OrgUnitFilterCleared() {
+		_spec.ClearField(ldapsource.FieldOrgUnitFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupBaseDn(); ok {
+		_spec.SetField(ldapsource.FieldGroupBaseDn, field.TypeString, value)
+	}
+	if _u.mutation.GroupBaseDnCleared() {
+		_spec.ClearField(ldapsource.FieldGroupBaseDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupFilter(); ok {
+		_spec.SetField(ldapsource.FieldGroupFilter, field.TypeString, value)
+	}
+	if _u.mutation.GroupFilterCleared() {
+		_spec.ClearField(ldapsource.FieldGroupFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupMemberAttribute(); ok {
+		_spec.SetField(ldapsource.FieldGroupMemberAttribute, field.TypeString, value)
+	}
+	if _u.mutation.GroupMemberAttributeCleared() {
+		_spec.ClearField(ldapsource.FieldGroupMemberAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupRoleMappings(); ok {
+		_spec.SetField(ldapsource.FieldGroupRoleMappings, field.TypeJSON, value)
+	}
+	if _u.mutation.GroupRoleMappingsCleared() {
+		_spec.ClearField(ldapsource.FieldGroupRoleMappings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultRoleID(); ok {
+		_spec.SetField(ldapsource.FieldDefaultRoleID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultRoleID(); ok {
+		_spec.AddField(ldapsource.FieldDefaultRoleID, field.TypeUint32, value)
+	}
+	if _u.mutation.DefaultRoleIDCleared() {
+		_spec.ClearField(ldapsource.FieldDefaultRoleID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.SyncEnabled(); ok {
+		_spec.SetField(ldapsource.FieldSyncEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.SyncEnabledCleared() {
+		_spec.ClearField(ldapsource.FieldSyncEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DisableMissingUsers(); ok {
+		_spec.SetField(ldapsource.FieldDisableMissingUsers, field.TypeBool, value)
+	}
+	if _u.mutation.DisableMissingUsersCleared() {
+		_spec.ClearField(ldapsource.FieldDisableMissingUsers, field.TypeBool)
+	}
+	if value, ok := _u.mutation.LastSyncAt(); ok {
+		_spec.SetField(ldapsource.FieldLastSyncAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncAtCleared() {
+		_spec.ClearField(ldapsource.FieldLastSyncAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSyncError(); ok {
+		_spec.SetField(ldapsource.FieldLastSyncError, field.TypeString, value)
+	}
+	if _u.mutation.LastSyncErrorCleared() {
+		_spec.ClearField(ldapsource.FieldLastSyncError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LdapSource{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapsource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
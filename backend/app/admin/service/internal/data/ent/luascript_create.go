@@ -0,0 +1,1615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascript"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LuaScriptCreate is the builder for creating a LuaScript entity.
+type LuaScriptCreate struct {
+	config
+	mutation *LuaScriptMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LuaScriptCreate) SetCreatedAt(v time.Time) *LuaScriptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillableCreatedAt(v *time.Time) *LuaScriptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LuaScriptCreate) SetUpdatedAt(v time.Time) *LuaScriptCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillableUpdatedAt(v *time.Time) *LuaScriptCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *LuaScriptCreate) SetDeletedAt(v time.Time) *LuaScriptCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillableDeletedAt(v *time.Time) *LuaScriptCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *LuaScriptCreate) SetCreatedBy(v uint32) *LuaScriptCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillableCreatedBy(v *uint32) *LuaScriptCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *LuaScriptCreate) SetUpdatedBy(v uint32) *LuaScriptCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillableUpdatedBy(v *uint32) *LuaScriptCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *LuaScriptCreate) SetDeletedBy(v uint32) *LuaScriptCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillableDeletedBy(v *uint32) *LuaScriptCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *LuaScriptCreate) SetName(v string) *LuaScriptCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetHook sets the "hook" field.
+func (_c *LuaScriptCreate) SetHook(v string) *LuaScriptCreate {
+	_c.mutation.SetHook(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *LuaScriptCreate) SetSource(v string) *LuaScriptCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *LuaScriptCreate) SetPriority(v int32) *LuaScriptCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillablePriority(v *int32) *LuaScriptCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *LuaScriptCreate) SetEnabled(v bool) *LuaScriptCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillableEnabled(v *bool) *LuaScriptCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCritical sets the "critical" field.
+func (_c *LuaScriptCreate) SetCritical(v bool) *LuaScriptCreate {
+	_c.mutation.SetCritical(v)
+	return _c
+}
+
+// SetNillableCritical sets the "critical" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillableCritical(v *bool) *LuaScriptCreate {
+	if v != nil {
+		_c.SetCritical(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *LuaScriptCreate) SetVersion(v uint32) *LuaScriptCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillableVersion(v *uint32) *LuaScriptCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetAuthor sets the "author" field.
+func (_c *LuaScriptCreate) SetAuthor(v string) *LuaScriptCreate {
+	_c.mutation.SetAuthor(v)
+	return _c
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillableAuthor(v *string) *LuaScriptCreate {
+	if v != nil {
+		_c.SetAuthor(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *LuaScriptCreate) SetDescription(v string) *LuaScriptCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *LuaScriptCreate) SetNillableDescription(v *string) *LuaScriptCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LuaScriptCreate) SetID(v uint32) *LuaScriptCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LuaScriptMutation object of the builder.
+func (_c *LuaScriptCreate) Mutation() *LuaScriptMutation {
+	return _c.mutation
+}
+
+// Save creates the LuaScript in the database.
+func (_c *LuaScriptCreate) Save(ctx context.Context) (*LuaScript, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LuaScriptCreate) SaveX(ctx context.Context) *LuaScript {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LuaScriptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LuaScriptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LuaScriptCreate) defaults() {
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := luascript.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := luascript.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Critical(); !ok {
+		v := luascript.DefaultCritical
+		_c.mutation.SetCritical(v)
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := luascript.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LuaScriptCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "LuaScript.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := luascript.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LuaScript.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Hook(); !ok {
+		return &ValidationError{Name: "hook", err: errors.New(`ent: missing required field "LuaScript.hook"`)}
+	}
+	if v, ok := _c.mutation.Hook(); ok {
+		if err := luascript.HookValidator(v); err != nil {
+			return &ValidationError{Name: "hook", err: fmt.Errorf(`ent: validator failed for field "LuaScript.hook": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "LuaScript.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := luascript.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "LuaScript.source": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := luascript.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LuaScript.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LuaScriptCreate) sqlSave(ctx context.Context) (*LuaScript, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LuaScriptCreate) createSpec() (*LuaScript, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LuaScript{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(luascript.Table, sqlgraph.NewFieldSpec(luascript.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(luascript.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(luascript.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(luascript.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(luascript.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(luascript.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(luascript.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(luascript.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Hook(); ok {
+		_spec.SetField(luascript.FieldHook, field.TypeString, value)
+		_node.Hook = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(luascript.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(luascript.FieldPriority, field.TypeInt32, value)
+		_node.Priority = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(luascript.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = &value
+	}
+	if value, ok := _c.mutation.Critical(); ok {
+		_spec.SetField(luascript.FieldCritical, field.TypeBool, value)
+		_node.Critical = &value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(luascript.FieldVersion, field.TypeUint32, value)
+		_node.Version = &value
+	}
+	if value, ok := _c.mutation.Author(); ok {
+		_spec.SetField(luascript.FieldAuthor, field.TypeString, value)
+		_node.Author = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(luascript.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LuaScript.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LuaScriptUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LuaScriptCreate) OnConflict(opts ...sql.ConflictOption) *LuaScriptUpsertOne {
+	_c.conflict = opts
+	return &LuaScriptUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LuaScript.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LuaScriptCreate) OnConflictColumns(columns ...string) *LuaScriptUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LuaScriptUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LuaScriptUpsertOne is the builder for "upsert"-ing
+	//  one LuaScript node.
+	LuaScriptUpsertOne struct {
+		create *LuaScriptCreate
+	}
+
+	// LuaScriptUpsert is the "OnConflict" setter.
+	LuaScriptUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LuaScriptUpsert) SetUpdatedAt(v time.Time) *LuaScriptUpsert {
+	u.Set(luascript.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateUpdatedAt() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *LuaScriptUpsert) ClearUpdatedAt() *LuaScriptUpsert {
+	u.SetNull(luascript.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LuaScriptUpsert) SetDeletedAt(v time.Time) *LuaScriptUpsert {
+	u.Set(luascript.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateDeletedAt() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LuaScriptUpsert) ClearDeletedAt() *LuaScriptUpsert {
+	u.SetNull(luascript.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LuaScriptUpsert) SetCreatedBy(v uint32) *LuaScriptUpsert {
+	u.Set(luascript.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateCreatedBy() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LuaScriptUpsert) AddCreatedBy(v uint32) *LuaScriptUpsert {
+	u.Add(luascript.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LuaScriptUpsert) ClearCreatedBy() *LuaScriptUpsert {
+	u.SetNull(luascript.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *LuaScriptUpsert) SetUpdatedBy(v uint32) *LuaScriptUpsert {
+	u.Set(luascript.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateUpdatedBy() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *LuaScriptUpsert) AddUpdatedBy(v uint32) *LuaScriptUpsert {
+	u.Add(luascript.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *LuaScriptUpsert) ClearUpdatedBy() *LuaScriptUpsert {
+	u.SetNull(luascript.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *LuaScriptUpsert) SetDeletedBy(v uint32) *LuaScriptUpsert {
+	u.Set(luascript.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateDeletedBy() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *LuaScriptUpsert) AddDeletedBy(v uint32) *LuaScriptUpsert {
+	u.Add(luascript.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *LuaScriptUpsert) ClearDeletedBy() *LuaScriptUpsert {
+	u.SetNull(luascript.FieldDeletedBy)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *LuaScriptUpsert) SetName(v string) *LuaScriptUpsert {
+	u.Set(luascript.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateName() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldName)
+	return u
+}
+
+// SetHook sets the "hook" field.
+func (u *LuaScriptUpsert) SetHook(v string) *LuaScriptUpsert {
+	u.Set(luascript.FieldHook, v)
+	return u
+}
+
+// UpdateHook sets the "hook" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateHook() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldHook)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *LuaScriptUpsert) SetSource(v string) *LuaScriptUpsert {
+	u.Set(luascript.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateSource() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldSource)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *LuaScriptUpsert) SetPriority(v int32) *LuaScriptUpsert {
+	u.Set(luascript.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdatePriority() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *LuaScriptUpsert) AddPriority(v int32) *LuaScriptUpsert {
+	u.Add(luascript.FieldPriority, v)
+	return u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (u *LuaScriptUpsert) ClearPriority() *LuaScriptUpsert {
+	u.SetNull(luascript.FieldPriority)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *LuaScriptUpsert) SetEnabled(v bool) *LuaScriptUpsert {
+	u.Set(luascript.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateEnabled() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldEnabled)
+	return u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *LuaScriptUpsert) ClearEnabled() *LuaScriptUpsert {
+	u.SetNull(luascript.FieldEnabled)
+	return u
+}
+
+// SetCritical sets the "critical" field.
+func (u *LuaScriptUpsert) SetCritical(v bool) *LuaScriptUpsert {
+	u.Set(luascript.FieldCritical, v)
+	return u
+}
+
+// UpdateCritical sets the "critical" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateCritical() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldCritical)
+	return u
+}
+
+// ClearCritical clears the value of the "critical" field.
+func (u *LuaScriptUpsert) ClearCritical() *LuaScriptUpsert {
+	u.SetNull(luascript.FieldCritical)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *LuaScriptUpsert) SetVersion(v uint32) *LuaScriptUpsert {
+	u.Set(luascript.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateVersion() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *LuaScriptUpsert) AddVersion(v uint32) *LuaScriptUpsert {
+	u.Add(luascript.FieldVersion, v)
+	return u
+}
+
+// ClearVersion clears the value of the "version" field.
+func (u *LuaScriptUpsert) ClearVersion() *LuaScriptUpsert {
+	u.SetNull(luascript.FieldVersion)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *LuaScriptUpsert) SetAuthor(v string) *LuaScriptUpsert {
+	u.Set(luascript.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateAuthor() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldAuthor)
+	return u
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *LuaScriptUpsert) ClearAuthor() *LuaScriptUpsert {
+	u.SetNull(luascript.FieldAuthor)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *LuaScriptUpsert) SetDescription(v string) *LuaScriptUpsert {
+	u.Set(luascript.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LuaScriptUpsert) UpdateDescription() *LuaScriptUpsert {
+	u.SetExcluded(luascript.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LuaScriptUpsert) ClearDescription() *LuaScriptUpsert {
+	u.SetNull(luascript.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LuaScript.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(luascript.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LuaScriptUpsertOne) UpdateNewValues() *LuaScriptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(luascript.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(luascript.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LuaScript.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LuaScriptUpsertOne) Ignore() *LuaScriptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LuaScriptUpsertOne) DoNothing() *LuaScriptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LuaScriptCreate.OnConflict
+// documentation for more info.
+func (u *LuaScriptUpsertOne) Update(set func(*LuaScriptUpsert)) *LuaScriptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LuaScriptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LuaScriptUpsertOne) SetUpdatedAt(v time.Time) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateUpdatedAt() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *LuaScriptUpsertOne) ClearUpdatedAt() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LuaScriptUpsertOne) SetDeletedAt(v time.Time) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateDeletedAt() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LuaScriptUpsertOne) ClearDeletedAt() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LuaScriptUpsertOne) SetCreatedBy(v uint32) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LuaScriptUpsertOne) AddCreatedBy(v uint32) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateCreatedBy() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LuaScriptUpsertOne) ClearCreatedBy() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *LuaScriptUpsertOne) SetUpdatedBy(v uint32) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *LuaScriptUpsertOne) AddUpdatedBy(v uint32) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateUpdatedBy() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *LuaScriptUpsertOne) ClearUpdatedBy() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *LuaScriptUpsertOne) SetDeletedBy(v uint32) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *LuaScriptUpsertOne) AddDeletedBy(v uint32) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateDeletedBy() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *LuaScriptUpsertOne) ClearDeletedBy() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LuaScriptUpsertOne) SetName(v string) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateName() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetHook sets the "hook" field.
+func (u *LuaScriptUpsertOne) SetHook(v string) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetHook(v)
+	})
+}
+
+// UpdateHook sets the "hook" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateHook() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateHook()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *LuaScriptUpsertOne) SetSource(v string) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateSource() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *LuaScriptUpsertOne) SetPriority(v int32) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *LuaScriptUpsertOne) AddPriority(v int32) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdatePriority() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (u *LuaScriptUpsertOne) ClearPriority() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearPriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *LuaScriptUpsertOne) SetEnabled(v bool) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateEnabled() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *LuaScriptUpsertOne) ClearEnabled() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearEnabled()
+	})
+}
+
+// SetCritical sets the "critical" field.
+func (u *LuaScriptUpsertOne) SetCritical(v bool) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetCritical(v)
+	})
+}
+
+// UpdateCritical sets the "critical" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateCritical() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateCritical()
+	})
+}
+
+// ClearCritical clears the value of the "critical" field.
+func (u *LuaScriptUpsertOne) ClearCritical() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearCritical()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *LuaScriptUpsertOne) SetVersion(v uint32) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *LuaScriptUpsertOne) AddVersion(v uint32) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateVersion() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// ClearVersion clears the value of the "version" field.
+func (u *LuaScriptUpsertOne) ClearVersion() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearVersion()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *LuaScriptUpsertOne) SetAuthor(v string) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateAuthor() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *LuaScriptUpsertOne) ClearAuthor() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearAuthor()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LuaScriptUpsertOne) SetDescription(v string) *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LuaScriptUpsertOne) UpdateDescription() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LuaScriptUpsertOne) ClearDescription() *LuaScriptUpsertOne {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *LuaScriptUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LuaScriptCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LuaScriptUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LuaScriptUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LuaScriptUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LuaScriptCreateBulk is the builder for creating many LuaScript entities in bulk.
+type LuaScriptCreateBulk struct {
+	config
+	err      error
+	builders []*LuaScriptCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LuaScript entities in the database.
+func (_c *LuaScriptCreateBulk) Save(ctx context.Context) ([]*LuaScript, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LuaScript, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LuaScriptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LuaScriptCreateBulk) SaveX(ctx context.Context) []*LuaScript {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LuaScriptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LuaScriptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LuaScript.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LuaScriptUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LuaScriptCreateBulk) OnConflict(opts ...sql.ConflictOption) *LuaScriptUpsertBulk {
+	_c.conflict = opts
+	return &LuaScriptUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LuaScript.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LuaScriptCreateBulk) OnConflictColumns(columns ...string) *LuaScriptUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LuaScriptUpsertBulk{
+		create: _c,
+	}
+}
+
+// LuaScriptUpsertBulk is the builder for "upsert"-ing
+// a bulk of LuaScript nodes.
+type LuaScriptUpsertBulk struct {
+	create *LuaScriptCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LuaScript.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(luascript.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LuaScriptUpsertBulk) UpdateNewValues() *LuaScriptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(luascript.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(luascript.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LuaScript.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LuaScriptUpsertBulk) Ignore() *LuaScriptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LuaScriptUpsertBulk) DoNothing() *LuaScriptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LuaScriptCreateBulk.OnConflict
+// documentation for more info.
+func (u *LuaScriptUpsertBulk) Update(set func(*LuaScriptUpsert)) *LuaScriptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LuaScriptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LuaScriptUpsertBulk) SetUpdatedAt(v time.Time) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateUpdatedAt() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *LuaScriptUpsertBulk) ClearUpdatedAt() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LuaScriptUpsertBulk) SetDeletedAt(v time.Time) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateDeletedAt() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LuaScriptUpsertBulk) ClearDeletedAt() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LuaScriptUpsertBulk) SetCreatedBy(v uint32) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LuaScriptUpsertBulk) AddCreatedBy(v uint32) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateCreatedBy() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LuaScriptUpsertBulk) ClearCreatedBy() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *LuaScriptUpsertBulk) SetUpdatedBy(v uint32) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *LuaScriptUpsertBulk) AddUpdatedBy(v uint32) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateUpdatedBy() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *LuaScriptUpsertBulk) ClearUpdatedBy() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *LuaScriptUpsertBulk) SetDeletedBy(v uint32) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *LuaScriptUpsertBulk) AddDeletedBy(v uint32) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateDeletedBy() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *LuaScriptUpsertBulk) ClearDeletedBy() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LuaScriptUpsertBulk) SetName(v string) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateName() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetHook sets the "hook" field.
+func (u *LuaScriptUpsertBulk) SetHook(v string) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetHook(v)
+	})
+}
+
+// UpdateHook sets the "hook" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateHook() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateHook()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *LuaScriptUpsertBulk) SetSource(v string) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateSource() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *LuaScriptUpsertBulk) SetPriority(v int32) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *LuaScriptUpsertBulk) AddPriority(v int32) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdatePriority() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (u *LuaScriptUpsertBulk) ClearPriority() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearPriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *LuaScriptUpsertBulk) SetEnabled(v bool) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateEnabled() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (u *LuaScriptUpsertBulk) ClearEnabled() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearEnabled()
+	})
+}
+
+// SetCritical sets the "critical" field.
+func (u *LuaScriptUpsertBulk) SetCritical(v bool) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetCritical(v)
+	})
+}
+
+// UpdateCritical sets the "critical" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateCritical() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateCritical()
+	})
+}
+
+// ClearCritical clears the value of the "critical" field.
+func (u *LuaScriptUpsertBulk) ClearCritical() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearCritical()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *LuaScriptUpsertBulk) SetVersion(v uint32) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *LuaScriptUpsertBulk) AddVersion(v uint32) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateVersion() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// ClearVersion clears the value of the "version" field.
+func (u *LuaScriptUpsertBulk) ClearVersion() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearVersion()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *LuaScriptUpsertBulk) SetAuthor(v string) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateAuthor() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *LuaScriptUpsertBulk) ClearAuthor() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearAuthor()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LuaScriptUpsertBulk) SetDescription(v string) *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LuaScriptUpsertBulk) UpdateDescription() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LuaScriptUpsertBulk) ClearDescription() *LuaScriptUpsertBulk {
+	return u.Update(func(s *LuaScriptUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *LuaScriptUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LuaScriptCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LuaScriptCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LuaScriptUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
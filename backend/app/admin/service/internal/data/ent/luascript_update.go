@@ -0,0 +1,1018 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascript"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LuaScriptUpdate is the builder for updating LuaScript entities.
+type LuaScriptUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LuaScriptMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LuaScriptUpdate builder.
+func (_u *LuaScriptUpdate) Where(ps ...predicate.LuaScript) *LuaScriptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LuaScriptUpdate) SetUpdatedAt(v time.Time) *LuaScriptUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableUpdatedAt(v *time.Time) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *LuaScriptUpdate) ClearUpdatedAt() *LuaScriptUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *LuaScriptUpdate) SetDeletedAt(v time.Time) *LuaScriptUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableDeletedAt(v *time.Time) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *LuaScriptUpdate) ClearDeletedAt() *LuaScriptUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LuaScriptUpdate) SetCreatedBy(v uint32) *LuaScriptUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableCreatedBy(v *uint32) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LuaScriptUpdate) AddCreatedBy(v int32) *LuaScriptUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LuaScriptUpdate) ClearCreatedBy() *LuaScriptUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *LuaScriptUpdate) SetUpdatedBy(v uint32) *LuaScriptUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableUpdatedBy(v *uint32) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *LuaScriptUpdate) AddUpdatedBy(v int32) *LuaScriptUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *LuaScriptUpdate) ClearUpdatedBy() *LuaScriptUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *LuaScriptUpdate) SetDeletedBy(v uint32) *LuaScriptUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableDeletedBy(v *uint32) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *LuaScriptUpdate) AddDeletedBy(v int32) *LuaScriptUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *LuaScriptUpdate) ClearDeletedBy() *LuaScriptUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LuaScriptUpdate) SetName(v string) *LuaScriptUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableName(v *string) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetHook sets the "hook" field.
+func (_u *LuaScriptUpdate) SetHook(v string) *LuaScriptUpdate {
+	_u.mutation.SetHook(v)
+	return _u
+}
+
+// SetNillableHook sets the "hook" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableHook(v *string) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetHook(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *LuaScriptUpdate) SetSource(v string) *LuaScriptUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableSource(v *string) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *LuaScriptUpdate) SetPriority(v int32) *LuaScriptUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillablePriority(v *int32) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *LuaScriptUpdate) AddPriority(v int32) *LuaScriptUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (_u *LuaScriptUpdate) ClearPriority() *LuaScriptUpdate {
+	_u.mutation.ClearPriority()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *LuaScriptUpdate) SetEnabled(v bool) *LuaScriptUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableEnabled(v *bool) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *LuaScriptUpdate) ClearEnabled() *LuaScriptUpdate {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetCritical sets the "critical" field.
+func (_u *LuaScriptUpdate) SetCritical(v bool) *LuaScriptUpdate {
+	_u.mutation.SetCritical(v)
+	return _u
+}
+
+// SetNillableCritical sets the "critical" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableCritical(v *bool) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetCritical(*v)
+	}
+	return _u
+}
+
+// ClearCritical clears the value of the "critical" field.
+func (_u *LuaScriptUpdate) ClearCritical() *LuaScriptUpdate {
+	_u.mutation.ClearCritical()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *LuaScriptUpdate) SetVersion(v uint32) *LuaScriptUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableVersion(v *uint32) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *LuaScriptUpdate) AddVersion(v int32) *LuaScriptUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// ClearVersion clears the value of the "version" field.
+func (_u *LuaScriptUpdate) ClearVersion() *LuaScriptUpdate {
+	_u.mutation.ClearVersion()
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *LuaScriptUpdate) SetAuthor(v string) *LuaScriptUpdate {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableAuthor(v *string) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (_u *LuaScriptUpdate) ClearAuthor() *LuaScriptUpdate {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *LuaScriptUpdate) SetDescription(v string) *LuaScriptUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *LuaScriptUpdate) SetNillableDescription(v *string) *LuaScriptUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *LuaScriptUpdate) ClearDescription() *LuaScriptUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the LuaScriptMutation object of the builder.
+func (_u *LuaScriptUpdate) Mutation() *LuaScriptMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LuaScriptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LuaScriptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LuaScriptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LuaScriptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LuaScriptUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := luascript.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LuaScript.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hook(); ok {
+		if err := luascript.HookValidator(v); err != nil {
+			return &ValidationError{Name: "hook", err: fmt.Errorf(`ent: validator failed for field "LuaScript.hook": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := luascript.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "LuaScript.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LuaScriptUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LuaScriptUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LuaScriptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(luascript.Table, luascript.Columns, sqlgraph.NewFieldSpec(luascript.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(luascript.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(luascript.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(luascript.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(luascript.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(luascript.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(luascript.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(luascript.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(luascript.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(luascript.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(luascript.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(luascript.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(luascript.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(luascript.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(luascript.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(luascript.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hook(); ok {
+		_spec.SetField(luascript.FieldHook, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(luascript.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(luascript.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(luascript.FieldPriority, field.TypeInt32, value)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(luascript.FieldPriority, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(luascript.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(luascript.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Critical(); ok {
+		_spec.SetField(luascript.FieldCritical, field.TypeBool, value)
+	}
+	if _u.mutation.CriticalCleared() {
+		_spec.ClearField(luascript.FieldCritical, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(luascript.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(luascript.FieldVersion, field.TypeUint32, value)
+	}
+	if _u.mutation.VersionCleared() {
+		_spec.ClearField(luascript.FieldVersion, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(luascript.FieldAuthor, field.TypeString, value)
+	}
+	if _u.mutation.AuthorCleared() {
+		_spec.ClearField(luascript.FieldAuthor, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(luascript.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(luascript.FieldDescription, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{luascript.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LuaScriptUpdateOne is the builder for updating a single LuaScript entity.
+type LuaScriptUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LuaScriptMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LuaScriptUpdateOne) SetUpdatedAt(v time.Time) *LuaScriptUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableUpdatedAt(v *time.Time) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *LuaScriptUpdateOne) ClearUpdatedAt() *LuaScriptUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *LuaScriptUpdateOne) SetDeletedAt(v time.Time) *LuaScriptUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableDeletedAt(v *time.Time) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *LuaScriptUpdateOne) ClearDeletedAt() *LuaScriptUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LuaScriptUpdateOne) SetCreatedBy(v uint32) *LuaScriptUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableCreatedBy(v *uint32) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LuaScriptUpdateOne) AddCreatedBy(v int32) *LuaScriptUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LuaScriptUpdateOne) ClearCreatedBy() *LuaScriptUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *LuaScriptUpdateOne) SetUpdatedBy(v uint32) *LuaScriptUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableUpdatedBy(v *uint32) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *LuaScriptUpdateOne) AddUpdatedBy(v int32) *LuaScriptUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *LuaScriptUpdateOne) ClearUpdatedBy() *LuaScriptUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *LuaScriptUpdateOne) SetDeletedBy(v uint32) *LuaScriptUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableDeletedBy(v *uint32) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *LuaScriptUpdateOne) AddDeletedBy(v int32) *LuaScriptUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *LuaScriptUpdateOne) ClearDeletedBy() *LuaScriptUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LuaScriptUpdateOne) SetName(v string) *LuaScriptUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableName(v *string) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetHook sets the "hook" field.
+func (_u *LuaScriptUpdateOne) SetHook(v string) *LuaScriptUpdateOne {
+	_u.mutation.SetHook(v)
+	return _u
+}
+
+// SetNillableHook sets the "hook" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableHook(v *string) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetHook(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *LuaScriptUpdateOne) SetSource(v string) *LuaScriptUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableSource(v *string) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *LuaScriptUpdateOne) SetPriority(v int32) *LuaScriptUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillablePriority(v *int32) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *LuaScriptUpdateOne) AddPriority(v int32) *LuaScriptUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (_u *LuaScriptUpdateOne) ClearPriority() *LuaScriptUpdateOne {
+	_u.mutation.ClearPriority()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *LuaScriptUpdateOne) SetEnabled(v bool) *LuaScriptUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableEnabled(v *bool) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *LuaScriptUpdateOne) ClearEnabled() *LuaScriptUpdateOne {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetCritical sets the "critical" field.
+func (_u *LuaScriptUpdateOne) SetCritical(v bool) *LuaScriptUpdateOne {
+	_u.mutation.SetCritical(v)
+	return _u
+}
+
+// SetNillableCritical sets the "critical" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableCritical(v *bool) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetCritical(*v)
+	}
+	return _u
+}
+
+// ClearCritical clears the value of the "critical" field.
+func (_u *LuaScriptUpdateOne) ClearCritical() *LuaScriptUpdateOne {
+	_u.mutation.ClearCritical()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *LuaScriptUpdateOne) SetVersion(v uint32) *LuaScriptUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableVersion(v *uint32) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *LuaScriptUpdateOne) AddVersion(v int32) *LuaScriptUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// ClearVersion clears the value of the "version" field.
+func (_u *LuaScriptUpdateOne) ClearVersion() *LuaScriptUpdateOne {
+	_u.mutation.ClearVersion()
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *LuaScriptUpdateOne) SetAuthor(v string) *LuaScriptUpdateOne {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableAuthor(v *string) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (_u *LuaScriptUpdateOne) ClearAuthor() *LuaScriptUpdateOne {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *LuaScriptUpdateOne) SetDescription(v string) *LuaScriptUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *LuaScriptUpdateOne) SetNillableDescription(v *string) *LuaScriptUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *LuaScriptUpdateOne) ClearDescription() *LuaScriptUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the LuaScriptMutation object of the builder.
+func (_u *LuaScriptUpdateOne) Mutation() *LuaScriptMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LuaScriptUpdate builder.
+func (_u *LuaScriptUpdateOne) Where(ps ...predicate.LuaScript) *LuaScriptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LuaScriptUpdateOne) Select(field string, fields ...string) *LuaScriptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LuaScript entity.
+func (_u *LuaScriptUpdateOne) Save(ctx context.Context) (*LuaScript, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LuaScriptUpdateOne) SaveX(ctx context.Context) *LuaScript {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LuaScriptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LuaScriptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LuaScriptUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := luascript.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LuaScript.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hook(); ok {
+		if err := luascript.HookValidator(v); err != nil {
+			return &ValidationError{Name: "hook", err: fmt.Errorf(`ent: validator failed for field "LuaScript.hook": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := luascript.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "LuaScript.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LuaScriptUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LuaScriptUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LuaScriptUpdateOne) sqlSave(ctx context.Context) (_node *LuaScript, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(luascript.Table, luascript.Columns, sqlgraph.NewFieldSpec(luascript.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LuaScript.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, luascript.FieldID)
+		for _, f := range fields {
+			if !luascript.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != luascript.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(luascript.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(luascript.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(luascript.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(luascript.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(luascript.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(luascript.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(luascript.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(luascript.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(luascript.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(luascript.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(luascript.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(luascript.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(luascript.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(luascript.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(luascript.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hook(); ok {
+		_spec.SetField(luascript.FieldHook, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(luascript.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(luascript.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(luascript.FieldPriority, field.TypeInt32, value)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(luascript.FieldPriority, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(luascript.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(luascript.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Critical(); ok {
+		_spec.SetField(luascript.FieldCritical, field.TypeBool, value)
+	}
+	if _u.mutation.CriticalCleared() {
+		_spec.ClearField(luascript.FieldCritical, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(luascript.FieldVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(luascript.FieldVersion, field.TypeUint32, value)
+	}
+	if _u.mutation.VersionCleared() {
+		_spec.ClearField(luascript.FieldVersion, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(luascript.FieldAuthor, field.TypeString, value)
+	}
+	if _u.mutation.AuthorCleared() {
+		_spec.ClearField(luascript.FieldAuthor, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(luascript.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(luascript.FieldDescription, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LuaScript{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{luascript.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Lua脚本历史版本表
+type LuaScriptVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 创建者ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 脚本ID
+	ScriptID uint32 `json:"script_id,omitempty"`
+	// 版本号
+	Version uint32 `json:"version,omitempty"`
+	// 脚本名称
+	Name string `json:"name,omitempty"`
+	// 挂载的钩子点
+	Hook string `json:"hook,omitempty"`
+	// Lua源代码
+	Source string `json:"source,omitempty"`
+	// 执行优先级
+	Priority *int32 `json:"priority,omitempty"`
+	// 是否启用
+	Enabled *bool `json:"enabled,omitempty"`
+	// 是否为关键脚本
+	Critical *bool `json:"critical,omitempty"`
+	// 作者
+	Author *string `json:"author,omitempty"`
+	// 描述
+	Description  *string `json:"description,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LuaScriptVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case luascriptversion.FieldEnabled, luascriptversion.FieldCritical:
+			values[i] = new(sql.NullBool)
+		case luascriptversion.FieldID, luascriptversion.FieldCreatedBy, luascriptversion.FieldScriptID, luascriptversion.FieldVersion, luascriptversion.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case luascriptversion.FieldName, luascriptversion.FieldHook, luascriptversion.FieldSource, luascriptversion.FieldAuthor, luascriptversion.FieldDescription:
+			values[i] = new(sql.NullString)
+		case luascriptversion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LuaScriptVersion fields.
+func (_m *LuaScriptVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case luascriptversion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case luascriptversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case luascriptversion.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case luascriptversion.FieldScriptID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field script_id", values[i])
+			} else if value.Valid {
+				_m.ScriptID = uint32(value.Int64)
+			}
+		case luascriptversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = uint32(value.Int64)
+			}
+		case luascriptversion.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case luascriptversion.FieldHook:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hook", values[i])
+			} else if value.Valid {
+				_m.Hook = value.String
+			}
+		case luascriptversion.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case luascriptversion.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = new(int32)
+				*_m.Priority = int32(value.Int64)
+			}
+		case luascriptversion.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = new(bool)
+				*_m.Enabled = value.Bool
+			}
+		case luascriptversion.FieldCritical:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field critical", values[i])
+			} else if value.Valid {
+				_m.Critical = new(bool)
+				*_m.Critical = value.Bool
+			}
+		case luascriptversion.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				_m.Author = new(string)
+				*_m.Author = value.String
+			}
+		case luascriptversion.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LuaScriptVersion.
+// This includes values selected through modifiers, order, etc.
+func (_m *LuaScriptVersion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LuaScriptVersion.
+// Note that you need to call LuaScriptVersion.Unwrap() before calling this method if this LuaScriptVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LuaScriptVersion) Update() *LuaScriptVersionUpdateOne {
+	return NewLuaScriptVersionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LuaScriptVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LuaScriptVersion) Unwrap() *LuaScriptVersion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LuaScriptVersion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LuaScriptVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("LuaScriptVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("script_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScriptID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("hook=")
+	builder.WriteString(_m.Hook)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	if v := _m.Priority; v != nil {
+		builder.WriteString("priority=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Enabled; v != nil {
+		builder.WriteString("enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Critical; v != nil {
+		builder.WriteString("critical=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Author; v != nil {
+		builder.WriteString("author=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LuaScriptVersions is a parsable slice of LuaScriptVersion.
+type LuaScriptVersions []*LuaScriptVersion
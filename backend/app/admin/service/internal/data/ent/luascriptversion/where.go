@@ -0,0 +1,745 @@
+// Code generated by ent, DO NOT EDIT.
+
+package luascriptversion
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// ScriptID applies equality check predicate on the "script_id" field. It's identical to ScriptIDEQ.
+func ScriptID(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldScriptID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldName, v))
+}
+
+// Hook applies equality check predicate on the "hook" field. It's identical to HookEQ.
+func Hook(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldHook, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldSource, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldPriority, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Critical applies equality check predicate on the "critical" field. It's identical to CriticalEQ.
+func Critical(v bool) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldCritical, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldAuthor, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// ScriptIDEQ applies the EQ predicate on the "script_id" field.
+func ScriptIDEQ(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldScriptID, v))
+}
+
+// ScriptIDNEQ applies the NEQ predicate on the "script_id" field.
+func ScriptIDNEQ(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldScriptID, v))
+}
+
+// ScriptIDIn applies the In predicate on the "script_id" field.
+func ScriptIDIn(vs ...uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIn(FieldScriptID, vs...))
+}
+
+// ScriptIDNotIn applies the NotIn predicate on the "script_id" field.
+func ScriptIDNotIn(vs ...uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotIn(FieldScriptID, vs...))
+}
+
+// ScriptIDGT applies the GT predicate on the "script_id" field.
+func ScriptIDGT(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGT(FieldScriptID, v))
+}
+
+// ScriptIDGTE applies the GTE predicate on the "script_id" field.
+func ScriptIDGTE(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGTE(FieldScriptID, v))
+}
+
+// ScriptIDLT applies the LT predicate on the "script_id" field.
+func ScriptIDLT(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLT(FieldScriptID, v))
+}
+
+// ScriptIDLTE applies the LTE predicate on the "script_id" field.
+func ScriptIDLTE(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLTE(FieldScriptID, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v uint32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldContainsFold(FieldName, v))
+}
+
+// HookEQ applies the EQ predicate on the "hook" field.
+func HookEQ(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldHook, v))
+}
+
+// HookNEQ applies the NEQ predicate on the "hook" field.
+func HookNEQ(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldHook, v))
+}
+
+// HookIn applies the In predicate on the "hook" field.
+func HookIn(vs ...string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIn(FieldHook, vs...))
+}
+
+// HookNotIn applies the NotIn predicate on the "hook" field.
+func HookNotIn(vs ...string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotIn(FieldHook, vs...))
+}
+
+// HookGT applies the GT predicate on the "hook" field.
+func HookGT(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGT(FieldHook, v))
+}
+
+// HookGTE applies the GTE predicate on the "hook" field.
+func HookGTE(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGTE(FieldHook, v))
+}
+
+// HookLT applies the LT predicate on the "hook" field.
+func HookLT(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLT(FieldHook, v))
+}
+
+// HookLTE applies the LTE predicate on the "hook" field.
+func HookLTE(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLTE(FieldHook, v))
+}
+
+// HookContains applies the Contains predicate on the "hook" field.
+func HookContains(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldContains(FieldHook, v))
+}
+
+// HookHasPrefix applies the HasPrefix predicate on the "hook" field.
+func HookHasPrefix(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldHasPrefix(FieldHook, v))
+}
+
+// HookHasSuffix applies the HasSuffix predicate on the "hook" field.
+func HookHasSuffix(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldHasSuffix(FieldHook, v))
+}
+
+// HookEqualFold applies the EqualFold predicate on the "hook" field.
+func HookEqualFold(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEqualFold(FieldHook, v))
+}
+
+// HookContainsFold applies the ContainsFold predicate on the "hook" field.
+func HookContainsFold(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldContainsFold(FieldHook, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldContainsFold(FieldSource, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int32) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLTE(FieldPriority, v))
+}
+
+// PriorityIsNil applies the IsNil predicate on the "priority" field.
+func PriorityIsNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIsNull(FieldPriority))
+}
+
+// PriorityNotNil applies the NotNil predicate on the "priority" field.
+func PriorityNotNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotNull(FieldPriority))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// EnabledIsNil applies the IsNil predicate on the "enabled" field.
+func EnabledIsNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIsNull(FieldEnabled))
+}
+
+// EnabledNotNil applies the NotNil predicate on the "enabled" field.
+func EnabledNotNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotNull(FieldEnabled))
+}
+
+// CriticalEQ applies the EQ predicate on the "critical" field.
+func CriticalEQ(v bool) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldCritical, v))
+}
+
+// CriticalNEQ applies the NEQ predicate on the "critical" field.
+func CriticalNEQ(v bool) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldCritical, v))
+}
+
+// CriticalIsNil applies the IsNil predicate on the "critical" field.
+func CriticalIsNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIsNull(FieldCritical))
+}
+
+// CriticalNotNil applies the NotNil predicate on the "critical" field.
+func CriticalNotNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotNull(FieldCritical))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorIsNil applies the IsNil predicate on the "author" field.
+func AuthorIsNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIsNull(FieldAuthor))
+}
+
+// AuthorNotNil applies the NotNil predicate on the "author" field.
+func AuthorNotNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotNull(FieldAuthor))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LuaScriptVersion) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LuaScriptVersion) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LuaScriptVersion) predicate.LuaScriptVersion {
+	return predicate.LuaScriptVersion(sql.NotPredicates(p))
+}
@@ -0,0 +1,774 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LuaScriptVersionCreate is the builder for creating a LuaScriptVersion entity.
+type LuaScriptVersionCreate struct {
+	config
+	mutation *LuaScriptVersionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LuaScriptVersionCreate) SetCreatedAt(v time.Time) *LuaScriptVersionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableCreatedAt(v *time.Time) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *LuaScriptVersionCreate) SetCreatedBy(v uint32) *LuaScriptVersionCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableCreatedBy(v *uint32) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetScriptID sets the "script_id" field.
+func (_c *LuaScriptVersionCreate) SetScriptID(v uint32) *LuaScriptVersionCreate {
+	_c.mutation.SetScriptID(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *LuaScriptVersionCreate) SetVersion(v uint32) *LuaScriptVersionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *LuaScriptVersionCreate) SetName(v string) *LuaScriptVersionCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetHook sets the "hook" field.
+func (_c *LuaScriptVersionCreate) SetHook(v string) *LuaScriptVersionCreate {
+	_c.mutation.SetHook(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *LuaScriptVersionCreate) SetSource(v string) *LuaScriptVersionCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *LuaScriptVersionCreate) SetPriority(v int32) *LuaScriptVersionCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillablePriority(v *int32) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *LuaScriptVersionCreate) SetEnabled(v bool) *LuaScriptVersionCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableEnabled(v *bool) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCritical sets the "critical" field.
+func (_c *LuaScriptVersionCreate) SetCritical(v bool) *LuaScriptVersionCreate {
+	_c.mutation.SetCritical(v)
+	return _c
+}
+
+// SetNillableCritical sets the "critical" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableCritical(v *bool) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetCritical(*v)
+	}
+	return _c
+}
+
+// SetAuthor sets the "author" field.
+func (_c *LuaScriptVersionCreate) SetAuthor(v string) *LuaScriptVersionCreate {
+	_c.mutation.SetAuthor(v)
+	return _c
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableAuthor(v *string) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetAuthor(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *LuaScriptVersionCreate) SetDescription(v string) *LuaScriptVersionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *LuaScriptVersionCreate) SetNillableDescription(v *string) *LuaScriptVersionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LuaScriptVersionCreate) SetID(v uint32) *LuaScriptVersionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LuaScriptVersionMutation object of the builder.
+func (_c *LuaScriptVersionCreate) Mutation() *LuaScriptVersionMutation {
+	return _c.mutation
+}
+
+// Save creates the LuaScriptVersion in the database.
+func (_c *LuaScriptVersionCreate) Save(ctx context.Context) (*LuaScriptVersion, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LuaScriptVersionCreate) SaveX(ctx context.Context) *LuaScriptVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LuaScriptVersionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LuaScriptVersionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LuaScriptVersionCreate) check() error {
+	if _, ok := _c.mutation.ScriptID(); !ok {
+		return &ValidationError{Name: "script_id", err: errors.New(`ent: missing required field "LuaScriptVersion.script_id"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "LuaScriptVersion.version"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "LuaScriptVersion.name"`)}
+	}
+	if _, ok := _c.mutation.Hook(); !ok {
+		return &ValidationError{Name: "hook", err: errors.New(`ent: missing required field "LuaScriptVersion.hook"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "LuaScriptVersion.source"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := luascriptversion.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LuaScriptVersion.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LuaScriptVersionCreate) sqlSave(ctx context.Context) (*LuaScriptVersion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LuaScriptVersionCreate) createSpec() (*LuaScriptVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LuaScriptVersion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(luascriptversion.Table, sqlgraph.NewFieldSpec(luascriptversion.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(luascriptversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(luascriptversion.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.ScriptID(); ok {
+		_spec.SetField(luascriptversion.FieldScriptID, field.TypeUint32, value)
+		_node.ScriptID = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(luascriptversion.FieldVersion, field.TypeUint32, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(luascriptversion.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Hook(); ok {
+		_spec.SetField(luascriptversion.FieldHook, field.TypeString, value)
+		_node.Hook = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(luascriptversion.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(luascriptversion.FieldPriority, field.TypeInt32, value)
+		_node.Priority = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(luascriptversion.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = &value
+	}
+	if value, ok := _c.mutation.Critical(); ok {
+		_spec.SetField(luascriptversion.FieldCritical, field.TypeBool, value)
+		_node.Critical = &value
+	}
+	if value, ok := _c.mutation.Author(); ok {
+		_spec.SetField(luascriptversion.FieldAuthor, field.TypeString, value)
+		_node.Author = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(luascriptversion.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LuaScriptVersion.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LuaScriptVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LuaScriptVersionCreate) OnConflict(opts ...sql.ConflictOption) *LuaScriptVersionUpsertOne {
+	_c.conflict = opts
+	return &LuaScriptVersionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LuaScriptVersionCreate) OnConflictColumns(columns ...string) *LuaScriptVersionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LuaScriptVersionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LuaScriptVersionUpsertOne is the builder for "upsert"-ing
+	//  one LuaScriptVersion node.
+	LuaScriptVersionUpsertOne struct {
+		create *LuaScriptVersionCreate
+	}
+
+	// LuaScriptVersionUpsert is the "OnConflict" setter.
+	LuaScriptVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LuaScriptVersionUpsert) SetCreatedBy(v uint32) *LuaScriptVersionUpsert {
+	u.Set(luascriptversion.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsert) UpdateCreatedBy() *LuaScriptVersionUpsert {
+	u.SetExcluded(luascriptversion.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LuaScriptVersionUpsert) AddCreatedBy(v uint32) *LuaScriptVersionUpsert {
+	u.Add(luascriptversion.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LuaScriptVersionUpsert) ClearCreatedBy() *LuaScriptVersionUpsert {
+	u.SetNull(luascriptversion.FieldCreatedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(luascriptversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LuaScriptVersionUpsertOne) UpdateNewValues() *LuaScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(luascriptversion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(luascriptversion.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.ScriptID(); exists {
+			s.SetIgnore(luascriptversion.FieldScriptID)
+		}
+		if _, exists := u.create.mutation.Version(); exists {
+			s.SetIgnore(luascriptversion.FieldVersion)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(luascriptversion.FieldName)
+		}
+		if _, exists := u.create.mutation.Hook(); exists {
+			s.SetIgnore(luascriptversion.FieldHook)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(luascriptversion.FieldSource)
+		}
+		if _, exists := u.create.mutation.Priority(); exists {
+			s.SetIgnore(luascriptversion.FieldPriority)
+		}
+		if _, exists := u.create.mutation.Enabled(); exists {
+			s.SetIgnore(luascriptversion.FieldEnabled)
+		}
+		if _, exists := u.create.mutation.Critical(); exists {
+			s.SetIgnore(luascriptversion.FieldCritical)
+		}
+		if _, exists := u.create.mutation.Author(); exists {
+			s.SetIgnore(luascriptversion.FieldAuthor)
+		}
+		if _, exists := u.create.mutation.Description(); exists {
+			s.SetIgnore(luascriptversion.FieldDescription)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LuaScriptVersionUpsertOne) Ignore() *LuaScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LuaScriptVersionUpsertOne) DoNothing() *LuaScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LuaScriptVersionCreate.OnConflict
+// documentation for more info.
+func (u *LuaScriptVersionUpsertOne) Update(set func(*LuaScriptVersionUpsert)) *LuaScriptVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LuaScriptVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LuaScriptVersionUpsertOne) SetCreatedBy(v uint32) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LuaScriptVersionUpsertOne) AddCreatedBy(v uint32) *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertOne) UpdateCreatedBy() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LuaScriptVersionUpsertOne) ClearCreatedBy() *LuaScriptVersionUpsertOne {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *LuaScriptVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LuaScriptVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LuaScriptVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LuaScriptVersionUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LuaScriptVersionUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LuaScriptVersionCreateBulk is the builder for creating many LuaScriptVersion entities in bulk.
+type LuaScriptVersionCreateBulk struct {
+	config
+	err      error
+	builders []*LuaScriptVersionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LuaScriptVersion entities in the database.
+func (_c *LuaScriptVersionCreateBulk) Save(ctx context.Context) ([]*LuaScriptVersion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LuaScriptVersion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LuaScriptVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LuaScriptVersionCreateBulk) SaveX(ctx context.Context) []*LuaScriptVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LuaScriptVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LuaScriptVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LuaScriptVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LuaScriptVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LuaScriptVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *LuaScriptVersionUpsertBulk {
+	_c.conflict = opts
+	return &LuaScriptVersionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LuaScriptVersionCreateBulk) OnConflictColumns(columns ...string) *LuaScriptVersionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LuaScriptVersionUpsertBulk{
+		create: _c,
+	}
+}
+
+// LuaScriptVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of LuaScriptVersion nodes.
+type LuaScriptVersionUpsertBulk struct {
+	create *LuaScriptVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(luascriptversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LuaScriptVersionUpsertBulk) UpdateNewValues() *LuaScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(luascriptversion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(luascriptversion.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.ScriptID(); exists {
+				s.SetIgnore(luascriptversion.FieldScriptID)
+			}
+			if _, exists := b.mutation.Version(); exists {
+				s.SetIgnore(luascriptversion.FieldVersion)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(luascriptversion.FieldName)
+			}
+			if _, exists := b.mutation.Hook(); exists {
+				s.SetIgnore(luascriptversion.FieldHook)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(luascriptversion.FieldSource)
+			}
+			if _, exists := b.mutation.Priority(); exists {
+				s.SetIgnore(luascriptversion.FieldPriority)
+			}
+			if _, exists := b.mutation.Enabled(); exists {
+				s.SetIgnore(luascriptversion.FieldEnabled)
+			}
+			if _, exists := b.mutation.Critical(); exists {
+				s.SetIgnore(luascriptversion.FieldCritical)
+			}
+			if _, exists := b.mutation.Author(); exists {
+				s.SetIgnore(luascriptversion.FieldAuthor)
+			}
+			if _, exists := b.mutation.Description(); exists {
+				s.SetIgnore(luascriptversion.FieldDescription)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LuaScriptVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LuaScriptVersionUpsertBulk) Ignore() *LuaScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LuaScriptVersionUpsertBulk) DoNothing() *LuaScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LuaScriptVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *LuaScriptVersionUpsertBulk) Update(set func(*LuaScriptVersionUpsert)) *LuaScriptVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LuaScriptVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LuaScriptVersionUpsertBulk) SetCreatedBy(v uint32) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LuaScriptVersionUpsertBulk) AddCreatedBy(v uint32) *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LuaScriptVersionUpsertBulk) UpdateCreatedBy() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LuaScriptVersionUpsertBulk) ClearCreatedBy() *LuaScriptVersionUpsertBulk {
+	return u.Update(func(s *LuaScriptVersionUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *LuaScriptVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LuaScriptVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LuaScriptVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LuaScriptVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
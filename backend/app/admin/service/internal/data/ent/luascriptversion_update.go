@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LuaScriptVersionUpdate is the builder for updating LuaScriptVersion entities.
+type LuaScriptVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LuaScriptVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LuaScriptVersionUpdate builder.
+func (_u *LuaScriptVersionUpdate) Where(ps ...predicate.LuaScriptVersion) *LuaScriptVersionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LuaScriptVersionUpdate) SetCreatedBy(v uint32) *LuaScriptVersionUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdate) SetNillableCreatedBy(v *uint32) *LuaScriptVersionUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LuaScriptVersionUpdate) AddCreatedBy(v int32) *LuaScriptVersionUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LuaScriptVersionUpdate) ClearCreatedBy() *LuaScriptVersionUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Mutation returns the LuaScriptVersionMutation object of the builder.
+func (_u *LuaScriptVersionUpdate) Mutation() *LuaScriptVersionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LuaScriptVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LuaScriptVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LuaScriptVersionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LuaScriptVersionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LuaScriptVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LuaScriptVersionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LuaScriptVersionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(luascriptversion.Table, luascriptversion.Columns, sqlgraph.NewFieldSpec(luascriptversion.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(luascriptversion.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(luascriptversion.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(luascriptversion.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(luascriptversion.FieldCreatedBy, field.TypeUint32)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(luascriptversion.FieldPriority, field.TypeInt32)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(luascriptversion.FieldEnabled, field.TypeBool)
+	}
+	if _u.mutation.CriticalCleared() {
+		_spec.ClearField(luascriptversion.FieldCritical, field.TypeBool)
+	}
+	if _u.mutation.AuthorCleared() {
+		_spec.ClearField(luascriptversion.FieldAuthor, field.TypeString)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(luascriptversion.FieldDescription, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{luascriptversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LuaScriptVersionUpdateOne is the builder for updating a single LuaScriptVersion entity.
+type LuaScriptVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LuaScriptVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LuaScriptVersionUpdateOne) SetCreatedBy(v uint32) *LuaScriptVersionUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LuaScriptVersionUpdateOne) SetNillableCreatedBy(v *uint32) *LuaScriptVersionUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LuaScriptVersionUpdateOne) AddCreatedBy(v int32) *LuaScriptVersionUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LuaScriptVersionUpdateOne) ClearCreatedBy() *LuaScriptVersionUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Mutation returns the LuaScriptVersionMutation object of the builder.
+func (_u *LuaScriptVersionUpdateOne) Mutation() *LuaScriptVersionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LuaScriptVersionUpdate builder.
+func (_u *LuaScriptVersionUpdateOne) Where(ps ...predicate.LuaScriptVersion) *LuaScriptVersionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LuaScriptVersionUpdateOne) Select(field string, fields ...string) *LuaScriptVersionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LuaScriptVersion entity.
+func (_u *LuaScriptVersionUpdateOne) Save(ctx context.Context) (*LuaScriptVersion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LuaScriptVersionUpdateOne) SaveX(ctx context.Context) *LuaScriptVersion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LuaScriptVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LuaScriptVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LuaScriptVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LuaScriptVersionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LuaScriptVersionUpdateOne) sqlSave(ctx context.Context) (_node *LuaScriptVersion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(luascriptversion.Table, luascriptversion.Columns, sqlgraph.NewFieldSpec(luascriptversion.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LuaScriptVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, luascriptversion.FieldID)
+		for _, f := range fields {
+			if !luascriptversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != luascriptversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(luascriptversion.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(luascriptversion.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(luascriptversion.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(luascriptversion.FieldCreatedBy, field.TypeUint32)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(luascriptversion.FieldPriority, field.TypeInt32)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(luascriptversion.FieldEnabled, field.TypeBool)
+	}
+	if _u.mutation.CriticalCleared() {
+		_spec.ClearField(luascriptversion.FieldCritical, field.TypeBool)
+	}
+	if _u.mutation.AuthorCleared() {
+		_spec.ClearField(luascriptversion.FieldAuthor, field.TypeString)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(luascriptversion.FieldDescription, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LuaScriptVersion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{luascriptversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -73,6 +73,8 @@ var (
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
 		{Name: "user_id", Type: field.TypeUint32, Nullable: true, Comment: "操作者用户ID"},
 		{Name: "username", Type: field.TypeString, Nullable: true, Comment: "操作者账号名"},
+		{Name: "actor_user_id", Type: field.TypeUint32, Nullable: true, Comment: "模拟登录时实际操作者的用户ID"},
+		{Name: "actor_username", Type: field.TypeString, Nullable: true, Comment: "模拟登录时实际操作者的账号名"},
 		{Name: "ip_address", Type: field.TypeString, Nullable: true, Comment: "IP地址"},
 		{Name: "geo_location", Type: field.TypeJSON, Nullable: true, Comment: "地理位置(来自IP库)"},
 		{Name: "device_info", Type: field.TypeJSON, Nullable: true, Comment: "设备信息"},
@@ -107,12 +109,12 @@ var (
 			{
 				Name:    "uidx_sys_api_audit_logs_tenant_request_id",
 				Unique:  true,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[16]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[18]},
 			},
 			{
 				Name:    "uidx_sys_api_audit_logs_tenant_log_hash",
 				Unique:  true,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[26]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[28]},
 			},
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_created_at",
@@ -129,6 +131,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[3], SysAPIAuditLogsColumns[1]},
 			},
+			{
+				Name:    "idx_sys_api_audit_logs_actor_user_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[5], SysAPIAuditLogsColumns[1]},
+			},
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_username_created_at",
 				Unique:  false,
@@ -137,27 +144,27 @@ var (
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_ip_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[5], SysAPIAuditLogsColumns[1]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[7], SysAPIAuditLogsColumns[1]},
 			},
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_trace_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[17]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[19]},
 			},
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_api_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[13], SysAPIAuditLogsColumns[14], SysAPIAuditLogsColumns[1]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[15], SysAPIAuditLogsColumns[16], SysAPIAuditLogsColumns[1]},
 			},
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_path_method_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[11], SysAPIAuditLogsColumns[10], SysAPIAuditLogsColumns[1]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[13], SysAPIAuditLogsColumns[12], SysAPIAuditLogsColumns[1]},
 			},
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_status_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[21], SysAPIAuditLogsColumns[20], SysAPIAuditLogsColumns[1]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[23], SysAPIAuditLogsColumns[22], SysAPIAuditLogsColumns[1]},
 			},
 		},
 	}
@@ -478,6 +485,9 @@ var (
 		{Name: "size_format", Type: field.TypeString, Nullable: true, Comment: "格式化后的文件长度字符串"},
 		{Name: "link_url", Type: field.TypeString, Nullable: true, Comment: "链接地址"},
 		{Name: "content_hash", Type: field.TypeString, Nullable: true, Comment: "文件内容hash值，防止上传重复文件"},
+		{Name: "content_type", Type: field.TypeString, Nullable: true, Comment: "文件内容类型（MIME）"},
+		{Name: "scan_status", Type: field.TypeEnum, Nullable: true, Comment: "内容扫描状态", Enums: []string{"UNSCANNED", "CLEAN", "QUARANTINED"}, Default: "UNSCANNED"},
+		{Name: "scan_result", Type: field.TypeString, Nullable: true, Comment: "扫描结果，如命中的威胁特征或扫描失败原因"},
 	}
 	// FilesTable holds the schema information for the "files" table.
 	FilesTable = &schema.Table{
@@ -533,6 +543,40 @@ var (
 			},
 		},
 	}
+	// FileSharesColumns holds the columns for the "file_shares" table.
+	FileSharesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "file_id", Type: field.TypeUint32, Comment: "分享的文件ID"},
+		{Name: "slug", Type: field.TypeString, Unique: true, Comment: "分享链接标识"},
+		{Name: "password_hash", Type: field.TypeString, Nullable: true, Comment: "访问密码哈希，为空表示无需密码"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空表示永不过期"},
+		{Name: "max_downloads", Type: field.TypeUint32, Nullable: true, Comment: "最大下载次数，0代表不限制", Default: 0},
+		{Name: "download_count", Type: field.TypeUint32, Nullable: true, Comment: "已下载次数", Default: 0},
+		{Name: "allowed_tenant_ids", Type: field.TypeJSON, Nullable: true, Comment: "允许访问的租户ID，为空表示不限制"},
+		{Name: "allowed_user_ids", Type: field.TypeJSON, Nullable: true, Comment: "允许访问的用户ID，为空表示不限制"},
+		{Name: "disabled", Type: field.TypeBool, Nullable: true, Comment: "是否已停用", Default: false},
+	}
+	// FileSharesTable holds the schema information for the "file_shares" table.
+	FileSharesTable = &schema.Table{
+		Name:       "file_shares",
+		Comment:    "文件分享链接表",
+		Columns:    FileSharesColumns,
+		PrimaryKey: []*schema.Column{FileSharesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_file_shares_tenant_file",
+				Unique:  false,
+				Columns: []*schema.Column{FileSharesColumns[7], FileSharesColumns[8]},
+			},
+		},
+	}
 	// InternalMessagesColumns holds the columns for the "internal_messages" table.
 	InternalMessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -687,6 +731,42 @@ var (
 			},
 		},
 	}
+	// SysJwtSigningKeysColumns holds the columns for the "sys_jwt_signing_keys" table.
+	SysJwtSigningKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "kid", Type: field.TypeString, Comment: "密钥ID，即公钥的JWK指纹"},
+		{Name: "algorithm", Type: field.TypeEnum, Comment: "签名算法", Enums: []string{"RS256", "ES256"}},
+		{Name: "private_key", Type: field.TypeString, Size: 2147483647, Comment: "PKCS#8 PEM格式的私钥，配置了加密密钥时加密保存"},
+		{Name: "public_key", Type: field.TypeString, Size: 2147483647, Comment: "PKIX PEM格式的公钥"},
+		{Name: "status", Type: field.TypeEnum, Comment: "密钥状态", Enums: []string{"ACTIVE", "RETIRED"}, Default: "ACTIVE"},
+		{Name: "retired_at", Type: field.TypeTime, Nullable: true, Comment: "退役时间"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "宽限期结束时间，之后不再用于验签"},
+	}
+	// SysJwtSigningKeysTable holds the schema information for the "sys_jwt_signing_keys" table.
+	SysJwtSigningKeysTable = &schema.Table{
+		Name:       "sys_jwt_signing_keys",
+		Comment:    "JWT签名密钥表",
+		Columns:    SysJwtSigningKeysColumns,
+		PrimaryKey: []*schema.Column{SysJwtSigningKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_sys_jwt_signing_key_kid",
+				Unique:  true,
+				Columns: []*schema.Column{SysJwtSigningKeysColumns[7]},
+			},
+			{
+				Name:    "idx_sys_jwt_signing_key_status",
+				Unique:  false,
+				Columns: []*schema.Column{SysJwtSigningKeysColumns[11]},
+			},
+		},
+	}
 	// SysLanguagesColumns holds the columns for the "sys_languages" table.
 	SysLanguagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -752,6 +832,54 @@ var (
 			},
 		},
 	}
+	// SysLdapSourcesColumns holds the columns for the "sys_ldap_sources" table.
+	SysLdapSourcesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 128, Comment: "名称"},
+		{Name: "enabled", Type: field.TypeBool, Nullable: true, Comment: "是否启用", Default: true},
+		{Name: "url", Type: field.TypeString, Size: 512, Comment: "服务地址"},
+		{Name: "start_tls", Type: field.TypeBool, Nullable: true, Comment: "连接后升级为TLS", Default: false},
+		{Name: "insecure_skip_verify", Type: field.TypeBool, Nullable: true, Comment: "跳过证书校验", Default: false},
+		{Name: "bind_dn", Type: field.TypeString, Nullable: true, Size: 512, Comment: "服务账号DN"},
+		{Name: "bind_password", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "服务账号密码，配置了加密密钥时加密保存"},
+		{Name: "base_dn", Type: field.TypeString, Size: 512, Comment: "搜索根"},
+		{Name: "user_filter", Type: field.TypeString, Nullable: true, Size: 512, Comment: "用户过滤条件"},
+		{Name: "login_attribute", Type: field.TypeString, Nullable: true, Size: 64, Comment: "登录名属性"},
+		{Name: "email_attribute", Type: field.TypeString, Nullable: true, Size: 64, Comment: "邮箱属性"},
+		{Name: "display_name_attribute", Type: field.TypeString, Nullable: true, Size: 64, Comment: "显示名属性"},
+		{Name: "mobile_attribute", Type: field.TypeString, Nullable: true, Size: 64, Comment: "手机号属性"},
+		{Name: "org_unit_filter", Type: field.TypeString, Nullable: true, Size: 512, Comment: "组织单元过滤条件，为空字符串时不同步组织"},
+		{Name: "group_base_dn", Type: field.TypeString, Nullable: true, Size: 512, Comment: "组搜索根"},
+		{Name: "group_filter", Type: field.TypeString, Nullable: true, Size: 512, Comment: "组过滤条件"},
+		{Name: "group_member_attribute", Type: field.TypeString, Nullable: true, Size: 64, Comment: "组成员属性"},
+		{Name: "group_role_mappings", Type: field.TypeJSON, Nullable: true, Comment: "组DN到角色ID的映射"},
+		{Name: "default_role_id", Type: field.TypeUint32, Nullable: true, Comment: "默认角色ID", Default: 0},
+		{Name: "sync_enabled", Type: field.TypeBool, Nullable: true, Comment: "是否参与定时同步", Default: false},
+		{Name: "disable_missing_users", Type: field.TypeBool, Nullable: true, Comment: "同步时禁用目录中已不存在的用户", Default: false},
+		{Name: "last_sync_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次同步时间"},
+		{Name: "last_sync_error", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "最近一次同步的错误"},
+	}
+	// SysLdapSourcesTable holds the schema information for the "sys_ldap_sources" table.
+	SysLdapSourcesTable = &schema.Table{
+		Name:       "sys_ldap_sources",
+		Comment:    "LDAP身份源表",
+		Columns:    SysLdapSourcesColumns,
+		PrimaryKey: []*schema.Column{SysLdapSourcesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_sys_ldap_sources_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysLdapSourcesColumns[7]},
+			},
+		},
+	}
 	// SysLoginAuditLogsColumns holds the columns for the "sys_login_audit_logs" table.
 	SysLoginAuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -880,6 +1008,74 @@ var (
 			},
 		},
 	}
+	// SysLuaScriptsColumns holds the columns for the "sys_lua_scripts" table.
+	SysLuaScriptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "name", Type: field.TypeString, Comment: "脚本名称"},
+		{Name: "hook", Type: field.TypeString, Comment: "挂载的钩子点"},
+		{Name: "source", Type: field.TypeString, Size: 2147483647, Comment: "Lua源代码"},
+		{Name: "priority", Type: field.TypeInt32, Nullable: true, Comment: "执行优先级，数值越小越先执行", Default: 0},
+		{Name: "enabled", Type: field.TypeBool, Nullable: true, Comment: "是否启用", Default: true},
+		{Name: "critical", Type: field.TypeBool, Nullable: true, Comment: "是否为关键脚本", Default: false},
+		{Name: "version", Type: field.TypeUint32, Nullable: true, Comment: "版本号，每次保存递增", Default: 1},
+		{Name: "author", Type: field.TypeString, Nullable: true, Comment: "作者"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "描述"},
+	}
+	// SysLuaScriptsTable holds the schema information for the "sys_lua_scripts" table.
+	SysLuaScriptsTable = &schema.Table{
+		Name:       "sys_lua_scripts",
+		Comment:    "Lua脚本表",
+		Columns:    SysLuaScriptsColumns,
+		PrimaryKey: []*schema.Column{SysLuaScriptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_sys_lua_script_name",
+				Unique:  true,
+				Columns: []*schema.Column{SysLuaScriptsColumns[7]},
+			},
+			{
+				Name:    "idx_sys_lua_script_hook",
+				Unique:  false,
+				Columns: []*schema.Column{SysLuaScriptsColumns[8]},
+			},
+		},
+	}
+	// SysLuaScriptVersionsColumns holds the columns for the "sys_lua_script_versions" table.
+	SysLuaScriptVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "script_id", Type: field.TypeUint32, Comment: "脚本ID"},
+		{Name: "version", Type: field.TypeUint32, Comment: "版本号"},
+		{Name: "name", Type: field.TypeString, Comment: "脚本名称"},
+		{Name: "hook", Type: field.TypeString, Comment: "挂载的钩子点"},
+		{Name: "source", Type: field.TypeString, Size: 2147483647, Comment: "Lua源代码"},
+		{Name: "priority", Type: field.TypeInt32, Nullable: true, Comment: "执行优先级"},
+		{Name: "enabled", Type: field.TypeBool, Nullable: true, Comment: "是否启用"},
+		{Name: "critical", Type: field.TypeBool, Nullable: true, Comment: "是否为关键脚本"},
+		{Name: "author", Type: field.TypeString, Nullable: true, Comment: "作者"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "描述"},
+	}
+	// SysLuaScriptVersionsTable holds the schema information for the "sys_lua_script_versions" table.
+	SysLuaScriptVersionsTable = &schema.Table{
+		Name:       "sys_lua_script_versions",
+		Comment:    "Lua脚本历史版本表",
+		Columns:    SysLuaScriptVersionsColumns,
+		PrimaryKey: []*schema.Column{SysLuaScriptVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_sys_lua_script_version_script_version",
+				Unique:  true,
+				Columns: []*schema.Column{SysLuaScriptVersionsColumns[3], SysLuaScriptVersionsColumns[4]},
+			},
+		},
+	}
 	// SysMembershipsColumns holds the columns for the "sys_memberships" table.
 	SysMembershipsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -1352,6 +1548,49 @@ var (
 			},
 		},
 	}
+	// SysOauthClientsColumns holds the columns for the "sys_oauth_clients" table.
+	SysOauthClientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "status", Type: field.TypeEnum, Comment: "状态", Enums: []string{"OFF", "ON"}, Default: "ON"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "client_id", Type: field.TypeString, Comment: "客户端ID"},
+		{Name: "name", Type: field.TypeString, Comment: "客户端名称"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "描述"},
+		{Name: "secret_hash", Type: field.TypeString, Comment: "客户端密钥哈希"},
+		{Name: "previous_secret_hash", Type: field.TypeString, Nullable: true, Comment: "轮换前的客户端密钥哈希，在重叠期内仍然有效"},
+		{Name: "previous_secret_expires_at", Type: field.TypeTime, Nullable: true, Comment: "轮换前的客户端密钥失效时间"},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true, Comment: "允许的授权范围，即权限码列表"},
+		{Name: "token_ttl", Type: field.TypeUint32, Nullable: true, Comment: "访问令牌有效期（秒），为0时使用系统默认值", Default: 0},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "最后一次换取令牌的时间"},
+		{Name: "secret_version", Type: field.TypeUint32, Comment: "客户端密钥版本，每次轮换加一，令牌记录换取时使用的版本", Default: 1},
+		{Name: "data_scope", Type: field.TypeEnum, Nullable: true, Comment: "客户端令牌的数据权限，取创建或最后修改客户端的操作人的数据权限", Enums: []string{"ALL", "SELF", "UNIT_ONLY", "UNIT_AND_CHILD", "SELECTED_UNITS"}},
+		{Name: "org_unit_id", Type: field.TypeUint32, Nullable: true, Comment: "客户端令牌所属的组织单元"},
+	}
+	// SysOauthClientsTable holds the schema information for the "sys_oauth_clients" table.
+	SysOauthClientsTable = &schema.Table{
+		Name:       "sys_oauth_clients",
+		Comment:    "OAuth客户端表",
+		Columns:    SysOauthClientsColumns,
+		PrimaryKey: []*schema.Column{SysOauthClientsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_sys_oauth_client_client_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysOauthClientsColumns[9]},
+			},
+			{
+				Name:    "idx_sys_oauth_client_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysOauthClientsColumns[8]},
+			},
+		},
+	}
 	// SysOperationAuditLogsColumns holds the columns for the "sys_operation_audit_logs" table.
 	SysOperationAuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -1599,6 +1838,39 @@ var (
 			},
 		},
 	}
+	// SysPasswordPoliciesColumns holds the columns for the "sys_password_policies" table.
+	SysPasswordPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "min_length", Type: field.TypeUint32, Nullable: true, Comment: "最小长度", Default: 8},
+		{Name: "require_uppercase", Type: field.TypeBool, Nullable: true, Comment: "必须包含大写字母", Default: false},
+		{Name: "require_lowercase", Type: field.TypeBool, Nullable: true, Comment: "必须包含小写字母", Default: false},
+		{Name: "require_digit", Type: field.TypeBool, Nullable: true, Comment: "必须包含数字", Default: false},
+		{Name: "require_symbol", Type: field.TypeBool, Nullable: true, Comment: "必须包含特殊字符", Default: false},
+		{Name: "history_count", Type: field.TypeUint32, Nullable: true, Comment: "不能与最近使用过的几个密码相同，0代表不限制", Default: 0},
+		{Name: "max_age_days", Type: field.TypeUint32, Nullable: true, Comment: "密码最长使用天数，0代表不过期", Default: 0},
+		{Name: "reject_breached", Type: field.TypeBool, Nullable: true, Comment: "拒绝已泄露的密码", Default: false},
+	}
+	// SysPasswordPoliciesTable holds the schema information for the "sys_password_policies" table.
+	SysPasswordPoliciesTable = &schema.Table{
+		Name:       "sys_password_policies",
+		Comment:    "密码策略表",
+		Columns:    SysPasswordPoliciesColumns,
+		PrimaryKey: []*schema.Column{SysPasswordPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_sys_password_policies_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysPasswordPoliciesColumns[7]},
+			},
+		},
+	}
 	// SysPermissionsColumns holds the columns for the "sys_permissions" table.
 	SysPermissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2076,6 +2348,71 @@ var (
 			},
 		},
 	}
+	// SysRegistrationInvitesColumns holds the columns for the "sys_registration_invites" table.
+	SysRegistrationInvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "code_hash", Type: field.TypeString, Comment: "邀请码哈希"},
+		{Name: "email", Type: field.TypeString, Nullable: true, Comment: "受邀邮箱，设置后只能用该邮箱注册"},
+		{Name: "expires_at", Type: field.TypeTime, Comment: "过期时间"},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true, Comment: "使用时间"},
+		{Name: "used_by", Type: field.TypeUint32, Nullable: true, Comment: "使用该邀请注册的用户ID"},
+	}
+	// SysRegistrationInvitesTable holds the schema information for the "sys_registration_invites" table.
+	SysRegistrationInvitesTable = &schema.Table{
+		Name:       "sys_registration_invites",
+		Comment:    "注册邀请表",
+		Columns:    SysRegistrationInvitesColumns,
+		PrimaryKey: []*schema.Column{SysRegistrationInvitesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_sys_registration_invites_code_hash",
+				Unique:  true,
+				Columns: []*schema.Column{SysRegistrationInvitesColumns[8]},
+			},
+			{
+				Name:    "idx_sys_registration_invites_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysRegistrationInvitesColumns[7]},
+			},
+		},
+	}
+	// SysRegistrationPoliciesColumns holds the columns for the "sys_registration_policies" table.
+	SysRegistrationPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "mode", Type: field.TypeEnum, Nullable: true, Comment: "注册方式", Enums: []string{"CLOSED", "OPEN", "INVITE_ONLY", "DOMAIN_RESTRICTED"}, Default: "CLOSED"},
+		{Name: "allowed_domains", Type: field.TypeJSON, Nullable: true, Comment: "允许注册的邮箱域名"},
+		{Name: "require_email_verification", Type: field.TypeBool, Nullable: true, Comment: "须验证邮箱后才能登录", Default: false},
+		{Name: "require_captcha", Type: field.TypeBool, Nullable: true, Comment: "须通过人机验证", Default: false},
+		{Name: "default_role_id", Type: field.TypeUint32, Nullable: true, Comment: "注册用户默认分配的角色ID", Default: 0},
+	}
+	// SysRegistrationPoliciesTable holds the schema information for the "sys_registration_policies" table.
+	SysRegistrationPoliciesTable = &schema.Table{
+		Name:       "sys_registration_policies",
+		Comment:    "注册策略表",
+		Columns:    SysRegistrationPoliciesColumns,
+		PrimaryKey: []*schema.Column{SysRegistrationPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_sys_registration_policies_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysRegistrationPoliciesColumns[7]},
+			},
+		},
+	}
 	// SysRolesColumns holds the columns for the "sys_roles" table.
 	SysRolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2283,6 +2620,71 @@ var (
 			},
 		},
 	}
+	// SysScimTokensColumns holds the columns for the "sys_scim_tokens" table.
+	SysScimTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 128, Comment: "名称"},
+		{Name: "token_prefix", Type: field.TypeString, Comment: "令牌前缀，用于辨认令牌"},
+		{Name: "token_hash", Type: field.TypeString, Comment: "令牌哈希"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空时不过期"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "最近使用时间"},
+	}
+	// SysScimTokensTable holds the schema information for the "sys_scim_tokens" table.
+	SysScimTokensTable = &schema.Table{
+		Name:       "sys_scim_tokens",
+		Comment:    "SCIM 令牌表",
+		Columns:    SysScimTokensColumns,
+		PrimaryKey: []*schema.Column{SysScimTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_sys_scim_tokens_token_hash",
+				Unique:  true,
+				Columns: []*schema.Column{SysScimTokensColumns[10]},
+			},
+			{
+				Name:    "idx_sys_scim_tokens_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysScimTokensColumns[7]},
+			},
+		},
+	}
+	// StorageQuotasColumns holds the columns for the "storage_quotas" table.
+	StorageQuotasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "user_id", Type: field.TypeUint32, Nullable: true, Comment: "用户ID，0代表租户整体配额", Default: 0},
+		{Name: "max_bytes", Type: field.TypeUint64, Nullable: true, Comment: "最大存储字节数，0代表不限制", Default: 0},
+		{Name: "max_files", Type: field.TypeUint64, Nullable: true, Comment: "最大文件数，0代表不限制", Default: 0},
+		{Name: "used_bytes", Type: field.TypeUint64, Nullable: true, Comment: "已用存储字节数", Default: 0},
+		{Name: "used_files", Type: field.TypeUint64, Nullable: true, Comment: "已用文件数", Default: 0},
+	}
+	// StorageQuotasTable holds the schema information for the "storage_quotas" table.
+	StorageQuotasTable = &schema.Table{
+		Name:       "storage_quotas",
+		Comment:    "存储配额表",
+		Columns:    StorageQuotasColumns,
+		PrimaryKey: []*schema.Column{StorageQuotasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_storage_quotas_tenant_user",
+				Unique:  true,
+				Columns: []*schema.Column{StorageQuotasColumns[7], StorageQuotasColumns[8]},
+			},
+		},
+	}
 	// SysTasksColumns holds the columns for the "sys_tasks" table.
 	SysTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2300,6 +2702,7 @@ var (
 		{Name: "cron_spec", Type: field.TypeString, Nullable: true, Comment: "cron表达式"},
 		{Name: "task_options", Type: field.TypeJSON, Nullable: true, Comment: "任务选项"},
 		{Name: "enable", Type: field.TypeBool, Nullable: true, Comment: "启用/禁用任务", Default: false},
+		{Name: "paused", Type: field.TypeBool, Nullable: true, Comment: "是否已暂停", Default: false},
 	}
 	// SysTasksTable holds the schema information for the "sys_tasks" table.
 	SysTasksTable = &schema.Table{
@@ -2335,6 +2738,50 @@ var (
 			},
 		},
 	}
+	// SysTaskRunsColumns holds the columns for the "sys_task_runs" table.
+	SysTaskRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "task_id", Type: field.TypeUint32, Nullable: true, Comment: "调度任务ID，临时投递的任务为空"},
+		{Name: "type_name", Type: field.TypeString, Comment: "任务执行类型名"},
+		{Name: "queue_task_id", Type: field.TypeString, Nullable: true, Comment: "任务队列中的任务ID"},
+		{Name: "queue", Type: field.TypeString, Nullable: true, Comment: "任务队列名称"},
+		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "执行状态", Enums: []string{"RUNNING", "SUCCEEDED", "FAILED"}, Default: "RUNNING"},
+		{Name: "retry_count", Type: field.TypeInt32, Nullable: true, Comment: "本次执行前已重试的次数", Default: 0},
+		{Name: "max_retry", Type: field.TypeInt32, Nullable: true, Comment: "最多可重试的次数", Default: 0},
+		{Name: "progress", Type: field.TypeUint32, Nullable: true, Comment: "执行进度百分比", Default: 0},
+		{Name: "message", Type: field.TypeString, Nullable: true, Comment: "最近一次上报的进度说明"},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "失败原因"},
+		{Name: "result", Type: field.TypeString, Nullable: true, Comment: "执行结果", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true, Comment: "开始时间"},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true, Comment: "结束时间"},
+		{Name: "duration_ms", Type: field.TypeUint64, Nullable: true, Comment: "执行耗时（毫秒）"},
+	}
+	// SysTaskRunsTable holds the schema information for the "sys_task_runs" table.
+	SysTaskRunsTable = &schema.Table{
+		Name:       "sys_task_runs",
+		Comment:    "任务执行记录表",
+		Columns:    SysTaskRunsColumns,
+		PrimaryKey: []*schema.Column{SysTaskRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_sys_task_run_task_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskRunsColumns[3], SysTaskRunsColumns[14]},
+			},
+			{
+				Name:    "idx_sys_task_run_type_name_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskRunsColumns[4], SysTaskRunsColumns[14]},
+			},
+			{
+				Name:    "idx_sys_task_run_status_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskRunsColumns[7], SysTaskRunsColumns[14]},
+			},
+		},
+	}
 	// SysTenantsColumns holds the columns for the "sys_tenants" table.
 	SysTenantsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2440,6 +2887,7 @@ var (
 		{Name: "region", Type: field.TypeString, Nullable: true, Comment: "国家地区", Default: ""},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1023, Comment: "个人说明"},
 		{Name: "gender", Type: field.TypeEnum, Nullable: true, Comment: "性别", Enums: []string{"SECRET", "MALE", "FEMALE"}, Default: "SECRET"},
+		{Name: "external_id", Type: field.TypeString, Nullable: true, Size: 255, Comment: "外部系统中的用户标识，由 SCIM 等身份供应方写入"},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true, Comment: "最后一次登录的时间"},
 		{Name: "last_login_ip", Type: field.TypeString, Nullable: true, Comment: "最后一次登录的IP"},
 		{Name: "locked_until", Type: field.TypeTime, Nullable: true, Comment: "锁定截止时间"},
@@ -2470,12 +2918,17 @@ var (
 			{
 				Name:    "idx_sys_user_tenant_last_login_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysUsersColumns[8], SysUsersColumns[20]},
+				Columns: []*schema.Column{SysUsersColumns[8], SysUsersColumns[21]},
 			},
 			{
 				Name:    "idx_sys_user_tenant_last_login_ip",
 				Unique:  false,
-				Columns: []*schema.Column{SysUsersColumns[8], SysUsersColumns[21]},
+				Columns: []*schema.Column{SysUsersColumns[8], SysUsersColumns[22]},
+			},
+			{
+				Name:    "idx_sys_user_tenant_external_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysUsersColumns[8], SysUsersColumns[20]},
 			},
 			{
 				Name:    "idx_sys_user_tenant_created_by",
@@ -2499,7 +2952,7 @@ var (
 		{Name: "user_id", Type: field.TypeUint32, Nullable: true, Comment: "关联主表的用户ID"},
 		{Name: "identity_type", Type: field.TypeEnum, Nullable: true, Comment: "认证方式类型", Enums: []string{"USERNAME", "USERID", "EMAIL", "PHONE", "SOCIAL_OAUTH", "ENTERPRISE_SSO", "IDENTITY_API_KEY", "DEVICE_ID", "CUSTOM"}, Default: "USERNAME"},
 		{Name: "identifier", Type: field.TypeString, Nullable: true, Comment: "身份唯一标识符"},
-		{Name: "credential_type", Type: field.TypeEnum, Nullable: true, Comment: "凭证类型", Enums: []string{"PASSWORD_HASH", "API_KEY", "API_SECRET", "ACCESS_TOKEN", "REFRESH_TOKEN", "JWT", "OAUTH_TOKEN", "OAUTH_AUTHORIZATION_CODE", "OAUTH_CLIENT_CREDENTIALS", "OTP", "TOTP", "SMS_OTP", "EMAIL_OTP", "HARDWARE_TOKEN", "SOFTWARE_TOKEN", "SECURITY_QUESTION", "BIOMETRIC", "BIOMETRIC_TOKEN", "SSO_TOKEN", "SAML_ASSERTION", "OPENID_CONNECT_ID_TOKEN", "SESSION_COOKIE", "TEMPORARY_CREDENTIAL", "CUSTOM", "RESERVED_FOR_FUTURE"}, Default: "PASSWORD_HASH"},
+		{Name: "credential_type", Type: field.TypeEnum, Nullable: true, Comment: "凭证类型", Enums: []string{"PASSWORD_HASH", "API_KEY", "API_SECRET", "ACCESS_TOKEN", "REFRESH_TOKEN", "JWT", "OAUTH_TOKEN", "OAUTH_AUTHORIZATION_CODE", "OAUTH_CLIENT_CREDENTIALS", "OTP", "TOTP", "SMS_OTP", "EMAIL_OTP", "HARDWARE_TOKEN", "SOFTWARE_TOKEN", "SECURITY_QUESTION", "BIOMETRIC", "BIOMETRIC_TOKEN", "SSO_TOKEN", "SAML_ASSERTION", "OPENID_CONNECT_ID_TOKEN", "SESSION_COOKIE", "TEMPORARY_CREDENTIAL", "EXTERNAL", "CUSTOM", "RESERVED_FOR_FUTURE"}, Default: "PASSWORD_HASH"},
 		{Name: "credential", Type: field.TypeString, Nullable: true, Comment: "凭证"},
 		{Name: "is_primary", Type: field.TypeBool, Nullable: true, Comment: "是否主认证方式", Default: false},
 		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "凭证状态", Enums: []string{"DISABLED", "ENABLED", "EXPIRED", "UNVERIFIED", "REMOVED", "BLOCKED", "TEMPORARY"}, Default: "ENABLED"},
@@ -2512,6 +2965,7 @@ var (
 		{Name: "reset_token_hash", Type: field.TypeString, Nullable: true, Size: 255, Comment: "重置密码令牌哈希（不要存明文）"},
 		{Name: "reset_token_expires_at", Type: field.TypeTime, Nullable: true, Comment: "重置令牌到期时间"},
 		{Name: "reset_token_used_at", Type: field.TypeTime, Nullable: true, Comment: "重置令牌使用时间"},
+		{Name: "password_changed_at", Type: field.TypeTime, Nullable: true, Comment: "密码修改时间，用于计算密码是否过期"},
 	}
 	// SysUserCredentialsTable holds the schema information for the "sys_user_credentials" table.
 	SysUserCredentialsTable = &schema.Table{
@@ -2672,6 +3126,30 @@ var (
 			},
 		},
 	}
+	// SysUserPasswordHistoriesColumns holds the columns for the "sys_user_password_histories" table.
+	SysUserPasswordHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "user_id", Type: field.TypeUint32, Comment: "用户ID"},
+		{Name: "credential", Type: field.TypeString, Comment: "密码哈希"},
+	}
+	// SysUserPasswordHistoriesTable holds the schema information for the "sys_user_password_histories" table.
+	SysUserPasswordHistoriesTable = &schema.Table{
+		Name:       "sys_user_password_histories",
+		Comment:    "用户历史密码表",
+		Columns:    SysUserPasswordHistoriesColumns,
+		PrimaryKey: []*schema.Column{SysUserPasswordHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_sys_user_password_histories_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysUserPasswordHistoriesColumns[5], SysUserPasswordHistoriesColumns[0]},
+			},
+		},
+	}
 	// SysUserPositionsColumns holds the columns for the "sys_user_positions" table.
 	SysUserPositionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2849,19 +3327,26 @@ var (
 		SysDictTypesTable,
 		SysDictTypeI18nTable,
 		FilesTable,
+		FileSharesTable,
 		InternalMessagesTable,
 		InternalMessageCategoriesTable,
 		InternalMessageRecipientsTable,
+		SysJwtSigningKeysTable,
 		SysLanguagesTable,
+		SysLdapSourcesTable,
 		SysLoginAuditLogsTable,
 		SysLoginPoliciesTable,
+		SysLuaScriptsTable,
+		SysLuaScriptVersionsTable,
 		SysMembershipsTable,
 		SysMembershipOrgUnitsTable,
 		SysMembershipPositionsTable,
 		SysMembershipRolesTable,
 		SysMenusTable,
+		SysOauthClientsTable,
 		SysOperationAuditLogsTable,
 		SysOrgUnitsTable,
+		SysPasswordPoliciesTable,
 		SysPermissionsTable,
 		SysPermissionApisTable,
 		SysPermissionAuditLogsTable,
@@ -2870,14 +3355,20 @@ var (
 		SysPermissionPoliciesTable,
 		SysPolicyEvaluationLogsTable,
 		SysPositionsTable,
+		SysRegistrationInvitesTable,
+		SysRegistrationPoliciesTable,
 		SysRolesTable,
 		SysRoleMetadataTable,
 		SysRolePermissionsTable,
+		SysScimTokensTable,
+		StorageQuotasTable,
 		SysTasksTable,
+		SysTaskRunsTable,
 		SysTenantsTable,
 		SysUsersTable,
 		SysUserCredentialsTable,
 		SysUserOrgUnitsTable,
+		SysUserPasswordHistoriesTable,
 		SysUserPositionsTable,
 		SysUserRolesTable,
 	}
@@ -2927,6 +3418,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	FileSharesTable.Annotation = &entsql.Annotation{
+		Table:     "file_shares",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	InternalMessagesTable.Annotation = &entsql.Annotation{
 		Table:     "internal_messages",
 		Charset:   "utf8mb4",
@@ -2942,11 +3438,21 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysJwtSigningKeysTable.Annotation = &entsql.Annotation{
+		Table:     "sys_jwt_signing_keys",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysLanguagesTable.Annotation = &entsql.Annotation{
 		Table:     "sys_languages",
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysLdapSourcesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_ldap_sources",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysLoginAuditLogsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_login_audit_logs",
 		Charset:   "utf8mb4",
@@ -2957,6 +3463,16 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysLuaScriptsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_lua_scripts",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	SysLuaScriptVersionsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_lua_script_versions",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysMembershipsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_memberships",
 		Charset:   "utf8mb4",
@@ -2983,6 +3499,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysOauthClientsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_oauth_clients",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysOperationAuditLogsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_operation_audit_logs",
 		Charset:   "utf8mb4",
@@ -2994,6 +3515,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysPasswordPoliciesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_password_policies",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysPermissionsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_permissions",
 		Charset:   "utf8mb4",
@@ -3035,6 +3561,16 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysRegistrationInvitesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_registration_invites",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	SysRegistrationPoliciesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_registration_policies",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysRolesTable.Annotation = &entsql.Annotation{
 		Table:     "sys_roles",
 		Charset:   "utf8mb4",
@@ -3050,11 +3586,26 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysScimTokensTable.Annotation = &entsql.Annotation{
+		Table:     "sys_scim_tokens",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	StorageQuotasTable.Annotation = &entsql.Annotation{
+		Table:     "storage_quotas",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysTasksTable.Annotation = &entsql.Annotation{
 		Table:     "sys_tasks",
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysTaskRunsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_task_runs",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysTenantsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_tenants",
 		Charset:   "utf8mb4",
@@ -3075,6 +3626,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysUserPasswordHistoriesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_user_password_histories",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysUserPositionsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_user_positions",
 		Charset:   "utf8mb4",
@@ -0,0 +1,1908 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthclient"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthClientCreate is the builder for creating a OAuthClient entity.
+type OAuthClientCreate struct {
+	config
+	mutation *OAuthClientMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OAuthClientCreate) SetCreatedAt(v time.Time) *OAuthClientCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableCreatedAt(v *time.Time) *OAuthClientCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OAuthClientCreate) SetUpdatedAt(v time.Time) *OAuthClientCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableUpdatedAt(v *time.Time) *OAuthClientCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *OAuthClientCreate) SetDeletedAt(v time.Time) *OAuthClientCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableDeletedAt(v *time.Time) *OAuthClientCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *OAuthClientCreate) SetCreatedBy(v uint32) *OAuthClientCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableCreatedBy(v *uint32) *OAuthClientCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *OAuthClientCreate) SetUpdatedBy(v uint32) *OAuthClientCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableUpdatedBy(v *uint32) *OAuthClientCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *OAuthClientCreate) SetDeletedBy(v uint32) *OAuthClientCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableDeletedBy(v *uint32) *OAuthClientCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OAuthClientCreate) SetStatus(v oauthclient.Status) *OAuthClientCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableStatus(v *oauthclient.Status) *OAuthClientCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *OAuthClientCreate) SetTenantID(v uint32) *OAuthClientCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableTenantID(v *uint32) *OAuthClientCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *OAuthClientCreate) SetClientID(v string) *OAuthClientCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *OAuthClientCreate) SetName(v string) *OAuthClientCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *OAuthClientCreate) SetDescription(v string) *OAuthClientCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableDescription(v *string) *OAuthClientCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (_c *OAuthClientCreate) SetSecretHash(v string) *OAuthClientCreate {
+	_c.mutation.SetSecretHash(v)
+	return _c
+}
+
+// SetPreviousSecretHash sets the "previous_secret_hash" field.
+func (_c *OAuthClientCreate) SetPreviousSecretHash(v string) *OAuthClientCreate {
+	_c.mutation.SetPreviousSecretHash(v)
+	return _c
+}
+
+// SetNillablePreviousSecretHash sets the "previous_secret_hash" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillablePreviousSecretHash(v *string) *OAuthClientCreate {
+	if v != nil {
+		_c.SetPreviousSecretHash(*v)
+	}
+	return _c
+}
+
+// SetPreviousSecretExpiresAt sets the "previous_secret_expires_at" field.
+func (_c *OAuthClientCreate) SetPreviousSecretExpiresAt(v time.Time) *OAuthClientCreate {
+	_c.mutation.SetPreviousSecretExpiresAt(v)
+	return _c
+}
+
+// SetNillablePreviousSecretExpiresAt sets the "previous_secret_expires_at" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillablePreviousSecretExpiresAt(v *time.Time) *OAuthClientCreate {
+	if v != nil {
+		_c.SetPreviousSecretExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *OAuthClientCreate) SetScopes(v []string) *OAuthClientCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetTokenTTL sets the "token_ttl" field.
+func (_c *OAuthClientCreate) SetTokenTTL(v uint32) *OAuthClientCreate {
+	_c.mutation.SetTokenTTL(v)
+	return _c
+}
+
+// SetNillableTokenTTL sets the "token_ttl" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableTokenTTL(v *uint32) *OAuthClientCreate {
+	if v != nil {
+		_c.SetTokenTTL(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *OAuthClientCreate) SetLastUsedAt(v time.Time) *OAuthClientCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableLastUsedAt(v *time.Time) *OAuthClientCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetSecretVersion sets the "secret_version" field.
+func (_c *OAuthClientCreate) SetSecretVersion(v uint32) *OAuthClientCreate {
+	_c.mutation.SetSecretVersion(v)
+	return _c
+}
+
+// SetNillableSecretVersion sets the "secret_version" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableSecretVersion(v *uint32) *OAuthClientCreate {
+	if v != nil {
+		_c.SetSecretVersion(*v)
+	}
+	return _c
+}
+
+// SetDataScope sets the "data_scope" field.
+func (_c *OAuthClientCreate) SetDataScope(v oauthclient.DataScope) *OAuthClientCreate {
+	_c.mutation.SetDataScope(v)
+	return _c
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableDataScope(v *oauthclient.DataScope) *OAuthClientCreate {
+	if v != nil {
+		_c.SetDataScope(*v)
+	}
+	return _c
+}
+
+// SetOrgUnitID sets the "org_unit_id" field.
+func (_c *OAuthClientCreate) SetOrgUnitID(v uint32) *OAuthClientCreate {
+	_c.mutation.SetOrgUnitID(v)
+	return _c
+}
+
+// SetNillableOrgUnitID sets the "org_unit_id" field if the given value is not nil.
+func (_c *OAuthClientCreate) SetNillableOrgUnitID(v *uint32) *OAuthClientCreate {
+	if v != nil {
+		_c.SetOrgUnitID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OAuthClientCreate) SetID(v uint32) *OAuthClientCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (_c *OAuthClientCreate) Mutation() *OAuthClientMutation {
+	return _c.mutation
+}
+
+// Save creates the OAuthClient in the database.
+func (_c *OAuthClientCreate) Save(ctx context.Context) (*OAuthClient, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OAuthClientCreate) SaveX(ctx context.Context) *OAuthClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthClientCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthClientCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OAuthClientCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := oauthclient.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := oauthclient.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.TokenTTL(); !ok {
+		v := oauthclient.DefaultTokenTTL
+		_c.mutation.SetTokenTTL(v)
+	}
+	if _, ok := _c.mutation.SecretVersion(); !ok {
+		v := oauthclient.DefaultSecretVersion
+		_c.mutation.SetSecretVersion(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OAuthClientCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OAuthClient.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := oauthclient.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OAuthClient.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := oauthclient.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "OAuthClient.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SecretHash(); !ok {
+		return &ValidationError{Name: "secret_hash", err: errors.New(`ent: missing required field "OAuthClient.secret_hash"`)}
+	}
+	if v, ok := _c.mutation.SecretHash(); ok {
+		if err := oauthclient.SecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "secret_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.secret_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SecretVersion(); !ok {
+		return &ValidationError{Name: "secret_version", err: errors.New(`ent: missing required field "OAuthClient.secret_version"`)}
+	}
+	if v, ok := _c.mutation.DataScope(); ok {
+		if err := oauthclient.DataScopeValidator(v); err != nil {
+			return &ValidationError{Name: "data_scope", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.data_scope": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := oauthclient.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OAuthClientCreate) sqlSave(ctx context.Context) (*OAuthClient, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OAuthClientCreate) createSpec() (*OAuthClient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthClient{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oauthclient.Table, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthclient.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthclient.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(oauthclient.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(oauthclient.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(oauthclient.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(oauthclient.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(oauthclient.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(oauthclient.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.SecretHash(); ok {
+		_spec.SetField(oauthclient.FieldSecretHash, field.TypeString, value)
+		_node.SecretHash = value
+	}
+	if value, ok := _c.mutation.PreviousSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldPreviousSecretHash, field.TypeString, value)
+		_node.PreviousSecretHash = &value
+	}
+	if value, ok := _c.mutation.PreviousSecretExpiresAt(); ok {
+		_spec.SetField(oauthclient.FieldPreviousSecretExpiresAt, field.TypeTime, value)
+		_node.PreviousSecretExpiresAt = &value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.TokenTTL(); ok {
+		_spec.SetField(oauthclient.FieldTokenTTL, field.TypeUint32, value)
+		_node.TokenTTL = &value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(oauthclient.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.SecretVersion(); ok {
+		_spec.SetField(oauthclient.FieldSecretVersion, field.TypeUint32, value)
+		_node.SecretVersion = value
+	}
+	if value, ok := _c.mutation.DataScope(); ok {
+		_spec.SetField(oauthclient.FieldDataScope, field.TypeEnum, value)
+		_node.DataScope = &value
+	}
+	if value, ok := _c.mutation.OrgUnitID(); ok {
+		_spec.SetField(oauthclient.FieldOrgUnitID, field.TypeUint32, value)
+		_node.OrgUnitID = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OAuthClient.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OAuthClientUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OAuthClientCreate) OnConflict(opts ...sql.ConflictOption) *OAuthClientUpsertOne {
+	_c.conflict = opts
+	return &OAuthClientUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OAuthClient.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OAuthClientCreate) OnConflictColumns(columns ...string) *OAuthClientUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OAuthClientUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// OAuthClientUpsertOne is the builder for "upsert"-ing
+	//  one OAuthClient node.
+	OAuthClientUpsertOne struct {
+		create *OAuthClientCreate
+	}
+
+	// OAuthClientUpsert is the "OnConflict" setter.
+	OAuthClientUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OAuthClientUpsert) SetUpdatedAt(v time.Time) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateUpdatedAt() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *OAuthClientUpsert) ClearUpdatedAt() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *OAuthClientUpsert) SetDeletedAt(v time.Time) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateDeletedAt() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *OAuthClientUpsert) ClearDeletedAt() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *OAuthClientUpsert) SetCreatedBy(v uint32) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateCreatedBy() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *OAuthClientUpsert) AddCreatedBy(v uint32) *OAuthClientUpsert {
+	u.Add(oauthclient.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *OAuthClientUpsert) ClearCreatedBy() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *OAuthClientUpsert) SetUpdatedBy(v uint32) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateUpdatedBy() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *OAuthClientUpsert) AddUpdatedBy(v uint32) *OAuthClientUpsert {
+	u.Add(oauthclient.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *OAuthClientUpsert) ClearUpdatedBy() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *OAuthClientUpsert) SetDeletedBy(v uint32) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateDeletedBy() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *OAuthClientUpsert) AddDeletedBy(v uint32) *OAuthClientUpsert {
+	u.Add(oauthclient.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *OAuthClientUpsert) ClearDeletedBy() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldDeletedBy)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *OAuthClientUpsert) SetStatus(v oauthclient.Status) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateStatus() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldStatus)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *OAuthClientUpsert) SetName(v string) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateName() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *OAuthClientUpsert) SetDescription(v string) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateDescription() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *OAuthClientUpsert) ClearDescription() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldDescription)
+	return u
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (u *OAuthClientUpsert) SetSecretHash(v string) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldSecretHash, v)
+	return u
+}
+
+// UpdateSecretHash sets the "secret_hash" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateSecretHash() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldSecretHash)
+	return u
+}
+
+// SetPreviousSecretHash sets the "previous_secret_hash" field.
+func (u *OAuthClientUpsert) SetPreviousSecretHash(v string) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldPreviousSecretHash, v)
+	return u
+}
+
+// UpdatePreviousSecretHash sets the "previous_secret_hash" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdatePreviousSecretHash() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldPreviousSecretHash)
+	return u
+}
+
+// ClearPreviousSecretHash clears the value of the "previous_secret_hash" field.
+func (u *OAuthClientUpsert) ClearPreviousSecretHash() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldPreviousSecretHash)
+	return u
+}
+
+// SetPreviousSecretExpiresAt sets the "previous_secret_expires_at" field.
+func (u *OAuthClientUpsert) SetPreviousSecretExpiresAt(v time.Time) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldPreviousSecretExpiresAt, v)
+	return u
+}
+
+// UpdatePreviousSecretExpiresAt sets the "previous_secret_expires_at" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdatePreviousSecretExpiresAt() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldPreviousSecretExpiresAt)
+	return u
+}
+
+// ClearPreviousSecretExpiresAt clears the value of the "previous_secret_expires_at" field.
+func (u *OAuthClientUpsert) ClearPreviousSecretExpiresAt() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldPreviousSecretExpiresAt)
+	return u
+}
+
+// SetScopes sets the "scopes" field.
+func (u *OAuthClientUpsert) SetScopes(v []string) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateScopes() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldScopes)
+	return u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *OAuthClientUpsert) ClearScopes() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldScopes)
+	return u
+}
+
+// SetTokenTTL sets the "token_ttl" field.
+func (u *OAuthClientUpsert) SetTokenTTL(v uint32) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldTokenTTL, v)
+	return u
+}
+
+// UpdateTokenTTL sets the "token_ttl" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateTokenTTL() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldTokenTTL)
+	return u
+}
+
+// AddTokenTTL adds v to the "token_ttl" field.
+func (u *OAuthClientUpsert) AddTokenTTL(v uint32) *OAuthClientUpsert {
+	u.Add(oauthclient.FieldTokenTTL, v)
+	return u
+}
+
+// ClearTokenTTL clears the value of the "token_ttl" field.
+func (u *OAuthClientUpsert) ClearTokenTTL() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldTokenTTL)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *OAuthClientUpsert) SetLastUsedAt(v time.Time) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateLastUsedAt() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *OAuthClientUpsert) ClearLastUsedAt() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldLastUsedAt)
+	return u
+}
+
+// SetSecretVersion sets the "secret_version" field.
+func (u *OAuthClientUpsert) SetSecretVersion(v uint32) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldSecretVersion, v)
+	return u
+}
+
+// UpdateSecretVersion sets the "secret_version" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateSecretVersion() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldSecretVersion)
+	return u
+}
+
+// AddSecretVersion adds v to the "secret_version" field.
+func (u *OAuthClientUpsert) AddSecretVersion(v uint32) *OAuthClientUpsert {
+	u.Add(oauthclient.FieldSecretVersion, v)
+	return u
+}
+
+// SetDataScope sets the "data_scope" field.
+func (u *OAuthClientUpsert) SetDataScope(v oauthclient.DataScope) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldDataScope, v)
+	return u
+}
+
+// UpdateDataScope sets the "data_scope" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateDataScope() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldDataScope)
+	return u
+}
+
+// ClearDataScope clears the value of the "data_scope" field.
+func (u *OAuthClientUpsert) ClearDataScope() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldDataScope)
+	return u
+}
+
+// SetOrgUnitID sets the "org_unit_id" field.
+func (u *OAuthClientUpsert) SetOrgUnitID(v uint32) *OAuthClientUpsert {
+	u.Set(oauthclient.FieldOrgUnitID, v)
+	return u
+}
+
+// UpdateOrgUnitID sets the "org_unit_id" field to the value that was provided on create.
+func (u *OAuthClientUpsert) UpdateOrgUnitID() *OAuthClientUpsert {
+	u.SetExcluded(oauthclient.FieldOrgUnitID)
+	return u
+}
+
+// AddOrgUnitID adds v to the "org_unit_id" field.
+func (u *OAuthClientUpsert) AddOrgUnitID(v uint32) *OAuthClientUpsert {
+	u.Add(oauthclient.FieldOrgUnitID, v)
+	return u
+}
+
+// ClearOrgUnitID clears the value of the "org_unit_id" field.
+func (u *OAuthClientUpsert) ClearOrgUnitID() *OAuthClientUpsert {
+	u.SetNull(oauthclient.FieldOrgUnitID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OAuthClient.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(oauthclient.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OAuthClientUpsertOne) UpdateNewValues() *OAuthClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(oauthclient.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(oauthclient.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(oauthclient.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.ClientID(); exists {
+			s.SetIgnore(oauthclient.FieldClientID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OAuthClient.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OAuthClientUpsertOne) Ignore() *OAuthClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OAuthClientUpsertOne) DoNothing() *OAuthClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OAuthClientCreate.OnConflict
+// documentation for more info.
+func (u *OAuthClientUpsertOne) Update(set func(*OAuthClientUpsert)) *OAuthClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OAuthClientUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OAuthClientUpsertOne) SetUpdatedAt(v time.Time) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateUpdatedAt() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *OAuthClientUpsertOne) ClearUpdatedAt() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *OAuthClientUpsertOne) SetDeletedAt(v time.Time) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateDeletedAt() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *OAuthClientUpsertOne) ClearDeletedAt() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *OAuthClientUpsertOne) SetCreatedBy(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *OAuthClientUpsertOne) AddCreatedBy(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateCreatedBy() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *OAuthClientUpsertOne) ClearCreatedBy() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *OAuthClientUpsertOne) SetUpdatedBy(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *OAuthClientUpsertOne) AddUpdatedBy(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateUpdatedBy() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *OAuthClientUpsertOne) ClearUpdatedBy() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *OAuthClientUpsertOne) SetDeletedBy(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *OAuthClientUpsertOne) AddDeletedBy(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateDeletedBy() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *OAuthClientUpsertOne) ClearDeletedBy() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *OAuthClientUpsertOne) SetStatus(v oauthclient.Status) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateStatus() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *OAuthClientUpsertOne) SetName(v string) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateName() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *OAuthClientUpsertOne) SetDescription(v string) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateDescription() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *OAuthClientUpsertOne) ClearDescription() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (u *OAuthClientUpsertOne) SetSecretHash(v string) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetSecretHash(v)
+	})
+}
+
+// UpdateSecretHash sets the "secret_hash" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateSecretHash() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateSecretHash()
+	})
+}
+
+// SetPreviousSecretHash sets the "previous_secret_hash" field.
+func (u *OAuthClientUpsertOne) SetPreviousSecretHash(v string) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetPreviousSecretHash(v)
+	})
+}
+
+// UpdatePreviousSecretHash sets the "previous_secret_hash" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdatePreviousSecretHash() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdatePreviousSecretHash()
+	})
+}
+
+// ClearPreviousSecretHash clears the value of the "previous_secret_hash" field.
+func (u *OAuthClientUpsertOne) ClearPreviousSecretHash() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearPreviousSecretHash()
+	})
+}
+
+// SetPreviousSecretExpiresAt sets the "previous_secret_expires_at" field.
+func (u *OAuthClientUpsertOne) SetPreviousSecretExpiresAt(v time.Time) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetPreviousSecretExpiresAt(v)
+	})
+}
+
+// UpdatePreviousSecretExpiresAt sets the "previous_secret_expires_at" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdatePreviousSecretExpiresAt() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdatePreviousSecretExpiresAt()
+	})
+}
+
+// ClearPreviousSecretExpiresAt clears the value of the "previous_secret_expires_at" field.
+func (u *OAuthClientUpsertOne) ClearPreviousSecretExpiresAt() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearPreviousSecretExpiresAt()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *OAuthClientUpsertOne) SetScopes(v []string) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateScopes() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *OAuthClientUpsertOne) ClearScopes() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetTokenTTL sets the "token_ttl" field.
+func (u *OAuthClientUpsertOne) SetTokenTTL(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetTokenTTL(v)
+	})
+}
+
+// AddTokenTTL adds v to the "token_ttl" field.
+func (u *OAuthClientUpsertOne) AddTokenTTL(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddTokenTTL(v)
+	})
+}
+
+// UpdateTokenTTL sets the "token_ttl" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateTokenTTL() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateTokenTTL()
+	})
+}
+
+// ClearTokenTTL clears the value of the "token_ttl" field.
+func (u *OAuthClientUpsertOne) ClearTokenTTL() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearTokenTTL()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *OAuthClientUpsertOne) SetLastUsedAt(v time.Time) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateLastUsedAt() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *OAuthClientUpsertOne) ClearLastUsedAt() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetSecretVersion sets the "secret_version" field.
+func (u *OAuthClientUpsertOne) SetSecretVersion(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetSecretVersion(v)
+	})
+}
+
+// AddSecretVersion adds v to the "secret_version" field.
+func (u *OAuthClientUpsertOne) AddSecretVersion(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddSecretVersion(v)
+	})
+}
+
+// UpdateSecretVersion sets the "secret_version" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateSecretVersion() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateSecretVersion()
+	})
+}
+
+// SetDataScope sets the "data_scope" field.
+func (u *OAuthClientUpsertOne) SetDataScope(v oauthclient.DataScope) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetDataScope(v)
+	})
+}
+
+// UpdateDataScope sets the "data_scope" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateDataScope() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateDataScope()
+	})
+}
+
+// ClearDataScope clears the value of the "data_scope" field.
+func (u *OAuthClientUpsertOne) ClearDataScope() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearDataScope()
+	})
+}
+
+// SetOrgUnitID sets the "org_unit_id" field.
+func (u *OAuthClientUpsertOne) SetOrgUnitID(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetOrgUnitID(v)
+	})
+}
+
+// AddOrgUnitID adds v to the "org_unit_id" field.
+func (u *OAuthClientUpsertOne) AddOrgUnitID(v uint32) *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddOrgUnitID(v)
+	})
+}
+
+// UpdateOrgUnitID sets the "org_unit_id" field to the value that was provided on create.
+func (u *OAuthClientUpsertOne) UpdateOrgUnitID() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateOrgUnitID()
+	})
+}
+
+// ClearOrgUnitID clears the value of the "org_unit_id" field.
+func (u *OAuthClientUpsertOne) ClearOrgUnitID() *OAuthClientUpsertOne {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearOrgUnitID()
+	})
+}
+
+// Exec executes the query.
+func (u *OAuthClientUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OAuthClientCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OAuthClientUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OAuthClientUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OAuthClientUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OAuthClientCreateBulk is the builder for creating many OAuthClient entities in bulk.
+type OAuthClientCreateBulk struct {
+	config
+	err      error
+	builders []*OAuthClientCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OAuthClient entities in the database.
+func (_c *OAuthClientCreateBulk) Save(ctx context.Context) ([]*OAuthClient, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OAuthClient, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OAuthClientCreateBulk) SaveX(ctx context.Context) []*OAuthClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthClientCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OAuthClient.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OAuthClientUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OAuthClientCreateBulk) OnConflict(opts ...sql.ConflictOption) *OAuthClientUpsertBulk {
+	_c.conflict = opts
+	return &OAuthClientUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OAuthClient.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OAuthClientCreateBulk) OnConflictColumns(columns ...string) *OAuthClientUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OAuthClientUpsertBulk{
+		create: _c,
+	}
+}
+
+// OAuthClientUpsertBulk is the builder for "upsert"-ing
+// a bulk of OAuthClient nodes.
+type OAuthClientUpsertBulk struct {
+	create *OAuthClientCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OAuthClient.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(oauthclient.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OAuthClientUpsertBulk) UpdateNewValues() *OAuthClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(oauthclient.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(oauthclient.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(oauthclient.FieldTenantID)
+			}
+			if _, exists := b.mutation.ClientID(); exists {
+				s.SetIgnore(oauthclient.FieldClientID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OAuthClient.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OAuthClientUpsertBulk) Ignore() *OAuthClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OAuthClientUpsertBulk) DoNothing() *OAuthClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OAuthClientCreateBulk.OnConflict
+// documentation for more info.
+func (u *OAuthClientUpsertBulk) Update(set func(*OAuthClientUpsert)) *OAuthClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OAuthClientUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OAuthClientUpsertBulk) SetUpdatedAt(v time.Time) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateUpdatedAt() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *OAuthClientUpsertBulk) ClearUpdatedAt() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *OAuthClientUpsertBulk) SetDeletedAt(v time.Time) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateDeletedAt() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *OAuthClientUpsertBulk) ClearDeletedAt() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *OAuthClientUpsertBulk) SetCreatedBy(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *OAuthClientUpsertBulk) AddCreatedBy(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateCreatedBy() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *OAuthClientUpsertBulk) ClearCreatedBy() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *OAuthClientUpsertBulk) SetUpdatedBy(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *OAuthClientUpsertBulk) AddUpdatedBy(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateUpdatedBy() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *OAuthClientUpsertBulk) ClearUpdatedBy() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *OAuthClientUpsertBulk) SetDeletedBy(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *OAuthClientUpsertBulk) AddDeletedBy(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateDeletedBy() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *OAuthClientUpsertBulk) ClearDeletedBy() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *OAuthClientUpsertBulk) SetStatus(v oauthclient.Status) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateStatus() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *OAuthClientUpsertBulk) SetName(v string) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateName() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *OAuthClientUpsertBulk) SetDescription(v string) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateDescription() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *OAuthClientUpsertBulk) ClearDescription() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (u *OAuthClientUpsertBulk) SetSecretHash(v string) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetSecretHash(v)
+	})
+}
+
+// UpdateSecretHash sets the "secret_hash" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateSecretHash() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateSecretHash()
+	})
+}
+
+// SetPreviousSecretHash sets the "previous_secret_hash" field.
+func (u *OAuthClientUpsertBulk) SetPreviousSecretHash(v string) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetPreviousSecretHash(v)
+	})
+}
+
+// UpdatePreviousSecretHash sets the "previous_secret_hash" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdatePreviousSecretHash() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdatePreviousSecretHash()
+	})
+}
+
+// ClearPreviousSecretHash clears the value of the "previous_secret_hash" field.
+func (u *OAuthClientUpsertBulk) ClearPreviousSecretHash() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearPreviousSecretHash()
+	})
+}
+
+// SetPreviousSecretExpiresAt sets the "previous_secret_expires_at" field.
+func (u *OAuthClientUpsertBulk) SetPreviousSecretExpiresAt(v time.Time) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetPreviousSecretExpiresAt(v)
+	})
+}
+
+// UpdatePreviousSecretExpiresAt sets the "previous_secret_expires_at" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdatePreviousSecretExpiresAt() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdatePreviousSecretExpiresAt()
+	})
+}
+
+// ClearPreviousSecretExpiresAt clears the value of the "previous_secret_expires_at" field.
+func (u *OAuthClientUpsertBulk) ClearPreviousSecretExpiresAt() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearPreviousSecretExpiresAt()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *OAuthClientUpsertBulk) SetScopes(v []string) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateScopes() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *OAuthClientUpsertBulk) ClearScopes() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetTokenTTL sets the "token_ttl" field.
+func (u *OAuthClientUpsertBulk) SetTokenTTL(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetTokenTTL(v)
+	})
+}
+
+// AddTokenTTL adds v to the "token_ttl" field.
+func (u *OAuthClientUpsertBulk) AddTokenTTL(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddTokenTTL(v)
+	})
+}
+
+// UpdateTokenTTL sets the "token_ttl" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateTokenTTL() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateTokenTTL()
+	})
+}
+
+// ClearTokenTTL clears the value of the "token_ttl" field.
+func (u *OAuthClientUpsertBulk) ClearTokenTTL() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearTokenTTL()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *OAuthClientUpsertBulk) SetLastUsedAt(v time.Time) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateLastUsedAt() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *OAuthClientUpsertBulk) ClearLastUsedAt() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetSecretVersion sets the "secret_version" field.
+func (u *OAuthClientUpsertBulk) SetSecretVersion(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetSecretVersion(v)
+	})
+}
+
+// AddSecretVersion adds v to the "secret_version" field.
+func (u *OAuthClientUpsertBulk) AddSecretVersion(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddSecretVersion(v)
+	})
+}
+
+// UpdateSecretVersion sets the "secret_version" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateSecretVersion() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateSecretVersion()
+	})
+}
+
+// SetDataScope sets the "data_scope" field.
+func (u *OAuthClientUpsertBulk) SetDataScope(v oauthclient.DataScope) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetDataScope(v)
+	})
+}
+
+// UpdateDataScope sets the "data_scope" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateDataScope() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateDataScope()
+	})
+}
+
+// ClearDataScope clears the value of the "data_scope" field.
+func (u *OAuthClientUpsertBulk) ClearDataScope() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearDataScope()
+	})
+}
+
+// SetOrgUnitID sets the "org_unit_id" field.
+func (u *OAuthClientUpsertBulk) SetOrgUnitID(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.SetOrgUnitID(v)
+	})
+}
+
+// AddOrgUnitID adds v to the "org_unit_id" field.
+func (u *OAuthClientUpsertBulk) AddOrgUnitID(v uint32) *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.AddOrgUnitID(v)
+	})
+}
+
+// UpdateOrgUnitID sets the "org_unit_id" field to the value that was provided on create.
+func (u *OAuthClientUpsertBulk) UpdateOrgUnitID() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.UpdateOrgUnitID()
+	})
+}
+
+// ClearOrgUnitID clears the value of the "org_unit_id" field.
+func (u *OAuthClientUpsertBulk) ClearOrgUnitID() *OAuthClientUpsertBulk {
+	return u.Update(func(s *OAuthClientUpsert) {
+		s.ClearOrgUnitID()
+	})
+}
+
+// Exec executes the query.
+func (u *OAuthClientUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OAuthClientCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OAuthClientCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OAuthClientUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
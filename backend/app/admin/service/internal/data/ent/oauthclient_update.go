@@ -0,0 +1,1199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthclient"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthClientUpdate is the builder for updating OAuthClient entities.
+type OAuthClientUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OAuthClientMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OAuthClientUpdate builder.
+func (_u *OAuthClientUpdate) Where(ps ...predicate.OAuthClient) *OAuthClientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OAuthClientUpdate) SetUpdatedAt(v time.Time) *OAuthClientUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableUpdatedAt(v *time.Time) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *OAuthClientUpdate) ClearUpdatedAt() *OAuthClientUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OAuthClientUpdate) SetDeletedAt(v time.Time) *OAuthClientUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableDeletedAt(v *time.Time) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *OAuthClientUpdate) ClearDeletedAt() *OAuthClientUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *OAuthClientUpdate) SetCreatedBy(v uint32) *OAuthClientUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableCreatedBy(v *uint32) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *OAuthClientUpdate) AddCreatedBy(v int32) *OAuthClientUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *OAuthClientUpdate) ClearCreatedBy() *OAuthClientUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *OAuthClientUpdate) SetUpdatedBy(v uint32) *OAuthClientUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableUpdatedBy(v *uint32) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *OAuthClientUpdate) AddUpdatedBy(v int32) *OAuthClientUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *OAuthClientUpdate) ClearUpdatedBy() *OAuthClientUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *OAuthClientUpdate) SetDeletedBy(v uint32) *OAuthClientUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableDeletedBy(v *uint32) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *OAuthClientUpdate) AddDeletedBy(v int32) *OAuthClientUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *OAuthClientUpdate) ClearDeletedBy() *OAuthClientUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OAuthClientUpdate) SetStatus(v oauthclient.Status) *OAuthClientUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableStatus(v *oauthclient.Status) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OAuthClientUpdate) SetName(v string) *OAuthClientUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableName(v *string) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *OAuthClientUpdate) SetDescription(v string) *OAuthClientUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableDescription(v *string) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *OAuthClientUpdate) ClearDescription() *OAuthClientUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (_u *OAuthClientUpdate) SetSecretHash(v string) *OAuthClientUpdate {
+	_u.mutation.SetSecretHash(v)
+	return _u
+}
+
+// SetNillableSecretHash sets the "secret_hash" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableSecretHash(v *string) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetSecretHash(*v)
+	}
+	return _u
+}
+
+// SetPreviousSecretHash sets the "previous_secret_hash" field.
+func (_u *OAuthClientUpdate) SetPreviousSecretHash(v string) *OAuthClientUpdate {
+	_u.mutation.SetPreviousSecretHash(v)
+	return _u
+}
+
+// SetNillablePreviousSecretHash sets the "previous_secret_hash" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillablePreviousSecretHash(v *string) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetPreviousSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSecretHash clears the value of the "previous_secret_hash" field.
+func (_u *OAuthClientUpdate) ClearPreviousSecretHash() *OAuthClientUpdate {
+	_u.mutation.ClearPreviousSecretHash()
+	return _u
+}
+
+// SetPreviousSecretExpiresAt sets the "previous_secret_expires_at" field.
+func (_u *OAuthClientUpdate) SetPreviousSecretExpiresAt(v time.Time) *OAuthClientUpdate {
+	_u.mutation.SetPreviousSecretExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousSecretExpiresAt sets the "previous_secret_expires_at" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillablePreviousSecretExpiresAt(v *time.Time) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetPreviousSecretExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSecretExpiresAt clears the value of the "previous_secret_expires_at" field.
+func (_u *OAuthClientUpdate) ClearPreviousSecretExpiresAt() *OAuthClientUpdate {
+	_u.mutation.ClearPreviousSecretExpiresAt()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OAuthClientUpdate) SetScopes(v []string) *OAuthClientUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OAuthClientUpdate) AppendScopes(v []string) *OAuthClientUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OAuthClientUpdate) ClearScopes() *OAuthClientUpdate {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetTokenTTL sets the "token_ttl" field.
+func (_u *OAuthClientUpdate) SetTokenTTL(v uint32) *OAuthClientUpdate {
+	_u.mutation.ResetTokenTTL()
+	_u.mutation.SetTokenTTL(v)
+	return _u
+}
+
+// SetNillableTokenTTL sets the "token_ttl" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableTokenTTL(v *uint32) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetTokenTTL(*v)
+	}
+	return _u
+}
+
+// AddTokenTTL adds value to the "token_ttl" field.
+func (_u *OAuthClientUpdate) AddTokenTTL(v int32) *OAuthClientUpdate {
+	_u.mutation.AddTokenTTL(v)
+	return _u
+}
+
+// ClearTokenTTL clears the value of the "token_ttl" field.
+func (_u *OAuthClientUpdate) ClearTokenTTL() *OAuthClientUpdate {
+	_u.mutation.ClearTokenTTL()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *OAuthClientUpdate) SetLastUsedAt(v time.Time) *OAuthClientUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableLastUsedAt(v *time.Time) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *OAuthClientUpdate) ClearLastUsedAt() *OAuthClientUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetSecretVersion sets the "secret_version" field.
+func (_u *OAuthClientUpdate) SetSecretVersion(v uint32) *OAuthClientUpdate {
+	_u.mutation.ResetSecretVersion()
+	_u.mutation.SetSecretVersion(v)
+	return _u
+}
+
+// SetNillableSecretVersion sets the "secret_version" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableSecretVersion(v *uint32) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetSecretVersion(*v)
+	}
+	return _u
+}
+
+// AddSecretVersion adds value to the "secret_version" field.
+func (_u *OAuthClientUpdate) AddSecretVersion(v int32) *OAuthClientUpdate {
+	_u.mutation.AddSecretVersion(v)
+	return _u
+}
+
+// SetDataScope sets the "data_scope" field.
+func (_u *OAuthClientUpdate) SetDataScope(v oauthclient.DataScope) *OAuthClientUpdate {
+	_u.mutation.SetDataScope(v)
+	return _u
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableDataScope(v *oauthclient.DataScope) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetDataScope(*v)
+	}
+	return _u
+}
+
+// ClearDataScope clears the value of the "data_scope" field.
+func (_u *OAuthClientUpdate) ClearDataScope() *OAuthClientUpdate {
+	_u.mutation.ClearDataScope()
+	return _u
+}
+
+// SetOrgUnitID sets the "org_unit_id" field.
+func (_u *OAuthClientUpdate) SetOrgUnitID(v uint32) *OAuthClientUpdate {
+	_u.mutation.ResetOrgUnitID()
+	_u.mutation.SetOrgUnitID(v)
+	return _u
+}
+
+// SetNillableOrgUnitID sets the "org_unit_id" field if the given value is not nil.
+func (_u *OAuthClientUpdate) SetNillableOrgUnitID(v *uint32) *OAuthClientUpdate {
+	if v != nil {
+		_u.SetOrgUnitID(*v)
+	}
+	return _u
+}
+
+// AddOrgUnitID adds value to the "org_unit_id" field.
+func (_u *OAuthClientUpdate) AddOrgUnitID(v int32) *OAuthClientUpdate {
+	_u.mutation.AddOrgUnitID(v)
+	return _u
+}
+
+// ClearOrgUnitID clears the value of the "org_unit_id" field.
+func (_u *OAuthClientUpdate) ClearOrgUnitID() *OAuthClientUpdate {
+	_u.mutation.ClearOrgUnitID()
+	return _u
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (_u *OAuthClientUpdate) Mutation() *OAuthClientMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OAuthClientUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OAuthClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OAuthClientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OAuthClientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OAuthClientUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := oauthclient.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SecretHash(); ok {
+		if err := oauthclient.SecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "secret_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.secret_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DataScope(); ok {
+		if err := oauthclient.DataScopeValidator(v); err != nil {
+			return &ValidationError{Name: "data_scope", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.data_scope": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OAuthClientUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthClientUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OAuthClientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(oauthclient.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(oauthclient.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthclient.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(oauthclient.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(oauthclient.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(oauthclient.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(oauthclient.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(oauthclient.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(oauthclient.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(oauthclient.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(oauthclient.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(oauthclient.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oauthclient.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(oauthclient.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(oauthclient.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(oauthclient.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecretHash(); ok {
+		_spec.SetField(oauthclient.FieldSecretHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PreviousSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldPreviousSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.PreviousSecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldPreviousSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousSecretExpiresAt(); ok {
+		_spec.SetField(oauthclient.FieldPreviousSecretExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousSecretExpiresAtCleared() {
+		_spec.ClearField(oauthclient.FieldPreviousSecretExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthclient.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TokenTTL(); ok {
+		_spec.SetField(oauthclient.FieldTokenTTL, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedTokenTTL(); ok {
+		_spec.AddField(oauthclient.FieldTokenTTL, field.TypeUint32, value)
+	}
+	if _u.mutation.TokenTTLCleared() {
+		_spec.ClearField(oauthclient.FieldTokenTTL, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(oauthclient.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(oauthclient.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SecretVersion(); ok {
+		_spec.SetField(oauthclient.FieldSecretVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSecretVersion(); ok {
+		_spec.AddField(oauthclient.FieldSecretVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DataScope(); ok {
+		_spec.SetField(oauthclient.FieldDataScope, field.TypeEnum, value)
+	}
+	if _u.mutation.DataScopeCleared() {
+		_spec.ClearField(oauthclient.FieldDataScope, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.OrgUnitID(); ok {
+		_spec.SetField(oauthclient.FieldOrgUnitID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedOrgUnitID(); ok {
+		_spec.AddField(oauthclient.FieldOrgUnitID, field.TypeUint32, value)
+	}
+	if _u.mutation.OrgUnitIDCleared() {
+		_spec.ClearField(oauthclient.FieldOrgUnitID, field.TypeUint32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OAuthClientUpdateOne is the builder for updating a single OAuthClient entity.
+type OAuthClientUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OAuthClientMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OAuthClientUpdateOne) SetUpdatedAt(v time.Time) *OAuthClientUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableUpdatedAt(v *time.Time) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *OAuthClientUpdateOne) ClearUpdatedAt() *OAuthClientUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OAuthClientUpdateOne) SetDeletedAt(v time.Time) *OAuthClientUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableDeletedAt(v *time.Time) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *OAuthClientUpdateOne) ClearDeletedAt() *OAuthClientUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *OAuthClientUpdateOne) SetCreatedBy(v uint32) *OAuthClientUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableCreatedBy(v *uint32) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *OAuthClientUpdateOne) AddCreatedBy(v int32) *OAuthClientUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *OAuthClientUpdateOne) ClearCreatedBy() *OAuthClientUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *OAuthClientUpdateOne) SetUpdatedBy(v uint32) *OAuthClientUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableUpdatedBy(v *uint32) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *OAuthClientUpdateOne) AddUpdatedBy(v int32) *OAuthClientUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *OAuthClientUpdateOne) ClearUpdatedBy() *OAuthClientUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *OAuthClientUpdateOne) SetDeletedBy(v uint32) *OAuthClientUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableDeletedBy(v *uint32) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *OAuthClientUpdateOne) AddDeletedBy(v int32) *OAuthClientUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *OAuthClientUpdateOne) ClearDeletedBy() *OAuthClientUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OAuthClientUpdateOne) SetStatus(v oauthclient.Status) *OAuthClientUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableStatus(v *oauthclient.Status) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OAuthClientUpdateOne) SetName(v string) *OAuthClientUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableName(v *string) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *OAuthClientUpdateOne) SetDescription(v string) *OAuthClientUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableDescription(v *string) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *OAuthClientUpdateOne) ClearDescription() *OAuthClientUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (_u *OAuthClientUpdateOne) SetSecretHash(v string) *OAuthClientUpdateOne {
+	_u.mutation.SetSecretHash(v)
+	return _u
+}
+
+// SetNillableSecretHash sets the "secret_hash" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableSecretHash(v *string) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetSecretHash(*v)
+	}
+	return _u
+}
+
+// SetPreviousSecretHash sets the "previous_secret_hash" field.
+func (_u *OAuthClientUpdateOne) SetPreviousSecretHash(v string) *OAuthClientUpdateOne {
+	_u.mutation.SetPreviousSecretHash(v)
+	return _u
+}
+
+// SetNillablePreviousSecretHash sets the "previous_secret_hash" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillablePreviousSecretHash(v *string) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetPreviousSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSecretHash clears the value of the "previous_secret_hash" field.
+func (_u *OAuthClientUpdateOne) ClearPreviousSecretHash() *OAuthClientUpdateOne {
+	_u.mutation.ClearPreviousSecretHash()
+	return _u
+}
+
+// SetPreviousSecretExpiresAt sets the "previous_secret_expires_at" field.
+func (_u *OAuthClientUpdateOne) SetPreviousSecretExpiresAt(v time.Time) *OAuthClientUpdateOne {
+	_u.mutation.SetPreviousSecretExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousSecretExpiresAt sets the "previous_secret_expires_at" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillablePreviousSecretExpiresAt(v *time.Time) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetPreviousSecretExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSecretExpiresAt clears the value of the "previous_secret_expires_at" field.
+func (_u *OAuthClientUpdateOne) ClearPreviousSecretExpiresAt() *OAuthClientUpdateOne {
+	_u.mutation.ClearPreviousSecretExpiresAt()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OAuthClientUpdateOne) SetScopes(v []string) *OAuthClientUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OAuthClientUpdateOne) AppendScopes(v []string) *OAuthClientUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OAuthClientUpdateOne) ClearScopes() *OAuthClientUpdateOne {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetTokenTTL sets the "token_ttl" field.
+func (_u *OAuthClientUpdateOne) SetTokenTTL(v uint32) *OAuthClientUpdateOne {
+	_u.mutation.ResetTokenTTL()
+	_u.mutation.SetTokenTTL(v)
+	return _u
+}
+
+// SetNillableTokenTTL sets the "token_ttl" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableTokenTTL(v *uint32) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetTokenTTL(*v)
+	}
+	return _u
+}
+
+// AddTokenTTL adds value to the "token_ttl" field.
+func (_u *OAuthClientUpdateOne) AddTokenTTL(v int32) *OAuthClientUpdateOne {
+	_u.mutation.AddTokenTTL(v)
+	return _u
+}
+
+// ClearTokenTTL clears the value of the "token_ttl" field.
+func (_u *OAuthClientUpdateOne) ClearTokenTTL() *OAuthClientUpdateOne {
+	_u.mutation.ClearTokenTTL()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *OAuthClientUpdateOne) SetLastUsedAt(v time.Time) *OAuthClientUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableLastUsedAt(v *time.Time) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *OAuthClientUpdateOne) ClearLastUsedAt() *OAuthClientUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetSecretVersion sets the "secret_version" field.
+func (_u *OAuthClientUpdateOne) SetSecretVersion(v uint32) *OAuthClientUpdateOne {
+	_u.mutation.ResetSecretVersion()
+	_u.mutation.SetSecretVersion(v)
+	return _u
+}
+
+// SetNillableSecretVersion sets the "secret_version" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableSecretVersion(v *uint32) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetSecretVersion(*v)
+	}
+	return _u
+}
+
+// AddSecretVersion adds value to the "secret_version" field.
+func (_u *OAuthClientUpdateOne) AddSecretVersion(v int32) *OAuthClientUpdateOne {
+	_u.mutation.AddSecretVersion(v)
+	return _u
+}
+
+// SetDataScope sets the "data_scope" field.
+func (_u *OAuthClientUpdateOne) SetDataScope(v oauthclient.DataScope) *OAuthClientUpdateOne {
+	_u.mutation.SetDataScope(v)
+	return _u
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableDataScope(v *oauthclient.DataScope) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetDataScope(*v)
+	}
+	return _u
+}
+
+// ClearDataScope clears the value of the "data_scope" field.
+func (_u *OAuthClientUpdateOne) ClearDataScope() *OAuthClientUpdateOne {
+	_u.mutation.ClearDataScope()
+	return _u
+}
+
+// SetOrgUnitID sets the "org_unit_id" field.
+func (_u *OAuthClientUpdateOne) SetOrgUnitID(v uint32) *OAuthClientUpdateOne {
+	_u.mutation.ResetOrgUnitID()
+	_u.mutation.SetOrgUnitID(v)
+	return _u
+}
+
+// SetNillableOrgUnitID sets the "org_unit_id" field if the given value is not nil.
+func (_u *OAuthClientUpdateOne) SetNillableOrgUnitID(v *uint32) *OAuthClientUpdateOne {
+	if v != nil {
+		_u.SetOrgUnitID(*v)
+	}
+	return _u
+}
+
+// AddOrgUnitID adds value to the "org_unit_id" field.
+func (_u *OAuthClientUpdateOne) AddOrgUnitID(v int32) *OAuthClientUpdateOne {
+	_u.mutation.AddOrgUnitID(v)
+	return _u
+}
+
+// ClearOrgUnitID clears the value of the "org_unit_id" field.
+func (_u *OAuthClientUpdateOne) ClearOrgUnitID() *OAuthClientUpdateOne {
+	_u.mutation.ClearOrgUnitID()
+	return _u
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (_u *OAuthClientUpdateOne) Mutation() *OAuthClientMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OAuthClientUpdate builder.
+func (_u *OAuthClientUpdateOne) Where(ps ...predicate.OAuthClient) *OAuthClientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OAuthClientUpdateOne) Select(field string, fields ...string) *OAuthClientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OAuthClient entity.
+func (_u *OAuthClientUpdateOne) Save(ctx context.Context) (*OAuthClient, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OAuthClientUpdateOne) SaveX(ctx context.Context) *OAuthClient {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OAuthClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OAuthClientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OAuthClientUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := oauthclient.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SecretHash(); ok {
+		if err := oauthclient.SecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "secret_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.secret_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DataScope(); ok {
+		if err := oauthclient.DataScopeValidator(v); err != nil {
+			return &ValidationError{Name: "data_scope", err: fmt.Errorf(`ent: validator failed for field "OAuthClient.data_scope": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OAuthClientUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthClientUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OAuthClientUpdateOne) sqlSave(ctx context.Context) (_node *OAuthClient, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthclient.FieldID)
+		for _, f := range fields {
+			if !oauthclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(oauthclient.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(oauthclient.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthclient.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(oauthclient.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(oauthclient.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(oauthclient.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(oauthclient.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(oauthclient.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(oauthclient.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(oauthclient.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(oauthclient.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(oauthclient.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oauthclient.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(oauthclient.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(oauthclient.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(oauthclient.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecretHash(); ok {
+		_spec.SetField(oauthclient.FieldSecretHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PreviousSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldPreviousSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.PreviousSecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldPreviousSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousSecretExpiresAt(); ok {
+		_spec.SetField(oauthclient.FieldPreviousSecretExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousSecretExpiresAtCleared() {
+		_spec.ClearField(oauthclient.FieldPreviousSecretExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthclient.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TokenTTL(); ok {
+		_spec.SetField(oauthclient.FieldTokenTTL, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedTokenTTL(); ok {
+		_spec.AddField(oauthclient.FieldTokenTTL, field.TypeUint32, value)
+	}
+	if _u.mutation.TokenTTLCleared() {
+		_spec.ClearField(oauthclient.FieldTokenTTL, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(oauthclient.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(oauthclient.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SecretVersion(); ok {
+		_spec.SetField(oauthclient.FieldSecretVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSecretVersion(); ok {
+		_spec.AddField(oauthclient.FieldSecretVersion, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DataScope(); ok {
+		_spec.SetField(oauthclient.FieldDataScope, field.TypeEnum, value)
+	}
+	if _u.mutation.DataScopeCleared() {
+		_spec.ClearField(oauthclient.FieldDataScope, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.OrgUnitID(); ok {
+		_spec.SetField(oauthclient.FieldOrgUnitID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedOrgUnitID(); ok {
+		_spec.AddField(oauthclient.FieldOrgUnitID, field.TypeUint32, value)
+	}
+	if _u.mutation.OrgUnitIDCleared() {
+		_spec.ClearField(oauthclient.FieldOrgUnitID, field.TypeUint32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &OAuthClient{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 密码策略表
+type PasswordPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 创建者ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 更新者ID
+	UpdatedBy *uint32 `json:"updated_by,omitempty"`
+	// 删除者ID
+	DeletedBy *uint32 `json:"deleted_by,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 最小长度
+	MinLength *uint32 `json:"min_length,omitempty"`
+	// 必须包含大写字母
+	RequireUppercase *bool `json:"require_uppercase,omitempty"`
+	// 必须包含小写字母
+	RequireLowercase *bool `json:"require_lowercase,omitempty"`
+	// 必须包含数字
+	RequireDigit *bool `json:"require_digit,omitempty"`
+	// 必须包含特殊字符
+	RequireSymbol *bool `json:"require_symbol,omitempty"`
+	// 不能与最近使用过的几个密码相同，0代表不限制
+	HistoryCount *uint32 `json:"history_count,omitempty"`
+	// 密码最长使用天数，0代表不过期
+	MaxAgeDays *uint32 `json:"max_age_days,omitempty"`
+	// 拒绝已泄露的密码
+	RejectBreached *bool `json:"reject_breached,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PasswordPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case passwordpolicy.FieldRequireUppercase, passwordpolicy.FieldRequireLowercase, passwordpolicy.FieldRequireDigit, passwordpolicy.FieldRequireSymbol, passwordpolicy.FieldRejectBreached:
+			values[i] = new(sql.NullBool)
+		case passwordpolicy.FieldID, passwordpolicy.FieldCreatedBy, passwordpolicy.FieldUpdatedBy, passwordpolicy.FieldDeletedBy, passwordpolicy.FieldTenantID, passwordpolicy.FieldMinLength, passwordpolicy.FieldHistoryCount, passwordpolicy.FieldMaxAgeDays:
+			values[i] = new(sql.NullInt64)
+		case passwordpolicy.FieldCreatedAt, passwordpolicy.FieldUpdatedAt, passwordpolicy.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PasswordPolicy fields.
+func (_m *PasswordPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case passwordpolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case passwordpolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case passwordpolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case passwordpolicy.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case passwordpolicy.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case passwordpolicy.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(uint32)
+				*_m.UpdatedBy = uint32(value.Int64)
+			}
+		case passwordpolicy.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = new(uint32)
+				*_m.DeletedBy = uint32(value.Int64)
+			}
+		case passwordpolicy.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case passwordpolicy.FieldMinLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_length", values[i])
+			} else if value.Valid {
+				_m.MinLength = new(uint32)
+				*_m.MinLength = uint32(value.Int64)
+			}
+		case passwordpolicy.FieldRequireUppercase:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_uppercase", values[i])
+			} else if value.Valid {
+				_m.RequireUppercase = new(bool)
+				*_m.RequireUppercase = value.Bool
+			}
+		case passwordpolicy.FieldRequireLowercase:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_lowercase", values[i])
+			} else if value.Valid {
+				_m.RequireLowercase = new(bool)
+				*_m.RequireLowercase = value.Bool
+			}
+		case passwordpolicy.FieldRequireDigit:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_digit", values[i])
+			} else if value.Valid {
+				_m.RequireDigit = new(bool)
+				*_m.RequireDigit = value.Bool
+			}
+		case passwordpolicy.FieldRequireSymbol:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_symbol", values[i])
+			} else if value.Valid {
+				_m.RequireSymbol = new(bool)
+				*_m.RequireSymbol = value.Bool
+			}
+		case passwordpolicy.FieldHistoryCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field history_count", values[i])
+			} else if value.Valid {
+				_m.HistoryCount = new(uint32)
+				*_m.HistoryCount = uint32(value.Int64)
+			}
+		case passwordpolicy.FieldMaxAgeDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_age_days", values[i])
+			} else if value.Valid {
+				_m.MaxAgeDays = new(uint32)
+				*_m.MaxAgeDays = uint32(value.Int64)
+			}
+		case passwordpolicy.FieldRejectBreached:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field reject_breached", values[i])
+			} else if value.Valid {
+				_m.RejectBreached = new(bool)
+				*_m.RejectBreached = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PasswordPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *PasswordPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PasswordPolicy.
+// Note that you need to call PasswordPolicy.Unwrap() before calling this method if this PasswordPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PasswordPolicy) Update() *PasswordPolicyUpdateOne {
+	return NewPasswordPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PasswordPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PasswordPolicy) Unwrap() *PasswordPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PasswordPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PasswordPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("PasswordPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedBy; v != nil {
+		builder.WriteString("deleted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MinLength; v != nil {
+		builder.WriteString("min_length=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RequireUppercase; v != nil {
+		builder.WriteString("require_uppercase=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RequireLowercase; v != nil {
+		builder.WriteString("require_lowercase=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RequireDigit; v != nil {
+		builder.WriteString("require_digit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RequireSymbol; v != nil {
+		builder.WriteString("require_symbol=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.HistoryCount; v != nil {
+		builder.WriteString("history_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxAgeDays; v != nil {
+		builder.WriteString("max_age_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RejectBreached; v != nil {
+		builder.WriteString("reject_breached=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PasswordPolicies is a parsable slice of PasswordPolicy.
+type PasswordPolicies []*PasswordPolicy
@@ -0,0 +1,745 @@
+// Code generated by ent, DO NOT EDIT.
+
+package passwordpolicy
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldTenantID, v))
+}
+
+// MinLength applies equality check predicate on the "min_length" field. It's identical to MinLengthEQ.
+func MinLength(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldMinLength, v))
+}
+
+// RequireUppercase applies equality check predicate on the "require_uppercase" field. It's identical to RequireUppercaseEQ.
+func RequireUppercase(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldRequireUppercase, v))
+}
+
+// RequireLowercase applies equality check predicate on the "require_lowercase" field. It's identical to RequireLowercaseEQ.
+func RequireLowercase(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldRequireLowercase, v))
+}
+
+// RequireDigit applies equality check predicate on the "require_digit" field. It's identical to RequireDigitEQ.
+func RequireDigit(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldRequireDigit, v))
+}
+
+// RequireSymbol applies equality check predicate on the "require_symbol" field. It's identical to RequireSymbolEQ.
+func RequireSymbol(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldRequireSymbol, v))
+}
+
+// HistoryCount applies equality check predicate on the "history_count" field. It's identical to HistoryCountEQ.
+func HistoryCount(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldHistoryCount, v))
+}
+
+// MaxAgeDays applies equality check predicate on the "max_age_days" field. It's identical to MaxAgeDaysEQ.
+func MaxAgeDays(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldMaxAgeDays, v))
+}
+
+// RejectBreached applies equality check predicate on the "reject_breached" field. It's identical to RejectBreachedEQ.
+func RejectBreached(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldRejectBreached, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldTenantID))
+}
+
+// MinLengthEQ applies the EQ predicate on the "min_length" field.
+func MinLengthEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldMinLength, v))
+}
+
+// MinLengthNEQ applies the NEQ predicate on the "min_length" field.
+func MinLengthNEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldMinLength, v))
+}
+
+// MinLengthIn applies the In predicate on the "min_length" field.
+func MinLengthIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIn(FieldMinLength, vs...))
+}
+
+// MinLengthNotIn applies the NotIn predicate on the "min_length" field.
+func MinLengthNotIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotIn(FieldMinLength, vs...))
+}
+
+// MinLengthGT applies the GT predicate on the "min_length" field.
+func MinLengthGT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGT(FieldMinLength, v))
+}
+
+// MinLengthGTE applies the GTE predicate on the "min_length" field.
+func MinLengthGTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGTE(FieldMinLength, v))
+}
+
+// MinLengthLT applies the LT predicate on the "min_length" field.
+func MinLengthLT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLT(FieldMinLength, v))
+}
+
+// MinLengthLTE applies the LTE predicate on the "min_length" field.
+func MinLengthLTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLTE(FieldMinLength, v))
+}
+
+// MinLengthIsNil applies the IsNil predicate on the "min_length" field.
+func MinLengthIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldMinLength))
+}
+
+// MinLengthNotNil applies the NotNil predicate on the "min_length" field.
+func MinLengthNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldMinLength))
+}
+
+// RequireUppercaseEQ applies the EQ predicate on the "require_uppercase" field.
+func RequireUppercaseEQ(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldRequireUppercase, v))
+}
+
+// RequireUppercaseNEQ applies the NEQ predicate on the "require_uppercase" field.
+func RequireUppercaseNEQ(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldRequireUppercase, v))
+}
+
+// RequireUppercaseIsNil applies the IsNil predicate on the "require_uppercase" field.
+func RequireUppercaseIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldRequireUppercase))
+}
+
+// RequireUppercaseNotNil applies the NotNil predicate on the "require_uppercase" field.
+func RequireUppercaseNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldRequireUppercase))
+}
+
+// RequireLowercaseEQ applies the EQ predicate on the "require_lowercase" field.
+func RequireLowercaseEQ(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldRequireLowercase, v))
+}
+
+// RequireLowercaseNEQ applies the NEQ predicate on the "require_lowercase" field.
+func RequireLowercaseNEQ(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldRequireLowercase, v))
+}
+
+// RequireLowercaseIsNil applies the IsNil predicate on the "require_lowercase" field.
+func RequireLowercaseIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldRequireLowercase))
+}
+
+// RequireLowercaseNotNil applies the NotNil predicate on the "require_lowercase" field.
+func RequireLowercaseNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldRequireLowercase))
+}
+
+// RequireDigitEQ applies the EQ predicate on the "require_digit" field.
+func RequireDigitEQ(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldRequireDigit, v))
+}
+
+// RequireDigitNEQ applies the NEQ predicate on the "require_digit" field.
+func RequireDigitNEQ(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldRequireDigit, v))
+}
+
+// RequireDigitIsNil applies the IsNil predicate on the "require_digit" field.
+func RequireDigitIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldRequireDigit))
+}
+
+// RequireDigitNotNil applies the NotNil predicate on the "require_digit" field.
+func RequireDigitNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldRequireDigit))
+}
+
+// RequireSymbolEQ applies the EQ predicate on the "require_symbol" field.
+func RequireSymbolEQ(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldRequireSymbol, v))
+}
+
+// RequireSymbolNEQ applies the NEQ predicate on the "require_symbol" field.
+func RequireSymbolNEQ(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldRequireSymbol, v))
+}
+
+// RequireSymbolIsNil applies the IsNil predicate on the "require_symbol" field.
+func RequireSymbolIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldRequireSymbol))
+}
+
+// RequireSymbolNotNil applies the NotNil predicate on the "require_symbol" field.
+func RequireSymbolNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldRequireSymbol))
+}
+
+// HistoryCountEQ applies the EQ predicate on the "history_count" field.
+func HistoryCountEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldHistoryCount, v))
+}
+
+// HistoryCountNEQ applies the NEQ predicate on the "history_count" field.
+func HistoryCountNEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldHistoryCount, v))
+}
+
+// HistoryCountIn applies the In predicate on the "history_count" field.
+func HistoryCountIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIn(FieldHistoryCount, vs...))
+}
+
+// HistoryCountNotIn applies the NotIn predicate on the "history_count" field.
+func HistoryCountNotIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotIn(FieldHistoryCount, vs...))
+}
+
+// HistoryCountGT applies the GT predicate on the "history_count" field.
+func HistoryCountGT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGT(FieldHistoryCount, v))
+}
+
+// HistoryCountGTE applies the GTE predicate on the "history_count" field.
+func HistoryCountGTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGTE(FieldHistoryCount, v))
+}
+
+// HistoryCountLT applies the LT predicate on the "history_count" field.
+func HistoryCountLT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLT(FieldHistoryCount, v))
+}
+
+// HistoryCountLTE applies the LTE predicate on the "history_count" field.
+func HistoryCountLTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLTE(FieldHistoryCount, v))
+}
+
+// HistoryCountIsNil applies the IsNil predicate on the "history_count" field.
+func HistoryCountIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldHistoryCount))
+}
+
+// HistoryCountNotNil applies the NotNil predicate on the "history_count" field.
+func HistoryCountNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldHistoryCount))
+}
+
+// MaxAgeDaysEQ applies the EQ predicate on the "max_age_days" field.
+func MaxAgeDaysEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysNEQ applies the NEQ predicate on the "max_age_days" field.
+func MaxAgeDaysNEQ(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysIn applies the In predicate on the "max_age_days" field.
+func MaxAgeDaysIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIn(FieldMaxAgeDays, vs...))
+}
+
+// MaxAgeDaysNotIn applies the NotIn predicate on the "max_age_days" field.
+func MaxAgeDaysNotIn(vs ...uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotIn(FieldMaxAgeDays, vs...))
+}
+
+// MaxAgeDaysGT applies the GT predicate on the "max_age_days" field.
+func MaxAgeDaysGT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGT(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysGTE applies the GTE predicate on the "max_age_days" field.
+func MaxAgeDaysGTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldGTE(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysLT applies the LT predicate on the "max_age_days" field.
+func MaxAgeDaysLT(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLT(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysLTE applies the LTE predicate on the "max_age_days" field.
+func MaxAgeDaysLTE(v uint32) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldLTE(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysIsNil applies the IsNil predicate on the "max_age_days" field.
+func MaxAgeDaysIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldMaxAgeDays))
+}
+
+// MaxAgeDaysNotNil applies the NotNil predicate on the "max_age_days" field.
+func MaxAgeDaysNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldMaxAgeDays))
+}
+
+// RejectBreachedEQ applies the EQ predicate on the "reject_breached" field.
+func RejectBreachedEQ(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldEQ(FieldRejectBreached, v))
+}
+
+// RejectBreachedNEQ applies the NEQ predicate on the "reject_breached" field.
+func RejectBreachedNEQ(v bool) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNEQ(FieldRejectBreached, v))
+}
+
+// RejectBreachedIsNil applies the IsNil predicate on the "reject_breached" field.
+func RejectBreachedIsNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldIsNull(FieldRejectBreached))
+}
+
+// RejectBreachedNotNil applies the NotNil predicate on the "reject_breached" field.
+func RejectBreachedNotNil() predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.FieldNotNull(FieldRejectBreached))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PasswordPolicy) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PasswordPolicy) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PasswordPolicy) predicate.PasswordPolicy {
+	return predicate.PasswordPolicy(sql.NotPredicates(p))
+}
@@ -0,0 +1,1664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PasswordPolicyCreate is the builder for creating a PasswordPolicy entity.
+type PasswordPolicyCreate struct {
+	config
+	mutation *PasswordPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PasswordPolicyCreate) SetCreatedAt(v time.Time) *PasswordPolicyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableCreatedAt(v *time.Time) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PasswordPolicyCreate) SetUpdatedAt(v time.Time) *PasswordPolicyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableUpdatedAt(v *time.Time) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PasswordPolicyCreate) SetDeletedAt(v time.Time) *PasswordPolicyCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableDeletedAt(v *time.Time) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PasswordPolicyCreate) SetCreatedBy(v uint32) *PasswordPolicyCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableCreatedBy(v *uint32) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *PasswordPolicyCreate) SetUpdatedBy(v uint32) *PasswordPolicyCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableUpdatedBy(v *uint32) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *PasswordPolicyCreate) SetDeletedBy(v uint32) *PasswordPolicyCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableDeletedBy(v *uint32) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *PasswordPolicyCreate) SetTenantID(v uint32) *PasswordPolicyCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableTenantID(v *uint32) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetMinLength sets the "min_length" field.
+func (_c *PasswordPolicyCreate) SetMinLength(v uint32) *PasswordPolicyCreate {
+	_c.mutation.SetMinLength(v)
+	return _c
+}
+
+// SetNillableMinLength sets the "min_length" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableMinLength(v *uint32) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetMinLength(*v)
+	}
+	return _c
+}
+
+// SetRequireUppercase sets the "require_uppercase" field.
+func (_c *PasswordPolicyCreate) SetRequireUppercase(v bool) *PasswordPolicyCreate {
+	_c.mutation.SetRequireUppercase(v)
+	return _c
+}
+
+// SetNillableRequireUppercase sets the "require_uppercase" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableRequireUppercase(v *bool) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetRequireUppercase(*v)
+	}
+	return _c
+}
+
+// SetRequireLowercase sets the "require_lowercase" field.
+func (_c *PasswordPolicyCreate) SetRequireLowercase(v bool) *PasswordPolicyCreate {
+	_c.mutation.SetRequireLowercase(v)
+	return _c
+}
+
+// SetNillableRequireLowercase sets the "require_lowercase" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableRequireLowercase(v *bool) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetRequireLowercase(*v)
+	}
+	return _c
+}
+
+// SetRequireDigit sets the "require_digit" field.
+func (_c *PasswordPolicyCreate) SetRequireDigit(v bool) *PasswordPolicyCreate {
+	_c.mutation.SetRequireDigit(v)
+	return _c
+}
+
+// SetNillableRequireDigit sets the "require_digit" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableRequireDigit(v *bool) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetRequireDigit(*v)
+	}
+	return _c
+}
+
+// SetRequireSymbol sets the "require_symbol" field.
+func (_c *PasswordPolicyCreate) SetRequireSymbol(v bool) *PasswordPolicyCreate {
+	_c.mutation.SetRequireSymbol(v)
+	return _c
+}
+
+// SetNillableRequireSymbol sets the "require_symbol" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableRequireSymbol(v *bool) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetRequireSymbol(*v)
+	}
+	return _c
+}
+
+// SetHistoryCount sets the "history_count" field.
+func (_c *PasswordPolicyCreate) SetHistoryCount(v uint32) *PasswordPolicyCreate {
+	_c.mutation.SetHistoryCount(v)
+	return _c
+}
+
+// SetNillableHistoryCount sets the "history_count" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableHistoryCount(v *uint32) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetHistoryCount(*v)
+	}
+	return _c
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (_c *PasswordPolicyCreate) SetMaxAgeDays(v uint32) *PasswordPolicyCreate {
+	_c.mutation.SetMaxAgeDays(v)
+	return _c
+}
+
+// SetNillableMaxAgeDays sets the "max_age_days" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableMaxAgeDays(v *uint32) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetMaxAgeDays(*v)
+	}
+	return _c
+}
+
+// SetRejectBreached sets the "reject_breached" field.
+func (_c *PasswordPolicyCreate) SetRejectBreached(v bool) *PasswordPolicyCreate {
+	_c.mutation.SetRejectBreached(v)
+	return _c
+}
+
+// SetNillableRejectBreached sets the "reject_breached" field if the given value is not nil.
+func (_c *PasswordPolicyCreate) SetNillableRejectBreached(v *bool) *PasswordPolicyCreate {
+	if v != nil {
+		_c.SetRejectBreached(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PasswordPolicyCreate) SetID(v uint32) *PasswordPolicyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PasswordPolicyMutation object of the builder.
+func (_c *PasswordPolicyCreate) Mutation() *PasswordPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the PasswordPolicy in the database.
+func (_c *PasswordPolicyCreate) Save(ctx context.Context) (*PasswordPolicy, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PasswordPolicyCreate) SaveX(ctx context.Context) *PasswordPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PasswordPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PasswordPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PasswordPolicyCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := passwordpolicy.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.MinLength(); !ok {
+		v := passwordpolicy.DefaultMinLength
+		_c.mutation.SetMinLength(v)
+	}
+	if _, ok := _c.mutation.RequireUppercase(); !ok {
+		v := passwordpolicy.DefaultRequireUppercase
+		_c.mutation.SetRequireUppercase(v)
+	}
+	if _, ok := _c.mutation.RequireLowercase(); !ok {
+		v := passwordpolicy.DefaultRequireLowercase
+		_c.mutation.SetRequireLowercase(v)
+	}
+	if _, ok := _c.mutation.RequireDigit(); !ok {
+		v := passwordpolicy.DefaultRequireDigit
+		_c.mutation.SetRequireDigit(v)
+	}
+	if _, ok := _c.mutation.RequireSymbol(); !ok {
+		v := passwordpolicy.DefaultRequireSymbol
+		_c.mutation.SetRequireSymbol(v)
+	}
+	if _, ok := _c.mutation.HistoryCount(); !ok {
+		v := passwordpolicy.DefaultHistoryCount
+		_c.mutation.SetHistoryCount(v)
+	}
+	if _, ok := _c.mutation.MaxAgeDays(); !ok {
+		v := passwordpolicy.DefaultMaxAgeDays
+		_c.mutation.SetMaxAgeDays(v)
+	}
+	if _, ok := _c.mutation.RejectBreached(); !ok {
+		v := passwordpolicy.DefaultRejectBreached
+		_c.mutation.SetRejectBreached(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PasswordPolicyCreate) check() error {
+	if v, ok := _c.mutation.ID(); ok {
+		if err := passwordpolicy.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PasswordPolicy.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PasswordPolicyCreate) sqlSave(ctx context.Context) (*PasswordPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PasswordPolicyCreate) createSpec() (*PasswordPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PasswordPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(passwordpolicy.Table, sqlgraph.NewFieldSpec(passwordpolicy.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(passwordpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(passwordpolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(passwordpolicy.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(passwordpolicy.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(passwordpolicy.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(passwordpolicy.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(passwordpolicy.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.MinLength(); ok {
+		_spec.SetField(passwordpolicy.FieldMinLength, field.TypeUint32, value)
+		_node.MinLength = &value
+	}
+	if value, ok := _c.mutation.RequireUppercase(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireUppercase, field.TypeBool, value)
+		_node.RequireUppercase = &value
+	}
+	if value, ok := _c.mutation.RequireLowercase(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireLowercase, field.TypeBool, value)
+		_node.RequireLowercase = &value
+	}
+	if value, ok := _c.mutation.RequireDigit(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireDigit, field.TypeBool, value)
+		_node.RequireDigit = &value
+	}
+	if value, ok := _c.mutation.RequireSymbol(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireSymbol, field.TypeBool, value)
+		_node.RequireSymbol = &value
+	}
+	if value, ok := _c.mutation.HistoryCount(); ok {
+		_spec.SetField(passwordpolicy.FieldHistoryCount, field.TypeUint32, value)
+		_node.HistoryCount = &value
+	}
+	if value, ok := _c.mutation.MaxAgeDays(); ok {
+		_spec.SetField(passwordpolicy.FieldMaxAgeDays, field.TypeUint32, value)
+		_node.MaxAgeDays = &value
+	}
+	if value, ok := _c.mutation.RejectBreached(); ok {
+		_spec.SetField(passwordpolicy.FieldRejectBreached, field.TypeBool, value)
+		_node.RejectBreached = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PasswordPolicy.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasswordPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PasswordPolicyCreate) OnConflict(opts ...sql.ConflictOption) *PasswordPolicyUpsertOne {
+	_c.conflict = opts
+	return &PasswordPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PasswordPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PasswordPolicyCreate) OnConflictColumns(columns ...string) *PasswordPolicyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PasswordPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PasswordPolicyUpsertOne is the builder for "upsert"-ing
+	//  one PasswordPolicy node.
+	PasswordPolicyUpsertOne struct {
+		create *PasswordPolicyCreate
+	}
+
+	// PasswordPolicyUpsert is the "OnConflict" setter.
+	PasswordPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasswordPolicyUpsert) SetUpdatedAt(v time.Time) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateUpdatedAt() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *PasswordPolicyUpsert) ClearUpdatedAt() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PasswordPolicyUpsert) SetDeletedAt(v time.Time) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateDeletedAt() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PasswordPolicyUpsert) ClearDeletedAt() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PasswordPolicyUpsert) SetCreatedBy(v uint32) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateCreatedBy() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *PasswordPolicyUpsert) AddCreatedBy(v uint32) *PasswordPolicyUpsert {
+	u.Add(passwordpolicy.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PasswordPolicyUpsert) ClearCreatedBy() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PasswordPolicyUpsert) SetUpdatedBy(v uint32) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateUpdatedBy() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *PasswordPolicyUpsert) AddUpdatedBy(v uint32) *PasswordPolicyUpsert {
+	u.Add(passwordpolicy.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PasswordPolicyUpsert) ClearUpdatedBy() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PasswordPolicyUpsert) SetDeletedBy(v uint32) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateDeletedBy() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *PasswordPolicyUpsert) AddDeletedBy(v uint32) *PasswordPolicyUpsert {
+	u.Add(passwordpolicy.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PasswordPolicyUpsert) ClearDeletedBy() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldDeletedBy)
+	return u
+}
+
+// SetMinLength sets the "min_length" field.
+func (u *PasswordPolicyUpsert) SetMinLength(v uint32) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldMinLength, v)
+	return u
+}
+
+// UpdateMinLength sets the "min_length" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateMinLength() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldMinLength)
+	return u
+}
+
+// AddMinLength adds v to the "min_length" field.
+func (u *PasswordPolicyUpsert) AddMinLength(v uint32) *PasswordPolicyUpsert {
+	u.Add(passwordpolicy.FieldMinLength, v)
+	return u
+}
+
+// ClearMinLength clears the value of the "min_length" field.
+func (u *PasswordPolicyUpsert) ClearMinLength() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldMinLength)
+	return u
+}
+
+// SetRequireUppercase sets the "require_uppercase" field.
+func (u *PasswordPolicyUpsert) SetRequireUppercase(v bool) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldRequireUppercase, v)
+	return u
+}
+
+// UpdateRequireUppercase sets the "require_uppercase" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateRequireUppercase() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldRequireUppercase)
+	return u
+}
+
+// ClearRequireUppercase clears the value of the "require_uppercase" field.
+func (u *PasswordPolicyUpsert) ClearRequireUppercase() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldRequireUppercase)
+	return u
+}
+
+// SetRequireLowercase sets the "require_lowercase" field.
+func (u *PasswordPolicyUpsert) SetRequireLowercase(v bool) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldRequireLowercase, v)
+	return u
+}
+
+// UpdateRequireLowercase sets the "require_lowercase" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateRequireLowercase() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldRequireLowercase)
+	return u
+}
+
+// ClearRequireLowercase clears the value of the "require_lowercase" field.
+func (u *PasswordPolicyUpsert) ClearRequireLowercase() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldRequireLowercase)
+	return u
+}
+
+// SetRequireDigit sets the "require_digit" field.
+func (u *PasswordPolicyUpsert) SetRequireDigit(v bool) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldRequireDigit, v)
+	return u
+}
+
+// UpdateRequireDigit sets the "require_digit" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateRequireDigit() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldRequireDigit)
+	return u
+}
+
+// ClearRequireDigit clears the value of the "require_digit" field.
+func (u *PasswordPolicyUpsert) ClearRequireDigit() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldRequireDigit)
+	return u
+}
+
+// SetRequireSymbol sets the "require_symbol" field.
+func (u *PasswordPolicyUpsert) SetRequireSymbol(v bool) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldRequireSymbol, v)
+	return u
+}
+
+// UpdateRequireSymbol sets the "require_symbol" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateRequireSymbol() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldRequireSymbol)
+	return u
+}
+
+// ClearRequireSymbol clears the value of the "require_symbol" field.
+func (u *PasswordPolicyUpsert) ClearRequireSymbol() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldRequireSymbol)
+	return u
+}
+
+// SetHistoryCount sets the "history_count" field.
+func (u *PasswordPolicyUpsert) SetHistoryCount(v uint32) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldHistoryCount, v)
+	return u
+}
+
+// UpdateHistoryCount sets the "history_count" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateHistoryCount() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldHistoryCount)
+	return u
+}
+
+// AddHistoryCount adds v to the "history_count" field.
+func (u *PasswordPolicyUpsert) AddHistoryCount(v uint32) *PasswordPolicyUpsert {
+	u.Add(passwordpolicy.FieldHistoryCount, v)
+	return u
+}
+
+// ClearHistoryCount clears the value of the "history_count" field.
+func (u *PasswordPolicyUpsert) ClearHistoryCount() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldHistoryCount)
+	return u
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (u *PasswordPolicyUpsert) SetMaxAgeDays(v uint32) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldMaxAgeDays, v)
+	return u
+}
+
+// UpdateMaxAgeDays sets the "max_age_days" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateMaxAgeDays() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldMaxAgeDays)
+	return u
+}
+
+// AddMaxAgeDays adds v to the "max_age_days" field.
+func (u *PasswordPolicyUpsert) AddMaxAgeDays(v uint32) *PasswordPolicyUpsert {
+	u.Add(passwordpolicy.FieldMaxAgeDays, v)
+	return u
+}
+
+// ClearMaxAgeDays clears the value of the "max_age_days" field.
+func (u *PasswordPolicyUpsert) ClearMaxAgeDays() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldMaxAgeDays)
+	return u
+}
+
+// SetRejectBreached sets the "reject_breached" field.
+func (u *PasswordPolicyUpsert) SetRejectBreached(v bool) *PasswordPolicyUpsert {
+	u.Set(passwordpolicy.FieldRejectBreached, v)
+	return u
+}
+
+// UpdateRejectBreached sets the "reject_breached" field to the value that was provided on create.
+func (u *PasswordPolicyUpsert) UpdateRejectBreached() *PasswordPolicyUpsert {
+	u.SetExcluded(passwordpolicy.FieldRejectBreached)
+	return u
+}
+
+// ClearRejectBreached clears the value of the "reject_breached" field.
+func (u *PasswordPolicyUpsert) ClearRejectBreached() *PasswordPolicyUpsert {
+	u.SetNull(passwordpolicy.FieldRejectBreached)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PasswordPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passwordpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasswordPolicyUpsertOne) UpdateNewValues() *PasswordPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(passwordpolicy.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(passwordpolicy.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(passwordpolicy.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PasswordPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PasswordPolicyUpsertOne) Ignore() *PasswordPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasswordPolicyUpsertOne) DoNothing() *PasswordPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasswordPolicyCreate.OnConflict
+// documentation for more info.
+func (u *PasswordPolicyUpsertOne) Update(set func(*PasswordPolicyUpsert)) *PasswordPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasswordPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasswordPolicyUpsertOne) SetUpdatedAt(v time.Time) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateUpdatedAt() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *PasswordPolicyUpsertOne) ClearUpdatedAt() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PasswordPolicyUpsertOne) SetDeletedAt(v time.Time) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateDeletedAt() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PasswordPolicyUpsertOne) ClearDeletedAt() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PasswordPolicyUpsertOne) SetCreatedBy(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *PasswordPolicyUpsertOne) AddCreatedBy(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateCreatedBy() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PasswordPolicyUpsertOne) ClearCreatedBy() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PasswordPolicyUpsertOne) SetUpdatedBy(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *PasswordPolicyUpsertOne) AddUpdatedBy(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateUpdatedBy() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PasswordPolicyUpsertOne) ClearUpdatedBy() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PasswordPolicyUpsertOne) SetDeletedBy(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *PasswordPolicyUpsertOne) AddDeletedBy(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateDeletedBy() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PasswordPolicyUpsertOne) ClearDeletedBy() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMinLength sets the "min_length" field.
+func (u *PasswordPolicyUpsertOne) SetMinLength(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetMinLength(v)
+	})
+}
+
+// AddMinLength adds v to the "min_length" field.
+func (u *PasswordPolicyUpsertOne) AddMinLength(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddMinLength(v)
+	})
+}
+
+// UpdateMinLength sets the "min_length" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateMinLength() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateMinLength()
+	})
+}
+
+// ClearMinLength clears the value of the "min_length" field.
+func (u *PasswordPolicyUpsertOne) ClearMinLength() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearMinLength()
+	})
+}
+
+// SetRequireUppercase sets the "require_uppercase" field.
+func (u *PasswordPolicyUpsertOne) SetRequireUppercase(v bool) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetRequireUppercase(v)
+	})
+}
+
+// UpdateRequireUppercase sets the "require_uppercase" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateRequireUppercase() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateRequireUppercase()
+	})
+}
+
+// ClearRequireUppercase clears the value of the "require_uppercase" field.
+func (u *PasswordPolicyUpsertOne) ClearRequireUppercase() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearRequireUppercase()
+	})
+}
+
+// SetRequireLowercase sets the "require_lowercase" field.
+func (u *PasswordPolicyUpsertOne) SetRequireLowercase(v bool) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetRequireLowercase(v)
+	})
+}
+
+// UpdateRequireLowercase sets the "require_lowercase" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateRequireLowercase() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateRequireLowercase()
+	})
+}
+
+// ClearRequireLowercase clears the value of the "require_lowercase" field.
+func (u *PasswordPolicyUpsertOne) ClearRequireLowercase() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearRequireLowercase()
+	})
+}
+
+// SetRequireDigit sets the "require_digit" field.
+func (u *PasswordPolicyUpsertOne) SetRequireDigit(v bool) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetRequireDigit(v)
+	})
+}
+
+// UpdateRequireDigit sets the "require_digit" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateRequireDigit() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateRequireDigit()
+	})
+}
+
+// ClearRequireDigit clears the value of the "require_digit" field.
+func (u *PasswordPolicyUpsertOne) ClearRequireDigit() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearRequireDigit()
+	})
+}
+
+// SetRequireSymbol sets the "require_symbol" field.
+func (u *PasswordPolicyUpsertOne) SetRequireSymbol(v bool) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetRequireSymbol(v)
+	})
+}
+
+// UpdateRequireSymbol sets the "require_symbol" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateRequireSymbol() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateRequireSymbol()
+	})
+}
+
+// ClearRequireSymbol clears the value of the "require_symbol" field.
+func (u *PasswordPolicyUpsertOne) ClearRequireSymbol() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearRequireSymbol()
+	})
+}
+
+// SetHistoryCount sets the "history_count" field.
+func (u *PasswordPolicyUpsertOne) SetHistoryCount(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetHistoryCount(v)
+	})
+}
+
+// AddHistoryCount adds v to the "history_count" field.
+func (u *PasswordPolicyUpsertOne) AddHistoryCount(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddHistoryCount(v)
+	})
+}
+
+// UpdateHistoryCount sets the "history_count" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateHistoryCount() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateHistoryCount()
+	})
+}
+
+// ClearHistoryCount clears the value of the "history_count" field.
+func (u *PasswordPolicyUpsertOne) ClearHistoryCount() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearHistoryCount()
+	})
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (u *PasswordPolicyUpsertOne) SetMaxAgeDays(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetMaxAgeDays(v)
+	})
+}
+
+// AddMaxAgeDays adds v to the "max_age_days" field.
+func (u *PasswordPolicyUpsertOne) AddMaxAgeDays(v uint32) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddMaxAgeDays(v)
+	})
+}
+
+// UpdateMaxAgeDays sets the "max_age_days" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateMaxAgeDays() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateMaxAgeDays()
+	})
+}
+
+// ClearMaxAgeDays clears the value of the "max_age_days" field.
+func (u *PasswordPolicyUpsertOne) ClearMaxAgeDays() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearMaxAgeDays()
+	})
+}
+
+// SetRejectBreached sets the "reject_breached" field.
+func (u *PasswordPolicyUpsertOne) SetRejectBreached(v bool) *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetRejectBreached(v)
+	})
+}
+
+// UpdateRejectBreached sets the "reject_breached" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertOne) UpdateRejectBreached() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateRejectBreached()
+	})
+}
+
+// ClearRejectBreached clears the value of the "reject_breached" field.
+func (u *PasswordPolicyUpsertOne) ClearRejectBreached() *PasswordPolicyUpsertOne {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearRejectBreached()
+	})
+}
+
+// Exec executes the query.
+func (u *PasswordPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasswordPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasswordPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PasswordPolicyUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PasswordPolicyUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PasswordPolicyCreateBulk is the builder for creating many PasswordPolicy entities in bulk.
+type PasswordPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*PasswordPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PasswordPolicy entities in the database.
+func (_c *PasswordPolicyCreateBulk) Save(ctx context.Context) ([]*PasswordPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PasswordPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasswordPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PasswordPolicyCreateBulk) SaveX(ctx context.Context) []*PasswordPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PasswordPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PasswordPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PasswordPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasswordPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PasswordPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *PasswordPolicyUpsertBulk {
+	_c.conflict = opts
+	return &PasswordPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PasswordPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PasswordPolicyCreateBulk) OnConflictColumns(columns ...string) *PasswordPolicyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PasswordPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// PasswordPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of PasswordPolicy nodes.
+type PasswordPolicyUpsertBulk struct {
+	create *PasswordPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PasswordPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passwordpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasswordPolicyUpsertBulk) UpdateNewValues() *PasswordPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(passwordpolicy.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(passwordpolicy.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(passwordpolicy.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PasswordPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PasswordPolicyUpsertBulk) Ignore() *PasswordPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasswordPolicyUpsertBulk) DoNothing() *PasswordPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasswordPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *PasswordPolicyUpsertBulk) Update(set func(*PasswordPolicyUpsert)) *PasswordPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasswordPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasswordPolicyUpsertBulk) SetUpdatedAt(v time.Time) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateUpdatedAt() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *PasswordPolicyUpsertBulk) ClearUpdatedAt() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PasswordPolicyUpsertBulk) SetDeletedAt(v time.Time) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateDeletedAt() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PasswordPolicyUpsertBulk) ClearDeletedAt() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PasswordPolicyUpsertBulk) SetCreatedBy(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *PasswordPolicyUpsertBulk) AddCreatedBy(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateCreatedBy() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PasswordPolicyUpsertBulk) ClearCreatedBy() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *PasswordPolicyUpsertBulk) SetUpdatedBy(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *PasswordPolicyUpsertBulk) AddUpdatedBy(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateUpdatedBy() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *PasswordPolicyUpsertBulk) ClearUpdatedBy() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PasswordPolicyUpsertBulk) SetDeletedBy(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *PasswordPolicyUpsertBulk) AddDeletedBy(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateDeletedBy() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PasswordPolicyUpsertBulk) ClearDeletedBy() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMinLength sets the "min_length" field.
+func (u *PasswordPolicyUpsertBulk) SetMinLength(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetMinLength(v)
+	})
+}
+
+// AddMinLength adds v to the "min_length" field.
+func (u *PasswordPolicyUpsertBulk) AddMinLength(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddMinLength(v)
+	})
+}
+
+// UpdateMinLength sets the "min_length" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateMinLength() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateMinLength()
+	})
+}
+
+// ClearMinLength clears the value of the "min_length" field.
+func (u *PasswordPolicyUpsertBulk) ClearMinLength() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearMinLength()
+	})
+}
+
+// SetRequireUppercase sets the "require_uppercase" field.
+func (u *PasswordPolicyUpsertBulk) SetRequireUppercase(v bool) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetRequireUppercase(v)
+	})
+}
+
+// UpdateRequireUppercase sets the "require_uppercase" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateRequireUppercase() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateRequireUppercase()
+	})
+}
+
+// ClearRequireUppercase clears the value of the "require_uppercase" field.
+func (u *PasswordPolicyUpsertBulk) ClearRequireUppercase() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearRequireUppercase()
+	})
+}
+
+// SetRequireLowercase sets the "require_lowercase" field.
+func (u *PasswordPolicyUpsertBulk) SetRequireLowercase(v bool) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetRequireLowercase(v)
+	})
+}
+
+// UpdateRequireLowercase sets the "require_lowercase" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateRequireLowercase() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateRequireLowercase()
+	})
+}
+
+// ClearRequireLowercase clears the value of the "require_lowercase" field.
+func (u *PasswordPolicyUpsertBulk) ClearRequireLowercase() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearRequireLowercase()
+	})
+}
+
+// SetRequireDigit sets the "require_digit" field.
+func (u *PasswordPolicyUpsertBulk) SetRequireDigit(v bool) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetRequireDigit(v)
+	})
+}
+
+// UpdateRequireDigit sets the "require_digit" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateRequireDigit() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateRequireDigit()
+	})
+}
+
+// ClearRequireDigit clears the value of the "require_digit" field.
+func (u *PasswordPolicyUpsertBulk) ClearRequireDigit() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearRequireDigit()
+	})
+}
+
+// SetRequireSymbol sets the "require_symbol" field.
+func (u *PasswordPolicyUpsertBulk) SetRequireSymbol(v bool) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetRequireSymbol(v)
+	})
+}
+
+// UpdateRequireSymbol sets the "require_symbol" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateRequireSymbol() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateRequireSymbol()
+	})
+}
+
+// ClearRequireSymbol clears the value of the "require_symbol" field.
+func (u *PasswordPolicyUpsertBulk) ClearRequireSymbol() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearRequireSymbol()
+	})
+}
+
+// SetHistoryCount sets the "history_count" field.
+func (u *PasswordPolicyUpsertBulk) SetHistoryCount(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetHistoryCount(v)
+	})
+}
+
+// AddHistoryCount adds v to the "history_count" field.
+func (u *PasswordPolicyUpsertBulk) AddHistoryCount(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddHistoryCount(v)
+	})
+}
+
+// UpdateHistoryCount sets the "history_count" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateHistoryCount() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateHistoryCount()
+	})
+}
+
+// ClearHistoryCount clears the value of the "history_count" field.
+func (u *PasswordPolicyUpsertBulk) ClearHistoryCount() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearHistoryCount()
+	})
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (u *PasswordPolicyUpsertBulk) SetMaxAgeDays(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetMaxAgeDays(v)
+	})
+}
+
+// AddMaxAgeDays adds v to the "max_age_days" field.
+func (u *PasswordPolicyUpsertBulk) AddMaxAgeDays(v uint32) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.AddMaxAgeDays(v)
+	})
+}
+
+// UpdateMaxAgeDays sets the "max_age_days" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateMaxAgeDays() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateMaxAgeDays()
+	})
+}
+
+// ClearMaxAgeDays clears the value of the "max_age_days" field.
+func (u *PasswordPolicyUpsertBulk) ClearMaxAgeDays() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearMaxAgeDays()
+	})
+}
+
+// SetRejectBreached sets the "reject_breached" field.
+func (u *PasswordPolicyUpsertBulk) SetRejectBreached(v bool) *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.SetRejectBreached(v)
+	})
+}
+
+// UpdateRejectBreached sets the "reject_breached" field to the value that was provided on create.
+func (u *PasswordPolicyUpsertBulk) UpdateRejectBreached() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.UpdateRejectBreached()
+	})
+}
+
+// ClearRejectBreached clears the value of the "reject_breached" field.
+func (u *PasswordPolicyUpsertBulk) ClearRejectBreached() *PasswordPolicyUpsertBulk {
+	return u.Update(func(s *PasswordPolicyUpsert) {
+		s.ClearRejectBreached()
+	})
+}
+
+// Exec executes the query.
+func (u *PasswordPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PasswordPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasswordPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasswordPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -30,6 +30,9 @@ type DictTypeI18n func(*sql.Selector)
 // File is the predicate function for file builders.
 type File func(*sql.Selector)
 
+// FileShare is the predicate function for fileshare builders.
+type FileShare func(*sql.Selector)
+
 // InternalMessage is the predicate function for internalmessage builders.
 type InternalMessage func(*sql.Selector)
 
@@ -39,15 +42,27 @@ type InternalMessageCategory func(*sql.Selector)
 // InternalMessageRecipient is the predicate function for internalmessagerecipient builders.
 type InternalMessageRecipient func(*sql.Selector)
 
+// JwtSigningKey is the predicate function for jwtsigningkey builders.
+type JwtSigningKey func(*sql.Selector)
+
 // Language is the predicate function for language builders.
 type Language func(*sql.Selector)
 
+// LdapSource is the predicate function for ldapsource builders.
+type LdapSource func(*sql.Selector)
+
 // LoginAuditLog is the predicate function for loginauditlog builders.
 type LoginAuditLog func(*sql.Selector)
 
 // LoginPolicy is the predicate function for loginpolicy builders.
 type LoginPolicy func(*sql.Selector)
 
+// LuaScript is the predicate function for luascript builders.
+type LuaScript func(*sql.Selector)
+
+// LuaScriptVersion is the predicate function for luascriptversion builders.
+type LuaScriptVersion func(*sql.Selector)
+
 // Membership is the predicate function for membership builders.
 type Membership func(*sql.Selector)
 
@@ -63,12 +78,18 @@ type MembershipRole func(*sql.Selector)
 // Menu is the predicate function for menu builders.
 type Menu func(*sql.Selector)
 
+// OAuthClient is the predicate function for oauthclient builders.
+type OAuthClient func(*sql.Selector)
+
 // OperationAuditLog is the predicate function for operationauditlog builders.
 type OperationAuditLog func(*sql.Selector)
 
 // OrgUnit is the predicate function for orgunit builders.
 type OrgUnit func(*sql.Selector)
 
+// PasswordPolicy is the predicate function for passwordpolicy builders.
+type PasswordPolicy func(*sql.Selector)
+
 // Permission is the predicate function for permission builders.
 type Permission func(*sql.Selector)
 
@@ -93,6 +114,12 @@ type PolicyEvaluationLog func(*sql.Selector)
 // Position is the predicate function for position builders.
 type Position func(*sql.Selector)
 
+// RegistrationInvite is the predicate function for registrationinvite builders.
+type RegistrationInvite func(*sql.Selector)
+
+// RegistrationPolicy is the predicate function for registrationpolicy builders.
+type RegistrationPolicy func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
@@ -102,9 +129,18 @@ type RoleMetadata func(*sql.Selector)
 // RolePermission is the predicate function for rolepermission builders.
 type RolePermission func(*sql.Selector)
 
+// ScimToken is the predicate function for scimtoken builders.
+type ScimToken func(*sql.Selector)
+
+// StorageQuota is the predicate function for storagequota builders.
+type StorageQuota func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
+// TaskRun is the predicate function for taskrun builders.
+type TaskRun func(*sql.Selector)
+
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
@@ -117,6 +153,9 @@ type UserCredential func(*sql.Selector)
 // UserOrgUnit is the predicate function for userorgunit builders.
 type UserOrgUnit func(*sql.Selector)
 
+// UserPasswordHistory is the predicate function for userpasswordhistory builders.
+type UserPasswordHistory func(*sql.Selector)
+
 // UserPosition is the predicate function for userposition builders.
 type UserPosition func(*sql.Selector)
 
@@ -303,6 +303,30 @@ func (f FileMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileMutation", m)
 }
 
+// The FileShareQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FileShareQueryRuleFunc func(context.Context, *ent.FileShareQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FileShareQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileShareQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FileShareQuery", q)
+}
+
+// The FileShareMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FileShareMutationRuleFunc func(context.Context, *ent.FileShareMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FileShareMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FileShareMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileShareMutation", m)
+}
+
 // The InternalMessageQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type InternalMessageQueryRuleFunc func(context.Context, *ent.InternalMessageQuery) error
@@ -375,6 +399,30 @@ func (f InternalMessageRecipientMutationRuleFunc) EvalMutation(ctx context.Conte
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.InternalMessageRecipientMutation", m)
 }
 
+// The JwtSigningKeyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type JwtSigningKeyQueryRuleFunc func(context.Context, *ent.JwtSigningKeyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f JwtSigningKeyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.JwtSigningKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.JwtSigningKeyQuery", q)
+}
+
+// The JwtSigningKeyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type JwtSigningKeyMutationRuleFunc func(context.Context, *ent.JwtSigningKeyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f JwtSigningKeyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.JwtSigningKeyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.JwtSigningKeyMutation", m)
+}
+
 // The LanguageQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type LanguageQueryRuleFunc func(context.Context, *ent.LanguageQuery) error
@@ -399,6 +447,30 @@ func (f LanguageMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutati
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LanguageMutation", m)
 }
 
+// The LdapSourceQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LdapSourceQueryRuleFunc func(context.Context, *ent.LdapSourceQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LdapSourceQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LdapSourceQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LdapSourceQuery", q)
+}
+
+// The LdapSourceMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LdapSourceMutationRuleFunc func(context.Context, *ent.LdapSourceMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LdapSourceMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LdapSourceMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LdapSourceMutation", m)
+}
+
 // The LoginAuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type LoginAuditLogQueryRuleFunc func(context.Context, *ent.LoginAuditLogQuery) error
@@ -447,6 +519,54 @@ func (f LoginPolicyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mut
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LoginPolicyMutation", m)
 }
 
+// The LuaScriptQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LuaScriptQueryRuleFunc func(context.Context, *ent.LuaScriptQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LuaScriptQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LuaScriptQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LuaScriptQuery", q)
+}
+
+// The LuaScriptMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LuaScriptMutationRuleFunc func(context.Context, *ent.LuaScriptMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LuaScriptMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LuaScriptMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LuaScriptMutation", m)
+}
+
+// The LuaScriptVersionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LuaScriptVersionQueryRuleFunc func(context.Context, *ent.LuaScriptVersionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LuaScriptVersionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LuaScriptVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LuaScriptVersionQuery", q)
+}
+
+// The LuaScriptVersionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LuaScriptVersionMutationRuleFunc func(context.Context, *ent.LuaScriptVersionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LuaScriptVersionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LuaScriptVersionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LuaScriptVersionMutation", m)
+}
+
 // The MembershipQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type MembershipQueryRuleFunc func(context.Context, *ent.MembershipQuery) error
@@ -567,6 +687,30 @@ func (f MenuMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MenuMutation", m)
 }
 
+// The OAuthClientQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OAuthClientQueryRuleFunc func(context.Context, *ent.OAuthClientQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OAuthClientQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OAuthClientQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OAuthClientQuery", q)
+}
+
+// The OAuthClientMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OAuthClientMutationRuleFunc func(context.Context, *ent.OAuthClientMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OAuthClientMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OAuthClientMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OAuthClientMutation", m)
+}
+
 // The OperationAuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type OperationAuditLogQueryRuleFunc func(context.Context, *ent.OperationAuditLogQuery) error
@@ -615,6 +759,30 @@ func (f OrgUnitMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OrgUnitMutation", m)
 }
 
+// The PasswordPolicyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PasswordPolicyQueryRuleFunc func(context.Context, *ent.PasswordPolicyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PasswordPolicyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PasswordPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PasswordPolicyQuery", q)
+}
+
+// The PasswordPolicyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PasswordPolicyMutationRuleFunc func(context.Context, *ent.PasswordPolicyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PasswordPolicyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PasswordPolicyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PasswordPolicyMutation", m)
+}
+
 // The PermissionQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type PermissionQueryRuleFunc func(context.Context, *ent.PermissionQuery) error
@@ -807,6 +975,54 @@ func (f PositionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutati
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PositionMutation", m)
 }
 
+// The RegistrationInviteQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RegistrationInviteQueryRuleFunc func(context.Context, *ent.RegistrationInviteQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RegistrationInviteQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RegistrationInviteQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RegistrationInviteQuery", q)
+}
+
+// The RegistrationInviteMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RegistrationInviteMutationRuleFunc func(context.Context, *ent.RegistrationInviteMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RegistrationInviteMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RegistrationInviteMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RegistrationInviteMutation", m)
+}
+
+// The RegistrationPolicyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RegistrationPolicyQueryRuleFunc func(context.Context, *ent.RegistrationPolicyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RegistrationPolicyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RegistrationPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RegistrationPolicyQuery", q)
+}
+
+// The RegistrationPolicyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RegistrationPolicyMutationRuleFunc func(context.Context, *ent.RegistrationPolicyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RegistrationPolicyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RegistrationPolicyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RegistrationPolicyMutation", m)
+}
+
 // The RoleQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type RoleQueryRuleFunc func(context.Context, *ent.RoleQuery) error
@@ -879,6 +1095,54 @@ func (f RolePermissionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RolePermissionMutation", m)
 }
 
+// The ScimTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ScimTokenQueryRuleFunc func(context.Context, *ent.ScimTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ScimTokenQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ScimTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ScimTokenQuery", q)
+}
+
+// The ScimTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ScimTokenMutationRuleFunc func(context.Context, *ent.ScimTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ScimTokenMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ScimTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ScimTokenMutation", m)
+}
+
+// The StorageQuotaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type StorageQuotaQueryRuleFunc func(context.Context, *ent.StorageQuotaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f StorageQuotaQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.StorageQuotaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.StorageQuotaQuery", q)
+}
+
+// The StorageQuotaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type StorageQuotaMutationRuleFunc func(context.Context, *ent.StorageQuotaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f StorageQuotaMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.StorageQuotaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.StorageQuotaMutation", m)
+}
+
 // The TaskQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type TaskQueryRuleFunc func(context.Context, *ent.TaskQuery) error
@@ -903,6 +1167,30 @@ func (f TaskMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TaskMutation", m)
 }
 
+// The TaskRunQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TaskRunQueryRuleFunc func(context.Context, *ent.TaskRunQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TaskRunQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TaskRunQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TaskRunQuery", q)
+}
+
+// The TaskRunMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TaskRunMutationRuleFunc func(context.Context, *ent.TaskRunMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TaskRunMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TaskRunMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TaskRunMutation", m)
+}
+
 // The TenantQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type TenantQueryRuleFunc func(context.Context, *ent.TenantQuery) error
@@ -999,6 +1287,30 @@ func (f UserOrgUnitMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mut
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserOrgUnitMutation", m)
 }
 
+// The UserPasswordHistoryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserPasswordHistoryQueryRuleFunc func(context.Context, *ent.UserPasswordHistoryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserPasswordHistoryQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserPasswordHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserPasswordHistoryQuery", q)
+}
+
+// The UserPasswordHistoryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserPasswordHistoryMutationRuleFunc func(context.Context, *ent.UserPasswordHistoryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserPasswordHistoryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserPasswordHistoryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserPasswordHistoryMutation", m)
+}
+
 // The UserPositionQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserPositionQueryRuleFunc func(context.Context, *ent.UserPositionQuery) error
@@ -1098,18 +1410,28 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.FileQuery:
 		return q.Filter(), nil
+	case *ent.FileShareQuery:
+		return q.Filter(), nil
 	case *ent.InternalMessageQuery:
 		return q.Filter(), nil
 	case *ent.InternalMessageCategoryQuery:
 		return q.Filter(), nil
 	case *ent.InternalMessageRecipientQuery:
 		return q.Filter(), nil
+	case *ent.JwtSigningKeyQuery:
+		return q.Filter(), nil
 	case *ent.LanguageQuery:
 		return q.Filter(), nil
+	case *ent.LdapSourceQuery:
+		return q.Filter(), nil
 	case *ent.LoginAuditLogQuery:
 		return q.Filter(), nil
 	case *ent.LoginPolicyQuery:
 		return q.Filter(), nil
+	case *ent.LuaScriptQuery:
+		return q.Filter(), nil
+	case *ent.LuaScriptVersionQuery:
+		return q.Filter(), nil
 	case *ent.MembershipQuery:
 		return q.Filter(), nil
 	case *ent.MembershipOrgUnitQuery:
@@ -1120,10 +1442,14 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.MenuQuery:
 		return q.Filter(), nil
+	case *ent.OAuthClientQuery:
+		return q.Filter(), nil
 	case *ent.OperationAuditLogQuery:
 		return q.Filter(), nil
 	case *ent.OrgUnitQuery:
 		return q.Filter(), nil
+	case *ent.PasswordPolicyQuery:
+		return q.Filter(), nil
 	case *ent.PermissionQuery:
 		return q.Filter(), nil
 	case *ent.PermissionApiQuery:
@@ -1140,14 +1466,24 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.PositionQuery:
 		return q.Filter(), nil
+	case *ent.RegistrationInviteQuery:
+		return q.Filter(), nil
+	case *ent.RegistrationPolicyQuery:
+		return q.Filter(), nil
 	case *ent.RoleQuery:
 		return q.Filter(), nil
 	case *ent.RoleMetadataQuery:
 		return q.Filter(), nil
 	case *ent.RolePermissionQuery:
 		return q.Filter(), nil
+	case *ent.ScimTokenQuery:
+		return q.Filter(), nil
+	case *ent.StorageQuotaQuery:
+		return q.Filter(), nil
 	case *ent.TaskQuery:
 		return q.Filter(), nil
+	case *ent.TaskRunQuery:
+		return q.Filter(), nil
 	case *ent.TenantQuery:
 		return q.Filter(), nil
 	case *ent.UserQuery:
@@ -1156,6 +1492,8 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.UserOrgUnitQuery:
 		return q.Filter(), nil
+	case *ent.UserPasswordHistoryQuery:
+		return q.Filter(), nil
 	case *ent.UserPositionQuery:
 		return q.Filter(), nil
 	case *ent.UserRoleQuery:
@@ -1183,18 +1521,28 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.FileMutation:
 		return m.Filter(), nil
+	case *ent.FileShareMutation:
+		return m.Filter(), nil
 	case *ent.InternalMessageMutation:
 		return m.Filter(), nil
 	case *ent.InternalMessageCategoryMutation:
 		return m.Filter(), nil
 	case *ent.InternalMessageRecipientMutation:
 		return m.Filter(), nil
+	case *ent.JwtSigningKeyMutation:
+		return m.Filter(), nil
 	case *ent.LanguageMutation:
 		return m.Filter(), nil
+	case *ent.LdapSourceMutation:
+		return m.Filter(), nil
 	case *ent.LoginAuditLogMutation:
 		return m.Filter(), nil
 	case *ent.LoginPolicyMutation:
 		return m.Filter(), nil
+	case *ent.LuaScriptMutation:
+		return m.Filter(), nil
+	case *ent.LuaScriptVersionMutation:
+		return m.Filter(), nil
 	case *ent.MembershipMutation:
 		return m.Filter(), nil
 	case *ent.MembershipOrgUnitMutation:
@@ -1205,10 +1553,14 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.MenuMutation:
 		return m.Filter(), nil
+	case *ent.OAuthClientMutation:
+		return m.Filter(), nil
 	case *ent.OperationAuditLogMutation:
 		return m.Filter(), nil
 	case *ent.OrgUnitMutation:
 		return m.Filter(), nil
+	case *ent.PasswordPolicyMutation:
+		return m.Filter(), nil
 	case *ent.PermissionMutation:
 		return m.Filter(), nil
 	case *ent.PermissionApiMutation:
@@ -1225,14 +1577,24 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.PositionMutation:
 		return m.Filter(), nil
+	case *ent.RegistrationInviteMutation:
+		return m.Filter(), nil
+	case *ent.RegistrationPolicyMutation:
+		return m.Filter(), nil
 	case *ent.RoleMutation:
 		return m.Filter(), nil
 	case *ent.RoleMetadataMutation:
 		return m.Filter(), nil
 	case *ent.RolePermissionMutation:
 		return m.Filter(), nil
+	case *ent.ScimTokenMutation:
+		return m.Filter(), nil
+	case *ent.StorageQuotaMutation:
+		return m.Filter(), nil
 	case *ent.TaskMutation:
 		return m.Filter(), nil
+	case *ent.TaskRunMutation:
+		return m.Filter(), nil
 	case *ent.TenantMutation:
 		return m.Filter(), nil
 	case *ent.UserMutation:
@@ -1241,6 +1603,8 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.UserOrgUnitMutation:
 		return m.Filter(), nil
+	case *ent.UserPasswordHistoryMutation:
+		return m.Filter(), nil
 	case *ent.UserPositionMutation:
 		return m.Filter(), nil
 	case *ent.UserRoleMutation:
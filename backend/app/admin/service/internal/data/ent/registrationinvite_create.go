@@ -0,0 +1,1191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationinvite"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RegistrationInviteCreate is the builder for creating a RegistrationInvite entity.
+type RegistrationInviteCreate struct {
+	config
+	mutation *RegistrationInviteMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RegistrationInviteCreate) SetCreatedAt(v time.Time) *RegistrationInviteCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RegistrationInviteCreate) SetNillableCreatedAt(v *time.Time) *RegistrationInviteCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RegistrationInviteCreate) SetUpdatedAt(v time.Time) *RegistrationInviteCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RegistrationInviteCreate) SetNillableUpdatedAt(v *time.Time) *RegistrationInviteCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *RegistrationInviteCreate) SetDeletedAt(v time.Time) *RegistrationInviteCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *RegistrationInviteCreate) SetNillableDeletedAt(v *time.Time) *RegistrationInviteCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *RegistrationInviteCreate) SetCreatedBy(v uint32) *RegistrationInviteCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *RegistrationInviteCreate) SetNillableCreatedBy(v *uint32) *RegistrationInviteCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *RegistrationInviteCreate) SetUpdatedBy(v uint32) *RegistrationInviteCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *RegistrationInviteCreate) SetNillableUpdatedBy(v *uint32) *RegistrationInviteCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *RegistrationInviteCreate) SetDeletedBy(v uint32) *RegistrationInviteCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *RegistrationInviteCreate) SetNillableDeletedBy(v *uint32) *RegistrationInviteCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *RegistrationInviteCreate) SetTenantID(v uint32) *RegistrationInviteCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *RegistrationInviteCreate) SetNillableTenantID(v *uint32) *RegistrationInviteCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_c *RegistrationInviteCreate) SetCodeHash(v string) *RegistrationInviteCreate {
+	_c.mutation.SetCodeHash(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *RegistrationInviteCreate) SetEmail(v string) *RegistrationInviteCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *RegistrationInviteCreate) SetNillableEmail(v *string) *RegistrationInviteCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *RegistrationInviteCreate) SetExpiresAt(v time.Time) *RegistrationInviteCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *RegistrationInviteCreate) SetUsedAt(v time.Time) *RegistrationInviteCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *RegistrationInviteCreate) SetNillableUsedAt(v *time.Time) *RegistrationInviteCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetUsedBy sets the "used_by" field.
+func (_c *RegistrationInviteCreate) SetUsedBy(v uint32) *RegistrationInviteCreate {
+	_c.mutation.SetUsedBy(v)
+	return _c
+}
+
+// SetNillableUsedBy sets the "used_by" field if the given value is not nil.
+func (_c *RegistrationInviteCreate) SetNillableUsedBy(v *uint32) *RegistrationInviteCreate {
+	if v != nil {
+		_c.SetUsedBy(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RegistrationInviteCreate) SetID(v uint32) *RegistrationInviteCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the RegistrationInviteMutation object of the builder.
+func (_c *RegistrationInviteCreate) Mutation() *RegistrationInviteMutation {
+	return _c.mutation
+}
+
+// Save creates the RegistrationInvite in the database.
+func (_c *RegistrationInviteCreate) Save(ctx context.Context) (*RegistrationInvite, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RegistrationInviteCreate) SaveX(ctx context.Context) *RegistrationInvite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RegistrationInviteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RegistrationInviteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RegistrationInviteCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := registrationinvite.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RegistrationInviteCreate) check() error {
+	if _, ok := _c.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "RegistrationInvite.code_hash"`)}
+	}
+	if v, ok := _c.mutation.CodeHash(); ok {
+		if err := registrationinvite.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "RegistrationInvite.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "RegistrationInvite.expires_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := registrationinvite.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RegistrationInvite.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *RegistrationInviteCreate) sqlSave(ctx context.Context) (*RegistrationInvite, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RegistrationInviteCreate) createSpec() (*RegistrationInvite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RegistrationInvite{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(registrationinvite.Table, sqlgraph.NewFieldSpec(registrationinvite.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(registrationinvite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationinvite.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(registrationinvite.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(registrationinvite.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(registrationinvite.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(registrationinvite.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(registrationinvite.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.CodeHash(); ok {
+		_spec.SetField(registrationinvite.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(registrationinvite.FieldEmail, field.TypeString, value)
+		_node.Email = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(registrationinvite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(registrationinvite.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.UsedBy(); ok {
+		_spec.SetField(registrationinvite.FieldUsedBy, field.TypeUint32, value)
+		_node.UsedBy = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RegistrationInvite.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RegistrationInviteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RegistrationInviteCreate) OnConflict(opts ...sql.ConflictOption) *RegistrationInviteUpsertOne {
+	_c.conflict = opts
+	return &RegistrationInviteUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RegistrationInvite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RegistrationInviteCreate) OnConflictColumns(columns ...string) *RegistrationInviteUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RegistrationInviteUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RegistrationInviteUpsertOne is the builder for "upsert"-ing
+	//  one RegistrationInvite node.
+	RegistrationInviteUpsertOne struct {
+		create *RegistrationInviteCreate
+	}
+
+	// RegistrationInviteUpsert is the "OnConflict" setter.
+	RegistrationInviteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RegistrationInviteUpsert) SetUpdatedAt(v time.Time) *RegistrationInviteUpsert {
+	u.Set(registrationinvite.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RegistrationInviteUpsert) UpdateUpdatedAt() *RegistrationInviteUpsert {
+	u.SetExcluded(registrationinvite.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *RegistrationInviteUpsert) ClearUpdatedAt() *RegistrationInviteUpsert {
+	u.SetNull(registrationinvite.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RegistrationInviteUpsert) SetDeletedAt(v time.Time) *RegistrationInviteUpsert {
+	u.Set(registrationinvite.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RegistrationInviteUpsert) UpdateDeletedAt() *RegistrationInviteUpsert {
+	u.SetExcluded(registrationinvite.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RegistrationInviteUpsert) ClearDeletedAt() *RegistrationInviteUpsert {
+	u.SetNull(registrationinvite.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RegistrationInviteUpsert) SetCreatedBy(v uint32) *RegistrationInviteUpsert {
+	u.Set(registrationinvite.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsert) UpdateCreatedBy() *RegistrationInviteUpsert {
+	u.SetExcluded(registrationinvite.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RegistrationInviteUpsert) AddCreatedBy(v uint32) *RegistrationInviteUpsert {
+	u.Add(registrationinvite.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RegistrationInviteUpsert) ClearCreatedBy() *RegistrationInviteUpsert {
+	u.SetNull(registrationinvite.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *RegistrationInviteUpsert) SetUpdatedBy(v uint32) *RegistrationInviteUpsert {
+	u.Set(registrationinvite.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsert) UpdateUpdatedBy() *RegistrationInviteUpsert {
+	u.SetExcluded(registrationinvite.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *RegistrationInviteUpsert) AddUpdatedBy(v uint32) *RegistrationInviteUpsert {
+	u.Add(registrationinvite.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *RegistrationInviteUpsert) ClearUpdatedBy() *RegistrationInviteUpsert {
+	u.SetNull(registrationinvite.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *RegistrationInviteUpsert) SetDeletedBy(v uint32) *RegistrationInviteUpsert {
+	u.Set(registrationinvite.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsert) UpdateDeletedBy() *RegistrationInviteUpsert {
+	u.SetExcluded(registrationinvite.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *RegistrationInviteUpsert) AddDeletedBy(v uint32) *RegistrationInviteUpsert {
+	u.Add(registrationinvite.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *RegistrationInviteUpsert) ClearDeletedBy() *RegistrationInviteUpsert {
+	u.SetNull(registrationinvite.FieldDeletedBy)
+	return u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *RegistrationInviteUpsert) SetUsedAt(v time.Time) *RegistrationInviteUpsert {
+	u.Set(registrationinvite.FieldUsedAt, v)
+	return u
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *RegistrationInviteUpsert) UpdateUsedAt() *RegistrationInviteUpsert {
+	u.SetExcluded(registrationinvite.FieldUsedAt)
+	return u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *RegistrationInviteUpsert) ClearUsedAt() *RegistrationInviteUpsert {
+	u.SetNull(registrationinvite.FieldUsedAt)
+	return u
+}
+
+// SetUsedBy sets the "used_by" field.
+func (u *RegistrationInviteUpsert) SetUsedBy(v uint32) *RegistrationInviteUpsert {
+	u.Set(registrationinvite.FieldUsedBy, v)
+	return u
+}
+
+// UpdateUsedBy sets the "used_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsert) UpdateUsedBy() *RegistrationInviteUpsert {
+	u.SetExcluded(registrationinvite.FieldUsedBy)
+	return u
+}
+
+// AddUsedBy adds v to the "used_by" field.
+func (u *RegistrationInviteUpsert) AddUsedBy(v uint32) *RegistrationInviteUpsert {
+	u.Add(registrationinvite.FieldUsedBy, v)
+	return u
+}
+
+// ClearUsedBy clears the value of the "used_by" field.
+func (u *RegistrationInviteUpsert) ClearUsedBy() *RegistrationInviteUpsert {
+	u.SetNull(registrationinvite.FieldUsedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RegistrationInvite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(registrationinvite.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RegistrationInviteUpsertOne) UpdateNewValues() *RegistrationInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(registrationinvite.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(registrationinvite.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(registrationinvite.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.CodeHash(); exists {
+			s.SetIgnore(registrationinvite.FieldCodeHash)
+		}
+		if _, exists := u.create.mutation.Email(); exists {
+			s.SetIgnore(registrationinvite.FieldEmail)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(registrationinvite.FieldExpiresAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RegistrationInvite.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RegistrationInviteUpsertOne) Ignore() *RegistrationInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RegistrationInviteUpsertOne) DoNothing() *RegistrationInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RegistrationInviteCreate.OnConflict
+// documentation for more info.
+func (u *RegistrationInviteUpsertOne) Update(set func(*RegistrationInviteUpsert)) *RegistrationInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RegistrationInviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RegistrationInviteUpsertOne) SetUpdatedAt(v time.Time) *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertOne) UpdateUpdatedAt() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *RegistrationInviteUpsertOne) ClearUpdatedAt() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RegistrationInviteUpsertOne) SetDeletedAt(v time.Time) *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertOne) UpdateDeletedAt() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RegistrationInviteUpsertOne) ClearDeletedAt() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RegistrationInviteUpsertOne) SetCreatedBy(v uint32) *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RegistrationInviteUpsertOne) AddCreatedBy(v uint32) *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertOne) UpdateCreatedBy() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RegistrationInviteUpsertOne) ClearCreatedBy() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *RegistrationInviteUpsertOne) SetUpdatedBy(v uint32) *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *RegistrationInviteUpsertOne) AddUpdatedBy(v uint32) *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertOne) UpdateUpdatedBy() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *RegistrationInviteUpsertOne) ClearUpdatedBy() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *RegistrationInviteUpsertOne) SetDeletedBy(v uint32) *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *RegistrationInviteUpsertOne) AddDeletedBy(v uint32) *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertOne) UpdateDeletedBy() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *RegistrationInviteUpsertOne) ClearDeletedBy() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *RegistrationInviteUpsertOne) SetUsedAt(v time.Time) *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertOne) UpdateUsedAt() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *RegistrationInviteUpsertOne) ClearUsedAt() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// SetUsedBy sets the "used_by" field.
+func (u *RegistrationInviteUpsertOne) SetUsedBy(v uint32) *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetUsedBy(v)
+	})
+}
+
+// AddUsedBy adds v to the "used_by" field.
+func (u *RegistrationInviteUpsertOne) AddUsedBy(v uint32) *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.AddUsedBy(v)
+	})
+}
+
+// UpdateUsedBy sets the "used_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertOne) UpdateUsedBy() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateUsedBy()
+	})
+}
+
+// ClearUsedBy clears the value of the "used_by" field.
+func (u *RegistrationInviteUpsertOne) ClearUsedBy() *RegistrationInviteUpsertOne {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearUsedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *RegistrationInviteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RegistrationInviteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RegistrationInviteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RegistrationInviteUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RegistrationInviteUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RegistrationInviteCreateBulk is the builder for creating many RegistrationInvite entities in bulk.
+type RegistrationInviteCreateBulk struct {
+	config
+	err      error
+	builders []*RegistrationInviteCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RegistrationInvite entities in the database.
+func (_c *RegistrationInviteCreateBulk) Save(ctx context.Context) ([]*RegistrationInvite, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RegistrationInvite, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RegistrationInviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RegistrationInviteCreateBulk) SaveX(ctx context.Context) []*RegistrationInvite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RegistrationInviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RegistrationInviteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RegistrationInvite.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RegistrationInviteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RegistrationInviteCreateBulk) OnConflict(opts ...sql.ConflictOption) *RegistrationInviteUpsertBulk {
+	_c.conflict = opts
+	return &RegistrationInviteUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RegistrationInvite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RegistrationInviteCreateBulk) OnConflictColumns(columns ...string) *RegistrationInviteUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RegistrationInviteUpsertBulk{
+		create: _c,
+	}
+}
+
+// RegistrationInviteUpsertBulk is the builder for "upsert"-ing
+// a bulk of RegistrationInvite nodes.
+type RegistrationInviteUpsertBulk struct {
+	create *RegistrationInviteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RegistrationInvite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(registrationinvite.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RegistrationInviteUpsertBulk) UpdateNewValues() *RegistrationInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(registrationinvite.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(registrationinvite.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(registrationinvite.FieldTenantID)
+			}
+			if _, exists := b.mutation.CodeHash(); exists {
+				s.SetIgnore(registrationinvite.FieldCodeHash)
+			}
+			if _, exists := b.mutation.Email(); exists {
+				s.SetIgnore(registrationinvite.FieldEmail)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(registrationinvite.FieldExpiresAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RegistrationInvite.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RegistrationInviteUpsertBulk) Ignore() *RegistrationInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RegistrationInviteUpsertBulk) DoNothing() *RegistrationInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RegistrationInviteCreateBulk.OnConflict
+// documentation for more info.
+func (u *RegistrationInviteUpsertBulk) Update(set func(*RegistrationInviteUpsert)) *RegistrationInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RegistrationInviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RegistrationInviteUpsertBulk) SetUpdatedAt(v time.Time) *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertBulk) UpdateUpdatedAt() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *RegistrationInviteUpsertBulk) ClearUpdatedAt() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RegistrationInviteUpsertBulk) SetDeletedAt(v time.Time) *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertBulk) UpdateDeletedAt() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RegistrationInviteUpsertBulk) ClearDeletedAt() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RegistrationInviteUpsertBulk) SetCreatedBy(v uint32) *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RegistrationInviteUpsertBulk) AddCreatedBy(v uint32) *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertBulk) UpdateCreatedBy() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RegistrationInviteUpsertBulk) ClearCreatedBy() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *RegistrationInviteUpsertBulk) SetUpdatedBy(v uint32) *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *RegistrationInviteUpsertBulk) AddUpdatedBy(v uint32) *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertBulk) UpdateUpdatedBy() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *RegistrationInviteUpsertBulk) ClearUpdatedBy() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *RegistrationInviteUpsertBulk) SetDeletedBy(v uint32) *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *RegistrationInviteUpsertBulk) AddDeletedBy(v uint32) *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertBulk) UpdateDeletedBy() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *RegistrationInviteUpsertBulk) ClearDeletedBy() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *RegistrationInviteUpsertBulk) SetUsedAt(v time.Time) *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertBulk) UpdateUsedAt() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *RegistrationInviteUpsertBulk) ClearUsedAt() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// SetUsedBy sets the "used_by" field.
+func (u *RegistrationInviteUpsertBulk) SetUsedBy(v uint32) *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.SetUsedBy(v)
+	})
+}
+
+// AddUsedBy adds v to the "used_by" field.
+func (u *RegistrationInviteUpsertBulk) AddUsedBy(v uint32) *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.AddUsedBy(v)
+	})
+}
+
+// UpdateUsedBy sets the "used_by" field to the value that was provided on create.
+func (u *RegistrationInviteUpsertBulk) UpdateUsedBy() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.UpdateUsedBy()
+	})
+}
+
+// ClearUsedBy clears the value of the "used_by" field.
+func (u *RegistrationInviteUpsertBulk) ClearUsedBy() *RegistrationInviteUpsertBulk {
+	return u.Update(func(s *RegistrationInviteUpsert) {
+		s.ClearUsedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *RegistrationInviteUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RegistrationInviteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RegistrationInviteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RegistrationInviteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
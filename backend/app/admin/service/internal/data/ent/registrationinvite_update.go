@@ -0,0 +1,654 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationinvite"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RegistrationInviteUpdate is the builder for updating RegistrationInvite entities.
+type RegistrationInviteUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RegistrationInviteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RegistrationInviteUpdate builder.
+func (_u *RegistrationInviteUpdate) Where(ps ...predicate.RegistrationInvite) *RegistrationInviteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RegistrationInviteUpdate) SetUpdatedAt(v time.Time) *RegistrationInviteUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *RegistrationInviteUpdate) SetNillableUpdatedAt(v *time.Time) *RegistrationInviteUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *RegistrationInviteUpdate) ClearUpdatedAt() *RegistrationInviteUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *RegistrationInviteUpdate) SetDeletedAt(v time.Time) *RegistrationInviteUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *RegistrationInviteUpdate) SetNillableDeletedAt(v *time.Time) *RegistrationInviteUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *RegistrationInviteUpdate) ClearDeletedAt() *RegistrationInviteUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *RegistrationInviteUpdate) SetCreatedBy(v uint32) *RegistrationInviteUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *RegistrationInviteUpdate) SetNillableCreatedBy(v *uint32) *RegistrationInviteUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *RegistrationInviteUpdate) AddCreatedBy(v int32) *RegistrationInviteUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *RegistrationInviteUpdate) ClearCreatedBy() *RegistrationInviteUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *RegistrationInviteUpdate) SetUpdatedBy(v uint32) *RegistrationInviteUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *RegistrationInviteUpdate) SetNillableUpdatedBy(v *uint32) *RegistrationInviteUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *RegistrationInviteUpdate) AddUpdatedBy(v int32) *RegistrationInviteUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *RegistrationInviteUpdate) ClearUpdatedBy() *RegistrationInviteUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *RegistrationInviteUpdate) SetDeletedBy(v uint32) *RegistrationInviteUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *RegistrationInviteUpdate) SetNillableDeletedBy(v *uint32) *RegistrationInviteUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *RegistrationInviteUpdate) AddDeletedBy(v int32) *RegistrationInviteUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *RegistrationInviteUpdate) ClearDeletedBy() *RegistrationInviteUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *RegistrationInviteUpdate) SetUsedAt(v time.Time) *RegistrationInviteUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *RegistrationInviteUpdate) SetNillableUsedAt(v *time.Time) *RegistrationInviteUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *RegistrationInviteUpdate) ClearUsedAt() *RegistrationInviteUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetUsedBy sets the "used_by" field.
+func (_u *RegistrationInviteUpdate) SetUsedBy(v uint32) *RegistrationInviteUpdate {
+	_u.mutation.ResetUsedBy()
+	_u.mutation.SetUsedBy(v)
+	return _u
+}
+
+// SetNillableUsedBy sets the "used_by" field if the given value is not nil.
+func (_u *RegistrationInviteUpdate) SetNillableUsedBy(v *uint32) *RegistrationInviteUpdate {
+	if v != nil {
+		_u.SetUsedBy(*v)
+	}
+	return _u
+}
+
+// AddUsedBy adds value to the "used_by" field.
+func (_u *RegistrationInviteUpdate) AddUsedBy(v int32) *RegistrationInviteUpdate {
+	_u.mutation.AddUsedBy(v)
+	return _u
+}
+
+// ClearUsedBy clears the value of the "used_by" field.
+func (_u *RegistrationInviteUpdate) ClearUsedBy() *RegistrationInviteUpdate {
+	_u.mutation.ClearUsedBy()
+	return _u
+}
+
+// Mutation returns the RegistrationInviteMutation object of the builder.
+func (_u *RegistrationInviteUpdate) Mutation() *RegistrationInviteMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RegistrationInviteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RegistrationInviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RegistrationInviteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RegistrationInviteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RegistrationInviteUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RegistrationInviteUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RegistrationInviteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(registrationinvite.Table, registrationinvite.Columns, sqlgraph.NewFieldSpec(registrationinvite.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(registrationinvite.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationinvite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(registrationinvite.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(registrationinvite.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(registrationinvite.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(registrationinvite.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(registrationinvite.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(registrationinvite.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(registrationinvite.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(registrationinvite.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(registrationinvite.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(registrationinvite.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(registrationinvite.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(registrationinvite.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(registrationinvite.FieldTenantID, field.TypeUint32)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(registrationinvite.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(registrationinvite.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(registrationinvite.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UsedBy(); ok {
+		_spec.SetField(registrationinvite.FieldUsedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUsedBy(); ok {
+		_spec.AddField(registrationinvite.FieldUsedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UsedByCleared() {
+		_spec.ClearField(registrationinvite.FieldUsedBy, field.TypeUint32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registrationinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RegistrationInviteUpdateOne is the builder for updating a single RegistrationInvite entity.
+type RegistrationInviteUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RegistrationInviteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RegistrationInviteUpdateOne) SetUpdatedAt(v time.Time) *RegistrationInviteUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *RegistrationInviteUpdateOne) SetNillableUpdatedAt(v *time.Time) *RegistrationInviteUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *RegistrationInviteUpdateOne) ClearUpdatedAt() *RegistrationInviteUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *RegistrationInviteUpdateOne) SetDeletedAt(v time.Time) *RegistrationInviteUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *RegistrationInviteUpdateOne) SetNillableDeletedAt(v *time.Time) *RegistrationInviteUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *RegistrationInviteUpdateOne) ClearDeletedAt() *RegistrationInviteUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *RegistrationInviteUpdateOne) SetCreatedBy(v uint32) *RegistrationInviteUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *RegistrationInviteUpdateOne) SetNillableCreatedBy(v *uint32) *RegistrationInviteUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *RegistrationInviteUpdateOne) AddCreatedBy(v int32) *RegistrationInviteUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *RegistrationInviteUpdateOne) ClearCreatedBy() *RegistrationInviteUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *RegistrationInviteUpdateOne) SetUpdatedBy(v uint32) *RegistrationInviteUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *RegistrationInviteUpdateOne) SetNillableUpdatedBy(v *uint32) *RegistrationInviteUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *RegistrationInviteUpdateOne) AddUpdatedBy(v int32) *RegistrationInviteUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *RegistrationInviteUpdateOne) ClearUpdatedBy() *RegistrationInviteUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *RegistrationInviteUpdateOne) SetDeletedBy(v uint32) *RegistrationInviteUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *RegistrationInviteUpdateOne) SetNillableDeletedBy(v *uint32) *RegistrationInviteUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *RegistrationInviteUpdateOne) AddDeletedBy(v int32) *RegistrationInviteUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *RegistrationInviteUpdateOne) ClearDeletedBy() *RegistrationInviteUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *RegistrationInviteUpdateOne) SetUsedAt(v time.Time) *RegistrationInviteUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *RegistrationInviteUpdateOne) SetNillableUsedAt(v *time.Time) *RegistrationInviteUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *RegistrationInviteUpdateOne) ClearUsedAt() *RegistrationInviteUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetUsedBy sets the "used_by" field.
+func (_u *RegistrationInviteUpdateOne) SetUsedBy(v uint32) *RegistrationInviteUpdateOne {
+	_u.mutation.ResetUsedBy()
+	_u.mutation.SetUsedBy(v)
+	return _u
+}
+
+// SetNillableUsedBy sets the "used_by" field if the given value is not nil.
+func (_u *RegistrationInviteUpdateOne) SetNillableUsedBy(v *uint32) *RegistrationInviteUpdateOne {
+	if v != nil {
+		_u.SetUsedBy(*v)
+	}
+	return _u
+}
+
+// AddUsedBy adds value to the "used_by" field.
+func (_u *RegistrationInviteUpdateOne) AddUsedBy(v int32) *RegistrationInviteUpdateOne {
+	_u.mutation.AddUsedBy(v)
+	return _u
+}
+
+// ClearUsedBy clears the value of the "used_by" field.
+func (_u *RegistrationInviteUpdateOne) ClearUsedBy() *RegistrationInviteUpdateOne {
+	_u.mutation.ClearUsedBy()
+	return _u
+}
+
+// Mutation returns the RegistrationInviteMutation object of the builder.
+func (_u *RegistrationInviteUpdateOne) Mutation() *RegistrationInviteMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RegistrationInviteUpdate builder.
+func (_u *RegistrationInviteUpdateOne) Where(ps ...predicate.RegistrationInvite) *RegistrationInviteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RegistrationInviteUpdateOne) Select(field string, fields ...string) *RegistrationInviteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RegistrationInvite entity.
+func (_u *RegistrationInviteUpdateOne) Save(ctx context.Context) (*RegistrationInvite, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RegistrationInviteUpdateOne) SaveX(ctx context.Context) *RegistrationInvite {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RegistrationInviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RegistrationInviteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RegistrationInviteUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RegistrationInviteUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RegistrationInviteUpdateOne) sqlSave(ctx context.Context) (_node *RegistrationInvite, err error) {
+	_spec := sqlgraph.NewUpdateSpec(registrationinvite.Table, registrationinvite.Columns, sqlgraph.NewFieldSpec(registrationinvite.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RegistrationInvite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, registrationinvite.FieldID)
+		for _, f := range fields {
+			if !registrationinvite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != registrationinvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(registrationinvite.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationinvite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(registrationinvite.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(registrationinvite.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(registrationinvite.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(registrationinvite.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(registrationinvite.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(registrationinvite.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(registrationinvite.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(registrationinvite.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(registrationinvite.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(registrationinvite.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(registrationinvite.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(registrationinvite.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(registrationinvite.FieldTenantID, field.TypeUint32)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(registrationinvite.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(registrationinvite.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(registrationinvite.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UsedBy(); ok {
+		_spec.SetField(registrationinvite.FieldUsedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUsedBy(); ok {
+		_spec.AddField(registrationinvite.FieldUsedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UsedByCleared() {
+		_spec.ClearField(registrationinvite.FieldUsedBy, field.TypeUint32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RegistrationInvite{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registrationinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationpolicy"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 注册策略表
+type RegistrationPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 创建者ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 更新者ID
+	UpdatedBy *uint32 `json:"updated_by,omitempty"`
+	// 删除者ID
+	DeletedBy *uint32 `json:"deleted_by,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 注册方式
+	Mode *registrationpolicy.Mode `json:"mode,omitempty"`
+	// 允许注册的邮箱域名
+	AllowedDomains []string `json:"allowed_domains,omitempty"`
+	// 须验证邮箱后才能登录
+	RequireEmailVerification *bool `json:"require_email_verification,omitempty"`
+	// 须通过人机验证
+	RequireCaptcha *bool `json:"require_captcha,omitempty"`
+	// 注册用户默认分配的角色ID
+	DefaultRoleID *uint32 `json:"default_role_id,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RegistrationPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case registrationpolicy.FieldAllowedDomains:
+			values[i] = new([]byte)
+		case registrationpolicy.FieldRequireEmailVerification, registrationpolicy.FieldRequireCaptcha:
+			values[i] = new(sql.NullBool)
+		case registrationpolicy.FieldID, registrationpolicy.FieldCreatedBy, registrationpolicy.FieldUpdatedBy, registrationpolicy.FieldDeletedBy, registrationpolicy.FieldTenantID, registrationpolicy.FieldDefaultRoleID:
+			values[i] = new(sql.NullInt64)
+		case registrationpolicy.FieldMode:
+			values[i] = new(sql.NullString)
+		case registrationpolicy.FieldCreatedAt, registrationpolicy.FieldUpdatedAt, registrationpolicy.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RegistrationPolicy fields.
+func (_m *RegistrationPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case registrationpolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case registrationpolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case registrationpolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case registrationpolicy.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case registrationpolicy.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case registrationpolicy.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(uint32)
+				*_m.UpdatedBy = uint32(value.Int64)
+			}
+		case registrationpolicy.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = new(uint32)
+				*_m.DeletedBy = uint32(value.Int64)
+			}
+		case registrationpolicy.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case registrationpolicy.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = new(registrationpolicy.Mode)
+				*_m.Mode = registrationpolicy.Mode(value.String)
+			}
+		case registrationpolicy.FieldAllowedDomains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_domains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedDomains); err != nil {
+					return fmt.Errorf("unmarshal field allowed_domains: %w", err)
+				}
+			}
+		case registrationpolicy.FieldRequireEmailVerification:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_email_verification", values[i])
+			} else if value.Valid {
+				_m.RequireEmailVerification = new(bool)
+				*_m.RequireEmailVerification = value.Bool
+			}
+		case registrationpolicy.FieldRequireCaptcha:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_captcha", values[i])
+			} else if value.Valid {
+				_m.RequireCaptcha = new(bool)
+				*_m.RequireCaptcha = value.Bool
+			}
+		case registrationpolicy.FieldDefaultRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_role_id", values[i])
+			} else if value.Valid {
+				_m.DefaultRoleID = new(uint32)
+				*_m.DefaultRoleID = uint32(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RegistrationPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *RegistrationPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RegistrationPolicy.
+// Note that you need to call RegistrationPolicy.Unwrap() before calling this method if this RegistrationPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RegistrationPolicy) Update() *RegistrationPolicyUpdateOne {
+	return NewRegistrationPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RegistrationPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RegistrationPolicy) Unwrap() *RegistrationPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RegistrationPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RegistrationPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("RegistrationPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedBy; v != nil {
+		builder.WriteString("deleted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Mode; v != nil {
+		builder.WriteString("mode=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("allowed_domains=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedDomains))
+	builder.WriteString(", ")
+	if v := _m.RequireEmailVerification; v != nil {
+		builder.WriteString("require_email_verification=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RequireCaptcha; v != nil {
+		builder.WriteString("require_captcha=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DefaultRoleID; v != nil {
+		builder.WriteString("default_role_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RegistrationPolicies is a parsable slice of RegistrationPolicy.
+type RegistrationPolicies []*RegistrationPolicy
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package registrationpolicy
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the registrationpolicy type in the database.
+	Label = "registration_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldAllowedDomains holds the string denoting the allowed_domains field in the database.
+	FieldAllowedDomains = "allowed_domains"
+	// FieldRequireEmailVerification holds the string denoting the require_email_verification field in the database.
+	FieldRequireEmailVerification = "require_email_verification"
+	// FieldRequireCaptcha holds the string denoting the require_captcha field in the database.
+	FieldRequireCaptcha = "require_captcha"
+	// FieldDefaultRoleID holds the string denoting the default_role_id field in the database.
+	FieldDefaultRoleID = "default_role_id"
+	// Table holds the table name of the registrationpolicy in the database.
+	Table = "sys_registration_policies"
+)
+
+// Columns holds all SQL columns for registrationpolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldDeletedBy,
+	FieldTenantID,
+	FieldMode,
+	FieldAllowedDomains,
+	FieldRequireEmailVerification,
+	FieldRequireCaptcha,
+	FieldDefaultRoleID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DefaultRequireEmailVerification holds the default value on creation for the "require_email_verification" field.
+	DefaultRequireEmailVerification bool
+	// DefaultRequireCaptcha holds the default value on creation for the "require_captcha" field.
+	DefaultRequireCaptcha bool
+	// DefaultDefaultRoleID holds the default value on creation for the "default_role_id" field.
+	DefaultDefaultRoleID uint32
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// Mode defines the type for the "mode" enum field.
+type Mode string
+
+// ModeClosed is the default value of the Mode enum.
+const DefaultMode = ModeClosed
+
+// Mode values.
+const (
+	ModeClosed           Mode = "CLOSED"
+	ModeOpen             Mode = "OPEN"
+	ModeInviteOnly       Mode = "INVITE_ONLY"
+	ModeDomainRestricted Mode = "DOMAIN_RESTRICTED"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
+func ModeValidator(m Mode) error {
+	switch m {
+	case ModeClosed, ModeOpen, ModeInviteOnly, ModeDomainRestricted:
+		return nil
+	default:
+		return fmt.Errorf("registrationpolicy: invalid enum value for mode field: %q", m)
+	}
+}
+
+// OrderOption defines the ordering options for the RegistrationPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByRequireEmailVerification orders the results by the require_email_verification field.
+func ByRequireEmailVerification(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireEmailVerification, opts...).ToFunc()
+}
+
+// ByRequireCaptcha orders the results by the require_captcha field.
+func ByRequireCaptcha(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireCaptcha, opts...).ToFunc()
+}
+
+// ByDefaultRoleID orders the results by the default_role_id field.
+func ByDefaultRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultRoleID, opts...).ToFunc()
+}
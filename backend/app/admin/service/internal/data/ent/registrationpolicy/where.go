@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package registrationpolicy
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldTenantID, v))
+}
+
+// RequireEmailVerification applies equality check predicate on the "require_email_verification" field. It's identical to RequireEmailVerificationEQ.
+func RequireEmailVerification(v bool) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldRequireEmailVerification, v))
+}
+
+// RequireCaptcha applies equality check predicate on the "require_captcha" field. It's identical to RequireCaptchaEQ.
+func RequireCaptcha(v bool) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldRequireCaptcha, v))
+}
+
+// DefaultRoleID applies equality check predicate on the "default_role_id" field. It's identical to DefaultRoleIDEQ.
+func DefaultRoleID(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldDefaultRoleID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldTenantID))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v Mode) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v Mode) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...Mode) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...Mode) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ModeIsNil applies the IsNil predicate on the "mode" field.
+func ModeIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldMode))
+}
+
+// ModeNotNil applies the NotNil predicate on the "mode" field.
+func ModeNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldMode))
+}
+
+// AllowedDomainsIsNil applies the IsNil predicate on the "allowed_domains" field.
+func AllowedDomainsIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldAllowedDomains))
+}
+
+// AllowedDomainsNotNil applies the NotNil predicate on the "allowed_domains" field.
+func AllowedDomainsNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldAllowedDomains))
+}
+
+// RequireEmailVerificationEQ applies the EQ predicate on the "require_email_verification" field.
+func RequireEmailVerificationEQ(v bool) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldRequireEmailVerification, v))
+}
+
+// RequireEmailVerificationNEQ applies the NEQ predicate on the "require_email_verification" field.
+func RequireEmailVerificationNEQ(v bool) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldRequireEmailVerification, v))
+}
+
+// RequireEmailVerificationIsNil applies the IsNil predicate on the "require_email_verification" field.
+func RequireEmailVerificationIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldRequireEmailVerification))
+}
+
+// RequireEmailVerificationNotNil applies the NotNil predicate on the "require_email_verification" field.
+func RequireEmailVerificationNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldRequireEmailVerification))
+}
+
+// RequireCaptchaEQ applies the EQ predicate on the "require_captcha" field.
+func RequireCaptchaEQ(v bool) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldRequireCaptcha, v))
+}
+
+// RequireCaptchaNEQ applies the NEQ predicate on the "require_captcha" field.
+func RequireCaptchaNEQ(v bool) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldRequireCaptcha, v))
+}
+
+// RequireCaptchaIsNil applies the IsNil predicate on the "require_captcha" field.
+func RequireCaptchaIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldRequireCaptcha))
+}
+
+// RequireCaptchaNotNil applies the NotNil predicate on the "require_captcha" field.
+func RequireCaptchaNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldRequireCaptcha))
+}
+
+// DefaultRoleIDEQ applies the EQ predicate on the "default_role_id" field.
+func DefaultRoleIDEQ(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldEQ(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDNEQ applies the NEQ predicate on the "default_role_id" field.
+func DefaultRoleIDNEQ(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNEQ(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDIn applies the In predicate on the "default_role_id" field.
+func DefaultRoleIDIn(vs ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIn(FieldDefaultRoleID, vs...))
+}
+
+// DefaultRoleIDNotIn applies the NotIn predicate on the "default_role_id" field.
+func DefaultRoleIDNotIn(vs ...uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotIn(FieldDefaultRoleID, vs...))
+}
+
+// DefaultRoleIDGT applies the GT predicate on the "default_role_id" field.
+func DefaultRoleIDGT(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGT(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDGTE applies the GTE predicate on the "default_role_id" field.
+func DefaultRoleIDGTE(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldGTE(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDLT applies the LT predicate on the "default_role_id" field.
+func DefaultRoleIDLT(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLT(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDLTE applies the LTE predicate on the "default_role_id" field.
+func DefaultRoleIDLTE(v uint32) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldLTE(FieldDefaultRoleID, v))
+}
+
+// DefaultRoleIDIsNil applies the IsNil predicate on the "default_role_id" field.
+func DefaultRoleIDIsNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldIsNull(FieldDefaultRoleID))
+}
+
+// DefaultRoleIDNotNil applies the NotNil predicate on the "default_role_id" field.
+func DefaultRoleIDNotNil() predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.FieldNotNull(FieldDefaultRoleID))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RegistrationPolicy) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RegistrationPolicy) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RegistrationPolicy) predicate.RegistrationPolicy {
+	return predicate.RegistrationPolicy(sql.NotPredicates(p))
+}
@@ -0,0 +1,1371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationpolicy"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RegistrationPolicyCreate is the builder for creating a RegistrationPolicy entity.
+type RegistrationPolicyCreate struct {
+	config
+	mutation *RegistrationPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RegistrationPolicyCreate) SetCreatedAt(v time.Time) *RegistrationPolicyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RegistrationPolicyCreate) SetNillableCreatedAt(v *time.Time) *RegistrationPolicyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RegistrationPolicyCreate) SetUpdatedAt(v time.Time) *RegistrationPolicyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RegistrationPolicyCreate) SetNillableUpdatedAt(v *time.Time) *RegistrationPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *RegistrationPolicyCreate) SetDeletedAt(v time.Time) *RegistrationPolicyCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *RegistrationPolicyCreate) SetNillableDeletedAt(v *time.Time) *RegistrationPolicyCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *RegistrationPolicyCreate) SetCreatedBy(v uint32) *RegistrationPolicyCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *RegistrationPolicyCreate) SetNillableCreatedBy(v *uint32) *RegistrationPolicyCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *RegistrationPolicyCreate) SetUpdatedBy(v uint32) *RegistrationPolicyCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *RegistrationPolicyCreate) SetNillableUpdatedBy(v *uint32) *RegistrationPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *RegistrationPolicyCreate) SetDeletedBy(v uint32) *RegistrationPolicyCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *RegistrationPolicyCreate) SetNillableDeletedBy(v *uint32) *RegistrationPolicyCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *RegistrationPolicyCreate) SetTenantID(v uint32) *RegistrationPolicyCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *RegistrationPolicyCreate) SetNillableTenantID(v *uint32) *RegistrationPolicyCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *RegistrationPolicyCreate) SetMode(v registrationpolicy.Mode) *RegistrationPolicyCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_c *RegistrationPolicyCreate) SetNillableMode(v *registrationpolicy.Mode) *RegistrationPolicyCreate {
+	if v != nil {
+		_c.SetMode(*v)
+	}
+	return _c
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (_c *RegistrationPolicyCreate) SetAllowedDomains(v []string) *RegistrationPolicyCreate {
+	_c.mutation.SetAllowedDomains(v)
+	return _c
+}
+
+// SetRequireEmailVerification sets the "require_email_verification" field.
+func (_c *RegistrationPolicyCreate) SetRequireEmailVerification(v bool) *RegistrationPolicyCreate {
+	_c.mutation.SetRequireEmailVerification(v)
+	return _c
+}
+
+// SetNillableRequireEmailVerification sets the "require_email_verification" field if the given value is not nil.
+func (_c *RegistrationPolicyCreate) SetNillableRequireEmailVerification(v *bool) *RegistrationPolicyCreate {
+	if v != nil {
+		_c.SetRequireEmailVerification(*v)
+	}
+	return _c
+}
+
+// SetRequireCaptcha sets the "require_captcha" field.
+func (_c *RegistrationPolicyCreate) SetRequireCaptcha(v bool) *RegistrationPolicyCreate {
+	_c.mutation.SetRequireCaptcha(v)
+	return _c
+}
+
+// SetNillableRequireCaptcha sets the "require_captcha" field if the given value is not nil.
+func (_c *RegistrationPolicyCreate) SetNillableRequireCaptcha(v *bool) *RegistrationPolicyCreate {
+	if v != nil {
+		_c.SetRequireCaptcha(*v)
+	}
+	return _c
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_c *RegistrationPolicyCreate) SetDefaultRoleID(v uint32) *RegistrationPolicyCreate {
+	_c.mutation.SetDefaultRoleID(v)
+	return _c
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_c *RegistrationPolicyCreate) SetNillableDefaultRoleID(v *uint32) *RegistrationPolicyCreate {
+	if v != nil {
+		_c.SetDefaultRoleID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RegistrationPolicyCreate) SetID(v uint32) *RegistrationPolicyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the RegistrationPolicyMutation object of the builder.
+func (_c *RegistrationPolicyCreate) Mutation() *RegistrationPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the RegistrationPolicy in the database.
+func (_c *RegistrationPolicyCreate) Save(ctx context.Context) (*RegistrationPolicy, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RegistrationPolicyCreate) SaveX(ctx context.Context) *RegistrationPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RegistrationPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RegistrationPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RegistrationPolicyCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := registrationpolicy.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		v := registrationpolicy.DefaultMode
+		_c.mutation.SetMode(v)
+	}
+	if _, ok := _c.mutation.RequireEmailVerification(); !ok {
+		v := registrationpolicy.DefaultRequireEmailVerification
+		_c.mutation.SetRequireEmailVerification(v)
+	}
+	if _, ok := _c.mutation.RequireCaptcha(); !ok {
+		v := registrationpolicy.DefaultRequireCaptcha
+		_c.mutation.SetRequireCaptcha(v)
+	}
+	if _, ok := _c.mutation.DefaultRoleID(); !ok {
+		v := registrationpolicy.DefaultDefaultRoleID
+		_c.mutation.SetDefaultRoleID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RegistrationPolicyCreate) check() error {
+	if v, ok := _c.mutation.Mode(); ok {
+		if err := registrationpolicy.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "RegistrationPolicy.mode": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := registrationpolicy.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RegistrationPolicy.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *RegistrationPolicyCreate) sqlSave(ctx context.Context) (*RegistrationPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RegistrationPolicyCreate) createSpec() (*RegistrationPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RegistrationPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(registrationpolicy.Table, sqlgraph.NewFieldSpec(registrationpolicy.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(registrationpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationpolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(registrationpolicy.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(registrationpolicy.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(registrationpolicy.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(registrationpolicy.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(registrationpolicy.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(registrationpolicy.FieldMode, field.TypeEnum, value)
+		_node.Mode = &value
+	}
+	if value, ok := _c.mutation.AllowedDomains(); ok {
+		_spec.SetField(registrationpolicy.FieldAllowedDomains, field.TypeJSON, value)
+		_node.AllowedDomains = value
+	}
+	if value, ok := _c.mutation.RequireEmailVerification(); ok {
+		_spec.SetField(registrationpolicy.FieldRequireEmailVerification, field.TypeBool, value)
+		_node.RequireEmailVerification = &value
+	}
+	if value, ok := _c.mutation.RequireCaptcha(); ok {
+		_spec.SetField(registrationpolicy.FieldRequireCaptcha, field.TypeBool, value)
+		_node.RequireCaptcha = &value
+	}
+	if value, ok := _c.mutation.DefaultRoleID(); ok {
+		_spec.SetField(registrationpolicy.FieldDefaultRoleID, field.TypeUint32, value)
+		_node.DefaultRoleID = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RegistrationPolicy.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RegistrationPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RegistrationPolicyCreate) OnConflict(opts ...sql.ConflictOption) *RegistrationPolicyUpsertOne {
+	_c.conflict = opts
+	return &RegistrationPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RegistrationPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RegistrationPolicyCreate) OnConflictColumns(columns ...string) *RegistrationPolicyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RegistrationPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RegistrationPolicyUpsertOne is the builder for "upsert"-ing
+	//  one RegistrationPolicy node.
+	RegistrationPolicyUpsertOne struct {
+		create *RegistrationPolicyCreate
+	}
+
+	// RegistrationPolicyUpsert is the "OnConflict" setter.
+	RegistrationPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RegistrationPolicyUpsert) SetUpdatedAt(v time.Time) *RegistrationPolicyUpsert {
+	u.Set(registrationpolicy.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsert) UpdateUpdatedAt() *RegistrationPolicyUpsert {
+	u.SetExcluded(registrationpolicy.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *RegistrationPolicyUpsert) ClearUpdatedAt() *RegistrationPolicyUpsert {
+	u.SetNull(registrationpolicy.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RegistrationPolicyUpsert) SetDeletedAt(v time.Time) *RegistrationPolicyUpsert {
+	u.Set(registrationpolicy.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsert) UpdateDeletedAt() *RegistrationPolicyUpsert {
+	u.SetExcluded(registrationpolicy.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RegistrationPolicyUpsert) ClearDeletedAt() *RegistrationPolicyUpsert {
+	u.SetNull(registrationpolicy.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RegistrationPolicyUpsert) SetCreatedBy(v uint32) *RegistrationPolicyUpsert {
+	u.Set(registrationpolicy.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsert) UpdateCreatedBy() *RegistrationPolicyUpsert {
+	u.SetExcluded(registrationpolicy.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RegistrationPolicyUpsert) AddCreatedBy(v uint32) *RegistrationPolicyUpsert {
+	u.Add(registrationpolicy.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RegistrationPolicyUpsert) ClearCreatedBy() *RegistrationPolicyUpsert {
+	u.SetNull(registrationpolicy.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *RegistrationPolicyUpsert) SetUpdatedBy(v uint32) *RegistrationPolicyUpsert {
+	u.Set(registrationpolicy.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsert) UpdateUpdatedBy() *RegistrationPolicyUpsert {
+	u.SetExcluded(registrationpolicy.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *RegistrationPolicyUpsert) AddUpdatedBy(v uint32) *RegistrationPolicyUpsert {
+	u.Add(registrationpolicy.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *RegistrationPolicyUpsert) ClearUpdatedBy() *RegistrationPolicyUpsert {
+	u.SetNull(registrationpolicy.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *RegistrationPolicyUpsert) SetDeletedBy(v uint32) *RegistrationPolicyUpsert {
+	u.Set(registrationpolicy.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsert) UpdateDeletedBy() *RegistrationPolicyUpsert {
+	u.SetExcluded(registrationpolicy.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *RegistrationPolicyUpsert) AddDeletedBy(v uint32) *RegistrationPolicyUpsert {
+	u.Add(registrationpolicy.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *RegistrationPolicyUpsert) ClearDeletedBy() *RegistrationPolicyUpsert {
+	u.SetNull(registrationpolicy.FieldDeletedBy)
+	return u
+}
+
+// SetMode sets the "mode" field.
+func (u *RegistrationPolicyUpsert) SetMode(v registrationpolicy.Mode) *RegistrationPolicyUpsert {
+	u.Set(registrationpolicy.FieldMode, v)
+	return u
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsert) UpdateMode() *RegistrationPolicyUpsert {
+	u.SetExcluded(registrationpolicy.FieldMode)
+	return u
+}
+
+// ClearMode clears the value of the "mode" field.
+func (u *RegistrationPolicyUpsert) ClearMode() *RegistrationPolicyUpsert {
+	u.SetNull(registrationpolicy.FieldMode)
+	return u
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (u *RegistrationPolicyUpsert) SetAllowedDomains(v []string) *RegistrationPolicyUpsert {
+	u.Set(registrationpolicy.FieldAllowedDomains, v)
+	return u
+}
+
+// UpdateAllowedDomains sets the "allowed_domains" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsert) UpdateAllowedDomains() *RegistrationPolicyUpsert {
+	u.SetExcluded(registrationpolicy.FieldAllowedDomains)
+	return u
+}
+
+// ClearAllowedDomains clears the value of the "allowed_domains" field.
+func (u *RegistrationPolicyUpsert) ClearAllowedDomains() *RegistrationPolicyUpsert {
+	u.SetNull(registrationpolicy.FieldAllowedDomains)
+	return u
+}
+
+// SetRequireEmailVerification sets the "require_email_verification" field.
+func (u *RegistrationPolicyUpsert) SetRequireEmailVerification(v bool) *RegistrationPolicyUpsert {
+	u.Set(registrationpolicy.FieldRequireEmailVerification, v)
+	return u
+}
+
+// UpdateRequireEmailVerification sets the "require_email_verification" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsert) UpdateRequireEmailVerification() *RegistrationPolicyUpsert {
+	u.SetExcluded(registrationpolicy.FieldRequireEmailVerification)
+	return u
+}
+
+// ClearRequireEmailVerification clears the value of the "require_email_verification" field.
+func (u *RegistrationPolicyUpsert) ClearRequireEmailVerification() *RegistrationPolicyUpsert {
+	u.SetNull(registrationpolicy.FieldRequireEmailVerification)
+	return u
+}
+
+// SetRequireCaptcha sets the "require_captcha" field.
+func (u *RegistrationPolicyUpsert) SetRequireCaptcha(v bool) *RegistrationPolicyUpsert {
+	u.Set(registrationpolicy.FieldRequireCaptcha, v)
+	return u
+}
+
+// UpdateRequireCaptcha sets the "require_captcha" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsert) UpdateRequireCaptcha() *RegistrationPolicyUpsert {
+	u.SetExcluded(registrationpolicy.FieldRequireCaptcha)
+	return u
+}
+
+// ClearRequireCaptcha clears the value of the "require_captcha" field.
+func (u *RegistrationPolicyUpsert) ClearRequireCaptcha() *RegistrationPolicyUpsert {
+	u.SetNull(registrationpolicy.FieldRequireCaptcha)
+	return u
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (u *RegistrationPolicyUpsert) SetDefaultRoleID(v uint32) *RegistrationPolicyUpsert {
+	u.Set(registrationpolicy.FieldDefaultRoleID, v)
+	return u
+}
+
+// UpdateDefaultRoleID sets the "default_role_id" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsert) UpdateDefaultRoleID() *RegistrationPolicyUpsert {
+	u.SetExcluded(registrationpolicy.FieldDefaultRoleID)
+	return u
+}
+
+// AddDefaultRoleID adds v to the "default_role_id" field.
+func (u *RegistrationPolicyUpsert) AddDefaultRoleID(v uint32) *RegistrationPolicyUpsert {
+	u.Add(registrationpolicy.FieldDefaultRoleID, v)
+	return u
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (u *RegistrationPolicyUpsert) ClearDefaultRoleID() *RegistrationPolicyUpsert {
+	u.SetNull(registrationpolicy.FieldDefaultRoleID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RegistrationPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(registrationpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RegistrationPolicyUpsertOne) UpdateNewValues() *RegistrationPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(registrationpolicy.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(registrationpolicy.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(registrationpolicy.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RegistrationPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RegistrationPolicyUpsertOne) Ignore() *RegistrationPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RegistrationPolicyUpsertOne) DoNothing() *RegistrationPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RegistrationPolicyCreate.OnConflict
+// documentation for more info.
+func (u *RegistrationPolicyUpsertOne) Update(set func(*RegistrationPolicyUpsert)) *RegistrationPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RegistrationPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RegistrationPolicyUpsertOne) SetUpdatedAt(v time.Time) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertOne) UpdateUpdatedAt() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *RegistrationPolicyUpsertOne) ClearUpdatedAt() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RegistrationPolicyUpsertOne) SetDeletedAt(v time.Time) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertOne) UpdateDeletedAt() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RegistrationPolicyUpsertOne) ClearDeletedAt() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RegistrationPolicyUpsertOne) SetCreatedBy(v uint32) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RegistrationPolicyUpsertOne) AddCreatedBy(v uint32) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertOne) UpdateCreatedBy() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RegistrationPolicyUpsertOne) ClearCreatedBy() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *RegistrationPolicyUpsertOne) SetUpdatedBy(v uint32) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *RegistrationPolicyUpsertOne) AddUpdatedBy(v uint32) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertOne) UpdateUpdatedBy() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *RegistrationPolicyUpsertOne) ClearUpdatedBy() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *RegistrationPolicyUpsertOne) SetDeletedBy(v uint32) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *RegistrationPolicyUpsertOne) AddDeletedBy(v uint32) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertOne) UpdateDeletedBy() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *RegistrationPolicyUpsertOne) ClearDeletedBy() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *RegistrationPolicyUpsertOne) SetMode(v registrationpolicy.Mode) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertOne) UpdateMode() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// ClearMode clears the value of the "mode" field.
+func (u *RegistrationPolicyUpsertOne) ClearMode() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearMode()
+	})
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (u *RegistrationPolicyUpsertOne) SetAllowedDomains(v []string) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetAllowedDomains(v)
+	})
+}
+
+// UpdateAllowedDomains sets the "allowed_domains" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertOne) UpdateAllowedDomains() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateAllowedDomains()
+	})
+}
+
+// ClearAllowedDomains clears the value of the "allowed_domains" field.
+func (u *RegistrationPolicyUpsertOne) ClearAllowedDomains() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearAllowedDomains()
+	})
+}
+
+// SetRequireEmailVerification sets the "require_email_verification" field.
+func (u *RegistrationPolicyUpsertOne) SetRequireEmailVerification(v bool) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetRequireEmailVerification(v)
+	})
+}
+
+// UpdateRequireEmailVerification sets the "require_email_verification" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertOne) UpdateRequireEmailVerification() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateRequireEmailVerification()
+	})
+}
+
+// ClearRequireEmailVerification clears the value of the "require_email_verification" field.
+func (u *RegistrationPolicyUpsertOne) ClearRequireEmailVerification() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearRequireEmailVerification()
+	})
+}
+
+// SetRequireCaptcha sets the "require_captcha" field.
+func (u *RegistrationPolicyUpsertOne) SetRequireCaptcha(v bool) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetRequireCaptcha(v)
+	})
+}
+
+// UpdateRequireCaptcha sets the "require_captcha" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertOne) UpdateRequireCaptcha() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateRequireCaptcha()
+	})
+}
+
+// ClearRequireCaptcha clears the value of the "require_captcha" field.
+func (u *RegistrationPolicyUpsertOne) ClearRequireCaptcha() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearRequireCaptcha()
+	})
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (u *RegistrationPolicyUpsertOne) SetDefaultRoleID(v uint32) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetDefaultRoleID(v)
+	})
+}
+
+// AddDefaultRoleID adds v to the "default_role_id" field.
+func (u *RegistrationPolicyUpsertOne) AddDefaultRoleID(v uint32) *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.AddDefaultRoleID(v)
+	})
+}
+
+// UpdateDefaultRoleID sets the "default_role_id" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertOne) UpdateDefaultRoleID() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateDefaultRoleID()
+	})
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (u *RegistrationPolicyUpsertOne) ClearDefaultRoleID() *RegistrationPolicyUpsertOne {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearDefaultRoleID()
+	})
+}
+
+// Exec executes the query.
+func (u *RegistrationPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RegistrationPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RegistrationPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RegistrationPolicyUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RegistrationPolicyUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RegistrationPolicyCreateBulk is the builder for creating many RegistrationPolicy entities in bulk.
+type RegistrationPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*RegistrationPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RegistrationPolicy entities in the database.
+func (_c *RegistrationPolicyCreateBulk) Save(ctx context.Context) ([]*RegistrationPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RegistrationPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RegistrationPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RegistrationPolicyCreateBulk) SaveX(ctx context.Context) []*RegistrationPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RegistrationPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RegistrationPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RegistrationPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RegistrationPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RegistrationPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *RegistrationPolicyUpsertBulk {
+	_c.conflict = opts
+	return &RegistrationPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RegistrationPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RegistrationPolicyCreateBulk) OnConflictColumns(columns ...string) *RegistrationPolicyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RegistrationPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// RegistrationPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of RegistrationPolicy nodes.
+type RegistrationPolicyUpsertBulk struct {
+	create *RegistrationPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RegistrationPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(registrationpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RegistrationPolicyUpsertBulk) UpdateNewValues() *RegistrationPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(registrationpolicy.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(registrationpolicy.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(registrationpolicy.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RegistrationPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RegistrationPolicyUpsertBulk) Ignore() *RegistrationPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RegistrationPolicyUpsertBulk) DoNothing() *RegistrationPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RegistrationPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *RegistrationPolicyUpsertBulk) Update(set func(*RegistrationPolicyUpsert)) *RegistrationPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RegistrationPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RegistrationPolicyUpsertBulk) SetUpdatedAt(v time.Time) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertBulk) UpdateUpdatedAt() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *RegistrationPolicyUpsertBulk) ClearUpdatedAt() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RegistrationPolicyUpsertBulk) SetDeletedAt(v time.Time) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertBulk) UpdateDeletedAt() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RegistrationPolicyUpsertBulk) ClearDeletedAt() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RegistrationPolicyUpsertBulk) SetCreatedBy(v uint32) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RegistrationPolicyUpsertBulk) AddCreatedBy(v uint32) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertBulk) UpdateCreatedBy() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RegistrationPolicyUpsertBulk) ClearCreatedBy() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *RegistrationPolicyUpsertBulk) SetUpdatedBy(v uint32) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *RegistrationPolicyUpsertBulk) AddUpdatedBy(v uint32) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertBulk) UpdateUpdatedBy() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *RegistrationPolicyUpsertBulk) ClearUpdatedBy() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *RegistrationPolicyUpsertBulk) SetDeletedBy(v uint32) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *RegistrationPolicyUpsertBulk) AddDeletedBy(v uint32) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertBulk) UpdateDeletedBy() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *RegistrationPolicyUpsertBulk) ClearDeletedBy() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *RegistrationPolicyUpsertBulk) SetMode(v registrationpolicy.Mode) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertBulk) UpdateMode() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// ClearMode clears the value of the "mode" field.
+func (u *RegistrationPolicyUpsertBulk) ClearMode() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearMode()
+	})
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (u *RegistrationPolicyUpsertBulk) SetAllowedDomains(v []string) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetAllowedDomains(v)
+	})
+}
+
+// UpdateAllowedDomains sets the "allowed_domains" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertBulk) UpdateAllowedDomains() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateAllowedDomains()
+	})
+}
+
+// ClearAllowedDomains clears the value of the "allowed_domains" field.
+func (u *RegistrationPolicyUpsertBulk) ClearAllowedDomains() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearAllowedDomains()
+	})
+}
+
+// SetRequireEmailVerification sets the "require_email_verification" field.
+func (u *RegistrationPolicyUpsertBulk) SetRequireEmailVerification(v bool) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetRequireEmailVerification(v)
+	})
+}
+
+// UpdateRequireEmailVerification sets the "require_email_verification" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertBulk) UpdateRequireEmailVerification() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateRequireEmailVerification()
+	})
+}
+
+// ClearRequireEmailVerification clears the value of the "require_email_verification" field.
+func (u *RegistrationPolicyUpsertBulk) ClearRequireEmailVerification() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearRequireEmailVerification()
+	})
+}
+
+// SetRequireCaptcha sets the "require_captcha" field.
+func (u *RegistrationPolicyUpsertBulk) SetRequireCaptcha(v bool) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetRequireCaptcha(v)
+	})
+}
+
+// UpdateRequireCaptcha sets the "require_captcha" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertBulk) UpdateRequireCaptcha() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateRequireCaptcha()
+	})
+}
+
+// ClearRequireCaptcha clears the value of the "require_captcha" field.
+func (u *RegistrationPolicyUpsertBulk) ClearRequireCaptcha() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearRequireCaptcha()
+	})
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (u *RegistrationPolicyUpsertBulk) SetDefaultRoleID(v uint32) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.SetDefaultRoleID(v)
+	})
+}
+
+// AddDefaultRoleID adds v to the "default_role_id" field.
+func (u *RegistrationPolicyUpsertBulk) AddDefaultRoleID(v uint32) *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.AddDefaultRoleID(v)
+	})
+}
+
+// UpdateDefaultRoleID sets the "default_role_id" field to the value that was provided on create.
+func (u *RegistrationPolicyUpsertBulk) UpdateDefaultRoleID() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.UpdateDefaultRoleID()
+	})
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (u *RegistrationPolicyUpsertBulk) ClearDefaultRoleID() *RegistrationPolicyUpsertBulk {
+	return u.Update(func(s *RegistrationPolicyUpsert) {
+		s.ClearDefaultRoleID()
+	})
+}
+
+// Exec executes the query.
+func (u *RegistrationPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RegistrationPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RegistrationPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RegistrationPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,837 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationpolicy"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// RegistrationPolicyUpdate is the builder for updating RegistrationPolicy entities.
+type RegistrationPolicyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RegistrationPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RegistrationPolicyUpdate builder.
+func (_u *RegistrationPolicyUpdate) Where(ps ...predicate.RegistrationPolicy) *RegistrationPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RegistrationPolicyUpdate) SetUpdatedAt(v time.Time) *RegistrationPolicyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdate) SetNillableUpdatedAt(v *time.Time) *RegistrationPolicyUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *RegistrationPolicyUpdate) ClearUpdatedAt() *RegistrationPolicyUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *RegistrationPolicyUpdate) SetDeletedAt(v time.Time) *RegistrationPolicyUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdate) SetNillableDeletedAt(v *time.Time) *RegistrationPolicyUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *RegistrationPolicyUpdate) ClearDeletedAt() *RegistrationPolicyUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *RegistrationPolicyUpdate) SetCreatedBy(v uint32) *RegistrationPolicyUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdate) SetNillableCreatedBy(v *uint32) *RegistrationPolicyUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *RegistrationPolicyUpdate) AddCreatedBy(v int32) *RegistrationPolicyUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *RegistrationPolicyUpdate) ClearCreatedBy() *RegistrationPolicyUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *RegistrationPolicyUpdate) SetUpdatedBy(v uint32) *RegistrationPolicyUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdate) SetNillableUpdatedBy(v *uint32) *RegistrationPolicyUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *RegistrationPolicyUpdate) AddUpdatedBy(v int32) *RegistrationPolicyUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *RegistrationPolicyUpdate) ClearUpdatedBy() *RegistrationPolicyUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *RegistrationPolicyUpdate) SetDeletedBy(v uint32) *RegistrationPolicyUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdate) SetNillableDeletedBy(v *uint32) *RegistrationPolicyUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *RegistrationPolicyUpdate) AddDeletedBy(v int32) *RegistrationPolicyUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *RegistrationPolicyUpdate) ClearDeletedBy() *RegistrationPolicyUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *RegistrationPolicyUpdate) SetMode(v registrationpolicy.Mode) *RegistrationPolicyUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdate) SetNillableMode(v *registrationpolicy.Mode) *RegistrationPolicyUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// ClearMode clears the value of the "mode" field.
+func (_u *RegistrationPolicyUpdate) ClearMode() *RegistrationPolicyUpdate {
+	_u.mutation.ClearMode()
+	return _u
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (_u *RegistrationPolicyUpdate) SetAllowedDomains(v []string) *RegistrationPolicyUpdate {
+	_u.mutation.SetAllowedDomains(v)
+	return _u
+}
+
+// AppendAllowedDomains appends value to the "allowed_domains" field.
+func (_u *RegistrationPolicyUpdate) AppendAllowedDomains(v []string) *RegistrationPolicyUpdate {
+	_u.mutation.AppendAllowedDomains(v)
+	return _u
+}
+
+// ClearAllowedDomains clears the value of the "allowed_domains" field.
+func (_u *RegistrationPolicyUpdate) ClearAllowedDomains() *RegistrationPolicyUpdate {
+	_u.mutation.ClearAllowedDomains()
+	return _u
+}
+
+// SetRequireEmailVerification sets the "require_email_verification" field.
+func (_u *RegistrationPolicyUpdate) SetRequireEmailVerification(v bool) *RegistrationPolicyUpdate {
+	_u.mutation.SetRequireEmailVerification(v)
+	return _u
+}
+
+// SetNillableRequireEmailVerification sets the "require_email_verification" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdate) SetNillableRequireEmailVerification(v *bool) *RegistrationPolicyUpdate {
+	if v != nil {
+		_u.SetRequireEmailVerification(*v)
+	}
+	return _u
+}
+
+// ClearRequireEmailVerification clears the value of the "require_email_verification" field.
+func (_u *RegistrationPolicyUpdate) ClearRequireEmailVerification() *RegistrationPolicyUpdate {
+	_u.mutation.ClearRequireEmailVerification()
+	return _u
+}
+
+// SetRequireCaptcha sets the "require_captcha" field.
+func (_u *RegistrationPolicyUpdate) SetRequireCaptcha(v bool) *RegistrationPolicyUpdate {
+	_u.mutation.SetRequireCaptcha(v)
+	return _u
+}
+
+// SetNillableRequireCaptcha sets the "require_captcha" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdate) SetNillableRequireCaptcha(v *bool) *RegistrationPolicyUpdate {
+	if v != nil {
+		_u.SetRequireCaptcha(*v)
+	}
+	return _u
+}
+
+// ClearRequireCaptcha clears the value of the "require_captcha" field.
+func (_u *RegistrationPolicyUpdate) ClearRequireCaptcha() *RegistrationPolicyUpdate {
+	_u.mutation.ClearRequireCaptcha()
+	return _u
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_u *RegistrationPolicyUpdate) SetDefaultRoleID(v uint32) *RegistrationPolicyUpdate {
+	_u.mutation.ResetDefaultRoleID()
+	_u.mutation.SetDefaultRoleID(v)
+	return _u
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdate) SetNillableDefaultRoleID(v *uint32) *RegistrationPolicyUpdate {
+	if v != nil {
+		_u.SetDefaultRoleID(*v)
+	}
+	return _u
+}
+
+// AddDefaultRoleID adds value to the "default_role_id" field.
+func (_u *RegistrationPolicyUpdate) AddDefaultRoleID(v int32) *RegistrationPolicyUpdate {
+	_u.mutation.AddDefaultRoleID(v)
+	return _u
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (_u *RegistrationPolicyUpdate) ClearDefaultRoleID() *RegistrationPolicyUpdate {
+	_u.mutation.ClearDefaultRoleID()
+	return _u
+}
+
+// Mutation returns the RegistrationPolicyMutation object of the builder.
+func (_u *RegistrationPolicyUpdate) Mutation() *RegistrationPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RegistrationPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RegistrationPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RegistrationPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RegistrationPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RegistrationPolicyUpdate) check() error {
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := registrationpolicy.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "RegistrationPolicy.mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RegistrationPolicyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RegistrationPolicyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RegistrationPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(registrationpolicy.Table, registrationpolicy.Columns, sqlgraph.NewFieldSpec(registrationpolicy.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(registrationpolicy.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(registrationpolicy.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(registrationpolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(registrationpolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(registrationpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(registrationpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(registrationpolicy.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(registrationpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(registrationpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(registrationpolicy.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(registrationpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(registrationpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(registrationpolicy.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(registrationpolicy.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(registrationpolicy.FieldMode, field.TypeEnum, value)
+	}
+	if _u.mutation.ModeCleared() {
+		_spec.ClearField(registrationpolicy.FieldMode, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.AllowedDomains(); ok {
+		_spec.SetField(registrationpolicy.FieldAllowedDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, registrationpolicy.FieldAllowedDomains, value)
+		})
+	}
+	if _u.mutation.AllowedDomainsCleared() {
+		_spec.ClearField(registrationpolicy.FieldAllowedDomains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequireEmailVerification(); ok {
+		_spec.SetField(registrationpolicy.FieldRequireEmailVerification, field.TypeBool, value)
+	}
+	if _u.mutation.RequireEmailVerificationCleared() {
+		_spec.ClearField(registrationpolicy.FieldRequireEmailVerification, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequireCaptcha(); ok {
+		_spec.SetField(registrationpolicy.FieldRequireCaptcha, field.TypeBool, value)
+	}
+	if _u.mutation.RequireCaptchaCleared() {
+		_spec.ClearField(registrationpolicy.FieldRequireCaptcha, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DefaultRoleID(); ok {
+		_spec.SetField(registrationpolicy.FieldDefaultRoleID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultRoleID(); ok {
+		_spec.AddField(registrationpolicy.FieldDefaultRoleID, field.TypeUint32, value)
+	}
+	if _u.mutation.DefaultRoleIDCleared() {
+		_spec.ClearField(registrationpolicy.FieldDefaultRoleID, field.TypeUint32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registrationpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RegistrationPolicyUpdateOne is the builder for updating a single RegistrationPolicy entity.
+type RegistrationPolicyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RegistrationPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RegistrationPolicyUpdateOne) SetUpdatedAt(v time.Time) *RegistrationPolicyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdateOne) SetNillableUpdatedAt(v *time.Time) *RegistrationPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *RegistrationPolicyUpdateOne) ClearUpdatedAt() *RegistrationPolicyUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *RegistrationPolicyUpdateOne) SetDeletedAt(v time.Time) *RegistrationPolicyUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdateOne) SetNillableDeletedAt(v *time.Time) *RegistrationPolicyUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *RegistrationPolicyUpdateOne) ClearDeletedAt() *RegistrationPolicyUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *RegistrationPolicyUpdateOne) SetCreatedBy(v uint32) *RegistrationPolicyUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdateOne) SetNillableCreatedBy(v *uint32) *RegistrationPolicyUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *RegistrationPolicyUpdateOne) AddCreatedBy(v int32) *RegistrationPolicyUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *RegistrationPolicyUpdateOne) ClearCreatedBy() *RegistrationPolicyUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *RegistrationPolicyUpdateOne) SetUpdatedBy(v uint32) *RegistrationPolicyUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdateOne) SetNillableUpdatedBy(v *uint32) *RegistrationPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *RegistrationPolicyUpdateOne) AddUpdatedBy(v int32) *RegistrationPolicyUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *RegistrationPolicyUpdateOne) ClearUpdatedBy() *RegistrationPolicyUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *RegistrationPolicyUpdateOne) SetDeletedBy(v uint32) *RegistrationPolicyUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdateOne) SetNillableDeletedBy(v *uint32) *RegistrationPolicyUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *RegistrationPolicyUpdateOne) AddDeletedBy(v int32) *RegistrationPolicyUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *RegistrationPolicyUpdateOne) ClearDeletedBy() *RegistrationPolicyUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *RegistrationPolicyUpdateOne) SetMode(v registrationpolicy.Mode) *RegistrationPolicyUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdateOne) SetNillableMode(v *registrationpolicy.Mode) *RegistrationPolicyUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// ClearMode clears the value of the "mode" field.
+func (_u *RegistrationPolicyUpdateOne) ClearMode() *RegistrationPolicyUpdateOne {
+	_u.mutation.ClearMode()
+	return _u
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (_u *RegistrationPolicyUpdateOne) SetAllowedDomains(v []string) *RegistrationPolicyUpdateOne {
+	_u.mutation.SetAllowedDomains(v)
+	return _u
+}
+
+// AppendAllowedDomains appends value to the "allowed_domains" field.
+func (_u *RegistrationPolicyUpdateOne) AppendAllowedDomains(v []string) *RegistrationPolicyUpdateOne {
+	_u.mutation.AppendAllowedDomains(v)
+	return _u
+}
+
+// ClearAllowedDomains clears the value of the "allowed_domains" field.
+func (_u *RegistrationPolicyUpdateOne) ClearAllowedDomains() *RegistrationPolicyUpdateOne {
+	_u.mutation.ClearAllowedDomains()
+	return _u
+}
+
+// SetRequireEmailVerification sets the "require_email_verification" field.
+func (_u *RegistrationPolicyUpdateOne) SetRequireEmailVerification(v bool) *RegistrationPolicyUpdateOne {
+	_u.mutation.SetRequireEmailVerification(v)
+	return _u
+}
+
+// SetNillableRequireEmailVerification sets the "require_email_verification" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdateOne) SetNillableRequireEmailVerification(v *bool) *RegistrationPolicyUpdateOne {
+	if v != nil {
+		_u.SetRequireEmailVerification(*v)
+	}
+	return _u
+}
+
+// ClearRequireEmailVerification clears the value of the "require_email_verification" field.
+func (_u *RegistrationPolicyUpdateOne) ClearRequireEmailVerification() *RegistrationPolicyUpdateOne {
+	_u.mutation.ClearRequireEmailVerification()
+	return _u
+}
+
+// SetRequireCaptcha sets the "require_captcha" field.
+func (_u *RegistrationPolicyUpdateOne) SetRequireCaptcha(v bool) *RegistrationPolicyUpdateOne {
+	_u.mutation.SetRequireCaptcha(v)
+	return _u
+}
+
+// SetNillableRequireCaptcha sets the "require_captcha" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdateOne) SetNillableRequireCaptcha(v *bool) *RegistrationPolicyUpdateOne {
+	if v != nil {
+		_u.SetRequireCaptcha(*v)
+	}
+	return _u
+}
+
+// ClearRequireCaptcha clears the value of the "require_captcha" field.
+func (_u *RegistrationPolicyUpdateOne) ClearRequireCaptcha() *RegistrationPolicyUpdateOne {
+	_u.mutation.ClearRequireCaptcha()
+	return _u
+}
+
+// SetDefaultRoleID sets the "default_role_id" field.
+func (_u *RegistrationPolicyUpdateOne) SetDefaultRoleID(v uint32) *RegistrationPolicyUpdateOne {
+	_u.mutation.ResetDefaultRoleID()
+	_u.mutation.SetDefaultRoleID(v)
+	return _u
+}
+
+// SetNillableDefaultRoleID sets the "default_role_id" field if the given value is not nil.
+func (_u *RegistrationPolicyUpdateOne) SetNillableDefaultRoleID(v *uint32) *RegistrationPolicyUpdateOne {
+	if v != nil {
+		_u.SetDefaultRoleID(*v)
+	}
+	return _u
+}
+
+// AddDefaultRoleID adds value to the "default_role_id" field.
+func (_u *RegistrationPolicyUpdateOne) AddDefaultRoleID(v int32) *RegistrationPolicyUpdateOne {
+	_u.mutation.AddDefaultRoleID(v)
+	return _u
+}
+
+// ClearDefaultRoleID clears the value of the "default_role_id" field.
+func (_u *RegistrationPolicyUpdateOne) ClearDefaultRoleID() *RegistrationPolicyUpdateOne {
+	_u.mutation.ClearDefaultRoleID()
+	return _u
+}
+
+// Mutation returns the RegistrationPolicyMutation object of the builder.
+func (_u *RegistrationPolicyUpdateOne) Mutation() *RegistrationPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RegistrationPolicyUpdate builder.
+func (_u *RegistrationPolicyUpdateOne) Where(ps ...predicate.RegistrationPolicy) *RegistrationPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RegistrationPolicyUpdateOne) Select(field string, fields ...string) *RegistrationPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RegistrationPolicy entity.
+func (_u *RegistrationPolicyUpdateOne) Save(ctx context.Context) (*RegistrationPolicy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RegistrationPolicyUpdateOne) SaveX(ctx context.Context) *RegistrationPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RegistrationPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RegistrationPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RegistrationPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := registrationpolicy.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "RegistrationPolicy.mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RegistrationPolicyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RegistrationPolicyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RegistrationPolicyUpdateOne) sqlSave(ctx context.Context) (_node *RegistrationPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(registrationpolicy.Table, registrationpolicy.Columns, sqlgraph.NewFieldSpec(registrationpolicy.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RegistrationPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, registrationpolicy.FieldID)
+		for _, f := range fields {
+			if !registrationpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != registrationpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(registrationpolicy.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(registrationpolicy.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(registrationpolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(registrationpolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(registrationpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(registrationpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(registrationpolicy.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(registrationpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(registrationpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(registrationpolicy.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(registrationpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(registrationpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(registrationpolicy.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(registrationpolicy.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(registrationpolicy.FieldMode, field.TypeEnum, value)
+	}
+	if _u.mutation.ModeCleared() {
+		_spec.ClearField(registrationpolicy.FieldMode, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.AllowedDomains(); ok {
+		_spec.SetField(registrationpolicy.FieldAllowedDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, registrationpolicy.FieldAllowedDomains, value)
+		})
+	}
+	if _u.mutation.AllowedDomainsCleared() {
+		_spec.ClearField(registrationpolicy.FieldAllowedDomains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequireEmailVerification(); ok {
+		_spec.SetField(registrationpolicy.FieldRequireEmailVerification, field.TypeBool, value)
+	}
+	if _u.mutation.RequireEmailVerificationCleared() {
+		_spec.ClearField(registrationpolicy.FieldRequireEmailVerification, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequireCaptcha(); ok {
+		_spec.SetField(registrationpolicy.FieldRequireCaptcha, field.TypeBool, value)
+	}
+	if _u.mutation.RequireCaptchaCleared() {
+		_spec.ClearField(registrationpolicy.FieldRequireCaptcha, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DefaultRoleID(); ok {
+		_spec.SetField(registrationpolicy.FieldDefaultRoleID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultRoleID(); ok {
+		_spec.AddField(registrationpolicy.FieldDefaultRoleID, field.TypeUint32, value)
+	}
+	if _u.mutation.DefaultRoleIDCleared() {
+		_spec.ClearField(registrationpolicy.FieldDefaultRoleID, field.TypeUint32)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RegistrationPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registrationpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
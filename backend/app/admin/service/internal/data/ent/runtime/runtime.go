@@ -13,19 +13,26 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttypei18n"
 	"go-wind-admin/app/admin/service/internal/data/ent/file"
+	"go-wind-admin/app/admin/service/internal/data/ent/fileshare"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapsource"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascript"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
 	"go-wind-admin/app/admin/service/internal/data/ent/membership"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiporgunit"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershipposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiprole"
 	"go-wind-admin/app/admin/service/internal/data/ent/menu"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthclient"
 	"go-wind-admin/app/admin/service/internal/data/ent/operationauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/permission"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionapi"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionauditlog"
@@ -34,15 +41,21 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/policyevaluationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationinvite"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemetadata"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
 	"go-wind-admin/app/admin/service/internal/data/ent/schema"
+	"go-wind-admin/app/admin/service/internal/data/ent/scimtoken"
+	"go-wind-admin/app/admin/service/internal/data/ent/storagequota"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
 	"go-wind-admin/app/admin/service/internal/data/ent/userorgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/userpasswordhistory"
 	"go-wind-admin/app/admin/service/internal/data/ent/userposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
 
@@ -287,6 +300,46 @@ func init() {
 	fileDescID := fileMixinFields0[0].Descriptor()
 	// file.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	file.IDValidator = fileDescID.Validators[0].(func(uint32) error)
+	fileshareMixin := schema.FileShare{}.Mixin()
+	fileshare.Policy = privacy.NewPolicies(fileshareMixin[3], schema.FileShare{})
+	fileshare.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := fileshare.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	fileshareMixinFields0 := fileshareMixin[0].Fields()
+	_ = fileshareMixinFields0
+	fileshareMixinFields3 := fileshareMixin[3].Fields()
+	_ = fileshareMixinFields3
+	fileshareFields := schema.FileShare{}.Fields()
+	_ = fileshareFields
+	// fileshareDescTenantID is the schema descriptor for tenant_id field.
+	fileshareDescTenantID := fileshareMixinFields3[0].Descriptor()
+	// fileshare.DefaultTenantID holds the default value on creation for the tenant_id field.
+	fileshare.DefaultTenantID = fileshareDescTenantID.Default.(uint32)
+	// fileshareDescSlug is the schema descriptor for slug field.
+	fileshareDescSlug := fileshareFields[1].Descriptor()
+	// fileshare.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	fileshare.SlugValidator = fileshareDescSlug.Validators[0].(func(string) error)
+	// fileshareDescMaxDownloads is the schema descriptor for max_downloads field.
+	fileshareDescMaxDownloads := fileshareFields[4].Descriptor()
+	// fileshare.DefaultMaxDownloads holds the default value on creation for the max_downloads field.
+	fileshare.DefaultMaxDownloads = fileshareDescMaxDownloads.Default.(uint32)
+	// fileshareDescDownloadCount is the schema descriptor for download_count field.
+	fileshareDescDownloadCount := fileshareFields[5].Descriptor()
+	// fileshare.DefaultDownloadCount holds the default value on creation for the download_count field.
+	fileshare.DefaultDownloadCount = fileshareDescDownloadCount.Default.(uint32)
+	// fileshareDescDisabled is the schema descriptor for disabled field.
+	fileshareDescDisabled := fileshareFields[8].Descriptor()
+	// fileshare.DefaultDisabled holds the default value on creation for the disabled field.
+	fileshare.DefaultDisabled = fileshareDescDisabled.Default.(bool)
+	// fileshareDescID is the schema descriptor for id field.
+	fileshareDescID := fileshareMixinFields0[0].Descriptor()
+	// fileshare.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	fileshare.IDValidator = fileshareDescID.Validators[0].(func(uint32) error)
 	internalmessageMixin := schema.InternalMessage{}.Mixin()
 	internalmessage.Policy = privacy.NewPolicies(internalmessageMixin[3], schema.InternalMessage{})
 	internalmessage.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -379,6 +432,27 @@ func init() {
 	internalmessagerecipientDescID := internalmessagerecipientMixinFields0[0].Descriptor()
 	// internalmessagerecipient.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	internalmessagerecipient.IDValidator = internalmessagerecipientDescID.Validators[0].(func(uint32) error)
+	jwtsigningkeyMixin := schema.JwtSigningKey{}.Mixin()
+	jwtsigningkeyMixinFields0 := jwtsigningkeyMixin[0].Fields()
+	_ = jwtsigningkeyMixinFields0
+	jwtsigningkeyFields := schema.JwtSigningKey{}.Fields()
+	_ = jwtsigningkeyFields
+	// jwtsigningkeyDescKid is the schema descriptor for kid field.
+	jwtsigningkeyDescKid := jwtsigningkeyFields[0].Descriptor()
+	// jwtsigningkey.KidValidator is a validator for the "kid" field. It is called by the builders before save.
+	jwtsigningkey.KidValidator = jwtsigningkeyDescKid.Validators[0].(func(string) error)
+	// jwtsigningkeyDescPrivateKey is the schema descriptor for private_key field.
+	jwtsigningkeyDescPrivateKey := jwtsigningkeyFields[2].Descriptor()
+	// jwtsigningkey.PrivateKeyValidator is a validator for the "private_key" field. It is called by the builders before save.
+	jwtsigningkey.PrivateKeyValidator = jwtsigningkeyDescPrivateKey.Validators[0].(func(string) error)
+	// jwtsigningkeyDescPublicKey is the schema descriptor for public_key field.
+	jwtsigningkeyDescPublicKey := jwtsigningkeyFields[3].Descriptor()
+	// jwtsigningkey.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	jwtsigningkey.PublicKeyValidator = jwtsigningkeyDescPublicKey.Validators[0].(func(string) error)
+	// jwtsigningkeyDescID is the schema descriptor for id field.
+	jwtsigningkeyDescID := jwtsigningkeyMixinFields0[0].Descriptor()
+	// jwtsigningkey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	jwtsigningkey.IDValidator = jwtsigningkeyDescID.Validators[0].(func(uint32) error)
 	languageMixin := schema.Language{}.Mixin()
 	languageMixinFields0 := languageMixin[0].Fields()
 	_ = languageMixinFields0
@@ -416,6 +490,142 @@ func init() {
 	languageDescID := languageMixinFields0[0].Descriptor()
 	// language.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	language.IDValidator = languageDescID.Validators[0].(func(uint32) error)
+	ldapsourceMixin := schema.LdapSource{}.Mixin()
+	ldapsource.Policy = privacy.NewPolicies(ldapsourceMixin[3], schema.LdapSource{})
+	ldapsource.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := ldapsource.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	ldapsourceMixinFields0 := ldapsourceMixin[0].Fields()
+	_ = ldapsourceMixinFields0
+	ldapsourceMixinFields3 := ldapsourceMixin[3].Fields()
+	_ = ldapsourceMixinFields3
+	ldapsourceFields := schema.LdapSource{}.Fields()
+	_ = ldapsourceFields
+	// ldapsourceDescTenantID is the schema descriptor for tenant_id field.
+	ldapsourceDescTenantID := ldapsourceMixinFields3[0].Descriptor()
+	// ldapsource.DefaultTenantID holds the default value on creation for the tenant_id field.
+	ldapsource.DefaultTenantID = ldapsourceDescTenantID.Default.(uint32)
+	// ldapsourceDescName is the schema descriptor for name field.
+	ldapsourceDescName := ldapsourceFields[0].Descriptor()
+	// ldapsource.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ldapsource.NameValidator = ldapsourceDescName.Validators[0].(func(string) error)
+	// ldapsourceDescEnabled is the schema descriptor for enabled field.
+	ldapsourceDescEnabled := ldapsourceFields[1].Descriptor()
+	// ldapsource.DefaultEnabled holds the default value on creation for the enabled field.
+	ldapsource.DefaultEnabled = ldapsourceDescEnabled.Default.(bool)
+	// ldapsourceDescURL is the schema descriptor for url field.
+	ldapsourceDescURL := ldapsourceFields[2].Descriptor()
+	// ldapsource.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	ldapsource.URLValidator = func() func(string) error {
+		validators := ldapsourceDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ldapsourceDescStartTLS is the schema descriptor for start_tls field.
+	ldapsourceDescStartTLS := ldapsourceFields[3].Descriptor()
+	// ldapsource.DefaultStartTLS holds the default value on creation for the start_tls field.
+	ldapsource.DefaultStartTLS = ldapsourceDescStartTLS.Default.(bool)
+	// ldapsourceDescInsecureSkipVerify is the schema descriptor for insecure_skip_verify field.
+	ldapsourceDescInsecureSkipVerify := ldapsourceFields[4].Descriptor()
+	// ldapsource.DefaultInsecureSkipVerify holds the default value on creation for the insecure_skip_verify field.
+	ldapsource.DefaultInsecureSkipVerify = ldapsourceDescInsecureSkipVerify.Default.(bool)
+	// ldapsourceDescBindDn is the schema descriptor for bind_dn field.
+	ldapsourceDescBindDn := ldapsourceFields[5].Descriptor()
+	// ldapsource.BindDnValidator is a validator for the "bind_dn" field. It is called by the builders before save.
+	ldapsource.BindDnValidator = ldapsourceDescBindDn.Validators[0].(func(string) error)
+	// ldapsourceDescBindPassword is the schema descriptor for bind_password field.
+	ldapsourceDescBindPassword := ldapsourceFields[6].Descriptor()
+	// ldapsource.BindPasswordValidator is a validator for the "bind_password" field. It is called by the builders before save.
+	ldapsource.BindPasswordValidator = ldapsourceDescBindPassword.Validators[0].(func(string) error)
+	// ldapsourceDescBaseDn is the schema descriptor for base_dn field.
+	ldapsourceDescBaseDn := ldapsourceFields[7].Descriptor()
+	// ldapsource.BaseDnValidator is a validator for the "base_dn" field. It is called by the builders before save.
+	ldapsource.BaseDnValidator = func() func(string) error {
+		validators := ldapsourceDescBaseDn.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(base_dn string) error {
+			for _, fn := range fns {
+				if err := fn(base_dn); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ldapsourceDescUserFilter is the schema descriptor for user_filter field.
+	ldapsourceDescUserFilter := ldapsourceFields[8].Descriptor()
+	// ldapsource.UserFilterValidator is a validator for the "user_filter" field. It is called by the builders before save.
+	ldapsource.UserFilterValidator = ldapsourceDescUserFilter.Validators[0].(func(string) error)
+	// ldapsourceDescLoginAttribute is the schema descriptor for login_attribute field.
+	ldapsourceDescLoginAttribute := ldapsourceFields[9].Descriptor()
+	// ldapsource.LoginAttributeValidator is a validator for the "login_attribute" field. It is called by the builders before save.
+	ldapsource.LoginAttributeValidator = ldapsourceDescLoginAttribute.Validators[0].(func(string) error)
+	// ldapsourceDescEmailAttribute is the schema descriptor for email_attribute field.
+	ldapsourceDescEmailAttribute := ldapsourceFields[10].Descriptor()
+	// ldapsource.EmailAttributeValidator is a validator for the "email_attribute" field. It is called by the builders before save.
+	ldapsource.EmailAttributeValidator = ldapsourceDescEmailAttribute.Validators[0].(func(string) error)
+	// ldapsourceDescDisplayNameAttribute is the schema descriptor for display_name_attribute field.
+	ldapsourceDescDisplayNameAttribute := ldapsourceFields[11].Descriptor()
+	// ldapsource.DisplayNameAttributeValidator is a validator for the "display_name_attribute" field. It is called by the builders before save.
+	ldapsource.DisplayNameAttributeValidator = ldapsourceDescDisplayNameAttribute.Validators[0].(func(string) error)
+	// ldapsourceDescMobileAttribute is the schema descriptor for mobile_attribute field.
+	ldapsourceDescMobileAttribute := ldapsourceFields[12].Descriptor()
+	// ldapsource.MobileAttributeValidator is a validator for the "mobile_attribute" field. It is called by the builders before save.
+	ldapsource.MobileAttributeValidator = ldapsourceDescMobileAttribute.Validators[0].(func(string) error)
+	// ldapsourceDescOrgUnitFilter is the schema descriptor for org_unit_filter field.
+	ldapsourceDescOrgUnitFilter := ldapsourceFields[13].Descriptor()
+	// ldapsource.OrgUnitFilterValidator is a validator for the "org_unit_filter" field. It is called by the builders before save.
+	ldapsource.OrgUnitFilterValidator = ldapsourceDescOrgUnitFilter.Validators[0].(func(string) error)
+	// ldapsourceDescGroupBaseDn is the schema descriptor for group_base_dn field.
+	ldapsourceDescGroupBaseDn := ldapsourceFields[14].Descriptor()
+	// ldapsource.GroupBaseDnValidator is a validator for the "group_base_dn" field. It is called by the builders before save.
+	ldapsource.GroupBaseDnValidator = ldapsourceDescGroupBaseDn.Validators[0].(func(string) error)
+	// ldapsourceDescGroupFilter is the schema descriptor for group_filter field.
+	ldapsourceDescGroupFilter := ldapsourceFields[15].Descriptor()
+	// ldapsource.GroupFilterValidator is a validator for the "group_filter" field. It is called by the builders before save.
+	ldapsource.GroupFilterValidator = ldapsourceDescGroupFilter.Validators[0].(func(string) error)
+	// ldapsourceDescGroupMemberAttribute is the schema descriptor for group_member_attribute field.
+	ldapsourceDescGroupMemberAttribute := ldapsourceFields[16].Descriptor()
+	// ldapsource.GroupMemberAttributeValidator is a validator for the "group_member_attribute" field. It is called by the builders before save.
+	ldapsource.GroupMemberAttributeValidator = ldapsourceDescGroupMemberAttribute.Validators[0].(func(string) error)
+	// ldapsourceDescDefaultRoleID is the schema descriptor for default_role_id field.
+	ldapsourceDescDefaultRoleID := ldapsourceFields[18].Descriptor()
+	// ldapsource.DefaultDefaultRoleID holds the default value on creation for the default_role_id field.
+	ldapsource.DefaultDefaultRoleID = ldapsourceDescDefaultRoleID.Default.(uint32)
+	// ldapsourceDescSyncEnabled is the schema descriptor for sync_enabled field.
+	ldapsourceDescSyncEnabled := ldapsourceFields[19].Descriptor()
+	// ldapsource.DefaultSyncEnabled holds the default value on creation for the sync_enabled field.
+	ldapsource.DefaultSyncEnabled = ldapsourceDescSyncEnabled.Default.(bool)
+	// ldapsourceDescDisableMissingUsers is the schema descriptor for disable_missing_users field.
+	ldapsourceDescDisableMissingUsers := ldapsourceFields[20].Descriptor()
+	// ldapsource.DefaultDisableMissingUsers holds the default value on creation for the disable_missing_users field.
+	ldapsource.DefaultDisableMissingUsers = ldapsourceDescDisableMissingUsers.Default.(bool)
+	// ldapsourceDescLastSyncError is the schema descriptor for last_sync_error field.
+	ldapsourceDescLastSyncError := ldapsourceFields[22].Descriptor()
+	// ldapsource.LastSyncErrorValidator is a validator for the "last_sync_error" field. It is called by the builders before save.
+	ldapsource.LastSyncErrorValidator = ldapsourceDescLastSyncError.Validators[0].(func(string) error)
+	// ldapsourceDescID is the schema descriptor for id field.
+	ldapsourceDescID := ldapsourceMixinFields0[0].Descriptor()
+	// ldapsource.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	ldapsource.IDValidator = ldapsourceDescID.Validators[0].(func(uint32) error)
 	loginauditlogMixin := schema.LoginAuditLog{}.Mixin()
 	loginauditlog.Policy = privacy.NewPolicies(loginauditlogMixin[2], schema.LoginAuditLog{})
 	loginauditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -464,6 +674,52 @@ func init() {
 	loginpolicyDescID := loginpolicyMixinFields0[0].Descriptor()
 	// loginpolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	loginpolicy.IDValidator = loginpolicyDescID.Validators[0].(func(uint32) error)
+	luascriptMixin := schema.LuaScript{}.Mixin()
+	luascriptMixinFields0 := luascriptMixin[0].Fields()
+	_ = luascriptMixinFields0
+	luascriptFields := schema.LuaScript{}.Fields()
+	_ = luascriptFields
+	// luascriptDescName is the schema descriptor for name field.
+	luascriptDescName := luascriptFields[0].Descriptor()
+	// luascript.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	luascript.NameValidator = luascriptDescName.Validators[0].(func(string) error)
+	// luascriptDescHook is the schema descriptor for hook field.
+	luascriptDescHook := luascriptFields[1].Descriptor()
+	// luascript.HookValidator is a validator for the "hook" field. It is called by the builders before save.
+	luascript.HookValidator = luascriptDescHook.Validators[0].(func(string) error)
+	// luascriptDescSource is the schema descriptor for source field.
+	luascriptDescSource := luascriptFields[2].Descriptor()
+	// luascript.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	luascript.SourceValidator = luascriptDescSource.Validators[0].(func(string) error)
+	// luascriptDescPriority is the schema descriptor for priority field.
+	luascriptDescPriority := luascriptFields[3].Descriptor()
+	// luascript.DefaultPriority holds the default value on creation for the priority field.
+	luascript.DefaultPriority = luascriptDescPriority.Default.(int32)
+	// luascriptDescEnabled is the schema descriptor for enabled field.
+	luascriptDescEnabled := luascriptFields[4].Descriptor()
+	// luascript.DefaultEnabled holds the default value on creation for the enabled field.
+	luascript.DefaultEnabled = luascriptDescEnabled.Default.(bool)
+	// luascriptDescCritical is the schema descriptor for critical field.
+	luascriptDescCritical := luascriptFields[5].Descriptor()
+	// luascript.DefaultCritical holds the default value on creation for the critical field.
+	luascript.DefaultCritical = luascriptDescCritical.Default.(bool)
+	// luascriptDescVersion is the schema descriptor for version field.
+	luascriptDescVersion := luascriptFields[6].Descriptor()
+	// luascript.DefaultVersion holds the default value on creation for the version field.
+	luascript.DefaultVersion = luascriptDescVersion.Default.(uint32)
+	// luascriptDescID is the schema descriptor for id field.
+	luascriptDescID := luascriptMixinFields0[0].Descriptor()
+	// luascript.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	luascript.IDValidator = luascriptDescID.Validators[0].(func(uint32) error)
+	luascriptversionMixin := schema.LuaScriptVersion{}.Mixin()
+	luascriptversionMixinFields0 := luascriptversionMixin[0].Fields()
+	_ = luascriptversionMixinFields0
+	luascriptversionFields := schema.LuaScriptVersion{}.Fields()
+	_ = luascriptversionFields
+	// luascriptversionDescID is the schema descriptor for id field.
+	luascriptversionDescID := luascriptversionMixinFields0[0].Descriptor()
+	// luascriptversion.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	luascriptversion.IDValidator = luascriptversionDescID.Validators[0].(func(uint32) error)
 	membershipMixin := schema.Membership{}.Mixin()
 	membership.Policy = privacy.NewPolicies(membershipMixin[3], schema.Membership{})
 	membership.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -595,6 +851,52 @@ func init() {
 	menuDescID := menuMixinFields0[0].Descriptor()
 	// menu.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	menu.IDValidator = menuDescID.Validators[0].(func(uint32) error)
+	oauthclientMixin := schema.OAuthClient{}.Mixin()
+	oauthclient.Policy = privacy.NewPolicies(oauthclientMixin[4], schema.OAuthClient{})
+	oauthclient.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := oauthclient.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	oauthclientMixinFields0 := oauthclientMixin[0].Fields()
+	_ = oauthclientMixinFields0
+	oauthclientMixinFields3 := oauthclientMixin[3].Fields()
+	_ = oauthclientMixinFields3
+	oauthclientMixinFields4 := oauthclientMixin[4].Fields()
+	_ = oauthclientMixinFields4
+	oauthclientFields := schema.OAuthClient{}.Fields()
+	_ = oauthclientFields
+	// oauthclientDescTenantID is the schema descriptor for tenant_id field.
+	oauthclientDescTenantID := oauthclientMixinFields4[0].Descriptor()
+	// oauthclient.DefaultTenantID holds the default value on creation for the tenant_id field.
+	oauthclient.DefaultTenantID = oauthclientDescTenantID.Default.(uint32)
+	// oauthclientDescClientID is the schema descriptor for client_id field.
+	oauthclientDescClientID := oauthclientFields[0].Descriptor()
+	// oauthclient.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthclient.ClientIDValidator = oauthclientDescClientID.Validators[0].(func(string) error)
+	// oauthclientDescName is the schema descriptor for name field.
+	oauthclientDescName := oauthclientFields[1].Descriptor()
+	// oauthclient.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthclient.NameValidator = oauthclientDescName.Validators[0].(func(string) error)
+	// oauthclientDescSecretHash is the schema descriptor for secret_hash field.
+	oauthclientDescSecretHash := oauthclientFields[3].Descriptor()
+	// oauthclient.SecretHashValidator is a validator for the "secret_hash" field. It is called by the builders before save.
+	oauthclient.SecretHashValidator = oauthclientDescSecretHash.Validators[0].(func(string) error)
+	// oauthclientDescTokenTTL is the schema descriptor for token_ttl field.
+	oauthclientDescTokenTTL := oauthclientFields[7].Descriptor()
+	// oauthclient.DefaultTokenTTL holds the default value on creation for the token_ttl field.
+	oauthclient.DefaultTokenTTL = oauthclientDescTokenTTL.Default.(uint32)
+	// oauthclientDescSecretVersion is the schema descriptor for secret_version field.
+	oauthclientDescSecretVersion := oauthclientFields[9].Descriptor()
+	// oauthclient.DefaultSecretVersion holds the default value on creation for the secret_version field.
+	oauthclient.DefaultSecretVersion = oauthclientDescSecretVersion.Default.(uint32)
+	// oauthclientDescID is the schema descriptor for id field.
+	oauthclientDescID := oauthclientMixinFields0[0].Descriptor()
+	// oauthclient.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	oauthclient.IDValidator = oauthclientDescID.Validators[0].(func(uint32) error)
 	operationauditlogMixin := schema.OperationAuditLog{}.Mixin()
 	operationauditlog.Policy = privacy.NewPolicies(operationauditlogMixin[2], schema.OperationAuditLog{})
 	operationauditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -665,6 +967,62 @@ func init() {
 	orgunitDescID := orgunitMixinFields0[0].Descriptor()
 	// orgunit.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	orgunit.IDValidator = orgunitDescID.Validators[0].(func(uint32) error)
+	passwordpolicyMixin := schema.PasswordPolicy{}.Mixin()
+	passwordpolicy.Policy = privacy.NewPolicies(passwordpolicyMixin[3], schema.PasswordPolicy{})
+	passwordpolicy.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := passwordpolicy.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	passwordpolicyMixinFields0 := passwordpolicyMixin[0].Fields()
+	_ = passwordpolicyMixinFields0
+	passwordpolicyMixinFields3 := passwordpolicyMixin[3].Fields()
+	_ = passwordpolicyMixinFields3
+	passwordpolicyFields := schema.PasswordPolicy{}.Fields()
+	_ = passwordpolicyFields
+	// passwordpolicyDescTenantID is the schema descriptor for tenant_id field.
+	passwordpolicyDescTenantID := passwordpolicyMixinFields3[0].Descriptor()
+	// passwordpolicy.DefaultTenantID holds the default value on creation for the tenant_id field.
+	passwordpolicy.DefaultTenantID = passwordpolicyDescTenantID.Default.(uint32)
+	// passwordpolicyDescMinLength is the schema descriptor for min_length field.
+	passwordpolicyDescMinLength := passwordpolicyFields[0].Descriptor()
+	// passwordpolicy.DefaultMinLength holds the default value on creation for the min_length field.
+	passwordpolicy.DefaultMinLength = passwordpolicyDescMinLength.Default.(uint32)
+	// passwordpolicyDescRequireUppercase is the schema descriptor for require_uppercase field.
+	passwordpolicyDescRequireUppercase := passwordpolicyFields[1].Descriptor()
+	// passwordpolicy.DefaultRequireUppercase holds the default value on creation for the require_uppercase field.
+	passwordpolicy.DefaultRequireUppercase = passwordpolicyDescRequireUppercase.Default.(bool)
+	// passwordpolicyDescRequireLowercase is the schema descriptor for require_lowercase field.
+	passwordpolicyDescRequireLowercase := passwordpolicyFields[2].Descriptor()
+	// passwordpolicy.DefaultRequireLowercase holds the default value on creation for the require_lowercase field.
+	passwordpolicy.DefaultRequireLowercase = passwordpolicyDescRequireLowercase.Default.(bool)
+	// passwordpolicyDescRequireDigit is the schema descriptor for require_digit field.
+	passwordpolicyDescRequireDigit := passwordpolicyFields[3].Descriptor()
+	// passwordpolicy.DefaultRequireDigit holds the default value on creation for the require_digit field.
+	passwordpolicy.DefaultRequireDigit = passwordpolicyDescRequireDigit.Default.(bool)
+	// passwordpolicyDescRequireSymbol is the schema descriptor for require_symbol field.
+	passwordpolicyDescRequireSymbol := passwordpolicyFields[4].Descriptor()
+	// passwordpolicy.DefaultRequireSymbol holds the default value on creation for the require_symbol field.
+	passwordpolicy.DefaultRequireSymbol = passwordpolicyDescRequireSymbol.Default.(bool)
+	// passwordpolicyDescHistoryCount is the schema descriptor for history_count field.
+	passwordpolicyDescHistoryCount := passwordpolicyFields[5].Descriptor()
+	// passwordpolicy.DefaultHistoryCount holds the default value on creation for the history_count field.
+	passwordpolicy.DefaultHistoryCount = passwordpolicyDescHistoryCount.Default.(uint32)
+	// passwordpolicyDescMaxAgeDays is the schema descriptor for max_age_days field.
+	passwordpolicyDescMaxAgeDays := passwordpolicyFields[6].Descriptor()
+	// passwordpolicy.DefaultMaxAgeDays holds the default value on creation for the max_age_days field.
+	passwordpolicy.DefaultMaxAgeDays = passwordpolicyDescMaxAgeDays.Default.(uint32)
+	// passwordpolicyDescRejectBreached is the schema descriptor for reject_breached field.
+	passwordpolicyDescRejectBreached := passwordpolicyFields[7].Descriptor()
+	// passwordpolicy.DefaultRejectBreached holds the default value on creation for the reject_breached field.
+	passwordpolicy.DefaultRejectBreached = passwordpolicyDescRejectBreached.Default.(bool)
+	// passwordpolicyDescID is the schema descriptor for id field.
+	passwordpolicyDescID := passwordpolicyMixinFields0[0].Descriptor()
+	// passwordpolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	passwordpolicy.IDValidator = passwordpolicyDescID.Validators[0].(func(uint32) error)
 	permissionMixin := schema.Permission{}.Mixin()
 	permissionMixinFields0 := permissionMixin[0].Fields()
 	_ = permissionMixinFields0
@@ -848,6 +1206,70 @@ func init() {
 	positionDescID := positionMixinFields0[0].Descriptor()
 	// position.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	position.IDValidator = positionDescID.Validators[0].(func(uint32) error)
+	registrationinviteMixin := schema.RegistrationInvite{}.Mixin()
+	registrationinvite.Policy = privacy.NewPolicies(registrationinviteMixin[3], schema.RegistrationInvite{})
+	registrationinvite.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := registrationinvite.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	registrationinviteMixinFields0 := registrationinviteMixin[0].Fields()
+	_ = registrationinviteMixinFields0
+	registrationinviteMixinFields3 := registrationinviteMixin[3].Fields()
+	_ = registrationinviteMixinFields3
+	registrationinviteFields := schema.RegistrationInvite{}.Fields()
+	_ = registrationinviteFields
+	// registrationinviteDescTenantID is the schema descriptor for tenant_id field.
+	registrationinviteDescTenantID := registrationinviteMixinFields3[0].Descriptor()
+	// registrationinvite.DefaultTenantID holds the default value on creation for the tenant_id field.
+	registrationinvite.DefaultTenantID = registrationinviteDescTenantID.Default.(uint32)
+	// registrationinviteDescCodeHash is the schema descriptor for code_hash field.
+	registrationinviteDescCodeHash := registrationinviteFields[0].Descriptor()
+	// registrationinvite.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	registrationinvite.CodeHashValidator = registrationinviteDescCodeHash.Validators[0].(func(string) error)
+	// registrationinviteDescID is the schema descriptor for id field.
+	registrationinviteDescID := registrationinviteMixinFields0[0].Descriptor()
+	// registrationinvite.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	registrationinvite.IDValidator = registrationinviteDescID.Validators[0].(func(uint32) error)
+	registrationpolicyMixin := schema.RegistrationPolicy{}.Mixin()
+	registrationpolicy.Policy = privacy.NewPolicies(registrationpolicyMixin[3], schema.RegistrationPolicy{})
+	registrationpolicy.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := registrationpolicy.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	registrationpolicyMixinFields0 := registrationpolicyMixin[0].Fields()
+	_ = registrationpolicyMixinFields0
+	registrationpolicyMixinFields3 := registrationpolicyMixin[3].Fields()
+	_ = registrationpolicyMixinFields3
+	registrationpolicyFields := schema.RegistrationPolicy{}.Fields()
+	_ = registrationpolicyFields
+	// registrationpolicyDescTenantID is the schema descriptor for tenant_id field.
+	registrationpolicyDescTenantID := registrationpolicyMixinFields3[0].Descriptor()
+	// registrationpolicy.DefaultTenantID holds the default value on creation for the tenant_id field.
+	registrationpolicy.DefaultTenantID = registrationpolicyDescTenantID.Default.(uint32)
+	// registrationpolicyDescRequireEmailVerification is the schema descriptor for require_email_verification field.
+	registrationpolicyDescRequireEmailVerification := registrationpolicyFields[2].Descriptor()
+	// registrationpolicy.DefaultRequireEmailVerification holds the default value on creation for the require_email_verification field.
+	registrationpolicy.DefaultRequireEmailVerification = registrationpolicyDescRequireEmailVerification.Default.(bool)
+	// registrationpolicyDescRequireCaptcha is the schema descriptor for require_captcha field.
+	registrationpolicyDescRequireCaptcha := registrationpolicyFields[3].Descriptor()
+	// registrationpolicy.DefaultRequireCaptcha holds the default value on creation for the require_captcha field.
+	registrationpolicy.DefaultRequireCaptcha = registrationpolicyDescRequireCaptcha.Default.(bool)
+	// registrationpolicyDescDefaultRoleID is the schema descriptor for default_role_id field.
+	registrationpolicyDescDefaultRoleID := registrationpolicyFields[4].Descriptor()
+	// registrationpolicy.DefaultDefaultRoleID holds the default value on creation for the default_role_id field.
+	registrationpolicy.DefaultDefaultRoleID = registrationpolicyDescDefaultRoleID.Default.(uint32)
+	// registrationpolicyDescID is the schema descriptor for id field.
+	registrationpolicyDescID := registrationpolicyMixinFields0[0].Descriptor()
+	// registrationpolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	registrationpolicy.IDValidator = registrationpolicyDescID.Validators[0].(func(uint32) error)
 	roleMixin := schema.Role{}.Mixin()
 	role.Policy = privacy.NewPolicies(roleMixin[6], schema.Role{})
 	role.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -962,6 +1384,100 @@ func init() {
 	rolepermissionDescID := rolepermissionMixinFields0[0].Descriptor()
 	// rolepermission.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	rolepermission.IDValidator = rolepermissionDescID.Validators[0].(func(uint32) error)
+	scimtokenMixin := schema.ScimToken{}.Mixin()
+	scimtoken.Policy = privacy.NewPolicies(scimtokenMixin[3], schema.ScimToken{})
+	scimtoken.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := scimtoken.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	scimtokenMixinFields0 := scimtokenMixin[0].Fields()
+	_ = scimtokenMixinFields0
+	scimtokenMixinFields3 := scimtokenMixin[3].Fields()
+	_ = scimtokenMixinFields3
+	scimtokenFields := schema.ScimToken{}.Fields()
+	_ = scimtokenFields
+	// scimtokenDescTenantID is the schema descriptor for tenant_id field.
+	scimtokenDescTenantID := scimtokenMixinFields3[0].Descriptor()
+	// scimtoken.DefaultTenantID holds the default value on creation for the tenant_id field.
+	scimtoken.DefaultTenantID = scimtokenDescTenantID.Default.(uint32)
+	// scimtokenDescName is the schema descriptor for name field.
+	scimtokenDescName := scimtokenFields[0].Descriptor()
+	// scimtoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	scimtoken.NameValidator = func() func(string) error {
+		validators := scimtokenDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scimtokenDescTokenPrefix is the schema descriptor for token_prefix field.
+	scimtokenDescTokenPrefix := scimtokenFields[1].Descriptor()
+	// scimtoken.TokenPrefixValidator is a validator for the "token_prefix" field. It is called by the builders before save.
+	scimtoken.TokenPrefixValidator = scimtokenDescTokenPrefix.Validators[0].(func(string) error)
+	// scimtokenDescTokenHash is the schema descriptor for token_hash field.
+	scimtokenDescTokenHash := scimtokenFields[2].Descriptor()
+	// scimtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	scimtoken.TokenHashValidator = scimtokenDescTokenHash.Validators[0].(func(string) error)
+	// scimtokenDescID is the schema descriptor for id field.
+	scimtokenDescID := scimtokenMixinFields0[0].Descriptor()
+	// scimtoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	scimtoken.IDValidator = scimtokenDescID.Validators[0].(func(uint32) error)
+	storagequotaMixin := schema.StorageQuota{}.Mixin()
+	storagequota.Policy = privacy.NewPolicies(storagequotaMixin[3], schema.StorageQuota{})
+	storagequota.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := storagequota.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	storagequotaMixinFields0 := storagequotaMixin[0].Fields()
+	_ = storagequotaMixinFields0
+	storagequotaMixinFields3 := storagequotaMixin[3].Fields()
+	_ = storagequotaMixinFields3
+	storagequotaFields := schema.StorageQuota{}.Fields()
+	_ = storagequotaFields
+	// storagequotaDescTenantID is the schema descriptor for tenant_id field.
+	storagequotaDescTenantID := storagequotaMixinFields3[0].Descriptor()
+	// storagequota.DefaultTenantID holds the default value on creation for the tenant_id field.
+	storagequota.DefaultTenantID = storagequotaDescTenantID.Default.(uint32)
+	// storagequotaDescUserID is the schema descriptor for user_id field.
+	storagequotaDescUserID := storagequotaFields[0].Descriptor()
+	// storagequota.DefaultUserID holds the default value on creation for the user_id field.
+	storagequota.DefaultUserID = storagequotaDescUserID.Default.(uint32)
+	// storagequotaDescMaxBytes is the schema descriptor for max_bytes field.
+	storagequotaDescMaxBytes := storagequotaFields[1].Descriptor()
+	// storagequota.DefaultMaxBytes holds the default value on creation for the max_bytes field.
+	storagequota.DefaultMaxBytes = storagequotaDescMaxBytes.Default.(uint64)
+	// storagequotaDescMaxFiles is the schema descriptor for max_files field.
+	storagequotaDescMaxFiles := storagequotaFields[2].Descriptor()
+	// storagequota.DefaultMaxFiles holds the default value on creation for the max_files field.
+	storagequota.DefaultMaxFiles = storagequotaDescMaxFiles.Default.(uint64)
+	// storagequotaDescUsedBytes is the schema descriptor for used_bytes field.
+	storagequotaDescUsedBytes := storagequotaFields[3].Descriptor()
+	// storagequota.DefaultUsedBytes holds the default value on creation for the used_bytes field.
+	storagequota.DefaultUsedBytes = storagequotaDescUsedBytes.Default.(uint64)
+	// storagequotaDescUsedFiles is the schema descriptor for used_files field.
+	storagequotaDescUsedFiles := storagequotaFields[4].Descriptor()
+	// storagequota.DefaultUsedFiles holds the default value on creation for the used_files field.
+	storagequota.DefaultUsedFiles = storagequotaDescUsedFiles.Default.(uint64)
+	// storagequotaDescID is the schema descriptor for id field.
+	storagequotaDescID := storagequotaMixinFields0[0].Descriptor()
+	// storagequota.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	storagequota.IDValidator = storagequotaDescID.Validators[0].(func(uint32) error)
 	taskMixin := schema.Task{}.Mixin()
 	task.Policy = privacy.NewPolicies(taskMixin[4], schema.Task{})
 	task.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -986,10 +1502,54 @@ func init() {
 	taskDescEnable := taskFields[5].Descriptor()
 	// task.DefaultEnable holds the default value on creation for the enable field.
 	task.DefaultEnable = taskDescEnable.Default.(bool)
+	// taskDescPaused is the schema descriptor for paused field.
+	taskDescPaused := taskFields[6].Descriptor()
+	// task.DefaultPaused holds the default value on creation for the paused field.
+	task.DefaultPaused = taskDescPaused.Default.(bool)
 	// taskDescID is the schema descriptor for id field.
 	taskDescID := taskMixinFields0[0].Descriptor()
 	// task.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	task.IDValidator = taskDescID.Validators[0].(func(uint32) error)
+	taskrunMixin := schema.TaskRun{}.Mixin()
+	taskrun.Policy = privacy.NewPolicies(taskrunMixin[2], schema.TaskRun{})
+	taskrun.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := taskrun.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	taskrunMixinFields0 := taskrunMixin[0].Fields()
+	_ = taskrunMixinFields0
+	taskrunMixinFields2 := taskrunMixin[2].Fields()
+	_ = taskrunMixinFields2
+	taskrunFields := schema.TaskRun{}.Fields()
+	_ = taskrunFields
+	// taskrunDescTenantID is the schema descriptor for tenant_id field.
+	taskrunDescTenantID := taskrunMixinFields2[0].Descriptor()
+	// taskrun.DefaultTenantID holds the default value on creation for the tenant_id field.
+	taskrun.DefaultTenantID = taskrunDescTenantID.Default.(uint32)
+	// taskrunDescTypeName is the schema descriptor for type_name field.
+	taskrunDescTypeName := taskrunFields[1].Descriptor()
+	// taskrun.TypeNameValidator is a validator for the "type_name" field. It is called by the builders before save.
+	taskrun.TypeNameValidator = taskrunDescTypeName.Validators[0].(func(string) error)
+	// taskrunDescRetryCount is the schema descriptor for retry_count field.
+	taskrunDescRetryCount := taskrunFields[5].Descriptor()
+	// taskrun.DefaultRetryCount holds the default value on creation for the retry_count field.
+	taskrun.DefaultRetryCount = taskrunDescRetryCount.Default.(int32)
+	// taskrunDescMaxRetry is the schema descriptor for max_retry field.
+	taskrunDescMaxRetry := taskrunFields[6].Descriptor()
+	// taskrun.DefaultMaxRetry holds the default value on creation for the max_retry field.
+	taskrun.DefaultMaxRetry = taskrunDescMaxRetry.Default.(int32)
+	// taskrunDescProgress is the schema descriptor for progress field.
+	taskrunDescProgress := taskrunFields[7].Descriptor()
+	// taskrun.DefaultProgress holds the default value on creation for the progress field.
+	taskrun.DefaultProgress = taskrunDescProgress.Default.(uint32)
+	// taskrunDescID is the schema descriptor for id field.
+	taskrunDescID := taskrunMixinFields0[0].Descriptor()
+	// taskrun.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	taskrun.IDValidator = taskrunDescID.Validators[0].(func(uint32) error)
 	tenantMixin := schema.Tenant{}.Mixin()
 	tenantMixinFields0 := tenantMixin[0].Fields()
 	_ = tenantMixinFields0
@@ -1059,6 +1619,10 @@ func init() {
 	userDescDescription := userFields[9].Descriptor()
 	// user.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	user.DescriptionValidator = userDescDescription.Validators[0].(func(string) error)
+	// userDescExternalID is the schema descriptor for external_id field.
+	userDescExternalID := userFields[11].Descriptor()
+	// user.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	user.ExternalIDValidator = userDescExternalID.Validators[0].(func(string) error)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userMixinFields0[0].Descriptor()
 	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -1135,6 +1699,34 @@ func init() {
 	userorgunitDescID := userorgunitMixinFields0[0].Descriptor()
 	// userorgunit.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	userorgunit.IDValidator = userorgunitDescID.Validators[0].(func(uint32) error)
+	userpasswordhistoryMixin := schema.UserPasswordHistory{}.Mixin()
+	userpasswordhistory.Policy = privacy.NewPolicies(userpasswordhistoryMixin[2], schema.UserPasswordHistory{})
+	userpasswordhistory.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := userpasswordhistory.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userpasswordhistoryMixinFields0 := userpasswordhistoryMixin[0].Fields()
+	_ = userpasswordhistoryMixinFields0
+	userpasswordhistoryMixinFields2 := userpasswordhistoryMixin[2].Fields()
+	_ = userpasswordhistoryMixinFields2
+	userpasswordhistoryFields := schema.UserPasswordHistory{}.Fields()
+	_ = userpasswordhistoryFields
+	// userpasswordhistoryDescTenantID is the schema descriptor for tenant_id field.
+	userpasswordhistoryDescTenantID := userpasswordhistoryMixinFields2[0].Descriptor()
+	// userpasswordhistory.DefaultTenantID holds the default value on creation for the tenant_id field.
+	userpasswordhistory.DefaultTenantID = userpasswordhistoryDescTenantID.Default.(uint32)
+	// userpasswordhistoryDescCredential is the schema descriptor for credential field.
+	userpasswordhistoryDescCredential := userpasswordhistoryFields[1].Descriptor()
+	// userpasswordhistory.CredentialValidator is a validator for the "credential" field. It is called by the builders before save.
+	userpasswordhistory.CredentialValidator = userpasswordhistoryDescCredential.Validators[0].(func(string) error)
+	// userpasswordhistoryDescID is the schema descriptor for id field.
+	userpasswordhistoryDescID := userpasswordhistoryMixinFields0[0].Descriptor()
+	// userpasswordhistory.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	userpasswordhistory.IDValidator = userpasswordhistoryDescID.Validators[0].(func(uint32) error)
 	userpositionMixin := schema.UserPosition{}.Mixin()
 	userposition.Policy = privacy.NewPolicies(userpositionMixin[3], schema.UserPosition{})
 	userposition.Hooks[0] = func(next ent.Mutator) ent.Mutator {
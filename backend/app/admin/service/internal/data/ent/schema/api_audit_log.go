@@ -42,6 +42,16 @@ func (ApiAuditLog) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.Uint32("actor_user_id").
+			Comment("模拟登录时实际操作者的用户ID").
+			Optional().
+			Nillable(),
+
+		field.String("actor_username").
+			Comment("模拟登录时实际操作者的账号名").
+			Optional().
+			Nillable(),
+
 		field.String("ip_address").
 			Comment("IP地址").
 			Optional().
@@ -191,6 +201,10 @@ func (ApiAuditLog) Indexes() []ent.Index {
 		index.Fields("tenant_id", "user_id", "created_at").
 			StorageKey("idx_sys_api_audit_logs_tenant_user_created_at"),
 
+		// 按实际操作者追溯模拟登录期间的请求
+		index.Fields("actor_user_id", "created_at").
+			StorageKey("idx_sys_api_audit_logs_actor_user_created_at"),
+
 		// 按用户名检索（兼容无 user_id 场景）
 		index.Fields("tenant_id", "username", "created_at").
 			StorageKey("idx_sys_api_audit_logs_tenant_username_created_at"),
@@ -98,6 +98,27 @@ func (File) Fields() []ent.Field {
 			Comment("文件内容hash值，防止上传重复文件").
 			Optional().
 			Nillable(),
+
+		field.String("content_type").
+			Comment("文件内容类型（MIME）").
+			Optional().
+			Nillable(),
+
+		field.Enum("scan_status").
+			Comment("内容扫描状态").
+			NamedValues(
+				"Unscanned", "UNSCANNED",
+				"Clean", "CLEAN",
+				"Quarantined", "QUARANTINED",
+			).
+			Default("UNSCANNED").
+			Optional().
+			Nillable(),
+
+		field.String("scan_result").
+			Comment("扫描结果，如命中的威胁特征或扫描失败原因").
+			Optional().
+			Nillable(),
 	}
 }
 
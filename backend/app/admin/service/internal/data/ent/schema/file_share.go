@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// FileShare holds the schema definition for the FileShare entity.
+type FileShare struct {
+	ent.Schema
+}
+
+func (FileShare) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "file_shares",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("文件分享链接表"),
+	}
+}
+
+// Fields of the FileShare.
+func (FileShare) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("file_id").
+			Comment("分享的文件ID"),
+
+		field.String("slug").
+			Comment("分享链接标识").
+			Unique().
+			NotEmpty().
+			Immutable(),
+
+		field.String("password_hash").
+			Comment("访问密码哈希，为空表示无需密码").
+			Sensitive().
+			Optional().
+			Nillable(),
+
+		field.Time("expires_at").
+			Comment("过期时间，为空表示永不过期").
+			Optional().
+			Nillable(),
+
+		field.Uint32("max_downloads").
+			Comment("最大下载次数，0代表不限制").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Uint32("download_count").
+			Comment("已下载次数").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.JSON("allowed_tenant_ids", []uint32{}).
+			Comment("允许访问的租户ID，为空表示不限制").
+			Optional(),
+
+		field.JSON("allowed_user_ids", []uint32{}).
+			Comment("允许访问的用户ID，为空表示不限制").
+			Optional(),
+
+		field.Bool("disabled").
+			Comment("是否已停用").
+			Default(false).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the FileShare.
+func (FileShare) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the FileShare.
+func (FileShare) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "file_id").
+			StorageKey("idx_file_shares_tenant_file"),
+	}
+}
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// JwtSigningKey holds the schema definition for the JwtSigningKey entity.
+type JwtSigningKey struct {
+	ent.Schema
+}
+
+func (JwtSigningKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_jwt_signing_keys",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("JWT签名密钥表"),
+	}
+}
+
+// Fields of the JwtSigningKey.
+func (JwtSigningKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("kid").
+			Comment("密钥ID，即公钥的JWK指纹").
+			NotEmpty().
+			Immutable(),
+
+		field.Enum("algorithm").
+			Comment("签名算法").
+			NamedValues(
+				"RS256", "RS256",
+				"ES256", "ES256",
+			).
+			Immutable(),
+
+		field.Text("private_key").
+			Comment("PKCS#8 PEM格式的私钥，配置了加密密钥时加密保存").
+			Sensitive().
+			NotEmpty(),
+
+		field.Text("public_key").
+			Comment("PKIX PEM格式的公钥").
+			NotEmpty(),
+
+		field.Enum("status").
+			Comment("密钥状态").
+			NamedValues(
+				"Active", "ACTIVE",
+				"Retired", "RETIRED",
+			).
+			Default("ACTIVE"),
+
+		field.Time("retired_at").
+			Comment("退役时间").
+			Optional().
+			Nillable(),
+
+		field.Time("expires_at").
+			Comment("宽限期结束时间，之后不再用于验签").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the JwtSigningKey.
+func (JwtSigningKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+	}
+}
+
+// Indexes of the JwtSigningKey.
+func (JwtSigningKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kid").
+			Unique().
+			StorageKey("uix_sys_jwt_signing_key_kid"),
+
+		index.Fields("status").
+			StorageKey("idx_sys_jwt_signing_key_status"),
+	}
+}
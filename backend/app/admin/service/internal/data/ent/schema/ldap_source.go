@@ -0,0 +1,187 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// LdapSource holds the schema definition for the LdapSource entity.
+type LdapSource struct {
+	ent.Schema
+}
+
+func (LdapSource) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_ldap_sources",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("LDAP身份源表"),
+	}
+}
+
+// Fields of the LdapSource.
+func (LdapSource) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("名称").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+
+		field.Bool("enabled").
+			Comment("是否启用").
+			Default(true).
+			Optional().
+			Nillable(),
+
+		field.String("url").
+			Comment("服务地址").
+			MaxLen(512).
+			NotEmpty(),
+
+		field.Bool("start_tls").
+			Comment("连接后升级为TLS").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Bool("insecure_skip_verify").
+			Comment("跳过证书校验").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.String("bind_dn").
+			Comment("服务账号DN").
+			MaxLen(512).
+			Optional().
+			Nillable(),
+
+		field.String("bind_password").
+			Comment("服务账号密码，配置了加密密钥时加密保存").
+			Sensitive().
+			MaxLen(1024).
+			Optional().
+			Nillable(),
+
+		field.String("base_dn").
+			Comment("搜索根").
+			MaxLen(512).
+			NotEmpty(),
+
+		field.String("user_filter").
+			Comment("用户过滤条件").
+			MaxLen(512).
+			Optional().
+			Nillable(),
+
+		field.String("login_attribute").
+			Comment("登录名属性").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+
+		field.String("email_attribute").
+			Comment("邮箱属性").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+
+		field.String("display_name_attribute").
+			Comment("显示名属性").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+
+		field.String("mobile_attribute").
+			Comment("手机号属性").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+
+		field.String("org_unit_filter").
+			Comment("组织单元过滤条件，为空字符串时不同步组织").
+			MaxLen(512).
+			Optional().
+			Nillable(),
+
+		field.String("group_base_dn").
+			Comment("组搜索根").
+			MaxLen(512).
+			Optional().
+			Nillable(),
+
+		field.String("group_filter").
+			Comment("组过滤条件").
+			MaxLen(512).
+			Optional().
+			Nillable(),
+
+		field.String("group_member_attribute").
+			Comment("组成员属性").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+
+		field.JSON("group_role_mappings", map[string]uint32{}).
+			Comment("组DN到角色ID的映射").
+			Optional(),
+
+		field.Uint32("default_role_id").
+			Comment("默认角色ID").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Bool("sync_enabled").
+			Comment("是否参与定时同步").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Bool("disable_missing_users").
+			Comment("同步时禁用目录中已不存在的用户").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Time("last_sync_at").
+			Comment("最近一次同步时间").
+			Optional().
+			Nillable(),
+
+		field.String("last_sync_error").
+			Comment("最近一次同步的错误").
+			MaxLen(1024).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the LdapSource.
+func (LdapSource) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the LdapSource.
+func (LdapSource) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个租户只有一个LDAP身份源
+		index.Fields("tenant_id").
+			Unique().
+			StorageKey("uix_sys_ldap_sources_tenant_id"),
+	}
+}
@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// LuaScript holds the schema definition for the LuaScript entity.
+type LuaScript struct {
+	ent.Schema
+}
+
+func (LuaScript) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_lua_scripts",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("Lua脚本表"),
+	}
+}
+
+// Fields of the LuaScript.
+func (LuaScript) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("脚本名称").
+			NotEmpty(),
+
+		field.String("hook").
+			Comment("挂载的钩子点").
+			NotEmpty(),
+
+		field.Text("source").
+			Comment("Lua源代码").
+			NotEmpty(),
+
+		field.Int32("priority").
+			Comment("执行优先级，数值越小越先执行").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Bool("enabled").
+			Comment("是否启用").
+			Default(true).
+			Optional().
+			Nillable(),
+
+		field.Bool("critical").
+			Comment("是否为关键脚本").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Uint32("version").
+			Comment("版本号，每次保存递增").
+			Default(1).
+			Optional().
+			Nillable(),
+
+		field.String("author").
+			Comment("作者").
+			Optional().
+			Nillable(),
+
+		field.String("description").
+			Comment("描述").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the LuaScript.
+func (LuaScript) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+	}
+}
+
+// Indexes of the LuaScript.
+func (LuaScript) Indexes() []ent.Index {
+	return []ent.Index{
+		// 脚本名称全局唯一，与脚本引擎中的脚本一一对应
+		index.Fields("name").
+			Unique().
+			StorageKey("uix_sys_lua_script_name"),
+
+		// 按钩子点查询脚本
+		index.Fields("hook").
+			StorageKey("idx_sys_lua_script_hook"),
+	}
+}
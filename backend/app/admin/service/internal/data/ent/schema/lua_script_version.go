@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// LuaScriptVersion holds the schema definition for the LuaScriptVersion entity.
+type LuaScriptVersion struct {
+	ent.Schema
+}
+
+func (LuaScriptVersion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_lua_script_versions",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("Lua脚本历史版本表"),
+	}
+}
+
+// Fields of the LuaScriptVersion.
+func (LuaScriptVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("script_id").
+			Comment("脚本ID").
+			Immutable(),
+
+		field.Uint32("version").
+			Comment("版本号").
+			Immutable(),
+
+		field.String("name").
+			Comment("脚本名称").
+			Immutable(),
+
+		field.String("hook").
+			Comment("挂载的钩子点").
+			Immutable(),
+
+		field.Text("source").
+			Comment("Lua源代码").
+			Immutable(),
+
+		field.Int32("priority").
+			Comment("执行优先级").
+			Immutable().
+			Optional().
+			Nillable(),
+
+		field.Bool("enabled").
+			Comment("是否启用").
+			Immutable().
+			Optional().
+			Nillable(),
+
+		field.Bool("critical").
+			Comment("是否为关键脚本").
+			Immutable().
+			Optional().
+			Nillable(),
+
+		field.String("author").
+			Comment("作者").
+			Immutable().
+			Optional().
+			Nillable(),
+
+		field.String("description").
+			Comment("描述").
+			Immutable().
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the LuaScriptVersion.
+func (LuaScriptVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.CreatedAt{},
+		mixin.CreatedBy{},
+	}
+}
+
+// Indexes of the LuaScriptVersion.
+func (LuaScriptVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个脚本的版本号唯一
+		index.Fields("script_id", "version").
+			Unique().
+			StorageKey("uix_sys_lua_script_version_script_version"),
+	}
+}
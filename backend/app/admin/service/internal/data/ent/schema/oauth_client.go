@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// OAuthClient holds the schema definition for the OAuthClient entity.
+type OAuthClient struct {
+	ent.Schema
+}
+
+func (OAuthClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_oauth_clients",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("OAuth客户端表"),
+	}
+}
+
+// Fields of the OAuthClient.
+func (OAuthClient) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("client_id").
+			Comment("客户端ID").
+			NotEmpty().
+			Immutable(),
+
+		field.String("name").
+			Comment("客户端名称").
+			NotEmpty(),
+
+		field.String("description").
+			Comment("描述").
+			Optional().
+			Nillable(),
+
+		field.String("secret_hash").
+			Comment("客户端密钥哈希").
+			Sensitive().
+			NotEmpty(),
+
+		field.String("previous_secret_hash").
+			Comment("轮换前的客户端密钥哈希，在重叠期内仍然有效").
+			Sensitive().
+			Optional().
+			Nillable(),
+
+		field.Time("previous_secret_expires_at").
+			Comment("轮换前的客户端密钥失效时间").
+			Optional().
+			Nillable(),
+
+		field.JSON("scopes", []string{}).
+			Comment("允许的授权范围，即权限码列表").
+			Optional(),
+
+		field.Uint32("token_ttl").
+			Comment("访问令牌有效期（秒），为0时使用系统默认值").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Time("last_used_at").
+			Comment("最后一次换取令牌的时间").
+			Optional().
+			Nillable(),
+
+		field.Uint32("secret_version").
+			Comment("客户端密钥版本，每次轮换加一，令牌记录换取时使用的版本").
+			Default(1),
+
+		field.Enum("data_scope").
+			Comment("客户端令牌的数据权限，取创建或最后修改客户端的操作人的数据权限").
+			NamedValues(
+				"All", "ALL",
+				"Self", "SELF",
+				"UnitOnly", "UNIT_ONLY",
+				"UnitAndChild", "UNIT_AND_CHILD",
+				"SelectedUnits", "SELECTED_UNITS",
+			).
+			Optional().
+			Nillable(),
+
+		field.Uint32("org_unit_id").
+			Comment("客户端令牌所属的组织单元").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the OAuthClient.
+func (OAuthClient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.SwitchStatus{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the OAuthClient.
+func (OAuthClient) Indexes() []ent.Index {
+	return []ent.Index{
+		// 客户端ID全局唯一，换取令牌时不区分租户
+		index.Fields("client_id").
+			Unique().
+			StorageKey("uix_sys_oauth_client_client_id"),
+
+		// 按租户查询客户端
+		index.Fields("tenant_id").
+			StorageKey("idx_sys_oauth_client_tenant_id"),
+	}
+}
@@ -0,0 +1,101 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// PasswordPolicy holds the schema definition for the PasswordPolicy entity.
+type PasswordPolicy struct {
+	ent.Schema
+}
+
+func (PasswordPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_password_policies",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("密码策略表"),
+	}
+}
+
+// Fields of the PasswordPolicy.
+func (PasswordPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("min_length").
+			Comment("最小长度").
+			Default(8).
+			Optional().
+			Nillable(),
+
+		field.Bool("require_uppercase").
+			Comment("必须包含大写字母").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Bool("require_lowercase").
+			Comment("必须包含小写字母").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Bool("require_digit").
+			Comment("必须包含数字").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Bool("require_symbol").
+			Comment("必须包含特殊字符").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Uint32("history_count").
+			Comment("不能与最近使用过的几个密码相同，0代表不限制").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Uint32("max_age_days").
+			Comment("密码最长使用天数，0代表不过期").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Bool("reject_breached").
+			Comment("拒绝已泄露的密码").
+			Default(false).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the PasswordPolicy.
+func (PasswordPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the PasswordPolicy.
+func (PasswordPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个租户只有一条密码策略
+		index.Fields("tenant_id").
+			Unique().
+			StorageKey("uix_sys_password_policies_tenant_id"),
+	}
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// RegistrationInvite holds the schema definition for the RegistrationInvite entity.
+type RegistrationInvite struct {
+	ent.Schema
+}
+
+func (RegistrationInvite) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_registration_invites",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("注册邀请表"),
+	}
+}
+
+// Fields of the RegistrationInvite.
+func (RegistrationInvite) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code_hash").
+			Comment("邀请码哈希").
+			Sensitive().
+			NotEmpty().
+			Immutable(),
+
+		field.String("email").
+			Comment("受邀邮箱，设置后只能用该邮箱注册").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Time("expires_at").
+			Comment("过期时间").
+			Immutable(),
+
+		field.Time("used_at").
+			Comment("使用时间").
+			Optional().
+			Nillable(),
+
+		field.Uint32("used_by").
+			Comment("使用该邀请注册的用户ID").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the RegistrationInvite.
+func (RegistrationInvite) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the RegistrationInvite.
+func (RegistrationInvite) Indexes() []ent.Index {
+	return []ent.Index{
+		// 注册时按邀请码哈希查找
+		index.Fields("code_hash").
+			Unique().
+			StorageKey("uix_sys_registration_invites_code_hash"),
+
+		// 按租户查询邀请
+		index.Fields("tenant_id").
+			StorageKey("idx_sys_registration_invites_tenant_id"),
+	}
+}
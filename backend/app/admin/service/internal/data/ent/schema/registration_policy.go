@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// RegistrationPolicy holds the schema definition for the RegistrationPolicy entity.
+type RegistrationPolicy struct {
+	ent.Schema
+}
+
+func (RegistrationPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_registration_policies",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("注册策略表"),
+	}
+}
+
+// Fields of the RegistrationPolicy.
+func (RegistrationPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("mode").
+			Comment("注册方式").
+			NamedValues(
+				"Closed", "CLOSED",
+				"Open", "OPEN",
+				"InviteOnly", "INVITE_ONLY",
+				"DomainRestricted", "DOMAIN_RESTRICTED",
+			).
+			Default("CLOSED").
+			Optional().
+			Nillable(),
+
+		field.JSON("allowed_domains", []string{}).
+			Comment("允许注册的邮箱域名").
+			Optional(),
+
+		field.Bool("require_email_verification").
+			Comment("须验证邮箱后才能登录").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Bool("require_captcha").
+			Comment("须通过人机验证").
+			Default(false).
+			Optional().
+			Nillable(),
+
+		field.Uint32("default_role_id").
+			Comment("注册用户默认分配的角色ID").
+			Default(0).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the RegistrationPolicy.
+func (RegistrationPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the RegistrationPolicy.
+func (RegistrationPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个租户只有一条注册策略
+		index.Fields("tenant_id").
+			Unique().
+			StorageKey("uix_sys_registration_policies_tenant_id"),
+	}
+}
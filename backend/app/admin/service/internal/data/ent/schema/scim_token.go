@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ScimToken holds the schema definition for the ScimToken entity.
+type ScimToken struct {
+	ent.Schema
+}
+
+func (ScimToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_scim_tokens",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("SCIM 令牌表"),
+	}
+}
+
+// Fields of the ScimToken.
+func (ScimToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("名称").
+			NotEmpty().
+			MaxLen(128),
+
+		field.String("token_prefix").
+			Comment("令牌前缀，用于辨认令牌").
+			NotEmpty().
+			Immutable(),
+
+		field.String("token_hash").
+			Comment("令牌哈希").
+			Sensitive().
+			NotEmpty().
+			Immutable(),
+
+		field.Time("expires_at").
+			Comment("过期时间，为空时不过期").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Time("last_used_at").
+			Comment("最近使用时间").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the ScimToken.
+func (ScimToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the ScimToken.
+func (ScimToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// 鉴权时按令牌哈希查找
+		index.Fields("token_hash").
+			Unique().
+			StorageKey("uix_sys_scim_tokens_token_hash"),
+
+		// 按租户查询令牌
+		index.Fields("tenant_id").
+			StorageKey("idx_sys_scim_tokens_tenant_id"),
+	}
+}
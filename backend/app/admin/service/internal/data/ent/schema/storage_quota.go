@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// StorageQuota holds the schema definition for the StorageQuota entity.
+type StorageQuota struct {
+	ent.Schema
+}
+
+func (StorageQuota) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "storage_quotas",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("存储配额表"),
+	}
+}
+
+// Fields of the StorageQuota.
+func (StorageQuota) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("user_id").
+			Comment("用户ID，0代表租户整体配额").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Uint64("max_bytes").
+			Comment("最大存储字节数，0代表不限制").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Uint64("max_files").
+			Comment("最大文件数，0代表不限制").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Uint64("used_bytes").
+			Comment("已用存储字节数").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Uint64("used_files").
+			Comment("已用文件数").
+			Default(0).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the StorageQuota.
+func (StorageQuota) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the StorageQuota.
+func (StorageQuota) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个租户（或租户内的用户）只有一条配额记录
+		index.Fields("tenant_id", "user_id").
+			Unique().
+			StorageKey("uix_storage_quotas_tenant_user"),
+	}
+}
@@ -73,6 +73,12 @@ func (Task) Fields() []ent.Field {
 			Default(false).
 			Optional().
 			Nillable(),
+
+		field.Bool("paused").
+			Comment("是否已暂停").
+			Default(false).
+			Optional().
+			Nillable(),
 	}
 }
 
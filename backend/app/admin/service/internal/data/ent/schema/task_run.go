@@ -0,0 +1,143 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// TaskRun holds the schema definition for the TaskRun entity.
+type TaskRun struct {
+	ent.Schema
+}
+
+func (TaskRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_task_runs",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("任务执行记录表"),
+	}
+}
+
+// Fields of the TaskRun.
+func (TaskRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("task_id").
+			Comment("调度任务ID，临时投递的任务为空").
+			Optional().
+			Nillable(),
+
+		field.String("type_name").
+			Comment("任务执行类型名").
+			NotEmpty(),
+
+		field.String("queue_task_id").
+			Comment("任务队列中的任务ID").
+			Optional().
+			Nillable(),
+
+		field.String("queue").
+			Comment("任务队列名称").
+			Optional().
+			Nillable(),
+
+		field.Enum("status").
+			Comment("执行状态").
+			NamedValues(
+				"Running", "RUNNING",
+				"Succeeded", "SUCCEEDED",
+				"Failed", "FAILED",
+			).
+			Default("RUNNING").
+			Optional().
+			Nillable(),
+
+		field.Int32("retry_count").
+			Comment("本次执行前已重试的次数").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Int32("max_retry").
+			Comment("最多可重试的次数").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Uint32("progress").
+			Comment("执行进度百分比").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.String("message").
+			Comment("最近一次上报的进度说明").
+			Optional().
+			Nillable(),
+
+		field.Text("error").
+			Comment("失败原因").
+			Optional().
+			Nillable(),
+
+		field.String("result").
+			Comment("执行结果").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional().
+			Nillable(),
+
+		field.Time("started_at").
+			Comment("开始时间").
+			Immutable().
+			Optional().
+			Nillable(),
+
+		field.Time("finished_at").
+			Comment("结束时间").
+			Optional().
+			Nillable(),
+
+		field.Uint64("duration_ms").
+			Comment("执行耗时（毫秒）").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the TaskRun.
+func (TaskRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.CreatedAt{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the TaskRun.
+func (TaskRun) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按任务查询执行历史，以及取最近一次执行
+		index.Fields("task_id", "started_at").
+			StorageKey("idx_sys_task_run_task_started_at"),
+
+		// 按任务类型查询执行历史
+		index.Fields("type_name", "started_at").
+			StorageKey("idx_sys_task_run_type_name_started_at"),
+
+		// 按状态过滤，如查询执行中的任务
+		index.Fields("status", "started_at").
+			StorageKey("idx_sys_task_run_status_started_at"),
+	}
+}
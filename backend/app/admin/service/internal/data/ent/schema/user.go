@@ -102,6 +102,12 @@ func (User) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.String("external_id").
+			Comment("外部系统中的用户标识，由 SCIM 等身份供应方写入").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+
 		field.Time("last_login_at").
 			Comment("最后一次登录的时间").
 			Optional().
@@ -162,6 +168,9 @@ func (User) Indexes() []ent.Index {
 		// 按租户 + 最后登录 IP，用于来源溯源
 		index.Fields("tenant_id", "last_login_ip").StorageKey("idx_sys_user_tenant_last_login_ip"),
 
+		// 按租户 + 外部标识，用于身份供应方按 externalId 查找用户
+		index.Fields("tenant_id", "external_id").StorageKey("idx_sys_user_tenant_external_id"),
+
 		// 按租户 + 操作者，用于审计与变更追溯
 		index.Fields("tenant_id", "created_by").StorageKey("idx_sys_user_tenant_created_by"),
 
@@ -95,6 +95,8 @@ func (UserCredential) Fields() []ent.Field {
 				"SessionCookie", "SESSION_COOKIE",
 				"TemporaryCredential", "TEMPORARY_CREDENTIAL",
 
+				"External", "EXTERNAL",
+
 				"Custom", "CUSTOM",
 				"ReservedForFuture", "RESERVED_FOR_FUTURE",
 			).
@@ -179,6 +181,11 @@ func (UserCredential) Fields() []ent.Field {
 			Comment("重置令牌使用时间").
 			Nillable().
 			Optional(),
+
+		field.Time("password_changed_at").
+			Comment("密码修改时间，用于计算密码是否过期").
+			Nillable().
+			Optional(),
 	}
 }
 
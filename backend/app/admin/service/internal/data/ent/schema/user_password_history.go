@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// UserPasswordHistory holds the schema definition for the UserPasswordHistory entity.
+type UserPasswordHistory struct {
+	ent.Schema
+}
+
+func (UserPasswordHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_user_password_histories",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("用户历史密码表"),
+	}
+}
+
+// Fields of the UserPasswordHistory.
+func (UserPasswordHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("user_id").
+			Comment("用户ID").
+			Immutable(),
+
+		field.String("credential").
+			Comment("密码哈希").
+			Sensitive().
+			NotEmpty().
+			Immutable(),
+	}
+}
+
+// Mixin of the UserPasswordHistory.
+func (UserPasswordHistory) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the UserPasswordHistory.
+func (UserPasswordHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "id").
+			StorageKey("idx_sys_user_password_histories_user_id"),
+	}
+}
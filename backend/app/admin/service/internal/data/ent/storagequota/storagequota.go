@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package storagequota
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the storagequota type in the database.
+	Label = "storage_quota"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldMaxBytes holds the string denoting the max_bytes field in the database.
+	FieldMaxBytes = "max_bytes"
+	// FieldMaxFiles holds the string denoting the max_files field in the database.
+	FieldMaxFiles = "max_files"
+	// FieldUsedBytes holds the string denoting the used_bytes field in the database.
+	FieldUsedBytes = "used_bytes"
+	// FieldUsedFiles holds the string denoting the used_files field in the database.
+	FieldUsedFiles = "used_files"
+	// Table holds the table name of the storagequota in the database.
+	Table = "storage_quotas"
+)
+
+// Columns holds all SQL columns for storagequota fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldDeletedBy,
+	FieldTenantID,
+	FieldUserID,
+	FieldMaxBytes,
+	FieldMaxFiles,
+	FieldUsedBytes,
+	FieldUsedFiles,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DefaultUserID holds the default value on creation for the "user_id" field.
+	DefaultUserID uint32
+	// DefaultMaxBytes holds the default value on creation for the "max_bytes" field.
+	DefaultMaxBytes uint64
+	// DefaultMaxFiles holds the default value on creation for the "max_files" field.
+	DefaultMaxFiles uint64
+	// DefaultUsedBytes holds the default value on creation for the "used_bytes" field.
+	DefaultUsedBytes uint64
+	// DefaultUsedFiles holds the default value on creation for the "used_files" field.
+	DefaultUsedFiles uint64
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// OrderOption defines the ordering options for the StorageQuota queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByMaxBytes orders the results by the max_bytes field.
+func ByMaxBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxBytes, opts...).ToFunc()
+}
+
+// ByMaxFiles orders the results by the max_files field.
+func ByMaxFiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxFiles, opts...).ToFunc()
+}
+
+// ByUsedBytes orders the results by the used_bytes field.
+func ByUsedBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsedBytes, opts...).ToFunc()
+}
+
+// ByUsedFiles orders the results by the used_files field.
+func ByUsedFiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsedFiles, opts...).ToFunc()
+}
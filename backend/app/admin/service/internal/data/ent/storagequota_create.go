@@ -0,0 +1,1458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/storagequota"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StorageQuotaCreate is the builder for creating a StorageQuota entity.
+type StorageQuotaCreate struct {
+	config
+	mutation *StorageQuotaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StorageQuotaCreate) SetCreatedAt(v time.Time) *StorageQuotaCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableCreatedAt(v *time.Time) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *StorageQuotaCreate) SetUpdatedAt(v time.Time) *StorageQuotaCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableUpdatedAt(v *time.Time) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *StorageQuotaCreate) SetDeletedAt(v time.Time) *StorageQuotaCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableDeletedAt(v *time.Time) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *StorageQuotaCreate) SetCreatedBy(v uint32) *StorageQuotaCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableCreatedBy(v *uint32) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *StorageQuotaCreate) SetUpdatedBy(v uint32) *StorageQuotaCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableUpdatedBy(v *uint32) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *StorageQuotaCreate) SetDeletedBy(v uint32) *StorageQuotaCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableDeletedBy(v *uint32) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *StorageQuotaCreate) SetTenantID(v uint32) *StorageQuotaCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableTenantID(v *uint32) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *StorageQuotaCreate) SetUserID(v uint32) *StorageQuotaCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableUserID(v *uint32) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_c *StorageQuotaCreate) SetMaxBytes(v uint64) *StorageQuotaCreate {
+	_c.mutation.SetMaxBytes(v)
+	return _c
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableMaxBytes(v *uint64) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetMaxBytes(*v)
+	}
+	return _c
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (_c *StorageQuotaCreate) SetMaxFiles(v uint64) *StorageQuotaCreate {
+	_c.mutation.SetMaxFiles(v)
+	return _c
+}
+
+// SetNillableMaxFiles sets the "max_files" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableMaxFiles(v *uint64) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetMaxFiles(*v)
+	}
+	return _c
+}
+
+// SetUsedBytes sets the "used_bytes" field.
+func (_c *StorageQuotaCreate) SetUsedBytes(v uint64) *StorageQuotaCreate {
+	_c.mutation.SetUsedBytes(v)
+	return _c
+}
+
+// SetNillableUsedBytes sets the "used_bytes" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableUsedBytes(v *uint64) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetUsedBytes(*v)
+	}
+	return _c
+}
+
+// SetUsedFiles sets the "used_files" field.
+func (_c *StorageQuotaCreate) SetUsedFiles(v uint64) *StorageQuotaCreate {
+	_c.mutation.SetUsedFiles(v)
+	return _c
+}
+
+// SetNillableUsedFiles sets the "used_files" field if the given value is not nil.
+func (_c *StorageQuotaCreate) SetNillableUsedFiles(v *uint64) *StorageQuotaCreate {
+	if v != nil {
+		_c.SetUsedFiles(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *StorageQuotaCreate) SetID(v uint32) *StorageQuotaCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the StorageQuotaMutation object of the builder.
+func (_c *StorageQuotaCreate) Mutation() *StorageQuotaMutation {
+	return _c.mutation
+}
+
+// Save creates the StorageQuota in the database.
+func (_c *StorageQuotaCreate) Save(ctx context.Context) (*StorageQuota, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StorageQuotaCreate) SaveX(ctx context.Context) *StorageQuota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StorageQuotaCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StorageQuotaCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StorageQuotaCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := storagequota.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		v := storagequota.DefaultUserID
+		_c.mutation.SetUserID(v)
+	}
+	if _, ok := _c.mutation.MaxBytes(); !ok {
+		v := storagequota.DefaultMaxBytes
+		_c.mutation.SetMaxBytes(v)
+	}
+	if _, ok := _c.mutation.MaxFiles(); !ok {
+		v := storagequota.DefaultMaxFiles
+		_c.mutation.SetMaxFiles(v)
+	}
+	if _, ok := _c.mutation.UsedBytes(); !ok {
+		v := storagequota.DefaultUsedBytes
+		_c.mutation.SetUsedBytes(v)
+	}
+	if _, ok := _c.mutation.UsedFiles(); !ok {
+		v := storagequota.DefaultUsedFiles
+		_c.mutation.SetUsedFiles(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StorageQuotaCreate) check() error {
+	if v, ok := _c.mutation.ID(); ok {
+		if err := storagequota.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "StorageQuota.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *StorageQuotaCreate) sqlSave(ctx context.Context) (*StorageQuota, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StorageQuotaCreate) createSpec() (*StorageQuota, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StorageQuota{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(storagequota.Table, sqlgraph.NewFieldSpec(storagequota.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(storagequota.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(storagequota.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(storagequota.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(storagequota.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(storagequota.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(storagequota.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(storagequota.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(storagequota.FieldUserID, field.TypeUint32, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.MaxBytes(); ok {
+		_spec.SetField(storagequota.FieldMaxBytes, field.TypeUint64, value)
+		_node.MaxBytes = &value
+	}
+	if value, ok := _c.mutation.MaxFiles(); ok {
+		_spec.SetField(storagequota.FieldMaxFiles, field.TypeUint64, value)
+		_node.MaxFiles = &value
+	}
+	if value, ok := _c.mutation.UsedBytes(); ok {
+		_spec.SetField(storagequota.FieldUsedBytes, field.TypeUint64, value)
+		_node.UsedBytes = &value
+	}
+	if value, ok := _c.mutation.UsedFiles(); ok {
+		_spec.SetField(storagequota.FieldUsedFiles, field.TypeUint64, value)
+		_node.UsedFiles = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StorageQuota.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StorageQuotaUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StorageQuotaCreate) OnConflict(opts ...sql.ConflictOption) *StorageQuotaUpsertOne {
+	_c.conflict = opts
+	return &StorageQuotaUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StorageQuota.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StorageQuotaCreate) OnConflictColumns(columns ...string) *StorageQuotaUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StorageQuotaUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// StorageQuotaUpsertOne is the builder for "upsert"-ing
+	//  one StorageQuota node.
+	StorageQuotaUpsertOne struct {
+		create *StorageQuotaCreate
+	}
+
+	// StorageQuotaUpsert is the "OnConflict" setter.
+	StorageQuotaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *StorageQuotaUpsert) SetUpdatedAt(v time.Time) *StorageQuotaUpsert {
+	u.Set(storagequota.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *StorageQuotaUpsert) UpdateUpdatedAt() *StorageQuotaUpsert {
+	u.SetExcluded(storagequota.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *StorageQuotaUpsert) ClearUpdatedAt() *StorageQuotaUpsert {
+	u.SetNull(storagequota.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *StorageQuotaUpsert) SetDeletedAt(v time.Time) *StorageQuotaUpsert {
+	u.Set(storagequota.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *StorageQuotaUpsert) UpdateDeletedAt() *StorageQuotaUpsert {
+	u.SetExcluded(storagequota.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *StorageQuotaUpsert) ClearDeletedAt() *StorageQuotaUpsert {
+	u.SetNull(storagequota.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *StorageQuotaUpsert) SetCreatedBy(v uint32) *StorageQuotaUpsert {
+	u.Set(storagequota.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *StorageQuotaUpsert) UpdateCreatedBy() *StorageQuotaUpsert {
+	u.SetExcluded(storagequota.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *StorageQuotaUpsert) AddCreatedBy(v uint32) *StorageQuotaUpsert {
+	u.Add(storagequota.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *StorageQuotaUpsert) ClearCreatedBy() *StorageQuotaUpsert {
+	u.SetNull(storagequota.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *StorageQuotaUpsert) SetUpdatedBy(v uint32) *StorageQuotaUpsert {
+	u.Set(storagequota.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *StorageQuotaUpsert) UpdateUpdatedBy() *StorageQuotaUpsert {
+	u.SetExcluded(storagequota.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *StorageQuotaUpsert) AddUpdatedBy(v uint32) *StorageQuotaUpsert {
+	u.Add(storagequota.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *StorageQuotaUpsert) ClearUpdatedBy() *StorageQuotaUpsert {
+	u.SetNull(storagequota.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *StorageQuotaUpsert) SetDeletedBy(v uint32) *StorageQuotaUpsert {
+	u.Set(storagequota.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *StorageQuotaUpsert) UpdateDeletedBy() *StorageQuotaUpsert {
+	u.SetExcluded(storagequota.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *StorageQuotaUpsert) AddDeletedBy(v uint32) *StorageQuotaUpsert {
+	u.Add(storagequota.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *StorageQuotaUpsert) ClearDeletedBy() *StorageQuotaUpsert {
+	u.SetNull(storagequota.FieldDeletedBy)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *StorageQuotaUpsert) SetUserID(v uint32) *StorageQuotaUpsert {
+	u.Set(storagequota.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *StorageQuotaUpsert) UpdateUserID() *StorageQuotaUpsert {
+	u.SetExcluded(storagequota.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *StorageQuotaUpsert) AddUserID(v uint32) *StorageQuotaUpsert {
+	u.Add(storagequota.FieldUserID, v)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *StorageQuotaUpsert) ClearUserID() *StorageQuotaUpsert {
+	u.SetNull(storagequota.FieldUserID)
+	return u
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (u *StorageQuotaUpsert) SetMaxBytes(v uint64) *StorageQuotaUpsert {
+	u.Set(storagequota.FieldMaxBytes, v)
+	return u
+}
+
+// UpdateMaxBytes sets the "max_bytes" field to the value that was provided on create.
+func (u *StorageQuotaUpsert) UpdateMaxBytes() *StorageQuotaUpsert {
+	u.SetExcluded(storagequota.FieldMaxBytes)
+	return u
+}
+
+// AddMaxBytes adds v to the "max_bytes" field.
+func (u *StorageQuotaUpsert) AddMaxBytes(v uint64) *StorageQuotaUpsert {
+	u.Add(storagequota.FieldMaxBytes, v)
+	return u
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (u *StorageQuotaUpsert) ClearMaxBytes() *StorageQuotaUpsert {
+	u.SetNull(storagequota.FieldMaxBytes)
+	return u
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (u *StorageQuotaUpsert) SetMaxFiles(v uint64) *StorageQuotaUpsert {
+	u.Set(storagequota.FieldMaxFiles, v)
+	return u
+}
+
+// UpdateMaxFiles sets the "max_files" field to the value that was provided on create.
+func (u *StorageQuotaUpsert) UpdateMaxFiles() *StorageQuotaUpsert {
+	u.SetExcluded(storagequota.FieldMaxFiles)
+	return u
+}
+
+// AddMaxFiles adds v to the "max_files" field.
+func (u *StorageQuotaUpsert) AddMaxFiles(v uint64) *StorageQuotaUpsert {
+	u.Add(storagequota.FieldMaxFiles, v)
+	return u
+}
+
+// ClearMaxFiles clears the value of the "max_files" field.
+func (u *StorageQuotaUpsert) ClearMaxFiles() *StorageQuotaUpsert {
+	u.SetNull(storagequota.FieldMaxFiles)
+	return u
+}
+
+// SetUsedBytes sets the "used_bytes" field.
+func (u *StorageQuotaUpsert) SetUsedBytes(v uint64) *StorageQuotaUpsert {
+	u.Set(storagequota.FieldUsedBytes, v)
+	return u
+}
+
+// UpdateUsedBytes sets the "used_bytes" field to the value that was provided on create.
+func (u *StorageQuotaUpsert) UpdateUsedBytes() *StorageQuotaUpsert {
+	u.SetExcluded(storagequota.FieldUsedBytes)
+	return u
+}
+
+// AddUsedBytes adds v to the "used_bytes" field.
+func (u *StorageQuotaUpsert) AddUsedBytes(v uint64) *StorageQuotaUpsert {
+	u.Add(storagequota.FieldUsedBytes, v)
+	return u
+}
+
+// ClearUsedBytes clears the value of the "used_bytes" field.
+func (u *StorageQuotaUpsert) ClearUsedBytes() *StorageQuotaUpsert {
+	u.SetNull(storagequota.FieldUsedBytes)
+	return u
+}
+
+// SetUsedFiles sets the "used_files" field.
+func (u *StorageQuotaUpsert) SetUsedFiles(v uint64) *StorageQuotaUpsert {
+	u.Set(storagequota.FieldUsedFiles, v)
+	return u
+}
+
+// UpdateUsedFiles sets the "used_files" field to the value that was provided on create.
+func (u *StorageQuotaUpsert) UpdateUsedFiles() *StorageQuotaUpsert {
+	u.SetExcluded(storagequota.FieldUsedFiles)
+	return u
+}
+
+// AddUsedFiles adds v to the "used_files" field.
+func (u *StorageQuotaUpsert) AddUsedFiles(v uint64) *StorageQuotaUpsert {
+	u.Add(storagequota.FieldUsedFiles, v)
+	return u
+}
+
+// ClearUsedFiles clears the value of the "used_files" field.
+func (u *StorageQuotaUpsert) ClearUsedFiles() *StorageQuotaUpsert {
+	u.SetNull(storagequota.FieldUsedFiles)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.StorageQuota.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(storagequota.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StorageQuotaUpsertOne) UpdateNewValues() *StorageQuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(storagequota.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(storagequota.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(storagequota.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StorageQuota.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *StorageQuotaUpsertOne) Ignore() *StorageQuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StorageQuotaUpsertOne) DoNothing() *StorageQuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StorageQuotaCreate.OnConflict
+// documentation for more info.
+func (u *StorageQuotaUpsertOne) Update(set func(*StorageQuotaUpsert)) *StorageQuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StorageQuotaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *StorageQuotaUpsertOne) SetUpdatedAt(v time.Time) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *StorageQuotaUpsertOne) UpdateUpdatedAt() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *StorageQuotaUpsertOne) ClearUpdatedAt() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *StorageQuotaUpsertOne) SetDeletedAt(v time.Time) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *StorageQuotaUpsertOne) UpdateDeletedAt() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *StorageQuotaUpsertOne) ClearDeletedAt() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *StorageQuotaUpsertOne) SetCreatedBy(v uint32) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *StorageQuotaUpsertOne) AddCreatedBy(v uint32) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *StorageQuotaUpsertOne) UpdateCreatedBy() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *StorageQuotaUpsertOne) ClearCreatedBy() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *StorageQuotaUpsertOne) SetUpdatedBy(v uint32) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *StorageQuotaUpsertOne) AddUpdatedBy(v uint32) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *StorageQuotaUpsertOne) UpdateUpdatedBy() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *StorageQuotaUpsertOne) ClearUpdatedBy() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *StorageQuotaUpsertOne) SetDeletedBy(v uint32) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *StorageQuotaUpsertOne) AddDeletedBy(v uint32) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *StorageQuotaUpsertOne) UpdateDeletedBy() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *StorageQuotaUpsertOne) ClearDeletedBy() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *StorageQuotaUpsertOne) SetUserID(v uint32) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *StorageQuotaUpsertOne) AddUserID(v uint32) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *StorageQuotaUpsertOne) UpdateUserID() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *StorageQuotaUpsertOne) ClearUserID() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (u *StorageQuotaUpsertOne) SetMaxBytes(v uint64) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetMaxBytes(v)
+	})
+}
+
+// AddMaxBytes adds v to the "max_bytes" field.
+func (u *StorageQuotaUpsertOne) AddMaxBytes(v uint64) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddMaxBytes(v)
+	})
+}
+
+// UpdateMaxBytes sets the "max_bytes" field to the value that was provided on create.
+func (u *StorageQuotaUpsertOne) UpdateMaxBytes() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateMaxBytes()
+	})
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (u *StorageQuotaUpsertOne) ClearMaxBytes() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearMaxBytes()
+	})
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (u *StorageQuotaUpsertOne) SetMaxFiles(v uint64) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetMaxFiles(v)
+	})
+}
+
+// AddMaxFiles adds v to the "max_files" field.
+func (u *StorageQuotaUpsertOne) AddMaxFiles(v uint64) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddMaxFiles(v)
+	})
+}
+
+// UpdateMaxFiles sets the "max_files" field to the value that was provided on create.
+func (u *StorageQuotaUpsertOne) UpdateMaxFiles() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateMaxFiles()
+	})
+}
+
+// ClearMaxFiles clears the value of the "max_files" field.
+func (u *StorageQuotaUpsertOne) ClearMaxFiles() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearMaxFiles()
+	})
+}
+
+// SetUsedBytes sets the "used_bytes" field.
+func (u *StorageQuotaUpsertOne) SetUsedBytes(v uint64) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetUsedBytes(v)
+	})
+}
+
+// AddUsedBytes adds v to the "used_bytes" field.
+func (u *StorageQuotaUpsertOne) AddUsedBytes(v uint64) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddUsedBytes(v)
+	})
+}
+
+// UpdateUsedBytes sets the "used_bytes" field to the value that was provided on create.
+func (u *StorageQuotaUpsertOne) UpdateUsedBytes() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateUsedBytes()
+	})
+}
+
+// ClearUsedBytes clears the value of the "used_bytes" field.
+func (u *StorageQuotaUpsertOne) ClearUsedBytes() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearUsedBytes()
+	})
+}
+
+// SetUsedFiles sets the "used_files" field.
+func (u *StorageQuotaUpsertOne) SetUsedFiles(v uint64) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetUsedFiles(v)
+	})
+}
+
+// AddUsedFiles adds v to the "used_files" field.
+func (u *StorageQuotaUpsertOne) AddUsedFiles(v uint64) *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddUsedFiles(v)
+	})
+}
+
+// UpdateUsedFiles sets the "used_files" field to the value that was provided on create.
+func (u *StorageQuotaUpsertOne) UpdateUsedFiles() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateUsedFiles()
+	})
+}
+
+// ClearUsedFiles clears the value of the "used_files" field.
+func (u *StorageQuotaUpsertOne) ClearUsedFiles() *StorageQuotaUpsertOne {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearUsedFiles()
+	})
+}
+
+// Exec executes the query.
+func (u *StorageQuotaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StorageQuotaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StorageQuotaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *StorageQuotaUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *StorageQuotaUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// StorageQuotaCreateBulk is the builder for creating many StorageQuota entities in bulk.
+type StorageQuotaCreateBulk struct {
+	config
+	err      error
+	builders []*StorageQuotaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the StorageQuota entities in the database.
+func (_c *StorageQuotaCreateBulk) Save(ctx context.Context) ([]*StorageQuota, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StorageQuota, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StorageQuotaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StorageQuotaCreateBulk) SaveX(ctx context.Context) []*StorageQuota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StorageQuotaCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StorageQuotaCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StorageQuota.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StorageQuotaUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StorageQuotaCreateBulk) OnConflict(opts ...sql.ConflictOption) *StorageQuotaUpsertBulk {
+	_c.conflict = opts
+	return &StorageQuotaUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StorageQuota.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StorageQuotaCreateBulk) OnConflictColumns(columns ...string) *StorageQuotaUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StorageQuotaUpsertBulk{
+		create: _c,
+	}
+}
+
+// StorageQuotaUpsertBulk is the builder for "upsert"-ing
+// a bulk of StorageQuota nodes.
+type StorageQuotaUpsertBulk struct {
+	create *StorageQuotaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.StorageQuota.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(storagequota.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StorageQuotaUpsertBulk) UpdateNewValues() *StorageQuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(storagequota.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(storagequota.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(storagequota.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StorageQuota.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *StorageQuotaUpsertBulk) Ignore() *StorageQuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StorageQuotaUpsertBulk) DoNothing() *StorageQuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StorageQuotaCreateBulk.OnConflict
+// documentation for more info.
+func (u *StorageQuotaUpsertBulk) Update(set func(*StorageQuotaUpsert)) *StorageQuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StorageQuotaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *StorageQuotaUpsertBulk) SetUpdatedAt(v time.Time) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *StorageQuotaUpsertBulk) UpdateUpdatedAt() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *StorageQuotaUpsertBulk) ClearUpdatedAt() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *StorageQuotaUpsertBulk) SetDeletedAt(v time.Time) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *StorageQuotaUpsertBulk) UpdateDeletedAt() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *StorageQuotaUpsertBulk) ClearDeletedAt() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *StorageQuotaUpsertBulk) SetCreatedBy(v uint32) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *StorageQuotaUpsertBulk) AddCreatedBy(v uint32) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *StorageQuotaUpsertBulk) UpdateCreatedBy() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *StorageQuotaUpsertBulk) ClearCreatedBy() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *StorageQuotaUpsertBulk) SetUpdatedBy(v uint32) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *StorageQuotaUpsertBulk) AddUpdatedBy(v uint32) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *StorageQuotaUpsertBulk) UpdateUpdatedBy() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *StorageQuotaUpsertBulk) ClearUpdatedBy() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *StorageQuotaUpsertBulk) SetDeletedBy(v uint32) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *StorageQuotaUpsertBulk) AddDeletedBy(v uint32) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *StorageQuotaUpsertBulk) UpdateDeletedBy() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *StorageQuotaUpsertBulk) ClearDeletedBy() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *StorageQuotaUpsertBulk) SetUserID(v uint32) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *StorageQuotaUpsertBulk) AddUserID(v uint32) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *StorageQuotaUpsertBulk) UpdateUserID() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *StorageQuotaUpsertBulk) ClearUserID() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (u *StorageQuotaUpsertBulk) SetMaxBytes(v uint64) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetMaxBytes(v)
+	})
+}
+
+// AddMaxBytes adds v to the "max_bytes" field.
+func (u *StorageQuotaUpsertBulk) AddMaxBytes(v uint64) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddMaxBytes(v)
+	})
+}
+
+// UpdateMaxBytes sets the "max_bytes" field to the value that was provided on create.
+func (u *StorageQuotaUpsertBulk) UpdateMaxBytes() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateMaxBytes()
+	})
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (u *StorageQuotaUpsertBulk) ClearMaxBytes() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearMaxBytes()
+	})
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (u *StorageQuotaUpsertBulk) SetMaxFiles(v uint64) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetMaxFiles(v)
+	})
+}
+
+// AddMaxFiles adds v to the "max_files" field.
+func (u *StorageQuotaUpsertBulk) AddMaxFiles(v uint64) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddMaxFiles(v)
+	})
+}
+
+// UpdateMaxFiles sets the "max_files" field to the value that was provided on create.
+func (u *StorageQuotaUpsertBulk) UpdateMaxFiles() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateMaxFiles()
+	})
+}
+
+// ClearMaxFiles clears the value of the "max_files" field.
+func (u *StorageQuotaUpsertBulk) ClearMaxFiles() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearMaxFiles()
+	})
+}
+
+// SetUsedBytes sets the "used_bytes" field.
+func (u *StorageQuotaUpsertBulk) SetUsedBytes(v uint64) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetUsedBytes(v)
+	})
+}
+
+// AddUsedBytes adds v to the "used_bytes" field.
+func (u *StorageQuotaUpsertBulk) AddUsedBytes(v uint64) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddUsedBytes(v)
+	})
+}
+
+// UpdateUsedBytes sets the "used_bytes" field to the value that was provided on create.
+func (u *StorageQuotaUpsertBulk) UpdateUsedBytes() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateUsedBytes()
+	})
+}
+
+// ClearUsedBytes clears the value of the "used_bytes" field.
+func (u *StorageQuotaUpsertBulk) ClearUsedBytes() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearUsedBytes()
+	})
+}
+
+// SetUsedFiles sets the "used_files" field.
+func (u *StorageQuotaUpsertBulk) SetUsedFiles(v uint64) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.SetUsedFiles(v)
+	})
+}
+
+// AddUsedFiles adds v to the "used_files" field.
+func (u *StorageQuotaUpsertBulk) AddUsedFiles(v uint64) *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.AddUsedFiles(v)
+	})
+}
+
+// UpdateUsedFiles sets the "used_files" field to the value that was provided on create.
+func (u *StorageQuotaUpsertBulk) UpdateUsedFiles() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.UpdateUsedFiles()
+	})
+}
+
+// ClearUsedFiles clears the value of the "used_files" field.
+func (u *StorageQuotaUpsertBulk) ClearUsedFiles() *StorageQuotaUpsertBulk {
+	return u.Update(func(s *StorageQuotaUpsert) {
+		s.ClearUsedFiles()
+	})
+}
+
+// Exec executes the query.
+func (u *StorageQuotaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the StorageQuotaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StorageQuotaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StorageQuotaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -47,7 +47,9 @@ type Task struct {
 	// 任务选项
 	TaskOptions *taskpb.TaskOption `json:"task_options,omitempty"`
 	// 启用/禁用任务
-	Enable       *bool `json:"enable,omitempty"`
+	Enable *bool `json:"enable,omitempty"`
+	// 是否已暂停
+	Paused       *bool `json:"paused,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -58,7 +60,7 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case task.FieldTaskOptions:
 			values[i] = new([]byte)
-		case task.FieldEnable:
+		case task.FieldEnable, task.FieldPaused:
 			values[i] = new(sql.NullBool)
 		case task.FieldID, task.FieldCreatedBy, task.FieldUpdatedBy, task.FieldDeletedBy, task.FieldTenantID:
 			values[i] = new(sql.NullInt64)
@@ -186,6 +188,13 @@ func (_m *Task) assignValues(columns []string, values []any) error {
 				_m.Enable = new(bool)
 				*_m.Enable = value.Bool
 			}
+		case task.FieldPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paused", values[i])
+			} else if value.Valid {
+				_m.Paused = new(bool)
+				*_m.Paused = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -289,6 +298,11 @@ func (_m *Task) String() string {
 		builder.WriteString("enable=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.Paused; v != nil {
+		builder.WriteString("paused=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
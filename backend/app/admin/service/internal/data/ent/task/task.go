@@ -42,6 +42,8 @@ const (
 	FieldTaskOptions = "task_options"
 	// FieldEnable holds the string denoting the enable field in the database.
 	FieldEnable = "enable"
+	// FieldPaused holds the string denoting the paused field in the database.
+	FieldPaused = "paused"
 	// Table holds the table name of the task in the database.
 	Table = "sys_tasks"
 )
@@ -63,6 +65,7 @@ var Columns = []string{
 	FieldCronSpec,
 	FieldTaskOptions,
 	FieldEnable,
+	FieldPaused,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -87,6 +90,8 @@ var (
 	DefaultTenantID uint32
 	// DefaultEnable holds the default value on creation for the "enable" field.
 	DefaultEnable bool
+	// DefaultPaused holds the default value on creation for the "paused" field.
+	DefaultPaused bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(uint32) error
 )
@@ -190,3 +195,8 @@ func ByCronSpec(opts ...sql.OrderTermOption) OrderOption {
 func ByEnable(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnable, opts...).ToFunc()
 }
+
+// ByPaused orders the results by the paused field.
+func ByPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaused, opts...).ToFunc()
+}
@@ -114,6 +114,11 @@ func Enable(v bool) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldEnable, v))
 }
 
+// Paused applies equality check predicate on the "paused" field. It's identical to PausedEQ.
+func Paused(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPaused, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreatedAt, v))
@@ -824,6 +829,26 @@ func EnableNotNil() predicate.Task {
 	return predicate.Task(sql.FieldNotNull(FieldEnable))
 }
 
+// PausedEQ applies the EQ predicate on the "paused" field.
+func PausedEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPaused, v))
+}
+
+// PausedNEQ applies the NEQ predicate on the "paused" field.
+func PausedNEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldPaused, v))
+}
+
+// PausedIsNil applies the IsNil predicate on the "paused" field.
+func PausedIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldPaused))
+}
+
+// PausedNotNil applies the NotNil predicate on the "paused" field.
+func PausedNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldPaused))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Task) predicate.Task {
 	return predicate.Task(sql.AndPredicates(predicates...))
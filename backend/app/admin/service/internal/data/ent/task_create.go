@@ -211,6 +211,20 @@ func (_c *TaskCreate) SetNillableEnable(v *bool) *TaskCreate {
 	return _c
 }
 
+// SetPaused sets the "paused" field.
+func (_c *TaskCreate) SetPaused(v bool) *TaskCreate {
+	_c.mutation.SetPaused(v)
+	return _c
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_c *TaskCreate) SetNillablePaused(v *bool) *TaskCreate {
+	if v != nil {
+		_c.SetPaused(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TaskCreate) SetID(v uint32) *TaskCreate {
 	_c.mutation.SetID(v)
@@ -266,6 +280,10 @@ func (_c *TaskCreate) defaults() error {
 		v := task.DefaultEnable
 		_c.mutation.SetEnable(v)
 	}
+	if _, ok := _c.mutation.Paused(); !ok {
+		v := task.DefaultPaused
+		_c.mutation.SetPaused(v)
+	}
 	return nil
 }
 
@@ -375,6 +393,10 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldEnable, field.TypeBool, value)
 		_node.Enable = &value
 	}
+	if value, ok := _c.mutation.Paused(); ok {
+		_spec.SetField(task.FieldPaused, field.TypeBool, value)
+		_node.Paused = &value
+	}
 	return _node, _spec
 }
 
@@ -661,6 +683,24 @@ func (u *TaskUpsert) ClearEnable() *TaskUpsert {
 	return u
 }
 
+// SetPaused sets the "paused" field.
+func (u *TaskUpsert) SetPaused(v bool) *TaskUpsert {
+	u.Set(task.FieldPaused, v)
+	return u
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *TaskUpsert) UpdatePaused() *TaskUpsert {
+	u.SetExcluded(task.FieldPaused)
+	return u
+}
+
+// ClearPaused clears the value of the "paused" field.
+func (u *TaskUpsert) ClearPaused() *TaskUpsert {
+	u.SetNull(task.FieldPaused)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -988,6 +1028,27 @@ func (u *TaskUpsertOne) ClearEnable() *TaskUpsertOne {
 	})
 }
 
+// SetPaused sets the "paused" field.
+func (u *TaskUpsertOne) SetPaused(v bool) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdatePaused() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// ClearPaused clears the value of the "paused" field.
+func (u *TaskUpsertOne) ClearPaused() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearPaused()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1481,6 +1542,27 @@ func (u *TaskUpsertBulk) ClearEnable() *TaskUpsertBulk {
 	})
 }
 
+// SetPaused sets the "paused" field.
+func (u *TaskUpsertBulk) SetPaused(v bool) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdatePaused() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// ClearPaused clears the value of the "paused" field.
+func (u *TaskUpsertBulk) ClearPaused() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearPaused()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -283,6 +283,26 @@ func (_u *TaskUpdate) ClearEnable() *TaskUpdate {
 	return _u
 }
 
+// SetPaused sets the "paused" field.
+func (_u *TaskUpdate) SetPaused(v bool) *TaskUpdate {
+	_u.mutation.SetPaused(v)
+	return _u
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillablePaused(v *bool) *TaskUpdate {
+	if v != nil {
+		_u.SetPaused(*v)
+	}
+	return _u
+}
+
+// ClearPaused clears the value of the "paused" field.
+func (_u *TaskUpdate) ClearPaused() *TaskUpdate {
+	_u.mutation.ClearPaused()
+	return _u
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdate) Mutation() *TaskMutation {
 	return _u.mutation
@@ -435,6 +455,12 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.EnableCleared() {
 		_spec.ClearField(task.FieldEnable, field.TypeBool)
 	}
+	if value, ok := _u.mutation.Paused(); ok {
+		_spec.SetField(task.FieldPaused, field.TypeBool, value)
+	}
+	if _u.mutation.PausedCleared() {
+		_spec.ClearField(task.FieldPaused, field.TypeBool)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -710,6 +736,26 @@ func (_u *TaskUpdateOne) ClearEnable() *TaskUpdateOne {
 	return _u
 }
 
+// SetPaused sets the "paused" field.
+func (_u *TaskUpdateOne) SetPaused(v bool) *TaskUpdateOne {
+	_u.mutation.SetPaused(v)
+	return _u
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillablePaused(v *bool) *TaskUpdateOne {
+	if v != nil {
+		_u.SetPaused(*v)
+	}
+	return _u
+}
+
+// ClearPaused clears the value of the "paused" field.
+func (_u *TaskUpdateOne) ClearPaused() *TaskUpdateOne {
+	_u.mutation.ClearPaused()
+	return _u
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdateOne) Mutation() *TaskMutation {
 	return _u.mutation
@@ -892,6 +938,12 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 	if _u.mutation.EnableCleared() {
 		_spec.ClearField(task.FieldEnable, field.TypeBool)
 	}
+	if value, ok := _u.mutation.Paused(); ok {
+		_spec.SetField(task.FieldPaused, field.TypeBool, value)
+	}
+	if _u.mutation.PausedCleared() {
+		_spec.ClearField(task.FieldPaused, field.TypeBool)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Task{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 任务执行记录表
+type TaskRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 调度任务ID，临时投递的任务为空
+	TaskID *uint32 `json:"task_id,omitempty"`
+	// 任务执行类型名
+	TypeName string `json:"type_name,omitempty"`
+	// 任务队列中的任务ID
+	QueueTaskID *string `json:"queue_task_id,omitempty"`
+	// 任务队列名称
+	Queue *string `json:"queue,omitempty"`
+	// 执行状态
+	Status *taskrun.Status `json:"status,omitempty"`
+	// 本次执行前已重试的次数
+	RetryCount *int32 `json:"retry_count,omitempty"`
+	// 最多可重试的次数
+	MaxRetry *int32 `json:"max_retry,omitempty"`
+	// 执行进度百分比
+	Progress *uint32 `json:"progress,omitempty"`
+	// 最近一次上报的进度说明
+	Message *string `json:"message,omitempty"`
+	// 失败原因
+	Error *string `json:"error,omitempty"`
+	// 执行结果
+	Result *string `json:"result,omitempty"`
+	// 开始时间
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// 结束时间
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// 执行耗时（毫秒）
+	DurationMs   *uint64 `json:"duration_ms,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskrun.FieldID, taskrun.FieldTenantID, taskrun.FieldTaskID, taskrun.FieldRetryCount, taskrun.FieldMaxRetry, taskrun.FieldProgress, taskrun.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case taskrun.FieldTypeName, taskrun.FieldQueueTaskID, taskrun.FieldQueue, taskrun.FieldStatus, taskrun.FieldMessage, taskrun.FieldError, taskrun.FieldResult:
+			values[i] = new(sql.NullString)
+		case taskrun.FieldCreatedAt, taskrun.FieldStartedAt, taskrun.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskRun fields.
+func (_m *TaskRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case taskrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case taskrun.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case taskrun.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				_m.TaskID = new(uint32)
+				*_m.TaskID = uint32(value.Int64)
+			}
+		case taskrun.FieldTypeName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type_name", values[i])
+			} else if value.Valid {
+				_m.TypeName = value.String
+			}
+		case taskrun.FieldQueueTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field queue_task_id", values[i])
+			} else if value.Valid {
+				_m.QueueTaskID = new(string)
+				*_m.QueueTaskID = value.String
+			}
+		case taskrun.FieldQueue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field queue", values[i])
+			} else if value.Valid {
+				_m.Queue = new(string)
+				*_m.Queue = value.String
+			}
+		case taskrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = new(taskrun.Status)
+				*_m.Status = taskrun.Status(value.String)
+			}
+		case taskrun.FieldRetryCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_count", values[i])
+			} else if value.Valid {
+				_m.RetryCount = new(int32)
+				*_m.RetryCount = int32(value.Int64)
+			}
+		case taskrun.FieldMaxRetry:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_retry", values[i])
+			} else if value.Valid {
+				_m.MaxRetry = new(int32)
+				*_m.MaxRetry = int32(value.Int64)
+			}
+		case taskrun.FieldProgress:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field progress", values[i])
+			} else if value.Valid {
+				_m.Progress = new(uint32)
+				*_m.Progress = uint32(value.Int64)
+			}
+		case taskrun.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = new(string)
+				*_m.Message = value.String
+			}
+		case taskrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = new(string)
+				*_m.Error = value.String
+			}
+		case taskrun.FieldResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				_m.Result = new(string)
+				*_m.Result = value.String
+			}
+		case taskrun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = new(time.Time)
+				*_m.StartedAt = value.Time
+			}
+		case taskrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		case taskrun.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = new(uint64)
+				*_m.DurationMs = uint64(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaskRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TaskRun.
+// Note that you need to call TaskRun.Unwrap() before calling this method if this TaskRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaskRun) Update() *TaskRunUpdateOne {
+	return NewTaskRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaskRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaskRun) Unwrap() *TaskRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaskRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TaskID; v != nil {
+		builder.WriteString("task_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("type_name=")
+	builder.WriteString(_m.TypeName)
+	builder.WriteString(", ")
+	if v := _m.QueueTaskID; v != nil {
+		builder.WriteString("queue_task_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Queue; v != nil {
+		builder.WriteString("queue=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Status; v != nil {
+		builder.WriteString("status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RetryCount; v != nil {
+		builder.WriteString("retry_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxRetry; v != nil {
+		builder.WriteString("max_retry=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Progress; v != nil {
+		builder.WriteString("progress=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Message; v != nil {
+		builder.WriteString("message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Result; v != nil {
+		builder.WriteString("result=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DurationMs; v != nil {
+		builder.WriteString("duration_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskRuns is a parsable slice of TaskRun.
+type TaskRuns []*TaskRun
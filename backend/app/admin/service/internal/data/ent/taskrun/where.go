@@ -0,0 +1,1065 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskrun
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TypeName applies equality check predicate on the "type_name" field. It's identical to TypeNameEQ.
+func TypeName(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTypeName, v))
+}
+
+// QueueTaskID applies equality check predicate on the "queue_task_id" field. It's identical to QueueTaskIDEQ.
+func QueueTaskID(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldQueueTaskID, v))
+}
+
+// Queue applies equality check predicate on the "queue" field. It's identical to QueueEQ.
+func Queue(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldQueue, v))
+}
+
+// RetryCount applies equality check predicate on the "retry_count" field. It's identical to RetryCountEQ.
+func RetryCount(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldRetryCount, v))
+}
+
+// MaxRetry applies equality check predicate on the "max_retry" field. It's identical to MaxRetryEQ.
+func MaxRetry(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldMaxRetry, v))
+}
+
+// Progress applies equality check predicate on the "progress" field. It's identical to ProgressEQ.
+func Progress(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldProgress, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldMessage, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldError, v))
+}
+
+// Result applies equality check predicate on the "result" field. It's identical to ResultEQ.
+func Result(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldResult, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldTenantID))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDIsNil applies the IsNil predicate on the "task_id" field.
+func TaskIDIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldTaskID))
+}
+
+// TaskIDNotNil applies the NotNil predicate on the "task_id" field.
+func TaskIDNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldTaskID))
+}
+
+// TypeNameEQ applies the EQ predicate on the "type_name" field.
+func TypeNameEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTypeName, v))
+}
+
+// TypeNameNEQ applies the NEQ predicate on the "type_name" field.
+func TypeNameNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldTypeName, v))
+}
+
+// TypeNameIn applies the In predicate on the "type_name" field.
+func TypeNameIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldTypeName, vs...))
+}
+
+// TypeNameNotIn applies the NotIn predicate on the "type_name" field.
+func TypeNameNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldTypeName, vs...))
+}
+
+// TypeNameGT applies the GT predicate on the "type_name" field.
+func TypeNameGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldTypeName, v))
+}
+
+// TypeNameGTE applies the GTE predicate on the "type_name" field.
+func TypeNameGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldTypeName, v))
+}
+
+// TypeNameLT applies the LT predicate on the "type_name" field.
+func TypeNameLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldTypeName, v))
+}
+
+// TypeNameLTE applies the LTE predicate on the "type_name" field.
+func TypeNameLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldTypeName, v))
+}
+
+// TypeNameContains applies the Contains predicate on the "type_name" field.
+func TypeNameContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldTypeName, v))
+}
+
+// TypeNameHasPrefix applies the HasPrefix predicate on the "type_name" field.
+func TypeNameHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldTypeName, v))
+}
+
+// TypeNameHasSuffix applies the HasSuffix predicate on the "type_name" field.
+func TypeNameHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldTypeName, v))
+}
+
+// TypeNameEqualFold applies the EqualFold predicate on the "type_name" field.
+func TypeNameEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldTypeName, v))
+}
+
+// TypeNameContainsFold applies the ContainsFold predicate on the "type_name" field.
+func TypeNameContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldTypeName, v))
+}
+
+// QueueTaskIDEQ applies the EQ predicate on the "queue_task_id" field.
+func QueueTaskIDEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldQueueTaskID, v))
+}
+
+// QueueTaskIDNEQ applies the NEQ predicate on the "queue_task_id" field.
+func QueueTaskIDNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldQueueTaskID, v))
+}
+
+// QueueTaskIDIn applies the In predicate on the "queue_task_id" field.
+func QueueTaskIDIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldQueueTaskID, vs...))
+}
+
+// QueueTaskIDNotIn applies the NotIn predicate on the "queue_task_id" field.
+func QueueTaskIDNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldQueueTaskID, vs...))
+}
+
+// QueueTaskIDGT applies the GT predicate on the "queue_task_id" field.
+func QueueTaskIDGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldQueueTaskID, v))
+}
+
+// QueueTaskIDGTE applies the GTE predicate on the "queue_task_id" field.
+func QueueTaskIDGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldQueueTaskID, v))
+}
+
+// QueueTaskIDLT applies the LT predicate on the "queue_task_id" field.
+func QueueTaskIDLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldQueueTaskID, v))
+}
+
+// QueueTaskIDLTE applies the LTE predicate on the "queue_task_id" field.
+func QueueTaskIDLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldQueueTaskID, v))
+}
+
+// QueueTaskIDContains applies the Contains predicate on the "queue_task_id" field.
+func QueueTaskIDContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldQueueTaskID, v))
+}
+
+// QueueTaskIDHasPrefix applies the HasPrefix predicate on the "queue_task_id" field.
+func QueueTaskIDHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldQueueTaskID, v))
+}
+
+// QueueTaskIDHasSuffix applies the HasSuffix predicate on the "queue_task_id" field.
+func QueueTaskIDHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldQueueTaskID, v))
+}
+
+// QueueTaskIDIsNil applies the IsNil predicate on the "queue_task_id" field.
+func QueueTaskIDIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldQueueTaskID))
+}
+
+// QueueTaskIDNotNil applies the NotNil predicate on the "queue_task_id" field.
+func QueueTaskIDNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldQueueTaskID))
+}
+
+// QueueTaskIDEqualFold applies the EqualFold predicate on the "queue_task_id" field.
+func QueueTaskIDEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldQueueTaskID, v))
+}
+
+// QueueTaskIDContainsFold applies the ContainsFold predicate on the "queue_task_id" field.
+func QueueTaskIDContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldQueueTaskID, v))
+}
+
+// QueueEQ applies the EQ predicate on the "queue" field.
+func QueueEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldQueue, v))
+}
+
+// QueueNEQ applies the NEQ predicate on the "queue" field.
+func QueueNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldQueue, v))
+}
+
+// QueueIn applies the In predicate on the "queue" field.
+func QueueIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldQueue, vs...))
+}
+
+// QueueNotIn applies the NotIn predicate on the "queue" field.
+func QueueNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldQueue, vs...))
+}
+
+// QueueGT applies the GT predicate on the "queue" field.
+func QueueGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldQueue, v))
+}
+
+// QueueGTE applies the GTE predicate on the "queue" field.
+func QueueGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldQueue, v))
+}
+
+// QueueLT applies the LT predicate on the "queue" field.
+func QueueLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldQueue, v))
+}
+
+// QueueLTE applies the LTE predicate on the "queue" field.
+func QueueLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldQueue, v))
+}
+
+// QueueContains applies the Contains predicate on the "queue" field.
+func QueueContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldQueue, v))
+}
+
+// QueueHasPrefix applies the HasPrefix predicate on the "queue" field.
+func QueueHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldQueue, v))
+}
+
+// QueueHasSuffix applies the HasSuffix predicate on the "queue" field.
+func QueueHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldQueue, v))
+}
+
+// QueueIsNil applies the IsNil predicate on the "queue" field.
+func QueueIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldQueue))
+}
+
+// QueueNotNil applies the NotNil predicate on the "queue" field.
+func QueueNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldQueue))
+}
+
+// QueueEqualFold applies the EqualFold predicate on the "queue" field.
+func QueueEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldQueue, v))
+}
+
+// QueueContainsFold applies the ContainsFold predicate on the "queue" field.
+func QueueContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldQueue, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldStatus))
+}
+
+// RetryCountEQ applies the EQ predicate on the "retry_count" field.
+func RetryCountEQ(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldRetryCount, v))
+}
+
+// RetryCountNEQ applies the NEQ predicate on the "retry_count" field.
+func RetryCountNEQ(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldRetryCount, v))
+}
+
+// RetryCountIn applies the In predicate on the "retry_count" field.
+func RetryCountIn(vs ...int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldRetryCount, vs...))
+}
+
+// RetryCountNotIn applies the NotIn predicate on the "retry_count" field.
+func RetryCountNotIn(vs ...int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldRetryCount, vs...))
+}
+
+// RetryCountGT applies the GT predicate on the "retry_count" field.
+func RetryCountGT(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldRetryCount, v))
+}
+
+// RetryCountGTE applies the GTE predicate on the "retry_count" field.
+func RetryCountGTE(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldRetryCount, v))
+}
+
+// RetryCountLT applies the LT predicate on the "retry_count" field.
+func RetryCountLT(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldRetryCount, v))
+}
+
+// RetryCountLTE applies the LTE predicate on the "retry_count" field.
+func RetryCountLTE(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldRetryCount, v))
+}
+
+// RetryCountIsNil applies the IsNil predicate on the "retry_count" field.
+func RetryCountIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldRetryCount))
+}
+
+// RetryCountNotNil applies the NotNil predicate on the "retry_count" field.
+func RetryCountNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldRetryCount))
+}
+
+// MaxRetryEQ applies the EQ predicate on the "max_retry" field.
+func MaxRetryEQ(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldMaxRetry, v))
+}
+
+// MaxRetryNEQ applies the NEQ predicate on the "max_retry" field.
+func MaxRetryNEQ(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldMaxRetry, v))
+}
+
+// MaxRetryIn applies the In predicate on the "max_retry" field.
+func MaxRetryIn(vs ...int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldMaxRetry, vs...))
+}
+
+// MaxRetryNotIn applies the NotIn predicate on the "max_retry" field.
+func MaxRetryNotIn(vs ...int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldMaxRetry, vs...))
+}
+
+// MaxRetryGT applies the GT predicate on the "max_retry" field.
+func MaxRetryGT(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldMaxRetry, v))
+}
+
+// MaxRetryGTE applies the GTE predicate on the "max_retry" field.
+func MaxRetryGTE(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldMaxRetry, v))
+}
+
+// MaxRetryLT applies the LT predicate on the "max_retry" field.
+func MaxRetryLT(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldMaxRetry, v))
+}
+
+// MaxRetryLTE applies the LTE predicate on the "max_retry" field.
+func MaxRetryLTE(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldMaxRetry, v))
+}
+
+// MaxRetryIsNil applies the IsNil predicate on the "max_retry" field.
+func MaxRetryIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldMaxRetry))
+}
+
+// MaxRetryNotNil applies the NotNil predicate on the "max_retry" field.
+func MaxRetryNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldMaxRetry))
+}
+
+// ProgressEQ applies the EQ predicate on the "progress" field.
+func ProgressEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldProgress, v))
+}
+
+// ProgressNEQ applies the NEQ predicate on the "progress" field.
+func ProgressNEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldProgress, v))
+}
+
+// ProgressIn applies the In predicate on the "progress" field.
+func ProgressIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldProgress, vs...))
+}
+
+// ProgressNotIn applies the NotIn predicate on the "progress" field.
+func ProgressNotIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldProgress, vs...))
+}
+
+// ProgressGT applies the GT predicate on the "progress" field.
+func ProgressGT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldProgress, v))
+}
+
+// ProgressGTE applies the GTE predicate on the "progress" field.
+func ProgressGTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldProgress, v))
+}
+
+// ProgressLT applies the LT predicate on the "progress" field.
+func ProgressLT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldProgress, v))
+}
+
+// ProgressLTE applies the LTE predicate on the "progress" field.
+func ProgressLTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldProgress, v))
+}
+
+// ProgressIsNil applies the IsNil predicate on the "progress" field.
+func ProgressIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldProgress))
+}
+
+// ProgressNotNil applies the NotNil predicate on the "progress" field.
+func ProgressNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldProgress))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldResult, v))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldResult, v))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldResult, vs...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldResult, vs...))
+}
+
+// ResultGT applies the GT predicate on the "result" field.
+func ResultGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldResult, v))
+}
+
+// ResultGTE applies the GTE predicate on the "result" field.
+func ResultGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldResult, v))
+}
+
+// ResultLT applies the LT predicate on the "result" field.
+func ResultLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldResult, v))
+}
+
+// ResultLTE applies the LTE predicate on the "result" field.
+func ResultLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldResult, v))
+}
+
+// ResultContains applies the Contains predicate on the "result" field.
+func ResultContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldResult, v))
+}
+
+// ResultHasPrefix applies the HasPrefix predicate on the "result" field.
+func ResultHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldResult, v))
+}
+
+// ResultHasSuffix applies the HasSuffix predicate on the "result" field.
+func ResultHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldResult, v))
+}
+
+// ResultIsNil applies the IsNil predicate on the "result" field.
+func ResultIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldResult))
+}
+
+// ResultNotNil applies the NotNil predicate on the "result" field.
+func ResultNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldResult))
+}
+
+// ResultEqualFold applies the EqualFold predicate on the "result" field.
+func ResultEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldResult, v))
+}
+
+// ResultContainsFold applies the ContainsFold predicate on the "result" field.
+func ResultContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldResult, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v uint64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// DurationMsIsNil applies the IsNil predicate on the "duration_ms" field.
+func DurationMsIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldDurationMs))
+}
+
+// DurationMsNotNil applies the NotNil predicate on the "duration_ms" field.
+func DurationMsNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldDurationMs))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.NotPredicates(p))
+}
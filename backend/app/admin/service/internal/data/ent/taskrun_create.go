@@ -0,0 +1,1637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskRunCreate is the builder for creating a TaskRun entity.
+type TaskRunCreate struct {
+	config
+	mutation *TaskRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaskRunCreate) SetCreatedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableCreatedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TaskRunCreate) SetTenantID(v uint32) *TaskRunCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableTenantID(v *uint32) *TaskRunCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *TaskRunCreate) SetTaskID(v uint32) *TaskRunCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableTaskID(v *uint32) *TaskRunCreate {
+	if v != nil {
+		_c.SetTaskID(*v)
+	}
+	return _c
+}
+
+// SetTypeName sets the "type_name" field.
+func (_c *TaskRunCreate) SetTypeName(v string) *TaskRunCreate {
+	_c.mutation.SetTypeName(v)
+	return _c
+}
+
+// SetQueueTaskID sets the "queue_task_id" field.
+func (_c *TaskRunCreate) SetQueueTaskID(v string) *TaskRunCreate {
+	_c.mutation.SetQueueTaskID(v)
+	return _c
+}
+
+// SetNillableQueueTaskID sets the "queue_task_id" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableQueueTaskID(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetQueueTaskID(*v)
+	}
+	return _c
+}
+
+// SetQueue sets the "queue" field.
+func (_c *TaskRunCreate) SetQueue(v string) *TaskRunCreate {
+	_c.mutation.SetQueue(v)
+	return _c
+}
+
+// SetNillableQueue sets the "queue" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableQueue(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetQueue(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TaskRunCreate) SetStatus(v taskrun.Status) *TaskRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableStatus(v *taskrun.Status) *TaskRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_c *TaskRunCreate) SetRetryCount(v int32) *TaskRunCreate {
+	_c.mutation.SetRetryCount(v)
+	return _c
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableRetryCount(v *int32) *TaskRunCreate {
+	if v != nil {
+		_c.SetRetryCount(*v)
+	}
+	return _c
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (_c *TaskRunCreate) SetMaxRetry(v int32) *TaskRunCreate {
+	_c.mutation.SetMaxRetry(v)
+	return _c
+}
+
+// SetNillableMaxRetry sets the "max_retry" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableMaxRetry(v *int32) *TaskRunCreate {
+	if v != nil {
+		_c.SetMaxRetry(*v)
+	}
+	return _c
+}
+
+// SetProgress sets the "progress" field.
+func (_c *TaskRunCreate) SetProgress(v uint32) *TaskRunCreate {
+	_c.mutation.SetProgress(v)
+	return _c
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableProgress(v *uint32) *TaskRunCreate {
+	if v != nil {
+		_c.SetProgress(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *TaskRunCreate) SetMessage(v string) *TaskRunCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableMessage(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *TaskRunCreate) SetError(v string) *TaskRunCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableError(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetResult sets the "result" field.
+func (_c *TaskRunCreate) SetResult(v string) *TaskRunCreate {
+	_c.mutation.SetResult(v)
+	return _c
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableResult(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetResult(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *TaskRunCreate) SetStartedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableStartedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *TaskRunCreate) SetFinishedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableFinishedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *TaskRunCreate) SetDurationMs(v uint64) *TaskRunCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableDurationMs(v *uint64) *TaskRunCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TaskRunCreate) SetID(v uint32) *TaskRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (_c *TaskRunCreate) Mutation() *TaskRunMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskRun in the database.
+func (_c *TaskRunCreate) Save(ctx context.Context) (*TaskRun, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskRunCreate) SaveX(ctx context.Context) *TaskRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskRunCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := taskrun.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := taskrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.RetryCount(); !ok {
+		v := taskrun.DefaultRetryCount
+		_c.mutation.SetRetryCount(v)
+	}
+	if _, ok := _c.mutation.MaxRetry(); !ok {
+		v := taskrun.DefaultMaxRetry
+		_c.mutation.SetMaxRetry(v)
+	}
+	if _, ok := _c.mutation.Progress(); !ok {
+		v := taskrun.DefaultProgress
+		_c.mutation.SetProgress(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskRunCreate) check() error {
+	if _, ok := _c.mutation.TypeName(); !ok {
+		return &ValidationError{Name: "type_name", err: errors.New(`ent: missing required field "TaskRun.type_name"`)}
+	}
+	if v, ok := _c.mutation.TypeName(); ok {
+		if err := taskrun.TypeNameValidator(v); err != nil {
+			return &ValidationError{Name: "type_name", err: fmt.Errorf(`ent: validator failed for field "TaskRun.type_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := taskrun.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TaskRun.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TaskRunCreate) sqlSave(ctx context.Context) (*TaskRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskRunCreate) createSpec() (*TaskRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskrun.Table, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taskrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(taskrun.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.TaskID(); ok {
+		_spec.SetField(taskrun.FieldTaskID, field.TypeUint32, value)
+		_node.TaskID = &value
+	}
+	if value, ok := _c.mutation.TypeName(); ok {
+		_spec.SetField(taskrun.FieldTypeName, field.TypeString, value)
+		_node.TypeName = value
+	}
+	if value, ok := _c.mutation.QueueTaskID(); ok {
+		_spec.SetField(taskrun.FieldQueueTaskID, field.TypeString, value)
+		_node.QueueTaskID = &value
+	}
+	if value, ok := _c.mutation.Queue(); ok {
+		_spec.SetField(taskrun.FieldQueue, field.TypeString, value)
+		_node.Queue = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.RetryCount(); ok {
+		_spec.SetField(taskrun.FieldRetryCount, field.TypeInt32, value)
+		_node.RetryCount = &value
+	}
+	if value, ok := _c.mutation.MaxRetry(); ok {
+		_spec.SetField(taskrun.FieldMaxRetry, field.TypeInt32, value)
+		_node.MaxRetry = &value
+	}
+	if value, ok := _c.mutation.Progress(); ok {
+		_spec.SetField(taskrun.FieldProgress, field.TypeUint32, value)
+		_node.Progress = &value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(taskrun.FieldMessage, field.TypeString, value)
+		_node.Message = &value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(taskrun.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := _c.mutation.Result(); ok {
+		_spec.SetField(taskrun.FieldResult, field.TypeString, value)
+		_node.Result = &value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(taskrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(taskrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(taskrun.FieldDurationMs, field.TypeUint64, value)
+		_node.DurationMs = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskRun.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaskRunCreate) OnConflict(opts ...sql.ConflictOption) *TaskRunUpsertOne {
+	_c.conflict = opts
+	return &TaskRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaskRunCreate) OnConflictColumns(columns ...string) *TaskRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaskRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TaskRunUpsertOne is the builder for "upsert"-ing
+	//  one TaskRun node.
+	TaskRunUpsertOne struct {
+		create *TaskRunCreate
+	}
+
+	// TaskRunUpsert is the "OnConflict" setter.
+	TaskRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRunUpsert) SetTaskID(v uint32) *TaskRunUpsert {
+	u.Set(taskrun.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateTaskID() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldTaskID)
+	return u
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *TaskRunUpsert) AddTaskID(v uint32) *TaskRunUpsert {
+	u.Add(taskrun.FieldTaskID, v)
+	return u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRunUpsert) ClearTaskID() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldTaskID)
+	return u
+}
+
+// SetTypeName sets the "type_name" field.
+func (u *TaskRunUpsert) SetTypeName(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldTypeName, v)
+	return u
+}
+
+// UpdateTypeName sets the "type_name" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateTypeName() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldTypeName)
+	return u
+}
+
+// SetQueueTaskID sets the "queue_task_id" field.
+func (u *TaskRunUpsert) SetQueueTaskID(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldQueueTaskID, v)
+	return u
+}
+
+// UpdateQueueTaskID sets the "queue_task_id" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateQueueTaskID() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldQueueTaskID)
+	return u
+}
+
+// ClearQueueTaskID clears the value of the "queue_task_id" field.
+func (u *TaskRunUpsert) ClearQueueTaskID() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldQueueTaskID)
+	return u
+}
+
+// SetQueue sets the "queue" field.
+func (u *TaskRunUpsert) SetQueue(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldQueue, v)
+	return u
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateQueue() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldQueue)
+	return u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (u *TaskRunUpsert) ClearQueue() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldQueue)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRunUpsert) SetStatus(v taskrun.Status) *TaskRunUpsert {
+	u.Set(taskrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateStatus() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskRunUpsert) ClearStatus() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldStatus)
+	return u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *TaskRunUpsert) SetRetryCount(v int32) *TaskRunUpsert {
+	u.Set(taskrun.FieldRetryCount, v)
+	return u
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateRetryCount() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldRetryCount)
+	return u
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *TaskRunUpsert) AddRetryCount(v int32) *TaskRunUpsert {
+	u.Add(taskrun.FieldRetryCount, v)
+	return u
+}
+
+// ClearRetryCount clears the value of the "retry_count" field.
+func (u *TaskRunUpsert) ClearRetryCount() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldRetryCount)
+	return u
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (u *TaskRunUpsert) SetMaxRetry(v int32) *TaskRunUpsert {
+	u.Set(taskrun.FieldMaxRetry, v)
+	return u
+}
+
+// UpdateMaxRetry sets the "max_retry" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateMaxRetry() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldMaxRetry)
+	return u
+}
+
+// AddMaxRetry adds v to the "max_retry" field.
+func (u *TaskRunUpsert) AddMaxRetry(v int32) *TaskRunUpsert {
+	u.Add(taskrun.FieldMaxRetry, v)
+	return u
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (u *TaskRunUpsert) ClearMaxRetry() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldMaxRetry)
+	return u
+}
+
+// SetProgress sets the "progress" field.
+func (u *TaskRunUpsert) SetProgress(v uint32) *TaskRunUpsert {
+	u.Set(taskrun.FieldProgress, v)
+	return u
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateProgress() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldProgress)
+	return u
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *TaskRunUpsert) AddProgress(v uint32) *TaskRunUpsert {
+	u.Add(taskrun.FieldProgress, v)
+	return u
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskRunUpsert) ClearProgress() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldProgress)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *TaskRunUpsert) SetMessage(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateMessage() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *TaskRunUpsert) ClearMessage() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldMessage)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *TaskRunUpsert) SetError(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateError() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *TaskRunUpsert) ClearError() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldError)
+	return u
+}
+
+// SetResult sets the "result" field.
+func (u *TaskRunUpsert) SetResult(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldResult, v)
+	return u
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateResult() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldResult)
+	return u
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *TaskRunUpsert) ClearResult() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldResult)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskRunUpsert) SetFinishedAt(v time.Time) *TaskRunUpsert {
+	u.Set(taskrun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateFinishedAt() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskRunUpsert) ClearFinishedAt() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldFinishedAt)
+	return u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *TaskRunUpsert) SetDurationMs(v uint64) *TaskRunUpsert {
+	u.Set(taskrun.FieldDurationMs, v)
+	return u
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateDurationMs() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldDurationMs)
+	return u
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *TaskRunUpsert) AddDurationMs(v uint64) *TaskRunUpsert {
+	u.Add(taskrun.FieldDurationMs, v)
+	return u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *TaskRunUpsert) ClearDurationMs() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldDurationMs)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taskrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaskRunUpsertOne) UpdateNewValues() *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(taskrun.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taskrun.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(taskrun.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.StartedAt(); exists {
+			s.SetIgnore(taskrun.FieldStartedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskRunUpsertOne) Ignore() *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskRunUpsertOne) DoNothing() *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskRunCreate.OnConflict
+// documentation for more info.
+func (u *TaskRunUpsertOne) Update(set func(*TaskRunUpsert)) *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRunUpsertOne) SetTaskID(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *TaskRunUpsertOne) AddTaskID(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateTaskID() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRunUpsertOne) ClearTaskID() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetTypeName sets the "type_name" field.
+func (u *TaskRunUpsertOne) SetTypeName(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTypeName(v)
+	})
+}
+
+// UpdateTypeName sets the "type_name" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateTypeName() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTypeName()
+	})
+}
+
+// SetQueueTaskID sets the "queue_task_id" field.
+func (u *TaskRunUpsertOne) SetQueueTaskID(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetQueueTaskID(v)
+	})
+}
+
+// UpdateQueueTaskID sets the "queue_task_id" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateQueueTaskID() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateQueueTaskID()
+	})
+}
+
+// ClearQueueTaskID clears the value of the "queue_task_id" field.
+func (u *TaskRunUpsertOne) ClearQueueTaskID() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearQueueTaskID()
+	})
+}
+
+// SetQueue sets the "queue" field.
+func (u *TaskRunUpsertOne) SetQueue(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetQueue(v)
+	})
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateQueue() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateQueue()
+	})
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (u *TaskRunUpsertOne) ClearQueue() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearQueue()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRunUpsertOne) SetStatus(v taskrun.Status) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateStatus() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskRunUpsertOne) ClearStatus() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *TaskRunUpsertOne) SetRetryCount(v int32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetRetryCount(v)
+	})
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *TaskRunUpsertOne) AddRetryCount(v int32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddRetryCount(v)
+	})
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateRetryCount() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateRetryCount()
+	})
+}
+
+// ClearRetryCount clears the value of the "retry_count" field.
+func (u *TaskRunUpsertOne) ClearRetryCount() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearRetryCount()
+	})
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (u *TaskRunUpsertOne) SetMaxRetry(v int32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetMaxRetry(v)
+	})
+}
+
+// AddMaxRetry adds v to the "max_retry" field.
+func (u *TaskRunUpsertOne) AddMaxRetry(v int32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddMaxRetry(v)
+	})
+}
+
+// UpdateMaxRetry sets the "max_retry" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateMaxRetry() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateMaxRetry()
+	})
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (u *TaskRunUpsertOne) ClearMaxRetry() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearMaxRetry()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *TaskRunUpsertOne) SetProgress(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *TaskRunUpsertOne) AddProgress(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateProgress() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskRunUpsertOne) ClearProgress() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearProgress()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *TaskRunUpsertOne) SetMessage(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateMessage() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *TaskRunUpsertOne) ClearMessage() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *TaskRunUpsertOne) SetError(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateError() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *TaskRunUpsertOne) ClearError() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *TaskRunUpsertOne) SetResult(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateResult() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *TaskRunUpsertOne) ClearResult() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearResult()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskRunUpsertOne) SetFinishedAt(v time.Time) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateFinishedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskRunUpsertOne) ClearFinishedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *TaskRunUpsertOne) SetDurationMs(v uint64) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *TaskRunUpsertOne) AddDurationMs(v uint64) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateDurationMs() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *TaskRunUpsertOne) ClearDurationMs() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDurationMs()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskRunUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskRunUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskRunCreateBulk is the builder for creating many TaskRun entities in bulk.
+type TaskRunCreateBulk struct {
+	config
+	err      error
+	builders []*TaskRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskRun entities in the database.
+func (_c *TaskRunCreateBulk) Save(ctx context.Context) ([]*TaskRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskRunCreateBulk) SaveX(ctx context.Context) []*TaskRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaskRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskRunUpsertBulk {
+	_c.conflict = opts
+	return &TaskRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaskRunCreateBulk) OnConflictColumns(columns ...string) *TaskRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaskRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// TaskRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskRun nodes.
+type TaskRunUpsertBulk struct {
+	create *TaskRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taskrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaskRunUpsertBulk) UpdateNewValues() *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(taskrun.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taskrun.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(taskrun.FieldTenantID)
+			}
+			if _, exists := b.mutation.StartedAt(); exists {
+				s.SetIgnore(taskrun.FieldStartedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskRunUpsertBulk) Ignore() *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskRunUpsertBulk) DoNothing() *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskRunUpsertBulk) Update(set func(*TaskRunUpsert)) *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRunUpsertBulk) SetTaskID(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *TaskRunUpsertBulk) AddTaskID(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateTaskID() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRunUpsertBulk) ClearTaskID() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetTypeName sets the "type_name" field.
+func (u *TaskRunUpsertBulk) SetTypeName(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTypeName(v)
+	})
+}
+
+// UpdateTypeName sets the "type_name" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateTypeName() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTypeName()
+	})
+}
+
+// SetQueueTaskID sets the "queue_task_id" field.
+func (u *TaskRunUpsertBulk) SetQueueTaskID(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetQueueTaskID(v)
+	})
+}
+
+// UpdateQueueTaskID sets the "queue_task_id" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateQueueTaskID() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateQueueTaskID()
+	})
+}
+
+// ClearQueueTaskID clears the value of the "queue_task_id" field.
+func (u *TaskRunUpsertBulk) ClearQueueTaskID() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearQueueTaskID()
+	})
+}
+
+// SetQueue sets the "queue" field.
+func (u *TaskRunUpsertBulk) SetQueue(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetQueue(v)
+	})
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateQueue() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateQueue()
+	})
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (u *TaskRunUpsertBulk) ClearQueue() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearQueue()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRunUpsertBulk) SetStatus(v taskrun.Status) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateStatus() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskRunUpsertBulk) ClearStatus() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *TaskRunUpsertBulk) SetRetryCount(v int32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetRetryCount(v)
+	})
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *TaskRunUpsertBulk) AddRetryCount(v int32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddRetryCount(v)
+	})
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateRetryCount() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateRetryCount()
+	})
+}
+
+// ClearRetryCount clears the value of the "retry_count" field.
+func (u *TaskRunUpsertBulk) ClearRetryCount() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearRetryCount()
+	})
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (u *TaskRunUpsertBulk) SetMaxRetry(v int32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetMaxRetry(v)
+	})
+}
+
+// AddMaxRetry adds v to the "max_retry" field.
+func (u *TaskRunUpsertBulk) AddMaxRetry(v int32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddMaxRetry(v)
+	})
+}
+
+// UpdateMaxRetry sets the "max_retry" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateMaxRetry() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateMaxRetry()
+	})
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (u *TaskRunUpsertBulk) ClearMaxRetry() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearMaxRetry()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *TaskRunUpsertBulk) SetProgress(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *TaskRunUpsertBulk) AddProgress(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateProgress() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskRunUpsertBulk) ClearProgress() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearProgress()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *TaskRunUpsertBulk) SetMessage(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateMessage() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *TaskRunUpsertBulk) ClearMessage() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *TaskRunUpsertBulk) SetError(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateError() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *TaskRunUpsertBulk) ClearError() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *TaskRunUpsertBulk) SetResult(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateResult() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *TaskRunUpsertBulk) ClearResult() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearResult()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskRunUpsertBulk) SetFinishedAt(v time.Time) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateFinishedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskRunUpsertBulk) ClearFinishedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *TaskRunUpsertBulk) SetDurationMs(v uint64) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *TaskRunUpsertBulk) AddDurationMs(v uint64) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateDurationMs() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *TaskRunUpsertBulk) ClearDurationMs() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDurationMs()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
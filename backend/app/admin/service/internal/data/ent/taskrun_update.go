@@ -0,0 +1,1004 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskRunUpdate is the builder for updating TaskRun entities.
+type TaskRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaskRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaskRunUpdate builder.
+func (_u *TaskRunUpdate) Where(ps ...predicate.TaskRun) *TaskRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskRunUpdate) SetTaskID(v uint32) *TaskRunUpdate {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableTaskID(v *uint32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *TaskRunUpdate) AddTaskID(v int32) *TaskRunUpdate {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (_u *TaskRunUpdate) ClearTaskID() *TaskRunUpdate {
+	_u.mutation.ClearTaskID()
+	return _u
+}
+
+// SetTypeName sets the "type_name" field.
+func (_u *TaskRunUpdate) SetTypeName(v string) *TaskRunUpdate {
+	_u.mutation.SetTypeName(v)
+	return _u
+}
+
+// SetNillableTypeName sets the "type_name" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableTypeName(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetTypeName(*v)
+	}
+	return _u
+}
+
+// SetQueueTaskID sets the "queue_task_id" field.
+func (_u *TaskRunUpdate) SetQueueTaskID(v string) *TaskRunUpdate {
+	_u.mutation.SetQueueTaskID(v)
+	return _u
+}
+
+// SetNillableQueueTaskID sets the "queue_task_id" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableQueueTaskID(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetQueueTaskID(*v)
+	}
+	return _u
+}
+
+// ClearQueueTaskID clears the value of the "queue_task_id" field.
+func (_u *TaskRunUpdate) ClearQueueTaskID() *TaskRunUpdate {
+	_u.mutation.ClearQueueTaskID()
+	return _u
+}
+
+// SetQueue sets the "queue" field.
+func (_u *TaskRunUpdate) SetQueue(v string) *TaskRunUpdate {
+	_u.mutation.SetQueue(v)
+	return _u
+}
+
+// SetNillableQueue sets the "queue" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableQueue(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetQueue(*v)
+	}
+	return _u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (_u *TaskRunUpdate) ClearQueue() *TaskRunUpdate {
+	_u.mutation.ClearQueue()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TaskRunUpdate) SetStatus(v taskrun.Status) *TaskRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableStatus(v *taskrun.Status) *TaskRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TaskRunUpdate) ClearStatus() *TaskRunUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_u *TaskRunUpdate) SetRetryCount(v int32) *TaskRunUpdate {
+	_u.mutation.ResetRetryCount()
+	_u.mutation.SetRetryCount(v)
+	return _u
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableRetryCount(v *int32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetRetryCount(*v)
+	}
+	return _u
+}
+
+// AddRetryCount adds value to the "retry_count" field.
+func (_u *TaskRunUpdate) AddRetryCount(v int32) *TaskRunUpdate {
+	_u.mutation.AddRetryCount(v)
+	return _u
+}
+
+// ClearRetryCount clears the value of the "retry_count" field.
+func (_u *TaskRunUpdate) ClearRetryCount() *TaskRunUpdate {
+	_u.mutation.ClearRetryCount()
+	return _u
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (_u *TaskRunUpdate) SetMaxRetry(v int32) *TaskRunUpdate {
+	_u.mutation.ResetMaxRetry()
+	_u.mutation.SetMaxRetry(v)
+	return _u
+}
+
+// SetNillableMaxRetry sets the "max_retry" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableMaxRetry(v *int32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetMaxRetry(*v)
+	}
+	return _u
+}
+
+// AddMaxRetry adds value to the "max_retry" field.
+func (_u *TaskRunUpdate) AddMaxRetry(v int32) *TaskRunUpdate {
+	_u.mutation.AddMaxRetry(v)
+	return _u
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (_u *TaskRunUpdate) ClearMaxRetry() *TaskRunUpdate {
+	_u.mutation.ClearMaxRetry()
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *TaskRunUpdate) SetProgress(v uint32) *TaskRunUpdate {
+	_u.mutation.ResetProgress()
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableProgress(v *uint32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetProgress(*v)
+	}
+	return _u
+}
+
+// AddProgress adds value to the "progress" field.
+func (_u *TaskRunUpdate) AddProgress(v int32) *TaskRunUpdate {
+	_u.mutation.AddProgress(v)
+	return _u
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (_u *TaskRunUpdate) ClearProgress() *TaskRunUpdate {
+	_u.mutation.ClearProgress()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *TaskRunUpdate) SetMessage(v string) *TaskRunUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableMessage(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *TaskRunUpdate) ClearMessage() *TaskRunUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *TaskRunUpdate) SetError(v string) *TaskRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableError(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *TaskRunUpdate) ClearError() *TaskRunUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *TaskRunUpdate) SetResult(v string) *TaskRunUpdate {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableResult(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *TaskRunUpdate) ClearResult() *TaskRunUpdate {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TaskRunUpdate) SetFinishedAt(v time.Time) *TaskRunUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableFinishedAt(v *time.Time) *TaskRunUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TaskRunUpdate) ClearFinishedAt() *TaskRunUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *TaskRunUpdate) SetDurationMs(v uint64) *TaskRunUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableDurationMs(v *uint64) *TaskRunUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *TaskRunUpdate) AddDurationMs(v int64) *TaskRunUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *TaskRunUpdate) ClearDurationMs() *TaskRunUpdate {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (_u *TaskRunUpdate) Mutation() *TaskRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskRunUpdate) check() error {
+	if v, ok := _u.mutation.TypeName(); ok {
+		if err := taskrun.TypeNameValidator(v); err != nil {
+			return &ValidationError{Name: "type_name", err: fmt.Errorf(`ent: validator failed for field "TaskRun.type_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaskRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaskRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrun.Table, taskrun.Columns, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(taskrun.FieldCreatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(taskrun.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(taskrun.FieldTaskID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskrun.FieldTaskID, field.TypeUint32, value)
+	}
+	if _u.mutation.TaskIDCleared() {
+		_spec.ClearField(taskrun.FieldTaskID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.TypeName(); ok {
+		_spec.SetField(taskrun.FieldTypeName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QueueTaskID(); ok {
+		_spec.SetField(taskrun.FieldQueueTaskID, field.TypeString, value)
+	}
+	if _u.mutation.QueueTaskIDCleared() {
+		_spec.ClearField(taskrun.FieldQueueTaskID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Queue(); ok {
+		_spec.SetField(taskrun.FieldQueue, field.TypeString, value)
+	}
+	if _u.mutation.QueueCleared() {
+		_spec.ClearField(taskrun.FieldQueue, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(taskrun.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RetryCount(); ok {
+		_spec.SetField(taskrun.FieldRetryCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRetryCount(); ok {
+		_spec.AddField(taskrun.FieldRetryCount, field.TypeInt32, value)
+	}
+	if _u.mutation.RetryCountCleared() {
+		_spec.ClearField(taskrun.FieldRetryCount, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.MaxRetry(); ok {
+		_spec.SetField(taskrun.FieldMaxRetry, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRetry(); ok {
+		_spec.AddField(taskrun.FieldMaxRetry, field.TypeInt32, value)
+	}
+	if _u.mutation.MaxRetryCleared() {
+		_spec.ClearField(taskrun.FieldMaxRetry, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(taskrun.FieldProgress, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedProgress(); ok {
+		_spec.AddField(taskrun.FieldProgress, field.TypeUint32, value)
+	}
+	if _u.mutation.ProgressCleared() {
+		_spec.ClearField(taskrun.FieldProgress, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(taskrun.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(taskrun.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(taskrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(taskrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(taskrun.FieldResult, field.TypeString, value)
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(taskrun.FieldResult, field.TypeString)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(taskrun.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(taskrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(taskrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(taskrun.FieldDurationMs, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(taskrun.FieldDurationMs, field.TypeUint64, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(taskrun.FieldDurationMs, field.TypeUint64)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskRunUpdateOne is the builder for updating a single TaskRun entity.
+type TaskRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskRunUpdateOne) SetTaskID(v uint32) *TaskRunUpdateOne {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableTaskID(v *uint32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *TaskRunUpdateOne) AddTaskID(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (_u *TaskRunUpdateOne) ClearTaskID() *TaskRunUpdateOne {
+	_u.mutation.ClearTaskID()
+	return _u
+}
+
+// SetTypeName sets the "type_name" field.
+func (_u *TaskRunUpdateOne) SetTypeName(v string) *TaskRunUpdateOne {
+	_u.mutation.SetTypeName(v)
+	return _u
+}
+
+// SetNillableTypeName sets the "type_name" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableTypeName(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetTypeName(*v)
+	}
+	return _u
+}
+
+// SetQueueTaskID sets the "queue_task_id" field.
+func (_u *TaskRunUpdateOne) SetQueueTaskID(v string) *TaskRunUpdateOne {
+	_u.mutation.SetQueueTaskID(v)
+	return _u
+}
+
+// SetNillableQueueTaskID sets the "queue_task_id" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableQueueTaskID(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetQueueTaskID(*v)
+	}
+	return _u
+}
+
+// ClearQueueTaskID clears the value of the "queue_task_id" field.
+func (_u *TaskRunUpdateOne) ClearQueueTaskID() *TaskRunUpdateOne {
+	_u.mutation.ClearQueueTaskID()
+	return _u
+}
+
+// SetQueue sets the "queue" field.
+func (_u *TaskRunUpdateOne) SetQueue(v string) *TaskRunUpdateOne {
+	_u.mutation.SetQueue(v)
+	return _u
+}
+
+// SetNillableQueue sets the "queue" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableQueue(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetQueue(*v)
+	}
+	return _u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (_u *TaskRunUpdateOne) ClearQueue() *TaskRunUpdateOne {
+	_u.mutation.ClearQueue()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TaskRunUpdateOne) SetStatus(v taskrun.Status) *TaskRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableStatus(v *taskrun.Status) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TaskRunUpdateOne) ClearStatus() *TaskRunUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_u *TaskRunUpdateOne) SetRetryCount(v int32) *TaskRunUpdateOne {
+	_u.mutation.ResetRetryCount()
+	_u.mutation.SetRetryCount(v)
+	return _u
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableRetryCount(v *int32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetRetryCount(*v)
+	}
+	return _u
+}
+
+// AddRetryCount adds value to the "retry_count" field.
+func (_u *TaskRunUpdateOne) AddRetryCount(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddRetryCount(v)
+	return _u
+}
+
+// ClearRetryCount clears the value of the "retry_count" field.
+func (_u *TaskRunUpdateOne) ClearRetryCount() *TaskRunUpdateOne {
+	_u.mutation.ClearRetryCount()
+	return _u
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (_u *TaskRunUpdateOne) SetMaxRetry(v int32) *TaskRunUpdateOne {
+	_u.mutation.ResetMaxRetry()
+	_u.mutation.SetMaxRetry(v)
+	return _u
+}
+
+// SetNillableMaxRetry sets the "max_retry" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableMaxRetry(v *int32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetMaxRetry(*v)
+	}
+	return _u
+}
+
+// AddMaxRetry adds value to the "max_retry" field.
+func (_u *TaskRunUpdateOne) AddMaxRetry(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddMaxRetry(v)
+	return _u
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (_u *TaskRunUpdateOne) ClearMaxRetry() *TaskRunUpdateOne {
+	_u.mutation.ClearMaxRetry()
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *TaskRunUpdateOne) SetProgress(v uint32) *TaskRunUpdateOne {
+	_u.mutation.ResetProgress()
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableProgress(v *uint32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetProgress(*v)
+	}
+	return _u
+}
+
+// AddProgress adds value to the "progress" field.
+func (_u *TaskRunUpdateOne) AddProgress(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddProgress(v)
+	return _u
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (_u *TaskRunUpdateOne) ClearProgress() *TaskRunUpdateOne {
+	_u.mutation.ClearProgress()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *TaskRunUpdateOne) SetMessage(v string) *TaskRunUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableMessage(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *TaskRunUpdateOne) ClearMessage() *TaskRunUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *TaskRunUpdateOne) SetError(v string) *TaskRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableError(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *TaskRunUpdateOne) ClearError() *TaskRunUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *TaskRunUpdateOne) SetResult(v string) *TaskRunUpdateOne {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableResult(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *TaskRunUpdateOne) ClearResult() *TaskRunUpdateOne {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TaskRunUpdateOne) SetFinishedAt(v time.Time) *TaskRunUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableFinishedAt(v *time.Time) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TaskRunUpdateOne) ClearFinishedAt() *TaskRunUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *TaskRunUpdateOne) SetDurationMs(v uint64) *TaskRunUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableDurationMs(v *uint64) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *TaskRunUpdateOne) AddDurationMs(v int64) *TaskRunUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *TaskRunUpdateOne) ClearDurationMs() *TaskRunUpdateOne {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (_u *TaskRunUpdateOne) Mutation() *TaskRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TaskRunUpdate builder.
+func (_u *TaskRunUpdateOne) Where(ps ...predicate.TaskRun) *TaskRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskRunUpdateOne) Select(field string, fields ...string) *TaskRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskRun entity.
+func (_u *TaskRunUpdateOne) Save(ctx context.Context) (*TaskRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskRunUpdateOne) SaveX(ctx context.Context) *TaskRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskRunUpdateOne) check() error {
+	if v, ok := _u.mutation.TypeName(); ok {
+		if err := taskrun.TypeNameValidator(v); err != nil {
+			return &ValidationError{Name: "type_name", err: fmt.Errorf(`ent: validator failed for field "TaskRun.type_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaskRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaskRunUpdateOne) sqlSave(ctx context.Context) (_node *TaskRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrun.Table, taskrun.Columns, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskrun.FieldID)
+		for _, f := range fields {
+			if !taskrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(taskrun.FieldCreatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(taskrun.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(taskrun.FieldTaskID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskrun.FieldTaskID, field.TypeUint32, value)
+	}
+	if _u.mutation.TaskIDCleared() {
+		_spec.ClearField(taskrun.FieldTaskID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.TypeName(); ok {
+		_spec.SetField(taskrun.FieldTypeName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QueueTaskID(); ok {
+		_spec.SetField(taskrun.FieldQueueTaskID, field.TypeString, value)
+	}
+	if _u.mutation.QueueTaskIDCleared() {
+		_spec.ClearField(taskrun.FieldQueueTaskID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Queue(); ok {
+		_spec.SetField(taskrun.FieldQueue, field.TypeString, value)
+	}
+	if _u.mutation.QueueCleared() {
+		_spec.ClearField(taskrun.FieldQueue, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(taskrun.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RetryCount(); ok {
+		_spec.SetField(taskrun.FieldRetryCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRetryCount(); ok {
+		_spec.AddField(taskrun.FieldRetryCount, field.TypeInt32, value)
+	}
+	if _u.mutation.RetryCountCleared() {
+		_spec.ClearField(taskrun.FieldRetryCount, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.MaxRetry(); ok {
+		_spec.SetField(taskrun.FieldMaxRetry, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRetry(); ok {
+		_spec.AddField(taskrun.FieldMaxRetry, field.TypeInt32, value)
+	}
+	if _u.mutation.MaxRetryCleared() {
+		_spec.ClearField(taskrun.FieldMaxRetry, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(taskrun.FieldProgress, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedProgress(); ok {
+		_spec.AddField(taskrun.FieldProgress, field.TypeUint32, value)
+	}
+	if _u.mutation.ProgressCleared() {
+		_spec.ClearField(taskrun.FieldProgress, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(taskrun.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(taskrun.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(taskrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(taskrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(taskrun.FieldResult, field.TypeString, value)
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(taskrun.FieldResult, field.TypeString)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(taskrun.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(taskrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(taskrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(taskrun.FieldDurationMs, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(taskrun.FieldDurationMs, field.TypeUint64, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(taskrun.FieldDurationMs, field.TypeUint64)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TaskRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
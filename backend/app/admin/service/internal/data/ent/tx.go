@@ -28,18 +28,28 @@ type Tx struct {
 	DictTypeI18n *DictTypeI18nClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// FileShare is the client for interacting with the FileShare builders.
+	FileShare *FileShareClient
 	// InternalMessage is the client for interacting with the InternalMessage builders.
 	InternalMessage *InternalMessageClient
 	// InternalMessageCategory is the client for interacting with the InternalMessageCategory builders.
 	InternalMessageCategory *InternalMessageCategoryClient
 	// InternalMessageRecipient is the client for interacting with the InternalMessageRecipient builders.
 	InternalMessageRecipient *InternalMessageRecipientClient
+	// JwtSigningKey is the client for interacting with the JwtSigningKey builders.
+	JwtSigningKey *JwtSigningKeyClient
 	// Language is the client for interacting with the Language builders.
 	Language *LanguageClient
+	// LdapSource is the client for interacting with the LdapSource builders.
+	LdapSource *LdapSourceClient
 	// LoginAuditLog is the client for interacting with the LoginAuditLog builders.
 	LoginAuditLog *LoginAuditLogClient
 	// LoginPolicy is the client for interacting with the LoginPolicy builders.
 	LoginPolicy *LoginPolicyClient
+	// LuaScript is the client for interacting with the LuaScript builders.
+	LuaScript *LuaScriptClient
+	// LuaScriptVersion is the client for interacting with the LuaScriptVersion builders.
+	LuaScriptVersion *LuaScriptVersionClient
 	// Membership is the client for interacting with the Membership builders.
 	Membership *MembershipClient
 	// MembershipOrgUnit is the client for interacting with the MembershipOrgUnit builders.
@@ -50,10 +60,14 @@ type Tx struct {
 	MembershipRole *MembershipRoleClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
+	// OAuthClient is the client for interacting with the OAuthClient builders.
+	OAuthClient *OAuthClientClient
 	// OperationAuditLog is the client for interacting with the OperationAuditLog builders.
 	OperationAuditLog *OperationAuditLogClient
 	// OrgUnit is the client for interacting with the OrgUnit builders.
 	OrgUnit *OrgUnitClient
+	// PasswordPolicy is the client for interacting with the PasswordPolicy builders.
+	PasswordPolicy *PasswordPolicyClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
 	// PermissionApi is the client for interacting with the PermissionApi builders.
@@ -70,14 +84,24 @@ type Tx struct {
 	PolicyEvaluationLog *PolicyEvaluationLogClient
 	// Position is the client for interacting with the Position builders.
 	Position *PositionClient
+	// RegistrationInvite is the client for interacting with the RegistrationInvite builders.
+	RegistrationInvite *RegistrationInviteClient
+	// RegistrationPolicy is the client for interacting with the RegistrationPolicy builders.
+	RegistrationPolicy *RegistrationPolicyClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RoleMetadata is the client for interacting with the RoleMetadata builders.
 	RoleMetadata *RoleMetadataClient
 	// RolePermission is the client for interacting with the RolePermission builders.
 	RolePermission *RolePermissionClient
+	// ScimToken is the client for interacting with the ScimToken builders.
+	ScimToken *ScimTokenClient
+	// StorageQuota is the client for interacting with the StorageQuota builders.
+	StorageQuota *StorageQuotaClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskRun is the client for interacting with the TaskRun builders.
+	TaskRun *TaskRunClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
 	// User is the client for interacting with the User builders.
@@ -86,6 +110,8 @@ type Tx struct {
 	UserCredential *UserCredentialClient
 	// UserOrgUnit is the client for interacting with the UserOrgUnit builders.
 	UserOrgUnit *UserOrgUnitClient
+	// UserPasswordHistory is the client for interacting with the UserPasswordHistory builders.
+	UserPasswordHistory *UserPasswordHistoryClient
 	// UserPosition is the client for interacting with the UserPosition builders.
 	UserPosition *UserPositionClient
 	// UserRole is the client for interacting with the UserRole builders.
@@ -229,19 +255,26 @@ func (tx *Tx) init() {
 	tx.DictType = NewDictTypeClient(tx.config)
 	tx.DictTypeI18n = NewDictTypeI18nClient(tx.config)
 	tx.File = NewFileClient(tx.config)
+	tx.FileShare = NewFileShareClient(tx.config)
 	tx.InternalMessage = NewInternalMessageClient(tx.config)
 	tx.InternalMessageCategory = NewInternalMessageCategoryClient(tx.config)
 	tx.InternalMessageRecipient = NewInternalMessageRecipientClient(tx.config)
+	tx.JwtSigningKey = NewJwtSigningKeyClient(tx.config)
 	tx.Language = NewLanguageClient(tx.config)
+	tx.LdapSource = NewLdapSourceClient(tx.config)
 	tx.LoginAuditLog = NewLoginAuditLogClient(tx.config)
 	tx.LoginPolicy = NewLoginPolicyClient(tx.config)
+	tx.LuaScript = NewLuaScriptClient(tx.config)
+	tx.LuaScriptVersion = NewLuaScriptVersionClient(tx.config)
 	tx.Membership = NewMembershipClient(tx.config)
 	tx.MembershipOrgUnit = NewMembershipOrgUnitClient(tx.config)
 	tx.MembershipPosition = NewMembershipPositionClient(tx.config)
 	tx.MembershipRole = NewMembershipRoleClient(tx.config)
 	tx.Menu = NewMenuClient(tx.config)
+	tx.OAuthClient = NewOAuthClientClient(tx.config)
 	tx.OperationAuditLog = NewOperationAuditLogClient(tx.config)
 	tx.OrgUnit = NewOrgUnitClient(tx.config)
+	tx.PasswordPolicy = NewPasswordPolicyClient(tx.config)
 	tx.Permission = NewPermissionClient(tx.config)
 	tx.PermissionApi = NewPermissionApiClient(tx.config)
 	tx.PermissionAuditLog = NewPermissionAuditLogClient(tx.config)
@@ -250,14 +283,20 @@ func (tx *Tx) init() {
 	tx.PermissionPolicy = NewPermissionPolicyClient(tx.config)
 	tx.PolicyEvaluationLog = NewPolicyEvaluationLogClient(tx.config)
 	tx.Position = NewPositionClient(tx.config)
+	tx.RegistrationInvite = NewRegistrationInviteClient(tx.config)
+	tx.RegistrationPolicy = NewRegistrationPolicyClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
 	tx.RoleMetadata = NewRoleMetadataClient(tx.config)
 	tx.RolePermission = NewRolePermissionClient(tx.config)
+	tx.ScimToken = NewScimTokenClient(tx.config)
+	tx.StorageQuota = NewStorageQuotaClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
+	tx.TaskRun = NewTaskRunClient(tx.config)
 	tx.Tenant = NewTenantClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserCredential = NewUserCredentialClient(tx.config)
 	tx.UserOrgUnit = NewUserOrgUnitClient(tx.config)
+	tx.UserPasswordHistory = NewUserPasswordHistoryClient(tx.config)
 	tx.UserPosition = NewUserPositionClient(tx.config)
 	tx.UserRole = NewUserRoleClient(tx.config)
 }
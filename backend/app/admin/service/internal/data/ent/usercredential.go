@@ -58,7 +58,9 @@ type UserCredential struct {
 	ResetTokenExpiresAt *time.Time `json:"reset_token_expires_at,omitempty"`
 	// 重置令牌使用时间
 	ResetTokenUsedAt *time.Time `json:"reset_token_used_at,omitempty"`
-	selectValues     sql.SelectValues
+	// 密码修改时间，用于计算密码是否过期
+	PasswordChangedAt *time.Time `json:"password_changed_at,omitempty"`
+	selectValues      sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -72,7 +74,7 @@ func (*UserCredential) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case usercredential.FieldIdentityType, usercredential.FieldIdentifier, usercredential.FieldCredentialType, usercredential.FieldCredential, usercredential.FieldStatus, usercredential.FieldExtraInfo, usercredential.FieldProvider, usercredential.FieldProviderAccountID, usercredential.FieldActivateTokenHash, usercredential.FieldResetTokenHash:
 			values[i] = new(sql.NullString)
-		case usercredential.FieldCreatedAt, usercredential.FieldUpdatedAt, usercredential.FieldDeletedAt, usercredential.FieldActivateTokenExpiresAt, usercredential.FieldActivateTokenUsedAt, usercredential.FieldResetTokenExpiresAt, usercredential.FieldResetTokenUsedAt:
+		case usercredential.FieldCreatedAt, usercredential.FieldUpdatedAt, usercredential.FieldDeletedAt, usercredential.FieldActivateTokenExpiresAt, usercredential.FieldActivateTokenUsedAt, usercredential.FieldResetTokenExpiresAt, usercredential.FieldResetTokenUsedAt, usercredential.FieldPasswordChangedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -235,6 +237,13 @@ func (_m *UserCredential) assignValues(columns []string, values []any) error {
 				_m.ResetTokenUsedAt = new(time.Time)
 				*_m.ResetTokenUsedAt = value.Time
 			}
+		case usercredential.FieldPasswordChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_changed_at", values[i])
+			} else if value.Valid {
+				_m.PasswordChangedAt = new(time.Time)
+				*_m.PasswordChangedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -370,6 +379,11 @@ func (_m *UserCredential) String() string {
 		builder.WriteString("reset_token_used_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := _m.PasswordChangedAt; v != nil {
+		builder.WriteString("password_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -54,6 +54,8 @@ const (
 	FieldResetTokenExpiresAt = "reset_token_expires_at"
 	// FieldResetTokenUsedAt holds the string denoting the reset_token_used_at field in the database.
 	FieldResetTokenUsedAt = "reset_token_used_at"
+	// FieldPasswordChangedAt holds the string denoting the password_changed_at field in the database.
+	FieldPasswordChangedAt = "password_changed_at"
 	// Table holds the table name of the usercredential in the database.
 	Table = "sys_user_credentials"
 )
@@ -81,6 +83,7 @@ var Columns = []string{
 	FieldResetTokenHash,
 	FieldResetTokenExpiresAt,
 	FieldResetTokenUsedAt,
+	FieldPasswordChangedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -181,6 +184,7 @@ const (
 	CredentialTypeOpenidConnectIdToken   CredentialType = "OPENID_CONNECT_ID_TOKEN"
 	CredentialTypeSessionCookie          CredentialType = "SESSION_COOKIE"
 	CredentialTypeTemporaryCredential    CredentialType = "TEMPORARY_CREDENTIAL"
+	CredentialTypeExternal               CredentialType = "EXTERNAL"
 	CredentialTypeCustom                 CredentialType = "CUSTOM"
 	CredentialTypeReservedForFuture      CredentialType = "RESERVED_FOR_FUTURE"
 )
@@ -192,7 +196,7 @@ func (ct CredentialType) String() string {
 // CredentialTypeValidator is a validator for the "credential_type" field enum values. It is called by the builders before save.
 func CredentialTypeValidator(ct CredentialType) error {
 	switch ct {
-	case CredentialTypePasswordHash, CredentialTypeApiKey, CredentialTypeApiSecret, CredentialTypeAccessToken, CredentialTypeRefreshToken, CredentialTypeJWT, CredentialTypeOauthToken, CredentialTypeOauthAuthorizationCode, CredentialTypeOauthClientCredentials, CredentialTypeOTP, CredentialTypeTOTP, CredentialTypeSmsOtp, CredentialTypeEmailOtp, CredentialTypeHardwareToken, CredentialTypeSoftwareToken, CredentialTypeSecurityQuestion, CredentialTypeBiometric, CredentialTypeBiometricToken, CredentialTypeSsoToken, CredentialTypeSamlAssertion, CredentialTypeOpenidConnectIdToken, CredentialTypeSessionCookie, CredentialTypeTemporaryCredential, CredentialTypeCustom, CredentialTypeReservedForFuture:
+	case CredentialTypePasswordHash, CredentialTypeApiKey, CredentialTypeApiSecret, CredentialTypeAccessToken, CredentialTypeRefreshToken, CredentialTypeJWT, CredentialTypeOauthToken, CredentialTypeOauthAuthorizationCode, CredentialTypeOauthClientCredentials, CredentialTypeOTP, CredentialTypeTOTP, CredentialTypeSmsOtp, CredentialTypeEmailOtp, CredentialTypeHardwareToken, CredentialTypeSoftwareToken, CredentialTypeSecurityQuestion, CredentialTypeBiometric, CredentialTypeBiometricToken, CredentialTypeSsoToken, CredentialTypeSamlAssertion, CredentialTypeOpenidConnectIdToken, CredentialTypeSessionCookie, CredentialTypeTemporaryCredential, CredentialTypeExternal, CredentialTypeCustom, CredentialTypeReservedForFuture:
 		return nil
 	default:
 		return fmt.Errorf("usercredential: invalid enum value for credential_type field: %q", ct)
@@ -337,3 +341,8 @@ func ByResetTokenExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 func ByResetTokenUsedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResetTokenUsedAt, opts...).ToFunc()
 }
+
+// ByPasswordChangedAt orders the results by the password_changed_at field.
+func ByPasswordChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordChangedAt, opts...).ToFunc()
+}
@@ -139,6 +139,11 @@ func ResetTokenUsedAt(v time.Time) predicate.UserCredential {
 	return predicate.UserCredential(sql.FieldEQ(FieldResetTokenUsedAt, v))
 }
 
+// PasswordChangedAt applies equality check predicate on the "password_changed_at" field. It's identical to PasswordChangedAtEQ.
+func PasswordChangedAt(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldEQ(FieldPasswordChangedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UserCredential {
 	return predicate.UserCredential(sql.FieldEQ(FieldCreatedAt, v))
@@ -1224,6 +1229,56 @@ func ResetTokenUsedAtNotNil() predicate.UserCredential {
 	return predicate.UserCredential(sql.FieldNotNull(FieldResetTokenUsedAt))
 }
 
+// PasswordChangedAtEQ applies the EQ predicate on the "password_changed_at" field.
+func PasswordChangedAtEQ(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldEQ(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtNEQ applies the NEQ predicate on the "password_changed_at" field.
+func PasswordChangedAtNEQ(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldNEQ(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtIn applies the In predicate on the "password_changed_at" field.
+func PasswordChangedAtIn(vs ...time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldIn(FieldPasswordChangedAt, vs...))
+}
+
+// PasswordChangedAtNotIn applies the NotIn predicate on the "password_changed_at" field.
+func PasswordChangedAtNotIn(vs ...time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldNotIn(FieldPasswordChangedAt, vs...))
+}
+
+// PasswordChangedAtGT applies the GT predicate on the "password_changed_at" field.
+func PasswordChangedAtGT(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldGT(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtGTE applies the GTE predicate on the "password_changed_at" field.
+func PasswordChangedAtGTE(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldGTE(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtLT applies the LT predicate on the "password_changed_at" field.
+func PasswordChangedAtLT(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldLT(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtLTE applies the LTE predicate on the "password_changed_at" field.
+func PasswordChangedAtLTE(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldLTE(FieldPasswordChangedAt, v))
+}
+
+// PasswordChangedAtIsNil applies the IsNil predicate on the "password_changed_at" field.
+func PasswordChangedAtIsNil() predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldIsNull(FieldPasswordChangedAt))
+}
+
+// PasswordChangedAtNotNil applies the NotNil predicate on the "password_changed_at" field.
+func PasswordChangedAtNotNil() predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldNotNull(FieldPasswordChangedAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.UserCredential) predicate.UserCredential {
 	return predicate.UserCredential(sql.AndPredicates(predicates...))
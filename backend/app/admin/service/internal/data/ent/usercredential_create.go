@@ -302,6 +302,20 @@ func (_c *UserCredentialCreate) SetNillableResetTokenUsedAt(v *time.Time) *UserC
 	return _c
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (_c *UserCredentialCreate) SetPasswordChangedAt(v time.Time) *UserCredentialCreate {
+	_c.mutation.SetPasswordChangedAt(v)
+	return _c
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (_c *UserCredentialCreate) SetNillablePasswordChangedAt(v *time.Time) *UserCredentialCreate {
+	if v != nil {
+		_c.SetPasswordChangedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *UserCredentialCreate) SetID(v uint32) *UserCredentialCreate {
 	_c.mutation.SetID(v)
@@ -523,6 +537,10 @@ func (_c *UserCredentialCreate) createSpec() (*UserCredential, *sqlgraph.CreateS
 		_spec.SetField(usercredential.FieldResetTokenUsedAt, field.TypeTime, value)
 		_node.ResetTokenUsedAt = &value
 	}
+	if value, ok := _c.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(usercredential.FieldPasswordChangedAt, field.TypeTime, value)
+		_node.PasswordChangedAt = &value
+	}
 	return _node, _spec
 }
 
@@ -905,6 +923,24 @@ func (u *UserCredentialUpsert) ClearResetTokenUsedAt() *UserCredentialUpsert {
 	return u
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (u *UserCredentialUpsert) SetPasswordChangedAt(v time.Time) *UserCredentialUpsert {
+	u.Set(usercredential.FieldPasswordChangedAt, v)
+	return u
+}
+
+// UpdatePasswordChangedAt sets the "password_changed_at" field to the value that was provided on create.
+func (u *UserCredentialUpsert) UpdatePasswordChangedAt() *UserCredentialUpsert {
+	u.SetExcluded(usercredential.FieldPasswordChangedAt)
+	return u
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (u *UserCredentialUpsert) ClearPasswordChangedAt() *UserCredentialUpsert {
+	u.SetNull(usercredential.FieldPasswordChangedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1344,6 +1380,27 @@ func (u *UserCredentialUpsertOne) ClearResetTokenUsedAt() *UserCredentialUpsertO
 	})
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (u *UserCredentialUpsertOne) SetPasswordChangedAt(v time.Time) *UserCredentialUpsertOne {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.SetPasswordChangedAt(v)
+	})
+}
+
+// UpdatePasswordChangedAt sets the "password_changed_at" field to the value that was provided on create.
+func (u *UserCredentialUpsertOne) UpdatePasswordChangedAt() *UserCredentialUpsertOne {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.UpdatePasswordChangedAt()
+	})
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (u *UserCredentialUpsertOne) ClearPasswordChangedAt() *UserCredentialUpsertOne {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.ClearPasswordChangedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *UserCredentialUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1949,6 +2006,27 @@ func (u *UserCredentialUpsertBulk) ClearResetTokenUsedAt() *UserCredentialUpsert
 	})
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (u *UserCredentialUpsertBulk) SetPasswordChangedAt(v time.Time) *UserCredentialUpsertBulk {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.SetPasswordChangedAt(v)
+	})
+}
+
+// UpdatePasswordChangedAt sets the "password_changed_at" field to the value that was provided on create.
+func (u *UserCredentialUpsertBulk) UpdatePasswordChangedAt() *UserCredentialUpsertBulk {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.UpdatePasswordChangedAt()
+	})
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (u *UserCredentialUpsertBulk) ClearPasswordChangedAt() *UserCredentialUpsertBulk {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.ClearPasswordChangedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *UserCredentialUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -396,6 +396,26 @@ func (_u *UserCredentialUpdate) ClearResetTokenUsedAt() *UserCredentialUpdate {
 	return _u
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (_u *UserCredentialUpdate) SetPasswordChangedAt(v time.Time) *UserCredentialUpdate {
+	_u.mutation.SetPasswordChangedAt(v)
+	return _u
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (_u *UserCredentialUpdate) SetNillablePasswordChangedAt(v *time.Time) *UserCredentialUpdate {
+	if v != nil {
+		_u.SetPasswordChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (_u *UserCredentialUpdate) ClearPasswordChangedAt() *UserCredentialUpdate {
+	_u.mutation.ClearPasswordChangedAt()
+	return _u
+}
+
 // Mutation returns the UserCredentialMutation object of the builder.
 func (_u *UserCredentialUpdate) Mutation() *UserCredentialMutation {
 	return _u.mutation
@@ -603,6 +623,12 @@ func (_u *UserCredentialUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.ResetTokenUsedAtCleared() {
 		_spec.ClearField(usercredential.FieldResetTokenUsedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(usercredential.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(usercredential.FieldPasswordChangedAt, field.TypeTime)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -992,6 +1018,26 @@ func (_u *UserCredentialUpdateOne) ClearResetTokenUsedAt() *UserCredentialUpdate
 	return _u
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (_u *UserCredentialUpdateOne) SetPasswordChangedAt(v time.Time) *UserCredentialUpdateOne {
+	_u.mutation.SetPasswordChangedAt(v)
+	return _u
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (_u *UserCredentialUpdateOne) SetNillablePasswordChangedAt(v *time.Time) *UserCredentialUpdateOne {
+	if v != nil {
+		_u.SetPasswordChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (_u *UserCredentialUpdateOne) ClearPasswordChangedAt() *UserCredentialUpdateOne {
+	_u.mutation.ClearPasswordChangedAt()
+	return _u
+}
+
 // Mutation returns the UserCredentialMutation object of the builder.
 func (_u *UserCredentialUpdateOne) Mutation() *UserCredentialMutation {
 	return _u.mutation
@@ -1229,6 +1275,12 @@ func (_u *UserCredentialUpdateOne) sqlSave(ctx context.Context) (_node *UserCred
 	if _u.mutation.ResetTokenUsedAtCleared() {
 		_spec.ClearField(usercredential.FieldResetTokenUsedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(usercredential.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(usercredential.FieldPasswordChangedAt, field.TypeTime)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &UserCredential{config: _u.config}
 	_spec.Assign = _node.assignValues
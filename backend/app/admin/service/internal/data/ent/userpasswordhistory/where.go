@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userpasswordhistory
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldTenantID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldUserID, v))
+}
+
+// Credential applies equality check predicate on the "credential" field. It's identical to CredentialEQ.
+func Credential(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldCredential, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNotNull(FieldTenantID))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint32) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLTE(FieldUserID, v))
+}
+
+// CredentialEQ applies the EQ predicate on the "credential" field.
+func CredentialEQ(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEQ(FieldCredential, v))
+}
+
+// CredentialNEQ applies the NEQ predicate on the "credential" field.
+func CredentialNEQ(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNEQ(FieldCredential, v))
+}
+
+// CredentialIn applies the In predicate on the "credential" field.
+func CredentialIn(vs ...string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldIn(FieldCredential, vs...))
+}
+
+// CredentialNotIn applies the NotIn predicate on the "credential" field.
+func CredentialNotIn(vs ...string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldNotIn(FieldCredential, vs...))
+}
+
+// CredentialGT applies the GT predicate on the "credential" field.
+func CredentialGT(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGT(FieldCredential, v))
+}
+
+// CredentialGTE applies the GTE predicate on the "credential" field.
+func CredentialGTE(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldGTE(FieldCredential, v))
+}
+
+// CredentialLT applies the LT predicate on the "credential" field.
+func CredentialLT(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLT(FieldCredential, v))
+}
+
+// CredentialLTE applies the LTE predicate on the "credential" field.
+func CredentialLTE(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldLTE(FieldCredential, v))
+}
+
+// CredentialContains applies the Contains predicate on the "credential" field.
+func CredentialContains(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldContains(FieldCredential, v))
+}
+
+// CredentialHasPrefix applies the HasPrefix predicate on the "credential" field.
+func CredentialHasPrefix(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldHasPrefix(FieldCredential, v))
+}
+
+// CredentialHasSuffix applies the HasSuffix predicate on the "credential" field.
+func CredentialHasSuffix(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldHasSuffix(FieldCredential, v))
+}
+
+// CredentialEqualFold applies the EqualFold predicate on the "credential" field.
+func CredentialEqualFold(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldEqualFold(FieldCredential, v))
+}
+
+// CredentialContainsFold applies the ContainsFold predicate on the "credential" field.
+func CredentialContainsFold(v string) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.FieldContainsFold(FieldCredential, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserPasswordHistory) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserPasswordHistory) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserPasswordHistory) predicate.UserPasswordHistory {
+	return predicate.UserPasswordHistory(sql.NotPredicates(p))
+}
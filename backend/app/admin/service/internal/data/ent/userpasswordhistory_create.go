@@ -0,0 +1,697 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/userpasswordhistory"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserPasswordHistoryCreate is the builder for creating a UserPasswordHistory entity.
+type UserPasswordHistoryCreate struct {
+	config
+	mutation *UserPasswordHistoryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserPasswordHistoryCreate) SetCreatedAt(v time.Time) *UserPasswordHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserPasswordHistoryCreate) SetNillableCreatedAt(v *time.Time) *UserPasswordHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserPasswordHistoryCreate) SetUpdatedAt(v time.Time) *UserPasswordHistoryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserPasswordHistoryCreate) SetNillableUpdatedAt(v *time.Time) *UserPasswordHistoryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *UserPasswordHistoryCreate) SetDeletedAt(v time.Time) *UserPasswordHistoryCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *UserPasswordHistoryCreate) SetNillableDeletedAt(v *time.Time) *UserPasswordHistoryCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *UserPasswordHistoryCreate) SetTenantID(v uint32) *UserPasswordHistoryCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *UserPasswordHistoryCreate) SetNillableTenantID(v *uint32) *UserPasswordHistoryCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserPasswordHistoryCreate) SetUserID(v uint32) *UserPasswordHistoryCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetCredential sets the "credential" field.
+func (_c *UserPasswordHistoryCreate) SetCredential(v string) *UserPasswordHistoryCreate {
+	_c.mutation.SetCredential(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserPasswordHistoryCreate) SetID(v uint32) *UserPasswordHistoryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the UserPasswordHistoryMutation object of the builder.
+func (_c *UserPasswordHistoryCreate) Mutation() *UserPasswordHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the UserPasswordHistory in the database.
+func (_c *UserPasswordHistoryCreate) Save(ctx context.Context) (*UserPasswordHistory, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserPasswordHistoryCreate) SaveX(ctx context.Context) *UserPasswordHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserPasswordHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserPasswordHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserPasswordHistoryCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := userpasswordhistory.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserPasswordHistoryCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserPasswordHistory.user_id"`)}
+	}
+	if _, ok := _c.mutation.Credential(); !ok {
+		return &ValidationError{Name: "credential", err: errors.New(`ent: missing required field "UserPasswordHistory.credential"`)}
+	}
+	if v, ok := _c.mutation.Credential(); ok {
+		if err := userpasswordhistory.CredentialValidator(v); err != nil {
+			return &ValidationError{Name: "credential", err: fmt.Errorf(`ent: validator failed for field "UserPasswordHistory.credential": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := userpasswordhistory.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "UserPasswordHistory.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *UserPasswordHistoryCreate) sqlSave(ctx context.Context) (*UserPasswordHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserPasswordHistoryCreate) createSpec() (*UserPasswordHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserPasswordHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userpasswordhistory.Table, sqlgraph.NewFieldSpec(userpasswordhistory.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userpasswordhistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(userpasswordhistory.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(userpasswordhistory.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(userpasswordhistory.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(userpasswordhistory.FieldUserID, field.TypeUint32, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Credential(); ok {
+		_spec.SetField(userpasswordhistory.FieldCredential, field.TypeString, value)
+		_node.Credential = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserPasswordHistory.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserPasswordHistoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserPasswordHistoryCreate) OnConflict(opts ...sql.ConflictOption) *UserPasswordHistoryUpsertOne {
+	_c.conflict = opts
+	return &UserPasswordHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserPasswordHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserPasswordHistoryCreate) OnConflictColumns(columns ...string) *UserPasswordHistoryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserPasswordHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserPasswordHistoryUpsertOne is the builder for "upsert"-ing
+	//  one UserPasswordHistory node.
+	UserPasswordHistoryUpsertOne struct {
+		create *UserPasswordHistoryCreate
+	}
+
+	// UserPasswordHistoryUpsert is the "OnConflict" setter.
+	UserPasswordHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserPasswordHistoryUpsert) SetUpdatedAt(v time.Time) *UserPasswordHistoryUpsert {
+	u.Set(userpasswordhistory.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserPasswordHistoryUpsert) UpdateUpdatedAt() *UserPasswordHistoryUpsert {
+	u.SetExcluded(userpasswordhistory.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *UserPasswordHistoryUpsert) ClearUpdatedAt() *UserPasswordHistoryUpsert {
+	u.SetNull(userpasswordhistory.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserPasswordHistoryUpsert) SetDeletedAt(v time.Time) *UserPasswordHistoryUpsert {
+	u.Set(userpasswordhistory.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserPasswordHistoryUpsert) UpdateDeletedAt() *UserPasswordHistoryUpsert {
+	u.SetExcluded(userpasswordhistory.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserPasswordHistoryUpsert) ClearDeletedAt() *UserPasswordHistoryUpsert {
+	u.SetNull(userpasswordhistory.FieldDeletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserPasswordHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userpasswordhistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserPasswordHistoryUpsertOne) UpdateNewValues() *UserPasswordHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(userpasswordhistory.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(userpasswordhistory.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(userpasswordhistory.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(userpasswordhistory.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Credential(); exists {
+			s.SetIgnore(userpasswordhistory.FieldCredential)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserPasswordHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserPasswordHistoryUpsertOne) Ignore() *UserPasswordHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserPasswordHistoryUpsertOne) DoNothing() *UserPasswordHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserPasswordHistoryCreate.OnConflict
+// documentation for more info.
+func (u *UserPasswordHistoryUpsertOne) Update(set func(*UserPasswordHistoryUpsert)) *UserPasswordHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserPasswordHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserPasswordHistoryUpsertOne) SetUpdatedAt(v time.Time) *UserPasswordHistoryUpsertOne {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserPasswordHistoryUpsertOne) UpdateUpdatedAt() *UserPasswordHistoryUpsertOne {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *UserPasswordHistoryUpsertOne) ClearUpdatedAt() *UserPasswordHistoryUpsertOne {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserPasswordHistoryUpsertOne) SetDeletedAt(v time.Time) *UserPasswordHistoryUpsertOne {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserPasswordHistoryUpsertOne) UpdateDeletedAt() *UserPasswordHistoryUpsertOne {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserPasswordHistoryUpsertOne) ClearDeletedAt() *UserPasswordHistoryUpsertOne {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserPasswordHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserPasswordHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserPasswordHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserPasswordHistoryUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserPasswordHistoryUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserPasswordHistoryCreateBulk is the builder for creating many UserPasswordHistory entities in bulk.
+type UserPasswordHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*UserPasswordHistoryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserPasswordHistory entities in the database.
+func (_c *UserPasswordHistoryCreateBulk) Save(ctx context.Context) ([]*UserPasswordHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserPasswordHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserPasswordHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserPasswordHistoryCreateBulk) SaveX(ctx context.Context) []*UserPasswordHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserPasswordHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserPasswordHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserPasswordHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserPasswordHistoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserPasswordHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserPasswordHistoryUpsertBulk {
+	_c.conflict = opts
+	return &UserPasswordHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserPasswordHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserPasswordHistoryCreateBulk) OnConflictColumns(columns ...string) *UserPasswordHistoryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserPasswordHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserPasswordHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserPasswordHistory nodes.
+type UserPasswordHistoryUpsertBulk struct {
+	create *UserPasswordHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserPasswordHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userpasswordhistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserPasswordHistoryUpsertBulk) UpdateNewValues() *UserPasswordHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(userpasswordhistory.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(userpasswordhistory.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(userpasswordhistory.FieldTenantID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(userpasswordhistory.FieldUserID)
+			}
+			if _, exists := b.mutation.Credential(); exists {
+				s.SetIgnore(userpasswordhistory.FieldCredential)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserPasswordHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserPasswordHistoryUpsertBulk) Ignore() *UserPasswordHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserPasswordHistoryUpsertBulk) DoNothing() *UserPasswordHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserPasswordHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserPasswordHistoryUpsertBulk) Update(set func(*UserPasswordHistoryUpsert)) *UserPasswordHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserPasswordHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserPasswordHistoryUpsertBulk) SetUpdatedAt(v time.Time) *UserPasswordHistoryUpsertBulk {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserPasswordHistoryUpsertBulk) UpdateUpdatedAt() *UserPasswordHistoryUpsertBulk {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *UserPasswordHistoryUpsertBulk) ClearUpdatedAt() *UserPasswordHistoryUpsertBulk {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserPasswordHistoryUpsertBulk) SetDeletedAt(v time.Time) *UserPasswordHistoryUpsertBulk {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserPasswordHistoryUpsertBulk) UpdateDeletedAt() *UserPasswordHistoryUpsertBulk {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserPasswordHistoryUpsertBulk) ClearDeletedAt() *UserPasswordHistoryUpsertBulk {
+	return u.Update(func(s *UserPasswordHistoryUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserPasswordHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserPasswordHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserPasswordHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserPasswordHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+)
+
+const (
+	// FileObjectLockTTL 对象锁的最长持有时间，持有者异常退出时自动释放
+	FileObjectLockTTL = 30 * time.Second
+	// FileObjectLockWait 等待对象锁的最长时间
+	FileObjectLockWait = 10 * time.Second
+
+	fileObjectLockRetryInterval = 50 * time.Millisecond
+	fileObjectLockKeyPrefix     = "file:object_lock:"
+)
+
+// 只释放自己持有的锁，避免锁过期后误删其他持有者的锁
+var unlockFileObjectScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// FileObjectLockRepo 存储对象锁，串行化内容寻址对象的复用与删除：
+// 上传复用对象并创建文件记录、统计引用并删除对象，两者在同一对象上不能交错执行。
+type FileObjectLockRepo struct {
+	log *log.Helper
+
+	rdb *redis.Client
+}
+
+func NewFileObjectLockRepo(ctx *bootstrap.Context, rdb *redis.Client) *FileObjectLockRepo {
+	return &FileObjectLockRepo{
+		log: ctx.NewLoggerHelper("file-object-lock/repo/admin-service"),
+		rdb: rdb,
+	}
+}
+
+// Lock 锁定存储对象，返回解锁函数
+func (r *FileObjectLockRepo) Lock(ctx context.Context, bucketName, objectName string) (func(), error) {
+	key := r.makeKey(bucketName, objectName)
+	token := uuid.NewString()
+
+	deadline := time.Now().Add(FileObjectLockWait)
+	for {
+		ok, err := r.rdb.SetNX(ctx, key, token, FileObjectLockTTL).Result()
+		if err != nil {
+			r.log.Errorf("lock file object [%s/%s] failed: %s", bucketName, objectName, err.Error())
+			return nil, fileV1.ErrorServiceUnavailable("lock file object failed")
+		}
+		if ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, fileV1.ErrorServiceUnavailable("file object is busy, try again later")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fileV1.ErrorServiceUnavailable("lock file object canceled")
+		case <-time.After(fileObjectLockRetryInterval):
+		}
+	}
+
+	return func() {
+		if err := unlockFileObjectScript.Run(context.WithoutCancel(ctx), r.rdb, []string{key}, token).Err(); err != nil {
+			r.log.Errorf("unlock file object [%s/%s] failed: %s", bucketName, objectName, err.Error())
+		}
+	}, nil
+}
+
+func (r *FileObjectLockRepo) makeKey(bucketName, objectName string) string {
+	return fmt.Sprintf("%s%s/%s", fileObjectLockKeyPrefix, bucketName, objectName)
+}
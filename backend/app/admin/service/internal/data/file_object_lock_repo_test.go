@@ -0,0 +1,50 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+)
+
+func TestFileObjectLockRepo(t *testing.T) {
+	m := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	repo := &FileObjectLockRepo{log: log.NewHelper(log.DefaultLogger), rdb: rdb}
+	ctx := context.Background()
+
+	unlock, err := repo.Lock(ctx, "files", "images/1/abc.png")
+	assert.NoError(t, err)
+
+	// 已被锁定的对象需要等待，其它对象不受影响
+	waitCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer cancel()
+	_, err = repo.Lock(waitCtx, "files", "images/1/abc.png")
+	assert.True(t, fileV1.IsServiceUnavailable(err))
+
+	other, err := repo.Lock(ctx, "files", "images/2/abc.png")
+	assert.NoError(t, err)
+	other()
+
+	// 解锁后可以再次锁定
+	unlock()
+	unlock, err = repo.Lock(ctx, "files", "images/1/abc.png")
+	assert.NoError(t, err)
+
+	// 锁过期后被其他持有者获得时，原持有者解锁不影响新的锁
+	m.FastForward(FileObjectLockTTL)
+	next, err := repo.Lock(ctx, "files", "images/1/abc.png")
+	assert.NoError(t, err)
+	unlock()
+	assert.True(t, m.Exists(repo.makeKey("files", "images/1/abc.png")))
+	next()
+	assert.False(t, m.Exists(repo.makeKey("files", "images/1/abc.png")))
+}
@@ -23,14 +23,19 @@ import (
 	"github.com/tx7do/go-utils/mapper"
 
 	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
 )
 
 type FileRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
 	log       *log.Helper
 
-	mapper            *mapper.CopierMapper[fileV1.File, ent.File]
-	providerConverter *mapper.EnumTypeConverter[fileV1.OSSProvider, file.Provider]
+	quotaRepo *StorageQuotaRepo
+
+	mapper              *mapper.CopierMapper[fileV1.File, ent.File]
+	providerConverter   *mapper.EnumTypeConverter[fileV1.OSSProvider, file.Provider]
+	scanStatusConverter *mapper.EnumTypeConverter[fileV1.FileScanStatus, file.ScanStatus]
 
 	repository *entCrud.Repository[
 		ent.FileQuery, ent.FileSelect,
@@ -42,12 +47,20 @@ type FileRepo struct {
 	]
 }
 
-func NewFileRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *FileRepo {
+func NewFileRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	quotaRepo *StorageQuotaRepo,
+) *FileRepo {
 	repo := &FileRepo{
 		log:               ctx.NewLoggerHelper("file/repo/admin-service"),
 		entClient:         entClient,
+		quotaRepo:         quotaRepo,
 		mapper:            mapper.NewCopierMapper[fileV1.File, ent.File](),
 		providerConverter: mapper.NewEnumTypeConverter[fileV1.OSSProvider, file.Provider](fileV1.OSSProvider_name, fileV1.OSSProvider_value),
+		scanStatusConverter: mapper.NewEnumTypeConverter[fileV1.FileScanStatus, file.ScanStatus](
+			fileV1.FileScanStatus_name, fileV1.FileScanStatus_value,
+		),
 	}
 
 	repo.init()
@@ -69,6 +82,7 @@ func (r *FileRepo) init() {
 	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
 
 	r.mapper.AppendConverters(r.providerConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.scanStatusConverter.NewConverterPair())
 }
 
 // formatSize 返回格式化后的文本，例如 "512B", "1.5KB"。
@@ -162,7 +176,8 @@ func (r *FileRepo) Get(ctx context.Context, req *fileV1.GetFileRequest) (*fileV1
 	return dto, err
 }
 
-func (r *FileRepo) Create(ctx context.Context, req *fileV1.CreateFileRequest) error {
+// Create 创建文件记录，并在同一事务中占用存储配额
+func (r *FileRepo) Create(ctx context.Context, req *fileV1.CreateFileRequest) (err error) {
 	if req == nil || req.Data == nil {
 		return fileV1.ErrorBadRequest("invalid parameter")
 	}
@@ -171,7 +186,26 @@ func (r *FileRepo) Create(ctx context.Context, req *fileV1.CreateFileRequest) er
 		req.Data.SizeFormat = trans.Ptr(r.formatSize(int64(req.Data.GetSize())))
 	}
 
-	builder := r.entClient.Client().File.Create().
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return fileV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = fileV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	builder := tx.File.Create().
 		SetNillableTenantID(req.Data.TenantId).
 		SetNillableProvider(r.providerConverter.ToEntity(req.Data.Provider)).
 		SetNillableBucketName(req.Data.BucketName).
@@ -184,6 +218,9 @@ func (r *FileRepo) Create(ctx context.Context, req *fileV1.CreateFileRequest) er
 		SetNillableSizeFormat(req.Data.SizeFormat).
 		SetNillableLinkURL(req.Data.LinkUrl).
 		SetNillableContentHash(req.Data.ContentHash).
+		SetNillableContentType(req.Data.ContentType).
+		SetNillableScanStatus(r.scanStatusConverter.ToEntity(req.Data.ScanStatus)).
+		SetNillableScanResult(req.Data.ScanResult).
 		SetNillableCreatedBy(req.Data.CreatedBy).
 		SetCreatedAt(time.Now())
 
@@ -191,11 +228,18 @@ func (r *FileRepo) Create(ctx context.Context, req *fileV1.CreateFileRequest) er
 		builder.SetID(req.GetData().GetId())
 	}
 
-	if err := builder.Exec(ctx); err != nil {
+	if err = builder.Exec(ctx); err != nil {
 		r.log.Errorf("insert file failed: %s", err.Error())
 		return fileV1.ErrorInternalServerError("insert file failed")
 	}
 
+	if err = r.quotaRepo.ConsumeWithTx(ctx, tx,
+		req.Data.GetTenantId(), req.Data.GetCreatedBy(),
+		req.Data.GetSize(),
+	); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -237,6 +281,9 @@ func (r *FileRepo) Update(ctx context.Context, req *fileV1.UpdateFileRequest) er
 				SetNillableSizeFormat(req.Data.SizeFormat).
 				SetNillableLinkURL(req.Data.LinkUrl).
 				SetNillableContentHash(req.Data.ContentHash).
+				SetNillableContentType(req.Data.ContentType).
+				SetNillableScanStatus(r.scanStatusConverter.ToEntity(req.Data.ScanStatus)).
+				SetNillableScanResult(req.Data.ScanResult).
 				SetNillableCreatedBy(req.Data.UpdatedBy).
 				SetUpdatedAt(time.Now())
 		},
@@ -248,12 +295,47 @@ func (r *FileRepo) Update(ctx context.Context, req *fileV1.UpdateFileRequest) er
 	return err
 }
 
-func (r *FileRepo) Delete(ctx context.Context, req *fileV1.DeleteFileRequest) error {
+// Delete 删除文件记录，并在同一事务中释放存储配额
+func (r *FileRepo) Delete(ctx context.Context, req *fileV1.DeleteFileRequest) (err error) {
 	if req == nil {
 		return fileV1.ErrorBadRequest("invalid parameter")
 	}
 
-	if err := r.entClient.Client().File.DeleteOneID(req.GetId()).Exec(ctx); err != nil {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return fileV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = fileV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	var entity *ent.File
+	entity, err = tx.File.Get(ctx, req.GetId())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fileV1.ErrorNotFound("file not found")
+		}
+		r.log.Errorf("query file failed: %s", err.Error())
+		return fileV1.ErrorInternalServerError("query file failed")
+	}
+
+	return r.deleteWithTx(ctx, tx, entity)
+}
+
+// deleteWithTx 在事务中删除文件记录并释放其占用的存储配额
+func (r *FileRepo) deleteWithTx(ctx context.Context, tx *ent.Tx, entity *ent.File) error {
+	if err := tx.File.DeleteOneID(entity.ID).Exec(ctx); err != nil {
 		if ent.IsNotFound(err) {
 			return fileV1.ErrorNotFound("file not found")
 		}
@@ -263,5 +345,98 @@ func (r *FileRepo) Delete(ctx context.Context, req *fileV1.DeleteFileRequest) er
 		return fileV1.ErrorInternalServerError("delete failed")
 	}
 
-	return nil
+	return r.quotaRepo.ReleaseWithTx(ctx, tx,
+		trans.Uint32Value(entity.TenantID), trans.Uint32Value(entity.CreatedBy),
+		trans.Uint64Value(entity.Size),
+	)
+}
+
+// CountObjectReferences 统计引用同一存储对象的文件记录数
+// 内容寻址的对象按租户分目录存放，只有同一租户的记录会引用它；
+// 删除可能由平台管理员发起，因此不按调用者的租户过滤。
+func (r *FileRepo) CountObjectReferences(ctx context.Context, bucketName, fileDirectory, saveFileName string) (int, error) {
+	count, err := r.entClient.Client().File.Query().
+		Where(
+			file.BucketNameEQ(bucketName),
+			file.FileDirectoryEQ(fileDirectory),
+			file.SaveFileNameEQ(saveFileName),
+		).
+		Count(appViewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		r.log.Errorf("query count failed: %s", err.Error())
+		return 0, fileV1.ErrorInternalServerError("query count failed")
+	}
+	return count, nil
+}
+
+// FileOwnerUsage 按租户、上传用户统计的文件用量
+type FileOwnerUsage struct {
+	TenantID   uint32 `json:"tenant_id"`
+	UserID     uint32 `json:"user_id"`
+	FileCount  uint64 `json:"file_count"`
+	TotalBytes uint64 `json:"total_bytes"`
+}
+
+// StatUsage 按租户、存储桶、内容类型统计文件用量，tenantID 为空时统计全部租户
+func (r *FileRepo) StatUsage(ctx context.Context, tenantID *uint32) ([]*fileV1.StorageUsage, error) {
+	builder := r.entClient.Client().File.Query()
+	if tenantID != nil {
+		builder.Where(file.TenantIDEQ(*tenantID))
+	}
+
+	var rows []struct {
+		TenantID    uint32 `json:"tenant_id"`
+		BucketName  string `json:"bucket_name"`
+		ContentType string `json:"content_type"`
+		FileCount   uint64 `json:"file_count"`
+		TotalBytes  uint64 `json:"total_bytes"`
+	}
+	if err := builder.Modify(func(s *sql.Selector) {
+		tenantCol := s.C(file.FieldTenantID)
+		bucketCol := s.C(file.FieldBucketName)
+		contentTypeCol := s.C(file.FieldContentType)
+		s.Select(
+			sql.As(fmt.Sprintf("COALESCE(%s, 0)", tenantCol), "tenant_id"),
+			sql.As(fmt.Sprintf("COALESCE(%s, '')", bucketCol), "bucket_name"),
+			sql.As(fmt.Sprintf("COALESCE(%s, '')", contentTypeCol), "content_type"),
+			sql.As(sql.Count("*"), "file_count"),
+			sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(file.FieldSize)), "total_bytes"),
+		).GroupBy(tenantCol, bucketCol, contentTypeCol)
+	}).Scan(ctx, &rows); err != nil {
+		r.log.Errorf("query file usage failed: %s", err.Error())
+		return nil, fileV1.ErrorInternalServerError("query file usage failed")
+	}
+
+	items := make([]*fileV1.StorageUsage, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, &fileV1.StorageUsage{
+			TenantId:    row.TenantID,
+			BucketName:  row.BucketName,
+			ContentType: row.ContentType,
+			FileCount:   row.FileCount,
+			TotalBytes:  row.TotalBytes,
+		})
+	}
+
+	return items, nil
+}
+
+// StatOwnerUsage 按租户、上传用户统计文件用量
+func (r *FileRepo) StatOwnerUsage(ctx context.Context) ([]FileOwnerUsage, error) {
+	var rows []FileOwnerUsage
+	if err := r.entClient.Client().File.Query().Modify(func(s *sql.Selector) {
+		tenantCol := s.C(file.FieldTenantID)
+		userCol := s.C(file.FieldCreatedBy)
+		s.Select(
+			sql.As(fmt.Sprintf("COALESCE(%s, 0)", tenantCol), "tenant_id"),
+			sql.As(fmt.Sprintf("COALESCE(%s, 0)", userCol), "user_id"),
+			sql.As(sql.Count("*"), "file_count"),
+			sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(file.FieldSize)), "total_bytes"),
+		).GroupBy(tenantCol, userCol)
+	}).Scan(ctx, &rows); err != nil {
+		r.log.Errorf("query file usage failed: %s", err.Error())
+		return nil, fileV1.ErrorInternalServerError("query file usage failed")
+	}
+
+	return rows, nil
 }
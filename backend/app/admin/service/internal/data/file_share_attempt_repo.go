@@ -0,0 +1,92 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+)
+
+const (
+	// FileSharePasswordMaxAttempts 同一分享链接、同一客户端在窗口期内允许的密码错误次数
+	FileSharePasswordMaxAttempts = 5
+	// FileSharePasswordMaxShareAttempts 同一分享链接在窗口期内允许的密码错误总次数，与客户端地址无关
+	FileSharePasswordMaxShareAttempts = 20
+	// FileSharePasswordAttemptWindow 密码错误次数的统计窗口，从第一次错误开始计算
+	FileSharePasswordAttemptWindow = 15 * time.Minute
+
+	fileShareAttemptKeyPrefix = "file_share:password_attempts:"
+)
+
+// 累加每个键的错误次数，第一次错误时开始计算窗口期
+var failFileSharePasswordScript = redis.NewScript(`
+for _, key in ipairs(KEYS) do
+	if redis.call("INCR", key) == 1 then
+		redis.call("PEXPIRE", key, ARGV[1])
+	end
+end
+return 0`)
+
+// FileShareAttemptRepo 分享链接访问密码的错误次数，防止暴力猜测密码。
+// 同时按分享链接与客户端IP、以及单个分享链接限制，更换客户端地址也无法获得新的尝试次数。
+type FileShareAttemptRepo struct {
+	log *log.Helper
+
+	rdb *redis.Client
+}
+
+func NewFileShareAttemptRepo(ctx *bootstrap.Context, rdb *redis.Client) *FileShareAttemptRepo {
+	return &FileShareAttemptRepo{
+		log: ctx.NewLoggerHelper("file-share-attempt/repo/admin-service"),
+		rdb: rdb,
+	}
+}
+
+// Check 错误次数达到上限时拒绝继续校验密码，直到窗口期结束
+func (r *FileShareAttemptRepo) Check(ctx context.Context, shareID uint32, clientIP string) error {
+	values, err := r.rdb.MGet(ctx, r.makeKey(shareID, clientIP), r.makeShareKey(shareID)).Result()
+	if err != nil {
+		r.log.Errorf("get file share password attempts failed: %s", err.Error())
+		return fileV1.ErrorServiceUnavailable("check file share password failed")
+	}
+
+	limits := []int{FileSharePasswordMaxAttempts, FileSharePasswordMaxShareAttempts}
+	for i, v := range values {
+		s, _ := v.(string)
+		if n, _ := strconv.Atoi(s); n >= limits[i] {
+			return fileV1.ErrorTooManyRequests("too many incorrect file share passwords, try again later")
+		}
+	}
+	return nil
+}
+
+// Fail 记录一次密码错误
+func (r *FileShareAttemptRepo) Fail(ctx context.Context, shareID uint32, clientIP string) {
+	if err := failFileSharePasswordScript.Run(ctx, r.rdb,
+		[]string{r.makeKey(shareID, clientIP), r.makeShareKey(shareID)},
+		FileSharePasswordAttemptWindow.Milliseconds(),
+	).Err(); err != nil {
+		r.log.Errorf("record file share password attempt failed: %s", err.Error())
+	}
+}
+
+// Reset 密码正确后清除该客户端的错误次数，分享链接的总次数只随窗口期结束清除
+func (r *FileShareAttemptRepo) Reset(ctx context.Context, shareID uint32, clientIP string) {
+	if err := r.rdb.Del(ctx, r.makeKey(shareID, clientIP)).Err(); err != nil {
+		r.log.Errorf("reset file share password attempts failed: %s", err.Error())
+	}
+}
+
+func (r *FileShareAttemptRepo) makeKey(shareID uint32, clientIP string) string {
+	return fmt.Sprintf("%s%d:%s", fileShareAttemptKeyPrefix, shareID, clientIP)
+}
+
+func (r *FileShareAttemptRepo) makeShareKey(shareID uint32) string {
+	return fmt.Sprintf("%s%d", fileShareAttemptKeyPrefix, shareID)
+}
@@ -0,0 +1,50 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+)
+
+func TestFileShareAttemptRepo(t *testing.T) {
+	m := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	repo := &FileShareAttemptRepo{log: log.NewHelper(log.DefaultLogger), rdb: rdb}
+	ctx := context.Background()
+
+	for i := 0; i < FileSharePasswordMaxAttempts; i++ {
+		assert.NoError(t, repo.Check(ctx, 1, "10.0.0.1"))
+		repo.Fail(ctx, 1, "10.0.0.1")
+	}
+
+	// 达到上限后拒绝，其它客户端与其它分享链接不受影响
+	assert.True(t, fileV1.IsTooManyRequests(repo.Check(ctx, 1, "10.0.0.1")))
+	assert.NoError(t, repo.Check(ctx, 1, "10.0.0.2"))
+	assert.NoError(t, repo.Check(ctx, 2, "10.0.0.1"))
+
+	// 窗口期结束后恢复
+	m.FastForward(FileSharePasswordAttemptWindow)
+	assert.NoError(t, repo.Check(ctx, 1, "10.0.0.1"))
+
+	// 更换客户端地址也受分享链接的总次数限制
+	for i := 0; i < FileSharePasswordMaxShareAttempts; i++ {
+		ip := fmt.Sprintf("10.0.1.%d", i)
+		assert.NoError(t, repo.Check(ctx, 4, ip))
+		repo.Fail(ctx, 4, ip)
+	}
+	assert.True(t, fileV1.IsTooManyRequests(repo.Check(ctx, 4, "10.0.2.1")))
+
+	// 密码正确后清除错误次数
+	repo.Fail(ctx, 3, "10.0.0.1")
+	repo.Reset(ctx, 3, "10.0.0.1")
+	assert.False(t, m.Exists(repo.makeKey(3, "10.0.0.1")))
+}
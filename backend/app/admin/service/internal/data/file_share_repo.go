@@ -0,0 +1,325 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/fileshare"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+)
+
+type FileShareRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper *mapper.CopierMapper[fileV1.FileShare, ent.FileShare]
+
+	repository *entCrud.Repository[
+		ent.FileShareQuery, ent.FileShareSelect,
+		ent.FileShareCreate, ent.FileShareCreateBulk,
+		ent.FileShareUpdate, ent.FileShareUpdateOne,
+		ent.FileShareDelete,
+		predicate.FileShare,
+		fileV1.FileShare, ent.FileShare,
+	]
+}
+
+func NewFileShareRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *FileShareRepo {
+	repo := &FileShareRepo{
+		log:       ctx.NewLoggerHelper("file-share/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[fileV1.FileShare, ent.FileShare](),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *FileShareRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.FileShareQuery, ent.FileShareSelect,
+		ent.FileShareCreate, ent.FileShareCreateBulk,
+		ent.FileShareUpdate, ent.FileShareUpdateOne,
+		ent.FileShareDelete,
+		predicate.FileShare,
+		fileV1.FileShare, ent.FileShare,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+func (r *FileShareRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector)) (int, error) {
+	builder := r.entClient.Client().FileShare.Query()
+	if len(whereCond) != 0 {
+		builder.Modify(whereCond...)
+	}
+
+	count, err := builder.Count(ctx)
+	if err != nil {
+		r.log.Errorf("query count failed: %s", err.Error())
+		return 0, fileV1.ErrorInternalServerError("query count failed")
+	}
+
+	return count, nil
+}
+
+func (r *FileShareRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*fileV1.ListFileShareResponse, error) {
+	if req == nil {
+		return nil, fileV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().FileShare.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &fileV1.ListFileShareResponse{Total: 0, Items: nil}, nil
+	}
+
+	if err = r.fillHasPassword(ctx, ret.Items...); err != nil {
+		return nil, err
+	}
+
+	return &fileV1.ListFileShareResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *FileShareRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().FileShare.Query().
+		Where(fileshare.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, fileV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *FileShareRepo) Get(ctx context.Context, req *fileV1.GetFileShareRequest) (*fileV1.FileShare, error) {
+	if req == nil {
+		return nil, fileV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().FileShare.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *fileV1.GetFileShareRequest_Id:
+		whereCond = append(whereCond, fileshare.IDEQ(req.GetId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.fillHasPassword(ctx, dto); err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+// GetBySlug 根据分享链接标识获取分享记录，包含密码哈希，仅供访问校验使用
+func (r *FileShareRepo) GetBySlug(ctx context.Context, slug string) (*ent.FileShare, error) {
+	entity, err := r.entClient.Client().FileShare.Query().
+		Where(fileshare.SlugEQ(slug)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fileV1.ErrorNotFound("file share not found")
+		}
+		r.log.Errorf("query file share failed: %s", err.Error())
+		return nil, fileV1.ErrorInternalServerError("query file share failed")
+	}
+	return entity, nil
+}
+
+// fillHasPassword 密码哈希不会返回给调用方，只标记是否设置了密码
+func (r *FileShareRepo) fillHasPassword(ctx context.Context, items ...*fileV1.FileShare) error {
+	ids := make([]uint32, 0, len(items))
+	for _, item := range items {
+		if item != nil && item.Id != nil {
+			ids = append(ids, item.GetId())
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	protected, err := r.entClient.Client().FileShare.Query().
+		Where(
+			fileshare.IDIn(ids...),
+			fileshare.PasswordHashNotNil(),
+			fileshare.PasswordHashNEQ(""),
+		).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("query file share failed: %s", err.Error())
+		return fileV1.ErrorInternalServerError("query file share failed")
+	}
+
+	set := make(map[uint32]struct{}, len(protected))
+	for _, id := range protected {
+		set[id] = struct{}{}
+	}
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		item.Password = nil
+		_, ok := set[item.GetId()]
+		item.HasPassword = trans.Ptr(ok)
+	}
+
+	return nil
+}
+
+// Create 创建分享链接，passwordHash 为空表示无需密码
+func (r *FileShareRepo) Create(ctx context.Context, req *fileV1.CreateFileShareRequest, passwordHash string) (*fileV1.FileShare, error) {
+	if req == nil || req.Data == nil {
+		return nil, fileV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().FileShare.Create().
+		SetNillableTenantID(req.Data.TenantId).
+		SetFileID(req.Data.GetFileId()).
+		SetSlug(req.Data.GetSlug()).
+		SetNillableMaxDownloads(req.Data.MaxDownloads).
+		SetAllowedTenantIds(req.Data.GetAllowedTenantIds()).
+		SetAllowedUserIds(req.Data.GetAllowedUserIds()).
+		SetNillableDisabled(req.Data.Disabled).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if passwordHash != "" {
+		builder.SetPasswordHash(passwordHash)
+	}
+	if req.Data.ExpiresAt != nil {
+		builder.SetExpiresAt(req.Data.ExpiresAt.AsTime())
+	}
+	if req.Data.Id != nil {
+		builder.SetID(req.GetData().GetId())
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fileV1.ErrorConflict("file share already exists")
+		}
+		r.log.Errorf("insert file share failed: %s", err.Error())
+		return nil, fileV1.ErrorInternalServerError("insert file share failed")
+	}
+
+	dto := r.mapper.ToDTO(entity)
+	dto.HasPassword = trans.Ptr(passwordHash != "")
+
+	return dto, nil
+}
+
+// Update 更新分享链接，passwordHash 为 nil 时不修改密码，为空字符串时清除密码
+func (r *FileShareRepo) Update(ctx context.Context, req *fileV1.UpdateFileShareRequest, passwordHash *string) error {
+	if req == nil || req.Data == nil {
+		return fileV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().FileShare.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *fileV1.FileShare) {
+			builder.
+				SetNillableMaxDownloads(req.Data.MaxDownloads).
+				SetNillableDisabled(req.Data.Disabled).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+
+			if req.Data.ExpiresAt != nil {
+				builder.SetExpiresAt(req.Data.ExpiresAt.AsTime())
+			}
+			if req.Data.AllowedTenantIds != nil {
+				builder.SetAllowedTenantIds(req.Data.GetAllowedTenantIds())
+			}
+			if req.Data.AllowedUserIds != nil {
+				builder.SetAllowedUserIds(req.Data.GetAllowedUserIds())
+			}
+
+			switch {
+			case passwordHash == nil:
+			case *passwordHash == "":
+				builder.ClearPasswordHash()
+			default:
+				builder.SetPasswordHash(*passwordHash)
+			}
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(fileshare.FieldID, req.GetId()))
+		},
+	)
+
+	return err
+}
+
+func (r *FileShareRepo) Delete(ctx context.Context, req *fileV1.DeleteFileShareRequest) error {
+	if req == nil {
+		return fileV1.ErrorBadRequest("invalid parameter")
+	}
+
+	if err := r.entClient.Client().FileShare.DeleteOneID(req.GetId()).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fileV1.ErrorNotFound("file share not found")
+		}
+
+		r.log.Errorf("delete one data failed: %s", err.Error())
+
+		return fileV1.ErrorInternalServerError("delete failed")
+	}
+
+	return nil
+}
+
+// ConsumeDownload 按条件原子递增下载次数，已达到最大下载次数时返回 false
+func (r *FileShareRepo) ConsumeDownload(ctx context.Context, id uint32) (bool, error) {
+	affected, err := r.entClient.Client().FileShare.Update().
+		Where(
+			fileshare.IDEQ(id),
+			func(s *sql.Selector) {
+				s.Where(sql.Or(
+					sql.IsNull(s.C(fileshare.FieldMaxDownloads)),
+					sql.EQ(s.C(fileshare.FieldMaxDownloads), 0),
+					sql.ColumnsGT(s.C(fileshare.FieldMaxDownloads), s.C(fileshare.FieldDownloadCount)),
+					sql.And(
+						sql.IsNull(s.C(fileshare.FieldDownloadCount)),
+						sql.GT(s.C(fileshare.FieldMaxDownloads), 0),
+					),
+				))
+			},
+		).
+		AddDownloadCount(1).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("update file share download count failed: %s", err.Error())
+		return false, fileV1.ErrorInternalServerError("update file share download count failed")
+	}
+
+	return affected > 0, nil
+}
@@ -0,0 +1,308 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
+
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/crypto"
+	"go-wind-admin/pkg/jwt"
+)
+
+// DefaultJwtSigningKeyRetireGrace 签名密钥退役后默认继续验签的时间
+const DefaultJwtSigningKeyRetireGrace = 24 * time.Hour
+
+var _ jwt.KeyStore = (*JwtSigningKeyRepo)(nil)
+
+// JwtSigningKeyRepo JWT签名密钥存储，私钥在配置了加密密钥时加密保存
+type JwtSigningKeyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	encryptor *crypto.Encryptor
+}
+
+func NewJwtSigningKeyRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	adminCfg *adminConfV1.Bootstrap,
+) *JwtSigningKeyRepo {
+	repo := &JwtSigningKeyRepo{
+		log:       ctx.NewLoggerHelper("jwt-signing-key/repo/admin-service"),
+		entClient: entClient,
+	}
+
+	if key := adminCfg.GetJwtKeyRing().GetEncryptionKey(); key != "" {
+		var err error
+		if repo.encryptor, err = crypto.NewEncryptor(key); err != nil {
+			repo.log.Errorf("create jwt signing key encryptor failed: %s", err.Error())
+		}
+	}
+
+	return repo
+}
+
+// LoadSigningKeys 加载仍可验签的密钥，只有当前密钥会解密私钥
+func (r *JwtSigningKeyRepo) LoadSigningKeys(ctx context.Context) ([]*jwt.SigningKey, error) {
+	now := time.Now()
+	entities, err := r.entClient.Client().JwtSigningKey.Query().
+		Where(jwtsigningkey.Or(
+			jwtsigningkey.ExpiresAtIsNil(),
+			jwtsigningkey.ExpiresAtGT(now),
+		)).
+		// 多个实例同时生成密钥时，以最新的当前密钥签名
+		Order(ent.Desc(jwtsigningkey.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query jwt signing keys failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query jwt signing keys failed")
+	}
+
+	keys := make([]*jwt.SigningKey, 0, len(entities))
+	for _, entity := range entities {
+		key, err := r.toSigningKey(entity)
+		if err != nil {
+			r.log.Errorf("load jwt signing key [%s] failed: %s", entity.Kid, err.Error())
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (r *JwtSigningKeyRepo) List(ctx context.Context) (*authenticationV1.ListJwtSigningKeyResponse, error) {
+	entities, err := r.entClient.Client().JwtSigningKey.Query().
+		Order(ent.Desc(jwtsigningkey.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query jwt signing keys failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query jwt signing keys failed")
+	}
+
+	items := make([]*authenticationV1.JwtSigningKey, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.toProto(entity))
+	}
+
+	return &authenticationV1.ListJwtSigningKeyResponse{
+		Items: items,
+		Total: uint64(len(items)),
+	}, nil
+}
+
+// HasActiveKey 是否存在指定算法的当前签名密钥
+func (r *JwtSigningKeyRepo) HasActiveKey(ctx context.Context, alg string) (bool, error) {
+	exist, err := r.entClient.Client().JwtSigningKey.Query().
+		Where(
+			jwtsigningkey.StatusEQ(jwtsigningkey.StatusActive),
+			jwtsigningkey.AlgorithmEQ(jwtsigningkey.Algorithm(alg)),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query active jwt signing key failed: %s", err.Error())
+		return false, adminV1.ErrorInternalServerError("query active jwt signing key failed")
+	}
+	return exist, nil
+}
+
+// Rotate 生成新的当前签名密钥，原当前密钥退役并在 grace 时间内继续用于验签
+func (r *JwtSigningKeyRepo) Rotate(ctx context.Context, alg string, grace time.Duration, operatorID uint32) (_ *authenticationV1.JwtSigningKey, err error) {
+	if !jwt.IsAsymmetricAlgorithm(alg) {
+		return nil, adminV1.ErrorBadRequest("unsupported signing algorithm")
+	}
+
+	key, err := jwt.GenerateSigningKey(alg)
+	if err != nil {
+		r.log.Errorf("generate jwt signing key failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("generate jwt signing key failed")
+	}
+
+	privateKey, publicKey, err := r.marshalKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = adminV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	now := time.Now()
+	if err = tx.JwtSigningKey.Update().
+		Where(jwtsigningkey.StatusEQ(jwtsigningkey.StatusActive)).
+		SetStatus(jwtsigningkey.StatusRetired).
+		SetRetiredAt(now).
+		SetExpiresAt(now.Add(grace)).
+		SetUpdatedBy(operatorID).
+		SetUpdatedAt(now).
+		Exec(ctx); err != nil {
+		r.log.Errorf("retire jwt signing key failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("retire jwt signing key failed")
+	}
+
+	builder := tx.JwtSigningKey.Create().
+		SetKid(key.Kid).
+		SetAlgorithm(jwtsigningkey.Algorithm(alg)).
+		SetPrivateKey(privateKey).
+		SetPublicKey(publicKey).
+		SetStatus(jwtsigningkey.StatusActive).
+		SetCreatedAt(now)
+	if operatorID > 0 {
+		builder.SetCreatedBy(operatorID)
+	}
+
+	var entity *ent.JwtSigningKey
+	if entity, err = builder.Save(ctx); err != nil {
+		r.log.Errorf("insert jwt signing key failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("insert jwt signing key failed")
+	}
+
+	return r.toProto(entity), nil
+}
+
+// Retire 调整已退役密钥的宽限期，grace 为0时立即失效；当前签名密钥须先轮换
+func (r *JwtSigningKeyRepo) Retire(ctx context.Context, kid string, grace time.Duration, operatorID uint32) error {
+	entity, err := r.entClient.Client().JwtSigningKey.Query().
+		Where(jwtsigningkey.KidEQ(kid)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return adminV1.ErrorNotFound("jwt signing key not found")
+		}
+		r.log.Errorf("query jwt signing key failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("query jwt signing key failed")
+	}
+
+	if entity.Status == jwtsigningkey.StatusActive {
+		return adminV1.ErrorBadRequest("cannot retire the active signing key, rotate it first")
+	}
+
+	now := time.Now()
+	builder := entity.Update().
+		SetExpiresAt(now.Add(grace)).
+		SetUpdatedBy(operatorID).
+		SetUpdatedAt(now)
+	if entity.RetiredAt == nil {
+		builder.SetRetiredAt(now)
+	}
+
+	if err = builder.Exec(ctx); err != nil {
+		r.log.Errorf("retire jwt signing key failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("retire jwt signing key failed")
+	}
+
+	return nil
+}
+
+func (r *JwtSigningKeyRepo) marshalKey(key *jwt.SigningKey) (privateKey, publicKey string, err error) {
+	if privateKey, err = key.MarshalPrivateKeyPEM(); err != nil {
+		r.log.Errorf("marshal jwt private key failed: %s", err.Error())
+		return "", "", adminV1.ErrorInternalServerError("marshal jwt signing key failed")
+	}
+	if publicKey, err = key.MarshalPublicKeyPEM(); err != nil {
+		r.log.Errorf("marshal jwt public key failed: %s", err.Error())
+		return "", "", adminV1.ErrorInternalServerError("marshal jwt signing key failed")
+	}
+
+	if r.encryptor != nil {
+		if privateKey, err = r.encryptor.Encrypt(privateKey); err != nil {
+			r.log.Errorf("encrypt jwt private key failed: %s", err.Error())
+			return "", "", adminV1.ErrorInternalServerError("encrypt jwt signing key failed")
+		}
+	}
+
+	return privateKey, publicKey, nil
+}
+
+func (r *JwtSigningKeyRepo) toSigningKey(entity *ent.JwtSigningKey) (*jwt.SigningKey, error) {
+	active := entity.Status == jwtsigningkey.StatusActive
+
+	// 退役密钥只用于验签，不需要私钥
+	var privateKey string
+	if active {
+		privateKey = entity.PrivateKey
+		if crypto.IsEncrypted(privateKey) {
+			if r.encryptor == nil {
+				return nil, errors.New("private key is encrypted but no encryption key is configured")
+			}
+			var err error
+			if privateKey, err = r.encryptor.Decrypt(privateKey); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	key, err := jwt.ParseSigningKey(string(entity.Algorithm), privateKey, entity.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if key.Kid != entity.Kid {
+		return nil, jwt.ErrKeyIDMismatch
+	}
+
+	key.Active = active
+	key.ExpiresAt = entity.ExpiresAt
+
+	return key, nil
+}
+
+func (r *JwtSigningKeyRepo) toProto(entity *ent.JwtSigningKey) *authenticationV1.JwtSigningKey {
+	dto := &authenticationV1.JwtSigningKey{
+		Id:        trans.Ptr(entity.ID),
+		Kid:       trans.Ptr(entity.Kid),
+		Algorithm: trans.Ptr(string(entity.Algorithm)),
+		PublicKey: trans.Ptr(entity.PublicKey),
+		CreatedBy: entity.CreatedBy,
+		UpdatedBy: entity.UpdatedBy,
+	}
+
+	if entity.Status == jwtsigningkey.StatusActive {
+		dto.Status = trans.Ptr(authenticationV1.JwtSigningKey_ACTIVE)
+	} else {
+		dto.Status = trans.Ptr(authenticationV1.JwtSigningKey_RETIRED)
+	}
+
+	if entity.RetiredAt != nil {
+		dto.RetiredAt = timestamppb.New(*entity.RetiredAt)
+	}
+	if entity.ExpiresAt != nil {
+		dto.ExpiresAt = timestamppb.New(*entity.ExpiresAt)
+	}
+	if entity.CreatedAt != nil {
+		dto.CreatedAt = timestamppb.New(*entity.CreatedAt)
+	}
+	if entity.UpdatedAt != nil {
+		dto.UpdatedAt = timestamppb.New(*entity.UpdatedAt)
+	}
+
+	return dto
+}
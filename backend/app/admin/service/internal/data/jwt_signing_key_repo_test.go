@@ -0,0 +1,62 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
+
+	"go-wind-admin/pkg/crypto"
+	"go-wind-admin/pkg/jwt"
+)
+
+func TestJwtSigningKeyRepo_EncryptedPrivateKey(t *testing.T) {
+	encryptor, err := crypto.NewEncryptor("jwt-signing-key-test")
+	assert.NoError(t, err)
+	r := &JwtSigningKeyRepo{log: log.NewHelper(log.DefaultLogger), encryptor: encryptor}
+
+	key, err := jwt.GenerateSigningKey(jwt.AlgorithmES256)
+	assert.NoError(t, err)
+
+	privateKey, publicKey, err := r.marshalKey(key)
+	assert.NoError(t, err)
+	assert.True(t, crypto.IsEncrypted(privateKey))
+
+	entity := &ent.JwtSigningKey{
+		Kid:        key.Kid,
+		Algorithm:  jwtsigningkey.AlgorithmES256,
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+		Status:     jwtsigningkey.StatusActive,
+	}
+
+	loaded, err := r.toSigningKey(entity)
+	assert.NoError(t, err)
+	assert.True(t, loaded.Active)
+	assert.NotNil(t, loaded.PrivateKey)
+	assert.Equal(t, key.Kid, loaded.Kid)
+
+	// 退役密钥只加载公钥
+	entity.Status = jwtsigningkey.StatusRetired
+	entity.ExpiresAt = trans.Ptr(time.Now().Add(time.Hour))
+	loaded, err = r.toSigningKey(entity)
+	assert.NoError(t, err)
+	assert.False(t, loaded.Active)
+	assert.Nil(t, loaded.PrivateKey)
+	assert.Equal(t, entity.ExpiresAt, loaded.ExpiresAt)
+
+	// 未配置加密密钥时无法解密当前密钥
+	entity.Status = jwtsigningkey.StatusActive
+	_, err = (&JwtSigningKeyRepo{}).toSigningKey(entity)
+	assert.Error(t, err)
+
+	// kid 与公钥不一致的记录不可信
+	entity.Kid = "tampered"
+	_, err = r.toSigningKey(entity)
+	assert.ErrorIs(t, err, jwt.ErrKeyIDMismatch)
+}
@@ -0,0 +1,464 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapsource"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/crypto"
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/ldap"
+)
+
+// LdapSourceRepo LDAP 身份源，服务账号密码在配置了加密密钥时加密保存，查询时不返回
+type LdapSourceRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper *mapper.CopierMapper[authenticationV1.LdapSource, ent.LdapSource]
+
+	repository *entCrud.Repository[
+		ent.LdapSourceQuery, ent.LdapSourceSelect,
+		ent.LdapSourceCreate, ent.LdapSourceCreateBulk,
+		ent.LdapSourceUpdate, ent.LdapSourceUpdateOne,
+		ent.LdapSourceDelete,
+		predicate.LdapSource,
+		authenticationV1.LdapSource, ent.LdapSource,
+	]
+
+	encryptor *crypto.Encryptor
+	timeout   time.Duration
+}
+
+func NewLdapSourceRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	adminCfg *adminConfV1.Bootstrap,
+) *LdapSourceRepo {
+	repo := &LdapSourceRepo{
+		log:       ctx.NewLoggerHelper("ldap-source/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[authenticationV1.LdapSource, ent.LdapSource](),
+		timeout:   adminCfg.GetLdap().GetTimeout().AsDuration(),
+	}
+
+	if key := adminCfg.GetLdap().GetEncryptionKey(); key != "" {
+		var err error
+		if repo.encryptor, err = crypto.NewEncryptor(key); err != nil {
+			repo.log.Errorf("create ldap bind password encryptor failed: %s", err.Error())
+		}
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *LdapSourceRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.LdapSourceQuery, ent.LdapSourceSelect,
+		ent.LdapSourceCreate, ent.LdapSourceCreateBulk,
+		ent.LdapSourceUpdate, ent.LdapSourceUpdateOne,
+		ent.LdapSourceDelete,
+		predicate.LdapSource,
+		authenticationV1.LdapSource, ent.LdapSource,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+func (r *LdapSourceRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector)) (int, error) {
+	builder := r.entClient.Client().LdapSource.Query()
+	if len(whereCond) != 0 {
+		builder.Modify(whereCond...)
+	}
+
+	count, err := builder.Count(ctx)
+	if err != nil {
+		r.log.Errorf("query count failed: %s", err.Error())
+		return 0, authenticationV1.ErrorInternalServerError("query count failed")
+	}
+
+	return count, nil
+}
+
+func (r *LdapSourceRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListLdapSourceResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().LdapSource.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &authenticationV1.ListLdapSourceResponse{Total: 0, Items: nil}, nil
+	}
+
+	for _, item := range ret.Items {
+		item.BindPassword = nil
+	}
+
+	return &authenticationV1.ListLdapSourceResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *LdapSourceRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().LdapSource.Query().
+		Where(ldapsource.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, authenticationV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *LdapSourceRepo) Get(ctx context.Context, req *authenticationV1.GetLdapSourceRequest) (*authenticationV1.LdapSource, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().LdapSource.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *authenticationV1.GetLdapSourceRequest_Id:
+		whereCond = append(whereCond, ldapsource.IDEQ(req.GetId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, authenticationV1.ErrorNotFound("ldap source not found")
+		}
+		return nil, err
+	}
+
+	dto.BindPassword = nil
+
+	return dto, nil
+}
+
+func (r *LdapSourceRepo) Create(ctx context.Context, req *authenticationV1.CreateLdapSourceRequest) error {
+	if req == nil || req.Data == nil {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	bindPassword, err := r.encryptBindPassword(req.Data.BindPassword)
+	if err != nil {
+		return err
+	}
+
+	builder := r.entClient.Client().LdapSource.Create().
+		SetNillableTenantID(req.Data.TenantId).
+		SetNillableName(req.Data.Name).
+		SetNillableEnabled(req.Data.Enabled).
+		SetURL(req.Data.GetUrl()).
+		SetNillableStartTLS(req.Data.StartTls).
+		SetNillableInsecureSkipVerify(req.Data.InsecureSkipVerify).
+		SetNillableBindDn(req.Data.BindDn).
+		SetNillableBindPassword(bindPassword).
+		SetBaseDn(req.Data.GetBaseDn()).
+		SetNillableUserFilter(req.Data.UserFilter).
+		SetNillableLoginAttribute(req.Data.LoginAttribute).
+		SetNillableEmailAttribute(req.Data.EmailAttribute).
+		SetNillableDisplayNameAttribute(req.Data.DisplayNameAttribute).
+		SetNillableMobileAttribute(req.Data.MobileAttribute).
+		SetNillableOrgUnitFilter(req.Data.OrgUnitFilter).
+		SetNillableGroupBaseDn(req.Data.GroupBaseDn).
+		SetNillableGroupFilter(req.Data.GroupFilter).
+		SetNillableGroupMemberAttribute(req.Data.GroupMemberAttribute).
+		SetGroupRoleMappings(normalizeGroupRoleMappings(req.Data.GroupRoleMappings)).
+		SetNillableDefaultRoleID(req.Data.DefaultRoleId).
+		SetNillableSyncEnabled(req.Data.SyncEnabled).
+		SetNillableDisableMissingUsers(req.Data.DisableMissingUsers).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if req.Data.Id != nil {
+		builder.SetID(req.GetData().GetId())
+	}
+
+	if err = builder.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return authenticationV1.ErrorConflict("ldap source already exists")
+		}
+		if ent.IsValidationError(err) {
+			return authenticationV1.ErrorBadRequest("url and base dn are required")
+		}
+		r.log.Errorf("insert ldap source failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("insert ldap source failed")
+	}
+
+	return nil
+}
+
+func (r *LdapSourceRepo) Update(ctx context.Context, req *authenticationV1.UpdateLdapSourceRequest) error {
+	if req == nil || req.Data == nil {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 如果不存在则创建
+	if req.GetAllowMissing() {
+		exist, err := r.IsExist(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			createReq := &authenticationV1.CreateLdapSourceRequest{Data: req.Data}
+			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
+			createReq.Data.UpdatedBy = nil
+			return r.Create(ctx, createReq)
+		}
+	}
+
+	// 密码留空表示不修改
+	if req.Data.GetBindPassword() == "" {
+		req.Data.BindPassword = nil
+	}
+	bindPassword, err := r.encryptBindPassword(req.Data.BindPassword)
+	if err != nil {
+		return err
+	}
+
+	builder := r.entClient.Client().Debug().LdapSource.Update()
+	err = r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *authenticationV1.LdapSource) {
+			if req.Data.Url != nil {
+				builder.SetURL(req.Data.GetUrl())
+			}
+			if req.Data.BaseDn != nil {
+				builder.SetBaseDn(req.Data.GetBaseDn())
+			}
+			if req.Data.GroupRoleMappings != nil {
+				builder.SetGroupRoleMappings(normalizeGroupRoleMappings(req.Data.GroupRoleMappings))
+			}
+			builder.
+				SetNillableName(req.Data.Name).
+				SetNillableEnabled(req.Data.Enabled).
+				SetNillableStartTLS(req.Data.StartTls).
+				SetNillableInsecureSkipVerify(req.Data.InsecureSkipVerify).
+				SetNillableBindDn(req.Data.BindDn).
+				SetNillableBindPassword(bindPassword).
+				SetNillableUserFilter(req.Data.UserFilter).
+				SetNillableLoginAttribute(req.Data.LoginAttribute).
+				SetNillableEmailAttribute(req.Data.EmailAttribute).
+				SetNillableDisplayNameAttribute(req.Data.DisplayNameAttribute).
+				SetNillableMobileAttribute(req.Data.MobileAttribute).
+				SetNillableOrgUnitFilter(req.Data.OrgUnitFilter).
+				SetNillableGroupBaseDn(req.Data.GroupBaseDn).
+				SetNillableGroupFilter(req.Data.GroupFilter).
+				SetNillableGroupMemberAttribute(req.Data.GroupMemberAttribute).
+				SetNillableDefaultRoleID(req.Data.DefaultRoleId).
+				SetNillableSyncEnabled(req.Data.SyncEnabled).
+				SetNillableDisableMissingUsers(req.Data.DisableMissingUsers).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(ldapsource.FieldID, req.GetId()))
+		},
+	)
+
+	return err
+}
+
+func (r *LdapSourceRepo) Delete(ctx context.Context, req *authenticationV1.DeleteLdapSourceRequest) error {
+	if req == nil {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	if err := r.entClient.Client().LdapSource.DeleteOneID(req.GetId()).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return authenticationV1.ErrorNotFound("ldap source not found")
+		}
+
+		r.log.Errorf("delete one data failed: %s", err.Error())
+
+		return authenticationV1.ErrorInternalServerError("delete failed")
+	}
+
+	return nil
+}
+
+// GetEntity 按ID查询身份源实体，包含解密前的服务账号密码，只用于建立连接
+func (r *LdapSourceRepo) GetEntity(ctx context.Context, id uint32) (*ent.LdapSource, error) {
+	entity, err := r.entClient.Client().LdapSource.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, authenticationV1.ErrorNotFound("ldap source not found")
+		}
+		r.log.Errorf("query ldap source [%d] failed: %s", id, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query ldap source failed")
+	}
+	return entity, nil
+}
+
+// ListSyncEnabled 查询启用并开启了定时同步的身份源，tenantIDs 为空时不限租户
+func (r *LdapSourceRepo) ListSyncEnabled(ctx context.Context, tenantIDs []uint32) ([]*ent.LdapSource, error) {
+	builder := r.entClient.Client().LdapSource.Query().
+		Where(
+			ldapsource.EnabledEQ(true),
+			ldapsource.SyncEnabledEQ(true),
+		)
+	if len(tenantIDs) > 0 {
+		builder.Where(ldapsource.TenantIDIn(tenantIDs...))
+	}
+
+	entities, err := builder.All(appViewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		r.log.Errorf("query sync enabled ldap sources failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query ldap sources failed")
+	}
+	return entities, nil
+}
+
+// RecordSyncResult 记录最近一次同步的时间与错误
+func (r *LdapSourceRepo) RecordSyncResult(ctx context.Context, id uint32, syncErr error) error {
+	builder := r.entClient.Client().LdapSource.UpdateOneID(id).
+		SetLastSyncAt(time.Now())
+	if syncErr != nil {
+		msg := syncErr.Error()
+		if len(msg) > 1024 {
+			msg = msg[:1024]
+		}
+		builder.SetLastSyncError(msg)
+	} else {
+		builder.ClearLastSyncError()
+	}
+
+	if err := builder.Exec(appViewer.NewSystemViewerContext(ctx)); err != nil {
+		r.log.Errorf("record sync result of ldap source [%d] failed: %s", id, err.Error())
+		return authenticationV1.ErrorInternalServerError("record ldap sync result failed")
+	}
+	return nil
+}
+
+// NewClient 按身份源配置创建目录客户端
+func (r *LdapSourceRepo) NewClient(entity *ent.LdapSource) (*ldap.Client, error) {
+	bindPassword := trans.StringValue(entity.BindPassword)
+	if crypto.IsEncrypted(bindPassword) {
+		if r.encryptor == nil {
+			r.log.Errorf("bind password of ldap source [%d] is encrypted but no encryption key is configured", entity.ID)
+			return nil, authenticationV1.ErrorServiceUnavailable("external identity source unavailable")
+		}
+		var err error
+		if bindPassword, err = r.encryptor.Decrypt(bindPassword); err != nil {
+			r.log.Errorf("decrypt bind password of ldap source [%d] failed: %s", entity.ID, err.Error())
+			return nil, authenticationV1.ErrorServiceUnavailable("external identity source unavailable")
+		}
+	}
+
+	// 组织单元过滤条件未设置时使用默认值，显式设为空字符串时不同步组织
+	orgUnitFilter := ldap.DefaultOrgUnitFilter
+	if entity.OrgUnitFilter != nil {
+		orgUnitFilter = *entity.OrgUnitFilter
+	}
+	groupFilter := ldap.DefaultGroupFilter
+	if entity.GroupFilter != nil {
+		groupFilter = *entity.GroupFilter
+	}
+
+	return ldap.NewClient(ldap.Config{
+		URL:                  entity.URL,
+		StartTLS:             trans.BoolValue(entity.StartTLS),
+		InsecureSkipVerify:   trans.BoolValue(entity.InsecureSkipVerify),
+		BindDN:               trans.StringValue(entity.BindDn),
+		BindPassword:         bindPassword,
+		BaseDN:               entity.BaseDn,
+		UserFilter:           trans.StringValue(entity.UserFilter),
+		LoginAttribute:       trans.StringValue(entity.LoginAttribute),
+		EmailAttribute:       trans.StringValue(entity.EmailAttribute),
+		DisplayNameAttribute: trans.StringValue(entity.DisplayNameAttribute),
+		MobileAttribute:      trans.StringValue(entity.MobileAttribute),
+		OrgUnitFilter:        orgUnitFilter,
+		GroupBaseDN:          trans.StringValue(entity.GroupBaseDn),
+		GroupFilter:          groupFilter,
+		GroupMemberAttribute: trans.StringValue(entity.GroupMemberAttribute),
+		Timeout:              r.timeout,
+	}), nil
+}
+
+// Authenticate 通过租户的身份源绑定校验用户密码
+func (r *LdapSourceRepo) Authenticate(ctx context.Context, tenantID uint32, username, password string) (*ldap.User, error) {
+	entity, err := r.entClient.Client().LdapSource.Query().
+		Where(ldapsource.TenantIDEQ(tenantID)).
+		Only(appViewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			r.log.Warnf("tenant [%d] has external users but no ldap source", tenantID)
+			return nil, authenticationV1.ErrorServiceUnavailable("external identity source unavailable")
+		}
+		r.log.Errorf("query ldap source of tenant [%d] failed: %s", tenantID, err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+	if !trans.BoolValue(entity.Enabled) {
+		return nil, authenticationV1.ErrorServiceUnavailable("external identity source unavailable")
+	}
+
+	client, err := r.NewClient(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := client.Authenticate(ctx, username, password)
+	if err != nil {
+		if errors.Is(err, ldap.ErrInvalidCredentials) {
+			return nil, authenticationV1.ErrorIncorrectPassword("incorrect password")
+		}
+		r.log.Errorf("authenticate [%s] against ldap source [%d] failed: %s", username, entity.ID, err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("external identity source unavailable")
+	}
+
+	return user, nil
+}
+
+func (r *LdapSourceRepo) encryptBindPassword(password *string) (*string, error) {
+	if password == nil || r.encryptor == nil {
+		return password, nil
+	}
+
+	encrypted, err := r.encryptor.Encrypt(*password)
+	if err != nil {
+		r.log.Errorf("encrypt ldap bind password failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("encrypt bind password failed")
+	}
+	return &encrypted, nil
+}
+
+// normalizeGroupRoleMappings 组DN统一规范化，忽略未指定角色的映射
+func normalizeGroupRoleMappings(mappings map[string]uint32) map[string]uint32 {
+	ret := make(map[string]uint32, len(mappings))
+	for dn, roleID := range mappings {
+		if dn == "" || roleID == 0 {
+			continue
+		}
+		ret[ldap.NormalizeDN(dn)] = roleID
+	}
+	return ret
+}
@@ -0,0 +1,835 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+	"go-wind-admin/app/admin/service/internal/data/ent/userorgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/ldap"
+)
+
+// LdapCredentialProvider LDAP 身份源用户凭证的提供方，凭证的 provider_account_id 保存规范化后的用户DN
+const LdapCredentialProvider = "ldap"
+
+// errLdapEmptyDirectory 目录没有返回任何用户，多半是过滤条件或搜索根配置错误，此时不能禁用本地用户
+var errLdapEmptyDirectory = errors.New("directory returned no users, refusing to disable local users")
+
+// LdapSyncRepo 把目录快照同步到租户的用户、组织单元与用户角色。
+// 用户按登录名匹配，只有凭证类型为外部身份源的用户由同步管理，同名的本地账号会被跳过；
+// 组织单元按 external_id（规范化后的DN）匹配；角色与组织单元按默认的一对一用户租户关系维护。
+type LdapSyncRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	userRepo           UserRepo
+	userCredentialRepo *UserCredentialRepo
+	userRoleRepo       *UserRoleRepo
+	userOrgUnitRepo    *UserOrgUnitRepo
+}
+
+func NewLdapSyncRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	userRepo UserRepo,
+	userCredentialRepo *UserCredentialRepo,
+	userRoleRepo *UserRoleRepo,
+	userOrgUnitRepo *UserOrgUnitRepo,
+) *LdapSyncRepo {
+	return &LdapSyncRepo{
+		log:                ctx.NewLoggerHelper("ldap-sync/repo/admin-service"),
+		entClient:          entClient,
+		userRepo:           userRepo,
+		userCredentialRepo: userCredentialRepo,
+		userRoleRepo:       userRoleRepo,
+		userOrgUnitRepo:    userOrgUnitRepo,
+	}
+}
+
+// ldapLocalUser 同步前租户内的用户
+type ldapLocalUser struct {
+	ID         uint32
+	Username   string
+	Email      string
+	Realname   string
+	Mobile     string
+	Disabled   bool
+	External   bool   // 由目录同步管理
+	ExternalID string // 规范化后的用户DN
+	RoleIDs    []uint32
+	OrgUnitIDs []uint32
+}
+
+// ldapLocalOrgUnit 同步前租户内的组织单元
+type ldapLocalOrgUnit struct {
+	ID         uint32
+	Name       string
+	ParentID   uint32
+	ExternalID string
+}
+
+// ldapSyncState 同步前租户内的相关数据
+type ldapSyncState struct {
+	Users          []ldapLocalUser
+	OrgUnits       []ldapLocalOrgUnit
+	EmailOwners    map[string]uint32   // 小写邮箱到用户ID
+	TakenUsernames map[string]struct{} // 其他租户已使用的登录名（小写）
+}
+
+// ldapSyncOptions 身份源中影响同步结果的设置
+type ldapSyncOptions struct {
+	GroupRoleMappings   map[string]uint32 // 规范化后的组DN到角色ID
+	DefaultRoleID       uint32
+	DisableMissingUsers bool
+}
+
+// ldapOrgUnitOp 组织单元变更，按先父后子的顺序排列
+type ldapOrgUnitOp struct {
+	ID               uint32 // 为0时新建
+	ExternalID       string
+	Name             string
+	ParentExternalID string // 为空时作为顶级组织
+}
+
+// ldapUserOp 用户变更
+type ldapUserOp struct {
+	ID       uint32 // 为0时新建
+	DN       string
+	Username string
+	Email    string
+	Realname string
+	Mobile   string
+
+	UpdateProfile bool
+
+	OrgUnitExternalID string
+	UpdateOrgUnit     bool
+
+	RoleIDs     []uint32
+	UpdateRoles bool
+}
+
+// ldapSyncPlan 同步计划，试运行时只返回其中的报告
+type ldapSyncPlan struct {
+	OrgUnits       []ldapOrgUnitOp
+	OrgUnitIDs     map[string]uint32 // 已存在的组织单元 external_id 到ID
+	Users          []ldapUserOp
+	DisableUserIDs []uint32
+	Report         *authenticationV1.LdapSyncReport
+}
+
+// Sync 读取租户现状并计算同步计划，非试运行时在一个事务中落库
+func (r *LdapSyncRepo) Sync(ctx context.Context, source *ent.LdapSource, dir *ldap.Directory, dryRun bool) (*authenticationV1.LdapSyncReport, error) {
+	ctx = appViewer.NewSystemViewerContext(ctx)
+	tenantID := trans.Uint32Value(source.TenantID)
+
+	state, err := r.loadState(ctx, tenantID, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := planLdapSync(dir, state, ldapSyncOptions{
+		GroupRoleMappings:   source.GroupRoleMappings,
+		DefaultRoleID:       trans.Uint32Value(source.DefaultRoleID),
+		DisableMissingUsers: trans.BoolValue(source.DisableMissingUsers),
+	})
+	if err != nil {
+		return nil, authenticationV1.ErrorPreconditionFailed("%s", err.Error())
+	}
+	plan.Report.DryRun = dryRun
+
+	if dryRun {
+		return plan.Report, nil
+	}
+
+	if err = r.apply(ctx, tenantID, plan); err != nil {
+		return nil, err
+	}
+
+	return plan.Report, nil
+}
+
+// loadState 查询与本次目录快照相关的租户数据
+func (r *LdapSyncRepo) loadState(ctx context.Context, tenantID uint32, dir *ldap.Directory) (*ldapSyncState, error) {
+	client := r.entClient.Client()
+	state := &ldapSyncState{
+		EmailOwners:    make(map[string]uint32),
+		TakenUsernames: make(map[string]struct{}),
+	}
+
+	credentials, err := client.UserCredential.Query().
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.CredentialTypeEQ(usercredential.CredentialTypeExternal),
+			usercredential.ProviderEQ(LdapCredentialProvider),
+		).
+		Select(usercredential.FieldUserID, usercredential.FieldProviderAccountID).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query external credentials of tenant [%d] failed: %s", tenantID, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query external credentials failed")
+	}
+	externalDNs := make(map[uint32]string, len(credentials))
+	for _, c := range credentials {
+		externalDNs[trans.Uint32Value(c.UserID)] = trans.StringValue(c.ProviderAccountID)
+	}
+
+	users, err := client.User.Query().
+		Where(user.TenantIDEQ(tenantID)).
+		Select(user.FieldID, user.FieldUsername, user.FieldEmail, user.FieldRealname, user.FieldMobile, user.FieldStatus).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query users of tenant [%d] failed: %s", tenantID, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query users failed")
+	}
+
+	var externalIDs []uint32
+	for _, u := range users {
+		local := ldapLocalUser{
+			ID:       u.ID,
+			Username: trans.StringValue(u.Username),
+			Email:    trans.StringValue(u.Email),
+			Realname: trans.StringValue(u.Realname),
+			Mobile:   trans.StringValue(u.Mobile),
+			Disabled: u.Status != nil && *u.Status == user.StatusDisabled,
+		}
+		if dn, ok := externalDNs[u.ID]; ok {
+			local.External = true
+			local.ExternalID = dn
+			externalIDs = append(externalIDs, u.ID)
+		}
+		if local.Email != "" {
+			state.EmailOwners[strings.ToLower(local.Email)] = u.ID
+		}
+		state.Users = append(state.Users, local)
+	}
+
+	if len(externalIDs) > 0 {
+		userRoles, err := client.UserRole.Query().
+			Where(userrole.UserIDIn(externalIDs...)).
+			Select(userrole.FieldUserID, userrole.FieldRoleID).
+			All(ctx)
+		if err != nil {
+			r.log.Errorf("query user roles failed: %s", err.Error())
+			return nil, authenticationV1.ErrorInternalServerError("query user roles failed")
+		}
+		userOrgUnits, err := client.UserOrgUnit.Query().
+			Where(userorgunit.UserIDIn(externalIDs...)).
+			Select(userorgunit.FieldUserID, userorgunit.FieldOrgUnitID).
+			All(ctx)
+		if err != nil {
+			r.log.Errorf("query user org units failed: %s", err.Error())
+			return nil, authenticationV1.ErrorInternalServerError("query user org units failed")
+		}
+
+		index := make(map[uint32]*ldapLocalUser, len(externalIDs))
+		for i := range state.Users {
+			index[state.Users[i].ID] = &state.Users[i]
+		}
+		for _, ur := range userRoles {
+			if u := index[trans.Uint32Value(ur.UserID)]; u != nil {
+				u.RoleIDs = append(u.RoleIDs, trans.Uint32Value(ur.RoleID))
+			}
+		}
+		for _, uo := range userOrgUnits {
+			if u := index[trans.Uint32Value(uo.UserID)]; u != nil {
+				u.OrgUnitIDs = append(u.OrgUnitIDs, trans.Uint32Value(uo.OrgUnitID))
+			}
+		}
+	}
+
+	orgUnits, err := client.OrgUnit.Query().
+		Where(orgunit.TenantIDEQ(tenantID)).
+		Select(orgunit.FieldID, orgunit.FieldName, orgunit.FieldParentID, orgunit.FieldExternalID).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query org units of tenant [%d] failed: %s", tenantID, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query org units failed")
+	}
+	for _, o := range orgUnits {
+		state.OrgUnits = append(state.OrgUnits, ldapLocalOrgUnit{
+			ID:         o.ID,
+			Name:       trans.StringValue(o.Name),
+			ParentID:   trans.Uint32Value(o.ParentID),
+			ExternalID: trans.StringValue(o.ExternalID),
+		})
+	}
+
+	// 登录时按登录名全局查找凭证，其他租户已使用的登录名不能再创建
+	if len(dir.Users) > 0 {
+		usernames := make([]string, 0, len(dir.Users))
+		for _, u := range dir.Users {
+			usernames = append(usernames, u.Username)
+		}
+		identifiers, err := client.UserCredential.Query().
+			Where(
+				usercredential.IdentityTypeEQ(usercredential.IdentityTypeUsername),
+				usercredential.IdentifierIn(usernames...),
+				usercredential.Or(
+					usercredential.TenantIDNEQ(tenantID),
+					usercredential.TenantIDIsNil(),
+				),
+			).
+			Select(usercredential.FieldIdentifier).
+			Strings(ctx)
+		if err != nil {
+			r.log.Errorf("query taken usernames failed: %s", err.Error())
+			return nil, authenticationV1.ErrorInternalServerError("query taken usernames failed")
+		}
+		for _, id := range identifiers {
+			state.TakenUsernames[strings.ToLower(id)] = struct{}{}
+		}
+	}
+
+	return state, nil
+}
+
+// planLdapSync 对比目录快照与租户现状，计算需要的变更
+func planLdapSync(dir *ldap.Directory, state *ldapSyncState, opts ldapSyncOptions) (*ldapSyncPlan, error) {
+	plan := &ldapSyncPlan{
+		OrgUnitIDs: make(map[string]uint32),
+		Report:     &authenticationV1.LdapSyncReport{},
+	}
+	report := plan.Report
+
+	// 组织单元，先父后子
+	ouSet := make(map[string]ldap.OrgUnit, len(dir.OrgUnits))
+	for _, ou := range dir.OrgUnits {
+		ouSet[ldap.NormalizeDN(ou.DN)] = ou
+	}
+	ouDNs := make([]string, 0, len(ouSet))
+	for dn := range ouSet {
+		ouDNs = append(ouDNs, dn)
+	}
+	sort.Slice(ouDNs, func(i, j int) bool {
+		di, dj := strings.Count(ouDNs[i], ","), strings.Count(ouDNs[j], ",")
+		if di != dj {
+			return di < dj
+		}
+		return ouDNs[i] < ouDNs[j]
+	})
+
+	localByExternalID := make(map[string]*ldapLocalOrgUnit)
+	type parentName struct {
+		parentID uint32
+		name     string
+	}
+	adoptable := make(map[parentName]*ldapLocalOrgUnit)
+	for i := range state.OrgUnits {
+		o := &state.OrgUnits[i]
+		if o.ExternalID != "" {
+			localByExternalID[o.ExternalID] = o
+		} else {
+			adoptable[parentName{o.ParentID, o.Name}] = o
+		}
+	}
+
+	for _, dn := range ouDNs {
+		ou := ouSet[dn]
+		parentExt := nearestLdapOrgUnit(ldap.ParentDN(ou.DN), ouSet)
+		parentID, parentKnown := plan.OrgUnitIDs[parentExt]
+		if parentExt == "" {
+			parentID, parentKnown = 0, true
+		}
+
+		change := &authenticationV1.LdapSyncChange{
+			Kind:       authenticationV1.LdapSyncChange_ORG_UNIT,
+			ExternalId: dn,
+			Name:       ou.Name,
+		}
+
+		if local := localByExternalID[dn]; local != nil {
+			plan.OrgUnitIDs[dn] = local.ID
+			if local.Name == ou.Name && parentKnown && local.ParentID == parentID {
+				continue
+			}
+			plan.OrgUnits = append(plan.OrgUnits, ldapOrgUnitOp{ID: local.ID, ExternalID: dn, Name: ou.Name, ParentExternalID: parentExt})
+			change.Action = authenticationV1.LdapSyncChange_UPDATE
+			change.Detail = "更新名称或上级组织"
+			report.OrgUnitsUpdated++
+		} else if local = adoptable[parentName{parentID, ou.Name}]; parentKnown && local != nil {
+			// 同一上级下已有同名的本地组织，关联到目录而不是重复创建
+			delete(adoptable, parentName{parentID, ou.Name})
+			plan.OrgUnitIDs[dn] = local.ID
+			plan.OrgUnits = append(plan.OrgUnits, ldapOrgUnitOp{ID: local.ID, ExternalID: dn, Name: ou.Name, ParentExternalID: parentExt})
+			change.Action = authenticationV1.LdapSyncChange_UPDATE
+			change.Detail = "关联已有的同名组织"
+			report.OrgUnitsUpdated++
+		} else {
+			plan.OrgUnits = append(plan.OrgUnits, ldapOrgUnitOp{ExternalID: dn, Name: ou.Name, ParentExternalID: parentExt})
+			change.Action = authenticationV1.LdapSyncChange_CREATE
+			report.OrgUnitsCreated++
+		}
+		report.Changes = append(report.Changes, change)
+	}
+
+	// 用户
+	manageRoles := len(opts.GroupRoleMappings) > 0 || opts.DefaultRoleID != 0
+	memberRoles := make(map[string][]uint32)
+	for _, g := range dir.Groups {
+		roleID := opts.GroupRoleMappings[ldap.NormalizeDN(g.DN)]
+		if roleID == 0 {
+			continue
+		}
+		for _, m := range g.Members {
+			dn := ldap.NormalizeDN(m)
+			memberRoles[dn] = append(memberRoles[dn], roleID)
+		}
+	}
+
+	localByUsername := make(map[string]*ldapLocalUser, len(state.Users))
+	for i := range state.Users {
+		localByUsername[strings.ToLower(state.Users[i].Username)] = &state.Users[i]
+	}
+
+	dirUsers := slices.Clone(dir.Users)
+	sort.Slice(dirUsers, func(i, j int) bool { return dirUsers[i].Username < dirUsers[j].Username })
+
+	seen := make(map[string]struct{}, len(dirUsers))
+	for _, u := range dirUsers {
+		key := strings.ToLower(u.Username)
+		dn := ldap.NormalizeDN(u.DN)
+		change := &authenticationV1.LdapSyncChange{
+			Kind:       authenticationV1.LdapSyncChange_USER,
+			ExternalId: dn,
+			Name:       u.Username,
+		}
+
+		if _, dup := seen[key]; dup {
+			change.Action = authenticationV1.LdapSyncChange_SKIP
+			change.Detail = "目录中存在重名的用户"
+			report.UsersSkipped++
+			report.Changes = append(report.Changes, change)
+			continue
+		}
+		seen[key] = struct{}{}
+
+		roleIDs := uniqueSortedIDs(memberRoles[dn])
+		if len(roleIDs) == 0 && opts.DefaultRoleID != 0 {
+			roleIDs = []uint32{opts.DefaultRoleID}
+		}
+
+		var ouExt string
+		if len(ouSet) > 0 {
+			ouExt = nearestLdapOrgUnit(ldap.ParentDN(u.DN), ouSet)
+		}
+
+		local := localByUsername[key]
+		switch {
+		case local == nil:
+			if _, taken := state.TakenUsernames[key]; taken {
+				change.Action = authenticationV1.LdapSyncChange_SKIP
+				change.Detail = "登录名已被其他租户使用"
+				report.UsersSkipped++
+				report.Changes = append(report.Changes, change)
+				continue
+			}
+
+			op := ldapUserOp{
+				DN:                dn,
+				Username:          u.Username,
+				Realname:          u.DisplayName,
+				Mobile:            u.Mobile,
+				OrgUnitExternalID: ouExt,
+				UpdateOrgUnit:     ouExt != "",
+				RoleIDs:           roleIDs,
+				UpdateRoles:       len(roleIDs) > 0,
+			}
+			if email := strings.ToLower(u.Email); email != "" {
+				if _, used := state.EmailOwners[email]; used {
+					change.Detail = "邮箱已被其他用户使用，未设置邮箱"
+				} else {
+					op.Email = u.Email
+					state.EmailOwners[email] = 0
+				}
+			}
+			plan.Users = append(plan.Users, op)
+
+			change.Action = authenticationV1.LdapSyncChange_CREATE
+			report.UsersCreated++
+			report.Changes = append(report.Changes, change)
+			if op.UpdateRoles {
+				report.RoleAssignments++
+				report.Changes = append(report.Changes, roleAssignmentChange(dn, u.Username, nil, roleIDs))
+			}
+
+		case !local.External:
+			change.Action = authenticationV1.LdapSyncChange_SKIP
+			change.Detail = "与本地账号同名，不由目录管理"
+			report.UsersSkipped++
+			report.Changes = append(report.Changes, change)
+
+		default:
+			op := ldapUserOp{
+				ID:       local.ID,
+				DN:       dn,
+				Username: local.Username,
+				Email:    local.Email,
+				Realname: u.DisplayName,
+				Mobile:   u.Mobile,
+			}
+
+			var fields []string
+			if local.ExternalID != dn {
+				fields = append(fields, "DN")
+			}
+			if local.Realname != u.DisplayName {
+				fields = append(fields, "姓名")
+			}
+			if local.Mobile != u.Mobile {
+				fields = append(fields, "手机号")
+			}
+			if !strings.EqualFold(local.Email, u.Email) {
+				owner, used := state.EmailOwners[strings.ToLower(u.Email)]
+				if u.Email == "" || !used || owner == local.ID {
+					op.Email = u.Email
+					fields = append(fields, "邮箱")
+				}
+			}
+			op.UpdateProfile = len(fields) > 0
+
+			if ouExt != "" {
+				ouID := plan.OrgUnitIDs[ouExt]
+				if ouID == 0 || len(local.OrgUnitIDs) != 1 || local.OrgUnitIDs[0] != ouID {
+					op.OrgUnitExternalID = ouExt
+					op.UpdateOrgUnit = true
+					fields = append(fields, "组织")
+				}
+			}
+
+			current := uniqueSortedIDs(local.RoleIDs)
+			if manageRoles && !slices.Equal(current, roleIDs) {
+				op.RoleIDs = roleIDs
+				op.UpdateRoles = true
+				report.RoleAssignments++
+				report.Changes = append(report.Changes, roleAssignmentChange(dn, local.Username, current, roleIDs))
+			}
+
+			if len(fields) > 0 {
+				change.Action = authenticationV1.LdapSyncChange_UPDATE
+				change.Detail = "更新" + strings.Join(fields, "、")
+				report.UsersUpdated++
+				report.Changes = append(report.Changes, change)
+			}
+			if op.UpdateProfile || op.UpdateOrgUnit || op.UpdateRoles {
+				plan.Users = append(plan.Users, op)
+			}
+		}
+	}
+
+	// 目录中已不存在的外部用户
+	if opts.DisableMissingUsers {
+		if len(dir.Users) == 0 {
+			return nil, errLdapEmptyDirectory
+		}
+		for _, local := range state.Users {
+			if !local.External || local.Disabled {
+				continue
+			}
+			if _, ok := seen[strings.ToLower(local.Username)]; ok {
+				continue
+			}
+			plan.DisableUserIDs = append(plan.DisableUserIDs, local.ID)
+			report.UsersDisabled++
+			report.Changes = append(report.Changes, &authenticationV1.LdapSyncChange{
+				Kind:       authenticationV1.LdapSyncChange_USER,
+				Action:     authenticationV1.LdapSyncChange_DISABLE,
+				ExternalId: local.ExternalID,
+				Name:       local.Username,
+				Detail:     "目录中已不存在",
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+// apply 在一个事务中执行同步计划
+func (r *LdapSyncRepo) apply(ctx context.Context, tenantID uint32, plan *ldapSyncPlan) (err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = authenticationV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	now := time.Now()
+
+	for _, op := range plan.OrgUnits {
+		parentID := plan.OrgUnitIDs[op.ParentExternalID]
+
+		if op.ID == 0 {
+			builder := tx.OrgUnit.Create().
+				SetTenantID(tenantID).
+				SetName(op.Name).
+				SetExternalID(op.ExternalID).
+				SetType(orgunit.TypeDepartment).
+				SetStatus(orgunit.StatusOn).
+				SetCreatedAt(now)
+			if parentID != 0 {
+				builder.SetParentID(parentID)
+			}
+
+			var entity *ent.OrgUnit
+			if entity, err = builder.Save(ctx); err != nil {
+				r.log.Errorf("insert org unit [%s] failed: %s", op.ExternalID, err.Error())
+				return authenticationV1.ErrorInternalServerError("insert org unit failed")
+			}
+			plan.OrgUnitIDs[op.ExternalID] = entity.ID
+			continue
+		}
+
+		builder := tx.OrgUnit.UpdateOneID(op.ID).
+			SetName(op.Name).
+			SetExternalID(op.ExternalID).
+			SetUpdatedAt(now)
+		if parentID != 0 {
+			builder.SetParentID(parentID)
+		} else {
+			builder.ClearParentID()
+		}
+		if err = builder.Exec(ctx); err != nil {
+			r.log.Errorf("update org unit [%d] failed: %s", op.ID, err.Error())
+			return authenticationV1.ErrorInternalServerError("update org unit failed")
+		}
+	}
+
+	if len(plan.OrgUnits) > 0 {
+		if err = rebuildOrgUnitPaths(ctx, tx, tenantID); err != nil {
+			r.log.Errorf("rebuild org unit paths of tenant [%d] failed: %s", tenantID, err.Error())
+			return authenticationV1.ErrorInternalServerError("rebuild org unit paths failed")
+		}
+	}
+
+	for _, op := range plan.Users {
+		var orgUnitIDs []uint32
+		if op.UpdateOrgUnit {
+			orgUnitIDs = []uint32{plan.OrgUnitIDs[op.OrgUnitExternalID]}
+		}
+
+		if op.ID == 0 {
+			if err = r.createUser(ctx, tx, tenantID, op, orgUnitIDs); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if op.UpdateProfile {
+			if err = tx.User.UpdateOneID(op.ID).
+				SetRealname(op.Realname).
+				SetMobile(op.Mobile).
+				SetNillableEmail(emptyToNil(op.Email)).
+				SetUpdatedAt(now).
+				Exec(ctx); err != nil {
+				r.log.Errorf("update user [%d] failed: %s", op.ID, err.Error())
+				return authenticationV1.ErrorInternalServerError("update user failed")
+			}
+			if op.Email == "" {
+				if err = tx.User.UpdateOneID(op.ID).ClearEmail().Exec(ctx); err != nil {
+					r.log.Errorf("clear email of user [%d] failed: %s", op.ID, err.Error())
+					return authenticationV1.ErrorInternalServerError("update user failed")
+				}
+			}
+			if _, err = tx.UserCredential.Update().
+				Where(
+					usercredential.UserIDEQ(op.ID),
+					usercredential.ProviderEQ(LdapCredentialProvider),
+				).
+				SetProviderAccountID(op.DN).
+				SetUpdatedAt(now).
+				Save(ctx); err != nil {
+				r.log.Errorf("update external credential of user [%d] failed: %s", op.ID, err.Error())
+				return authenticationV1.ErrorInternalServerError("update user credential failed")
+			}
+		}
+
+		if op.UpdateOrgUnit {
+			if err = r.userOrgUnitRepo.AssignUserOrgUnits(ctx, tx, op.ID, []*userV1.UserOrgUnit{{
+				TenantId:   trans.Ptr(tenantID),
+				UserId:     trans.Ptr(op.ID),
+				OrgUnitId:  trans.Ptr(orgUnitIDs[0]),
+				Status:     userV1.UserOrgUnit_ACTIVE.Enum(),
+				IsPrimary:  trans.Ptr(true),
+				AssignedAt: timeutil.TimeToTimestamppb(&now),
+			}}); err != nil {
+				return err
+			}
+		}
+
+		if op.UpdateRoles {
+			if len(op.RoleIDs) == 0 {
+				err = r.userRoleRepo.CleanRelationsByUserID(ctx, tx, op.ID)
+			} else {
+				userRoles := make([]*userV1.UserRole, 0, len(op.RoleIDs))
+				for _, roleID := range op.RoleIDs {
+					userRoles = append(userRoles, &userV1.UserRole{
+						TenantId:   trans.Ptr(tenantID),
+						UserId:     trans.Ptr(op.ID),
+						RoleId:     trans.Ptr(roleID),
+						Status:     userV1.UserRole_ACTIVE.Enum(),
+						IsPrimary:  trans.Ptr(true),
+						AssignedAt: timeutil.TimeToTimestamppb(&now),
+					})
+				}
+				err = r.userRoleRepo.AssignUserRoles(ctx, tx, op.ID, userRoles)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(plan.DisableUserIDs) > 0 {
+		if _, err = tx.User.Update().
+			Where(user.IDIn(plan.DisableUserIDs...)).
+			SetStatus(user.StatusDisabled).
+			SetUpdatedAt(now).
+			Save(ctx); err != nil {
+			r.log.Errorf("disable users failed: %s", err.Error())
+			return authenticationV1.ErrorInternalServerError("disable users failed")
+		}
+	}
+
+	return nil
+}
+
+// createUser 创建目录用户及其外部身份源凭证，本地不保存密码
+func (r *LdapSyncRepo) createUser(ctx context.Context, tx *ent.Tx, tenantID uint32, op ldapUserOp, orgUnitIDs []uint32) error {
+	nickname := op.Realname
+	if nickname == "" {
+		nickname = op.Username
+	}
+
+	dto, err := r.userRepo.CreateWithTx(ctx, tx, &userV1.User{
+		TenantId:   trans.Ptr(tenantID),
+		Username:   trans.Ptr(op.Username),
+		Nickname:   trans.Ptr(nickname),
+		Realname:   emptyToNil(op.Realname),
+		Email:      emptyToNil(op.Email),
+		Mobile:     emptyToNil(op.Mobile),
+		Status:     userV1.User_NORMAL.Enum(),
+		RoleIds:    op.RoleIDs,
+		OrgUnitIds: orgUnitIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.userCredentialRepo.CreateWithTx(ctx, tx, &authenticationV1.UserCredential{
+		UserId:            dto.Id,
+		TenantId:          trans.Ptr(tenantID),
+		IdentityType:      authenticationV1.UserCredential_USERNAME.Enum(),
+		Identifier:        trans.Ptr(op.Username),
+		CredentialType:    authenticationV1.UserCredential_EXTERNAL.Enum(),
+		IsPrimary:         trans.Ptr(true),
+		Status:            authenticationV1.UserCredential_ENABLED.Enum(),
+		Provider:          trans.Ptr(LdapCredentialProvider),
+		ProviderAccountId: trans.Ptr(op.DN),
+	})
+}
+
+// rebuildOrgUnitPaths 组织结构变化后重新计算租户内所有组织单元的树路径
+func rebuildOrgUnitPaths(ctx context.Context, tx *ent.Tx, tenantID uint32) error {
+	entities, err := tx.OrgUnit.Query().
+		Where(orgunit.TenantIDEQ(tenantID)).
+		Select(orgunit.FieldID, orgunit.FieldParentID, orgunit.FieldPath).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	children := make(map[uint32][]*ent.OrgUnit)
+	for _, e := range entities {
+		parentID := trans.Uint32Value(e.ParentID)
+		children[parentID] = append(children[parentID], e)
+	}
+
+	var walk func(parentID uint32, parentPath string) error
+	walk = func(parentID uint32, parentPath string) error {
+		for _, e := range children[parentID] {
+			path := entCrud.ComputeTreePath(parentPath, e.ID)
+			if trans.StringValue(e.Path) != path {
+				if err := tx.OrgUnit.UpdateOneID(e.ID).SetPath(path).Exec(ctx); err != nil {
+					return err
+				}
+			}
+			if err := walk(e.ID, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return walk(0, "")
+}
+
+// nearestLdapOrgUnit 从 dn 开始向上查找最近的已同步组织单元，返回其规范化DN
+func nearestLdapOrgUnit(dn string, ouSet map[string]ldap.OrgUnit) string {
+	for dn != "" {
+		normalized := ldap.NormalizeDN(dn)
+		if _, ok := ouSet[normalized]; ok {
+			return normalized
+		}
+		dn = ldap.ParentDN(dn)
+	}
+	return ""
+}
+
+func roleAssignmentChange(dn, username string, from, to []uint32) *authenticationV1.LdapSyncChange {
+	return &authenticationV1.LdapSyncChange{
+		Kind:       authenticationV1.LdapSyncChange_ROLE_ASSIGNMENT,
+		Action:     authenticationV1.LdapSyncChange_UPDATE,
+		ExternalId: dn,
+		Name:       username,
+		Detail:     fmt.Sprintf("角色 %v -> %v", from, to),
+	}
+}
+
+func uniqueSortedIDs(ids []uint32) []uint32 {
+	if len(ids) == 0 {
+		return nil
+	}
+	ret := slices.Clone(ids)
+	slices.Sort(ret)
+	return slices.Compact(ret)
+}
+
+func emptyToNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,157 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/ldap"
+)
+
+func testLdapDirectory() *ldap.Directory {
+	return &ldap.Directory{
+		OrgUnits: []ldap.OrgUnit{
+			{DN: "ou=Backend,ou=Engineering,dc=example,dc=com", Name: "Backend"},
+			{DN: "ou=Engineering,dc=example,dc=com", Name: "Engineering"},
+			{DN: "ou=Sales,dc=example,dc=com", Name: "Sales"},
+		},
+		Users: []ldap.User{
+			{DN: "uid=alice,ou=Backend,ou=Engineering,dc=example,dc=com", Username: "alice", Email: "alice@example.com", DisplayName: "Alice"},
+			{DN: "uid=bob,ou=Sales,dc=example,dc=com", Username: "bob", DisplayName: "Bob Smith"},
+			{DN: "uid=carol,dc=example,dc=com", Username: "carol", Email: "taken@example.com"},
+			{DN: "uid=admin,dc=example,dc=com", Username: "admin"},
+			{DN: "uid=eve,dc=example,dc=com", Username: "eve"},
+		},
+		Groups: []ldap.Group{
+			{DN: "cn=developers,dc=example,dc=com", Members: []string{"UID=alice,OU=Backend,OU=Engineering,DC=example,DC=com"}},
+			{DN: "cn=sales,dc=example,dc=com", Members: []string{"uid=bob,ou=Sales,dc=example,dc=com"}},
+			{DN: "cn=unmapped,dc=example,dc=com", Members: []string{"uid=bob,ou=Sales,dc=example,dc=com"}},
+		},
+	}
+}
+
+func testLdapState() *ldapSyncState {
+	return &ldapSyncState{
+		OrgUnits: []ldapLocalOrgUnit{
+			// 已同步过的组织，名称在目录中改过
+			{ID: 10, Name: "Eng", ExternalID: "ou=engineering,dc=example,dc=com"},
+			// 手工创建的同名组织，会被关联到目录
+			{ID: 11, Name: "Sales"},
+		},
+		Users: []ldapLocalUser{
+			{ID: 1, Username: "admin", Email: "admin@example.com"},
+			{ID: 2, Username: "bob", External: true, ExternalID: "uid=bob,ou=sales,dc=example,dc=com", Realname: "Bob", RoleIDs: []uint32{7}},
+			{ID: 3, Username: "dave", External: true, ExternalID: "uid=dave,dc=example,dc=com"},
+			{ID: 4, Username: "frank", External: true, Disabled: true},
+			{ID: 5, Username: "grace", Email: "taken@example.com"},
+		},
+		EmailOwners: map[string]uint32{
+			"admin@example.com": 1,
+			"taken@example.com": 5,
+		},
+		TakenUsernames: map[string]struct{}{"eve": {}},
+	}
+}
+
+func findLdapChange(report *authenticationV1.LdapSyncReport, kind authenticationV1.LdapSyncChange_Kind, name string) *authenticationV1.LdapSyncChange {
+	for _, c := range report.Changes {
+		if c.Kind == kind && c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestPlanLdapSync(t *testing.T) {
+	plan, err := planLdapSync(testLdapDirectory(), testLdapState(), ldapSyncOptions{
+		GroupRoleMappings: map[string]uint32{
+			"cn=developers,dc=example,dc=com": 5,
+			"cn=sales,dc=example,dc=com":      6,
+		},
+		DisableMissingUsers: true,
+	})
+	assert.NoError(t, err)
+	report := plan.Report
+
+	// 组织单元先父后子：Engineering 改名，Sales 关联已有组织，Backend 新建在 Engineering 下
+	if assert.Len(t, plan.OrgUnits, 3) {
+		assert.Equal(t, uint32(10), plan.OrgUnits[0].ID)
+		assert.Equal(t, "Engineering", plan.OrgUnits[0].Name)
+		assert.Equal(t, uint32(11), plan.OrgUnits[1].ID)
+		assert.Equal(t, "ou=sales,dc=example,dc=com", plan.OrgUnits[1].ExternalID)
+		assert.Equal(t, uint32(0), plan.OrgUnits[2].ID)
+		assert.Equal(t, "ou=engineering,dc=example,dc=com", plan.OrgUnits[2].ParentExternalID)
+	}
+	assert.Equal(t, uint32(1), report.OrgUnitsCreated)
+	assert.Equal(t, uint32(2), report.OrgUnitsUpdated)
+
+	users := make(map[string]ldapUserOp)
+	for _, op := range plan.Users {
+		users[op.Username] = op
+	}
+
+	// alice 新建，放到最近的组织单元并按组映射分配角色
+	alice := users["alice"]
+	assert.Equal(t, uint32(0), alice.ID)
+	assert.Equal(t, "ou=backend,ou=engineering,dc=example,dc=com", alice.OrgUnitExternalID)
+	assert.Equal(t, []uint32{5}, alice.RoleIDs)
+	assert.Equal(t, "alice@example.com", alice.Email)
+
+	// bob 已由目录管理：更新姓名、组织与角色
+	bob := users["bob"]
+	assert.Equal(t, uint32(2), bob.ID)
+	assert.True(t, bob.UpdateProfile)
+	assert.True(t, bob.UpdateOrgUnit)
+	assert.Equal(t, []uint32{6}, bob.RoleIDs)
+	assert.True(t, bob.UpdateRoles)
+
+	// carol 新建，但邮箱已被占用
+	carol := users["carol"]
+	assert.Equal(t, "", carol.Email)
+	assert.Empty(t, carol.OrgUnitExternalID)
+
+	// 同名的本地账号与其他租户占用的登录名被跳过
+	assert.NotContains(t, users, "admin")
+	assert.NotContains(t, users, "eve")
+	assert.Equal(t, authenticationV1.LdapSyncChange_SKIP, findLdapChange(report, authenticationV1.LdapSyncChange_USER, "admin").Action)
+	assert.Equal(t, authenticationV1.LdapSyncChange_SKIP, findLdapChange(report, authenticationV1.LdapSyncChange_USER, "eve").Action)
+
+	// dave 已不在目录中被禁用，已禁用的 frank 不重复处理
+	assert.Equal(t, []uint32{3}, plan.DisableUserIDs)
+
+	assert.Equal(t, uint32(2), report.UsersCreated)
+	assert.Equal(t, uint32(1), report.UsersUpdated)
+	assert.Equal(t, uint32(1), report.UsersDisabled)
+	assert.Equal(t, uint32(2), report.UsersSkipped)
+	assert.Equal(t, uint32(2), report.RoleAssignments)
+}
+
+func TestPlanLdapSync_NoChanges(t *testing.T) {
+	dir := &ldap.Directory{
+		Users: []ldap.User{{DN: "uid=bob,dc=example,dc=com", Username: "bob", DisplayName: "Bob"}},
+	}
+	state := &ldapSyncState{
+		Users: []ldapLocalUser{
+			{ID: 2, Username: "bob", External: true, ExternalID: "uid=bob,dc=example,dc=com", Realname: "Bob", RoleIDs: []uint32{7}},
+		},
+	}
+
+	// 未配置角色映射时不改动用户的角色
+	plan, err := planLdapSync(dir, state, ldapSyncOptions{DisableMissingUsers: true})
+	assert.NoError(t, err)
+	assert.Empty(t, plan.Users)
+	assert.Empty(t, plan.DisableUserIDs)
+	assert.Empty(t, plan.Report.Changes)
+}
+
+func TestPlanLdapSync_EmptyDirectory(t *testing.T) {
+	// 目录没有返回用户时拒绝禁用，避免配置错误导致所有外部用户被禁用
+	_, err := planLdapSync(&ldap.Directory{}, testLdapState(), ldapSyncOptions{DisableMissingUsers: true})
+	assert.ErrorIs(t, err, errLdapEmptyDirectory)
+
+	plan, err := planLdapSync(&ldap.Directory{}, testLdapState(), ldapSyncOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, plan.DisableUserIDs)
+}
@@ -0,0 +1,100 @@
+package data
+
+import (
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+
+	"go-wind-admin/pkg/lua"
+	"go-wind-admin/pkg/lua/api"
+	"go-wind-admin/pkg/oss"
+)
+
+// NewLuaEngine 创建 Lua 脚本引擎并加载脚本目录中的脚本，配置禁用时返回 nil
+func NewLuaEngine(
+	ctx *bootstrap.Context,
+	cfg *adminConfV1.Bootstrap,
+	rdb *redis.Client,
+	ossClient *oss.MinIOClient,
+	queryRepo *LuaQueryRepo,
+) (*lua.Engine, func(), error) {
+	c := cfg.GetLua()
+	if c.GetDisable() {
+		return nil, func() {}, nil
+	}
+
+	l := ctx.NewLoggerHelper("lua/data/admin-service")
+
+	config := lua.DefaultConfig()
+	if c.GetScriptDir() != "" {
+		config.ScriptDir = c.GetScriptDir()
+	}
+	if c.GetPoolSize() > 0 {
+		config.PoolSize = int(c.GetPoolSize())
+	}
+	if c.GetMaxVms() > 0 {
+		config.MaxVMs = int(c.GetMaxVms())
+	}
+	if c.GetVmTimeout() != nil {
+		config.VMTimeout = c.GetVmTimeout().AsDuration()
+	}
+	if c.GetMaxMemory() > 0 {
+		config.MaxMemory = c.GetMaxMemory()
+	}
+	if c.GetQueueTimeout() != nil {
+		config.QueueTimeout = c.GetQueueTimeout().AsDuration()
+	}
+	if c.GetMaxInstructions() > 0 {
+		config.MaxInstructions = c.GetMaxInstructions()
+	}
+	if c.GetCallStackSize() > 0 {
+		config.CallStackSize = int(c.GetCallStackSize())
+	}
+	if c.GetRegistrySize() > 0 {
+		config.RegistrySize = int(c.GetRegistrySize())
+	}
+	if c.GetRegistryMaxSize() > 0 {
+		config.RegistryMaxSize = int(c.GetRegistryMaxSize())
+	}
+	if len(c.GetAllowedModules()) > 0 {
+		config.AllowedModules = c.GetAllowedModules()
+	}
+	config.EnableDebug = c.GetEnableDebug()
+
+	var opts []lua.Option
+	if rdb != nil {
+		opts = append(opts, lua.WithRedis(rdb))
+	}
+	if ossClient != nil {
+		opts = append(opts, lua.WithOSS(ossClient))
+	}
+	if h := c.GetHttp(); len(h.GetAllowedHosts()) > 0 {
+		httpConfig := api.HTTPConfig{
+			AllowedHosts:    h.GetAllowedHosts(),
+			MaxResponseSize: h.GetMaxResponseSize(),
+		}
+		if h.GetTimeout() != nil {
+			httpConfig.Timeout = h.GetTimeout().AsDuration()
+		}
+		opts = append(opts, lua.WithHTTP(httpConfig))
+	}
+	if queryRepo != nil {
+		opts = append(opts, lua.WithDatabase(queryRepo))
+	}
+
+	// 先注册标准钩子点，脚本在加载时即可挂载回调
+	scriptDir := config.ScriptDir
+	config.ScriptDir = ""
+	engine := lua.NewEngine(config, ctx.GetLogger(), opts...)
+	engine.RegisterStandardHooks()
+	if err := engine.LoadScriptsFromDir(ctx.Context(), scriptDir); err != nil {
+		l.Errorf("load lua scripts from [%s] failed: %s", scriptDir, err.Error())
+	}
+
+	return engine, func() {
+		if err := engine.Close(); err != nil {
+			l.Error(err)
+		}
+	}, nil
+}
@@ -0,0 +1,430 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"entgo.io/ent/dialect/sql"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
+	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
+	"go-wind-admin/app/admin/service/internal/data/ent/file"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
+	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/userorgunit"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+
+	"go-wind-admin/pkg/lua/api"
+)
+
+// luaEntQuery 生成的查询构建器，查询经过 ent 的隐私策略（租户隔离）与拦截器
+type luaEntQuery interface {
+	Scan(ctx context.Context, v any) error
+	Count(ctx context.Context) (int, error)
+}
+
+// luaQueryTable 脚本可查询的表，只开放列出的列，敏感列不开放
+type luaQueryTable struct {
+	columns []string
+
+	ownerColumn string // SELF/USER 数据权限比较的用户列，为空时这两种权限看不到任何行
+	shared      bool   // 字典等公共数据只按租户隔离，不应用数据权限
+
+	// unit 生成 UNIT 数据权限的条件，为空时该权限看不到任何行
+	unit func(s *sql.Selector, orgUnitIDs []any) *sql.Predicate
+
+	// query 使用生成的查询构建器创建查询，modifiers 追加条件、数据权限、列、排序与分页
+	query func(c *ent.Client, modifiers ...func(s *sql.Selector)) luaEntQuery
+}
+
+// unitColumn 按表中的组织单元列过滤
+func unitColumn(column string) func(s *sql.Selector, orgUnitIDs []any) *sql.Predicate {
+	return func(s *sql.Selector, orgUnitIDs []any) *sql.Predicate {
+		return sql.In(s.C(column), orgUnitIDs...)
+	}
+}
+
+var luaQueryTables = map[string]*luaQueryTable{
+	"users": {
+		query: func(c *ent.Client, m ...func(s *sql.Selector)) luaEntQuery {
+			return c.User.Query().Modify(m...)
+		},
+		columns: []string{
+			user.FieldID, user.FieldTenantID, user.FieldUsername, user.FieldNickname, user.FieldRealname,
+			user.FieldEmail, user.FieldMobile, user.FieldTelephone, user.FieldAvatar, user.FieldRegion,
+			user.FieldDescription, user.FieldGender, user.FieldStatus, user.FieldLastLoginAt,
+			user.FieldCreatedBy, user.FieldCreatedAt, user.FieldUpdatedAt,
+		},
+		ownerColumn: user.FieldID,
+		// 用户通过用户-组织单元关系归属组织单元
+		unit: func(s *sql.Selector, orgUnitIDs []any) *sql.Predicate {
+			b := sql.Dialect(s.Dialect())
+			members := b.Select(userorgunit.FieldUserID).
+				From(b.Table(userorgunit.Table)).
+				Where(sql.In(userorgunit.FieldOrgUnitID, orgUnitIDs...))
+			return sql.In(s.C(user.FieldID), members)
+		},
+	},
+	"org_units": {
+		query: func(c *ent.Client, m ...func(s *sql.Selector)) luaEntQuery {
+			return c.OrgUnit.Query().Modify(m...)
+		},
+		columns: []string{
+			orgunit.FieldID, orgunit.FieldTenantID, orgunit.FieldParentID, orgunit.FieldPath, orgunit.FieldName,
+			orgunit.FieldCode, orgunit.FieldType, orgunit.FieldStatus, orgunit.FieldSortOrder,
+			orgunit.FieldLeaderID, orgunit.FieldDescription,
+			orgunit.FieldCreatedBy, orgunit.FieldCreatedAt, orgunit.FieldUpdatedAt,
+		},
+		ownerColumn: orgunit.FieldCreatedBy,
+		unit:        unitColumn(orgunit.FieldID),
+	},
+	"positions": {
+		query: func(c *ent.Client, m ...func(s *sql.Selector)) luaEntQuery {
+			return c.Position.Query().Modify(m...)
+		},
+		columns: []string{
+			position.FieldID, position.FieldTenantID, position.FieldOrgUnitID, position.FieldName,
+			position.FieldCode, position.FieldStatus, position.FieldSortOrder, position.FieldType,
+			position.FieldJobFamily, position.FieldJobGrade, position.FieldLevel, position.FieldHeadcount,
+			position.FieldDescription,
+			position.FieldCreatedBy, position.FieldCreatedAt, position.FieldUpdatedAt,
+		},
+		ownerColumn: position.FieldCreatedBy,
+		unit:        unitColumn(position.FieldOrgUnitID),
+	},
+	"roles": {
+		query: func(c *ent.Client, m ...func(s *sql.Selector)) luaEntQuery {
+			return c.Role.Query().Modify(m...)
+		},
+		columns: []string{
+			role.FieldID, role.FieldTenantID, role.FieldName, role.FieldCode, role.FieldStatus,
+			role.FieldSortOrder, role.FieldIsSystem, role.FieldDescription,
+			role.FieldCreatedBy, role.FieldCreatedAt, role.FieldUpdatedAt,
+		},
+		ownerColumn: role.FieldCreatedBy,
+	},
+	"dict_types": {
+		query: func(c *ent.Client, m ...func(s *sql.Selector)) luaEntQuery {
+			return c.DictType.Query().Modify(m...)
+		},
+		columns: []string{
+			dicttype.FieldID, dicttype.FieldTenantID, dicttype.FieldTypeCode, dicttype.FieldIsEnabled,
+			dicttype.FieldSortOrder, dicttype.FieldCreatedAt, dicttype.FieldUpdatedAt,
+		},
+		shared: true,
+	},
+	"dict_entries": {
+		query: func(c *ent.Client, m ...func(s *sql.Selector)) luaEntQuery {
+			return c.DictEntry.Query().Modify(m...)
+		},
+		columns: []string{
+			dictentry.FieldID, dictentry.FieldTenantID, dictentry.FieldEntryValue, dictentry.FieldNumericValue,
+			dictentry.FieldIsEnabled, dictentry.FieldSortOrder, dictentry.FieldCreatedAt, dictentry.FieldUpdatedAt,
+		},
+		shared: true,
+	},
+	"files": {
+		query: func(c *ent.Client, m ...func(s *sql.Selector)) luaEntQuery {
+			return c.File.Query().Modify(m...)
+		},
+		columns: []string{
+			file.FieldID, file.FieldTenantID, file.FieldProvider, file.FieldBucketName, file.FieldFileDirectory,
+			file.FieldFileName, file.FieldExtension, file.FieldSize, file.FieldContentType, file.FieldScanStatus,
+			file.FieldCreatedBy, file.FieldCreatedAt, file.FieldUpdatedAt,
+		},
+		ownerColumn: file.FieldCreatedBy,
+	},
+	"internal_messages": {
+		query: func(c *ent.Client, m ...func(s *sql.Selector)) luaEntQuery {
+			return c.InternalMessage.Query().Modify(m...)
+		},
+		columns: []string{
+			internalmessage.FieldID, internalmessage.FieldTenantID, internalmessage.FieldTitle,
+			internalmessage.FieldContent, internalmessage.FieldSenderID, internalmessage.FieldCategoryID,
+			internalmessage.FieldStatus, internalmessage.FieldType,
+			internalmessage.FieldCreatedBy, internalmessage.FieldCreatedAt, internalmessage.FieldUpdatedAt,
+		},
+		ownerColumn: internalmessage.FieldCreatedBy,
+	},
+}
+
+func (t *luaQueryTable) hasColumn(column string) bool {
+	for _, c := range t.columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
+// dataScope 按访问者的数据权限限制可见的行，租户隔离由 ent 的隐私策略完成
+func (t *luaQueryTable) dataScope(vc viewer.Context) func(s *sql.Selector) {
+	// 系统任务不受限制，字典等公共数据不应用数据权限
+	if vc.IsSystemContext() || t.shared {
+		return nil
+	}
+
+	return func(s *sql.Selector) {
+		var preds []*sql.Predicate
+		for _, scope := range vc.DataScope() {
+			switch scope.ScopeType {
+			case viewer.ScopeTypeAll:
+				return
+
+			case viewer.ScopeTypeSelf:
+				if t.ownerColumn != "" {
+					preds = append(preds, sql.EQ(s.C(t.ownerColumn), vc.UserID()))
+				}
+
+			case viewer.ScopeTypeUser:
+				if t.ownerColumn != "" {
+					preds = append(preds, sql.In(s.C(t.ownerColumn), uint64sToAny(scope.TargetIDs)...))
+				}
+
+			case viewer.ScopeTypeUnit:
+				// 未展开具体组织单元时使用当前身份所在的组织单元
+				ids := scope.TargetIDs
+				if len(ids) == 0 && vc.OrgUnitID() > 0 {
+					ids = []uint64{vc.OrgUnitID()}
+				}
+				if t.unit != nil && len(ids) > 0 {
+					preds = append(preds, t.unit(s, uint64sToAny(ids)))
+				}
+			}
+		}
+
+		// 没有任何可用的数据权限时看不到任何行
+		if len(preds) == 0 {
+			s.Where(sql.False())
+			return
+		}
+
+		s.Where(sql.Or(preds...))
+	}
+}
+
+func uint64sToAny(ids []uint64) []any {
+	values := make([]any, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id)
+	}
+	return values
+}
+
+// LuaQueryRepo 执行脚本 db 模块的只读查询
+type LuaQueryRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewLuaQueryRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *LuaQueryRepo {
+	return &LuaQueryRepo{
+		log:       ctx.NewLoggerHelper("lua-query/repo/admin-service"),
+		entClient: entClient,
+	}
+}
+
+var _ api.DBQuerier = (*LuaQueryRepo)(nil)
+
+// Tables 返回脚本可查询的表
+func (r *LuaQueryRepo) Tables() []string {
+	tables := make([]string, 0, len(luaQueryTables))
+	for name := range luaQueryTables {
+		tables = append(tables, name)
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// Query 查询符合条件的行
+func (r *LuaQueryRepo) Query(ctx context.Context, q *api.DBQuery) ([]map[string]any, error) {
+	plan, err := buildLuaQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := reflect.New(reflect.SliceOf(luaQueryRowType(plan.columns)))
+	if err = plan.table.query(r.entClient.Client(), append(plan.filters, plan.page)...).
+		Scan(ctx, rows.Interface()); err != nil {
+		r.log.Errorf("lua db query [%s] failed: %s", q.Table, err.Error())
+		return nil, adminV1.ErrorInternalServerError("query failed")
+	}
+
+	rows = rows.Elem()
+	results := make([]map[string]any, 0, rows.Len())
+	for i := 0; i < rows.Len(); i++ {
+		row := make(map[string]any, len(plan.columns))
+		for j, column := range plan.columns {
+			row[column] = luaQueryValue(rows.Index(i).Field(j).Interface())
+		}
+		results = append(results, row)
+	}
+
+	return results, nil
+}
+
+// Count 统计符合条件的行数
+func (r *LuaQueryRepo) Count(ctx context.Context, q *api.DBQuery) (int64, error) {
+	plan, err := buildLuaQuery(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := plan.table.query(r.entClient.Client(), plan.filters...).Count(ctx)
+	if err != nil {
+		r.log.Errorf("lua db count [%s] failed: %s", q.Table, err.Error())
+		return 0, adminV1.ErrorInternalServerError("count failed")
+	}
+
+	return int64(count), nil
+}
+
+// luaQueryPlan 校验后的查询
+type luaQueryPlan struct {
+	table   *luaQueryTable
+	columns []string
+
+	filters []func(s *sql.Selector) // 查询条件与数据权限
+	page    func(s *sql.Selector)   // 查询的列、排序与分页，统计时不使用
+}
+
+// buildLuaQuery 校验表和列，生成附加到 ent 查询上的参数化条件
+func buildLuaQuery(ctx context.Context, q *api.DBQuery) (*luaQueryPlan, error) {
+	t, ok := luaQueryTables[q.Table]
+	if !ok {
+		return nil, adminV1.ErrorBadRequest("table '%s' is not queryable", q.Table)
+	}
+
+	// 没有访问者时拒绝查询
+	vc, ok := viewer.FromContext(ctx)
+	if !ok || vc == nil {
+		return nil, adminV1.ErrorForbidden("db query requires a viewer in context")
+	}
+
+	plan := &luaQueryPlan{table: t}
+
+	for _, cond := range q.Where {
+		if !t.hasColumn(cond.Column) {
+			return nil, adminV1.ErrorBadRequest("unknown column '%s' in where", cond.Column)
+		}
+		pred, err := luaQueryPredicate(cond)
+		if err != nil {
+			return nil, err
+		}
+		plan.filters = append(plan.filters, func(s *sql.Selector) {
+			s.Where(pred(s.C(cond.Column)))
+		})
+	}
+
+	if scope := t.dataScope(vc); scope != nil {
+		plan.filters = append(plan.filters, scope)
+	}
+
+	plan.columns = q.Fields
+	if len(plan.columns) == 0 {
+		plan.columns = t.columns
+	}
+	for _, column := range plan.columns {
+		if !t.hasColumn(column) {
+			return nil, adminV1.ErrorBadRequest("unknown column '%s' in fields", column)
+		}
+	}
+
+	orderBy := q.OrderBy
+	if len(orderBy) == 0 {
+		orderBy = []string{"id"}
+	}
+	for _, order := range orderBy {
+		column, _ := strings.CutPrefix(order, "-")
+		if !t.hasColumn(column) {
+			return nil, adminV1.ErrorBadRequest("unknown column '%s' in order_by", column)
+		}
+	}
+
+	plan.page = func(s *sql.Selector) {
+		s.Select(s.Columns(plan.columns...)...)
+		for _, order := range orderBy {
+			if column, desc := strings.CutPrefix(order, "-"); desc {
+				s.OrderBy(sql.Desc(s.C(column)))
+			} else {
+				s.OrderBy(sql.Asc(s.C(column)))
+			}
+		}
+		s.Limit(q.Limit)
+		if q.Offset > 0 {
+			s.Offset(q.Offset)
+		}
+	}
+
+	return plan, nil
+}
+
+// luaQueryRowType 按查询的列生成扫描结果用的结构体类型
+func luaQueryRowType(columns []string) reflect.Type {
+	fields := make([]reflect.StructField, 0, len(columns))
+	for i, column := range columns {
+		fields = append(fields, reflect.StructField{
+			Name: fmt.Sprintf("F%d", i),
+			Type: reflect.TypeFor[any](),
+			Tag:  reflect.StructTag(fmt.Sprintf(`sql:"%s"`, column)),
+		})
+	}
+	return reflect.StructOf(fields)
+}
+
+// luaQueryPredicate 生成列上的条件
+func luaQueryPredicate(cond api.DBCondition) (func(column string) *sql.Predicate, error) {
+	switch cond.Op {
+	case api.DBOpEq:
+		return func(column string) *sql.Predicate { return sql.EQ(column, cond.Value) }, nil
+	case api.DBOpNe:
+		return func(column string) *sql.Predicate { return sql.NEQ(column, cond.Value) }, nil
+	case api.DBOpGt:
+		return func(column string) *sql.Predicate { return sql.GT(column, cond.Value) }, nil
+	case api.DBOpGte:
+		return func(column string) *sql.Predicate { return sql.GTE(column, cond.Value) }, nil
+	case api.DBOpLt:
+		return func(column string) *sql.Predicate { return sql.LT(column, cond.Value) }, nil
+	case api.DBOpLte:
+		return func(column string) *sql.Predicate { return sql.LTE(column, cond.Value) }, nil
+	case api.DBOpLike:
+		return func(column string) *sql.Predicate { return sql.Like(column, fmt.Sprint(cond.Value)) }, nil
+	case api.DBOpIn:
+		values, _ := cond.Value.([]any)
+		return func(column string) *sql.Predicate { return sql.In(column, values...) }, nil
+	case api.DBOpNull:
+		if isNull, _ := cond.Value.(bool); isNull {
+			return sql.IsNull, nil
+		}
+		return sql.NotNull, nil
+	default:
+		return nil, adminV1.ErrorBadRequest("unknown operator '%s'", cond.Op)
+	}
+}
+
+// luaQueryValue 将驱动返回的值转换为脚本可用的值
+func luaQueryValue(v any) any {
+	switch tv := v.(type) {
+	case []byte:
+		return string(tv)
+	case time.Time:
+		return tv.Format(time.RFC3339)
+	default:
+		return v
+	}
+}
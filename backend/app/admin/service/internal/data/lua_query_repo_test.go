@@ -0,0 +1,148 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entSql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-crud/viewer"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/lua/api"
+)
+
+func luaQueryContext(scope permissionV1.DataScope) context.Context {
+	return viewer.WithContext(context.Background(), appViewer.NewUserViewer(7, 3, 11, "", scope))
+}
+
+// newLuaQueryTestRepo 使用内存 SQLite 创建查询用到的表：租户 3 有用户 1、7、9，租户 4 有用户 8
+func newLuaQueryTestRepo(t *testing.T) *LuaQueryRepo {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+
+	for _, stmt := range []string{
+		`CREATE TABLE sys_users (id INTEGER PRIMARY KEY, tenant_id INTEGER, username TEXT, status TEXT, created_by INTEGER)`,
+		`CREATE TABLE sys_user_org_units (user_id INTEGER, org_unit_id INTEGER)`,
+		`CREATE TABLE sys_dict_entries (id INTEGER PRIMARY KEY, tenant_id INTEGER, entry_value TEXT, created_by INTEGER)`,
+		`INSERT INTO sys_users VALUES (1, 3, 'alice', 'ON', 2), (7, 3, 'me', 'ON', 2), (9, 3, 'off', 'OFF', 7), (8, 4, 'other', 'ON', 7)`,
+		`INSERT INTO sys_user_org_units VALUES (1, 11), (8, 11)`,
+		`INSERT INTO sys_dict_entries VALUES (1, 3, 'a', 2), (2, 4, 'b', 2)`,
+	} {
+		if _, err = db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	drv := entSql.OpenDB(dialect.SQLite, db)
+	return &LuaQueryRepo{
+		log:       log.NewHelper(log.DefaultLogger),
+		entClient: entCrud.NewEntClient(ent.NewClient(ent.Driver(drv)), drv),
+	}
+}
+
+func luaQueryIDs(t *testing.T, rows []map[string]any) []int64 {
+	ids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		id, ok := row["id"].(int64)
+		assert.True(t, ok, row)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestLuaQueryRepo_DataScope(t *testing.T) {
+	repo := newLuaQueryTestRepo(t)
+
+	q := &api.DBQuery{
+		Table:  "users",
+		Fields: []string{"id", "username"},
+		Where:  []api.DBCondition{{Column: "status", Op: api.DBOpEq, Value: "ON"}},
+		Limit:  10,
+	}
+
+	// 本人数据：只能查到自己
+	rows, err := repo.Query(luaQueryContext(permissionV1.DataScope_SELF), q)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"id": int64(7), "username": "me"}}, rows)
+
+	// 本部门数据：通过用户组织关系过滤，其它租户的成员由租户隔离排除
+	rows, err = repo.Query(luaQueryContext(permissionV1.DataScope_UNIT_ONLY), q)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, luaQueryIDs(t, rows))
+
+	// 全部数据：只保留租户隔离
+	rows, err = repo.Query(luaQueryContext(permissionV1.DataScope_ALL), &api.DBQuery{
+		Table:   "users",
+		Fields:  []string{"id"},
+		OrderBy: []string{"-id"},
+		Limit:   10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{9, 7, 1}, luaQueryIDs(t, rows))
+
+	count, err := repo.Count(luaQueryContext(permissionV1.DataScope_ALL), q)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// 无法映射的数据权限不返回任何数据
+	rows, err = repo.Query(luaQueryContext(permissionV1.DataScope_SELECTED_UNITS), q)
+	assert.NoError(t, err)
+	assert.Empty(t, rows)
+}
+
+func TestLuaQueryRepo_SharedAndSystem(t *testing.T) {
+	repo := newLuaQueryTestRepo(t)
+
+	// 字典为租户内共享数据，不受数据权限限制
+	rows, err := repo.Query(luaQueryContext(permissionV1.DataScope_SELF), &api.DBQuery{
+		Table:  "dict_entries",
+		Fields: []string{"id", "entry_value"},
+		Limit:  10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"id": int64(1), "entry_value": "a"}}, rows)
+
+	// 系统身份不做任何过滤
+	count, err := repo.Count(appViewer.NewSystemViewerContext(context.Background()), &api.DBQuery{
+		Table: "users",
+		Where: []api.DBCondition{{Column: "id", Op: api.DBOpIn, Value: []any{int64(1), int64(8)}}},
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestBuildLuaQuery_Invalid(t *testing.T) {
+	ctx := luaQueryContext(permissionV1.DataScope_ALL)
+
+	for _, q := range []*api.DBQuery{
+		{Table: "user_credentials"},
+		{Table: "users", Fields: []string{"password"}},
+		{Table: "users", Where: []api.DBCondition{{Column: "password", Op: api.DBOpEq, Value: "x"}}},
+		{Table: "users", OrderBy: []string{"-password"}},
+		{Table: "users", Where: []api.DBCondition{{Column: "id", Op: "between", Value: 1}}},
+	} {
+		_, err := buildLuaQuery(ctx, q)
+		assert.True(t, adminV1.IsBadRequest(err), q)
+	}
+
+	// 没有 viewer 时拒绝查询
+	_, err := buildLuaQuery(context.Background(), &api.DBQuery{Table: "users"})
+	assert.True(t, adminV1.IsForbidden(err))
+}
@@ -0,0 +1,382 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"entgo.io/ent/dialect/sql"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascript"
+	"go-wind-admin/app/admin/service/internal/data/ent/luascriptversion"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	scriptV1 "go-wind-admin/api/gen/go/script/service/v1"
+)
+
+type LuaScriptRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper        *mapper.CopierMapper[scriptV1.LuaScript, ent.LuaScript]
+	versionMapper *mapper.CopierMapper[scriptV1.LuaScriptVersion, ent.LuaScriptVersion]
+
+	repository *entCrud.Repository[
+		ent.LuaScriptQuery, ent.LuaScriptSelect,
+		ent.LuaScriptCreate, ent.LuaScriptCreateBulk,
+		ent.LuaScriptUpdate, ent.LuaScriptUpdateOne,
+		ent.LuaScriptDelete,
+		predicate.LuaScript,
+		scriptV1.LuaScript, ent.LuaScript,
+	]
+}
+
+func NewLuaScriptRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *LuaScriptRepo {
+	repo := &LuaScriptRepo{
+		log:           ctx.NewLoggerHelper("lua-script/repo/admin-service"),
+		entClient:     entClient,
+		mapper:        mapper.NewCopierMapper[scriptV1.LuaScript, ent.LuaScript](),
+		versionMapper: mapper.NewCopierMapper[scriptV1.LuaScriptVersion, ent.LuaScriptVersion](),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *LuaScriptRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.LuaScriptQuery, ent.LuaScriptSelect,
+		ent.LuaScriptCreate, ent.LuaScriptCreateBulk,
+		ent.LuaScriptUpdate, ent.LuaScriptUpdateOne,
+		ent.LuaScriptDelete,
+		predicate.LuaScript,
+		scriptV1.LuaScript, ent.LuaScript,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.versionMapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.versionMapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+func (r *LuaScriptRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*scriptV1.ListLuaScriptResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().LuaScript.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &scriptV1.ListLuaScriptResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &scriptV1.ListLuaScriptResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+// ListAll 查询全部脚本，用于同步脚本引擎
+func (r *LuaScriptRepo) ListAll(ctx context.Context) ([]*scriptV1.LuaScript, error) {
+	entities, err := r.entClient.Client().LuaScript.Query().
+		Order(ent.Asc(luascript.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query lua scripts failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query lua scripts failed")
+	}
+
+	items := make([]*scriptV1.LuaScript, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.mapper.ToDTO(entity))
+	}
+
+	return items, nil
+}
+
+func (r *LuaScriptRepo) Get(ctx context.Context, req *scriptV1.GetLuaScriptRequest) (*scriptV1.LuaScript, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().LuaScript.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *scriptV1.GetLuaScriptRequest_Id:
+		whereCond = append(whereCond, luascript.IDEQ(req.GetId()))
+
+	case *scriptV1.GetLuaScriptRequest_Name:
+		whereCond = append(whereCond, luascript.NameEQ(req.GetName()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, adminV1.ErrorNotFound("lua script not found")
+		}
+		return nil, err
+	}
+
+	return dto, err
+}
+
+// Create 创建脚本并记录第一个版本
+func (r *LuaScriptRepo) Create(ctx context.Context, data *scriptV1.LuaScript) (dto *scriptV1.LuaScript, err error) {
+	if data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = adminV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	var entity *ent.LuaScript
+	entity, err = tx.LuaScript.Create().
+		SetName(data.GetName()).
+		SetHook(data.GetHook()).
+		SetSource(data.GetSource()).
+		SetNillablePriority(data.Priority).
+		SetNillableEnabled(data.Enabled).
+		SetNillableCritical(data.Critical).
+		SetNillableAuthor(data.Author).
+		SetNillableDescription(data.Description).
+		SetVersion(1).
+		SetNillableCreatedBy(data.CreatedBy).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, adminV1.ErrorConflict("lua script name already exists")
+		}
+		r.log.Errorf("insert lua script failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("insert lua script failed")
+	}
+
+	if err = r.createVersion(ctx, tx, entity, data.CreatedBy); err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// Update 用 data 覆盖脚本的全部可编辑字段，版本号加一并记录新版本
+func (r *LuaScriptRepo) Update(ctx context.Context, id uint32, data *scriptV1.LuaScript) (dto *scriptV1.LuaScript, err error) {
+	if data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = adminV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	var current *ent.LuaScript
+	if current, err = tx.LuaScript.Get(ctx, id); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, adminV1.ErrorNotFound("lua script not found")
+		}
+		r.log.Errorf("query lua script failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query lua script failed")
+	}
+
+	var version uint32 = 1
+	if current.Version != nil {
+		version = *current.Version + 1
+	}
+
+	builder := tx.LuaScript.UpdateOneID(id).
+		SetName(data.GetName()).
+		SetHook(data.GetHook()).
+		SetSource(data.GetSource()).
+		SetPriority(data.GetPriority()).
+		SetEnabled(data.GetEnabled()).
+		SetCritical(data.GetCritical()).
+		SetVersion(version).
+		SetNillableUpdatedBy(data.UpdatedBy).
+		SetUpdatedAt(time.Now())
+
+	if data.Author != nil {
+		builder.SetAuthor(data.GetAuthor())
+	} else {
+		builder.ClearAuthor()
+	}
+	if data.Description != nil {
+		builder.SetDescription(data.GetDescription())
+	} else {
+		builder.ClearDescription()
+	}
+
+	var entity *ent.LuaScript
+	if entity, err = builder.Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, adminV1.ErrorConflict("lua script name already exists")
+		}
+		r.log.Errorf("update lua script failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("update lua script failed")
+	}
+
+	if err = r.createVersion(ctx, tx, entity, data.UpdatedBy); err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// Delete 删除脚本及其全部历史版本
+func (r *LuaScriptRepo) Delete(ctx context.Context, id uint32) (err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = adminV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	if err = tx.LuaScript.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return adminV1.ErrorNotFound("lua script not found")
+		}
+		r.log.Errorf("delete lua script failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("delete failed")
+	}
+
+	if _, err = tx.LuaScriptVersion.Delete().
+		Where(luascriptversion.ScriptIDEQ(id)).
+		Exec(ctx); err != nil {
+		r.log.Errorf("delete lua script versions failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("delete failed")
+	}
+
+	return nil
+}
+
+// ListVersion 查询脚本的历史版本，最新的版本在前
+func (r *LuaScriptRepo) ListVersion(ctx context.Context, scriptID uint32) (*scriptV1.ListLuaScriptVersionResponse, error) {
+	entities, err := r.entClient.Client().LuaScriptVersion.Query().
+		Where(luascriptversion.ScriptIDEQ(scriptID)).
+		Order(ent.Desc(luascriptversion.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query lua script versions failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query lua script versions failed")
+	}
+
+	items := make([]*scriptV1.LuaScriptVersion, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.versionMapper.ToDTO(entity))
+	}
+
+	return &scriptV1.ListLuaScriptVersionResponse{
+		Total: uint64(len(items)),
+		Items: items,
+	}, nil
+}
+
+// GetVersion 查询脚本的指定版本
+func (r *LuaScriptRepo) GetVersion(ctx context.Context, scriptID, version uint32) (*scriptV1.LuaScriptVersion, error) {
+	entity, err := r.entClient.Client().LuaScriptVersion.Query().
+		Where(
+			luascriptversion.ScriptIDEQ(scriptID),
+			luascriptversion.VersionEQ(version),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, adminV1.ErrorNotFound("lua script version not found")
+		}
+		r.log.Errorf("query lua script version failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query lua script version failed")
+	}
+
+	return r.versionMapper.ToDTO(entity), nil
+}
+
+// createVersion 记录脚本当前内容的快照
+func (r *LuaScriptRepo) createVersion(ctx context.Context, tx *ent.Tx, entity *ent.LuaScript, operatorID *uint32) error {
+	var version uint32 = 1
+	if entity.Version != nil {
+		version = *entity.Version
+	}
+
+	err := tx.LuaScriptVersion.Create().
+		SetScriptID(entity.ID).
+		SetVersion(version).
+		SetName(entity.Name).
+		SetHook(entity.Hook).
+		SetSource(entity.Source).
+		SetNillablePriority(entity.Priority).
+		SetNillableEnabled(entity.Enabled).
+		SetNillableCritical(entity.Critical).
+		SetNillableAuthor(entity.Author).
+		SetNillableDescription(entity.Description).
+		SetNillableCreatedBy(operatorID).
+		SetCreatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return adminV1.ErrorConflict("lua script was modified concurrently")
+		}
+		r.log.Errorf("insert lua script version failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("insert lua script version failed")
+	}
+
+	return nil
+}
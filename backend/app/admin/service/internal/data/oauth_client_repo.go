@@ -0,0 +1,465 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/password"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthclient"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+)
+
+const (
+	// oauthClientIDBytes 自动生成的客户端ID的随机字节数
+	oauthClientIDBytes = 12
+	// oauthClientSecretBytes 客户端密钥的随机字节数
+	oauthClientSecretBytes = 32
+
+	// DefaultOAuthClientSecretOverlap 轮换密钥时旧密钥默认继续有效的时间
+	DefaultOAuthClientSecretOverlap = 24 * time.Hour
+)
+
+type OAuthClientRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	passwordCrypto password.Crypto
+
+	mapper             *mapper.CopierMapper[authenticationV1.OAuthClient, ent.OAuthClient]
+	statusConverter    *mapper.EnumTypeConverter[authenticationV1.OAuthClient_Status, oauthclient.Status]
+	dataScopeConverter *mapper.EnumTypeConverter[permissionV1.DataScope, oauthclient.DataScope]
+
+	repository *entCrud.Repository[
+		ent.OAuthClientQuery, ent.OAuthClientSelect,
+		ent.OAuthClientCreate, ent.OAuthClientCreateBulk,
+		ent.OAuthClientUpdate, ent.OAuthClientUpdateOne,
+		ent.OAuthClientDelete,
+		predicate.OAuthClient,
+		authenticationV1.OAuthClient, ent.OAuthClient,
+	]
+}
+
+func NewOAuthClientRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	passwordCrypto password.Crypto,
+) *OAuthClientRepo {
+	repo := &OAuthClientRepo{
+		log:            ctx.NewLoggerHelper("oauth-client/repo/admin-service"),
+		entClient:      entClient,
+		passwordCrypto: passwordCrypto,
+		mapper:         mapper.NewCopierMapper[authenticationV1.OAuthClient, ent.OAuthClient](),
+		statusConverter: mapper.NewEnumTypeConverter[authenticationV1.OAuthClient_Status, oauthclient.Status](
+			authenticationV1.OAuthClient_Status_name,
+			authenticationV1.OAuthClient_Status_value,
+		),
+		dataScopeConverter: mapper.NewEnumTypeConverter[permissionV1.DataScope, oauthclient.DataScope](
+			permissionV1.DataScope_name,
+			permissionV1.DataScope_value,
+		),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *OAuthClientRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.OAuthClientQuery, ent.OAuthClientSelect,
+		ent.OAuthClientCreate, ent.OAuthClientCreateBulk,
+		ent.OAuthClientUpdate, ent.OAuthClientUpdateOne,
+		ent.OAuthClientDelete,
+		predicate.OAuthClient,
+		authenticationV1.OAuthClient, ent.OAuthClient,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.dataScopeConverter.NewConverterPair())
+}
+
+func (r *OAuthClientRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListOAuthClientResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().OAuthClient.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &authenticationV1.ListOAuthClientResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &authenticationV1.ListOAuthClientResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *OAuthClientRepo) Get(ctx context.Context, req *authenticationV1.GetOAuthClientRequest) (*authenticationV1.OAuthClient, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().OAuthClient.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *authenticationV1.GetOAuthClientRequest_Id:
+		whereCond = append(whereCond, oauthclient.IDEQ(req.GetId()))
+
+	case *authenticationV1.GetOAuthClientRequest_ClientId:
+		whereCond = append(whereCond, oauthclient.ClientIDEQ(req.GetClientId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, adminV1.ErrorNotFound("oauth client not found")
+		}
+		return nil, err
+	}
+
+	return dto, err
+}
+
+// Create 创建客户端，返回只显示一次的客户端密钥明文
+func (r *OAuthClientRepo) Create(ctx context.Context, req *authenticationV1.CreateOAuthClientRequest) (*authenticationV1.OAuthClientSecret, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	clientID := req.Data.GetClientId()
+	if clientID == "" {
+		b := make([]byte, oauthClientIDBytes)
+		if _, err := rand.Read(b); err != nil {
+			r.log.Errorf("generate client id failed: %s", err.Error())
+			return nil, adminV1.ErrorInternalServerError("generate client id failed")
+		}
+		clientID = hex.EncodeToString(b)
+	}
+
+	secret, secretHash, err := r.newSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := r.entClient.Client().OAuthClient.Create().
+		SetClientID(clientID).
+		SetName(req.Data.GetName()).
+		SetNillableDescription(req.Data.Description).
+		SetSecretHash(secretHash).
+		SetScopes(normalizeScopes(req.Data.GetScopes())).
+		SetNillableTokenTTL(req.Data.TokenTtl).
+		SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
+		SetNillableDataScope(r.toDataScope(req.Data.DataScope)).
+		SetNillableOrgUnitID(req.Data.OrgUnitId).
+		SetNillableTenantID(req.Data.TenantId).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, adminV1.ErrorConflict("oauth client id already exists")
+		}
+		r.log.Errorf("insert oauth client failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("insert oauth client failed")
+	}
+
+	return &authenticationV1.OAuthClientSecret{
+		Id:           entity.ID,
+		ClientId:     entity.ClientID,
+		ClientSecret: secret,
+	}, nil
+}
+
+func (r *OAuthClientRepo) Update(ctx context.Context, req *authenticationV1.UpdateOAuthClientRequest) error {
+	if req == nil || req.Data == nil {
+		return adminV1.ErrorBadRequest("invalid request")
+	}
+
+	if req.Data.Scopes != nil {
+		req.Data.Scopes = normalizeScopes(req.Data.Scopes)
+	}
+
+	builder := r.entClient.Client().OAuthClient.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *authenticationV1.OAuthClient) {
+			builder.
+				SetNillableName(req.Data.Name).
+				SetNillableDescription(req.Data.Description).
+				SetNillableTokenTTL(req.Data.TokenTtl).
+				SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+			if req.Data.Scopes != nil {
+				builder.SetScopes(req.Data.Scopes)
+			}
+			// 数据权限随修改人重新计算，未设置表示不授予任何数据权限
+			if dataScope := r.toDataScope(req.Data.DataScope); dataScope != nil {
+				builder.SetDataScope(*dataScope)
+			} else {
+				builder.ClearDataScope()
+			}
+			if req.Data.OrgUnitId != nil {
+				builder.SetOrgUnitID(req.Data.GetOrgUnitId())
+			} else {
+				builder.ClearOrgUnitID()
+			}
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(oauthclient.FieldID, req.GetId()))
+		},
+	)
+
+	return err
+}
+
+func (r *OAuthClientRepo) Delete(ctx context.Context, req *authenticationV1.DeleteOAuthClientRequest) error {
+	if req == nil {
+		return adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().OAuthClient.Delete()
+	_, err := r.repository.Delete(ctx, builder, func(s *sql.Selector) {
+		s.Where(sql.EQ(oauthclient.FieldID, req.GetId()))
+	})
+	if err != nil {
+		r.log.Errorf("delete oauth client failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("delete oauth client failed")
+	}
+
+	return nil
+}
+
+// RotateSecret 生成新的客户端密钥，旧密钥在 overlap 时间内仍然可以换取令牌
+func (r *OAuthClientRepo) RotateSecret(ctx context.Context, id uint32, overlap time.Duration, operatorID uint32) (*authenticationV1.OAuthClientSecret, error) {
+	entity, err := r.entClient.Client().OAuthClient.Query().
+		Where(oauthclient.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, adminV1.ErrorNotFound("oauth client not found")
+		}
+		r.log.Errorf("query oauth client failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query oauth client failed")
+	}
+
+	secret, secretHash, err := r.newSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	builder := entity.Update().
+		SetSecretHash(secretHash).
+		AddSecretVersion(1).
+		SetUpdatedBy(operatorID).
+		SetUpdatedAt(now)
+
+	var previousExpiresAt *time.Time
+	if overlap > 0 {
+		previousExpiresAt = trans.Ptr(now.Add(overlap))
+		builder.
+			SetPreviousSecretHash(entity.SecretHash).
+			SetPreviousSecretExpiresAt(*previousExpiresAt)
+	} else {
+		builder.
+			ClearPreviousSecretHash().
+			ClearPreviousSecretExpiresAt()
+	}
+
+	if err = builder.Exec(ctx); err != nil {
+		r.log.Errorf("rotate oauth client secret failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("rotate oauth client secret failed")
+	}
+
+	ret := &authenticationV1.OAuthClientSecret{
+		Id:           entity.ID,
+		ClientId:     entity.ClientID,
+		ClientSecret: secret,
+	}
+	if previousExpiresAt != nil {
+		ret.PreviousSecretExpiresAt = timestamppb.New(*previousExpiresAt)
+	}
+
+	return ret, nil
+}
+
+// VerifySecret 校验客户端凭据，当前密钥或重叠期内的旧密钥均可通过，成功时记录最后使用时间。
+// 同时返回所用密钥的版本，写入令牌后用于判断令牌是否随密钥轮换失效。
+func (r *OAuthClientRepo) VerifySecret(ctx context.Context, clientID, clientSecret string) (*authenticationV1.OAuthClient, uint32, error) {
+	if clientID == "" || clientSecret == "" {
+		return nil, 0, authenticationV1.ErrorIncorrectAppSecret("invalid client credentials")
+	}
+
+	entity, err := r.entClient.Client().OAuthClient.Query().
+		Where(oauthclient.ClientIDEQ(clientID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, 0, authenticationV1.ErrorIncorrectAppSecret("invalid client credentials")
+		}
+		r.log.Errorf("query oauth client failed: %s", err.Error())
+		return nil, 0, authenticationV1.ErrorServiceUnavailable("query oauth client failed")
+	}
+
+	secretVersion, ok := r.matchSecret(entity, clientSecret, time.Now())
+	if !ok {
+		return nil, 0, authenticationV1.ErrorIncorrectAppSecret("invalid client credentials")
+	}
+
+	if err = entity.Update().SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+		r.log.Warnf("update oauth client [%s] last used time failed: %s", clientID, err.Error())
+	}
+
+	return r.mapper.ToDTO(entity), secretVersion, nil
+}
+
+// matchSecret 判断密钥是否与当前密钥或未过期的旧密钥匹配，返回匹配的密钥版本
+func (r *OAuthClientRepo) matchSecret(entity *ent.OAuthClient, clientSecret string, now time.Time) (uint32, bool) {
+	if ok, _ := r.passwordCrypto.Verify(clientSecret, entity.SecretHash); ok {
+		return entity.SecretVersion, true
+	}
+
+	if !previousSecretActive(entity, now) {
+		return 0, false
+	}
+
+	if ok, _ := r.passwordCrypto.Verify(clientSecret, *entity.PreviousSecretHash); ok {
+		return entity.SecretVersion - 1, true
+	}
+	return 0, false
+}
+
+// previousSecretActive 轮换前的旧密钥是否仍在重叠期内
+func previousSecretActive(entity *ent.OAuthClient, now time.Time) bool {
+	return entity.PreviousSecretHash != nil &&
+		entity.PreviousSecretExpiresAt != nil &&
+		now.Before(*entity.PreviousSecretExpiresAt)
+}
+
+// IsActiveClient 客户端令牌是否仍然有效：客户端存在且启用，换取令牌的密钥为当前密钥或重叠期内的旧密钥
+func (r *OAuthClientRepo) IsActiveClient(ctx context.Context, clientID string, secretVersion uint32) bool {
+	if clientID == "" {
+		return false
+	}
+
+	// 令牌校验发生在注入租户之前，客户端ID全局唯一，按系统身份查询
+	entity, err := r.entClient.Client().OAuthClient.Query().
+		Where(oauthclient.ClientIDEQ(clientID)).
+		Only(appViewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			r.log.Errorf("query oauth client [%s] failed: %s", clientID, err.Error())
+		}
+		return false
+	}
+
+	return clientTokenActive(entity, secretVersion, time.Now())
+}
+
+// clientTokenActive 按客户端的状态与密钥版本判断其令牌是否有效
+func clientTokenActive(entity *ent.OAuthClient, secretVersion uint32, now time.Time) bool {
+	if entity.Status == nil || *entity.Status != oauthclient.StatusOn {
+		return false
+	}
+	if secretVersion == entity.SecretVersion {
+		return true
+	}
+	return secretVersion+1 == entity.SecretVersion && previousSecretActive(entity, now)
+}
+
+// toDataScope 转换数据权限，未指定时返回 nil
+func (r *OAuthClientRepo) toDataScope(dataScope *permissionV1.DataScope) *oauthclient.DataScope {
+	if dataScope == nil || *dataScope == permissionV1.DataScope_DATA_SCOPE_UNSPECIFIED {
+		return nil
+	}
+	return r.dataScopeConverter.ToEntity(dataScope)
+}
+
+// ListEnabledScopes 查询所有启用的客户端使用的授权范围，用于生成鉴权策略
+func (r *OAuthClientRepo) ListEnabledScopes(ctx context.Context) ([]string, error) {
+	// 授权范围与权限码一一对应，与租户无关，因此查询全部租户的客户端
+	entities, err := r.entClient.Client().OAuthClient.Query().
+		Where(oauthclient.StatusEQ(oauthclient.StatusOn)).
+		Select(oauthclient.FieldScopes).
+		All(appViewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		r.log.Errorf("query oauth client scopes failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query oauth client scopes failed")
+	}
+
+	var scopes []string
+	for _, entity := range entities {
+		scopes = append(scopes, entity.Scopes...)
+	}
+
+	return normalizeScopes(scopes), nil
+}
+
+// newSecret 生成客户端密钥明文及其哈希
+func (r *OAuthClientRepo) newSecret() (secret, secretHash string, err error) {
+	b := make([]byte, oauthClientSecretBytes)
+	if _, err = rand.Read(b); err != nil {
+		r.log.Errorf("generate client secret failed: %s", err.Error())
+		return "", "", adminV1.ErrorInternalServerError("generate client secret failed")
+	}
+	secret = base64.RawURLEncoding.EncodeToString(b)
+
+	if secretHash, err = r.passwordCrypto.Encrypt(secret); err != nil {
+		r.log.Errorf("hash client secret failed: %s", err.Error())
+		return "", "", adminV1.ErrorInternalServerError("hash client secret failed")
+	}
+
+	return secret, secretHash, nil
+}
+
+// normalizeScopes 去除空值和重复的授权范围并排序
+func normalizeScopes(scopes []string) []string {
+	seen := make(map[string]struct{}, len(scopes))
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if scope == "" {
+			continue
+		}
+		if _, ok := seen[scope]; ok {
+			continue
+		}
+		seen[scope] = struct{}{}
+		result = append(result, scope)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,116 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthclient"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+)
+
+func TestOAuthClientRepo_MatchSecret(t *testing.T) {
+	r := &OAuthClientRepo{passwordCrypto: NewPasswordCrypto()}
+
+	current, err := r.passwordCrypto.Encrypt("new-secret")
+	assert.NoError(t, err)
+	previous, err := r.passwordCrypto.Encrypt("old-secret")
+	assert.NoError(t, err)
+
+	now := time.Now()
+	entity := &ent.OAuthClient{
+		SecretHash:              current,
+		SecretVersion:           2,
+		PreviousSecretHash:      trans.Ptr(previous),
+		PreviousSecretExpiresAt: trans.Ptr(now.Add(time.Hour)),
+	}
+
+	version, ok := r.matchSecret(entity, "new-secret", now)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(2), version)
+	_, ok = r.matchSecret(entity, "wrong-secret", now)
+	assert.False(t, ok)
+
+	// 重叠期内旧密钥仍然有效，过期后失效
+	version, ok = r.matchSecret(entity, "old-secret", now)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(1), version)
+	_, ok = r.matchSecret(entity, "old-secret", now.Add(time.Hour))
+	assert.False(t, ok)
+
+	// 立即轮换时没有旧密钥
+	entity.PreviousSecretHash = nil
+	entity.PreviousSecretExpiresAt = nil
+	_, ok = r.matchSecret(entity, "old-secret", now)
+	assert.False(t, ok)
+}
+
+func TestClientTokenActive(t *testing.T) {
+	now := time.Now()
+	on, off := oauthclient.StatusOn, oauthclient.StatusOff
+	entity := &ent.OAuthClient{
+		Status:                  &on,
+		SecretVersion:           3,
+		PreviousSecretHash:      trans.Ptr("hash"),
+		PreviousSecretExpiresAt: trans.Ptr(now.Add(time.Hour)),
+	}
+
+	assert.True(t, clientTokenActive(entity, 3, now))
+	// 旧密钥换取的令牌在重叠期内有效，更早版本的令牌无效
+	assert.True(t, clientTokenActive(entity, 2, now))
+	assert.False(t, clientTokenActive(entity, 2, now.Add(time.Hour)))
+	assert.False(t, clientTokenActive(entity, 1, now))
+	// 升级前签发的令牌没有密钥版本
+	assert.False(t, clientTokenActive(entity, 0, now))
+
+	// 客户端被禁用后令牌立即失效
+	entity.Status = &off
+	assert.False(t, clientTokenActive(entity, 3, now))
+}
+
+func TestOAuthClientRepo_ToDTO(t *testing.T) {
+	r := &OAuthClientRepo{
+		mapper: mapper.NewCopierMapper[authenticationV1.OAuthClient, ent.OAuthClient](),
+		statusConverter: mapper.NewEnumTypeConverter[authenticationV1.OAuthClient_Status, oauthclient.Status](
+			authenticationV1.OAuthClient_Status_name,
+			authenticationV1.OAuthClient_Status_value,
+		),
+		dataScopeConverter: mapper.NewEnumTypeConverter[permissionV1.DataScope, oauthclient.DataScope](
+			permissionV1.DataScope_name,
+			permissionV1.DataScope_value,
+		),
+	}
+	r.init()
+
+	status := oauthclient.StatusOn
+	dto := r.mapper.ToDTO(&ent.OAuthClient{
+		ID:         3,
+		ClientID:   "billing",
+		Name:       "Billing",
+		SecretHash: "hash",
+		Scopes:     []string{"sys:user:list"},
+		TokenTTL:   trans.Ptr(uint32(600)),
+		Status:     &status,
+		DataScope:  trans.Ptr(oauthclient.DataScopeUnitOnly),
+		TenantID:   trans.Ptr(uint32(2)),
+	})
+
+	assert.Equal(t, uint32(3), dto.GetId())
+	assert.Equal(t, "billing", dto.GetClientId())
+	assert.Equal(t, []string{"sys:user:list"}, dto.GetScopes())
+	assert.Equal(t, uint32(600), dto.GetTokenTtl())
+	assert.Equal(t, authenticationV1.OAuthClient_ON, dto.GetStatus())
+	assert.Equal(t, permissionV1.DataScope_UNIT_ONLY, dto.GetDataScope())
+	assert.Equal(t, uint32(2), dto.GetTenantId())
+}
+
+func TestNormalizeScopes(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, normalizeScopes([]string{"b", "", "a", "b"}))
+	assert.Empty(t, normalizeScopes(nil))
+}
@@ -0,0 +1,306 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	passwordPolicy "go-wind-admin/pkg/passwordpolicy"
+)
+
+type PasswordPolicyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper *mapper.CopierMapper[authenticationV1.PasswordPolicy, ent.PasswordPolicy]
+
+	repository *entCrud.Repository[
+		ent.PasswordPolicyQuery, ent.PasswordPolicySelect,
+		ent.PasswordPolicyCreate, ent.PasswordPolicyCreateBulk,
+		ent.PasswordPolicyUpdate, ent.PasswordPolicyUpdateOne,
+		ent.PasswordPolicyDelete,
+		predicate.PasswordPolicy,
+		authenticationV1.PasswordPolicy, ent.PasswordPolicy,
+	]
+
+	breachList *passwordPolicy.BreachList
+}
+
+func NewPasswordPolicyRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	adminCfg *adminConfV1.Bootstrap,
+) *PasswordPolicyRepo {
+	repo := &PasswordPolicyRepo{
+		log:       ctx.NewLoggerHelper("password-policy/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[authenticationV1.PasswordPolicy, ent.PasswordPolicy](),
+	}
+
+	repo.init()
+
+	cfg := adminCfg.GetPasswordPolicy()
+	if path := cfg.GetBreachedPasswordFile(); path != "" {
+		var err error
+		if repo.breachList, err = passwordPolicy.LoadBreachList(path, cfg.GetBreachedFalsePositiveRate()); err != nil {
+			repo.log.Errorf("load breached password list [%s] failed: %s", path, err.Error())
+		} else {
+			repo.log.Infof("loaded %d breached passwords from [%s]", repo.breachList.Len(), path)
+		}
+	}
+
+	return repo
+}
+
+func (r *PasswordPolicyRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.PasswordPolicyQuery, ent.PasswordPolicySelect,
+		ent.PasswordPolicyCreate, ent.PasswordPolicyCreateBulk,
+		ent.PasswordPolicyUpdate, ent.PasswordPolicyUpdateOne,
+		ent.PasswordPolicyDelete,
+		predicate.PasswordPolicy,
+		authenticationV1.PasswordPolicy, ent.PasswordPolicy,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+func (r *PasswordPolicyRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector)) (int, error) {
+	builder := r.entClient.Client().PasswordPolicy.Query()
+	if len(whereCond) != 0 {
+		builder.Modify(whereCond...)
+	}
+
+	count, err := builder.Count(ctx)
+	if err != nil {
+		r.log.Errorf("query count failed: %s", err.Error())
+		return 0, authenticationV1.ErrorInternalServerError("query count failed")
+	}
+
+	return count, nil
+}
+
+func (r *PasswordPolicyRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListPasswordPolicyResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().PasswordPolicy.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &authenticationV1.ListPasswordPolicyResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &authenticationV1.ListPasswordPolicyResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *PasswordPolicyRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().PasswordPolicy.Query().
+		Where(passwordpolicy.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, authenticationV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *PasswordPolicyRepo) Get(ctx context.Context, req *authenticationV1.GetPasswordPolicyRequest) (*authenticationV1.PasswordPolicy, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().PasswordPolicy.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *authenticationV1.GetPasswordPolicyRequest_Id:
+		whereCond = append(whereCond, passwordpolicy.IDEQ(req.GetId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+func (r *PasswordPolicyRepo) Create(ctx context.Context, req *authenticationV1.CreatePasswordPolicyRequest) error {
+	if req == nil || req.Data == nil {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().PasswordPolicy.Create().
+		SetNillableTenantID(req.Data.TenantId).
+		SetNillableMinLength(req.Data.MinLength).
+		SetNillableRequireUppercase(req.Data.RequireUppercase).
+		SetNillableRequireLowercase(req.Data.RequireLowercase).
+		SetNillableRequireDigit(req.Data.RequireDigit).
+		SetNillableRequireSymbol(req.Data.RequireSymbol).
+		SetNillableHistoryCount(req.Data.HistoryCount).
+		SetNillableMaxAgeDays(req.Data.MaxAgeDays).
+		SetNillableRejectBreached(req.Data.RejectBreached).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if req.Data.Id != nil {
+		builder.SetID(req.GetData().GetId())
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return authenticationV1.ErrorConflict("password policy already exists")
+		}
+		r.log.Errorf("insert password policy failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("insert password policy failed")
+	}
+
+	return nil
+}
+
+func (r *PasswordPolicyRepo) Update(ctx context.Context, req *authenticationV1.UpdatePasswordPolicyRequest) error {
+	if req == nil || req.Data == nil {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 如果不存在则创建
+	if req.GetAllowMissing() {
+		exist, err := r.IsExist(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			createReq := &authenticationV1.CreatePasswordPolicyRequest{Data: req.Data}
+			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
+			createReq.Data.UpdatedBy = nil
+			return r.Create(ctx, createReq)
+		}
+	}
+
+	builder := r.entClient.Client().Debug().PasswordPolicy.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *authenticationV1.PasswordPolicy) {
+			builder.
+				SetNillableMinLength(req.Data.MinLength).
+				SetNillableRequireUppercase(req.Data.RequireUppercase).
+				SetNillableRequireLowercase(req.Data.RequireLowercase).
+				SetNillableRequireDigit(req.Data.RequireDigit).
+				SetNillableRequireSymbol(req.Data.RequireSymbol).
+				SetNillableHistoryCount(req.Data.HistoryCount).
+				SetNillableMaxAgeDays(req.Data.MaxAgeDays).
+				SetNillableRejectBreached(req.Data.RejectBreached).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(passwordpolicy.FieldID, req.GetId()))
+		},
+	)
+
+	return err
+}
+
+func (r *PasswordPolicyRepo) Delete(ctx context.Context, req *authenticationV1.DeletePasswordPolicyRequest) error {
+	if req == nil {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	if err := r.entClient.Client().PasswordPolicy.DeleteOneID(req.GetId()).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return authenticationV1.ErrorNotFound("password policy not found")
+		}
+
+		r.log.Errorf("delete one data failed: %s", err.Error())
+
+		return authenticationV1.ErrorInternalServerError("delete failed")
+	}
+
+	return nil
+}
+
+// GetEffective 查询租户生效的密码策略：租户策略优先，其次是平台策略，都没有时返回默认策略
+func (r *PasswordPolicyRepo) GetEffective(ctx context.Context, tenantID uint32) (*authenticationV1.PasswordPolicy, error) {
+	entity, err := r.entClient.Client().PasswordPolicy.Query().
+		Where(passwordpolicy.TenantIDIn(tenantID, 0)).
+		Order(ent.Desc(passwordpolicy.FieldTenantID)).
+		First(appViewer.NewSystemViewerContext(ctx))
+	if err != nil && !ent.IsNotFound(err) {
+		r.log.Errorf("query password policy of tenant [%d] failed: %s", tenantID, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query password policy failed")
+	}
+
+	if entity != nil {
+		return r.mapper.ToDTO(entity), nil
+	}
+
+	def := passwordPolicy.Default()
+	return &authenticationV1.PasswordPolicy{
+		MinLength:        trans.Ptr(uint32(def.MinLength)),
+		RequireUppercase: trans.Ptr(def.RequireUppercase),
+		RequireLowercase: trans.Ptr(def.RequireLowercase),
+		RequireDigit:     trans.Ptr(def.RequireDigit),
+		RequireSymbol:    trans.Ptr(def.RequireSymbol),
+		HistoryCount:     trans.Ptr(uint32(def.HistoryCount)),
+		MaxAgeDays:       trans.Ptr(uint32(def.MaxAge / (24 * time.Hour))),
+		RejectBreached:   trans.Ptr(def.RejectBreached),
+		TenantId:         trans.Ptr(tenantID),
+	}, nil
+}
+
+// Resolve 获取租户生效的密码策略
+func (r *PasswordPolicyRepo) Resolve(ctx context.Context, tenantID uint32) (*passwordPolicy.Policy, error) {
+	dto, err := r.GetEffective(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return toPasswordPolicy(dto), nil
+}
+
+// Validate 检查密码是否符合策略中与历史密码无关的规则
+func (r *PasswordPolicyRepo) Validate(policy *passwordPolicy.Policy, password string) []passwordPolicy.Rule {
+	if policy.RejectBreached && r.breachList == nil {
+		r.log.Warn("password policy rejects breached passwords but no breached password list is loaded")
+	}
+	return policy.Validate(password, r.breachList)
+}
+
+func toPasswordPolicy(dto *authenticationV1.PasswordPolicy) *passwordPolicy.Policy {
+	return &passwordPolicy.Policy{
+		MinLength:        int(dto.GetMinLength()),
+		RequireUppercase: dto.GetRequireUppercase(),
+		RequireLowercase: dto.GetRequireLowercase(),
+		RequireDigit:     dto.GetRequireDigit(),
+		RequireSymbol:    dto.GetRequireSymbol(),
+		HistoryCount:     int(dto.GetHistoryCount()),
+		MaxAge:           time.Duration(dto.GetMaxAgeDays()) * 24 * time.Hour,
+		RejectBreached:   dto.GetRejectBreached(),
+	}
+}
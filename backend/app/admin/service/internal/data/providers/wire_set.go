@@ -22,12 +22,25 @@ var ProviderSet = wire.NewSet(
 	data.NewEntClient,
 
 	data.NewAuthorizerProvider,
+	data.NewJwtKeyRing,
 	data.NewAuthenticator,
 	data.NewAuthorizer,
 
 	data.NewPasswordCrypto,
 
+	data.NewAdminConfig,
+
+	data.NewNotifier,
+	data.NewVerificationCodeRepo,
+	data.NewCaptchaVerifier,
+	data.NewEventBusTransport,
+	data.NewEventBus,
+
 	data.NewMinIoClient,
+	data.NewContentScanner,
+	data.NewLuaQueryRepo,
+	data.NewLuaEngine,
+	data.NewLuaScriptRepo,
 
 	data.NewDictTypeRepo,
 	data.NewDictTypeI18nRepo,
@@ -36,7 +49,18 @@ var ProviderSet = wire.NewSet(
 	data.NewLanguageRepo,
 
 	data.NewTaskRepo,
+	data.NewTaskRunRepo,
+	data.NewBackupRepo,
 	data.NewLoginPolicyRepo,
+	data.NewPasswordPolicyRepo,
+	data.NewRegistrationPolicyRepo,
+	data.NewRegistrationInviteRepo,
+	data.NewLdapSourceRepo,
+	data.NewLdapSyncRepo,
+	data.NewScimTokenRepo,
+	data.NewScimRepo,
+	data.NewOAuthClientRepo,
+	data.NewJwtSigningKeyRepo,
 
 	data.NewOrgUnitRepo,
 	data.NewPositionRepo,
@@ -44,6 +68,7 @@ var ProviderSet = wire.NewSet(
 
 	data.NewUserRepo,
 	data.NewUserCredentialRepo,
+	data.NewUserRegistrationRepo,
 	data.NewUserOrgUnitRepo,
 	data.NewUserPositionRepo,
 	data.NewUserRoleRepo,
@@ -73,6 +98,10 @@ var ProviderSet = wire.NewSet(
 	data.NewDataAccessAuditLogRepo,
 
 	data.NewFileRepo,
+	data.NewStorageQuotaRepo,
+	data.NewFileShareRepo,
+	data.NewFileShareAttemptRepo,
+	data.NewFileObjectLockRepo,
 
 	data.NewInternalMessageRepo,
 	data.NewInternalMessageCategoryRepo,
@@ -0,0 +1,215 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationinvite"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+)
+
+const (
+	DefaultRegistrationInviteTTL = 7 * 24 * time.Hour
+
+	registrationInviteCodeBytes = 18
+)
+
+type RegistrationInviteRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper *mapper.CopierMapper[authenticationV1.RegistrationInvite, ent.RegistrationInvite]
+
+	repository *entCrud.Repository[
+		ent.RegistrationInviteQuery, ent.RegistrationInviteSelect,
+		ent.RegistrationInviteCreate, ent.RegistrationInviteCreateBulk,
+		ent.RegistrationInviteUpdate, ent.RegistrationInviteUpdateOne,
+		ent.RegistrationInviteDelete,
+		predicate.RegistrationInvite,
+		authenticationV1.RegistrationInvite, ent.RegistrationInvite,
+	]
+}
+
+func NewRegistrationInviteRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *RegistrationInviteRepo {
+	repo := &RegistrationInviteRepo{
+		log:       ctx.NewLoggerHelper("registration-invite/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[authenticationV1.RegistrationInvite, ent.RegistrationInvite](),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *RegistrationInviteRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.RegistrationInviteQuery, ent.RegistrationInviteSelect,
+		ent.RegistrationInviteCreate, ent.RegistrationInviteCreateBulk,
+		ent.RegistrationInviteUpdate, ent.RegistrationInviteUpdateOne,
+		ent.RegistrationInviteDelete,
+		predicate.RegistrationInvite,
+		authenticationV1.RegistrationInvite, ent.RegistrationInvite,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+func (r *RegistrationInviteRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListRegistrationInviteResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().RegistrationInvite.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &authenticationV1.ListRegistrationInviteResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &authenticationV1.ListRegistrationInviteResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+// Create 创建邀请，返回的邀请码只在这里出现一次，数据库中只保存其摘要
+func (r *RegistrationInviteRepo) Create(ctx context.Context, tenantID uint32, email *string, ttl time.Duration, createdBy uint32) (*authenticationV1.RegistrationInvite, string, error) {
+	if ttl <= 0 {
+		ttl = DefaultRegistrationInviteTTL
+	}
+
+	code, err := randomInviteCode()
+	if err != nil {
+		r.log.Errorf("generate invite code failed: %s", err.Error())
+		return nil, "", authenticationV1.ErrorInternalServerError("generate invite code failed")
+	}
+
+	now := time.Now()
+	entity, err := r.entClient.Client().RegistrationInvite.Create().
+		SetTenantID(tenantID).
+		SetCodeHash(hashInviteCode(code)).
+		SetNillableEmail(email).
+		SetExpiresAt(now.Add(ttl)).
+		SetCreatedBy(createdBy).
+		SetCreatedAt(now).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("insert registration invite failed: %s", err.Error())
+		return nil, "", authenticationV1.ErrorInternalServerError("insert registration invite failed")
+	}
+
+	return r.mapper.ToDTO(entity), code, nil
+}
+
+// Revoke 删除未使用的邀请，已使用的邀请作为注册记录保留
+func (r *RegistrationInviteRepo) Revoke(ctx context.Context, id uint32) error {
+	n, err := r.entClient.Client().RegistrationInvite.Delete().
+		Where(
+			registrationinvite.IDEQ(id),
+			registrationinvite.UsedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete registration invite failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("delete registration invite failed")
+	}
+	if n > 0 {
+		return nil
+	}
+
+	exist, err := r.entClient.Client().RegistrationInvite.Query().
+		Where(registrationinvite.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query registration invite failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("query registration invite failed")
+	}
+	if exist {
+		return authenticationV1.ErrorConflict("registration invite already used")
+	}
+	return authenticationV1.ErrorNotFound("registration invite not found")
+}
+
+// FindValid 查找租户下未使用且未过期的邀请
+func (r *RegistrationInviteRepo) FindValid(ctx context.Context, tenantID uint32, code string) (*authenticationV1.RegistrationInvite, error) {
+	if code == "" {
+		return nil, authenticationV1.ErrorInvalidInviteCode("invite code is required")
+	}
+
+	entity, err := r.entClient.Client().RegistrationInvite.Query().
+		Where(
+			registrationinvite.CodeHashEQ(hashInviteCode(code)),
+			registrationinvite.TenantIDEQ(tenantID),
+			registrationinvite.UsedAtIsNil(),
+			registrationinvite.ExpiresAtGT(time.Now()),
+		).
+		Only(appViewer.NewSystemViewerContext(ctx))
+	switch {
+	case err == nil:
+		return r.mapper.ToDTO(entity), nil
+	case ent.IsNotFound(err):
+		return nil, authenticationV1.ErrorInvalidInviteCode("invite code is invalid or expired")
+	default:
+		r.log.Errorf("query registration invite failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query registration invite failed")
+	}
+}
+
+// ConsumeWithTx 在事务中核销邀请，并发注册时只有一个请求能成功
+func (r *RegistrationInviteRepo) ConsumeWithTx(ctx context.Context, tx *ent.Tx, id, userID uint32) error {
+	now := time.Now()
+	n, err := tx.RegistrationInvite.Update().
+		Where(
+			registrationinvite.IDEQ(id),
+			registrationinvite.UsedAtIsNil(),
+			registrationinvite.ExpiresAtGT(now),
+		).
+		SetUsedAt(now).
+		SetUsedBy(userID).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("consume registration invite [%d] failed: %s", id, err.Error())
+		return authenticationV1.ErrorInternalServerError("consume registration invite failed")
+	}
+	if n == 0 {
+		return authenticationV1.ErrorInvalidInviteCode("invite code is invalid or expired")
+	}
+	return nil
+}
+
+func randomInviteCode() (string, error) {
+	b := make([]byte, registrationInviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashInviteCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,284 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/registrationpolicy"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+)
+
+type RegistrationPolicyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper        *mapper.CopierMapper[authenticationV1.RegistrationPolicy, ent.RegistrationPolicy]
+	modeConverter *mapper.EnumTypeConverter[authenticationV1.RegistrationPolicy_Mode, registrationpolicy.Mode]
+
+	repository *entCrud.Repository[
+		ent.RegistrationPolicyQuery, ent.RegistrationPolicySelect,
+		ent.RegistrationPolicyCreate, ent.RegistrationPolicyCreateBulk,
+		ent.RegistrationPolicyUpdate, ent.RegistrationPolicyUpdateOne,
+		ent.RegistrationPolicyDelete,
+		predicate.RegistrationPolicy,
+		authenticationV1.RegistrationPolicy, ent.RegistrationPolicy,
+	]
+}
+
+func NewRegistrationPolicyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *RegistrationPolicyRepo {
+	repo := &RegistrationPolicyRepo{
+		log:       ctx.NewLoggerHelper("registration-policy/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[authenticationV1.RegistrationPolicy, ent.RegistrationPolicy](),
+		modeConverter: mapper.NewEnumTypeConverter[authenticationV1.RegistrationPolicy_Mode, registrationpolicy.Mode](
+			authenticationV1.RegistrationPolicy_Mode_name, authenticationV1.RegistrationPolicy_Mode_value,
+		),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *RegistrationPolicyRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.RegistrationPolicyQuery, ent.RegistrationPolicySelect,
+		ent.RegistrationPolicyCreate, ent.RegistrationPolicyCreateBulk,
+		ent.RegistrationPolicyUpdate, ent.RegistrationPolicyUpdateOne,
+		ent.RegistrationPolicyDelete,
+		predicate.RegistrationPolicy,
+		authenticationV1.RegistrationPolicy, ent.RegistrationPolicy,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.modeConverter.NewConverterPair())
+}
+
+func (r *RegistrationPolicyRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector)) (int, error) {
+	builder := r.entClient.Client().RegistrationPolicy.Query()
+	if len(whereCond) != 0 {
+		builder.Modify(whereCond...)
+	}
+
+	count, err := builder.Count(ctx)
+	if err != nil {
+		r.log.Errorf("query count failed: %s", err.Error())
+		return 0, authenticationV1.ErrorInternalServerError("query count failed")
+	}
+
+	return count, nil
+}
+
+func (r *RegistrationPolicyRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListRegistrationPolicyResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().RegistrationPolicy.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &authenticationV1.ListRegistrationPolicyResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &authenticationV1.ListRegistrationPolicyResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *RegistrationPolicyRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().RegistrationPolicy.Query().
+		Where(registrationpolicy.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, authenticationV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *RegistrationPolicyRepo) Get(ctx context.Context, req *authenticationV1.GetRegistrationPolicyRequest) (*authenticationV1.RegistrationPolicy, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().RegistrationPolicy.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *authenticationV1.GetRegistrationPolicyRequest_Id:
+		whereCond = append(whereCond, registrationpolicy.IDEQ(req.GetId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+func (r *RegistrationPolicyRepo) Create(ctx context.Context, req *authenticationV1.CreateRegistrationPolicyRequest) error {
+	if req == nil || req.Data == nil {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().RegistrationPolicy.Create().
+		SetNillableTenantID(req.Data.TenantId).
+		SetNillableMode(r.modeConverter.ToEntity(req.Data.Mode)).
+		SetAllowedDomains(normalizeEmailDomains(req.Data.AllowedDomains)).
+		SetNillableRequireEmailVerification(req.Data.RequireEmailVerification).
+		SetNillableRequireCaptcha(req.Data.RequireCaptcha).
+		SetNillableDefaultRoleID(req.Data.DefaultRoleId).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if req.Data.Id != nil {
+		builder.SetID(req.GetData().GetId())
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return authenticationV1.ErrorConflict("registration policy already exists")
+		}
+		r.log.Errorf("insert registration policy failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("insert registration policy failed")
+	}
+
+	return nil
+}
+
+func (r *RegistrationPolicyRepo) Update(ctx context.Context, req *authenticationV1.UpdateRegistrationPolicyRequest) error {
+	if req == nil || req.Data == nil {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 如果不存在则创建
+	if req.GetAllowMissing() {
+		exist, err := r.IsExist(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			createReq := &authenticationV1.CreateRegistrationPolicyRequest{Data: req.Data}
+			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
+			createReq.Data.UpdatedBy = nil
+			return r.Create(ctx, createReq)
+		}
+	}
+
+	builder := r.entClient.Client().Debug().RegistrationPolicy.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *authenticationV1.RegistrationPolicy) {
+			if req.Data.AllowedDomains != nil {
+				builder.SetAllowedDomains(normalizeEmailDomains(req.Data.AllowedDomains))
+			}
+			builder.
+				SetNillableMode(r.modeConverter.ToEntity(req.Data.Mode)).
+				SetNillableRequireEmailVerification(req.Data.RequireEmailVerification).
+				SetNillableRequireCaptcha(req.Data.RequireCaptcha).
+				SetNillableDefaultRoleID(req.Data.DefaultRoleId).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(registrationpolicy.FieldID, req.GetId()))
+		},
+	)
+
+	return err
+}
+
+func (r *RegistrationPolicyRepo) Delete(ctx context.Context, req *authenticationV1.DeleteRegistrationPolicyRequest) error {
+	if req == nil {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	if err := r.entClient.Client().RegistrationPolicy.DeleteOneID(req.GetId()).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return authenticationV1.ErrorNotFound("registration policy not found")
+		}
+
+		r.log.Errorf("delete one data failed: %s", err.Error())
+
+		return authenticationV1.ErrorInternalServerError("delete failed")
+	}
+
+	return nil
+}
+
+// GetEffective 查询租户的注册策略，租户没有配置时返回关闭注册的策略
+func (r *RegistrationPolicyRepo) GetEffective(ctx context.Context, tenantID uint32) (*authenticationV1.RegistrationPolicy, error) {
+	entity, err := r.entClient.Client().RegistrationPolicy.Query().
+		Where(registrationpolicy.TenantIDEQ(tenantID)).
+		Only(appViewer.NewSystemViewerContext(ctx))
+	switch {
+	case err == nil:
+		return r.mapper.ToDTO(entity), nil
+	case ent.IsNotFound(err):
+		return &authenticationV1.RegistrationPolicy{
+			Mode:     authenticationV1.RegistrationPolicy_CLOSED.Enum(),
+			TenantId: &tenantID,
+		}, nil
+	default:
+		r.log.Errorf("query registration policy of tenant [%d] failed: %s", tenantID, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query registration policy failed")
+	}
+}
+
+// normalizeEmailDomains 域名统一转为小写，去掉前导的 @ 与空白，并去重
+func normalizeEmailDomains(domains []string) []string {
+	ret := make([]string, 0, len(domains))
+	seen := make(map[string]struct{}, len(domains))
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(d), "@"))
+		if d == "" {
+			continue
+		}
+		if _, ok := seen[d]; ok {
+			continue
+		}
+		seen[d] = struct{}{}
+		ret = append(ret, d)
+	}
+	return ret
+}
+
+// EmailDomainAllowed 邮箱域名等于允许的域名或是其子域名时返回 true
+func EmailDomainAllowed(email string, allowedDomains []string) bool {
+	i := strings.LastIndexByte(email, '@')
+	if i < 0 || i == len(email)-1 {
+		return false
+	}
+	domain := strings.ToLower(email[i+1:])
+
+	for _, allowed := range normalizeEmailDomains(allowedDomains) {
+		if domain == allowed || strings.HasSuffix(domain, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeEmailDomains(t *testing.T) {
+	assert.Equal(t,
+		[]string{"example.com", "corp.example.org"},
+		normalizeEmailDomains([]string{" Example.com ", "@corp.example.org", "", "example.com"}),
+	)
+}
+
+func TestEmailDomainAllowed(t *testing.T) {
+	allowed := []string{"example.com", "@Partner.org"}
+
+	assert.True(t, EmailDomainAllowed("user@example.com", allowed))
+	assert.True(t, EmailDomainAllowed("user@EXAMPLE.COM", allowed))
+	assert.True(t, EmailDomainAllowed("user@mail.example.com", allowed))
+	assert.True(t, EmailDomainAllowed("user@partner.org", allowed))
+
+	assert.False(t, EmailDomainAllowed("user@badexample.com", allowed))
+	assert.False(t, EmailDomainAllowed("user@example.com.evil.io", allowed))
+	assert.False(t, EmailDomainAllowed("user@", allowed))
+	assert.False(t, EmailDomainAllowed("example.com", allowed))
+	assert.False(t, EmailDomainAllowed("user@example.com", nil))
+}
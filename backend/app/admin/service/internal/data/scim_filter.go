@@ -0,0 +1,272 @@
+package data
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+
+	"go-wind-admin/pkg/scim"
+)
+
+type scimColumnKind int
+
+const (
+	scimColumnString scimColumnKind = iota // 不区分大小写的字符串
+	scimColumnExact                        // 区分大小写的字符串
+	scimColumnID                           // 数字主键，SCIM 中以字符串表示
+	scimColumnTime                         // 时间，SCIM 中以 RFC3339 字符串表示
+	scimColumnActive                       // 用户状态，SCIM 中以布尔值表示
+)
+
+type scimColumn struct {
+	Name string
+	Kind scimColumnKind
+}
+
+// scimUserColumns SCIM 用户属性到 sys_users 列的映射，键为小写的属性路径
+var scimUserColumns = map[string]scimColumn{
+	"id":                 {user.FieldID, scimColumnID},
+	"username":           {user.FieldUsername, scimColumnString},
+	"externalid":         {user.FieldExternalID, scimColumnExact},
+	"displayname":        {user.FieldNickname, scimColumnString},
+	"name.formatted":     {user.FieldRealname, scimColumnString},
+	"emails":             {user.FieldEmail, scimColumnString},
+	"emails.value":       {user.FieldEmail, scimColumnString},
+	"phonenumbers":       {user.FieldMobile, scimColumnString},
+	"phonenumbers.value": {user.FieldMobile, scimColumnString},
+	"active":             {user.FieldStatus, scimColumnActive},
+	"meta.created":       {user.FieldCreatedAt, scimColumnTime},
+	"meta.lastmodified":  {user.FieldUpdatedAt, scimColumnTime},
+}
+
+// scimGroupColumns SCIM 组属性到 sys_roles 列的映射
+var scimGroupColumns = map[string]scimColumn{
+	"id":                {role.FieldID, scimColumnID},
+	"displayname":       {role.FieldName, scimColumnString},
+	"meta.created":      {role.FieldCreatedAt, scimColumnTime},
+	"meta.lastmodified": {role.FieldUpdatedAt, scimColumnTime},
+}
+
+// scimPredicate 在查询语句上生成过滤条件，列名需要通过 Selector 限定表名
+type scimPredicate func(s *sql.Selector) *sql.Predicate
+
+// scimFilterPredicate 把 SCIM 过滤表达式转换为 SQL 条件，不支持的属性与运算返回 invalidFilter
+func scimFilterPredicate(f scim.Filter, columns map[string]scimColumn) (scimPredicate, error) {
+	switch e := f.(type) {
+	case *scim.LogicalExpr:
+		left, err := scimFilterPredicate(e.Left, columns)
+		if err != nil {
+			return nil, err
+		}
+		right, err := scimFilterPredicate(e.Right, columns)
+		if err != nil {
+			return nil, err
+		}
+		if e.Op == "or" {
+			return func(s *sql.Selector) *sql.Predicate { return sql.Or(left(s), right(s)) }, nil
+		}
+		return func(s *sql.Selector) *sql.Predicate { return sql.And(left(s), right(s)) }, nil
+
+	case *scim.NotExpr:
+		inner, err := scimFilterPredicate(e.Filter, columns)
+		if err != nil {
+			return nil, err
+		}
+		return func(s *sql.Selector) *sql.Predicate { return sql.Not(inner(s)) }, nil
+
+	case *scim.ValuePathExpr:
+		// 多值属性在表中只保存了一个值，emails[value eq "x"] 等价于 emails.value eq "x"
+		return scimValuePathPredicate(e.Path, e.Filter, columns)
+
+	case *scim.AttrExpr:
+		return scimAttrPredicate(e, columns)
+	}
+
+	return nil, scim.NewError(http.StatusBadRequest, scim.ErrInvalidFilter, "unsupported filter")
+}
+
+func scimValuePathPredicate(parent scim.AttrPath, f scim.Filter, columns map[string]scimColumn) (scimPredicate, error) {
+	rewritten, err := scimQualifyFilter(parent, f)
+	if err != nil {
+		return nil, err
+	}
+	return scimFilterPredicate(rewritten, columns)
+}
+
+// scimQualifyFilter 把元素过滤条件中相对于元素的路径改写为完整路径
+func scimQualifyFilter(parent scim.AttrPath, f scim.Filter) (scim.Filter, error) {
+	switch e := f.(type) {
+	case *scim.LogicalExpr:
+		left, err := scimQualifyFilter(parent, e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := scimQualifyFilter(parent, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &scim.LogicalExpr{Op: e.Op, Left: left, Right: right}, nil
+
+	case *scim.NotExpr:
+		inner, err := scimQualifyFilter(parent, e.Filter)
+		if err != nil {
+			return nil, err
+		}
+		return &scim.NotExpr{Filter: inner}, nil
+
+	case *scim.AttrExpr:
+		if e.Path.SubAttr != "" || e.Path.URN != "" {
+			return nil, scim.NewError(http.StatusBadRequest, scim.ErrInvalidFilter, "invalid attribute %q in value filter", e.Path.String())
+		}
+		path := parent
+		path.SubAttr = e.Path.Attr
+		return &scim.AttrExpr{Path: path, Op: e.Op, Value: e.Value}, nil
+	}
+
+	return nil, scim.NewError(http.StatusBadRequest, scim.ErrInvalidFilter, "nested value filter is not supported")
+}
+
+func scimAttrPredicate(e *scim.AttrExpr, columns map[string]scimColumn) (scimPredicate, error) {
+	col, ok := columns[e.Path.String()]
+	if !ok {
+		return nil, scim.NewError(http.StatusBadRequest, scim.ErrInvalidFilter, "filtering on attribute %q is not supported", e.Path.String())
+	}
+
+	invalid := func() (scimPredicate, error) {
+		return nil, scim.NewError(http.StatusBadRequest, scim.ErrInvalidFilter, "invalid comparison %s %s %v", e.Path.String(), e.Op, e.Value)
+	}
+
+	if e.Op == scim.OpPresent {
+		if col.Kind == scimColumnString || col.Kind == scimColumnExact {
+			return func(s *sql.Selector) *sql.Predicate {
+				return sql.And(sql.NotNull(s.C(col.Name)), sql.NEQ(s.C(col.Name), ""))
+			}, nil
+		}
+		return func(s *sql.Selector) *sql.Predicate { return sql.NotNull(s.C(col.Name)) }, nil
+	}
+
+	if e.Value == nil {
+		switch e.Op {
+		case scim.OpEqual:
+			return func(s *sql.Selector) *sql.Predicate { return sql.IsNull(s.C(col.Name)) }, nil
+		case scim.OpNotEqual:
+			return func(s *sql.Selector) *sql.Predicate { return sql.NotNull(s.C(col.Name)) }, nil
+		}
+		return invalid()
+	}
+
+	switch col.Kind {
+	case scimColumnString, scimColumnExact:
+		v, ok := e.Value.(string)
+		if !ok {
+			return invalid()
+		}
+		fold := col.Kind == scimColumnString
+		switch e.Op {
+		case scim.OpEqual:
+			if fold {
+				return func(s *sql.Selector) *sql.Predicate { return sql.EqualFold(s.C(col.Name), v) }, nil
+			}
+			return func(s *sql.Selector) *sql.Predicate { return sql.EQ(s.C(col.Name), v) }, nil
+		case scim.OpNotEqual:
+			if fold {
+				return func(s *sql.Selector) *sql.Predicate { return sql.Not(sql.EqualFold(s.C(col.Name), v)) }, nil
+			}
+			return func(s *sql.Selector) *sql.Predicate { return sql.NEQ(s.C(col.Name), v) }, nil
+		case scim.OpContains:
+			if fold {
+				return func(s *sql.Selector) *sql.Predicate { return sql.ContainsFold(s.C(col.Name), v) }, nil
+			}
+			return func(s *sql.Selector) *sql.Predicate { return sql.Contains(s.C(col.Name), v) }, nil
+		case scim.OpStartsWith:
+			if fold {
+				return func(s *sql.Selector) *sql.Predicate { return sql.HasPrefixFold(s.C(col.Name), v) }, nil
+			}
+			return func(s *sql.Selector) *sql.Predicate { return sql.HasPrefix(s.C(col.Name), v) }, nil
+		case scim.OpEndsWith:
+			if fold {
+				return func(s *sql.Selector) *sql.Predicate { return sql.HasSuffixFold(s.C(col.Name), v) }, nil
+			}
+			return func(s *sql.Selector) *sql.Predicate { return sql.HasSuffix(s.C(col.Name), v) }, nil
+		}
+		return scimOrderedPredicate(col.Name, e.Op, v), nil
+
+	case scimColumnID:
+		var id uint64
+		var err error
+		switch v := e.Value.(type) {
+		case string:
+			id, err = strconv.ParseUint(v, 10, 32)
+		case float64:
+			id, err = strconv.ParseUint(fmt.Sprint(v), 10, 32)
+		default:
+			return invalid()
+		}
+		if err != nil {
+			// 不是合法的ID时不可能有匹配的记录
+			if e.Op == scim.OpEqual {
+				return func(*sql.Selector) *sql.Predicate { return sql.False() }, nil
+			}
+			return invalid()
+		}
+		if p := scimOrderedPredicate(col.Name, e.Op, uint32(id)); p != nil {
+			return p, nil
+		}
+
+	case scimColumnTime:
+		v, ok := e.Value.(string)
+		if !ok {
+			return invalid()
+		}
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return invalid()
+		}
+		if p := scimOrderedPredicate(col.Name, e.Op, t); p != nil {
+			return p, nil
+		}
+
+	case scimColumnActive:
+		v, ok := e.Value.(bool)
+		if !ok {
+			return invalid()
+		}
+		switch e.Op {
+		case scim.OpEqual, scim.OpNotEqual:
+			if (e.Op == scim.OpEqual) == v {
+				return func(s *sql.Selector) *sql.Predicate { return sql.EQ(s.C(col.Name), user.StatusNormal) }, nil
+			}
+			return func(s *sql.Selector) *sql.Predicate { return sql.NEQ(s.C(col.Name), user.StatusNormal) }, nil
+		}
+	}
+
+	return invalid()
+}
+
+// scimOrderedPredicate 生成相等与大小比较条件，其它运算返回 nil
+func scimOrderedPredicate(column, op string, v any) scimPredicate {
+	var fn func(col string, value any) *sql.Predicate
+	switch op {
+	case scim.OpEqual:
+		fn = sql.EQ
+	case scim.OpNotEqual:
+		fn = sql.NEQ
+	case scim.OpGreater:
+		fn = sql.GT
+	case scim.OpGreaterOrEqual:
+		fn = sql.GTE
+	case scim.OpLess:
+		fn = sql.LT
+	case scim.OpLessOrEqual:
+		fn = sql.LTE
+	default:
+		return nil
+	}
+	return func(s *sql.Selector) *sql.Predicate { return fn(s.C(column), v) }
+}
@@ -0,0 +1,78 @@
+package data
+
+import (
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+
+	"go-wind-admin/pkg/scim"
+)
+
+func testScimFilterSQL(t *testing.T, filter string, columns map[string]scimColumn) (string, []any, error) {
+	f, err := scim.ParseFilter(filter)
+	if !assert.NoError(t, err, filter) {
+		return "", nil, err
+	}
+	p, err := scimFilterPredicate(f, columns)
+	if err != nil {
+		return "", nil, err
+	}
+	s := sql.Dialect(dialect.Postgres).Select("id").From(sql.Table("sys_users"))
+	s.Where(p(s))
+	query, args := s.Query()
+	return query, args, nil
+}
+
+func TestScimFilterPredicate(t *testing.T) {
+	query, args, err := testScimFilterSQL(t, `userName eq "BJensen" and active eq true`, scimUserColumns)
+	assert.NoError(t, err)
+	assert.Contains(t, query, `"sys_users"."username"`)
+	assert.Contains(t, query, `"sys_users"."status" = $2`)
+	assert.Equal(t, []any{"bjensen", user.StatusNormal}, args)
+
+	// 表中只保存了邮箱地址，不支持按邮箱类型过滤
+	_, _, err = testScimFilterSQL(t, `emails[type eq "work" and value co "@example.com"]`, scimUserColumns)
+	var scimErr *scim.Error
+	if assert.True(t, errors.As(err, &scimErr)) {
+		assert.Equal(t, scim.ErrInvalidFilter, scimErr.ScimType)
+	}
+
+	query, args, err = testScimFilterSQL(t, `emails[value co "@Example.com"] or externalId eq "00u1"`, scimUserColumns)
+	assert.NoError(t, err)
+	assert.Contains(t, query, `"sys_users"."email"`)
+	assert.Contains(t, query, `"sys_users"."external_id" = $2`)
+	assert.Equal(t, []any{"%@example.com%", "00u1"}, args)
+
+	query, args, err = testScimFilterSQL(t, `id eq "42" and not (meta.lastModified lt "2024-01-02T03:04:05Z")`, scimUserColumns)
+	assert.NoError(t, err)
+	assert.Contains(t, query, `"sys_users"."id" = $1`)
+	assert.Contains(t, query, `NOT ("sys_users"."updated_at" < $2)`)
+	assert.Equal(t, uint32(42), args[0])
+
+	query, _, err = testScimFilterSQL(t, `id eq "not-a-number"`, scimUserColumns)
+	assert.NoError(t, err)
+	assert.Contains(t, query, "FALSE")
+
+	query, args, err = testScimFilterSQL(t, `displayName sw "Sales"`, scimGroupColumns)
+	assert.NoError(t, err)
+	assert.Contains(t, query, `"sys_users"."name"`)
+	assert.Equal(t, []any{"sales%"}, args)
+
+	for _, filter := range []string{
+		`title eq "Manager"`,
+		`active co "true"`,
+		`userName gt 1`,
+		`meta.created gt "yesterday"`,
+		`members eq "1"`,
+	} {
+		_, _, err = testScimFilterSQL(t, filter, scimUserColumns)
+		if assert.True(t, errors.As(err, &scimErr), filter) {
+			assert.Equal(t, scim.ErrInvalidFilter, scimErr.ScimType, filter)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/scimtoken"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+)
+
+const (
+	scimTokenPrefix    = "scim_"
+	scimTokenBytes     = 32
+	scimTokenShownSize = len(scimTokenPrefix) + 6
+
+	// 最近使用时间的更新间隔，避免每次请求都写库
+	scimTokenTouchInterval = time.Minute
+)
+
+type ScimTokenRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper *mapper.CopierMapper[authenticationV1.ScimToken, ent.ScimToken]
+
+	repository *entCrud.Repository[
+		ent.ScimTokenQuery, ent.ScimTokenSelect,
+		ent.ScimTokenCreate, ent.ScimTokenCreateBulk,
+		ent.ScimTokenUpdate, ent.ScimTokenUpdateOne,
+		ent.ScimTokenDelete,
+		predicate.ScimToken,
+		authenticationV1.ScimToken, ent.ScimToken,
+	]
+}
+
+func NewScimTokenRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ScimTokenRepo {
+	repo := &ScimTokenRepo{
+		log:       ctx.NewLoggerHelper("scim-token/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[authenticationV1.ScimToken, ent.ScimToken](),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *ScimTokenRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.ScimTokenQuery, ent.ScimTokenSelect,
+		ent.ScimTokenCreate, ent.ScimTokenCreateBulk,
+		ent.ScimTokenUpdate, ent.ScimTokenUpdateOne,
+		ent.ScimTokenDelete,
+		predicate.ScimToken,
+		authenticationV1.ScimToken, ent.ScimToken,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+func (r *ScimTokenRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListScimTokenResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().ScimToken.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &authenticationV1.ListScimTokenResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &authenticationV1.ListScimTokenResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+// Create 创建令牌，返回的令牌只在这里出现一次，数据库中只保存其摘要与前缀
+func (r *ScimTokenRepo) Create(ctx context.Context, tenantID uint32, name string, ttl time.Duration, createdBy uint32) (*authenticationV1.ScimToken, string, error) {
+	if tenantID == 0 {
+		return nil, "", authenticationV1.ErrorBadRequest("tenant is required")
+	}
+	if name == "" {
+		return nil, "", authenticationV1.ErrorBadRequest("name is required")
+	}
+
+	b := make([]byte, scimTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		r.log.Errorf("generate scim token failed: %s", err.Error())
+		return nil, "", authenticationV1.ErrorInternalServerError("generate scim token failed")
+	}
+	token := scimTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	now := time.Now()
+	builder := r.entClient.Client().ScimToken.Create().
+		SetTenantID(tenantID).
+		SetName(name).
+		SetTokenPrefix(token[:scimTokenShownSize]).
+		SetTokenHash(hashInviteCode(token)).
+		SetCreatedBy(createdBy).
+		SetCreatedAt(now)
+	if ttl > 0 {
+		builder.SetExpiresAt(now.Add(ttl))
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsValidationError(err) {
+			return nil, "", authenticationV1.ErrorBadRequest("invalid scim token")
+		}
+		r.log.Errorf("insert scim token failed: %s", err.Error())
+		return nil, "", authenticationV1.ErrorInternalServerError("insert scim token failed")
+	}
+
+	return r.mapper.ToDTO(entity), token, nil
+}
+
+func (r *ScimTokenRepo) Delete(ctx context.Context, id uint32) error {
+	if err := r.entClient.Client().ScimToken.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return authenticationV1.ErrorNotFound("scim token not found")
+		}
+		r.log.Errorf("delete scim token failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("delete scim token failed")
+	}
+	return nil
+}
+
+// Authenticate 校验令牌，返回令牌所属的租户ID
+func (r *ScimTokenRepo) Authenticate(ctx context.Context, token string) (uint32, error) {
+	if token == "" {
+		return 0, authenticationV1.ErrorUnauthorized("scim token is required")
+	}
+
+	ctx = appViewer.NewSystemViewerContext(ctx)
+	now := time.Now()
+
+	entity, err := r.entClient.Client().ScimToken.Query().
+		Where(
+			scimtoken.TokenHashEQ(hashInviteCode(token)),
+			scimtoken.Or(
+				scimtoken.ExpiresAtIsNil(),
+				scimtoken.ExpiresAtGT(now),
+			),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return 0, authenticationV1.ErrorUnauthorized("scim token is invalid or expired")
+	case err != nil:
+		r.log.Errorf("query scim token failed: %s", err.Error())
+		return 0, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+
+	if entity.LastUsedAt == nil || now.Sub(*entity.LastUsedAt) > scimTokenTouchInterval {
+		if err = r.entClient.Client().ScimToken.UpdateOneID(entity.ID).
+			SetLastUsedAt(now).
+			Exec(ctx); err != nil {
+			r.log.Warnf("update last used time of scim token [%d] failed: %s", entity.ID, err.Error())
+		}
+	}
+
+	var tenantID uint32
+	if entity.TenantID != nil {
+		tenantID = *entity.TenantID
+	}
+	return tenantID, nil
+}
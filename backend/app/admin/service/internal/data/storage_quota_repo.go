@@ -0,0 +1,349 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/storagequota"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+)
+
+type StorageQuotaRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper *mapper.CopierMapper[fileV1.StorageQuota, ent.StorageQuota]
+
+	repository *entCrud.Repository[
+		ent.StorageQuotaQuery, ent.StorageQuotaSelect,
+		ent.StorageQuotaCreate, ent.StorageQuotaCreateBulk,
+		ent.StorageQuotaUpdate, ent.StorageQuotaUpdateOne,
+		ent.StorageQuotaDelete,
+		predicate.StorageQuota,
+		fileV1.StorageQuota, ent.StorageQuota,
+	]
+}
+
+func NewStorageQuotaRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *StorageQuotaRepo {
+	repo := &StorageQuotaRepo{
+		log:       ctx.NewLoggerHelper("storage-quota/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[fileV1.StorageQuota, ent.StorageQuota](),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *StorageQuotaRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.StorageQuotaQuery, ent.StorageQuotaSelect,
+		ent.StorageQuotaCreate, ent.StorageQuotaCreateBulk,
+		ent.StorageQuotaUpdate, ent.StorageQuotaUpdateOne,
+		ent.StorageQuotaDelete,
+		predicate.StorageQuota,
+		fileV1.StorageQuota, ent.StorageQuota,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+func (r *StorageQuotaRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector)) (int, error) {
+	builder := r.entClient.Client().StorageQuota.Query()
+	if len(whereCond) != 0 {
+		builder.Modify(whereCond...)
+	}
+
+	count, err := builder.Count(ctx)
+	if err != nil {
+		r.log.Errorf("query count failed: %s", err.Error())
+		return 0, fileV1.ErrorInternalServerError("query count failed")
+	}
+
+	return count, nil
+}
+
+func (r *StorageQuotaRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*fileV1.ListStorageQuotaResponse, error) {
+	if req == nil {
+		return nil, fileV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().StorageQuota.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &fileV1.ListStorageQuotaResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &fileV1.ListStorageQuotaResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *StorageQuotaRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().StorageQuota.Query().
+		Where(storagequota.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, fileV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *StorageQuotaRepo) Get(ctx context.Context, req *fileV1.GetStorageQuotaRequest) (*fileV1.StorageQuota, error) {
+	if req == nil {
+		return nil, fileV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().StorageQuota.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *fileV1.GetStorageQuotaRequest_Id:
+		whereCond = append(whereCond, storagequota.IDEQ(req.GetId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+func (r *StorageQuotaRepo) Create(ctx context.Context, req *fileV1.CreateStorageQuotaRequest) error {
+	if req == nil || req.Data == nil {
+		return fileV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().StorageQuota.Create().
+		SetNillableTenantID(req.Data.TenantId).
+		SetNillableUserID(req.Data.UserId).
+		SetNillableMaxBytes(req.Data.MaxBytes).
+		SetNillableMaxFiles(req.Data.MaxFiles).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if req.Data.Id != nil {
+		builder.SetID(req.GetData().GetId())
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return fileV1.ErrorConflict("storage quota already exists")
+		}
+		r.log.Errorf("insert storage quota failed: %s", err.Error())
+		return fileV1.ErrorInternalServerError("insert storage quota failed")
+	}
+
+	return nil
+}
+
+func (r *StorageQuotaRepo) Update(ctx context.Context, req *fileV1.UpdateStorageQuotaRequest) error {
+	if req == nil || req.Data == nil {
+		return fileV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 如果不存在则创建
+	if req.GetAllowMissing() {
+		exist, err := r.IsExist(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			createReq := &fileV1.CreateStorageQuotaRequest{Data: req.Data}
+			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
+			createReq.Data.UpdatedBy = nil
+			return r.Create(ctx, createReq)
+		}
+	}
+
+	builder := r.entClient.Client().Debug().StorageQuota.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *fileV1.StorageQuota) {
+			builder.
+				SetNillableMaxBytes(req.Data.MaxBytes).
+				SetNillableMaxFiles(req.Data.MaxFiles).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(storagequota.FieldID, req.GetId()))
+		},
+	)
+
+	return err
+}
+
+func (r *StorageQuotaRepo) Delete(ctx context.Context, req *fileV1.DeleteStorageQuotaRequest) error {
+	if req == nil {
+		return fileV1.ErrorBadRequest("invalid parameter")
+	}
+
+	if err := r.entClient.Client().StorageQuota.DeleteOneID(req.GetId()).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fileV1.ErrorNotFound("storage quota not found")
+		}
+
+		r.log.Errorf("delete one data failed: %s", err.Error())
+
+		return fileV1.ErrorInternalServerError("delete failed")
+	}
+
+	return nil
+}
+
+// quotaScopes 返回一次上传需要检查的配额范围：租户整体配额，以及（如有）用户配额
+func quotaScopes(tenantID, userID uint32) []predicate.StorageQuota {
+	scopes := []predicate.StorageQuota{
+		storagequota.And(storagequota.TenantIDEQ(tenantID), storagequota.UserIDEQ(0)),
+	}
+	if userID != 0 {
+		scopes = append(scopes, storagequota.And(storagequota.TenantIDEQ(tenantID), storagequota.UserIDEQ(userID)))
+	}
+	return scopes
+}
+
+// quotaAllows 构造“增加用量后仍不超过配额”的条件，上限为 0 或空时表示不限制
+func quotaAllows(size uint64) predicate.StorageQuota {
+	return func(s *sql.Selector) {
+		maxBytes, usedBytes := s.C(storagequota.FieldMaxBytes), s.C(storagequota.FieldUsedBytes)
+		maxFiles, usedFiles := s.C(storagequota.FieldMaxFiles), s.C(storagequota.FieldUsedFiles)
+
+		s.Where(sql.And(
+			sql.ExprP(fmt.Sprintf("(COALESCE(%s, 0) = 0 OR COALESCE(%s, 0) + ? <= %s)", maxBytes, usedBytes, maxBytes), size),
+			sql.ExprP(fmt.Sprintf("(COALESCE(%s, 0) = 0 OR COALESCE(%s, 0) + 1 <= %s)", maxFiles, usedFiles, maxFiles)),
+		))
+	}
+}
+
+// Check 检查上传 size 字节的文件是否会超出配额，不修改用量
+func (r *StorageQuotaRepo) Check(ctx context.Context, tenantID, userID uint32, size uint64) error {
+	quotas, err := r.entClient.Client().StorageQuota.Query().
+		Where(storagequota.Or(quotaScopes(tenantID, userID)...)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query storage quota failed: %s", err.Error())
+		return fileV1.ErrorInternalServerError("query storage quota failed")
+	}
+
+	for _, q := range quotas {
+		if !quotaAvailable(q, size) {
+			return fileV1.ErrorStorageQuotaExceeded("storage quota exceeded")
+		}
+	}
+
+	return nil
+}
+
+// quotaAvailable 判断配额是否还能容纳一个 size 字节的文件
+func quotaAvailable(q *ent.StorageQuota, size uint64) bool {
+	if maxBytes := trans.Uint64Value(q.MaxBytes); maxBytes > 0 && trans.Uint64Value(q.UsedBytes)+size > maxBytes {
+		return false
+	}
+	if maxFiles := trans.Uint64Value(q.MaxFiles); maxFiles > 0 && trans.Uint64Value(q.UsedFiles)+1 > maxFiles {
+		return false
+	}
+	return true
+}
+
+// ConsumeWithTx 在事务中占用配额：用量按条件原子递增，超出配额时返回错误
+func (r *StorageQuotaRepo) ConsumeWithTx(ctx context.Context, tx *ent.Tx, tenantID, userID uint32, size uint64) error {
+	for _, scope := range quotaScopes(tenantID, userID) {
+		affected, err := tx.StorageQuota.Update().
+			Where(scope, quotaAllows(size)).
+			AddUsedBytes(int64(size)).
+			AddUsedFiles(1).
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			r.log.Errorf("update storage usage failed: %s", err.Error())
+			return fileV1.ErrorInternalServerError("update storage usage failed")
+		}
+		if affected > 0 {
+			continue
+		}
+
+		// 没有更新到记录：要么未设置配额，要么已超出配额
+		exist, err := tx.StorageQuota.Query().Where(scope).Exist(ctx)
+		if err != nil {
+			r.log.Errorf("query storage quota failed: %s", err.Error())
+			return fileV1.ErrorInternalServerError("query storage quota failed")
+		}
+		if exist {
+			return fileV1.ErrorStorageQuotaExceeded("storage quota exceeded")
+		}
+	}
+
+	return nil
+}
+
+// ReleaseWithTx 在事务中释放配额，用量不会减到 0 以下
+func (r *StorageQuotaRepo) ReleaseWithTx(ctx context.Context, tx *ent.Tx, tenantID, userID uint32, size uint64) error {
+	for _, scope := range quotaScopes(tenantID, userID) {
+		if err := tx.StorageQuota.Update().
+			Where(scope).
+			SetUpdatedAt(time.Now()).
+			Modify(func(u *sql.UpdateBuilder) {
+				u.Set(storagequota.FieldUsedBytes, sql.Expr(
+					fmt.Sprintf("CASE WHEN COALESCE(%[1]s, 0) > ? THEN %[1]s - ? ELSE 0 END", u.Quote(storagequota.FieldUsedBytes)),
+					size, size,
+				))
+				u.Set(storagequota.FieldUsedFiles, sql.Expr(
+					fmt.Sprintf("CASE WHEN COALESCE(%[1]s, 0) > 1 THEN %[1]s - 1 ELSE 0 END", u.Quote(storagequota.FieldUsedFiles)),
+				))
+			}).
+			Exec(ctx); err != nil {
+			r.log.Errorf("update storage usage failed: %s", err.Error())
+			return fileV1.ErrorInternalServerError("update storage usage failed")
+		}
+	}
+
+	return nil
+}
+
+// ListAll 获取全部配额记录
+func (r *StorageQuotaRepo) ListAll(ctx context.Context) ([]*ent.StorageQuota, error) {
+	quotas, err := r.entClient.Client().StorageQuota.Query().All(ctx)
+	if err != nil {
+		r.log.Errorf("query storage quota failed: %s", err.Error())
+		return nil, fileV1.ErrorInternalServerError("query storage quota failed")
+	}
+	return quotas, nil
+}
+
+// SetUsage 直接设置配额的已用量，用于按文件表重新计算用量
+func (r *StorageQuotaRepo) SetUsage(ctx context.Context, id uint32, usedBytes, usedFiles uint64) error {
+	if err := r.entClient.Client().StorageQuota.UpdateOneID(id).
+		SetUsedBytes(usedBytes).
+		SetUsedFiles(usedFiles).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx); err != nil {
+		r.log.Errorf("update storage usage failed: %s", err.Error())
+		return fileV1.ErrorInternalServerError("update storage usage failed")
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+)
+
+func TestQuotaAvailable(t *testing.T) {
+	// 未设置上限，不限制
+	assert.True(t, quotaAvailable(&ent.StorageQuota{}, 1<<40))
+
+	q := &ent.StorageQuota{
+		MaxBytes:  trans.Ptr(uint64(100)),
+		MaxFiles:  trans.Ptr(uint64(2)),
+		UsedBytes: trans.Ptr(uint64(60)),
+		UsedFiles: trans.Ptr(uint64(1)),
+	}
+	assert.True(t, quotaAvailable(q, 40))
+	assert.False(t, quotaAvailable(q, 41))
+
+	q.UsedFiles = trans.Ptr(uint64(2))
+	assert.False(t, quotaAvailable(q, 1))
+
+	// 只限制文件数
+	q = &ent.StorageQuota{
+		MaxFiles:  trans.Ptr(uint64(1)),
+		UsedBytes: trans.Ptr(uint64(1 << 30)),
+	}
+	assert.True(t, quotaAvailable(q, 1<<30))
+}
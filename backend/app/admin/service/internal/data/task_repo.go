@@ -134,6 +134,9 @@ func (r *TaskRepo) Get(ctx context.Context, req *taskV1.GetTaskRequest) (*taskV1
 
 	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, adminV1.ErrorNotFound("task not found")
+		}
 		return nil, err
 	}
 
@@ -234,3 +237,23 @@ func (r *TaskRepo) Delete(ctx context.Context, req *taskV1.DeleteTaskRequest) er
 
 	return nil
 }
+
+// SetPaused 设置任务的暂停状态
+func (r *TaskRepo) SetPaused(ctx context.Context, id uint32, paused bool, operatorID uint32) error {
+	err := r.entClient.Client().Task.UpdateOneID(id).
+		SetPaused(paused).
+		SetUpdatedBy(operatorID).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return adminV1.ErrorNotFound("task not found")
+		}
+
+		r.log.Errorf("update task paused failed: %s", err.Error())
+
+		return adminV1.ErrorInternalServerError("update task paused failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+)
+
+type TaskRunRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper          *mapper.CopierMapper[taskV1.TaskRun, ent.TaskRun]
+	statusConverter *mapper.EnumTypeConverter[taskV1.TaskRun_Status, taskrun.Status]
+
+	repository *entCrud.Repository[
+		ent.TaskRunQuery, ent.TaskRunSelect,
+		ent.TaskRunCreate, ent.TaskRunCreateBulk,
+		ent.TaskRunUpdate, ent.TaskRunUpdateOne,
+		ent.TaskRunDelete,
+		predicate.TaskRun,
+		taskV1.TaskRun, ent.TaskRun,
+	]
+}
+
+func NewTaskRunRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *TaskRunRepo {
+	repo := &TaskRunRepo{
+		log:       ctx.NewLoggerHelper("task-run/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[taskV1.TaskRun, ent.TaskRun](),
+		statusConverter: mapper.NewEnumTypeConverter[taskV1.TaskRun_Status, taskrun.Status](
+			taskV1.TaskRun_Status_name, taskV1.TaskRun_Status_value,
+		),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *TaskRunRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.TaskRunQuery, ent.TaskRunSelect,
+		ent.TaskRunCreate, ent.TaskRunCreateBulk,
+		ent.TaskRunUpdate, ent.TaskRunUpdateOne,
+		ent.TaskRunDelete,
+		predicate.TaskRun,
+		taskV1.TaskRun, ent.TaskRun,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
+}
+
+func (r *TaskRunRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListTaskRunResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().TaskRun.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &taskV1.ListTaskRunResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &taskV1.ListTaskRunResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *TaskRunRepo) Get(ctx context.Context, req *taskV1.GetTaskRunRequest) (*taskV1.TaskRun, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().TaskRun.Query()
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), taskrun.IDEQ(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+// Create 记录一次任务执行的开始
+func (r *TaskRunRepo) Create(ctx context.Context, data *taskV1.TaskRun) (*taskV1.TaskRun, error) {
+	if data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().TaskRun.Create().
+		SetNillableTenantID(data.TenantId).
+		SetNillableTaskID(data.TaskId).
+		SetTypeName(data.GetTypeName()).
+		SetNillableQueueTaskID(data.QueueTaskId).
+		SetNillableQueue(data.Queue).
+		SetStatus(taskrun.StatusRunning).
+		SetNillableRetryCount(data.RetryCount).
+		SetNillableMaxRetry(data.MaxRetry).
+		SetStartedAt(data.GetStartedAt().AsTime()).
+		SetCreatedAt(time.Now())
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("insert task run failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("insert task run failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// UpdateProgress 更新执行进度
+func (r *TaskRunRepo) UpdateProgress(ctx context.Context, id uint32, progress uint32, message *string) error {
+	err := r.entClient.Client().TaskRun.UpdateOneID(id).
+		SetProgress(progress).
+		SetNillableMessage(message).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("update task run progress failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("update task run progress failed")
+	}
+	return nil
+}
+
+// Finish 记录一次任务执行的结束
+func (r *TaskRunRepo) Finish(ctx context.Context, data *taskV1.TaskRun) error {
+	if data == nil || data.Id == nil {
+		return adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	err := r.entClient.Client().TaskRun.UpdateOneID(data.GetId()).
+		SetNillableStatus(r.statusConverter.ToEntity(data.Status)).
+		SetNillableProgress(data.Progress).
+		SetNillableMessage(data.Message).
+		SetNillableError(data.Error).
+		SetNillableResult(data.Result).
+		SetFinishedAt(data.GetFinishedAt().AsTime()).
+		SetNillableDurationMs(data.DurationMs).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("finish task run failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("finish task run failed")
+	}
+	return nil
+}
+
+// ListLatestByTaskIDs 查询每个任务最近一次的执行记录
+func (r *TaskRunRepo) ListLatestByTaskIDs(ctx context.Context, taskIDs []uint32) (map[uint32]*taskV1.TaskRun, error) {
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+
+	var latest []struct {
+		TaskID uint32 `json:"task_id"`
+		ID     uint32 `json:"max"`
+	}
+	if err := r.entClient.Client().TaskRun.Query().
+		Where(taskrun.TaskIDIn(taskIDs...)).
+		GroupBy(taskrun.FieldTaskID).
+		Aggregate(ent.Max(taskrun.FieldID)).
+		Scan(ctx, &latest); err != nil {
+		r.log.Errorf("query latest task runs failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query latest task runs failed")
+	}
+	if len(latest) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uint32, 0, len(latest))
+	for _, l := range latest {
+		ids = append(ids, l.ID)
+	}
+
+	entities, err := r.entClient.Client().TaskRun.Query().
+		Where(taskrun.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query latest task runs failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query latest task runs failed")
+	}
+
+	runs := make(map[uint32]*taskV1.TaskRun, len(entities))
+	for _, e := range entities {
+		if e.TaskID != nil {
+			runs[*e.TaskID] = r.mapper.ToDTO(e)
+		}
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,181 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+	"go-wind-admin/app/admin/service/internal/data/ent/userpasswordhistory"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	passwordPolicy "go-wind-admin/pkg/passwordpolicy"
+)
+
+// checkPasswordPolicy 检查新密码是否符合用户所在租户的密码策略。
+// currentHash 为用户当前的密码哈希，没有历史记录的旧账号也不能沿用当前密码。
+func (r *UserCredentialRepo) checkPasswordPolicy(ctx context.Context, tenantID, userID uint32, plainPassword, currentHash string) error {
+	if r.policyRepo == nil {
+		return nil
+	}
+
+	policy, err := r.policyRepo.Resolve(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+
+	failed := r.policyRepo.Validate(policy, plainPassword)
+
+	if limit := policy.HistoryLimit(); limit > 0 && userID > 0 {
+		var reused bool
+		if reused, err = r.isRecentPassword(ctx, userID, plainPassword, currentHash, limit); err != nil {
+			return err
+		}
+		if reused {
+			failed = append(failed, passwordPolicy.RuleHistory)
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return newPasswordPolicyViolation(policy, failed)
+}
+
+// ValidateNewPassword 创建用户前检查初始密码是否符合租户的密码策略，避免用户已创建而凭证创建失败
+func (r *UserCredentialRepo) ValidateNewPassword(ctx context.Context, tenantID uint32, plainPassword string) error {
+	return r.checkPasswordPolicy(ctx, tenantID, 0, plainPassword, "")
+}
+
+// newPasswordPolicyViolation 构造密码策略错误，元数据 rules 为逗号分隔的未通过规则
+func newPasswordPolicyViolation(policy *passwordPolicy.Policy, failed []passwordPolicy.Rule) error {
+	rules := make([]string, 0, len(failed))
+	metadata := map[string]string{}
+	for _, rule := range failed {
+		rules = append(rules, string(rule))
+		switch rule {
+		case passwordPolicy.RuleMinLength:
+			metadata["min_length"] = strconv.Itoa(policy.MinLength)
+		case passwordPolicy.RuleHistory:
+			metadata["history_count"] = strconv.Itoa(policy.HistoryLimit())
+		}
+	}
+	metadata["rules"] = strings.Join(rules, ",")
+
+	return authenticationV1.ErrorPasswordPolicyViolation("password does not meet the password policy: %s", metadata["rules"]).
+		WithMetadata(metadata)
+}
+
+// isRecentPassword 新密码是否与当前密码或最近 limit 个历史密码相同
+func (r *UserCredentialRepo) isRecentPassword(ctx context.Context, userID uint32, plainPassword, currentHash string, limit int) (bool, error) {
+	if currentHash != "" {
+		if ok, _ := r.passwordCrypto.Verify(plainPassword, currentHash); ok {
+			return true, nil
+		}
+	}
+
+	hashes, err := r.entClient.Client().UserPasswordHistory.Query().
+		Where(userpasswordhistory.UserIDEQ(userID)).
+		Order(ent.Desc(userpasswordhistory.FieldID)).
+		Limit(limit).
+		Select(userpasswordhistory.FieldCredential).
+		Strings(appViewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		r.log.Errorf("query password history of user [%d] failed: %s", userID, err.Error())
+		return false, authenticationV1.ErrorInternalServerError("query password history failed")
+	}
+
+	for _, hash := range hashes {
+		if ok, _ := r.passwordCrypto.Verify(plainPassword, hash); ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// recordPasswordHistory 保存新的密码哈希，只保留最近 MaxHistoryCount 个
+func (r *UserCredentialRepo) recordPasswordHistory(ctx context.Context, client *ent.Client, tenantID, userID uint32, hash string) error {
+	if userID == 0 || hash == "" {
+		return nil
+	}
+
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	if err := client.UserPasswordHistory.Create().
+		SetTenantID(tenantID).
+		SetUserID(userID).
+		SetCredential(hash).
+		SetCreatedAt(time.Now()).
+		Exec(ctx); err != nil {
+		r.log.Errorf("insert password history of user [%d] failed: %s", userID, err.Error())
+		return authenticationV1.ErrorInternalServerError("insert password history failed")
+	}
+
+	ids, err := client.UserPasswordHistory.Query().
+		Where(userpasswordhistory.UserIDEQ(userID)).
+		Order(ent.Desc(userpasswordhistory.FieldID)).
+		Offset(passwordPolicy.MaxHistoryCount).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("query password history of user [%d] failed: %s", userID, err.Error())
+		return nil
+	}
+	if len(ids) > 0 {
+		if _, err = client.UserPasswordHistory.Delete().
+			Where(userpasswordhistory.IDIn(ids...)).
+			Exec(ctx); err != nil {
+			r.log.Errorf("prune password history of user [%d] failed: %s", userID, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// IsPasswordExpired 用户名密码是否已超过租户密码策略的最长使用期限。
+// 升级前创建、没有密码修改时间的凭证按创建时间计算。
+func (r *UserCredentialRepo) IsPasswordExpired(ctx context.Context, tenantID uint32, username string) (bool, error) {
+	if r.policyRepo == nil {
+		return false, nil
+	}
+
+	policy, err := r.policyRepo.Resolve(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+	if policy.MaxAge <= 0 {
+		return false, nil
+	}
+
+	entity, err := r.entClient.Client().UserCredential.Query().
+		Select(usercredential.FieldPasswordChangedAt, usercredential.FieldCreatedAt).
+		Where(
+			usercredential.IdentityTypeEQ(usercredential.IdentityTypeUsername),
+			usercredential.IdentifierEQ(username),
+			// 外部身份源用户的密码有效期由外部目录管理
+			usercredential.CredentialTypeEQ(usercredential.CredentialTypePasswordHash),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		r.log.Errorf("query user credential failed: %s", err.Error())
+		return false, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+
+	var changedAt time.Time
+	switch {
+	case entity.PasswordChangedAt != nil:
+		changedAt = *entity.PasswordChangedAt
+	case entity.CreatedAt != nil:
+		changedAt = *entity.CreatedAt
+	}
+
+	return policy.IsExpired(changedAt, time.Now()), nil
+}
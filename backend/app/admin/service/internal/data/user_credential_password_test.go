@@ -0,0 +1,29 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	passwordPolicy "go-wind-admin/pkg/passwordpolicy"
+)
+
+func TestNewPasswordPolicyViolation(t *testing.T) {
+	policy := &passwordPolicy.Policy{MinLength: 12, RequireDigit: true, HistoryCount: 30}
+
+	err := newPasswordPolicyViolation(policy, []passwordPolicy.Rule{
+		passwordPolicy.RuleMinLength,
+		passwordPolicy.RuleDigit,
+		passwordPolicy.RuleHistory,
+	})
+	assert.True(t, authenticationV1.IsPasswordPolicyViolation(err))
+
+	e := errors.FromError(err)
+	assert.Equal(t, "min_length,digit,history", e.Metadata["rules"])
+	assert.Equal(t, "12", e.Metadata["min_length"])
+	// 历史密码数不超过最大保留数
+	assert.Equal(t, "24", e.Metadata["history_count"])
+}
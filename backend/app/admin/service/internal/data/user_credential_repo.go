@@ -36,6 +36,7 @@ type UserCredentialRepo struct {
 	credentialTypeConverter *mapper.EnumTypeConverter[authenticationV1.UserCredential_CredentialType, usercredential.CredentialType]
 
 	passwordCrypto password.Crypto
+	policyRepo     *PasswordPolicyRepo
 
 	repository *entCrud.Repository[
 		ent.UserCredentialQuery, ent.UserCredentialSelect,
@@ -47,11 +48,17 @@ type UserCredentialRepo struct {
 	]
 }
 
-func NewUserCredentialRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], passwordCrypto password.Crypto) *UserCredentialRepo {
+func NewUserCredentialRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	passwordCrypto password.Crypto,
+	policyRepo *PasswordPolicyRepo,
+) *UserCredentialRepo {
 	repo := &UserCredentialRepo{
 		log:                     ctx.NewLoggerHelper("user-credentials/repo/admin-service"),
 		entClient:               entClient,
 		passwordCrypto:          passwordCrypto,
+		policyRepo:              policyRepo,
 		mapper:                  mapper.NewCopierMapper[authenticationV1.UserCredential, ent.UserCredential](),
 		statusConverter:         mapper.NewEnumTypeConverter[authenticationV1.UserCredential_Status, usercredential.Status](authenticationV1.UserCredential_Status_name, authenticationV1.UserCredential_Status_value),
 		identityTypeConverter:   mapper.NewEnumTypeConverter[authenticationV1.UserCredential_IdentityType, usercredential.IdentityType](authenticationV1.UserCredential_IdentityType_name, authenticationV1.UserCredential_IdentityType_value),
@@ -162,6 +169,13 @@ func (r *UserCredentialRepo) CreateWithTx(ctx context.Context, tx *ent.Tx, data
 
 	var err error
 
+	isPassword := data.Credential != nil && isPasswordCredential(data.CredentialType)
+	if isPassword {
+		if err = r.checkPasswordPolicy(ctx, data.GetTenantId(), data.GetUserId(), data.GetCredential(), ""); err != nil {
+			return err
+		}
+	}
+
 	if data.Credential != nil {
 		var newCredential string
 		newCredential, err = r.prepareCredential(r.credentialTypeConverter.ToEntity(data.CredentialType), data.GetCredential())
@@ -186,15 +200,27 @@ func (r *UserCredentialRepo) CreateWithTx(ctx context.Context, tx *ent.Tx, data
 		SetNillableProvider(data.Provider).
 		SetNillableProviderAccountID(data.ProviderAccountId).
 		SetCreatedAt(time.Now())
+	if isPassword {
+		builder.SetPasswordChangedAt(time.Now())
+	}
 
 	if err = builder.Exec(ctx); err != nil {
 		r.log.Errorf("insert user credential failed: %s [%v]", err.Error(), data)
 		return authenticationV1.ErrorInternalServerError("insert user credential failed")
 	}
 
+	if isPassword {
+		return r.recordPasswordHistory(ctx, tx.Client(), data.GetTenantId(), data.GetUserId(), data.GetCredential())
+	}
+
 	return nil
 }
 
+// isPasswordCredential 凭证类型是否为密码，未指定时按数据库默认值即密码处理
+func isPasswordCredential(credentialType *authenticationV1.UserCredential_CredentialType) bool {
+	return credentialType == nil || *credentialType == authenticationV1.UserCredential_PASSWORD_HASH
+}
+
 func (r *UserCredentialRepo) Update(ctx context.Context, req *authenticationV1.UpdateUserCredentialRequest) error {
 	if req == nil || req.Data == nil {
 		return authenticationV1.ErrorBadRequest("invalid request")
@@ -214,6 +240,24 @@ func (r *UserCredentialRepo) Update(ctx context.Context, req *authenticationV1.U
 
 	var err error
 
+	var passwordOwner *ent.UserCredential
+	if req.Data.Credential != nil && isPasswordCredential(req.Data.CredentialType) {
+		if passwordOwner, err = r.entClient.Client().UserCredential.Query().
+			Select(usercredential.FieldTenantID, usercredential.FieldUserID, usercredential.FieldCredential).
+			Where(usercredential.IDEQ(req.GetId())).
+			Only(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return authenticationV1.ErrorNotFound("user credential not found")
+			}
+			r.log.Errorf("query one data failed: %s", err.Error())
+			return authenticationV1.ErrorInternalServerError("query one data failed")
+		}
+
+		if err = r.checkPasswordPolicy(ctx, trans.Uint32Value(passwordOwner.TenantID), trans.Uint32Value(passwordOwner.UserID), req.Data.GetCredential(), trans.StringValue(passwordOwner.Credential)); err != nil {
+			return err
+		}
+	}
+
 	if req.Data.Credential != nil {
 		var newCredential string
 		newCredential, err = r.prepareCredential(r.credentialTypeConverter.ToEntity(req.Data.CredentialType), req.Data.GetCredential())
@@ -243,8 +287,17 @@ func (r *UserCredentialRepo) Update(ctx context.Context, req *authenticationV1.U
 			s.Where(sql.EQ(usercredential.FieldID, req.GetId()))
 		},
 	)
+	if err != nil || passwordOwner == nil {
+		return err
+	}
+
+	if err = r.entClient.Client().UserCredential.UpdateOneID(req.GetId()).
+		SetPasswordChangedAt(time.Now()).
+		Exec(ctx); err != nil {
+		r.log.Errorf("update password changed time failed: %s", err.Error())
+	}
 
-	return err
+	return r.recordPasswordHistory(ctx, r.entClient.Client(), trans.Uint32Value(passwordOwner.TenantID), trans.Uint32Value(passwordOwner.UserID), req.Data.GetCredential())
 }
 
 func (r *UserCredentialRepo) Delete(ctx context.Context, id uint32) error {
@@ -354,21 +407,53 @@ func (r *UserCredentialRepo) GetByIdentifier(ctx context.Context, req *authentic
 	return r.mapper.ToDTO(entity), nil
 }
 
+// DecryptCredential 解密前端传输时加密的密码
+func (r *UserCredentialRepo) DecryptCredential(credential string) (string, error) {
+	bytesPass, err := base64.StdEncoding.DecodeString(credential)
+	if err != nil {
+		r.log.Errorf("decode base64 credential failed: %s", err.Error())
+		return "", authenticationV1.ErrorBadRequest("invalid credential format")
+	}
+	plainPassword, err := crypto.AesDecrypt(bytesPass, crypto.DefaultAESKey, nil)
+	if err != nil {
+		r.log.Errorf("decrypt credential failed: %s", err.Error())
+		return "", authenticationV1.ErrorBadRequest("decrypt credential failed")
+	}
+
+	return string(plainPassword), nil
+}
+
+// GetExternalCredential 查询外部身份源的凭证，凭证不存在或不是外部身份源时返回 nil
+func (r *UserCredentialRepo) GetExternalCredential(ctx context.Context, identityType authenticationV1.UserCredential_IdentityType, identifier string) (*authenticationV1.UserCredential, error) {
+	entity, err := r.entClient.Client().UserCredential.Query().
+		Where(
+			usercredential.IdentityTypeEQ(*r.identityTypeConverter.ToEntity(trans.Ptr(identityType))),
+			usercredential.IdentifierEQ(identifier),
+			usercredential.CredentialTypeEQ(usercredential.CredentialTypeExternal),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		r.log.Errorf("query external credential failed: %s", err.Error())
+
+		return nil, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
 func (r *UserCredentialRepo) VerifyCredential(ctx context.Context, req *authenticationV1.VerifyCredentialRequest) (*authenticationV1.VerifyCredentialResponse, error) {
 	if req.GetNeedDecrypt() {
 		// 解密密码
-		bytesPass, err := base64.StdEncoding.DecodeString(req.GetCredential())
-		if err != nil {
-			r.log.Errorf("decode base64 credential failed: %s", err.Error())
-			return nil, authenticationV1.ErrorBadRequest("invalid credential format")
-		}
-		plainPassword, err := crypto.AesDecrypt(bytesPass, crypto.DefaultAESKey, nil)
+		plainPassword, err := r.DecryptCredential(req.GetCredential())
 		if err != nil {
-			r.log.Errorf("decrypt credential failed: %s", err.Error())
-			return nil, authenticationV1.ErrorBadRequest("decrypt credential failed")
+			return nil, err
 		}
 
-		req.Credential = string(plainPassword)
+		req.Credential = plainPassword
 	}
 
 	entity, err := r.entClient.Client().UserCredential.Query().
@@ -392,7 +477,7 @@ func (r *UserCredentialRepo) VerifyCredential(ctx context.Context, req *authenti
 		return nil, authenticationV1.ErrorUserFreeze("account has freeze")
 	}
 
-	if !r.verifyCredential(entity.CredentialType, req.GetCredential(), *entity.Credential) {
+	if !r.verifyCredential(entity.CredentialType, req.GetCredential(), trans.StringValue(entity.Credential)) {
 		return nil, authenticationV1.ErrorIncorrectPassword("incorrect password")
 	}
 
@@ -414,6 +499,9 @@ func (r *UserCredentialRepo) verifyCredential(credentialType *usercredential.Cre
 			return false
 		}
 		return ok
+	case usercredential.CredentialTypeExternal:
+		// 外部身份源的密码只能由外部目录校验
+		return false
 	default:
 		return plainCredential == targetCredential
 	}
@@ -456,6 +544,8 @@ func (r *UserCredentialRepo) ChangeCredential(ctx context.Context, req *authenti
 		Select(
 			usercredential.FieldCredentialType,
 			usercredential.FieldCredential,
+			usercredential.FieldTenantID,
+			usercredential.FieldUserID,
 		).
 		Where(
 			usercredential.IdentityTypeEQ(*r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType()))),
@@ -470,14 +560,24 @@ func (r *UserCredentialRepo) ChangeCredential(ctx context.Context, req *authenti
 	if entity.CredentialType == nil {
 		return authenticationV1.ErrorNotFound("user credential not found")
 	}
+	if *entity.CredentialType == usercredential.CredentialTypeExternal {
+		return authenticationV1.ErrorForbidden("password is managed by external identity source")
+	}
 
 	// 验证旧认证信息
-	if !r.verifyCredential(entity.CredentialType, req.GetOldCredential(), *entity.Credential) {
+	if !r.verifyCredential(entity.CredentialType, req.GetOldCredential(), trans.StringValue(entity.Credential)) {
 		return authenticationV1.ErrorBadRequest("invalid old password")
 	}
 
+	isPassword := *entity.CredentialType == usercredential.CredentialTypePasswordHash
+	if isPassword {
+		if err = r.checkPasswordPolicy(ctx, trans.Uint32Value(entity.TenantID), trans.Uint32Value(entity.UserID), req.GetNewCredential(), *entity.Credential); err != nil {
+			return err
+		}
+	}
+
 	var newCredential string
-	newCredential, err = r.prepareCredential(entity.CredentialType, req.GetOldCredential())
+	newCredential, err = r.prepareCredential(entity.CredentialType, req.GetNewCredential())
 	if err != nil {
 		r.log.Errorf("prepare new credential failed: %s", err.Error())
 		return authenticationV1.ErrorBadRequest("prepare new credential failed")
@@ -495,11 +595,18 @@ func (r *UserCredentialRepo) ChangeCredential(ctx context.Context, req *authenti
 	builder.
 		SetCredential(newCredential).
 		SetUpdatedAt(time.Now())
+	if isPassword {
+		builder.SetPasswordChangedAt(time.Now())
+	}
 	if err = builder.Exec(ctx); err != nil {
 		r.log.Errorf("update one data failed: %s", err.Error())
 		return authenticationV1.ErrorInternalServerError("update data failed")
 	}
 
+	if isPassword {
+		return r.recordPasswordHistory(ctx, r.entClient.Client(), trans.Uint32Value(entity.TenantID), trans.Uint32Value(entity.UserID), newCredential)
+	}
+
 	return nil
 }
 
@@ -512,17 +619,37 @@ func (r *UserCredentialRepo) ResetCredential(ctx context.Context, req *authentic
 		req.NewCredential = string(plainPassword)
 	}
 
+	return r.resetCredential(ctx, req.GetNewCredential(),
+		usercredential.IdentityTypeEQ(*r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType()))),
+		usercredential.IdentifierEQ(req.GetIdentifier()),
+	)
+}
+
+// ResetUserPassword 按租户与用户重置用户名登录的密码，用户名只在租户内唯一
+func (r *UserCredentialRepo) ResetUserPassword(ctx context.Context, tenantID, userID uint32, newPassword string) error {
+	return r.resetCredential(ctx, newPassword,
+		usercredential.TenantIDEQ(tenantID),
+		usercredential.UserIDEQ(userID),
+		usercredential.IdentityTypeEQ(usercredential.IdentityTypeUsername),
+	)
+}
+
+func (r *UserCredentialRepo) resetCredential(ctx context.Context, plainCredential string, ps ...predicate.UserCredential) error {
 	entity, err := r.entClient.Client().UserCredential.
 		Query().
 		Select(
+			usercredential.FieldID,
 			usercredential.FieldCredentialType,
+			usercredential.FieldCredential,
+			usercredential.FieldTenantID,
+			usercredential.FieldUserID,
 		).
-		Where(
-			usercredential.IdentityTypeEQ(*r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType()))),
-			usercredential.IdentifierEQ(req.GetIdentifier()),
-		).
+		Where(ps...).
 		Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return authenticationV1.ErrorNotFound("user credential not found")
+		}
 		r.log.Errorf("query one data failed: %s", err.Error())
 		return authenticationV1.ErrorInternalServerError("query one data failed")
 	}
@@ -530,9 +657,19 @@ func (r *UserCredentialRepo) ResetCredential(ctx context.Context, req *authentic
 	if entity.CredentialType == nil {
 		return authenticationV1.ErrorNotFound("user credential not found")
 	}
+	if *entity.CredentialType == usercredential.CredentialTypeExternal {
+		return authenticationV1.ErrorForbidden("password is managed by external identity source")
+	}
+
+	isPassword := *entity.CredentialType == usercredential.CredentialTypePasswordHash
+	if isPassword {
+		if err = r.checkPasswordPolicy(ctx, trans.Uint32Value(entity.TenantID), trans.Uint32Value(entity.UserID), plainCredential, trans.StringValue(entity.Credential)); err != nil {
+			return err
+		}
+	}
 
 	var newCredential string
-	newCredential, err = r.prepareCredential(entity.CredentialType, req.GetNewCredential())
+	newCredential, err = r.prepareCredential(entity.CredentialType, plainCredential)
 	if err != nil {
 		r.log.Errorf("prepare new credential failed: %s", err.Error())
 		return authenticationV1.ErrorBadRequest("prepare new credential failed")
@@ -542,18 +679,20 @@ func (r *UserCredentialRepo) ResetCredential(ctx context.Context, req *authentic
 		return authenticationV1.ErrorBadRequest("new credential cannot be empty")
 	}
 
-	builder := r.entClient.Client().UserCredential.Update()
-	builder.Where(
-		usercredential.IdentityTypeEQ(*r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType()))),
-		usercredential.IdentifierEQ(req.GetIdentifier()),
-	)
-	builder.
+	builder := r.entClient.Client().UserCredential.UpdateOneID(entity.ID).
 		SetCredential(newCredential).
 		SetUpdatedAt(time.Now())
+	if isPassword {
+		builder.SetPasswordChangedAt(time.Now())
+	}
 	if err = builder.Exec(ctx); err != nil {
 		r.log.Errorf("update one data failed: %s", err.Error())
 		return authenticationV1.ErrorInternalServerError("update data failed")
 	}
 
+	if isPassword {
+		return r.recordPasswordHistory(ctx, r.entClient.Client(), trans.Uint32Value(entity.TenantID), trans.Uint32Value(entity.UserID), newCredential)
+	}
+
 	return nil
 }
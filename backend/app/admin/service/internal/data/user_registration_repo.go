@@ -0,0 +1,135 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+)
+
+// UserRegistrationRepo 自助注册，在一个事务中创建用户、成员关系与默认角色、登录凭证并核销邀请
+type UserRegistrationRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	userRepo           UserRepo
+	userCredentialRepo *UserCredentialRepo
+	inviteRepo         *RegistrationInviteRepo
+}
+
+func NewUserRegistrationRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	userRepo UserRepo,
+	userCredentialRepo *UserCredentialRepo,
+	inviteRepo *RegistrationInviteRepo,
+) *UserRegistrationRepo {
+	return &UserRegistrationRepo{
+		log:                ctx.NewLoggerHelper("user-registration/repo/admin-service"),
+		entClient:          entClient,
+		userRepo:           userRepo,
+		userCredentialRepo: userCredentialRepo,
+		inviteRepo:         inviteRepo,
+	}
+}
+
+// Register 注册用户，inviteID 为 0 时不核销邀请。任何一步失败都会回滚，不会留下没有凭证的用户。
+func (r *UserRegistrationRepo) Register(ctx context.Context, data *userV1.User, password string, inviteID uint32) (dto *userV1.User, err error) {
+	if data == nil || data.GetUsername() == "" || password == "" {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = authenticationV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	if err = r.checkConflict(ctx, tx, data); err != nil {
+		return nil, err
+	}
+
+	if dto, err = r.userRepo.CreateWithTx(ctx, tx, data); err != nil {
+		return nil, err
+	}
+
+	if err = r.userCredentialRepo.CreateWithTx(ctx, tx, &authenticationV1.UserCredential{
+		UserId:   dto.Id,
+		TenantId: dto.TenantId,
+
+		IdentityType: authenticationV1.UserCredential_USERNAME.Enum(),
+		Identifier:   dto.Username,
+
+		CredentialType: authenticationV1.UserCredential_PASSWORD_HASH.Enum(),
+		Credential:     trans.Ptr(password),
+
+		IsPrimary: trans.Ptr(true),
+		Status:    authenticationV1.UserCredential_ENABLED.Enum(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if inviteID != 0 {
+		if err = r.inviteRepo.ConsumeWithTx(ctx, tx, inviteID, dto.GetId()); err != nil {
+			return nil, err
+		}
+	}
+
+	return dto, nil
+}
+
+// checkConflict 用户名全局唯一（登录时不区分租户），邮箱在租户内唯一
+func (r *UserRegistrationRepo) checkConflict(ctx context.Context, tx *ent.Tx, data *userV1.User) error {
+	exist, err := tx.User.Query().
+		Where(user.UsernameEQ(data.GetUsername())).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query username exist failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("query user failed")
+	}
+	if exist {
+		return authenticationV1.ErrorConflict("username already exists")
+	}
+
+	if data.GetEmail() == "" {
+		return nil
+	}
+
+	exist, err = tx.User.Query().
+		Where(
+			user.TenantIDEQ(data.GetTenantId()),
+			user.EmailEQ(data.GetEmail()),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query email exist failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("query user failed")
+	}
+	if exist {
+		return authenticationV1.ErrorConflict("email already registered")
+	}
+
+	return nil
+}
@@ -459,6 +459,10 @@ func (r *userRepo) Get(ctx context.Context, req *userV1.GetUserRequest) (*userV1
 		whereCond = append(whereCond, user.IDEQ(req.GetId()))
 	case *userV1.GetUserRequest_Username:
 		whereCond = append(whereCond, user.UsernameEQ(req.GetUsername()))
+	case *userV1.GetUserRequest_Email:
+		whereCond = append(whereCond, user.EmailEQ(req.GetEmail()))
+	case *userV1.GetUserRequest_Mobile:
+		whereCond = append(whereCond, user.MobileEQ(req.GetMobile()))
 	default:
 		whereCond = append(whereCond, user.IDEQ(req.GetId()))
 	}
@@ -526,6 +530,7 @@ func (r *userRepo) CreateWithTx(ctx context.Context, tx *ent.Tx, data *userV1.Us
 		SetNillableAddress(data.Address).
 		SetNillableDescription(data.Description).
 		SetNillableRemark(data.Remark).
+		SetNillableExternalID(data.ExternalId).
 		SetNillableLastLoginAt(timeutil.TimestamppbToTime(data.LastLoginAt)).
 		SetNillableLockedUntil(timeutil.TimestamppbToTime(data.LockedUntil)).
 		SetNillableLastLoginIP(data.LastLoginIp).
@@ -634,6 +639,7 @@ func (r *userRepo) Update(ctx context.Context, req *userV1.UpdateUserRequest) (e
 				SetNillableAddress(req.Data.Address).
 				SetNillableDescription(req.Data.Description).
 				SetNillableRemark(req.Data.Remark).
+				SetNillableExternalID(req.Data.ExternalId).
 				SetNillableLastLoginAt(timeutil.TimestamppbToTime(req.Data.LastLoginAt)).
 				SetNillableLockedUntil(timeutil.TimestamppbToTime(req.Data.LockedUntil)).
 				SetNillableLastLoginIP(req.Data.LastLoginIp).
@@ -785,6 +791,10 @@ func (r *userRepo) UserExists(ctx context.Context, req *userV1.UserExistsRequest
 		builder.Where(user.IDEQ(req.GetId()))
 	case *userV1.UserExistsRequest_Username:
 		builder.Where(user.UsernameEQ(req.GetUsername()))
+	case *userV1.UserExistsRequest_Email:
+		builder.Where(user.EmailEQ(req.GetEmail()))
+	case *userV1.UserExistsRequest_Mobile:
+		builder.Where(user.MobileEQ(req.GetMobile()))
 	default:
 		return &userV1.UserExistsResponse{
 			Exist: false,
@@ -84,7 +84,7 @@ func (r *UserTokenCacheRepo) GenerateToken(
 	}
 
 	// 创建刷新令牌
-	if refreshToken, err = r.GenerateRefreshToken(ctx, tokenPayload.UserId); refreshToken == "" {
+	if refreshToken, err = r.GenerateRefreshToken(ctx, tokenPayload); refreshToken == "" {
 		err = errors.New("create refresh token failed")
 		return
 	}
@@ -97,26 +97,50 @@ func (r *UserTokenCacheRepo) GenerateAccessToken(
 	ctx context.Context,
 	tokenPayload *authenticationV1.UserTokenPayload,
 ) (accessToken string, err error) {
-	if accessToken = r.newAccessJwtToken(tokenPayload); accessToken == "" {
+	if accessToken = r.newAccessJwtToken(tokenPayload, r.accessTokenExpires); accessToken == "" {
 		err = errors.New("create access token failed")
 		return
 	}
 
-	if err = r.setAccessTokenToRedis(ctx, tokenPayload.GetUserId(), accessToken, r.accessTokenExpires); err != nil {
+	if err = r.setAccessTokenToRedis(ctx, tokenPayload.GetUserId(), accessToken, tokenPayload.GetSessionId(), r.accessTokenExpires); err != nil {
 		return
 	}
 
 	return
 }
 
-// GenerateRefreshToken 创建刷新令牌
-func (r *UserTokenCacheRepo) GenerateRefreshToken(ctx context.Context, userID uint32) (refreshToken string, err error) {
+// GenerateClientAccessToken 创建客户端访问令牌。客户端没有用户ID，令牌不写入缓存，也没有刷新令牌，过期后重新换取
+func (r *UserTokenCacheRepo) GenerateClientAccessToken(
+	tokenPayload *authenticationV1.UserTokenPayload,
+	expires time.Duration,
+) (string, error) {
+	if expires <= 0 {
+		expires = r.accessTokenExpires
+	}
+
+	accessToken := r.newAccessJwtToken(tokenPayload, expires)
+	if accessToken == "" {
+		return "", errors.New("create access token failed")
+	}
+
+	return accessToken, nil
+}
+
+// GenerateRefreshToken 创建刷新令牌，令牌归属于载体中的会话，并绑定签发时的客户端和设备
+func (r *UserTokenCacheRepo) GenerateRefreshToken(
+	ctx context.Context,
+	tokenPayload *authenticationV1.UserTokenPayload,
+) (refreshToken string, err error) {
 	if refreshToken = r.newRefreshToken(); refreshToken == "" {
 		err = errors.New("create refresh token failed")
 		return
 	}
 
-	if err = r.setRefreshTokenToRedis(ctx, userID, refreshToken, r.refreshTokenExpires); err != nil {
+	if err = r.setRefreshTokenToRedis(ctx, tokenPayload.GetUserId(), refreshToken, tokenPayload.GetSessionId(), r.refreshTokenExpires); err != nil {
+		return
+	}
+
+	if err = r.setRefreshTokenIndex(ctx, refreshToken, tokenPayload); err != nil {
 		return
 	}
 
@@ -126,7 +150,7 @@ func (r *UserTokenCacheRepo) GenerateRefreshToken(ctx context.Context, userID ui
 // AddBlockedAccessToken 添加被阻止的访问令牌
 func (r *UserTokenCacheRepo) AddBlockedAccessToken(ctx context.Context, userId uint32, accessToken string, expires time.Duration) error {
 	key := r.makeAccessTokenBlockerKey(userId)
-	return r.set(ctx, key, accessToken, expires)
+	return r.set(ctx, key, accessToken, "", expires)
 }
 
 // GetAccessTokens 获取访问令牌列表
@@ -141,9 +165,13 @@ func (r *UserTokenCacheRepo) GetRefreshTokens(ctx context.Context, userId uint32
 	return r.get(ctx, key)
 }
 
-// RemoveToken 移除所有令牌
+// RemoveToken 移除所有令牌及会话
 func (r *UserTokenCacheRepo) RemoveToken(ctx context.Context, userId uint32) error {
 	var err error
+	if err = r.del(ctx, r.makeSessionKey(userId)); err != nil {
+		r.log.Errorf("remove user sessions failed: [%v]", err)
+	}
+
 	if err = r.deleteAccessTokenFromRedis(ctx, userId); err != nil {
 		r.log.Errorf("remove user access token failed: [%v]", err)
 	}
@@ -163,6 +191,10 @@ func (r *UserTokenCacheRepo) RemoveAccessToken(ctx context.Context, userId uint3
 
 // RemoveRefreshToken 刷新令牌
 func (r *UserTokenCacheRepo) RemoveRefreshToken(ctx context.Context, userId uint32, refreshToken string) error {
+	if err := r.deleteRefreshTokenIndex(ctx, refreshToken); err != nil {
+		return err
+	}
+
 	key := r.makeRefreshTokenKey(userId)
 	return r.delField(ctx, key, refreshToken)
 }
@@ -191,16 +223,16 @@ func (r *UserTokenCacheRepo) IsBlockedAccessToken(ctx context.Context, userId ui
 	return r.exists(ctx, key, accessToken)
 }
 
-// setAccessTokenToRedis 设置访问令牌
-func (r *UserTokenCacheRepo) setAccessTokenToRedis(ctx context.Context, userId uint32, token string, expires time.Duration) error {
+// setAccessTokenToRedis 设置访问令牌，字段值为令牌所属的会话ID
+func (r *UserTokenCacheRepo) setAccessTokenToRedis(ctx context.Context, userId uint32, token, sessionID string, expires time.Duration) error {
 	key := r.makeAccessTokenKey(userId)
-	return r.set(ctx, key, token, expires)
+	return r.set(ctx, key, token, sessionID, expires)
 }
 
 // set 设置字段
-func (r *UserTokenCacheRepo) set(ctx context.Context, key string, token string, expires time.Duration) error {
+func (r *UserTokenCacheRepo) set(ctx context.Context, key string, token, value string, expires time.Duration) error {
 	var err error
-	if err = r.rdb.HSet(ctx, key, token, "").Err(); err != nil {
+	if err = r.rdb.HSet(ctx, key, token, value).Err(); err != nil {
 		return err
 	}
 
@@ -253,23 +285,33 @@ func (r *UserTokenCacheRepo) deleteAccessTokenFromRedis(ctx context.Context, use
 	return r.del(ctx, key)
 }
 
-// setRefreshTokenToRedis 设置刷新令牌
-func (r *UserTokenCacheRepo) setRefreshTokenToRedis(ctx context.Context, userId uint32, token string, expires time.Duration) error {
+// setRefreshTokenToRedis 设置刷新令牌，字段值为令牌所属的会话ID
+func (r *UserTokenCacheRepo) setRefreshTokenToRedis(ctx context.Context, userId uint32, token, sessionID string, expires time.Duration) error {
 	key := r.makeRefreshTokenKey(userId)
-	return r.set(ctx, key, token, expires)
+	return r.set(ctx, key, token, sessionID, expires)
 }
 
 // deleteRefreshTokenFromRedis 删除刷新令牌
 func (r *UserTokenCacheRepo) deleteRefreshTokenFromRedis(ctx context.Context, userId uint32) error {
 	key := r.makeRefreshTokenKey(userId)
+
+	tokens, err := r.rdb.HKeys(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	if err = r.deleteRefreshTokenIndex(ctx, tokens...); err != nil {
+		return err
+	}
+
 	return r.del(ctx, key)
 }
 
 // newAccessJwtToken 生成JWT访问令牌
 func (r *UserTokenCacheRepo) newAccessJwtToken(
 	tokenPayload *authenticationV1.UserTokenPayload,
+	expires time.Duration,
 ) string {
-	expTime := time.Now().Add(r.accessTokenExpires)
+	expTime := time.Now().Add(expires)
 	authClaims := jwt.NewUserTokenAuthClaims(tokenPayload, &expTime)
 
 	signedToken, err := r.authenticator.CreateIdentity(*authClaims)
@@ -300,3 +342,8 @@ func (r *UserTokenCacheRepo) makeRefreshTokenKey(userId uint32) string {
 func (r *UserTokenCacheRepo) makeAccessTokenBlockerKey(userId uint32) string {
 	return fmt.Sprintf("%sblocker:%d", r.accessTokenKeyPrefix, userId)
 }
+
+// makeSessionKey 生成会话键
+func (r *UserTokenCacheRepo) makeSessionKey(userId uint32) string {
+	return fmt.Sprintf("%ssession:%d", r.accessTokenKeyPrefix, userId)
+}
@@ -32,7 +32,7 @@ func TestUserTokenCache(t *testing.T) {
 	}
 	bctx := bootstrap.NewContextWithParam(ctx, &conf.AppInfo{}, cfg, l)
 
-	authenticator := NewAuthenticator(bctx)
+	authenticator := NewAuthenticator(bctx, nil, nil)
 	assert.NotNil(t, authenticator)
 
 	rdb, _, _ := NewRedisClient(bctx)
@@ -44,7 +44,7 @@ func TestUserTokenCache(t *testing.T) {
 	var userId uint32 = 0
 	var err error
 
-	err = repo.setAccessTokenToRedis(ctx, userId, "access_token", 0)
+	err = repo.setAccessTokenToRedis(ctx, userId, "access_token", "", 0)
 	assert.Nil(t, err)
 	exist := repo.IsExistAccessToken(ctx, userId, "access_token")
 	assert.True(t, exist)
@@ -54,7 +54,7 @@ func TestUserTokenCache(t *testing.T) {
 	err = repo.RemoveAccessToken(ctx, userId, "access_token")
 	assert.Nil(t, err)
 
-	err = repo.setRefreshTokenToRedis(ctx, userId, "refresh_token", 0)
+	err = repo.setRefreshTokenToRedis(ctx, userId, "refresh_token", "", 0)
 	assert.Nil(t, err)
 	exist = repo.IsExistRefreshToken(ctx, userId, "refresh_token")
 	assert.True(t, exist)
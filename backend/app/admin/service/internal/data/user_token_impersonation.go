@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tx7do/go-utils/trans"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+// DefaultImpersonationTokenExpires 模拟登录令牌的默认有效期
+const DefaultImpersonationTokenExpires = 15 * time.Minute
+
+// GenerateImpersonationToken 为模拟登录创建会话并签发访问令牌。
+// 模拟登录不签发刷新令牌，会话与访问令牌同时过期，有效期不超过普通访问令牌；
+// 被模拟的用户可以在自己的会话列表中看到并注销该会话。
+func (r *UserTokenCacheRepo) GenerateImpersonationToken(
+	ctx context.Context,
+	tokenPayload *authenticationV1.UserTokenPayload,
+	ip, userAgent string,
+	expires time.Duration,
+) (accessToken string, ttl time.Duration, err error) {
+	actor := tokenPayload.GetActor()
+	if actor == nil {
+		return "", 0, errors.New("impersonation token requires an actor")
+	}
+
+	ttl = expires
+	if ttl <= 0 {
+		ttl = DefaultImpersonationTokenExpires
+	}
+	if r.accessTokenExpires > 0 && ttl > r.accessTokenExpires {
+		ttl = r.accessTokenExpires
+	}
+
+	now := time.Now()
+	rec := &userSessionRecord{
+		ID:               uuid.NewString(),
+		UserID:           tokenPayload.GetUserId(),
+		TenantID:         tokenPayload.GetTenantId(),
+		DeviceID:         tokenPayload.GetDeviceId(),
+		ClientID:         tokenPayload.GetClientId(),
+		ClientType:       int32(authenticationV1.ClientType_admin),
+		IP:               ip,
+		UserAgent:        userAgent,
+		CreatedAt:        now.Unix(),
+		LastSeenAt:       now.Unix(),
+		ExpiresAt:        now.Add(ttl).Unix(),
+		ImpersonatorID:   actor.GetUserId(),
+		ImpersonatorName: actor.GetUsername(),
+	}
+	if err = r.saveSession(ctx, rec); err != nil {
+		return "", 0, err
+	}
+
+	tokenPayload.SessionId = trans.Ptr(rec.ID)
+
+	if accessToken = r.newAccessJwtToken(tokenPayload, ttl); accessToken == "" {
+		return "", 0, errors.New("create access token failed")
+	}
+
+	if err = r.setAccessTokenToRedis(ctx, tokenPayload.GetUserId(), accessToken, rec.ID, ttl); err != nil {
+		return "", 0, err
+	}
+
+	return accessToken, ttl, nil
+}
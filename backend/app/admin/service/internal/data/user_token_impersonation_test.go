@@ -0,0 +1,68 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-authn/engine/jwt"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	appJwt "go-wind-admin/pkg/jwt"
+)
+
+func TestGenerateImpersonationToken(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestSessionRepo(t)
+
+	authenticator, err := jwt.NewAuthenticator(
+		jwt.WithKey([]byte("impersonation-test")),
+		jwt.WithSigningMethod("HS256"),
+	)
+	assert.NoError(t, err)
+	repo.authenticator = authenticator
+
+	// 没有实际操作者的载荷不能签发模拟登录令牌
+	_, _, err = repo.GenerateImpersonationToken(ctx, &authenticationV1.UserTokenPayload{UserId: 7}, "", "", 0)
+	assert.Error(t, err)
+
+	payload := &authenticationV1.UserTokenPayload{
+		UserId:   7,
+		TenantId: trans.Ptr(uint32(2)),
+		Username: trans.Ptr("alice"),
+		ReadOnly: trans.Ptr(true),
+		Actor: &authenticationV1.TokenActor{
+			UserId:   3,
+			Username: trans.Ptr("support"),
+			TenantId: trans.Ptr(uint32(2)),
+		},
+	}
+
+	// 有效期不超过普通访问令牌
+	accessToken, ttl, err := repo.GenerateImpersonationToken(ctx, payload, "10.0.0.1", "", time.Hour)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, accessToken)
+	assert.Equal(t, repo.accessTokenExpires, ttl)
+	assert.NotEmpty(t, payload.GetSessionId())
+	assert.True(t, repo.IsExistAccessToken(ctx, 7, accessToken))
+
+	claims, err := authenticator.AuthenticateToken(accessToken)
+	assert.NoError(t, err)
+	parsed, err := appJwt.NewUserTokenPayloadWithClaims(claims)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), parsed.GetActor().GetUserId())
+	assert.True(t, parsed.GetReadOnly())
+
+	// 被模拟的用户能看到该会话及实际操作者
+	sessions, err := repo.ListSessions(ctx, 7, "")
+	assert.NoError(t, err)
+	assert.Len(t, sessions.Items, 1)
+	assert.Equal(t, uint32(3), sessions.Items[0].GetImpersonatorId())
+	assert.Equal(t, "support", sessions.Items[0].GetImpersonatorName())
+
+	// 没有刷新令牌
+	assert.Empty(t, repo.GetRefreshTokens(ctx, 7))
+}
@@ -0,0 +1,155 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+// RefreshTokenState 刷新令牌的校验结果
+type RefreshTokenState int
+
+const (
+	RefreshTokenInvalid  RefreshTokenState = iota // 不存在或已过期
+	RefreshTokenActive                            // 有效，已被本次刷新消费
+	RefreshTokenReused                            // 已轮换过的令牌被再次使用，可能已泄露
+	RefreshTokenMismatch                          // 客户端或设备与签发时不一致
+)
+
+// RefreshTokenInfo 刷新令牌的归属信息，同一会话内轮换出的刷新令牌属于同一个令牌族
+type RefreshTokenInfo struct {
+	UserID    uint32 `json:"uid"`
+	TenantID  uint32 `json:"tid,omitempty"`
+	SessionID string `json:"sid,omitempty"`
+	ClientID  string `json:"cid,omitempty"`
+	DeviceID  string `json:"did,omitempty"`
+	ExpiresAt int64  `json:"eat"`
+}
+
+// matchClient 刷新时提交的客户端和设备是否与签发时一致
+func (info *RefreshTokenInfo) matchClient(clientID, deviceID string) bool {
+	return info.ClientID == clientID && info.DeviceID == deviceID
+}
+
+// ConsumeRefreshToken 校验并消费刷新令牌。
+// 有效的刷新令牌只能使用一次，使用后在原有效期内保留已轮换标记，再次出现时返回 RefreshTokenReused，
+// 调用方应注销整个令牌族。客户端或设备不一致时不消费令牌。
+func (r *UserTokenCacheRepo) ConsumeRefreshToken(ctx context.Context, refreshToken, clientID, deviceID string) (*RefreshTokenInfo, RefreshTokenState, error) {
+	if refreshToken == "" {
+		return nil, RefreshTokenInvalid, nil
+	}
+
+	indexKey := r.makeRefreshTokenIndexKey(refreshToken)
+
+	info, err := r.getRefreshTokenInfo(ctx, indexKey)
+	if err != nil {
+		return nil, RefreshTokenInvalid, err
+	}
+	if info == nil {
+		return r.checkRotatedRefreshToken(ctx, refreshToken)
+	}
+
+	if !info.matchClient(clientID, deviceID) {
+		return info, RefreshTokenMismatch, nil
+	}
+
+	// GETDEL 保证并发刷新时只有一个请求能消费成功，其余请求按重用处理
+	v, err := r.rdb.GetDel(ctx, indexKey).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return r.checkRotatedRefreshToken(ctx, refreshToken)
+		}
+		return nil, RefreshTokenInvalid, err
+	}
+
+	if ttl := time.Until(time.Unix(info.ExpiresAt, 0)); ttl > 0 {
+		if err = r.rdb.Set(ctx, r.makeRotatedRefreshTokenKey(refreshToken), v, ttl).Err(); err != nil {
+			r.log.Errorf("mark refresh token rotated failed: [%v]", err)
+		}
+	}
+
+	if err = r.delField(ctx, r.makeRefreshTokenKey(info.UserID), refreshToken); err != nil {
+		r.log.Errorf("remove refresh token failed: [%v]", err)
+	}
+
+	return info, RefreshTokenActive, nil
+}
+
+// checkRotatedRefreshToken 检查刷新令牌是否已被轮换过
+func (r *UserTokenCacheRepo) checkRotatedRefreshToken(ctx context.Context, refreshToken string) (*RefreshTokenInfo, RefreshTokenState, error) {
+	info, err := r.getRefreshTokenInfo(ctx, r.makeRotatedRefreshTokenKey(refreshToken))
+	if err != nil {
+		return nil, RefreshTokenInvalid, err
+	}
+	if info == nil {
+		return nil, RefreshTokenInvalid, nil
+	}
+	return info, RefreshTokenReused, nil
+}
+
+// getRefreshTokenInfo 读取刷新令牌归属信息，不存在时返回 nil
+func (r *UserTokenCacheRepo) getRefreshTokenInfo(ctx context.Context, key string) (*RefreshTokenInfo, error) {
+	v, err := r.rdb.Get(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var info RefreshTokenInfo
+	if err = json.Unmarshal([]byte(v), &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// setRefreshTokenIndex 写入刷新令牌索引，刷新时无需访问令牌即可找到令牌归属
+func (r *UserTokenCacheRepo) setRefreshTokenIndex(ctx context.Context, refreshToken string, tokenPayload *authenticationV1.UserTokenPayload) error {
+	info := &RefreshTokenInfo{
+		UserID:    tokenPayload.GetUserId(),
+		TenantID:  tokenPayload.GetTenantId(),
+		SessionID: tokenPayload.GetSessionId(),
+		ClientID:  tokenPayload.GetClientId(),
+		DeviceID:  tokenPayload.GetDeviceId(),
+		ExpiresAt: time.Now().Add(r.refreshTokenExpires).Unix(),
+	}
+
+	buf, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	return r.rdb.Set(ctx, r.makeRefreshTokenIndexKey(refreshToken), buf, r.refreshTokenExpires).Err()
+}
+
+// deleteRefreshTokenIndex 删除刷新令牌索引，已轮换标记保留以便继续检测重放
+func (r *UserTokenCacheRepo) deleteRefreshTokenIndex(ctx context.Context, refreshTokens ...string) error {
+	if len(refreshTokens) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(refreshTokens))
+	for _, token := range refreshTokens {
+		keys = append(keys, r.makeRefreshTokenIndexKey(token))
+	}
+
+	return r.rdb.Del(ctx, keys...).Err()
+}
+
+// makeRefreshTokenIndexKey 生成刷新令牌索引键
+func (r *UserTokenCacheRepo) makeRefreshTokenIndexKey(refreshToken string) string {
+	return fmt.Sprintf("%stoken:%s", r.refreshTokenKeyPrefix, refreshToken)
+}
+
+// makeRotatedRefreshTokenKey 生成已轮换刷新令牌键
+func (r *UserTokenCacheRepo) makeRotatedRefreshTokenKey(refreshToken string) string {
+	return fmt.Sprintf("%srotated:%s", r.refreshTokenKeyPrefix, refreshToken)
+}
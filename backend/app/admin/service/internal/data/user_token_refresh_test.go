@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+func TestConsumeRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestSessionRepo(t)
+
+	payload := &authenticationV1.UserTokenPayload{
+		UserId:   3,
+		TenantId: trans.Ptr(uint32(2)),
+		ClientId: trans.Ptr("web"),
+		DeviceId: trans.Ptr("laptop"),
+	}
+	assert.NoError(t, repo.CreateSession(ctx, payload, authenticationV1.ClientType_admin, "", ""))
+
+	token, err := repo.GenerateRefreshToken(ctx, payload)
+	assert.NoError(t, err)
+
+	// 客户端或设备不一致时不消费令牌
+	_, state, err := repo.ConsumeRefreshToken(ctx, token, "web", "phone")
+	assert.NoError(t, err)
+	assert.Equal(t, RefreshTokenMismatch, state)
+	assert.True(t, repo.IsExistRefreshToken(ctx, 3, token))
+
+	info, state, err := repo.ConsumeRefreshToken(ctx, token, "web", "laptop")
+	assert.NoError(t, err)
+	assert.Equal(t, RefreshTokenActive, state)
+	assert.Equal(t, uint32(3), info.UserID)
+	assert.Equal(t, uint32(2), info.TenantID)
+	assert.Equal(t, payload.GetSessionId(), info.SessionID)
+	assert.False(t, repo.IsExistRefreshToken(ctx, 3, token))
+
+	// 已轮换的令牌再次出现
+	info, state, err = repo.ConsumeRefreshToken(ctx, token, "web", "laptop")
+	assert.NoError(t, err)
+	assert.Equal(t, RefreshTokenReused, state)
+	assert.Equal(t, payload.GetSessionId(), info.SessionID)
+
+	_, state, err = repo.ConsumeRefreshToken(ctx, "unknown", "web", "laptop")
+	assert.NoError(t, err)
+	assert.Equal(t, RefreshTokenInvalid, state)
+
+	// 注销会话后，同一令牌族中尚未使用的刷新令牌也失效
+	next, err := repo.GenerateRefreshToken(ctx, payload)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.RemoveSession(ctx, 3, payload.GetSessionId()))
+	_, state, err = repo.ConsumeRefreshToken(ctx, next, "web", "laptop")
+	assert.NoError(t, err)
+	assert.Equal(t, RefreshTokenInvalid, state)
+}
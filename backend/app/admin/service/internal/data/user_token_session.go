@@ -0,0 +1,313 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mileusna/useragent"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+// sessionTouchInterval 会话最后活跃时间的刷新间隔，避免每个请求都写缓存
+const sessionTouchInterval = time.Minute
+
+// userSessionRecord 缓存中的会话记录
+type userSessionRecord struct {
+	ID         string `json:"id"`
+	UserID     uint32 `json:"uid"`
+	TenantID   uint32 `json:"tid,omitempty"`
+	DeviceID   string `json:"did,omitempty"`
+	ClientID   string `json:"cid,omitempty"`
+	ClientType int32  `json:"ct,omitempty"`
+	IP         string `json:"ip,omitempty"`
+	UserAgent  string `json:"ua,omitempty"`
+	CreatedAt  int64  `json:"cat"`
+	LastSeenAt int64  `json:"lat"`
+	ExpiresAt  int64  `json:"eat"`
+
+	ImpersonatorID   uint32 `json:"iid,omitempty"`
+	ImpersonatorName string `json:"inm,omitempty"`
+}
+
+// toProto 转换为会话信息，并解析用户代理中的浏览器、系统和设备
+func (rec *userSessionRecord) toProto(currentSessionID string) *authenticationV1.UserSession {
+	dto := &authenticationV1.UserSession{
+		Id:         rec.ID,
+		UserId:     rec.UserID,
+		ClientType: trans.Ptr(authenticationV1.ClientType(rec.ClientType)),
+		Current:    rec.ID == currentSessionID,
+		CreatedAt:  timestamppb.New(time.Unix(rec.CreatedAt, 0)),
+		LastSeenAt: timestamppb.New(time.Unix(rec.LastSeenAt, 0)),
+	}
+	if rec.TenantID != 0 {
+		dto.TenantId = trans.Ptr(rec.TenantID)
+	}
+	if rec.DeviceID != "" {
+		dto.DeviceId = trans.Ptr(rec.DeviceID)
+	}
+	if rec.ClientID != "" {
+		dto.ClientId = trans.Ptr(rec.ClientID)
+	}
+	if rec.IP != "" {
+		dto.IpAddress = trans.Ptr(rec.IP)
+	}
+	if rec.ImpersonatorID != 0 {
+		dto.ImpersonatorId = trans.Ptr(rec.ImpersonatorID)
+		dto.ImpersonatorName = trans.Ptr(rec.ImpersonatorName)
+	}
+
+	if rec.UserAgent != "" {
+		ua := useragent.Parse(rec.UserAgent)
+		dto.UserAgent = trans.Ptr(rec.UserAgent)
+		dto.BrowserName = trans.Ptr(ua.Name)
+		dto.OsName = trans.Ptr(ua.OS)
+
+		deviceName := ua.Device
+		if deviceName == "" && ua.Desktop {
+			deviceName = "PC"
+		}
+		dto.DeviceName = trans.Ptr(deviceName)
+	}
+
+	return dto
+}
+
+// CreateSession 创建登录会话，并把会话ID写入令牌载体，之后签发的令牌都归属于该会话
+func (r *UserTokenCacheRepo) CreateSession(
+	ctx context.Context,
+	tokenPayload *authenticationV1.UserTokenPayload,
+	clientType authenticationV1.ClientType,
+	ip, userAgent string,
+) error {
+	now := time.Now()
+	rec := &userSessionRecord{
+		ID:         uuid.NewString(),
+		UserID:     tokenPayload.GetUserId(),
+		TenantID:   tokenPayload.GetTenantId(),
+		DeviceID:   tokenPayload.GetDeviceId(),
+		ClientID:   tokenPayload.GetClientId(),
+		ClientType: int32(clientType),
+		IP:         ip,
+		UserAgent:  userAgent,
+		CreatedAt:  now.Unix(),
+		LastSeenAt: now.Unix(),
+		ExpiresAt:  now.Add(r.refreshTokenExpires).Unix(),
+	}
+
+	if err := r.saveSession(ctx, rec); err != nil {
+		return err
+	}
+
+	tokenPayload.SessionId = trans.Ptr(rec.ID)
+
+	return nil
+}
+
+// RenewSession 刷新令牌时延长会话有效期，会话已被注销时返回 false
+func (r *UserTokenCacheRepo) RenewSession(ctx context.Context, userId uint32, sessionID string) (bool, error) {
+	rec, err := r.getSession(ctx, userId, sessionID)
+	if err != nil {
+		return false, err
+	}
+	if rec == nil {
+		return false, nil
+	}
+
+	now := time.Now()
+	rec.LastSeenAt = now.Unix()
+	rec.ExpiresAt = now.Add(r.refreshTokenExpires).Unix()
+
+	if err = r.saveSession(ctx, rec); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// IsActiveSession 会话是否仍然有效，同时按间隔刷新最后活跃时间
+func (r *UserTokenCacheRepo) IsActiveSession(ctx context.Context, userId uint32, sessionID string) bool {
+	rec, err := r.getSession(ctx, userId, sessionID)
+	if err != nil {
+		r.log.Errorf("get user session failed: [%v]", err)
+		return false
+	}
+	if rec == nil {
+		return false
+	}
+
+	now := time.Now()
+	if now.Sub(time.Unix(rec.LastSeenAt, 0)) >= sessionTouchInterval {
+		rec.LastSeenAt = now.Unix()
+		if err = r.saveSession(ctx, rec); err != nil {
+			r.log.Warnf("touch user session failed: [%v]", err)
+		}
+	}
+
+	return true
+}
+
+// ListSessions 获取用户的会话列表，按最后活跃时间倒序
+func (r *UserTokenCacheRepo) ListSessions(ctx context.Context, userId uint32, currentSessionID string) (*authenticationV1.ListUserSessionResponse, error) {
+	values, err := r.rdb.HGetAll(ctx, r.makeSessionKey(userId)).Result()
+	if err != nil {
+		r.log.Errorf("list user sessions failed: [%v]", err)
+		return nil, authenticationV1.ErrorServiceUnavailable("list user sessions failed")
+	}
+
+	records := make([]*userSessionRecord, 0, len(values))
+	for _, v := range values {
+		var rec userSessionRecord
+		if err = json.Unmarshal([]byte(v), &rec); err != nil {
+			r.log.Warnf("unmarshal user session failed: [%v]", err)
+			continue
+		}
+		records = append(records, &rec)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].LastSeenAt != records[j].LastSeenAt {
+			return records[i].LastSeenAt > records[j].LastSeenAt
+		}
+		return records[i].ID < records[j].ID
+	})
+
+	items := make([]*authenticationV1.UserSession, 0, len(records))
+	for _, rec := range records {
+		items = append(items, rec.toProto(currentSessionID))
+	}
+
+	return &authenticationV1.ListUserSessionResponse{
+		Items: items,
+		Total: uint64(len(items)),
+	}, nil
+}
+
+// RemoveSession 注销指定会话，只删除该会话签发的令牌
+func (r *UserTokenCacheRepo) RemoveSession(ctx context.Context, userId uint32, sessionID string) error {
+	n, err := r.rdb.HDel(ctx, r.makeSessionKey(userId), sessionID).Result()
+	if err != nil {
+		r.log.Errorf("remove user session failed: [%v]", err)
+		return authenticationV1.ErrorServiceUnavailable("remove user session failed")
+	}
+	if n == 0 {
+		return authenticationV1.ErrorNotFound("session not found")
+	}
+
+	return r.removeSessionTokens(ctx, userId, func(sid string) bool {
+		return sid == sessionID
+	})
+}
+
+// RemoveOtherSessions 注销除指定会话以外的全部会话，未归属任何会话的旧令牌一并删除
+func (r *UserTokenCacheRepo) RemoveOtherSessions(ctx context.Context, userId uint32, keepSessionID string) error {
+	key := r.makeSessionKey(userId)
+
+	sessionIDs, err := r.rdb.HKeys(ctx, key).Result()
+	if err != nil {
+		r.log.Errorf("list user sessions failed: [%v]", err)
+		return authenticationV1.ErrorServiceUnavailable("remove user sessions failed")
+	}
+
+	var others []string
+	for _, sid := range sessionIDs {
+		if sid != keepSessionID {
+			others = append(others, sid)
+		}
+	}
+	if len(others) > 0 {
+		if err = r.rdb.HDel(ctx, key, others...).Err(); err != nil {
+			r.log.Errorf("remove user sessions failed: [%v]", err)
+			return authenticationV1.ErrorServiceUnavailable("remove user sessions failed")
+		}
+	}
+
+	return r.removeSessionTokens(ctx, userId, func(sid string) bool {
+		return sid != keepSessionID
+	})
+}
+
+// removeSessionTokens 删除所属会话满足条件的访问令牌和刷新令牌
+func (r *UserTokenCacheRepo) removeSessionTokens(ctx context.Context, userId uint32, match func(sessionID string) bool) error {
+	for _, key := range []string{r.makeAccessTokenKey(userId), r.makeRefreshTokenKey(userId)} {
+		values, err := r.rdb.HGetAll(ctx, key).Result()
+		if err != nil {
+			r.log.Errorf("list user tokens failed: [%v]", err)
+			return authenticationV1.ErrorServiceUnavailable("remove user tokens failed")
+		}
+
+		var tokens []string
+		for token, sid := range values {
+			if match(sid) {
+				tokens = append(tokens, token)
+			}
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		if key == r.makeRefreshTokenKey(userId) {
+			if err = r.deleteRefreshTokenIndex(ctx, tokens...); err != nil {
+				r.log.Errorf("remove refresh token index failed: [%v]", err)
+				return authenticationV1.ErrorServiceUnavailable("remove user tokens failed")
+			}
+		}
+
+		if err = r.rdb.HDel(ctx, key, tokens...).Err(); err != nil {
+			r.log.Errorf("remove user tokens failed: [%v]", err)
+			return authenticationV1.ErrorServiceUnavailable("remove user tokens failed")
+		}
+	}
+
+	return nil
+}
+
+// getSession 获取会话记录，会话不存在时返回 nil
+func (r *UserTokenCacheRepo) getSession(ctx context.Context, userId uint32, sessionID string) (*userSessionRecord, error) {
+	if sessionID == "" {
+		return nil, nil
+	}
+
+	v, err := r.rdb.HGet(ctx, r.makeSessionKey(userId), sessionID).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var rec userSessionRecord
+	if err = json.Unmarshal([]byte(v), &rec); err != nil {
+		return nil, err
+	}
+
+	return &rec, nil
+}
+
+// saveSession 保存会话记录，会话字段与刷新令牌同时过期
+func (r *UserTokenCacheRepo) saveSession(ctx context.Context, rec *userSessionRecord) error {
+	buf, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	key := r.makeSessionKey(rec.UserID)
+	if err = r.rdb.HSet(ctx, key, rec.ID, buf).Err(); err != nil {
+		return err
+	}
+
+	if ttl := time.Until(time.Unix(rec.ExpiresAt, 0)); ttl > 0 {
+		if err = r.rdb.HExpire(ctx, key, ttl, rec.ID).Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+func newTestSessionRepo(t *testing.T) *UserTokenCacheRepo {
+	m := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	return &UserTokenCacheRepo{
+		log:                   log.NewHelper(log.DefaultLogger),
+		rdb:                   rdb,
+		accessTokenKeyPrefix:  "at:",
+		refreshTokenKeyPrefix: "rt:",
+		accessTokenExpires:    time.Minute,
+		refreshTokenExpires:   time.Hour,
+	}
+}
+
+func TestUserSessionRevoke(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestSessionRepo(t)
+
+	const userId uint32 = 7
+	ua := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"
+
+	phone := &authenticationV1.UserTokenPayload{UserId: userId}
+	assert.NoError(t, repo.CreateSession(ctx, phone, authenticationV1.ClientType_app, "10.0.0.1", ua))
+	desktop := &authenticationV1.UserTokenPayload{UserId: userId}
+	assert.NoError(t, repo.CreateSession(ctx, desktop, authenticationV1.ClientType_admin, "10.0.0.2", ""))
+	assert.NotEqual(t, phone.GetSessionId(), desktop.GetSessionId())
+
+	assert.NoError(t, repo.setAccessTokenToRedis(ctx, userId, "phone-at", phone.GetSessionId(), 0))
+	assert.NoError(t, repo.setRefreshTokenToRedis(ctx, userId, "phone-rt", phone.GetSessionId(), 0))
+	assert.NoError(t, repo.setAccessTokenToRedis(ctx, userId, "desktop-at", desktop.GetSessionId(), 0))
+	assert.NoError(t, repo.setRefreshTokenToRedis(ctx, userId, "desktop-rt", desktop.GetSessionId(), 0))
+
+	sessions, err := repo.ListSessions(ctx, userId, phone.GetSessionId())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), sessions.GetTotal())
+	for _, item := range sessions.GetItems() {
+		assert.Equal(t, item.GetId() == phone.GetSessionId(), item.GetCurrent())
+		if item.GetCurrent() {
+			assert.Equal(t, "10.0.0.1", item.GetIpAddress())
+			assert.Equal(t, "iPhone", item.GetDeviceName())
+			assert.Equal(t, authenticationV1.ClientType_app, item.GetClientType())
+		}
+	}
+
+	// 注销手机会话，桌面端不受影响
+	assert.NoError(t, repo.RemoveSession(ctx, userId, phone.GetSessionId()))
+	assert.False(t, repo.IsActiveSession(ctx, userId, phone.GetSessionId()))
+	assert.True(t, repo.IsActiveSession(ctx, userId, desktop.GetSessionId()))
+	assert.False(t, repo.IsExistAccessToken(ctx, userId, "phone-at"))
+	assert.False(t, repo.IsExistRefreshToken(ctx, userId, "phone-rt"))
+	assert.True(t, repo.IsExistAccessToken(ctx, userId, "desktop-at"))
+	assert.True(t, repo.IsExistRefreshToken(ctx, userId, "desktop-rt"))
+
+	ok, err := repo.RenewSession(ctx, userId, phone.GetSessionId())
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	err = repo.RemoveSession(ctx, userId, phone.GetSessionId())
+	assert.True(t, authenticationV1.IsNotFound(err))
+}
+
+func TestUserSessionRemoveOthers(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestSessionRepo(t)
+
+	const userId uint32 = 8
+
+	current := &authenticationV1.UserTokenPayload{UserId: userId}
+	assert.NoError(t, repo.CreateSession(ctx, current, authenticationV1.ClientType_admin, "", ""))
+	other := &authenticationV1.UserTokenPayload{UserId: userId}
+	assert.NoError(t, repo.CreateSession(ctx, other, authenticationV1.ClientType_app, "", ""))
+
+	assert.NoError(t, repo.setAccessTokenToRedis(ctx, userId, "current-at", current.GetSessionId(), 0))
+	assert.NoError(t, repo.setAccessTokenToRedis(ctx, userId, "other-at", other.GetSessionId(), 0))
+	// 升级前签发的令牌没有会话
+	assert.NoError(t, repo.setRefreshTokenToRedis(ctx, userId, "legacy-rt", "", 0))
+
+	assert.NoError(t, repo.RemoveOtherSessions(ctx, userId, current.GetSessionId()))
+
+	assert.True(t, repo.IsActiveSession(ctx, userId, current.GetSessionId()))
+	assert.False(t, repo.IsActiveSession(ctx, userId, other.GetSessionId()))
+	assert.True(t, repo.IsExistAccessToken(ctx, userId, "current-at"))
+	assert.False(t, repo.IsExistAccessToken(ctx, userId, "other-at"))
+	assert.False(t, repo.IsExistRefreshToken(ctx, userId, "legacy-rt"))
+
+	sessions, err := repo.ListSessions(ctx, userId, current.GetSessionId())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), sessions.GetTotal())
+}
@@ -0,0 +1,384 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/notifier"
+)
+
+const (
+	DefaultVerificationCodeTTL         = 10 * time.Minute
+	DefaultVerificationResendInterval  = time.Minute
+	DefaultVerificationMaxSendsPerHour = 5
+	DefaultVerificationMaxAttempts     = 5
+	DefaultVerificationCodeLength      = 6
+
+	verificationKeyPrefix      = "verification:"
+	verificationLinkTokenBytes = 32
+)
+
+// VerificationPurpose 验证码用途，不同用途的验证码互不通用
+type VerificationPurpose string
+
+const (
+	VerificationPurposeBindContact   VerificationPurpose = "bind_contact"   // 绑定手机号码/邮箱
+	VerificationPurposePasswordReset VerificationPurpose = "password_reset" // 重置密码
+	VerificationPurposeRegistration  VerificationPurpose = "registration"   // 激活注册的用户
+)
+
+// VerificationRecord 验证通过的验证码所属的用户
+type VerificationRecord struct {
+	UserID   uint32
+	TenantID uint32
+}
+
+// 校验失败时累加失败次数，达到上限后作废验证码；验证码已过期时不重新创建
+var failVerificationScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return -1
+end
+local n = redis.call("HINCRBY", KEYS[1], "attempts", 1)
+if n >= tonumber(ARGV[1]) then
+	redis.call("DEL", KEYS[1])
+end
+return n`)
+
+// VerificationCodeRepo 验证码，保存在 Redis 中，一次有效，并按目标限制发送频率
+type VerificationCodeRepo struct {
+	log *log.Helper
+
+	rdb      *redis.Client
+	notifier notifier.Notifier
+
+	codeTTL          time.Duration
+	resendInterval   time.Duration
+	maxSendsPerHour  int64
+	maxAttempts      int
+	codeLength       int
+	passwordResetURL string
+}
+
+func NewVerificationCodeRepo(
+	ctx *bootstrap.Context,
+	rdb *redis.Client,
+	n notifier.Notifier,
+	adminCfg *adminConfV1.Bootstrap,
+) *VerificationCodeRepo {
+	cfg := adminCfg.GetVerification()
+
+	repo := &VerificationCodeRepo{
+		log:              ctx.NewLoggerHelper("verification-code/repo/admin-service"),
+		rdb:              rdb,
+		notifier:         n,
+		codeTTL:          DefaultVerificationCodeTTL,
+		resendInterval:   DefaultVerificationResendInterval,
+		maxSendsPerHour:  DefaultVerificationMaxSendsPerHour,
+		maxAttempts:      DefaultVerificationMaxAttempts,
+		codeLength:       DefaultVerificationCodeLength,
+		passwordResetURL: cfg.GetPasswordResetUrl(),
+	}
+
+	if d := cfg.GetCodeTtl().AsDuration(); d > 0 {
+		repo.codeTTL = d
+	}
+	if d := cfg.GetResendInterval().AsDuration(); d > 0 {
+		repo.resendInterval = d
+	}
+	if v := cfg.GetMaxSendsPerHour(); v > 0 {
+		repo.maxSendsPerHour = int64(v)
+	}
+	if v := cfg.GetMaxAttempts(); v > 0 {
+		repo.maxAttempts = int(v)
+	}
+	if v := cfg.GetCodeLength(); v >= 4 && v <= 10 {
+		repo.codeLength = int(v)
+	}
+
+	return repo
+}
+
+// NormalizeVerificationTarget 规范化接收方，邮箱地址不区分大小写
+func NormalizeVerificationTarget(channel notifier.Channel, target string) string {
+	target = strings.TrimSpace(target)
+	if channel == notifier.ChannelEmail {
+		target = strings.ToLower(target)
+	}
+	return target
+}
+
+// Throttle 检查并记录一次发送，同一目标在重发间隔内或超过每小时上限时拒绝。
+// 目标不存在时也应调用，避免通过限流结果推断账号是否存在。
+func (r *VerificationCodeRepo) Throttle(ctx context.Context, purpose VerificationPurpose, channel notifier.Channel, target string) error {
+	target = NormalizeVerificationTarget(channel, target)
+
+	ok, err := r.rdb.SetNX(ctx, r.makeCooldownKey(purpose, channel, target), 1, r.resendInterval).Result()
+	if err != nil {
+		r.log.Errorf("set verification cooldown failed: %s", err.Error())
+		return authenticationV1.ErrorServiceUnavailable("send verification code failed")
+	}
+	if !ok {
+		return authenticationV1.ErrorTooManyRequests("verification code requested too frequently")
+	}
+
+	hourlyKey := r.makeHourlyKey(channel, target)
+	count, err := r.rdb.Incr(ctx, hourlyKey).Result()
+	if err != nil {
+		r.log.Errorf("count verification sends failed: %s", err.Error())
+		return authenticationV1.ErrorServiceUnavailable("send verification code failed")
+	}
+	if count == 1 {
+		_ = r.rdb.Expire(ctx, hourlyKey, time.Hour).Err()
+	}
+	if count > r.maxSendsPerHour {
+		return authenticationV1.ErrorTooManyRequests("verification code requested too many times")
+	}
+
+	return nil
+}
+
+// Send 生成验证码并发送给目标，同一用途与目标之前未使用的验证码作废。
+// 邮件重置密码并配置了重置页面时，邮件中附带包含一次性令牌的链接，令牌与验证码等效。
+func (r *VerificationCodeRepo) Send(ctx context.Context, purpose VerificationPurpose, channel notifier.Channel, target string, userID, tenantID uint32) error {
+	target = NormalizeVerificationTarget(channel, target)
+
+	if err := r.Throttle(ctx, purpose, channel, target); err != nil {
+		return err
+	}
+
+	code, err := randomDigits(r.codeLength)
+	if err != nil {
+		r.log.Errorf("generate verification code failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("generate verification code failed")
+	}
+
+	fields := map[string]any{
+		"code":      hashVerificationCode(purpose, target, code),
+		"user_id":   userID,
+		"tenant_id": tenantID,
+		"attempts":  0,
+	}
+
+	var link string
+	if purpose == VerificationPurposePasswordReset && channel == notifier.ChannelEmail && r.passwordResetURL != "" {
+		var token string
+		if token, err = randomLinkToken(); err != nil {
+			r.log.Errorf("generate verification link token failed: %s", err.Error())
+			return authenticationV1.ErrorInternalServerError("generate verification code failed")
+		}
+		fields["token"] = hashVerificationCode(purpose, target, token)
+		link = buildVerificationLink(r.passwordResetURL, target, token)
+	}
+
+	key := r.makeCodeKey(purpose, channel, target)
+	if _, err = r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, fields)
+		pipe.Expire(ctx, key, r.codeTTL)
+		return nil
+	}); err != nil {
+		r.log.Errorf("save verification code failed: %s", err.Error())
+		return authenticationV1.ErrorServiceUnavailable("send verification code failed")
+	}
+
+	if err = r.notifier.Send(ctx, r.buildMessage(purpose, channel, target, code, link)); err != nil {
+		_ = r.rdb.Del(ctx, key).Err()
+
+		if errors.Is(err, notifier.ErrUnsupportedChannel) || errors.Is(err, notifier.ErrSMSNotConfigured) {
+			return authenticationV1.ErrorNotImplemented("%s verification is not available", channel)
+		}
+		r.log.Errorf("send verification code to [%s] failed: %s", target, err.Error())
+		return authenticationV1.ErrorServiceUnavailable("send verification code failed")
+	}
+
+	return nil
+}
+
+// Verify 校验验证码或链接令牌，通过后验证码立即作废；连续失败达到上限也会作废
+func (r *VerificationCodeRepo) Verify(ctx context.Context, purpose VerificationPurpose, channel notifier.Channel, target, code string) (*VerificationRecord, error) {
+	rec, err := r.Check(ctx, purpose, channel, target, code)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.Consume(ctx, purpose, channel, target); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// Check 校验验证码或链接令牌但不作废，用于操作成功后再调用 Consume；连续失败达到上限会作废
+func (r *VerificationCodeRepo) Check(ctx context.Context, purpose VerificationPurpose, channel notifier.Channel, target, code string) (*VerificationRecord, error) {
+	target = NormalizeVerificationTarget(channel, target)
+	code = strings.TrimSpace(code)
+	if target == "" || code == "" {
+		return nil, authenticationV1.ErrorInvalidVerificationCode("verification code is invalid or expired")
+	}
+
+	key := r.makeCodeKey(purpose, channel, target)
+
+	values, err := r.rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		r.log.Errorf("get verification code failed: %s", err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("verify code failed")
+	}
+	if len(values) == 0 {
+		return nil, authenticationV1.ErrorInvalidVerificationCode("verification code is invalid or expired")
+	}
+
+	hash := hashVerificationCode(purpose, target, code)
+	matched := subtle.ConstantTimeCompare([]byte(hash), []byte(values["code"])) == 1
+	if token := values["token"]; !matched && token != "" {
+		matched = subtle.ConstantTimeCompare([]byte(hash), []byte(token)) == 1
+	}
+
+	if !matched {
+		if err = failVerificationScript.Run(ctx, r.rdb, []string{key}, r.maxAttempts).Err(); err != nil {
+			r.log.Errorf("count verification attempts failed: %s", err.Error())
+		}
+		return nil, authenticationV1.ErrorInvalidVerificationCode("verification code is invalid or expired")
+	}
+
+	userID, _ := strconv.ParseUint(values["user_id"], 10, 32)
+	tenantID, _ := strconv.ParseUint(values["tenant_id"], 10, 32)
+
+	return &VerificationRecord{
+		UserID:   uint32(userID),
+		TenantID: uint32(tenantID),
+	}, nil
+}
+
+// Consume 作废验证码，并发时只有成功删除验证码的请求有效
+func (r *VerificationCodeRepo) Consume(ctx context.Context, purpose VerificationPurpose, channel notifier.Channel, target string) error {
+	target = NormalizeVerificationTarget(channel, target)
+
+	n, err := r.rdb.Del(ctx, r.makeCodeKey(purpose, channel, target)).Result()
+	if err != nil {
+		r.log.Errorf("consume verification code failed: %s", err.Error())
+		return authenticationV1.ErrorServiceUnavailable("verify code failed")
+	}
+	if n == 0 {
+		return authenticationV1.ErrorInvalidVerificationCode("verification code is invalid or expired")
+	}
+	return nil
+}
+
+// Lookup 查询未使用的验证码所属的用户，不校验也不作废验证码，用于在校验前做其他检查
+func (r *VerificationCodeRepo) Lookup(ctx context.Context, purpose VerificationPurpose, channel notifier.Channel, target string) (*VerificationRecord, error) {
+	target = NormalizeVerificationTarget(channel, target)
+
+	values, err := r.rdb.HMGet(ctx, r.makeCodeKey(purpose, channel, target), "user_id", "tenant_id").Result()
+	if err != nil {
+		r.log.Errorf("get verification code failed: %s", err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("verify code failed")
+	}
+	if len(values) != 2 || values[0] == nil {
+		return nil, authenticationV1.ErrorInvalidVerificationCode("verification code is invalid or expired")
+	}
+
+	userID, _ := strconv.ParseUint(fmt.Sprint(values[0]), 10, 32)
+	tenantID, _ := strconv.ParseUint(fmt.Sprint(values[1]), 10, 32)
+
+	return &VerificationRecord{
+		UserID:   uint32(userID),
+		TenantID: uint32(tenantID),
+	}, nil
+}
+
+func (r *VerificationCodeRepo) buildMessage(purpose VerificationPurpose, channel notifier.Channel, target, code, link string) *notifier.Message {
+	minutes := int(r.codeTTL.Minutes())
+	if minutes < 1 {
+		minutes = 1
+	}
+
+	msg := &notifier.Message{
+		Channel: channel,
+		To:      target,
+	}
+
+	switch purpose {
+	case VerificationPurposePasswordReset:
+		msg.Subject = "重置密码"
+		msg.Body = fmt.Sprintf("您正在重置密码，验证码为 %s，%d 分钟内有效。", code, minutes)
+		if link != "" {
+			msg.Body += fmt.Sprintf("\n也可以打开以下链接重置密码（%d 分钟内有效，只能使用一次）：\n%s", minutes, link)
+		}
+	case VerificationPurposeRegistration:
+		msg.Subject = "激活账号"
+		msg.Body = fmt.Sprintf("感谢注册，激活验证码为 %s，%d 分钟内有效。", code, minutes)
+	default:
+		msg.Subject = "验证码"
+		msg.Body = fmt.Sprintf("您正在绑定%s，验证码为 %s，%d 分钟内有效。", target, code, minutes)
+	}
+	msg.Body += "\n如非本人操作，请忽略。"
+
+	return msg
+}
+
+// makeCodeKey 生成验证码键
+func (r *VerificationCodeRepo) makeCodeKey(purpose VerificationPurpose, channel notifier.Channel, target string) string {
+	return fmt.Sprintf("%scode:%s:%s:%s", verificationKeyPrefix, purpose, channel, target)
+}
+
+// makeCooldownKey 生成重发间隔键
+func (r *VerificationCodeRepo) makeCooldownKey(purpose VerificationPurpose, channel notifier.Channel, target string) string {
+	return fmt.Sprintf("%scooldown:%s:%s:%s", verificationKeyPrefix, purpose, channel, target)
+}
+
+// makeHourlyKey 生成每小时发送次数键，各用途共用
+func (r *VerificationCodeRepo) makeHourlyKey(channel notifier.Channel, target string) string {
+	return fmt.Sprintf("%shourly:%s:%s", verificationKeyPrefix, channel, target)
+}
+
+// hashVerificationCode Redis 中只保存验证码的摘要
+func hashVerificationCode(purpose VerificationPurpose, target, code string) string {
+	sum := sha256.Sum256([]byte(string(purpose) + "|" + target + "|" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomDigits(n int) (string, error) {
+	var sb strings.Builder
+	ten := big.NewInt(10)
+	for i := 0; i < n; i++ {
+		d, err := rand.Int(rand.Reader, ten)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(byte('0' + d.Int64()))
+	}
+	return sb.String(), nil
+}
+
+func randomLinkToken() (string, error) {
+	b := make([]byte, verificationLinkTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// buildVerificationLink 在页面地址后追加 email 与 token 参数，兼容 "#/path" 形式的前端路由
+func buildVerificationLink(base, email, token string) string {
+	sep := "?"
+	if strings.Contains(base, "?") {
+		sep = "&"
+	}
+	return base + sep + "email=" + url.QueryEscape(email) + "&token=" + url.QueryEscape(token)
+}
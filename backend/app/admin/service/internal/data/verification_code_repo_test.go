@@ -0,0 +1,163 @@
+package data
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/notifier"
+)
+
+func newTestVerificationCodeRepo(t *testing.T) (*VerificationCodeRepo, *notifier.LogNotifier, *miniredis.Miniredis) {
+	m := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	sink := notifier.NewLogNotifier(nil)
+
+	return &VerificationCodeRepo{
+		log:              log.NewHelper(log.DefaultLogger),
+		rdb:              rdb,
+		notifier:         notifier.NewRouter().Register(notifier.ChannelEmail, sink),
+		codeTTL:          DefaultVerificationCodeTTL,
+		resendInterval:   DefaultVerificationResendInterval,
+		maxSendsPerHour:  2,
+		maxAttempts:      3,
+		codeLength:       DefaultVerificationCodeLength,
+		passwordResetURL: "https://admin.example.com/#/reset-password",
+	}, sink, m
+}
+
+var verificationCodePattern = regexp.MustCompile(`验证码为 (\d+)`)
+
+func lastVerificationCode(t *testing.T, sink *notifier.LogNotifier, to string) string {
+	msg, ok := sink.Last(to)
+	assert.True(t, ok)
+	match := verificationCodePattern.FindStringSubmatch(msg.Body)
+	assert.Len(t, match, 2)
+	return match[1]
+}
+
+func TestVerificationCodeSingleUse(t *testing.T) {
+	ctx := context.Background()
+	repo, sink, _ := newTestVerificationCodeRepo(t)
+
+	const email = "user@example.com"
+	assert.NoError(t, repo.Send(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, " User@Example.com ", 7, 1))
+	code := lastVerificationCode(t, sink, email)
+	assert.Len(t, code, DefaultVerificationCodeLength)
+
+	// 不同用途的验证码不通用
+	_, err := repo.Verify(ctx, VerificationPurposePasswordReset, notifier.ChannelEmail, email, code)
+	assert.True(t, authenticationV1.IsInvalidVerificationCode(err))
+
+	// 查询不会作废验证码
+	pending, err := repo.Lookup(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email)
+	assert.NoError(t, err)
+	assert.Equal(t, &VerificationRecord{UserID: 7, TenantID: 1}, pending)
+
+	rec, err := repo.Verify(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, "USER@example.com", code)
+	assert.NoError(t, err)
+	assert.Equal(t, &VerificationRecord{UserID: 7, TenantID: 1}, rec)
+
+	_, err = repo.Verify(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email, code)
+	assert.True(t, authenticationV1.IsInvalidVerificationCode(err))
+
+	_, err = repo.Lookup(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email)
+	assert.True(t, authenticationV1.IsInvalidVerificationCode(err))
+}
+
+func TestVerificationCodeCheckThenConsume(t *testing.T) {
+	ctx := context.Background()
+	repo, sink, _ := newTestVerificationCodeRepo(t)
+
+	const email = "user@example.com"
+	assert.NoError(t, repo.Send(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email, 7, 1))
+	code := lastVerificationCode(t, sink, email)
+
+	// 校验通过但尚未作废时可以重试
+	for i := 0; i < 2; i++ {
+		rec, err := repo.Check(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email, code)
+		assert.NoError(t, err)
+		assert.Equal(t, &VerificationRecord{UserID: 7, TenantID: 1}, rec)
+	}
+
+	assert.NoError(t, repo.Consume(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, " USER@example.com "))
+
+	_, err := repo.Check(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email, code)
+	assert.True(t, authenticationV1.IsInvalidVerificationCode(err))
+
+	// 并发作废时只有一个请求成功
+	err = repo.Consume(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email)
+	assert.True(t, authenticationV1.IsInvalidVerificationCode(err))
+}
+
+func TestVerificationCodeMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	repo, sink, _ := newTestVerificationCodeRepo(t)
+
+	const email = "user@example.com"
+	assert.NoError(t, repo.Send(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email, 7, 1))
+	code := lastVerificationCode(t, sink, email)
+
+	for i := 0; i < repo.maxAttempts; i++ {
+		_, err := repo.Verify(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email, "wrong")
+		assert.True(t, authenticationV1.IsInvalidVerificationCode(err))
+	}
+
+	// 失败次数达到上限后正确的验证码也已作废
+	_, err := repo.Verify(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email, code)
+	assert.True(t, authenticationV1.IsInvalidVerificationCode(err))
+}
+
+func TestVerificationCodeThrottle(t *testing.T) {
+	ctx := context.Background()
+	repo, _, m := newTestVerificationCodeRepo(t)
+
+	const email = "user@example.com"
+	assert.NoError(t, repo.Send(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email, 7, 1))
+
+	err := repo.Send(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email, 7, 1)
+	assert.True(t, authenticationV1.IsTooManyRequests(err))
+
+	m.FastForward(repo.resendInterval + time.Second)
+	assert.NoError(t, repo.Send(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email, 7, 1))
+
+	// 超过每小时上限
+	m.FastForward(repo.resendInterval + time.Second)
+	err = repo.Send(ctx, VerificationPurposeBindContact, notifier.ChannelEmail, email, 7, 1)
+	assert.True(t, authenticationV1.IsTooManyRequests(err))
+
+	// 不支持的通道
+	err = repo.Send(ctx, VerificationPurposeBindContact, notifier.ChannelSMS, "+8613800000000", 7, 1)
+	assert.True(t, authenticationV1.IsNotImplemented(err))
+}
+
+func TestVerificationPasswordResetLink(t *testing.T) {
+	ctx := context.Background()
+	repo, sink, _ := newTestVerificationCodeRepo(t)
+
+	const email = "user@example.com"
+	assert.NoError(t, repo.Send(ctx, VerificationPurposePasswordReset, notifier.ChannelEmail, email, 7, 1))
+
+	msg, ok := sink.Last(email)
+	assert.True(t, ok)
+
+	prefix := "https://admin.example.com/#/reset-password?email=user%40example.com&token="
+	i := strings.Index(msg.Body, prefix)
+	assert.GreaterOrEqual(t, i, 0)
+	token := strings.Fields(msg.Body[i+len(prefix):])[0]
+
+	rec, err := repo.Verify(ctx, VerificationPurposePasswordReset, notifier.ChannelEmail, email, token)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(7), rec.UserID)
+}
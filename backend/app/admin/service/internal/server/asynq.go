@@ -2,7 +2,6 @@ package server
 
 import (
 	"github.com/go-kratos/kratos/v2/log"
-	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	bootstrapAsynq "github.com/tx7do/kratos-bootstrap/transport/asynq"
@@ -10,35 +9,63 @@ import (
 
 	"go-wind-admin/app/admin/service/internal/service"
 
-	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/lua"
 	"go-wind-admin/pkg/task"
 )
 
 // NewAsynqServer creates a new asynq server.
-func NewAsynqServer(ctx *bootstrap.Context, taskService *service.TaskService) (*asynqServer.Server, error) {
+func NewAsynqServer(
+	ctx *bootstrap.Context,
+	taskService *service.TaskService,
+	storageQuotaService *service.StorageQuotaService,
+	backupService *service.BackupService,
+	ldapSourceService *service.LdapSourceService,
+	luaEngine *lua.Engine, // 脚本在引擎创建时加载，之后才能取到脚本注册的任务处理函数
+) (*asynqServer.Server, func(), error) {
 	cfg := ctx.GetConfig()
 
 	if cfg == nil || cfg.Server == nil || cfg.Server.Asynq == nil {
-		return nil, nil
+		return nil, func() {}, nil
 	}
 
 	srv := bootstrapAsynq.NewAsynqServer(cfg.Server.Asynq)
 
 	taskService.RegisterTaskScheduler(srv)
 
+	if inspector, err := task.NewInspector(cfg.Server.Asynq); err != nil {
+		log.Warnf("create task inspector failed: %s", err.Error())
+	} else {
+		taskService.RegisterTaskInspector(inspector)
+	}
+
 	var err error
 
-	// 注册任务
-	if err = asynqServer.RegisterSubscriber(srv, task.BackupTaskType, taskService.AsyncBackup); err != nil {
+	// 注册任务，每次执行都会记录执行记录
+	if err = service.RegisterTaskHandler(srv, taskService, task.BackupTaskType, backupService.AsyncBackup); err != nil {
+		log.Error(err)
+		return nil, nil, err
+	}
+	if err = service.RegisterTaskHandler(srv, taskService, task.StorageUsageReconcileTaskType, storageQuotaService.AsyncReconcileStorageUsage); err != nil {
+		log.Error(err)
+		return nil, nil, err
+	}
+	if err = service.RegisterTaskHandler(srv, taskService, task.LdapSyncTaskType, ldapSourceService.AsyncLdapSync); err != nil {
+		log.Error(err)
+		return nil, nil, err
+	}
+
+	// 注册脚本中声明的任务处理函数
+	if err = service.RegisterLuaTaskHandlers(srv, taskService, luaEngine); err != nil {
 		log.Error(err)
-		return nil, err
+		return nil, nil, err
 	}
 
-	// 启动所有的任务
-	if _, err = taskService.StartAllTask(appViewer.NewSystemViewerContext(ctx.Context()), &emptypb.Empty{}); err != nil {
+	// 启动任务调度，多个节点时只有选出的调度节点注册周期任务
+	stopScheduler, err := taskService.StartScheduler(ctx.Context())
+	if err != nil {
 		log.Error(err)
-		return nil, err
+		return nil, nil, err
 	}
 
-	return srv, nil
+	return srv, stopScheduler, nil
 }
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"mime"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	"go-wind-admin/app/admin/service/internal/service"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+)
+
+// registerFileShareAccessHandler 注册分享链接的公开访问接口
+// 需要直接输出文件内容或重定向，必须先于代码生成器生成的 Handler 注册，以便优先匹配。
+func registerFileShareAccessHandler(srv *http.Server, svc *service.FileShareService) {
+	r := srv.Route("/")
+
+	r.GET("admin/v1/shared/{slug}", _FileShareService_Access_HTTP_Handler(svc, false))
+	r.POST("admin/v1/shared/{slug}", _FileShareService_Access_HTTP_Handler(svc, true))
+}
+
+func _FileShareService_Access_HTTP_Handler(svc *service.FileShareService, withBody bool) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, adminV1.OperationFileShareServiceAccess)
+
+		var in fileV1.AccessFileShareRequest
+		var err error
+
+		if err = ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		// 查询参数会出现在访问日志、浏览器历史与 Referer 中，密码只能通过请求体或请求头传递
+		if in.Password != nil {
+			return fileV1.ErrorBadRequest("file share password must be sent in the request body or the %s header", service.HeaderKeyXSharePassword)
+		}
+		if withBody {
+			if err = ctx.Bind(&in); err != nil {
+				return err
+			}
+		}
+		if err = ctx.BindVars(&in); err != nil {
+			return err
+		}
+
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return svc.Access(ctx, req.(*fileV1.AccessFileShareRequest))
+		})
+
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+
+		reply := out.(*fileV1.DownloadFileResponse)
+		rw := ctx.Response()
+		if rw == nil {
+			return ctx.Result(500, "response writer not available")
+		}
+
+		// 每次访问都需要重新校验，禁止缓存
+		rw.Header().Set("Cache-Control", "no-store")
+
+		if url := reply.GetDownloadUrl(); url != "" {
+			return ctx.Result(302, http.NewRedirect(url, 302))
+		}
+
+		data := reply.GetFile()
+
+		contentType := reply.GetMime()
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		filename := reply.GetSourceFileName()
+		if filename == "" {
+			filename = "file"
+		}
+
+		rw.Header().Set("Content-Type", contentType)
+		rw.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+		rw.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		rw.WriteHeader(200)
+		if _, err = rw.Write(data); err != nil {
+			return ctx.Result(500, err.Error())
+		}
+		return nil
+	}
+}
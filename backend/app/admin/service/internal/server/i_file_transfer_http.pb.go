@@ -28,6 +28,7 @@ func registerFileTransferServiceHandler(srv *http.Server, svc *service.FileTrans
 	r.PUT("admin/v1/file/upload", _FileTransferService_PutUploadFile_HTTP_Handler(svc))
 
 	r.GET("admin/v1/file/download", _FileTransferService_DownloadFile_HTTP_Handler(svc))
+	r.GET("admin/v1/files/{id}/variant", _FileTransferService_GetFileVariant_HTTP_Handler(svc))
 
 	r.POST("admin/v1/ueditor", _FileTransferService_UEditorPostUploadFile_HTTP_Handler(svc))
 	r.PUT("admin/v1/ueditor", _FileTransferService_UEditorPutUploadFile_HTTP_Handler(svc))
@@ -37,6 +38,7 @@ const OperationFileTransferServicePostUploadFile = "/admin.service.v1.FileTransf
 const OperationFileTransferServicePutUploadFile = "/admin.service.v1.FileTransferService/PutUploadFile"
 
 const OperationFileTransferServiceDownloadFile = "/admin.service.v1.FileTransferService/DownloadFile"
+const OperationFileTransferServiceGetFileVariant = "/admin.service.v1.FileTransferService/GetFileVariant"
 
 const OperationFileTransferServiceUEditorPostUploadFile = "/admin.service.v1.FileTransferService/UEditorPostUploadFile"
 const OperationFileTransferServiceUEditorPutUploadFile = "/admin.service.v1.FileTransferService/UEditorPutUploadFile"
@@ -205,6 +207,50 @@ func _FileTransferService_PutUploadFile_HTTP_Handler(svc *service.FileTransferSe
 	}
 }
 
+func _FileTransferService_GetFileVariant_HTTP_Handler(svc *service.FileTransferService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationFileTransferServiceGetFileVariant)
+
+		var in fileV1.GetFileVariantRequest
+		var err error
+
+		if err = ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err = ctx.BindVars(&in); err != nil {
+			return err
+		}
+
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return svc.GetFileVariant(ctx, req.(*fileV1.GetFileVariantRequest))
+		})
+
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+
+		reply := out.(*fileV1.DownloadFileResponse)
+		rw := ctx.Response()
+		if rw == nil {
+			return ctx.Result(500, "response writer not available")
+		}
+
+		data := reply.GetFile()
+
+		// 衍生图内容由原图与参数唯一确定，允许客户端缓存
+		rw.Header().Set("Content-Type", reply.GetMime())
+		rw.Header().Set("Content-Disposition", "inline; filename=\""+reply.GetSourceFileName()+"\"")
+		rw.Header().Set("Cache-Control", "private, max-age=86400")
+		rw.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		rw.WriteHeader(200)
+		if _, err = rw.Write(data); err != nil {
+			return ctx.Result(500, err.Error())
+		}
+		return nil
+	}
+}
+
 func _FileTransferService_DownloadFile_HTTP_Handler(svc *service.FileTransferService) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		http.SetOperation(ctx, OperationFileTransferServiceDownloadFile)
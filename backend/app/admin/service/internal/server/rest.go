@@ -38,6 +38,8 @@ func NewRestMiddleware(
 	authorizer *data.Authorizer,
 	apiAuditLogRepo *data.ApiAuditLogRepo,
 	loginLogRepo *data.LoginAuditLogRepo,
+	userTokenRepo *data.UserTokenCacheRepo,
+	oauthClientRepo *data.OAuthClientRepo,
 ) []middleware.Middleware {
 	var ms []middleware.Middleware
 	ms = append(ms, logging.Server(ctx.GetLogger()))
@@ -56,16 +58,47 @@ func NewRestMiddleware(
 	// add white list for authentication.
 	rpc.AddWhiteList(
 		adminV1.OperationAuthenticationServiceLogin,
+		adminV1.OperationAuthenticationServiceRefreshToken,
+		adminV1.OperationAuthenticationServiceRequestPasswordReset,
+		adminV1.OperationAuthenticationServiceConfirmPasswordReset,
+		adminV1.OperationAuthenticationServiceRegisterUser,
+		adminV1.OperationAuthenticationServiceConfirmRegistration,
+		adminV1.OperationAuthenticationServiceResendRegistrationCode,
+		adminV1.OperationJwtSigningKeyServiceGetJwks,
+		adminV1.OperationFileShareServiceAccess,
 		//OperationFileTransferServiceDownloadFile,
 		//OperationFileTransferServicePostUploadFile,
 		//OperationFileTransferServicePutUploadFile,
 	)
+	// SCIM 接口由租户的 SCIM 令牌认证
+	rpc.AddWhiteList(scimOperations...)
+
+	authOpts := []auth.Option{
+		auth.WithInjectEnt(true),
+		// 只读的模拟登录令牌仍需要能查看当前身份和登出
+		auth.WithReadOnlyAllowedOperations(
+			adminV1.OperationAuthenticationServiceWhoAmI,
+			adminV1.OperationAuthenticationServiceLogout,
+		),
+		auth.WithPasswordChangeAllowedOperations(
+			adminV1.OperationUserProfileServiceChangePassword,
+			adminV1.OperationPasswordPolicyServiceGetEffective,
+			adminV1.OperationAuthenticationServiceWhoAmI,
+			adminV1.OperationAuthenticationServiceLogout,
+		),
+	}
+	if userTokenRepo != nil {
+		// 已注销会话签发的访问令牌立即失效
+		authOpts = append(authOpts, auth.WithSessionChecker(userTokenRepo))
+	}
+	if oauthClientRepo != nil {
+		// 客户端被禁用、删除或密钥轮换后，其令牌立即失效
+		authOpts = append(authOpts, auth.WithClientChecker(oauthClientRepo))
+	}
 
 	ms = append(ms, selector.Server(
 		authn.Server(authenticator),
-		auth.Server(
-			auth.WithInjectEnt(true),
-		),
+		auth.Server(authOpts...),
 		authz.Server(authorizer.Engine()),
 	).
 		Match(rpc.NewRestWhiteListMatcher()).
@@ -84,13 +117,23 @@ func NewRestServer(
 
 	authenticationService *service.AuthenticationService,
 	loginPolicyService *service.LoginPolicyService,
+	passwordPolicyService *service.PasswordPolicyService,
+	registrationPolicyService *service.RegistrationPolicyService,
+	ldapSourceService *service.LdapSourceService,
+	scimTokenService *service.ScimTokenService,
+	scimService *service.ScimService,
+	oauthClientService *service.OAuthClientService,
+	jwtSigningKeyService *service.JwtSigningKeyService,
 
 	portalService *service.AdminPortalService,
 	taskService *service.TaskService,
+	luaScriptService *service.LuaScriptService,
 
 	uEditorService *service.UEditorService,
 	fileService *service.FileService,
 	fileTransferService *service.FileTransferService,
+	storageQuotaService *service.StorageQuotaService,
+	fileShareService *service.FileShareService,
 
 	dictTypeService *service.DictTypeService,
 	dictEntryService *service.DictEntryService,
@@ -141,7 +184,14 @@ func NewRestServer(
 
 	adminV1.RegisterAdminPortalServiceHTTPServer(srv, portalService)
 	adminV1.RegisterTaskServiceHTTPServer(srv, taskService)
+	adminV1.RegisterLuaScriptServiceHTTPServer(srv, luaScriptService)
 	adminV1.RegisterLoginPolicyServiceHTTPServer(srv, loginPolicyService)
+	adminV1.RegisterPasswordPolicyServiceHTTPServer(srv, passwordPolicyService)
+	adminV1.RegisterRegistrationPolicyServiceHTTPServer(srv, registrationPolicyService)
+	adminV1.RegisterLdapSourceServiceHTTPServer(srv, ldapSourceService)
+	adminV1.RegisterScimTokenServiceHTTPServer(srv, scimTokenService)
+	adminV1.RegisterOAuthClientServiceHTTPServer(srv, oauthClientService)
+	adminV1.RegisterJwtSigningKeyServiceHTTPServer(srv, jwtSigningKeyService)
 
 	adminV1.RegisterDictTypeServiceHTTPServer(srv, dictTypeService)
 	adminV1.RegisterDictEntryServiceHTTPServer(srv, dictEntryService)
@@ -166,12 +216,20 @@ func NewRestServer(
 	adminV1.RegisterDataAccessAuditLogServiceHTTPServer(srv, dataAccessAuditLogService)
 
 	adminV1.RegisterFileServiceHTTPServer(srv, fileService)
+	adminV1.RegisterStorageQuotaServiceHTTPServer(srv, storageQuotaService)
 
 	// 注册文件传输服务，用于处理文件上传下载等功能
 	// TODO 它不能够使用代码生成器生成的Handler，需要手动注册。代码生成器生成的Handler无法处理文件上传下载的请求。
 	// 但，代码生成器生成代码可以提供给OpenAPI使用。
 	registerFileTransferServiceHandler(srv, fileTransferService)
 
+	// 分享链接的访问接口需要直接输出文件内容或重定向，手动注册的 Handler 须先于生成的 Handler 注册
+	registerFileShareAccessHandler(srv, fileShareService)
+	adminV1.RegisterFileShareServiceHTTPServer(srv, fileShareService)
+
+	// SCIM 2.0 供应接口使用自己的媒体类型与错误格式，需要手动注册
+	registerScimHandler(srv, scimService)
+
 	adminV1.RegisterUEditorServiceHTTPServer(srv, uEditorService)
 
 	adminV1.RegisterInternalMessageServiceHTTPServer(srv, internalMessageService)
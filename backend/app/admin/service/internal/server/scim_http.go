@@ -0,0 +1,395 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	stdhttp "net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/service"
+
+	"go-wind-admin/pkg/scim"
+)
+
+// scimMaxBodySize SCIM 请求体的大小上限
+const scimMaxBodySize = 1 << 20
+
+// registerScimHandler 注册 SCIM 2.0 接口
+// SCIM 使用自己的媒体类型、错误格式与 ETag 条件请求，且由租户的 SCIM 令牌认证，无法使用代码生成器生成的 Handler。
+func registerScimHandler(srv *http.Server, svc *service.ScimService) {
+	r := srv.Route("/")
+
+	r.GET("scim/v2/ServiceProviderConfig", _ScimService_ServiceProviderConfig_HTTP_Handler(svc))
+
+	r.GET("scim/v2/Users", scimHandler(svc, OperationScimServiceListUsers, scimListUsers))
+	r.POST("scim/v2/Users", scimHandler(svc, OperationScimServiceCreateUser, scimCreateUser))
+	r.GET("scim/v2/Users/{id}", scimHandler(svc, OperationScimServiceGetUser, scimGetUser))
+	r.PUT("scim/v2/Users/{id}", scimHandler(svc, OperationScimServiceReplaceUser, scimReplaceUser))
+	r.PATCH("scim/v2/Users/{id}", scimHandler(svc, OperationScimServicePatchUser, scimPatchUser))
+	r.DELETE("scim/v2/Users/{id}", scimHandler(svc, OperationScimServiceDeleteUser, scimDeleteUser))
+
+	r.GET("scim/v2/Groups", scimHandler(svc, OperationScimServiceListGroups, scimListGroups))
+	r.POST("scim/v2/Groups", scimHandler(svc, OperationScimServiceCreateGroup, scimCreateGroup))
+	r.GET("scim/v2/Groups/{id}", scimHandler(svc, OperationScimServiceGetGroup, scimGetGroup))
+	r.PUT("scim/v2/Groups/{id}", scimHandler(svc, OperationScimServiceReplaceGroup, scimReplaceGroup))
+	r.PATCH("scim/v2/Groups/{id}", scimHandler(svc, OperationScimServicePatchGroup, scimPatchGroup))
+	r.DELETE("scim/v2/Groups/{id}", scimHandler(svc, OperationScimServiceDeleteGroup, scimDeleteGroup))
+}
+
+const OperationScimServiceServiceProviderConfig = "/admin.service.v1.ScimService/ServiceProviderConfig"
+
+const OperationScimServiceListUsers = "/admin.service.v1.ScimService/ListUsers"
+const OperationScimServiceGetUser = "/admin.service.v1.ScimService/GetUser"
+const OperationScimServiceCreateUser = "/admin.service.v1.ScimService/CreateUser"
+const OperationScimServiceReplaceUser = "/admin.service.v1.ScimService/ReplaceUser"
+const OperationScimServicePatchUser = "/admin.service.v1.ScimService/PatchUser"
+const OperationScimServiceDeleteUser = "/admin.service.v1.ScimService/DeleteUser"
+
+const OperationScimServiceListGroups = "/admin.service.v1.ScimService/ListGroups"
+const OperationScimServiceGetGroup = "/admin.service.v1.ScimService/GetGroup"
+const OperationScimServiceCreateGroup = "/admin.service.v1.ScimService/CreateGroup"
+const OperationScimServiceReplaceGroup = "/admin.service.v1.ScimService/ReplaceGroup"
+const OperationScimServicePatchGroup = "/admin.service.v1.ScimService/PatchGroup"
+const OperationScimServiceDeleteGroup = "/admin.service.v1.ScimService/DeleteGroup"
+
+// scimOperations SCIM 接口使用自己的令牌认证，需要加入登录认证的白名单
+var scimOperations = []string{
+	OperationScimServiceServiceProviderConfig,
+	OperationScimServiceListUsers,
+	OperationScimServiceGetUser,
+	OperationScimServiceCreateUser,
+	OperationScimServiceReplaceUser,
+	OperationScimServicePatchUser,
+	OperationScimServiceDeleteUser,
+	OperationScimServiceListGroups,
+	OperationScimServiceGetGroup,
+	OperationScimServiceCreateGroup,
+	OperationScimServiceReplaceGroup,
+	OperationScimServicePatchGroup,
+	OperationScimServiceDeleteGroup,
+}
+
+// scimRequest 已认证的 SCIM 请求
+type scimRequest struct {
+	http.Context
+
+	TenantID uint32
+	BaseURL  string
+}
+
+func (r *scimRequest) ID() string {
+	return r.Vars().Get("id")
+}
+
+func (r *scimRequest) IfMatch() string {
+	return r.Header().Get("If-Match")
+}
+
+func (r *scimRequest) decode(v any) error {
+	body, err := io.ReadAll(io.LimitReader(r.Request().Body, scimMaxBodySize+1))
+	if err != nil {
+		return scim.NewError(stdhttp.StatusBadRequest, scim.ErrInvalidSyntax, "read request body failed")
+	}
+	if len(body) > scimMaxBodySize {
+		return scim.NewError(stdhttp.StatusRequestEntityTooLarge, "", "request body is too large")
+	}
+	if err = json.Unmarshal(body, v); err != nil {
+		return scim.NewError(stdhttp.StatusBadRequest, scim.ErrInvalidSyntax, "invalid request body: %s", err.Error())
+	}
+	return nil
+}
+
+func (r *scimRequest) listQuery() (*data.ScimListQuery, error) {
+	q := r.Request().URL.Query()
+
+	start, count, err := scim.ParsePagination(q.Get("startIndex"), q.Get("count"))
+	if err != nil {
+		return nil, err
+	}
+
+	query := &data.ScimListQuery{
+		StartIndex:     start,
+		Count:          count,
+		ExcludeMembers: scimExcludes(q.Get("excludedAttributes"), "members"),
+	}
+	if filter := strings.TrimSpace(q.Get("filter")); filter != "" {
+		if query.Filter, err = scim.ParseFilter(filter); err != nil {
+			return nil, err
+		}
+	}
+	return query, nil
+}
+
+// scimExcludes excludedAttributes 中是否包含指定属性
+func scimExcludes(excluded, attr string) bool {
+	for _, a := range strings.Split(excluded, ",") {
+		if strings.EqualFold(strings.TrimSpace(a), attr) {
+			return true
+		}
+	}
+	return false
+}
+
+// scimResult SCIM 接口的响应
+type scimResult struct {
+	Status   int
+	Body     any
+	Version  string
+	Location string
+}
+
+func scimResource(status int, body any, meta *scim.Meta) *scimResult {
+	res := &scimResult{Status: status, Body: body}
+	if meta != nil {
+		res.Version = meta.Version
+		if status == stdhttp.StatusCreated {
+			res.Location = meta.Location
+		}
+	}
+	return res
+}
+
+// scimBaseURL 根据请求推断 SCIM 接口的根地址，用于资源的 location
+func scimBaseURL(r *stdhttp.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = strings.TrimSpace(strings.Split(proto, ",")[0])
+	}
+
+	host := r.Host
+	if forwarded := r.Header.Get("X-Forwarded-Host"); forwarded != "" {
+		host = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+
+	return scheme + "://" + host + "/scim/v2"
+}
+
+// scimHandler 认证 SCIM 令牌并执行 fn，按 SCIM 的格式输出结果或错误
+func scimHandler(svc *service.ScimService, operation string, fn func(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error)) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, operation)
+
+		req := &scimRequest{
+			Context: ctx,
+			BaseURL: scimBaseURL(ctx.Request()),
+		}
+
+		h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+			tenantID, err := svc.Authenticate(c, ctx.Header().Get("Authorization"))
+			if err != nil {
+				return nil, err
+			}
+			req.TenantID = tenantID
+			return fn(c, svc, req)
+		})
+
+		out, err := h(ctx, nil)
+		if err != nil {
+			return writeScimError(ctx, service.ToScimError(err))
+		}
+
+		res := out.(*scimResult)
+
+		// 条件获取，资源未变化时不返回内容
+		if ctx.Request().Method == stdhttp.MethodGet && res.Version != "" {
+			if inm := ctx.Header().Get("If-None-Match"); inm != "" && scim.MatchETag(inm, res.Version) {
+				rw := ctx.Response()
+				rw.Header().Set("ETag", res.Version)
+				rw.WriteHeader(stdhttp.StatusNotModified)
+				return nil
+			}
+		}
+
+		return writeScimResult(ctx, res)
+	}
+}
+
+func writeScimResult(ctx http.Context, res *scimResult) error {
+	rw := ctx.Response()
+	if rw == nil {
+		return ctx.Result(500, "response writer not available")
+	}
+
+	if res.Version != "" {
+		rw.Header().Set("ETag", res.Version)
+	}
+	if res.Location != "" {
+		rw.Header().Set("Location", res.Location)
+	}
+
+	if res.Body == nil {
+		rw.WriteHeader(res.Status)
+		return nil
+	}
+
+	body, err := json.Marshal(res.Body)
+	if err != nil {
+		return writeScimError(ctx, scim.NewError(stdhttp.StatusInternalServerError, "", "encode response failed"))
+	}
+
+	rw.Header().Set("Content-Type", scim.ContentType)
+	rw.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	rw.WriteHeader(res.Status)
+	_, err = rw.Write(body)
+	return err
+}
+
+func writeScimError(ctx http.Context, scimErr *scim.Error) error {
+	rw := ctx.Response()
+	if rw == nil {
+		return ctx.Result(500, "response writer not available")
+	}
+
+	body, _ := json.Marshal(scimErr)
+
+	rw.Header().Set("Content-Type", scim.ContentType)
+	rw.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	rw.WriteHeader(scimErr.Status)
+	_, err := rw.Write(body)
+	return err
+}
+
+func _ScimService_ServiceProviderConfig_HTTP_Handler(svc *service.ScimService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationScimServiceServiceProviderConfig)
+		return writeScimResult(ctx, &scimResult{
+			Status: stdhttp.StatusOK,
+			Body:   svc.ServiceProviderConfig(scimBaseURL(ctx.Request())),
+		})
+	}
+}
+
+func scimListUsers(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	query, err := req.listQuery()
+	if err != nil {
+		return nil, err
+	}
+	out, err := svc.ListUsers(ctx, req.TenantID, query, req.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return &scimResult{Status: stdhttp.StatusOK, Body: out}, nil
+}
+
+func scimGetUser(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	out, err := svc.GetUser(ctx, req.TenantID, req.ID(), req.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return scimResource(stdhttp.StatusOK, out, out.Meta), nil
+}
+
+func scimCreateUser(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	var in scim.User
+	if err := req.decode(&in); err != nil {
+		return nil, err
+	}
+	out, err := svc.CreateUser(ctx, req.TenantID, &in, req.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return scimResource(stdhttp.StatusCreated, out, out.Meta), nil
+}
+
+func scimReplaceUser(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	var in scim.User
+	if err := req.decode(&in); err != nil {
+		return nil, err
+	}
+	out, err := svc.ReplaceUser(ctx, req.TenantID, req.ID(), &in, req.IfMatch(), req.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return scimResource(stdhttp.StatusOK, out, out.Meta), nil
+}
+
+func scimPatchUser(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	var in scim.PatchRequest
+	if err := req.decode(&in); err != nil {
+		return nil, err
+	}
+	out, err := svc.PatchUser(ctx, req.TenantID, req.ID(), in.Operations, req.IfMatch(), req.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return scimResource(stdhttp.StatusOK, out, out.Meta), nil
+}
+
+func scimDeleteUser(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	if err := svc.DeleteUser(ctx, req.TenantID, req.ID()); err != nil {
+		return nil, err
+	}
+	return &scimResult{Status: stdhttp.StatusNoContent}, nil
+}
+
+func scimListGroups(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	query, err := req.listQuery()
+	if err != nil {
+		return nil, err
+	}
+	out, err := svc.ListGroups(ctx, req.TenantID, query, req.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return &scimResult{Status: stdhttp.StatusOK, Body: out}, nil
+}
+
+func scimGetGroup(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	excludeMembers := scimExcludes(req.Request().URL.Query().Get("excludedAttributes"), "members")
+	out, err := svc.GetGroup(ctx, req.TenantID, req.ID(), excludeMembers, req.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return scimResource(stdhttp.StatusOK, out, out.Meta), nil
+}
+
+func scimCreateGroup(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	var in scim.Group
+	if err := req.decode(&in); err != nil {
+		return nil, err
+	}
+	out, err := svc.CreateGroup(ctx, req.TenantID, &in, req.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return scimResource(stdhttp.StatusCreated, out, out.Meta), nil
+}
+
+func scimReplaceGroup(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	var in scim.Group
+	if err := req.decode(&in); err != nil {
+		return nil, err
+	}
+	out, err := svc.ReplaceGroup(ctx, req.TenantID, req.ID(), &in, req.IfMatch(), req.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return scimResource(stdhttp.StatusOK, out, out.Meta), nil
+}
+
+func scimPatchGroup(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	var in scim.PatchRequest
+	if err := req.decode(&in); err != nil {
+		return nil, err
+	}
+	out, err := svc.PatchGroup(ctx, req.TenantID, req.ID(), in.Operations, req.IfMatch(), req.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return scimResource(stdhttp.StatusOK, out, out.Meta), nil
+}
+
+func scimDeleteGroup(ctx context.Context, svc *service.ScimService, req *scimRequest) (*scimResult, error) {
+	if err := svc.DeleteGroup(ctx, req.TenantID, req.ID()); err != nil {
+		return nil, err
+	}
+	return &scimResult{Status: stdhttp.StatusNoContent}, nil
+}
@@ -2,26 +2,41 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/tx7do/go-crud/viewer"
 	"github.com/tx7do/go-utils/trans"
 	authnEngine "github.com/tx7do/kratos-authn/engine"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent"
 	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
 
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
 	userV1 "go-wind-admin/api/gen/go/user/service/v1"
 
+	"go-wind-admin/pkg/captcha"
 	"go-wind-admin/pkg/constants"
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/eventbus"
 	"go-wind-admin/pkg/jwt"
+	"go-wind-admin/pkg/lua"
 	"go-wind-admin/pkg/middleware/auth"
+	applogging "go-wind-admin/pkg/middleware/logging"
+	"go-wind-admin/pkg/notifier"
 )
 
 type AuthenticationService struct {
@@ -36,10 +51,27 @@ type AuthenticationService struct {
 	orgUnitRepo    *data.OrgUnitRepo
 	permissionRepo *data.PermissionRepo
 
-	userToken *data.UserTokenCacheRepo
+	oauthClientRepo  *data.OAuthClientRepo
+	loginLogRepo     *data.LoginAuditLogRepo
+	operationLogRepo *data.OperationAuditLogRepo
+
+	userToken        *data.UserTokenCacheRepo
+	verificationRepo *data.VerificationCodeRepo
+
+	registrationPolicyRepo *data.RegistrationPolicyRepo
+	inviteRepo             *data.RegistrationInviteRepo
+	userRegistrationRepo   *data.UserRegistrationRepo
+	captchaVerifier        captcha.Verifier
+	eventBus               eventbus.EventBus
+
+	ldapSourceRepo *data.LdapSourceRepo
 
 	authenticator authnEngine.Authenticator
 
+	impersonation *adminConfV1.Impersonation
+
+	hooks *luaHooks
+
 	log *log.Helper
 }
 
@@ -52,11 +84,25 @@ func NewAuthenticationService(
 	membershipRepo *data.MembershipRepo,
 	orgUnitRepo *data.OrgUnitRepo,
 	permissionRepo *data.PermissionRepo,
+	oauthClientRepo *data.OAuthClientRepo,
+	loginLogRepo *data.LoginAuditLogRepo,
+	operationLogRepo *data.OperationAuditLogRepo,
 	userToken *data.UserTokenCacheRepo,
+	verificationRepo *data.VerificationCodeRepo,
+	registrationPolicyRepo *data.RegistrationPolicyRepo,
+	inviteRepo *data.RegistrationInviteRepo,
+	userRegistrationRepo *data.UserRegistrationRepo,
+	ldapSourceRepo *data.LdapSourceRepo,
+	captchaVerifier captcha.Verifier,
+	eventBus eventbus.EventBus,
 	authenticator authnEngine.Authenticator,
+	luaEngine *lua.Engine,
+	adminCfg *adminConfV1.Bootstrap,
 ) *AuthenticationService {
+	l := ctx.NewLoggerHelper("authn/service/admin-service")
 	return &AuthenticationService{
-		log:                ctx.NewLoggerHelper("authn/service/admin-service"),
+		log:                l,
+		hooks:              newLuaHooks(luaEngine, l),
 		userRepo:           userRepo,
 		userCredentialRepo: userCredentialRepo,
 		tenantRepo:         tenantRepo,
@@ -64,8 +110,21 @@ func NewAuthenticationService(
 		membershipRepo:     membershipRepo,
 		orgUnitRepo:        orgUnitRepo,
 		permissionRepo:     permissionRepo,
+		oauthClientRepo:    oauthClientRepo,
+		loginLogRepo:       loginLogRepo,
+		operationLogRepo:   operationLogRepo,
 		userToken:          userToken,
+		verificationRepo:   verificationRepo,
 		authenticator:      authenticator,
+		impersonation:      adminCfg.GetImpersonation(),
+
+		registrationPolicyRepo: registrationPolicyRepo,
+		inviteRepo:             inviteRepo,
+		userRegistrationRepo:   userRegistrationRepo,
+		captchaVerifier:        captchaVerifier,
+		eventBus:               eventBus,
+
+		ldapSourceRepo: ldapSourceRepo,
 	}
 }
 
@@ -318,6 +377,9 @@ func (s *AuthenticationService) authorizeAndEnrichUserTokenPayload(ctx context.C
 func (s *AuthenticationService) resolveUserAuthority(ctx context.Context, user *userV1.User, tokenPayload *authenticationV1.UserTokenPayload) error {
 	if user.GetStatus() != userV1.User_NORMAL {
 		s.log.Errorf("user [%d] is [%v]", user.GetId(), user.GetStatus())
+		if user.GetStatus() == userV1.User_PENDING {
+			return authenticationV1.ErrorForbidden("user is pending activation")
+		}
 		return authenticationV1.ErrorForbidden("user is disabled")
 	}
 
@@ -328,15 +390,50 @@ func (s *AuthenticationService) resolveUserAuthority(ctx context.Context, user *
 	return nil
 }
 
+// verifyPassword 校验用户名密码，由外部身份源管理的用户通过租户的 LDAP 绑定校验
+func (s *AuthenticationService) verifyPassword(ctx context.Context, username, password string) error {
+	credential, err := s.userCredentialRepo.GetExternalCredential(ctx, authenticationV1.UserCredential_USERNAME, username)
+	if err != nil {
+		return err
+	}
+
+	if credential == nil {
+		_, err = s.userCredentialRepo.VerifyCredential(ctx, &authenticationV1.VerifyCredentialRequest{
+			IdentityType: authenticationV1.UserCredential_USERNAME,
+			Identifier:   username,
+			Credential:   password,
+			NeedDecrypt:  true,
+		})
+		return err
+	}
+
+	if credential.GetStatus() != authenticationV1.UserCredential_ENABLED {
+		return authenticationV1.ErrorUserFreeze("account has freeze")
+	}
+
+	plainPassword, err := s.userCredentialRepo.DecryptCredential(password)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.ldapSourceRepo.Authenticate(ctx, credential.GetTenantId(), username, plainPassword)
+	return err
+}
+
 // doGrantTypePassword 处理授权类型 - 密码
 func (s *AuthenticationService) doGrantTypePassword(ctx context.Context, req *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
 	var err error
-	if _, err = s.userCredentialRepo.VerifyCredential(ctx, &authenticationV1.VerifyCredentialRequest{
-		IdentityType: authenticationV1.UserCredential_USERNAME,
-		Identifier:   req.GetUsername(),
-		Credential:   req.GetPassword(),
-		NeedDecrypt:  true,
+
+	// 登录前钩子，脚本可以拒绝本次登录
+	if err = s.hooks.before(ctx, lua.HookBeforeLogin, map[string]any{
+		"username":  req.GetUsername(),
+		"client_id": req.GetClientId(),
+		"device_id": req.GetDeviceId(),
 	}); err != nil {
+		return nil, err
+	}
+
+	if err = s.verifyPassword(ctx, req.GetUsername(), req.GetPassword()); err != nil {
 		s.log.Errorf("verify user credential failed for username [%s]: %s", req.GetUsername(), err.Error())
 		return nil, err
 	}
@@ -357,6 +454,12 @@ func (s *AuthenticationService) doGrantTypePassword(ctx context.Context, req *au
 		DeviceId: req.DeviceId,
 	}
 
+	// 密码已过期时签发受限令牌，只能修改密码
+	passwordExpired, err := s.checkPasswordExpired(ctx, user, tokenPayload)
+	if err != nil {
+		return nil, err
+	}
+
 	// 解析用户权限信息
 	err = s.resolveUserAuthority(ctx, user, tokenPayload)
 	if err != nil {
@@ -364,33 +467,89 @@ func (s *AuthenticationService) doGrantTypePassword(ctx context.Context, req *au
 		return nil, err
 	}
 
+	// 每次登录创建一个新的会话
+	ip, userAgent := sessionClientInfo(ctx)
+	if err = s.userToken.CreateSession(ctx, tokenPayload, req.GetClientType(), ip, userAgent); err != nil {
+		s.log.Errorf("create session for user [%d] failed [%s]", user.GetId(), err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("create session failed")
+	}
+
 	// 生成令牌
 	accessToken, refreshToken, err := s.userToken.GenerateToken(ctx, tokenPayload)
 	if err != nil {
 		return nil, err
 	}
 
+	s.hooks.after(ctx, lua.HookAfterLogin, map[string]any{
+		"user_id":   user.GetId(),
+		"username":  user.GetUsername(),
+		"tenant_id": user.GetTenantId(),
+		"client_id": req.GetClientId(),
+		"device_id": req.GetDeviceId(),
+	})
+
 	return &authenticationV1.LoginResponse{
-		TokenType:        authenticationV1.TokenType_bearer,
-		AccessToken:      accessToken,
-		RefreshToken:     trans.Ptr(refreshToken),
-		ExpiresIn:        int64(s.userToken.GetAccessTokenExpires().Seconds()),
-		RefreshExpiresIn: trans.Ptr(int64(s.userToken.GetRefreshTokenExpires().Seconds())),
+		TokenType:              authenticationV1.TokenType_bearer,
+		AccessToken:            accessToken,
+		RefreshToken:           trans.Ptr(refreshToken),
+		ExpiresIn:              int64(s.userToken.GetAccessTokenExpires().Seconds()),
+		RefreshExpiresIn:       trans.Ptr(int64(s.userToken.GetRefreshTokenExpires().Seconds())),
+		PasswordChangeRequired: passwordExpired,
 	}, nil
 }
 
-// doGrantTypeAuthorizationCode 处理授权类型 - 刷新令牌
-func (s *AuthenticationService) doGrantTypeRefreshToken(ctx context.Context, req *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
-	// 获取操作人信息
-	operator, err := auth.FromContext(ctx)
+// checkPasswordExpired 检查用户密码是否超过租户密码策略的最长使用期限，过期时在令牌中标记
+func (s *AuthenticationService) checkPasswordExpired(ctx context.Context, user *userV1.User, tokenPayload *authenticationV1.UserTokenPayload) (*bool, error) {
+	expired, err := s.userCredentialRepo.IsPasswordExpired(ctx, user.GetTenantId(), user.GetUsername())
 	if err != nil {
+		s.log.Errorf("check password expiry of user [%d] failed [%s]", user.GetId(), err.Error())
 		return nil, err
 	}
+	if !expired {
+		return nil, nil
+	}
+
+	tokenPayload.PasswordExpired = trans.Ptr(true)
+	return trans.Ptr(true), nil
+}
+
+// doGrantTypeRefreshToken 处理授权类型 - 刷新令牌
+// 刷新令牌只能使用一次，每次刷新在同一会话（令牌族）内签发新的刷新令牌；
+// 已轮换的刷新令牌再次出现说明令牌可能已泄露，注销整个会话并写入安全审计日志。
+func (s *AuthenticationService) doGrantTypeRefreshToken(ctx context.Context, req *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
+	info, state, err := s.userToken.ConsumeRefreshToken(ctx, req.GetRefreshToken(), req.GetClientId(), req.GetDeviceId())
+	if err != nil {
+		s.log.Errorf("consume refresh token failed [%s]", err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("refresh token failed")
+	}
+
+	switch state {
+	case data.RefreshTokenActive:
+	case data.RefreshTokenReused:
+		s.revokeRefreshTokenFamily(ctx, info)
+		return nil, authenticationV1.ErrorIncorrectRefreshToken("refresh token reused")
+	case data.RefreshTokenMismatch:
+		s.log.Warnf("refresh token of user [%d] presented by another client or device", info.UserID)
+		return nil, authenticationV1.ErrorIncorrectRefreshToken("invalid refresh token")
+	default:
+		return nil, authenticationV1.ErrorIncorrectRefreshToken("invalid refresh token")
+	}
+
+	// 沿用原会话，会话已被注销时不能再刷新
+	if info.SessionID != "" {
+		var ok bool
+		if ok, err = s.userToken.RenewSession(ctx, info.UserID, info.SessionID); err != nil {
+			s.log.Errorf("renew session [%s] failed [%s]", info.SessionID, err.Error())
+			return nil, authenticationV1.ErrorServiceUnavailable("renew session failed")
+		} else if !ok {
+			return nil, authenticationV1.ErrorIncorrectRefreshToken("session revoked")
+		}
+	}
 
 	// 获取用户信息
 	user, err := s.userRepo.Get(ctx, &userV1.GetUserRequest{
 		QueryBy: &userV1.GetUserRequest_Id{
-			Id: operator.UserId,
+			Id: info.UserID,
 		},
 	})
 	if err != nil {
@@ -404,6 +563,15 @@ func (s *AuthenticationService) doGrantTypeRefreshToken(ctx context.Context, req
 		ClientId: req.ClientId,
 		DeviceId: req.DeviceId,
 	}
+	if info.SessionID != "" {
+		tokenPayload.SessionId = trans.Ptr(info.SessionID)
+	}
+
+	// 密码已过期时签发受限令牌，只能修改密码
+	passwordExpired, err := s.checkPasswordExpired(ctx, user, tokenPayload)
+	if err != nil {
+		return nil, err
+	}
 
 	// 解析用户权限信息
 	err = s.resolveUserAuthority(ctx, user, tokenPayload)
@@ -412,33 +580,146 @@ func (s *AuthenticationService) doGrantTypeRefreshToken(ctx context.Context, req
 		return nil, err
 	}
 
-	// 校验刷新令牌
-	if !s.userToken.IsExistRefreshToken(ctx, operator.UserId, req.GetRefreshToken()) {
-		return nil, authenticationV1.ErrorIncorrectRefreshToken("invalid refresh token")
+	// 生成令牌
+	accessToken, refreshToken, err := s.userToken.GenerateToken(ctx, tokenPayload)
+	if err != nil {
+		return nil, authenticationV1.ErrorServiceUnavailable("generate token failed")
 	}
 
-	if err = s.userToken.RemoveRefreshToken(ctx, operator.UserId, req.GetRefreshToken()); err != nil {
-		s.log.Errorf("remove refresh token failed [%s]", err.Error())
+	return &authenticationV1.LoginResponse{
+		TokenType:              authenticationV1.TokenType_bearer,
+		AccessToken:            accessToken,
+		RefreshToken:           trans.Ptr(refreshToken),
+		ExpiresIn:              int64(s.userToken.GetAccessTokenExpires().Seconds()),
+		RefreshExpiresIn:       trans.Ptr(int64(s.userToken.GetRefreshTokenExpires().Seconds())),
+		PasswordChangeRequired: passwordExpired,
+	}, nil
+}
+
+// revokeRefreshTokenFamily 检测到刷新令牌重用时注销整个会话，并写入安全审计日志
+func (s *AuthenticationService) revokeRefreshTokenFamily(ctx context.Context, info *data.RefreshTokenInfo) {
+	var err error
+	if info.SessionID != "" {
+		err = s.userToken.RemoveSession(ctx, info.UserID, info.SessionID)
+	} else {
+		err = s.userToken.RemoveToken(ctx, info.UserID)
+	}
+	if err != nil && !authenticationV1.IsNotFound(err) {
+		s.log.Errorf("revoke session [%s] of user [%d] failed [%s]", info.SessionID, info.UserID, err.Error())
 	}
 
-	// 生成令牌
-	accessToken, refreshToken, err := s.userToken.GenerateToken(ctx, tokenPayload)
+	s.log.Warnf("refresh token reuse detected, session [%s] of user [%d] revoked", info.SessionID, info.UserID)
+
+	entry := &auditV1.LoginAuditLog{
+		UserId:        trans.Ptr(info.UserID),
+		ActionType:    trans.Ptr(auditV1.LoginAuditLog_KICKED_OUT),
+		Status:        trans.Ptr(auditV1.LoginAuditLog_FAILED),
+		FailureReason: trans.Ptr("refresh token reuse detected"),
+		RiskScore:     trans.Ptr(uint32(100)),
+		RiskLevel:     trans.Ptr(auditV1.LoginAuditLog_HIGH),
+		RiskFactors:   []string{applogging.RiskFactorRefreshTokenReuse},
+		CreatedAt:     timestamppb.Now(),
+	}
+	if info.TenantID != 0 {
+		entry.TenantId = trans.Ptr(info.TenantID)
+	}
+	if info.SessionID != "" {
+		entry.SessionId = trans.Ptr(info.SessionID)
+	}
+	if r, ok := http.RequestFromServerContext(ctx); ok {
+		entry.IpAddress = trans.Ptr(applogging.ClientRealIP(r))
+		entry.RequestId = trans.Ptr(applogging.RequestID(r))
+	}
+
+	if err = s.loginLogRepo.Create(appViewer.NewSystemViewerContext(ctx), &auditV1.CreateLoginAuditLogRequest{Data: entry}); err != nil {
+		s.log.Errorf("write refresh token reuse audit log failed [%s]", err.Error())
+	}
+}
+
+// doGrantTypeClientCredentials 处理授权类型 - 客户端凭据
+func (s *AuthenticationService) doGrantTypeClientCredentials(ctx context.Context, req *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
+	client, secretVersion, err := s.oauthClientRepo.VerifySecret(ctx, req.GetClientId(), req.GetClientSecret())
 	if err != nil {
+		s.log.Errorf("verify oauth client [%s] secret failed: %s", req.GetClientId(), err.Error())
+		return nil, err
+	}
+
+	if client.GetStatus() != authenticationV1.OAuthClient_ON {
+		s.log.Errorf("oauth client [%s] is disabled", client.GetClientId())
+		return nil, authenticationV1.ErrorForbidden("client is disabled")
+	}
+
+	if client.GetTenantId() > 0 {
+		// 检查租户状态
+		tenant, _ := s.tenantRepo.Get(ctx, &userV1.GetTenantRequest{
+			QueryBy: &userV1.GetTenantRequest_Id{Id: client.GetTenantId()},
+		})
+		if tenant == nil || tenant.GetStatus() != userV1.Tenant_ON {
+			return nil, authenticationV1.ErrorForbidden("insufficient authority")
+		}
+	}
+
+	scopes, err := resolveClientScopes(client.GetScopes(), req.GetScope())
+	if err != nil {
+		s.log.Errorf("oauth client [%s] requested invalid scope [%s]", client.GetClientId(), req.GetScope())
+		return nil, err
+	}
+
+	tokenPayload := jwt.NewClientTokenPayload(
+		client.GetClientId(), client.GetTenantId(), scopes,
+		client.DataScope, client.OrgUnitId, secretVersion,
+	)
+
+	expires := time.Duration(client.GetTokenTtl()) * time.Second
+	if expires <= 0 {
+		expires = s.userToken.GetAccessTokenExpires()
+	}
+
+	// 生成令牌，客户端凭据模式不签发刷新令牌
+	accessToken, err := s.userToken.GenerateClientAccessToken(tokenPayload, expires)
+	if err != nil {
+		s.log.Errorf("generate oauth client [%s] token failed: %s", client.GetClientId(), err.Error())
 		return nil, authenticationV1.ErrorServiceUnavailable("generate token failed")
 	}
 
 	return &authenticationV1.LoginResponse{
-		TokenType:        authenticationV1.TokenType_bearer,
-		AccessToken:      accessToken,
-		RefreshToken:     trans.Ptr(refreshToken),
-		ExpiresIn:        int64(s.userToken.GetAccessTokenExpires().Seconds()),
-		RefreshExpiresIn: trans.Ptr(int64(s.userToken.GetRefreshTokenExpires().Seconds())),
+		TokenType:   authenticationV1.TokenType_bearer,
+		AccessToken: accessToken,
+		ExpiresIn:   int64(expires.Seconds()),
+		Scope:       trans.Ptr(strings.Join(scopes, " ")),
 	}, nil
 }
 
-// doGrantTypeClientCredentials 处理授权类型 - 客户端凭据
-func (s *AuthenticationService) doGrantTypeClientCredentials(_ context.Context, _ *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
-	return nil, authenticationV1.ErrorInvalidGrantType("invalid grant type")
+// resolveClientScopes 计算客户端令牌的授权范围：未请求时为全部允许的范围，否则必须是允许范围的子集
+func resolveClientScopes(allowed []string, requested string) ([]string, error) {
+	if len(allowed) == 0 {
+		return nil, authenticationV1.ErrorForbidden("client has no scope")
+	}
+
+	fields := strings.Fields(requested)
+	if len(fields) == 0 {
+		return allowed, nil
+	}
+
+	allowedSet := make(map[string]struct{}, len(allowed))
+	for _, scope := range allowed {
+		allowedSet[scope] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(fields))
+	scopes := make([]string, 0, len(fields))
+	for _, scope := range fields {
+		if _, ok := allowedSet[scope]; !ok {
+			return nil, authenticationV1.ErrorForbidden("invalid scope")
+		}
+		if _, ok := seen[scope]; ok {
+			continue
+		}
+		seen[scope] = struct{}{}
+		scopes = append(scopes, scope)
+	}
+
+	return scopes, nil
 }
 
 // Logout 登出
@@ -449,13 +730,27 @@ func (s *AuthenticationService) Logout(ctx context.Context, _ *emptypb.Empty) (*
 		return nil, err
 	}
 
-	if err = s.userToken.RemoveToken(ctx, operator.UserId); err != nil {
+	// 只注销当前会话，不影响其他设备上的登录；没有会话的旧令牌仍然注销全部令牌
+	if operator.GetSessionId() != "" {
+		err = s.userToken.RemoveSession(ctx, operator.UserId, operator.GetSessionId())
+	} else {
+		err = s.userToken.RemoveToken(ctx, operator.UserId)
+	}
+	if err != nil {
 		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// sessionClientInfo 获取创建会话所需的客户端IP和用户代理
+func sessionClientInfo(ctx context.Context) (ip, userAgent string) {
+	if r, ok := http.RequestFromServerContext(ctx); ok {
+		return applogging.ClientRealIP(r), r.UserAgent()
+	}
+	return "", ""
+}
+
 // RefreshToken 刷新令牌
 func (s *AuthenticationService) RefreshToken(ctx context.Context, req *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
 	// 校验授权类型
@@ -463,7 +758,7 @@ func (s *AuthenticationService) RefreshToken(ctx context.Context, req *authentic
 		return nil, authenticationV1.ErrorInvalidGrantType("invalid grant type")
 	}
 
-	return s.doGrantTypeRefreshToken(ctx, req)
+	return s.Login(ctx, req)
 }
 
 // ValidateToken 验证令牌
@@ -488,52 +783,328 @@ func (s *AuthenticationService) ValidateToken(_ context.Context, req *authentica
 	}, nil
 }
 
-// RegisterUser 注册前台用户
+// RegisterUser 用户自助注册。
+// 是否开放注册、注册方式、是否需要人机验证与邮箱验证由租户的注册策略决定，租户没有配置注册策略时不开放注册。
 func (s *AuthenticationService) RegisterUser(ctx context.Context, req *authenticationV1.RegisterUserRequest) (*authenticationV1.RegisterUserResponse, error) {
-	var err error
+	username := strings.TrimSpace(req.GetUsername())
+	if username == "" || req.GetPassword() == "" {
+		return nil, authenticationV1.ErrorBadRequest("username and password are required")
+	}
+	email := data.NormalizeVerificationTarget(notifier.ChannelEmail, req.GetEmail())
 
-	var tenantId *uint32
-	tenant, err := s.tenantRepo.Get(ctx, &userV1.GetTenantRequest{QueryBy: &userV1.GetTenantRequest_Code{Code: req.GetTenantCode()}})
-	if tenant != nil {
-		tenantId = tenant.Id
+	sysCtx := appViewer.NewSystemViewerContext(ctx)
+
+	tenantID, err := s.registrationTenantID(sysCtx, req.GetTenantCode())
+	if err != nil {
+		return nil, err
 	}
 
-	user, err := s.userRepo.Create(ctx, &userV1.CreateUserRequest{
-		Data: &userV1.User{
-			TenantId: tenantId,
-			Username: trans.Ptr(req.Username),
-			Email:    req.Email,
-		},
-	})
+	policy, err := s.registrationPolicyRepo.GetEffective(sysCtx, tenantID)
 	if err != nil {
-		s.log.Errorf("create user error: %v", err)
 		return nil, err
 	}
+	if policy.GetMode() == authenticationV1.RegistrationPolicy_CLOSED {
+		return nil, authenticationV1.ErrorRegistrationClosed("registration is closed")
+	}
 
-	if err = s.userCredentialRepo.Create(ctx, &authenticationV1.CreateUserCredentialRequest{
-		Data: &authenticationV1.UserCredential{
-			UserId:   user.Id,
-			TenantId: user.TenantId,
+	if policy.GetRequireCaptcha() {
+		if err = s.verifyCaptcha(ctx, req.GetCaptchaToken()); err != nil {
+			return nil, err
+		}
+	}
 
-			IdentityType: authenticationV1.UserCredential_USERNAME.Enum(),
-			Identifier:   trans.Ptr(req.GetUsername()),
+	if email == "" && (policy.GetRequireEmailVerification() ||
+		policy.GetMode() == authenticationV1.RegistrationPolicy_DOMAIN_RESTRICTED) {
+		return nil, authenticationV1.ErrorBadRequest("email is required")
+	}
 
-			CredentialType: authenticationV1.UserCredential_PASSWORD_HASH.Enum(),
-			Credential:     trans.Ptr(req.GetPassword()),
+	var inviteID uint32
+	switch policy.GetMode() {
+	case authenticationV1.RegistrationPolicy_DOMAIN_RESTRICTED:
+		if !data.EmailDomainAllowed(email, policy.GetAllowedDomains()) {
+			return nil, authenticationV1.ErrorEmailDomainNotAllowed("email domain is not allowed to register")
+		}
 
-			IsPrimary: trans.Ptr(true),
-			Status:    authenticationV1.UserCredential_ENABLED.Enum(),
-		},
-	}); err != nil {
-		s.log.Errorf("create user credentials error: %v", err)
+	case authenticationV1.RegistrationPolicy_INVITE_ONLY:
+		invite, err := s.inviteRepo.FindValid(sysCtx, tenantID, req.GetInviteCode())
+		if err != nil {
+			return nil, err
+		}
+		if invite.GetEmail() != "" && invite.GetEmail() != email {
+			return nil, authenticationV1.ErrorInvalidInviteCode("invite code is not issued to this email")
+		}
+		inviteID = invite.GetId()
+	}
+
+	// 先检查密码策略，不符合时不必开启事务
+	if err = s.userCredentialRepo.ValidateNewPassword(sysCtx, tenantID, req.GetPassword()); err != nil {
 		return nil, err
 	}
 
+	status := userV1.User_NORMAL
+	if policy.GetRequireEmailVerification() {
+		status = userV1.User_PENDING
+	}
+
+	newUser := &userV1.User{
+		TenantId: trans.Ptr(tenantID),
+		Username: trans.Ptr(username),
+		Status:   status.Enum(),
+	}
+	if email != "" {
+		newUser.Email = trans.Ptr(email)
+	}
+	if roleID := policy.GetDefaultRoleId(); roleID != 0 {
+		newUser.RoleIds = []uint32{roleID}
+	}
+
+	user, err := s.userRegistrationRepo.Register(sysCtx, newUser, req.GetPassword(), inviteID)
+	if err != nil {
+		s.log.Errorf("register user [%s] failed: %v", username, err)
+		return nil, err
+	}
+
+	if status == userV1.User_PENDING {
+		// 用户已创建，验证码发送失败时可以通过重新发送接口再次获取
+		if err = s.verificationRepo.Send(ctx, data.VerificationPurposeRegistration, notifier.ChannelEmail, email, user.GetId(), tenantID); err != nil {
+			s.log.Errorf("send registration code to user [%d] failed: %v", user.GetId(), err)
+		}
+	}
+
+	// 配置了事件传输时事件先持久化再分发，发布失败不影响已完成的注册
+	if err = s.eventBus.PublishAsync(ctx, eventbus.NewEvent(eventbus.EventUserCreated, eventbus.UserCreatedEvent{
+		UserID:   user.GetId(),
+		TenantID: tenantID,
+		Username: user.GetUsername(),
+		Email:    email,
+	}).WithSource("admin-service")); err != nil {
+		s.log.Errorf("publish user created event of user [%d] failed: %v", user.GetId(), err)
+	}
+
 	return &authenticationV1.RegisterUserResponse{
-		UserId: user.GetId(),
+		UserId:             user.GetId(),
+		ActivationRequired: status == userV1.User_PENDING,
 	}, nil
 }
 
+// ConfirmRegistration 校验注册激活验证码，通过后用户可以登录
+func (s *AuthenticationService) ConfirmRegistration(ctx context.Context, req *authenticationV1.ConfirmRegistrationRequest) (*emptypb.Empty, error) {
+	email := data.NormalizeVerificationTarget(notifier.ChannelEmail, req.GetEmail())
+	if email == "" || req.GetCode() == "" {
+		return nil, authenticationV1.ErrorBadRequest("email and code are required")
+	}
+
+	rec, err := s.verificationRepo.Verify(ctx, data.VerificationPurposeRegistration, notifier.ChannelEmail, email, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	sysCtx := appViewer.NewSystemViewerContext(ctx)
+
+	user, err := s.userRepo.Get(sysCtx, &userV1.GetUserRequest{
+		QueryBy: &userV1.GetUserRequest_Id{Id: rec.UserID},
+	})
+	if err != nil {
+		s.log.Errorf("get user [%d] for registration confirm failed [%s]", rec.UserID, err.Error())
+		return nil, authenticationV1.ErrorInvalidVerificationCode("verification code is invalid or expired")
+	}
+	if user.GetStatus() != userV1.User_PENDING {
+		// 已激活，或已被管理员禁用，都不再改变状态
+		return &emptypb.Empty{}, nil
+	}
+
+	if err = s.userRepo.Update(sysCtx, &userV1.UpdateUserRequest{
+		Id: user.GetId(),
+		Data: &userV1.User{
+			Id:     user.Id,
+			Status: userV1.User_NORMAL.Enum(),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+	}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResendRegistrationCode 重新发送注册激活验证码。
+// 邮箱不属于待激活用户时同样计入限流并返回成功，避免通过该接口探测已注册的邮箱。
+func (s *AuthenticationService) ResendRegistrationCode(ctx context.Context, req *authenticationV1.ResendRegistrationCodeRequest) (*emptypb.Empty, error) {
+	email := data.NormalizeVerificationTarget(notifier.ChannelEmail, req.GetEmail())
+	if email == "" {
+		return nil, authenticationV1.ErrorBadRequest("email is required")
+	}
+
+	user, err := s.findUserByContact(ctx, notifier.ChannelEmail, email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.GetStatus() != userV1.User_PENDING {
+		if err = s.verificationRepo.Throttle(ctx, data.VerificationPurposeRegistration, notifier.ChannelEmail, email); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	if err = s.verificationRepo.Send(ctx, data.VerificationPurposeRegistration, notifier.ChannelEmail, email, user.GetId(), user.GetTenantId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// registrationTenantID 按租户代码查找注册的目标租户，代码为空时注册到平台
+func (s *AuthenticationService) registrationTenantID(ctx context.Context, code string) (uint32, error) {
+	if code == "" {
+		return 0, nil
+	}
+
+	tenant, err := s.tenantRepo.Get(ctx, &userV1.GetTenantRequest{QueryBy: &userV1.GetTenantRequest_Code{Code: code}})
+	switch {
+	case err == nil:
+	case ent.IsNotFound(err):
+		return 0, authenticationV1.ErrorBadRequest("tenant not found")
+	default:
+		s.log.Errorf("query tenant [%s] for registration failed [%s]", code, err.Error())
+		return 0, authenticationV1.ErrorServiceUnavailable("query tenant failed")
+	}
+
+	if tenant.GetStatus() != userV1.Tenant_ON {
+		return 0, authenticationV1.ErrorRegistrationClosed("registration is closed")
+	}
+
+	return tenant.GetId(), nil
+}
+
+// verifyCaptcha 校验人机验证令牌，要求人机验证而没有配置校验器时拒绝注册
+func (s *AuthenticationService) verifyCaptcha(ctx context.Context, token string) error {
+	if s.captchaVerifier == nil {
+		s.log.Error("registration policy requires captcha but no captcha verifier is configured")
+		return authenticationV1.ErrorNotImplemented("captcha is not configured")
+	}
+
+	var remoteIP string
+	if r, ok := http.RequestFromServerContext(ctx); ok {
+		remoteIP = applogging.ClientRealIP(r)
+	}
+
+	if err := s.captchaVerifier.Verify(ctx, token, remoteIP); err != nil {
+		if errors.Is(err, captcha.ErrVerificationFailed) {
+			return authenticationV1.ErrorInvalidCaptcha("captcha verification failed")
+		}
+		s.log.Errorf("verify captcha failed [%s]", err.Error())
+		return authenticationV1.ErrorServiceUnavailable("captcha service unavailable")
+	}
+
+	return nil
+}
+
+// RequestPasswordReset 忘记密码，向用户绑定的邮箱或手机发送重置密码验证码。
+// 账号不存在时同样计入限流并返回成功，避免通过该接口探测已注册的邮箱或手机号码。
+func (s *AuthenticationService) RequestPasswordReset(ctx context.Context, req *authenticationV1.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	channel, target := passwordResetContact(req.GetEmail(), req.GetPhone())
+	if target == "" {
+		return nil, authenticationV1.ErrorBadRequest("email or phone is required")
+	}
+
+	user, err := s.findUserByContact(ctx, channel, target)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		if err = s.verificationRepo.Throttle(ctx, data.VerificationPurposePasswordReset, channel, target); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	if err = s.verificationRepo.Send(ctx, data.VerificationPurposePasswordReset, channel, target, user.GetId(), user.GetTenantId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ConfirmPasswordReset 校验验证码或重置链接中的令牌并设置新密码，成功后注销该用户的全部会话
+func (s *AuthenticationService) ConfirmPasswordReset(ctx context.Context, req *authenticationV1.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	channel, target := passwordResetContact(req.GetEmail(), req.GetPhone())
+	if target == "" {
+		return nil, authenticationV1.ErrorBadRequest("email or phone is required")
+	}
+	if req.GetNewPassword() == "" {
+		return nil, authenticationV1.ErrorBadRequest("new password is required")
+	}
+
+	sysCtx := appViewer.NewSystemViewerContext(ctx)
+
+	// 先按验证码所属租户的密码策略检查新密码，不符合时验证码仍然有效
+	pending, err := s.verificationRepo.Lookup(ctx, data.VerificationPurposePasswordReset, channel, target)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.userCredentialRepo.ValidateNewPassword(sysCtx, pending.TenantID, req.GetNewPassword()); err != nil {
+		return nil, err
+	}
+
+	// 验证码在密码更新成功后才作废，更新失败时可以重试
+	rec, err := s.verificationRepo.Check(ctx, data.VerificationPurposePasswordReset, channel, target, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	// 用户名只在租户内唯一，按验证码记录的租户与用户重置
+	if err = s.userCredentialRepo.ResetUserPassword(sysCtx, rec.TenantID, rec.UserID, req.GetNewPassword()); err != nil {
+		if authenticationV1.IsNotFound(err) {
+			s.log.Errorf("credential of user [%d] for password reset not found", rec.UserID)
+			return nil, authenticationV1.ErrorInvalidVerificationCode("verification code is invalid or expired")
+		}
+		return nil, err
+	}
+
+	if err = s.verificationRepo.Consume(ctx, data.VerificationPurposePasswordReset, channel, target); err != nil {
+		s.log.Warnf("consume password reset code of user [%d] failed [%s]", rec.UserID, err.Error())
+	}
+
+	// 重置密码后原有的登录会话全部失效
+	if err = s.userToken.RemoveOtherSessions(ctx, rec.UserID, ""); err != nil {
+		s.log.Errorf("remove sessions of user [%d] after password reset failed [%s]", rec.UserID, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// findUserByContact 按绑定的邮箱或手机号码查找用户，不存在或不唯一时返回 nil
+func (s *AuthenticationService) findUserByContact(ctx context.Context, channel notifier.Channel, target string) (*userV1.User, error) {
+	req := &userV1.GetUserRequest{}
+	if channel == notifier.ChannelSMS {
+		req.QueryBy = &userV1.GetUserRequest_Mobile{Mobile: target}
+	} else {
+		req.QueryBy = &userV1.GetUserRequest_Email{Email: target}
+	}
+
+	user, err := s.userRepo.Get(appViewer.NewSystemViewerContext(ctx), req)
+	switch {
+	case err == nil:
+		return user, nil
+	case ent.IsNotFound(err):
+		return nil, nil
+	case ent.IsNotSingular(err):
+		s.log.Warnf("contact [%s] is bound to more than one user, password reset skipped", target)
+		return nil, nil
+	default:
+		s.log.Errorf("query user by contact failed [%s]", err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("query user failed")
+	}
+}
+
+// passwordResetContact 重置密码的接收方，邮箱优先
+func passwordResetContact(email, phone string) (notifier.Channel, string) {
+	if email = data.NormalizeVerificationTarget(notifier.ChannelEmail, email); email != "" {
+		return notifier.ChannelEmail, email
+	}
+	return notifier.ChannelSMS, data.NormalizeVerificationTarget(notifier.ChannelSMS, phone)
+}
+
 func (s *AuthenticationService) WhoAmI(ctx context.Context, _ *emptypb.Empty) (*authenticationV1.WhoAmIResponse, error) {
 	// 获取操作人信息
 	operator, err := auth.FromContext(ctx)
@@ -542,7 +1113,171 @@ func (s *AuthenticationService) WhoAmI(ctx context.Context, _ *emptypb.Empty) (*
 	}
 
 	return &authenticationV1.WhoAmIResponse{
-		UserId:   operator.GetUserId(),
-		Username: operator.GetUsername(),
+		UserId:       operator.GetUserId(),
+		Username:     operator.GetUsername(),
+		TenantId:     operator.TenantId,
+		Impersonated: operator.GetActor() != nil,
+		Actor:        operator.GetActor(),
+		ReadOnly:     operator.GetReadOnly(),
 	}, nil
 }
+
+// impersonationProtectedPermissionCodes 持有这些权限的用户不能被模拟，避免借模拟登录提升权限
+var impersonationProtectedPermissionCodes = []string{
+	constants.SystemPlatformAdminPermissionCode,
+	constants.SystemTenantManagerPermissionCode,
+	constants.SystemImpersonateUserPermissionCode,
+}
+
+// Impersonate 模拟登录为同租户的其他用户。
+// 只签发短期访问令牌，不签发刷新令牌；令牌携带实际操作者（act），配置了只读时只允许读操作。
+func (s *AuthenticationService) Impersonate(ctx context.Context, req *authenticationV1.ImpersonateRequest) (*authenticationV1.LoginResponse, error) {
+	if req == nil || req.GetUserId() == 0 {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+	if strings.TrimSpace(req.GetReason()) == "" {
+		return nil, adminV1.ErrorBadRequest("impersonation reason is required")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenPayload, ttl, accessToken, err := s.doImpersonate(ctx, operator, req)
+
+	detail := map[string]any{
+		"reason":    req.GetReason(),
+		"read_only": s.impersonation.GetReadOnly(),
+	}
+	if tokenPayload != nil {
+		detail["session_id"] = tokenPayload.GetSessionId()
+		detail["expires_in"] = int64(ttl.Seconds())
+	}
+	writeOperationAuditLog(ctx, s.operationLogRepo, s.log, operator, "user", strconv.FormatUint(uint64(req.GetUserId()), 10), auditV1.OperationAuditLog_OTHER, detail, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Warnf("user [%d] impersonated user [%d] for [%s]", operator.GetUserId(), req.GetUserId(), req.GetReason())
+
+	return &authenticationV1.LoginResponse{
+		TokenType:   authenticationV1.TokenType_bearer,
+		AccessToken: accessToken,
+		ExpiresIn:   int64(ttl.Seconds()),
+	}, nil
+}
+
+func (s *AuthenticationService) doImpersonate(
+	ctx context.Context,
+	operator *authenticationV1.UserTokenPayload,
+	req *authenticationV1.ImpersonateRequest,
+) (*authenticationV1.UserTokenPayload, time.Duration, string, error) {
+	// 客户端令牌没有用户身份，模拟登录令牌不能再次模拟
+	if operator.GetSubjectType() == authenticationV1.UserTokenPayload_CLIENT {
+		return nil, 0, "", adminV1.ErrorForbidden("client tokens cannot impersonate users")
+	}
+	if operator.GetActor() != nil {
+		return nil, 0, "", adminV1.ErrorForbidden("nested impersonation is not allowed")
+	}
+	if operator.GetUserId() == req.GetUserId() {
+		return nil, 0, "", adminV1.ErrorBadRequest("cannot impersonate yourself")
+	}
+
+	// 与登录一样绕过隐私保护，租户范围在下面显式校验
+	sysCtx := privacy.DecisionContext(viewer.WithContext(ctx, viewer.NewNoopContext()), privacy.Allow)
+
+	codes, err := s.listUserPermissionCodes(sysCtx, operator.GetUserId(), operator.GetTenantId())
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if !containsPermission(codes, constants.SystemImpersonateUserPermissionCode) {
+		return nil, 0, "", adminV1.ErrorForbidden("no permission to impersonate users")
+	}
+
+	target, err := s.userRepo.Get(sysCtx, &userV1.GetUserRequest{
+		QueryBy: &userV1.GetUserRequest_Id{Id: req.GetUserId()},
+	})
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if target.GetTenantId() != operator.GetTenantId() {
+		// 不暴露其他租户的用户是否存在
+		return nil, 0, "", adminV1.ErrorNotFound("user not found")
+	}
+
+	if codes, err = s.listUserPermissionCodes(sysCtx, target.GetId(), target.GetTenantId()); err != nil {
+		return nil, 0, "", err
+	}
+	for _, code := range impersonationProtectedPermissionCodes {
+		if containsPermission(codes, code) {
+			return nil, 0, "", adminV1.ErrorForbidden("cannot impersonate an administrator")
+		}
+	}
+
+	tokenPayload := &authenticationV1.UserTokenPayload{
+		UserId:   target.GetId(),
+		TenantId: target.TenantId,
+		Username: target.Username,
+		ClientId: operator.ClientId,
+		DeviceId: operator.DeviceId,
+		Actor: &authenticationV1.TokenActor{
+			UserId:   operator.GetUserId(),
+			Username: operator.Username,
+			TenantId: operator.TenantId,
+		},
+	}
+	if s.impersonation.GetReadOnly() {
+		tokenPayload.ReadOnly = trans.Ptr(true)
+	}
+
+	// 解析被模拟用户的权限信息，被禁用或无后台权限的用户不能被模拟
+	if err = s.resolveUserAuthority(sysCtx, target, tokenPayload); err != nil {
+		s.log.Errorf("resolve user [%d] authority failed [%s]", target.GetId(), err.Error())
+		return nil, 0, "", err
+	}
+
+	ip, userAgent := sessionClientInfo(ctx)
+	accessToken, ttl, err := s.userToken.GenerateImpersonationToken(ctx, tokenPayload, ip, userAgent, s.impersonation.GetTokenTtl().AsDuration())
+	if err != nil {
+		s.log.Errorf("generate impersonation token for user [%d] failed [%s]", target.GetId(), err.Error())
+		return nil, 0, "", authenticationV1.ErrorServiceUnavailable("generate token failed")
+	}
+
+	return tokenPayload, ttl, accessToken, nil
+}
+
+// listUserPermissionCodes 获取用户在指定租户下的权限代码列表
+func (s *AuthenticationService) listUserPermissionCodes(ctx context.Context, userID, tenantID uint32) ([]string, error) {
+	var roleIDs []uint32
+	var err error
+	switch constants.DefaultUserTenantRelationType {
+	case constants.UserTenantRelationOneToMany:
+		var membership *userV1.Membership
+		if membership, err = s.membershipRepo.GetMembershipByUserTenant(ctx, userID); err == nil {
+			roleIDs, err = s.membershipRepo.GetRoleIDsByMembership(ctx, membership.GetId())
+		}
+	default:
+		roleIDs, err = s.userRepo.ListRoleIDsByUserID(ctx, userID)
+	}
+	if err != nil {
+		s.log.Errorf("get roles of user [%d] in tenant [%d] failed [%s]", userID, tenantID, err.Error())
+		return nil, adminV1.ErrorForbidden("insufficient authority")
+	}
+	if len(roleIDs) == 0 {
+		return nil, nil
+	}
+
+	permissionIDs, err := s.roleRepo.ListPermissionIDsByRoleIDs(ctx, roleIDs)
+	if err != nil {
+		s.log.Errorf("get permissions by role ids failed [%s]", err.Error())
+		return nil, adminV1.ErrorForbidden("insufficient authority")
+	}
+	if len(permissionIDs) == 0 {
+		return nil, nil
+	}
+
+	return s.permissionRepo.GetPermissionCodesByIDs(ctx, permissionIDs)
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+func TestResolveClientScopes(t *testing.T) {
+	allowed := []string{"sys:user:get", "sys:user:list"}
+
+	// 未请求时授予全部允许的范围
+	scopes, err := resolveClientScopes(allowed, "")
+	assert.NoError(t, err)
+	assert.Equal(t, allowed, scopes)
+
+	scopes, err = resolveClientScopes(allowed, " sys:user:list  sys:user:list ")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sys:user:list"}, scopes)
+
+	_, err = resolveClientScopes(allowed, "sys:user:list sys:user:delete")
+	assert.True(t, authenticationV1.IsForbidden(err))
+
+	_, err = resolveClientScopes(nil, "")
+	assert.True(t, authenticationV1.IsForbidden(err))
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+
+	"go-wind-admin/pkg/backup"
+	"go-wind-admin/pkg/crypto"
+	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/task"
+)
+
+// 备份对象布局：<名称>/<时间>.jsonl.gz[.enc]，每次运行的结果记录在 <名称>/<时间>.manifest.json
+const (
+	defaultBackupName = "database"
+
+	backupTimeLayout   = "20060102T150405.000Z"
+	backupArchiveExt   = ".jsonl.gz"
+	backupEncryptedExt = ".enc"
+	backupManifestExt  = ".manifest.json"
+
+	BackupStatusSucceeded = "succeeded"
+	BackupStatusFailed    = "failed"
+)
+
+var backupNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// BackupManifest 单次备份的运行结果
+type BackupManifest struct {
+	Name      string `json:"name"`
+	Object    string `json:"object"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	Dialect   string `json:"dialect"`
+	Encrypted bool   `json:"encrypted"`
+	Size      int64  `json:"size"`
+
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+
+	Rows   int64              `json:"rows"`
+	Tables []backup.TableStat `json:"tables,omitempty"`
+}
+
+// BackupService 数据库备份服务
+type BackupService struct {
+	log *log.Helper
+
+	backupRepo *data.BackupRepo
+	mc         *oss.MinIOClient
+
+	bucket     string
+	tables     []string
+	keepLast   uint32
+	keepWithin time.Duration
+	encryptor  *crypto.Encryptor
+}
+
+func NewBackupService(
+	ctx *bootstrap.Context,
+	backupRepo *data.BackupRepo,
+	mc *oss.MinIOClient,
+	cfg *adminConfV1.Bootstrap,
+) (*BackupService, error) {
+	c := cfg.GetBackup()
+
+	svc := &BackupService{
+		log:        ctx.NewLoggerHelper("backup/service/admin-service"),
+		backupRepo: backupRepo,
+		mc:         mc,
+		bucket:     c.GetBucket(),
+		tables:     c.GetTables(),
+		keepLast:   c.GetKeepLast(),
+		keepWithin: c.GetKeepWithin().AsDuration(),
+	}
+	if svc.bucket == "" {
+		svc.bucket = oss.BucketBackups
+	}
+
+	// 配置了密钥却无法创建加密器时拒绝启动，不能退化为上传明文备份
+	if key := c.GetEncryptionKey(); key != "" {
+		var err error
+		if svc.encryptor, err = crypto.NewEncryptor(key); err != nil {
+			svc.log.Errorf("create backup encryptor failed: %s", err.Error())
+			return nil, fmt.Errorf("create backup encryptor: %w", err)
+		}
+	}
+
+	return svc, nil
+}
+
+// AsyncBackup 异步备份
+func (s *BackupService) AsyncBackup(ctx context.Context, taskType string, taskData *task.BackupTaskData) error {
+	if taskData == nil {
+		taskData = &task.BackupTaskData{}
+	}
+
+	m, err := s.Backup(ctx, taskData)
+	if err != nil {
+		s.log.Errorf("[%s] 数据库备份失败[%s]", taskType, err.Error())
+		return err
+	}
+
+	s.log.Infof("[%s] 数据库备份完成，对象[%s]，数据[%d]行，大小[%d]字节", taskType, m.Object, m.Rows, m.Size)
+
+	task.ProgressFromContext(ctx).SetResult(m)
+
+	return nil
+}
+
+// Backup 导出数据库并上传到对象存储，随后按保留策略清理旧备份
+// 无论成功与否都会写入运行结果清单。
+func (s *BackupService) Backup(ctx context.Context, req *task.BackupTaskData) (*BackupManifest, error) {
+	name := req.Name
+	if name == "" {
+		name = defaultBackupName
+	}
+	if !backupNamePattern.MatchString(name) {
+		return nil, adminV1.ErrorBadRequest("invalid backup name [%s]", name)
+	}
+
+	tableNames := req.Tables
+	if len(tableNames) == 0 {
+		tableNames = s.tables
+	}
+	tables, err := s.backupRepo.Tables(tableNames)
+	if err != nil {
+		return nil, err
+	}
+
+	startedAt := time.Now().UTC()
+	stem := path.Join(name, startedAt.Format(backupTimeLayout))
+
+	m := &BackupManifest{
+		Name:      name,
+		Object:    stem + backupArchiveExt,
+		Dialect:   s.backupRepo.Dialect(),
+		Encrypted: s.encryptor != nil,
+		StartedAt: startedAt,
+	}
+	if m.Encrypted {
+		m.Object += backupEncryptedExt
+	}
+
+	stats, size, err := s.upload(ctx, m.Object, tables)
+
+	m.FinishedAt = time.Now().UTC()
+	if err != nil {
+		m.Status = BackupStatusFailed
+		m.Error = err.Error()
+	} else {
+		m.Status = BackupStatusSucceeded
+		m.Size = size
+		m.Rows = stats.Rows
+		m.Tables = stats.Tables
+	}
+
+	if merr := s.writeManifest(ctx, stem, m); merr != nil {
+		s.log.Errorf("write backup manifest [%s] failed: %s", stem, merr.Error())
+	}
+
+	if err != nil {
+		return m, err
+	}
+
+	keepLast := s.keepLast
+	if req.KeepLast > 0 {
+		keepLast = req.KeepLast
+	}
+	keepWithin := s.keepWithin
+	if req.KeepDays > 0 {
+		keepWithin = time.Duration(req.KeepDays) * 24 * time.Hour
+	}
+	if rerr := s.applyRetention(ctx, name, keepLast, keepWithin, startedAt); rerr != nil {
+		s.log.Warnf("apply backup retention [%s] failed: %s", name, rerr.Error())
+	}
+
+	return m, nil
+}
+
+// upload 边导出边压缩（加密）边上传，不在本地落盘
+func (s *BackupService) upload(ctx context.Context, object string, tables []string) (*backup.Stats, int64, error) {
+	pr, pw := io.Pipe()
+
+	var stats *backup.Stats
+	dumpDone := make(chan error, 1)
+	go func() {
+		aw, err := backup.NewArchiveWriter(pw, s.encryptor)
+		if err == nil {
+			stats, err = s.backupRepo.Dump(ctx, tables, aw)
+			if cerr := aw.Close(); err == nil {
+				err = cerr
+			}
+		}
+		_ = pw.CloseWithError(err)
+		dumpDone <- err
+	}()
+
+	info, err := s.mc.UploadStream(ctx, s.bucket, object, pr, "application/octet-stream")
+	// 上传提前失败时让导出协程退出
+	_ = pr.CloseWithError(err)
+
+	if dumpErr := <-dumpDone; dumpErr != nil {
+		if err == nil {
+			_ = s.mc.DeleteFile(context.WithoutCancel(ctx), s.bucket, object)
+		}
+		return nil, 0, dumpErr
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return stats, info.Size, nil
+}
+
+func (s *BackupService) writeManifest(ctx context.Context, stem string, m *BackupManifest) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.mc.UploadFile(context.WithoutCancel(ctx), s.bucket, stem+backupManifestExt, b)
+	return err
+}
+
+// backupStem 从对象名中去掉扩展名，得到 <名称>/<时间>；不是备份对象时返回空
+func backupStem(objectName string) string {
+	for _, ext := range []string{backupManifestExt, backupArchiveExt + backupEncryptedExt, backupArchiveExt} {
+		if strings.HasSuffix(objectName, ext) {
+			return strings.TrimSuffix(objectName, ext)
+		}
+	}
+	return ""
+}
+
+// expiredBackups 按保留策略选出需要清理的备份
+// 备份只要满足任一条件即保留：属于最近的 keepLast 份，或在 keepWithin 时间内；两个条件都未设置时全部保留。
+func expiredBackups(stems []string, keepLast uint32, keepWithin time.Duration, now time.Time) []string {
+	if keepLast == 0 && keepWithin <= 0 {
+		return nil
+	}
+
+	type item struct {
+		stem string
+		at   time.Time
+	}
+
+	items := make([]item, 0, len(stems))
+	for _, stem := range stems {
+		at, err := time.Parse(backupTimeLayout, path.Base(stem))
+		if err != nil {
+			// 无法识别的对象不做处理
+			continue
+		}
+		items = append(items, item{stem: stem, at: at})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].at.After(items[j].at) })
+
+	var expired []string
+	for i, it := range items {
+		if uint32(i) < keepLast {
+			continue
+		}
+		if keepWithin > 0 && now.Sub(it.at) <= keepWithin {
+			continue
+		}
+		expired = append(expired, it.stem)
+	}
+
+	return expired
+}
+
+// applyRetention 清理名称下超出保留策略的备份及其运行结果清单
+func (s *BackupService) applyRetention(ctx context.Context, name string, keepLast uint32, keepWithin time.Duration, now time.Time) error {
+	objects, err := s.mc.ListObjects(ctx, s.bucket, name+"/")
+	if err != nil {
+		return err
+	}
+
+	groups := make(map[string][]string)
+	for _, o := range objects {
+		if stem := backupStem(o.Key); stem != "" {
+			groups[stem] = append(groups[stem], o.Key)
+		}
+	}
+
+	stems := make([]string, 0, len(groups))
+	for stem := range groups {
+		stems = append(stems, stem)
+	}
+
+	var errs []error
+	for _, stem := range expiredBackups(stems, keepLast, keepWithin, now) {
+		for _, key := range groups[stem] {
+			if err = s.mc.DeleteFile(ctx, s.bucket, key); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		s.log.Infof("removed expired backup [%s]", stem)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Restore 从对象存储中的备份恢复数据库，opts.DryRun 为 true 时只做校验
+func (s *BackupService) Restore(ctx context.Context, bucket, object string, opts backup.RestoreOptions) (*backup.Header, *backup.Stats, error) {
+	if bucket == "" {
+		bucket = s.bucket
+	}
+	if object == "" {
+		return nil, nil, adminV1.ErrorBadRequest("backup object is required")
+	}
+
+	rc, err := s.mc.OpenObject(ctx, bucket, object)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rc.Close()
+
+	ar, err := backup.NewArchiveReader(rc, s.encryptor)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer ar.Close()
+
+	header, stats, err := s.backupRepo.Restore(ctx, ar, opts)
+	if err != nil {
+		return header, nil, err
+	}
+
+	// 读完剩余数据以校验压缩流的校验和
+	if _, err = io.Copy(io.Discard, ar); err != nil {
+		return header, nil, err
+	}
+
+	return header, stats, nil
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupStem(t *testing.T) {
+	assert.Equal(t, "db/20240102T030405.000Z", backupStem("db/20240102T030405.000Z.jsonl.gz"))
+	assert.Equal(t, "db/20240102T030405.000Z", backupStem("db/20240102T030405.000Z.jsonl.gz.enc"))
+	assert.Equal(t, "db/20240102T030405.000Z", backupStem("db/20240102T030405.000Z.manifest.json"))
+	assert.Empty(t, backupStem("db/readme.txt"))
+}
+
+func TestExpiredBackups(t *testing.T) {
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	var stems []string
+	for day := 1; day <= 9; day++ {
+		stems = append(stems, "db/"+time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC).Format(backupTimeLayout))
+	}
+	stems = append(stems, "db/unknown")
+
+	// 未设置保留策略，全部保留
+	assert.Empty(t, expiredBackups(stems, 0, 0, now))
+
+	// 只按份数保留
+	assert.ElementsMatch(t, stems[:6], expiredBackups(stems, 3, 0, now))
+
+	// 只按时间保留
+	assert.ElementsMatch(t, stems[:4], expiredBackups(stems, 0, 5*24*time.Hour, now))
+
+	// 满足任一条件即保留
+	assert.ElementsMatch(t, stems[:2], expiredBackups(stems, 7, 2*24*time.Hour, now))
+	assert.ElementsMatch(t, stems[:4], expiredBackups(stems, 1, 5*24*time.Hour, now))
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"path"
 
 	"github.com/go-kratos/kratos/v2/log"
 	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
@@ -23,19 +24,22 @@ type FileService struct {
 
 	log *log.Helper
 
-	fileRepo *data.FileRepo
-	mc       *oss.MinIOClient
+	fileRepo   *data.FileRepo
+	objectLock *data.FileObjectLockRepo
+	mc         *oss.MinIOClient
 }
 
 func NewFileService(
 	ctx *bootstrap.Context,
 	fileRepo *data.FileRepo,
+	objectLock *data.FileObjectLockRepo,
 	mc *oss.MinIOClient,
 ) *FileService {
 	return &FileService{
-		log:      ctx.NewLoggerHelper("file/service/admin-service"),
-		fileRepo: fileRepo,
-		mc:       mc,
+		log:        ctx.NewLoggerHelper("file/service/admin-service"),
+		fileRepo:   fileRepo,
+		objectLock: objectLock,
+		mc:         mc,
 	}
 }
 
@@ -102,12 +106,48 @@ func (s *FileService) Delete(ctx context.Context, req *fileV1.DeleteFileRequest)
 		return nil, err
 	}
 
-	if err = s.mc.DeleteFile(ctx,
-		f.GetBucketName(),
-		f.GetFileDirectory()+"/"+f.GetSaveFileName(),
-	); err != nil {
-		return nil, err
-	}
+	// 内容去重时多条记录可能共享同一对象，仅当没有记录引用时才删除物理对象
+	removeUnreferencedObject(ctx, s.log, s.fileRepo, s.objectLock, s.mc, f.GetBucketName(), f.GetFileDirectory(), f.GetSaveFileName())
 
 	return &emptypb.Empty{}, nil
 }
+
+// fileObjectName 返回文件记录对应的存储对象名
+func fileObjectName(fileDirectory, saveFileName string) string {
+	return path.Join(fileDirectory, saveFileName)
+}
+
+// removeUnreferencedObject 当没有任何文件记录引用该对象时，删除物理对象
+// 统计与删除在对象锁内执行，与内容去重上传复用该对象互斥。
+func removeUnreferencedObject(
+	ctx context.Context,
+	l *log.Helper,
+	fileRepo *data.FileRepo,
+	objectLock *data.FileObjectLockRepo,
+	mc *oss.MinIOClient,
+	bucketName, fileDirectory, saveFileName string,
+) {
+	objectName := fileObjectName(fileDirectory, saveFileName)
+
+	unlock, err := objectLock.Lock(ctx, bucketName, objectName)
+	if err != nil {
+		l.Warnf("lock object [%s/%s] failed, keep it: %v", bucketName, objectName, err)
+		return
+	}
+	defer unlock()
+
+	count, err := fileRepo.CountObjectReferences(ctx, bucketName, fileDirectory, saveFileName)
+	if err != nil {
+		l.Warnf("count references of object [%s/%s] failed, keep it: %v", bucketName, objectName, err)
+		return
+	}
+	if count > 0 {
+		return
+	}
+
+	if err = mc.DeleteFile(ctx, bucketName, objectName); err != nil {
+		l.Warnf("remove unreferenced object [%s/%s] failed: %v", bucketName, objectName, err)
+		return
+	}
+	removeImageVariants(ctx, l, mc, bucketName, fileDirectory, saveFileName)
+}
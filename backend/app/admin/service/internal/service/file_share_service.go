@@ -0,0 +1,436 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/password"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	authnEngine "github.com/tx7do/kratos-authn/engine"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/jwt"
+	"go-wind-admin/pkg/middleware/auth"
+	applogging "go-wind-admin/pkg/middleware/logging"
+	"go-wind-admin/pkg/oss"
+)
+
+const (
+	// fileShareSlugBytes 分享链接标识的随机字节数，编码后为 22 个字符
+	fileShareSlugBytes = 16
+
+	// fileShareRedirectExpireSeconds 重定向时预签名地址的有效期
+	fileShareRedirectExpireSeconds = 300
+
+	// HeaderKeyXSharePassword 分享链接访问密码请求头
+	HeaderKeyXSharePassword = "X-Share-Password"
+
+	fileShareAuditTable   = "file_shares"
+	fileShareAuditPurpose = "file:share_download"
+)
+
+type FileShareService struct {
+	adminV1.FileShareServiceHTTPServer
+
+	log *log.Helper
+
+	shareRepo     *data.FileShareRepo
+	attemptRepo   *data.FileShareAttemptRepo
+	fileRepo      *data.FileRepo
+	auditLogRepo  *data.DataAccessAuditLogRepo
+	userTokenRepo *data.UserTokenCacheRepo
+
+	passwordCrypto password.Crypto
+	authenticator  authnEngine.Authenticator
+
+	mc *oss.MinIOClient
+}
+
+func NewFileShareService(
+	ctx *bootstrap.Context,
+	shareRepo *data.FileShareRepo,
+	attemptRepo *data.FileShareAttemptRepo,
+	fileRepo *data.FileRepo,
+	auditLogRepo *data.DataAccessAuditLogRepo,
+	userTokenRepo *data.UserTokenCacheRepo,
+	passwordCrypto password.Crypto,
+	authenticator authnEngine.Authenticator,
+	mc *oss.MinIOClient,
+) *FileShareService {
+	return &FileShareService{
+		log:            ctx.NewLoggerHelper("file-share/service/admin-service"),
+		shareRepo:      shareRepo,
+		attemptRepo:    attemptRepo,
+		fileRepo:       fileRepo,
+		auditLogRepo:   auditLogRepo,
+		userTokenRepo:  userTokenRepo,
+		passwordCrypto: passwordCrypto,
+		authenticator:  authenticator,
+		mc:             mc,
+	}
+}
+
+func (s *FileShareService) List(ctx context.Context, req *paginationV1.PagingRequest) (*fileV1.ListFileShareResponse, error) {
+	return s.shareRepo.List(ctx, req)
+}
+
+func (s *FileShareService) Get(ctx context.Context, req *fileV1.GetFileShareRequest) (*fileV1.FileShare, error) {
+	return s.shareRepo.Get(ctx, req)
+}
+
+func (s *FileShareService) Create(ctx context.Context, req *fileV1.CreateFileShareRequest) (*fileV1.FileShare, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只能分享当前租户可见的文件
+	f, err := s.fileRepo.Get(ctx, &fileV1.GetFileRequest{
+		QueryBy: &fileV1.GetFileRequest_Id{Id: req.Data.GetFileId()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = ensureNotQuarantined(f); err != nil {
+		return nil, err
+	}
+
+	slug, err := generateShareSlug()
+	if err != nil {
+		s.log.Errorf("generate file share slug failed: %v", err)
+		return nil, fileV1.ErrorInternalServerError("generate file share slug failed")
+	}
+
+	passwordHash, err := s.hashPassword(req.Data.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.Id = nil
+	req.Data.Slug = trans.Ptr(slug)
+	req.Data.TenantId = f.TenantId
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	return s.shareRepo.Create(ctx, req, passwordHash)
+}
+
+func (s *FileShareService) Update(ctx context.Context, req *fileV1.UpdateFileShareRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+	if req.UpdateMask != nil {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
+	}
+
+	// 密码字段出现在请求中时才修改，空字符串表示清除密码
+	var passwordHash *string
+	if req.Data.Password != nil {
+		hash, err := s.hashPassword(req.Data.GetPassword())
+		if err != nil {
+			return nil, err
+		}
+		passwordHash = trans.Ptr(hash)
+	}
+	req.Data.Password = nil
+
+	if err = s.shareRepo.Update(ctx, req, passwordHash); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *FileShareService) Delete(ctx context.Context, req *fileV1.DeleteFileShareRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	if err := s.shareRepo.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Access 通过分享链接访问文件，无论成功与否都会写入数据访问审计日志
+func (s *FileShareService) Access(ctx context.Context, req *fileV1.AccessFileShareRequest) (*fileV1.DownloadFileResponse, error) {
+	if req == nil || req.GetSlug() == "" {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	start := time.Now()
+
+	// 公开接口没有 viewer 信息，以系统身份查询
+	ctx = appViewer.NewSystemViewerContext(ctx)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	visitor := s.visitor(ctx)
+
+	share, resp, err := s.access(ctx, req, visitor)
+
+	s.writeAccessLog(ctx, req.GetSlug(), share, visitor, time.Since(start), err)
+
+	return resp, err
+}
+
+func (s *FileShareService) access(
+	ctx context.Context,
+	req *fileV1.AccessFileShareRequest,
+	visitor *authenticationV1.UserTokenPayload,
+) (*ent.FileShare, *fileV1.DownloadFileResponse, error) {
+	share, err := s.shareRepo.GetBySlug(ctx, req.GetSlug())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = checkShareAvailable(share, time.Now()); err != nil {
+		return share, nil, err
+	}
+	if err = checkShareVisitor(share, visitor); err != nil {
+		return share, nil, err
+	}
+	if err = s.checkSharePassword(ctx, share, req.Password); err != nil {
+		return share, nil, err
+	}
+
+	f, err := s.fileRepo.Get(ctx, &fileV1.GetFileRequest{
+		QueryBy: &fileV1.GetFileRequest_Id{Id: share.FileID},
+	})
+	if err != nil {
+		return share, nil, err
+	}
+	if err = ensureNotQuarantined(f); err != nil {
+		return share, nil, err
+	}
+
+	resp, err := s.mc.DownloadFile(ctx, &fileV1.DownloadFileRequest{
+		Selector: &fileV1.DownloadFileRequest_StorageObject{
+			StorageObject: &fileV1.StorageObject{
+				BucketName: f.BucketName,
+				ObjectName: trans.Ptr(fileObjectName(f.GetFileDirectory(), f.GetSaveFileName())),
+			},
+		},
+		PreferPresignedUrl:   req.Redirect,
+		PresignExpireSeconds: trans.Ptr(int32(fileShareRedirectExpireSeconds)),
+	})
+	if err != nil {
+		return share, nil, err
+	}
+
+	// 文件取到后才计入下载次数，并发访问时由条件更新保证不超过上限
+	ok, err := s.shareRepo.ConsumeDownload(ctx, share.ID)
+	if err != nil {
+		return share, nil, err
+	}
+	if !ok {
+		return share, nil, fileV1.ErrorFileShareDownloadLimitReached("file share download limit reached")
+	}
+
+	resp.SourceFileName = f.GetFileName()
+	if f.GetContentType() != "" {
+		resp.Mime = f.GetContentType()
+	}
+	if resp.Size == 0 {
+		resp.Size = int64(f.GetSize())
+	}
+
+	return share, resp, nil
+}
+
+// checkShareAvailable 检查分享链接是否已停用、过期或达到最大下载次数
+func checkShareAvailable(share *ent.FileShare, now time.Time) error {
+	if trans.BoolValue(share.Disabled) {
+		return fileV1.ErrorFileShareExpired("file share is disabled")
+	}
+	if share.ExpiresAt != nil && !now.Before(*share.ExpiresAt) {
+		return fileV1.ErrorFileShareExpired("file share has expired")
+	}
+	if maxDownloads := trans.Uint32Value(share.MaxDownloads); maxDownloads > 0 && trans.Uint32Value(share.DownloadCount) >= maxDownloads {
+		return fileV1.ErrorFileShareDownloadLimitReached("file share download limit reached")
+	}
+	return nil
+}
+
+// checkShareVisitor 限定了租户或用户时，访问者必须登录，且属于允许的租户或是允许的用户
+func checkShareVisitor(share *ent.FileShare, visitor *authenticationV1.UserTokenPayload) error {
+	if len(share.AllowedTenantIds) == 0 && len(share.AllowedUserIds) == 0 {
+		return nil
+	}
+
+	if visitor == nil {
+		return fileV1.ErrorUnauthorized("file share requires authentication")
+	}
+
+	if slices.Contains(share.AllowedUserIds, visitor.GetUserId()) {
+		return nil
+	}
+	if visitor.TenantId != nil && slices.Contains(share.AllowedTenantIds, visitor.GetTenantId()) {
+		return nil
+	}
+
+	return fileV1.ErrorFileShareAccessDenied("access to file share denied")
+}
+
+// checkSharePassword 校验访问密码，请求体未携带时读取 X-Share-Password 请求头。
+// 同一分享链接、同一客户端连续输错达到上限后，窗口期内不再校验
+func (s *FileShareService) checkSharePassword(ctx context.Context, share *ent.FileShare, pwd *string) error {
+	hash := trans.StringValue(share.PasswordHash)
+	if hash == "" {
+		return nil
+	}
+
+	// 按直连的对端地址计数，转发头可以被客户端随意伪造
+	var clientIP string
+	if r, ok := http.RequestFromServerContext(ctx); ok {
+		clientIP = applogging.ClientPeerIP(r)
+	}
+	if err := s.attemptRepo.Check(ctx, share.ID, clientIP); err != nil {
+		return err
+	}
+
+	plain := trans.StringValue(pwd)
+	if plain == "" {
+		if tr, ok := transport.FromServerContext(ctx); ok {
+			plain = tr.RequestHeader().Get(HeaderKeyXSharePassword)
+		}
+	}
+	if plain == "" {
+		return fileV1.ErrorFileSharePasswordRequired("file share password required")
+	}
+
+	ok, err := s.passwordCrypto.Verify(plain, hash)
+	if err != nil || !ok {
+		s.attemptRepo.Fail(ctx, share.ID, clientIP)
+		return fileV1.ErrorFileSharePasswordIncorrect("file share password incorrect")
+	}
+
+	s.attemptRepo.Reset(ctx, share.ID, clientIP)
+	return nil
+}
+
+// visitor 解析访问者身份：公开接口不经过认证中间件，携带了有效令牌时才能识别访问者
+func (s *FileShareService) visitor(ctx context.Context) *authenticationV1.UserTokenPayload {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	token := strings.TrimSpace(strings.TrimPrefix(tr.RequestHeader().Get("Authorization"), "Bearer "))
+	if token == "" {
+		return nil
+	}
+
+	claims, err := s.authenticator.AuthenticateToken(token)
+	if err != nil {
+		return nil
+	}
+
+	payload, err := jwt.NewUserTokenPayloadWithClaims(claims)
+	if err != nil {
+		return nil
+	}
+
+	// 与认证中间件一致，已注销会话的令牌不能再识别为访问者
+	if payload.GetSessionId() != "" && !s.userTokenRepo.IsActiveSession(ctx, payload.GetUserId(), payload.GetSessionId()) {
+		return nil
+	}
+
+	return payload
+}
+
+func (s *FileShareService) hashPassword(plain string) (string, error) {
+	if plain == "" {
+		return "", nil
+	}
+
+	hash, err := s.passwordCrypto.Encrypt(plain)
+	if err != nil {
+		s.log.Errorf("encrypt file share password failed: %v", err)
+		return "", fileV1.ErrorInternalServerError("encrypt file share password failed")
+	}
+	return hash, nil
+}
+
+// writeAccessLog 记录分享链接访问日志，写入失败只记录错误，不影响本次访问
+func (s *FileShareService) writeAccessLog(
+	ctx context.Context,
+	slug string,
+	share *ent.FileShare,
+	visitor *authenticationV1.UserTokenPayload,
+	latency time.Duration,
+	accessErr error,
+) {
+	entry := &auditV1.DataAccessAuditLog{
+		DataSource:      trans.Ptr("oss"),
+		TableName:       trans.Ptr(fileShareAuditTable),
+		DataId:          trans.Ptr(slug),
+		AccessType:      trans.Ptr(auditV1.DataAccessAuditLog_EXPORT),
+		LatencyMs:       trans.Ptr(uint32(latency.Milliseconds())),
+		Success:         trans.Ptr(accessErr == nil),
+		BusinessPurpose: trans.Ptr(fileShareAuditPurpose),
+		DataCategory:    trans.Ptr("file"),
+	}
+
+	if share != nil {
+		entry.TenantId = share.TenantID
+	}
+	if visitor != nil {
+		entry.UserId = trans.Ptr(visitor.GetUserId())
+		entry.Username = visitor.Username
+	}
+	if r, ok := http.RequestFromServerContext(ctx); ok {
+		entry.IpAddress = trans.Ptr(applogging.ClientRealIP(r))
+		entry.RequestId = trans.Ptr(applogging.RequestID(r))
+	}
+
+	if accessErr != nil {
+		s.log.Infof("file share [%s] access denied: %s", slug, errors.FromError(accessErr).GetReason())
+	}
+
+	if err := s.auditLogRepo.Create(ctx, &auditV1.CreateDataAccessAuditLogRequest{Data: entry}); err != nil {
+		s.log.Errorf("write file share access log failed: %v", err)
+	}
+}
+
+// generateShareSlug 生成 URL 安全的随机分享链接标识
+func generateShareSlug() (string, error) {
+	b := make([]byte, fileShareSlugBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+)
+
+func TestCheckShareAvailable(t *testing.T) {
+	now := time.Now()
+
+	assert.NoError(t, checkShareAvailable(&ent.FileShare{}, now))
+
+	err := checkShareAvailable(&ent.FileShare{Disabled: trans.Ptr(true)}, now)
+	assert.True(t, fileV1.IsFileShareExpired(err))
+
+	err = checkShareAvailable(&ent.FileShare{ExpiresAt: trans.Ptr(now.Add(-time.Minute))}, now)
+	assert.True(t, fileV1.IsFileShareExpired(err))
+	assert.NoError(t, checkShareAvailable(&ent.FileShare{ExpiresAt: trans.Ptr(now.Add(time.Minute))}, now))
+
+	err = checkShareAvailable(&ent.FileShare{MaxDownloads: trans.Ptr(uint32(2)), DownloadCount: trans.Ptr(uint32(2))}, now)
+	assert.True(t, fileV1.IsFileShareDownloadLimitReached(err))
+	assert.NoError(t, checkShareAvailable(&ent.FileShare{MaxDownloads: trans.Ptr(uint32(2)), DownloadCount: trans.Ptr(uint32(1))}, now))
+	assert.NoError(t, checkShareAvailable(&ent.FileShare{MaxDownloads: trans.Ptr(uint32(0)), DownloadCount: trans.Ptr(uint32(100))}, now))
+}
+
+func TestCheckShareVisitor(t *testing.T) {
+	// 未限定租户与用户时，匿名访问者也可访问
+	assert.NoError(t, checkShareVisitor(&ent.FileShare{}, nil))
+
+	share := &ent.FileShare{
+		AllowedTenantIds: []uint32{10},
+		AllowedUserIds:   []uint32{7},
+	}
+
+	assert.True(t, fileV1.IsUnauthorized(checkShareVisitor(share, nil)))
+
+	assert.NoError(t, checkShareVisitor(share, &authenticationV1.UserTokenPayload{UserId: 7}))
+	assert.NoError(t, checkShareVisitor(share, &authenticationV1.UserTokenPayload{UserId: 8, TenantId: trans.Ptr(uint32(10))}))
+
+	err := checkShareVisitor(share, &authenticationV1.UserTokenPayload{UserId: 8, TenantId: trans.Ptr(uint32(11))})
+	assert.True(t, fileV1.IsFileShareAccessDenied(err))
+
+	err = checkShareVisitor(share, &authenticationV1.UserTokenPayload{UserId: 8})
+	assert.True(t, fileV1.IsFileShareAccessDenied(err))
+}
+
+func TestGenerateShareSlug(t *testing.T) {
+	a, err := generateShareSlug()
+	assert.NoError(t, err)
+	b, err := generateShareSlug()
+	assert.NoError(t, err)
+
+	assert.Len(t, a, 22)
+	assert.NotEqual(t, a, b)
+}
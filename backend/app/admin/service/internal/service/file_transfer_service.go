@@ -1,12 +1,14 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"io"
 	"net/http"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -17,9 +19,11 @@ import (
 
 	"go-wind-admin/app/admin/service/internal/data"
 
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
 	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
 
+	"go-wind-admin/pkg/lua"
 	"go-wind-admin/pkg/middleware/auth"
 	"go-wind-admin/pkg/oss"
 )
@@ -29,19 +33,39 @@ type FileTransferService struct {
 
 	log *log.Helper
 
-	mc       *oss.MinIOClient
-	fileRepo *data.FileRepo
+	mc         *oss.MinIOClient
+	fileRepo   *data.FileRepo
+	quotaRepo  *data.StorageQuotaRepo
+	objectLock *data.FileObjectLockRepo
+
+	variants *imageVariantGenerator
+	guard    *uploadGuard
+	hooks    *luaHooks
+
+	dedup bool
 }
 
 func NewFileTransferService(
 	ctx *bootstrap.Context,
+	cfg *adminConfV1.Bootstrap,
 	mc *oss.MinIOClient,
 	fileRepo *data.FileRepo,
+	quotaRepo *data.StorageQuotaRepo,
+	objectLock *data.FileObjectLockRepo,
+	scanner oss.Scanner,
+	luaEngine *lua.Engine,
 ) *FileTransferService {
+	l := ctx.NewLoggerHelper("file-transfer/service/admin-service")
 	return &FileTransferService{
-		log:      ctx.NewLoggerHelper("file-transfer/service/admin-service"),
-		mc:       mc,
-		fileRepo: fileRepo,
+		log:        l,
+		mc:         mc,
+		fileRepo:   fileRepo,
+		quotaRepo:  quotaRepo,
+		objectLock: objectLock,
+		variants:   newImageVariantGenerator(l, cfg, mc),
+		guard:      newUploadGuard(l, cfg, scanner),
+		hooks:      newLuaHooks(luaEngine, l),
+		dedup:      cfg.GetFileStorage().GetDedup(),
 	}
 }
 
@@ -85,28 +109,38 @@ func parseKey(key string) (folder, filename, ext string) {
 	return dir, name, ext
 }
 
+// contentSHA256 计算文件内容的 SHA-256 十六进制字符串
+func contentSHA256(fileData []byte) string {
+	sum := sha256.Sum256(fileData)    // sha256.Sum256 返回 [32]byte
+	return hex.EncodeToString(sum[:]) // 转为十六进制字符串
+}
+
 // recordFile 记录文件元数据到数据库
-func (s *FileTransferService) recordFile(
+// 每条记录在创建时计入上传人的存储配额，删除时按同一上传人释放；内容去重复用对象时同样如此。
+func recordFile(
 	ctx context.Context,
+	l *log.Helper,
+	fileRepo *data.FileRepo,
 	tenantID, userID uint32,
-	fileData []byte,
+	contentHash string,
 	sourceFileName string,
+	contentType string,
+	verdict scanVerdict,
 	info minio.UploadInfo,
 	downloadUrl string,
 ) error {
-
-	sum := sha256.Sum256(fileData)          // sha256.Sum256 返回 [32]byte
-	sha256Hex := hex.EncodeToString(sum[:]) // 转为十六进制字符串
-
 	dir, fileName, ext := parseKey(info.Key)
 	//s.log.Debugf("Parsed file - Dir: %s, FileName: %s, Ext: %s", dir, fileName, ext)
 
-	if err := s.fileRepo.Create(ctx, &fileV1.CreateFileRequest{
+	if err := fileRepo.Create(ctx, &fileV1.CreateFileRequest{
 		Data: &fileV1.File{
 			Provider:      trans.Ptr(fileV1.OSSProvider_MINIO),
 			BucketName:    trans.Ptr(info.Bucket),
 			SaveFileName:  trans.Ptr(fileName + "." + ext),
-			ContentHash:   trans.Ptr(sha256Hex),
+			ContentHash:   trans.Ptr(contentHash),
+			ContentType:   trans.Ptr(contentType),
+			ScanStatus:    trans.Ptr(verdict.status),
+			ScanResult:    trans.Ptr(verdict.result),
 			FileDirectory: trans.Ptr(dir),
 			FileName:      trans.Ptr(sourceFileName),
 			Extension:     trans.Ptr(ext),
@@ -117,7 +151,7 @@ func (s *FileTransferService) recordFile(
 			TenantId:      trans.Ptr(tenantID),
 		},
 	}); err != nil {
-		s.log.Errorf("Failed to create file record: %v", err)
+		l.Errorf("Failed to create file record: %v", err)
 		return err
 	}
 	return nil
@@ -147,10 +181,45 @@ func (s *FileTransferService) directUploadFile(ctx context.Context, req *fileV1.
 		return nil, err
 	}
 
+	// 上传前检查存储配额
+	if err = s.quotaRepo.Check(ctx, operator.GetTenantId(), operator.GetUserId(), uint64(len(req.GetFile()))); err != nil {
+		return nil, err
+	}
+
 	if req.StorageObject.BucketName == nil {
 		req.StorageObject.BucketName = trans.Ptr(oss.ContentTypeToBucketName(req.GetMime()))
 	}
 
+	// 按上传策略校验内容，记录的内容类型以嗅探结果为准
+	contentType, err := s.guard.checkContent(
+		req.GetStorageObject().GetBucketName(),
+		req.GetStorageObject().GetFileDirectory(),
+		req.GetSourceFileName(),
+		req.GetMime(),
+		req.GetFile(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Mime = trans.Ptr(contentType)
+
+	verdict := s.guard.scan(ctx, req.GetFile())
+	if verdict.quarantined() {
+		return nil, quarantineFile(
+			ctx, s.log, s.mc, s.fileRepo,
+			operator.GetTenantId(), operator.GetUserId(),
+			req.GetStorageObject().GetFileDirectory(),
+			req.GetSourceFileName(),
+			req.GetMime(),
+			req.GetFile(),
+			verdict,
+		)
+	}
+
+	if s.dedup && req.StorageObject.ObjectName == nil {
+		return s.dedupUploadFile(ctx, operator.GetTenantId(), operator.GetUserId(), req, verdict)
+	}
+
 	if req.StorageObject.ObjectName == nil {
 		req.StorageObject.ObjectName = trans.Ptr(
 			oss.EnsureObjectName(
@@ -173,17 +242,62 @@ func (s *FileTransferService) directUploadFile(ctx context.Context, req *fileV1.
 		return nil, err
 	}
 
-	if err = s.recordFile(
-		ctx,
+	if err = recordFile(
+		ctx, s.log, s.fileRepo,
 		operator.GetTenantId(), operator.GetUserId(),
-		req.GetFile(),
+		contentSHA256(req.GetFile()),
 		req.GetSourceFileName(),
+		req.GetMime(),
+		verdict,
 		info, downloadUrl); err != nil {
+		// 记录失败（例如并发上传导致超出配额）时，删除已上传的对象
+		_ = s.mc.DeleteFile(ctx, info.Bucket, info.Key)
+		return nil, err
 	}
 
 	return &fileV1.UploadFileResponse{
 		ObjectName: trans.Ptr(downloadUrl),
-	}, err
+	}, nil
+}
+
+// dedupUploadFile 以内容寻址方式上传文件
+// 相同内容只保存一份存储对象；每次上传仍各自创建一条文件记录，记录的文件名、目录与上传人互不影响。
+// 对象按租户分目录存放，只在同一租户内复用。
+func (s *FileTransferService) dedupUploadFile(
+	ctx context.Context,
+	tenantID, userID uint32,
+	req *fileV1.UploadFileRequest,
+	verdict scanVerdict,
+) (*fileV1.UploadFileResponse, error) {
+	fileExt := oss.EnsureFileExtension(req.GetSourceFileName(), req.GetMime(), req.GetFile())
+
+	var objectUrl string
+	if err := s.mc.UploadContentAddressed(
+		ctx,
+		req.GetStorageObject().GetBucketName(),
+		path.Join(req.GetStorageObject().GetFileDirectory(), strconv.FormatUint(uint64(tenantID), 10)),
+		fileExt,
+		bytes.NewReader(req.GetFile()),
+		int64(len(req.GetFile())),
+		s.objectLock.Lock,
+		func(info minio.UploadInfo, contentHash, downloadUrl string) error {
+			objectUrl = downloadUrl
+			return recordFile(
+				ctx, s.log, s.fileRepo,
+				tenantID, userID,
+				contentHash,
+				req.GetSourceFileName(),
+				req.GetMime(),
+				verdict,
+				info, downloadUrl)
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &fileV1.UploadFileResponse{
+		ObjectName: trans.Ptr(objectUrl),
+	}, nil
 }
 
 // presignedUploadFile 预签名上传文件
@@ -209,9 +323,31 @@ func (s *FileTransferService) presignedUploadFile(ctx context.Context, req *file
 		return nil, fileV1.ErrorUploadFailed("unknown source file name")
 	}
 
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 预签名上传由客户端直传对象存储，只能按声明的文件大小预先检查配额
+	if err = s.quotaRepo.Check(ctx, operator.GetTenantId(), operator.GetUserId(), uint64(req.GetSize())); err != nil {
+		return nil, err
+	}
+
 	if req.StorageObject.BucketName == nil {
 		req.StorageObject.BucketName = trans.Ptr(oss.ContentTypeToBucketName(contentType))
 	}
+
+	// 预签名上传由客户端直传，无法嗅探与扫描内容，只能按声明的类型与大小校验
+	if err = s.guard.checkDeclared(
+		req.GetStorageObject().GetBucketName(),
+		req.GetStorageObject().GetFileDirectory(),
+		contentType,
+		req.GetSize(),
+	); err != nil {
+		return nil, err
+	}
+
 	if req.StorageObject.ObjectName == nil {
 		req.StorageObject.ObjectName = trans.Ptr(
 			oss.EnsureObjectName(
@@ -258,7 +394,21 @@ func (s *FileTransferService) presignedUploadFile(ctx context.Context, req *file
 func (s *FileTransferService) UploadFile(ctx context.Context, req *fileV1.UploadFileRequest) (*fileV1.UploadFileResponse, error) {
 	switch req.Source.(type) {
 	case *fileV1.UploadFileRequest_File:
-		return s.directUploadFile(ctx, req)
+		resp, err := s.directUploadFile(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		s.hooks.after(ctx, lua.HookOnFileUploaded, map[string]any{
+			"bucket_name":      req.GetStorageObject().GetBucketName(),
+			"file_directory":   req.GetStorageObject().GetFileDirectory(),
+			"source_file_name": req.GetSourceFileName(),
+			"content_type":     req.GetMime(),
+			"size":             len(req.GetFile()),
+			"url":              resp.GetObjectName(),
+		})
+
+		return resp, nil
 
 	case *fileV1.UploadFileRequest_Presign:
 		return s.presignedUploadFile(ctx, req)
@@ -277,24 +427,24 @@ func (s *FileTransferService) downloadFileFromURL(ctx context.Context, downloadU
 	// 如果需要支持断点续传，可在此构造请求并设置 Range 头
 	httpReq, err := http.NewRequestWithContext(ctx, "GET", downloadUrl, nil)
 	if err != nil {
-		return nil, fileV1.ErrorDownloadFailed(err.Error())
+		return nil, fileV1.ErrorDownloadFailed("%s", err.Error())
 	}
 	// 示例：如果你要设置 Range（可选）
 	// httpReq.Header.Set("Range", "bytes=100-")
 
 	resp, err := http.DefaultClient.Do(httpReq)
 	if err != nil {
-		return nil, fileV1.ErrorDownloadFailed(err.Error())
+		return nil, fileV1.ErrorDownloadFailed("%s", err.Error())
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
-		return nil, fileV1.ErrorDownloadFailed("unexpected status: " + resp.Status)
+		return nil, fileV1.ErrorDownloadFailed("unexpected status: %s", resp.Status)
 	}
 
 	fileData, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fileV1.ErrorDownloadFailed(err.Error())
+		return nil, fileV1.ErrorDownloadFailed("%s", err.Error())
 	}
 
 	return &fileV1.DownloadFileResponse{
@@ -314,11 +464,14 @@ func (s *FileTransferService) DownloadFile(ctx context.Context, req *fileV1.Down
 		if err != nil {
 			return nil, fileV1.ErrorDownloadFailed("file not found")
 		}
+		if err = ensureNotQuarantined(resp); err != nil {
+			return nil, err
+		}
 
 		req.Selector = &fileV1.DownloadFileRequest_StorageObject{
 			StorageObject: &fileV1.StorageObject{
 				BucketName: resp.BucketName,
-				ObjectName: trans.Ptr(resp.GetFileDirectory() + resp.GetSaveFileName()),
+				ObjectName: trans.Ptr(fileObjectName(resp.GetFileDirectory(), resp.GetSaveFileName())),
 			},
 		}
 
@@ -335,6 +488,38 @@ func (s *FileTransferService) DownloadFile(ctx context.Context, req *fileV1.Down
 	}
 }
 
+// GetFileVariant 获取图片衍生图
+func (s *FileTransferService) GetFileVariant(ctx context.Context, req *fileV1.GetFileVariantRequest) (*fileV1.DownloadFileResponse, error) {
+	f, err := s.fileRepo.Get(ctx, &fileV1.GetFileRequest{
+		QueryBy: &fileV1.GetFileRequest_Id{Id: req.GetId()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = ensureNotQuarantined(f); err != nil {
+		return nil, err
+	}
+
+	opts, err := s.variants.options(req.GetW(), req.GetH(), req.GetFit(), req.GetFmt(), f.GetExtension())
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.variants.get(ctx, f.GetBucketName(), f.GetFileDirectory(), f.GetSaveFileName(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileV1.DownloadFileResponse{
+		Content: &fileV1.DownloadFileResponse_File{
+			File: content,
+		},
+		SourceFileName: variantName(opts),
+		Mime:           opts.Format.ContentType(),
+		Size:           int64(len(content)),
+	}, nil
+}
+
 func (s *FileTransferService) UEditorUploadFile(ctx context.Context, req *fileV1.UEditorUploadRequest) (*fileV1.UEditorUploadResponse, error) {
 	//s.log.Infof("上传文件： %s", req.GetFile())
 
@@ -360,6 +545,36 @@ func (s *FileTransferService) UEditorUploadFile(ctx context.Context, req *fileV1
 		bucketName = "videos"
 	}
 
+	// 上传前检查存储配额
+	if err = s.quotaRepo.Check(ctx, operator.GetTenantId(), operator.GetUserId(), uint64(len(req.GetFile()))); err != nil {
+		return &fileV1.UEditorUploadResponse{
+			State: trans.Ptr(err.Error()),
+		}, err
+	}
+
+	mimeType, err := s.guard.checkContent(bucketName, "", req.GetSourceFileName(), "", req.GetFile())
+	if err != nil {
+		return &fileV1.UEditorUploadResponse{
+			State: trans.Ptr(err.Error()),
+		}, err
+	}
+
+	verdict := s.guard.scan(ctx, req.GetFile())
+	if verdict.quarantined() {
+		err = quarantineFile(
+			ctx, s.log, s.mc, s.fileRepo,
+			operator.GetTenantId(), operator.GetUserId(),
+			"",
+			req.GetSourceFileName(),
+			mimeType,
+			req.GetFile(),
+			verdict,
+		)
+		return &fileV1.UEditorUploadResponse{
+			State: trans.Ptr(err.Error()),
+		}, err
+	}
+
 	info, downloadUrl, err := s.mc.UploadFile(ctx, bucketName, req.GetSourceFileName(), req.GetFile())
 	if err != nil {
 		return &fileV1.UEditorUploadResponse{
@@ -367,12 +582,18 @@ func (s *FileTransferService) UEditorUploadFile(ctx context.Context, req *fileV1
 		}, err
 	}
 
-	if err = s.recordFile(
-		ctx,
+	if err = recordFile(
+		ctx, s.log, s.fileRepo,
 		operator.GetTenantId(), operator.GetUserId(),
-		req.GetFile(),
+		contentSHA256(req.GetFile()),
 		req.GetSourceFileName(),
+		mimeType,
+		verdict,
 		info, downloadUrl); err != nil {
+		_ = s.mc.DeleteFile(ctx, info.Bucket, info.Key)
+		return &fileV1.UEditorUploadResponse{
+			State: trans.Ptr(err.Error()),
+		}, err
 	}
 
 	return &fileV1.UEditorUploadResponse{
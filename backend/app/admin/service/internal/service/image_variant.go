@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+
+	"go-wind-admin/pkg/imaging"
+	"go-wind-admin/pkg/oss"
+)
+
+var (
+	// defaultVariantSizes 未配置白名单时允许生成的尺寸
+	defaultVariantSizes = []string{"64x64", "128x128", "256x256", "512x512"}
+
+	// defaultAvatarSizes 未配置时头像预生成的尺寸
+	defaultAvatarSizes = []string{"64x64", "128x128", "256x256"}
+)
+
+// imageVariantGenerator 图片衍生图生成器
+// 衍生图按需生成，并缓存到原图所在目录的 .variants 子目录下。
+type imageVariantGenerator struct {
+	log *log.Helper
+	mc  *oss.MinIOClient
+
+	whitelist       imaging.SizeWhitelist
+	avatarSizes     []imaging.Size
+	avatarFormat    imaging.Format
+	quality         int
+	maxSourcePixels int
+}
+
+func newImageVariantGenerator(l *log.Helper, cfg *adminConfV1.Bootstrap, mc *oss.MinIOClient) *imageVariantGenerator {
+	c := cfg.GetFileStorage().GetImageVariant()
+
+	sizes := c.GetSizes()
+	if len(sizes) == 0 {
+		sizes = defaultVariantSizes
+	}
+
+	avatarSizes := c.GetAvatarSizes()
+	if len(avatarSizes) == 0 {
+		avatarSizes = defaultAvatarSizes
+	}
+
+	avatarFormat, err := imaging.ParseFormat(c.GetAvatarFormat())
+	if err != nil {
+		avatarFormat = imaging.FormatWEBP
+	}
+
+	g := &imageVariantGenerator{
+		log:             l,
+		mc:              mc,
+		whitelist:       imaging.NewSizeWhitelist(sizes),
+		avatarFormat:    avatarFormat,
+		quality:         int(c.GetQuality()),
+		maxSourcePixels: int(c.GetMaxSourcePixels()),
+	}
+
+	for _, spec := range avatarSizes {
+		sz, err := imaging.ParseSize(spec)
+		if err != nil || !g.whitelist.Allows(sz) {
+			l.Warnf("ignore avatar variant size [%s]: not in whitelist", spec)
+			continue
+		}
+		g.avatarSizes = append(g.avatarSizes, sz)
+	}
+
+	return g
+}
+
+// options 校验请求参数并生成变换参数，未指定输出格式时沿用原图格式
+func (g *imageVariantGenerator) options(width, height uint32, fit, format, sourceExt string) (imaging.Options, error) {
+	sz := imaging.Size{Width: int(width), Height: int(height)}
+	if !sz.Valid() {
+		return imaging.Options{}, fileV1.ErrorBadRequest("variant width or height is required")
+	}
+	if !g.whitelist.Allows(sz) {
+		return imaging.Options{}, fileV1.ErrorBadRequest("variant size %s is not allowed", sz)
+	}
+
+	f, err := imaging.ParseFit(fit)
+	if err != nil {
+		return imaging.Options{}, fileV1.ErrorBadRequest("unsupported fit mode: %s", fit)
+	}
+
+	if format == "" {
+		format = sourceExt
+	}
+	ff, err := imaging.ParseFormat(format)
+	if err != nil {
+		return imaging.Options{}, fileV1.ErrorUnsupportedMediaType("unsupported image format: %s", format)
+	}
+
+	return g.withLimits(imaging.Options{Size: sz, Fit: f, Format: ff}), nil
+}
+
+func (g *imageVariantGenerator) withLimits(opts imaging.Options) imaging.Options {
+	opts.Quality = g.quality
+	opts.MaxSourcePixels = g.maxSourcePixels
+	return opts
+}
+
+// variantName 衍生图文件名，如 128x128_cover.webp
+func variantName(opts imaging.Options) string {
+	return fmt.Sprintf("%s_%s.%s", opts.Size, opts.Fit, opts.Format.Extension())
+}
+
+// get 获取衍生图，已缓存时直接读取，否则由原图生成并写入缓存
+func (g *imageVariantGenerator) get(
+	ctx context.Context,
+	bucketName, fileDirectory, saveFileName string,
+	opts imaging.Options,
+) ([]byte, error) {
+	objectName := oss.VariantObjectName(fileDirectory, saveFileName, variantName(opts))
+
+	exists, err := g.mc.FileExists(ctx, bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return g.download(ctx, bucketName, objectName)
+	}
+
+	src, err := g.download(ctx, bucketName, fileObjectName(fileDirectory, saveFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	return g.generate(ctx, bucketName, objectName, src, opts)
+}
+
+func (g *imageVariantGenerator) download(ctx context.Context, bucketName, objectName string) ([]byte, error) {
+	resp, err := g.mc.DownloadFile(ctx, &fileV1.DownloadFileRequest{
+		Selector: &fileV1.DownloadFileRequest_StorageObject{
+			StorageObject: &fileV1.StorageObject{
+				BucketName: trans.Ptr(bucketName),
+				ObjectName: trans.Ptr(objectName),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetFile(), nil
+}
+
+// generate 生成衍生图并写入缓存，缓存写入失败不影响本次返回
+func (g *imageVariantGenerator) generate(
+	ctx context.Context,
+	bucketName, objectName string,
+	src []byte,
+	opts imaging.Options,
+) ([]byte, error) {
+	out, err := imaging.Transform(src, opts)
+	if err != nil {
+		switch {
+		case errors.Is(err, imaging.ErrSourceTooLarge):
+			return nil, fileV1.ErrorPayloadTooLarge("source image too large")
+		case errors.Is(err, imaging.ErrUnsupportedFormat):
+			return nil, fileV1.ErrorUnsupportedMediaType("unsupported source image")
+		default:
+			g.log.Errorf("generate image variant [%s] failed: %v", objectName, err)
+			return nil, fileV1.ErrorInternalServerError("generate image variant failed")
+		}
+	}
+
+	if _, _, err = g.mc.UploadFile(ctx, bucketName, objectName, out); err != nil {
+		g.log.Warnf("cache image variant [%s/%s] failed: %v", bucketName, objectName, err)
+	}
+
+	return out, nil
+}
+
+// pregenerateAvatar 为头像预生成标准尺寸的衍生图
+func (g *imageVariantGenerator) pregenerateAvatar(
+	ctx context.Context,
+	bucketName, fileDirectory, saveFileName string,
+	src []byte,
+) {
+	for _, sz := range g.avatarSizes {
+		opts := g.withLimits(imaging.Options{Size: sz, Fit: imaging.FitCover, Format: g.avatarFormat})
+		objectName := oss.VariantObjectName(fileDirectory, saveFileName, variantName(opts))
+		if _, err := g.generate(ctx, bucketName, objectName, src, opts); err != nil {
+			g.log.Warnf("pregenerate avatar variant [%s] failed: %v", objectName, err)
+		}
+	}
+}
+
+// removeImageVariants 删除原图的所有衍生图
+func removeImageVariants(ctx context.Context, l *log.Helper, mc *oss.MinIOClient, bucketName, fileDirectory, saveFileName string) {
+	prefix := oss.VariantObjectPrefix(fileDirectory, saveFileName)
+	if err := mc.DeleteFilesWithPrefix(ctx, bucketName, prefix); err != nil {
+		l.Warnf("remove image variants [%s/%s] failed: %v", bucketName, prefix, err)
+	}
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/jwt"
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+const (
+	jwtSigningKeyAuditResource = "jwt_signing_key"
+
+	// jwksCacheControl JWKS 的缓存时间应远小于密钥退役的宽限期
+	jwksCacheControl = "public, max-age=300"
+)
+
+// JwtSigningKeyService JWT签名密钥管理服务，只允许平台管理员操作
+type JwtSigningKeyService struct {
+	adminV1.JwtSigningKeyServiceHTTPServer
+
+	log *log.Helper
+
+	repo             *data.JwtSigningKeyRepo
+	operationLogRepo *data.OperationAuditLogRepo
+	keyRing          *jwt.KeyRing
+
+	algorithm   string
+	retireGrace time.Duration
+}
+
+func NewJwtSigningKeyService(
+	ctx *bootstrap.Context,
+	repo *data.JwtSigningKeyRepo,
+	operationLogRepo *data.OperationAuditLogRepo,
+	keyRing *jwt.KeyRing,
+	adminCfg *adminConfV1.Bootstrap,
+) *JwtSigningKeyService {
+	return &JwtSigningKeyService{
+		log:              ctx.NewLoggerHelper("jwt-signing-key/service/admin-service"),
+		repo:             repo,
+		operationLogRepo: operationLogRepo,
+		keyRing:          keyRing,
+		algorithm:        ctx.GetConfig().GetAuthn().GetJwt().GetMethod(),
+		retireGrace:      data.JwtKeyRingRetireGrace(adminCfg),
+	}
+}
+
+func (s *JwtSigningKeyService) List(ctx context.Context, _ *emptypb.Empty) (*authenticationV1.ListJwtSigningKeyResponse, error) {
+	if _, err := s.checkPlatformOperator(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.repo.List(ctx)
+}
+
+// Rotate 生成新的签名密钥，原密钥在宽限期内继续验签，已签发的令牌不受影响
+func (s *JwtSigningKeyService) Rotate(ctx context.Context, req *authenticationV1.RotateJwtSigningKeyRequest) (*authenticationV1.JwtSigningKey, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	operator, err := s.checkPlatformOperator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.keyRing == nil {
+		return nil, adminV1.ErrorBadRequest("jwt key ring is not enabled")
+	}
+
+	alg := s.algorithm
+	if req.Algorithm != nil {
+		alg = req.GetAlgorithm()
+	}
+	grace := s.retireGrace
+	if req.GraceSeconds != nil {
+		grace = time.Duration(req.GetGraceSeconds()) * time.Second
+	}
+
+	var key *authenticationV1.JwtSigningKey
+	key, err = s.repo.Rotate(ctx, alg, grace, operator.UserId)
+
+	detail := map[string]any{"algorithm": alg, "grace_seconds": int64(grace.Seconds())}
+	var kid string
+	if key != nil {
+		kid = key.GetKid()
+	}
+	s.writeOperationLog(ctx, kid, auditV1.OperationAuditLog_CREATE, detail, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.reloadKeyRing(ctx)
+
+	return key, nil
+}
+
+// Retire 调整已退役密钥的宽限期，为0时由该密钥签发的令牌立即失效
+func (s *JwtSigningKeyService) Retire(ctx context.Context, req *authenticationV1.RetireJwtSigningKeyRequest) (*emptypb.Empty, error) {
+	if req == nil || req.GetKid() == "" {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	operator, err := s.checkPlatformOperator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grace := s.retireGrace
+	if req.GraceSeconds != nil {
+		grace = time.Duration(req.GetGraceSeconds()) * time.Second
+	}
+
+	err = s.repo.Retire(ctx, req.GetKid(), grace, operator.UserId)
+
+	detail := map[string]any{"kid": req.GetKid(), "grace_seconds": int64(grace.Seconds())}
+	s.writeOperationLog(ctx, req.GetKid(), auditV1.OperationAuditLog_UPDATE, detail, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.reloadKeyRing(ctx)
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetJwks 发布仍可验签的公钥，供其他服务离线验证访问令牌
+func (s *JwtSigningKeyService) GetJwks(ctx context.Context, _ *emptypb.Empty) (*authenticationV1.Jwks, error) {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		tr.ReplyHeader().Set("Cache-Control", jwksCacheControl)
+	}
+
+	resp := &authenticationV1.Jwks{}
+	if s.keyRing == nil {
+		return resp, nil
+	}
+
+	for _, key := range s.keyRing.KeySet().Keys {
+		jwk := &authenticationV1.Jwk{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+		}
+		if key.Kty == "RSA" {
+			jwk.N = trans.Ptr(key.N)
+			jwk.E = trans.Ptr(key.E)
+		} else {
+			jwk.Crv = trans.Ptr(key.Crv)
+			jwk.X = trans.Ptr(key.X)
+			jwk.Y = trans.Ptr(key.Y)
+		}
+		resp.Keys = append(resp.Keys, jwk)
+	}
+
+	return resp, nil
+}
+
+// checkPlatformOperator 签名密钥对所有租户生效，只允许平台用户管理
+func (s *JwtSigningKeyService) checkPlatformOperator(ctx context.Context) (*authenticationV1.UserTokenPayload, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if operator.GetTenantId() != 0 {
+		return nil, adminV1.ErrorForbidden("only platform administrators can manage jwt signing keys")
+	}
+	return operator, nil
+}
+
+// reloadKeyRing 立即在本实例生效，其他实例在下一个加载间隔内生效
+func (s *JwtSigningKeyService) reloadKeyRing(ctx context.Context) {
+	if s.keyRing == nil {
+		return
+	}
+	if err := s.keyRing.Reload(ctx); err != nil {
+		s.log.Errorf("reload jwt key ring failed: %v", err)
+	}
+}
+
+func (s *JwtSigningKeyService) writeOperationLog(
+	ctx context.Context,
+	kid string,
+	action auditV1.OperationAuditLog_ActionType,
+	detail any,
+	opErr error,
+) {
+	operator, _ := auth.FromContext(ctx)
+	writeOperationAuditLog(ctx, s.operationLogRepo, s.log, operator, jwtSigningKeyAuditResource, kid, action, detail, opErr)
+}
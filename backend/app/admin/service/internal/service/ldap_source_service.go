@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/task"
+)
+
+type LdapSourceService struct {
+	adminV1.LdapSourceServiceHTTPServer
+
+	log *log.Helper
+
+	repo     *data.LdapSourceRepo
+	syncRepo *data.LdapSyncRepo
+	roleRepo *data.RoleRepo
+}
+
+func NewLdapSourceService(
+	ctx *bootstrap.Context,
+	repo *data.LdapSourceRepo,
+	syncRepo *data.LdapSyncRepo,
+	roleRepo *data.RoleRepo,
+) *LdapSourceService {
+	return &LdapSourceService{
+		log:      ctx.NewLoggerHelper("ldap-source/service/admin-service"),
+		repo:     repo,
+		syncRepo: syncRepo,
+		roleRepo: roleRepo,
+	}
+}
+
+func (s *LdapSourceService) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListLdapSourceResponse, error) {
+	return s.repo.List(ctx, req)
+}
+
+func (s *LdapSourceService) Get(ctx context.Context, req *authenticationV1.GetLdapSourceRequest) (*authenticationV1.LdapSource, error) {
+	return s.repo.Get(ctx, req)
+}
+
+func (s *LdapSourceService) Create(ctx context.Context, req *authenticationV1.CreateLdapSourceRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	// 租户用户只能创建本租户的身份源
+	if operator.GetTenantId() != 0 {
+		req.Data.TenantId = trans.Ptr(operator.GetTenantId())
+	}
+
+	if err = s.checkRoles(ctx, req.Data.GetTenantId(), req.Data); err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.Create(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LdapSourceService) Update(ctx context.Context, req *authenticationV1.UpdateLdapSourceRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Data.GetDefaultRoleId() != 0 || len(req.Data.GetGroupRoleMappings()) > 0 {
+		source, err := s.repo.Get(ctx, &authenticationV1.GetLdapSourceRequest{
+			QueryBy: &authenticationV1.GetLdapSourceRequest_Id{Id: req.GetId()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err = s.checkRoles(ctx, source.GetTenantId(), req.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+	if req.UpdateMask != nil {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
+	}
+
+	if err = s.repo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LdapSourceService) Delete(ctx context.Context, req *authenticationV1.DeleteLdapSourceRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	if err := s.repo.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Sync 立即同步一次目录，dry_run 时只返回变更计划
+func (s *LdapSourceService) Sync(ctx context.Context, req *authenticationV1.SyncLdapSourceRequest) (*authenticationV1.LdapSyncReport, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 按当前租户查询，租户用户只能同步本租户的身份源
+	source, err := s.repo.GetEntity(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.syncSource(ctx, source, req.GetDryRun())
+}
+
+// AsyncLdapSync 异步同步开启了定时同步的身份源
+func (s *LdapSourceService) AsyncLdapSync(ctx context.Context, taskType string, taskData *task.LdapSyncTaskData) error {
+	sources, err := s.repo.ListSyncEnabled(ctx, taskData.TenantIDs)
+	if err != nil {
+		s.log.Errorf("[%s] 查询身份源失败[%s]", taskType, err.Error())
+		return err
+	}
+
+	result := map[string]uint32{}
+	var failed uint32
+	for _, source := range sources {
+		report, err := s.syncSource(ctx, source, taskData.DryRun)
+		if err != nil {
+			// 单个身份源失败不影响其他租户，错误已记录在身份源上
+			s.log.Errorf("[%s] 同步身份源[%d]失败[%s]", taskType, source.ID, err.Error())
+			failed++
+			continue
+		}
+
+		result["users_created"] += report.GetUsersCreated()
+		result["users_updated"] += report.GetUsersUpdated()
+		result["users_disabled"] += report.GetUsersDisabled()
+		result["users_skipped"] += report.GetUsersSkipped()
+		result["org_units_created"] += report.GetOrgUnitsCreated()
+		result["org_units_updated"] += report.GetOrgUnitsUpdated()
+		result["role_assignments"] += report.GetRoleAssignments()
+	}
+	result["sources"] = uint32(len(sources))
+	result["failed"] = failed
+
+	s.log.Infof("[%s] 同步身份源完成，共[%d]个，失败[%d]个", taskType, len(sources), failed)
+
+	task.ProgressFromContext(ctx).SetResult(result)
+
+	return nil
+}
+
+// syncSource 读取目录快照并同步到身份源所属租户，非试运行时记录同步结果
+func (s *LdapSourceService) syncSource(ctx context.Context, source *ent.LdapSource, dryRun bool) (*authenticationV1.LdapSyncReport, error) {
+	report, err := s.doSyncSource(ctx, source, dryRun)
+	if !dryRun {
+		if recordErr := s.repo.RecordSyncResult(ctx, source.ID, err); recordErr != nil {
+			s.log.Errorf("record sync result of ldap source [%d] failed: %s", source.ID, recordErr.Error())
+		}
+	}
+	return report, err
+}
+
+func (s *LdapSourceService) doSyncSource(ctx context.Context, source *ent.LdapSource, dryRun bool) (*authenticationV1.LdapSyncReport, error) {
+	if !trans.BoolValue(source.Enabled) {
+		return nil, adminV1.ErrorPreconditionFailed("ldap source is disabled")
+	}
+
+	client, err := s.repo.NewClient(source)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := client.Snapshot(ctx)
+	if err != nil {
+		s.log.Errorf("read directory of ldap source [%d] failed: %s", source.ID, err.Error())
+		return nil, adminV1.ErrorServiceUnavailable("read directory failed: %s", err.Error())
+	}
+
+	return s.syncRepo.Sync(ctx, source, dir, dryRun)
+}
+
+// checkRoles 默认角色与组映射的角色必须是身份源所属租户的角色
+func (s *LdapSourceService) checkRoles(ctx context.Context, tenantID uint32, source *authenticationV1.LdapSource) error {
+	roleIDs := make([]uint32, 0, len(source.GetGroupRoleMappings())+1)
+	if source.GetDefaultRoleId() != 0 {
+		roleIDs = append(roleIDs, source.GetDefaultRoleId())
+	}
+	for _, roleID := range source.GetGroupRoleMappings() {
+		roleIDs = append(roleIDs, roleID)
+	}
+
+	for _, roleID := range roleIDs {
+		role, err := s.roleRepo.Get(ctx, &userV1.GetRoleRequest{
+			QueryBy: &userV1.GetRoleRequest_Id{Id: roleID},
+		})
+		if err != nil || role.GetTenantId() != tenantID {
+			return adminV1.ErrorBadRequest("role [%d] does not belong to the tenant", roleID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+
+	"go-wind-admin/pkg/lua"
+	"go-wind-admin/pkg/middleware/auth"
+	applogging "go-wind-admin/pkg/middleware/logging"
+)
+
+// luaHooks 触发 Lua 脚本钩子，未启用脚本引擎时不做任何事
+type luaHooks struct {
+	engine *lua.Engine
+	log    *log.Helper
+}
+
+func newLuaHooks(engine *lua.Engine, l *log.Helper) *luaHooks {
+	return &luaHooks{engine: engine, log: l}
+}
+
+// before 触发前置钩子，脚本调用 ctx.stop(reason)、返回 false 或执行失败时拒绝本次操作
+func (h *luaHooks) before(ctx context.Context, hookName string, data map[string]any) error {
+	if h == nil || h.engine == nil {
+		return nil
+	}
+
+	err := h.engine.ExecuteHook(ctx, hookName, h.newContext(ctx, hookName, data))
+	if err == nil {
+		return nil
+	}
+
+	if stopErr, ok := lua.AsStopError(err); ok && stopErr.Reason != "" {
+		return adminV1.ErrorForbidden("%s", stopErr.Reason)
+	}
+
+	h.log.Errorf("[%s] 钩子拒绝了本次操作[%s]", hookName, err.Error())
+	return adminV1.ErrorForbidden("operation rejected by %s hook", hookName)
+}
+
+// after 触发后置钩子，操作已经完成，失败只记录日志
+func (h *luaHooks) after(ctx context.Context, hookName string, data map[string]any) {
+	if h == nil || h.engine == nil {
+		return
+	}
+
+	if err := h.engine.ExecuteHook(context.WithoutCancel(ctx), hookName, h.newContext(ctx, hookName, data)); err != nil {
+		h.log.Warnf("[%s] 执行钩子失败[%s]", hookName, err.Error())
+	}
+}
+
+// newContext 创建钩子的执行上下文，附带操作人和客户端地址
+func (h *luaHooks) newContext(ctx context.Context, hookName string, data map[string]any) *lua.Context {
+	execCtx := lua.NewContext(hookName).WithContext(ctx).WithLogger(h.log)
+	for k, v := range data {
+		execCtx.Data[k] = v
+	}
+
+	if operator, err := auth.FromContext(ctx); err == nil {
+		execCtx.Data["operator"] = map[string]any{
+			"user_id":   operator.GetUserId(),
+			"username":  operator.GetUsername(),
+			"tenant_id": operator.GetTenantId(),
+		}
+		execCtx.WithUser(&lua.UserContext{
+			ID:       operator.GetUserId(),
+			Username: operator.GetUsername(),
+			TenantID: operator.GetTenantId(),
+		})
+	}
+
+	if r, ok := http.RequestFromServerContext(ctx); ok {
+		execCtx.Data["client_ip"] = applogging.ClientRealIP(r)
+		execCtx.WithRequest(&lua.HTTPContext{
+			Method:     r.Method,
+			Path:       r.URL.Path,
+			RemoteAddr: applogging.ClientRealIP(r),
+		})
+	}
+
+	return execCtx
+}
+
+// protoToLuaData 将 proto 消息转换为脚本可以读取的表，字段名使用 proto 中的名称
+func protoToLuaData(m proto.Message) map[string]any {
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil
+	}
+
+	var data map[string]any
+	if err = json.Unmarshal(b, &data); err != nil {
+		return nil
+	}
+	return data
+}
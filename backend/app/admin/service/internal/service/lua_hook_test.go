@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/lua"
+)
+
+func TestLuaHooks(t *testing.T) {
+	config := lua.DefaultConfig()
+	config.ScriptDir = ""
+	engine := lua.NewEngine(config, log.DefaultLogger)
+	defer engine.Close()
+
+	engine.RegisterStandardHooks()
+	assert.NoError(t, engine.LoadScriptString(context.Background(), "hooks", `
+hook.register("before_login", "", function(ctx)
+    if ctx.get("username") == "blocked" then
+        ctx.stop("account is blocked by policy")
+    end
+    return true
+end)
+
+hook.register("before_user_create", "", function(ctx)
+    local user = ctx.get("user")
+    if user.username == "root" then
+        return false
+    end
+    return true
+end)
+
+hook.register("after_user_create", "", function(ctx)
+    error("after hooks never fail the operation")
+end)
+`))
+
+	hooks := newLuaHooks(engine, log.NewHelper(log.DefaultLogger))
+	ctx := context.Background()
+
+	// ctx.stop(reason) 拒绝操作并返回原因
+	err := hooks.before(ctx, lua.HookBeforeLogin, map[string]any{"username": "blocked"})
+	assert.True(t, adminV1.IsForbidden(err))
+	assert.Contains(t, err.Error(), "account is blocked by policy")
+	assert.NoError(t, hooks.before(ctx, lua.HookBeforeLogin, map[string]any{"username": "alice"}))
+
+	// 返回 false 同样拒绝操作
+	err = hooks.before(ctx, lua.HookBeforeUserCreate, map[string]any{
+		"user": protoToLuaData(&userV1.User{Username: trans.Ptr("root")}),
+	})
+	assert.True(t, adminV1.IsForbidden(err))
+	assert.NoError(t, hooks.before(ctx, lua.HookBeforeUserCreate, map[string]any{
+		"user": protoToLuaData(&userV1.User{Username: trans.Ptr("bob")}),
+	}))
+
+	// 后置钩子失败不影响操作，没有脚本的钩子直接通过
+	hooks.after(ctx, lua.HookAfterUserCreate, nil)
+	assert.NoError(t, hooks.before(ctx, lua.HookBeforeUserUpdate, nil))
+
+	// 回调绑定在注册它的虚拟机上，并发触发时需要串行执行
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, hooks.before(ctx, lua.HookBeforeLogin, map[string]any{"username": "alice"}))
+		}()
+	}
+	wg.Wait()
+
+	// 未启用脚本引擎时不做任何事
+	var disabled *luaHooks
+	assert.NoError(t, disabled.before(ctx, lua.HookBeforeLogin, nil))
+	assert.NoError(t, newLuaHooks(nil, nil).before(ctx, lua.HookBeforeLogin, nil))
+}
@@ -0,0 +1,493 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+	scriptV1 "go-wind-admin/api/gen/go/script/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/lua"
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+const (
+	luaScriptAuditResource = "lua_script"
+
+	// luaScriptsChangedChannel 脚本变更的通知频道，收到通知的节点从数据库重新加载脚本
+	luaScriptsChangedChannel = "lua:scripts:changed"
+
+	// luaTestScriptName 试运行未保存的源代码时使用的脚本名称
+	luaTestScriptName = "test_script"
+)
+
+// luaScriptEditableFields 更新时允许修改的字段
+var luaScriptEditableFields = map[string]bool{
+	"name":        true,
+	"hook":        true,
+	"source":      true,
+	"priority":    true,
+	"enabled":     true,
+	"critical":    true,
+	"author":      true,
+	"description": true,
+}
+
+// luaScriptChange 脚本变更通知
+type luaScriptChange struct {
+	Node   string `json:"node"`
+	Script string `json:"script"`
+}
+
+// LuaScriptService Lua脚本管理服务
+type LuaScriptService struct {
+	adminV1.LuaScriptServiceHTTPServer
+
+	log *log.Helper
+
+	engine *lua.Engine
+	hooks  *luaHooks
+	rdb    *redis.Client
+	node   string
+
+	repo             *data.LuaScriptRepo
+	operationLogRepo *data.OperationAuditLogRepo
+
+	mu     sync.Mutex
+	loaded map[string]string // 已加载到脚本引擎中的脚本，脚本名称 -> 钩子点
+}
+
+func NewLuaScriptService(
+	ctx *bootstrap.Context,
+	repo *data.LuaScriptRepo,
+	operationLogRepo *data.OperationAuditLogRepo,
+	luaEngine *lua.Engine,
+	rdb *redis.Client,
+) (*LuaScriptService, func(), error) {
+	l := ctx.NewLoggerHelper("lua-script/service/admin-service")
+	svc := &LuaScriptService{
+		log:              l,
+		engine:           luaEngine,
+		hooks:            newLuaHooks(luaEngine, l),
+		rdb:              rdb,
+		node:             nodeID(),
+		repo:             repo,
+		operationLogRepo: operationLogRepo,
+		loaded:           make(map[string]string),
+	}
+
+	cleanup, err := svc.start(ctx.Context())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return svc, cleanup, nil
+}
+
+// start 加载数据库中的脚本，配置了 Redis 时监听其他节点发来的脚本变更
+func (s *LuaScriptService) start(ctx context.Context) (func(), error) {
+	if s.engine == nil {
+		return func() {}, nil
+	}
+
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	if err := s.syncScripts(ctx); err != nil {
+		s.log.Errorf("加载数据库中的脚本失败[%s]", err.Error())
+	}
+
+	if s.rdb == nil {
+		return func() {}, nil
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.watchScriptChanges(runCtx)
+	}()
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}, nil
+}
+
+func (s *LuaScriptService) List(ctx context.Context, req *paginationV1.PagingRequest) (*scriptV1.ListLuaScriptResponse, error) {
+	return s.repo.List(ctx, req)
+}
+
+func (s *LuaScriptService) Get(ctx context.Context, req *scriptV1.GetLuaScriptRequest) (*scriptV1.LuaScript, error) {
+	return s.repo.Get(ctx, req)
+}
+
+func (s *LuaScriptService) Create(ctx context.Context, req *scriptV1.CreateLuaScriptRequest) (*emptypb.Empty, error) {
+	if req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateLuaScript(req.Data); err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	var script *scriptV1.LuaScript
+	script, err = s.repo.Create(ctx, req.Data)
+
+	s.writeOperationLog(ctx, req.Data.GetName(), auditV1.OperationAuditLog_CREATE, script, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyChanged(ctx, script.GetName())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LuaScriptService) Update(ctx context.Context, req *scriptV1.UpdateLuaScriptRequest) (*emptypb.Empty, error) {
+	if req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var current *scriptV1.LuaScript
+	if current, err = s.repo.Get(ctx, &scriptV1.GetLuaScriptRequest{
+		QueryBy: &scriptV1.GetLuaScriptRequest_Id{Id: req.GetId()},
+	}); err != nil {
+		return nil, err
+	}
+
+	// 每次保存都会生成新版本，因此以当前内容为基础合并本次修改的字段后整体保存
+	if err = mergeLuaScript(current, req.Data, req.GetUpdateMask()); err != nil {
+		return nil, err
+	}
+	if err = validateLuaScript(current); err != nil {
+		return nil, err
+	}
+
+	current.UpdatedBy = trans.Ptr(operator.UserId)
+
+	return s.save(ctx, req.GetId(), current, nil)
+}
+
+func (s *LuaScriptService) Delete(ctx context.Context, req *scriptV1.DeleteLuaScriptRequest) (*emptypb.Empty, error) {
+	script, err := s.repo.Get(ctx, &scriptV1.GetLuaScriptRequest{
+		QueryBy: &scriptV1.GetLuaScriptRequest_Id{Id: req.GetId()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.Delete(ctx, req.GetId())
+
+	s.writeOperationLog(ctx, script.GetName(), auditV1.OperationAuditLog_DELETE, script, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyChanged(ctx, script.GetName())
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListVersion 查询脚本的历史版本
+func (s *LuaScriptService) ListVersion(ctx context.Context, req *scriptV1.ListLuaScriptVersionRequest) (*scriptV1.ListLuaScriptVersionResponse, error) {
+	return s.repo.ListVersion(ctx, req.GetId())
+}
+
+// Rollback 将脚本恢复为指定历史版本的内容，回滚本身会生成一个新版本
+func (s *LuaScriptService) Rollback(ctx context.Context, req *scriptV1.RollbackLuaScriptRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var version *scriptV1.LuaScriptVersion
+	if version, err = s.repo.GetVersion(ctx, req.GetId(), req.GetVersion()); err != nil {
+		return nil, err
+	}
+
+	script := &scriptV1.LuaScript{
+		Name:        version.Name,
+		Hook:        version.Hook,
+		Source:      version.Source,
+		Priority:    version.Priority,
+		Enabled:     version.Enabled,
+		Critical:    version.Critical,
+		Author:      version.Author,
+		Description: version.Description,
+		UpdatedBy:   trans.Ptr(operator.UserId),
+	}
+
+	return s.save(ctx, req.GetId(), script, map[string]uint32{"rollback_to": req.GetVersion()})
+}
+
+// save 保存脚本的新内容并通知各节点重新加载
+func (s *LuaScriptService) save(ctx context.Context, id uint32, script *scriptV1.LuaScript, detail any) (*emptypb.Empty, error) {
+	saved, err := s.repo.Update(ctx, id, script)
+
+	if detail == nil {
+		detail = saved
+	}
+	s.writeOperationLog(ctx, script.GetName(), auditV1.OperationAuditLog_UPDATE, detail, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyChanged(ctx, saved.GetName())
+
+	return &emptypb.Empty{}, nil
+}
+
+// TestExecute 在隔离的虚拟机中运行一次脚本，返回脚本输出的日志、错误和执行后的上下文数据
+func (s *LuaScriptService) TestExecute(ctx context.Context, req *scriptV1.TestExecuteLuaScriptRequest) (*scriptV1.TestExecuteLuaScriptResponse, error) {
+	if s.engine == nil {
+		return nil, adminV1.ErrorServiceUnavailable("lua engine is disabled")
+	}
+
+	script := &lua.Script{
+		Name:   luaTestScriptName,
+		Source: req.GetSource(),
+	}
+	if req.Source == nil {
+		if req.Id == nil {
+			return nil, adminV1.ErrorBadRequest("script id or source is required")
+		}
+
+		saved, err := s.repo.Get(ctx, &scriptV1.GetLuaScriptRequest{
+			QueryBy: &scriptV1.GetLuaScriptRequest_Id{Id: req.GetId()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		script = toLuaScript(saved)
+	}
+	if req.Hook != nil {
+		script.Hook = req.GetHook()
+	}
+
+	var sample map[string]any
+	if req.GetData() != "" {
+		if err := json.Unmarshal([]byte(req.GetData()), &sample); err != nil {
+			return nil, adminV1.ErrorBadRequest("data must be a JSON object: %s", err.Error())
+		}
+	}
+
+	execCtx := s.hooks.newContext(ctx, script.Hook, sample)
+
+	start := time.Now()
+	logs, err := s.engine.TestScript(ctx, script, execCtx)
+
+	resp := &scriptV1.TestExecuteLuaScriptResponse{
+		Success:    err == nil && !execCtx.Stopped,
+		Stopped:    execCtx.Stopped,
+		DurationMs: uint64(time.Since(start).Milliseconds()),
+	}
+	if err != nil {
+		resp.Error = trans.Ptr(err.Error())
+	}
+	if execCtx.Stopped {
+		resp.StopReason = trans.Ptr(execCtx.StopReason)
+	}
+	for _, entry := range logs {
+		resp.Logs = append(resp.Logs, &scriptV1.LuaScriptLog{Level: entry.Level, Message: entry.Message})
+	}
+	if b, marshalErr := json.Marshal(execCtx.Data); marshalErr == nil {
+		resp.Data = trans.Ptr(string(b))
+	}
+
+	return resp, nil
+}
+
+// notifyChanged 重新加载本节点的脚本并通知其他节点
+func (s *LuaScriptService) notifyChanged(ctx context.Context, name string) {
+	if s.engine != nil {
+		if err := s.syncScripts(ctx); err != nil {
+			s.log.Errorf("[%s] 重新加载脚本失败[%s]", name, err.Error())
+		}
+	}
+
+	if s.rdb == nil {
+		return
+	}
+
+	b, err := json.Marshal(&luaScriptChange{Node: s.node, Script: name})
+	if err != nil {
+		return
+	}
+	if err = s.rdb.Publish(ctx, luaScriptsChangedChannel, b).Err(); err != nil {
+		s.log.Errorf("[%s] 发送脚本变更通知失败[%s]", name, err.Error())
+	}
+}
+
+// watchScriptChanges 接收其他节点发来的脚本变更，从数据库重新加载脚本
+func (s *LuaScriptService) watchScriptChanges(ctx context.Context) {
+	sub := s.rdb.Subscribe(ctx, luaScriptsChangedChannel)
+	defer func() {
+		_ = sub.Close()
+	}()
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+
+			var change luaScriptChange
+			if err := json.Unmarshal([]byte(msg.Payload), &change); err != nil {
+				s.log.Warnf("无法解析脚本变更通知[%s]", err.Error())
+				continue
+			}
+			if change.Node == s.node {
+				continue
+			}
+
+			if err := s.syncScripts(ctx); err != nil {
+				s.log.Errorf("[%s] 重新加载脚本失败[%s]", change.Script, err.Error())
+			}
+		}
+	}
+}
+
+// syncScripts 以数据库为准更新脚本引擎中的脚本，已禁用和已删除的脚本会被移除
+// 只管理通过本服务加载的脚本，脚本目录中的脚本不受影响
+func (s *LuaScriptService) syncScripts(ctx context.Context) error {
+	scripts, err := s.repo.ListAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	loaded := make(map[string]string, len(scripts))
+	for _, script := range scripts {
+		if !script.GetEnabled() {
+			continue
+		}
+
+		// 修改了钩子点的脚本需要先从原钩子点移除
+		if hook, ok := s.loaded[script.GetName()]; ok && hook != script.GetHook() {
+			_ = s.engine.RemoveScript(hook, script.GetName())
+		}
+
+		if err = s.engine.UpsertScript(script.GetHook(), toLuaScript(script)); err != nil {
+			s.log.Errorf("[%s] 加载脚本失败[%s]", script.GetName(), err.Error())
+			continue
+		}
+		loaded[script.GetName()] = script.GetHook()
+	}
+
+	for name, hook := range s.loaded {
+		if _, ok := loaded[name]; !ok {
+			_ = s.engine.RemoveScript(hook, name)
+		}
+	}
+	s.loaded = loaded
+
+	return nil
+}
+
+func (s *LuaScriptService) writeOperationLog(
+	ctx context.Context,
+	name string,
+	action auditV1.OperationAuditLog_ActionType,
+	detail any,
+	opErr error,
+) {
+	operator, _ := auth.FromContext(ctx)
+	writeOperationAuditLog(ctx, s.operationLogRepo, s.log, operator, luaScriptAuditResource, name, action, detail, opErr)
+}
+
+// validateLuaScript 校验脚本的必填字段，并确认源代码可以通过编译
+func validateLuaScript(script *scriptV1.LuaScript) error {
+	if err := toLuaScript(script).Validate(); err != nil {
+		return adminV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	if err := lua.CompileScript(script.GetName(), script.GetSource()); err != nil {
+		return adminV1.ErrorBadRequest("lua script compile failed: %s", err.Error())
+	}
+
+	return nil
+}
+
+// mergeLuaScript 将 data 中的字段合并到 current，指定了 mask 时只合并 mask 中的字段
+func mergeLuaScript(current, data *scriptV1.LuaScript, mask *fieldmaskpb.FieldMask) error {
+	if len(mask.GetPaths()) == 0 {
+		proto.Merge(current, data)
+		return nil
+	}
+
+	dst := current.ProtoReflect()
+	src := data.ProtoReflect()
+	fields := dst.Descriptor().Fields()
+	for _, path := range mask.GetPaths() {
+		if !luaScriptEditableFields[path] {
+			return adminV1.ErrorBadRequest("field [%s] can not be updated", path)
+		}
+
+		fd := fields.ByName(protoreflect.Name(path))
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		} else {
+			dst.Clear(fd)
+		}
+	}
+
+	return nil
+}
+
+func toLuaScript(script *scriptV1.LuaScript) *lua.Script {
+	return &lua.Script{
+		ID:          script.GetId(),
+		Name:        script.GetName(),
+		Hook:        script.GetHook(),
+		Source:      script.GetSource(),
+		Enabled:     script.GetEnabled(),
+		Priority:    int(script.GetPriority()),
+		Description: script.GetDescription(),
+		Version:     int(script.GetVersion()),
+		Author:      script.GetAuthor(),
+		Critical:    script.GetCritical(),
+	}
+}
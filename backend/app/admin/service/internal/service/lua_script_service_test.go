@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	scriptV1 "go-wind-admin/api/gen/go/script/service/v1"
+
+	"go-wind-admin/pkg/lua"
+)
+
+func TestMergeLuaScript(t *testing.T) {
+	newCurrent := func() *scriptV1.LuaScript {
+		return &scriptV1.LuaScript{
+			Id:          trans.Ptr(uint32(1)),
+			Name:        trans.Ptr("login_guard"),
+			Hook:        trans.Ptr(lua.HookBeforeLogin),
+			Source:      trans.Ptr("return true"),
+			Enabled:     trans.Ptr(true),
+			Description: trans.Ptr("guard"),
+			Version:     trans.Ptr(uint32(3)),
+		}
+	}
+
+	// 未指定 mask 时合并已设置的字段
+	current := newCurrent()
+	assert.NoError(t, mergeLuaScript(current, &scriptV1.LuaScript{Enabled: trans.Ptr(false)}, nil))
+	assert.False(t, current.GetEnabled())
+	assert.Equal(t, "return true", current.GetSource())
+
+	// 指定 mask 时只处理 mask 中的字段，未设置的字段被清空
+	current = newCurrent()
+	assert.NoError(t, mergeLuaScript(current, &scriptV1.LuaScript{
+		Source:   trans.Ptr("return false"),
+		Priority: trans.Ptr(int32(5)),
+	}, &fieldmaskpb.FieldMask{Paths: []string{"source", "description"}}))
+	assert.Equal(t, "return false", current.GetSource())
+	assert.Equal(t, int32(0), current.GetPriority())
+	assert.Nil(t, current.Description)
+
+	// 只读字段不允许修改
+	err := mergeLuaScript(newCurrent(), &scriptV1.LuaScript{Version: trans.Ptr(uint32(9))},
+		&fieldmaskpb.FieldMask{Paths: []string{"version"}})
+	assert.True(t, adminV1.IsBadRequest(err))
+}
+
+func TestValidateLuaScript(t *testing.T) {
+	script := &scriptV1.LuaScript{
+		Name:   trans.Ptr("login_guard"),
+		Hook:   trans.Ptr(lua.HookBeforeLogin),
+		Source: trans.Ptr("function execute(ctx) return true end"),
+	}
+	assert.NoError(t, validateLuaScript(script))
+
+	script.Source = trans.Ptr("function execute(ctx) return true")
+	err := validateLuaScript(script)
+	assert.True(t, adminV1.IsBadRequest(err))
+	assert.Contains(t, err.Error(), "compile failed")
+
+	script.Source = nil
+	assert.True(t, adminV1.IsBadRequest(validateLuaScript(script)))
+}
+
+func TestLuaScriptService_TestExecute(t *testing.T) {
+	config := lua.DefaultConfig()
+	config.ScriptDir = ""
+	engine := lua.NewEngine(config, log.DefaultLogger)
+	defer engine.Close()
+
+	l := log.NewHelper(log.DefaultLogger)
+	svc := &LuaScriptService{log: l, engine: engine, hooks: newLuaHooks(engine, l)}
+	ctx := context.Background()
+
+	resp, err := svc.TestExecute(ctx, &scriptV1.TestExecuteLuaScriptRequest{
+		Hook: trans.Ptr(lua.HookBeforeLogin),
+		Source: trans.Ptr(`
+function execute(ctx)
+    log.warn("login attempt for " .. ctx.get("username"))
+    if ctx.get("username") == "blocked" then
+        ctx.stop("account is blocked")
+    end
+    return true
+end
+`),
+		Data: trans.Ptr(`{"username": "blocked"}`),
+	})
+	assert.NoError(t, err)
+	assert.False(t, resp.GetSuccess())
+	assert.True(t, resp.GetStopped())
+	assert.Equal(t, "account is blocked", resp.GetStopReason())
+	assert.Contains(t, resp.GetData(), `"username":"blocked"`)
+	if assert.NotEmpty(t, resp.GetLogs()) {
+		assert.Equal(t, "WARN", resp.GetLogs()[0].GetLevel())
+		assert.Equal(t, "login attempt for blocked", resp.GetLogs()[0].GetMessage())
+	}
+
+	// 运行错误在回应中返回，而不是作为接口错误
+	resp, err = svc.TestExecute(ctx, &scriptV1.TestExecuteLuaScriptRequest{
+		Source: trans.Ptr(`error("boom")`),
+	})
+	assert.NoError(t, err)
+	assert.False(t, resp.GetSuccess())
+	assert.Contains(t, resp.GetError(), "boom")
+
+	// 试运行不会注册钩子
+	_, err = svc.TestExecute(ctx, &scriptV1.TestExecuteLuaScriptRequest{
+		Source: trans.Ptr(`hook.register("before_login", "", function(ctx) return false end)`),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, engine.ExecuteHook(ctx, lua.HookBeforeLogin, lua.NewContext(lua.HookBeforeLogin)))
+
+	_, err = svc.TestExecute(ctx, &scriptV1.TestExecuteLuaScriptRequest{Data: trans.Ptr("[]"), Source: trans.Ptr("")})
+	assert.True(t, adminV1.IsBadRequest(err))
+	_, err = svc.TestExecute(ctx, &scriptV1.TestExecuteLuaScriptRequest{})
+	assert.True(t, adminV1.IsBadRequest(err))
+}
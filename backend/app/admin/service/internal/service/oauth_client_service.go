@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+const oauthClientAuditResource = "oauth_client"
+
+// OAuthClientService OAuth客户端管理服务
+type OAuthClientService struct {
+	adminV1.OAuthClientServiceHTTPServer
+
+	log *log.Helper
+
+	repo             *data.OAuthClientRepo
+	roleRepo         *data.RoleRepo
+	permissionRepo   *data.PermissionRepo
+	operationLogRepo *data.OperationAuditLogRepo
+
+	authorizer *data.Authorizer
+}
+
+func NewOAuthClientService(
+	ctx *bootstrap.Context,
+	repo *data.OAuthClientRepo,
+	roleRepo *data.RoleRepo,
+	permissionRepo *data.PermissionRepo,
+	operationLogRepo *data.OperationAuditLogRepo,
+	authorizer *data.Authorizer,
+) *OAuthClientService {
+	return &OAuthClientService{
+		log:              ctx.NewLoggerHelper("oauth-client/service/admin-service"),
+		repo:             repo,
+		roleRepo:         roleRepo,
+		permissionRepo:   permissionRepo,
+		operationLogRepo: operationLogRepo,
+		authorizer:       authorizer,
+	}
+}
+
+func (s *OAuthClientService) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListOAuthClientResponse, error) {
+	return s.repo.List(ctx, req)
+}
+
+func (s *OAuthClientService) Get(ctx context.Context, req *authenticationV1.GetOAuthClientRequest) (*authenticationV1.OAuthClient, error) {
+	return s.repo.Get(ctx, req)
+}
+
+func (s *OAuthClientService) Create(ctx context.Context, req *authenticationV1.CreateOAuthClientRequest) (*authenticationV1.OAuthClientSecret, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+	if req.Data.GetName() == "" {
+		return nil, adminV1.ErrorBadRequest("oauth client name is required")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validateScopes(ctx, operator, req.Data.GetScopes()); err != nil {
+		return nil, err
+	}
+
+	// 租户管理员只能创建本租户的客户端
+	if operator.GetTenantId() > 0 {
+		req.Data.TenantId = trans.Ptr(operator.GetTenantId())
+	}
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+	capDataScope(operator, req.Data)
+
+	var secret *authenticationV1.OAuthClientSecret
+	secret, err = s.repo.Create(ctx, req)
+
+	if secret != nil {
+		req.Data.ClientId = trans.Ptr(secret.GetClientId())
+	}
+	s.writeOperationLog(ctx, req.Data.GetClientId(), auditV1.OperationAuditLog_CREATE, req.Data, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.resetPolicies(ctx)
+
+	return secret, nil
+}
+
+func (s *OAuthClientService) Update(ctx context.Context, req *authenticationV1.UpdateOAuthClientRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validateScopes(ctx, operator, req.Data.GetScopes()); err != nil {
+		return nil, err
+	}
+
+	var client *authenticationV1.OAuthClient
+	if client, err = s.repo.Get(ctx, &authenticationV1.GetOAuthClientRequest{
+		QueryBy: &authenticationV1.GetOAuthClientRequest_Id{Id: req.GetId()},
+	}); err != nil {
+		return nil, err
+	}
+
+	// 客户端ID与所属租户创建后不可修改
+	req.Data.ClientId = nil
+	req.Data.TenantId = nil
+	capDataScope(operator, req.Data)
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+	if req.UpdateMask != nil {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
+	}
+
+	err = s.repo.Update(ctx, req)
+
+	s.writeOperationLog(ctx, client.GetClientId(), auditV1.OperationAuditLog_UPDATE, req.Data, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.resetPolicies(ctx)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *OAuthClientService) Delete(ctx context.Context, req *authenticationV1.DeleteOAuthClientRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	client, err := s.repo.Get(ctx, &authenticationV1.GetOAuthClientRequest{
+		QueryBy: &authenticationV1.GetOAuthClientRequest_Id{Id: req.GetId()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.Delete(ctx, req)
+
+	s.writeOperationLog(ctx, client.GetClientId(), auditV1.OperationAuditLog_DELETE, client, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.resetPolicies(ctx)
+
+	return &emptypb.Empty{}, nil
+}
+
+// RotateSecret 轮换客户端密钥，旧密钥在重叠期内仍然可以换取令牌，便于调用方滚动更新配置
+func (s *OAuthClientService) RotateSecret(ctx context.Context, req *authenticationV1.RotateOAuthClientSecretRequest) (*authenticationV1.OAuthClientSecret, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	overlap := data.DefaultOAuthClientSecretOverlap
+	if req.OverlapSeconds != nil {
+		overlap = time.Duration(req.GetOverlapSeconds()) * time.Second
+	}
+
+	var secret *authenticationV1.OAuthClientSecret
+	secret, err = s.repo.RotateSecret(ctx, req.GetId(), overlap, operator.UserId)
+
+	// 审计日志中不记录密钥明文
+	detail := map[string]any{"id": req.GetId(), "overlap_seconds": int64(overlap.Seconds())}
+	var clientID string
+	if secret != nil {
+		clientID = secret.GetClientId()
+		detail["client_id"] = clientID
+	}
+	s.writeOperationLog(ctx, clientID, auditV1.OperationAuditLog_UPDATE, detail, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// validateScopes 校验授权范围均为操作人自己拥有的权限码，避免通过客户端获得超出自身的权限
+func (s *OAuthClientService) validateScopes(ctx context.Context, operator *authenticationV1.UserTokenPayload, scopes []string) error {
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	granted, err := s.listOperatorPermissionCodes(ctx, operator)
+	if err != nil {
+		return err
+	}
+	grantedSet := make(map[string]struct{}, len(granted))
+	for _, code := range granted {
+		grantedSet[code] = struct{}{}
+	}
+
+	for _, scope := range scopes {
+		if scope == "" {
+			return adminV1.ErrorBadRequest("empty scope")
+		}
+		if _, ok := grantedSet[scope]; !ok {
+			return adminV1.ErrorForbidden("scope [%s] is not granted to the operator", scope)
+		}
+	}
+
+	return nil
+}
+
+// listOperatorPermissionCodes 查询操作人的角色拥有的权限码
+func (s *OAuthClientService) listOperatorPermissionCodes(ctx context.Context, operator *authenticationV1.UserTokenPayload) ([]string, error) {
+	if len(operator.GetRoles()) == 0 {
+		return nil, nil
+	}
+
+	permissionIDs, err := s.roleRepo.ListPermissionIDsByRoleCodes(ctx, operator.GetRoles())
+	if err != nil {
+		return nil, err
+	}
+	if len(permissionIDs) == 0 {
+		return nil, nil
+	}
+
+	return s.permissionRepo.GetPermissionCodesByIDs(ctx, permissionIDs)
+}
+
+// capDataScope 客户端令牌的数据权限不超过创建或修改客户端的操作人
+func capDataScope(operator *authenticationV1.UserTokenPayload, client *authenticationV1.OAuthClient) {
+	client.DataScope = operator.DataScope
+	client.OrgUnitId = operator.OrgUnitId
+}
+
+// resetPolicies 客户端的授权范围或状态变化后重新生成鉴权策略
+func (s *OAuthClientService) resetPolicies(ctx context.Context) {
+	if err := s.authorizer.ResetPolicies(ctx); err != nil {
+		s.log.Errorf("reset policies error: %v", err)
+	}
+}
+
+func (s *OAuthClientService) writeOperationLog(
+	ctx context.Context,
+	clientID string,
+	action auditV1.OperationAuditLog_ActionType,
+	detail any,
+	opErr error,
+) {
+	operator, _ := auth.FromContext(ctx)
+	writeOperationAuditLog(ctx, s.operationLogRepo, s.log, operator, oauthClientAuditResource, clientID, action, detail, opErr)
+}
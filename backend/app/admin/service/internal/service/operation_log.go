@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	applogging "go-wind-admin/pkg/middleware/logging"
+)
+
+// writeOperationAuditLog 记录操作审计日志，写入失败只记录错误，不影响本次操作
+func writeOperationAuditLog(
+	ctx context.Context,
+	operationLogRepo *data.OperationAuditLogRepo,
+	l *log.Helper,
+	operator *authenticationV1.UserTokenPayload,
+	resourceType, resourceID string,
+	action auditV1.OperationAuditLog_ActionType,
+	detail any,
+	opErr error,
+) {
+	if operationLogRepo == nil {
+		return
+	}
+
+	entry := &auditV1.OperationAuditLog{
+		ResourceType:   trans.Ptr(resourceType),
+		ResourceId:     trans.Ptr(resourceID),
+		Action:         trans.Ptr(action),
+		Success:        trans.Ptr(opErr == nil),
+		SensitiveLevel: trans.Ptr(auditV1.SensitiveLevel_INTERNAL),
+	}
+	if b, err := json.Marshal(detail); err == nil {
+		entry.AfterData = trans.Ptr(string(b))
+	}
+	if opErr != nil {
+		entry.FailureReason = trans.Ptr(opErr.Error())
+	}
+	if operator != nil {
+		entry.TenantId = trans.Ptr(operator.GetTenantId())
+		entry.UserId = trans.Ptr(operator.GetUserId())
+		entry.Username = operator.Username
+	}
+	if r, ok := http.RequestFromServerContext(ctx); ok {
+		entry.IpAddress = trans.Ptr(applogging.ClientRealIP(r))
+		entry.RequestId = trans.Ptr(applogging.RequestID(r))
+	}
+
+	if err := operationLogRepo.Create(ctx, &auditV1.CreateOperationAuditLogRequest{Data: entry}); err != nil {
+		l.Errorf("write %s operation log failed: %v", resourceType, err)
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+type PasswordPolicyService struct {
+	adminV1.PasswordPolicyServiceHTTPServer
+
+	log *log.Helper
+
+	repo *data.PasswordPolicyRepo
+}
+
+func NewPasswordPolicyService(ctx *bootstrap.Context, repo *data.PasswordPolicyRepo) *PasswordPolicyService {
+	return &PasswordPolicyService{
+		log:  ctx.NewLoggerHelper("password-policy/service/admin-service"),
+		repo: repo,
+	}
+}
+
+func (s *PasswordPolicyService) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListPasswordPolicyResponse, error) {
+	return s.repo.List(ctx, req)
+}
+
+func (s *PasswordPolicyService) Get(ctx context.Context, req *authenticationV1.GetPasswordPolicyRequest) (*authenticationV1.PasswordPolicy, error) {
+	return s.repo.Get(ctx, req)
+}
+
+func (s *PasswordPolicyService) Create(ctx context.Context, req *authenticationV1.CreatePasswordPolicyRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	// 租户用户只能创建本租户的密码策略
+	if operator.GetTenantId() != 0 {
+		req.Data.TenantId = trans.Ptr(operator.GetTenantId())
+	}
+
+	if err = s.repo.Create(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PasswordPolicyService) Update(ctx context.Context, req *authenticationV1.UpdatePasswordPolicyRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+	if req.UpdateMask != nil {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
+	}
+
+	if err = s.repo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PasswordPolicyService) Delete(ctx context.Context, req *authenticationV1.DeletePasswordPolicyRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	if err := s.repo.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetEffective 查询当前用户所在租户生效的密码策略
+func (s *PasswordPolicyService) GetEffective(ctx context.Context, _ *emptypb.Empty) (*authenticationV1.PasswordPolicy, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetEffective(ctx, operator.GetTenantId())
+}
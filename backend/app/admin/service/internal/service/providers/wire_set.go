@@ -38,6 +38,13 @@ var ProviderSet = wire.NewSet(
 	service.NewInternalMessageCategoryService,
 	service.NewInternalMessageRecipientService,
 	service.NewLoginPolicyService,
+	service.NewPasswordPolicyService,
+	service.NewRegistrationPolicyService,
+	service.NewLdapSourceService,
+	service.NewScimTokenService,
+	service.NewScimService,
+	service.NewOAuthClientService,
+	service.NewJwtSigningKeyService,
 	service.NewUserProfileService,
 	service.NewUserCredentialService,
 	service.NewApiService,
@@ -48,4 +55,8 @@ var ProviderSet = wire.NewSet(
 	service.NewDataAccessAuditLogService,
 	service.NewOperationAuditLogService,
 	service.NewFileTransferService,
+	service.NewStorageQuotaService,
+	service.NewFileShareService,
+	service.NewBackupService,
+	service.NewLuaScriptService,
 )
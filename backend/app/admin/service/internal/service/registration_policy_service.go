@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/notifier"
+)
+
+type RegistrationPolicyService struct {
+	adminV1.RegistrationPolicyServiceHTTPServer
+
+	log *log.Helper
+
+	repo       *data.RegistrationPolicyRepo
+	inviteRepo *data.RegistrationInviteRepo
+	roleRepo   *data.RoleRepo
+	notifier   notifier.Notifier
+}
+
+func NewRegistrationPolicyService(
+	ctx *bootstrap.Context,
+	repo *data.RegistrationPolicyRepo,
+	inviteRepo *data.RegistrationInviteRepo,
+	roleRepo *data.RoleRepo,
+	notifier notifier.Notifier,
+) *RegistrationPolicyService {
+	return &RegistrationPolicyService{
+		log:        ctx.NewLoggerHelper("registration-policy/service/admin-service"),
+		repo:       repo,
+		inviteRepo: inviteRepo,
+		roleRepo:   roleRepo,
+		notifier:   notifier,
+	}
+}
+
+func (s *RegistrationPolicyService) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListRegistrationPolicyResponse, error) {
+	return s.repo.List(ctx, req)
+}
+
+func (s *RegistrationPolicyService) Get(ctx context.Context, req *authenticationV1.GetRegistrationPolicyRequest) (*authenticationV1.RegistrationPolicy, error) {
+	return s.repo.Get(ctx, req)
+}
+
+func (s *RegistrationPolicyService) Create(ctx context.Context, req *authenticationV1.CreateRegistrationPolicyRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	// 租户用户只能创建本租户的注册策略
+	if operator.GetTenantId() != 0 {
+		req.Data.TenantId = trans.Ptr(operator.GetTenantId())
+	}
+
+	if err = s.checkDefaultRole(ctx, req.Data.GetTenantId(), req.Data.GetDefaultRoleId()); err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.Create(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *RegistrationPolicyService) Update(ctx context.Context, req *authenticationV1.UpdateRegistrationPolicyRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Data.GetDefaultRoleId() != 0 {
+		policy, err := s.repo.Get(ctx, &authenticationV1.GetRegistrationPolicyRequest{
+			QueryBy: &authenticationV1.GetRegistrationPolicyRequest_Id{Id: req.GetId()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err = s.checkDefaultRole(ctx, policy.GetTenantId(), req.Data.GetDefaultRoleId()); err != nil {
+			return nil, err
+		}
+	}
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+	if req.UpdateMask != nil {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
+	}
+
+	if err = s.repo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *RegistrationPolicyService) Delete(ctx context.Context, req *authenticationV1.DeleteRegistrationPolicyRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	if err := s.repo.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *RegistrationPolicyService) ListInvites(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListRegistrationInviteResponse, error) {
+	return s.inviteRepo.List(ctx, req)
+}
+
+// CreateInvite 创建本租户的注册邀请，指定了邮箱时把邀请码发送到该邮箱
+func (s *RegistrationPolicyService) CreateInvite(ctx context.Context, req *authenticationV1.CreateRegistrationInviteRequest) (*authenticationV1.CreateRegistrationInviteResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var email *string
+	if req.Email != nil {
+		e := data.NormalizeVerificationTarget(notifier.ChannelEmail, req.GetEmail())
+		if e == "" {
+			return nil, adminV1.ErrorBadRequest("invalid email")
+		}
+		email = &e
+	}
+
+	invite, code, err := s.inviteRepo.Create(ctx, operator.GetTenantId(), email, req.GetTtl().AsDuration(), operator.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if email != nil {
+		// 邮件发送失败不影响邀请，管理员可以把返回的邀请码转交给对方
+		if err = s.notifier.Send(ctx, &notifier.Message{
+			Channel: notifier.ChannelEmail,
+			To:      *email,
+			Subject: "注册邀请",
+			Body: fmt.Sprintf("您收到一份注册邀请，邀请码为 %s，有效期至 %s，只能使用一次。",
+				code, invite.GetExpiresAt().AsTime().Local().Format(time.DateTime)),
+		}); err != nil {
+			s.log.Errorf("send registration invite [%d] failed: %s", invite.GetId(), err.Error())
+		}
+	}
+
+	return &authenticationV1.CreateRegistrationInviteResponse{
+		Invite: invite,
+		Code:   code,
+	}, nil
+}
+
+func (s *RegistrationPolicyService) RevokeInvite(ctx context.Context, req *authenticationV1.RevokeRegistrationInviteRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	if err := s.inviteRepo.Revoke(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkDefaultRole 默认角色必须是策略所属租户的角色
+func (s *RegistrationPolicyService) checkDefaultRole(ctx context.Context, tenantID, roleID uint32) error {
+	if roleID == 0 {
+		return nil
+	}
+
+	role, err := s.roleRepo.Get(ctx, &userV1.GetRoleRequest{
+		QueryBy: &userV1.GetRoleRequest_Id{Id: roleID},
+	})
+	if err != nil || role.GetTenantId() != tenantID {
+		return adminV1.ErrorBadRequest("default role does not belong to the tenant")
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/scim"
+)
+
+// ScimService SCIM 2.0 用户与组的供应接口，供身份供应方（如 Okta、Azure AD）按租户同步账号。
+// 请求使用租户的 SCIM 令牌认证，不经过后台的登录令牌。
+type ScimService struct {
+	log *log.Helper
+
+	tokenRepo     *data.ScimTokenRepo
+	repo          *data.ScimRepo
+	userTokenRepo *data.UserTokenCacheRepo
+	authorizer    *data.Authorizer
+}
+
+func NewScimService(
+	ctx *bootstrap.Context,
+	tokenRepo *data.ScimTokenRepo,
+	repo *data.ScimRepo,
+	userTokenRepo *data.UserTokenCacheRepo,
+	authorizer *data.Authorizer,
+) *ScimService {
+	return &ScimService{
+		log:           ctx.NewLoggerHelper("scim/service/admin-service"),
+		tokenRepo:     tokenRepo,
+		repo:          repo,
+		userTokenRepo: userTokenRepo,
+		authorizer:    authorizer,
+	}
+}
+
+// Authenticate 校验 Authorization 头中的 Bearer 令牌，返回令牌所属的租户ID
+func (s *ScimService) Authenticate(ctx context.Context, authorization string) (uint32, error) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(authorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return 0, authenticationV1.ErrorUnauthorized("bearer token is required")
+	}
+	return s.tokenRepo.Authenticate(ctx, strings.TrimSpace(token))
+}
+
+func (s *ScimService) ListUsers(ctx context.Context, tenantID uint32, query *data.ScimListQuery, baseURL string) (*scim.ListResponse, error) {
+	return s.repo.ListUsers(ctx, tenantID, query, baseURL)
+}
+
+func (s *ScimService) GetUser(ctx context.Context, tenantID uint32, id, baseURL string) (*scim.User, error) {
+	return s.repo.GetUser(ctx, tenantID, id, baseURL)
+}
+
+func (s *ScimService) CreateUser(ctx context.Context, tenantID uint32, in *scim.User, baseURL string) (*scim.User, error) {
+	return s.repo.CreateUser(ctx, tenantID, in, baseURL)
+}
+
+func (s *ScimService) ReplaceUser(ctx context.Context, tenantID uint32, id string, in *scim.User, ifMatch, baseURL string) (*scim.User, error) {
+	out, err := s.repo.ReplaceUser(ctx, tenantID, id, in, ifMatch, baseURL)
+	if err != nil {
+		return nil, err
+	}
+	s.kickIfInactive(ctx, out)
+	return out, nil
+}
+
+func (s *ScimService) PatchUser(ctx context.Context, tenantID uint32, id string, ops []scim.PatchOperation, ifMatch, baseURL string) (*scim.User, error) {
+	out, err := s.repo.PatchUser(ctx, tenantID, id, ops, ifMatch, baseURL)
+	if err != nil {
+		return nil, err
+	}
+	s.kickIfInactive(ctx, out)
+	return out, nil
+}
+
+// DeleteUser 身份供应方移除用户时只禁用账号，并使其现有会话失效
+func (s *ScimService) DeleteUser(ctx context.Context, tenantID uint32, id string) error {
+	userID, err := s.repo.DeactivateUser(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	if err = s.userTokenRepo.RemoveToken(ctx, userID); err != nil {
+		s.log.Errorf("remove tokens of user [%d] failed: %v", userID, err)
+	}
+	return nil
+}
+
+// kickIfInactive 用户被停用后使其现有会话失效
+func (s *ScimService) kickIfInactive(ctx context.Context, u *scim.User) {
+	if u == nil || u.Active == nil || *u.Active {
+		return
+	}
+	userID, err := strconv.ParseUint(u.ID, 10, 32)
+	if err != nil {
+		return
+	}
+	if err = s.userTokenRepo.RemoveToken(ctx, uint32(userID)); err != nil {
+		s.log.Errorf("remove tokens of user [%d] failed: %v", userID, err)
+	}
+}
+
+func (s *ScimService) ListGroups(ctx context.Context, tenantID uint32, query *data.ScimListQuery, baseURL string) (*scim.ListResponse, error) {
+	return s.repo.ListGroups(ctx, tenantID, query, baseURL)
+}
+
+func (s *ScimService) GetGroup(ctx context.Context, tenantID uint32, id string, excludeMembers bool, baseURL string) (*scim.Group, error) {
+	return s.repo.GetGroup(ctx, tenantID, id, excludeMembers, baseURL)
+}
+
+func (s *ScimService) CreateGroup(ctx context.Context, tenantID uint32, in *scim.Group, baseURL string) (*scim.Group, error) {
+	out, err := s.repo.CreateGroup(ctx, tenantID, in, baseURL)
+	if err != nil {
+		return nil, err
+	}
+	s.resetPolicies(ctx)
+	return out, nil
+}
+
+func (s *ScimService) ReplaceGroup(ctx context.Context, tenantID uint32, id string, in *scim.Group, ifMatch, baseURL string) (*scim.Group, error) {
+	out, err := s.repo.ReplaceGroup(ctx, tenantID, id, in, ifMatch, baseURL)
+	if err != nil {
+		return nil, err
+	}
+	s.resetPolicies(ctx)
+	return out, nil
+}
+
+func (s *ScimService) PatchGroup(ctx context.Context, tenantID uint32, id string, ops []scim.PatchOperation, ifMatch, baseURL string) (*scim.Group, error) {
+	out, err := s.repo.PatchGroup(ctx, tenantID, id, ops, ifMatch, baseURL)
+	if err != nil {
+		return nil, err
+	}
+	s.resetPolicies(ctx)
+	return out, nil
+}
+
+func (s *ScimService) DeleteGroup(ctx context.Context, tenantID uint32, id string) error {
+	if err := s.repo.DeleteGroup(ctx, tenantID, id); err != nil {
+		return err
+	}
+	s.resetPolicies(ctx)
+	return nil
+}
+
+// resetPolicies 组成员即角色分配，变化后重新加载权限策略
+func (s *ScimService) resetPolicies(ctx context.Context) {
+	if err := s.authorizer.ResetPolicies(appViewer.NewSystemViewerContext(ctx)); err != nil {
+		s.log.Errorf("reset policies error: %v", err)
+	}
+}
+
+// ServiceProviderConfig 返回服务端支持的 SCIM 能力
+func (s *ScimService) ServiceProviderConfig(baseURL string) map[string]any {
+	supported := func(v bool) map[string]any { return map[string]any{"supported": v} }
+	return map[string]any{
+		"schemas":        []string{scim.SchemaServiceProviderConfig},
+		"patch":          supported(true),
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": scim.MaxCount},
+		"changePassword": supported(true),
+		"sort":           supported(false),
+		"etag":           supported(true),
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication using a tenant-scoped SCIM token",
+			"primary":     true,
+		}},
+		"meta": map[string]any{
+			"resourceType": "ServiceProviderConfig",
+			"location":     baseURL + "/ServiceProviderConfig",
+		},
+	}
+}
+
+// ToScimError 把服务返回的错误转换为 SCIM 错误响应
+func ToScimError(err error) *scim.Error {
+	var scimErr *scim.Error
+	if errors.As(err, &scimErr) {
+		return scimErr
+	}
+
+	e := kerrors.FromError(err)
+	status := int(e.Code)
+	if status < http.StatusBadRequest || status > 599 {
+		status = http.StatusInternalServerError
+	}
+
+	var scimType string
+	switch status {
+	case http.StatusBadRequest:
+		scimType = scim.ErrInvalidValue
+	case http.StatusConflict:
+		scimType = scim.ErrUniqueness
+	}
+
+	return scim.NewError(status, scimType, "%s", e.Message)
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+type ScimTokenService struct {
+	adminV1.ScimTokenServiceHTTPServer
+
+	log *log.Helper
+
+	repo       *data.ScimTokenRepo
+	tenantRepo *data.TenantRepo
+}
+
+func NewScimTokenService(
+	ctx *bootstrap.Context,
+	repo *data.ScimTokenRepo,
+	tenantRepo *data.TenantRepo,
+) *ScimTokenService {
+	return &ScimTokenService{
+		log:        ctx.NewLoggerHelper("scim-token/service/admin-service"),
+		repo:       repo,
+		tenantRepo: tenantRepo,
+	}
+}
+
+func (s *ScimTokenService) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListScimTokenResponse, error) {
+	return s.repo.List(ctx, req)
+}
+
+// Create 创建 SCIM 令牌，令牌明文只在响应中返回一次
+func (s *ScimTokenService) Create(ctx context.Context, req *authenticationV1.CreateScimTokenRequest) (*authenticationV1.CreateScimTokenResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 租户用户只能创建本租户的令牌
+	tenantID := operator.GetTenantId()
+	if tenantID == 0 {
+		tenantID = req.GetTenantId()
+	}
+
+	// 令牌按租户供应账号，不能创建平台级令牌
+	if tenantID == 0 {
+		return nil, adminV1.ErrorBadRequest("tenant is required")
+	}
+	exist, err := s.tenantRepo.IsExist(appViewer.NewSystemViewerContext(ctx), tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, adminV1.ErrorNotFound("tenant not found")
+	}
+
+	token, plain, err := s.repo.Create(ctx, tenantID, strings.TrimSpace(req.GetName()), req.GetTtl().AsDuration(), operator.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &authenticationV1.CreateScimTokenResponse{
+		ScimToken: token,
+		Token:     plain,
+	}, nil
+}
+
+func (s *ScimTokenService) Delete(ctx context.Context, req *authenticationV1.DeleteScimTokenRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	if err := s.repo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
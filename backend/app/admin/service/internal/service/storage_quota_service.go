@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/task"
+)
+
+type StorageQuotaService struct {
+	adminV1.StorageQuotaServiceHTTPServer
+
+	log *log.Helper
+
+	quotaRepo *data.StorageQuotaRepo
+	fileRepo  *data.FileRepo
+}
+
+func NewStorageQuotaService(
+	ctx *bootstrap.Context,
+	quotaRepo *data.StorageQuotaRepo,
+	fileRepo *data.FileRepo,
+) *StorageQuotaService {
+	return &StorageQuotaService{
+		log:       ctx.NewLoggerHelper("storage-quota/service/admin-service"),
+		quotaRepo: quotaRepo,
+		fileRepo:  fileRepo,
+	}
+}
+
+func (s *StorageQuotaService) List(ctx context.Context, req *paginationV1.PagingRequest) (*fileV1.ListStorageQuotaResponse, error) {
+	return s.quotaRepo.List(ctx, req)
+}
+
+func (s *StorageQuotaService) Get(ctx context.Context, req *fileV1.GetStorageQuotaRequest) (*fileV1.StorageQuota, error) {
+	return s.quotaRepo.Get(ctx, req)
+}
+
+func (s *StorageQuotaService) Create(ctx context.Context, req *fileV1.CreateStorageQuotaRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.quotaRepo.Create(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *StorageQuotaService) Update(ctx context.Context, req *fileV1.UpdateStorageQuotaRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+	if req.UpdateMask != nil {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
+	}
+
+	if err = s.quotaRepo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *StorageQuotaService) Delete(ctx context.Context, req *fileV1.DeleteStorageQuotaRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	if err := s.quotaRepo.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetStorageUsage 按租户、存储桶、内容类型统计存储用量
+func (s *StorageQuotaService) GetStorageUsage(ctx context.Context, req *fileV1.GetStorageUsageRequest) (*fileV1.GetStorageUsageResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 租户用户只能查看本租户的用量
+	tenantID := req.TenantId
+	if operator.GetTenantId() != 0 {
+		tenantID = trans.Ptr(operator.GetTenantId())
+	}
+
+	items, err := s.fileRepo.StatUsage(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &fileV1.GetStorageUsageResponse{
+		Items: items,
+	}
+	for _, item := range items {
+		resp.TotalFiles += item.GetFileCount()
+		resp.TotalBytes += item.GetTotalBytes()
+	}
+
+	return resp, nil
+}
+
+// ReconcileStorageUsage 按文件表重新计算所有配额的已用量
+func (s *StorageQuotaService) ReconcileStorageUsage(ctx context.Context, _ *emptypb.Empty) (*fileV1.ReconcileStorageUsageResponse, error) {
+	count, err := s.reconcileStorageUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileV1.ReconcileStorageUsageResponse{
+		Count: count,
+	}, nil
+}
+
+// AsyncReconcileStorageUsage 异步重新计算存储用量
+func (s *StorageQuotaService) AsyncReconcileStorageUsage(ctx context.Context, taskType string, _ *task.StorageUsageReconcileTaskData) error {
+	count, err := s.reconcileStorageUsage(appViewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		s.log.Errorf("[%s] 重新计算存储用量失败[%s]", taskType, err.Error())
+		return err
+	}
+
+	s.log.Infof("[%s] 重新计算存储用量完成，更新配额[%d]条", taskType, count)
+
+	task.ProgressFromContext(ctx).SetResult(map[string]uint32{"updated": count})
+
+	return nil
+}
+
+// reconcileStorageUsage 从文件表汇总用量，并写回配额记录
+func (s *StorageQuotaService) reconcileStorageUsage(ctx context.Context) (uint32, error) {
+	usages, err := s.fileRepo.StatOwnerUsage(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	type usageKey struct {
+		tenantID uint32
+		userID   uint32
+	}
+	userTotals := make(map[usageKey]data.FileOwnerUsage, len(usages))
+	tenantTotals := make(map[uint32]data.FileOwnerUsage)
+	for _, u := range usages {
+		userTotals[usageKey{tenantID: u.TenantID, userID: u.UserID}] = u
+
+		t := tenantTotals[u.TenantID]
+		t.FileCount += u.FileCount
+		t.TotalBytes += u.TotalBytes
+		tenantTotals[u.TenantID] = t
+	}
+
+	quotas, err := s.quotaRepo.ListAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint32
+	for _, q := range quotas {
+		var u data.FileOwnerUsage
+		if userID := trans.Uint32Value(q.UserID); userID == 0 {
+			u = tenantTotals[trans.Uint32Value(q.TenantID)]
+		} else {
+			u = userTotals[usageKey{tenantID: trans.Uint32Value(q.TenantID), userID: userID}]
+		}
+		if err = s.quotaRepo.SetUsage(ctx, q.ID, u.TotalBytes, u.FileCount); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	asynqServer "github.com/tx7do/kratos-transport/transport/asynq"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/lua"
+	"go-wind-admin/pkg/lua/api"
+)
+
+// RegisterLuaTaskHandlers 将脚本通过 task.register_handler() 注册的任务处理函数注册为任务类型
+// 与已有任务类型同名的处理函数会被跳过，内置任务优先。
+func RegisterLuaTaskHandlers(srv *asynqServer.Server, taskService *TaskService, engine *lua.Engine) error {
+	if engine == nil {
+		return nil
+	}
+
+	for _, h := range lua.TaskHandlers() {
+		if srv.TaskTypeExists(h.Name) {
+			taskService.log.Warnf("[%s] 任务类型已存在，忽略脚本注册的任务处理函数", h.Name)
+			continue
+		}
+
+		if err := RegisterTaskHandler(srv, taskService, h.Name, luaTaskHandler(engine, h)); err != nil {
+			return err
+		}
+
+		taskService.log.Infof("[%s] 注册脚本任务处理函数：%s", h.Name, h.Description)
+	}
+
+	return nil
+}
+
+// luaTaskHandler 将脚本任务处理函数适配为任务处理函数，执行受脚本引擎的资源限制约束
+func luaTaskHandler(engine *lua.Engine, h *api.LuaTaskHandler) func(ctx context.Context, taskType string, taskData *map[string]any) error {
+	return func(ctx context.Context, _ string, taskData *map[string]any) error {
+		var payload map[string]any
+		if taskData != nil {
+			payload = *taskData
+		}
+
+		// 后台任务没有发起请求的用户，以系统身份执行，db 模块不按数据权限过滤
+		err := engine.ExecuteTaskHandler(appViewer.NewSystemViewerContext(ctx), h, payload)
+		if errors.Is(err, lua.ErrInvalidTaskPayload) {
+			// 参数错误重试也不会成功
+			return fmt.Errorf("%w: %w", err, asynq.SkipRetry)
+		}
+		return err
+	}
+}
+
+// luaTaskOptions 脚本任务处理函数声明的默认重试次数和超时时间，任务自身的选项优先
+func luaTaskOptions(typeName string) []asynq.Option {
+	h, ok := lua.TaskHandler(typeName)
+	if !ok {
+		return nil
+	}
+
+	var opts []asynq.Option
+	if h.MaxRetries >= 0 {
+		opts = append(opts, asynq.MaxRetry(h.MaxRetries))
+	}
+	if timeout := lua.TaskTimeout(h); timeout > 0 {
+		opts = append(opts, asynq.Timeout(timeout))
+	}
+	return opts
+}
+
+// validateLuaTaskPayload 按脚本任务处理函数声明的必填字段校验任务参数，非脚本任务不做校验
+func validateLuaTaskPayload(typeName, taskPayload string) error {
+	h, ok := lua.TaskHandler(typeName)
+	if !ok {
+		return nil
+	}
+
+	var payload map[string]any
+	if len(taskPayload) > 0 {
+		if err := json.Unmarshal([]byte(taskPayload), &payload); err != nil {
+			return adminV1.ErrorBadRequest("task payload must be a JSON object")
+		}
+	}
+
+	if _, err := lua.ValidateTaskPayload(h, payload); err != nil {
+		return adminV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	return nil
+}
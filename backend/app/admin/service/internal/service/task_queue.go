@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+const (
+	taskAuditResource      = "task"
+	taskQueueAuditResource = "task_queue"
+
+	defaultQueueTaskPageSize = 10
+	maxQueueTaskPageSize     = 100
+)
+
+// ListTaskQueue 查询任务队列统计
+func (s *TaskService) ListTaskQueue(_ context.Context, _ *emptypb.Empty) (*taskV1.ListTaskQueueResponse, error) {
+	if s.taskInspector == nil {
+		return nil, adminV1.ErrorServiceUnavailable("task queue is not available")
+	}
+
+	queues, err := s.taskInspector.Queues()
+	if err != nil {
+		s.log.Errorf("获取任务队列失败[%s]", err.Error())
+		return nil, adminV1.ErrorInternalServerError("list task queues failed")
+	}
+
+	items := make([]*taskV1.TaskQueueInfo, 0, len(queues))
+	for _, queue := range queues {
+		info, err := s.taskInspector.GetQueueInfo(queue)
+		if err != nil {
+			// 队列可能刚被删除
+			s.log.Warnf("[%s] 获取队列统计失败[%s]", queue, err.Error())
+			continue
+		}
+		items = append(items, queueInfoToProto(info))
+	}
+
+	return &taskV1.ListTaskQueueResponse{
+		Items: items,
+		Total: uint64(len(items)),
+	}, nil
+}
+
+// ListQueueTask 按状态分页查询队列中的任务
+func (s *TaskService) ListQueueTask(_ context.Context, req *taskV1.ListQueueTaskRequest) (*taskV1.ListQueueTaskResponse, error) {
+	if s.taskInspector == nil {
+		return nil, adminV1.ErrorServiceUnavailable("task queue is not available")
+	}
+	if req.GetQueue() == "" {
+		return nil, adminV1.ErrorBadRequest("queue is required")
+	}
+
+	info, err := s.taskInspector.GetQueueInfo(req.GetQueue())
+	if err != nil {
+		return nil, s.queueError(req.GetQueue(), err)
+	}
+
+	page := int(req.GetPage())
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultQueueTaskPageSize
+	}
+	if pageSize > maxQueueTaskPageSize {
+		pageSize = maxQueueTaskPageSize
+	}
+	opts := []asynq.ListOption{asynq.Page(page), asynq.PageSize(pageSize)}
+
+	var tasks []*asynq.TaskInfo
+	var total int
+	switch req.GetState() {
+	case taskV1.QueueTask_PENDING:
+		tasks, err = s.taskInspector.ListPendingTasks(req.GetQueue(), opts...)
+		total = info.Pending
+	case taskV1.QueueTask_ACTIVE:
+		tasks, err = s.taskInspector.ListActiveTasks(req.GetQueue(), opts...)
+		total = info.Active
+	case taskV1.QueueTask_SCHEDULED:
+		tasks, err = s.taskInspector.ListScheduledTasks(req.GetQueue(), opts...)
+		total = info.Scheduled
+	case taskV1.QueueTask_RETRY:
+		tasks, err = s.taskInspector.ListRetryTasks(req.GetQueue(), opts...)
+		total = info.Retry
+	case taskV1.QueueTask_ARCHIVED:
+		tasks, err = s.taskInspector.ListArchivedTasks(req.GetQueue(), opts...)
+		total = info.Archived
+	case taskV1.QueueTask_COMPLETED:
+		tasks, err = s.taskInspector.ListCompletedTasks(req.GetQueue(), opts...)
+		total = info.Completed
+	default:
+		return nil, adminV1.ErrorBadRequest("unsupported task state [%s]", req.GetState().String())
+	}
+	if err != nil {
+		return nil, s.queueError(req.GetQueue(), err)
+	}
+
+	items := make([]*taskV1.QueueTask, 0, len(tasks))
+	for _, t := range tasks {
+		items = append(items, queueTaskToProto(t))
+	}
+
+	return &taskV1.ListQueueTaskResponse{
+		Items: items,
+		Total: uint64(total),
+	}, nil
+}
+
+// RetryArchivedTask 将已归档（死信）的任务重新放入待处理队列
+func (s *TaskService) RetryArchivedTask(ctx context.Context, req *taskV1.QueueTaskRequest) (*emptypb.Empty, error) {
+	return s.handleArchivedTask(ctx, req, auditV1.OperationAuditLog_UPDATE, "retry", TaskInspector.RunTask)
+}
+
+// DeleteArchivedTask 删除已归档（死信）的任务
+func (s *TaskService) DeleteArchivedTask(ctx context.Context, req *taskV1.QueueTaskRequest) (*emptypb.Empty, error) {
+	return s.handleArchivedTask(ctx, req, auditV1.OperationAuditLog_DELETE, "delete", TaskInspector.DeleteTask)
+}
+
+func (s *TaskService) handleArchivedTask(
+	ctx context.Context,
+	req *taskV1.QueueTaskRequest,
+	action auditV1.OperationAuditLog_ActionType,
+	operation string,
+	fn func(inspector TaskInspector, queue, id string) error,
+) (*emptypb.Empty, error) {
+	if s.taskInspector == nil {
+		return nil, adminV1.ErrorServiceUnavailable("task queue is not available")
+	}
+	if req.GetQueue() == "" || req.GetId() == "" {
+		return nil, adminV1.ErrorBadRequest("queue and task id are required")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := s.taskInspector.GetTaskInfo(req.GetQueue(), req.GetId())
+	if err != nil {
+		return nil, s.queueError(req.GetQueue(), err)
+	}
+	if info.State != asynq.TaskStateArchived {
+		return nil, adminV1.ErrorBadRequest("task [%s] is not archived", req.GetId())
+	}
+
+	err = fn(s.taskInspector, req.GetQueue(), req.GetId())
+	if err != nil {
+		err = s.queueError(req.GetQueue(), err)
+	}
+
+	s.writeOperationLog(ctx, operator, taskQueueAuditResource, req.GetQueue()+"/"+req.GetId(), action,
+		map[string]string{"operation": operation, "type_name": info.Type}, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// removeQueuedTasks 删除队列中指定类型尚未执行的任务，用于停止延时任务和等待结果任务
+func (s *TaskService) removeQueuedTasks(typeName string) error {
+	if s.taskInspector == nil {
+		return nil
+	}
+
+	queues, err := s.taskInspector.Queues()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, queue := range queues {
+		for _, list := range []func(string, ...asynq.ListOption) ([]*asynq.TaskInfo, error){
+			s.taskInspector.ListScheduledTasks,
+			s.taskInspector.ListPendingTasks,
+			s.taskInspector.ListRetryTasks,
+		} {
+			for page := 1; ; page++ {
+				tasks, err := list(queue, asynq.Page(page), asynq.PageSize(maxQueueTaskPageSize))
+				if err != nil {
+					errs = append(errs, err)
+					break
+				}
+
+				var removed int
+				for _, t := range tasks {
+					if t.Type != typeName {
+						continue
+					}
+					if err = s.taskInspector.DeleteTask(queue, t.ID); err != nil {
+						errs = append(errs, err)
+						continue
+					}
+					removed++
+				}
+
+				// 删除后后续任务会前移，删除过的页需要重新读取
+				if removed > 0 {
+					page--
+				}
+				if len(tasks) < maxQueueTaskPageSize {
+					break
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *TaskService) queueError(queue string, err error) error {
+	switch {
+	case errors.Is(err, asynq.ErrQueueNotFound):
+		return adminV1.ErrorNotFound("task queue [%s] not found", queue)
+	case errors.Is(err, asynq.ErrTaskNotFound):
+		return adminV1.ErrorNotFound("task not found in queue [%s]", queue)
+	default:
+		s.log.Errorf("[%s] 任务队列操作失败[%s]", queue, err.Error())
+		return adminV1.ErrorInternalServerError("task queue operation failed")
+	}
+}
+
+// writeOperationLog 记录操作审计日志，写入失败只记录错误，不影响本次操作
+func (s *TaskService) writeOperationLog(
+	ctx context.Context,
+	operator *authenticationV1.UserTokenPayload,
+	resourceType, resourceID string,
+	action auditV1.OperationAuditLog_ActionType,
+	detail any,
+	opErr error,
+) {
+	writeOperationAuditLog(ctx, s.operationLogRepo, s.log, operator, resourceType, resourceID, action, detail, opErr)
+}
+
+func queueInfoToProto(info *asynq.QueueInfo) *taskV1.TaskQueueInfo {
+	return &taskV1.TaskQueueInfo{
+		Queue:          trans.Ptr(info.Queue),
+		MemoryUsage:    trans.Ptr(info.MemoryUsage),
+		Latency:        durationpb.New(info.Latency),
+		Size:           trans.Ptr(int32(info.Size)),
+		Pending:        trans.Ptr(int32(info.Pending)),
+		Active:         trans.Ptr(int32(info.Active)),
+		Scheduled:      trans.Ptr(int32(info.Scheduled)),
+		Retry:          trans.Ptr(int32(info.Retry)),
+		Archived:       trans.Ptr(int32(info.Archived)),
+		Completed:      trans.Ptr(int32(info.Completed)),
+		Aggregating:    trans.Ptr(int32(info.Aggregating)),
+		Processed:      trans.Ptr(int32(info.Processed)),
+		Failed:         trans.Ptr(int32(info.Failed)),
+		ProcessedTotal: trans.Ptr(int32(info.ProcessedTotal)),
+		FailedTotal:    trans.Ptr(int32(info.FailedTotal)),
+		Paused:         trans.Ptr(info.Paused),
+		Timestamp:      timestamppb.New(info.Timestamp),
+	}
+}
+
+func queueTaskStateToProto(state asynq.TaskState) taskV1.QueueTask_State {
+	switch state {
+	case asynq.TaskStateActive:
+		return taskV1.QueueTask_ACTIVE
+	case asynq.TaskStateScheduled:
+		return taskV1.QueueTask_SCHEDULED
+	case asynq.TaskStateRetry:
+		return taskV1.QueueTask_RETRY
+	case asynq.TaskStateArchived:
+		return taskV1.QueueTask_ARCHIVED
+	case asynq.TaskStateCompleted:
+		return taskV1.QueueTask_COMPLETED
+	case asynq.TaskStateAggregating:
+		return taskV1.QueueTask_AGGREGATING
+	default:
+		return taskV1.QueueTask_PENDING
+	}
+}
+
+func queueTaskToProto(t *asynq.TaskInfo) *taskV1.QueueTask {
+	dto := &taskV1.QueueTask{
+		Id:         trans.Ptr(t.ID),
+		Queue:      trans.Ptr(t.Queue),
+		TypeName:   trans.Ptr(t.Type),
+		Payload:    trans.Ptr(string(t.Payload)),
+		State:      trans.Ptr(queueTaskStateToProto(t.State)),
+		MaxRetry:   trans.Ptr(int32(t.MaxRetry)),
+		Retried:    trans.Ptr(int32(t.Retried)),
+		IsOrphaned: trans.Ptr(t.IsOrphaned),
+	}
+	if t.LastErr != "" {
+		dto.LastError = trans.Ptr(t.LastErr)
+	}
+	if t.Group != "" {
+		dto.Group = trans.Ptr(t.Group)
+	}
+	if !t.LastFailedAt.IsZero() {
+		dto.LastFailedAt = timestamppb.New(t.LastFailedAt)
+	}
+	if !t.NextProcessAt.IsZero() {
+		dto.NextProcessAt = timestamppb.New(t.NextProcessAt)
+	}
+	if !t.CompletedAt.IsZero() {
+		dto.CompletedAt = timestamppb.New(t.CompletedAt)
+	}
+	return dto
+}
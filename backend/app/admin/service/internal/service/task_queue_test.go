@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+)
+
+// fakeTaskInspector 内存中的任务队列，只实现测试用到的方法
+type fakeTaskInspector struct {
+	TaskInspector
+
+	tasks map[string][]*asynq.TaskInfo
+}
+
+func (f *fakeTaskInspector) Queues() ([]string, error) {
+	var queues []string
+	for q := range f.tasks {
+		queues = append(queues, q)
+	}
+	return queues, nil
+}
+
+func (f *fakeTaskInspector) GetQueueInfo(queue string) (*asynq.QueueInfo, error) {
+	tasks, ok := f.tasks[queue]
+	if !ok {
+		return nil, asynq.ErrQueueNotFound
+	}
+	info := &asynq.QueueInfo{Queue: queue, Size: len(tasks)}
+	for _, t := range tasks {
+		switch t.State {
+		case asynq.TaskStatePending:
+			info.Pending++
+		case asynq.TaskStateScheduled:
+			info.Scheduled++
+		case asynq.TaskStateArchived:
+			info.Archived++
+		}
+	}
+	return info, nil
+}
+
+func (f *fakeTaskInspector) list(queue string, state asynq.TaskState, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error) {
+	page, pageSize := 1, 30
+	// asynq 的分页选项类型未导出，按类型名解析
+	for _, o := range opts {
+		switch reflect.TypeOf(o).Name() {
+		case "pageNumOpt":
+			page = int(reflect.ValueOf(o).Int())
+		case "pageSizeOpt":
+			pageSize = int(reflect.ValueOf(o).Int())
+		}
+	}
+
+	var matched []*asynq.TaskInfo
+	for _, t := range f.tasks[queue] {
+		if t.State == state {
+			matched = append(matched, t)
+		}
+	}
+
+	start := (page - 1) * pageSize
+	if start >= len(matched) {
+		return nil, nil
+	}
+	return matched[start:min(start+pageSize, len(matched))], nil
+}
+
+func (f *fakeTaskInspector) ListPendingTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error) {
+	return f.list(queue, asynq.TaskStatePending, opts...)
+}
+
+func (f *fakeTaskInspector) ListScheduledTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error) {
+	return f.list(queue, asynq.TaskStateScheduled, opts...)
+}
+
+func (f *fakeTaskInspector) ListRetryTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error) {
+	return f.list(queue, asynq.TaskStateRetry, opts...)
+}
+
+func (f *fakeTaskInspector) ListArchivedTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error) {
+	return f.list(queue, asynq.TaskStateArchived, opts...)
+}
+
+func (f *fakeTaskInspector) DeleteTask(queue, id string) error {
+	for i, t := range f.tasks[queue] {
+		if t.ID == id {
+			f.tasks[queue] = append(f.tasks[queue][:i], f.tasks[queue][i+1:]...)
+			return nil
+		}
+	}
+	return asynq.ErrTaskNotFound
+}
+
+func newTestTaskQueueService(inspector TaskInspector) *TaskService {
+	return &TaskService{
+		log:           log.NewHelper(log.DefaultLogger),
+		taskInspector: inspector,
+	}
+}
+
+func TestRemoveQueuedTasks(t *testing.T) {
+	inspector := &fakeTaskInspector{tasks: map[string][]*asynq.TaskInfo{}}
+	for i := 0; i < 250; i++ {
+		typeName := "report"
+		if i%3 == 0 {
+			typeName = "email"
+		}
+		state := asynq.TaskStatePending
+		if i%2 == 0 {
+			state = asynq.TaskStateScheduled
+		}
+		inspector.tasks["default"] = append(inspector.tasks["default"], &asynq.TaskInfo{
+			ID: string(rune('a'+i%26)) + string(rune('0'+i/26)), Queue: "default", Type: typeName, State: state,
+		})
+	}
+	inspector.tasks["default"] = append(inspector.tasks["default"],
+		&asynq.TaskInfo{ID: "dead", Queue: "default", Type: "email", State: asynq.TaskStateArchived},
+	)
+
+	s := newTestTaskQueueService(inspector)
+	assert.NoError(t, s.removeQueuedTasks("email"))
+
+	for _, task := range inspector.tasks["default"] {
+		// 死信任务不属于待执行的任务，保留
+		if task.Type == "email" {
+			assert.Equal(t, asynq.TaskStateArchived, task.State)
+		}
+	}
+	assert.Len(t, inspector.tasks["default"], 250-84+1)
+
+	// 未配置队列查询器时不做任何事
+	assert.NoError(t, newTestTaskQueueService(nil).removeQueuedTasks("email"))
+}
+
+func TestListQueueTask(t *testing.T) {
+	inspector := &fakeTaskInspector{tasks: map[string][]*asynq.TaskInfo{
+		"default": {
+			{ID: "1", Queue: "default", Type: "backup", State: asynq.TaskStateArchived, LastErr: "timeout", MaxRetry: 3, Retried: 3},
+			{ID: "2", Queue: "default", Type: "backup", State: asynq.TaskStatePending},
+		},
+	}}
+	s := newTestTaskQueueService(inspector)
+
+	resp, err := s.ListQueueTask(context.Background(), &taskV1.ListQueueTaskRequest{
+		Queue: "default",
+		State: taskV1.QueueTask_ARCHIVED,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), resp.GetTotal())
+	if assert.Len(t, resp.GetItems(), 1) {
+		assert.Equal(t, "1", resp.GetItems()[0].GetId())
+		assert.Equal(t, taskV1.QueueTask_ARCHIVED, resp.GetItems()[0].GetState())
+		assert.Equal(t, "timeout", resp.GetItems()[0].GetLastError())
+		assert.Equal(t, int32(3), resp.GetItems()[0].GetRetried())
+	}
+
+	_, err = s.ListQueueTask(context.Background(), &taskV1.ListQueueTaskRequest{Queue: "missing", PageSize: trans.Ptr(uint32(5))})
+	assert.Error(t, err)
+
+	_, err = newTestTaskQueueService(nil).ListQueueTask(context.Background(), &taskV1.ListQueueTaskRequest{Queue: "default"})
+	assert.Error(t, err)
+}
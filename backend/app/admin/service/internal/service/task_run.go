@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	asynqServer "github.com/tx7do/kratos-transport/transport/asynq"
+	"github.com/tx7do/kratos-transport/transport/sse"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/task"
+)
+
+// RegisterTaskHandler 注册任务处理函数，每次执行都会记录一条执行记录
+// 处理函数可以通过 task.ProgressFromContext 上报进度和设置执行结果。
+func RegisterTaskHandler[T any](
+	srv *asynqServer.Server,
+	taskService *TaskService,
+	taskType string,
+	handler func(ctx context.Context, taskType string, taskData *T) error,
+) error {
+	return asynqServer.RegisterSubscriberWithCtx(srv, taskType,
+		func(ctx context.Context, taskType string, taskData *T) error {
+			return taskService.runTask(ctx, taskType, func(ctx context.Context) error {
+				return handler(ctx, taskType, taskData)
+			})
+		},
+	)
+}
+
+// runTask 执行任务并记录执行记录
+func (s *TaskService) runTask(ctx context.Context, taskType string, fn func(ctx context.Context) error) (err error) {
+	sysCtx := appViewer.NewSystemViewerContext(context.WithoutCancel(ctx))
+
+	startedAt := time.Now()
+	run := &taskV1.TaskRun{
+		TypeName:  trans.Ptr(taskType),
+		StartedAt: timestamppb.New(startedAt),
+	}
+	if v, ok := asynq.GetTaskID(ctx); ok {
+		run.QueueTaskId = trans.Ptr(v)
+	}
+	if v, ok := asynq.GetQueueName(ctx); ok {
+		run.Queue = trans.Ptr(v)
+	}
+	if v, ok := asynq.GetRetryCount(ctx); ok {
+		run.RetryCount = trans.Ptr(int32(v))
+	}
+	if v, ok := asynq.GetMaxRetry(ctx); ok {
+		run.MaxRetry = trans.Ptr(int32(v))
+	}
+
+	// 关联到调度任务，临时投递的任务没有对应的调度任务
+	var ownerID uint32
+	if t, terr := s.taskRepo.Get(sysCtx, &taskV1.GetTaskRequest{
+		QueryBy: &taskV1.GetTaskRequest_TypeName{TypeName: taskType},
+	}); terr == nil {
+		run.TaskId = t.Id
+		run.TenantId = t.TenantId
+		ownerID = t.GetCreatedBy()
+	}
+
+	// 执行记录写入失败不影响任务本身的执行
+	if created, cerr := s.taskRunRepo.Create(sysCtx, run); cerr != nil {
+		s.log.Errorf("[%s] 创建任务执行记录失败[%s]", taskType, cerr.Error())
+	} else {
+		run.Id = created.Id
+	}
+
+	progress := &taskProgress{svc: s, ctx: sysCtx, run: run, ownerID: ownerID}
+	progress.publish(run)
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("task panic: %v", r)
+		}
+		progress.finish(err, time.Since(startedAt))
+	}()
+
+	return fn(task.NewProgressContext(ctx, progress))
+}
+
+// taskProgress 任务进度上报，进度写入执行记录并通过 SSE 推送给任务的创建者
+type taskProgress struct {
+	svc *TaskService
+	ctx context.Context
+
+	ownerID uint32
+
+	mu     sync.Mutex
+	run    *taskV1.TaskRun
+	result *string
+}
+
+func (p *taskProgress) Report(percent uint32, message string) {
+	if percent > 100 {
+		percent = 100
+	}
+
+	p.mu.Lock()
+	if p.run.GetProgress() == percent && p.run.GetMessage() == message {
+		p.mu.Unlock()
+		return
+	}
+	p.run.Progress = trans.Ptr(percent)
+	p.run.Message = trans.Ptr(message)
+	snapshot := p.snapshot()
+	p.mu.Unlock()
+
+	if snapshot.Id != nil {
+		if err := p.svc.taskRunRepo.UpdateProgress(p.ctx, snapshot.GetId(), percent, snapshot.Message); err != nil {
+			p.svc.log.Warnf("[%s] 更新任务进度失败[%s]", snapshot.GetTypeName(), err.Error())
+		}
+	}
+
+	p.publish(snapshot)
+}
+
+func (p *taskProgress) SetResult(result any) {
+	b, err := json.Marshal(result)
+	if err != nil {
+		p.svc.log.Warnf("[%s] 任务执行结果无法序列化[%s]", p.run.GetTypeName(), err.Error())
+		return
+	}
+
+	p.mu.Lock()
+	p.result = trans.Ptr(string(b))
+	p.mu.Unlock()
+}
+
+// finish 记录执行结束的状态、结果与耗时
+func (p *taskProgress) finish(err error, elapsed time.Duration) {
+	p.mu.Lock()
+	p.run.FinishedAt = timestamppb.Now()
+	p.run.DurationMs = trans.Ptr(uint64(elapsed.Milliseconds()))
+	p.run.Result = p.result
+	if err != nil {
+		p.run.Status = trans.Ptr(taskV1.TaskRun_FAILED)
+		p.run.Error = trans.Ptr(err.Error())
+	} else {
+		p.run.Status = trans.Ptr(taskV1.TaskRun_SUCCEEDED)
+		p.run.Progress = trans.Ptr(uint32(100))
+	}
+	snapshot := p.snapshot()
+	p.mu.Unlock()
+
+	if snapshot.Id != nil {
+		if ferr := p.svc.taskRunRepo.Finish(p.ctx, snapshot); ferr != nil {
+			p.svc.log.Errorf("[%s] 更新任务执行记录失败[%s]", snapshot.GetTypeName(), ferr.Error())
+		}
+	}
+
+	p.publish(snapshot)
+}
+
+func (p *taskProgress) snapshot() *taskV1.TaskRun {
+	return &taskV1.TaskRun{
+		Id:         p.run.Id,
+		TaskId:     p.run.TaskId,
+		TypeName:   p.run.TypeName,
+		Status:     p.run.Status,
+		RetryCount: p.run.RetryCount,
+		Progress:   p.run.Progress,
+		Message:    p.run.Message,
+		Error:      p.run.Error,
+		Result:     p.run.Result,
+		StartedAt:  p.run.StartedAt,
+		FinishedAt: p.run.FinishedAt,
+		DurationMs: p.run.DurationMs,
+		TenantId:   p.run.TenantId,
+	}
+}
+
+// publish 推送任务进度，没有订阅者时不会阻塞
+// 只推送给任务创建者的 SSE 流（与站内信一样以访问令牌为流ID），不推送执行结果与错误信息，需要时通过执行记录接口查询。
+func (p *taskProgress) publish(run *taskV1.TaskRun) {
+	if p.svc.sseServer == nil || p.svc.userToken == nil || p.ownerID == 0 {
+		return
+	}
+
+	streamIds := p.svc.userToken.GetAccessTokens(p.ctx, p.ownerID)
+	if len(streamIds) == 0 {
+		return
+	}
+
+	event := &taskV1.TaskRun{
+		Id:         run.Id,
+		TaskId:     run.TaskId,
+		TypeName:   run.TypeName,
+		Status:     run.Status,
+		RetryCount: run.RetryCount,
+		Progress:   run.Progress,
+		Message:    run.Message,
+		StartedAt:  run.StartedAt,
+		FinishedAt: run.FinishedAt,
+		DurationMs: run.DurationMs,
+	}
+	b, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	for _, streamId := range streamIds {
+		p.svc.sseServer.TryPublish(p.ctx, sse.StreamID(streamId), &sse.Event{
+			ID:    []byte(uuid.New().String()),
+			Data:  b,
+			Event: []byte(task.ProgressEvent),
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+
+	"go-wind-admin/pkg/task"
+)
+
+func newTestTaskProgress() *taskProgress {
+	return &taskProgress{
+		svc: &TaskService{log: log.NewHelper(log.DefaultLogger)},
+		ctx: context.Background(),
+		run: &taskV1.TaskRun{TypeName: trans.Ptr("test")},
+	}
+}
+
+func TestTaskProgress(t *testing.T) {
+	p := newTestTaskProgress()
+
+	ctx := task.NewProgressContext(context.Background(), p)
+	task.ProgressFromContext(ctx).Report(150, "done")
+	assert.Equal(t, uint32(100), p.run.GetProgress())
+	assert.Equal(t, "done", p.run.GetMessage())
+
+	task.ProgressFromContext(ctx).SetResult(map[string]int{"rows": 3})
+	p.finish(nil, 2*time.Second)
+	assert.Equal(t, taskV1.TaskRun_SUCCEEDED, p.run.GetStatus())
+	assert.Equal(t, `{"rows":3}`, p.run.GetResult())
+	assert.Equal(t, uint64(2000), p.run.GetDurationMs())
+	assert.NotNil(t, p.run.GetFinishedAt())
+
+	// 失败时保留最后上报的进度
+	p = newTestTaskProgress()
+	p.Report(40, "dumping")
+	p.finish(errors.New("boom"), time.Second)
+	assert.Equal(t, taskV1.TaskRun_FAILED, p.run.GetStatus())
+	assert.Equal(t, "boom", p.run.GetError())
+	assert.Equal(t, uint32(40), p.run.GetProgress())
+
+	// 没有上报器时不做任何事
+	assert.NotPanics(t, func() {
+		task.ProgressFromContext(context.Background()).Report(10, "")
+	})
+}
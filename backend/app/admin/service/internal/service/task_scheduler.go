@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/google/uuid"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/task"
+)
+
+// StartScheduler 启动任务调度，返回的函数用于停止调度
+// 配置了 Redis 时各节点通过选主决定由谁注册周期任务，主节点失联后由其他节点接管；
+// 未配置 Redis 时按单节点处理，直接启动所有的任务。
+func (s *TaskService) StartScheduler(ctx context.Context) (func(), error) {
+	ctx = appViewer.NewSystemViewerContext(ctx)
+
+	if s.rdb == nil {
+		if _, err := s.startAllTask(ctx); err != nil {
+			return nil, err
+		}
+		return func() {}, nil
+	}
+
+	s.elector = task.NewLeaderElector(s.rdb, task.SchedulerLeaderKey, nodeID(), task.SchedulerLeaseTTL)
+
+	runCtx, cancel := context.WithCancel(ctx)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		s.watchScheduleChanges(runCtx)
+	}()
+	go func() {
+		defer wg.Done()
+		s.elector.Run(runCtx, func() {
+			s.log.Infof("[%s] 成为任务调度节点", s.elector.ID())
+			// 延时任务和等待结果任务在创建时已经投递，切换调度节点时只需要注册周期任务
+			_, _ = s.startAllTask(ctx, taskV1.Task_PERIODIC)
+		}, func() {
+			s.log.Infof("[%s] 不再是任务调度节点", s.elector.ID())
+			s.stopAllTask()
+		})
+	}()
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}, nil
+}
+
+// isScheduler 当前节点是否负责注册周期任务
+func (s *TaskService) isScheduler() bool {
+	return s.elector == nil || s.elector.IsLeader()
+}
+
+// publishScheduleChange 通知调度节点执行任务调度变更
+func (s *TaskService) publishScheduleChange(ctx context.Context, action task.ScheduleAction, typeName string) error {
+	b, err := json.Marshal(&task.ScheduleChange{
+		Action:   action,
+		TypeName: typeName,
+		Node:     s.elector.ID(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = s.rdb.Publish(ctx, task.ScheduleChangedChannel, b).Err(); err != nil {
+		s.log.Errorf("[%s] 发送任务调度变更失败[%s]", typeName, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// watchScheduleChanges 接收其他节点发来的任务调度变更，只有调度节点会执行
+func (s *TaskService) watchScheduleChanges(ctx context.Context) {
+	sub := s.rdb.Subscribe(ctx, task.ScheduleChangedChannel)
+	defer func() {
+		_ = sub.Close()
+	}()
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			if !s.isScheduler() {
+				continue
+			}
+
+			var change task.ScheduleChange
+			if err := json.Unmarshal([]byte(msg.Payload), &change); err != nil {
+				s.log.Warnf("无法解析任务调度变更[%s]", err.Error())
+				continue
+			}
+			if err := s.applyScheduleChange(ctx, &change); err != nil {
+				s.log.Errorf("[%s] 执行任务调度变更[%s]失败[%s]", change.TypeName, change.Action, err.Error())
+			}
+		}
+	}
+}
+
+// applyScheduleChange 在调度节点上执行任务调度变更
+func (s *TaskService) applyScheduleChange(ctx context.Context, change *task.ScheduleChange) error {
+	switch change.Action {
+	case task.ScheduleActionStart:
+		// 以数据库中最新的配置为准，任务可能已经被删除、禁用或暂停
+		_ = s.taskScheduler.RemovePeriodicTask(change.TypeName)
+
+		t, err := s.taskRepo.Get(ctx, &taskV1.GetTaskRequest{
+			QueryBy: &taskV1.GetTaskRequest_TypeName{TypeName: change.TypeName},
+		})
+		if err != nil {
+			if adminV1.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if t.GetType() != taskV1.Task_PERIODIC || !t.GetEnable() || t.GetPaused() {
+			return nil
+		}
+		return s.startTask(ctx, t)
+
+	case task.ScheduleActionStop:
+		_ = s.taskScheduler.RemovePeriodicTask(change.TypeName)
+
+	case task.ScheduleActionStopAll:
+		s.stopAllTask()
+	}
+
+	return nil
+}
+
+// nodeID 当前节点的标识，同一主机上的多个进程也能区分
+func nodeID() string {
+	host, _ := os.Hostname()
+	if host == "" {
+		host = "unknown"
+	}
+	return host + "-" + uuid.NewString()[:8]
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-transport/broker"
+
+	"github.com/hibiken/asynq"
+
+	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
+
+	"go-wind-admin/pkg/task"
+)
+
+// fakeTaskScheduler 记录注册和移除的周期任务
+type fakeTaskScheduler struct {
+	TaskScheduler
+
+	mu       sync.Mutex
+	periodic map[string]string
+	removed  []string
+}
+
+func (f *fakeTaskScheduler) NewPeriodicTask(cronSpec, _, typeName string, _ broker.Any, _ ...asynq.Option) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.periodic[typeName] = cronSpec
+	return typeName, nil
+}
+
+func (f *fakeTaskScheduler) RemovePeriodicTask(id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.removed = append(f.removed, id)
+	delete(f.periodic, id)
+	return nil
+}
+
+func (f *fakeTaskScheduler) RemoveAllPeriodicTask() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.periodic = map[string]string{}
+}
+
+func (f *fakeTaskScheduler) registered(typeName string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.periodic[typeName]
+	return ok
+}
+
+func newTestSchedulerNode(rdb *redis.Client, id string) (*TaskService, *fakeTaskScheduler) {
+	scheduler := &fakeTaskScheduler{periodic: map[string]string{}}
+	s := &TaskService{
+		log:           log.NewHelper(log.DefaultLogger),
+		rdb:           rdb,
+		taskScheduler: scheduler,
+		elector:       task.NewLeaderElector(rdb, task.SchedulerLeaderKey, id, task.SchedulerLeaseTTL),
+	}
+	return s, scheduler
+}
+
+func TestTaskSchedulerLeaderOnly(t *testing.T) {
+	m := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	defer rdb.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leader, leaderScheduler := newTestSchedulerNode(rdb, "leader")
+	follower, followerScheduler := newTestSchedulerNode(rdb, "follower")
+
+	// 先启动的节点取得租约
+	go leader.elector.Run(ctx, func() {}, func() {})
+	assert.Eventually(t, leader.elector.IsLeader, time.Second, 10*time.Millisecond)
+	go follower.elector.Run(ctx, func() {}, func() {})
+	assert.True(t, leader.isScheduler())
+	assert.False(t, follower.isScheduler())
+
+	backup := &taskV1.Task{
+		Id:       trans.Ptr(uint32(1)),
+		Type:     trans.Ptr(taskV1.Task_PERIODIC),
+		TypeName: trans.Ptr(task.BackupTaskType),
+		CronSpec: trans.Ptr("0 3 * * *"),
+		Enable:   trans.Ptr(true),
+	}
+
+	sub := rdb.Subscribe(ctx, task.ScheduleChangedChannel)
+	defer sub.Close()
+	_, err := sub.Receive(ctx)
+	assert.NoError(t, err)
+
+	// 只有调度节点注册周期任务，非调度节点通知调度节点
+	assert.NoError(t, leader.startTask(ctx, backup))
+	assert.NoError(t, follower.startTask(ctx, backup))
+	assert.True(t, leaderScheduler.registered(task.BackupTaskType))
+	assert.False(t, followerScheduler.registered(task.BackupTaskType))
+
+	msg, err := sub.ReceiveMessage(ctx)
+	if assert.NoError(t, err) {
+		var change task.ScheduleChange
+		assert.NoError(t, json.Unmarshal([]byte(msg.Payload), &change))
+		assert.Equal(t, task.ScheduleActionStart, change.Action)
+		assert.Equal(t, task.BackupTaskType, change.TypeName)
+		assert.Equal(t, "follower", change.Node)
+	}
+
+	go leader.watchScheduleChanges(ctx)
+	go follower.watchScheduleChanges(ctx)
+	// 等待订阅生效
+	assert.Eventually(t, func() bool {
+		return m.PubSubNumSub(task.ScheduleChangedChannel)[task.ScheduleChangedChannel] == 3
+	}, time.Second, 10*time.Millisecond)
+
+	// 非调度节点上的停止交给调度节点执行
+	assert.NoError(t, follower.stopTask(ctx, backup))
+	assert.Eventually(t, func() bool {
+		return !leaderScheduler.registered(task.BackupTaskType)
+	}, time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, leader.startTask(ctx, backup))
+	assert.NoError(t, follower.stopAllScheduledTask(ctx))
+	assert.Eventually(t, func() bool {
+		return !leaderScheduler.registered(task.BackupTaskType)
+	}, time.Second, 10*time.Millisecond)
+	assert.Empty(t, followerScheduler.removed)
+}
@@ -4,18 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"slices"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
 	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
 	"github.com/tx7do/go-utils/trans"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"github.com/tx7do/kratos-transport/broker"
+	"github.com/tx7do/kratos-transport/transport/sse"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"go-wind-admin/app/admin/service/internal/data"
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
 	taskV1 "go-wind-admin/api/gen/go/task/service/v1"
 
 	"go-wind-admin/pkg/middleware/auth"
@@ -35,6 +39,23 @@ type TaskScheduler interface {
 	RemoveAllPeriodicTask()
 }
 
+// TaskInspector 任务队列查询接口
+type TaskInspector interface {
+	Queues() ([]string, error)
+	GetQueueInfo(queue string) (*asynq.QueueInfo, error)
+	GetTaskInfo(queue, id string) (*asynq.TaskInfo, error)
+
+	ListPendingTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error)
+	ListActiveTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error)
+	ListScheduledTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error)
+	ListRetryTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error)
+	ListArchivedTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error)
+	ListCompletedTasks(queue string, opts ...asynq.ListOption) ([]*asynq.TaskInfo, error)
+
+	RunTask(queue, id string) error
+	DeleteTask(queue, id string) error
+}
+
 // TaskService 任务服务
 type TaskService struct {
 	adminV1.TaskServiceHTTPServer
@@ -42,20 +63,39 @@ type TaskService struct {
 	log *log.Helper
 
 	taskScheduler TaskScheduler
+	taskInspector TaskInspector
+
+	sseServer *sse.Server
+
+	rdb     *redis.Client
+	elector *task.LeaderElector
 
-	userRepo data.UserRepo
-	taskRepo *data.TaskRepo
+	userRepo         data.UserRepo
+	userToken        *data.UserTokenCacheRepo
+	taskRepo         *data.TaskRepo
+	taskRunRepo      *data.TaskRunRepo
+	operationLogRepo *data.OperationAuditLogRepo
 }
 
 func NewTaskService(
 	ctx *bootstrap.Context,
 	taskRepo *data.TaskRepo,
+	taskRunRepo *data.TaskRunRepo,
 	userRepo data.UserRepo,
+	userToken *data.UserTokenCacheRepo,
+	operationLogRepo *data.OperationAuditLogRepo,
+	rdb *redis.Client,
+	sseServer *sse.Server,
 ) *TaskService {
 	svc := &TaskService{
-		log:      ctx.NewLoggerHelper("task/service/admin-service"),
-		taskRepo: taskRepo,
-		userRepo: userRepo,
+		log:              ctx.NewLoggerHelper("task/service/admin-service"),
+		taskRepo:         taskRepo,
+		taskRunRepo:      taskRunRepo,
+		userRepo:         userRepo,
+		userToken:        userToken,
+		operationLogRepo: operationLogRepo,
+		rdb:              rdb,
+		sseServer:        sseServer,
 	}
 
 	return svc
@@ -65,12 +105,60 @@ func (s *TaskService) RegisterTaskScheduler(taskScheduler TaskScheduler) {
 	s.taskScheduler = taskScheduler
 }
 
+func (s *TaskService) RegisterTaskInspector(taskInspector TaskInspector) {
+	s.taskInspector = taskInspector
+}
+
 func (s *TaskService) List(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListTaskResponse, error) {
-	return s.taskRepo.List(ctx, req)
+	resp, err := s.taskRepo.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.fillLastRuns(ctx, resp.Items)
+
+	return resp, nil
 }
 
 func (s *TaskService) Get(ctx context.Context, req *taskV1.GetTaskRequest) (*taskV1.Task, error) {
-	return s.taskRepo.Get(ctx, req)
+	t, err := s.taskRepo.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.fillLastRuns(ctx, []*taskV1.Task{t})
+
+	return t, nil
+}
+
+// fillLastRuns 填充任务最近一次的执行记录
+func (s *TaskService) fillLastRuns(ctx context.Context, tasks []*taskV1.Task) {
+	ids := make([]uint32, 0, len(tasks))
+	for _, t := range tasks {
+		if t.Id != nil {
+			ids = append(ids, t.GetId())
+		}
+	}
+
+	runs, err := s.taskRunRepo.ListLatestByTaskIDs(ctx, ids)
+	if err != nil {
+		s.log.Errorf("获取任务最近执行记录失败[%s]", err.Error())
+		return
+	}
+
+	for _, t := range tasks {
+		if t.Id != nil {
+			t.LastRun = runs[t.GetId()]
+		}
+	}
+}
+
+func (s *TaskService) ListTaskRun(ctx context.Context, req *paginationV1.PagingRequest) (*taskV1.ListTaskRunResponse, error) {
+	return s.taskRunRepo.List(ctx, req)
+}
+
+func (s *TaskService) GetTaskRun(ctx context.Context, req *taskV1.GetTaskRunRequest) (*taskV1.TaskRun, error) {
+	return s.taskRunRepo.Get(ctx, req)
 }
 
 func (s *TaskService) ListTaskTypeName(_ context.Context, _ *emptypb.Empty) (*taskV1.ListTaskTypeNameResponse, error) {
@@ -91,6 +179,10 @@ func (s *TaskService) Create(ctx context.Context, req *taskV1.CreateTaskRequest)
 		return nil, err
 	}
 
+	if err = validateLuaTaskPayload(req.Data.GetTypeName(), req.Data.GetTaskPayload()); err != nil {
+		return nil, err
+	}
+
 	req.Data.CreatedBy = trans.Ptr(operator.UserId)
 
 	var t *taskV1.Task
@@ -98,7 +190,7 @@ func (s *TaskService) Create(ctx context.Context, req *taskV1.CreateTaskRequest)
 		return nil, err
 	}
 
-	if err = s.startTask(t); err != nil {
+	if err = s.startTask(ctx, t); err != nil {
 		s.log.Error(err)
 	}
 
@@ -116,6 +208,12 @@ func (s *TaskService) Update(ctx context.Context, req *taskV1.UpdateTaskRequest)
 		return nil, err
 	}
 
+	if req.Data.TypeName != nil {
+		if err = validateLuaTaskPayload(req.Data.GetTypeName(), req.Data.GetTaskPayload()); err != nil {
+			return nil, err
+		}
+	}
+
 	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
 	if req.UpdateMask != nil {
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
@@ -127,7 +225,7 @@ func (s *TaskService) Update(ctx context.Context, req *taskV1.UpdateTaskRequest)
 		return nil, err
 	}
 
-	if err = s.startTask(t); err != nil {
+	if err = s.startTask(ctx, t); err != nil {
 		s.log.Error(err)
 	}
 
@@ -146,7 +244,7 @@ func (s *TaskService) Delete(ctx context.Context, req *taskV1.DeleteTaskRequest)
 	}
 
 	if t != nil {
-		_ = s.stopTask(t)
+		_ = s.stopTask(ctx, t)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -154,54 +252,109 @@ func (s *TaskService) Delete(ctx context.Context, req *taskV1.DeleteTaskRequest)
 
 // ControlTask 控制调度任务
 func (s *TaskService) ControlTask(ctx context.Context, req *taskV1.ControlTaskRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	t, err := s.taskRepo.Get(ctx, &taskV1.GetTaskRequest{QueryBy: &taskV1.GetTaskRequest_TypeName{TypeName: req.GetTypeName()}})
 	if err != nil {
 		s.log.Errorf("获取任务失败[%s]", err.Error())
 		return nil, err
 	}
 
-	switch req.GetControlType() {
-	case taskV1.ControlTaskRequest_Restart:
-		if err = s.stopTask(t); err != nil {
-			return nil, err
-		}
+	err = s.controlTask(ctx, operator.GetUserId(), t, req.GetControlType())
 
-		if err = s.startTask(t); err != nil {
-			return nil, err
+	s.writeOperationLog(ctx, operator, taskAuditResource, req.GetTypeName(),
+		auditV1.OperationAuditLog_UPDATE, map[string]string{"control": req.GetControlType().String()}, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TaskService) controlTask(ctx context.Context, operatorID uint32, t *taskV1.Task, controlType taskV1.ControlTaskRequest_ControlType) error {
+	var err error
+
+	switch controlType {
+	case taskV1.ControlTaskRequest_Restart:
+		if err = s.stopTask(ctx, t); err != nil {
+			return err
 		}
+		return s.startTask(ctx, t)
 
 	case taskV1.ControlTaskRequest_Stop:
-		err = s.stopTask(t)
-		return nil, err
+		return s.stopTask(ctx, t)
 
 	case taskV1.ControlTaskRequest_Start:
-		err = s.startTask(t)
-		return nil, err
+		return s.startTask(ctx, t)
+
+	case taskV1.ControlTaskRequest_RunNow:
+		return s.runTaskNow(t)
+
+	case taskV1.ControlTaskRequest_Pause:
+		if t.GetPaused() {
+			return nil
+		}
+		if err = s.taskRepo.SetPaused(ctx, t.GetId(), true, operatorID); err != nil {
+			return err
+		}
+		if err = s.stopTask(ctx, t); err != nil {
+			s.log.Warnf("[%s] 暂停时停止任务失败[%s]", t.GetTypeName(), err.Error())
+		}
+		return nil
+
+	case taskV1.ControlTaskRequest_Resume:
+		if !t.GetPaused() {
+			return nil
+		}
+		if err = s.taskRepo.SetPaused(ctx, t.GetId(), false, operatorID); err != nil {
+			return err
+		}
+		t.Paused = trans.Ptr(false)
+		return s.startTask(ctx, t)
 	}
 
-	return &emptypb.Empty{}, nil
+	return adminV1.ErrorBadRequest("unsupported control type [%s]", controlType.String())
 }
 
 // StopAllTask 停止所有的调度任务
-func (s *TaskService) StopAllTask(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
-	s.stopAllTask()
+func (s *TaskService) StopAllTask(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := s.stopAllScheduledTask(ctx); err != nil {
+		return nil, err
+	}
 	return &emptypb.Empty{}, nil
 }
 
-// StartAllTask 启动所有的调度任务
-func (s *TaskService) StartAllTask(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
-	_, err := s.startAllTask(ctx)
+// StartAllTask 启动所有的调度任务，并返回各任务最近一次的执行记录
+func (s *TaskService) StartAllTask(ctx context.Context, _ *emptypb.Empty) (*taskV1.StartAllTaskResponse, error) {
+	count, err := s.startAllTask(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	resp, err := s.List(ctx, &paginationV1.PagingRequest{
+		NoPaging: trans.Ptr(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskV1.StartAllTaskResponse{
+		Count: count,
+		Items: resp.GetItems(),
+	}, nil
 }
 
 // RestartAllTask 重启所有的调度任务
 func (s *TaskService) RestartAllTask(ctx context.Context, _ *emptypb.Empty) (*taskV1.RestartAllTaskResponse, error) {
 	// 停止所有的任务
-	s.stopAllTask()
+	if err := s.stopAllScheduledTask(ctx); err != nil {
+		return nil, err
+	}
 
 	// 重新启动所有的任务
 	count, err := s.startAllTask(ctx)
@@ -211,11 +364,9 @@ func (s *TaskService) RestartAllTask(ctx context.Context, _ *emptypb.Empty) (*ta
 	}, err
 }
 
-// StartAllTask 启动所有的任务
-func (s *TaskService) startAllTask(ctx context.Context) (int32, error) {
-	//_, _ = s.asynqServer.NewPeriodicTask("*/1 * * * ?", task.BackupTaskType, task.BackupTaskData{Name: "test"})
-
-	resp, err := s.List(ctx, &paginationV1.PagingRequest{
+// startAllTask 启动所有的任务，指定了任务类型时只启动这些类型的任务
+func (s *TaskService) startAllTask(ctx context.Context, types ...taskV1.Task_Type) (int32, error) {
+	resp, err := s.taskRepo.List(ctx, &paginationV1.PagingRequest{
 		NoPaging: trans.Ptr(true),
 	})
 	if err != nil {
@@ -228,7 +379,10 @@ func (s *TaskService) startAllTask(ctx context.Context) (int32, error) {
 	// 重新启动任务
 	var count int32
 	for _, t := range resp.GetItems() {
-		if s.startTask(t) != nil {
+		if len(types) > 0 && !slices.Contains(types, t.GetType()) {
+			continue
+		}
+		if s.startTask(ctx, t) != nil {
 			continue
 		} else {
 			count++
@@ -240,7 +394,18 @@ func (s *TaskService) startAllTask(ctx context.Context) (int32, error) {
 	return count, nil
 }
 
-// stopAllTask 停止所有的任务
+// stopAllScheduledTask 停止所有的周期任务，当前节点不是调度节点时交给调度节点执行
+func (s *TaskService) stopAllScheduledTask(ctx context.Context) error {
+	if !s.isScheduler() {
+		return s.publishScheduleChange(ctx, task.ScheduleActionStopAll, "")
+	}
+
+	s.stopAllTask()
+
+	return nil
+}
+
+// stopAllTask 停止当前节点上所有的任务
 func (s *TaskService) stopAllTask() {
 	s.log.Infof("开始清除所有的定时任务...")
 
@@ -251,7 +416,7 @@ func (s *TaskService) stopAllTask() {
 }
 
 // stopTask 停止一个任务
-func (s *TaskService) stopTask(t *taskV1.Task) error {
+func (s *TaskService) stopTask(ctx context.Context, t *taskV1.Task) error {
 	if t == nil {
 		return errors.New("task is nil")
 	}
@@ -262,11 +427,14 @@ func (s *TaskService) stopTask(t *taskV1.Task) error {
 
 	switch t.GetType() {
 	case taskV1.Task_PERIODIC:
+		if !s.isScheduler() {
+			return s.publishScheduleChange(ctx, task.ScheduleActionStop, t.GetTypeName())
+		}
 		return s.taskScheduler.RemovePeriodicTask(t.GetTypeName())
 
-	case taskV1.Task_DELAY:
-
-	case taskV1.Task_WAIT_RESULT:
+	case taskV1.Task_DELAY, taskV1.Task_WAIT_RESULT:
+		// 从队列中删除尚未执行的任务
+		return s.removeQueuedTasks(t.GetTypeName())
 	}
 
 	return nil
@@ -282,6 +450,9 @@ func (s *TaskService) convertTaskOption(t *taskV1.Task) (opts []asynq.Option, pa
 		_ = json.Unmarshal([]byte(t.GetTaskPayload()), &payload)
 	}
 
+	// 脚本任务处理函数声明的选项作为默认值，后面的选项会覆盖前面的
+	opts = append(opts, luaTaskOptions(t.GetTypeName())...)
+
 	if t.TaskOptions != nil {
 		if t.GetTaskOptions().GetMaxRetry() > 0 {
 			opts = append(opts, asynq.MaxRetry(int(t.GetTaskOptions().GetMaxRetry())))
@@ -316,7 +487,7 @@ func (s *TaskService) convertTaskOption(t *taskV1.Task) (opts []asynq.Option, pa
 }
 
 // startTask 启动一个任务
-func (s *TaskService) startTask(t *taskV1.Task) error {
+func (s *TaskService) startTask(ctx context.Context, t *taskV1.Task) error {
 	if t == nil {
 		return errors.New("task is nil")
 	}
@@ -325,12 +496,24 @@ func (s *TaskService) startTask(t *taskV1.Task) error {
 		return errors.New("task is not enable")
 	}
 
+	if t.GetPaused() {
+		return errors.New("task is paused")
+	}
+
 	var opts []asynq.Option
 	var payload broker.Any
 	var err error
 
 	switch t.GetType() {
 	case taskV1.Task_PERIODIC:
+		// 周期任务只在调度节点上注册，避免多个节点重复执行
+		if !s.isScheduler() {
+			return s.publishScheduleChange(ctx, task.ScheduleActionStart, t.GetTypeName())
+		}
+
+		// 重新注册前移除旧的调度
+		_ = s.taskScheduler.RemovePeriodicTask(t.GetTypeName())
+
 		opts, payload = s.convertTaskOption(t)
 		if _, err = s.taskScheduler.NewPeriodicTask(t.GetCronSpec(), task.CreateBackupTaskID(t.GetId()), t.GetTypeName(), payload, opts...); err != nil {
 			s.log.Errorf("[%s] 创建定时任务失败[%s]", t.GetTypeName(), err.Error())
@@ -355,8 +538,33 @@ func (s *TaskService) startTask(t *taskV1.Task) error {
 	return nil
 }
 
-// AsyncBackup 异步备份
-func (s *TaskService) AsyncBackup(taskType string, taskData *task.BackupTaskData) error {
-	s.log.Infof("AsyncBackup [%s] [%+v] [%s]", taskType, taskData, taskData.Name)
+// runTaskNow 立即执行一次任务，不影响已有的调度
+func (s *TaskService) runTaskNow(t *taskV1.Task) error {
+	if t == nil {
+		return errors.New("task is nil")
+	}
+
+	// 只保留与执行相关的选项，去掉延迟、唯一性和任务ID，避免与调度产生的任务冲突
+	var runOpts *taskV1.TaskOption
+	if o := t.GetTaskOptions(); o != nil {
+		runOpts = &taskV1.TaskOption{
+			MaxRetry:  o.MaxRetry,
+			Timeout:   o.Timeout,
+			Deadline:  o.Deadline,
+			Retention: o.Retention,
+		}
+	}
+
+	opts, payload := s.convertTaskOption(&taskV1.Task{
+		TypeName:    t.TypeName,
+		TaskPayload: t.TaskPayload,
+		TaskOptions: runOpts,
+	})
+
+	if err := s.taskScheduler.NewTask(t.GetTypeName(), payload, opts...); err != nil {
+		s.log.Errorf("[%s] 立即执行任务失败[%s]", t.GetTypeName(), err.Error())
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+
+	"go-wind-admin/pkg/oss"
+)
+
+// scanVerdict 内容扫描结论
+type scanVerdict struct {
+	status fileV1.FileScanStatus
+	result string
+}
+
+func (v scanVerdict) quarantined() bool {
+	return v.status == fileV1.FileScanStatus_QUARANTINED
+}
+
+// uploadGuard 上传守卫：按上传策略校验文件内容，并调用扫描器扫描
+type uploadGuard struct {
+	log *log.Helper
+
+	policies oss.UploadPolicies
+	scanner  oss.Scanner
+}
+
+func newUploadGuard(l *log.Helper, cfg *adminConfV1.Bootstrap, scanner oss.Scanner) *uploadGuard {
+	g := &uploadGuard{
+		log:     l,
+		scanner: scanner,
+	}
+
+	for _, p := range cfg.GetFileStorage().GetUploadPolicies() {
+		g.policies = append(g.policies, oss.UploadPolicy{
+			Bucket:                 p.GetBucket(),
+			Directory:              p.GetDirectory(),
+			AllowedTypes:           p.GetAllowedTypes(),
+			MaxSize:                p.GetMaxSize(),
+			AllowExtensionMismatch: p.GetAllowExtensionMismatch(),
+		})
+	}
+
+	return g
+}
+
+// checkContent 按存储桶与目录匹配的策略校验文件内容，返回用于记录的内容类型
+// 嗅探结果明确时以嗅探结果为准，无法识别具体格式时沿用客户端声明的类型。
+func (g *uploadGuard) checkContent(bucketName, fileDirectory, sourceFileName, declaredType string, content []byte) (string, error) {
+	sniffed, err := g.policies.Match(bucketName, fileDirectory).CheckContent(sourceFileName, declaredType, content)
+	if err != nil {
+		g.log.Warnf("upload rejected by policy [%s/%s] file [%s]: %v", bucketName, fileDirectory, sourceFileName, err)
+		return "", err
+	}
+
+	if declaredType != "" && (sniffed == "" || sniffed == "application/octet-stream" || strings.HasPrefix(sniffed, "text/plain")) {
+		return declaredType, nil
+	}
+	return sniffed, nil
+}
+
+// checkDeclared 预签名上传时无法读取内容，只能按声明的类型与大小校验
+func (g *uploadGuard) checkDeclared(bucketName, fileDirectory, declaredType string, size int64) error {
+	p := g.policies.Match(bucketName, fileDirectory)
+	if err := p.CheckSize(uint64(max(size, 0))); err != nil {
+		return err
+	}
+	return p.CheckType(declaredType)
+}
+
+// scan 扫描文件内容；未配置扫描器时返回未扫描，扫描失败或发现威胁时返回隔离
+func (g *uploadGuard) scan(ctx context.Context, content []byte) scanVerdict {
+	if g.scanner == nil {
+		return scanVerdict{status: fileV1.FileScanStatus_UNSCANNED}
+	}
+
+	res, err := g.scanner.Scan(ctx, bytes.NewReader(content))
+	if err != nil {
+		g.log.Errorf("scan upload content failed: %v", err)
+		return scanVerdict{status: fileV1.FileScanStatus_QUARANTINED, result: "scan failed: " + err.Error()}
+	}
+	if res.Infected {
+		g.log.Warnf("upload content infected: %s", res.Signature)
+		return scanVerdict{status: fileV1.FileScanStatus_QUARANTINED, result: res.Signature}
+	}
+
+	return scanVerdict{status: fileV1.FileScanStatus_CLEAN}
+}
+
+// ensureNotQuarantined 已隔离的文件禁止访问
+func ensureNotQuarantined(f *fileV1.File) error {
+	if f.GetScanStatus() == fileV1.FileScanStatus_QUARANTINED {
+		return fileV1.ErrorFileQuarantined("file is quarantined")
+	}
+	return nil
+}
+
+// quarantineFile 将扫描未通过的文件存入隔离存储桶并记录，始终返回错误告知上传方文件已被隔离
+func quarantineFile(
+	ctx context.Context,
+	l *log.Helper,
+	mc *oss.MinIOClient,
+	fileRepo *data.FileRepo,
+	tenantID, userID uint32,
+	fileDirectory, sourceFileName, contentType string,
+	content []byte,
+	verdict scanVerdict,
+) error {
+	objectName := oss.EnsureObjectName(fileDirectory, sourceFileName, contentType, content, oss.GenerateFileNameTypeUUID)
+
+	info, downloadUrl, err := mc.UploadFile(ctx, oss.BucketQuarantine, objectName, content)
+	if err != nil {
+		return err
+	}
+
+	if err = recordFile(
+		ctx, l, fileRepo,
+		tenantID, userID,
+		contentSHA256(content),
+		sourceFileName,
+		contentType,
+		verdict,
+		info, downloadUrl); err != nil {
+		_ = mc.DeleteFile(ctx, info.Bucket, info.Key)
+		return err
+	}
+
+	return fileV1.ErrorFileQuarantined("file is quarantined: %s", verdict.result)
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+
+	"go-wind-admin/pkg/oss"
+)
+
+// fakeScanner 内容包含 "EICAR" 时报告威胁，err 非空时模拟扫描失败
+type fakeScanner struct {
+	err     error
+	scanned int
+}
+
+func (s *fakeScanner) Scan(_ context.Context, content io.Reader) (*oss.ScanResult, error) {
+	s.scanned++
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(string(data), "EICAR") {
+		return &oss.ScanResult{Infected: true, Signature: "Eicar-Test-Signature"}, nil
+	}
+	return &oss.ScanResult{}, nil
+}
+
+func newTestUploadGuard(scanner oss.Scanner) *uploadGuard {
+	cfg := &adminConfV1.Bootstrap{
+		FileStorage: &adminConfV1.FileStorage{
+			UploadPolicies: []*adminConfV1.UploadPolicy{
+				{Bucket: oss.BucketImages, AllowedTypes: []string{"image/*"}, MaxSize: 1024},
+			},
+		},
+	}
+	return newUploadGuard(log.NewHelper(log.DefaultLogger), cfg, scanner)
+}
+
+func TestUploadGuardScan(t *testing.T) {
+	scanner := &fakeScanner{}
+	g := newTestUploadGuard(scanner)
+
+	v := g.scan(t.Context(), []byte("hello"))
+	assert.Equal(t, fileV1.FileScanStatus_CLEAN, v.status)
+	assert.False(t, v.quarantined())
+
+	v = g.scan(t.Context(), []byte("X5O!P%@AP EICAR"))
+	assert.True(t, v.quarantined())
+	assert.Equal(t, "Eicar-Test-Signature", v.result)
+
+	// 扫描失败时按隔离处理
+	scanner.err = errors.New("connection refused")
+	v = g.scan(t.Context(), []byte("hello"))
+	assert.True(t, v.quarantined())
+	assert.Contains(t, v.result, "connection refused")
+
+	assert.Equal(t, 3, scanner.scanned)
+
+	// 未配置扫描器
+	v = newTestUploadGuard(nil).scan(t.Context(), []byte("hello"))
+	assert.Equal(t, fileV1.FileScanStatus_UNSCANNED, v.status)
+}
+
+func TestUploadGuardCheckContent(t *testing.T) {
+	g := newTestUploadGuard(nil)
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	ct, err := g.checkContent(oss.BucketImages, "", "a.png", "image/png", png)
+	assert.Nil(t, err)
+	assert.Equal(t, "image/png", ct)
+
+	// 伪装成图片的可执行文件
+	exe := append([]byte("MZ"), make([]byte, 126)...)
+	_, err = g.checkContent(oss.BucketImages, "", "a.png", "image/png", exe)
+	assert.True(t, fileV1.IsFileTypeNotAllowed(err))
+
+	// 未匹配策略的存储桶不限制类型，但记录的类型以嗅探结果为准
+	ct, err = g.checkContent(oss.BucketFiles, "", "a.bin", "image/png", exe)
+	assert.Nil(t, err)
+	assert.Equal(t, "application/x-msdownload", ct)
+
+	// 无法识别具体格式时沿用声明的类型
+	ct, err = g.checkContent(oss.BucketFiles, "", "a.csv", "text/csv", []byte("a,b\n1,2\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "text/csv", ct)
+
+	assert.True(t, fileV1.IsFileTooLarge(g.checkDeclared(oss.BucketImages, "", "image/png", 2048)))
+	assert.True(t, fileV1.IsFileTypeNotAllowed(g.checkDeclared(oss.BucketImages, "", "application/pdf", 10)))
+	assert.Nil(t, g.checkDeclared(oss.BucketImages, "", "image/png", 10))
+}
+
+func TestEnsureNotQuarantined(t *testing.T) {
+	f := &fileV1.File{ScanStatus: fileV1.FileScanStatus_QUARANTINED.Enum()}
+	assert.True(t, fileV1.IsFileQuarantined(ensureNotQuarantined(f)))
+	assert.Nil(t, ensureNotQuarantined(&fileV1.File{}))
+}
@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/go-utils/trans"
@@ -10,14 +13,22 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent"
 
+	adminConfV1 "go-wind-admin/api/gen/go/admin/conf/v1"
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 	userV1 "go-wind-admin/api/gen/go/user/service/v1"
 
+	appViewer "go-wind-admin/pkg/entgo/viewer"
 	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/notifier"
+	"go-wind-admin/pkg/oss"
 )
 
+// avatarDirectory 头像存放目录，按用户 ID 分目录
+const avatarDirectory = "avatars"
+
 type UserProfileService struct {
 	adminV1.UserProfileServiceHTTPServer
 
@@ -25,6 +36,13 @@ type UserProfileService struct {
 	userToken          *data.UserTokenCacheRepo
 	roleRepo           *data.RoleRepo
 	userCredentialRepo *data.UserCredentialRepo
+	fileRepo           *data.FileRepo
+	quotaRepo          *data.StorageQuotaRepo
+	verificationRepo   *data.VerificationCodeRepo
+
+	mc       *oss.MinIOClient
+	variants *imageVariantGenerator
+	guard    *uploadGuard
 
 	log *log.Helper
 }
@@ -35,13 +53,26 @@ func NewUserProfileService(
 	userToken *data.UserTokenCacheRepo,
 	roleRepo *data.RoleRepo,
 	userCredentialRepo *data.UserCredentialRepo,
+	fileRepo *data.FileRepo,
+	quotaRepo *data.StorageQuotaRepo,
+	verificationRepo *data.VerificationCodeRepo,
+	cfg *adminConfV1.Bootstrap,
+	mc *oss.MinIOClient,
+	scanner oss.Scanner,
 ) *UserProfileService {
+	l := ctx.NewLoggerHelper("user-profile/service/admin-service")
 	return &UserProfileService{
-		log:                ctx.NewLoggerHelper("user-profile/service/admin-service"),
+		log:                l,
 		userRepo:           userRepo,
 		userToken:          userToken,
 		roleRepo:           roleRepo,
 		userCredentialRepo: userCredentialRepo,
+		fileRepo:           fileRepo,
+		quotaRepo:          quotaRepo,
+		verificationRepo:   verificationRepo,
+		mc:                 mc,
+		variants:           newImageVariantGenerator(l, cfg, mc),
+		guard:              newUploadGuard(l, cfg, scanner),
 	}
 }
 
@@ -140,6 +171,9 @@ func (s *UserProfileService) UploadAvatar(ctx context.Context, req *userV1.Uploa
 	var avatarURL string
 	switch req.GetSource().(type) {
 	case *userV1.UploadAvatarRequest_ImageBase64:
+		if avatarURL, err = s.uploadAvatarImage(ctx, operator, req.GetImageBase64()); err != nil {
+			return nil, err
+		}
 	case *userV1.UploadAvatarRequest_ImageUrl:
 		avatarURL = req.GetImageUrl()
 	default:
@@ -165,12 +199,246 @@ func (s *UserProfileService) UploadAvatar(ctx context.Context, req *userV1.Uploa
 	}, nil
 }
 
-// BindContact 绑定手机号码/邮箱
-func (s *UserProfileService) BindContact(context.Context, *userV1.BindContactRequest) (*emptypb.Empty, error) {
-	return nil, nil
+// uploadAvatarImage 保存 Base64 编码的头像图片，并预生成标准尺寸的衍生图
+func (s *UserProfileService) uploadAvatarImage(ctx context.Context, operator *authenticationV1.UserTokenPayload, imageBase64 string) (string, error) {
+	// 兼容 data URL 形式：data:image/png;base64,xxxx
+	if idx := strings.Index(imageBase64, ";base64,"); idx >= 0 && strings.HasPrefix(imageBase64, "data:") {
+		imageBase64 = imageBase64[idx+len(";base64,"):]
+	}
+
+	content, err := base64.StdEncoding.DecodeString(imageBase64)
+	if err != nil || len(content) == 0 {
+		return "", authenticationV1.ErrorBadRequest("invalid avatar image")
+	}
+
+	mimeType, _ := oss.DetectFileType(content)
+	if !strings.HasPrefix(mimeType, "image/") {
+		return "", authenticationV1.ErrorBadRequest("avatar must be an image")
+	}
+
+	if err = s.quotaRepo.Check(ctx, operator.GetTenantId(), operator.GetUserId(), uint64(len(content))); err != nil {
+		return "", err
+	}
+
+	dir := avatarDirectory + "/" + strconv.FormatUint(uint64(operator.GetUserId()), 10)
+
+	if mimeType, err = s.guard.checkContent(oss.BucketImages, dir, "", mimeType, content); err != nil {
+		return "", err
+	}
+
+	verdict := s.guard.scan(ctx, content)
+	if verdict.quarantined() {
+		return "", quarantineFile(
+			ctx, s.log, s.mc, s.fileRepo,
+			operator.GetTenantId(), operator.GetUserId(),
+			dir, "avatar", mimeType, content,
+			verdict,
+		)
+	}
+
+	objectName := oss.EnsureObjectName(
+		dir,
+		"",
+		mimeType,
+		content,
+		oss.GenerateFileNameTypeUUID,
+	)
+
+	info, downloadUrl, err := s.mc.UploadFile(ctx, oss.BucketImages, objectName, content)
+	if err != nil {
+		return "", err
+	}
+
+	dir, fileName, ext := parseKey(info.Key)
+
+	if err = recordFile(
+		ctx, s.log, s.fileRepo,
+		operator.GetTenantId(), operator.GetUserId(),
+		contentSHA256(content),
+		"avatar."+ext,
+		mimeType,
+		verdict,
+		info, downloadUrl); err != nil {
+		_ = s.mc.DeleteFile(ctx, info.Bucket, info.Key)
+		return "", err
+	}
+
+	s.variants.pregenerateAvatar(ctx, info.Bucket, dir, fileName+"."+ext, content)
+
+	return downloadUrl, nil
+}
+
+// BindContact 绑定手机号码/邮箱。
+// 没有携带验证码时向新的手机号码/邮箱发送验证码；携带验证码时校验并完成绑定，等同于 VerifyContact。
+func (s *UserProfileService) BindContact(ctx context.Context, req *userV1.BindContactRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var channel notifier.Channel
+	var target, code string
+	switch req.Contact.(type) {
+	case *userV1.BindContactRequest_Phone:
+		channel, target, code = notifier.ChannelSMS, req.GetPhone().GetPhone(), req.GetPhone().GetCode()
+	case *userV1.BindContactRequest_Email:
+		channel, target, code = notifier.ChannelEmail, req.GetEmail().GetEmail(), req.GetEmail().GetVerificationCode()
+	default:
+		return nil, adminV1.ErrorBadRequest("contact is required")
+	}
+
+	target = data.NormalizeVerificationTarget(channel, target)
+	if target == "" {
+		return nil, adminV1.ErrorBadRequest("contact is required")
+	}
+
+	if code != "" {
+		return s.verifyAndBindContact(ctx, operator.UserId, channel, target, code)
+	}
+
+	if err = s.checkContactAvailable(ctx, operator.UserId, channel, target); err != nil {
+		return nil, err
+	}
+
+	if err = s.verificationRepo.Send(ctx, data.VerificationPurposeBindContact, channel, target, operator.UserId, operator.GetTenantId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// VerifyContact 校验发送到手机号码/邮箱的验证码，通过后绑定到当前用户
+func (s *UserProfileService) VerifyContact(ctx context.Context, req *userV1.VerifyContactRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Contact.(type) {
+	case *userV1.VerifyContactRequest_Phone:
+		return s.verifyAndBindContact(ctx, operator.UserId, notifier.ChannelSMS, req.GetPhone().GetPhone(), req.GetPhone().GetCode())
+	case *userV1.VerifyContactRequest_Email:
+		return s.verifyAndBindContact(ctx, operator.UserId, notifier.ChannelEmail, req.GetEmail().GetEmail(), req.GetEmail().GetCode())
+	default:
+		return nil, adminV1.ErrorBadRequest("contact is required")
+	}
+}
+
+// verifyAndBindContact 校验验证码，验证码必须是发给当前用户的，通过后更新用户的手机号码/邮箱
+func (s *UserProfileService) verifyAndBindContact(ctx context.Context, userID uint32, channel notifier.Channel, target, code string) (*emptypb.Empty, error) {
+	target = data.NormalizeVerificationTarget(channel, target)
+
+	rec, err := s.verificationRepo.Verify(ctx, data.VerificationPurposeBindContact, channel, target, code)
+	if err != nil {
+		return nil, err
+	}
+	if rec.UserID != userID {
+		return nil, authenticationV1.ErrorInvalidVerificationCode("verification code is invalid or expired")
+	}
+
+	// 发送验证码之后可能已被其他用户绑定
+	if err = s.checkContactAvailable(ctx, userID, channel, target); err != nil {
+		return nil, err
+	}
+
+	updateReq := &userV1.UpdateUserRequest{
+		Id: userID,
+		Data: &userV1.User{
+			Id: trans.Ptr(userID),
+		},
+	}
+	if channel == notifier.ChannelSMS {
+		updateReq.Data.Mobile = trans.Ptr(target)
+		updateReq.UpdateMask = &field_mask.FieldMask{Paths: []string{"mobile"}}
+	} else {
+		updateReq.Data.Email = trans.Ptr(target)
+		updateReq.UpdateMask = &field_mask.FieldMask{Paths: []string{"email"}}
+	}
+
+	if err = s.userRepo.Update(ctx, updateReq); err != nil {
+		s.log.Errorf("bind contact for user [%d] failed [%s]", userID, err.Error())
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkContactAvailable 手机号码/邮箱用于找回密码，在所有租户中只能属于一个用户
+func (s *UserProfileService) checkContactAvailable(ctx context.Context, userID uint32, channel notifier.Channel, target string) error {
+	getReq := &userV1.GetUserRequest{
+		ViewMask: &field_mask.FieldMask{Paths: []string{"id"}},
+	}
+	if channel == notifier.ChannelSMS {
+		getReq.QueryBy = &userV1.GetUserRequest_Mobile{Mobile: target}
+	} else {
+		getReq.QueryBy = &userV1.GetUserRequest_Email{Email: target}
+	}
+
+	owner, err := s.userRepo.Get(appViewer.NewSystemViewerContext(ctx), getReq)
+	switch {
+	case ent.IsNotFound(err):
+		return nil
+	case err != nil:
+		s.log.Errorf("query user by contact failed [%s]", err.Error())
+		return adminV1.ErrorConflict("contact is already in use")
+	case owner.GetId() != userID:
+		return adminV1.ErrorConflict("contact is already in use")
+	}
+
+	return nil
 }
 
-// VerifyContact 验证手机号码/邮箱
-func (s *UserProfileService) VerifyContact(context.Context, *userV1.VerifyContactRequest) (*emptypb.Empty, error) {
-	return nil, nil
+// ListSessions 查询本人的登录会话
+func (s *UserProfileService) ListSessions(ctx context.Context, _ *emptypb.Empty) (*authenticationV1.ListUserSessionResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.userToken.ListSessions(ctx, operator.UserId, operator.GetSessionId())
+}
+
+// RevokeSession 注销本人的指定会话，注销当前会话等同于登出
+func (s *UserProfileService) RevokeSession(ctx context.Context, req *authenticationV1.RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	if req == nil || req.GetSessionId() == "" {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.userToken.RemoveSession(ctx, operator.UserId, req.GetSessionId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RevokeOtherSessions 注销本人除当前会话以外的全部会话
+func (s *UserProfileService) RevokeOtherSessions(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.userToken.RemoveOtherSessions(ctx, operator.UserId, operator.GetSessionId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
 }
@@ -17,6 +17,7 @@ import (
 
 	"go-wind-admin/pkg/constants"
 	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/lua"
 	"go-wind-admin/pkg/middleware/auth"
 	"go-wind-admin/pkg/utils/name_set"
 )
@@ -35,6 +36,10 @@ type UserService struct {
 	tenantRepo   *data.TenantRepo
 
 	membershipRepo *data.MembershipRepo
+
+	userToken *data.UserTokenCacheRepo
+
+	hooks *luaHooks
 }
 
 func NewUserService(
@@ -46,9 +51,13 @@ func NewUserService(
 	orgUnitRepo *data.OrgUnitRepo,
 	tenantRepo *data.TenantRepo,
 	membershipRepo *data.MembershipRepo,
+	userToken *data.UserTokenCacheRepo,
+	luaEngine *lua.Engine,
 ) *UserService {
+	l := ctx.NewLoggerHelper("user/service/admin-service")
 	svc := &UserService{
-		log:                ctx.NewLoggerHelper("user/service/admin-service"),
+		log:                l,
+		hooks:              newLuaHooks(luaEngine, l),
 		userRepo:           userRepo,
 		roleRepo:           roleRepo,
 		userCredentialRepo: userCredentialRepo,
@@ -56,6 +65,7 @@ func NewUserService(
 		orgUnitRepo:        orgUnitRepo,
 		tenantRepo:         tenantRepo,
 		membershipRepo:     membershipRepo,
+		userToken:          userToken,
 	}
 
 	svc.init()
@@ -294,9 +304,10 @@ func (s *UserService) Create(ctx context.Context, req *userV1.CreateUserRequest)
 	req.Data.CreatedBy = trans.Ptr(operator.UserId)
 	req.Data.TenantId = operator.TenantId
 
-	// 创建用户
-	var user *userV1.User
-	if user, err = s.userRepo.Create(ctx, req); err != nil {
+	// 创建前钩子，脚本可以拒绝本次创建
+	if err = s.hooks.before(ctx, lua.HookBeforeUserCreate, map[string]any{
+		"user": protoToLuaData(req.Data),
+	}); err != nil {
 		return nil, err
 	}
 
@@ -305,6 +316,17 @@ func (s *UserService) Create(ctx context.Context, req *userV1.CreateUserRequest)
 		req.Password = trans.Ptr(constants.DefaultUserPassword)
 	}
 
+	// 先检查密码策略，避免用户已创建而凭证创建失败
+	if err = s.userCredentialRepo.ValidateNewPassword(ctx, operator.GetTenantId(), req.GetPassword()); err != nil {
+		return nil, err
+	}
+
+	// 创建用户
+	var user *userV1.User
+	if user, err = s.userRepo.Create(ctx, req); err != nil {
+		return nil, err
+	}
+
 	if len(req.GetPassword()) > 0 {
 		if err = s.userCredentialRepo.Create(ctx, &authenticationV1.CreateUserCredentialRequest{
 			Data: &authenticationV1.UserCredential{
@@ -325,6 +347,11 @@ func (s *UserService) Create(ctx context.Context, req *userV1.CreateUserRequest)
 		}
 	}
 
+	s.hooks.after(ctx, lua.HookAfterUserCreate, map[string]any{
+		"user_id": user.GetId(),
+		"user":    protoToLuaData(user),
+	})
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -349,6 +376,15 @@ func (s *UserService) Update(ctx context.Context, req *userV1.UpdateUserRequest)
 		return nil, err
 	}
 
+	// 更新前钩子，脚本可以拒绝本次更新
+	if err = s.hooks.before(ctx, lua.HookBeforeUserUpdate, map[string]any{
+		"user_id":     req.GetId(),
+		"user":        protoToLuaData(req.Data),
+		"update_mask": req.GetUpdateMask().GetPaths(),
+	}); err != nil {
+		return nil, err
+	}
+
 	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
 	if req.UpdateMask != nil {
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
@@ -370,6 +406,12 @@ func (s *UserService) Update(ctx context.Context, req *userV1.UpdateUserRequest)
 		}
 	}
 
+	s.hooks.after(ctx, lua.HookAfterUserUpdate, map[string]any{
+		"user_id":     req.GetId(),
+		"user":        protoToLuaData(req.Data),
+		"update_mask": req.GetUpdateMask().GetPaths(),
+	})
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -435,6 +477,52 @@ func (s *UserService) EditUserPassword(ctx context.Context, req *userV1.EditUser
 	return &emptypb.Empty{}, nil
 }
 
+// ListSessions 查询用户的登录会话
+func (s *UserService) ListSessions(ctx context.Context, req *authenticationV1.ListUserSessionRequest) (*authenticationV1.ListUserSessionResponse, error) {
+	// 先查询用户，租户管理员只能查看本租户的用户
+	if _, err := s.userRepo.Get(ctx, &userV1.GetUserRequest{
+		QueryBy: &userV1.GetUserRequest_Id{Id: req.GetUserId()},
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.userToken.ListSessions(ctx, req.GetUserId(), "")
+}
+
+// RevokeSession 强制注销用户的指定会话
+func (s *UserService) RevokeSession(ctx context.Context, req *authenticationV1.RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	if req.GetSessionId() == "" {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	if _, err := s.userRepo.Get(ctx, &userV1.GetUserRequest{
+		QueryBy: &userV1.GetUserRequest_Id{Id: req.GetUserId()},
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.userToken.RemoveSession(ctx, req.GetUserId(), req.GetSessionId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RevokeAllSessions 强制注销用户的全部会话
+func (s *UserService) RevokeAllSessions(ctx context.Context, req *authenticationV1.RevokeAllUserSessionsRequest) (*emptypb.Empty, error) {
+	if _, err := s.userRepo.Get(ctx, &userV1.GetUserRequest{
+		QueryBy: &userV1.GetUserRequest_Id{Id: req.GetUserId()},
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.userToken.RemoveToken(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 // createDefaultUser 创建默认用户，即超级用户
 func (s *UserService) createDefaultUser(ctx context.Context) error {
 	var err error
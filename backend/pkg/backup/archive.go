@@ -0,0 +1,82 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+
+	"go-wind-admin/pkg/crypto"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+var ErrEncryptionKeyRequired = errors.New("backup archive is encrypted but no key is provided")
+
+// archiveWriter 压缩后（可选）加密写入底层 io.Writer
+type archiveWriter struct {
+	gz  *gzip.Writer
+	enc io.WriteCloser
+}
+
+// NewArchiveWriter 创建备份归档写入器：数据先经 gzip 压缩，encryptor 非空时再流式加密
+// Close 会依次写出压缩与加密的尾部数据，但不会关闭 w。
+func NewArchiveWriter(w io.Writer, encryptor *crypto.Encryptor) (io.WriteCloser, error) {
+	aw := &archiveWriter{}
+
+	if encryptor != nil {
+		enc, err := encryptor.NewEncryptWriter(w)
+		if err != nil {
+			return nil, err
+		}
+		aw.enc = enc
+		w = enc
+	}
+
+	aw.gz = gzip.NewWriter(w)
+	return aw, nil
+}
+
+func (a *archiveWriter) Write(p []byte) (int, error) {
+	return a.gz.Write(p)
+}
+
+func (a *archiveWriter) Close() error {
+	if err := a.gz.Close(); err != nil {
+		return err
+	}
+	if a.enc != nil {
+		return a.enc.Close()
+	}
+	return nil
+}
+
+// NewArchiveReader 创建备份归档读取器，根据文件头自动识别是否加密
+func NewArchiveReader(r io.Reader, encryptor *crypto.Encryptor) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+
+	head, err := br.Peek(len(crypto.StreamMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	var src io.Reader = br
+	if crypto.IsEncryptedStream(head) {
+		if encryptor == nil {
+			return nil, ErrEncryptionKeyRequired
+		}
+		if src, err = encryptor.NewDecryptReader(br); err != nil {
+			return nil, err
+		}
+	} else if !bytes.HasPrefix(head, gzipMagic) {
+		return nil, fmt.Errorf("%w: unknown archive format", ErrInvalidDump)
+	}
+
+	gz, err := gzip.NewReader(src)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDump, err)
+	}
+	return gz, nil
+}
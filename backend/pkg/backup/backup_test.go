@@ -0,0 +1,222 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+
+	"go-wind-admin/pkg/crypto"
+)
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+
+	for _, stmt := range []string{
+		`CREATE TABLE "orgs" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT NOT NULL, "logo" BLOB)`,
+		`CREATE TABLE "users" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "org_id" INTEGER REFERENCES "orgs"("id"), "name" TEXT, "score" REAL, "active" BOOLEAN, "created_at" DATETIME)`,
+	} {
+		if _, err = db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return db
+}
+
+func seedTestDB(t *testing.T, db *sql.DB) {
+	t.Helper()
+
+	if _, err := db.Exec(`INSERT INTO "orgs" ("name", "logo") VALUES ('acme', X'00FF10'), ('中文', NULL)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO "users" ("org_id", "name", "score", "active", "created_at") VALUES (1, 'alice', 1.5, 1, '2024-01-02 03:04:05'), (2, NULL, NULL, 0, NULL)`); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func dumpTestDB(t *testing.T, db *sql.DB, encryptor *crypto.Encryptor) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	aw, err := NewArchiveWriter(&buf, encryptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := Dump(context.Background(), db, dialect.SQLite, []string{"orgs", "users"}, aw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = aw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if stats.Rows != 4 || len(stats.Tables) != 2 {
+		t.Fatalf("unexpected dump stats: %+v", stats)
+	}
+
+	return buf.Bytes()
+}
+
+func restoreTestDB(t *testing.T, db *sql.DB, archive []byte, encryptor *crypto.Encryptor, opts RestoreOptions) (*Stats, error) {
+	t.Helper()
+
+	ar, err := NewArchiveReader(bytes.NewReader(archive), encryptor)
+	if err != nil {
+		return nil, err
+	}
+	defer ar.Close()
+
+	_, stats, err := Restore(context.Background(), db, dialect.SQLite, ar, opts)
+	return stats, err
+}
+
+func TestDumpRestore(t *testing.T) {
+	encryptor, err := crypto.NewEncryptor("backup-test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		encryptor *crypto.Encryptor
+	}{
+		{"gzip", nil},
+		{"encrypted", encryptor},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			src := openTestDB(t)
+			seedTestDB(t, src)
+			archive := dumpTestDB(t, src, tc.encryptor)
+
+			dst := openTestDB(t)
+			if _, err = dst.Exec(`INSERT INTO "orgs" ("name") VALUES ('stale')`); err != nil {
+				t.Fatal(err)
+			}
+
+			stats, err := restoreTestDB(t, dst, archive, tc.encryptor, RestoreOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stats.Rows != 4 {
+				t.Fatalf("expected 4 rows restored, got %d", stats.Rows)
+			}
+
+			var name string
+			var logo []byte
+			if err = dst.QueryRow(`SELECT "name", "logo" FROM "orgs" WHERE "id" = 1`).Scan(&name, &logo); err != nil {
+				t.Fatal(err)
+			}
+			if name != "acme" || !bytes.Equal(logo, []byte{0x00, 0xff, 0x10}) {
+				t.Fatalf("unexpected org: %s %x", name, logo)
+			}
+
+			var count int
+			if err = dst.QueryRow(`SELECT COUNT(*) FROM "orgs"`).Scan(&count); err != nil {
+				t.Fatal(err)
+			}
+			if count != 2 {
+				t.Fatalf("expected stale rows to be replaced, got %d orgs", count)
+			}
+
+			var userName sql.NullString
+			var score sql.NullFloat64
+			var active bool
+			if err = dst.QueryRow(`SELECT "name", "score", "active" FROM "users" WHERE "id" = 2`).Scan(&userName, &score, &active); err != nil {
+				t.Fatal(err)
+			}
+			if userName.Valid || score.Valid || active {
+				t.Fatalf("unexpected user: %v %v %v", userName, score, active)
+			}
+		})
+	}
+}
+
+func TestRestoreDryRun(t *testing.T) {
+	src := openTestDB(t)
+	seedTestDB(t, src)
+	archive := dumpTestDB(t, src, nil)
+
+	dst := openTestDB(t)
+	stats, err := restoreTestDB(t, dst, archive, nil, RestoreOptions{DryRun: true, Tables: []string{"users"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Rows != 2 || len(stats.Tables) != 1 {
+		t.Fatalf("unexpected dry run stats: %+v", stats)
+	}
+
+	var count int
+	if err = dst.QueryRow(`SELECT COUNT(*) FROM "users"`).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Fatalf("dry run must not write, got %d users", count)
+	}
+
+	if _, err = dst.Exec(`ALTER TABLE "users" RENAME COLUMN "score" TO "points"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = restoreTestDB(t, dst, archive, nil, RestoreOptions{DryRun: true}); !errors.Is(err, ErrInvalidDump) {
+		t.Fatalf("expected schema mismatch, got %v", err)
+	}
+}
+
+func TestRestoreIncomplete(t *testing.T) {
+	src := openTestDB(t)
+	seedTestDB(t, src)
+
+	var plain bytes.Buffer
+	if _, err := Dump(context.Background(), src, dialect.SQLite, []string{"orgs", "users"}, &plain); err != nil {
+		t.Fatal(err)
+	}
+
+	// 去掉 footer 记录
+	lines := bytes.Split(bytes.TrimSpace(plain.Bytes()), []byte("\n"))
+	truncated := bytes.Join(lines[:len(lines)-1], []byte("\n"))
+
+	dst := openTestDB(t)
+	_, _, err := Restore(context.Background(), dst, dialect.SQLite, bytes.NewReader(truncated), RestoreOptions{})
+	if !errors.Is(err, ErrIncompleteDump) {
+		t.Fatalf("expected incomplete dump, got %v", err)
+	}
+
+	var count int
+	if err = dst.QueryRow(`SELECT COUNT(*) FROM "orgs"`).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Fatalf("failed restore must roll back, got %d orgs", count)
+	}
+}
+
+func TestArchiveReader_RequiresKey(t *testing.T) {
+	encryptor, err := crypto.NewEncryptor("backup-test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	aw, err := NewArchiveWriter(&buf, encryptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = io.WriteString(aw, "data")
+	_ = aw.Close()
+
+	if _, err = NewArchiveReader(bytes.NewReader(buf.Bytes()), nil); !errors.Is(err, ErrEncryptionKeyRequired) {
+		t.Fatalf("expected key required, got %v", err)
+	}
+}
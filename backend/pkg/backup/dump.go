@@ -0,0 +1,176 @@
+package backup
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// checkDialect 只支持 ent 使用的 PostgreSQL、MySQL 以及用于开发测试的 SQLite
+func checkDialect(d string) error {
+	switch d {
+	case dialect.Postgres, dialect.MySQL, dialect.SQLite:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedDialect, d)
+	}
+}
+
+// quoteIdent 按方言引用标识符
+func quoteIdent(d, s string) string {
+	if d == dialect.MySQL {
+		return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+	}
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// placeholder 按方言生成第 i 个（从 1 开始）参数占位符
+func placeholder(d string, i int) string {
+	if d == dialect.Postgres {
+		return "$" + strconv.Itoa(i)
+	}
+	return "?"
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// tableColumns 查询表的列信息，不读取数据
+func tableColumns(ctx context.Context, q queryer, d, table string) ([]*sql.ColumnType, error) {
+	rows, err := q.QueryContext(ctx, "SELECT * FROM "+quoteIdent(d, table)+" WHERE 1 = 0")
+	if err != nil {
+		return nil, fmt.Errorf("query columns of table %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	return rows.ColumnTypes()
+}
+
+// snapshotOptions 导出使用只读的可重复读事务，保证各表数据处于同一快照
+func snapshotOptions(d string) *sql.TxOptions {
+	if d == dialect.SQLite {
+		return nil
+	}
+	return &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+}
+
+// Dump 按顺序导出各表的数据到 w
+// tables 应按外键依赖排序（被引用的表在前），恢复时按相同顺序写入。
+func Dump(ctx context.Context, db *sql.DB, d string, tables []string, w io.Writer) (*Stats, error) {
+	if err := checkDialect(d); err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, snapshotOptions(d))
+	if err != nil {
+		return nil, fmt.Errorf("begin snapshot transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	if err = enc.Encode(record{
+		Type: recordHeader,
+		Header: &Header{
+			Version:   FormatVersion,
+			Dialect:   d,
+			CreatedAt: time.Now().UTC(),
+			Tables:    tables,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{}
+	for _, table := range tables {
+		var rows int64
+		if rows, err = dumpTable(ctx, tx, d, table, enc); err != nil {
+			return nil, err
+		}
+		stats.add(table, rows)
+	}
+
+	if err = enc.Encode(record{Type: recordFooter, TableCount: len(tables), Rows: stats.Rows}); err != nil {
+		return nil, err
+	}
+
+	if err = bw.Flush(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func dumpTable(ctx context.Context, tx *sql.Tx, d, table string, enc *json.Encoder) (int64, error) {
+	columnTypes, err := tableColumns(ctx, tx, d, table)
+	if err != nil {
+		return 0, err
+	}
+
+	columns := make([]string, len(columnTypes))
+	binary := make([]bool, len(columnTypes))
+	for i, ct := range columnTypes {
+		columns[i] = ct.Name()
+		binary[i] = isBinaryColumn(ct.DatabaseTypeName())
+	}
+
+	query := "SELECT * FROM " + quoteIdent(d, table)
+	// 按主键排序，使自引用的树形数据尽量父节点在前
+	if slices.Contains(columns, "id") {
+		query += " ORDER BY " + quoteIdent(d, "id")
+	}
+
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("query table %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	if err = enc.Encode(record{Type: recordTable, Name: table, Columns: columns}); err != nil {
+		return 0, err
+	}
+
+	values := make([]any, len(columns))
+	ptrs := make([]any, len(columns))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+
+	var count int64
+	for rows.Next() {
+		if err = rows.Scan(ptrs...); err != nil {
+			return count, fmt.Errorf("scan table %s: %w", table, err)
+		}
+
+		rec := record{Type: recordRow, Values: make([]json.RawMessage, len(values))}
+		for i, v := range values {
+			if rec.Values[i], err = encodeValue(v, binary[i]); err != nil {
+				return count, fmt.Errorf("table %s column %s: %w", table, columns[i], err)
+			}
+		}
+		if err = enc.Encode(rec); err != nil {
+			return count, err
+		}
+		count++
+	}
+	if err = rows.Err(); err != nil {
+		return count, fmt.Errorf("read table %s: %w", table, err)
+	}
+
+	if err = enc.Encode(record{Type: recordEnd, Name: table, Rows: count}); err != nil {
+		return count, err
+	}
+
+	return count, nil
+}
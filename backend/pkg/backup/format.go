@@ -0,0 +1,176 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 逻辑备份格式：每行一个 JSON 记录（JSON Lines），依次为
+//
+//	header -> (table -> row... -> end)... -> footer
+//
+// 缺少 footer 说明备份文件不完整。
+const (
+	// FormatVersion 备份格式版本
+	FormatVersion = 1
+
+	recordHeader = "header"
+	recordTable  = "table"
+	recordRow    = "row"
+	recordEnd    = "end"
+	recordFooter = "footer"
+)
+
+var (
+	ErrUnsupportedDialect = errors.New("unsupported database dialect")
+	ErrInvalidDump        = errors.New("invalid backup dump")
+	ErrIncompleteDump     = errors.New("incomplete backup dump")
+)
+
+// Header 备份文件头
+type Header struct {
+	Version   int       `json:"version"`
+	Dialect   string    `json:"dialect"`
+	CreatedAt time.Time `json:"created_at"`
+	Tables    []string  `json:"tables"`
+}
+
+// TableStat 单表导出/导入的行数
+type TableStat struct {
+	Name string `json:"name"`
+	Rows int64  `json:"rows"`
+}
+
+// Stats 备份或恢复的统计信息
+type Stats struct {
+	Tables []TableStat `json:"tables"`
+	Rows   int64       `json:"rows"`
+}
+
+func (s *Stats) add(table string, rows int64) {
+	s.Tables = append(s.Tables, TableStat{Name: table, Rows: rows})
+	s.Rows += rows
+}
+
+type record struct {
+	Type string `json:"type"`
+
+	Header *Header `json:"header,omitempty"`
+
+	// table / end
+	Name    string   `json:"name,omitempty"`
+	Columns []string `json:"columns,omitempty"`
+	Rows    int64    `json:"rows,omitempty"`
+
+	// row
+	Values []json.RawMessage `json:"values,omitempty"`
+
+	// footer
+	TableCount int `json:"table_count,omitempty"`
+}
+
+// 非 JSON 原生类型的单元格以带标记的对象编码
+type taggedValue struct {
+	Bytes *string `json:"b,omitempty"` // 二进制数据，base64 编码
+	Time  *string `json:"t,omitempty"` // 时间，RFC3339Nano
+}
+
+var binaryColumnTypes = []string{"BYTEA", "BLOB", "BINARY", "VARBINARY"}
+
+// isBinaryColumn 判断数据库列是否为二进制类型
+func isBinaryColumn(databaseType string) bool {
+	t := strings.ToUpper(databaseType)
+	for _, b := range binaryColumnTypes {
+		if strings.Contains(t, b) {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeValue 编码单元格的值，非二进制列的 []byte 按文本处理
+func encodeValue(v any, binary bool) (json.RawMessage, error) {
+	switch x := v.(type) {
+	case nil:
+		return json.RawMessage("null"), nil
+
+	case []byte:
+		if !binary && utf8.Valid(x) {
+			return json.Marshal(string(x))
+		}
+		s := base64.StdEncoding.EncodeToString(x)
+		return json.Marshal(taggedValue{Bytes: &s})
+
+	case time.Time:
+		s := x.Format(time.RFC3339Nano)
+		return json.Marshal(taggedValue{Time: &s})
+
+	case int64, int32, int, uint64, uint32, float64, float32, bool, string:
+		return json.Marshal(x)
+
+	default:
+		// 驱动返回的其他类型（如 JSON 列解析出的结构）统一转为 JSON 文本
+		raw, err := json.Marshal(x)
+		if err != nil {
+			return nil, fmt.Errorf("encode value of type %T: %w", v, err)
+		}
+		return json.Marshal(string(raw))
+	}
+}
+
+// decodeValue 解码单元格的值，得到可直接作为 SQL 参数的值
+func decodeValue(raw json.RawMessage) (any, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil, ErrInvalidDump
+	}
+
+	switch raw[0] {
+	case 'n':
+		return nil, nil
+
+	case 't', 'f':
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return nil, err
+		}
+		return b, nil
+
+	case '"':
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		return s, nil
+
+	case '{':
+		var tv taggedValue
+		if err := json.Unmarshal(raw, &tv); err != nil {
+			return nil, err
+		}
+		switch {
+		case tv.Bytes != nil:
+			return base64.StdEncoding.DecodeString(*tv.Bytes)
+		case tv.Time != nil:
+			return time.Parse(time.RFC3339Nano, *tv.Time)
+		default:
+			return nil, fmt.Errorf("%w: unknown tagged value %s", ErrInvalidDump, raw)
+		}
+
+	default:
+		var n json.Number
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return nil, err
+		}
+		if i, err := n.Int64(); err == nil {
+			return i, nil
+		}
+		return n.Float64()
+	}
+}
@@ -0,0 +1,315 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect"
+)
+
+// RestoreOptions 恢复选项
+type RestoreOptions struct {
+	// DryRun 只校验备份文件的完整性以及与目标数据库表结构的兼容性，不写入数据
+	DryRun bool
+
+	// Tables 只恢复指定的表，为空时恢复备份中的全部表
+	Tables []string
+}
+
+// Restore 从 r 读取逻辑备份并恢复到数据库
+// 所有写入在同一个事务中完成：先按依赖的逆序清空要恢复的表，再按备份顺序写入数据，
+// 任何错误（包括备份文件不完整）都会回滚整个事务。
+func Restore(ctx context.Context, db *sql.DB, d string, r io.Reader, opts RestoreOptions) (*Header, *Stats, error) {
+	if err := checkDialect(d); err != nil {
+		return nil, nil, err
+	}
+
+	dec := json.NewDecoder(r)
+
+	header, err := readHeader(dec)
+	if err != nil {
+		return nil, nil, err
+	}
+	if header.Dialect != d {
+		return header, nil, fmt.Errorf("%w: dump dialect %s does not match target %s", ErrInvalidDump, header.Dialect, d)
+	}
+
+	selected := header.Tables
+	if len(opts.Tables) > 0 {
+		for _, t := range opts.Tables {
+			if !slices.Contains(header.Tables, t) {
+				return header, nil, fmt.Errorf("%w: table %s not found in dump", ErrInvalidDump, t)
+			}
+		}
+		// 保持备份中的依赖顺序，而不是调用方给出的顺序
+		selected = slices.DeleteFunc(slices.Clone(header.Tables), func(t string) bool {
+			return !slices.Contains(opts.Tables, t)
+		})
+	}
+
+	rs := &restorer{
+		dialect:  d,
+		dec:      dec,
+		selected: selected,
+		dryRun:   opts.DryRun,
+		stats:    &Stats{},
+	}
+
+	if opts.DryRun {
+		rs.q = db
+		if err = rs.run(ctx, header); err != nil {
+			return header, nil, err
+		}
+		return header, rs.stats, nil
+	}
+
+	// FOREIGN_KEY_CHECKS 是会话级变量，不随事务回滚，必须在同一个连接上设置和恢复，
+	// 否则连接回到连接池后仍关闭外键检查
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return header, nil, fmt.Errorf("get restore connection: %w", err)
+	}
+	defer conn.Close()
+
+	if d == dialect.MySQL {
+		if _, err = conn.ExecContext(ctx, "SET FOREIGN_KEY_CHECKS = 0"); err != nil {
+			return header, nil, fmt.Errorf("disable foreign key checks: %w", err)
+		}
+		defer func() {
+			// 恢复不依赖 ctx 是否已取消，无论提交还是回滚都要执行
+			if _, err := conn.ExecContext(context.WithoutCancel(ctx), "SET FOREIGN_KEY_CHECKS = 1"); err != nil {
+				// 无法恢复时丢弃该连接，不让它回到连接池
+				_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+			}
+		}()
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return header, nil, fmt.Errorf("begin restore transaction: %w", err)
+	}
+	rs.q = tx
+	rs.tx = tx
+
+	if err = rs.run(ctx, header); err != nil {
+		_ = tx.Rollback()
+		return header, nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return header, nil, fmt.Errorf("commit restore transaction: %w", err)
+	}
+
+	return header, rs.stats, nil
+}
+
+func readHeader(dec *json.Decoder) (*Header, error) {
+	var rec record
+	if err := dec.Decode(&rec); err != nil {
+		return nil, fmt.Errorf("%w: read header: %v", ErrInvalidDump, err)
+	}
+	if rec.Type != recordHeader || rec.Header == nil {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidDump)
+	}
+	if rec.Header.Version != FormatVersion {
+		return nil, fmt.Errorf("%w: unsupported format version %d", ErrInvalidDump, rec.Header.Version)
+	}
+	return rec.Header, nil
+}
+
+type restorer struct {
+	dialect  string
+	dec      *json.Decoder
+	selected []string
+	dryRun   bool
+
+	q  queryer
+	tx *sql.Tx
+
+	stats *Stats
+}
+
+func (rs *restorer) run(ctx context.Context, header *Header) error {
+	if !rs.dryRun {
+		if err := rs.prepare(ctx); err != nil {
+			return err
+		}
+	}
+
+	var tables int
+	for {
+		var rec record
+		if err := rs.dec.Decode(&rec); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return ErrIncompleteDump
+			}
+			return fmt.Errorf("%w: %v", ErrInvalidDump, err)
+		}
+
+		switch rec.Type {
+		case recordTable:
+			if err := rs.restoreTable(ctx, rec.Name, rec.Columns); err != nil {
+				return err
+			}
+			tables++
+
+		case recordFooter:
+			if rec.TableCount != len(header.Tables) || tables != len(header.Tables) {
+				return fmt.Errorf("%w: expected %d tables, got %d", ErrIncompleteDump, len(header.Tables), tables)
+			}
+			return nil
+
+		default:
+			return fmt.Errorf("%w: unexpected %s record", ErrInvalidDump, rec.Type)
+		}
+	}
+}
+
+// prepare 清空要恢复的表：按备份顺序的逆序删除，避免违反外键约束
+func (rs *restorer) prepare(ctx context.Context) error {
+	for i := len(rs.selected) - 1; i >= 0; i-- {
+		table := rs.selected[i]
+		if _, err := rs.tx.ExecContext(ctx, "DELETE FROM "+quoteIdent(rs.dialect, table)); err != nil {
+			return fmt.Errorf("clear table %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreTable 读取一张表的全部行，直到 end 记录；未选中的表只校验不写入
+func (rs *restorer) restoreTable(ctx context.Context, table string, columns []string) error {
+	if table == "" || len(columns) == 0 {
+		return fmt.Errorf("%w: table record without name or columns", ErrInvalidDump)
+	}
+
+	selected := slices.Contains(rs.selected, table)
+
+	var stmt *sql.Stmt
+	if selected {
+		if err := rs.checkColumns(ctx, table, columns); err != nil {
+			return err
+		}
+
+		if !rs.dryRun {
+			var err error
+			if stmt, err = rs.tx.PrepareContext(ctx, rs.insertSQL(table, columns)); err != nil {
+				return fmt.Errorf("prepare insert into %s: %w", table, err)
+			}
+			defer stmt.Close()
+		}
+	}
+
+	args := make([]any, len(columns))
+
+	var count int64
+	for {
+		var rec record
+		if err := rs.dec.Decode(&rec); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return ErrIncompleteDump
+			}
+			return fmt.Errorf("%w: %v", ErrInvalidDump, err)
+		}
+
+		switch rec.Type {
+		case recordRow:
+			if len(rec.Values) != len(columns) {
+				return fmt.Errorf("%w: table %s row %d has %d values, expected %d", ErrInvalidDump, table, count+1, len(rec.Values), len(columns))
+			}
+			for i, raw := range rec.Values {
+				v, err := decodeValue(raw)
+				if err != nil {
+					return fmt.Errorf("%w: table %s row %d column %s: %v", ErrInvalidDump, table, count+1, columns[i], err)
+				}
+				args[i] = v
+			}
+			if stmt != nil {
+				if _, err := stmt.ExecContext(ctx, args...); err != nil {
+					return fmt.Errorf("insert into %s row %d: %w", table, count+1, err)
+				}
+			}
+			count++
+
+		case recordEnd:
+			if rec.Name != table || rec.Rows != count {
+				return fmt.Errorf("%w: table %s expected %d rows, got %d", ErrIncompleteDump, table, rec.Rows, count)
+			}
+			if selected {
+				rs.stats.add(table, count)
+				if !rs.dryRun && slices.Contains(columns, "id") {
+					return rs.syncSequence(ctx, table)
+				}
+			}
+			return nil
+
+		default:
+			return fmt.Errorf("%w: unexpected %s record in table %s", ErrInvalidDump, rec.Type, table)
+		}
+	}
+}
+
+// checkColumns 备份中的列必须都存在于目标表中
+func (rs *restorer) checkColumns(ctx context.Context, table string, columns []string) error {
+	columnTypes, err := tableColumns(ctx, rs.q, rs.dialect, table)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]struct{}, len(columnTypes))
+	for _, ct := range columnTypes {
+		existing[ct.Name()] = struct{}{}
+	}
+
+	var missing []string
+	for _, c := range columns {
+		if _, ok := existing[c]; !ok {
+			missing = append(missing, c)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: table %s has no columns %s", ErrInvalidDump, table, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func (rs *restorer) insertSQL(table string, columns []string) string {
+	quoted := make([]string, len(columns))
+	holders := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = quoteIdent(rs.dialect, c)
+		holders[i] = placeholder(rs.dialect, i+1)
+	}
+
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		quoteIdent(rs.dialect, table),
+		strings.Join(quoted, ", "),
+		strings.Join(holders, ", "),
+	)
+}
+
+// syncSequence 显式写入了自增主键，PostgreSQL 需要同步序列的当前值
+func (rs *restorer) syncSequence(ctx context.Context, table string) error {
+	if rs.dialect != dialect.Postgres {
+		return nil
+	}
+
+	query := fmt.Sprintf(
+		"SELECT setval(pg_get_serial_sequence('%s', 'id'), COALESCE((SELECT MAX(%s) FROM %s), 1), true)",
+		strings.ReplaceAll(quoteIdent(rs.dialect, table), "'", "''"),
+		quoteIdent(rs.dialect, "id"),
+		quoteIdent(rs.dialect, table),
+	)
+	if _, err := rs.tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("sync sequence of table %s: %w", table, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSiteVerifier(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "secret", r.PostForm.Get("secret"))
+
+		resp := siteVerifyResponse{Success: r.PostForm.Get("response") == "good"}
+		if !resp.Success {
+			resp.ErrorCodes = []string{"invalid-input-response"}
+		} else {
+			assert.Equal(t, "10.0.0.1", r.PostForm.Get("remoteip"))
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	v, err := NewSiteVerifier(Config{Provider: ProviderTurnstile, Secret: "secret", VerifyURL: srv.URL})
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	assert.NoError(t, v.Verify(ctx, "good", "10.0.0.1"))
+	assert.ErrorIs(t, v.Verify(ctx, "bad", ""), ErrVerificationFailed)
+	assert.ErrorIs(t, v.Verify(ctx, "", ""), ErrVerificationFailed)
+
+	// 校验服务不可用不算作未通过
+	srv.Close()
+	err = v.Verify(ctx, "good", "")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrVerificationFailed)
+}
+
+func TestNewSiteVerifier(t *testing.T) {
+	_, err := NewSiteVerifier(Config{Provider: ProviderRecaptcha})
+	assert.Error(t, err)
+
+	_, err = NewSiteVerifier(Config{Provider: "unknown", Secret: "secret"})
+	assert.Error(t, err)
+
+	v, err := NewSiteVerifier(Config{Provider: "HCaptcha", Secret: "secret"})
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultVerifyURL(ProviderHCaptcha), v.verifyURL)
+}
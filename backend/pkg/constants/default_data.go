@@ -162,6 +162,14 @@ var DefaultPermissions = []*permissionV1.Permission{
 		Code:        trans.Ptr(SystemAuditLogsPermissionCode),
 		Status:      trans.Ptr(permissionV1.Permission_ON),
 	},
+	{
+		//Id:          trans.Ptr(uint32(6)),
+		GroupId:     trans.Ptr(uint32(5)),
+		Name:        trans.Ptr("模拟登录"),
+		Description: trans.Ptr("允许以同租户内其他用户的身份登录，用于排查问题，所有操作均记录双方身份"),
+		Code:        trans.Ptr(SystemImpersonateUserPermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
 }
 
 // DefaultRoles 系统初始化默认角色数据
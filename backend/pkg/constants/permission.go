@@ -18,6 +18,9 @@ const (
 	// SystemTenantManagerPermissionCode 系统租户管理员权限代码
 	SystemTenantManagerPermissionCode = SystemPermissionCodePrefix + "tenant_manager"
 
+	// SystemImpersonateUserPermissionCode 系统模拟登录权限代码
+	SystemImpersonateUserPermissionCode = SystemPermissionCodePrefix + "impersonate_user"
+
 	// SystemPermissionModule 系统权限模块标识
 	SystemPermissionModule = "sys"
 
@@ -35,4 +38,13 @@ var ProtectedPermissionCodes = []string{
 	SystemAuditLogsPermissionCode,
 	SystemPlatformAdminPermissionCode,
 	SystemTenantManagerPermissionCode,
+	SystemImpersonateUserPermissionCode,
+}
+
+// ClientScopeSubjectPrefix 客户端授权范围在鉴权策略中的主体前缀，与角色代码区分
+const ClientScopeSubjectPrefix = "scope" + RoleCodeSpilt
+
+// ClientScopeSubject 返回授权范围（权限码）在鉴权策略中的主体
+func ClientScopeSubject(scope string) string {
+	return ClientScopeSubjectPrefix + scope
 }
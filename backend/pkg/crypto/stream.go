@@ -0,0 +1,247 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// 流式加密格式：
+//
+//	magic(8) | nonce prefix(7) | segment...
+//	segment = flag(1) | length(4, big endian) | ciphertext(length)
+//
+// 明文按 StreamSegmentSize 分段，每段独立使用 AES-256-GCM 加密，
+// nonce = nonce prefix(7) | 段序号(4, big endian) | flag(1)，最后一段的 flag 为 1，
+// 以此防止分段被重排、截断或拼接。
+const (
+	// StreamSegmentSize 流式加密的明文分段大小
+	StreamSegmentSize = 64 * 1024
+
+	streamNoncePrefixSize = 7
+	streamFlagLast        = 1
+)
+
+// StreamMagic 流式加密数据的文件头
+var StreamMagic = []byte("GWAENC\x00\x01")
+
+var (
+	ErrStreamTruncated = errors.New("encrypted stream truncated")
+	ErrStreamCorrupted = errors.New("encrypted stream corrupted")
+)
+
+// IsEncryptedStream 根据文件头判断数据是否为流式加密格式
+func IsEncryptedStream(header []byte) bool {
+	return bytes.HasPrefix(header, StreamMagic)
+}
+
+func (e *Encryptor) newGCM() (cipher.AEAD, error) {
+	if len(e.key) == 0 {
+		return nil, ErrInvalidKey
+	}
+
+	block, err := aes.NewCipher(e.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+	return gcm, nil
+}
+
+func streamNonce(prefix []byte, counter uint32, flag byte) []byte {
+	nonce := make([]byte, 0, streamNoncePrefixSize+5)
+	nonce = append(nonce, prefix...)
+	nonce = binary.BigEndian.AppendUint32(nonce, counter)
+	return append(nonce, flag)
+}
+
+// streamWriter 流式加密写入器
+type streamWriter struct {
+	w       io.Writer
+	gcm     cipher.AEAD
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	closed  bool
+}
+
+// NewEncryptWriter 创建流式加密写入器，写入的数据加密后输出到 w
+// 必须调用 Close 写出最后一段，否则解密时会报告数据被截断。
+func (e *Encryptor) NewEncryptWriter(w io.Writer) (io.WriteCloser, error) {
+	gcm, err := e.newGCM()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := make([]byte, streamNoncePrefixSize)
+	if _, err = io.ReadFull(rand.Reader, prefix); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	if _, err = w.Write(StreamMagic); err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(prefix); err != nil {
+		return nil, err
+	}
+
+	return &streamWriter{
+		w:      w,
+		gcm:    gcm,
+		prefix: prefix,
+		buf:    make([]byte, 0, StreamSegmentSize),
+	}, nil
+}
+
+func (s *streamWriter) Write(p []byte) (int, error) {
+	if s.closed {
+		return 0, errors.New("write to closed encrypt writer")
+	}
+
+	n := 0
+	for len(p) > 0 {
+		// 缓冲区满且还有后续数据时才写出，保证最后一段在 Close 时写出
+		if len(s.buf) == StreamSegmentSize {
+			if err := s.flush(0); err != nil {
+				return n, err
+			}
+		}
+
+		c := copy(s.buf[len(s.buf):StreamSegmentSize], p)
+		s.buf = s.buf[:len(s.buf)+c]
+		p = p[c:]
+		n += c
+	}
+
+	return n, nil
+}
+
+func (s *streamWriter) flush(flag byte) error {
+	sealed := s.gcm.Seal(nil, streamNonce(s.prefix, s.counter, flag), s.buf, nil)
+
+	var head [5]byte
+	head[0] = flag
+	binary.BigEndian.PutUint32(head[1:], uint32(len(sealed)))
+	if _, err := s.w.Write(head[:]); err != nil {
+		return err
+	}
+	if _, err := s.w.Write(sealed); err != nil {
+		return err
+	}
+
+	s.counter++
+	s.buf = s.buf[:0]
+	return nil
+}
+
+// Close 写出最后一段，不会关闭底层的 io.Writer
+func (s *streamWriter) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return s.flush(streamFlagLast)
+}
+
+// streamReader 流式解密读取器
+type streamReader struct {
+	r       io.Reader
+	gcm     cipher.AEAD
+	prefix  []byte
+	counter uint32
+	plain   []byte
+	done    bool
+}
+
+// NewDecryptReader 创建流式解密读取器，r 须从文件头开始
+func (e *Encryptor) NewDecryptReader(r io.Reader) (io.Reader, error) {
+	gcm, err := e.newGCM()
+	if err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, len(StreamMagic)+streamNoncePrefixSize)
+	if _, err = io.ReadFull(r, head); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCiphertext, err)
+	}
+	if !IsEncryptedStream(head) {
+		return nil, fmt.Errorf("%w: bad stream header", ErrInvalidCiphertext)
+	}
+
+	return &streamReader{
+		r:      r,
+		gcm:    gcm,
+		prefix: head[len(StreamMagic):],
+	}, nil
+}
+
+func (s *streamReader) Read(p []byte) (int, error) {
+	for len(s.plain) == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+		if err := s.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, s.plain)
+	s.plain = s.plain[n:]
+	return n, nil
+}
+
+func (s *streamReader) next() error {
+	var head [5]byte
+	if _, err := io.ReadFull(s.r, head[:]); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return ErrStreamTruncated
+		}
+		return err
+	}
+
+	flag := head[0]
+	if flag > streamFlagLast {
+		return ErrStreamCorrupted
+	}
+
+	size := binary.BigEndian.Uint32(head[1:])
+	if size < uint32(s.gcm.Overhead()) || size > StreamSegmentSize+uint32(s.gcm.Overhead()) {
+		return ErrStreamCorrupted
+	}
+
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(s.r, sealed); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return ErrStreamTruncated
+		}
+		return err
+	}
+
+	plain, err := s.gcm.Open(sealed[:0], streamNonce(s.prefix, s.counter, flag), sealed, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDecryptionFailed, err)
+	}
+
+	s.counter++
+	s.plain = plain
+
+	if flag == streamFlagLast {
+		s.done = true
+		// 最后一段之后不应再有数据
+		var extra [1]byte
+		if n, _ := s.r.Read(extra[:]); n > 0 {
+			return ErrStreamCorrupted
+		}
+	}
+
+	return nil
+}
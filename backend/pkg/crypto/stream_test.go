@@ -0,0 +1,111 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+func encryptStream(t *testing.T, e *Encryptor, plain []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := e.NewEncryptWriter(&buf)
+	if err != nil {
+		t.Fatalf("NewEncryptWriter() error = %v", err)
+	}
+	// 分多次写入，覆盖跨段的情况
+	for len(plain) > 0 {
+		n := min(len(plain), 10000)
+		if _, err = w.Write(plain[:n]); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		plain = plain[n:]
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestEncryptor_Stream(t *testing.T) {
+	encryptor, err := NewEncryptor("test-encryption-key-123")
+	if err != nil {
+		t.Fatalf("Failed to create encryptor: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		size int
+	}{
+		{name: "empty", size: 0},
+		{name: "small", size: 100},
+		{name: "exact segment", size: StreamSegmentSize},
+		{name: "multiple segments", size: StreamSegmentSize*3 + 17},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plain := make([]byte, tt.size)
+			_, _ = rand.Read(plain)
+
+			sealed := encryptStream(t, encryptor, plain)
+			if !IsEncryptedStream(sealed) {
+				t.Fatalf("IsEncryptedStream() = false")
+			}
+
+			r, err := encryptor.NewDecryptReader(bytes.NewReader(sealed))
+			if err != nil {
+				t.Fatalf("NewDecryptReader() error = %v", err)
+			}
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+			if !bytes.Equal(got, plain) {
+				t.Errorf("decrypted data mismatch, got %d bytes, want %d bytes", len(got), len(plain))
+			}
+		})
+	}
+}
+
+func TestEncryptor_StreamTampered(t *testing.T) {
+	encryptor, _ := NewEncryptor("test-encryption-key-123")
+	other, _ := NewEncryptor("another-key")
+
+	plain := make([]byte, StreamSegmentSize*2+5)
+	_, _ = rand.Read(plain)
+	sealed := encryptStream(t, encryptor, plain)
+
+	decrypt := func(e *Encryptor, data []byte) error {
+		r, err := e.NewDecryptReader(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		_, err = io.ReadAll(r)
+		return err
+	}
+
+	if err := decrypt(other, sealed); !errors.Is(err, ErrDecryptionFailed) {
+		t.Errorf("wrong key: error = %v, want %v", err, ErrDecryptionFailed)
+	}
+
+	// 去掉最后一段，模拟截断
+	segment := 5 + StreamSegmentSize + 16
+	truncated := sealed[:len(StreamMagic)+streamNoncePrefixSize+segment*2]
+	if err := decrypt(encryptor, truncated); !errors.Is(err, ErrStreamTruncated) {
+		t.Errorf("truncated: error = %v, want %v", err, ErrStreamTruncated)
+	}
+
+	flipped := bytes.Clone(sealed)
+	flipped[len(flipped)-1] ^= 0xff
+	if err := decrypt(encryptor, flipped); !errors.Is(err, ErrDecryptionFailed) {
+		t.Errorf("flipped: error = %v, want %v", err, ErrDecryptionFailed)
+	}
+
+	if err := decrypt(encryptor, []byte("not encrypted at all")); !errors.Is(err, ErrInvalidCiphertext) {
+		t.Errorf("plain: error = %v, want %v", err, ErrInvalidCiphertext)
+	}
+}
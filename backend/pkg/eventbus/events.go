@@ -54,6 +54,7 @@ type EmailProcessedEvent struct {
 // UserCreatedEvent represents a user created event
 type UserCreatedEvent struct {
 	UserID   uint32 `json:"user_id"`
+	TenantID uint32 `json:"tenant_id"`
 	Username string `json:"username"`
 	Email    string `json:"email"`
 }
@@ -9,20 +9,44 @@ import (
 
 // Manager manages multiple event buses and provides a global interface
 type Manager struct {
-	mu       sync.RWMutex
-	buses    map[string]EventBus
-	global   EventBus
-	logger   *log.Helper
+	mu        sync.RWMutex
+	buses     map[string]EventBus
+	global    EventBus
+	transport Transport
+	logger    *log.Helper
 }
 
-// NewManager creates a new event bus manager
-func NewManager(logger log.Logger) *Manager {
-	l := log.NewHelper(log.With(logger, "module", "eventbus/manager"))
-	return &Manager{
+// ManagerOption configures a Manager
+type ManagerOption func(*Manager)
+
+// WithTransport makes all buses of the manager publish through the transport.
+// The transport is closed with the manager.
+func WithTransport(transport Transport) ManagerOption {
+	return func(m *Manager) {
+		m.transport = transport
+	}
+}
+
+// NewManager creates a new event bus manager, buses are in-process unless a transport is given
+func NewManager(logger log.Logger, opts ...ManagerOption) *Manager {
+	m := &Manager{
 		buses:  make(map[string]EventBus),
-		global: NewEventBus(logger),
-		logger: l,
+		logger: log.NewHelper(log.With(logger, "module", "eventbus/manager")),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	m.global = m.newBus("", logger)
+	return m
+}
+
+// newBus creates an in-process bus, or a transport bus when the manager has a transport
+func (m *Manager) newBus(name string, logger log.Logger) EventBus {
+	if m.transport != nil {
+		return NewTransportEventBus(name, m.transport, logger)
 	}
+	return NewEventBus(logger)
 }
 
 // GetBus returns an event bus by name, creates it if it doesn't exist
@@ -35,7 +59,7 @@ func (m *Manager) GetBus(name string) EventBus {
 	}
 
 	// Create new bus
-	bus := NewEventBus(log.DefaultLogger)
+	bus := m.newBus(name, log.DefaultLogger)
 	m.buses[name] = bus
 	m.logger.Infof("Created new event bus: %s", name)
 
@@ -84,12 +108,23 @@ func (m *Manager) Close() error {
 		m.logger.Errorf("Error closing global bus: %v", err)
 	}
 
+	if m.transport != nil {
+		if err := m.transport.Close(); err != nil {
+			m.logger.Errorf("Error closing transport: %v", err)
+		}
+	}
+
 	m.buses = make(map[string]EventBus)
 	m.logger.Info("Event bus manager closed")
 
 	return nil
 }
 
+// eventTypeLister is implemented by buses that report their subscribed event types
+type eventTypeLister interface {
+	GetEventTypes() []string
+}
+
 // GetStats returns statistics for all buses
 func (m *Manager) GetStats() map[string]interface{} {
 	m.mu.RLock()
@@ -100,17 +135,17 @@ func (m *Manager) GetStats() map[string]interface{} {
 
 	busStats := make(map[string]interface{})
 	for name, bus := range m.buses {
-		if defaultBus, ok := bus.(*DefaultEventBus); ok {
+		if typedBus, ok := bus.(eventTypeLister); ok {
 			busStats[name] = map[string]interface{}{
-				"event_types": defaultBus.GetEventTypes(),
+				"event_types": typedBus.GetEventTypes(),
 			}
 		}
 	}
 	stats["buses"] = busStats
 
-	if defaultBus, ok := m.global.(*DefaultEventBus); ok {
+	if typedBus, ok := m.global.(eventTypeLister); ok {
 		stats["global_bus"] = map[string]interface{}{
-			"event_types": defaultBus.GetEventTypes(),
+			"event_types": typedBus.GetEventTypes(),
 		}
 	}
 
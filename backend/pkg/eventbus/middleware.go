@@ -67,8 +67,38 @@ func TimeoutMiddleware(timeout time.Duration) Middleware {
 	}
 }
 
+// Backoff returns the delay before the given retry, attempt starts at 1
+type Backoff func(attempt int) time.Duration
+
+// ConstantBackoff waits the same delay before every retry
+func ConstantBackoff(delay time.Duration) Backoff {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// ExponentialBackoff doubles the delay after every retry, up to max
+func ExponentialBackoff(base, max time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		delay := base
+		for i := 1; i < attempt && delay < max; i++ {
+			delay *= 2
+		}
+		if delay > max {
+			delay = max
+		}
+		return delay
+	}
+}
+
 // RetryMiddleware retries failed event handling
 func RetryMiddleware(maxRetries int, delay time.Duration) Middleware {
+	return RetryBackoffMiddleware(maxRetries, ConstantBackoff(delay))
+}
+
+// RetryBackoffMiddleware retries failed event handling, waiting backoff between attempts.
+// Waiting stops early when ctx is done.
+func RetryBackoffMiddleware(maxRetries int, backoff Backoff) Middleware {
 	return func(next Handler) Handler {
 		return EventHandlerFunc(func(ctx context.Context, event *Event) error {
 			var err error
@@ -79,7 +109,13 @@ func RetryMiddleware(maxRetries int, delay time.Duration) Middleware {
 				}
 
 				if i < maxRetries {
-					time.Sleep(delay)
+					timer := time.NewTimer(backoff(i + 1))
+					select {
+					case <-timer.C:
+					case <-ctx.Done():
+						timer.Stop()
+						return err
+					}
 				}
 			}
 			return err
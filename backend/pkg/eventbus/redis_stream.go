@@ -0,0 +1,492 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// Fields of a stream entry
+const (
+	streamFieldID         = "id"
+	streamFieldEvent      = "event"
+	streamFieldError      = "error"
+	streamFieldDeliveries = "deliveries"
+	streamFieldStreamID   = "stream_id"
+)
+
+// RedisStreamConfig configures a RedisStreamTransport
+type RedisStreamConfig struct {
+	Prefix        string        // Stream key prefix (default: "eventbus:")
+	Group         string        // Consumer group, processes of one service share a group and split its events (required)
+	Consumer      string        // Consumer name, unique per process (default: hostname-pid)
+	MaxLen        int64         // Approximate maximum entries kept per stream (default: 10000)
+	BatchSize     int64         // Entries read per request (default: 10)
+	Block         time.Duration // How long a read waits for new entries (default: 1s)
+	MaxDeliveries int64         // Deliveries before an event is dead-lettered (default: 5)
+	Backoff       Backoff       // Delay before a failed event is delivered again (default: 1s doubling up to 1m)
+	ClaimTimeout  time.Duration // Idle time after which events of another consumer are taken over (default: 1m)
+}
+
+// RedisStreamTransport is a Transport on Redis Streams.
+//
+// Each topic is a stream, each service reads it through a consumer group. Failed
+// events stay pending and are delivered again after the backoff. Events of a process
+// that stopped are taken over by another process of the group after ClaimTimeout.
+// After MaxDeliveries events are moved to the dead-letter stream "<stream>:dead-letter",
+// from where Replay can deliver them again.
+type RedisStreamTransport struct {
+	rdb    redis.UniversalClient
+	config RedisStreamConfig
+	logger *log.Helper
+
+	// Last handler error per pending entry of this consumer, recorded in the dead-letter stream
+	failures sync.Map // failureKey -> string
+
+	mu     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	closed bool
+}
+
+// NewRedisStreamTransport creates a Redis Streams transport. The Redis client is not closed with the transport.
+func NewRedisStreamTransport(rdb redis.UniversalClient, config RedisStreamConfig, logger log.Logger) (*RedisStreamTransport, error) {
+	if config.Group == "" {
+		return nil, fmt.Errorf("consumer group is required")
+	}
+	if config.Prefix == "" {
+		config.Prefix = "eventbus:"
+	}
+	if config.Consumer == "" {
+		hostname, _ := os.Hostname()
+		config.Consumer = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+	if config.MaxLen <= 0 {
+		config.MaxLen = 10000
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = 10
+	}
+	if config.Block <= 0 {
+		config.Block = time.Second
+	}
+	if config.MaxDeliveries <= 0 {
+		config.MaxDeliveries = 5
+	}
+	if config.Backoff == nil {
+		config.Backoff = ExponentialBackoff(time.Second, time.Minute)
+	}
+	if config.ClaimTimeout <= 0 {
+		config.ClaimTimeout = time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &RedisStreamTransport{
+		rdb:    rdb,
+		config: config,
+		logger: log.NewHelper(log.With(logger, "module", "eventbus/redis")),
+		ctx:    ctx,
+		cancel: cancel,
+	}, nil
+}
+
+// failureKey identifies a stream entry, entry IDs are only unique within a stream
+type failureKey struct {
+	stream string
+	id     string
+}
+
+func (t *RedisStreamTransport) streamKey(topic string) string {
+	return t.config.Prefix + topic
+}
+
+func (t *RedisStreamTransport) deadLetterKey(topic string) string {
+	return t.streamKey(topic) + ":dead-letter"
+}
+
+// Send appends the event to the stream of topic
+func (t *RedisStreamTransport) Send(ctx context.Context, topic string, event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	return t.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: t.streamKey(topic),
+		MaxLen: t.config.MaxLen,
+		Approx: true,
+		Values: map[string]any{streamFieldID: event.ID, streamFieldEvent: data},
+	}).Err()
+}
+
+// Subscribe creates the consumer group if needed and starts reading the stream of topic
+func (t *RedisStreamTransport) Subscribe(ctx context.Context, topic string, handler Handler) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return fmt.Errorf("transport is closed")
+	}
+
+	stream := t.streamKey(topic)
+	err := t.rdb.XGroupCreateMkStream(ctx, stream, t.config.Group, "$").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("create consumer group: %w", err)
+	}
+
+	// Stop when either the subscription or the transport ends
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(t.ctx, cancel)
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		defer stop()
+		defer cancel()
+		t.consume(ctx, topic, handler)
+	}()
+
+	return nil
+}
+
+// consume reads new entries and claims the failed ones until ctx is done
+func (t *RedisStreamTransport) consume(ctx context.Context, topic string, handler Handler) {
+	stream := t.streamKey(topic)
+
+	for ctx.Err() == nil {
+		t.claimPending(ctx, topic, handler)
+
+		streams, err := t.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    t.config.Group,
+			Consumer: t.config.Consumer,
+			Streams:  []string{stream, ">"},
+			Count:    t.config.BatchSize,
+			Block:    t.config.Block,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || ctx.Err() != nil {
+				continue
+			}
+			t.logger.Errorf("Read stream %s failed: %v", stream, err)
+			t.wait(ctx, t.config.Block)
+			continue
+		}
+
+		for _, s := range streams {
+			for _, msg := range s.Messages {
+				t.deliver(ctx, topic, msg, handler)
+			}
+		}
+	}
+}
+
+// claimPending delivers failed entries again once their backoff has passed and
+// dead-letters those that failed too often. Entries of other consumers are only
+// taken over after ClaimTimeout, until then they may still be handled.
+func (t *RedisStreamTransport) claimPending(ctx context.Context, topic string, handler Handler) {
+	stream := t.streamKey(topic)
+
+	t.forgetReleased(ctx, stream)
+
+	own, err := t.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream:   stream,
+		Group:    t.config.Group,
+		Consumer: t.config.Consumer,
+		Idle:     t.config.Backoff(1),
+		Start:    "-",
+		End:      "+",
+		Count:    t.config.BatchSize,
+	}).Result()
+	if err != nil {
+		if ctx.Err() == nil {
+			t.logger.Errorf("Read pending entries of %s failed: %v", stream, err)
+		}
+		return
+	}
+
+	stale, err := t.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: stream,
+		Group:  t.config.Group,
+		Idle:   t.config.ClaimTimeout,
+		Start:  "-",
+		End:    "+",
+		Count:  t.config.BatchSize,
+	}).Result()
+	if err != nil {
+		if ctx.Err() == nil {
+			t.logger.Errorf("Read pending entries of %s failed: %v", stream, err)
+		}
+		return
+	}
+
+	seen := make(map[string]bool, len(own)+len(stale))
+	for _, p := range append(own, stale...) {
+		if seen[p.ID] {
+			continue
+		}
+		seen[p.ID] = true
+
+		minIdle := t.config.Backoff(1)
+		if p.RetryCount < t.config.MaxDeliveries {
+			minIdle = t.config.Backoff(int(p.RetryCount))
+		}
+		if p.Consumer != t.config.Consumer {
+			minIdle = max(minIdle, t.config.ClaimTimeout)
+		}
+		if p.Idle < minIdle {
+			continue
+		}
+
+		// Claiming with the same minimum idle time lets only one process take the entry
+		msgs, err := t.rdb.XClaim(ctx, &redis.XClaimArgs{
+			Stream:   stream,
+			Group:    t.config.Group,
+			Consumer: t.config.Consumer,
+			MinIdle:  minIdle,
+			Messages: []string{p.ID},
+		}).Result()
+		if err != nil {
+			t.logger.Errorf("Claim entry %s of %s failed: %v", p.ID, stream, err)
+			continue
+		}
+
+		for _, msg := range msgs {
+			if p.RetryCount >= t.config.MaxDeliveries {
+				t.deadLetter(topic, msg, p.RetryCount)
+				continue
+			}
+			t.deliver(ctx, topic, msg, handler)
+		}
+	}
+}
+
+// forgetReleased drops the recorded errors of entries that are no longer pending for
+// this consumer, because another consumer claimed them or they were acknowledged
+func (t *RedisStreamTransport) forgetReleased(ctx context.Context, stream string) {
+	t.failures.Range(func(key, _ any) bool {
+		k := key.(failureKey)
+		if k.stream != stream {
+			return true
+		}
+
+		pending, err := t.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+			Stream: stream,
+			Group:  t.config.Group,
+			Start:  k.id,
+			End:    k.id,
+			Count:  1,
+		}).Result()
+		if err != nil {
+			// Keep the error, the entry is checked again on the next round
+			return ctx.Err() == nil
+		}
+
+		if len(pending) == 0 || pending[0].Consumer != t.config.Consumer {
+			t.failures.Delete(k)
+		}
+		return true
+	})
+}
+
+// deliver runs the handler and acknowledges the entry when it succeeds
+func (t *RedisStreamTransport) deliver(ctx context.Context, topic string, msg redis.XMessage, handler Handler) {
+	key := failureKey{stream: t.streamKey(topic), id: msg.ID}
+
+	event, err := decodeStreamEvent(msg)
+	if err != nil {
+		// Malformed entries can never succeed
+		t.failures.Store(key, err.Error())
+		t.deadLetter(topic, msg, 1)
+		return
+	}
+
+	if err = handler.Handle(ctx, event); err != nil {
+		t.failures.Store(key, err.Error())
+		return
+	}
+
+	t.failures.Delete(key)
+	if err = t.rdb.XAck(context.WithoutCancel(ctx), t.streamKey(topic), t.config.Group, msg.ID).Err(); err != nil {
+		t.logger.Errorf("Ack entry %s of %s failed: %v", msg.ID, t.streamKey(topic), err)
+	}
+}
+
+// deadLetter moves the entry to the dead-letter stream of topic
+func (t *RedisStreamTransport) deadLetter(topic string, msg redis.XMessage, deliveries int64) {
+	reason := "too many deliveries"
+	if v, ok := t.failures.LoadAndDelete(failureKey{stream: t.streamKey(topic), id: msg.ID}); ok {
+		reason = v.(string)
+	}
+
+	values := map[string]any{
+		streamFieldError:      reason,
+		streamFieldDeliveries: deliveries,
+		streamFieldStreamID:   msg.ID,
+	}
+	for key, value := range msg.Values {
+		values[key] = value
+	}
+
+	// Moving and acknowledging together keeps the entry in exactly one place
+	ctx := context.Background()
+	_, err := t.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: t.deadLetterKey(topic),
+			MaxLen: t.config.MaxLen,
+			Approx: true,
+			Values: values,
+		})
+		pipe.XAck(ctx, t.streamKey(topic), t.config.Group, msg.ID)
+		return nil
+	})
+	if err != nil {
+		t.logger.Errorf("Dead-letter entry %s of %s failed: %v", msg.ID, t.streamKey(topic), err)
+		return
+	}
+
+	t.logger.Warnf("Event %v of %s dead-lettered after %d deliveries: %s", msg.Values[streamFieldID], topic, deliveries, reason)
+}
+
+// Replay appends the event with the given ID to the stream of topic again. Dead-lettered
+// events are removed from the dead-letter stream, events still in the stream are sent
+// again to every consumer group.
+func (t *RedisStreamTransport) Replay(ctx context.Context, topic, eventID string) error {
+	deadLetterKey := t.deadLetterKey(topic)
+
+	msg, err := t.findEvent(ctx, deadLetterKey, eventID)
+	if err != nil {
+		return err
+	}
+	if msg != nil {
+		_, err = t.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.XAdd(ctx, &redis.XAddArgs{
+				Stream: t.streamKey(topic),
+				MaxLen: t.config.MaxLen,
+				Approx: true,
+				Values: map[string]any{streamFieldID: eventID, streamFieldEvent: msg.Values[streamFieldEvent]},
+			})
+			pipe.XDel(ctx, deadLetterKey, msg.ID)
+			return nil
+		})
+		return err
+	}
+
+	if msg, err = t.findEvent(ctx, t.streamKey(topic), eventID); err != nil {
+		return err
+	}
+	if msg == nil {
+		return fmt.Errorf("%w: %s", ErrEventNotFound, eventID)
+	}
+
+	return t.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: t.streamKey(topic),
+		MaxLen: t.config.MaxLen,
+		Approx: true,
+		Values: map[string]any{streamFieldID: eventID, streamFieldEvent: msg.Values[streamFieldEvent]},
+	}).Err()
+}
+
+// findEvent scans the stream from newest to oldest for the entry of an event
+func (t *RedisStreamTransport) findEvent(ctx context.Context, stream, eventID string) (*redis.XMessage, error) {
+	end := "+"
+	for {
+		msgs, err := t.rdb.XRevRangeN(ctx, stream, end, "-", 100).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range msgs {
+			if msgs[i].Values[streamFieldID] == eventID {
+				return &msgs[i], nil
+			}
+		}
+
+		if len(msgs) < 100 {
+			return nil, nil
+		}
+		end = "(" + msgs[len(msgs)-1].ID
+	}
+}
+
+// DeadLetter is an event that failed too often
+type DeadLetter struct {
+	Event      *Event
+	Error      string // Last handler error
+	Deliveries int64
+	StreamID   string // Entry ID in the original stream
+}
+
+// DeadLetters returns up to count of the newest dead-lettered events of topic
+func (t *RedisStreamTransport) DeadLetters(ctx context.Context, topic string, count int64) ([]*DeadLetter, error) {
+	msgs, err := t.rdb.XRevRangeN(ctx, t.deadLetterKey(topic), "+", "-", count).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*DeadLetter, 0, len(msgs))
+	for _, msg := range msgs {
+		event, err := decodeStreamEvent(msg)
+		if err != nil {
+			event = &Event{ID: fmt.Sprint(msg.Values[streamFieldID])}
+		}
+
+		deliveries, _ := strconv.ParseInt(fmt.Sprint(msg.Values[streamFieldDeliveries]), 10, 64)
+		result = append(result, &DeadLetter{
+			Event:      event,
+			Error:      fmt.Sprint(msg.Values[streamFieldError]),
+			Deliveries: deliveries,
+			StreamID:   fmt.Sprint(msg.Values[streamFieldStreamID]),
+		})
+	}
+
+	return result, nil
+}
+
+// Close stops all subscriptions and waits for running handlers
+func (t *RedisStreamTransport) Close() error {
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return fmt.Errorf("transport already closed")
+	}
+	t.closed = true
+	t.mu.Unlock()
+
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
+
+// wait sleeps for d or until ctx is done
+func (t *RedisStreamTransport) wait(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
+
+func decodeStreamEvent(msg redis.XMessage) (*Event, error) {
+	data, ok := msg.Values[streamFieldEvent].(string)
+	if !ok {
+		return nil, fmt.Errorf("entry %s has no event", msg.ID)
+	}
+
+	var event Event
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		return nil, fmt.Errorf("decode entry %s: %w", msg.ID, err)
+	}
+	return &event, nil
+}
@@ -0,0 +1,227 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTransport(t *testing.T, mr *miniredis.Miniredis, group, consumer string) *RedisStreamTransport {
+	t.Helper()
+
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	transport, err := NewRedisStreamTransport(rdb, RedisStreamConfig{
+		Group:         group,
+		Consumer:      consumer,
+		Block:         20 * time.Millisecond,
+		MaxDeliveries: 3,
+		Backoff:       ConstantBackoff(20 * time.Millisecond),
+		ClaimTimeout:  time.Minute,
+	}, log.DefaultLogger)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = transport.Close() })
+
+	return transport
+}
+
+// recorder collects the IDs of handled events
+type recorder struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (r *recorder) Handle(_ context.Context, event *Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids = append(r.ids, event.ID)
+	return nil
+}
+
+func (r *recorder) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.ids)
+}
+
+func TestRedisStreamTransport_ConsumerGroups(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	// Two processes of one service share the events, another service gets all of them
+	replica1 := NewTransportEventBus("", newTestTransport(t, mr, "mail", "mail-1"), log.DefaultLogger)
+	replica2 := NewTransportEventBus("", newTestTransport(t, mr, "mail", "mail-2"), log.DefaultLogger)
+	audit := NewTransportEventBus("", newTestTransport(t, mr, "audit", "audit-1"), log.DefaultLogger)
+
+	var mail1, mail2, auditLog recorder
+	require.NoError(t, replica1.Subscribe(EventUserCreated, &mail1))
+	require.NoError(t, replica2.Subscribe(EventUserCreated, &mail2))
+	require.NoError(t, audit.Subscribe(EventUserCreated, &auditLog))
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, replica1.Publish(context.Background(), NewEvent(EventUserCreated, map[string]any{"n": i})))
+	}
+
+	assert.Eventually(t, func() bool {
+		return mail1.count()+mail2.count() == 10 && auditLog.count() == 10
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// Nothing is delivered twice
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 10, mail1.count()+mail2.count())
+	assert.Equal(t, []string{EventUserCreated}, replica1.GetEventTypes())
+}
+
+func TestRedisStreamTransport_Retry(t *testing.T) {
+	mr := miniredis.RunT(t)
+	transport := newTestTransport(t, mr, "svc", "svc-1")
+	bus := NewTransportEventBus("", transport, log.DefaultLogger)
+
+	var attempts atomic.Int32
+	require.NoError(t, bus.Subscribe("order.paid", EventHandlerFunc(func(ctx context.Context, event *Event) error {
+		if attempts.Add(1) < 3 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})))
+
+	require.NoError(t, bus.Publish(context.Background(), NewEvent("order.paid", nil)))
+
+	assert.Eventually(t, func() bool { return attempts.Load() == 3 }, 2*time.Second, 10*time.Millisecond)
+
+	// The successful delivery is acknowledged
+	assert.Eventually(t, func() bool {
+		pending, err := transport.rdb.XPending(context.Background(), "eventbus:order.paid", "svc").Result()
+		return err == nil && pending.Count == 0
+	}, time.Second, 10*time.Millisecond)
+
+	deadLetters, err := transport.DeadLetters(context.Background(), "order.paid", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, deadLetters)
+}
+
+func TestRedisStreamTransport_ForgetClaimedFailures(t *testing.T) {
+	mr := miniredis.RunT(t)
+	transport := newTestTransport(t, mr, "svc", "svc-1")
+	ctx := context.Background()
+	stream := transport.streamKey("order.paid")
+
+	require.NoError(t, transport.rdb.XGroupCreateMkStream(ctx, stream, "svc", "$").Err())
+	require.NoError(t, transport.Send(ctx, "order.paid", NewEvent("order.paid", nil)))
+	streams, err := transport.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    "svc",
+		Consumer: "svc-1",
+		Streams:  []string{stream, ">"},
+	}).Result()
+	require.NoError(t, err)
+	id := streams[0].Messages[0].ID
+
+	// The failure is kept while the entry is pending for this consumer
+	key := failureKey{stream: stream, id: id}
+	transport.failures.Store(key, "temporary failure")
+	transport.forgetReleased(ctx, stream)
+	_, ok := transport.failures.Load(key)
+	assert.True(t, ok)
+
+	// and dropped once another consumer took the entry over
+	require.NoError(t, transport.rdb.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   stream,
+		Group:    "svc",
+		Consumer: "svc-2",
+		Messages: []string{id},
+	}).Err())
+	transport.forgetReleased(ctx, stream)
+	_, ok = transport.failures.Load(key)
+	assert.False(t, ok)
+}
+
+func TestRedisStreamTransport_DeadLetterAndReplay(t *testing.T) {
+	mr := miniredis.RunT(t)
+	transport := newTestTransport(t, mr, "svc", "svc-1")
+	bus := NewTransportEventBus("orders", transport, log.DefaultLogger)
+
+	var healthy atomic.Bool
+	var attempts atomic.Int32
+	require.NoError(t, bus.Subscribe("order.paid", EventHandlerFunc(func(ctx context.Context, event *Event) error {
+		attempts.Add(1)
+		if !healthy.Load() {
+			return errors.New("downstream unavailable")
+		}
+		return nil
+	})))
+
+	event := NewEvent("order.paid", map[string]any{"order_id": 42})
+	require.NoError(t, bus.Publish(context.Background(), event))
+
+	var deadLetters []*DeadLetter
+	assert.Eventually(t, func() bool {
+		deadLetters, _ = transport.DeadLetters(context.Background(), "orders:order.paid", 10)
+		return len(deadLetters) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, int32(3), attempts.Load())
+	assert.Equal(t, event.ID, deadLetters[0].Event.ID)
+	assert.Equal(t, "downstream unavailable", deadLetters[0].Error)
+	assert.Equal(t, int64(3), deadLetters[0].Deliveries)
+
+	// Replaying after the fix delivers the event once more and empties the dead-letter stream
+	healthy.Store(true)
+	require.NoError(t, bus.Replay(context.Background(), "order.paid", event.ID))
+
+	assert.Eventually(t, func() bool { return attempts.Load() == 4 }, 2*time.Second, 10*time.Millisecond)
+	deadLetters, err := transport.DeadLetters(context.Background(), "orders:order.paid", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, deadLetters)
+
+	assert.ErrorIs(t, bus.Replay(context.Background(), "order.paid", "missing"), ErrEventNotFound)
+}
+
+func TestTransportEventBus_SubscribeOnce(t *testing.T) {
+	mr := miniredis.RunT(t)
+	bus := NewTransportEventBus("", newTestTransport(t, mr, "svc", "svc-1"), log.DefaultLogger)
+
+	var once recorder
+	require.NoError(t, bus.SubscribeOnce("system.started", &once))
+
+	require.NoError(t, bus.Publish(context.Background(), NewEvent("system.started", nil)))
+	assert.Eventually(t, func() bool { return once.count() == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	// The subscription ends with its last handler
+	assert.Empty(t, bus.GetEventTypes())
+	assert.Error(t, bus.Unsubscribe("system.started", &once))
+
+	require.NoError(t, bus.Close())
+	assert.Error(t, bus.Publish(context.Background(), NewEvent("system.started", nil)))
+}
+
+func TestRetryBackoffMiddleware(t *testing.T) {
+	backoff := ExponentialBackoff(10*time.Millisecond, 30*time.Millisecond)
+	assert.Equal(t, 10*time.Millisecond, backoff(1))
+	assert.Equal(t, 20*time.Millisecond, backoff(2))
+	assert.Equal(t, 30*time.Millisecond, backoff(3))
+	assert.Equal(t, 30*time.Millisecond, backoff(10))
+
+	var attempts int
+	handler := RetryBackoffMiddleware(2, backoff)(EventHandlerFunc(func(ctx context.Context, event *Event) error {
+		attempts++
+		return errors.New("failed")
+	}))
+	assert.Error(t, handler.Handle(context.Background(), NewEvent("test", nil)))
+	assert.Equal(t, 3, attempts)
+
+	// Waiting between attempts stops with the context
+	attempts = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, handler.Handle(ctx, NewEvent("test", nil)))
+	assert.Equal(t, 1, attempts)
+}
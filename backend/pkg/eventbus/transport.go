@@ -0,0 +1,251 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrEventNotFound is returned when a replayed event is no longer stored
+var ErrEventNotFound = errors.New("event not found")
+
+// Transport carries events between processes.
+//
+// Delivery is at-least-once: an event is acknowledged only after the handler
+// returns nil, otherwise it is delivered again later, so handlers must be idempotent.
+type Transport interface {
+	// Send stores the event for the subscribers of topic
+	Send(ctx context.Context, topic string, event *Event) error
+
+	// Subscribe delivers the events of topic to handler until ctx is done or the
+	// transport is closed. Events sent before the first subscription are not delivered.
+	Subscribe(ctx context.Context, topic string, handler Handler) error
+
+	// Close stops all subscriptions
+	Close() error
+}
+
+// Replayer is implemented by transports that can deliver a stored event again
+type Replayer interface {
+	// Replay delivers the event with the given ID again, dead-lettered events included
+	Replay(ctx context.Context, topic, eventID string) error
+}
+
+// TransportEventBus is an EventBus that publishes through a Transport, so events
+// survive restarts and reach the subscribers of every process.
+//
+// Publish returns once the transport has stored the event, handlers run later on
+// the subscription goroutine. When several handlers subscribe to one event type,
+// a failure of any of them redelivers the event to all of them.
+type TransportEventBus struct {
+	mu           sync.Mutex
+	name         string
+	transport    Transport
+	handlers     map[string][]Handler
+	onceHandlers map[string][]Handler
+	cancels      map[string]context.CancelFunc
+	logger       *log.Helper
+	closed       bool
+}
+
+// NewTransportEventBus creates an event bus on top of transport. Buses with different
+// names do not share events. The transport is not closed with the bus.
+func NewTransportEventBus(name string, transport Transport, logger log.Logger) *TransportEventBus {
+	return &TransportEventBus{
+		name:         name,
+		transport:    transport,
+		handlers:     make(map[string][]Handler),
+		onceHandlers: make(map[string][]Handler),
+		cancels:      make(map[string]context.CancelFunc),
+		logger:       log.NewHelper(log.With(logger, "module", "eventbus/transport")),
+	}
+}
+
+// topic returns the transport topic of an event type
+func (eb *TransportEventBus) topic(eventType string) string {
+	if eb.name == "" {
+		return eventType
+	}
+	return eb.name + ":" + eventType
+}
+
+// Subscribe registers a handler for a specific event type
+func (eb *TransportEventBus) Subscribe(eventType string, handler Handler) error {
+	return eb.subscribe(eventType, handler, false)
+}
+
+// SubscribeAsync registers an async handler for a specific event type.
+// The event is acknowledged before the handler finishes, so failures are not retried.
+func (eb *TransportEventBus) SubscribeAsync(eventType string, handler Handler) error {
+	return eb.subscribe(eventType, NewAsyncHandler(handler), false)
+}
+
+// SubscribeOnce registers a handler that will be called until it succeeds once
+func (eb *TransportEventBus) SubscribeOnce(eventType string, handler Handler) error {
+	return eb.subscribe(eventType, handler, true)
+}
+
+func (eb *TransportEventBus) subscribe(eventType string, handler Handler, once bool) error {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	if eb.closed {
+		return fmt.Errorf("event bus is closed")
+	}
+
+	// The first handler of an event type starts the subscription
+	if _, exists := eb.cancels[eventType]; !exists {
+		ctx, cancel := context.WithCancel(context.Background())
+		if err := eb.transport.Subscribe(ctx, eb.topic(eventType), eb.dispatcher(eventType)); err != nil {
+			cancel()
+			return fmt.Errorf("subscribe %s: %w", eventType, err)
+		}
+		eb.cancels[eventType] = cancel
+	}
+
+	if once {
+		eb.onceHandlers[eventType] = append(eb.onceHandlers[eventType], handler)
+	} else {
+		eb.handlers[eventType] = append(eb.handlers[eventType], handler)
+	}
+	return nil
+}
+
+// dispatcher runs the handlers of an event type for one delivery
+func (eb *TransportEventBus) dispatcher(eventType string) Handler {
+	return EventHandlerFunc(func(ctx context.Context, event *Event) error {
+		eb.mu.Lock()
+		handlers := append([]Handler(nil), eb.handlers[eventType]...)
+		onceHandlers := append([]Handler(nil), eb.onceHandlers[eventType]...)
+		eb.mu.Unlock()
+
+		var errs []error
+		for _, handler := range handlers {
+			if err := handler.Handle(ctx, event); err != nil {
+				eb.logger.Errorf("Handler error for event %s: %v", event.Type, err)
+				errs = append(errs, err)
+			}
+		}
+
+		for _, handler := range onceHandlers {
+			if err := handler.Handle(ctx, event); err != nil {
+				eb.logger.Errorf("Once handler error for event %s: %v", event.Type, err)
+				errs = append(errs, err)
+				continue
+			}
+			eb.removeHandler(eventType, handler, true)
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+// Unsubscribe removes a handler for a specific event type
+func (eb *TransportEventBus) Unsubscribe(eventType string, handler Handler) error {
+	if eb.removeHandler(eventType, handler, false) {
+		return nil
+	}
+	return fmt.Errorf("handler not found for event type: %s", eventType)
+}
+
+// removeHandler removes the handler and stops the subscription after the last one
+func (eb *TransportEventBus) removeHandler(eventType string, handler Handler, once bool) bool {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	registry := eb.handlers
+	if once {
+		registry = eb.onceHandlers
+	}
+
+	handlers := registry[eventType]
+	found := false
+	for i, h := range handlers {
+		if fmt.Sprintf("%p", h) == fmt.Sprintf("%p", handler) {
+			registry[eventType] = append(handlers[:i:i], handlers[i+1:]...)
+			found = true
+			break
+		}
+	}
+	if !found {
+		return false
+	}
+
+	if len(eb.handlers[eventType])+len(eb.onceHandlers[eventType]) == 0 {
+		delete(eb.handlers, eventType)
+		delete(eb.onceHandlers, eventType)
+		if cancel, exists := eb.cancels[eventType]; exists {
+			cancel()
+			delete(eb.cancels, eventType)
+		}
+	}
+	return true
+}
+
+// Publish stores the event in the transport
+func (eb *TransportEventBus) Publish(ctx context.Context, event *Event) error {
+	eb.mu.Lock()
+	closed := eb.closed
+	eb.mu.Unlock()
+
+	if closed {
+		eb.logger.Warnf("❌ Event bus is closed, cannot publish event: %s", event.Type)
+		return fmt.Errorf("event bus is closed")
+	}
+
+	return eb.transport.Send(ctx, eb.topic(event.Type), event)
+}
+
+// PublishAsync stores the event in the transport. Handlers always run asynchronously
+// on a transport bus, so this is the same as Publish.
+func (eb *TransportEventBus) PublishAsync(ctx context.Context, event *Event) error {
+	return eb.Publish(ctx, event)
+}
+
+// Replay delivers a stored event again, if the transport supports it
+func (eb *TransportEventBus) Replay(ctx context.Context, eventType, eventID string) error {
+	replayer, ok := eb.transport.(Replayer)
+	if !ok {
+		return fmt.Errorf("transport does not support replay")
+	}
+	return replayer.Replay(ctx, eb.topic(eventType), eventID)
+}
+
+// Close stops all subscriptions of the bus
+func (eb *TransportEventBus) Close() error {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	if eb.closed {
+		return fmt.Errorf("event bus already closed")
+	}
+
+	eb.closed = true
+	for _, cancel := range eb.cancels {
+		cancel()
+	}
+	eb.cancels = make(map[string]context.CancelFunc)
+	eb.handlers = make(map[string][]Handler)
+	eb.onceHandlers = make(map[string][]Handler)
+	eb.logger.Info("Event bus closed")
+
+	return nil
+}
+
+// GetEventTypes returns all event types that have subscribers
+func (eb *TransportEventBus) GetEventTypes() []string {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	result := make([]string, 0, len(eb.cancels))
+	for eventType := range eb.cancels {
+		result = append(result, eventType)
+	}
+	sort.Strings(result)
+
+	return result
+}
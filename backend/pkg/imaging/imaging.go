@@ -0,0 +1,283 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// Fit 缩放模式
+type Fit string
+
+const (
+	FitCover   Fit = "cover"   // 等比缩放后居中裁剪，填满目标尺寸
+	FitContain Fit = "contain" // 等比缩放，完整放入目标尺寸内
+	FitFill    Fit = "fill"    // 拉伸到目标尺寸，不保持比例
+)
+
+// Format 输出格式
+type Format string
+
+const (
+	FormatJPEG Format = "jpeg"
+	FormatPNG  Format = "png"
+	FormatGIF  Format = "gif"
+	FormatWEBP Format = "webp"
+)
+
+const (
+	// DefaultQuality JPEG 默认编码质量
+	DefaultQuality = 85
+
+	// DefaultMaxSourcePixels 允许解码的原图最大像素数，防止解压炸弹
+	DefaultMaxSourcePixels = 50_000_000
+)
+
+var (
+	ErrUnsupportedFit    = errors.New("unsupported fit mode")
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrInvalidSize       = errors.New("invalid image size")
+	ErrSourceTooLarge    = errors.New("source image too large")
+)
+
+// ParseFit 解析缩放模式，空字符串视为 cover
+func ParseFit(s string) (Fit, error) {
+	switch Fit(strings.ToLower(strings.TrimSpace(s))) {
+	case "", FitCover:
+		return FitCover, nil
+	case FitContain:
+		return FitContain, nil
+	case FitFill:
+		return FitFill, nil
+	default:
+		return "", ErrUnsupportedFit
+	}
+}
+
+// ParseFormat 解析输出格式，支持 jpg/jpeg/png/gif/webp
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(strings.TrimPrefix(strings.TrimSpace(s), ".")) {
+	case "jpg", "jpeg":
+		return FormatJPEG, nil
+	case "png":
+		return FormatPNG, nil
+	case "gif":
+		return FormatGIF, nil
+	case "webp":
+		return FormatWEBP, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// ContentType 返回格式对应的 MIME 类型
+func (f Format) ContentType() string {
+	return "image/" + string(f)
+}
+
+// Extension 返回格式对应的文件扩展名（不含点）
+func (f Format) Extension() string {
+	if f == FormatJPEG {
+		return "jpg"
+	}
+	return string(f)
+}
+
+// Size 目标尺寸，宽或高为 0 表示按原图比例自动计算
+type Size struct {
+	Width  int
+	Height int
+}
+
+// ParseSize 解析 "宽x高" 形式的尺寸，如 "128x128"、"256x0"
+func ParseSize(s string) (Size, error) {
+	w, h, ok := strings.Cut(strings.ToLower(strings.TrimSpace(s)), "x")
+	if !ok {
+		return Size{}, ErrInvalidSize
+	}
+
+	width, err := strconv.Atoi(w)
+	if err != nil {
+		return Size{}, ErrInvalidSize
+	}
+	height, err := strconv.Atoi(h)
+	if err != nil {
+		return Size{}, ErrInvalidSize
+	}
+
+	sz := Size{Width: width, Height: height}
+	if !sz.Valid() {
+		return Size{}, ErrInvalidSize
+	}
+	return sz, nil
+}
+
+// Valid 宽高均非负且至少有一个大于 0
+func (s Size) Valid() bool {
+	return s.Width >= 0 && s.Height >= 0 && (s.Width > 0 || s.Height > 0)
+}
+
+func (s Size) String() string {
+	return fmt.Sprintf("%dx%d", s.Width, s.Height)
+}
+
+// SizeWhitelist 允许生成的尺寸白名单
+type SizeWhitelist map[Size]struct{}
+
+// NewSizeWhitelist 由 "宽x高" 列表创建白名单，无法解析的项会被忽略
+func NewSizeWhitelist(specs []string) SizeWhitelist {
+	wl := make(SizeWhitelist, len(specs))
+	for _, spec := range specs {
+		if sz, err := ParseSize(spec); err == nil {
+			wl[sz] = struct{}{}
+		}
+	}
+	return wl
+}
+
+// Allows 判断尺寸是否在白名单内
+func (wl SizeWhitelist) Allows(sz Size) bool {
+	_, ok := wl[sz]
+	return ok
+}
+
+// Options 变换参数
+type Options struct {
+	Size    Size
+	Fit     Fit
+	Format  Format
+	Quality int // 仅对 JPEG 有效，0 表示使用默认值
+
+	MaxSourcePixels int // 0 表示使用默认值
+}
+
+// Transform 解码原图，按参数缩放并编码为目标格式
+func Transform(src []byte, opts Options) ([]byte, error) {
+	if !opts.Size.Valid() {
+		return nil, ErrInvalidSize
+	}
+
+	maxPixels := opts.MaxSourcePixels
+	if maxPixels <= 0 {
+		maxPixels = DefaultMaxSourcePixels
+	}
+
+	// 先读取图片头，避免为超大图片分配内存
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(src))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, ErrInvalidSize
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > int64(maxPixels) {
+		return nil, ErrSourceTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(src))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+
+	dst := Resize(img, opts.Size, opts.Fit)
+
+	var buf bytes.Buffer
+	if err = Encode(&buf, dst, opts.Format, opts.Quality); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Resize 按缩放模式将图片缩放到目标尺寸
+func Resize(img image.Image, size Size, fit Fit) image.Image {
+	sb := img.Bounds()
+	sw, sh := sb.Dx(), sb.Dy()
+
+	tw, th := targetSize(sw, sh, size)
+
+	switch fit {
+	case FitFill:
+		return scale(img, sb, tw, th)
+
+	case FitContain:
+		// 按较小的缩放比例等比缩放，结果不超过目标尺寸
+		if sw*th > sh*tw {
+			th = max(1, sh*tw/sw)
+		} else {
+			tw = max(1, sw*th/sh)
+		}
+		return scale(img, sb, tw, th)
+
+	default:
+		// 从原图中截取与目标宽高比一致的居中区域，再缩放
+		crop := sb
+		if sw*th > sh*tw {
+			cw := max(1, sh*tw/th)
+			crop.Min.X += (sw - cw) / 2
+			crop.Max.X = crop.Min.X + cw
+		} else {
+			ch := max(1, sw*th/tw)
+			crop.Min.Y += (sh - ch) / 2
+			crop.Max.Y = crop.Min.Y + ch
+		}
+		return scale(img, crop, tw, th)
+	}
+}
+
+// targetSize 计算最终输出尺寸，宽或高为 0 时按原图比例推算
+func targetSize(sw, sh int, size Size) (int, int) {
+	tw, th := size.Width, size.Height
+	switch {
+	case tw == 0:
+		tw = max(1, sw*th/sh)
+	case th == 0:
+		th = max(1, sh*tw/sw)
+	}
+	return tw, th
+}
+
+func scale(img image.Image, sr image.Rectangle, w, h int) image.Image {
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, sr, draw.Src, nil)
+	return dst
+}
+
+// Encode 将图片编码为指定格式
+func Encode(w io.Writer, img image.Image, format Format, quality int) error {
+	switch format {
+	case FormatJPEG:
+		if quality <= 0 || quality > 100 {
+			quality = DefaultQuality
+		}
+		return jpeg.Encode(w, flatten(img), &jpeg.Options{Quality: quality})
+	case FormatPNG:
+		return png.Encode(w, img)
+	case FormatGIF:
+		return gif.Encode(w, img, nil)
+	case FormatWEBP:
+		return nativewebp.Encode(w, img, nil)
+	default:
+		return ErrUnsupportedFormat
+	}
+}
+
+// flatten 将透明区域合成到白色背景上，JPEG 不支持透明通道
+func flatten(img image.Image) image.Image {
+	b := img.Bounds()
+	dst := image.NewRGBA(b)
+	draw.Draw(dst, b, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, b, img, b.Min, draw.Over)
+	return dst
+}
@@ -0,0 +1,85 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestImage(t *testing.T, w, h int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	assert.Nil(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestParseSize(t *testing.T) {
+	sz, err := ParseSize("128x64")
+	assert.Nil(t, err)
+	assert.Equal(t, Size{Width: 128, Height: 64}, sz)
+
+	sz, err = ParseSize("256X0")
+	assert.Nil(t, err)
+	assert.Equal(t, Size{Width: 256}, sz)
+
+	for _, s := range []string{"", "128", "0x0", "-1x10", "axb"} {
+		_, err = ParseSize(s)
+		assert.ErrorIs(t, err, ErrInvalidSize, s)
+	}
+}
+
+func TestSizeWhitelist(t *testing.T) {
+	wl := NewSizeWhitelist([]string{"64x64", "128x128", "bad"})
+	assert.Len(t, wl, 2)
+	assert.True(t, wl.Allows(Size{Width: 64, Height: 64}))
+	assert.False(t, wl.Allows(Size{Width: 65, Height: 64}))
+}
+
+func TestResize(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 400, 200))
+
+	assert.Equal(t, image.Rect(0, 0, 100, 100), Resize(src, Size{Width: 100, Height: 100}, FitCover).Bounds())
+	assert.Equal(t, image.Rect(0, 0, 100, 50), Resize(src, Size{Width: 100, Height: 100}, FitContain).Bounds())
+	assert.Equal(t, image.Rect(0, 0, 100, 100), Resize(src, Size{Width: 100, Height: 100}, FitFill).Bounds())
+	assert.Equal(t, image.Rect(0, 0, 100, 50), Resize(src, Size{Width: 100}, FitCover).Bounds())
+	assert.Equal(t, image.Rect(0, 0, 40, 20), Resize(src, Size{Height: 20}, FitFill).Bounds())
+}
+
+func TestTransform(t *testing.T) {
+	src := createTestImage(t, 300, 200)
+
+	for _, format := range []Format{FormatJPEG, FormatPNG, FormatGIF, FormatWEBP} {
+		out, err := Transform(src, Options{
+			Size:   Size{Width: 64, Height: 64},
+			Fit:    FitCover,
+			Format: format,
+		})
+		assert.Nil(t, err, format)
+
+		cfg, name, err := image.DecodeConfig(bytes.NewReader(out))
+		assert.Nil(t, err, format)
+		assert.Equal(t, string(format), name)
+		assert.Equal(t, 64, cfg.Width)
+		assert.Equal(t, 64, cfg.Height)
+	}
+
+	_, err := Transform(src, Options{
+		Size:            Size{Width: 64, Height: 64},
+		Format:          FormatPNG,
+		MaxSourcePixels: 100,
+	})
+	assert.ErrorIs(t, err, ErrSourceTooLarge)
+
+	_, err = Transform([]byte("not an image"), Options{Size: Size{Width: 64, Height: 64}, Format: FormatPNG})
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
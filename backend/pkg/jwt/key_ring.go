@@ -0,0 +1,184 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// DefaultKeyRingReloadInterval 默认密钥环重新加载间隔，多实例部署时其他实例轮换的密钥在该间隔内生效
+	DefaultKeyRingReloadInterval = time.Minute
+
+	// minKeyRingMissReloadInterval 遇到未知 kid 时重新加载的最小间隔，防止伪造 kid 击穿存储
+	minKeyRingMissReloadInterval = 5 * time.Second
+)
+
+var (
+	ErrNoSigningKey  = errors.New("no active signing key")
+	ErrUnknownKeyID  = errors.New("unknown signing key id")
+	ErrKeyIDMismatch = errors.New("signing method does not match key")
+)
+
+// KeyStore 签名密钥存储
+type KeyStore interface {
+	// LoadSigningKeys 加载仍在有效期内的签名密钥
+	LoadSigningKeys(ctx context.Context) ([]*SigningKey, error)
+}
+
+// KeyRing 签名密钥环。当前密钥负责签名，退役的密钥在宽限期内仍可验签，便于无缝轮换。
+type KeyRing struct {
+	store          KeyStore
+	reloadInterval time.Duration
+
+	mu           sync.RWMutex
+	keys         map[string]*SigningKey
+	active       *SigningKey
+	loadedAt     time.Time
+	missReloadAt time.Time
+}
+
+func NewKeyRing(store KeyStore, reloadInterval time.Duration) *KeyRing {
+	if reloadInterval <= 0 {
+		reloadInterval = DefaultKeyRingReloadInterval
+	}
+	return &KeyRing{
+		store:          store,
+		reloadInterval: reloadInterval,
+		keys:           map[string]*SigningKey{},
+	}
+}
+
+// Reload 从存储重新加载密钥
+func (r *KeyRing) Reload(ctx context.Context) error {
+	keys, err := r.store.LoadSigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.setKeys(keys)
+
+	return nil
+}
+
+// SigningKey 获取当前签名密钥
+func (r *KeyRing) SigningKey() (*SigningKey, error) {
+	r.reloadIfStale()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.active == nil || !r.active.IsValidAt(time.Now()) {
+		return nil, ErrNoSigningKey
+	}
+	return r.active, nil
+}
+
+// VerificationKey 按 kid 获取验签密钥，退役密钥超过宽限期后不再可用
+func (r *KeyRing) VerificationKey(kid string) (*SigningKey, error) {
+	r.reloadIfStale()
+
+	key := r.lookup(kid)
+	if key == nil && r.allowMissReload() {
+		// 其他实例可能刚刚轮换了密钥
+		if err := r.Reload(context.Background()); err != nil {
+			log.Errorf("reload jwt key ring failed: %v", err)
+		}
+		key = r.lookup(kid)
+	}
+	if key == nil || !key.IsValidAt(time.Now()) {
+		return nil, ErrUnknownKeyID
+	}
+
+	return key, nil
+}
+
+// KeySet 获取仍可验签的公钥集合，当前签名密钥排在最前
+func (r *KeyRing) KeySet() *JSONWebKeySet {
+	r.reloadIfStale()
+
+	r.mu.RLock()
+	keys := make([]*SigningKey, 0, len(r.keys))
+	now := time.Now()
+	for _, key := range r.keys {
+		if key.IsValidAt(now) {
+			keys = append(keys, key)
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Active != keys[j].Active {
+			return keys[i].Active
+		}
+		return keys[i].Kid < keys[j].Kid
+	})
+
+	set := &JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(keys))}
+	for _, key := range keys {
+		jwk, err := NewJSONWebKey(key.Algorithm, key.Kid, key.PublicKey)
+		if err != nil {
+			log.Errorf("convert signing key [%s] to jwk failed: %v", key.Kid, err)
+			continue
+		}
+		set.Keys = append(set.Keys, *jwk)
+	}
+
+	return set
+}
+
+func (r *KeyRing) setKeys(keys []*SigningKey) {
+	m := make(map[string]*SigningKey, len(keys))
+	var active *SigningKey
+	for _, key := range keys {
+		m[key.Kid] = key
+		if key.Active && key.PrivateKey != nil && active == nil {
+			active = key
+		}
+	}
+
+	r.mu.Lock()
+	r.keys = m
+	r.active = active
+	r.loadedAt = time.Now()
+	r.mu.Unlock()
+}
+
+func (r *KeyRing) lookup(kid string) *SigningKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.keys[kid]
+}
+
+func (r *KeyRing) reloadIfStale() {
+	r.mu.RLock()
+	stale := time.Since(r.loadedAt) >= r.reloadInterval
+	r.mu.RUnlock()
+	if !stale {
+		return
+	}
+
+	// 加载失败时继续使用已有的密钥，等到下一个间隔再重试
+	if err := r.Reload(context.Background()); err != nil {
+		log.Errorf("reload jwt key ring failed: %v", err)
+
+		r.mu.Lock()
+		r.loadedAt = time.Now()
+		r.mu.Unlock()
+	}
+}
+
+func (r *KeyRing) allowMissReload() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.missReloadAt) < minKeyRingMissReloadInterval {
+		return false
+	}
+	r.missReloadAt = time.Now()
+	return true
+}
@@ -0,0 +1,157 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	authn "github.com/tx7do/kratos-authn/engine"
+)
+
+// HeaderKeyID JWT 头部中的密钥ID
+const HeaderKeyID = "kid"
+
+var _ authn.Authenticator = (*KeyRingAuthenticator)(nil)
+
+// KeyRingAuthenticator 基于密钥环的非对称签名认证器。
+// 签名时使用当前密钥并写入 kid 头部，验签时按 kid 查找仍在宽限期内的密钥。
+// 配置了旧的 HMAC 密钥时，不带 kid 的旧令牌在截止时间之前仍可通过验签，便于从共享密钥平滑迁移。
+type KeyRingAuthenticator struct {
+	ring *KeyRing
+
+	legacyMethod jwt.SigningMethod
+	legacyKey    []byte
+	legacyUntil  time.Time
+}
+
+func NewKeyRingAuthenticator(ring *KeyRing, opts ...KeyRingAuthenticatorOption) *KeyRingAuthenticator {
+	a := &KeyRingAuthenticator{ring: ring}
+	for _, o := range opts {
+		o(a)
+	}
+	return a
+}
+
+type KeyRingAuthenticatorOption func(*KeyRingAuthenticator)
+
+// WithLegacyHMACKey 在 until 之前继续接受迁移前使用共享密钥签发的令牌，过了截止时间后一律拒绝
+func WithLegacyHMACKey(method string, key []byte, until time.Time) KeyRingAuthenticatorOption {
+	return func(a *KeyRingAuthenticator) {
+		m, ok := jwt.GetSigningMethod(method).(*jwt.SigningMethodHMAC)
+		if !ok || len(key) == 0 || until.IsZero() {
+			return
+		}
+		a.legacyMethod = m
+		a.legacyKey = key
+		a.legacyUntil = until
+	}
+}
+
+// Authenticate 从请求上下文中读取令牌并验签
+func (a *KeyRingAuthenticator) Authenticate(ctx context.Context, contextType authn.ContextType) (*authn.AuthClaims, error) {
+	tokenString, err := authn.AuthFromMD(ctx, authn.BearerWord, contextType)
+	if err != nil {
+		return nil, authn.ErrMissingBearerToken
+	}
+
+	return a.AuthenticateToken(tokenString)
+}
+
+// AuthenticateToken 验签并返回令牌声明
+func (a *KeyRingAuthenticator) AuthenticateToken(tokenString string) (*authn.AuthClaims, error) {
+	validMethods := []string{AlgorithmRS256, AlgorithmES256}
+	if a.legacyAccepted() {
+		validMethods = append(validMethods, a.legacyMethod.Alg())
+	}
+
+	jwtToken, err := jwt.Parse(tokenString, a.keyFunc, jwt.WithValidMethods(validMethods))
+	if err != nil {
+		switch {
+		case errors.Is(err, jwt.ErrTokenMalformed):
+			return nil, authn.ErrInvalidToken
+		case errors.Is(err, jwt.ErrTokenSignatureInvalid):
+			return nil, authn.ErrSignTokenFailed
+		case errors.Is(err, jwt.ErrTokenExpired) || errors.Is(err, jwt.ErrTokenNotValidYet):
+			return nil, authn.ErrTokenExpired
+		case errors.Is(err, jwt.ErrTokenUnverifiable):
+			return nil, authn.ErrUnsupportedSigningMethod
+		default:
+			return nil, authn.ErrInvalidToken
+		}
+	}
+	if jwtToken == nil || !jwtToken.Valid {
+		return nil, authn.ErrInvalidToken
+	}
+
+	claims, ok := jwtToken.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, authn.ErrInvalidClaims
+	}
+
+	authClaims := authn.AuthClaims(claims)
+
+	return &authClaims, nil
+}
+
+// CreateIdentityWithContext 签发令牌并写入上下文
+func (a *KeyRingAuthenticator) CreateIdentityWithContext(ctx context.Context, contextType authn.ContextType, claims authn.AuthClaims) (context.Context, error) {
+	strToken, err := a.CreateIdentity(claims)
+	if err != nil {
+		return ctx, err
+	}
+
+	return authn.MDWithAuth(ctx, authn.BearerWord, strToken, contextType), nil
+}
+
+// CreateIdentity 使用当前签名密钥签发令牌
+func (a *KeyRingAuthenticator) CreateIdentity(claims authn.AuthClaims) (string, error) {
+	key, err := a.ring.SigningKey()
+	if err != nil {
+		return "", authn.ErrGetKeyFailed
+	}
+
+	method, err := key.SigningMethod()
+	if err != nil {
+		return "", authn.ErrUnsupportedSigningMethod
+	}
+
+	jwtToken := jwt.NewWithClaims(method, &claims)
+	jwtToken.Header[HeaderKeyID] = key.Kid
+
+	strToken, err := jwtToken.SignedString(key.PrivateKey)
+	if err != nil {
+		return "", authn.ErrSignTokenFailed
+	}
+
+	return strToken, nil
+}
+
+func (a *KeyRingAuthenticator) Close() {}
+
+// keyFunc 按 kid 选择验签密钥，签名方法必须与密钥一致
+func (a *KeyRingAuthenticator) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header[HeaderKeyID].(string)
+	if kid == "" {
+		if a.legacyAccepted() && token.Method == a.legacyMethod {
+			return a.legacyKey, nil
+		}
+		return nil, ErrUnknownKeyID
+	}
+
+	key, err := a.ring.VerificationKey(kid)
+	if err != nil {
+		return nil, err
+	}
+	if token.Method.Alg() != key.Algorithm {
+		return nil, ErrKeyIDMismatch
+	}
+
+	return key.PublicKey, nil
+}
+
+// legacyAccepted 是否仍接受共享密钥签发的旧令牌
+func (a *KeyRingAuthenticator) legacyAccepted() bool {
+	return a.legacyMethod != nil && time.Now().Before(a.legacyUntil)
+}
@@ -0,0 +1,141 @@
+package jwt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	authn "github.com/tx7do/kratos-authn/engine"
+)
+
+type memoryKeyStore struct {
+	keys []*SigningKey
+}
+
+func (s *memoryKeyStore) LoadSigningKeys(_ context.Context) ([]*SigningKey, error) {
+	return s.keys, nil
+}
+
+func TestSigningKeyPEMRoundTrip(t *testing.T) {
+	for _, alg := range []string{AlgorithmRS256, AlgorithmES256} {
+		key, err := GenerateSigningKey(alg)
+		assert.NoError(t, err)
+
+		privPEM, err := key.MarshalPrivateKeyPEM()
+		assert.NoError(t, err)
+		pubPEM, err := key.MarshalPublicKeyPEM()
+		assert.NoError(t, err)
+
+		parsed, err := ParseSigningKey(alg, privPEM, pubPEM)
+		assert.NoError(t, err)
+		assert.Equal(t, key.Kid, parsed.Kid, alg)
+		assert.NotNil(t, parsed.PrivateKey)
+
+		// 只有公钥时也能得到相同的 kid
+		public, err := ParseSigningKey(alg, "", pubPEM)
+		assert.NoError(t, err)
+		assert.Equal(t, key.Kid, public.Kid, alg)
+		assert.Nil(t, public.PrivateKey)
+	}
+
+	_, err := GenerateSigningKey("HS256")
+	assert.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+}
+
+func TestKeyRingRotation(t *testing.T) {
+	oldKey, err := GenerateSigningKey(AlgorithmRS256)
+	assert.NoError(t, err)
+	oldKey.Active = true
+
+	store := &memoryKeyStore{keys: []*SigningKey{oldKey}}
+	ring := NewKeyRing(store, time.Hour)
+	assert.NoError(t, ring.Reload(context.Background()))
+
+	a := NewKeyRingAuthenticator(ring)
+
+	oldToken, err := a.CreateIdentity(authn.AuthClaims{"sub": "alice"})
+	assert.NoError(t, err)
+
+	// 轮换：新密钥签名，旧密钥进入宽限期
+	newKey, err := GenerateSigningKey(AlgorithmES256)
+	assert.NoError(t, err)
+	newKey.Active = true
+	oldKey.Active = false
+	grace := time.Now().Add(time.Hour)
+	oldKey.ExpiresAt = &grace
+	store.keys = []*SigningKey{oldKey, newKey}
+	assert.NoError(t, ring.Reload(context.Background()))
+
+	newToken, err := a.CreateIdentity(authn.AuthClaims{"sub": "bob"})
+	assert.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, jwt.MapClaims{})
+	assert.NoError(t, err)
+	assert.Equal(t, newKey.Kid, parsed.Header[HeaderKeyID])
+	assert.Equal(t, AlgorithmES256, parsed.Method.Alg())
+
+	claims, err := a.AuthenticateToken(oldToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", (*claims)["sub"])
+
+	claims, err = a.AuthenticateToken(newToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", (*claims)["sub"])
+
+	set := ring.KeySet()
+	assert.Len(t, set.Keys, 2)
+	assert.Equal(t, newKey.Kid, set.Keys[0].Kid)
+	assert.Equal(t, "EC", set.Keys[0].Kty)
+	assert.Equal(t, "RSA", set.Keys[1].Kty)
+
+	// 宽限期结束后旧令牌失效，也不再发布旧公钥
+	expired := time.Now().Add(-time.Second)
+	oldKey.ExpiresAt = &expired
+	assert.NoError(t, ring.Reload(context.Background()))
+
+	_, err = a.AuthenticateToken(oldToken)
+	assert.Error(t, err)
+	assert.Len(t, ring.KeySet().Keys, 1)
+}
+
+func TestKeyRingAuthenticatorLegacyHMAC(t *testing.T) {
+	key, err := GenerateSigningKey(AlgorithmRS256)
+	assert.NoError(t, err)
+	key.Active = true
+
+	ring := NewKeyRing(&memoryKeyStore{keys: []*SigningKey{key}}, time.Hour)
+	assert.NoError(t, ring.Reload(context.Background()))
+
+	legacyToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "legacy"}).
+		SignedString([]byte("some_api_key"))
+	assert.NoError(t, err)
+
+	// 未配置旧密钥时拒绝不带 kid 的令牌
+	_, err = NewKeyRingAuthenticator(ring).AuthenticateToken(legacyToken)
+	assert.Error(t, err)
+
+	// 未配置截止时间时不接受旧令牌
+	_, err = NewKeyRingAuthenticator(ring, WithLegacyHMACKey("HS256", []byte("some_api_key"), time.Time{})).AuthenticateToken(legacyToken)
+	assert.Error(t, err)
+
+	// 过了截止时间后拒绝旧令牌
+	_, err = NewKeyRingAuthenticator(ring, WithLegacyHMACKey("HS256", []byte("some_api_key"), time.Now().Add(-time.Second))).AuthenticateToken(legacyToken)
+	assert.Error(t, err)
+
+	a := NewKeyRingAuthenticator(ring, WithLegacyHMACKey("HS256", []byte("some_api_key"), time.Now().Add(time.Hour)))
+	claims, err := a.AuthenticateToken(legacyToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "legacy", (*claims)["sub"])
+
+	// 伪造 kid 并用公钥当作 HMAC 密钥签名的令牌必须被拒绝
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "mallory"})
+	forged.Header[HeaderKeyID] = key.Kid
+	pubPEM, err := key.MarshalPublicKeyPEM()
+	assert.NoError(t, err)
+	forgedToken, err := forged.SignedString([]byte(pubPEM))
+	assert.NoError(t, err)
+	_, err = a.AuthenticateToken(forgedToken)
+	assert.Error(t, err)
+}
@@ -0,0 +1,237 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgorithmRS256 = "RS256" // RSA 2048 + SHA-256
+	AlgorithmES256 = "ES256" // ECDSA P-256 + SHA-256
+
+	rsaKeyBits = 2048
+)
+
+var ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+
+// IsAsymmetricAlgorithm 是否为密钥环支持的非对称签名算法
+func IsAsymmetricAlgorithm(alg string) bool {
+	return alg == AlgorithmRS256 || alg == AlgorithmES256
+}
+
+// SigningKey 非对称签名密钥
+type SigningKey struct {
+	Kid        string           // 密钥ID，即公钥的 JWK 指纹（RFC 7638）
+	Algorithm  string           // 签名算法
+	PrivateKey crypto.Signer    // 私钥，只用于签名
+	PublicKey  crypto.PublicKey // 公钥，用于验签和发布 JWKS
+	Active     bool             // 是否为当前签名密钥
+	ExpiresAt  *time.Time       // 退役后宽限期的结束时间，为空表示不过期
+}
+
+// IsValidAt 密钥在指定时间是否仍可用于验签
+func (k *SigningKey) IsValidAt(t time.Time) bool {
+	return k.ExpiresAt == nil || t.Before(*k.ExpiresAt)
+}
+
+// SigningMethod 获取密钥对应的签名方法
+func (k *SigningKey) SigningMethod() (jwt.SigningMethod, error) {
+	switch k.Algorithm {
+	case AlgorithmRS256:
+		return jwt.SigningMethodRS256, nil
+	case AlgorithmES256:
+		return jwt.SigningMethodES256, nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+// GenerateSigningKey 生成新的签名密钥
+func GenerateSigningKey(alg string) (*SigningKey, error) {
+	var (
+		priv crypto.Signer
+		err  error
+	)
+	switch alg {
+	case AlgorithmRS256:
+		priv, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case AlgorithmES256:
+		priv, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newSigningKey(alg, priv, priv.Public())
+}
+
+// ParseSigningKey 从 PEM 格式的私钥和公钥还原签名密钥，私钥为空时只能用于验签
+func ParseSigningKey(alg, privateKeyPEM, publicKeyPEM string) (*SigningKey, error) {
+	var priv crypto.Signer
+	if privateKeyPEM != "" {
+		block, _ := pem.Decode([]byte(privateKeyPEM))
+		if block == nil {
+			return nil, errors.New("invalid private key pem")
+		}
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if priv, ok = key.(crypto.Signer); !ok {
+			return nil, errors.New("private key is not a signer")
+		}
+	}
+
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return nil, errors.New("invalid public key pem")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSigningKey(alg, priv, pub)
+}
+
+func newSigningKey(alg string, priv crypto.Signer, pub crypto.PublicKey) (*SigningKey, error) {
+	jwk, err := NewJSONWebKey(alg, "", pub)
+	if err != nil {
+		return nil, err
+	}
+
+	kid, err := jwk.Thumbprint()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SigningKey{
+		Kid:        kid,
+		Algorithm:  alg,
+		PrivateKey: priv,
+		PublicKey:  pub,
+	}, nil
+}
+
+// MarshalPrivateKeyPEM 将私钥编码为 PKCS#8 PEM
+func (k *SigningKey) MarshalPrivateKeyPEM() (string, error) {
+	if k.PrivateKey == nil {
+		return "", errors.New("missing private key")
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(k.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// MarshalPublicKeyPEM 将公钥编码为 PKIX PEM
+func (k *SigningKey) MarshalPublicKeyPEM() (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(k.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+// JSONWebKey JWKS 中的公钥（RFC 7517）
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+
+	// RSA 公钥
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// EC 公钥
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JSONWebKeySet JWKS
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// NewJSONWebKey 将公钥转换为 JWK
+func NewJSONWebKey(alg, kid string, pub crypto.PublicKey) (*JSONWebKey, error) {
+	jwk := &JSONWebKey{Kid: kid, Use: "sig", Alg: alg}
+
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("unsupported curve %s", key.Curve.Params().Name)
+		}
+		ecdhKey, err := key.ECDH()
+		if err != nil {
+			return nil, err
+		}
+		// 未压缩格式：0x04 || X || Y
+		raw := ecdhKey.Bytes()
+		size := (len(raw) - 1) / 2
+		jwk.Kty = "EC"
+		jwk.Crv = "P-256"
+		jwk.X = base64.RawURLEncoding.EncodeToString(raw[1 : 1+size])
+		jwk.Y = base64.RawURLEncoding.EncodeToString(raw[1+size:])
+
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	return jwk, nil
+}
+
+// Thumbprint 计算 JWK 指纹（RFC 7638），只包含必需字段且按字典序排列
+func (k *JSONWebKey) Thumbprint() (string, error) {
+	var members any
+	switch k.Kty {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{k.E, k.Kty, k.N}
+	case "EC":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}{k.Crv, k.Kty, k.X, k.Y}
+	default:
+		return "", ErrUnsupportedAlgorithm
+	}
+
+	buf, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(buf)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
@@ -23,6 +23,15 @@ const (
 	ClaimFieldRoleCodes = "roc"                   // 角色码列表
 	ClaimFieldDataScope = "ds"                    // 数据范围
 	ClaimFieldOrgUnitID = "ouid"                  // 组织单元 ID
+
+	ClaimFieldSubjectType = "st"  // 主体类型
+	ClaimFieldScopes      = "scp" // 客户端授权范围
+	ClaimFieldSessionID   = "sid" // 会话 ID
+	ClaimFieldActor       = "act" // 实际操作者（RFC 8693）
+	ClaimFieldReadOnly    = "ro"  // 只读令牌
+	ClaimFieldPwdExpired  = "pwe" // 密码已过期
+
+	ClaimFieldClientSecretVersion = "csv" // 客户端密钥版本
 )
 
 const (
@@ -59,6 +68,27 @@ func NewUserTokenPayload(
 	}
 }
 
+// NewClientTokenPayload 创建客户端令牌，主体为客户端ID，权限由授权范围决定，数据权限不超过创建客户端的操作人
+func NewClientTokenPayload(
+	clientID string,
+	tenantID uint32,
+	scopes []string,
+	dataScope *permissionV1.DataScope,
+	orgUnitID *uint32,
+	secretVersion uint32,
+) *authenticationV1.UserTokenPayload {
+	return &authenticationV1.UserTokenPayload{
+		Username:            trans.Ptr(clientID),
+		TenantId:            trans.Ptr(tenantID),
+		ClientId:            trans.Ptr(clientID),
+		SubjectType:         authenticationV1.UserTokenPayload_CLIENT.Enum(),
+		Scopes:              scopes,
+		DataScope:           dataScope,
+		OrgUnitId:           orgUnitID,
+		ClientSecretVersion: trans.Ptr(secretVersion),
+	}
+}
+
 // NewUserTokenAuthClaims 创建用户令牌认证声明
 func NewUserTokenAuthClaims(
 	tokenPayload *authenticationV1.UserTokenPayload,
@@ -84,6 +114,9 @@ func NewUserTokenAuthClaims(
 	if tokenPayload.ClientId != nil {
 		authClaims[ClaimFieldClientID] = tokenPayload.GetClientId()
 	}
+	if tokenPayload.SessionId != nil {
+		authClaims[ClaimFieldSessionID] = tokenPayload.GetSessionId()
+	}
 
 	if tokenPayload.DataScope != nil {
 		authClaims[ClaimFieldDataScope] = tokenPayload.GetDataScope().String()
@@ -92,6 +125,36 @@ func NewUserTokenAuthClaims(
 		authClaims[ClaimFieldOrgUnitID] = tokenPayload.GetOrgUnitId()
 	}
 
+	// 用户令牌省略主体类型，保持与旧令牌一致
+	if tokenPayload.GetSubjectType() != authenticationV1.UserTokenPayload_USER {
+		authClaims[ClaimFieldSubjectType] = tokenPayload.GetSubjectType().String()
+	}
+	if len(tokenPayload.Scopes) > 0 {
+		authClaims[ClaimFieldScopes] = tokenPayload.Scopes
+	}
+	if tokenPayload.ClientSecretVersion != nil {
+		authClaims[ClaimFieldClientSecretVersion] = tokenPayload.GetClientSecretVersion()
+	}
+
+	if tokenPayload.Actor != nil {
+		actor := map[string]any{
+			ClaimFieldUserID: tokenPayload.Actor.GetUserId(),
+		}
+		if tokenPayload.Actor.Username != nil {
+			actor[ClaimFieldUserName] = tokenPayload.Actor.GetUsername()
+		}
+		if tokenPayload.Actor.TenantId != nil {
+			actor[ClaimFieldTenantID] = tokenPayload.Actor.GetTenantId()
+		}
+		authClaims[ClaimFieldActor] = actor
+	}
+	if tokenPayload.GetReadOnly() {
+		authClaims[ClaimFieldReadOnly] = true
+	}
+	if tokenPayload.GetPasswordExpired() {
+		authClaims[ClaimFieldPwdExpired] = true
+	}
+
 	return &authClaims
 }
 
@@ -139,6 +202,14 @@ func NewUserTokenPayloadWithClaims(claims *authn.AuthClaims) (*authenticationV1.
 		payload.DeviceId = trans.Ptr(deviceId)
 	}
 
+	sessionId, err := claims.GetString(ClaimFieldSessionID)
+	if err != nil {
+		log.Errorf("GetString ClaimFieldSessionID failed: %v", err)
+	}
+	if sessionId != "" {
+		payload.SessionId = trans.Ptr(sessionId)
+	}
+
 	roleCodes, err := claims.GetStrings(ClaimFieldRoleCodes)
 	if err != nil {
 		log.Errorf("GetStrings ClaimFieldRoleCodes failed: %v", err)
@@ -166,6 +237,41 @@ func NewUserTokenPayloadWithClaims(claims *authn.AuthClaims) (*authenticationV1.
 		payload.OrgUnitId = trans.Ptr(orgUnitID)
 	}
 
+	subjectType, err := claims.GetString(ClaimFieldSubjectType)
+	if err != nil {
+		log.Errorf("GetString ClaimFieldSubjectType failed: %v", err)
+	}
+	if subjectType != "" {
+		v, ok := authenticationV1.UserTokenPayload_SubjectType_value[subjectType]
+		if ok {
+			payload.SubjectType = trans.Ptr(authenticationV1.UserTokenPayload_SubjectType(v))
+		}
+	}
+
+	scopes, err := claims.GetStrings(ClaimFieldScopes)
+	if err != nil {
+		log.Errorf("GetStrings ClaimFieldScopes failed: %v", err)
+	}
+	if scopes != nil {
+		payload.Scopes = scopes
+	}
+
+	secretVersion, err := claims.GetUint32(ClaimFieldClientSecretVersion)
+	if err != nil {
+		log.Errorf("GetUint32 ClaimFieldClientSecretVersion failed: %v", err)
+	}
+	if secretVersion != 0 {
+		payload.ClientSecretVersion = trans.Ptr(secretVersion)
+	}
+
+	payload.Actor = parseTokenActor((*claims)[ClaimFieldActor])
+	if readOnly, ok := (*claims)[ClaimFieldReadOnly].(bool); ok && readOnly {
+		payload.ReadOnly = trans.Ptr(true)
+	}
+	if expired, ok := (*claims)[ClaimFieldPwdExpired].(bool); ok && expired {
+		payload.PasswordExpired = trans.Ptr(true)
+	}
+
 	return payload, nil
 }
 
@@ -201,6 +307,11 @@ func NewUserTokenPayloadWithJwtMapClaims(claims jwt.MapClaims) (*authenticationV
 		payload.DeviceId = trans.Ptr(deviceId.(string))
 	}
 
+	sessionId, _ := claims[ClaimFieldSessionID]
+	if sessionId != nil {
+		payload.SessionId = trans.Ptr(sessionId.(string))
+	}
+
 	dataScope, _ := claims[ClaimFieldDataScope]
 	if dataScope != nil {
 		v, ok := permissionV1.DataScope_value[dataScope.(string)]
@@ -230,9 +341,84 @@ func NewUserTokenPayloadWithJwtMapClaims(claims jwt.MapClaims) (*authenticationV
 		}
 	}
 
+	subjectType, _ := claims[ClaimFieldSubjectType]
+	if subjectType != nil {
+		v, ok := authenticationV1.UserTokenPayload_SubjectType_value[subjectType.(string)]
+		if ok {
+			payload.SubjectType = trans.Ptr(authenticationV1.UserTokenPayload_SubjectType(v))
+		}
+	}
+
+	scopes, _ := claims[ClaimFieldScopes]
+	if scopes != nil {
+		switch itf := scopes.(type) {
+		case []interface{}:
+			for _, sc := range itf {
+				payload.Scopes = append(payload.Scopes, sc.(string))
+			}
+
+		case []string:
+			payload.Scopes = itf
+
+		default:
+			return nil, errors.New("invalid scopes type")
+		}
+	}
+
+	if secretVersion, ok := claimUint32(claims[ClaimFieldClientSecretVersion]); ok && secretVersion != 0 {
+		payload.ClientSecretVersion = trans.Ptr(secretVersion)
+	}
+
+	payload.Actor = parseTokenActor(claims[ClaimFieldActor])
+	if readOnly, ok := claims[ClaimFieldReadOnly].(bool); ok && readOnly {
+		payload.ReadOnly = trans.Ptr(true)
+	}
+	if expired, ok := claims[ClaimFieldPwdExpired].(bool); ok && expired {
+		payload.PasswordExpired = trans.Ptr(true)
+	}
+
 	return payload, nil
 }
 
+// parseTokenActor 解析 act 声明，声明不存在或格式不正确时返回 nil
+func parseTokenActor(v any) *authenticationV1.TokenActor {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	userID, ok := claimUint32(m[ClaimFieldUserID])
+	if !ok || userID == 0 {
+		return nil
+	}
+
+	actor := &authenticationV1.TokenActor{UserId: userID}
+	if username, ok := m[ClaimFieldUserName].(string); ok && username != "" {
+		actor.Username = trans.Ptr(username)
+	}
+	if tenantID, ok := claimUint32(m[ClaimFieldTenantID]); ok {
+		actor.TenantId = trans.Ptr(tenantID)
+	}
+
+	return actor
+}
+
+// claimUint32 读取数字声明，签发时为 uint32，解析 JSON 后为 float64
+func claimUint32(v any) (uint32, bool) {
+	switch n := v.(type) {
+	case uint32:
+		return n, true
+	case float64:
+		return uint32(n), true
+	case int:
+		return uint32(n), true
+	case int64:
+		return uint32(n), true
+	default:
+		return 0, false
+	}
+}
+
 // IsTokenExpired 检查令牌是否过期
 func IsTokenExpired(claims *authn.AuthClaims) bool {
 	if claims == nil {
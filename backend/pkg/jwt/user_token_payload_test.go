@@ -8,6 +8,7 @@ import (
 	"github.com/tx7do/go-utils/trans"
 	authn "github.com/tx7do/kratos-authn/engine"
 
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
 	userV1 "go-wind-admin/api/gen/go/user/service/v1"
 )
@@ -96,6 +97,7 @@ func TestNewUserTokenPayloadWithClaims(t *testing.T) {
 		ClaimFieldRoleCodes:     user.Roles,
 		ClaimFieldDataScope:     ds.String(),
 		ClaimFieldOrgUnitID:     ou,
+		ClaimFieldSessionID:     "s1",
 	}
 
 	payload, err := NewUserTokenPayloadWithClaims(claims)
@@ -106,6 +108,7 @@ func TestNewUserTokenPayloadWithClaims(t *testing.T) {
 	assert.Equal(t, user.GetTenantId(), payload.GetTenantId())
 	assert.Equal(t, client, payload.GetClientId())
 	assert.Equal(t, device, payload.GetDeviceId())
+	assert.Equal(t, "s1", payload.GetSessionId())
 	assert.Equal(t, user.Roles, payload.GetRoles())
 	if payload.DataScope != nil {
 		assert.Equal(t, ds, payload.GetDataScope())
@@ -138,6 +141,7 @@ func TestNewUserTokenPayloadWithJwtMapClaims(t *testing.T) {
 		ClaimFieldDataScope: ds.String(),
 		ClaimFieldOrgUnitID: float64(ou),
 		ClaimFieldRoleCodes: []interface{}{"r1", "r2"},
+		ClaimFieldSessionID: "s2",
 	}
 
 	payload, err := NewUserTokenPayloadWithJwtMapClaims(mapClaims)
@@ -149,6 +153,7 @@ func TestNewUserTokenPayloadWithJwtMapClaims(t *testing.T) {
 	assert.Equal(t, client, payload.GetClientId())
 	assert.Equal(t, device, payload.GetDeviceId())
 	assert.Equal(t, []string{"r1", "r2"}, payload.GetRoles())
+	assert.Equal(t, "s2", payload.GetSessionId())
 	if payload.DataScope != nil {
 		assert.Equal(t, ds, payload.GetDataScope())
 	}
@@ -156,3 +161,104 @@ func TestNewUserTokenPayloadWithJwtMapClaims(t *testing.T) {
 		assert.Equal(t, ou, payload.GetOrgUnitId())
 	}
 }
+
+func TestClientTokenPayloadClaims(t *testing.T) {
+	payload := NewClientTokenPayload("billing-service", 8, []string{"sys:user:list", "sys:user:get"},
+		permissionV1.DataScope_UNIT_ONLY.Enum(), trans.Ptr(uint32(3)), 2)
+
+	claims := NewUserTokenAuthClaims(payload, nil)
+	assert.Equal(t, "billing-service", (*claims)[authn.ClaimFieldSubject])
+	assert.Equal(t, authenticationV1.UserTokenPayload_CLIENT.String(), (*claims)[ClaimFieldSubjectType])
+	assert.Equal(t, []string{"sys:user:list", "sys:user:get"}, (*claims)[ClaimFieldScopes])
+	assert.Nil(t, (*claims)[ClaimFieldRoleCodes])
+
+	decoded, err := NewUserTokenPayloadWithClaims(claims)
+	assert.NoError(t, err)
+	assert.Equal(t, authenticationV1.UserTokenPayload_CLIENT, decoded.GetSubjectType())
+	assert.Equal(t, payload.GetScopes(), decoded.GetScopes())
+	assert.Equal(t, uint32(8), decoded.GetTenantId())
+	assert.Equal(t, uint32(0), decoded.GetUserId())
+	assert.Equal(t, permissionV1.DataScope_UNIT_ONLY, decoded.GetDataScope())
+	assert.Equal(t, uint32(3), decoded.GetOrgUnitId())
+	assert.Equal(t, uint32(2), decoded.GetClientSecretVersion())
+
+	// 用户令牌不写入主体类型
+	userClaims := NewUserTokenAuthClaims(NewUserTokenPayload("alice", 1, 2, nil, []string{"r"}, nil, nil, nil), nil)
+	_, exists := (*userClaims)[ClaimFieldSubjectType]
+	assert.False(t, exists)
+
+	mapped, err := NewUserTokenPayloadWithJwtMapClaims(jwt.MapClaims{
+		"sub":                 "billing-service",
+		ClaimFieldTenantID:    float64(8),
+		ClaimFieldSubjectType: "CLIENT",
+		ClaimFieldScopes:      []interface{}{"sys:user:list"},
+
+		ClaimFieldClientSecretVersion: float64(2),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, authenticationV1.UserTokenPayload_CLIENT, mapped.GetSubjectType())
+	assert.Equal(t, []string{"sys:user:list"}, mapped.GetScopes())
+	assert.Equal(t, uint32(2), mapped.GetClientSecretVersion())
+}
+
+func TestImpersonationTokenPayloadClaims(t *testing.T) {
+	payload := NewUserTokenPayload("alice", 7, 2, nil, []string{"staff"}, nil, nil, nil)
+	payload.Actor = &authenticationV1.TokenActor{
+		UserId:   3,
+		Username: trans.Ptr("support"),
+		TenantId: trans.Ptr(uint32(2)),
+	}
+	payload.ReadOnly = trans.Ptr(true)
+
+	claims := NewUserTokenAuthClaims(payload, nil)
+	assert.Equal(t, true, (*claims)[ClaimFieldReadOnly])
+
+	decoded, err := NewUserTokenPayloadWithClaims(claims)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(7), decoded.GetUserId())
+	assert.Equal(t, uint32(3), decoded.GetActor().GetUserId())
+	assert.Equal(t, "support", decoded.GetActor().GetUsername())
+	assert.Equal(t, uint32(2), decoded.GetActor().GetTenantId())
+	assert.True(t, decoded.GetReadOnly())
+
+	// 解析 JSON 后数字为 float64
+	mapped, err := NewUserTokenPayloadWithJwtMapClaims(jwt.MapClaims{
+		"sub":              "alice",
+		ClaimFieldUserID:   float64(7),
+		ClaimFieldActor:    map[string]interface{}{"uid": float64(3), "sub": "support"},
+		ClaimFieldReadOnly: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), mapped.GetActor().GetUserId())
+	assert.Equal(t, "support", mapped.GetActor().GetUsername())
+	assert.True(t, mapped.GetReadOnly())
+
+	// 普通令牌不包含操作者
+	plain := NewUserTokenAuthClaims(NewUserTokenPayload("bob", 1, 2, nil, nil, nil, nil, nil), nil)
+	_, exists := (*plain)[ClaimFieldActor]
+	assert.False(t, exists)
+	decoded, err = NewUserTokenPayloadWithClaims(plain)
+	assert.NoError(t, err)
+	assert.Nil(t, decoded.GetActor())
+	assert.False(t, decoded.GetReadOnly())
+}
+
+func TestPasswordExpiredTokenPayloadClaim(t *testing.T) {
+	payload := NewUserTokenPayload("alice", 7, 2, nil, nil, nil, nil, nil)
+	payload.PasswordExpired = trans.Ptr(true)
+
+	claims := NewUserTokenAuthClaims(payload, nil)
+	assert.Equal(t, true, (*claims)[ClaimFieldPwdExpired])
+
+	decoded, err := NewUserTokenPayloadWithClaims(claims)
+	assert.NoError(t, err)
+	assert.True(t, decoded.GetPasswordExpired())
+
+	mapped, err := NewUserTokenPayloadWithJwtMapClaims(jwt.MapClaims{
+		"sub":                "alice",
+		ClaimFieldUserID:     float64(7),
+		ClaimFieldPwdExpired: true,
+	})
+	assert.NoError(t, err)
+	assert.True(t, mapped.GetPasswordExpired())
+}
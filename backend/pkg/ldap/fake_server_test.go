@@ -0,0 +1,212 @@
+package ldap
+
+import (
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goLdap "github.com/go-ldap/ldap/v3"
+)
+
+// fakeEntry 测试目录中的条目，属性名不区分大小写
+type fakeEntry struct {
+	dn       string
+	password string
+	attrs    map[string][]string
+}
+
+// fakeServer 进程内的 LDAP 服务替身，只实现简单绑定、子树搜索（与、或、非、等值、存在过滤）和解绑
+type fakeServer struct {
+	t        *testing.T
+	listener net.Listener
+	entries  []fakeEntry
+
+	mu    sync.Mutex
+	binds []string
+}
+
+func newFakeServer(t *testing.T, entries ...fakeEntry) *fakeServer {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &fakeServer{t: t, listener: l, entries: entries}
+	go s.serve()
+	t.Cleanup(func() { _ = l.Close() })
+
+	return s
+}
+
+func (s *fakeServer) URL() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+// Binds 返回成功绑定过的DN
+func (s *fakeServer) Binds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.binds...)
+}
+
+func (s *fakeServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil {
+			if err != io.EOF {
+				s.t.Logf("fake ldap: read packet: %v", err)
+			}
+			return
+		}
+		if len(packet.Children) < 2 {
+			return
+		}
+
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+
+		switch op.Tag {
+		case goLdap.ApplicationBindRequest:
+			dn := op.Children[1].Value.(string)
+			password := op.Children[2].Data.String()
+			code := uint16(goLdap.LDAPResultInvalidCredentials)
+			if e := s.find(dn); e != nil && password != "" && e.password == password {
+				code = goLdap.LDAPResultSuccess
+				s.mu.Lock()
+				s.binds = append(s.binds, dn)
+				s.mu.Unlock()
+			}
+			s.write(conn, messageID, resultPacket(goLdap.ApplicationBindResponse, code))
+
+		case goLdap.ApplicationSearchRequest:
+			base := NormalizeDN(op.Children[0].Value.(string))
+			filter := op.Children[6]
+			for i := range s.entries {
+				e := &s.entries[i]
+				dn := NormalizeDN(e.dn)
+				if dn != base && !strings.HasSuffix(dn, ","+base) {
+					continue
+				}
+				if !matchFilter(filter, e) {
+					continue
+				}
+				s.write(conn, messageID, entryPacket(e))
+			}
+			s.write(conn, messageID, resultPacket(goLdap.ApplicationSearchResultDone, goLdap.LDAPResultSuccess))
+
+		case goLdap.ApplicationUnbindRequest:
+			return
+
+		default:
+			s.t.Logf("fake ldap: unsupported operation %d", op.Tag)
+			return
+		}
+	}
+}
+
+func (s *fakeServer) find(dn string) *fakeEntry {
+	dn = NormalizeDN(dn)
+	for i := range s.entries {
+		if NormalizeDN(s.entries[i].dn) == dn {
+			return &s.entries[i]
+		}
+	}
+	return nil
+}
+
+func (s *fakeServer) write(conn net.Conn, messageID int64, op *ber.Packet) {
+	envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "MessageID"))
+	envelope.AppendChild(op)
+	if _, err := conn.Write(envelope.Bytes()); err != nil {
+		s.t.Logf("fake ldap: write: %v", err)
+	}
+}
+
+func resultPacket(application int, code uint16) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ber.Tag(application), nil, "Result")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "resultCode"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "diagnosticMessage"))
+	return p
+}
+
+func entryPacket(e *fakeEntry) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goLdap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.dn, "objectName"))
+
+	attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes")
+	for name, values := range e.attrs {
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "values")
+		for _, v := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "value"))
+		}
+		attr.AppendChild(set)
+		attrs.AppendChild(attr)
+	}
+	p.AppendChild(attrs)
+
+	return p
+}
+
+func (e *fakeEntry) values(name string) []string {
+	for k, v := range e.attrs {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+func matchFilter(f *ber.Packet, e *fakeEntry) bool {
+	switch f.Tag {
+	case goLdap.FilterAnd:
+		for _, c := range f.Children {
+			if !matchFilter(c, e) {
+				return false
+			}
+		}
+		return true
+	case goLdap.FilterOr:
+		for _, c := range f.Children {
+			if matchFilter(c, e) {
+				return true
+			}
+		}
+		return false
+	case goLdap.FilterNot:
+		return !matchFilter(f.Children[0], e)
+	case goLdap.FilterEqualityMatch:
+		name := f.Children[0].Value.(string)
+		want := f.Children[1].Value.(string)
+		for _, v := range e.values(name) {
+			if strings.EqualFold(v, want) {
+				return true
+			}
+		}
+		return false
+	case goLdap.FilterPresent:
+		return len(e.values(f.Data.String())) > 0
+	default:
+		return false
+	}
+}
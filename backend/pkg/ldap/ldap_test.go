@@ -0,0 +1,100 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testDirectory(t *testing.T) *fakeServer {
+	return newFakeServer(t,
+		fakeEntry{dn: "cn=admin,dc=example,dc=com", password: "admin-secret",
+			attrs: map[string][]string{"objectClass": {"person"}, "cn": {"admin"}}},
+		fakeEntry{dn: "ou=Engineering,dc=example,dc=com",
+			attrs: map[string][]string{"objectClass": {"organizationalUnit"}, "ou": {"Engineering"}}},
+		fakeEntry{dn: "ou=Backend,ou=Engineering,dc=example,dc=com",
+			attrs: map[string][]string{"objectClass": {"organizationalUnit"}, "ou": {"Backend"}}},
+		fakeEntry{dn: "uid=alice,ou=Backend,ou=Engineering,dc=example,dc=com", password: "alice-secret",
+			attrs: map[string][]string{"objectClass": {"person", "inetOrgPerson"}, "uid": {"alice"}, "mail": {"alice@example.com"}, "displayName": {"Alice"}}},
+		fakeEntry{dn: "uid=bob,ou=Engineering,dc=example,dc=com", password: "bob-secret",
+			attrs: map[string][]string{"objectClass": {"person"}, "uid": {"bob"}, "mobile": {"13800000000"}}},
+		fakeEntry{dn: "cn=developers,dc=example,dc=com",
+			attrs: map[string][]string{"objectClass": {"groupOfNames"}, "cn": {"developers"},
+				"member": {"uid=alice,ou=Backend,ou=Engineering,dc=example,dc=com", "uid=bob,ou=Engineering,dc=example,dc=com"}}},
+	)
+}
+
+func TestClient_Authenticate(t *testing.T) {
+	srv := testDirectory(t)
+	c := NewClient(Config{
+		URL:          srv.URL(),
+		BindDN:       "cn=admin,dc=example,dc=com",
+		BindPassword: "admin-secret",
+		BaseDN:       "dc=example,dc=com",
+	})
+	ctx := context.Background()
+
+	u, err := c.Authenticate(ctx, "alice", "alice-secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "uid=alice,ou=Backend,ou=Engineering,dc=example,dc=com", u.DN)
+	assert.Equal(t, "alice@example.com", u.Email)
+	assert.Equal(t, "Alice", u.DisplayName)
+	assert.Contains(t, srv.Binds(), u.DN)
+
+	_, err = c.Authenticate(ctx, "alice", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = c.Authenticate(ctx, "nobody", "alice-secret")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	// 空密码不能发起绑定，否则可能被当作匿名绑定
+	_, err = c.Authenticate(ctx, "alice", "")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	// 过滤条件中的特殊字符需要转义
+	_, err = c.Authenticate(ctx, "*", "alice-secret")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	// 服务账号密码错误属于配置错误，不当作用户密码错误
+	c = NewClient(Config{URL: srv.URL(), BindDN: "cn=admin,dc=example,dc=com", BindPassword: "bad", BaseDN: "dc=example,dc=com"})
+	_, err = c.Authenticate(ctx, "alice", "alice-secret")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestClient_Snapshot(t *testing.T) {
+	srv := testDirectory(t)
+	c := NewClient(Config{
+		URL:           srv.URL(),
+		BindDN:        "cn=admin,dc=example,dc=com",
+		BindPassword:  "admin-secret",
+		BaseDN:        "dc=example,dc=com",
+		UserFilter:    "(&(objectClass=person)(uid=*))",
+		OrgUnitFilter: DefaultOrgUnitFilter,
+		GroupFilter:   DefaultGroupFilter,
+	})
+
+	dir, err := c.Snapshot(context.Background())
+	assert.NoError(t, err)
+
+	var usernames []string
+	for _, u := range dir.Users {
+		usernames = append(usernames, u.Username)
+	}
+	assert.ElementsMatch(t, []string{"alice", "bob"}, usernames)
+
+	assert.Len(t, dir.OrgUnits, 2)
+	assert.Equal(t, "Engineering", dir.OrgUnits[0].Name)
+
+	assert.Len(t, dir.Groups, 1)
+	assert.Equal(t, "developers", dir.Groups[0].Name)
+	assert.Len(t, dir.Groups[0].Members, 2)
+}
+
+func TestDNHelpers(t *testing.T) {
+	assert.Equal(t, "uid=alice,ou=backend,dc=example,dc=com", NormalizeDN("UID=Alice, OU=Backend,DC=example,DC=com"))
+	assert.Equal(t, "ou=Backend,dc=example,dc=com", ParentDN("uid=alice,ou=Backend,dc=example,dc=com"))
+	assert.Equal(t, "", ParentDN("dc=com"))
+	assert.Equal(t, "Backend", FirstRDNValue("ou=Backend,dc=example,dc=com"))
+}
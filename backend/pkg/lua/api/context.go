@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// unlimitedContext is implemented by the engine's execution context. Its Done method
+// counts instructions and must only be called by the VM, Unlimited returns the
+// underlying context for use by other goroutines.
+type unlimitedContext interface {
+	Unlimited() context.Context
+}
+
+// executionContext returns the context of the running execution, safe to hand to
+// clients that watch it from other goroutines
+func executionContext(L *lua.LState) context.Context {
+	ctx := L.Context()
+	if ctx == nil {
+		return context.Background()
+	}
+	if u, ok := ctx.(unlimitedContext); ok {
+		return u.Unlimited()
+	}
+	return ctx
+}
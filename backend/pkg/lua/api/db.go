@@ -0,0 +1,322 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/log"
+	lua "github.com/yuin/gopher-lua"
+
+	"go-wind-admin/pkg/lua/internal/convert"
+)
+
+const (
+	defaultDBLimit = 100  // Rows returned when a query sets no limit
+	maxDBLimit     = 1000 // Upper bound for the limit of a query
+)
+
+// DB condition operators accepted in the where table
+const (
+	DBOpEq   = "eq"
+	DBOpNe   = "ne"
+	DBOpGt   = "gt"
+	DBOpGte  = "gte"
+	DBOpLt   = "lt"
+	DBOpLte  = "lte"
+	DBOpLike = "like"
+	DBOpIn   = "in"
+	DBOpNull = "null" // true matches NULL, false matches NOT NULL
+)
+
+var dbOps = map[string]bool{
+	DBOpEq: true, DBOpNe: true, DBOpGt: true, DBOpGte: true, DBOpLt: true,
+	DBOpLte: true, DBOpLike: true, DBOpIn: true, DBOpNull: true,
+}
+
+// DBCondition compares a column with a value, values are always passed as query parameters
+type DBCondition struct {
+	Column string
+	Op     string
+	Value  any // A []any for DBOpIn, a bool for DBOpNull
+}
+
+// DBQuery is a read-only query issued by the db module
+type DBQuery struct {
+	Table   string
+	Fields  []string      // Columns to return, empty returns all readable columns
+	Where   []DBCondition // Conditions joined with AND
+	OrderBy []string      // Columns, prefixed with "-" for descending order
+	Limit   int
+	Offset  int
+}
+
+// DBQuerier runs the queries of the db module. Implementations must only read
+// data and must restrict the rows to what the viewer in ctx may see.
+type DBQuerier interface {
+	// Tables returns the tables scripts may query
+	Tables() []string
+
+	// Query returns the matching rows as column -> value maps
+	Query(ctx context.Context, q *DBQuery) ([]map[string]any, error)
+
+	// Count returns the number of matching rows, Fields, OrderBy, Limit and Offset are ignored
+	Count(ctx context.Context, q *DBQuery) (int64, error)
+}
+
+// RegisterDB registers the read-only database API for Lua as a requireable module
+func RegisterDB(L *lua.LState, querier DBQuerier, logger *log.Helper) {
+	// Create loader function that returns the module
+	loader := func(L *lua.LState) int {
+		// Create db module
+		dbModule := L.NewTable()
+
+		// db.query(table, {fields=, where=, order_by=, limit=, offset=})
+		// Returns: rows, error
+		dbModule.RawSetString("query", L.NewFunction(func(L *lua.LState) int {
+			q, err := parseDBQuery(L.CheckString(1), L.OptTable(2, nil))
+			if err != nil {
+				L.ArgError(2, err.Error())
+				return 0
+			}
+
+			rows, err := querier.Query(executionContext(L), q)
+			if err != nil {
+				logger.Warnf("db.query(%s) failed: %v", q.Table, err)
+				L.Push(lua.LNil)
+				L.Push(lua.LString(err.Error()))
+				return 2
+			}
+
+			result := L.NewTable()
+			for i, row := range rows {
+				result.RawSetInt(i+1, convert.ToLuaValue(L, row))
+			}
+			L.Push(result)
+			return 1
+		}))
+
+		// db.first(table, {fields=, where=, order_by=})
+		// Returns: row or nil, error
+		dbModule.RawSetString("first", L.NewFunction(func(L *lua.LState) int {
+			q, err := parseDBQuery(L.CheckString(1), L.OptTable(2, nil))
+			if err != nil {
+				L.ArgError(2, err.Error())
+				return 0
+			}
+			q.Limit = 1
+			q.Offset = 0
+
+			rows, err := querier.Query(executionContext(L), q)
+			if err != nil {
+				logger.Warnf("db.first(%s) failed: %v", q.Table, err)
+				L.Push(lua.LNil)
+				L.Push(lua.LString(err.Error()))
+				return 2
+			}
+
+			if len(rows) == 0 {
+				L.Push(lua.LNil)
+				return 1
+			}
+			L.Push(convert.ToLuaValue(L, rows[0]))
+			return 1
+		}))
+
+		// db.count(table, {where=})
+		// Returns: count, error
+		dbModule.RawSetString("count", L.NewFunction(func(L *lua.LState) int {
+			q, err := parseDBQuery(L.CheckString(1), L.OptTable(2, nil))
+			if err != nil {
+				L.ArgError(2, err.Error())
+				return 0
+			}
+
+			count, err := querier.Count(executionContext(L), q)
+			if err != nil {
+				logger.Warnf("db.count(%s) failed: %v", q.Table, err)
+				L.Push(lua.LNil)
+				L.Push(lua.LString(err.Error()))
+				return 2
+			}
+
+			L.Push(lua.LNumber(count))
+			return 1
+		}))
+
+		// db.tables()
+		// Returns: list of queryable tables
+		dbModule.RawSetString("tables", L.NewFunction(func(L *lua.LState) int {
+			result := L.NewTable()
+			for i, table := range querier.Tables() {
+				result.RawSetInt(i+1, lua.LString(table))
+			}
+			L.Push(result)
+			return 1
+		}))
+
+		L.Push(dbModule)
+		return 1
+	}
+
+	// Register in package.preload so it can be required
+	L.PreloadModule("kratos_db", loader)
+}
+
+// parseDBQuery converts the Lua query options into a DBQuery
+func parseDBQuery(table string, opts *lua.LTable) (*DBQuery, error) {
+	q := &DBQuery{Table: table, Limit: defaultDBLimit}
+	if opts == nil {
+		return q, nil
+	}
+
+	var err error
+	if q.Fields, err = stringList(opts.RawGetString("fields"), "fields"); err != nil {
+		return nil, err
+	}
+	if q.OrderBy, err = stringList(opts.RawGetString("order_by"), "order_by"); err != nil {
+		return nil, err
+	}
+
+	if limit, ok := opts.RawGetString("limit").(lua.LNumber); ok {
+		q.Limit = int(limit)
+		if q.Limit <= 0 || q.Limit > maxDBLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", maxDBLimit)
+		}
+	}
+	if offset, ok := opts.RawGetString("offset").(lua.LNumber); ok {
+		q.Offset = int(offset)
+		if q.Offset < 0 {
+			return nil, fmt.Errorf("offset must not be negative")
+		}
+	}
+
+	switch where := opts.RawGetString("where").(type) {
+	case *lua.LNilType:
+	case *lua.LTable:
+		if q.Where, err = parseDBWhere(where); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("where must be a table")
+	}
+
+	return q, nil
+}
+
+// parseDBWhere converts {column = value}, {column = {v1, v2}} and {column = {op = value}}
+// into conditions sorted by column
+func parseDBWhere(where *lua.LTable) ([]DBCondition, error) {
+	var conditions []DBCondition
+	var err error
+
+	where.ForEach(func(key, value lua.LValue) {
+		if err != nil {
+			return
+		}
+
+		column, ok := key.(lua.LString)
+		if !ok {
+			err = fmt.Errorf("where keys must be column names")
+			return
+		}
+
+		table, ok := value.(*lua.LTable)
+		if !ok {
+			conditions = append(conditions, DBCondition{Column: string(column), Op: DBOpEq, Value: dbValue(convert.ToGoValue(value))})
+			return
+		}
+
+		// A list is shorthand for "in"
+		if table.MaxN() > 0 {
+			conditions = append(conditions, DBCondition{Column: string(column), Op: DBOpIn, Value: dbValue(convert.ToGoValue(table))})
+			return
+		}
+
+		table.ForEach(func(opKey, opValue lua.LValue) {
+			if err != nil {
+				return
+			}
+
+			op := lua.LVAsString(opKey)
+			if !dbOps[op] {
+				err = fmt.Errorf("unknown operator '%s' for column '%s'", op, column)
+				return
+			}
+
+			v := dbValue(convert.ToGoValue(opValue))
+			switch op {
+			case DBOpIn:
+				if _, ok := v.([]any); !ok {
+					err = fmt.Errorf("operator 'in' for column '%s' needs a list", column)
+					return
+				}
+			case DBOpNull:
+				if _, ok := v.(bool); !ok {
+					err = fmt.Errorf("operator 'null' for column '%s' needs a boolean", column)
+					return
+				}
+			default:
+				if _, ok := v.([]any); ok || v == nil {
+					err = fmt.Errorf("operator '%s' for column '%s' needs a scalar value", op, column)
+					return
+				}
+			}
+
+			conditions = append(conditions, DBCondition{Column: string(column), Op: op, Value: v})
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Deterministic order keeps the generated SQL stable
+	sort.SliceStable(conditions, func(i, j int) bool {
+		if conditions[i].Column != conditions[j].Column {
+			return conditions[i].Column < conditions[j].Column
+		}
+		return conditions[i].Op < conditions[j].Op
+	})
+
+	return conditions, nil
+}
+
+// dbValue converts whole Lua numbers to int64 so they bind to integer columns
+func dbValue(v any) any {
+	switch tv := v.(type) {
+	case float64:
+		if tv == math.Trunc(tv) && math.Abs(tv) < 1<<53 {
+			return int64(tv)
+		}
+		return tv
+	case []any:
+		for i := range tv {
+			tv[i] = dbValue(tv[i])
+		}
+		return tv
+	default:
+		return v
+	}
+}
+
+func stringList(value lua.LValue, name string) ([]string, error) {
+	switch tv := value.(type) {
+	case *lua.LNilType:
+		return nil, nil
+	case lua.LString:
+		return []string{string(tv)}, nil
+	case *lua.LTable:
+		var list []string
+		for i := 1; i <= tv.Len(); i++ {
+			s, ok := tv.RawGetInt(i).(lua.LString)
+			if !ok {
+				return nil, fmt.Errorf("%s must be a list of strings", name)
+			}
+			list = append(list, string(s))
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("%s must be a list of strings", name)
+	}
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	lua "github.com/yuin/gopher-lua"
+)
+
+type fakeDBQuerier struct {
+	queries []*DBQuery
+	rows    []map[string]any
+}
+
+func (q *fakeDBQuerier) Tables() []string {
+	return []string{"roles", "users"}
+}
+
+func (q *fakeDBQuerier) Query(_ context.Context, query *DBQuery) ([]map[string]any, error) {
+	q.queries = append(q.queries, query)
+	if query.Table != "users" {
+		return nil, errors.New("table is not queryable")
+	}
+	return q.rows, nil
+}
+
+func (q *fakeDBQuerier) Count(_ context.Context, query *DBQuery) (int64, error) {
+	q.queries = append(q.queries, query)
+	return int64(len(q.rows)), nil
+}
+
+func TestRegisterDB(t *testing.T) {
+	L := lua.NewState()
+	defer L.Close()
+
+	querier := &fakeDBQuerier{rows: []map[string]any{
+		{"id": int64(1), "username": "alice"},
+		{"id": int64(2), "username": "bob"},
+	}}
+	RegisterDB(L, querier, log.NewHelper(log.DefaultLogger))
+
+	err := L.DoString(`
+local db = require "kratos_db"
+
+local tables = db.tables()
+assert(#tables == 2 and tables[2] == "users")
+
+local rows, err = db.query("users", {
+    fields = {"id", "username"},
+    where = {
+        status = "NORMAL",
+        id = {1, 2},
+        created_at = {gte = "2024-01-01", lt = "2025-01-01"},
+        deleted_at = {null = true},
+    },
+    order_by = {"-id"},
+    limit = 10,
+    offset = 5,
+})
+assert(err == nil, err)
+assert(#rows == 2 and rows[1].username == "alice")
+
+local row = db.first("users", {where = {username = "bob"}})
+assert(row.id == 1)
+
+assert(db.count("users") == 2)
+
+rows, err = db.query("secrets")
+assert(rows == nil and err == "table is not queryable")
+`)
+	assert.NoError(t, err)
+
+	assert.Len(t, querier.queries, 4)
+
+	q := querier.queries[0]
+	assert.Equal(t, "users", q.Table)
+	assert.Equal(t, []string{"id", "username"}, q.Fields)
+	assert.Equal(t, []string{"-id"}, q.OrderBy)
+	assert.Equal(t, 10, q.Limit)
+	assert.Equal(t, 5, q.Offset)
+	assert.Equal(t, []DBCondition{
+		{Column: "created_at", Op: DBOpGte, Value: "2024-01-01"},
+		{Column: "created_at", Op: DBOpLt, Value: "2025-01-01"},
+		{Column: "deleted_at", Op: DBOpNull, Value: true},
+		{Column: "id", Op: DBOpIn, Value: []any{int64(1), int64(2)}},
+		{Column: "status", Op: DBOpEq, Value: "NORMAL"},
+	}, q.Where)
+
+	// db.first always fetches a single row, queries without a limit use the default
+	assert.Equal(t, 1, querier.queries[1].Limit)
+	assert.Equal(t, defaultDBLimit, querier.queries[2].Limit)
+}
+
+func TestRegisterDB_InvalidQuery(t *testing.T) {
+	L := lua.NewState()
+	defer L.Close()
+
+	RegisterDB(L, &fakeDBQuerier{}, log.NewHelper(log.DefaultLogger))
+
+	for _, script := range []string{
+		`require("kratos_db").query("users", {limit = 5000})`,
+		`require("kratos_db").query("users", {offset = -1})`,
+		`require("kratos_db").query("users", {where = "id = 1"})`,
+		`require("kratos_db").query("users", {where = {id = {between = {1, 2}}}})`,
+		`require("kratos_db").query("users", {where = {id = {["in"] = 1}}})`,
+		`require("kratos_db").query("users", {where = {id = {null = "yes"}}})`,
+		`require("kratos_db").query("users", {fields = {1, 2}})`,
+	} {
+		assert.Error(t, L.DoString(script), script)
+	}
+}
@@ -0,0 +1,273 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	lua "github.com/yuin/gopher-lua"
+
+	"go-wind-admin/pkg/lua/internal/convert"
+)
+
+const (
+	defaultHTTPTimeout         = 5 * time.Second
+	defaultHTTPMaxResponseSize = 1024 * 1024 // 1MB
+	maxHTTPRedirects           = 5
+)
+
+// HTTPConfig restricts the outbound requests made by the http module
+type HTTPConfig struct {
+	AllowedHosts    []string      // Hosts scripts may call, "*.example.com" matches the subdomains of example.com
+	Timeout         time.Duration // Request timeout, scripts may only lower it (default: 5s)
+	MaxResponseSize int64         // Maximum response body size in bytes (default: 1MB)
+}
+
+// HTTPClient performs the requests of the http module within the HTTPConfig limits
+type HTTPClient struct {
+	config HTTPConfig
+	client *http.Client
+}
+
+// HTTPRequest is an outbound request made by a script
+type HTTPRequest struct {
+	Method  string
+	URL     string
+	Headers map[string]string
+	Body    []byte
+	Timeout time.Duration // Zero uses the configured timeout
+}
+
+// HTTPResponse is the response returned to a script
+type HTTPResponse struct {
+	Status  int
+	Headers map[string]string // Lower-case header names, first value only
+	Body    []byte
+}
+
+// NewHTTPClient creates the client shared by the http module of all VMs
+func NewHTTPClient(config HTTPConfig) *HTTPClient {
+	if config.Timeout <= 0 {
+		config.Timeout = defaultHTTPTimeout
+	}
+	if config.MaxResponseSize <= 0 {
+		config.MaxResponseSize = defaultHTTPMaxResponseSize
+	}
+
+	c := &HTTPClient{config: config}
+	c.client = &http.Client{
+		// Redirects must stay on allowed hosts
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxHTTPRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxHTTPRedirects)
+			}
+			return c.checkURL(req.URL)
+		},
+	}
+	return c
+}
+
+// checkURL allows http and https requests to the allowed hosts only
+func (c *HTTPClient) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme '%s' is not allowed", u.Scheme)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host == "" {
+		return errors.New("url has no host")
+	}
+
+	for _, allowed := range c.config.AllowedHosts {
+		allowed = strings.ToLower(allowed)
+		if host == allowed {
+			return nil
+		}
+		if suffix, ok := strings.CutPrefix(allowed, "*"); ok && strings.HasSuffix(host, suffix) && strings.HasPrefix(suffix, ".") {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("host '%s' is not allowed", host)
+}
+
+// Do sends the request and reads at most MaxResponseSize bytes of the response body
+func (c *HTTPClient) Do(ctx context.Context, r *HTTPRequest) (*HTTPResponse, error) {
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	}
+	if err = c.checkURL(u); err != nil {
+		return nil, err
+	}
+
+	timeout := c.config.Timeout
+	if r.Timeout > 0 && r.Timeout < timeout {
+		timeout = r.Timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	method := strings.ToUpper(r.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body io.Reader
+	if r.Body != nil {
+		body = bytes.NewReader(r.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range r.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, c.config.MaxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > c.config.MaxResponseSize {
+		return nil, fmt.Errorf("response body exceeds %d bytes", c.config.MaxResponseSize)
+	}
+
+	headers := make(map[string]string, len(resp.Header))
+	for key, values := range resp.Header {
+		if len(values) > 0 {
+			headers[strings.ToLower(key)] = values[0]
+		}
+	}
+
+	return &HTTPResponse{Status: resp.StatusCode, Headers: headers, Body: data}, nil
+}
+
+// RegisterHTTP registers the sandboxed HTTP client API for Lua as a requireable module
+func RegisterHTTP(L *lua.LState, client *HTTPClient, logger *log.Helper) {
+	// Create loader function that returns the module
+	loader := func(L *lua.LState) int {
+		// Create http module
+		httpModule := L.NewTable()
+
+		// http.request({method=, url=, headers=, body=, json=, timeout=})
+		httpModule.RawSetString("request", L.NewFunction(func(L *lua.LState) int {
+			opts := L.CheckTable(1)
+			return doHTTPRequest(L, client, logger, lua.LVAsString(opts.RawGetString("method")), opts)
+		}))
+
+		// http.get(url, opts)
+		httpModule.RawSetString("get", L.NewFunction(func(L *lua.LState) int {
+			opts := optionsWithURL(L, L.CheckString(1), L.OptTable(2, nil))
+			return doHTTPRequest(L, client, logger, http.MethodGet, opts)
+		}))
+
+		// http.post(url, body, opts), a table body is sent as JSON
+		httpModule.RawSetString("post", L.NewFunction(func(L *lua.LState) int {
+			opts := optionsWithURL(L, L.CheckString(1), L.OptTable(3, nil))
+			switch body := L.Get(2).(type) {
+			case *lua.LTable:
+				opts.RawSetString("json", body)
+			case lua.LString:
+				opts.RawSetString("body", body)
+			}
+			return doHTTPRequest(L, client, logger, http.MethodPost, opts)
+		}))
+
+		L.Push(httpModule)
+		return 1
+	}
+
+	// Register in package.preload so it can be required
+	L.PreloadModule("kratos_http", loader)
+}
+
+// optionsWithURL copies the request options and sets the url
+func optionsWithURL(L *lua.LState, rawURL string, opts *lua.LTable) *lua.LTable {
+	result := L.NewTable()
+	if opts != nil {
+		opts.ForEach(func(key, value lua.LValue) {
+			result.RawSet(key, value)
+		})
+	}
+	result.RawSetString("url", lua.LString(rawURL))
+	return result
+}
+
+// doHTTPRequest sends the request described by opts and pushes the response table,
+// or nil and the error message
+func doHTTPRequest(L *lua.LState, client *HTTPClient, logger *log.Helper, method string, opts *lua.LTable) int {
+	req := &HTTPRequest{
+		Method:  method,
+		URL:     lua.LVAsString(opts.RawGetString("url")),
+		Headers: map[string]string{},
+	}
+	if headers, ok := opts.RawGetString("headers").(*lua.LTable); ok {
+		headers.ForEach(func(key, value lua.LValue) {
+			req.Headers[http.CanonicalHeaderKey(key.String())] = value.String()
+		})
+	}
+
+	if timeout, ok := opts.RawGetString("timeout").(lua.LNumber); ok {
+		req.Timeout = time.Duration(float64(timeout) * float64(time.Second))
+	}
+
+	if payload := opts.RawGetString("json"); payload != lua.LNil {
+		data, err := json.Marshal(convert.ToGoValue(payload))
+		if err != nil {
+			L.Push(lua.LNil)
+			L.Push(lua.LString(fmt.Sprintf("encode json body: %v", err)))
+			return 2
+		}
+		req.Body = data
+		if _, ok := req.Headers["Content-Type"]; !ok {
+			req.Headers["Content-Type"] = "application/json"
+		}
+	} else if body, ok := opts.RawGetString("body").(lua.LString); ok {
+		req.Body = []byte(body)
+	}
+
+	resp, err := client.Do(executionContext(L), req)
+	if err != nil {
+		logger.Warnf("http.request %s %s failed: %v", req.Method, req.URL, err)
+		L.Push(lua.LNil)
+		L.Push(lua.LString(err.Error()))
+		return 2
+	}
+
+	result := L.NewTable()
+	result.RawSetString("status", lua.LNumber(resp.Status))
+	result.RawSetString("body", lua.LString(resp.Body))
+
+	headers := L.NewTable()
+	for key, value := range resp.Headers {
+		headers.RawSetString(key, lua.LString(value))
+	}
+	result.RawSetString("headers", headers)
+
+	// Decode JSON responses for convenience
+	if strings.Contains(resp.Headers["content-type"], "json") {
+		var decoded any
+		if err = json.Unmarshal(resp.Body, &decoded); err == nil {
+			result.RawSetString("json", convert.ToLuaValue(L, decoded))
+		}
+	}
+
+	L.Push(result)
+	return 1
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func newHTTPTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"method":"` + r.Method + `"}`))
+	})
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		w.Header().Set("X-Token", r.Header.Get("X-Token"))
+		_, _ = w.Write(body)
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("x", 2048)))
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		// Same server, but addressed through a host that is not allowed
+		u, _ := url.Parse("http://" + r.Host + "/json")
+		u.Host = "localhost:" + u.Port()
+		http.Redirect(w, r, u.String(), http.StatusFound)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestHTTPClient_CheckURL(t *testing.T) {
+	client := NewHTTPClient(HTTPConfig{AllowedHosts: []string{"api.example.com", "*.hooks.example.com"}})
+
+	for rawURL, allowed := range map[string]bool{
+		"https://api.example.com/v1":         true,
+		"https://API.example.com:8443/v1":    true,
+		"https://a.hooks.example.com/x":      true,
+		"https://hooks.example.com/x":        false,
+		"https://evilhooks.example.com/x":    false,
+		"https://example.com/":               false,
+		"ftp://api.example.com/file":         false,
+		"file:///etc/passwd":                 false,
+		"https://api.example.com.evil.io/v1": false,
+	} {
+		u, err := url.Parse(rawURL)
+		assert.NoError(t, err)
+		assert.Equal(t, allowed, client.checkURL(u) == nil, rawURL)
+	}
+}
+
+func TestRegisterHTTP(t *testing.T) {
+	server := newHTTPTestServer(t)
+
+	L := lua.NewState()
+	defer L.Close()
+
+	client := NewHTTPClient(HTTPConfig{
+		AllowedHosts:    []string{"127.0.0.1"},
+		MaxResponseSize: 1024,
+	})
+	RegisterHTTP(L, client, log.NewHelper(log.DefaultLogger))
+	L.SetGlobal("base", lua.LString(server.URL))
+
+	err := L.DoString(`
+local http = require "kratos_http"
+
+-- JSON responses are decoded
+local resp, err = http.get(base .. "/json")
+assert(err == nil, err)
+assert(resp.status == 200, "status " .. tostring(resp.status))
+assert(resp.json.ok == true and resp.json.method == "GET", resp.body)
+
+-- Tables are sent as JSON, headers are passed through
+resp, err = http.post(base .. "/echo", {name = "alice"}, {headers = {["x-token"] = "secret"}})
+assert(err == nil, err)
+assert(resp.json.name == "alice", resp.body)
+assert(resp.headers["x-token"] == "secret", "missing echoed header")
+
+-- Generic request with a string body
+resp, err = http.request({method = "put", url = base .. "/echo", body = "raw", headers = {["Content-Type"] = "text/plain"}})
+assert(err == nil, err)
+assert(resp.body == "raw" and resp.json == nil, resp.body)
+
+-- Hosts outside the allowlist are refused
+resp, err = http.get("http://localhost/json")
+assert(resp == nil and string.find(err, "not allowed"), "expected host to be refused")
+
+-- Redirects must stay on allowed hosts
+resp, err = http.get(base .. "/redirect")
+assert(resp == nil and string.find(err, "not allowed"), "expected redirect to be refused")
+
+-- Bodies above the size cap are rejected
+resp, err = http.get(base .. "/large")
+assert(resp == nil and string.find(err, "exceeds 1024 bytes"), "expected size cap")
+
+-- Scripts can lower the timeout
+resp, err = http.get(base .. "/slow", {timeout = 0.05})
+assert(resp == nil and err ~= nil, "expected timeout")
+`)
+	assert.NoError(t, err)
+}
+
+func TestHTTPClient_Do(t *testing.T) {
+	server := newHTTPTestServer(t)
+	client := NewHTTPClient(HTTPConfig{AllowedHosts: []string{"127.0.0.1"}})
+
+	resp, err := client.Do(t.Context(), &HTTPRequest{URL: server.URL + "/json"})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.Status)
+
+	var body map[string]any
+	assert.NoError(t, json.Unmarshal(resp.Body, &body))
+	assert.Equal(t, "GET", body["method"])
+}
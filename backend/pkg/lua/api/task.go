@@ -1,6 +1,9 @@
 package api
 
 import (
+	"sort"
+	"sync"
+
 	"github.com/go-kratos/kratos/v2/log"
 	lua "github.com/yuin/gopher-lua"
 )
@@ -10,19 +13,20 @@ type TaskHandlerRegistry struct {
 	handlers map[string]*LuaTaskHandler
 	logger   *log.Helper
 	engine   VMManager
+	mu       sync.RWMutex
 }
 
 // VMManager provides VM management operations
 type VMManager interface {
-	MarkVMDedicated(L *lua.LState)
+	// ScriptSource returns the source of the script being loaded on L, empty when L is not loading a script
+	ScriptSource(L *lua.LState) string
 }
 
 // LuaTaskHandler represents a task handler registered from Lua
 type LuaTaskHandler struct {
 	Name         string
 	Description  string
-	Function     *lua.LFunction
-	L            *lua.LState
+	Source       string // Source of the registering script, loaded on a pooled VM for each execution
 	Required     []string
 	Optional     map[string]interface{}
 	TimeoutSecs  int // Timeout in seconds (default: 30)
@@ -34,6 +38,22 @@ var globalTaskRegistry = &TaskHandlerRegistry{
 	handlers: make(map[string]*LuaTaskHandler),
 }
 
+// taskCaptures holds the handler functions registered on VMs that load a handler for one execution
+var taskCaptures sync.Map // *lua.LState -> map[string]*lua.LFunction
+
+// CaptureTaskHandlers runs load on L and returns the handler functions registered by it.
+// The handlers are not added to the registry, so a script can be loaded again to run one of them.
+func CaptureTaskHandlers(L *lua.LState, load func() error) (map[string]*lua.LFunction, error) {
+	captured := make(map[string]*lua.LFunction)
+	taskCaptures.Store(L, captured)
+	defer taskCaptures.Delete(L)
+
+	if err := load(); err != nil {
+		return nil, err
+	}
+	return captured, nil
+}
+
 // RegisterTask registers the task API for Lua scripts
 func RegisterTask(L *lua.LState, engine VMManager, logger *log.Helper) {
 	globalTaskRegistry.logger = logger
@@ -75,6 +95,22 @@ func registerTaskHandler(L *lua.LState) int {
 	handlerFunc := L.CheckFunction(3)
 	options := L.OptTable(4, L.NewTable())
 
+	// Loading the handler for an execution only captures the function
+	if captured, ok := taskCaptures.Load(L); ok {
+		captured.(map[string]*lua.LFunction)[name] = handlerFunc
+		return 0
+	}
+
+	// Each execution loads the registering script again, so it has to be known
+	var source string
+	if globalTaskRegistry.engine != nil {
+		source = globalTaskRegistry.engine.ScriptSource(L)
+	}
+	if source == "" {
+		L.RaiseError("task handler '%s' must be registered while loading a script", name)
+		return 0
+	}
+
 	// Extract required fields
 	var required []string
 	if reqTable := options.RawGetString("required"); reqTable.Type() == lua.LTTable {
@@ -123,8 +159,7 @@ func registerTaskHandler(L *lua.LState) int {
 	handler := &LuaTaskHandler{
 		Name:        name,
 		Description: description,
-		Function:    handlerFunc,
-		L:           L,
+		Source:      source,
 		Required:    required,
 		Optional:    optional,
 		TimeoutSecs: timeoutSecs,
@@ -133,16 +168,9 @@ func registerTaskHandler(L *lua.LState) int {
 	}
 
 	// Register globally
+	globalTaskRegistry.mu.Lock()
 	globalTaskRegistry.handlers[name] = handler
-
-	// Mark the VM as dedicated so it won't be returned to the pool
-	// This ensures the handler function remains available for execution
-	if globalTaskRegistry.engine != nil {
-		globalTaskRegistry.engine.MarkVMDedicated(L)
-		if globalTaskRegistry.logger != nil {
-			globalTaskRegistry.logger.Debugf("VM marked as dedicated for task handler: %s", name)
-		}
-	}
+	globalTaskRegistry.mu.Unlock()
 
 	if globalTaskRegistry.logger != nil {
 		globalTaskRegistry.logger.Infof("📝 Registered Lua task handler: %s (timeout: %ds, retries: %d, priority: %d)",
@@ -154,17 +182,41 @@ func registerTaskHandler(L *lua.LState) int {
 
 // GetRegisteredHandlers returns all registered Lua task handlers
 func GetRegisteredHandlers() map[string]*LuaTaskHandler {
+	globalTaskRegistry.mu.RLock()
+	defer globalTaskRegistry.mu.RUnlock()
+
+	handlers := make(map[string]*LuaTaskHandler, len(globalTaskRegistry.handlers))
+	for name, handler := range globalTaskRegistry.handlers {
+		handlers[name] = handler
+	}
+
 	if globalTaskRegistry.logger != nil {
-		globalTaskRegistry.logger.Infof("📋 GetRegisteredHandlers called: %d handlers available", len(globalTaskRegistry.handlers))
-		for name := range globalTaskRegistry.handlers {
+		globalTaskRegistry.logger.Infof("📋 GetRegisteredHandlers called: %d handlers available", len(handlers))
+		for name := range handlers {
 			globalTaskRegistry.logger.Infof("  - %s", name)
 		}
 	}
-	return globalTaskRegistry.handlers
+	return handlers
+}
+
+// GetRegisteredHandlerNames returns the names of all registered Lua task handlers, sorted
+func GetRegisteredHandlerNames() []string {
+	globalTaskRegistry.mu.RLock()
+	defer globalTaskRegistry.mu.RUnlock()
+
+	names := make([]string, 0, len(globalTaskRegistry.handlers))
+	for name := range globalTaskRegistry.handlers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 // GetHandler returns a specific Lua task handler
 func GetHandler(name string) (*LuaTaskHandler, bool) {
+	globalTaskRegistry.mu.RLock()
+	defer globalTaskRegistry.mu.RUnlock()
+
 	handler, exists := globalTaskRegistry.handlers[name]
 	return handler, exists
 }
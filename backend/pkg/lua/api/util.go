@@ -25,7 +25,20 @@ func RegisterUtilAPI(L *lua.LState, logger *log.Helper) {
 				logger.Debugf("Lua sleep: %v", duration)
 			}
 
-			time.Sleep(duration)
+			// Wake up early when the execution is cancelled or times out
+			ctx := L.Context()
+			if ctx == nil {
+				time.Sleep(duration)
+				return 0
+			}
+
+			timer := time.NewTimer(duration)
+			defer timer.Stop()
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				L.RaiseError("sleep interrupted: %v", ctx.Err())
+			}
 			return 0
 		}))
 
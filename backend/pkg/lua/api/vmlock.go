@@ -0,0 +1,23 @@
+package api
+
+import (
+	"sync"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// vmLocks holds one lock per dedicated VM (*lua.LState -> *sync.Mutex)
+var vmLocks sync.Map
+
+// VMLock returns the lock that serializes execution on a dedicated VM.
+// Hook callbacks and task handlers stay bound to the VM that registered them,
+// and an LState must not be used by more than one goroutine at a time.
+func VMLock(L *lua.LState) *sync.Mutex {
+	v, _ := vmLocks.LoadOrStore(L, &sync.Mutex{})
+	return v.(*sync.Mutex)
+}
+
+// ReleaseVMLock forgets the lock of a closed VM
+func ReleaseVMLock(L *lua.LState) {
+	vmLocks.Delete(L)
+}
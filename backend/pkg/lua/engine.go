@@ -29,42 +29,88 @@ type CallbackInfo struct {
 type Engine struct {
 	config          *Config
 	pool            *vmPool
+	taskPool        *vmPool // VMs that load and run task handlers, hook registrations are ignored on them
 	logger          *log.Helper
 	registry        *hook.Registry
 	rdb             *redis.Client              // Redis client for cache operations
 	eventbusManager *eventbus.Manager          // EventBus manager
 	ossClient       *oss.MinIOClient           // OSS/MinIO client
+	httpClient      *api.HTTPClient            // Sandboxed HTTP client
+	dbQuerier       api.DBQuerier              // Read-only database querier
 	callbacks       map[string][]*CallbackInfo // Hook callbacks (hook name -> multiple callbacks)
 	dedicatedVMs    map[*lua.LState]bool       // VMs that should not be pooled
+	scriptSources   map[*lua.LState]string     // Source of the script each VM is loading
+	slots           chan struct{}              // MaxVMs execution slots, nil when unlimited
+	stats           engineStats                // Execution and limit breach counters
 	mu              sync.RWMutex
 }
 
 // Config defines Lua engine configuration
 type Config struct {
-	MaxVMs         int           // Maximum concurrent VMs (default: 10)
-	VMTimeout      time.Duration // Execution timeout per script (default: 5s)
-	MaxMemory      int64         // Memory limit per VM in bytes (default: 50MB)
-	EnableDebug    bool          // Enable debug logging
-	ScriptDir      string        // Directory for file-based scripts
-	AllowedModules []string      // Allowed Lua modules
-	PoolSize       int           // VM pool size (default: 5)
+	MaxVMs          int           // Maximum concurrent executions, 0 disables the limit (default: 10)
+	QueueTimeout    time.Duration // Maximum wait for a free execution slot (default: 1s)
+	VMTimeout       time.Duration // Execution timeout per script (default: 5s)
+	MaxMemory       int64         // Estimated memory limit per execution in bytes, 0 disables the limit (default: 50MB)
+	MaxInstructions int64         // Instruction budget per execution, 0 disables the limit (default: 100M)
+	CallStackSize   int           // Maximum call stack depth (default: 120)
+	RegistrySize    int           // Initial registry (value stack) size (default: 2400)
+	RegistryMaxSize int           // Maximum registry size, the registry does not grow when not above RegistrySize (default: 76800)
+	EnableDebug     bool          // Enable debug logging
+	ScriptDir       string        // Directory for file-based scripts
+	AllowedModules  []string      // Modules require() may load, empty allows all modules
+	PoolSize        int           // VM pool size (default: 5)
 }
 
 // DefaultConfig returns default configuration
 func DefaultConfig() *Config {
 	return &Config{
-		MaxVMs:         10,
-		VMTimeout:      5 * time.Second,
-		MaxMemory:      50 * 1024 * 1024, // 50MB
-		EnableDebug:    false,
-		ScriptDir:      "scripts",
-		AllowedModules: []string{},
-		PoolSize:       5,
+		MaxVMs:          10,
+		QueueTimeout:    time.Second,
+		VMTimeout:       5 * time.Second,
+		MaxMemory:       50 * 1024 * 1024, // 50MB
+		MaxInstructions: 100_000_000,
+		CallStackSize:   120,
+		RegistrySize:    120 * 20,
+		RegistryMaxSize: 120 * 20 * 32,
+		EnableDebug:     false,
+		ScriptDir:       "scripts",
+		AllowedModules:  []string{},
+		PoolSize:        5,
 	}
 }
 
+// Option configures optional engine dependencies
+type Option func(*Engine)
+
+// WithRedis enables the cache API
+func WithRedis(rdb *redis.Client) Option {
+	return func(e *Engine) { e.rdb = rdb }
+}
+
+// WithEventBus enables the eventbus API
+func WithEventBus(manager *eventbus.Manager) Option {
+	return func(e *Engine) { e.eventbusManager = manager }
+}
+
+// WithOSS enables the OSS API
+func WithOSS(client *oss.MinIOClient) Option {
+	return func(e *Engine) { e.ossClient = client }
+}
+
+// WithHTTP enables the HTTP API for the hosts allowed by config
+func WithHTTP(config api.HTTPConfig) Option {
+	return func(e *Engine) { e.httpClient = api.NewHTTPClient(config) }
+}
+
+// WithDatabase enables the read-only database API
+func WithDatabase(querier api.DBQuerier) Option {
+	return func(e *Engine) { e.dbQuerier = querier }
+}
+
 // NewEngine creates a new Lua engine
-func NewEngine(config *Config, logger log.Logger) *Engine {
+// Options are applied before the VM pool is created and scripts are loaded,
+// so every VM has the optional APIs available.
+func NewEngine(config *Config, logger log.Logger, opts ...Option) *Engine {
 	if config == nil {
 		config = DefaultConfig()
 	}
@@ -72,19 +118,29 @@ func NewEngine(config *Config, logger log.Logger) *Engine {
 	l := log.NewHelper(log.With(logger, "module", "lua/engine"))
 
 	engine := &Engine{
-		config:       config,
-		logger:       l,
-		registry:     hook.NewRegistry(),
-		callbacks:    make(map[string][]*CallbackInfo),
-		dedicatedVMs: make(map[*lua.LState]bool),
+		config:        config,
+		logger:        l,
+		registry:      hook.NewRegistry(),
+		callbacks:     make(map[string][]*CallbackInfo),
+		dedicatedVMs:  make(map[*lua.LState]bool),
+		scriptSources: make(map[*lua.LState]string),
+	}
+	if config.MaxVMs > 0 {
+		engine.slots = make(chan struct{}, config.MaxVMs)
+	}
+	for _, opt := range opts {
+		opt(engine)
 	}
 
 	// Initialize VM pool
 	engine.pool = newVMPool(config.PoolSize, func() *lua.LState {
 		return engine.createVM()
 	})
+	engine.taskPool = newVMPool(config.PoolSize, func() *lua.LState {
+		return engine.createTaskVM()
+	})
 
-	l.Infof("Lua engine initialized (pool: %d, timeout: %s)", config.PoolSize, config.VMTimeout)
+	l.Infof("Lua engine initialized (pool: %d, max VMs: %d, timeout: %s)", config.PoolSize, config.MaxVMs, config.VMTimeout)
 
 	// Automatically load scripts from ScriptDir if configured
 	if config.ScriptDir != "" {
@@ -98,9 +154,22 @@ func NewEngine(config *Config, logger log.Logger) *Engine {
 
 // createVM creates a new Lua VM with sandbox and API bindings
 func (e *Engine) createVM() *lua.LState {
+	return e.newVM(e.logger, e, true)
+}
+
+// createTaskVM creates a VM for task handler executions. Loading the handler runs the
+// registering script again, so its hook registrations are ignored.
+func (e *Engine) createTaskVM() *lua.LState {
+	return e.newVM(e.logger, &sandboxHooks{engine: e, logger: e.logger, mode: "task execution"}, true)
+}
+
+// newVM creates a VM whose APIs log to logger and register hooks with hooks,
+// the task API is only available when withTasks is set
+func (e *Engine) newVM(logger *log.Helper, hooks api.HookEngine, withTasks bool) *lua.LState {
 	L := lua.NewState(lua.Options{
-		CallStackSize:       120,
-		RegistrySize:        120 * 20,
+		CallStackSize:       e.config.CallStackSize,
+		RegistrySize:        e.config.RegistrySize,
+		RegistryMaxSize:     e.config.RegistryMaxSize,
 		SkipOpenLibs:        true, // We'll selectively open safe libs
 		IncludeGoStackTrace: e.config.EnableDebug,
 	})
@@ -109,7 +178,7 @@ func (e *Engine) createVM() *lua.LState {
 	e.openSafeLibs(L)
 
 	// Register custom API modules
-	e.registerAPIs(L)
+	e.registerAPIs(L, logger, hooks, withTasks)
 
 	return L
 }
@@ -122,6 +191,9 @@ func (e *Engine) openSafeLibs(L *lua.LState) {
 	lua.OpenString(L)
 	lua.OpenMath(L)
 
+	// Check the size of strings built in a single call before allocating them
+	e.capAllocations(L)
+
 	// Remove dangerous functions from base
 	L.SetGlobal("dofile", lua.LNil)
 	L.SetGlobal("loadfile", lua.LNil)
@@ -139,6 +211,9 @@ func (e *Engine) openSafeLibs(L *lua.LState) {
 	L.SetGlobal("require", L.NewFunction(func(L *lua.LState) int {
 		name := L.CheckString(1)
 
+		// Only modules listed in AllowedModules may be loaded
+		e.checkModule(L, name)
+
 		// Check if module is already loaded in package.loaded
 		pkg := L.GetGlobal("package")
 		if pkg == lua.LNil {
@@ -189,36 +264,48 @@ func (e *Engine) openSafeLibs(L *lua.LState) {
 }
 
 // registerAPIs registers custom Go APIs for Lua
-func (e *Engine) registerAPIs(L *lua.LState) {
+func (e *Engine) registerAPIs(L *lua.LState, logger *log.Helper, hooks api.HookEngine, withTasks bool) {
 	// Register logger API from api package
-	api.RegisterLogger(L, e.logger)
+	api.RegisterLogger(L, logger)
 
 	// Register cache API if Redis client is available
 	if e.rdb != nil {
-		api.RegisterCache(L, e.rdb, e.logger)
+		api.RegisterCache(L, e.rdb, logger)
 	}
 
 	// Register eventbus API if manager is available
 	if e.eventbusManager != nil {
-		api.RegisterEventBus(L, e.eventbusManager, e.logger)
+		api.RegisterEventBus(L, e.eventbusManager, logger)
 	}
 
 	// Register OSS API if client is available
 	if e.ossClient != nil {
-		api.RegisterOSS(L, e.ossClient, e.logger)
+		api.RegisterOSS(L, e.ossClient, logger)
+	}
+
+	// Register HTTP API if outbound requests are configured
+	if e.httpClient != nil {
+		api.RegisterHTTP(L, e.httpClient, logger)
+	}
+
+	// Register database API if a querier is available
+	if e.dbQuerier != nil {
+		api.RegisterDB(L, e.dbQuerier, logger)
 	}
 
 	// Register Crypto API (always available - uses global encryptor)
-	api.RegisterCrypto(L, e.logger)
+	api.RegisterCrypto(L, logger)
 
 	// Register hook API for self-registration
-	api.RegisterHookAPI(L, e, e.logger)
+	api.RegisterHookAPI(L, hooks, logger)
 
 	// Register task API for task handler registration
-	api.RegisterTask(L, e, e.logger)
+	if withTasks {
+		api.RegisterTask(L, e, logger)
+	}
 
 	// Register utility API (sleep, time, etc.)
-	api.RegisterUtilAPI(L, e.logger)
+	api.RegisterUtilAPI(L, logger)
 
 	// Set up module aliases and global convenience variables
 	// This allows scripts to use both require('logger') and log.info() directly
@@ -226,18 +313,14 @@ func (e *Engine) registerAPIs(L *lua.LState) {
 
 	// Create shorter aliases for modules in package.preload
 	// So scripts can use require('logger') instead of require('kratos_logger')
-	if logLoader := preloadTable.RawGetString("kratos_logger"); logLoader != lua.LNil {
-		preloadTable.RawSetString("logger", logLoader)
-	}
-	if hookLoader := preloadTable.RawGetString("kratos_hook"); hookLoader != lua.LNil {
-		preloadTable.RawSetString("hook", hookLoader)
-	}
-	if utilLoader := preloadTable.RawGetString("kratos_util"); utilLoader != lua.LNil {
-		preloadTable.RawSetString("util", utilLoader)
+	for alias, name := range moduleAliases {
+		if loader := preloadTable.RawGetString(name); loader != lua.LNil {
+			preloadTable.RawSetString(alias, loader)
+		}
 	}
 
 	// Load logger and set as global 'log' for convenience
-	if logLoader, ok := preloadTable.RawGetString("logger").(*lua.LFunction); ok {
+	if logLoader, ok := preloadTable.RawGetString("logger").(*lua.LFunction); ok && e.moduleAllowed("logger") {
 		L.Push(logLoader)
 		if err := L.PCall(0, 1, nil); err == nil {
 			L.SetGlobal("log", L.Get(-1))
@@ -246,85 +329,99 @@ func (e *Engine) registerAPIs(L *lua.LState) {
 	}
 
 	// Load hook and set as global 'hook' for convenience
-	if hookLoader, ok := preloadTable.RawGetString("hook").(*lua.LFunction); ok {
+	if hookLoader, ok := preloadTable.RawGetString("hook").(*lua.LFunction); ok && e.moduleAllowed("hook") {
 		L.Push(hookLoader)
 		if err := L.PCall(0, 1, nil); err == nil {
 			L.SetGlobal("hook", L.Get(-1))
 			L.Pop(1)
 		}
 	}
-
-	// Future APIs will be registered here:
-	// api.RegisterHTTP(L, httpClient)
-	// api.RegisterDatabase(L, db)
-	// etc.
 }
 
 // Execute executes a Lua script with given context
 func (e *Engine) Execute(ctx context.Context, script *Script, execCtx *Context) error {
+	// Wait for a free execution slot
+	release, err := e.acquireVM(ctx)
+	if err != nil {
+		return e.observe(script.Name, err)
+	}
+	defer release()
+
 	// Get VM from pool
 	L := e.pool.Get()
-	defer e.pool.Put(L)
 
 	// Set execution context
-	if err := e.setContext(L, execCtx); err != nil {
+	if err = e.setContext(L, execCtx); err != nil {
+		e.pool.Put(L)
 		return fmt.Errorf("failed to set context: %w", err)
 	}
 
-	// Create timeout context
-	timeoutCtx, cancel := context.WithTimeout(ctx, e.config.VMTimeout)
+	// Enforce the execution limits
+	lim, cancel := e.newLimiter(ctx, L, e.config.VMTimeout)
 	defer cancel()
 
 	// Execute script with timeout
 	errChan := make(chan error, 1)
 	go func() {
-		// Set context in VM
-		L.SetContext(timeoutCtx)
+		errChan <- lim.result(e.runScript(lim, L, script, execCtx))
+	}()
 
-		// Load and execute script
-		if err := L.DoString(script.Source); err != nil {
-			errChan <- fmt.Errorf("script execution error: %w", err)
-			return
-		}
+	// Wait for completion or timeout
+	select {
+	case err = <-errChan:
+		e.pool.Put(L)
+		return e.observe(script.Name, err)
+	case <-lim.Unlimited().Done():
+	}
 
-		// Call execute function if exists
-		if fn := L.GetGlobal("execute"); fn.Type() == lua.LTFunction {
-			// Push function and arguments
-			L.Push(fn)
-			L.Push(e.contextToLuaTable(L, execCtx))
+	// The VM aborts on its next instruction, unless it is blocked in a Go function
+	select {
+	case err = <-errChan:
+		e.pool.Put(L)
+		return e.observe(script.Name, err)
+	case <-time.After(abandonGrace):
+		// The VM is still used by the executing goroutine, leave it there and refill the pool
+		e.pool.Put(e.createVM())
+		return e.observe(script.Name, lim.result(lim.Unlimited().Err()))
+	}
+}
 
-			// Call function (1 argument, 1 return value)
-			if err := L.PCall(1, 1, nil); err != nil {
-				errChan <- fmt.Errorf("execute function error: %w", err)
-				return
-			}
+// runScript runs the script body on L and calls its execute(ctx) function if defined
+func (e *Engine) runScript(ctx context.Context, L *lua.LState, script *Script, execCtx *Context) error {
+	// Set context in VM
+	L.SetContext(ctx)
+	defer L.RemoveContext()
 
-			// Get result
-			ret := L.Get(-1)
-			L.Pop(1)
+	// A pooled VM may still hold the execute function of the previous script
+	L.SetGlobal("execute", lua.LNil)
+
+	// Load and execute script
+	if err := L.DoString(script.Source); err != nil {
+		return fmt.Errorf("script execution error: %w", err)
+	}
+
+	// Call execute function if exists
+	if fn := L.GetGlobal("execute"); fn.Type() == lua.LTFunction {
+		// Push function and arguments
+		L.Push(fn)
+		L.Push(e.contextToLuaTable(L, execCtx))
 
-			// Check if script returned false (abort)
-			if ret.Type() == lua.LTBool && !lua.LVAsBool(ret) {
-				errChan <- fmt.Errorf("script returned false")
-				return
-			}
+		// Call function (1 argument, 1 return value)
+		if err := L.PCall(1, 1, nil); err != nil {
+			return fmt.Errorf("execute function error: %w", err)
 		}
 
-		errChan <- nil
-	}()
+		// Get result
+		ret := L.Get(-1)
+		L.Pop(1)
 
-	// Wait for completion or timeout
-	select {
-	case err := <-errChan:
-		return err
-	case <-timeoutCtx.Done():
-		// Force stop the VM
-		L.Close()
-		// Create new VM for pool
-		newL := e.createVM()
-		e.pool.Replace(L, newL)
-		return fmt.Errorf("script execution timeout after %s", e.config.VMTimeout)
+		// Check if script returned false (abort)
+		if ret.Type() == lua.LTBool && !lua.LVAsBool(ret) {
+			return fmt.Errorf("script returned false")
+		}
 	}
+
+	return nil
 }
 
 // ExecuteHook executes all scripts registered for a hook
@@ -340,6 +437,12 @@ func (e *Engine) ExecuteHook(ctx context.Context, hookName string, execCtx *Cont
 		err := e.executeCallback(ctx, callback, execCtx)
 		duration := time.Since(start)
 
+		// ctx.stop(reason) vetoes the operation, remaining callbacks and scripts are skipped
+		if execCtx.Stopped {
+			e.logger.Infof("Callback %d stopped hook %s: %s", i+1, hookName, execCtx.StopReason)
+			return &StopError{Hook: hookName, Reason: execCtx.StopReason}
+		}
+
 		if err != nil {
 			e.logger.Errorf("Callback %d failed (hook: %s, duration: %s): %v",
 				i+1, hookName, duration, err)
@@ -382,6 +485,11 @@ func (e *Engine) ExecuteHook(ctx context.Context, hookName string, execCtx *Cont
 		err := e.Execute(ctx, script, execCtx)
 		duration := time.Since(start)
 
+		if execCtx.Stopped {
+			e.logger.Infof("Script '%s' stopped hook %s: %s", script.Name, hookName, execCtx.StopReason)
+			return &StopError{Hook: hookName, Reason: execCtx.StopReason}
+		}
+
 		if err != nil {
 			e.logger.Errorf("Script '%s' failed (hook: %s, duration: %s): %v",
 				script.Name, hookName, duration, err)
@@ -399,17 +507,37 @@ func (e *Engine) ExecuteHook(ctx context.Context, hookName string, execCtx *Cont
 
 // executeCallback executes a registered callback function
 func (e *Engine) executeCallback(ctx context.Context, callback *CallbackInfo, execCtx *Context) error {
+	name := "callback:" + callback.HookName
+
+	// Wait for a free execution slot
+	release, err := e.acquireVM(ctx)
+	if err != nil {
+		return e.observe(name, err)
+	}
+	defer release()
+
 	L := callback.L
 
-	// Create timeout context
-	timeoutCtx, cancel := context.WithTimeout(ctx, e.config.VMTimeout)
+	// Callbacks run on the VM that registered them, which may be shared with other
+	// callbacks and task handlers; an LState must not be used concurrently.
+	// The lock is released by the executing goroutine, so a timed out execution
+	// keeps the VM until the cancelled context aborts it.
+	vmLock := api.VMLock(L)
+	vmLock.Lock()
+
+	// Enforce the execution limits
+	lim, cancel := e.newLimiter(ctx, L, e.config.VMTimeout)
 	defer cancel()
 
 	// Execute callback with timeout
 	errChan := make(chan error, 1)
 	go func() {
+		defer vmLock.Unlock()
+
 		// Set context in VM
-		L.SetContext(timeoutCtx)
+		L.SetContext(lim)
+		defer L.RemoveContext()
+		defer L.SetTop(0)
 
 		// Push function and context argument
 		L.Push(callback.Function)
@@ -417,7 +545,7 @@ func (e *Engine) executeCallback(ctx context.Context, callback *CallbackInfo, ex
 
 		// Call function (1 argument, 1 return value)
 		if err := L.PCall(1, 1, nil); err != nil {
-			errChan <- fmt.Errorf("callback execution error: %w", err)
+			errChan <- lim.result(fmt.Errorf("callback execution error: %w", err))
 			return
 		}
 
@@ -436,10 +564,17 @@ func (e *Engine) executeCallback(ctx context.Context, callback *CallbackInfo, ex
 
 	// Wait for completion or timeout
 	select {
-	case err := <-errChan:
-		return err
-	case <-timeoutCtx.Done():
-		return fmt.Errorf("callback execution timeout after %s", e.config.VMTimeout)
+	case err = <-errChan:
+		return e.observe(name, err)
+	case <-lim.Unlimited().Done():
+	}
+
+	// The VM aborts on its next instruction, unless it is blocked in a Go function
+	select {
+	case err = <-errChan:
+		return e.observe(name, err)
+	case <-time.After(abandonGrace):
+		return e.observe(name, lim.result(lim.Unlimited().Err()))
 	}
 }
 
@@ -543,6 +678,26 @@ func extractScriptFields(script interface{}) (*hook.Script, bool) {
 	return hookScript, true
 }
 
+// UpsertScript adds a script to a hook, replacing the script with the same name
+func (e *Engine) UpsertScript(hookName string, script *Script) error {
+	if err := script.Validate(); err != nil {
+		return err
+	}
+
+	return e.registry.UpsertScript(hookName, &hook.Script{
+		ID:          script.ID,
+		Name:        script.Name,
+		Hook:        script.Hook,
+		Source:      script.Source,
+		Enabled:     script.Enabled,
+		Priority:    script.Priority,
+		Description: script.Description,
+		Version:     script.Version,
+		Author:      script.Author,
+		Critical:    script.Critical,
+	})
+}
+
 // RemoveScript removes a script from a hook
 func (e *Engine) RemoveScript(hookName, scriptName string) error {
 	return e.registry.RemoveScript(hookName, scriptName)
@@ -580,6 +735,26 @@ func (e *Engine) MarkVMDedicated(L *lua.LState) {
 	e.logger.Debugf("VM marked as dedicated")
 }
 
+// ScriptSource returns the source of the script being loaded on L
+func (e *Engine) ScriptSource(L *lua.LState) string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.scriptSources[L]
+}
+
+// loadingScript records source as the script loaded on L until the returned function is called
+func (e *Engine) loadingScript(L *lua.LState, source string) func() {
+	e.mu.Lock()
+	e.scriptSources[L] = source
+	e.mu.Unlock()
+
+	return func() {
+		e.mu.Lock()
+		delete(e.scriptSources, L)
+		e.mu.Unlock()
+	}
+}
+
 // SetRedis sets the Redis client for cache operations
 func (e *Engine) SetRedis(rdb *redis.Client) {
 	e.mu.Lock()
@@ -660,6 +835,7 @@ func (e *Engine) Close() error {
 				}()
 				vm.Close()
 			}()
+			api.ReleaseVMLock(vm)
 		}
 	}
 	e.dedicatedVMs = make(map[*lua.LState]bool)
@@ -667,6 +843,7 @@ func (e *Engine) Close() error {
 
 	// Close pooled VMs
 	e.pool.Close()
+	e.taskPool.Close()
 
 	return nil
 }
@@ -733,11 +910,6 @@ func (p *vmPool) Put(vm *lua.LState) {
 	}
 }
 
-func (p *vmPool) Replace(old, new *lua.LState) {
-	old.Close()
-	p.Put(new)
-}
-
 func (p *vmPool) Close() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -90,6 +90,38 @@ func (r *Registry) AddScript(hookName string, script *Script) error {
 	return nil
 }
 
+// UpsertScript adds a script to a hook, replacing the script with the same name
+func (r *Registry) UpsertScript(hookName string, script *Script) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	hook, exists := r.hooks[hookName]
+	if !exists {
+		hook = &Hook{
+			Name:    hookName,
+			Scripts: make([]*Script, 0),
+		}
+		r.hooks[hookName] = hook
+	}
+
+	scripts := make([]*Script, 0, len(hook.Scripts)+1)
+	for _, s := range hook.Scripts {
+		if s.Name != script.Name {
+			scripts = append(scripts, s)
+		}
+	}
+	scripts = append(scripts, script)
+
+	sort.SliceStable(scripts, func(i, j int) bool {
+		return scripts[i].Priority < scripts[j].Priority
+	})
+
+	// Swap the slice so GetScripts copies taken earlier are not modified
+	hook.Scripts = scripts
+
+	return nil
+}
+
 // RemoveScript removes a script from a hook
 func (r *Registry) RemoveScript(hookName, scriptName string) error {
 	r.mu.Lock()
@@ -0,0 +1,64 @@
+package lua
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Standard hook points triggered by the admin service
+const (
+	HookOnServerStart    = "on_server_start"
+	HookBeforeLogin      = "before_login"
+	HookAfterLogin       = "after_login"
+	HookBeforeUserCreate = "before_user_create"
+	HookAfterUserCreate  = "after_user_create"
+	HookBeforeUserUpdate = "before_user_update"
+	HookAfterUserUpdate  = "after_user_update"
+	HookOnFileUploaded   = "on_file_uploaded"
+)
+
+// HookPoint describes a hook point
+type HookPoint struct {
+	Name        string
+	Description string
+}
+
+// StandardHooks lists the standard hook points, scripts can still register their own
+var StandardHooks = []HookPoint{
+	{Name: HookOnServerStart, Description: "Triggered when the server starts"},
+	{Name: HookBeforeLogin, Description: "Triggered before credentials are checked, ctx.stop(reason) rejects the login"},
+	{Name: HookAfterLogin, Description: "Triggered after a successful login"},
+	{Name: HookBeforeUserCreate, Description: "Triggered before a user is created, ctx.stop(reason) rejects the creation"},
+	{Name: HookAfterUserCreate, Description: "Triggered after a user is created"},
+	{Name: HookBeforeUserUpdate, Description: "Triggered before a user is updated, ctx.stop(reason) rejects the update"},
+	{Name: HookAfterUserUpdate, Description: "Triggered after a user is updated"},
+	{Name: HookOnFileUploaded, Description: "Triggered after a file is uploaded"},
+}
+
+// RegisterStandardHooks registers the standard hook points, hooks already registered by scripts are kept
+func (e *Engine) RegisterStandardHooks() {
+	for _, h := range StandardHooks {
+		if err := e.RegisterHook(h.Name, h.Description); err != nil {
+			e.logger.Debugf("Standard hook %s: %v", h.Name, err)
+		}
+	}
+}
+
+// StopError is returned by ExecuteHook when a script calls ctx.stop(reason)
+type StopError struct {
+	Hook   string
+	Reason string
+}
+
+func (e *StopError) Error() string {
+	return fmt.Sprintf("hook %s stopped: %s", e.Hook, e.Reason)
+}
+
+// AsStopError reports whether err was caused by ctx.stop(reason) and returns the stop error
+func AsStopError(err error) (*StopError, bool) {
+	var stopErr *StopError
+	if errors.As(err, &stopErr) {
+		return stopErr, true
+	}
+	return nil, false
+}
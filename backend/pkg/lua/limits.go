@@ -0,0 +1,515 @@
+package lua
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// LimitKind identifies the resource limit an execution exceeded
+type LimitKind string
+
+const (
+	LimitInstructions LimitKind = "instructions" // MaxInstructions
+	LimitMemory       LimitKind = "memory"       // MaxMemory
+	LimitCallStack    LimitKind = "call_stack"   // CallStackSize
+	LimitRegistry     LimitKind = "registry"     // RegistryMaxSize
+	LimitConcurrency  LimitKind = "concurrency"  // MaxVMs and QueueTimeout
+	LimitTimeout      LimitKind = "timeout"      // VMTimeout or the task handler timeout
+	LimitModule       LimitKind = "module"       // AllowedModules
+)
+
+var limitKinds = []LimitKind{
+	LimitInstructions, LimitMemory, LimitCallStack, LimitRegistry,
+	LimitConcurrency, LimitTimeout, LimitModule,
+}
+
+const (
+	// memoryCheckInterval is the minimum number of instructions between two memory estimates,
+	// the interval grows with the number of values walked so accounting stays linear
+	memoryCheckInterval = 10000
+
+	// abandonGrace is how long a timed out execution may take to abort before its VM is dropped
+	abandonGrace = 100 * time.Millisecond
+)
+
+// LimitError is returned when an execution exceeds one of the engine limits
+type LimitError struct {
+	Kind   LimitKind
+	Limit  int64  // Configured limit, zero when the limit is not numeric
+	Used   int64  // Observed usage when the limit was hit
+	Detail string // Human-readable detail for non-numeric limits
+}
+
+func (e *LimitError) Error() string {
+	if e.Detail != "" {
+		return fmt.Sprintf("lua %s limit exceeded: %s", e.Kind, e.Detail)
+	}
+	return fmt.Sprintf("lua %s limit exceeded (used %d, limit %d)", e.Kind, e.Used, e.Limit)
+}
+
+// Unwrap lets errors.Is(err, context.DeadlineExceeded) match timeouts
+func (e *LimitError) Unwrap() error {
+	if e.Kind == LimitTimeout {
+		return context.DeadlineExceeded
+	}
+	return nil
+}
+
+// AsLimitError reports whether err was caused by an exceeded limit and returns the limit error
+func AsLimitError(err error) (*LimitError, bool) {
+	var limitErr *LimitError
+	if errors.As(err, &limitErr) {
+		return limitErr, true
+	}
+	return nil, false
+}
+
+// Stats is a snapshot of the engine execution counters
+type Stats struct {
+	Executions    uint64               // Executions started
+	Failures      uint64               // Executions that returned an error
+	Active        int64                // Executions holding a VM slot
+	Waiting       int64                // Executions waiting for a VM slot
+	LimitBreaches map[LimitKind]uint64 // Executions aborted by each limit
+}
+
+type engineStats struct {
+	executions atomic.Uint64
+	failures   atomic.Uint64
+	active     atomic.Int64
+	waiting    atomic.Int64
+	breaches   sync.Map // LimitKind -> *atomic.Uint64
+}
+
+// Stats returns a snapshot of the execution counters
+func (e *Engine) Stats() Stats {
+	stats := Stats{
+		Executions:    e.stats.executions.Load(),
+		Failures:      e.stats.failures.Load(),
+		Active:        e.stats.active.Load(),
+		Waiting:       e.stats.waiting.Load(),
+		LimitBreaches: make(map[LimitKind]uint64, len(limitKinds)),
+	}
+	for _, kind := range limitKinds {
+		stats.LimitBreaches[kind] = 0
+		if counter, ok := e.stats.breaches.Load(kind); ok {
+			stats.LimitBreaches[kind] = counter.(*atomic.Uint64).Load()
+		}
+	}
+	return stats
+}
+
+// observe records the outcome of an execution and returns err unchanged
+func (e *Engine) observe(name string, err error) error {
+	e.stats.executions.Add(1)
+	if err == nil {
+		return nil
+	}
+	e.stats.failures.Add(1)
+
+	return e.recordBreach(name, err)
+}
+
+// recordBreach counts and logs err when it was caused by an exceeded limit, err is returned unchanged
+func (e *Engine) recordBreach(name string, err error) error {
+	if limitErr, ok := AsLimitError(err); ok {
+		counter, _ := e.stats.breaches.LoadOrStore(limitErr.Kind, new(atomic.Uint64))
+		counter.(*atomic.Uint64).Add(1)
+
+		e.logger.Warnw(
+			"msg", "lua limit exceeded",
+			"script", name,
+			"kind", string(limitErr.Kind),
+			"used", limitErr.Used,
+			"limit", limitErr.Limit,
+			"detail", limitErr.Detail,
+		)
+	}
+
+	return err
+}
+
+// acquireVM waits at most QueueTimeout for one of the MaxVMs execution slots,
+// the returned function releases the slot
+func (e *Engine) acquireVM(ctx context.Context) (func(), error) {
+	if e.slots == nil {
+		return func() {}, nil
+	}
+
+	release := func() {
+		<-e.slots
+		e.stats.active.Add(-1)
+	}
+
+	// Fast path when a slot is free
+	select {
+	case e.slots <- struct{}{}:
+		e.stats.active.Add(1)
+		return release, nil
+	default:
+	}
+
+	e.stats.waiting.Add(1)
+	defer e.stats.waiting.Add(-1)
+
+	timer := time.NewTimer(e.config.QueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case e.slots <- struct{}{}:
+		e.stats.active.Add(1)
+		return release, nil
+	case <-timer.C:
+		return nil, &LimitError{
+			Kind:   LimitConcurrency,
+			Limit:  int64(e.config.MaxVMs),
+			Used:   int64(e.config.MaxVMs),
+			Detail: fmt.Sprintf("no free VM within %s (max %d)", e.config.QueueTimeout, e.config.MaxVMs),
+		}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// limiter enforces the per-execution limits. It is installed as the VM context:
+// gopher-lua checks Done() before every instruction, which gives a step hook for
+// the instruction budget and the memory accounting.
+//
+// Done must only be called from the goroutine running the VM; Go functions that
+// hand the context to other goroutines should use Unlimited().
+type limiter struct {
+	context.Context
+
+	L               *lua.LState
+	timeout         time.Duration
+	maxInstructions int64
+	maxMemory       int64
+	callStackSize   int
+	registryMaxSize int
+
+	steps           int64
+	nextMemoryCheck int64
+
+	exceeded atomic.Pointer[LimitError]
+	done     chan struct{}
+}
+
+// newLimiter creates the limits for one execution on L, timeout zero means no timeout
+func (e *Engine) newLimiter(ctx context.Context, L *lua.LState, timeout time.Duration) (*limiter, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+
+	registryMaxSize := e.config.RegistryMaxSize
+	if registryMaxSize < e.config.RegistrySize {
+		registryMaxSize = e.config.RegistrySize
+	}
+
+	return &limiter{
+		Context:         ctx,
+		L:               L,
+		timeout:         timeout,
+		maxInstructions: e.config.MaxInstructions,
+		maxMemory:       e.config.MaxMemory,
+		callStackSize:   e.config.CallStackSize,
+		registryMaxSize: registryMaxSize,
+		nextMemoryCheck: memoryCheckInterval,
+		done:            make(chan struct{}),
+	}, cancel
+}
+
+func (l *limiter) Done() <-chan struct{} {
+	if l.exceeded.Load() != nil {
+		return l.done
+	}
+
+	l.steps++
+
+	if l.maxInstructions > 0 && l.steps > l.maxInstructions {
+		l.fail(&LimitError{Kind: LimitInstructions, Limit: l.maxInstructions, Used: l.steps})
+		return l.done
+	}
+
+	if l.maxMemory > 0 && l.steps >= l.nextMemoryCheck {
+		used, visited := estimateMemory(l.L)
+		if used > l.maxMemory {
+			l.fail(&LimitError{Kind: LimitMemory, Limit: l.maxMemory, Used: used})
+			return l.done
+		}
+		l.nextMemoryCheck = l.steps + max(memoryCheckInterval, visited)
+	}
+
+	// `..` builds its result in a single instruction from the registers of the running
+	// function, keeping their strings under the limit bounds what one concatenation allocates
+	if l.maxMemory > 0 {
+		if used := registerStringBytes(l.L); used > l.maxMemory {
+			l.fail(&LimitError{Kind: LimitMemory, Limit: l.maxMemory, Used: used})
+			return l.done
+		}
+	}
+
+	return l.Context.Done()
+}
+
+func (l *limiter) Err() error {
+	if limitErr := l.exceeded.Load(); limitErr != nil {
+		return limitErr
+	}
+	return l.Context.Err()
+}
+
+// Unlimited returns the execution context without the instruction and memory accounting
+func (l *limiter) Unlimited() context.Context {
+	return l.Context
+}
+
+func (l *limiter) fail(limitErr *LimitError) {
+	if l.exceeded.CompareAndSwap(nil, limitErr) {
+		close(l.done)
+	}
+}
+
+// result converts an execution error into a *LimitError when it was caused by a limit
+func (l *limiter) result(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if limitErr := l.exceeded.Load(); limitErr != nil {
+		return limitErr
+	}
+
+	if errors.Is(l.Context.Err(), context.DeadlineExceeded) {
+		return &LimitError{
+			Kind:   LimitTimeout,
+			Limit:  l.timeout.Milliseconds(),
+			Detail: fmt.Sprintf("execution timeout after %s", l.timeout),
+		}
+	}
+
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "stack overflow"):
+		return &LimitError{Kind: LimitCallStack, Limit: int64(l.callStackSize), Used: int64(l.callStackSize)}
+	case strings.Contains(msg, "registry overflow"):
+		return &LimitError{Kind: LimitRegistry, Limit: int64(l.registryMaxSize), Used: int64(l.registryMaxSize)}
+	}
+
+	return err
+}
+
+// moduleAliases maps the short module names to the registered modules
+var moduleAliases = map[string]string{
+	"logger": "kratos_logger",
+	"hook":   "kratos_hook",
+	"util":   "kratos_util",
+	"http":   "kratos_http",
+	"db":     "kratos_db",
+}
+
+func canonicalModule(name string) string {
+	if module, ok := moduleAliases[name]; ok {
+		return module
+	}
+	return name
+}
+
+// moduleAllowed reports whether AllowedModules permits the module, an empty list allows all modules
+func (e *Engine) moduleAllowed(name string) bool {
+	if len(e.config.AllowedModules) == 0 {
+		return true
+	}
+
+	module := canonicalModule(name)
+	for _, allowed := range e.config.AllowedModules {
+		if canonicalModule(allowed) == module {
+			return true
+		}
+	}
+	return false
+}
+
+// checkModule aborts the execution when require() loads a module that is not allowed
+func (e *Engine) checkModule(L *lua.LState, name string) {
+	if e.moduleAllowed(name) {
+		return
+	}
+
+	limitErr := &LimitError{Kind: LimitModule, Detail: fmt.Sprintf("module '%s' is not allowed", name)}
+	if lim, ok := L.Context().(*limiter); ok {
+		lim.fail(limitErr)
+	}
+	L.RaiseError("%s", limitErr.Error())
+}
+
+// capAllocations wraps the library functions that build a string of arbitrary size in
+// one call, the size is checked against MaxMemory before the string is allocated
+func (e *Engine) capAllocations(L *lua.LState) {
+	strlib := L.GetGlobal(lua.StringLibName).(*lua.LTable)
+	if rep, ok := strlib.RawGetString("rep").(*lua.LFunction); ok && rep.IsG {
+		strlib.RawSetString("rep", L.NewFunction(func(L *lua.LState) int {
+			str := L.CheckString(1)
+			n := L.CheckInt(2)
+			checkAllocation(L, saturatingMul(int64(len(str)), int64(n)))
+			return rep.GFunction(L)
+		}))
+	}
+
+	tablib := L.GetGlobal(lua.TabLibName).(*lua.LTable)
+	if concat, ok := tablib.RawGetString("concat").(*lua.LFunction); ok && concat.IsG {
+		tablib.RawSetString("concat", L.NewFunction(func(L *lua.LState) int {
+			checkAllocation(L, concatSize(L))
+			return concat.GFunction(L)
+		}))
+	}
+}
+
+// checkAllocation aborts the execution when allocating size bytes would exceed MaxMemory
+func checkAllocation(L *lua.LState, size int64) {
+	lim, ok := L.Context().(*limiter)
+	if !ok || lim.maxMemory <= 0 || size <= lim.maxMemory {
+		return
+	}
+
+	limitErr := &LimitError{Kind: LimitMemory, Limit: lim.maxMemory, Used: size}
+	lim.fail(limitErr)
+	L.RaiseError("%s", limitErr.Error())
+}
+
+// concatSize returns the length of the string table.concat would build from its arguments,
+// the walk stops at the first value that cannot be concatenated since table.concat fails there
+func concatSize(L *lua.LState) int64 {
+	tbl := L.CheckTable(1)
+	sep := int64(len(L.OptString(2, "")))
+	i := L.OptInt(3, 1)
+	j := min(L.OptInt(4, tbl.Len()), tbl.Len())
+
+	var size int64
+	for k := max(i, 1); k <= j; k++ {
+		switch v := tbl.RawGetInt(k).(type) {
+		case lua.LString:
+			size += int64(len(v))
+		case lua.LNumber:
+			size += int64(len(v.String()))
+		default:
+			return size
+		}
+		if k != j {
+			size += sep
+		}
+		if size < 0 {
+			return math.MaxInt64
+		}
+	}
+	return size
+}
+
+func saturatingMul(a, b int64) int64 {
+	if a <= 0 || b <= 0 {
+		return 0
+	}
+	if a > math.MaxInt64/b {
+		return math.MaxInt64
+	}
+	return a * b
+}
+
+// registerStringBytes returns the length of the strings held in the registers of the running function
+func registerStringBytes(L *lua.LState) int64 {
+	var bytes int64
+	for i := L.GetTop(); i > 0; i-- {
+		if str, ok := L.Get(i).(lua.LString); ok {
+			bytes += int64(len(str))
+		}
+	}
+	return bytes
+}
+
+// estimateMemory approximates the memory held by the values reachable from the
+// globals, the registry and the locals of the running functions. gopher-lua has
+// no allocator hook, so sizes are estimated from the value shapes.
+func estimateMemory(L *lua.LState) (bytes int64, visited int64) {
+	const (
+		stringOverhead   = 16
+		tableOverhead    = 64
+		entryOverhead    = 40
+		functionOverhead = 64
+		userDataOverhead = 48
+	)
+
+	seen := make(map[lua.LValue]struct{})
+	pending := []lua.LValue{L.G.Global, L.G.Registry}
+
+	for level := 0; ; level++ {
+		dbg, ok := L.GetStack(level)
+		if !ok {
+			break
+		}
+		for i := 1; ; i++ {
+			name, v := L.GetLocal(dbg, i)
+			if name == "" {
+				break
+			}
+			pending = append(pending, v)
+		}
+	}
+
+	for len(pending) > 0 {
+		v := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		visited++
+
+		switch tv := v.(type) {
+		case lua.LString:
+			bytes += int64(len(tv)) + stringOverhead
+
+		case *lua.LTable:
+			if _, ok := seen[tv]; ok {
+				continue
+			}
+			seen[tv] = struct{}{}
+
+			bytes += tableOverhead
+			tv.ForEach(func(key, value lua.LValue) {
+				bytes += entryOverhead
+				pending = append(pending, key, value)
+			})
+			if tv.Metatable != nil {
+				pending = append(pending, tv.Metatable)
+			}
+
+		case *lua.LFunction:
+			if _, ok := seen[tv]; ok {
+				continue
+			}
+			seen[tv] = struct{}{}
+
+			bytes += functionOverhead
+			for _, uv := range tv.Upvalues {
+				pending = append(pending, uv.Value())
+			}
+
+		case *lua.LUserData:
+			if _, ok := seen[tv]; ok {
+				continue
+			}
+			seen[tv] = struct{}{}
+
+			bytes += userDataOverhead
+			if tv.Metatable != nil {
+				pending = append(pending, tv.Metatable)
+			}
+		}
+	}
+
+	return bytes, visited
+}
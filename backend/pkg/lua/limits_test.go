@@ -0,0 +1,180 @@
+package lua
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newLimitTestEngine(t *testing.T, configure func(config *Config)) *Engine {
+	t.Helper()
+
+	config := DefaultConfig()
+	config.ScriptDir = ""
+	config.PoolSize = 1
+	configure(config)
+
+	engine := NewEngine(config, log.DefaultLogger)
+	t.Cleanup(func() { _ = engine.Close() })
+	return engine
+}
+
+func executeLimited(t *testing.T, engine *Engine, source string) *LimitError {
+	t.Helper()
+
+	err := engine.Execute(context.Background(), &Script{Name: t.Name(), Source: source}, NewContext("test"))
+	limitErr, ok := AsLimitError(err)
+	if !ok {
+		t.Fatalf("expected a limit error, got %v", err)
+	}
+	return limitErr
+}
+
+func TestLimits_Instructions(t *testing.T) {
+	engine := newLimitTestEngine(t, func(config *Config) {
+		config.MaxInstructions = 10000
+	})
+
+	limitErr := executeLimited(t, engine, `while true do end`)
+	if limitErr.Kind != LimitInstructions || limitErr.Limit != 10000 {
+		t.Fatalf("unexpected limit error: %+v", limitErr)
+	}
+
+	// The budget is per execution, the pooled VM is usable again
+	if err := engine.Execute(context.Background(), &Script{Name: "ok", Source: `local x = 1`}, NewContext("test")); err != nil {
+		t.Fatalf("execution after breach failed: %v", err)
+	}
+
+	stats := engine.Stats()
+	if stats.Executions != 2 || stats.Failures != 1 || stats.LimitBreaches[LimitInstructions] != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestLimits_Memory(t *testing.T) {
+	engine := newLimitTestEngine(t, func(config *Config) {
+		config.MaxInstructions = 0
+		config.MaxMemory = 1024 * 1024
+	})
+
+	limitErr := executeLimited(t, engine, `
+local t = {}
+for i = 1, 10000000 do
+    t[i] = string.rep("x", 100) .. i
+end
+`)
+	if limitErr.Kind != LimitMemory || limitErr.Used <= limitErr.Limit {
+		t.Fatalf("unexpected limit error: %+v", limitErr)
+	}
+}
+
+func TestLimits_SingleAllocation(t *testing.T) {
+	engine := newLimitTestEngine(t, func(config *Config) {
+		config.MaxInstructions = 0
+		config.MaxMemory = 1024 * 1024
+	})
+
+	sources := map[string]string{
+		"string.rep":   `local s = string.rep("x", 256 * 1024 * 1024)`,
+		"method rep":   `local s = ("x"):rep(256 * 1024 * 1024)`,
+		"table.concat": `local t = {} for i = 1, 64 do t[i] = string.rep("x", 512 * 1024) end local s = table.concat(t)`,
+		"concat":       `local s = string.rep("x", 512 * 1024) for i = 1, 30 do s = s .. s end`,
+	}
+	for name, source := range sources {
+		t.Run(name, func(t *testing.T) {
+			limitErr := executeLimited(t, engine, source)
+			if limitErr.Kind != LimitMemory || limitErr.Used <= limitErr.Limit {
+				t.Fatalf("unexpected limit error: %+v", limitErr)
+			}
+		})
+	}
+}
+
+func TestLimits_CallStack(t *testing.T) {
+	engine := newLimitTestEngine(t, func(config *Config) {})
+
+	limitErr := executeLimited(t, engine, `
+local function f(n) return 1 + f(n + 1) end
+f(1)
+`)
+	if limitErr.Kind != LimitCallStack {
+		t.Fatalf("unexpected limit error: %+v", limitErr)
+	}
+}
+
+func TestLimits_Timeout(t *testing.T) {
+	engine := newLimitTestEngine(t, func(config *Config) {
+		config.VMTimeout = 100 * time.Millisecond
+	})
+
+	err := engine.Execute(context.Background(), &Script{Name: "sleep", Source: `
+local util = require "kratos_util"
+util.sleep(10)
+`}, NewContext("test"))
+	if limitErr, ok := AsLimitError(err); !ok || limitErr.Kind != LimitTimeout {
+		t.Fatalf("expected timeout limit error, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected timeout to match context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestLimits_Concurrency(t *testing.T) {
+	engine := newLimitTestEngine(t, func(config *Config) {
+		config.MaxVMs = 1
+		config.QueueTimeout = 50 * time.Millisecond
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		done <- engine.Execute(context.Background(), &Script{Name: "slow", Source: `
+local util = require "kratos_util"
+util.sleep(0.5)
+`}, NewContext("test"))
+	}()
+
+	// Wait until the slow script holds the only slot
+	deadline := time.Now().Add(time.Second)
+	for engine.Stats().Active == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	limitErr := executeLimited(t, engine, `local x = 1`)
+	if limitErr.Kind != LimitConcurrency || limitErr.Limit != 1 {
+		t.Fatalf("unexpected limit error: %+v", limitErr)
+	}
+
+	if err := <-done; err != nil {
+		t.Fatalf("slow script failed: %v", err)
+	}
+	if stats := engine.Stats(); stats.Active != 0 || stats.Waiting != 0 {
+		t.Fatalf("slots not released: %+v", stats)
+	}
+}
+
+func TestLimits_AllowedModules(t *testing.T) {
+	engine := newLimitTestEngine(t, func(config *Config) {
+		config.AllowedModules = []string{"logger"}
+	})
+
+	// Aliases and module names are interchangeable
+	if err := engine.Execute(context.Background(), &Script{Name: "ok", Source: `
+local log = require "kratos_logger"
+log.info("allowed")
+assert(hook == nil, "hook global must not be set")
+`}, NewContext("test")); err != nil {
+		t.Fatalf("allowed module failed: %v", err)
+	}
+
+	// A denied module aborts the execution even inside pcall
+	limitErr := executeLimited(t, engine, `
+pcall(require, "kratos_util")
+local x = 1
+`)
+	if limitErr.Kind != LimitModule {
+		t.Fatalf("unexpected limit error: %+v", limitErr)
+	}
+}
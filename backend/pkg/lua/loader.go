@@ -78,13 +78,18 @@ func (e *Engine) LoadScriptFile(ctx context.Context, filePath string) error {
 		}
 	}()
 
-	// Set context
-	L.SetContext(ctx)
+	// Loading is bound by the same limits as an execution
+	lim, cancel := e.newLimiter(ctx, L, e.config.VMTimeout)
+	defer cancel()
+	L.SetContext(lim)
+	defer L.RemoveContext()
+
+	defer e.loadingScript(L, string(content))()
 
 	// Execute the script
 	// This allows the script to call hook.register() and hook.add_script()
 	if err := L.DoString(string(content)); err != nil {
-		return fmt.Errorf("failed to execute script: %w", err)
+		return fmt.Errorf("failed to execute script: %w", e.recordBreach(filePath, lim.result(err)))
 	}
 
 	e.logger.Debugf("Successfully loaded script: %s", filePath)
@@ -112,12 +117,17 @@ func (e *Engine) LoadScriptString(ctx context.Context, scriptName, source string
 		}
 	}()
 
-	// Set context
-	L.SetContext(ctx)
+	// Loading is bound by the same limits as an execution
+	lim, cancel := e.newLimiter(ctx, L, e.config.VMTimeout)
+	defer cancel()
+	L.SetContext(lim)
+	defer L.RemoveContext()
+
+	defer e.loadingScript(L, source)()
 
 	// Execute the script
 	if err := L.DoString(source); err != nil {
-		return fmt.Errorf("failed to execute script %s: %w", scriptName, err)
+		return fmt.Errorf("failed to execute script %s: %w", scriptName, e.recordBreach(scriptName, lim.result(err)))
 	}
 
 	e.logger.Debugf("Successfully loaded script: %s", scriptName)
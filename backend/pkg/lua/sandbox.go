@@ -0,0 +1,125 @@
+package lua
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/parse"
+)
+
+// LogEntry is a log line written by a script during TestScript
+type LogEntry struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// CompileScript parses and compiles the source without running it
+func CompileScript(name, source string) error {
+	chunk, err := parse.Parse(strings.NewReader(source), name)
+	if err != nil {
+		return fmt.Errorf("syntax error: %w", err)
+	}
+
+	if _, err = lua.Compile(chunk, name); err != nil {
+		return fmt.Errorf("compile error: %w", err)
+	}
+
+	return nil
+}
+
+// TestScript runs a script once against execCtx on a throwaway VM and returns
+// the logs it wrote. Hook and task registrations made by the script are ignored,
+// so testing never changes what the engine executes.
+func (e *Engine) TestScript(ctx context.Context, script *Script, execCtx *Context) ([]LogEntry, error) {
+	capture := &captureLogger{}
+	logger := log.NewHelper(capture)
+
+	if err := CompileScript(script.Name, script.Source); err != nil {
+		return nil, err
+	}
+
+	// Test executions share the MaxVMs slots with the hooks
+	release, err := e.acquireVM(ctx)
+	if err != nil {
+		return nil, e.observe(script.Name, err)
+	}
+	defer release()
+
+	L := e.newVM(logger, &sandboxHooks{engine: e, logger: logger, mode: "test execution"}, false)
+	defer L.Close()
+
+	// Only keep what the script writes, not the API registration messages
+	capture.Reset()
+
+	lim, cancel := e.newLimiter(ctx, L, e.config.VMTimeout)
+	defer cancel()
+
+	err = lim.result(e.runScript(lim, L, script, execCtx))
+
+	return capture.Entries(), e.observe(script.Name, err)
+}
+
+// captureLogger records the log lines written through a log.Helper
+type captureLogger struct {
+	mu      sync.Mutex
+	entries []LogEntry
+}
+
+func (c *captureLogger) Log(level log.Level, keyvals ...interface{}) error {
+	var msg string
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		if keyvals[i] == log.DefaultMessageKey {
+			msg = fmt.Sprint(keyvals[i+1])
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = append(c.entries, LogEntry{Level: level.String(), Message: msg})
+
+	return nil
+}
+
+func (c *captureLogger) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = nil
+}
+
+func (c *captureLogger) Entries() []LogEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := make([]LogEntry, len(c.entries))
+	copy(entries, c.entries)
+	return entries
+}
+
+// sandboxHooks lets a script call the hook API without registering anything,
+// for scripts that are run again outside of loading
+type sandboxHooks struct {
+	engine *Engine
+	logger *log.Helper
+	mode   string // Named in the warnings, e.g. "test execution"
+}
+
+func (h *sandboxHooks) RegisterHook(_, _ string) error {
+	return nil
+}
+
+func (h *sandboxHooks) AddScript(hookName string, _ interface{}) error {
+	h.logger.Warnf("hook.add_script(%s) is ignored in %s", hookName, h.mode)
+	return nil
+}
+
+func (h *sandboxHooks) ListHooks() []string {
+	return h.engine.ListHooks()
+}
+
+func (h *sandboxHooks) RegisterCallback(hookName string, _ *lua.LState, _ *lua.LFunction) {
+	h.logger.Warnf("callback for hook %s is ignored in %s", hookName, h.mode)
+}
@@ -0,0 +1,131 @@
+package lua
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestCompileScript(t *testing.T) {
+	if err := CompileScript("ok", `local x = 1 return x`); err != nil {
+		t.Fatalf("valid script failed to compile: %v", err)
+	}
+
+	if err := CompileScript("bad", `local x = `); err == nil {
+		t.Fatal("expected syntax error")
+	}
+}
+
+func TestTestScript(t *testing.T) {
+	config := DefaultConfig()
+	config.ScriptDir = ""
+	engine := NewEngine(config, log.DefaultLogger)
+	defer engine.Close()
+
+	script := &Script{
+		Name: "test_script",
+		Hook: HookBeforeLogin,
+		Source: `
+hook.register("before_login", "", function(ctx) end)
+
+function execute(ctx)
+    log.info("checking " .. ctx.get("username"))
+    ctx.set("checked", true)
+    if ctx.get("username") == "blocked" then
+        ctx.stop("blocked")
+    end
+    return true
+end
+`,
+	}
+
+	execCtx := NewContext(HookBeforeLogin)
+	execCtx.Data["username"] = "blocked"
+
+	logs, err := engine.TestScript(context.Background(), script, execCtx)
+	if err != nil {
+		t.Fatalf("TestScript failed: %v", err)
+	}
+	if !execCtx.Stopped || execCtx.StopReason != "blocked" {
+		t.Fatalf("expected script to stop, got %v %q", execCtx.Stopped, execCtx.StopReason)
+	}
+	if execCtx.Data["checked"] != true {
+		t.Fatalf("expected context data to be set, got %v", execCtx.Data["checked"])
+	}
+
+	var found bool
+	for _, entry := range logs {
+		if entry.Level == "INFO" && entry.Message == "checking blocked" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("script log not captured: %+v", logs)
+	}
+
+	// Registrations made while testing must not reach the engine
+	engine.mu.RLock()
+	callbacks := len(engine.callbacks[HookBeforeLogin])
+	engine.mu.RUnlock()
+	if callbacks != 0 {
+		t.Fatalf("test execution registered %d callbacks", callbacks)
+	}
+
+	// Compile errors are reported without running the script
+	if _, err = engine.TestScript(context.Background(), &Script{Name: "bad", Source: "function ("}, NewContext("")); err == nil {
+		t.Fatal("expected compile error")
+	}
+}
+
+func TestTestScript_Timeout(t *testing.T) {
+	config := DefaultConfig()
+	config.ScriptDir = ""
+	config.VMTimeout = 100 * time.Millisecond
+	engine := NewEngine(config, log.DefaultLogger)
+	defer engine.Close()
+
+	_, err := engine.TestScript(context.Background(), &Script{Name: "loop", Source: `while true do end`}, NewContext(""))
+	if err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+}
+
+func TestEngine_UpsertScript(t *testing.T) {
+	config := DefaultConfig()
+	config.ScriptDir = ""
+	engine := NewEngine(config, log.DefaultLogger)
+	defer engine.Close()
+
+	script := &Script{Name: "counter", Hook: "custom", Enabled: true, Source: `
+function execute(ctx)
+    ctx.set("value", 1)
+end
+`}
+	if err := engine.UpsertScript("custom", script); err != nil {
+		t.Fatalf("UpsertScript failed: %v", err)
+	}
+
+	updated := script.Clone()
+	updated.Source = `
+function execute(ctx)
+    ctx.set("value", 2)
+end
+`
+	if err := engine.UpsertScript("custom", updated); err != nil {
+		t.Fatalf("UpsertScript failed: %v", err)
+	}
+
+	execCtx := NewContext("custom")
+	if err := engine.ExecuteHook(context.Background(), "custom", execCtx); err != nil {
+		t.Fatalf("ExecuteHook failed: %v", err)
+	}
+	if v, _ := execCtx.Data["value"].(float64); v != 2 {
+		t.Fatalf("expected replaced script to run once, got %v", execCtx.Data["value"])
+	}
+	if n := len(engine.registry.GetScripts("custom")); n != 1 {
+		t.Fatalf("expected 1 script, got %d", n)
+	}
+}
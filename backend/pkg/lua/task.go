@@ -0,0 +1,181 @@
+package lua
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+
+	"go-wind-admin/pkg/lua/api"
+	"go-wind-admin/pkg/lua/internal/convert"
+	"go-wind-admin/pkg/task"
+)
+
+// ErrInvalidTaskPayload is returned when a payload does not satisfy the handler's required fields
+var ErrInvalidTaskPayload = errors.New("invalid task payload")
+
+// TaskHandlers returns the task handlers registered by scripts through task.register_handler(), sorted by name
+func TaskHandlers() []*api.LuaTaskHandler {
+	names := api.GetRegisteredHandlerNames()
+	handlers := make([]*api.LuaTaskHandler, 0, len(names))
+	for _, name := range names {
+		if h, ok := api.GetHandler(name); ok {
+			handlers = append(handlers, h)
+		}
+	}
+	return handlers
+}
+
+// TaskHandler returns the script task handler registered under name
+func TaskHandler(name string) (*api.LuaTaskHandler, bool) {
+	return api.GetHandler(name)
+}
+
+// TaskTimeout returns the execution timeout of a handler, zero means no timeout
+func TaskTimeout(h *api.LuaTaskHandler) time.Duration {
+	if h == nil || h.TimeoutSecs <= 0 {
+		return 0
+	}
+	return time.Duration(h.TimeoutSecs) * time.Second
+}
+
+// ValidateTaskPayload checks that all required fields are present and returns
+// a copy of the payload with defaults filled in for missing optional fields
+func ValidateTaskPayload(h *api.LuaTaskHandler, payload map[string]any) (map[string]any, error) {
+	result := make(map[string]any, len(payload)+len(h.Optional))
+	for k, v := range payload {
+		result[k] = v
+	}
+
+	for _, field := range h.Required {
+		if v, ok := result[field]; !ok || v == nil {
+			return nil, fmt.Errorf("%w: missing required field '%s'", ErrInvalidTaskPayload, field)
+		}
+	}
+
+	for field, def := range h.Optional {
+		if v, ok := result[field]; !ok || v == nil {
+			result[field] = def
+		}
+	}
+
+	return result, nil
+}
+
+// ExecuteTaskHandler validates the payload and runs the handler with its timeout and the engine limits.
+//
+// The handler receives a context table:
+//
+//	ctx.type                     -- task type name
+//	ctx.payload                  -- payload table with optional defaults applied
+//	ctx.get(key)                 -- payload value
+//	ctx.report(percent, message) -- report progress
+//	ctx.set_result(value)        -- record the run result
+//
+// Returning false (optionally followed by a reason) or raising an error fails the task;
+// returning any other non-boolean value records it as the result.
+func (e *Engine) ExecuteTaskHandler(ctx context.Context, h *api.LuaTaskHandler, payload map[string]any) (err error) {
+	data, err := ValidateTaskPayload(h, payload)
+	if err != nil {
+		return err
+	}
+
+	// Wait for a free execution slot
+	release, err := e.acquireVM(ctx)
+	if err != nil {
+		return e.observe("task:"+h.Name, err)
+	}
+	defer release()
+	defer func() {
+		err = e.observe("task:"+h.Name, err)
+	}()
+
+	// Each execution loads the handler on its own pooled VM, so executions run in parallel
+	L := e.taskPool.Get()
+	defer e.taskPool.Put(L)
+
+	// Task handlers use their own timeout, the other limits are the engine limits.
+	// They cover loading the handler as well as running it.
+	lim, cancel := e.newLimiter(ctx, L, TaskTimeout(h))
+	defer cancel()
+
+	L.SetContext(lim)
+	defer L.RemoveContext()
+
+	handlers, err := api.CaptureTaskHandlers(L, func() error {
+		return L.DoString(h.Source)
+	})
+	if err != nil {
+		if limitErr := lim.result(err); limitErr != err {
+			return fmt.Errorf("task handler '%s' aborted: %w", h.Name, limitErr)
+		}
+		return fmt.Errorf("task handler '%s' load error: %w", h.Name, err)
+	}
+	fn, ok := handlers[h.Name]
+	if !ok {
+		return fmt.Errorf("task handler '%s' is not registered by its script", h.Name)
+	}
+
+	progress := task.ProgressFromContext(ctx)
+
+	L.Push(fn)
+	L.Push(taskContextToLuaTable(L, h.Name, data, progress))
+	if err = L.PCall(1, 2, nil); err != nil {
+		if limitErr := lim.result(err); limitErr != err {
+			return fmt.Errorf("task handler '%s' aborted: %w", h.Name, limitErr)
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("task handler '%s' aborted: %w", h.Name, ctx.Err())
+		}
+		return fmt.Errorf("task handler '%s' error: %w", h.Name, err)
+	}
+
+	ret, reason := L.Get(-2), L.Get(-1)
+	switch ret.Type() {
+	case lua.LTBool:
+		if !lua.LVAsBool(ret) {
+			if reason.Type() == lua.LTString {
+				return fmt.Errorf("task handler '%s' failed: %s", h.Name, reason.String())
+			}
+			return fmt.Errorf("task handler '%s' returned false", h.Name)
+		}
+	case lua.LTNil:
+	default:
+		progress.SetResult(convert.ToGoValue(ret))
+	}
+
+	return nil
+}
+
+// taskContextToLuaTable builds the context table passed to a task handler
+func taskContextToLuaTable(L *lua.LState, typeName string, payload map[string]any, progress task.ProgressReporter) *lua.LTable {
+	table := L.NewTable()
+
+	table.RawSetString("type", lua.LString(typeName))
+	table.RawSetString("payload", convert.ToLuaValue(L, payload))
+
+	table.RawSetString("get", L.NewFunction(func(L *lua.LState) int {
+		key := L.CheckString(1)
+		L.Push(convert.ToLuaValue(L, payload[key]))
+		return 1
+	}))
+
+	table.RawSetString("report", L.NewFunction(func(L *lua.LState) int {
+		percent := L.CheckInt(1)
+		message := L.OptString(2, "")
+		if percent < 0 {
+			percent = 0
+		}
+		progress.Report(uint32(percent), message)
+		return 0
+	}))
+
+	table.RawSetString("set_result", L.NewFunction(func(L *lua.LState) int {
+		progress.SetResult(convert.ToGoValue(L.Get(1)))
+		return 0
+	}))
+
+	return table
+}
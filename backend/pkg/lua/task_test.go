@@ -0,0 +1,195 @@
+package lua
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"go-wind-admin/pkg/task"
+)
+
+type recordingProgress struct {
+	mu      sync.Mutex
+	percent uint32
+	message string
+	result  any
+}
+
+func (p *recordingProgress) Report(percent uint32, message string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.percent = percent
+	p.message = message
+}
+
+func (p *recordingProgress) SetResult(result any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.result = result
+}
+
+func newTaskTestEngine(t *testing.T, source string) *Engine {
+	t.Helper()
+
+	config := DefaultConfig()
+	config.ScriptDir = "" // Don't auto-load
+	config.PoolSize = 1
+	engine := NewEngine(config, log.DefaultLogger)
+	t.Cleanup(func() { _ = engine.Close() })
+
+	if err := engine.LoadScriptString(context.Background(), t.Name(), source); err != nil {
+		t.Fatalf("Failed to load script: %v", err)
+	}
+	return engine
+}
+
+func TestExecuteTaskHandler(t *testing.T) {
+	engine := newTaskTestEngine(t, `
+local total = 0
+
+task.register_handler("test_sum", "Sums numbers", function(ctx)
+    total = total + ctx.get("a") + ctx.payload.b
+    ctx.report(50, "half way")
+    return { sum = total, mode = ctx.get("mode"), type = ctx.type }
+end, {
+    required = {"a"},
+    optional = {b = 10, mode = "fast"},
+    timeout_secs = 3,
+    max_retries = 5
+})
+
+task.register_handler("test_fail", "Always fails", function(ctx)
+    return false, "quota exceeded"
+end)
+`)
+
+	h, ok := TaskHandler("test_sum")
+	if !ok {
+		t.Fatal("Expected test_sum to be registered")
+	}
+	if TaskTimeout(h) != 3*time.Second || h.MaxRetries != 5 {
+		t.Errorf("Unexpected options: timeout=%s retries=%d", TaskTimeout(h), h.MaxRetries)
+	}
+
+	names := map[string]bool{}
+	for _, handler := range TaskHandlers() {
+		names[handler.Name] = true
+	}
+	if !names["test_sum"] || !names["test_fail"] {
+		t.Errorf("Expected both handlers to be listed, got %v", names)
+	}
+
+	// Required fields are checked before running the handler
+	err := engine.ExecuteTaskHandler(context.Background(), h, map[string]any{"b": 1})
+	if !errors.Is(err, ErrInvalidTaskPayload) {
+		t.Fatalf("Expected invalid payload error, got %v", err)
+	}
+
+	progress := &recordingProgress{}
+	ctx := task.NewProgressContext(context.Background(), progress)
+	if err = engine.ExecuteTaskHandler(ctx, h, map[string]any{"a": float64(1)}); err != nil {
+		t.Fatalf("Handler failed: %v", err)
+	}
+
+	result, _ := progress.result.(map[string]any)
+	if result["sum"] != float64(11) || result["mode"] != "fast" || result["type"] != "test_sum" {
+		t.Errorf("Unexpected result: %v", progress.result)
+	}
+	if progress.percent != 50 || progress.message != "half way" {
+		t.Errorf("Unexpected progress: %d %s", progress.percent, progress.message)
+	}
+
+	// Each execution loads the script again, upvalues don't carry over
+	if err = engine.ExecuteTaskHandler(ctx, h, map[string]any{"a": float64(1), "b": float64(2)}); err != nil {
+		t.Fatalf("Handler failed: %v", err)
+	}
+	if result, _ = progress.result.(map[string]any); result["sum"] != float64(3) {
+		t.Errorf("Expected sum 3, got %v", progress.result)
+	}
+
+	fail, _ := TaskHandler("test_fail")
+	err = engine.ExecuteTaskHandler(context.Background(), fail, nil)
+	if err == nil || !strings.Contains(err.Error(), "quota exceeded") {
+		t.Errorf("Expected failure reason, got %v", err)
+	}
+}
+
+func TestExecuteTaskHandler_Timeout(t *testing.T) {
+	engine := newTaskTestEngine(t, `
+local util = require "kratos_util"
+
+task.register_handler("test_loop", "Never returns", function(ctx)
+    while true do end
+end, { timeout_secs = 1 })
+
+task.register_handler("test_sleep", "Sleeps too long", function(ctx)
+    util.sleep(30)
+    return true
+end, { timeout_secs = 1 })
+
+task.register_handler("test_ok", "Returns immediately", function(ctx)
+    return true
+end)
+`)
+
+	for _, name := range []string{"test_loop", "test_sleep"} {
+		h, _ := TaskHandler(name)
+
+		start := time.Now()
+		err := engine.ExecuteTaskHandler(context.Background(), h, nil)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("%s: expected timeout, got %v", name, err)
+		}
+		if elapsed := time.Since(start); elapsed > 3*time.Second {
+			t.Errorf("%s: timeout took too long: %s", name, elapsed)
+		}
+	}
+
+	// The VM is still usable after an aborted execution
+	h, _ := TaskHandler("test_ok")
+	if err := engine.ExecuteTaskHandler(context.Background(), h, nil); err != nil {
+		t.Errorf("Expected handler to succeed after timeout, got %v", err)
+	}
+}
+
+func TestExecuteTaskHandler_Parallel(t *testing.T) {
+	engine := newTaskTestEngine(t, `
+local util = require "kratos_util"
+
+hook.register("test_parallel_hook", "Registered while loading")
+
+task.register_handler("test_parallel", "Sleeps briefly", function(ctx)
+    util.sleep(0.5)
+    return true
+end)
+`)
+
+	h, _ := TaskHandler("test_parallel")
+
+	// Executions no longer share the registering VM, so they run at the same time
+	start := time.Now()
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = engine.ExecuteTaskHandler(context.Background(), h, nil)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("Handler failed: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 1200*time.Millisecond {
+		t.Errorf("Executions were serialized: %s", elapsed)
+	}
+}
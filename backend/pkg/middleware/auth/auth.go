@@ -21,6 +21,7 @@ import (
 
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 
+	"go-wind-admin/pkg/constants"
 	appViewer "go-wind-admin/pkg/entgo/viewer"
 	"go-wind-admin/pkg/jwt"
 	"go-wind-admin/pkg/metadata"
@@ -88,6 +89,36 @@ func Server(opts ...Option) middleware.Middleware {
 				}
 			}
 
+			// 校验令牌所属的会话是否已被注销
+			if op.sessionChecker != nil && tokenPayload.GetSessionId() != "" {
+				if !op.sessionChecker.IsActiveSession(ctx, tokenPayload.UserId, tokenPayload.GetSessionId()) {
+					op.log.Errorf("auth middleware: session [%s] revoked for user id [%d]", tokenPayload.GetSessionId(), tokenPayload.UserId)
+					return nil, ErrSessionRevoked
+				}
+			}
+
+			// 校验客户端令牌所属的客户端是否仍然启用、换取令牌的密钥是否仍然有效
+			if op.clientChecker != nil && tokenPayload.GetSubjectType() == authenticationV1.UserTokenPayload_CLIENT {
+				if !op.clientChecker.IsActiveClient(ctx, tokenPayload.GetClientId(), tokenPayload.GetClientSecretVersion()) {
+					op.log.Errorf("auth middleware: oauth client [%s] revoked", tokenPayload.GetClientId())
+					return nil, ErrClientRevoked
+				}
+			}
+
+			// 密码已过期的令牌只能调用修改密码等少数操作
+			if tokenPayload.GetPasswordExpired() {
+				if _, ok := op.passwordChangeAllowedOperations[tr.Operation()]; !ok {
+					op.log.Errorf("auth middleware: password of user id [%d] expired, cannot call [%s]", tokenPayload.UserId, tr.Operation())
+					return nil, ErrPasswordExpired
+				}
+			}
+
+			// 只读令牌（如模拟登录令牌）只允许查询类请求
+			if tokenPayload.GetReadOnly() && !op.isReadOnlyAllowed(tr) {
+				op.log.Errorf("auth middleware: read-only token of user id [%d] cannot call [%s]", tokenPayload.UserId, tr.Operation())
+				return nil, ErrReadOnlyToken
+			}
+
 			// 检查访问令牌是否过期
 			if op.enableCheckTokenExpiration {
 				if jwt.IsTokenExpired(authnClaims) {
@@ -162,6 +193,25 @@ func Server(opts ...Option) middleware.Middleware {
 	}
 }
 
+// isReadOnlyAllowed 只读令牌是否可以调用当前操作：HTTP 的查询类方法或显式允许的操作
+func (o *options) isReadOnlyAllowed(tr transport.Transporter) bool {
+	if _, ok := o.readOnlyAllowedOperations[tr.Operation()]; ok {
+		return true
+	}
+
+	htr, ok := tr.(*http.Transport)
+	if !ok {
+		return false
+	}
+
+	switch htr.Request().Method {
+	case "GET", "HEAD", "OPTIONS":
+		return true
+	default:
+		return false
+	}
+}
+
 func processAuthz(
 	ctx context.Context,
 	tr transport.Transporter,
@@ -182,7 +232,7 @@ func processAuthz(
 	//)
 
 	authzClaims := authzEngine.AuthClaims{
-		Subjects: trans.Ptr(tokenPayload.GetRoles()),
+		Subjects: trans.Ptr(authzSubjects(tokenPayload)),
 		Action:   trans.Ptr(action),
 		Resource: trans.Ptr(path),
 	}
@@ -192,6 +242,19 @@ func processAuthz(
 	return ctx, nil
 }
 
+// authzSubjects 返回鉴权主体：用户令牌为角色码，客户端令牌为带前缀的授权范围
+func authzSubjects(tokenPayload *authenticationV1.UserTokenPayload) []string {
+	if tokenPayload.GetSubjectType() != authenticationV1.UserTokenPayload_CLIENT {
+		return tokenPayload.GetRoles()
+	}
+
+	subjects := make([]string, 0, len(tokenPayload.GetScopes()))
+	for _, scope := range tokenPayload.GetScopes() {
+		subjects = append(subjects, constants.ClientScopeSubject(scope))
+	}
+	return subjects
+}
+
 func FromContext(ctx context.Context) (*authenticationV1.UserTokenPayload, error) {
 	claims, ok := authnEngine.AuthClaimsFromContext(ctx)
 	if !ok {
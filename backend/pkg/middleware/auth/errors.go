@@ -3,7 +3,9 @@ package auth
 import "github.com/go-kratos/kratos/v2/errors"
 
 const (
-	reason string = "UNAUTHORIZED"
+	reason           string = "UNAUTHORIZED"
+	reasonForbidden  string = "FORBIDDEN"
+	reasonPwdExpired string = "PASSWORD_EXPIRED"
 )
 
 var (
@@ -13,4 +15,8 @@ var (
 	ErrExtractSubjectFailed  = errors.Unauthorized(reason, "extract subject failed")
 	ErrAccessTokenExpired    = errors.Unauthorized(reason, "access token expired")
 	ErrInvalidRequest        = errors.Unauthorized(reason, "invalid request")
+	ErrSessionRevoked        = errors.Unauthorized(reason, "session revoked")
+	ErrClientRevoked         = errors.Unauthorized(reason, "client revoked")
+	ErrReadOnlyToken         = errors.Forbidden(reasonForbidden, "read-only token cannot perform this operation")
+	ErrPasswordExpired       = errors.Forbidden(reasonPwdExpired, "password expired, change it before continuing")
 )
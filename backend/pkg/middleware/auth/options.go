@@ -57,10 +57,36 @@ func (c *composedChecker) IsBlockedAccessToken(ctx context.Context, userID uint3
 	return c.blocker(ctx, userID, accessToken)
 }
 
+// SessionChecker 定义会话检查接口，会话被注销后其签发的访问令牌立即失效
+type SessionChecker interface {
+	// IsActiveSession 检查会话是否有效
+	IsActiveSession(ctx context.Context, userID uint32, sessionID string) bool
+}
+
+type SessionCheckerFunc func(ctx context.Context, userID uint32, sessionID string) bool
+
+func (f SessionCheckerFunc) IsActiveSession(ctx context.Context, userID uint32, sessionID string) bool {
+	return f(ctx, userID, sessionID)
+}
+
+// ClientChecker 定义客户端检查接口，客户端被禁用、删除或换取令牌的密钥失效后，其令牌立即失效
+type ClientChecker interface {
+	// IsActiveClient 检查客户端令牌是否有效
+	IsActiveClient(ctx context.Context, clientID string, secretVersion uint32) bool
+}
+
+type ClientCheckerFunc func(ctx context.Context, clientID string, secretVersion uint32) bool
+
+func (f ClientCheckerFunc) IsActiveClient(ctx context.Context, clientID string, secretVersion uint32) bool {
+	return f(ctx, clientID, secretVersion)
+}
+
 type options struct {
 	log *log.Helper
 
 	accessTokenChecker                AccessTokenChecker // 访问令牌检查器
+	sessionChecker                    SessionChecker     // 会话检查器
+	clientChecker                     ClientChecker      // 客户端检查器
 	enableCheckTokenExpiration        bool               // 是否启用访问令牌过期检查
 	enableCheckRefreshTokenExpiration bool               // 是否启用刷新令牌过期检查
 	enableCheckScopes                 bool               // 是否启用作用域检查
@@ -68,6 +94,9 @@ type options struct {
 
 	enableAuthz bool // 是否启用鉴权
 
+	readOnlyAllowedOperations       map[string]struct{} // 只读令牌额外允许调用的操作
+	passwordChangeAllowedOperations map[string]struct{} // 密码已过期的令牌允许调用的操作
+
 	injectOperatorId bool
 	injectTenantId   bool
 	injectEnt        bool
@@ -89,6 +118,44 @@ func WithAccessTokenCheckerFromFuncs(valid AccessTokenCheckerFunc, blocker Acces
 	}
 }
 
+// WithSessionChecker 设置会话检查器，不携带会话ID的令牌（如客户端令牌）不做检查
+func WithSessionChecker(checker SessionChecker) Option {
+	return func(opts *options) {
+		opts.sessionChecker = checker
+	}
+}
+
+// WithClientChecker 设置客户端检查器，只检查客户端凭据模式签发的令牌
+func WithClientChecker(checker ClientChecker) Option {
+	return func(opts *options) {
+		opts.clientChecker = checker
+	}
+}
+
+// WithReadOnlyAllowedOperations 设置只读令牌额外允许调用的操作，如登出
+func WithReadOnlyAllowedOperations(operations ...string) Option {
+	return func(opts *options) {
+		if opts.readOnlyAllowedOperations == nil {
+			opts.readOnlyAllowedOperations = make(map[string]struct{}, len(operations))
+		}
+		for _, operation := range operations {
+			opts.readOnlyAllowedOperations[operation] = struct{}{}
+		}
+	}
+}
+
+// WithPasswordChangeAllowedOperations 设置密码已过期的令牌允许调用的操作，如修改密码和登出
+func WithPasswordChangeAllowedOperations(operations ...string) Option {
+	return func(opts *options) {
+		if opts.passwordChangeAllowedOperations == nil {
+			opts.passwordChangeAllowedOperations = make(map[string]struct{}, len(operations))
+		}
+		for _, operation := range operations {
+			opts.passwordChangeAllowedOperations[operation] = struct{}{}
+		}
+	}
+}
+
 // WithEnableCheckTokenExpiration 设置是否启用访问令牌过期检查
 func WithEnableCheckTokenExpiration(enable bool) Option {
 	return func(opts *options) {
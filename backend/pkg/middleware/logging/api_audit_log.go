@@ -60,6 +60,12 @@ func (a *ApiAuditLogMiddleware) Handle(ctx context.Context, htr *http.Transport,
 		apiAuditLog.UserId = trans.Ptr(ut.UserId)
 		apiAuditLog.TenantId = ut.TenantId
 		apiAuditLog.Username = ut.Username
+
+		// 模拟登录时同时记录实际操作者
+		if actor := ut.GetActor(); actor != nil {
+			apiAuditLog.ActorUserId = trans.Ptr(actor.GetUserId())
+			apiAuditLog.ActorUsername = actor.Username
+		}
 	}
 
 	// 地理位置
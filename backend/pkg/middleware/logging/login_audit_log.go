@@ -268,6 +268,8 @@ const (
 	RiskFactorHighRiskScore    = "HIGH_RISK_SCORE"
 	RiskFactorMediumRiskScore  = "MEDIUM_RISK_SCORE"
 	RiskFactorLowRiskScore     = "LOW_RISK_SCORE"
+
+	RiskFactorRefreshTokenReuse = "REFRESH_TOKEN_REUSE" // 已轮换的刷新令牌被再次使用
 )
 
 // computeRiskFactors 基于 LoginAuditLog 的若干字段，使用无状态启发式规则返回风险因素列表（去重、排序）。
@@ -118,6 +118,24 @@ func getIPFromRemoteAddr(hostAddress string) string {
 	return ""
 }
 
+// ClientRealIP 获取客户端真实IP，用于中间件之外需要记录访问来源的场景
+func ClientRealIP(request *http.Request) string {
+	return getClientRealIP(request)
+}
+
+// ClientPeerIP 获取直连的对端IP，不读取客户端可以伪造的转发头，用于限流等安全相关的场景
+func ClientPeerIP(request *http.Request) string {
+	if request == nil {
+		return ""
+	}
+	return getIPFromRemoteAddr(request.RemoteAddr)
+}
+
+// RequestID 获取请求ID，用于中间件之外需要关联请求的场景
+func RequestID(request *http.Request) string {
+	return getRequestId(request)
+}
+
 // getRequestId 获取请求ID
 func getRequestId(request *http.Request) string {
 	if request == nil {
@@ -0,0 +1,62 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// logSinkCapacity 日志发送器在内存中保留的最近消息数
+const logSinkCapacity = 100
+
+// LogNotifier 只把消息输出到日志而不实际发送，并在内存中保留最近的消息，用于开发和测试
+type LogNotifier struct {
+	log *log.Helper
+
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewLogNotifier 创建日志发送器，logger 为 nil 时只保留在内存中
+func NewLogNotifier(logger *log.Helper) *LogNotifier {
+	return &LogNotifier{log: logger}
+}
+
+// Send 记录消息
+func (n *LogNotifier) Send(_ context.Context, msg *Message) error {
+	if n.log != nil {
+		n.log.Infof("[%s] to [%s] subject [%s]: %s", msg.Channel, msg.To, msg.Subject, msg.Body)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.messages = append(n.messages, *msg)
+	if len(n.messages) > logSinkCapacity {
+		n.messages = n.messages[len(n.messages)-logSinkCapacity:]
+	}
+
+	return nil
+}
+
+// Last 发给 to 的最近一条消息
+func (n *LogNotifier) Last(to string) (Message, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for i := len(n.messages) - 1; i >= 0; i-- {
+		if n.messages[i].To == to {
+			return n.messages[i], true
+		}
+	}
+	return Message{}, false
+}
+
+// Messages 保留的全部消息，按发送顺序
+func (n *LogNotifier) Messages() []Message {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return append([]Message(nil), n.messages...)
+}
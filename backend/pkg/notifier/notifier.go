@@ -0,0 +1,74 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Channel 消息通道
+type Channel string
+
+const (
+	ChannelEmail Channel = "email" // 邮件
+	ChannelSMS   Channel = "sms"   // 短信
+)
+
+// ErrUnsupportedChannel 没有可用于该通道的发送器
+var ErrUnsupportedChannel = errors.New("notifier: unsupported channel")
+
+// Message 待发送的消息
+type Message struct {
+	Channel Channel // 通道
+	To      string  // 接收方：邮箱地址或手机号码
+	Subject string  // 标题，短信忽略
+	Body    string  // 纯文本正文
+}
+
+// Notifier 消息发送器，可对接 SMTP、短信服务商等
+type Notifier interface {
+	// Send 发送消息，返回 error 表示发送失败
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Router 按通道将消息分发给对应的发送器
+type Router struct {
+	notifiers map[Channel]Notifier
+}
+
+// NewRouter 创建分发器
+func NewRouter() *Router {
+	return &Router{
+		notifiers: make(map[Channel]Notifier),
+	}
+}
+
+// Register 注册通道的发送器，nil 表示不支持该通道
+func (r *Router) Register(channel Channel, n Notifier) *Router {
+	if n == nil {
+		delete(r.notifiers, channel)
+	} else {
+		r.notifiers[channel] = n
+	}
+	return r
+}
+
+// Supports 是否支持该通道
+func (r *Router) Supports(channel Channel) bool {
+	_, ok := r.notifiers[channel]
+	return ok
+}
+
+// Send 将消息交给对应通道的发送器
+func (r *Router) Send(ctx context.Context, msg *Message) error {
+	if msg == nil {
+		return errors.New("notifier: nil message")
+	}
+
+	n, ok := r.notifiers[msg.Channel]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedChannel, msg.Channel)
+	}
+
+	return n.Send(ctx, msg)
+}
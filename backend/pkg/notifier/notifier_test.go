@@ -0,0 +1,137 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"mime"
+	"net"
+	"net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouter(t *testing.T) {
+	ctx := context.Background()
+	sink := NewLogNotifier(nil)
+
+	r := NewRouter().
+		Register(ChannelEmail, sink).
+		Register(ChannelSMS, NewStubSMSNotifier())
+
+	assert.NoError(t, r.Send(ctx, &Message{Channel: ChannelEmail, To: "a@example.com", Body: "1"}))
+	assert.NoError(t, r.Send(ctx, &Message{Channel: ChannelEmail, To: "a@example.com", Body: "2"}))
+
+	last, ok := sink.Last("a@example.com")
+	assert.True(t, ok)
+	assert.Equal(t, "2", last.Body)
+	assert.Len(t, sink.Messages(), 2)
+
+	assert.ErrorIs(t, r.Send(ctx, &Message{Channel: ChannelSMS, To: "+8613800000000"}), ErrSMSNotConfigured)
+
+	r.Register(ChannelSMS, nil)
+	assert.False(t, r.Supports(ChannelSMS))
+	assert.ErrorIs(t, r.Send(ctx, &Message{Channel: ChannelSMS, To: "+8613800000000"}), ErrUnsupportedChannel)
+}
+
+func TestBuildMailMessage(t *testing.T) {
+	from := &mail.Address{Name: "Admin", Address: "noreply@example.com"}
+	to := &mail.Address{Address: "user@example.com"}
+
+	data, err := buildMailMessage(from, to, "验证码", "您的验证码是 123456", time.Now())
+	assert.NoError(t, err)
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(data)))
+	assert.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, "验证码", subject)
+	assert.True(t, strings.HasSuffix(msg.Header.Get("Message-ID"), "@example.com>"))
+
+	raw := new(strings.Builder)
+	_, _ = bufio.NewReader(msg.Body).WriteTo(raw)
+	body, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(raw.String(), "\r\n", ""))
+	assert.NoError(t, err)
+	assert.Equal(t, "您的验证码是 123456", string(body))
+}
+
+func TestSmtpNotifierSend(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer ln.Close()
+
+	received := make(chan []string, 1)
+	go serveFakeSmtp(ln, received)
+
+	n := NewSmtpNotifier(SmtpConfig{
+		Host: "127.0.0.1",
+		Port: ln.Addr().(*net.TCPAddr).Port,
+		From: "noreply@example.com",
+	})
+
+	err = n.Send(context.Background(), &Message{
+		Channel: ChannelEmail,
+		To:      "user@example.com",
+		Subject: "hello",
+		Body:    "world",
+	})
+	assert.NoError(t, err)
+
+	commands := <-received
+	assert.Contains(t, commands, "MAIL FROM:<noreply@example.com>")
+	assert.Contains(t, commands, "RCPT TO:<user@example.com>")
+
+	err = n.Send(context.Background(), &Message{Channel: ChannelSMS, To: "+8613800000000"})
+	assert.ErrorIs(t, err, ErrUnsupportedChannel)
+}
+
+// serveFakeSmtp 接受一个连接，按最简单的 SMTP 会话应答，并返回收到的命令
+func serveFakeSmtp(ln net.Listener, received chan<- []string) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(code int, text string) {
+		_, _ = conn.Write([]byte(strconv.Itoa(code) + " " + text + "\r\n"))
+	}
+
+	var commands []string
+	reply(220, "fake smtp ready")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			break
+		}
+		line = strings.TrimRight(line, "\r\n")
+		commands = append(commands, line)
+
+		switch verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); verb {
+		case "EHLO", "HELO":
+			reply(250, "fake")
+		case "DATA":
+			reply(354, "go ahead")
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil || dataLine == ".\r\n" {
+					break
+				}
+			}
+			reply(250, "queued")
+		case "QUIT":
+			reply(221, "bye")
+			received <- commands
+			return
+		default:
+			reply(250, "ok")
+		}
+	}
+	received <- commands
+}
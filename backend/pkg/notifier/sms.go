@@ -0,0 +1,22 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSMSNotConfigured 没有接入短信服务商
+var ErrSMSNotConfigured = errors.New("notifier: sms provider not configured")
+
+// StubSMSNotifier 短信发送器占位实现，接入短信服务商时替换为对应的实现
+type StubSMSNotifier struct{}
+
+// NewStubSMSNotifier 创建短信占位发送器
+func NewStubSMSNotifier() *StubSMSNotifier {
+	return &StubSMSNotifier{}
+}
+
+// Send 总是返回 ErrSMSNotConfigured
+func (n *StubSMSNotifier) Send(context.Context, *Message) error {
+	return ErrSMSNotConfigured
+}
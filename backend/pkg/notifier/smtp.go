@@ -0,0 +1,188 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultSmtpPort    = 587
+	defaultSmtpTimeout = 10 * time.Second
+	smtpsPort          = 465
+)
+
+// SmtpConfig SMTP 发送配置
+type SmtpConfig struct {
+	Host        string
+	Port        int
+	Username    string
+	Password    string
+	From        string
+	ImplicitTLS bool // 隐式 TLS（SMTPS），否则在服务器支持时使用 STARTTLS
+	Timeout     time.Duration
+}
+
+// SmtpNotifier 通过 SMTP 发送邮件
+type SmtpNotifier struct {
+	cfg SmtpConfig
+}
+
+// NewSmtpNotifier 创建 SMTP 发送器，端口为 465 时使用隐式 TLS
+func NewSmtpNotifier(cfg SmtpConfig) *SmtpNotifier {
+	if cfg.Port <= 0 {
+		cfg.Port = defaultSmtpPort
+	}
+	if cfg.Port == smtpsPort {
+		cfg.ImplicitTLS = true
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultSmtpTimeout
+	}
+	if cfg.From == "" {
+		cfg.From = cfg.Username
+	}
+	return &SmtpNotifier{cfg: cfg}
+}
+
+// Send 发送邮件
+func (n *SmtpNotifier) Send(ctx context.Context, msg *Message) error {
+	if msg.Channel != ChannelEmail {
+		return fmt.Errorf("%w: %s", ErrUnsupportedChannel, msg.Channel)
+	}
+
+	from, err := mail.ParseAddress(n.cfg.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient address: %w", err)
+	}
+
+	data, err := buildMailMessage(from, to, msg.Subject, msg.Body, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, n.cfg.Timeout)
+	defer cancel()
+
+	client, err := n.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if n.cfg.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, n.cfg.Host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err = client.Mail(from.Address); err != nil {
+		return fmt.Errorf("smtp mail: %w", err)
+	}
+	if err = client.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("smtp rcpt: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if _, err = w.Write(data); err != nil {
+		_ = w.Close()
+		return fmt.Errorf("smtp write: %w", err)
+	}
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// dial 建立连接，隐式 TLS 直接握手，否则在服务器支持时升级为 STARTTLS
+func (n *SmtpNotifier) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(n.cfg.Host, strconv.Itoa(n.cfg.Port))
+	tlsConfig := &tls.Config{ServerName: n.cfg.Host}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("connect smtp server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if n.cfg.ImplicitTLS {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	client, err := smtp.NewClient(conn, n.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("smtp handshake: %w", err)
+	}
+
+	if !n.cfg.ImplicitTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err = client.StartTLS(tlsConfig); err != nil {
+				_ = client.Close()
+				return nil, fmt.Errorf("smtp starttls: %w", err)
+			}
+		} else if n.cfg.Username != "" {
+			// net/smtp 拒绝在非加密连接上使用 PLAIN 认证（本机除外）
+			_ = client.Close()
+			return nil, errors.New("smtp server does not support STARTTLS")
+		}
+	}
+
+	return client, nil
+}
+
+// buildMailMessage 生成 UTF-8 纯文本邮件，标题按 RFC 2047 编码，正文使用 base64
+func buildMailMessage(from, to *mail.Address, subject, body string, date time.Time) ([]byte, error) {
+	var msgID [16]byte
+	if _, err := rand.Read(msgID[:]); err != nil {
+		return nil, err
+	}
+
+	domain := "localhost"
+	if i := strings.LastIndexByte(from.Address, '@'); i >= 0 {
+		domain = from.Address[i+1:]
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("From: " + from.String() + "\r\n")
+	buf.WriteString("To: " + to.String() + "\r\n")
+	buf.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	buf.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("Message-ID: <" + hex.EncodeToString(msgID[:]) + "@" + domain + ">\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	buf.WriteString("\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(body))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,44 @@
+package oss
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+)
+
+// HashingReader 在读取数据的同时计算内容的 SHA-256 摘要，
+// 用于上传文件时一边流式写入对象存储，一边得到内容哈希，而无需把整个文件读入内存。
+type HashingReader struct {
+	r    io.Reader
+	h    hash.Hash
+	size int64
+}
+
+// NewHashingReader 创建一个计算 SHA-256 的 HashingReader
+func NewHashingReader(r io.Reader) *HashingReader {
+	return &HashingReader{
+		r: r,
+		h: sha256.New(),
+	}
+}
+
+// Read 实现 io.Reader，读取到的数据同时写入哈希
+func (r *HashingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		_, _ = r.h.Write(p[:n])
+		r.size += int64(n)
+	}
+	return n, err
+}
+
+// Sum 返回已读取内容的 SHA-256 十六进制字符串
+func (r *HashingReader) Sum() string {
+	return hex.EncodeToString(r.h.Sum(nil))
+}
+
+// Size 返回已读取的字节数
+func (r *HashingReader) Size() int64 {
+	return r.size
+}
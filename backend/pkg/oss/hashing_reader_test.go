@@ -0,0 +1,27 @@
+package oss
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashingReader(t *testing.T) {
+	content := bytes.Repeat([]byte("go-wind-admin"), 4096)
+
+	hr := NewHashingReader(bytes.NewReader(content))
+	n, err := io.Copy(io.Discard, hr)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(content)), n)
+	assert.Equal(t, int64(len(content)), hr.Size())
+
+	sum := sha256.Sum256(content)
+	assert.Equal(t, hex.EncodeToString(sum[:]), hr.Sum())
+
+	// 与基于内容生成的文件名保持一致
+	assert.Equal(t, GeneraContentSHA265FileName(content, "txt"), hr.Sum()+".txt")
+}
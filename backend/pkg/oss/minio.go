@@ -3,7 +3,9 @@ package oss
 import (
 	"bytes"
 	"context"
+	"io"
 	"net/url"
+	"path"
 	"strings"
 	"time"
 
@@ -20,6 +22,9 @@ import (
 
 const (
 	defaultExpiryTime = time.Minute * 60 // 默认的预签名时间，默认为：1小时
+
+	stagingDirectory = ".staging"  // 内容寻址上传时的临时对象目录
+	variantDirectory = ".variants" // 图片衍生图目录
 )
 
 // MinIOClient MinIO 客户端封装
@@ -222,6 +227,45 @@ func (c *MinIOClient) DeleteFile(ctx context.Context, bucketName, objectName str
 	return nil
 }
 
+// DeleteFilesWithPrefix 删除指定前缀下的所有文件
+func (c *MinIOClient) DeleteFilesWithPrefix(ctx context.Context, bucketName, prefix string) error {
+	if bucketName == "" {
+		return fileV1.ErrorBadRequest("bucket name is required")
+	}
+	if prefix == "" {
+		return fileV1.ErrorBadRequest("prefix is required")
+	}
+
+	objectsCh := c.mc.ListObjects(ctx, bucketName, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	})
+	for result := range c.mc.RemoveObjects(ctx, bucketName, objectsCh, minio.RemoveObjectsOptions{}) {
+		if result.Err != nil {
+			c.log.Errorf("Failed to delete file [%s]: %v", result.ObjectName, result.Err)
+			return fileV1.ErrorDeleteFailed("failed to delete file")
+		}
+	}
+
+	return nil
+}
+
+// FileExists 判断文件是否存在
+func (c *MinIOClient) FileExists(ctx context.Context, bucketName, objectName string) (bool, error) {
+	_, err := c.mc.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	if err == nil {
+		return true, nil
+	}
+
+	switch minio.ToErrorResponse(err).Code {
+	case minio.NoSuchKey, minio.NoSuchBucket:
+		return false, nil
+	default:
+		c.log.Errorf("Failed to stat object: %v", err)
+		return false, fileV1.ErrorInternalServerError("failed to stat object")
+	}
+}
+
 // UploadFile 上传文件
 func (c *MinIOClient) UploadFile(ctx context.Context, bucketName string, objectName string, fileContent []byte) (minio.UploadInfo, string, error) {
 	if len(fileContent) == 0 {
@@ -270,6 +314,166 @@ func (c *MinIOClient) UploadFile(ctx context.Context, bucketName string, objectN
 	return info, downloadUrl, nil
 }
 
+// ObjectLockFunc 锁定存储对象，返回解锁函数
+type ObjectLockFunc func(ctx context.Context, bucketName, objectName string) (func(), error)
+
+// ContentAddressedCommitFunc 记录引用内容寻址对象的文件，返回值中的 string 依次为：内容哈希、下载地址
+type ContentAddressedCommitFunc func(info minio.UploadInfo, contentHash, downloadUrl string) error
+
+// UploadContentAddressed 以内容寻址的方式上传文件
+// 数据先流式写入临时对象，同时计算 SHA-256；随后以 "<目录>/<sha256>.<扩展名>" 作为最终对象名，
+// 若该对象已存在则直接复用并删除临时对象，否则将临时对象复制为最终对象。
+// 复用或创建对象与 commit 在 lock 持有期间执行，删除对象的一方使用同一把锁，避免记录指向已删除的对象；
+// commit 失败时删除本次新建的对象。
+func (c *MinIOClient) UploadContentAddressed(
+	ctx context.Context,
+	bucketName, fileDirectory, fileExt string,
+	reader io.Reader, size int64,
+	lock ObjectLockFunc,
+	commit ContentAddressedCommitFunc,
+) error {
+	if reader == nil {
+		return fileV1.ErrorUploadFailed("invalid fileContent data")
+	}
+
+	if bucketName == "" {
+		bucketName = BucketFiles
+	}
+	if err := c.EnsureBucketExists(ctx, bucketName); err != nil {
+		return err
+	}
+
+	dir := strings.Trim(fileDirectory, "/")
+	stagingName := path.Join(dir, stagingDirectory, GeneraUUIDFileName(""))
+
+	hr := NewHashingReader(reader)
+	if _, err := c.mc.PutObject(ctx, bucketName, stagingName, hr, size, minio.PutObjectOptions{}); err != nil {
+		c.log.Errorf("Failed to upload fileContent: %v", err)
+		return fileV1.ErrorUploadFailed("failed to upload fileContent")
+	}
+	defer func() {
+		if err := c.mc.RemoveObject(context.WithoutCancel(ctx), bucketName, stagingName, minio.RemoveObjectOptions{}); err != nil {
+			c.log.Warnf("Failed to remove staging object [%s]: %v", stagingName, err)
+		}
+	}()
+
+	contentHash := hr.Sum()
+	objectName := path.Join(dir, contentHash)
+	if cleanExt := strings.TrimPrefix(fileExt, "."); cleanExt != "" {
+		objectName += "." + cleanExt
+	}
+
+	downloadUrl := JoinObjectUrl("", bucketName, objectName)
+
+	unlock, err := lock(ctx, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	var info minio.UploadInfo
+	var created bool
+
+	st, err := c.mc.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	switch {
+	case err == nil:
+		// 相同内容的对象已存在，直接复用
+		info = minio.UploadInfo{
+			Bucket:       bucketName,
+			Key:          objectName,
+			ETag:         st.ETag,
+			Size:         st.Size,
+			LastModified: st.LastModified,
+		}
+
+	case minio.ToErrorResponse(err).Code == minio.NoSuchKey:
+		if info, err = c.mc.CopyObject(ctx,
+			minio.CopyDestOptions{Bucket: bucketName, Object: objectName},
+			minio.CopySrcOptions{Bucket: bucketName, Object: stagingName},
+		); err != nil {
+			c.log.Errorf("Failed to copy staging object: %v", err)
+			return fileV1.ErrorUploadFailed("failed to upload fileContent")
+		}
+		info.Size = hr.Size()
+		created = true
+
+	default:
+		c.log.Errorf("Failed to stat object: %v", err)
+		return fileV1.ErrorUploadFailed("failed to stat object")
+	}
+
+	if err = commit(info, contentHash, downloadUrl); err != nil {
+		if created {
+			if rmErr := c.mc.RemoveObject(context.WithoutCancel(ctx), bucketName, objectName, minio.RemoveObjectOptions{}); rmErr != nil {
+				c.log.Warnf("Failed to remove uncommitted object [%s]: %v", objectName, rmErr)
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UploadStream 流式上传长度未知的数据，如备份归档
+func (c *MinIOClient) UploadStream(ctx context.Context, bucketName, objectName string, reader io.Reader, contentType string) (minio.UploadInfo, error) {
+	if bucketName == "" || objectName == "" {
+		return minio.UploadInfo{}, fileV1.ErrorBadRequest("bucket name and object name are required")
+	}
+	if err := c.EnsureBucketExists(ctx, bucketName); err != nil {
+		return minio.UploadInfo{}, err
+	}
+
+	info, err := c.mc.PutObject(ctx, bucketName, objectName, reader, -1, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		c.log.Errorf("Failed to upload stream [%s]: %v", objectName, err)
+		return info, fileV1.ErrorUploadFailed("failed to upload stream")
+	}
+
+	return info, nil
+}
+
+// ListObjects 列出指定前缀下的所有对象
+func (c *MinIOClient) ListObjects(ctx context.Context, bucketName, prefix string) ([]minio.ObjectInfo, error) {
+	var objects []minio.ObjectInfo
+	for object := range c.mc.ListObjects(ctx, bucketName, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	}) {
+		if object.Err != nil {
+			if minio.ToErrorResponse(object.Err).Code == minio.NoSuchBucket {
+				return nil, nil
+			}
+			c.log.Errorf("Failed to list objects: %v", object.Err)
+			return nil, fileV1.ErrorInternalServerError("failed to list objects")
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// OpenObject 打开对象以流式读取，调用方负责关闭
+func (c *MinIOClient) OpenObject(ctx context.Context, bucketName, objectName string) (io.ReadCloser, error) {
+	object, err := c.mc.GetObject(ctx, bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		c.log.Errorf("Failed to open object [%s]: %v", objectName, err)
+		return nil, fileV1.ErrorDownloadFailed("failed to open object")
+	}
+
+	// GetObject 是惰性的，通过 Stat 提前发现对象不存在的情况
+	if _, err = object.Stat(); err != nil {
+		_ = object.Close()
+		if minio.ToErrorResponse(err).Code == minio.NoSuchKey {
+			return nil, fileV1.ErrorNotFound("object not found")
+		}
+		c.log.Errorf("Failed to stat object [%s]: %v", objectName, err)
+		return nil, fileV1.ErrorDownloadFailed("failed to open object")
+	}
+
+	return object, nil
+}
+
 // getDownloadUrlWithStorageObjectDirect 直接获取文件内容
 func (c *MinIOClient) getDownloadUrlWithStorageObjectDirect(ctx context.Context, req *fileV1.GetDownloadInfoRequest) (*fileV1.GetDownloadInfoResponse, error) {
 	opts := minio.GetObjectOptions{}
@@ -0,0 +1,185 @@
+package oss
+
+import (
+	"mime"
+	"path"
+	"strings"
+
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+)
+
+// UploadPolicy 上传策略，作用于一个存储桶或存储桶下的某个目录
+type UploadPolicy struct {
+	Bucket    string // 存储桶，为空匹配所有存储桶
+	Directory string // 目录前缀，为空匹配所有目录
+
+	AllowedTypes []string // 允许的嗅探类型，支持 "image/*" 形式的通配；为空不限制
+	MaxSize      uint64   // 单个文件的最大字节数，0 表示不限制
+
+	AllowExtensionMismatch bool // 是否允许内容与扩展名、声明的 MIME 类型不一致
+}
+
+// UploadPolicies 上传策略集合
+type UploadPolicies []UploadPolicy
+
+// Match 返回与存储桶、目录匹配的最具体的策略，没有匹配时返回 nil
+// 指定了存储桶的策略优先于未指定的，目录前缀越长越优先。
+func (ps UploadPolicies) Match(bucketName, fileDirectory string) *UploadPolicy {
+	dir := strings.Trim(fileDirectory, "/")
+
+	var (
+		best      *UploadPolicy
+		bestScore = -1
+	)
+	for i := range ps {
+		p := &ps[i]
+
+		if p.Bucket != "" && p.Bucket != bucketName {
+			continue
+		}
+
+		prefix := strings.Trim(p.Directory, "/")
+		if prefix != "" && dir != prefix && !strings.HasPrefix(dir, prefix+"/") {
+			continue
+		}
+
+		score := len(prefix) + 1
+		if p.Bucket != "" {
+			// 指定存储桶的策略总是优先
+			score += 1 << 16
+		}
+		if score > bestScore {
+			best, bestScore = p, score
+		}
+	}
+
+	return best
+}
+
+// CheckSize 检查文件大小
+func (p *UploadPolicy) CheckSize(size uint64) error {
+	if p == nil || p.MaxSize == 0 || size <= p.MaxSize {
+		return nil
+	}
+	return fileV1.ErrorFileTooLarge("file size %d exceeds the limit of %d bytes", size, p.MaxSize)
+}
+
+// CheckType 检查文件类型是否在允许列表内
+func (p *UploadPolicy) CheckType(contentType string) error {
+	if p == nil || len(p.AllowedTypes) == 0 {
+		return nil
+	}
+
+	ct := baseMimeType(contentType)
+	for _, allowed := range p.AllowedTypes {
+		allowed = baseMimeType(allowed)
+		if allowed == ct {
+			return nil
+		}
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(ct, prefix+"/") {
+			return nil
+		}
+	}
+
+	return fileV1.ErrorFileTypeNotAllowed("file type %s is not allowed", ct)
+}
+
+// CheckContent 根据文件内容嗅探实际类型，并依次检查大小、类型以及扩展名、声明类型的一致性
+// 返回嗅探出的 MIME 类型。
+func (p *UploadPolicy) CheckContent(sourceFileName, declaredType string, content []byte) (string, error) {
+	sniffed, _ := DetectFileType(content)
+
+	if p == nil {
+		return sniffed, nil
+	}
+
+	if err := p.CheckSize(uint64(len(content))); err != nil {
+		return sniffed, err
+	}
+
+	if err := p.CheckType(sniffed); err != nil {
+		return sniffed, err
+	}
+
+	if p.AllowExtensionMismatch {
+		return sniffed, nil
+	}
+
+	if declaredType != "" && !MimeTypeCompatible(sniffed, declaredType) {
+		return sniffed, fileV1.ErrorFileTypeMismatch("file content %s does not match declared type %s", baseMimeType(sniffed), baseMimeType(declaredType))
+	}
+
+	if ext := path.Ext(sourceFileName); ext != "" {
+		if extType := mime.TypeByExtension(strings.ToLower(ext)); extType != "" && !MimeTypeCompatible(sniffed, extType) {
+			return sniffed, fileV1.ErrorFileTypeMismatch("file content %s does not match extension %s", baseMimeType(sniffed), ext)
+		}
+	}
+
+	return sniffed, nil
+}
+
+// strongMagicTypes 可以通过魔术头可靠识别的类型族，声明为这些类型时内容必须能被识别为相同类型
+var strongMagicTypes = []string{"image/", "audio/", "video/", "application/pdf", "application/zip"}
+
+// zipContainerTypes 基于 zip 容器的文档类型，嗅探结果为 application/zip
+var zipContainerTypes = []string{"openxmlformats", "opendocument", "java-archive", "epub", "android.package-archive"}
+
+// MimeTypeCompatible 判断嗅探出的类型与声明（或由扩展名推断）的类型是否一致
+// 嗅探只能识别有限的类型，对于无法可靠识别的类型按类型族宽松比较。
+func MimeTypeCompatible(sniffed, claimed string) bool {
+	s := baseMimeType(sniffed)
+	c := baseMimeType(claimed)
+
+	if c == "" || s == c {
+		return true
+	}
+
+	switch s {
+	case "image/jpg", "image/pjpeg":
+		s = "image/jpeg"
+	}
+	switch c {
+	case "image/jpg", "image/pjpeg":
+		c = "image/jpeg"
+	}
+	if s == c {
+		return true
+	}
+
+	switch {
+	case strings.HasPrefix(s, "audio/"), strings.HasPrefix(s, "video/"):
+		// 音视频只按魔术头识别容器，不区分具体编码；HEIC/AVIF 与 MP4 同为 ISO BMFF 容器
+		family := s[:strings.IndexByte(s, '/')+1]
+		if strings.HasPrefix(c, family) {
+			return true
+		}
+		return s == "video/mp4" && (c == "image/heic" || c == "image/heif" || c == "image/avif")
+
+	case s == "application/zip":
+		for _, t := range zipContainerTypes {
+			if strings.Contains(c, t) {
+				return true
+			}
+		}
+		return c == "application/x-zip-compressed"
+
+	case s == "application/octet-stream", strings.HasPrefix(s, "text/"):
+		// 内容未被识别为特定格式，只要声明的不是可被魔术头识别的类型就视为一致
+		for _, t := range strongMagicTypes {
+			if strings.HasPrefix(c, t) && c != "image/svg+xml" {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// baseMimeType 去除 MIME 参数并转为小写，如 "text/plain; charset=utf-8" -> "text/plain"
+func baseMimeType(contentType string) string {
+	if idx := strings.IndexByte(contentType, ';'); idx >= 0 {
+		contentType = contentType[:idx]
+	}
+	return strings.ToLower(strings.TrimSpace(contentType))
+}
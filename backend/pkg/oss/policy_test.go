@@ -0,0 +1,107 @@
+package oss
+
+import (
+	"testing"
+
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestUploadPoliciesMatch(t *testing.T) {
+	t.Parallel()
+	ps := UploadPolicies{
+		{MaxSize: 1},
+		{Bucket: "images", MaxSize: 2},
+		{Bucket: "images", Directory: "avatars", MaxSize: 3},
+		{Directory: "docs", MaxSize: 4},
+	}
+
+	cases := []struct {
+		bucket, dir string
+		want        uint64
+	}{
+		{"files", "", 1},
+		{"files", "docs/2024", 4},
+		{"files", "docsx", 1},
+		{"images", "", 2},
+		{"images", "docs", 2},
+		{"images", "/avatars/1/", 3},
+	}
+	for _, c := range cases {
+		if got := ps.Match(c.bucket, c.dir); got == nil || got.MaxSize != c.want {
+			t.Fatalf("Match(%q, %q) = %+v, want MaxSize %d", c.bucket, c.dir, got, c.want)
+		}
+	}
+
+	if got := (UploadPolicies{{Bucket: "images"}}).Match("files", ""); got != nil {
+		t.Fatalf("expected no policy, got %+v", got)
+	}
+}
+
+func TestUploadPolicyCheckContent(t *testing.T) {
+	t.Parallel()
+	p := &UploadPolicy{
+		AllowedTypes: []string{"image/*", "application/pdf"},
+		MaxSize:      1024,
+	}
+
+	sniffed, err := p.CheckContent("a.png", "image/png", pngHeader)
+	if err != nil || sniffed != "image/png" {
+		t.Fatalf("unexpected result: %q, %v", sniffed, err)
+	}
+
+	exe := append([]byte("MZ"), make([]byte, 126)...)
+	if _, err = p.CheckContent("a.png", "image/png", exe); !fileV1.IsFileTypeNotAllowed(err) {
+		t.Fatalf("expected type not allowed, got %v", err)
+	}
+
+	if _, err = p.CheckContent("a.pdf", "image/png", pngHeader); !fileV1.IsFileTypeMismatch(err) {
+		t.Fatalf("expected extension mismatch, got %v", err)
+	}
+
+	if _, err = p.CheckContent("a.png", "image/gif", pngHeader); !fileV1.IsFileTypeMismatch(err) {
+		t.Fatalf("expected declared type mismatch, got %v", err)
+	}
+
+	if _, err = p.CheckContent("a.png", "image/png", append(pngHeader, make([]byte, 1024)...)); !fileV1.IsFileTooLarge(err) {
+		t.Fatalf("expected file too large, got %v", err)
+	}
+
+	p.AllowExtensionMismatch = true
+	if _, err = p.CheckContent("a.pdf", "image/gif", pngHeader); err != nil {
+		t.Fatalf("expected mismatch allowed, got %v", err)
+	}
+
+	var nilPolicy *UploadPolicy
+	if _, err = nilPolicy.CheckContent("a.png", "image/png", exe); err != nil {
+		t.Fatalf("nil policy should allow everything, got %v", err)
+	}
+}
+
+func TestMimeTypeCompatible(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		sniffed, claimed string
+		want             bool
+	}{
+		{"image/png", "image/png", true},
+		{"image/jpeg", "image/jpg", true},
+		{"image/png", "image/jpeg", false},
+		{"text/plain; charset=utf-8", "application/json", true},
+		{"text/plain; charset=utf-8", "image/png", false},
+		{"text/xml; charset=utf-8", "image/svg+xml", true},
+		{"application/octet-stream", "image/png", false},
+		{"application/octet-stream", "application/x-tar", true},
+		{"application/zip", "application/vnd.openxmlformats-officedocument.wordprocessingml.document", true},
+		{"application/zip", "application/pdf", false},
+		{"video/mp4", "video/quicktime", true},
+		{"video/mp4", "image/heic", true},
+		{"application/x-msdownload", "image/png", false},
+	}
+	for _, c := range cases {
+		if got := MimeTypeCompatible(c.sniffed, c.claimed); got != c.want {
+			t.Fatalf("MimeTypeCompatible(%q, %q) = %v, want %v", c.sniffed, c.claimed, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package oss
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// ScanResult 内容扫描结果
+type ScanResult struct {
+	Infected  bool   // 是否发现威胁
+	Signature string // 命中的威胁特征名称
+}
+
+// Scanner 内容扫描器，可对接 clamd、ICAP 等外部扫描服务
+type Scanner interface {
+	// Scan 扫描内容，返回 error 表示扫描本身失败（服务不可用、超时等）
+	Scan(ctx context.Context, content io.Reader) (*ScanResult, error)
+}
+
+const (
+	defaultClamdTimeout   = 30 * time.Second
+	clamdStreamChunkSize  = 64 * 1024
+	clamdInstreamCommand  = "zINSTREAM\x00"
+	clamdResponseOK       = "OK"
+	clamdResponseFound    = "FOUND"
+	clamdResponseMaxBytes = 4096
+)
+
+// ClamdScanner 基于 clamd INSTREAM 协议的扫描器
+type ClamdScanner struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamdScanner 创建 clamd 扫描器，network 为空时使用 tcp
+func NewClamdScanner(network, address string, timeout time.Duration) *ClamdScanner {
+	if network == "" {
+		network = "tcp"
+	}
+	if timeout <= 0 {
+		timeout = defaultClamdTimeout
+	}
+	return &ClamdScanner{
+		network: network,
+		address: address,
+		timeout: timeout,
+	}
+}
+
+// Scan 以流的方式将内容发送给 clamd 扫描
+func (s *ClamdScanner) Scan(ctx context.Context, content io.Reader) (*ScanResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return nil, fmt.Errorf("connect clamd: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if _, err = io.WriteString(conn, clamdInstreamCommand); err != nil {
+		return nil, fmt.Errorf("send clamd command: %w", err)
+	}
+
+	// 数据分块发送：4 字节大端长度 + 数据，以长度为 0 的块结束
+	buf := make([]byte, clamdStreamChunkSize)
+	var size [4]byte
+	for {
+		n, readErr := content.Read(buf)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size[:], uint32(n))
+			if _, err = conn.Write(size[:]); err != nil {
+				return nil, fmt.Errorf("send clamd stream: %w", err)
+			}
+			if _, err = conn.Write(buf[:n]); err != nil {
+				return nil, fmt.Errorf("send clamd stream: %w", err)
+			}
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("read content: %w", readErr)
+		}
+	}
+	binary.BigEndian.PutUint32(size[:], 0)
+	if _, err = conn.Write(size[:]); err != nil {
+		return nil, fmt.Errorf("send clamd stream: %w", err)
+	}
+
+	reply, err := bufio.NewReader(io.LimitReader(conn, clamdResponseMaxBytes)).ReadBytes(0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("read clamd response: %w", err)
+	}
+
+	return parseClamdResponse(string(bytes.TrimRight(reply, "\x00\n")))
+}
+
+// parseClamdResponse 解析 clamd 响应，形如 "stream: OK"、"stream: Eicar-Signature FOUND"
+func parseClamdResponse(reply string) (*ScanResult, error) {
+	_, status, ok := strings.Cut(reply, ": ")
+	if !ok {
+		return nil, fmt.Errorf("unexpected clamd response: %q", reply)
+	}
+
+	switch {
+	case status == clamdResponseOK:
+		return &ScanResult{}, nil
+	case strings.HasSuffix(status, " "+clamdResponseFound):
+		return &ScanResult{
+			Infected:  true,
+			Signature: strings.TrimSuffix(status, " "+clamdResponseFound),
+		}, nil
+	default:
+		return nil, fmt.Errorf("clamd error: %s", status)
+	}
+}
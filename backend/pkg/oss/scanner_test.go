@@ -0,0 +1,100 @@
+package oss
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// startFakeClamd 启动一个模拟 clamd INSTREAM 协议的服务，内容包含 "EICAR" 时报告发现威胁
+func startFakeClamd(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+
+				r := bufio.NewReader(conn)
+				cmd, err := r.ReadString(0)
+				if err != nil || cmd != clamdInstreamCommand {
+					_, _ = io.WriteString(conn, "stream: UNKNOWN COMMAND ERROR\x00")
+					return
+				}
+
+				var data bytes.Buffer
+				var size uint32
+				for {
+					if err = binary.Read(r, binary.BigEndian, &size); err != nil {
+						return
+					}
+					if size == 0 {
+						break
+					}
+					if _, err = io.CopyN(&data, r, int64(size)); err != nil {
+						return
+					}
+				}
+
+				if strings.Contains(data.String(), "EICAR") {
+					_, _ = io.WriteString(conn, "stream: Eicar-Signature FOUND\x00")
+				} else {
+					_, _ = io.WriteString(conn, "stream: OK\x00")
+				}
+			}(conn)
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func TestClamdScanner(t *testing.T) {
+	s := NewClamdScanner("tcp", startFakeClamd(t), time.Second)
+
+	res, err := s.Scan(t.Context(), strings.NewReader("hello world"))
+	if err != nil || res.Infected {
+		t.Fatalf("expected clean result, got %+v, %v", res, err)
+	}
+
+	// 超过一个分块的内容
+	big := strings.Repeat("x", clamdStreamChunkSize*2) + "EICAR"
+	res, err = s.Scan(t.Context(), strings.NewReader(big))
+	if err != nil || !res.Infected || res.Signature != "Eicar-Signature" {
+		t.Fatalf("expected infected result, got %+v, %v", res, err)
+	}
+}
+
+func TestClamdScannerUnavailable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	if _, err = NewClamdScanner("tcp", addr, time.Second).Scan(t.Context(), strings.NewReader("x")); err == nil {
+		t.Fatal("expected error when clamd is unavailable")
+	}
+}
+
+func TestParseClamdResponse(t *testing.T) {
+	if _, err := parseClamdResponse("stream: INSTREAM size limit exceeded. ERROR"); err == nil {
+		t.Fatal("expected error response")
+	}
+	if _, err := parseClamdResponse("garbage"); err == nil {
+		t.Fatal("expected error for malformed response")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"mime"
 	"net/http"
+	"path"
 	"strings"
 	"time"
 
@@ -30,6 +31,9 @@ const (
 	BucketAudios = "audios"
 	BucketDocs   = "docs"
 	BucketFiles  = "files"
+
+	BucketQuarantine = "quarantine" // 扫描未通过的文件隔离存放的存储桶
+	BucketBackups    = "backups"    // 数据库备份存储桶
 )
 
 var staticHMACSecret = []byte("0123456789abcdef0123456789abcdef") // 32 bytes secret for HMAC
@@ -284,6 +288,14 @@ func DetectFileType(fileContent []byte) (mimeType, ext string) {
 		return "audio/wav", ".wav"
 	case len(fileContent) >= 2 && bytes.Equal(fileContent[:2], []byte("BM")):
 		return "image/bmp", ".bmp"
+	case len(fileContent) >= 64 && bytes.Equal(fileContent[:2], []byte("MZ")):
+		// Windows PE / DOS 可执行文件
+		return "application/x-msdownload", ".exe"
+	case len(fileContent) >= 4 && bytes.Equal(fileContent[:4], []byte("\x7fELF")):
+		return "application/x-executable", ""
+	case len(fileContent) >= 4 && (bytes.Equal(fileContent[:4], []byte{0xcf, 0xfa, 0xed, 0xfe}) || bytes.Equal(fileContent[:4], []byte{0xce, 0xfa, 0xed, 0xfe})):
+		// Mach-O 可执行文件（小端）
+		return "application/x-mach-binary", ""
 	}
 
 	// 若魔术头未命中，尝试从 http.DetectContentType 的 MIME 类型获取扩展名
@@ -527,3 +539,13 @@ func SetDownloadRange(opts *minio.GetObjectOptions, start, end *int64) {
 		_ = opts.SetRange(0, *end)
 	}
 }
+
+// VariantObjectPrefix 返回原图所有衍生图所在的对象前缀，衍生图与原图存放在同一目录下
+func VariantObjectPrefix(fileDirectory, saveFileName string) string {
+	return path.Join(strings.Trim(fileDirectory, "/"), variantDirectory, saveFileName) + "/"
+}
+
+// VariantObjectName 返回衍生图的对象名
+func VariantObjectName(fileDirectory, saveFileName, variantName string) string {
+	return VariantObjectPrefix(fileDirectory, saveFileName) + variantName
+}
@@ -405,3 +405,18 @@ func TestGeneraTimeBaseFileName_Uniqueness(t *testing.T) {
 	}
 	t.Logf("Generated names: %q and %q", a, b)
 }
+
+func TestVariantObjectName(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		dir, name, want string
+	}{
+		{"", "a.png", ".variants/a.png/128x128_cover.webp"},
+		{"/2024/01/", "a.png", "2024/01/.variants/a.png/128x128_cover.webp"},
+	}
+	for _, c := range cases {
+		if got := VariantObjectName(c.dir, c.name, "128x128_cover.webp"); got != c.want {
+			t.Fatalf("VariantObjectName(%q, %q) = %q, want %q", c.dir, c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+// DefaultFalsePositiveRate 布隆过滤器的默认误判率
+const DefaultFalsePositiveRate = 0.001
+
+// BreachList 已泄露密码列表，使用布隆过滤器保存密码的 SHA-1 摘要。
+// 存在误判：少量未泄露的密码也会被判定为已泄露，概率由误判率控制；不会漏判。
+type BreachList struct {
+	bits   []uint64
+	m      uint64 // 位数
+	k      uint64 // 哈希函数个数
+	length int
+}
+
+// NewBreachList 按预计的条目数和误判率创建空的列表
+func NewBreachList(n int, falsePositiveRate float64) *BreachList {
+	if n < 1 {
+		n = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = DefaultFalsePositiveRate
+	}
+
+	m := uint64(math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	k := uint64(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+
+	return &BreachList{
+		bits: make([]uint64, (m+63)/64),
+		m:    m,
+		k:    k,
+	}
+}
+
+// LoadBreachList 从文件加载已泄露密码列表。
+// 每行一个明文密码，或其 SHA-1 十六进制摘要（兼容 "摘要:次数" 格式），空行忽略。
+func LoadBreachList(path string, falsePositiveRate float64) (*BreachList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// 先统计行数以确定过滤器大小
+	n, err := countLines(f)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	list := NewBreachList(n, falsePositiveRate)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if digest, ok := parseDigest(line); ok {
+			list.addDigest(digest)
+		} else {
+			list.Add(line)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// Add 加入一个明文密码
+func (l *BreachList) Add(password string) {
+	l.addDigest(sha1.Sum([]byte(password)))
+}
+
+// Contains 密码是否可能已泄露，列表为空时总是返回 false
+func (l *BreachList) Contains(password string) bool {
+	if l == nil || l.length == 0 {
+		return false
+	}
+
+	h1, h2 := splitDigest(sha1.Sum([]byte(password)))
+	for i := uint64(0); i < l.k; i++ {
+		bit := (h1 + i*h2) % l.m
+		if l.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Len 加入的条目数
+func (l *BreachList) Len() int {
+	if l == nil {
+		return 0
+	}
+	return l.length
+}
+
+func (l *BreachList) addDigest(digest [sha1.Size]byte) {
+	h1, h2 := splitDigest(digest)
+	for i := uint64(0); i < l.k; i++ {
+		bit := (h1 + i*h2) % l.m
+		l.bits[bit/64] |= 1 << (bit % 64)
+	}
+	l.length++
+}
+
+// splitDigest 用摘要的前后两段做双重哈希，摘要本身已均匀分布
+func splitDigest(digest [sha1.Size]byte) (uint64, uint64) {
+	h1 := binary.BigEndian.Uint64(digest[0:8])
+	h2 := binary.BigEndian.Uint64(digest[8:16]) | 1
+	return h1, h2
+}
+
+func parseDigest(line string) ([sha1.Size]byte, bool) {
+	var digest [sha1.Size]byte
+
+	if i := strings.IndexByte(line, ':'); i == sha1.Size*2 {
+		line = line[:i]
+	}
+	if len(line) != sha1.Size*2 {
+		return digest, false
+	}
+	if _, err := hex.Decode(digest[:], []byte(line)); err != nil {
+		return digest, false
+	}
+	return digest, true
+}
+
+func countLines(r io.Reader) (int, error) {
+	n := 0
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if scanner.Text() != "" {
+			n++
+		}
+	}
+	return n, scanner.Err()
+}
@@ -0,0 +1,99 @@
+package passwordpolicy
+
+import (
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Rule 密码策略规则
+type Rule string
+
+const (
+	RuleMinLength Rule = "min_length" // 长度不足
+	RuleUppercase Rule = "uppercase"  // 缺少大写字母
+	RuleLowercase Rule = "lowercase"  // 缺少小写字母
+	RuleDigit     Rule = "digit"      // 缺少数字
+	RuleSymbol    Rule = "symbol"     // 缺少特殊字符
+	RuleBreached  Rule = "breached"   // 出现在已泄露密码列表中
+	RuleHistory   Rule = "history"    // 与最近使用过的密码相同
+)
+
+// MaxHistoryCount 最多保留的历史密码数，策略中的 HistoryCount 超过该值时按该值处理
+const MaxHistoryCount = 24
+
+// Policy 密码策略
+type Policy struct {
+	MinLength        int           // 最小长度（按字符计）
+	RequireUppercase bool          // 必须包含大写字母
+	RequireLowercase bool          // 必须包含小写字母
+	RequireDigit     bool          // 必须包含数字
+	RequireSymbol    bool          // 必须包含特殊字符
+	HistoryCount     int           // 不能与最近使用过的几个密码相同，0 表示不限制
+	MaxAge           time.Duration // 密码最长使用期限，0 表示不过期
+	RejectBreached   bool          // 拒绝已泄露的密码
+}
+
+// Default 没有配置密码策略时使用的默认策略，不做任何限制，与启用密码策略前的行为一致
+func Default() *Policy {
+	return &Policy{}
+}
+
+// Validate 检查密码的长度、字符类型以及是否已泄露，返回未通过的规则。
+// 历史密码需要与存储的哈希逐个比对，由调用方检查。
+func (p *Policy) Validate(password string, breached *BreachList) []Rule {
+	var failed []Rule
+
+	if p.MinLength > 0 && utf8.RuneCountInString(password) < p.MinLength {
+		failed = append(failed, RuleMinLength)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, c := range password {
+		switch {
+		case unicode.IsUpper(c):
+			hasUpper = true
+		case unicode.IsLower(c):
+			hasLower = true
+		case unicode.IsDigit(c):
+			hasDigit = true
+		case unicode.IsPunct(c) || unicode.IsSymbol(c) || unicode.IsSpace(c):
+			hasSymbol = true
+		}
+	}
+
+	if p.RequireUppercase && !hasUpper {
+		failed = append(failed, RuleUppercase)
+	}
+	if p.RequireLowercase && !hasLower {
+		failed = append(failed, RuleLowercase)
+	}
+	if p.RequireDigit && !hasDigit {
+		failed = append(failed, RuleDigit)
+	}
+	if p.RequireSymbol && !hasSymbol {
+		failed = append(failed, RuleSymbol)
+	}
+
+	if p.RejectBreached && breached.Contains(password) {
+		failed = append(failed, RuleBreached)
+	}
+
+	return failed
+}
+
+// HistoryLimit 需要比对的历史密码数
+func (p *Policy) HistoryLimit() int {
+	if p.HistoryCount > MaxHistoryCount {
+		return MaxHistoryCount
+	}
+	return p.HistoryCount
+}
+
+// IsExpired 密码自 changedAt 起是否已超过最长使用期限
+func (p *Policy) IsExpired(changedAt, now time.Time) bool {
+	if p.MaxAge <= 0 || changedAt.IsZero() {
+		return false
+	}
+	return now.Sub(changedAt) > p.MaxAge
+}
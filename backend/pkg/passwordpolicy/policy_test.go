@@ -0,0 +1,79 @@
+package passwordpolicy
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	p := &Policy{
+		MinLength:        10,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+
+	assert.Equal(t, []Rule{RuleMinLength, RuleUppercase, RuleDigit, RuleSymbol}, p.Validate("short", nil))
+	assert.Empty(t, p.Validate("Correct-Horse-9", nil))
+
+	// 长度按字符计算
+	assert.Empty(t, (&Policy{MinLength: 4}).Validate("密码密码", nil))
+
+	breached := NewBreachList(10, 0)
+	breached.Add("Correct-Horse-9")
+	assert.Empty(t, p.Validate("Correct-Horse-9", breached))
+
+	p.RejectBreached = true
+	assert.Equal(t, []Rule{RuleBreached}, p.Validate("Correct-Horse-9", breached))
+}
+
+func TestPolicyIsExpired(t *testing.T) {
+	now := time.Now()
+	p := &Policy{MaxAge: 90 * 24 * time.Hour}
+
+	assert.False(t, p.IsExpired(now.Add(-24*time.Hour), now))
+	assert.True(t, p.IsExpired(now.Add(-91*24*time.Hour), now))
+	assert.False(t, (&Policy{}).IsExpired(now.Add(-1000*24*time.Hour), now))
+}
+
+func TestLoadBreachList(t *testing.T) {
+	digest := sha1.Sum([]byte("P@ssw0rd"))
+
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	content := strings.Join([]string{
+		"123456",
+		"",
+		"qwerty\r",
+		strings.ToUpper(hex.EncodeToString(digest[:])) + ":52256",
+	}, "\n")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	list, err := LoadBreachList(path, 0.0001)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, list.Len())
+
+	assert.True(t, list.Contains("123456"))
+	assert.True(t, list.Contains("qwerty"))
+	assert.True(t, list.Contains("P@ssw0rd"))
+
+	// 误判率远低于 1%
+	falsePositives := 0
+	for i := 0; i < 1000; i++ {
+		if list.Contains(fmt.Sprintf("not-breached-%d", i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 10)
+
+	_, err = LoadBreachList(filepath.Join(t.TempDir(), "missing.txt"), 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,545 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 过滤条件的比较运算符
+const (
+	OpEqual          = "eq"
+	OpNotEqual       = "ne"
+	OpContains       = "co"
+	OpStartsWith     = "sw"
+	OpEndsWith       = "ew"
+	OpPresent        = "pr"
+	OpGreater        = "gt"
+	OpGreaterOrEqual = "ge"
+	OpLess           = "lt"
+	OpLessOrEqual    = "le"
+)
+
+var compareOps = map[string]struct{}{
+	OpEqual: {}, OpNotEqual: {}, OpContains: {}, OpStartsWith: {}, OpEndsWith: {}, OpPresent: {},
+	OpGreater: {}, OpGreaterOrEqual: {}, OpLess: {}, OpLessOrEqual: {},
+}
+
+// AttrPath 属性路径，名称统一为小写；核心模式的 URN 前缀会被去掉，扩展模式的 URN 保存在 URN 中
+type AttrPath struct {
+	URN     string
+	Attr    string
+	SubAttr string
+}
+
+func (p AttrPath) String() string {
+	s := p.Attr
+	if p.SubAttr != "" {
+		s += "." + p.SubAttr
+	}
+	if p.URN != "" {
+		s = p.URN + ":" + s
+	}
+	return s
+}
+
+var coreSchemaPrefixes = []string{
+	strings.ToLower(SchemaUser) + ":",
+	strings.ToLower(SchemaGroup) + ":",
+}
+
+var extensionSchemas = []string{
+	strings.ToLower(SchemaEnterpriseUser),
+}
+
+// ParseAttrPath 解析属性路径，如 userName、name.givenName、urn:...:enterprise:2.0:User:department
+func ParseAttrPath(s string) (AttrPath, error) {
+	var p AttrPath
+	rest := strings.ToLower(strings.TrimSpace(s))
+
+	for _, prefix := range coreSchemaPrefixes {
+		if strings.HasPrefix(rest, prefix) {
+			rest = rest[len(prefix):]
+			break
+		}
+	}
+	for _, urn := range extensionSchemas {
+		if strings.HasPrefix(rest, urn+":") {
+			p.URN = urn
+			rest = rest[len(urn)+1:]
+			break
+		}
+	}
+
+	p.Attr, p.SubAttr, _ = strings.Cut(rest, ".")
+	if !validAttrName(p.Attr) || (p.SubAttr != "" && !validAttrName(p.SubAttr)) || strings.Contains(p.SubAttr, ".") {
+		return AttrPath{}, NewError(http.StatusBadRequest, ErrInvalidPath, "invalid attribute path %q", s)
+	}
+	return p, nil
+}
+
+func validAttrName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c == '$' && i == 0:
+		case i > 0 && (c >= '0' && c <= '9' || c == '_' || c == '-'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Filter 过滤条件
+type Filter interface {
+	isFilter()
+}
+
+// AttrExpr 属性比较，如 userName eq "bjensen"
+type AttrExpr struct {
+	Path  AttrPath
+	Op    string
+	Value any // string、bool、float64 或 nil
+}
+
+// LogicalExpr and / or 组合
+type LogicalExpr struct {
+	Op    string // "and" 或 "or"
+	Left  Filter
+	Right Filter
+}
+
+// NotExpr 取反
+type NotExpr struct {
+	Filter Filter
+}
+
+// ValuePathExpr 多值属性的元素过滤，如 emails[type eq "work"]，内层条件的路径相对于元素
+type ValuePathExpr struct {
+	Path   AttrPath
+	Filter Filter
+}
+
+func (*AttrExpr) isFilter()      {}
+func (*LogicalExpr) isFilter()   {}
+func (*NotExpr) isFilter()       {}
+func (*ValuePathExpr) isFilter() {}
+
+// ParseFilter 解析 RFC 7644 3.4.2.2 定义的过滤表达式
+func ParseFilter(s string) (Filter, error) {
+	p := &filterParser{tokens: nil}
+	if err := p.tokenize(s); err != nil {
+		return nil, err
+	}
+	if len(p.tokens) == 0 {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidFilter, "empty filter")
+	}
+
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidFilter, "unexpected %q in filter", p.tokens[p.pos].text)
+	}
+	return f, nil
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+type filterParser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *filterParser) tokenize(s string) error {
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			p.tokens = append(p.tokens, token{kind: tokenLParen, text: "("})
+			i++
+		case c == ')':
+			p.tokens = append(p.tokens, token{kind: tokenRParen, text: ")"})
+			i++
+		case c == '[':
+			p.tokens = append(p.tokens, token{kind: tokenLBracket, text: "["})
+			i++
+		case c == ']':
+			p.tokens = append(p.tokens, token{kind: tokenRBracket, text: "]"})
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(s); j++ {
+				if s[j] == '\\' {
+					j++
+					continue
+				}
+				if s[j] == '"' {
+					break
+				}
+			}
+			if j >= len(s) {
+				return NewError(http.StatusBadRequest, ErrInvalidFilter, "unterminated string in filter")
+			}
+			var v string
+			if err := json.Unmarshal([]byte(s[i:j+1]), &v); err != nil {
+				return NewError(http.StatusBadRequest, ErrInvalidFilter, "invalid string %s in filter", s[i:j+1])
+			}
+			p.tokens = append(p.tokens, token{kind: tokenString, text: v})
+			i = j + 1
+		default:
+			j := i
+			for j < len(s) && !strings.ContainsRune(" \t\r\n()[]\"", rune(s[j])) {
+				j++
+			}
+			p.tokens = append(p.tokens, token{kind: tokenWord, text: s[i:j]})
+			i = j
+		}
+	}
+	return nil
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	return p.pos < len(p.tokens) &&
+		p.tokens[p.pos].kind == tokenWord &&
+		strings.EqualFold(p.tokens[p.pos].text, keyword)
+}
+
+func (p *filterParser) expect(kind tokenKind, text string) error {
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != kind {
+		return NewError(http.StatusBadRequest, ErrInvalidFilter, "expected %q in filter", text)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *filterParser) parseOr() (Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &LogicalExpr{Op: "or", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (Filter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &LogicalExpr{Op: "and", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (Filter, error) {
+	// not 后面必须是括号，否则 not 是一个属性名
+	if p.peekKeyword("not") && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].kind == tokenLParen {
+		p.pos++
+		f, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Filter: f}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *filterParser) parsePrimary() (Filter, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidFilter, "unexpected end of filter")
+	}
+
+	t := p.tokens[p.pos]
+	if t.kind == tokenLParen {
+		p.pos++
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(tokenRParen, ")"); err != nil {
+			return nil, err
+		}
+		return f, nil
+	}
+
+	if t.kind != tokenWord {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidFilter, "expected attribute but got %q", t.text)
+	}
+	p.pos++
+
+	path, err := ParseAttrPath(t.text)
+	if err != nil {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidFilter, "invalid attribute %q in filter", t.text)
+	}
+
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == tokenLBracket {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(tokenRBracket, "]"); err != nil {
+			return nil, err
+		}
+		return &ValuePathExpr{Path: path, Filter: inner}, nil
+	}
+
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != tokenWord {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidFilter, "expected operator after %q", t.text)
+	}
+	op := strings.ToLower(p.tokens[p.pos].text)
+	if _, ok := compareOps[op]; !ok {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidFilter, "unsupported operator %q", p.tokens[p.pos].text)
+	}
+	p.pos++
+
+	if op == OpPresent {
+		return &AttrExpr{Path: path, Op: op}, nil
+	}
+
+	if p.pos >= len(p.tokens) {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidFilter, "expected value after %q", op)
+	}
+	v := p.tokens[p.pos]
+	p.pos++
+
+	var value any
+	switch v.kind {
+	case tokenString:
+		value = v.text
+	case tokenWord:
+		switch strings.ToLower(v.text) {
+		case "true":
+			value = true
+		case "false":
+			value = false
+		case "null":
+			value = nil
+		default:
+			n, err := strconv.ParseFloat(v.text, 64)
+			if err != nil {
+				return nil, NewError(http.StatusBadRequest, ErrInvalidFilter, "invalid value %q in filter", v.text)
+			}
+			value = n
+		}
+	default:
+		return nil, NewError(http.StatusBadRequest, ErrInvalidFilter, "expected value after %q", op)
+	}
+
+	return &AttrExpr{Path: path, Op: op, Value: value}, nil
+}
+
+// LookupKey 不区分大小写地查找 JSON 文档中的键，返回文档中实际的键名
+func LookupKey(doc map[string]any, name string) (string, bool) {
+	if _, ok := doc[name]; ok {
+		return name, true
+	}
+	for k := range doc {
+		if strings.EqualFold(k, name) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// Matches 在内存中计算过滤条件，用于 PATCH 路径中多值属性的元素过滤
+func Matches(f Filter, doc map[string]any) bool {
+	switch e := f.(type) {
+	case *LogicalExpr:
+		if e.Op == "and" {
+			return Matches(e.Left, doc) && Matches(e.Right, doc)
+		}
+		return Matches(e.Left, doc) || Matches(e.Right, doc)
+
+	case *NotExpr:
+		return !Matches(e.Filter, doc)
+
+	case *ValuePathExpr:
+		for _, elem := range asSlice(resolve(doc, AttrPath{URN: e.Path.URN, Attr: e.Path.Attr})) {
+			if m, ok := elem.(map[string]any); ok && Matches(e.Filter, m) {
+				return true
+			}
+		}
+		return false
+
+	case *AttrExpr:
+		actual := resolve(doc, e.Path)
+		if values, ok := actual.([]any); ok {
+			// 多值属性只要有一个元素满足即可
+			for _, v := range values {
+				if compare(v, e.Op, e.Value) {
+					return true
+				}
+			}
+			return e.Op == OpNotEqual && len(values) == 0
+		}
+		return compare(actual, e.Op, e.Value)
+	}
+	return false
+}
+
+func resolve(doc map[string]any, path AttrPath) any {
+	if path.URN != "" {
+		key, ok := LookupKey(doc, path.URN)
+		if !ok {
+			return nil
+		}
+		ext, _ := doc[key].(map[string]any)
+		doc = ext
+	}
+
+	key, ok := LookupKey(doc, path.Attr)
+	if !ok {
+		return nil
+	}
+	v := doc[key]
+	if path.SubAttr == "" {
+		return v
+	}
+
+	switch t := v.(type) {
+	case map[string]any:
+		return resolve(t, AttrPath{Attr: path.SubAttr})
+	case []any:
+		var ret []any
+		for _, elem := range t {
+			if m, ok := elem.(map[string]any); ok {
+				if sub := resolve(m, AttrPath{Attr: path.SubAttr}); sub != nil {
+					ret = append(ret, sub)
+				}
+			}
+		}
+		return ret
+	}
+	return nil
+}
+
+func asSlice(v any) []any {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		return t
+	default:
+		return []any{t}
+	}
+}
+
+// compare 字符串比较不区分大小写
+func compare(actual any, op string, expected any) bool {
+	if op == OpPresent {
+		switch t := actual.(type) {
+		case nil:
+			return false
+		case string:
+			return t != ""
+		case []any:
+			return len(t) > 0
+		case map[string]any:
+			return len(t) > 0
+		}
+		return true
+	}
+
+	switch a := actual.(type) {
+	case string:
+		e, ok := expected.(string)
+		if !ok {
+			return op == OpNotEqual
+		}
+		// 两边都是时间时按时间比较
+		if at, err := time.Parse(time.RFC3339, a); err == nil {
+			if et, err := time.Parse(time.RFC3339, e); err == nil {
+				return compareOrdered(at.Compare(et), op)
+			}
+		}
+		a, e = strings.ToLower(a), strings.ToLower(e)
+		switch op {
+		case OpContains:
+			return strings.Contains(a, e)
+		case OpStartsWith:
+			return strings.HasPrefix(a, e)
+		case OpEndsWith:
+			return strings.HasSuffix(a, e)
+		}
+		return compareOrdered(strings.Compare(a, e), op)
+
+	case float64:
+		e, ok := expected.(float64)
+		if !ok {
+			return op == OpNotEqual
+		}
+		switch {
+		case a < e:
+			return compareOrdered(-1, op)
+		case a > e:
+			return compareOrdered(1, op)
+		}
+		return compareOrdered(0, op)
+
+	default:
+		equal := reflect.DeepEqual(actual, expected)
+		switch op {
+		case OpEqual:
+			return equal
+		case OpNotEqual:
+			return !equal
+		}
+	}
+	return false
+}
+
+func compareOrdered(c int, op string) bool {
+	switch op {
+	case OpEqual:
+		return c == 0
+	case OpNotEqual:
+		return c != 0
+	case OpGreater:
+		return c > 0
+	case OpGreaterOrEqual:
+		return c >= 0
+	case OpLess:
+		return c < 0
+	case OpLessOrEqual:
+		return c <= 0
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package scim
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAttrPath(t *testing.T) {
+	p, err := ParseAttrPath("name.givenName")
+	assert.NoError(t, err)
+	assert.Equal(t, AttrPath{Attr: "name", SubAttr: "givenname"}, p)
+
+	p, err = ParseAttrPath("urn:ietf:params:scim:schemas:core:2.0:User:userName")
+	assert.NoError(t, err)
+	assert.Equal(t, "username", p.String())
+
+	p, err = ParseAttrPath("urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department")
+	assert.NoError(t, err)
+	assert.Equal(t, "department", p.Attr)
+	assert.Equal(t, "urn:ietf:params:scim:schemas:extension:enterprise:2.0:user", p.URN)
+
+	for _, s := range []string{"", "a.b.c", "1abc", "user name"} {
+		_, err = ParseAttrPath(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	f, err := ParseFilter(`userName Eq "bjensen"`)
+	assert.NoError(t, err)
+	assert.Equal(t, &AttrExpr{Path: AttrPath{Attr: "username"}, Op: OpEqual, Value: "bjensen"}, f)
+
+	// and 的优先级高于 or
+	f, err = ParseFilter(`title pr or userType eq "Employee" and active eq true`)
+	assert.NoError(t, err)
+	or, ok := f.(*LogicalExpr)
+	if assert.True(t, ok) {
+		assert.Equal(t, "or", or.Op)
+		assert.Equal(t, &AttrExpr{Path: AttrPath{Attr: "title"}, Op: OpPresent}, or.Left)
+		and, ok := or.Right.(*LogicalExpr)
+		if assert.True(t, ok) {
+			assert.Equal(t, "and", and.Op)
+			assert.Equal(t, true, and.Right.(*AttrExpr).Value)
+		}
+	}
+
+	f, err = ParseFilter(`not (emails[type eq "work" and value co "@example.com"]) and meta.lastModified gt "2011-05-13T04:42:34Z"`)
+	assert.NoError(t, err)
+	and := f.(*LogicalExpr)
+	not, ok := and.Left.(*NotExpr)
+	if assert.True(t, ok) {
+		vp, ok := not.Filter.(*ValuePathExpr)
+		if assert.True(t, ok) {
+			assert.Equal(t, "emails", vp.Path.Attr)
+		}
+	}
+	assert.Equal(t, "meta.lastmodified", and.Right.(*AttrExpr).Path.String())
+
+	f, err = ParseFilter(`displayName eq "say \"hi\""`)
+	assert.NoError(t, err)
+	assert.Equal(t, `say "hi"`, f.(*AttrExpr).Value)
+
+	for _, s := range []string{``, `userName`, `userName xx "a"`, `userName eq`, `(userName eq "a"`, `userName eq "a" extra`, `userName eq "a`} {
+		_, err = ParseFilter(s)
+		var scimErr *Error
+		if assert.True(t, errors.As(err, &scimErr), s) {
+			assert.Equal(t, ErrInvalidFilter, scimErr.ScimType, s)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	doc := map[string]any{
+		"userName": "BJensen",
+		"active":   true,
+		"emails": []any{
+			map[string]any{"value": "bjensen@example.com", "type": "work"},
+			map[string]any{"value": "babs@home.org", "type": "home"},
+		},
+		"meta": map[string]any{"lastModified": "2011-05-13T04:42:34Z"},
+	}
+
+	cases := map[string]bool{
+		`userName eq "bjensen"`:                       true,
+		`userName ne "bjensen"`:                       false,
+		`userName sw "bj" and active eq true`:         true,
+		`active eq false or userName ew "sen"`:        true,
+		`emails[type eq "home" and value co "home"]`:  true,
+		`emails[type eq "other"]`:                     false,
+		`emails.value eq "babs@home.org"`:             true,
+		`title pr`:                                    false,
+		`not (title pr)`:                              true,
+		`meta.lastModified gt "2011-05-13T00:00:00Z"`: true,
+		`meta.lastModified lt "2011-05-13T00:00:00Z"`: false,
+	}
+	for s, want := range cases {
+		f, err := ParseFilter(s)
+		if assert.NoError(t, err, s) {
+			assert.Equal(t, want, Matches(f, doc), s)
+		}
+	}
+}
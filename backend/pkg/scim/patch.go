@@ -0,0 +1,377 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// PATCH 操作类型
+const (
+	PatchAdd     = "add"
+	PatchReplace = "replace"
+	PatchRemove  = "remove"
+)
+
+// PatchPath PATCH 操作的目标路径，如 members[value eq "2819c223"]、emails[type eq "work"].value
+type PatchPath struct {
+	AttrPath
+	Filter Filter // 多值属性的元素过滤条件，可以为空
+}
+
+// ParsePatchPath 解析 PATCH 操作的目标路径
+func ParsePatchPath(s string) (*PatchPath, error) {
+	open := strings.IndexByte(s, '[')
+	if open < 0 {
+		p, err := ParseAttrPath(s)
+		if err != nil {
+			return nil, err
+		}
+		return &PatchPath{AttrPath: p}, nil
+	}
+
+	end := strings.LastIndexByte(s, ']')
+	if end < open {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidPath, "invalid path %q", s)
+	}
+
+	p, err := ParseAttrPath(s[:open])
+	if err != nil {
+		return nil, err
+	}
+	if p.SubAttr != "" {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidPath, "invalid path %q", s)
+	}
+
+	filter, err := ParseFilter(s[open+1 : end])
+	if err != nil {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidPath, "invalid filter in path %q", s)
+	}
+
+	if tail := s[end+1:]; tail != "" {
+		if !strings.HasPrefix(tail, ".") || !validAttrName(strings.ToLower(tail[1:])) {
+			return nil, NewError(http.StatusBadRequest, ErrInvalidPath, "invalid path %q", s)
+		}
+		p.SubAttr = strings.ToLower(tail[1:])
+	}
+
+	return &PatchPath{AttrPath: p, Filter: filter}, nil
+}
+
+// ApplyPatch 把 PATCH 操作依次应用到资源的 JSON 文档上，任何一个操作失败时返回错误，调用方应放弃整个文档
+func ApplyPatch(doc map[string]any, ops []PatchOperation) error {
+	for _, op := range ops {
+		if err := applyOperation(doc, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyOperation(doc map[string]any, op PatchOperation) error {
+	kind := strings.ToLower(op.Op)
+	switch kind {
+	case PatchAdd, PatchReplace, PatchRemove:
+	default:
+		return NewError(http.StatusBadRequest, ErrInvalidSyntax, "unsupported patch op %q", op.Op)
+	}
+
+	var value any
+	if len(op.Value) > 0 {
+		if err := json.Unmarshal(op.Value, &value); err != nil {
+			return NewError(http.StatusBadRequest, ErrInvalidSyntax, "invalid patch value: %s", err.Error())
+		}
+	}
+
+	if op.Path != "" {
+		path, err := ParsePatchPath(op.Path)
+		if err != nil {
+			return err
+		}
+		return applyPath(doc, kind, path, value)
+	}
+
+	// 没有路径时 value 是包含若干属性的对象
+	if kind == PatchRemove {
+		return NewError(http.StatusBadRequest, ErrNoTarget, "remove operation requires a path")
+	}
+	attrs, ok := value.(map[string]any)
+	if !ok {
+		return NewError(http.StatusBadRequest, ErrInvalidValue, "patch value must be an object when path is empty")
+	}
+
+	for k, v := range attrs {
+		if strings.EqualFold(k, "schemas") {
+			continue
+		}
+
+		if urn, isExtension := extensionURN(k); isExtension {
+			sub, ok := v.(map[string]any)
+			if !ok {
+				return NewError(http.StatusBadRequest, ErrInvalidValue, "extension %q must be an object", k)
+			}
+			for subKey, subValue := range sub {
+				path, err := ParsePatchPath(urn + ":" + subKey)
+				if err != nil {
+					return err
+				}
+				if err = applyPath(doc, kind, path, subValue); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		path, err := ParsePatchPath(k)
+		if err != nil {
+			return err
+		}
+		if err = applyPath(doc, kind, path, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extensionURN(key string) (string, bool) {
+	lower := strings.ToLower(key)
+	for _, urn := range extensionSchemas {
+		if lower == urn {
+			return urn, true
+		}
+	}
+	return "", false
+}
+
+func applyPath(doc map[string]any, kind string, path *PatchPath, value any) error {
+	container := doc
+	if path.URN != "" {
+		key, ok := LookupKey(doc, path.URN)
+		if !ok {
+			if kind == PatchRemove {
+				return nil
+			}
+			key = path.URN
+			doc[key] = map[string]any{}
+		}
+		ext, ok := doc[key].(map[string]any)
+		if !ok {
+			return NewError(http.StatusBadRequest, ErrInvalidPath, "%q is not an object", path.URN)
+		}
+		container = ext
+	}
+
+	key, exists := LookupKey(container, path.Attr)
+	if !exists {
+		key = path.Attr
+	}
+
+	if path.Filter == nil {
+		if path.SubAttr == "" {
+			return setValue(container, key, exists, kind, value)
+		}
+
+		// 单值复杂属性的子属性，如 name.givenName
+		if !exists || container[key] == nil {
+			if kind == PatchRemove {
+				return nil
+			}
+			container[key] = map[string]any{}
+		}
+		complexValue, ok := container[key].(map[string]any)
+		if !ok {
+			return NewError(http.StatusBadRequest, ErrInvalidPath, "%q is not a complex attribute", path.Attr)
+		}
+		subKey, subExists := LookupKey(complexValue, path.SubAttr)
+		if !subExists {
+			subKey = path.SubAttr
+		}
+		return setValue(complexValue, subKey, subExists, kind, value)
+	}
+
+	var values []any
+	if exists && container[key] != nil {
+		var ok bool
+		if values, ok = container[key].([]any); !ok {
+			return NewError(http.StatusBadRequest, ErrInvalidPath, "%q is not a multi-valued attribute", path.Attr)
+		}
+	}
+
+	var matched []int
+	for i, elem := range values {
+		if m, ok := elem.(map[string]any); ok && Matches(path.Filter, m) {
+			matched = append(matched, i)
+		}
+	}
+
+	if len(matched) == 0 {
+		if kind == PatchRemove {
+			return nil
+		}
+		// 过滤条件是简单的相等比较时按条件新建元素，如 emails[type eq "work"].value
+		elem, ok := newElementFromFilter(path.Filter)
+		if !ok {
+			return NewError(http.StatusBadRequest, ErrNoTarget, "no value matches path filter")
+		}
+		if path.SubAttr != "" {
+			elem[path.SubAttr] = value
+		} else if m, ok := value.(map[string]any); ok {
+			for k, v := range m {
+				elem[k] = v
+			}
+		} else {
+			return NewError(http.StatusBadRequest, ErrInvalidValue, "patch value must be an object")
+		}
+		container[key] = append(values, elem)
+		return nil
+	}
+
+	if kind == PatchRemove && path.SubAttr == "" {
+		kept := make([]any, 0, len(values))
+		for i, elem := range values {
+			if !slices.Contains(matched, i) {
+				kept = append(kept, elem)
+			}
+		}
+		container[key] = kept
+		return nil
+	}
+
+	for _, i := range matched {
+		elem := values[i].(map[string]any)
+		if path.SubAttr != "" {
+			subKey, subExists := LookupKey(elem, path.SubAttr)
+			if !subExists {
+				subKey = path.SubAttr
+			}
+			if err := setValue(elem, subKey, subExists, kind, value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		m, ok := value.(map[string]any)
+		if !ok {
+			return NewError(http.StatusBadRequest, ErrInvalidValue, "patch value must be an object")
+		}
+		if kind == PatchReplace {
+			values[i] = m
+		} else {
+			mergeInto(elem, m)
+		}
+	}
+	container[key] = values
+	return nil
+}
+
+// setValue 对单个属性执行操作：多值属性的 add 追加元素，复杂属性的 add/replace 合并子属性
+func setValue(m map[string]any, key string, exists bool, kind string, value any) error {
+	existing := m[key]
+
+	switch kind {
+	case PatchRemove:
+		if !exists {
+			return nil
+		}
+		// 部分身份供应方在 remove 时通过 value 指定要移除的元素，而不是使用过滤条件
+		if values, ok := existing.([]any); ok && value != nil {
+			kept := make([]any, 0, len(values))
+			for _, elem := range values {
+				if !containsElement(asSlice(value), elem) {
+					kept = append(kept, elem)
+				}
+			}
+			m[key] = kept
+			return nil
+		}
+		delete(m, key)
+
+	case PatchAdd:
+		if values, ok := existing.([]any); ok && exists {
+			for _, elem := range asSlice(value) {
+				if !containsElement(values, elem) {
+					values = append(values, elem)
+				}
+			}
+			m[key] = values
+			return nil
+		}
+		if mergeComplex(existing, value) {
+			return nil
+		}
+		m[key] = value
+
+	case PatchReplace:
+		if _, ok := existing.([]any); ok && exists {
+			m[key] = asSlice(value)
+			return nil
+		}
+		if mergeComplex(existing, value) {
+			return nil
+		}
+		m[key] = value
+	}
+	return nil
+}
+
+func mergeComplex(existing, value any) bool {
+	dst, ok := existing.(map[string]any)
+	if !ok {
+		return false
+	}
+	src, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+	mergeInto(dst, src)
+	return true
+}
+
+func mergeInto(dst, src map[string]any) {
+	for k, v := range src {
+		if existingKey, ok := LookupKey(dst, k); ok {
+			k = existingKey
+		}
+		dst[k] = v
+	}
+}
+
+func newElementFromFilter(f Filter) (map[string]any, bool) {
+	e, ok := f.(*AttrExpr)
+	if !ok || e.Op != OpEqual || e.Path.SubAttr != "" || e.Path.URN != "" {
+		return nil, false
+	}
+	return map[string]any{e.Path.Attr: e.Value}, true
+}
+
+// containsElement 元素都带有 value 子属性时按 value 比较，否则比较整个元素
+func containsElement(values []any, target any) bool {
+	targetValue, targetHasValue := elementValue(target)
+	for _, v := range values {
+		if targetHasValue {
+			if value, ok := elementValue(v); ok && value == targetValue {
+				return true
+			}
+			continue
+		}
+		if reflect.DeepEqual(v, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func elementValue(elem any) (string, bool) {
+	m, ok := elem.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	key, ok := LookupKey(m, "value")
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprint(m[key]), true
+}
@@ -0,0 +1,118 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testPatchOps(t *testing.T, s string) []PatchOperation {
+	var req PatchRequest
+	assert.NoError(t, json.Unmarshal([]byte(s), &req))
+	return req.Operations
+}
+
+func TestApplyPatch_User(t *testing.T) {
+	doc, err := ToDocument(&User{
+		Schemas:  []string{SchemaUser},
+		UserName: "bjensen",
+		Name:     &Name{GivenName: "Barbara", FamilyName: "Jensen"},
+		Active:   new(bool),
+		Emails:   []MultiValued{{Value: "bjensen@example.com", Type: "work", Primary: true}},
+	})
+	assert.NoError(t, err)
+
+	// Okta 不带路径替换，Azure AD 使用大写的 op、字符串形式的布尔值与带过滤条件的路径
+	err = ApplyPatch(doc, testPatchOps(t, `{"Operations":[
+		{"op":"replace","value":{"displayName":"Babs","name":{"givenName":"Babs"}}},
+		{"op":"Replace","path":"active","value":"True"},
+		{"op":"Replace","path":"emails[type eq \"work\"].value","value":"babs@example.com"},
+		{"op":"Add","path":"phoneNumbers[type eq \"mobile\"].value","value":"+1 555 0100"},
+		{"op":"Add","path":"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department","value":"Sales"},
+		{"op":"remove","path":"name.familyName"}
+	]}`))
+	assert.NoError(t, err)
+
+	u, err := UserFromDocument(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, "Babs", u.DisplayName)
+	assert.Equal(t, &Name{GivenName: "Babs"}, u.Name)
+	if assert.NotNil(t, u.Active) {
+		assert.True(t, *u.Active)
+	}
+	assert.Equal(t, []MultiValued{{Value: "babs@example.com", Type: "work", Primary: true}}, u.Emails)
+	assert.Equal(t, []MultiValued{{Value: "+1 555 0100", Type: "mobile"}}, u.PhoneNumbers)
+	if assert.NotNil(t, u.Enterprise) {
+		assert.Equal(t, "Sales", u.Enterprise.Department)
+	}
+}
+
+func TestApplyPatch_GroupMembers(t *testing.T) {
+	doc, err := ToDocument(&Group{
+		Schemas:     []string{SchemaGroup},
+		DisplayName: "Sales",
+		Members:     []MultiValued{{Value: "1"}, {Value: "2"}},
+	})
+	assert.NoError(t, err)
+
+	err = ApplyPatch(doc, testPatchOps(t, `{"Operations":[
+		{"op":"add","path":"members","value":[{"value":"2"},{"value":"3"}]},
+		{"op":"remove","path":"members[value eq \"1\"]"},
+		{"op":"Remove","path":"members","value":[{"value":"3"}]},
+		{"op":"add","path":"members","value":[{"value":"4"}]}
+	]}`))
+	assert.NoError(t, err)
+
+	g, err := GroupFromDocument(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, []MultiValued{{Value: "2"}, {Value: "4"}}, g.Members)
+
+	err = ApplyPatch(doc, testPatchOps(t, `{"Operations":[{"op":"replace","path":"members","value":[{"value":"9"}]}]}`))
+	assert.NoError(t, err)
+	g, _ = GroupFromDocument(doc)
+	assert.Equal(t, []MultiValued{{Value: "9"}}, g.Members)
+
+	err = ApplyPatch(doc, testPatchOps(t, `{"Operations":[{"op":"remove","path":"members"}]}`))
+	assert.NoError(t, err)
+	g, _ = GroupFromDocument(doc)
+	assert.Empty(t, g.Members)
+}
+
+func TestApplyPatch_Errors(t *testing.T) {
+	cases := map[string]string{
+		`{"Operations":[{"op":"move","path":"userName"}]}`:                       ErrInvalidSyntax,
+		`{"Operations":[{"op":"remove"}]}`:                                       ErrNoTarget,
+		`{"Operations":[{"op":"replace","value":"x"}]}`:                          ErrInvalidValue,
+		`{"Operations":[{"op":"replace","path":"emails[","value":"x"}]}`:         ErrInvalidPath,
+		`{"Operations":[{"op":"replace","path":"emails[value pr]","value":{}}]}`: ErrNoTarget,
+	}
+	for s, scimType := range cases {
+		doc := map[string]any{"userName": "bjensen"}
+		err := ApplyPatch(doc, testPatchOps(t, s))
+		if scimErr, ok := err.(*Error); assert.True(t, ok, s) {
+			assert.Equal(t, scimType, scimErr.ScimType, s)
+		}
+	}
+}
+
+func TestETagAndPagination(t *testing.T) {
+	assert.True(t, MatchETag(`W/"a1"`, `W/"a1"`))
+	assert.True(t, MatchETag(`"a1"`, `W/"a1"`))
+	assert.True(t, MatchETag(`W/"b2", W/"a1"`, `W/"a1"`))
+	assert.True(t, MatchETag(`*`, `W/"a1"`))
+	assert.False(t, MatchETag(`W/"b2"`, `W/"a1"`))
+
+	start, count, err := ParsePagination("", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, start)
+	assert.Equal(t, DefaultCount, count)
+
+	start, count, err = ParsePagination("0", "1000")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, start)
+	assert.Equal(t, MaxCount, count)
+
+	_, _, err = ParsePagination("x", "")
+	assert.Error(t, err)
+}
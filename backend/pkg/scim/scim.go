@@ -0,0 +1,288 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaEnterpriseUser        = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	// ContentType SCIM 请求与响应的媒体类型
+	ContentType = "application/scim+json"
+
+	ResourceTypeUser  = "User"
+	ResourceTypeGroup = "Group"
+
+	DefaultCount = 100 // 列表查询未指定 count 时每页的条数
+	MaxCount     = 200 // 列表查询每页最多的条数
+)
+
+// 错误类型，见 RFC 7644 3.12
+const (
+	ErrInvalidFilter = "invalidFilter"
+	ErrTooMany       = "tooMany"
+	ErrUniqueness    = "uniqueness"
+	ErrMutability    = "mutability"
+	ErrInvalidSyntax = "invalidSyntax"
+	ErrInvalidPath   = "invalidPath"
+	ErrNoTarget      = "noTarget"
+	ErrInvalidValue  = "invalidValue"
+	ErrInvalidVers   = "invalidVers"
+)
+
+// Error SCIM 错误响应
+type Error struct {
+	Status   int
+	ScimType string
+	Detail   string
+}
+
+// NewError 创建 SCIM 错误，scimType 可以为空
+func NewError(status int, scimType, format string, args ...any) *Error {
+	return &Error{
+		Status:   status,
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *Error) Error() string {
+	if e.ScimType != "" {
+		return fmt.Sprintf("scim: %d %s: %s", e.Status, e.ScimType, e.Detail)
+	}
+	return fmt.Sprintf("scim: %d: %s", e.Status, e.Detail)
+}
+
+func (e *Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Schemas  []string `json:"schemas"`
+		Status   string   `json:"status"`
+		ScimType string   `json:"scimType,omitempty"`
+		Detail   string   `json:"detail,omitempty"`
+	}{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(e.Status),
+		ScimType: e.ScimType,
+		Detail:   e.Detail,
+	})
+}
+
+// Meta 资源的元数据
+type Meta struct {
+	ResourceType string     `json:"resourceType,omitempty"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+	Version      string     `json:"version,omitempty"`
+}
+
+// Name 用户姓名
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// MultiValued 多值属性的元素，如邮箱、电话、组成员
+type MultiValued struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// EnterpriseUser 企业用户扩展
+type EnterpriseUser struct {
+	EmployeeNumber string `json:"employeeNumber,omitempty"`
+	Organization   string `json:"organization,omitempty"`
+	Department     string `json:"department,omitempty"`
+}
+
+// User 用户资源
+type User struct {
+	Schemas      []string        `json:"schemas"`
+	ID           string          `json:"id,omitempty"`
+	ExternalID   string          `json:"externalId,omitempty"`
+	UserName     string          `json:"userName"`
+	Name         *Name           `json:"name,omitempty"`
+	DisplayName  string          `json:"displayName,omitempty"`
+	Active       *bool           `json:"active,omitempty"`
+	Password     string          `json:"password,omitempty"` // 只写，不会出现在响应中
+	Emails       []MultiValued   `json:"emails,omitempty"`
+	PhoneNumbers []MultiValued   `json:"phoneNumbers,omitempty"`
+	Groups       []MultiValued   `json:"groups,omitempty"` // 只读
+	Enterprise   *EnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	Meta         *Meta           `json:"meta,omitempty"`
+}
+
+// Group 组资源
+type Group struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []MultiValued `json:"members,omitempty"`
+	Meta        *Meta         `json:"meta,omitempty"`
+}
+
+// ListResponse 列表查询的响应
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    any      `json:"Resources"`
+}
+
+// NewListResponse 创建列表响应，resources 必须是切片
+func NewListResponse(total, startIndex, itemsPerPage int, resources any) *ListResponse {
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: itemsPerPage,
+		Resources:    resources,
+	}
+}
+
+// PatchRequest PATCH 请求
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation PATCH 操作，op 不区分大小写
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// DecodeUser 解析用户资源。身份供应方有时会把布尔值写成字符串（如 Azure AD 的 "False"），在这里统一转换。
+func DecodeUser(data []byte) (*User, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidSyntax, "invalid json: %s", err.Error())
+	}
+	return UserFromDocument(doc)
+}
+
+// UserFromDocument 把 JSON 文档转换为用户资源
+func UserFromDocument(doc map[string]any) (*User, error) {
+	if key, ok := LookupKey(doc, "active"); ok {
+		if s, isString := doc[key].(string); isString {
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return nil, NewError(http.StatusBadRequest, ErrInvalidValue, "active must be a boolean")
+			}
+			doc[key] = b
+		}
+	}
+
+	var u User
+	if err := remarshal(doc, &u); err != nil {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidValue, "invalid user: %s", err.Error())
+	}
+	return &u, nil
+}
+
+// DecodeGroup 解析组资源
+func DecodeGroup(data []byte) (*Group, error) {
+	var g Group
+	if err := json.Unmarshal(data, &g); err != nil {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidSyntax, "invalid json: %s", err.Error())
+	}
+	return &g, nil
+}
+
+// GroupFromDocument 把 JSON 文档转换为组资源
+func GroupFromDocument(doc map[string]any) (*Group, error) {
+	var g Group
+	if err := remarshal(doc, &g); err != nil {
+		return nil, NewError(http.StatusBadRequest, ErrInvalidValue, "invalid group: %s", err.Error())
+	}
+	return &g, nil
+}
+
+// ToDocument 把资源转换为 JSON 文档，用于应用 PATCH 操作
+func ToDocument(resource any) (map[string]any, error) {
+	var doc map[string]any
+	if err := remarshal(resource, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func remarshal(from, to any) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
+
+// PrimaryValue 返回多值属性中的主值，没有标记主值时返回第一个
+func PrimaryValue(values []MultiValued) string {
+	for _, v := range values {
+		if v.Primary {
+			return v.Value
+		}
+	}
+	if len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
+
+// Version 按资源的更新时间生成弱 ETag
+func Version(t time.Time) string {
+	return fmt.Sprintf(`W/"%x"`, t.UnixNano())
+}
+
+// MatchETag 判断 If-Match / If-None-Match 请求头是否命中当前版本，支持 * 和逗号分隔的多个值
+func MatchETag(header, version string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(version, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// ParsePagination 解析列表查询的 startIndex（从1开始）与 count 参数
+func ParsePagination(startIndex, count string) (int, int, error) {
+	start := 1
+	if startIndex != "" {
+		n, err := strconv.Atoi(startIndex)
+		if err != nil {
+			return 0, 0, NewError(http.StatusBadRequest, ErrInvalidValue, "invalid startIndex")
+		}
+		// 小于1时按1处理，见 RFC 7644 3.4.2.4
+		if n > 1 {
+			start = n
+		}
+	}
+
+	size := DefaultCount
+	if count != "" {
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, 0, NewError(http.StatusBadRequest, ErrInvalidValue, "invalid count")
+		}
+		size = max(n, 0)
+	}
+
+	return start, min(size, MaxCount), nil
+}
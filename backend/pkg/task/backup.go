@@ -6,8 +6,13 @@ const (
 	BackupTaskType = "backup"
 )
 
+// BackupTaskData 数据库备份任务数据，未设置的字段使用配置文件中的默认值
 type BackupTaskData struct {
-	Name string `json:"name"`
+	Name string `json:"name"` // 备份名称，作为对象存储中的目录
+
+	Tables   []string `json:"tables,omitempty"`    // 只备份指定的表
+	KeepLast uint32   `json:"keep_last,omitempty"` // 保留最近的份数
+	KeepDays uint32   `json:"keep_days,omitempty"` // 保留最近天数内的备份
 }
 
 // CreateBackupTaskID creates a unique task ID for a backup task based on the lottery details.
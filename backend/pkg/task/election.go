@@ -0,0 +1,112 @@
+package task
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// 租约空闲时取得，已由自己持有时续期；自己持有的租约在短暂失联后也能重新取得
+	acquireLeaseScript = redis.NewScript(`
+local holder = redis.call("GET", KEYS[1])
+if holder == false then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+if holder == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+	return 1
+end
+return 0`)
+
+	// 只释放自己持有的租约，避免误删其他实例取得的租约
+	releaseLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+)
+
+// LeaderElector 基于 Redis 租约的选主，同一时刻最多只有一个实例持有租约
+type LeaderElector struct {
+	rdb redis.UniversalClient
+
+	key string
+	id  string
+	ttl time.Duration
+
+	leader atomic.Bool
+}
+
+// NewLeaderElector 创建选主器，id 为当前实例的唯一标识，租约在 ttl 内未续期即失效
+func NewLeaderElector(rdb redis.UniversalClient, key, id string, ttl time.Duration) *LeaderElector {
+	return &LeaderElector{
+		rdb: rdb,
+		key: key,
+		id:  id,
+		ttl: ttl,
+	}
+}
+
+// ID 当前实例的标识
+func (e *LeaderElector) ID() string {
+	return e.id
+}
+
+// IsLeader 当前实例是否持有租约
+func (e *LeaderElector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Run 参与选主直到 ctx 结束，每隔 ttl/3 尝试取得或续期租约
+// 成为主节点时调用 onElected，失去主节点时调用 onRevoked；退出时主动释放租约，其他实例可以立即接管。
+func (e *LeaderElector) Run(ctx context.Context, onElected, onRevoked func()) {
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		e.tick(ctx, onElected, onRevoked)
+
+		select {
+		case <-ctx.Done():
+			if e.leader.Swap(false) {
+				onRevoked()
+			}
+			_ = e.release(context.WithoutCancel(ctx))
+			return
+
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick 取得或续期一次租约，并在主节点状态变化时回调
+func (e *LeaderElector) tick(ctx context.Context, onElected, onRevoked func()) {
+	// 与 Redis 通信失败时无法确认租约是否仍然有效，主动让出，宁可短暂无主也不重复调度
+	held, err := e.acquireOrRenew(ctx)
+	if err != nil {
+		held = false
+	}
+
+	switch {
+	case held && !e.leader.Swap(true):
+		onElected()
+	case !held && e.leader.Swap(false):
+		onRevoked()
+	}
+}
+
+func (e *LeaderElector) acquireOrRenew(ctx context.Context) (bool, error) {
+	n, err := acquireLeaseScript.Run(ctx, e.rdb, []string{e.key}, e.id, e.ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (e *LeaderElector) release(ctx context.Context) error {
+	return releaseLeaseScript.Run(ctx, e.rdb, []string{e.key}, e.id).Err()
+}
@@ -0,0 +1,126 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+type electionRecorder struct {
+	elected int
+	revoked int
+}
+
+func (r *electionRecorder) onElected() { r.elected++ }
+func (r *electionRecorder) onRevoked() { r.revoked++ }
+
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	m := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return m, rdb
+}
+
+func TestLeaderElector(t *testing.T) {
+	ctx := context.Background()
+	m, rdb := newTestRedis(t)
+
+	const ttl = 3 * time.Second
+	a := NewLeaderElector(rdb, "leader", "a", ttl)
+	b := NewLeaderElector(rdb, "leader", "b", ttl)
+	var ra, rb electionRecorder
+
+	// 只有一个实例能取得租约
+	a.tick(ctx, ra.onElected, ra.onRevoked)
+	b.tick(ctx, rb.onElected, rb.onRevoked)
+	assert.True(t, a.IsLeader())
+	assert.False(t, b.IsLeader())
+	assert.Equal(t, 1, ra.elected)
+	assert.Equal(t, 0, rb.elected)
+
+	// 续期后租约不会过期
+	m.FastForward(2 * time.Second)
+	a.tick(ctx, ra.onElected, ra.onRevoked)
+	m.FastForward(2 * time.Second)
+	b.tick(ctx, rb.onElected, rb.onRevoked)
+	assert.True(t, a.IsLeader())
+	assert.False(t, b.IsLeader())
+	assert.Equal(t, 1, ra.elected, "续期不应重复回调")
+
+	// 主节点停止续期，租约过期后由其他实例接管，原主节点随后让出
+	m.FastForward(ttl + time.Second)
+	b.tick(ctx, rb.onElected, rb.onRevoked)
+	assert.True(t, b.IsLeader())
+	a.tick(ctx, ra.onElected, ra.onRevoked)
+	assert.False(t, a.IsLeader())
+	assert.Equal(t, 1, ra.revoked)
+	assert.Equal(t, "b", mustGet(t, m, "leader"))
+
+	// 释放租约只影响自己持有的租约
+	assert.NoError(t, a.release(ctx))
+	assert.Equal(t, "b", mustGet(t, m, "leader"))
+	assert.NoError(t, b.release(ctx))
+	assert.False(t, m.Exists("leader"))
+}
+
+func TestLeaderElectorRedisFailure(t *testing.T) {
+	ctx := context.Background()
+	m, rdb := newTestRedis(t)
+
+	e := NewLeaderElector(rdb, "leader", "a", 3*time.Second)
+	var r electionRecorder
+
+	e.tick(ctx, r.onElected, r.onRevoked)
+	assert.True(t, e.IsLeader())
+
+	// 无法确认租约时主动让出
+	m.SetError("connection lost")
+	e.tick(ctx, r.onElected, r.onRevoked)
+	assert.False(t, e.IsLeader())
+	assert.Equal(t, 1, r.revoked)
+
+	// 恢复后重新取得租约
+	m.SetError("")
+	e.tick(ctx, r.onElected, r.onRevoked)
+	assert.True(t, e.IsLeader())
+	assert.Equal(t, 2, r.elected)
+}
+
+func TestLeaderElectorRun(t *testing.T) {
+	m, rdb := newTestRedis(t)
+
+	e := NewLeaderElector(rdb, "leader", "a", 300*time.Millisecond)
+
+	elected := make(chan struct{})
+	revoked := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		e.Run(ctx, func() { close(elected) }, func() { close(revoked) })
+	}()
+
+	select {
+	case <-elected:
+	case <-time.After(time.Second):
+		t.Fatal("leader was not elected")
+	}
+
+	// 退出时让出并释放租约，其他实例可以立即接管
+	cancel()
+	<-done
+	<-revoked
+	assert.False(t, e.IsLeader())
+	assert.False(t, m.Exists("leader"))
+}
+
+func mustGet(t *testing.T, m *miniredis.Miniredis, key string) string {
+	t.Helper()
+	v, err := m.Get(key)
+	assert.NoError(t, err)
+	return v
+}
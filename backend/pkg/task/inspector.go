@@ -0,0 +1,34 @@
+package task
+
+import (
+	"errors"
+
+	"github.com/hibiken/asynq"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+)
+
+// NewInspector 按任务队列的配置创建队列查询器，用于查看队列统计和管理队列中的任务
+func NewInspector(cfg *conf.Server_Asynq) (*asynq.Inspector, error) {
+	if cfg == nil || cfg.GetUri() == "" {
+		return nil, errors.New("asynq redis uri is not configured")
+	}
+
+	opt, err := asynq.ParseRedisURI(cfg.GetUri())
+	if err != nil {
+		return nil, err
+	}
+
+	// 与任务服务保持一致的库和连接池设置
+	if o, ok := opt.(asynq.RedisClientOpt); ok {
+		if cfg.GetDb() != 0 {
+			o.DB = int(cfg.GetDb())
+		}
+		if cfg.GetPoolSize() != 0 {
+			o.PoolSize = int(cfg.GetPoolSize())
+		}
+		opt = o
+	}
+
+	return asynq.NewInspector(opt), nil
+}
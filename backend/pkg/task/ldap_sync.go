@@ -0,0 +1,11 @@
+package task
+
+const (
+	LdapSyncTaskType = "ldap_sync"
+)
+
+// LdapSyncTaskData LDAP 目录同步任务数据，未指定租户时同步所有开启了定时同步的身份源
+type LdapSyncTaskData struct {
+	TenantIDs []uint32 `json:"tenant_ids,omitempty"` // 只同步指定租户的身份源
+	DryRun    bool     `json:"dry_run,omitempty"`    // 试运行，只生成报告不落库
+}
@@ -0,0 +1,36 @@
+package task
+
+import "context"
+
+// ProgressEvent 任务执行进度的 SSE 事件名，事件推送到任务创建者的 SSE 流
+const ProgressEvent = "task_progress"
+
+// ProgressReporter 任务执行进度上报，由任务处理函数从 context 中获取
+type ProgressReporter interface {
+	// Report 上报执行进度，percent 取值 0-100
+	Report(percent uint32, message string)
+
+	// SetResult 设置执行结果，以 JSON 格式记录到执行记录中
+	SetResult(result any)
+}
+
+type progressKey struct{}
+
+// NewProgressContext 将进度上报器放入 context
+func NewProgressContext(ctx context.Context, reporter ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressKey{}, reporter)
+}
+
+// ProgressFromContext 从 context 中获取进度上报器，不存在时返回不做任何事的实现
+func ProgressFromContext(ctx context.Context) ProgressReporter {
+	if r, ok := ctx.Value(progressKey{}).(ProgressReporter); ok && r != nil {
+		return r
+	}
+	return noopProgress{}
+}
+
+type noopProgress struct{}
+
+func (noopProgress) Report(uint32, string) {}
+
+func (noopProgress) SetResult(any) {}
@@ -0,0 +1,30 @@
+package task
+
+import "time"
+
+const (
+	// SchedulerLeaderKey 周期任务调度节点的选主租约
+	SchedulerLeaderKey = "task:scheduler:leader"
+
+	// SchedulerLeaseTTL 调度节点租约的有效期，主节点失联后最长经过该时间由其他节点接管
+	SchedulerLeaseTTL = 15 * time.Second
+
+	// ScheduleChangedChannel 任务调度变更的通知频道，非主节点上的变更通过该频道交给主节点执行
+	ScheduleChangedChannel = "task:scheduler:changed"
+)
+
+// ScheduleAction 任务调度变更动作
+type ScheduleAction string
+
+const (
+	ScheduleActionStart   ScheduleAction = "start"    // 按最新配置注册任务
+	ScheduleActionStop    ScheduleAction = "stop"     // 移除任务
+	ScheduleActionStopAll ScheduleAction = "stop_all" // 移除所有的任务
+)
+
+// ScheduleChange 任务调度变更通知
+type ScheduleChange struct {
+	Action   ScheduleAction `json:"action"`
+	TypeName string         `json:"type_name,omitempty"`
+	Node     string         `json:"node,omitempty"` // 发出通知的节点
+}
@@ -0,0 +1,9 @@
+package task
+
+const (
+	StorageUsageReconcileTaskType = "storage_usage_reconcile"
+)
+
+// StorageUsageReconcileTaskData 存储用量重新计算任务数据
+type StorageUsageReconcileTaskData struct {
+}
@@ -1875,6 +1875,9 @@ export type fileservicev1_File = {
   sizeFormat?: string;
   linkUrl?: string;
   contentHash?: string;
+  contentType?: string;
+  scanStatus?: fileservicev1_FileScanStatus;
+  scanResult?: string;
   tenantId?: number;
   tenantName?: string;
   createdBy?: number;
@@ -1897,6 +1900,12 @@ export type fileservicev1_OSSProvider =
   | "GOOGLE"
   | "HUAWEI"
   | "LOCAL";
+
+// 文件内容扫描状态
+export type fileservicev1_FileScanStatus =
+  | "UNSCANNED"
+  | "CLEAN"
+  | "QUARANTINED";
 // 查询 - 请求
 export type fileservicev1_GetFileRequest = {
   id?: number;